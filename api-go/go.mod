@@ -0,0 +1,16 @@
+module go.temporal.io/api
+
+go 1.13
+
+require (
+	github.com/gogo/googleapis v1.4.0 // indirect
+	github.com/gogo/protobuf v1.3.1
+	github.com/gogo/status v1.1.0
+	github.com/golang/mock v1.4.4
+	github.com/golang/protobuf v1.4.2 // indirect
+	golang.org/x/net v0.0.0-20201010224723-4f7140c49acb // indirect
+	golang.org/x/sys v0.0.0-20201009025420-dfb3f7c4e634 // indirect
+	google.golang.org/genproto v0.0.0-20201012135029-0c95dc0d88e8 // indirect
+	google.golang.org/grpc v1.32.0
+	google.golang.org/protobuf v1.25.0 // indirect
+)
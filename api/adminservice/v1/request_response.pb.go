@@ -44,11 +44,15 @@ import (
 	github_com_gogo_protobuf_types "github.com/gogo/protobuf/types"
 	v1 "go.temporal.io/api/common/v1"
 	v15 "go.temporal.io/api/enums/v1"
+	v18 "go.temporal.io/api/failure/v1"
+	v19 "go.temporal.io/api/history/v1"
+	v20 "go.temporal.io/api/workflow/v1"
 	v16 "go.temporal.io/server/api/cluster/v1"
 	v12 "go.temporal.io/server/api/enums/v1"
 	v13 "go.temporal.io/server/api/history/v1"
 	v11 "go.temporal.io/server/api/namespace/v1"
 	v14 "go.temporal.io/server/api/replication/v1"
+	v17 "go.temporal.io/server/api/schedule/v1"
 )
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -1841,4006 +1845,17867 @@ func (m *ResendReplicationTasksResponse) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_ResendReplicationTasksResponse proto.InternalMessageInfo
 
-func init() {
-	proto.RegisterType((*DescribeWorkflowExecutionRequest)(nil), "temporal.server.api.adminservice.v1.DescribeWorkflowExecutionRequest")
-	proto.RegisterType((*DescribeWorkflowExecutionResponse)(nil), "temporal.server.api.adminservice.v1.DescribeWorkflowExecutionResponse")
-	proto.RegisterType((*DescribeHistoryHostRequest)(nil), "temporal.server.api.adminservice.v1.DescribeHistoryHostRequest")
-	proto.RegisterType((*DescribeHistoryHostResponse)(nil), "temporal.server.api.adminservice.v1.DescribeHistoryHostResponse")
-	proto.RegisterType((*CloseShardRequest)(nil), "temporal.server.api.adminservice.v1.CloseShardRequest")
-	proto.RegisterType((*CloseShardResponse)(nil), "temporal.server.api.adminservice.v1.CloseShardResponse")
-	proto.RegisterType((*RemoveTaskRequest)(nil), "temporal.server.api.adminservice.v1.RemoveTaskRequest")
-	proto.RegisterType((*RemoveTaskResponse)(nil), "temporal.server.api.adminservice.v1.RemoveTaskResponse")
-	proto.RegisterType((*GetWorkflowExecutionRawHistoryV2Request)(nil), "temporal.server.api.adminservice.v1.GetWorkflowExecutionRawHistoryV2Request")
-	proto.RegisterType((*GetWorkflowExecutionRawHistoryV2Response)(nil), "temporal.server.api.adminservice.v1.GetWorkflowExecutionRawHistoryV2Response")
-	proto.RegisterType((*GetReplicationMessagesRequest)(nil), "temporal.server.api.adminservice.v1.GetReplicationMessagesRequest")
-	proto.RegisterType((*GetReplicationMessagesResponse)(nil), "temporal.server.api.adminservice.v1.GetReplicationMessagesResponse")
-	proto.RegisterMapType((map[int32]*v14.ReplicationMessages)(nil), "temporal.server.api.adminservice.v1.GetReplicationMessagesResponse.ShardMessagesEntry")
-	proto.RegisterType((*GetNamespaceReplicationMessagesRequest)(nil), "temporal.server.api.adminservice.v1.GetNamespaceReplicationMessagesRequest")
-	proto.RegisterType((*GetNamespaceReplicationMessagesResponse)(nil), "temporal.server.api.adminservice.v1.GetNamespaceReplicationMessagesResponse")
-	proto.RegisterType((*GetDLQReplicationMessagesRequest)(nil), "temporal.server.api.adminservice.v1.GetDLQReplicationMessagesRequest")
-	proto.RegisterType((*GetDLQReplicationMessagesResponse)(nil), "temporal.server.api.adminservice.v1.GetDLQReplicationMessagesResponse")
-	proto.RegisterType((*ReapplyEventsRequest)(nil), "temporal.server.api.adminservice.v1.ReapplyEventsRequest")
-	proto.RegisterType((*ReapplyEventsResponse)(nil), "temporal.server.api.adminservice.v1.ReapplyEventsResponse")
-	proto.RegisterType((*AddSearchAttributeRequest)(nil), "temporal.server.api.adminservice.v1.AddSearchAttributeRequest")
-	proto.RegisterMapType((map[string]v15.IndexedValueType)(nil), "temporal.server.api.adminservice.v1.AddSearchAttributeRequest.SearchAttributeEntry")
-	proto.RegisterType((*AddSearchAttributeResponse)(nil), "temporal.server.api.adminservice.v1.AddSearchAttributeResponse")
-	proto.RegisterType((*DescribeClusterRequest)(nil), "temporal.server.api.adminservice.v1.DescribeClusterRequest")
-	proto.RegisterType((*DescribeClusterResponse)(nil), "temporal.server.api.adminservice.v1.DescribeClusterResponse")
-	proto.RegisterMapType((map[string]string)(nil), "temporal.server.api.adminservice.v1.DescribeClusterResponse.SupportedClientsEntry")
-	proto.RegisterType((*GetDLQMessagesRequest)(nil), "temporal.server.api.adminservice.v1.GetDLQMessagesRequest")
-	proto.RegisterType((*GetDLQMessagesResponse)(nil), "temporal.server.api.adminservice.v1.GetDLQMessagesResponse")
-	proto.RegisterType((*PurgeDLQMessagesRequest)(nil), "temporal.server.api.adminservice.v1.PurgeDLQMessagesRequest")
-	proto.RegisterType((*PurgeDLQMessagesResponse)(nil), "temporal.server.api.adminservice.v1.PurgeDLQMessagesResponse")
-	proto.RegisterType((*MergeDLQMessagesRequest)(nil), "temporal.server.api.adminservice.v1.MergeDLQMessagesRequest")
-	proto.RegisterType((*MergeDLQMessagesResponse)(nil), "temporal.server.api.adminservice.v1.MergeDLQMessagesResponse")
-	proto.RegisterType((*RefreshWorkflowTasksRequest)(nil), "temporal.server.api.adminservice.v1.RefreshWorkflowTasksRequest")
-	proto.RegisterType((*RefreshWorkflowTasksResponse)(nil), "temporal.server.api.adminservice.v1.RefreshWorkflowTasksResponse")
-	proto.RegisterType((*ResendReplicationTasksRequest)(nil), "temporal.server.api.adminservice.v1.ResendReplicationTasksRequest")
-	proto.RegisterType((*ResendReplicationTasksResponse)(nil), "temporal.server.api.adminservice.v1.ResendReplicationTasksResponse")
+type CreateScheduleRequest struct {
+	Namespace    string             `protobuf:"bytes,1,opt,name=namespace,proto3" json:"namespace,omitempty"`
+	ScheduleId   string             `protobuf:"bytes,2,opt,name=schedule_id,json=scheduleId,proto3" json:"schedule_id,omitempty"`
+	Schedule     *v17.Schedule      `protobuf:"bytes,3,opt,name=schedule,proto3" json:"schedule,omitempty"`
+	InitialPatch *v17.SchedulePatch `protobuf:"bytes,4,opt,name=initial_patch,json=initialPatch,proto3" json:"initial_patch,omitempty"`
+	Identity     string             `protobuf:"bytes,5,opt,name=identity,proto3" json:"identity,omitempty"`
+	RequestId    string             `protobuf:"bytes,6,opt,name=request_id,json=requestId,proto3" json:"request_id,omitempty"`
 }
 
-func init() {
-	proto.RegisterFile("temporal/server/api/adminservice/v1/request_response.proto", fileDescriptor_cc07c1a2abe7cb51)
+func (m *CreateScheduleRequest) Reset()      { *m = CreateScheduleRequest{} }
+func (*CreateScheduleRequest) ProtoMessage() {}
+func (*CreateScheduleRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_cc07c1a2abe7cb51, []int{32}
 }
-
-var fileDescriptor_cc07c1a2abe7cb51 = []byte{
-	// 1849 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xdc, 0x58, 0x4b, 0x6f, 0x1b, 0xd7,
-	0x15, 0xd6, 0x90, 0x96, 0x2c, 0x1e, 0x49, 0x94, 0x35, 0x95, 0x2c, 0x9a, 0x76, 0x68, 0x79, 0x9c,
-	0xc6, 0x8a, 0x51, 0x8c, 0x6a, 0x25, 0x48, 0xd2, 0x14, 0x5d, 0x58, 0xb2, 0xaa, 0x10, 0xb0, 0x02,
-	0x67, 0x64, 0xc8, 0x45, 0x81, 0x82, 0xbd, 0xe4, 0x1c, 0x51, 0x03, 0x71, 0x1e, 0xbd, 0xf7, 0x0e,
-	0x6d, 0x19, 0x68, 0xda, 0x45, 0x0b, 0xb4, 0x3b, 0xaf, 0xfb, 0x0b, 0xba, 0x29, 0xfa, 0x1b, 0xba,
-	0xcb, 0xd2, 0xe8, 0x2a, 0x68, 0x17, 0xa9, 0xe5, 0x4d, 0xbb, 0xcb, 0xaa, 0xbb, 0x02, 0xc5, 0x7d,
-	0xcd, 0xf0, 0x31, 0xa2, 0xe5, 0x26, 0xcd, 0x22, 0xbb, 0x99, 0xf3, 0x9a, 0xf3, 0xba, 0xdf, 0x39,
-	0x77, 0xe0, 0x43, 0x8e, 0x61, 0x12, 0x53, 0xd2, 0xdb, 0x60, 0x48, 0xfb, 0x48, 0x37, 0x48, 0x12,
-	0x6c, 0x10, 0x3f, 0x0c, 0x22, 0xf1, 0x1e, 0x74, 0x70, 0xa3, 0x7f, 0x67, 0x83, 0xe2, 0x2f, 0x52,
-	0x64, 0xbc, 0x45, 0x91, 0x25, 0x71, 0xc4, 0xd0, 0x4d, 0x68, 0xcc, 0x63, 0xfb, 0xa6, 0xd1, 0x75,
-	0x95, 0xae, 0x4b, 0x92, 0xc0, 0x1d, 0xd4, 0x75, 0xfb, 0x77, 0xea, 0xd7, 0xbb, 0x71, 0xdc, 0xed,
-	0xe1, 0x86, 0x54, 0x69, 0xa7, 0x87, 0x1b, 0x3c, 0x08, 0x91, 0x71, 0x12, 0x26, 0xca, 0x4a, 0xfd,
-	0x86, 0x8f, 0x09, 0x46, 0x3e, 0x46, 0x9d, 0x00, 0xd9, 0x46, 0x37, 0xee, 0xc6, 0x92, 0x2e, 0x9f,
-	0xb4, 0x88, 0x93, 0x39, 0x29, 0xbc, 0xc3, 0x28, 0x0d, 0x99, 0x70, 0xab, 0x13, 0x87, 0x61, 0x1c,
-	0x69, 0x99, 0x37, 0x87, 0x64, 0x14, 0x4b, 0x08, 0x85, 0xc8, 0x18, 0xe9, 0x6a, 0x97, 0xeb, 0xdf,
-	0x2b, 0x0a, 0xb7, 0xd3, 0x4b, 0x19, 0x47, 0x3a, 0x2e, 0xfd, 0x76, 0x91, 0x74, 0xf1, 0xe7, 0x6f,
-	0x4d, 0x14, 0xe5, 0x84, 0x1d, 0x6b, 0x41, 0xb7, 0x48, 0x30, 0x22, 0x21, 0xb2, 0x84, 0xa8, 0x6c,
-	0x9f, 0xc3, 0xe3, 0xa3, 0x80, 0xf1, 0x98, 0x9e, 0x8c, 0x4b, 0x7f, 0xbf, 0x48, 0x9a, 0x62, 0xd2,
-	0x0b, 0x3a, 0x84, 0x07, 0x05, 0x19, 0x71, 0x7e, 0x6f, 0xc1, 0xda, 0x3d, 0x64, 0x1d, 0x1a, 0xb4,
-	0xf1, 0x51, 0x4c, 0x8f, 0x0f, 0x7b, 0xf1, 0xe3, 0x9d, 0x27, 0xd8, 0x49, 0x85, 0xb8, 0xa7, 0x0a,
-	0x6f, 0x5f, 0x83, 0x4a, 0xe6, 0x62, 0xcd, 0x5a, 0xb3, 0xd6, 0x2b, 0x5e, 0x4e, 0xb0, 0x77, 0xa1,
-	0x82, 0x46, 0xa3, 0x56, 0x5a, 0xb3, 0xd6, 0xe7, 0x36, 0xdf, 0xce, 0xc2, 0x94, 0x4d, 0xa1, 0x53,
-	0xd5, 0xbf, 0xe3, 0x8e, 0x7f, 0x22, 0xd7, 0x75, 0xfe, 0x63, 0xc1, 0x8d, 0x09, 0xbe, 0xa8, 0xe6,
-	0xb3, 0xaf, 0xc0, 0x2c, 0x3b, 0x22, 0xd4, 0x6f, 0x05, 0xbe, 0xf6, 0xe5, 0xa2, 0x7c, 0x6f, 0xfa,
-	0xf6, 0x0d, 0x98, 0xd7, 0xa9, 0x69, 0x11, 0xdf, 0xa7, 0xd2, 0x99, 0x8a, 0x37, 0xa7, 0x69, 0x77,
-	0x7d, 0x9f, 0xda, 0x2e, 0x7c, 0xa7, 0x43, 0x3a, 0x47, 0xd8, 0x0a, 0x53, 0x4e, 0xda, 0x3d, 0x6c,
-	0x31, 0x4e, 0x38, 0xd6, 0xca, 0x52, 0x72, 0x49, 0xb2, 0xf6, 0x14, 0x67, 0x5f, 0x30, 0xec, 0x77,
-	0xe1, 0xb2, 0x4f, 0x38, 0x69, 0x13, 0x36, 0xaa, 0x72, 0x41, 0xaa, 0x2c, 0x1b, 0xee, 0x90, 0xd6,
-	0x2a, 0x5c, 0xe4, 0x14, 0x51, 0xb8, 0x38, 0x2d, 0xc5, 0x66, 0xc4, 0x6b, 0xd3, 0xb7, 0xaf, 0x42,
-	0xa5, 0x4d, 0x49, 0xd4, 0x39, 0x12, 0xac, 0x19, 0xc9, 0x9a, 0x55, 0x84, 0xa6, 0xef, 0xfc, 0xd5,
-	0x82, 0xba, 0x89, 0xff, 0x23, 0xe5, 0xf3, 0x47, 0x31, 0xe3, 0xa6, 0x0a, 0x22, 0xba, 0x98, 0x71,
-	0x19, 0x1a, 0x32, 0xa6, 0x83, 0x9f, 0x13, 0xb4, 0xbb, 0x8a, 0x34, 0x94, 0x1b, 0x11, 0xfc, 0x74,
-	0x9e, 0x9b, 0xa1, 0x1a, 0x96, 0x47, 0x6b, 0xf8, 0x13, 0xb0, 0x1f, 0xeb, 0x8c, 0xb7, 0xf2, 0x62,
-	0x5e, 0x78, 0xdd, 0x62, 0x2e, 0x3d, 0x1e, 0x25, 0x39, 0xcf, 0x4a, 0x70, 0xb5, 0x30, 0x28, 0x5d,
-	0xce, 0x9b, 0xb0, 0x20, 0x5d, 0x64, 0xad, 0x28, 0x0d, 0xdb, 0x48, 0x65, 0x58, 0xd3, 0xde, 0xbc,
-	0x22, 0x7e, 0x2c, 0x69, 0x22, 0x6d, 0x26, 0x2e, 0x56, 0x2b, 0xad, 0x95, 0xd7, 0xa7, 0xbd, 0x59,
-	0x1d, 0x18, 0xb3, 0x7f, 0x06, 0x8b, 0x59, 0x20, 0x2d, 0x59, 0x41, 0x19, 0xdf, 0xdc, 0xe6, 0xbb,
-	0x6e, 0x11, 0x42, 0x65, 0xb2, 0x22, 0x84, 0x8f, 0xcd, 0xcb, 0xb6, 0xd0, 0x6b, 0x46, 0x87, 0xb1,
-	0x57, 0x8d, 0x86, 0x68, 0xf6, 0x7b, 0xb0, 0xaa, 0xbe, 0xdd, 0x89, 0x23, 0x4e, 0xe3, 0x5e, 0x0f,
-	0xa9, 0xec, 0x80, 0x94, 0xe9, 0x16, 0x58, 0x91, 0xec, 0xed, 0x8c, 0xbb, 0x2f, 0x99, 0x76, 0x0d,
-	0x2e, 0x9a, 0x4a, 0xa9, 0x1e, 0x30, 0xaf, 0x8e, 0x0b, 0x4b, 0xdb, 0xbd, 0x98, 0xe1, 0xbe, 0xd0,
-	0x33, 0xd5, 0x1d, 0x6d, 0xeb, 0xbc, 0x74, 0xce, 0x32, 0xd8, 0x83, 0xf2, 0x2a, 0x71, 0xce, 0xdf,
-	0x2c, 0x58, 0xf2, 0x30, 0x8c, 0xfb, 0xf8, 0x90, 0xb0, 0xe3, 0x57, 0x9b, 0xb1, 0x7f, 0x0c, 0xb3,
-	0x1d, 0xc2, 0xb1, 0x1b, 0xd3, 0x13, 0xd9, 0x1c, 0xd5, 0xcd, 0xdb, 0x85, 0x09, 0x92, 0xb0, 0x25,
-	0x92, 0x23, 0xec, 0x6e, 0x6b, 0x0d, 0x2f, 0xd3, 0x95, 0xcd, 0x4d, 0xd8, 0xb1, 0xf8, 0x82, 0xc8,
-	0x73, 0xd9, 0x9b, 0x11, 0xaf, 0x4d, 0xdf, 0x6e, 0xc2, 0x62, 0x3f, 0x60, 0x41, 0x3b, 0xe8, 0x05,
-	0xfc, 0xa4, 0x25, 0x80, 0x5e, 0x77, 0x50, 0xdd, 0x55, 0x53, 0xc0, 0x35, 0x53, 0xc0, 0x7d, 0x68,
-	0xa6, 0xc0, 0xd6, 0x85, 0x67, 0x5f, 0x5c, 0xb7, 0xbc, 0x6a, 0xae, 0x28, 0x58, 0x22, 0xe4, 0xc1,
-	0xd8, 0x74, 0xc8, 0xbf, 0x2b, 0xc3, 0xad, 0x5d, 0xe4, 0xe3, 0x7d, 0x47, 0x1e, 0xeb, 0xd6, 0x3a,
-	0xd8, 0xfc, 0x66, 0x31, 0xcb, 0x7e, 0x13, 0xaa, 0x8c, 0x13, 0xca, 0x5b, 0xd8, 0xc7, 0x88, 0xe7,
-	0x39, 0x99, 0x97, 0xd4, 0x1d, 0x41, 0x6c, 0xfa, 0x02, 0x75, 0x06, 0xa5, 0xfa, 0x48, 0x99, 0x39,
-	0x5f, 0x65, 0x6f, 0x29, 0x17, 0x3d, 0x50, 0x0c, 0x7b, 0x0d, 0xe6, 0x31, 0xf2, 0x73, 0x9b, 0xd3,
-	0x52, 0x10, 0x30, 0xf2, 0x8d, 0xc5, 0xdb, 0xb0, 0x94, 0x4b, 0x18, 0x7b, 0x33, 0x52, 0x6c, 0xd1,
-	0x88, 0x19, 0x6b, 0xb7, 0x61, 0x29, 0x24, 0x4f, 0x82, 0x30, 0x0d, 0x5b, 0x09, 0xe9, 0x62, 0x8b,
-	0x05, 0x4f, 0xb1, 0x76, 0x51, 0x36, 0xc7, 0xa2, 0x66, 0x3c, 0x20, 0x5d, 0xdc, 0x0f, 0x9e, 0xa2,
-	0xfd, 0x16, 0x2c, 0x46, 0xf8, 0x84, 0x2b, 0x41, 0x1e, 0x1f, 0x63, 0x54, 0x9b, 0x5d, 0xb3, 0xd6,
-	0xe7, 0xbd, 0x05, 0x41, 0x16, 0x62, 0x0f, 0x05, 0xd1, 0xf9, 0xb7, 0x05, 0xeb, 0xaf, 0x2e, 0x85,
-	0x3e, 0xe3, 0x05, 0x46, 0xad, 0x02, 0xa3, 0xa2, 0x81, 0x0c, 0x7e, 0xb7, 0x09, 0xef, 0x1c, 0xa1,
-	0x3a, 0xec, 0x73, 0x9b, 0x6b, 0x67, 0xd5, 0xe6, 0x1e, 0xe1, 0x64, 0xab, 0x17, 0xb7, 0xbd, 0xaa,
-	0x56, 0xdc, 0x52, 0x7a, 0xf6, 0x23, 0x58, 0xd4, 0x59, 0x69, 0x69, 0x8e, 0x06, 0x05, 0xb7, 0xb0,
-	0xe7, 0xb5, 0x8c, 0x30, 0xa9, 0xb3, 0xa6, 0xa3, 0xf0, 0xaa, 0xfd, 0xa1, 0x77, 0xe7, 0x99, 0x05,
-	0x6f, 0xec, 0x22, 0xf7, 0xf2, 0xa1, 0xba, 0xa7, 0x06, 0x2a, 0x33, 0x9d, 0x77, 0x1f, 0x66, 0x64,
-	0x8c, 0x02, 0xa1, 0xcb, 0x67, 0xc2, 0xd0, 0xc0, 0x54, 0x16, 0x5f, 0x1d, 0xb0, 0x27, 0x73, 0xe1,
-	0x69, 0x1b, 0x02, 0xf5, 0xf5, 0x82, 0xd2, 0x12, 0xed, 0x6b, 0x66, 0x9a, 0xa6, 0x09, 0xfc, 0x72,
-	0xfe, 0x50, 0x82, 0xc6, 0x59, 0x2e, 0xe9, 0x0a, 0xfc, 0x12, 0xaa, 0x0a, 0x16, 0xf4, 0xf4, 0x37,
-	0xbe, 0x1d, 0xb8, 0xe7, 0x58, 0xe2, 0xdc, 0xc9, 0xc6, 0x5d, 0x89, 0x4b, 0x86, 0xba, 0x13, 0x71,
-	0x7a, 0xe2, 0x29, 0x4c, 0x37, 0xb4, 0xfa, 0x09, 0xd8, 0xe3, 0x42, 0xf6, 0x25, 0x28, 0x1f, 0xe3,
-	0x89, 0x86, 0x29, 0xf1, 0x68, 0xef, 0xc1, 0x74, 0x9f, 0xf4, 0x52, 0xd4, 0x47, 0xf2, 0xfd, 0xd7,
-	0xcc, 0x5c, 0xe6, 0x99, 0xb2, 0xf2, 0x61, 0xe9, 0x03, 0xcb, 0xf9, 0x8b, 0x05, 0x6f, 0xed, 0x22,
-	0xcf, 0x80, 0x7e, 0x42, 0xe1, 0x7e, 0x00, 0x57, 0x7a, 0x44, 0xee, 0xb9, 0x9c, 0x06, 0xd8, 0xc7,
-	0x2c, 0x5b, 0x06, 0x4c, 0xcb, 0xde, 0x65, 0x21, 0xe0, 0x19, 0xbe, 0x36, 0xd0, 0xf4, 0x33, 0xd5,
-	0x84, 0xc6, 0x1d, 0x64, 0x6c, 0x58, 0xb5, 0x94, 0xab, 0x3e, 0x30, 0xfc, 0x5c, 0x75, 0xb4, 0xc0,
-	0xe5, 0xf1, 0x02, 0x7f, 0x2a, 0x61, 0x6f, 0x72, 0x08, 0xba, 0xd0, 0xfb, 0x30, 0x3b, 0x50, 0xe2,
-	0xaf, 0x94, 0xc4, 0xcc, 0x90, 0xf3, 0x14, 0xd6, 0x76, 0x91, 0xdf, 0xbb, 0xff, 0xc9, 0x84, 0xe4,
-	0x1d, 0x00, 0xa8, 0xa9, 0x10, 0x1d, 0xc6, 0xa6, 0xbb, 0x5e, 0xf7, 0xd3, 0x02, 0xec, 0xe5, 0x0c,
-	0xae, 0x70, 0xfd, 0xc4, 0x9c, 0xdf, 0x5a, 0x70, 0x63, 0xc2, 0xc7, 0x75, 0xd8, 0x3f, 0x87, 0xa5,
-	0x01, 0xb3, 0x2d, 0xa1, 0x6e, 0x9c, 0x78, 0xe7, 0x7f, 0x70, 0xc2, 0xbb, 0x44, 0x87, 0x09, 0xcc,
-	0xf9, 0xcc, 0x82, 0x65, 0x0f, 0x49, 0x92, 0xf4, 0x4e, 0x24, 0xb8, 0xb2, 0xf3, 0x0d, 0x9a, 0xe2,
-	0xc5, 0xaa, 0xf4, 0xd5, 0x17, 0x2b, 0xfb, 0x03, 0x98, 0x91, 0xe8, 0xcf, 0x34, 0xb0, 0xbd, 0x1a,
-	0x23, 0xb5, 0xbc, 0xb3, 0x0a, 0x2b, 0x23, 0x91, 0xe8, 0xf9, 0xfa, 0xe7, 0x12, 0x5c, 0xb9, 0xeb,
-	0xfb, 0xfb, 0x48, 0x68, 0xe7, 0xe8, 0x2e, 0xe7, 0x34, 0x68, 0xa7, 0x1c, 0x4d, 0xa0, 0x9f, 0xc2,
-	0x25, 0x26, 0x39, 0x2d, 0x62, 0x58, 0x3a, 0xc5, 0xfb, 0xe7, 0x42, 0x91, 0x33, 0x2d, 0xbb, 0x23,
-	0x64, 0x05, 0x21, 0x8b, 0x6c, 0x98, 0x6a, 0x7f, 0x17, 0xaa, 0x0c, 0x3b, 0x29, 0x95, 0xcb, 0x85,
-	0x1c, 0x22, 0x0a, 0x0b, 0x17, 0x0c, 0x55, 0x02, 0x67, 0xfd, 0x18, 0x96, 0x8b, 0xec, 0x0d, 0xa2,
-	0x4d, 0x45, 0xa1, 0xcd, 0x8f, 0x06, 0xd1, 0xa6, 0xba, 0x79, 0x6b, 0x38, 0x81, 0xd9, 0x1a, 0xd4,
-	0x8c, 0x7c, 0x7c, 0x82, 0xfe, 0x81, 0x10, 0x7d, 0x78, 0x92, 0xe0, 0x20, 0xba, 0x5c, 0x83, 0x7a,
-	0x51, 0x58, 0x3a, 0x9f, 0x35, 0xb8, 0x6c, 0x56, 0xdf, 0x6d, 0x75, 0x9c, 0x75, 0xc4, 0xce, 0x17,
-	0x25, 0x58, 0x1d, 0x63, 0xe9, 0x5e, 0xfe, 0x15, 0x2c, 0xb1, 0x34, 0x49, 0x62, 0xca, 0xd1, 0x6f,
-	0x75, 0x7a, 0x81, 0xac, 0xb1, 0x4a, 0xb4, 0x77, 0xae, 0x44, 0x9f, 0x61, 0xd8, 0xdd, 0x37, 0x56,
-	0xb7, 0x95, 0x51, 0x95, 0xe7, 0x4b, 0x6c, 0x84, 0xac, 0x12, 0x2d, 0xac, 0x67, 0x8b, 0x45, 0x96,
-	0x68, 0x41, 0x35, 0x6b, 0xc5, 0x23, 0x58, 0x0c, 0x51, 0xac, 0xe7, 0xec, 0x28, 0x48, 0xe4, 0xb9,
-	0x9f, 0x38, 0x62, 0x35, 0xa0, 0x09, 0x07, 0xf7, 0x32, 0x35, 0xb5, 0x71, 0x87, 0x43, 0xef, 0xf5,
-	0x6d, 0x58, 0x29, 0x74, 0xb5, 0xa0, 0x84, 0xcb, 0x83, 0x25, 0xac, 0x0c, 0x56, 0xe6, 0x4f, 0x25,
-	0x58, 0x51, 0xb8, 0x31, 0x8a, 0x54, 0x3b, 0x70, 0x81, 0x9f, 0x24, 0xea, 0xac, 0x56, 0x37, 0xef,
-	0x4c, 0xde, 0x81, 0xef, 0x21, 0xf1, 0xef, 0x23, 0xe7, 0x48, 0x3f, 0x49, 0x51, 0xd7, 0x5f, 0xaa,
-	0x4f, 0xba, 0x6b, 0x89, 0x04, 0xc6, 0x29, 0x15, 0xd7, 0x11, 0x15, 0xb4, 0x06, 0xf5, 0x05, 0x45,
-	0xd5, 0x75, 0xb1, 0xdf, 0x87, 0x5a, 0x10, 0x09, 0x89, 0xa0, 0x8f, 0x2d, 0xb1, 0xcd, 0x0d, 0xcc,
-	0x0c, 0xb5, 0x1a, 0xae, 0x64, 0xfc, 0x9d, 0x68, 0x60, 0x64, 0x14, 0x2e, 0x74, 0xd3, 0xe7, 0x5e,
-	0xe8, 0x66, 0x8a, 0x16, 0xba, 0x7f, 0x59, 0x70, 0x79, 0x34, 0x5f, 0xba, 0x21, 0xbf, 0xa6, 0x84,
-	0x15, 0x62, 0x74, 0xe9, 0x6b, 0xc4, 0xe8, 0xa2, 0x58, 0xcb, 0x45, 0xb1, 0xfe, 0xdd, 0x82, 0xd5,
-	0x07, 0x29, 0xed, 0xe2, 0xb7, 0xb1, 0x3b, 0x9c, 0x3a, 0xd4, 0xc6, 0x83, 0xcb, 0x11, 0x7e, 0x75,
-	0x0f, 0xbf, 0xa5, 0x91, 0xff, 0x5f, 0xce, 0xc5, 0x16, 0xd4, 0xc6, 0x13, 0xf6, 0x7a, 0xf7, 0x1a,
-	0xe7, 0x37, 0x16, 0x5c, 0xf5, 0xf0, 0x90, 0x22, 0x3b, 0x32, 0xa3, 0x5d, 0x36, 0xec, 0x37, 0xfc,
-	0x7f, 0xad, 0x01, 0xd7, 0x8a, 0xbd, 0xc8, 0x9b, 0xe3, 0x0d, 0x0f, 0x19, 0x46, 0xfe, 0xc8, 0x51,
-	0x63, 0x03, 0xbf, 0xa0, 0xf2, 0x5f, 0x2d, 0xd9, 0xff, 0xb7, 0xb9, 0x8c, 0xd6, 0xf4, 0xed, 0xeb,
-	0x30, 0x97, 0x2d, 0x3c, 0xba, 0x03, 0x2a, 0x1e, 0x18, 0x52, 0xd3, 0xb7, 0x57, 0x60, 0x86, 0xa6,
-	0x91, 0xb9, 0x29, 0x57, 0xbc, 0x69, 0x9a, 0x46, 0xaa, 0x37, 0x28, 0x86, 0x31, 0xcf, 0x7b, 0x43,
-	0xfd, 0x5d, 0x59, 0x50, 0x54, 0xd3, 0x1b, 0xe3, 0xf7, 0xed, 0xe9, 0x82, 0xfb, 0xf6, 0x4d, 0x58,
-	0x50, 0x52, 0xc3, 0x37, 0x63, 0x25, 0x74, 0xd6, 0x25, 0xfb, 0xe2, 0xd8, 0x25, 0xfb, 0x3a, 0xcc,
-	0x09, 0x09, 0x63, 0x64, 0x36, 0x13, 0xd0, 0x26, 0x9c, 0x35, 0x68, 0x9c, 0x95, 0x30, 0x95, 0xd3,
-	0xad, 0xde, 0xf3, 0x17, 0x8d, 0xa9, 0xcf, 0x5f, 0x34, 0xa6, 0xbe, 0x7c, 0xd1, 0xb0, 0x7e, 0x7d,
-	0xda, 0xb0, 0xfe, 0x78, 0xda, 0xb0, 0x3e, 0x3b, 0x6d, 0x58, 0xcf, 0x4f, 0x1b, 0xd6, 0x3f, 0x4e,
-	0x1b, 0xd6, 0x3f, 0x4f, 0x1b, 0x53, 0x5f, 0x9e, 0x36, 0xac, 0x67, 0x2f, 0x1b, 0x53, 0xcf, 0x5f,
-	0x36, 0xa6, 0x3e, 0x7f, 0xd9, 0x98, 0xfa, 0xe9, 0x7b, 0xdd, 0x38, 0xaf, 0x70, 0x10, 0x4f, 0xf8,
-	0x39, 0xff, 0xc3, 0xc1, 0xf7, 0xf6, 0x8c, 0xfc, 0xc1, 0xf2, 0xce, 0x7f, 0x03, 0x00, 0x00, 0xff,
-	0xff, 0x4c, 0x79, 0x62, 0x54, 0xd7, 0x17, 0x00, 0x00,
+func (m *CreateScheduleRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *CreateScheduleRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_CreateScheduleRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *CreateScheduleRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CreateScheduleRequest.Merge(m, src)
+}
+func (m *CreateScheduleRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *CreateScheduleRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_CreateScheduleRequest.DiscardUnknown(m)
 }
 
-func (this *DescribeWorkflowExecutionRequest) Equal(that interface{}) bool {
-	if that == nil {
-		return this == nil
+var xxx_messageInfo_CreateScheduleRequest proto.InternalMessageInfo
+
+func (m *CreateScheduleRequest) GetNamespace() string {
+	if m != nil {
+		return m.Namespace
 	}
+	return ""
+}
 
-	that1, ok := that.(*DescribeWorkflowExecutionRequest)
-	if !ok {
-		that2, ok := that.(DescribeWorkflowExecutionRequest)
-		if ok {
-			that1 = &that2
-		} else {
-			return false
-		}
+func (m *CreateScheduleRequest) GetScheduleId() string {
+	if m != nil {
+		return m.ScheduleId
 	}
-	if that1 == nil {
-		return this == nil
-	} else if this == nil {
-		return false
+	return ""
+}
+
+func (m *CreateScheduleRequest) GetSchedule() *v17.Schedule {
+	if m != nil {
+		return m.Schedule
 	}
-	if this.Namespace != that1.Namespace {
-		return false
+	return nil
+}
+
+func (m *CreateScheduleRequest) GetInitialPatch() *v17.SchedulePatch {
+	if m != nil {
+		return m.InitialPatch
 	}
-	if !this.Execution.Equal(that1.Execution) {
-		return false
+	return nil
+}
+
+func (m *CreateScheduleRequest) GetIdentity() string {
+	if m != nil {
+		return m.Identity
 	}
-	return true
+	return ""
 }
-func (this *DescribeWorkflowExecutionResponse) Equal(that interface{}) bool {
-	if that == nil {
-		return this == nil
+
+func (m *CreateScheduleRequest) GetRequestId() string {
+	if m != nil {
+		return m.RequestId
 	}
+	return ""
+}
 
-	that1, ok := that.(*DescribeWorkflowExecutionResponse)
-	if !ok {
-		that2, ok := that.(DescribeWorkflowExecutionResponse)
-		if ok {
-			that1 = &that2
-		} else {
-			return false
+type CreateScheduleResponse struct {
+	ConflictToken int64 `protobuf:"varint,1,opt,name=conflict_token,json=conflictToken,proto3" json:"conflict_token,omitempty"`
+}
+
+func (m *CreateScheduleResponse) Reset()      { *m = CreateScheduleResponse{} }
+func (*CreateScheduleResponse) ProtoMessage() {}
+func (*CreateScheduleResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_cc07c1a2abe7cb51, []int{33}
+}
+func (m *CreateScheduleResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *CreateScheduleResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_CreateScheduleResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	if that1 == nil {
-		return this == nil
-	} else if this == nil {
-		return false
-	}
-	if this.ShardId != that1.ShardId {
-		return false
-	}
-	if this.HistoryAddr != that1.HistoryAddr {
-		return false
-	}
-	if this.CacheMutableState != that1.CacheMutableState {
-		return false
-	}
-	if this.DatabaseMutableState != that1.DatabaseMutableState {
-		return false
-	}
-	if this.TreeId != that1.TreeId {
-		return false
-	}
-	if this.BranchId != that1.BranchId {
-		return false
-	}
-	return true
 }
-func (this *DescribeHistoryHostRequest) Equal(that interface{}) bool {
-	if that == nil {
-		return this == nil
+func (m *CreateScheduleResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CreateScheduleResponse.Merge(m, src)
+}
+func (m *CreateScheduleResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *CreateScheduleResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_CreateScheduleResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_CreateScheduleResponse proto.InternalMessageInfo
+
+func (m *CreateScheduleResponse) GetConflictToken() int64 {
+	if m != nil {
+		return m.ConflictToken
 	}
+	return 0
+}
 
-	that1, ok := that.(*DescribeHistoryHostRequest)
-	if !ok {
-		that2, ok := that.(DescribeHistoryHostRequest)
-		if ok {
-			that1 = &that2
-		} else {
-			return false
+type DescribeScheduleRequest struct {
+	Namespace  string `protobuf:"bytes,1,opt,name=namespace,proto3" json:"namespace,omitempty"`
+	ScheduleId string `protobuf:"bytes,2,opt,name=schedule_id,json=scheduleId,proto3" json:"schedule_id,omitempty"`
+}
+
+func (m *DescribeScheduleRequest) Reset()      { *m = DescribeScheduleRequest{} }
+func (*DescribeScheduleRequest) ProtoMessage() {}
+func (*DescribeScheduleRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_cc07c1a2abe7cb51, []int{34}
+}
+func (m *DescribeScheduleRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *DescribeScheduleRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_DescribeScheduleRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	if that1 == nil {
-		return this == nil
-	} else if this == nil {
-		return false
+}
+func (m *DescribeScheduleRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DescribeScheduleRequest.Merge(m, src)
+}
+func (m *DescribeScheduleRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *DescribeScheduleRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_DescribeScheduleRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DescribeScheduleRequest proto.InternalMessageInfo
+
+func (m *DescribeScheduleRequest) GetNamespace() string {
+	if m != nil {
+		return m.Namespace
 	}
-	if this.HostAddress != that1.HostAddress {
-		return false
+	return ""
+}
+
+func (m *DescribeScheduleRequest) GetScheduleId() string {
+	if m != nil {
+		return m.ScheduleId
 	}
-	if this.ShardId != that1.ShardId {
-		return false
+	return ""
+}
+
+type DescribeScheduleResponse struct {
+	Schedule *v17.Schedule     `protobuf:"bytes,1,opt,name=schedule,proto3" json:"schedule,omitempty"`
+	Info     *v17.ScheduleInfo `protobuf:"bytes,2,opt,name=info,proto3" json:"info,omitempty"`
+	// Can be passed to UpdateScheduleRequest to detect concurrent updates.
+	ConflictToken int64 `protobuf:"varint,3,opt,name=conflict_token,json=conflictToken,proto3" json:"conflict_token,omitempty"`
+}
+
+func (m *DescribeScheduleResponse) Reset()      { *m = DescribeScheduleResponse{} }
+func (*DescribeScheduleResponse) ProtoMessage() {}
+func (*DescribeScheduleResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_cc07c1a2abe7cb51, []int{35}
+}
+func (m *DescribeScheduleResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *DescribeScheduleResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_DescribeScheduleResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	if this.Namespace != that1.Namespace {
-		return false
+}
+func (m *DescribeScheduleResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DescribeScheduleResponse.Merge(m, src)
+}
+func (m *DescribeScheduleResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *DescribeScheduleResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_DescribeScheduleResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DescribeScheduleResponse proto.InternalMessageInfo
+
+func (m *DescribeScheduleResponse) GetSchedule() *v17.Schedule {
+	if m != nil {
+		return m.Schedule
 	}
-	if !this.WorkflowExecution.Equal(that1.WorkflowExecution) {
-		return false
+	return nil
+}
+
+func (m *DescribeScheduleResponse) GetInfo() *v17.ScheduleInfo {
+	if m != nil {
+		return m.Info
 	}
-	return true
+	return nil
 }
-func (this *DescribeHistoryHostResponse) Equal(that interface{}) bool {
-	if that == nil {
-		return this == nil
+
+func (m *DescribeScheduleResponse) GetConflictToken() int64 {
+	if m != nil {
+		return m.ConflictToken
 	}
+	return 0
+}
 
-	that1, ok := that.(*DescribeHistoryHostResponse)
-	if !ok {
-		that2, ok := that.(DescribeHistoryHostResponse)
-		if ok {
-			that1 = &that2
-		} else {
-			return false
+type UpdateScheduleRequest struct {
+	Namespace  string        `protobuf:"bytes,1,opt,name=namespace,proto3" json:"namespace,omitempty"`
+	ScheduleId string        `protobuf:"bytes,2,opt,name=schedule_id,json=scheduleId,proto3" json:"schedule_id,omitempty"`
+	Schedule   *v17.Schedule `protobuf:"bytes,3,opt,name=schedule,proto3" json:"schedule,omitempty"`
+	// If not zero, the update is dropped unless it matches the current token.
+	ConflictToken int64  `protobuf:"varint,4,opt,name=conflict_token,json=conflictToken,proto3" json:"conflict_token,omitempty"`
+	Identity      string `protobuf:"bytes,5,opt,name=identity,proto3" json:"identity,omitempty"`
+	RequestId     string `protobuf:"bytes,6,opt,name=request_id,json=requestId,proto3" json:"request_id,omitempty"`
+}
+
+func (m *UpdateScheduleRequest) Reset()      { *m = UpdateScheduleRequest{} }
+func (*UpdateScheduleRequest) ProtoMessage() {}
+func (*UpdateScheduleRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_cc07c1a2abe7cb51, []int{36}
+}
+func (m *UpdateScheduleRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *UpdateScheduleRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_UpdateScheduleRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	if that1 == nil {
-		return this == nil
-	} else if this == nil {
-		return false
-	}
-	if this.ShardsNumber != that1.ShardsNumber {
-		return false
-	}
-	if len(this.ShardIds) != len(that1.ShardIds) {
-		return false
+}
+func (m *UpdateScheduleRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_UpdateScheduleRequest.Merge(m, src)
+}
+func (m *UpdateScheduleRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *UpdateScheduleRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_UpdateScheduleRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_UpdateScheduleRequest proto.InternalMessageInfo
+
+func (m *UpdateScheduleRequest) GetNamespace() string {
+	if m != nil {
+		return m.Namespace
 	}
-	for i := range this.ShardIds {
-		if this.ShardIds[i] != that1.ShardIds[i] {
-			return false
-		}
+	return ""
+}
+
+func (m *UpdateScheduleRequest) GetScheduleId() string {
+	if m != nil {
+		return m.ScheduleId
 	}
-	if !this.NamespaceCache.Equal(that1.NamespaceCache) {
-		return false
+	return ""
+}
+
+func (m *UpdateScheduleRequest) GetSchedule() *v17.Schedule {
+	if m != nil {
+		return m.Schedule
 	}
-	if this.ShardControllerStatus != that1.ShardControllerStatus {
-		return false
+	return nil
+}
+
+func (m *UpdateScheduleRequest) GetConflictToken() int64 {
+	if m != nil {
+		return m.ConflictToken
 	}
-	if this.Address != that1.Address {
-		return false
+	return 0
+}
+
+func (m *UpdateScheduleRequest) GetIdentity() string {
+	if m != nil {
+		return m.Identity
 	}
-	return true
+	return ""
 }
-func (this *CloseShardRequest) Equal(that interface{}) bool {
-	if that == nil {
-		return this == nil
+
+func (m *UpdateScheduleRequest) GetRequestId() string {
+	if m != nil {
+		return m.RequestId
 	}
+	return ""
+}
 
-	that1, ok := that.(*CloseShardRequest)
-	if !ok {
-		that2, ok := that.(CloseShardRequest)
-		if ok {
-			that1 = &that2
-		} else {
-			return false
+type UpdateScheduleResponse struct {
+}
+
+func (m *UpdateScheduleResponse) Reset()      { *m = UpdateScheduleResponse{} }
+func (*UpdateScheduleResponse) ProtoMessage() {}
+func (*UpdateScheduleResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_cc07c1a2abe7cb51, []int{37}
+}
+func (m *UpdateScheduleResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *UpdateScheduleResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_UpdateScheduleResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	if that1 == nil {
-		return this == nil
-	} else if this == nil {
-		return false
-	}
-	if this.ShardId != that1.ShardId {
-		return false
-	}
-	return true
 }
-func (this *CloseShardResponse) Equal(that interface{}) bool {
-	if that == nil {
-		return this == nil
-	}
+func (m *UpdateScheduleResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_UpdateScheduleResponse.Merge(m, src)
+}
+func (m *UpdateScheduleResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *UpdateScheduleResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_UpdateScheduleResponse.DiscardUnknown(m)
+}
 
-	that1, ok := that.(*CloseShardResponse)
-	if !ok {
-		that2, ok := that.(CloseShardResponse)
-		if ok {
-			that1 = &that2
-		} else {
-			return false
+var xxx_messageInfo_UpdateScheduleResponse proto.InternalMessageInfo
+
+type PauseScheduleRequest struct {
+	Namespace  string `protobuf:"bytes,1,opt,name=namespace,proto3" json:"namespace,omitempty"`
+	ScheduleId string `protobuf:"bytes,2,opt,name=schedule_id,json=scheduleId,proto3" json:"schedule_id,omitempty"`
+	Notes      string `protobuf:"bytes,3,opt,name=notes,proto3" json:"notes,omitempty"`
+	Identity   string `protobuf:"bytes,4,opt,name=identity,proto3" json:"identity,omitempty"`
+}
+
+func (m *PauseScheduleRequest) Reset()      { *m = PauseScheduleRequest{} }
+func (*PauseScheduleRequest) ProtoMessage() {}
+func (*PauseScheduleRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_cc07c1a2abe7cb51, []int{38}
+}
+func (m *PauseScheduleRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *PauseScheduleRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_PauseScheduleRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	if that1 == nil {
-		return this == nil
-	} else if this == nil {
-		return false
-	}
-	return true
 }
-func (this *RemoveTaskRequest) Equal(that interface{}) bool {
-	if that == nil {
-		return this == nil
-	}
+func (m *PauseScheduleRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PauseScheduleRequest.Merge(m, src)
+}
+func (m *PauseScheduleRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *PauseScheduleRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_PauseScheduleRequest.DiscardUnknown(m)
+}
 
-	that1, ok := that.(*RemoveTaskRequest)
-	if !ok {
-		that2, ok := that.(RemoveTaskRequest)
-		if ok {
-			that1 = &that2
-		} else {
-			return false
-		}
+var xxx_messageInfo_PauseScheduleRequest proto.InternalMessageInfo
+
+func (m *PauseScheduleRequest) GetNamespace() string {
+	if m != nil {
+		return m.Namespace
 	}
-	if that1 == nil {
-		return this == nil
-	} else if this == nil {
-		return false
+	return ""
+}
+
+func (m *PauseScheduleRequest) GetScheduleId() string {
+	if m != nil {
+		return m.ScheduleId
 	}
-	if this.ShardId != that1.ShardId {
-		return false
+	return ""
+}
+
+func (m *PauseScheduleRequest) GetNotes() string {
+	if m != nil {
+		return m.Notes
 	}
-	if this.Category != that1.Category {
-		return false
+	return ""
+}
+
+func (m *PauseScheduleRequest) GetIdentity() string {
+	if m != nil {
+		return m.Identity
 	}
-	if this.TaskId != that1.TaskId {
-		return false
+	return ""
+}
+
+type PauseScheduleResponse struct {
+}
+
+func (m *PauseScheduleResponse) Reset()      { *m = PauseScheduleResponse{} }
+func (*PauseScheduleResponse) ProtoMessage() {}
+func (*PauseScheduleResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_cc07c1a2abe7cb51, []int{39}
+}
+func (m *PauseScheduleResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *PauseScheduleResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_PauseScheduleResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	if that1.VisibilityTime == nil {
-		if this.VisibilityTime != nil {
-			return false
+}
+func (m *PauseScheduleResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PauseScheduleResponse.Merge(m, src)
+}
+func (m *PauseScheduleResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *PauseScheduleResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_PauseScheduleResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PauseScheduleResponse proto.InternalMessageInfo
+
+type UnpauseScheduleRequest struct {
+	Namespace  string `protobuf:"bytes,1,opt,name=namespace,proto3" json:"namespace,omitempty"`
+	ScheduleId string `protobuf:"bytes,2,opt,name=schedule_id,json=scheduleId,proto3" json:"schedule_id,omitempty"`
+	Notes      string `protobuf:"bytes,3,opt,name=notes,proto3" json:"notes,omitempty"`
+	Identity   string `protobuf:"bytes,4,opt,name=identity,proto3" json:"identity,omitempty"`
+}
+
+func (m *UnpauseScheduleRequest) Reset()      { *m = UnpauseScheduleRequest{} }
+func (*UnpauseScheduleRequest) ProtoMessage() {}
+func (*UnpauseScheduleRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_cc07c1a2abe7cb51, []int{40}
+}
+func (m *UnpauseScheduleRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *UnpauseScheduleRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_UnpauseScheduleRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
-	} else if !this.VisibilityTime.Equal(*that1.VisibilityTime) {
-		return false
+		return b[:n], nil
 	}
-	return true
 }
-func (this *RemoveTaskResponse) Equal(that interface{}) bool {
-	if that == nil {
-		return this == nil
+func (m *UnpauseScheduleRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_UnpauseScheduleRequest.Merge(m, src)
+}
+func (m *UnpauseScheduleRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *UnpauseScheduleRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_UnpauseScheduleRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_UnpauseScheduleRequest proto.InternalMessageInfo
+
+func (m *UnpauseScheduleRequest) GetNamespace() string {
+	if m != nil {
+		return m.Namespace
 	}
+	return ""
+}
 
-	that1, ok := that.(*RemoveTaskResponse)
-	if !ok {
-		that2, ok := that.(RemoveTaskResponse)
-		if ok {
-			that1 = &that2
-		} else {
-			return false
-		}
+func (m *UnpauseScheduleRequest) GetScheduleId() string {
+	if m != nil {
+		return m.ScheduleId
 	}
-	if that1 == nil {
-		return this == nil
-	} else if this == nil {
-		return false
+	return ""
+}
+
+func (m *UnpauseScheduleRequest) GetNotes() string {
+	if m != nil {
+		return m.Notes
 	}
-	return true
+	return ""
 }
-func (this *GetWorkflowExecutionRawHistoryV2Request) Equal(that interface{}) bool {
-	if that == nil {
-		return this == nil
+
+func (m *UnpauseScheduleRequest) GetIdentity() string {
+	if m != nil {
+		return m.Identity
 	}
+	return ""
+}
 
-	that1, ok := that.(*GetWorkflowExecutionRawHistoryV2Request)
-	if !ok {
-		that2, ok := that.(GetWorkflowExecutionRawHistoryV2Request)
-		if ok {
-			that1 = &that2
-		} else {
-			return false
+type UnpauseScheduleResponse struct {
+}
+
+func (m *UnpauseScheduleResponse) Reset()      { *m = UnpauseScheduleResponse{} }
+func (*UnpauseScheduleResponse) ProtoMessage() {}
+func (*UnpauseScheduleResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_cc07c1a2abe7cb51, []int{41}
+}
+func (m *UnpauseScheduleResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *UnpauseScheduleResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_UnpauseScheduleResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	if that1 == nil {
-		return this == nil
-	} else if this == nil {
-		return false
-	}
-	if this.Namespace != that1.Namespace {
-		return false
-	}
-	if !this.Execution.Equal(that1.Execution) {
-		return false
-	}
-	if this.StartEventId != that1.StartEventId {
-		return false
-	}
-	if this.StartEventVersion != that1.StartEventVersion {
-		return false
-	}
-	if this.EndEventId != that1.EndEventId {
-		return false
-	}
-	if this.EndEventVersion != that1.EndEventVersion {
-		return false
-	}
-	if this.MaximumPageSize != that1.MaximumPageSize {
-		return false
-	}
-	if !bytes.Equal(this.NextPageToken, that1.NextPageToken) {
-		return false
-	}
-	return true
 }
-func (this *GetWorkflowExecutionRawHistoryV2Response) Equal(that interface{}) bool {
-	if that == nil {
-		return this == nil
-	}
+func (m *UnpauseScheduleResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_UnpauseScheduleResponse.Merge(m, src)
+}
+func (m *UnpauseScheduleResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *UnpauseScheduleResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_UnpauseScheduleResponse.DiscardUnknown(m)
+}
 
-	that1, ok := that.(*GetWorkflowExecutionRawHistoryV2Response)
-	if !ok {
-		that2, ok := that.(GetWorkflowExecutionRawHistoryV2Response)
-		if ok {
-			that1 = &that2
-		} else {
-			return false
+var xxx_messageInfo_UnpauseScheduleResponse proto.InternalMessageInfo
+
+type TriggerScheduleRequest struct {
+	Namespace  string `protobuf:"bytes,1,opt,name=namespace,proto3" json:"namespace,omitempty"`
+	ScheduleId string `protobuf:"bytes,2,opt,name=schedule_id,json=scheduleId,proto3" json:"schedule_id,omitempty"`
+	// Overrides the schedule policy for these actions.
+	OverlapPolicy v12.ScheduleOverlapPolicy `protobuf:"varint,3,opt,name=overlap_policy,json=overlapPolicy,proto3,enum=temporal.server.api.enums.v1.ScheduleOverlapPolicy" json:"overlap_policy,omitempty"`
+	// If set, actions are taken for the given ranges instead of right now.
+	Backfill []*v17.BackfillRequest `protobuf:"bytes,4,rep,name=backfill,proto3" json:"backfill,omitempty"`
+	Identity string                 `protobuf:"bytes,5,opt,name=identity,proto3" json:"identity,omitempty"`
+}
+
+func (m *TriggerScheduleRequest) Reset()      { *m = TriggerScheduleRequest{} }
+func (*TriggerScheduleRequest) ProtoMessage() {}
+func (*TriggerScheduleRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_cc07c1a2abe7cb51, []int{42}
+}
+func (m *TriggerScheduleRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *TriggerScheduleRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_TriggerScheduleRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	if that1 == nil {
-		return this == nil
-	} else if this == nil {
-		return false
-	}
-	if !bytes.Equal(this.NextPageToken, that1.NextPageToken) {
-		return false
+}
+func (m *TriggerScheduleRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_TriggerScheduleRequest.Merge(m, src)
+}
+func (m *TriggerScheduleRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *TriggerScheduleRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_TriggerScheduleRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_TriggerScheduleRequest proto.InternalMessageInfo
+
+func (m *TriggerScheduleRequest) GetNamespace() string {
+	if m != nil {
+		return m.Namespace
 	}
-	if len(this.HistoryBatches) != len(that1.HistoryBatches) {
-		return false
+	return ""
+}
+
+func (m *TriggerScheduleRequest) GetScheduleId() string {
+	if m != nil {
+		return m.ScheduleId
 	}
-	for i := range this.HistoryBatches {
-		if !this.HistoryBatches[i].Equal(that1.HistoryBatches[i]) {
-			return false
-		}
+	return ""
+}
+
+func (m *TriggerScheduleRequest) GetOverlapPolicy() v12.ScheduleOverlapPolicy {
+	if m != nil {
+		return m.OverlapPolicy
 	}
-	if !this.VersionHistory.Equal(that1.VersionHistory) {
-		return false
+	return v12.SCHEDULE_OVERLAP_POLICY_UNSPECIFIED
+}
+
+func (m *TriggerScheduleRequest) GetBackfill() []*v17.BackfillRequest {
+	if m != nil {
+		return m.Backfill
 	}
-	return true
+	return nil
 }
-func (this *GetReplicationMessagesRequest) Equal(that interface{}) bool {
-	if that == nil {
-		return this == nil
+
+func (m *TriggerScheduleRequest) GetIdentity() string {
+	if m != nil {
+		return m.Identity
 	}
+	return ""
+}
 
-	that1, ok := that.(*GetReplicationMessagesRequest)
-	if !ok {
-		that2, ok := that.(GetReplicationMessagesRequest)
-		if ok {
-			that1 = &that2
-		} else {
-			return false
+type TriggerScheduleResponse struct {
+}
+
+func (m *TriggerScheduleResponse) Reset()      { *m = TriggerScheduleResponse{} }
+func (*TriggerScheduleResponse) ProtoMessage() {}
+func (*TriggerScheduleResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_cc07c1a2abe7cb51, []int{43}
+}
+func (m *TriggerScheduleResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *TriggerScheduleResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_TriggerScheduleResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	if that1 == nil {
-		return this == nil
-	} else if this == nil {
-		return false
-	}
-	if len(this.Tokens) != len(that1.Tokens) {
-		return false
-	}
-	for i := range this.Tokens {
-		if !this.Tokens[i].Equal(that1.Tokens[i]) {
-			return false
+}
+func (m *TriggerScheduleResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_TriggerScheduleResponse.Merge(m, src)
+}
+func (m *TriggerScheduleResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *TriggerScheduleResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_TriggerScheduleResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_TriggerScheduleResponse proto.InternalMessageInfo
+
+type DeleteScheduleRequest struct {
+	Namespace  string `protobuf:"bytes,1,opt,name=namespace,proto3" json:"namespace,omitempty"`
+	ScheduleId string `protobuf:"bytes,2,opt,name=schedule_id,json=scheduleId,proto3" json:"schedule_id,omitempty"`
+	Identity   string `protobuf:"bytes,3,opt,name=identity,proto3" json:"identity,omitempty"`
+}
+
+func (m *DeleteScheduleRequest) Reset()      { *m = DeleteScheduleRequest{} }
+func (*DeleteScheduleRequest) ProtoMessage() {}
+func (*DeleteScheduleRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_cc07c1a2abe7cb51, []int{44}
+}
+func (m *DeleteScheduleRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *DeleteScheduleRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_DeleteScheduleRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	if this.ClusterName != that1.ClusterName {
-		return false
-	}
-	return true
 }
-func (this *GetReplicationMessagesResponse) Equal(that interface{}) bool {
-	if that == nil {
-		return this == nil
-	}
+func (m *DeleteScheduleRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DeleteScheduleRequest.Merge(m, src)
+}
+func (m *DeleteScheduleRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *DeleteScheduleRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_DeleteScheduleRequest.DiscardUnknown(m)
+}
 
-	that1, ok := that.(*GetReplicationMessagesResponse)
-	if !ok {
-		that2, ok := that.(GetReplicationMessagesResponse)
-		if ok {
-			that1 = &that2
-		} else {
-			return false
-		}
+var xxx_messageInfo_DeleteScheduleRequest proto.InternalMessageInfo
+
+func (m *DeleteScheduleRequest) GetNamespace() string {
+	if m != nil {
+		return m.Namespace
 	}
-	if that1 == nil {
-		return this == nil
-	} else if this == nil {
-		return false
+	return ""
+}
+
+func (m *DeleteScheduleRequest) GetScheduleId() string {
+	if m != nil {
+		return m.ScheduleId
 	}
-	if len(this.ShardMessages) != len(that1.ShardMessages) {
-		return false
+	return ""
+}
+
+func (m *DeleteScheduleRequest) GetIdentity() string {
+	if m != nil {
+		return m.Identity
 	}
-	for i := range this.ShardMessages {
-		if !this.ShardMessages[i].Equal(that1.ShardMessages[i]) {
-			return false
+	return ""
+}
+
+type DeleteScheduleResponse struct {
+}
+
+func (m *DeleteScheduleResponse) Reset()      { *m = DeleteScheduleResponse{} }
+func (*DeleteScheduleResponse) ProtoMessage() {}
+func (*DeleteScheduleResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_cc07c1a2abe7cb51, []int{45}
+}
+func (m *DeleteScheduleResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *DeleteScheduleResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_DeleteScheduleResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	return true
 }
-func (this *GetNamespaceReplicationMessagesRequest) Equal(that interface{}) bool {
-	if that == nil {
-		return this == nil
-	}
+func (m *DeleteScheduleResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DeleteScheduleResponse.Merge(m, src)
+}
+func (m *DeleteScheduleResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *DeleteScheduleResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_DeleteScheduleResponse.DiscardUnknown(m)
+}
 
-	that1, ok := that.(*GetNamespaceReplicationMessagesRequest)
-	if !ok {
-		that2, ok := that.(GetNamespaceReplicationMessagesRequest)
-		if ok {
-			that1 = &that2
-		} else {
-			return false
+var xxx_messageInfo_DeleteScheduleResponse proto.InternalMessageInfo
+
+type ListSchedulesRequest struct {
+	Namespace       string `protobuf:"bytes,1,opt,name=namespace,proto3" json:"namespace,omitempty"`
+	MaximumPageSize int32  `protobuf:"varint,2,opt,name=maximum_page_size,json=maximumPageSize,proto3" json:"maximum_page_size,omitempty"`
+	NextPageToken   []byte `protobuf:"bytes,3,opt,name=next_page_token,json=nextPageToken,proto3" json:"next_page_token,omitempty"`
+}
+
+func (m *ListSchedulesRequest) Reset()      { *m = ListSchedulesRequest{} }
+func (*ListSchedulesRequest) ProtoMessage() {}
+func (*ListSchedulesRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_cc07c1a2abe7cb51, []int{46}
+}
+func (m *ListSchedulesRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ListSchedulesRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ListSchedulesRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	if that1 == nil {
-		return this == nil
-	} else if this == nil {
-		return false
+}
+func (m *ListSchedulesRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ListSchedulesRequest.Merge(m, src)
+}
+func (m *ListSchedulesRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *ListSchedulesRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_ListSchedulesRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ListSchedulesRequest proto.InternalMessageInfo
+
+func (m *ListSchedulesRequest) GetNamespace() string {
+	if m != nil {
+		return m.Namespace
 	}
-	if this.LastRetrievedMessageId != that1.LastRetrievedMessageId {
-		return false
+	return ""
+}
+
+func (m *ListSchedulesRequest) GetMaximumPageSize() int32 {
+	if m != nil {
+		return m.MaximumPageSize
 	}
-	if this.LastProcessedMessageId != that1.LastProcessedMessageId {
-		return false
-	}
-	if this.ClusterName != that1.ClusterName {
-		return false
-	}
-	return true
+	return 0
 }
-func (this *GetNamespaceReplicationMessagesResponse) Equal(that interface{}) bool {
-	if that == nil {
-		return this == nil
-	}
 
-	that1, ok := that.(*GetNamespaceReplicationMessagesResponse)
-	if !ok {
-		that2, ok := that.(GetNamespaceReplicationMessagesResponse)
-		if ok {
-			that1 = &that2
-		} else {
-			return false
-		}
-	}
-	if that1 == nil {
-		return this == nil
-	} else if this == nil {
-		return false
-	}
-	if !this.Messages.Equal(that1.Messages) {
-		return false
+func (m *ListSchedulesRequest) GetNextPageToken() []byte {
+	if m != nil {
+		return m.NextPageToken
 	}
-	return true
+	return nil
 }
-func (this *GetDLQReplicationMessagesRequest) Equal(that interface{}) bool {
-	if that == nil {
-		return this == nil
-	}
 
-	that1, ok := that.(*GetDLQReplicationMessagesRequest)
-	if !ok {
-		that2, ok := that.(GetDLQReplicationMessagesRequest)
-		if ok {
-			that1 = &that2
-		} else {
-			return false
+type ListSchedulesResponse struct {
+	Schedules     []*v17.ScheduleListEntry `protobuf:"bytes,1,rep,name=schedules,proto3" json:"schedules,omitempty"`
+	NextPageToken []byte                   `protobuf:"bytes,2,opt,name=next_page_token,json=nextPageToken,proto3" json:"next_page_token,omitempty"`
+}
+
+func (m *ListSchedulesResponse) Reset()      { *m = ListSchedulesResponse{} }
+func (*ListSchedulesResponse) ProtoMessage() {}
+func (*ListSchedulesResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_cc07c1a2abe7cb51, []int{47}
+}
+func (m *ListSchedulesResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ListSchedulesResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ListSchedulesResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	if that1 == nil {
-		return this == nil
-	} else if this == nil {
-		return false
+}
+func (m *ListSchedulesResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ListSchedulesResponse.Merge(m, src)
+}
+func (m *ListSchedulesResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *ListSchedulesResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_ListSchedulesResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ListSchedulesResponse proto.InternalMessageInfo
+
+func (m *ListSchedulesResponse) GetSchedules() []*v17.ScheduleListEntry {
+	if m != nil {
+		return m.Schedules
 	}
-	if len(this.TaskInfos) != len(that1.TaskInfos) {
-		return false
+	return nil
+}
+
+func (m *ListSchedulesResponse) GetNextPageToken() []byte {
+	if m != nil {
+		return m.NextPageToken
 	}
-	for i := range this.TaskInfos {
-		if !this.TaskInfos[i].Equal(that1.TaskInfos[i]) {
-			return false
+	return nil
+}
+
+type DeleteWorkflowExecutionRequest struct {
+	Namespace string                `protobuf:"bytes,1,opt,name=namespace,proto3" json:"namespace,omitempty"`
+	Execution *v1.WorkflowExecution `protobuf:"bytes,2,opt,name=execution,proto3" json:"execution,omitempty"`
+	Identity  string                `protobuf:"bytes,3,opt,name=identity,proto3" json:"identity,omitempty"`
+}
+
+func (m *DeleteWorkflowExecutionRequest) Reset()      { *m = DeleteWorkflowExecutionRequest{} }
+func (*DeleteWorkflowExecutionRequest) ProtoMessage() {}
+func (*DeleteWorkflowExecutionRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_cc07c1a2abe7cb51, []int{48}
+}
+func (m *DeleteWorkflowExecutionRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *DeleteWorkflowExecutionRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_DeleteWorkflowExecutionRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	return true
 }
-func (this *GetDLQReplicationMessagesResponse) Equal(that interface{}) bool {
-	if that == nil {
-		return this == nil
-	}
+func (m *DeleteWorkflowExecutionRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DeleteWorkflowExecutionRequest.Merge(m, src)
+}
+func (m *DeleteWorkflowExecutionRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *DeleteWorkflowExecutionRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_DeleteWorkflowExecutionRequest.DiscardUnknown(m)
+}
 
-	that1, ok := that.(*GetDLQReplicationMessagesResponse)
-	if !ok {
-		that2, ok := that.(GetDLQReplicationMessagesResponse)
-		if ok {
-			that1 = &that2
-		} else {
-			return false
-		}
+var xxx_messageInfo_DeleteWorkflowExecutionRequest proto.InternalMessageInfo
+
+func (m *DeleteWorkflowExecutionRequest) GetNamespace() string {
+	if m != nil {
+		return m.Namespace
 	}
-	if that1 == nil {
-		return this == nil
-	} else if this == nil {
-		return false
+	return ""
+}
+
+func (m *DeleteWorkflowExecutionRequest) GetExecution() *v1.WorkflowExecution {
+	if m != nil {
+		return m.Execution
 	}
-	if len(this.ReplicationTasks) != len(that1.ReplicationTasks) {
-		return false
+	return nil
+}
+
+func (m *DeleteWorkflowExecutionRequest) GetIdentity() string {
+	if m != nil {
+		return m.Identity
 	}
-	for i := range this.ReplicationTasks {
-		if !this.ReplicationTasks[i].Equal(that1.ReplicationTasks[i]) {
-			return false
+	return ""
+}
+
+type DeleteWorkflowExecutionResponse struct {
+}
+
+func (m *DeleteWorkflowExecutionResponse) Reset()      { *m = DeleteWorkflowExecutionResponse{} }
+func (*DeleteWorkflowExecutionResponse) ProtoMessage() {}
+func (*DeleteWorkflowExecutionResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_cc07c1a2abe7cb51, []int{49}
+}
+func (m *DeleteWorkflowExecutionResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *DeleteWorkflowExecutionResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_DeleteWorkflowExecutionResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	return true
 }
-func (this *ReapplyEventsRequest) Equal(that interface{}) bool {
-	if that == nil {
-		return this == nil
-	}
+func (m *DeleteWorkflowExecutionResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DeleteWorkflowExecutionResponse.Merge(m, src)
+}
+func (m *DeleteWorkflowExecutionResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *DeleteWorkflowExecutionResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_DeleteWorkflowExecutionResponse.DiscardUnknown(m)
+}
 
-	that1, ok := that.(*ReapplyEventsRequest)
-	if !ok {
-		that2, ok := that.(ReapplyEventsRequest)
-		if ok {
-			that1 = &that2
-		} else {
-			return false
+var xxx_messageInfo_DeleteWorkflowExecutionResponse proto.InternalMessageInfo
+
+type BatchResetParams struct {
+	// One of the reset types of the batcher, e.g. LastWorkflowTask.
+	ResetType            string `protobuf:"bytes,1,opt,name=reset_type,json=resetType,proto3" json:"reset_type,omitempty"`
+	BadBinaryChecksum    string `protobuf:"bytes,2,opt,name=bad_binary_checksum,json=badBinaryChecksum,proto3" json:"bad_binary_checksum,omitempty"`
+	SkipCurrentOpen      bool   `protobuf:"varint,3,opt,name=skip_current_open,json=skipCurrentOpen,proto3" json:"skip_current_open,omitempty"`
+	SkipBaseNotCurrent   bool   `protobuf:"varint,4,opt,name=skip_base_not_current,json=skipBaseNotCurrent,proto3" json:"skip_base_not_current,omitempty"`
+	NonDeterministicOnly bool   `protobuf:"varint,5,opt,name=non_deterministic_only,json=nonDeterministicOnly,proto3" json:"non_deterministic_only,omitempty"`
+}
+
+func (m *BatchResetParams) Reset()      { *m = BatchResetParams{} }
+func (*BatchResetParams) ProtoMessage() {}
+func (*BatchResetParams) Descriptor() ([]byte, []int) {
+	return fileDescriptor_cc07c1a2abe7cb51, []int{50}
+}
+func (m *BatchResetParams) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *BatchResetParams) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_BatchResetParams.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	if that1 == nil {
-		return this == nil
-	} else if this == nil {
-		return false
+}
+func (m *BatchResetParams) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_BatchResetParams.Merge(m, src)
+}
+func (m *BatchResetParams) XXX_Size() int {
+	return m.Size()
+}
+func (m *BatchResetParams) XXX_DiscardUnknown() {
+	xxx_messageInfo_BatchResetParams.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_BatchResetParams proto.InternalMessageInfo
+
+func (m *BatchResetParams) GetResetType() string {
+	if m != nil {
+		return m.ResetType
 	}
-	if this.Namespace != that1.Namespace {
-		return false
+	return ""
+}
+
+func (m *BatchResetParams) GetBadBinaryChecksum() string {
+	if m != nil {
+		return m.BadBinaryChecksum
 	}
-	if !this.WorkflowExecution.Equal(that1.WorkflowExecution) {
-		return false
+	return ""
+}
+
+func (m *BatchResetParams) GetSkipCurrentOpen() bool {
+	if m != nil {
+		return m.SkipCurrentOpen
 	}
-	if !this.Events.Equal(that1.Events) {
-		return false
+	return false
+}
+
+func (m *BatchResetParams) GetSkipBaseNotCurrent() bool {
+	if m != nil {
+		return m.SkipBaseNotCurrent
 	}
-	return true
+	return false
 }
-func (this *ReapplyEventsResponse) Equal(that interface{}) bool {
-	if that == nil {
-		return this == nil
+
+func (m *BatchResetParams) GetNonDeterministicOnly() bool {
+	if m != nil {
+		return m.NonDeterministicOnly
 	}
+	return false
+}
 
-	that1, ok := that.(*ReapplyEventsResponse)
-	if !ok {
-		that2, ok := that.(ReapplyEventsResponse)
-		if ok {
-			that1 = &that2
-		} else {
-			return false
+type StartBatchOperationRequest struct {
+	Namespace string `protobuf:"bytes,1,opt,name=namespace,proto3" json:"namespace,omitempty"`
+	JobId     string `protobuf:"bytes,2,opt,name=job_id,json=jobId,proto3" json:"job_id,omitempty"`
+	Query     string `protobuf:"bytes,3,opt,name=query,proto3" json:"query,omitempty"`
+	Reason    string `protobuf:"bytes,4,opt,name=reason,proto3" json:"reason,omitempty"`
+	// One of terminate, cancel, signal, reset and delete.
+	BatchType   string            `protobuf:"bytes,5,opt,name=batch_type,json=batchType,proto3" json:"batch_type,omitempty"`
+	Identity    string            `protobuf:"bytes,6,opt,name=identity,proto3" json:"identity,omitempty"`
+	SignalName  string            `protobuf:"bytes,7,opt,name=signal_name,json=signalName,proto3" json:"signal_name,omitempty"`
+	SignalInput *v1.Payloads      `protobuf:"bytes,8,opt,name=signal_input,json=signalInput,proto3" json:"signal_input,omitempty"`
+	ResetParams *BatchResetParams `protobuf:"bytes,9,opt,name=reset_params,json=resetParams,proto3" json:"reset_params,omitempty"`
+	Rps         int32             `protobuf:"varint,10,opt,name=rps,proto3" json:"rps,omitempty"`
+}
+
+func (m *StartBatchOperationRequest) Reset()      { *m = StartBatchOperationRequest{} }
+func (*StartBatchOperationRequest) ProtoMessage() {}
+func (*StartBatchOperationRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_cc07c1a2abe7cb51, []int{51}
+}
+func (m *StartBatchOperationRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *StartBatchOperationRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_StartBatchOperationRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	if that1 == nil {
-		return this == nil
-	} else if this == nil {
-		return false
+}
+func (m *StartBatchOperationRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_StartBatchOperationRequest.Merge(m, src)
+}
+func (m *StartBatchOperationRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *StartBatchOperationRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_StartBatchOperationRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_StartBatchOperationRequest proto.InternalMessageInfo
+
+func (m *StartBatchOperationRequest) GetNamespace() string {
+	if m != nil {
+		return m.Namespace
 	}
-	return true
+	return ""
 }
-func (this *AddSearchAttributeRequest) Equal(that interface{}) bool {
-	if that == nil {
-		return this == nil
+
+func (m *StartBatchOperationRequest) GetJobId() string {
+	if m != nil {
+		return m.JobId
 	}
+	return ""
+}
 
-	that1, ok := that.(*AddSearchAttributeRequest)
-	if !ok {
-		that2, ok := that.(AddSearchAttributeRequest)
-		if ok {
-			that1 = &that2
-		} else {
-			return false
-		}
-	}
-	if that1 == nil {
-		return this == nil
-	} else if this == nil {
-		return false
+func (m *StartBatchOperationRequest) GetQuery() string {
+	if m != nil {
+		return m.Query
 	}
-	if len(this.SearchAttribute) != len(that1.SearchAttribute) {
-		return false
+	return ""
+}
+
+func (m *StartBatchOperationRequest) GetReason() string {
+	if m != nil {
+		return m.Reason
 	}
-	for i := range this.SearchAttribute {
-		if this.SearchAttribute[i] != that1.SearchAttribute[i] {
-			return false
-		}
+	return ""
+}
+
+func (m *StartBatchOperationRequest) GetBatchType() string {
+	if m != nil {
+		return m.BatchType
 	}
-	if this.SecurityToken != that1.SecurityToken {
-		return false
+	return ""
+}
+
+func (m *StartBatchOperationRequest) GetIdentity() string {
+	if m != nil {
+		return m.Identity
 	}
-	return true
+	return ""
 }
-func (this *AddSearchAttributeResponse) Equal(that interface{}) bool {
-	if that == nil {
-		return this == nil
+
+func (m *StartBatchOperationRequest) GetSignalName() string {
+	if m != nil {
+		return m.SignalName
 	}
+	return ""
+}
 
-	that1, ok := that.(*AddSearchAttributeResponse)
-	if !ok {
-		that2, ok := that.(AddSearchAttributeResponse)
-		if ok {
-			that1 = &that2
-		} else {
-			return false
-		}
+func (m *StartBatchOperationRequest) GetSignalInput() *v1.Payloads {
+	if m != nil {
+		return m.SignalInput
 	}
-	if that1 == nil {
-		return this == nil
-	} else if this == nil {
-		return false
+	return nil
+}
+
+func (m *StartBatchOperationRequest) GetResetParams() *BatchResetParams {
+	if m != nil {
+		return m.ResetParams
 	}
-	return true
+	return nil
 }
-func (this *DescribeClusterRequest) Equal(that interface{}) bool {
-	if that == nil {
-		return this == nil
+
+func (m *StartBatchOperationRequest) GetRps() int32 {
+	if m != nil {
+		return m.Rps
 	}
+	return 0
+}
 
-	that1, ok := that.(*DescribeClusterRequest)
-	if !ok {
-		that2, ok := that.(DescribeClusterRequest)
-		if ok {
-			that1 = &that2
-		} else {
-			return false
+type StartBatchOperationResponse struct {
+}
+
+func (m *StartBatchOperationResponse) Reset()      { *m = StartBatchOperationResponse{} }
+func (*StartBatchOperationResponse) ProtoMessage() {}
+func (*StartBatchOperationResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_cc07c1a2abe7cb51, []int{52}
+}
+func (m *StartBatchOperationResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *StartBatchOperationResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_StartBatchOperationResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	if that1 == nil {
-		return this == nil
-	} else if this == nil {
-		return false
+}
+func (m *StartBatchOperationResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_StartBatchOperationResponse.Merge(m, src)
+}
+func (m *StartBatchOperationResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *StartBatchOperationResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_StartBatchOperationResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_StartBatchOperationResponse proto.InternalMessageInfo
+
+type StopBatchOperationRequest struct {
+	Namespace string `protobuf:"bytes,1,opt,name=namespace,proto3" json:"namespace,omitempty"`
+	JobId     string `protobuf:"bytes,2,opt,name=job_id,json=jobId,proto3" json:"job_id,omitempty"`
+	Reason    string `protobuf:"bytes,3,opt,name=reason,proto3" json:"reason,omitempty"`
+	Identity  string `protobuf:"bytes,4,opt,name=identity,proto3" json:"identity,omitempty"`
+}
+
+func (m *StopBatchOperationRequest) Reset()      { *m = StopBatchOperationRequest{} }
+func (*StopBatchOperationRequest) ProtoMessage() {}
+func (*StopBatchOperationRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_cc07c1a2abe7cb51, []int{53}
+}
+func (m *StopBatchOperationRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *StopBatchOperationRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_StopBatchOperationRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return true
 }
-func (this *DescribeClusterResponse) Equal(that interface{}) bool {
-	if that == nil {
-		return this == nil
+func (m *StopBatchOperationRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_StopBatchOperationRequest.Merge(m, src)
+}
+func (m *StopBatchOperationRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *StopBatchOperationRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_StopBatchOperationRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_StopBatchOperationRequest proto.InternalMessageInfo
+
+func (m *StopBatchOperationRequest) GetNamespace() string {
+	if m != nil {
+		return m.Namespace
 	}
+	return ""
+}
 
-	that1, ok := that.(*DescribeClusterResponse)
-	if !ok {
-		that2, ok := that.(DescribeClusterResponse)
-		if ok {
-			that1 = &that2
-		} else {
-			return false
-		}
+func (m *StopBatchOperationRequest) GetJobId() string {
+	if m != nil {
+		return m.JobId
 	}
-	if that1 == nil {
-		return this == nil
-	} else if this == nil {
-		return false
+	return ""
+}
+
+func (m *StopBatchOperationRequest) GetReason() string {
+	if m != nil {
+		return m.Reason
 	}
-	if len(this.SupportedClients) != len(that1.SupportedClients) {
-		return false
+	return ""
+}
+
+func (m *StopBatchOperationRequest) GetIdentity() string {
+	if m != nil {
+		return m.Identity
 	}
-	for i := range this.SupportedClients {
-		if this.SupportedClients[i] != that1.SupportedClients[i] {
-			return false
+	return ""
+}
+
+type StopBatchOperationResponse struct {
+}
+
+func (m *StopBatchOperationResponse) Reset()      { *m = StopBatchOperationResponse{} }
+func (*StopBatchOperationResponse) ProtoMessage() {}
+func (*StopBatchOperationResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_cc07c1a2abe7cb51, []int{54}
+}
+func (m *StopBatchOperationResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *StopBatchOperationResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_StopBatchOperationResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	if this.ServerVersion != that1.ServerVersion {
-		return false
+}
+func (m *StopBatchOperationResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_StopBatchOperationResponse.Merge(m, src)
+}
+func (m *StopBatchOperationResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *StopBatchOperationResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_StopBatchOperationResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_StopBatchOperationResponse proto.InternalMessageInfo
+
+type DescribeBatchOperationRequest struct {
+	Namespace string `protobuf:"bytes,1,opt,name=namespace,proto3" json:"namespace,omitempty"`
+	JobId     string `protobuf:"bytes,2,opt,name=job_id,json=jobId,proto3" json:"job_id,omitempty"`
+}
+
+func (m *DescribeBatchOperationRequest) Reset()      { *m = DescribeBatchOperationRequest{} }
+func (*DescribeBatchOperationRequest) ProtoMessage() {}
+func (*DescribeBatchOperationRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_cc07c1a2abe7cb51, []int{55}
+}
+func (m *DescribeBatchOperationRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *DescribeBatchOperationRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_DescribeBatchOperationRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	if !this.MembershipInfo.Equal(that1.MembershipInfo) {
-		return false
+}
+func (m *DescribeBatchOperationRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DescribeBatchOperationRequest.Merge(m, src)
+}
+func (m *DescribeBatchOperationRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *DescribeBatchOperationRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_DescribeBatchOperationRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DescribeBatchOperationRequest proto.InternalMessageInfo
+
+func (m *DescribeBatchOperationRequest) GetNamespace() string {
+	if m != nil {
+		return m.Namespace
 	}
-	return true
+	return ""
 }
-func (this *GetDLQMessagesRequest) Equal(that interface{}) bool {
-	if that == nil {
-		return this == nil
+
+func (m *DescribeBatchOperationRequest) GetJobId() string {
+	if m != nil {
+		return m.JobId
 	}
+	return ""
+}
 
-	that1, ok := that.(*GetDLQMessagesRequest)
-	if !ok {
-		that2, ok := that.(GetDLQMessagesRequest)
-		if ok {
-			that1 = &that2
-		} else {
-			return false
+type DescribeBatchOperationResponse struct {
+	JobId string `protobuf:"bytes,1,opt,name=job_id,json=jobId,proto3" json:"job_id,omitempty"`
+	// One of Running, Completed, Stopped and Failed.
+	State                  string     `protobuf:"bytes,2,opt,name=state,proto3" json:"state,omitempty"`
+	StartTime              *time.Time `protobuf:"bytes,3,opt,name=start_time,json=startTime,proto3,stdtime" json:"start_time,omitempty"`
+	CloseTime              *time.Time `protobuf:"bytes,4,opt,name=close_time,json=closeTime,proto3,stdtime" json:"close_time,omitempty"`
+	Reason                 string     `protobuf:"bytes,5,opt,name=reason,proto3" json:"reason,omitempty"`
+	Identity               string     `protobuf:"bytes,6,opt,name=identity,proto3" json:"identity,omitempty"`
+	TotalOperationCount    int64      `protobuf:"varint,7,opt,name=total_operation_count,json=totalOperationCount,proto3" json:"total_operation_count,omitempty"`
+	CompleteOperationCount int64      `protobuf:"varint,8,opt,name=complete_operation_count,json=completeOperationCount,proto3" json:"complete_operation_count,omitempty"`
+	FailureOperationCount  int64      `protobuf:"varint,9,opt,name=failure_operation_count,json=failureOperationCount,proto3" json:"failure_operation_count,omitempty"`
+}
+
+func (m *DescribeBatchOperationResponse) Reset()      { *m = DescribeBatchOperationResponse{} }
+func (*DescribeBatchOperationResponse) ProtoMessage() {}
+func (*DescribeBatchOperationResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_cc07c1a2abe7cb51, []int{56}
+}
+func (m *DescribeBatchOperationResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *DescribeBatchOperationResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_DescribeBatchOperationResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	if that1 == nil {
-		return this == nil
-	} else if this == nil {
-		return false
+}
+func (m *DescribeBatchOperationResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DescribeBatchOperationResponse.Merge(m, src)
+}
+func (m *DescribeBatchOperationResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *DescribeBatchOperationResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_DescribeBatchOperationResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DescribeBatchOperationResponse proto.InternalMessageInfo
+
+func (m *DescribeBatchOperationResponse) GetJobId() string {
+	if m != nil {
+		return m.JobId
 	}
-	if this.Type != that1.Type {
-		return false
+	return ""
+}
+
+func (m *DescribeBatchOperationResponse) GetState() string {
+	if m != nil {
+		return m.State
 	}
-	if this.ShardId != that1.ShardId {
-		return false
+	return ""
+}
+
+func (m *DescribeBatchOperationResponse) GetStartTime() *time.Time {
+	if m != nil {
+		return m.StartTime
 	}
-	if this.SourceCluster != that1.SourceCluster {
-		return false
+	return nil
+}
+
+func (m *DescribeBatchOperationResponse) GetCloseTime() *time.Time {
+	if m != nil {
+		return m.CloseTime
 	}
-	if this.InclusiveEndMessageId != that1.InclusiveEndMessageId {
-		return false
-	}
-	if this.MaximumPageSize != that1.MaximumPageSize {
-		return false
-	}
-	if !bytes.Equal(this.NextPageToken, that1.NextPageToken) {
-		return false
-	}
-	return true
+	return nil
 }
-func (this *GetDLQMessagesResponse) Equal(that interface{}) bool {
-	if that == nil {
-		return this == nil
+
+func (m *DescribeBatchOperationResponse) GetReason() string {
+	if m != nil {
+		return m.Reason
 	}
+	return ""
+}
 
-	that1, ok := that.(*GetDLQMessagesResponse)
-	if !ok {
-		that2, ok := that.(GetDLQMessagesResponse)
-		if ok {
-			that1 = &that2
-		} else {
-			return false
-		}
+func (m *DescribeBatchOperationResponse) GetIdentity() string {
+	if m != nil {
+		return m.Identity
 	}
-	if that1 == nil {
-		return this == nil
-	} else if this == nil {
-		return false
+	return ""
+}
+
+func (m *DescribeBatchOperationResponse) GetTotalOperationCount() int64 {
+	if m != nil {
+		return m.TotalOperationCount
 	}
-	if this.Type != that1.Type {
-		return false
+	return 0
+}
+
+func (m *DescribeBatchOperationResponse) GetCompleteOperationCount() int64 {
+	if m != nil {
+		return m.CompleteOperationCount
 	}
-	if len(this.ReplicationTasks) != len(that1.ReplicationTasks) {
-		return false
+	return 0
+}
+
+func (m *DescribeBatchOperationResponse) GetFailureOperationCount() int64 {
+	if m != nil {
+		return m.FailureOperationCount
 	}
-	for i := range this.ReplicationTasks {
-		if !this.ReplicationTasks[i].Equal(that1.ReplicationTasks[i]) {
-			return false
+	return 0
+}
+
+type ListBatchOperationsRequest struct {
+	Namespace     string `protobuf:"bytes,1,opt,name=namespace,proto3" json:"namespace,omitempty"`
+	PageSize      int32  `protobuf:"varint,2,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
+	NextPageToken []byte `protobuf:"bytes,3,opt,name=next_page_token,json=nextPageToken,proto3" json:"next_page_token,omitempty"`
+}
+
+func (m *ListBatchOperationsRequest) Reset()      { *m = ListBatchOperationsRequest{} }
+func (*ListBatchOperationsRequest) ProtoMessage() {}
+func (*ListBatchOperationsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_cc07c1a2abe7cb51, []int{57}
+}
+func (m *ListBatchOperationsRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ListBatchOperationsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ListBatchOperationsRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	if !bytes.Equal(this.NextPageToken, that1.NextPageToken) {
-		return false
-	}
-	return true
 }
-func (this *PurgeDLQMessagesRequest) Equal(that interface{}) bool {
-	if that == nil {
-		return this == nil
+func (m *ListBatchOperationsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ListBatchOperationsRequest.Merge(m, src)
+}
+func (m *ListBatchOperationsRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *ListBatchOperationsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_ListBatchOperationsRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ListBatchOperationsRequest proto.InternalMessageInfo
+
+func (m *ListBatchOperationsRequest) GetNamespace() string {
+	if m != nil {
+		return m.Namespace
 	}
+	return ""
+}
 
-	that1, ok := that.(*PurgeDLQMessagesRequest)
-	if !ok {
-		that2, ok := that.(PurgeDLQMessagesRequest)
-		if ok {
-			that1 = &that2
-		} else {
-			return false
-		}
+func (m *ListBatchOperationsRequest) GetPageSize() int32 {
+	if m != nil {
+		return m.PageSize
 	}
-	if that1 == nil {
-		return this == nil
-	} else if this == nil {
-		return false
+	return 0
+}
+
+func (m *ListBatchOperationsRequest) GetNextPageToken() []byte {
+	if m != nil {
+		return m.NextPageToken
 	}
-	if this.Type != that1.Type {
-		return false
+	return nil
+}
+
+type BatchOperationInfo struct {
+	JobId string `protobuf:"bytes,1,opt,name=job_id,json=jobId,proto3" json:"job_id,omitempty"`
+	// One of Running, Completed, Stopped and Failed.
+	State     string     `protobuf:"bytes,2,opt,name=state,proto3" json:"state,omitempty"`
+	StartTime *time.Time `protobuf:"bytes,3,opt,name=start_time,json=startTime,proto3,stdtime" json:"start_time,omitempty"`
+	CloseTime *time.Time `protobuf:"bytes,4,opt,name=close_time,json=closeTime,proto3,stdtime" json:"close_time,omitempty"`
+}
+
+func (m *BatchOperationInfo) Reset()      { *m = BatchOperationInfo{} }
+func (*BatchOperationInfo) ProtoMessage() {}
+func (*BatchOperationInfo) Descriptor() ([]byte, []int) {
+	return fileDescriptor_cc07c1a2abe7cb51, []int{58}
+}
+func (m *BatchOperationInfo) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *BatchOperationInfo) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_BatchOperationInfo.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	if this.ShardId != that1.ShardId {
-		return false
+}
+func (m *BatchOperationInfo) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_BatchOperationInfo.Merge(m, src)
+}
+func (m *BatchOperationInfo) XXX_Size() int {
+	return m.Size()
+}
+func (m *BatchOperationInfo) XXX_DiscardUnknown() {
+	xxx_messageInfo_BatchOperationInfo.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_BatchOperationInfo proto.InternalMessageInfo
+
+func (m *BatchOperationInfo) GetJobId() string {
+	if m != nil {
+		return m.JobId
 	}
-	if this.SourceCluster != that1.SourceCluster {
-		return false
+	return ""
+}
+
+func (m *BatchOperationInfo) GetState() string {
+	if m != nil {
+		return m.State
 	}
-	if this.InclusiveEndMessageId != that1.InclusiveEndMessageId {
-		return false
+	return ""
+}
+
+func (m *BatchOperationInfo) GetStartTime() *time.Time {
+	if m != nil {
+		return m.StartTime
 	}
-	return true
+	return nil
 }
-func (this *PurgeDLQMessagesResponse) Equal(that interface{}) bool {
-	if that == nil {
-		return this == nil
+
+func (m *BatchOperationInfo) GetCloseTime() *time.Time {
+	if m != nil {
+		return m.CloseTime
 	}
+	return nil
+}
 
-	that1, ok := that.(*PurgeDLQMessagesResponse)
-	if !ok {
-		that2, ok := that.(PurgeDLQMessagesResponse)
-		if ok {
-			that1 = &that2
-		} else {
-			return false
+type ListBatchOperationsResponse struct {
+	OperationInfo []*BatchOperationInfo `protobuf:"bytes,1,rep,name=operation_info,json=operationInfo,proto3" json:"operation_info,omitempty"`
+	NextPageToken []byte                `protobuf:"bytes,2,opt,name=next_page_token,json=nextPageToken,proto3" json:"next_page_token,omitempty"`
+}
+
+func (m *ListBatchOperationsResponse) Reset()      { *m = ListBatchOperationsResponse{} }
+func (*ListBatchOperationsResponse) ProtoMessage() {}
+func (*ListBatchOperationsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_cc07c1a2abe7cb51, []int{59}
+}
+func (m *ListBatchOperationsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ListBatchOperationsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ListBatchOperationsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	if that1 == nil {
-		return this == nil
-	} else if this == nil {
-		return false
+}
+func (m *ListBatchOperationsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ListBatchOperationsResponse.Merge(m, src)
+}
+func (m *ListBatchOperationsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *ListBatchOperationsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_ListBatchOperationsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ListBatchOperationsResponse proto.InternalMessageInfo
+
+func (m *ListBatchOperationsResponse) GetOperationInfo() []*BatchOperationInfo {
+	if m != nil {
+		return m.OperationInfo
 	}
-	return true
+	return nil
 }
-func (this *MergeDLQMessagesRequest) Equal(that interface{}) bool {
-	if that == nil {
-		return this == nil
+
+func (m *ListBatchOperationsResponse) GetNextPageToken() []byte {
+	if m != nil {
+		return m.NextPageToken
 	}
+	return nil
+}
 
-	that1, ok := that.(*MergeDLQMessagesRequest)
-	if !ok {
-		that2, ok := that.(MergeDLQMessagesRequest)
-		if ok {
-			that1 = &that2
-		} else {
-			return false
+type UpdateWorkflowExecutionRequest struct {
+	Namespace string                `protobuf:"bytes,1,opt,name=namespace,proto3" json:"namespace,omitempty"`
+	Execution *v1.WorkflowExecution `protobuf:"bytes,2,opt,name=execution,proto3" json:"execution,omitempty"`
+	// A random id is used if it is not set, requests with the id of an update delivered before share its outcome.
+	UpdateId string       `protobuf:"bytes,3,opt,name=update_id,json=updateId,proto3" json:"update_id,omitempty"`
+	Name     string       `protobuf:"bytes,4,opt,name=name,proto3" json:"name,omitempty"`
+	Input    *v1.Payloads `protobuf:"bytes,5,opt,name=input,proto3" json:"input,omitempty"`
+	Identity string       `protobuf:"bytes,6,opt,name=identity,proto3" json:"identity,omitempty"`
+	// Return once the workflow accepted the update instead of waiting for it to complete.
+	WaitForAccepted bool `protobuf:"varint,7,opt,name=wait_for_accepted,json=waitForAccepted,proto3" json:"wait_for_accepted,omitempty"`
+}
+
+func (m *UpdateWorkflowExecutionRequest) Reset()      { *m = UpdateWorkflowExecutionRequest{} }
+func (*UpdateWorkflowExecutionRequest) ProtoMessage() {}
+func (*UpdateWorkflowExecutionRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_cc07c1a2abe7cb51, []int{60}
+}
+func (m *UpdateWorkflowExecutionRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *UpdateWorkflowExecutionRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_UpdateWorkflowExecutionRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	if that1 == nil {
-		return this == nil
-	} else if this == nil {
-		return false
-	}
-	if this.Type != that1.Type {
-		return false
-	}
-	if this.ShardId != that1.ShardId {
-		return false
-	}
-	if this.SourceCluster != that1.SourceCluster {
-		return false
-	}
-	if this.InclusiveEndMessageId != that1.InclusiveEndMessageId {
-		return false
+}
+func (m *UpdateWorkflowExecutionRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_UpdateWorkflowExecutionRequest.Merge(m, src)
+}
+func (m *UpdateWorkflowExecutionRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *UpdateWorkflowExecutionRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_UpdateWorkflowExecutionRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_UpdateWorkflowExecutionRequest proto.InternalMessageInfo
+
+func (m *UpdateWorkflowExecutionRequest) GetNamespace() string {
+	if m != nil {
+		return m.Namespace
 	}
-	if this.MaximumPageSize != that1.MaximumPageSize {
-		return false
+	return ""
+}
+
+func (m *UpdateWorkflowExecutionRequest) GetExecution() *v1.WorkflowExecution {
+	if m != nil {
+		return m.Execution
 	}
-	if !bytes.Equal(this.NextPageToken, that1.NextPageToken) {
-		return false
+	return nil
+}
+
+func (m *UpdateWorkflowExecutionRequest) GetUpdateId() string {
+	if m != nil {
+		return m.UpdateId
 	}
-	return true
+	return ""
 }
-func (this *MergeDLQMessagesResponse) Equal(that interface{}) bool {
-	if that == nil {
-		return this == nil
+
+func (m *UpdateWorkflowExecutionRequest) GetName() string {
+	if m != nil {
+		return m.Name
 	}
+	return ""
+}
 
-	that1, ok := that.(*MergeDLQMessagesResponse)
-	if !ok {
-		that2, ok := that.(MergeDLQMessagesResponse)
-		if ok {
-			that1 = &that2
-		} else {
-			return false
-		}
-	}
-	if that1 == nil {
-		return this == nil
-	} else if this == nil {
-		return false
+func (m *UpdateWorkflowExecutionRequest) GetInput() *v1.Payloads {
+	if m != nil {
+		return m.Input
 	}
-	if !bytes.Equal(this.NextPageToken, that1.NextPageToken) {
-		return false
+	return nil
+}
+
+func (m *UpdateWorkflowExecutionRequest) GetIdentity() string {
+	if m != nil {
+		return m.Identity
 	}
-	return true
+	return ""
 }
-func (this *RefreshWorkflowTasksRequest) Equal(that interface{}) bool {
-	if that == nil {
-		return this == nil
+
+func (m *UpdateWorkflowExecutionRequest) GetWaitForAccepted() bool {
+	if m != nil {
+		return m.WaitForAccepted
 	}
+	return false
+}
 
-	that1, ok := that.(*RefreshWorkflowTasksRequest)
-	if !ok {
-		that2, ok := that.(RefreshWorkflowTasksRequest)
-		if ok {
-			that1 = &that2
-		} else {
-			return false
+type UpdateWorkflowExecutionResponse struct {
+	UpdateId string `protobuf:"bytes,1,opt,name=update_id,json=updateId,proto3" json:"update_id,omitempty"`
+	// Result is only set once the update is completed.
+	Completed bool         `protobuf:"varint,2,opt,name=completed,proto3" json:"completed,omitempty"`
+	Result    *v1.Payloads `protobuf:"bytes,3,opt,name=result,proto3" json:"result,omitempty"`
+	// Set if the workflow rejected the update or failed to complete it.
+	Failure *v18.Failure `protobuf:"bytes,4,opt,name=failure,proto3" json:"failure,omitempty"`
+}
+
+func (m *UpdateWorkflowExecutionResponse) Reset()      { *m = UpdateWorkflowExecutionResponse{} }
+func (*UpdateWorkflowExecutionResponse) ProtoMessage() {}
+func (*UpdateWorkflowExecutionResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_cc07c1a2abe7cb51, []int{61}
+}
+func (m *UpdateWorkflowExecutionResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *UpdateWorkflowExecutionResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_UpdateWorkflowExecutionResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	if that1 == nil {
-		return this == nil
-	} else if this == nil {
-		return false
+}
+func (m *UpdateWorkflowExecutionResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_UpdateWorkflowExecutionResponse.Merge(m, src)
+}
+func (m *UpdateWorkflowExecutionResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *UpdateWorkflowExecutionResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_UpdateWorkflowExecutionResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_UpdateWorkflowExecutionResponse proto.InternalMessageInfo
+
+func (m *UpdateWorkflowExecutionResponse) GetUpdateId() string {
+	if m != nil {
+		return m.UpdateId
 	}
-	if this.Namespace != that1.Namespace {
-		return false
+	return ""
+}
+
+func (m *UpdateWorkflowExecutionResponse) GetCompleted() bool {
+	if m != nil {
+		return m.Completed
 	}
-	if !this.Execution.Equal(that1.Execution) {
-		return false
+	return false
+}
+
+func (m *UpdateWorkflowExecutionResponse) GetResult() *v1.Payloads {
+	if m != nil {
+		return m.Result
 	}
-	return true
+	return nil
 }
-func (this *RefreshWorkflowTasksResponse) Equal(that interface{}) bool {
-	if that == nil {
-		return this == nil
+
+func (m *UpdateWorkflowExecutionResponse) GetFailure() *v18.Failure {
+	if m != nil {
+		return m.Failure
 	}
+	return nil
+}
 
-	that1, ok := that.(*RefreshWorkflowTasksResponse)
-	if !ok {
-		that2, ok := that.(RefreshWorkflowTasksResponse)
-		if ok {
-			that1 = &that2
-		} else {
-			return false
+type GetWorkflowExecutionResultRequest struct {
+	Namespace string `protobuf:"bytes,1,opt,name=namespace,proto3" json:"namespace,omitempty"`
+	// The current run is used if the run id is not set.
+	Execution *v1.WorkflowExecution `protobuf:"bytes,2,opt,name=execution,proto3" json:"execution,omitempty"`
+	// Wait for the outcome of the last run of the chain started by continue-as-new, retries and cron runs included.
+	FollowRuns bool `protobuf:"varint,3,opt,name=follow_runs,json=followRuns,proto3" json:"follow_runs,omitempty"`
+}
+
+func (m *GetWorkflowExecutionResultRequest) Reset()      { *m = GetWorkflowExecutionResultRequest{} }
+func (*GetWorkflowExecutionResultRequest) ProtoMessage() {}
+func (*GetWorkflowExecutionResultRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_cc07c1a2abe7cb51, []int{62}
+}
+func (m *GetWorkflowExecutionResultRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *GetWorkflowExecutionResultRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_GetWorkflowExecutionResultRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	if that1 == nil {
-		return this == nil
-	} else if this == nil {
-		return false
+}
+func (m *GetWorkflowExecutionResultRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetWorkflowExecutionResultRequest.Merge(m, src)
+}
+func (m *GetWorkflowExecutionResultRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *GetWorkflowExecutionResultRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetWorkflowExecutionResultRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GetWorkflowExecutionResultRequest proto.InternalMessageInfo
+
+func (m *GetWorkflowExecutionResultRequest) GetNamespace() string {
+	if m != nil {
+		return m.Namespace
 	}
-	return true
+	return ""
 }
-func (this *ResendReplicationTasksRequest) Equal(that interface{}) bool {
-	if that == nil {
-		return this == nil
+
+func (m *GetWorkflowExecutionResultRequest) GetExecution() *v1.WorkflowExecution {
+	if m != nil {
+		return m.Execution
 	}
+	return nil
+}
 
-	that1, ok := that.(*ResendReplicationTasksRequest)
-	if !ok {
-		that2, ok := that.(ResendReplicationTasksRequest)
-		if ok {
-			that1 = &that2
-		} else {
-			return false
+func (m *GetWorkflowExecutionResultRequest) GetFollowRuns() bool {
+	if m != nil {
+		return m.FollowRuns
+	}
+	return false
+}
+
+type GetWorkflowExecutionResultResponse struct {
+	// The run the outcome belongs to.
+	Execution *v1.WorkflowExecution `protobuf:"bytes,1,opt,name=execution,proto3" json:"execution,omitempty"`
+	// Running if the poll expired before the workflow closed, the caller is expected to poll again.
+	Status  v15.WorkflowExecutionStatus `protobuf:"varint,2,opt,name=status,proto3,enum=temporal.api.enums.v1.WorkflowExecutionStatus" json:"status,omitempty"`
+	Result  *v1.Payloads                `protobuf:"bytes,3,opt,name=result,proto3" json:"result,omitempty"`
+	Failure *v18.Failure                `protobuf:"bytes,4,opt,name=failure,proto3" json:"failure,omitempty"`
+	// The run which continued the workflow if runs are not followed.
+	NewExecutionRunId string `protobuf:"bytes,5,opt,name=new_execution_run_id,json=newExecutionRunId,proto3" json:"new_execution_run_id,omitempty"`
+}
+
+func (m *GetWorkflowExecutionResultResponse) Reset()      { *m = GetWorkflowExecutionResultResponse{} }
+func (*GetWorkflowExecutionResultResponse) ProtoMessage() {}
+func (*GetWorkflowExecutionResultResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_cc07c1a2abe7cb51, []int{63}
+}
+func (m *GetWorkflowExecutionResultResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *GetWorkflowExecutionResultResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_GetWorkflowExecutionResultResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	if that1 == nil {
-		return this == nil
-	} else if this == nil {
-		return false
+}
+func (m *GetWorkflowExecutionResultResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetWorkflowExecutionResultResponse.Merge(m, src)
+}
+func (m *GetWorkflowExecutionResultResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *GetWorkflowExecutionResultResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetWorkflowExecutionResultResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GetWorkflowExecutionResultResponse proto.InternalMessageInfo
+
+func (m *GetWorkflowExecutionResultResponse) GetExecution() *v1.WorkflowExecution {
+	if m != nil {
+		return m.Execution
 	}
-	if this.NamespaceId != that1.NamespaceId {
-		return false
+	return nil
+}
+
+func (m *GetWorkflowExecutionResultResponse) GetStatus() v15.WorkflowExecutionStatus {
+	if m != nil {
+		return m.Status
 	}
-	if this.WorkflowId != that1.WorkflowId {
-		return false
+	return v15.WORKFLOW_EXECUTION_STATUS_UNSPECIFIED
+}
+
+func (m *GetWorkflowExecutionResultResponse) GetResult() *v1.Payloads {
+	if m != nil {
+		return m.Result
 	}
-	if this.RunId != that1.RunId {
-		return false
+	return nil
+}
+
+func (m *GetWorkflowExecutionResultResponse) GetFailure() *v18.Failure {
+	if m != nil {
+		return m.Failure
 	}
-	if this.RemoteCluster != that1.RemoteCluster {
-		return false
+	return nil
+}
+
+func (m *GetWorkflowExecutionResultResponse) GetNewExecutionRunId() string {
+	if m != nil {
+		return m.NewExecutionRunId
 	}
-	if this.StartEventId != that1.StartEventId {
-		return false
+	return ""
+}
+
+type ResetWorkflowExecutionWithOptionsRequest struct {
+	Namespace string                `protobuf:"bytes,1,opt,name=namespace,proto3" json:"namespace,omitempty"`
+	Execution *v1.WorkflowExecution `protobuf:"bytes,2,opt,name=execution,proto3" json:"execution,omitempty"`
+	Reason    string                `protobuf:"bytes,3,opt,name=reason,proto3" json:"reason,omitempty"`
+	RequestId string                `protobuf:"bytes,4,opt,name=request_id,json=requestId,proto3" json:"request_id,omitempty"`
+	// The event the run is reset to, as in ResetWorkflowExecution.
+	WorkflowTaskFinishEventId int64 `protobuf:"varint,5,opt,name=workflow_task_finish_event_id,json=workflowTaskFinishEventId,proto3" json:"workflow_task_finish_event_id,omitempty"`
+	// Reset to the workflow task scheduled with this event id instead, the run may be any run of the continue-as-new chain of the current run.
+	WorkflowTaskScheduledEventId int64 `protobuf:"varint,6,opt,name=workflow_task_scheduled_event_id,json=workflowTaskScheduledEventId,proto3" json:"workflow_task_scheduled_event_id,omitempty"`
+	// One of all, exclude_signals and none, all is used if it is not set.
+	ReapplyType string `protobuf:"bytes,7,opt,name=reapply_type,json=reapplyType,proto3" json:"reapply_type,omitempty"`
+	// Only return a preview of the reset, nothing is persisted.
+	DryRun bool `protobuf:"varint,8,opt,name=dry_run,json=dryRun,proto3" json:"dry_run,omitempty"`
+}
+
+func (m *ResetWorkflowExecutionWithOptionsRequest) Reset() {
+	*m = ResetWorkflowExecutionWithOptionsRequest{}
+}
+func (*ResetWorkflowExecutionWithOptionsRequest) ProtoMessage() {}
+func (*ResetWorkflowExecutionWithOptionsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_cc07c1a2abe7cb51, []int{64}
+}
+func (m *ResetWorkflowExecutionWithOptionsRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ResetWorkflowExecutionWithOptionsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ResetWorkflowExecutionWithOptionsRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	if this.StartVersion != that1.StartVersion {
-		return false
+}
+func (m *ResetWorkflowExecutionWithOptionsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ResetWorkflowExecutionWithOptionsRequest.Merge(m, src)
+}
+func (m *ResetWorkflowExecutionWithOptionsRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *ResetWorkflowExecutionWithOptionsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_ResetWorkflowExecutionWithOptionsRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ResetWorkflowExecutionWithOptionsRequest proto.InternalMessageInfo
+
+func (m *ResetWorkflowExecutionWithOptionsRequest) GetNamespace() string {
+	if m != nil {
+		return m.Namespace
 	}
-	if this.EndEventId != that1.EndEventId {
-		return false
+	return ""
+}
+
+func (m *ResetWorkflowExecutionWithOptionsRequest) GetExecution() *v1.WorkflowExecution {
+	if m != nil {
+		return m.Execution
 	}
-	if this.EndVersion != that1.EndVersion {
-		return false
+	return nil
+}
+
+func (m *ResetWorkflowExecutionWithOptionsRequest) GetReason() string {
+	if m != nil {
+		return m.Reason
 	}
-	return true
+	return ""
 }
-func (this *ResendReplicationTasksResponse) Equal(that interface{}) bool {
-	if that == nil {
-		return this == nil
+
+func (m *ResetWorkflowExecutionWithOptionsRequest) GetRequestId() string {
+	if m != nil {
+		return m.RequestId
 	}
+	return ""
+}
 
-	that1, ok := that.(*ResendReplicationTasksResponse)
-	if !ok {
-		that2, ok := that.(ResendReplicationTasksResponse)
-		if ok {
-			that1 = &that2
-		} else {
-			return false
-		}
+func (m *ResetWorkflowExecutionWithOptionsRequest) GetWorkflowTaskFinishEventId() int64 {
+	if m != nil {
+		return m.WorkflowTaskFinishEventId
 	}
-	if that1 == nil {
-		return this == nil
-	} else if this == nil {
-		return false
+	return 0
+}
+
+func (m *ResetWorkflowExecutionWithOptionsRequest) GetWorkflowTaskScheduledEventId() int64 {
+	if m != nil {
+		return m.WorkflowTaskScheduledEventId
 	}
-	return true
+	return 0
 }
-func (this *DescribeWorkflowExecutionRequest) GoString() string {
-	if this == nil {
-		return "nil"
+
+func (m *ResetWorkflowExecutionWithOptionsRequest) GetReapplyType() string {
+	if m != nil {
+		return m.ReapplyType
 	}
-	s := make([]string, 0, 6)
-	s = append(s, "&adminservice.DescribeWorkflowExecutionRequest{")
-	s = append(s, "Namespace: "+fmt.Sprintf("%#v", this.Namespace)+",\n")
-	if this.Execution != nil {
-		s = append(s, "Execution: "+fmt.Sprintf("%#v", this.Execution)+",\n")
-	}
-	s = append(s, "}")
-	return strings.Join(s, "")
+	return ""
 }
-func (this *DescribeWorkflowExecutionResponse) GoString() string {
-	if this == nil {
-		return "nil"
+
+func (m *ResetWorkflowExecutionWithOptionsRequest) GetDryRun() bool {
+	if m != nil {
+		return m.DryRun
 	}
-	s := make([]string, 0, 10)
-	s = append(s, "&adminservice.DescribeWorkflowExecutionResponse{")
-	s = append(s, "ShardId: "+fmt.Sprintf("%#v", this.ShardId)+",\n")
-	s = append(s, "HistoryAddr: "+fmt.Sprintf("%#v", this.HistoryAddr)+",\n")
-	s = append(s, "CacheMutableState: "+fmt.Sprintf("%#v", this.CacheMutableState)+",\n")
-	s = append(s, "DatabaseMutableState: "+fmt.Sprintf("%#v", this.DatabaseMutableState)+",\n")
-	s = append(s, "TreeId: "+fmt.Sprintf("%#v", this.TreeId)+",\n")
-	s = append(s, "BranchId: "+fmt.Sprintf("%#v", this.BranchId)+",\n")
-	s = append(s, "}")
-	return strings.Join(s, "")
+	return false
 }
-func (this *DescribeHistoryHostRequest) GoString() string {
-	if this == nil {
-		return "nil"
-	}
-	s := make([]string, 0, 8)
-	s = append(s, "&adminservice.DescribeHistoryHostRequest{")
-	s = append(s, "HostAddress: "+fmt.Sprintf("%#v", this.HostAddress)+",\n")
-	s = append(s, "ShardId: "+fmt.Sprintf("%#v", this.ShardId)+",\n")
-	s = append(s, "Namespace: "+fmt.Sprintf("%#v", this.Namespace)+",\n")
-	if this.WorkflowExecution != nil {
-		s = append(s, "WorkflowExecution: "+fmt.Sprintf("%#v", this.WorkflowExecution)+",\n")
-	}
-	s = append(s, "}")
-	return strings.Join(s, "")
+
+type ResetWorkflowExecutionWithOptionsResponse struct {
+	// The reset run, it is not set for a dry run.
+	RunId string `protobuf:"bytes,1,opt,name=run_id,json=runId,proto3" json:"run_id,omitempty"`
+	// Only set for a dry run.
+	Preview *ResetWorkflowExecutionPreview `protobuf:"bytes,2,opt,name=preview,proto3" json:"preview,omitempty"`
 }
-func (this *DescribeHistoryHostResponse) GoString() string {
-	if this == nil {
-		return "nil"
-	}
-	s := make([]string, 0, 9)
-	s = append(s, "&adminservice.DescribeHistoryHostResponse{")
-	s = append(s, "ShardsNumber: "+fmt.Sprintf("%#v", this.ShardsNumber)+",\n")
-	s = append(s, "ShardIds: "+fmt.Sprintf("%#v", this.ShardIds)+",\n")
-	if this.NamespaceCache != nil {
-		s = append(s, "NamespaceCache: "+fmt.Sprintf("%#v", this.NamespaceCache)+",\n")
-	}
-	s = append(s, "ShardControllerStatus: "+fmt.Sprintf("%#v", this.ShardControllerStatus)+",\n")
-	s = append(s, "Address: "+fmt.Sprintf("%#v", this.Address)+",\n")
-	s = append(s, "}")
-	return strings.Join(s, "")
+
+func (m *ResetWorkflowExecutionWithOptionsResponse) Reset() {
+	*m = ResetWorkflowExecutionWithOptionsResponse{}
 }
-func (this *CloseShardRequest) GoString() string {
-	if this == nil {
-		return "nil"
-	}
-	s := make([]string, 0, 5)
-	s = append(s, "&adminservice.CloseShardRequest{")
-	s = append(s, "ShardId: "+fmt.Sprintf("%#v", this.ShardId)+",\n")
-	s = append(s, "}")
-	return strings.Join(s, "")
+func (*ResetWorkflowExecutionWithOptionsResponse) ProtoMessage() {}
+func (*ResetWorkflowExecutionWithOptionsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_cc07c1a2abe7cb51, []int{65}
 }
-func (this *CloseShardResponse) GoString() string {
-	if this == nil {
-		return "nil"
-	}
-	s := make([]string, 0, 4)
-	s = append(s, "&adminservice.CloseShardResponse{")
-	s = append(s, "}")
-	return strings.Join(s, "")
+func (m *ResetWorkflowExecutionWithOptionsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
-func (this *RemoveTaskRequest) GoString() string {
-	if this == nil {
-		return "nil"
+func (m *ResetWorkflowExecutionWithOptionsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ResetWorkflowExecutionWithOptionsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	s := make([]string, 0, 8)
-	s = append(s, "&adminservice.RemoveTaskRequest{")
-	s = append(s, "ShardId: "+fmt.Sprintf("%#v", this.ShardId)+",\n")
-	s = append(s, "Category: "+fmt.Sprintf("%#v", this.Category)+",\n")
-	s = append(s, "TaskId: "+fmt.Sprintf("%#v", this.TaskId)+",\n")
-	s = append(s, "VisibilityTime: "+fmt.Sprintf("%#v", this.VisibilityTime)+",\n")
-	s = append(s, "}")
-	return strings.Join(s, "")
 }
-func (this *RemoveTaskResponse) GoString() string {
-	if this == nil {
-		return "nil"
+func (m *ResetWorkflowExecutionWithOptionsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ResetWorkflowExecutionWithOptionsResponse.Merge(m, src)
+}
+func (m *ResetWorkflowExecutionWithOptionsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *ResetWorkflowExecutionWithOptionsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_ResetWorkflowExecutionWithOptionsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ResetWorkflowExecutionWithOptionsResponse proto.InternalMessageInfo
+
+func (m *ResetWorkflowExecutionWithOptionsResponse) GetRunId() string {
+	if m != nil {
+		return m.RunId
 	}
-	s := make([]string, 0, 4)
-	s = append(s, "&adminservice.RemoveTaskResponse{")
-	s = append(s, "}")
-	return strings.Join(s, "")
+	return ""
 }
-func (this *GetWorkflowExecutionRawHistoryV2Request) GoString() string {
-	if this == nil {
-		return "nil"
+
+func (m *ResetWorkflowExecutionWithOptionsResponse) GetPreview() *ResetWorkflowExecutionPreview {
+	if m != nil {
+		return m.Preview
 	}
-	s := make([]string, 0, 12)
-	s = append(s, "&adminservice.GetWorkflowExecutionRawHistoryV2Request{")
-	s = append(s, "Namespace: "+fmt.Sprintf("%#v", this.Namespace)+",\n")
-	if this.Execution != nil {
-		s = append(s, "Execution: "+fmt.Sprintf("%#v", this.Execution)+",\n")
+	return nil
+}
+
+type ResetWorkflowExecutionPreview struct {
+	BaseRunId                 string `protobuf:"bytes,1,opt,name=base_run_id,json=baseRunId,proto3" json:"base_run_id,omitempty"`
+	WorkflowTaskFinishEventId int64  `protobuf:"varint,2,opt,name=workflow_task_finish_event_id,json=workflowTaskFinishEventId,proto3" json:"workflow_task_finish_event_id,omitempty"`
+	// The current run which is terminated, it is empty if the run is closed.
+	TerminatedRunId string `protobuf:"bytes,3,opt,name=terminated_run_id,json=terminatedRunId,proto3" json:"terminated_run_id,omitempty"`
+	// The events of the base run after the reset point.
+	DroppedEvents []*v19.HistoryEvent `protobuf:"bytes,4,rep,name=dropped_events,json=droppedEvents,proto3" json:"dropped_events,omitempty"`
+	// The activities started before the reset point, they are failed in the reset run.
+	FailedActivities []*v20.PendingActivityInfo `protobuf:"bytes,5,rep,name=failed_activities,json=failedActivities,proto3" json:"failed_activities,omitempty"`
+	// The child workflows pending at the reset point, a reset with pending children is rejected.
+	PendingChildren []*v20.PendingChildExecutionInfo `protobuf:"bytes,6,rep,name=pending_children,json=pendingChildren,proto3" json:"pending_children,omitempty"`
+	// The events received after the reset point which are reapplied.
+	ReappliedEvents []*v19.HistoryEvent `protobuf:"bytes,7,rep,name=reapplied_events,json=reappliedEvents,proto3" json:"reapplied_events,omitempty"`
+}
+
+func (m *ResetWorkflowExecutionPreview) Reset()      { *m = ResetWorkflowExecutionPreview{} }
+func (*ResetWorkflowExecutionPreview) ProtoMessage() {}
+func (*ResetWorkflowExecutionPreview) Descriptor() ([]byte, []int) {
+	return fileDescriptor_cc07c1a2abe7cb51, []int{66}
+}
+func (m *ResetWorkflowExecutionPreview) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ResetWorkflowExecutionPreview) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ResetWorkflowExecutionPreview.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	s = append(s, "StartEventId: "+fmt.Sprintf("%#v", this.StartEventId)+",\n")
-	s = append(s, "StartEventVersion: "+fmt.Sprintf("%#v", this.StartEventVersion)+",\n")
-	s = append(s, "EndEventId: "+fmt.Sprintf("%#v", this.EndEventId)+",\n")
-	s = append(s, "EndEventVersion: "+fmt.Sprintf("%#v", this.EndEventVersion)+",\n")
-	s = append(s, "MaximumPageSize: "+fmt.Sprintf("%#v", this.MaximumPageSize)+",\n")
-	s = append(s, "NextPageToken: "+fmt.Sprintf("%#v", this.NextPageToken)+",\n")
-	s = append(s, "}")
-	return strings.Join(s, "")
 }
-func (this *GetWorkflowExecutionRawHistoryV2Response) GoString() string {
-	if this == nil {
-		return "nil"
+func (m *ResetWorkflowExecutionPreview) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ResetWorkflowExecutionPreview.Merge(m, src)
+}
+func (m *ResetWorkflowExecutionPreview) XXX_Size() int {
+	return m.Size()
+}
+func (m *ResetWorkflowExecutionPreview) XXX_DiscardUnknown() {
+	xxx_messageInfo_ResetWorkflowExecutionPreview.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ResetWorkflowExecutionPreview proto.InternalMessageInfo
+
+func (m *ResetWorkflowExecutionPreview) GetBaseRunId() string {
+	if m != nil {
+		return m.BaseRunId
 	}
-	s := make([]string, 0, 7)
-	s = append(s, "&adminservice.GetWorkflowExecutionRawHistoryV2Response{")
-	s = append(s, "NextPageToken: "+fmt.Sprintf("%#v", this.NextPageToken)+",\n")
-	if this.HistoryBatches != nil {
-		s = append(s, "HistoryBatches: "+fmt.Sprintf("%#v", this.HistoryBatches)+",\n")
+	return ""
+}
+
+func (m *ResetWorkflowExecutionPreview) GetWorkflowTaskFinishEventId() int64 {
+	if m != nil {
+		return m.WorkflowTaskFinishEventId
 	}
-	if this.VersionHistory != nil {
-		s = append(s, "VersionHistory: "+fmt.Sprintf("%#v", this.VersionHistory)+",\n")
+	return 0
+}
+
+func (m *ResetWorkflowExecutionPreview) GetTerminatedRunId() string {
+	if m != nil {
+		return m.TerminatedRunId
 	}
-	s = append(s, "}")
-	return strings.Join(s, "")
+	return ""
 }
-func (this *GetReplicationMessagesRequest) GoString() string {
-	if this == nil {
-		return "nil"
+
+func (m *ResetWorkflowExecutionPreview) GetDroppedEvents() []*v19.HistoryEvent {
+	if m != nil {
+		return m.DroppedEvents
 	}
-	s := make([]string, 0, 6)
-	s = append(s, "&adminservice.GetReplicationMessagesRequest{")
-	if this.Tokens != nil {
-		s = append(s, "Tokens: "+fmt.Sprintf("%#v", this.Tokens)+",\n")
+	return nil
+}
+
+func (m *ResetWorkflowExecutionPreview) GetFailedActivities() []*v20.PendingActivityInfo {
+	if m != nil {
+		return m.FailedActivities
 	}
-	s = append(s, "ClusterName: "+fmt.Sprintf("%#v", this.ClusterName)+",\n")
-	s = append(s, "}")
-	return strings.Join(s, "")
+	return nil
 }
-func (this *GetReplicationMessagesResponse) GoString() string {
-	if this == nil {
-		return "nil"
+
+func (m *ResetWorkflowExecutionPreview) GetPendingChildren() []*v20.PendingChildExecutionInfo {
+	if m != nil {
+		return m.PendingChildren
 	}
-	s := make([]string, 0, 5)
-	s = append(s, "&adminservice.GetReplicationMessagesResponse{")
-	keysForShardMessages := make([]int32, 0, len(this.ShardMessages))
-	for k, _ := range this.ShardMessages {
-		keysForShardMessages = append(keysForShardMessages, k)
+	return nil
+}
+
+func (m *ResetWorkflowExecutionPreview) GetReappliedEvents() []*v19.HistoryEvent {
+	if m != nil {
+		return m.ReappliedEvents
 	}
-	github_com_gogo_protobuf_sortkeys.Int32s(keysForShardMessages)
-	mapStringForShardMessages := "map[int32]*v14.ReplicationMessages{"
-	for _, k := range keysForShardMessages {
-		mapStringForShardMessages += fmt.Sprintf("%#v: %#v,", k, this.ShardMessages[k])
+	return nil
+}
+
+type PauseWorkflowExecutionRequest struct {
+	Namespace string                `protobuf:"bytes,1,opt,name=namespace,proto3" json:"namespace,omitempty"`
+	Execution *v1.WorkflowExecution `protobuf:"bytes,2,opt,name=execution,proto3" json:"execution,omitempty"`
+	Identity  string                `protobuf:"bytes,3,opt,name=identity,proto3" json:"identity,omitempty"`
+	Reason    string                `protobuf:"bytes,4,opt,name=reason,proto3" json:"reason,omitempty"`
+}
+
+func (m *PauseWorkflowExecutionRequest) Reset()      { *m = PauseWorkflowExecutionRequest{} }
+func (*PauseWorkflowExecutionRequest) ProtoMessage() {}
+func (*PauseWorkflowExecutionRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_cc07c1a2abe7cb51, []int{67}
+}
+func (m *PauseWorkflowExecutionRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *PauseWorkflowExecutionRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_PauseWorkflowExecutionRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	mapStringForShardMessages += "}"
-	if this.ShardMessages != nil {
-		s = append(s, "ShardMessages: "+mapStringForShardMessages+",\n")
+}
+func (m *PauseWorkflowExecutionRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PauseWorkflowExecutionRequest.Merge(m, src)
+}
+func (m *PauseWorkflowExecutionRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *PauseWorkflowExecutionRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_PauseWorkflowExecutionRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PauseWorkflowExecutionRequest proto.InternalMessageInfo
+
+func (m *PauseWorkflowExecutionRequest) GetNamespace() string {
+	if m != nil {
+		return m.Namespace
 	}
-	s = append(s, "}")
-	return strings.Join(s, "")
+	return ""
 }
-func (this *GetNamespaceReplicationMessagesRequest) GoString() string {
-	if this == nil {
-		return "nil"
+
+func (m *PauseWorkflowExecutionRequest) GetExecution() *v1.WorkflowExecution {
+	if m != nil {
+		return m.Execution
 	}
-	s := make([]string, 0, 7)
-	s = append(s, "&adminservice.GetNamespaceReplicationMessagesRequest{")
-	s = append(s, "LastRetrievedMessageId: "+fmt.Sprintf("%#v", this.LastRetrievedMessageId)+",\n")
-	s = append(s, "LastProcessedMessageId: "+fmt.Sprintf("%#v", this.LastProcessedMessageId)+",\n")
-	s = append(s, "ClusterName: "+fmt.Sprintf("%#v", this.ClusterName)+",\n")
-	s = append(s, "}")
-	return strings.Join(s, "")
+	return nil
 }
-func (this *GetNamespaceReplicationMessagesResponse) GoString() string {
-	if this == nil {
-		return "nil"
+
+func (m *PauseWorkflowExecutionRequest) GetIdentity() string {
+	if m != nil {
+		return m.Identity
 	}
-	s := make([]string, 0, 5)
-	s = append(s, "&adminservice.GetNamespaceReplicationMessagesResponse{")
-	if this.Messages != nil {
-		s = append(s, "Messages: "+fmt.Sprintf("%#v", this.Messages)+",\n")
-	}
-	s = append(s, "}")
-	return strings.Join(s, "")
+	return ""
 }
-func (this *GetDLQReplicationMessagesRequest) GoString() string {
-	if this == nil {
-		return "nil"
-	}
-	s := make([]string, 0, 5)
-	s = append(s, "&adminservice.GetDLQReplicationMessagesRequest{")
-	if this.TaskInfos != nil {
-		s = append(s, "TaskInfos: "+fmt.Sprintf("%#v", this.TaskInfos)+",\n")
+
+func (m *PauseWorkflowExecutionRequest) GetReason() string {
+	if m != nil {
+		return m.Reason
 	}
-	s = append(s, "}")
-	return strings.Join(s, "")
+	return ""
 }
-func (this *GetDLQReplicationMessagesResponse) GoString() string {
-	if this == nil {
-		return "nil"
-	}
-	s := make([]string, 0, 5)
-	s = append(s, "&adminservice.GetDLQReplicationMessagesResponse{")
-	if this.ReplicationTasks != nil {
-		s = append(s, "ReplicationTasks: "+fmt.Sprintf("%#v", this.ReplicationTasks)+",\n")
-	}
-	s = append(s, "}")
-	return strings.Join(s, "")
+
+type PauseWorkflowExecutionResponse struct {
 }
-func (this *ReapplyEventsRequest) GoString() string {
-	if this == nil {
-		return "nil"
-	}
-	s := make([]string, 0, 7)
-	s = append(s, "&adminservice.ReapplyEventsRequest{")
-	s = append(s, "Namespace: "+fmt.Sprintf("%#v", this.Namespace)+",\n")
-	if this.WorkflowExecution != nil {
-		s = append(s, "WorkflowExecution: "+fmt.Sprintf("%#v", this.WorkflowExecution)+",\n")
-	}
-	if this.Events != nil {
-		s = append(s, "Events: "+fmt.Sprintf("%#v", this.Events)+",\n")
-	}
-	s = append(s, "}")
-	return strings.Join(s, "")
+
+func (m *PauseWorkflowExecutionResponse) Reset()      { *m = PauseWorkflowExecutionResponse{} }
+func (*PauseWorkflowExecutionResponse) ProtoMessage() {}
+func (*PauseWorkflowExecutionResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_cc07c1a2abe7cb51, []int{68}
 }
-func (this *ReapplyEventsResponse) GoString() string {
-	if this == nil {
-		return "nil"
-	}
-	s := make([]string, 0, 4)
-	s = append(s, "&adminservice.ReapplyEventsResponse{")
-	s = append(s, "}")
-	return strings.Join(s, "")
+func (m *PauseWorkflowExecutionResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
-func (this *AddSearchAttributeRequest) GoString() string {
-	if this == nil {
-		return "nil"
-	}
-	s := make([]string, 0, 6)
-	s = append(s, "&adminservice.AddSearchAttributeRequest{")
-	keysForSearchAttribute := make([]string, 0, len(this.SearchAttribute))
-	for k, _ := range this.SearchAttribute {
-		keysForSearchAttribute = append(keysForSearchAttribute, k)
-	}
-	github_com_gogo_protobuf_sortkeys.Strings(keysForSearchAttribute)
-	mapStringForSearchAttribute := "map[string]v15.IndexedValueType{"
-	for _, k := range keysForSearchAttribute {
-		mapStringForSearchAttribute += fmt.Sprintf("%#v: %#v,", k, this.SearchAttribute[k])
-	}
-	mapStringForSearchAttribute += "}"
-	if this.SearchAttribute != nil {
-		s = append(s, "SearchAttribute: "+mapStringForSearchAttribute+",\n")
+func (m *PauseWorkflowExecutionResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_PauseWorkflowExecutionResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	s = append(s, "SecurityToken: "+fmt.Sprintf("%#v", this.SecurityToken)+",\n")
-	s = append(s, "}")
-	return strings.Join(s, "")
 }
-func (this *AddSearchAttributeResponse) GoString() string {
-	if this == nil {
-		return "nil"
-	}
-	s := make([]string, 0, 4)
-	s = append(s, "&adminservice.AddSearchAttributeResponse{")
-	s = append(s, "}")
-	return strings.Join(s, "")
+func (m *PauseWorkflowExecutionResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PauseWorkflowExecutionResponse.Merge(m, src)
 }
-func (this *DescribeClusterRequest) GoString() string {
-	if this == nil {
-		return "nil"
-	}
-	s := make([]string, 0, 4)
-	s = append(s, "&adminservice.DescribeClusterRequest{")
-	s = append(s, "}")
-	return strings.Join(s, "")
+func (m *PauseWorkflowExecutionResponse) XXX_Size() int {
+	return m.Size()
 }
-func (this *DescribeClusterResponse) GoString() string {
-	if this == nil {
-		return "nil"
-	}
-	s := make([]string, 0, 7)
-	s = append(s, "&adminservice.DescribeClusterResponse{")
-	keysForSupportedClients := make([]string, 0, len(this.SupportedClients))
-	for k, _ := range this.SupportedClients {
-		keysForSupportedClients = append(keysForSupportedClients, k)
-	}
-	github_com_gogo_protobuf_sortkeys.Strings(keysForSupportedClients)
-	mapStringForSupportedClients := "map[string]string{"
-	for _, k := range keysForSupportedClients {
-		mapStringForSupportedClients += fmt.Sprintf("%#v: %#v,", k, this.SupportedClients[k])
-	}
-	mapStringForSupportedClients += "}"
-	if this.SupportedClients != nil {
-		s = append(s, "SupportedClients: "+mapStringForSupportedClients+",\n")
-	}
-	s = append(s, "ServerVersion: "+fmt.Sprintf("%#v", this.ServerVersion)+",\n")
-	if this.MembershipInfo != nil {
-		s = append(s, "MembershipInfo: "+fmt.Sprintf("%#v", this.MembershipInfo)+",\n")
-	}
-	s = append(s, "}")
-	return strings.Join(s, "")
+func (m *PauseWorkflowExecutionResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_PauseWorkflowExecutionResponse.DiscardUnknown(m)
 }
-func (this *GetDLQMessagesRequest) GoString() string {
-	if this == nil {
-		return "nil"
-	}
-	s := make([]string, 0, 10)
-	s = append(s, "&adminservice.GetDLQMessagesRequest{")
-	s = append(s, "Type: "+fmt.Sprintf("%#v", this.Type)+",\n")
-	s = append(s, "ShardId: "+fmt.Sprintf("%#v", this.ShardId)+",\n")
-	s = append(s, "SourceCluster: "+fmt.Sprintf("%#v", this.SourceCluster)+",\n")
-	s = append(s, "InclusiveEndMessageId: "+fmt.Sprintf("%#v", this.InclusiveEndMessageId)+",\n")
-	s = append(s, "MaximumPageSize: "+fmt.Sprintf("%#v", this.MaximumPageSize)+",\n")
-	s = append(s, "NextPageToken: "+fmt.Sprintf("%#v", this.NextPageToken)+",\n")
-	s = append(s, "}")
-	return strings.Join(s, "")
+
+var xxx_messageInfo_PauseWorkflowExecutionResponse proto.InternalMessageInfo
+
+type UnpauseWorkflowExecutionRequest struct {
+	Namespace string                `protobuf:"bytes,1,opt,name=namespace,proto3" json:"namespace,omitempty"`
+	Execution *v1.WorkflowExecution `protobuf:"bytes,2,opt,name=execution,proto3" json:"execution,omitempty"`
+	Identity  string                `protobuf:"bytes,3,opt,name=identity,proto3" json:"identity,omitempty"`
 }
-func (this *GetDLQMessagesResponse) GoString() string {
-	if this == nil {
-		return "nil"
-	}
-	s := make([]string, 0, 7)
-	s = append(s, "&adminservice.GetDLQMessagesResponse{")
-	s = append(s, "Type: "+fmt.Sprintf("%#v", this.Type)+",\n")
-	if this.ReplicationTasks != nil {
-		s = append(s, "ReplicationTasks: "+fmt.Sprintf("%#v", this.ReplicationTasks)+",\n")
-	}
-	s = append(s, "NextPageToken: "+fmt.Sprintf("%#v", this.NextPageToken)+",\n")
-	s = append(s, "}")
-	return strings.Join(s, "")
+
+func (m *UnpauseWorkflowExecutionRequest) Reset()      { *m = UnpauseWorkflowExecutionRequest{} }
+func (*UnpauseWorkflowExecutionRequest) ProtoMessage() {}
+func (*UnpauseWorkflowExecutionRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_cc07c1a2abe7cb51, []int{69}
 }
-func (this *PurgeDLQMessagesRequest) GoString() string {
-	if this == nil {
-		return "nil"
-	}
-	s := make([]string, 0, 8)
-	s = append(s, "&adminservice.PurgeDLQMessagesRequest{")
-	s = append(s, "Type: "+fmt.Sprintf("%#v", this.Type)+",\n")
-	s = append(s, "ShardId: "+fmt.Sprintf("%#v", this.ShardId)+",\n")
-	s = append(s, "SourceCluster: "+fmt.Sprintf("%#v", this.SourceCluster)+",\n")
-	s = append(s, "InclusiveEndMessageId: "+fmt.Sprintf("%#v", this.InclusiveEndMessageId)+",\n")
-	s = append(s, "}")
-	return strings.Join(s, "")
+func (m *UnpauseWorkflowExecutionRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
-func (this *PurgeDLQMessagesResponse) GoString() string {
-	if this == nil {
-		return "nil"
+func (m *UnpauseWorkflowExecutionRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_UnpauseWorkflowExecutionRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	s := make([]string, 0, 4)
-	s = append(s, "&adminservice.PurgeDLQMessagesResponse{")
-	s = append(s, "}")
-	return strings.Join(s, "")
 }
-func (this *MergeDLQMessagesRequest) GoString() string {
-	if this == nil {
-		return "nil"
-	}
-	s := make([]string, 0, 10)
-	s = append(s, "&adminservice.MergeDLQMessagesRequest{")
-	s = append(s, "Type: "+fmt.Sprintf("%#v", this.Type)+",\n")
-	s = append(s, "ShardId: "+fmt.Sprintf("%#v", this.ShardId)+",\n")
-	s = append(s, "SourceCluster: "+fmt.Sprintf("%#v", this.SourceCluster)+",\n")
-	s = append(s, "InclusiveEndMessageId: "+fmt.Sprintf("%#v", this.InclusiveEndMessageId)+",\n")
-	s = append(s, "MaximumPageSize: "+fmt.Sprintf("%#v", this.MaximumPageSize)+",\n")
-	s = append(s, "NextPageToken: "+fmt.Sprintf("%#v", this.NextPageToken)+",\n")
-	s = append(s, "}")
-	return strings.Join(s, "")
+func (m *UnpauseWorkflowExecutionRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_UnpauseWorkflowExecutionRequest.Merge(m, src)
 }
-func (this *MergeDLQMessagesResponse) GoString() string {
-	if this == nil {
-		return "nil"
-	}
-	s := make([]string, 0, 5)
-	s = append(s, "&adminservice.MergeDLQMessagesResponse{")
-	s = append(s, "NextPageToken: "+fmt.Sprintf("%#v", this.NextPageToken)+",\n")
-	s = append(s, "}")
-	return strings.Join(s, "")
+func (m *UnpauseWorkflowExecutionRequest) XXX_Size() int {
+	return m.Size()
 }
-func (this *RefreshWorkflowTasksRequest) GoString() string {
-	if this == nil {
-		return "nil"
-	}
-	s := make([]string, 0, 6)
-	s = append(s, "&adminservice.RefreshWorkflowTasksRequest{")
-	s = append(s, "Namespace: "+fmt.Sprintf("%#v", this.Namespace)+",\n")
-	if this.Execution != nil {
-		s = append(s, "Execution: "+fmt.Sprintf("%#v", this.Execution)+",\n")
-	}
-	s = append(s, "}")
-	return strings.Join(s, "")
+func (m *UnpauseWorkflowExecutionRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_UnpauseWorkflowExecutionRequest.DiscardUnknown(m)
 }
-func (this *RefreshWorkflowTasksResponse) GoString() string {
-	if this == nil {
-		return "nil"
+
+var xxx_messageInfo_UnpauseWorkflowExecutionRequest proto.InternalMessageInfo
+
+func (m *UnpauseWorkflowExecutionRequest) GetNamespace() string {
+	if m != nil {
+		return m.Namespace
 	}
-	s := make([]string, 0, 4)
-	s = append(s, "&adminservice.RefreshWorkflowTasksResponse{")
-	s = append(s, "}")
-	return strings.Join(s, "")
+	return ""
 }
-func (this *ResendReplicationTasksRequest) GoString() string {
-	if this == nil {
-		return "nil"
+
+func (m *UnpauseWorkflowExecutionRequest) GetExecution() *v1.WorkflowExecution {
+	if m != nil {
+		return m.Execution
 	}
-	s := make([]string, 0, 12)
-	s = append(s, "&adminservice.ResendReplicationTasksRequest{")
-	s = append(s, "NamespaceId: "+fmt.Sprintf("%#v", this.NamespaceId)+",\n")
-	s = append(s, "WorkflowId: "+fmt.Sprintf("%#v", this.WorkflowId)+",\n")
-	s = append(s, "RunId: "+fmt.Sprintf("%#v", this.RunId)+",\n")
-	s = append(s, "RemoteCluster: "+fmt.Sprintf("%#v", this.RemoteCluster)+",\n")
-	s = append(s, "StartEventId: "+fmt.Sprintf("%#v", this.StartEventId)+",\n")
-	s = append(s, "StartVersion: "+fmt.Sprintf("%#v", this.StartVersion)+",\n")
-	s = append(s, "EndEventId: "+fmt.Sprintf("%#v", this.EndEventId)+",\n")
-	s = append(s, "EndVersion: "+fmt.Sprintf("%#v", this.EndVersion)+",\n")
-	s = append(s, "}")
-	return strings.Join(s, "")
+	return nil
 }
-func (this *ResendReplicationTasksResponse) GoString() string {
-	if this == nil {
-		return "nil"
-	}
-	s := make([]string, 0, 4)
-	s = append(s, "&adminservice.ResendReplicationTasksResponse{")
-	s = append(s, "}")
-	return strings.Join(s, "")
-}
-func valueToGoStringRequestResponse(v interface{}, typ string) string {
-	rv := reflect.ValueOf(v)
-	if rv.IsNil() {
-		return "nil"
-	}
-	pv := reflect.Indirect(rv).Interface()
-	return fmt.Sprintf("func(v %v) *%v { return &v } ( %#v )", typ, typ, pv)
-}
-func (m *DescribeWorkflowExecutionRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+
+func (m *UnpauseWorkflowExecutionRequest) GetIdentity() string {
+	if m != nil {
+		return m.Identity
 	}
-	return dAtA[:n], nil
+	return ""
 }
 
-func (m *DescribeWorkflowExecutionRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+type UnpauseWorkflowExecutionResponse struct {
 }
 
-func (m *DescribeWorkflowExecutionRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Execution != nil {
-		{
-			size, err := m.Execution.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintRequestResponse(dAtA, i, uint64(size))
+func (m *UnpauseWorkflowExecutionResponse) Reset()      { *m = UnpauseWorkflowExecutionResponse{} }
+func (*UnpauseWorkflowExecutionResponse) ProtoMessage() {}
+func (*UnpauseWorkflowExecutionResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_cc07c1a2abe7cb51, []int{70}
+}
+func (m *UnpauseWorkflowExecutionResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *UnpauseWorkflowExecutionResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_UnpauseWorkflowExecutionResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
-		i--
-		dAtA[i] = 0x12
-	}
-	if len(m.Namespace) > 0 {
-		i -= len(m.Namespace)
-		copy(dAtA[i:], m.Namespace)
-		i = encodeVarintRequestResponse(dAtA, i, uint64(len(m.Namespace)))
-		i--
-		dAtA[i] = 0xa
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
+}
+func (m *UnpauseWorkflowExecutionResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_UnpauseWorkflowExecutionResponse.Merge(m, src)
+}
+func (m *UnpauseWorkflowExecutionResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *UnpauseWorkflowExecutionResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_UnpauseWorkflowExecutionResponse.DiscardUnknown(m)
 }
 
-func (m *DescribeWorkflowExecutionResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+var xxx_messageInfo_UnpauseWorkflowExecutionResponse proto.InternalMessageInfo
+
+func init() {
+	proto.RegisterType((*DescribeWorkflowExecutionRequest)(nil), "temporal.server.api.adminservice.v1.DescribeWorkflowExecutionRequest")
+	proto.RegisterType((*DescribeWorkflowExecutionResponse)(nil), "temporal.server.api.adminservice.v1.DescribeWorkflowExecutionResponse")
+	proto.RegisterType((*DescribeHistoryHostRequest)(nil), "temporal.server.api.adminservice.v1.DescribeHistoryHostRequest")
+	proto.RegisterType((*DescribeHistoryHostResponse)(nil), "temporal.server.api.adminservice.v1.DescribeHistoryHostResponse")
+	proto.RegisterType((*CloseShardRequest)(nil), "temporal.server.api.adminservice.v1.CloseShardRequest")
+	proto.RegisterType((*CloseShardResponse)(nil), "temporal.server.api.adminservice.v1.CloseShardResponse")
+	proto.RegisterType((*RemoveTaskRequest)(nil), "temporal.server.api.adminservice.v1.RemoveTaskRequest")
+	proto.RegisterType((*RemoveTaskResponse)(nil), "temporal.server.api.adminservice.v1.RemoveTaskResponse")
+	proto.RegisterType((*GetWorkflowExecutionRawHistoryV2Request)(nil), "temporal.server.api.adminservice.v1.GetWorkflowExecutionRawHistoryV2Request")
+	proto.RegisterType((*GetWorkflowExecutionRawHistoryV2Response)(nil), "temporal.server.api.adminservice.v1.GetWorkflowExecutionRawHistoryV2Response")
+	proto.RegisterType((*GetReplicationMessagesRequest)(nil), "temporal.server.api.adminservice.v1.GetReplicationMessagesRequest")
+	proto.RegisterType((*GetReplicationMessagesResponse)(nil), "temporal.server.api.adminservice.v1.GetReplicationMessagesResponse")
+	proto.RegisterMapType((map[int32]*v14.ReplicationMessages)(nil), "temporal.server.api.adminservice.v1.GetReplicationMessagesResponse.ShardMessagesEntry")
+	proto.RegisterType((*GetNamespaceReplicationMessagesRequest)(nil), "temporal.server.api.adminservice.v1.GetNamespaceReplicationMessagesRequest")
+	proto.RegisterType((*GetNamespaceReplicationMessagesResponse)(nil), "temporal.server.api.adminservice.v1.GetNamespaceReplicationMessagesResponse")
+	proto.RegisterType((*GetDLQReplicationMessagesRequest)(nil), "temporal.server.api.adminservice.v1.GetDLQReplicationMessagesRequest")
+	proto.RegisterType((*GetDLQReplicationMessagesResponse)(nil), "temporal.server.api.adminservice.v1.GetDLQReplicationMessagesResponse")
+	proto.RegisterType((*ReapplyEventsRequest)(nil), "temporal.server.api.adminservice.v1.ReapplyEventsRequest")
+	proto.RegisterType((*ReapplyEventsResponse)(nil), "temporal.server.api.adminservice.v1.ReapplyEventsResponse")
+	proto.RegisterType((*AddSearchAttributeRequest)(nil), "temporal.server.api.adminservice.v1.AddSearchAttributeRequest")
+	proto.RegisterMapType((map[string]v15.IndexedValueType)(nil), "temporal.server.api.adminservice.v1.AddSearchAttributeRequest.SearchAttributeEntry")
+	proto.RegisterType((*AddSearchAttributeResponse)(nil), "temporal.server.api.adminservice.v1.AddSearchAttributeResponse")
+	proto.RegisterType((*DescribeClusterRequest)(nil), "temporal.server.api.adminservice.v1.DescribeClusterRequest")
+	proto.RegisterType((*DescribeClusterResponse)(nil), "temporal.server.api.adminservice.v1.DescribeClusterResponse")
+	proto.RegisterMapType((map[string]string)(nil), "temporal.server.api.adminservice.v1.DescribeClusterResponse.SupportedClientsEntry")
+	proto.RegisterType((*GetDLQMessagesRequest)(nil), "temporal.server.api.adminservice.v1.GetDLQMessagesRequest")
+	proto.RegisterType((*GetDLQMessagesResponse)(nil), "temporal.server.api.adminservice.v1.GetDLQMessagesResponse")
+	proto.RegisterType((*PurgeDLQMessagesRequest)(nil), "temporal.server.api.adminservice.v1.PurgeDLQMessagesRequest")
+	proto.RegisterType((*PurgeDLQMessagesResponse)(nil), "temporal.server.api.adminservice.v1.PurgeDLQMessagesResponse")
+	proto.RegisterType((*MergeDLQMessagesRequest)(nil), "temporal.server.api.adminservice.v1.MergeDLQMessagesRequest")
+	proto.RegisterType((*MergeDLQMessagesResponse)(nil), "temporal.server.api.adminservice.v1.MergeDLQMessagesResponse")
+	proto.RegisterType((*RefreshWorkflowTasksRequest)(nil), "temporal.server.api.adminservice.v1.RefreshWorkflowTasksRequest")
+	proto.RegisterType((*RefreshWorkflowTasksResponse)(nil), "temporal.server.api.adminservice.v1.RefreshWorkflowTasksResponse")
+	proto.RegisterType((*ResendReplicationTasksRequest)(nil), "temporal.server.api.adminservice.v1.ResendReplicationTasksRequest")
+	proto.RegisterType((*ResendReplicationTasksResponse)(nil), "temporal.server.api.adminservice.v1.ResendReplicationTasksResponse")
+	proto.RegisterType((*CreateScheduleRequest)(nil), "temporal.server.api.adminservice.v1.CreateScheduleRequest")
+	proto.RegisterType((*CreateScheduleResponse)(nil), "temporal.server.api.adminservice.v1.CreateScheduleResponse")
+	proto.RegisterType((*DescribeScheduleRequest)(nil), "temporal.server.api.adminservice.v1.DescribeScheduleRequest")
+	proto.RegisterType((*DescribeScheduleResponse)(nil), "temporal.server.api.adminservice.v1.DescribeScheduleResponse")
+	proto.RegisterType((*UpdateScheduleRequest)(nil), "temporal.server.api.adminservice.v1.UpdateScheduleRequest")
+	proto.RegisterType((*UpdateScheduleResponse)(nil), "temporal.server.api.adminservice.v1.UpdateScheduleResponse")
+	proto.RegisterType((*PauseScheduleRequest)(nil), "temporal.server.api.adminservice.v1.PauseScheduleRequest")
+	proto.RegisterType((*PauseScheduleResponse)(nil), "temporal.server.api.adminservice.v1.PauseScheduleResponse")
+	proto.RegisterType((*UnpauseScheduleRequest)(nil), "temporal.server.api.adminservice.v1.UnpauseScheduleRequest")
+	proto.RegisterType((*UnpauseScheduleResponse)(nil), "temporal.server.api.adminservice.v1.UnpauseScheduleResponse")
+	proto.RegisterType((*TriggerScheduleRequest)(nil), "temporal.server.api.adminservice.v1.TriggerScheduleRequest")
+	proto.RegisterType((*TriggerScheduleResponse)(nil), "temporal.server.api.adminservice.v1.TriggerScheduleResponse")
+	proto.RegisterType((*DeleteScheduleRequest)(nil), "temporal.server.api.adminservice.v1.DeleteScheduleRequest")
+	proto.RegisterType((*DeleteScheduleResponse)(nil), "temporal.server.api.adminservice.v1.DeleteScheduleResponse")
+	proto.RegisterType((*ListSchedulesRequest)(nil), "temporal.server.api.adminservice.v1.ListSchedulesRequest")
+	proto.RegisterType((*ListSchedulesResponse)(nil), "temporal.server.api.adminservice.v1.ListSchedulesResponse")
+	proto.RegisterType((*DeleteWorkflowExecutionRequest)(nil), "temporal.server.api.adminservice.v1.DeleteWorkflowExecutionRequest")
+	proto.RegisterType((*DeleteWorkflowExecutionResponse)(nil), "temporal.server.api.adminservice.v1.DeleteWorkflowExecutionResponse")
+	proto.RegisterType((*BatchResetParams)(nil), "temporal.server.api.adminservice.v1.BatchResetParams")
+	proto.RegisterType((*StartBatchOperationRequest)(nil), "temporal.server.api.adminservice.v1.StartBatchOperationRequest")
+	proto.RegisterType((*StartBatchOperationResponse)(nil), "temporal.server.api.adminservice.v1.StartBatchOperationResponse")
+	proto.RegisterType((*StopBatchOperationRequest)(nil), "temporal.server.api.adminservice.v1.StopBatchOperationRequest")
+	proto.RegisterType((*StopBatchOperationResponse)(nil), "temporal.server.api.adminservice.v1.StopBatchOperationResponse")
+	proto.RegisterType((*DescribeBatchOperationRequest)(nil), "temporal.server.api.adminservice.v1.DescribeBatchOperationRequest")
+	proto.RegisterType((*DescribeBatchOperationResponse)(nil), "temporal.server.api.adminservice.v1.DescribeBatchOperationResponse")
+	proto.RegisterType((*ListBatchOperationsRequest)(nil), "temporal.server.api.adminservice.v1.ListBatchOperationsRequest")
+	proto.RegisterType((*BatchOperationInfo)(nil), "temporal.server.api.adminservice.v1.BatchOperationInfo")
+	proto.RegisterType((*ListBatchOperationsResponse)(nil), "temporal.server.api.adminservice.v1.ListBatchOperationsResponse")
+	proto.RegisterType((*UpdateWorkflowExecutionRequest)(nil), "temporal.server.api.adminservice.v1.UpdateWorkflowExecutionRequest")
+	proto.RegisterType((*UpdateWorkflowExecutionResponse)(nil), "temporal.server.api.adminservice.v1.UpdateWorkflowExecutionResponse")
+	proto.RegisterType((*GetWorkflowExecutionResultRequest)(nil), "temporal.server.api.adminservice.v1.GetWorkflowExecutionResultRequest")
+	proto.RegisterType((*GetWorkflowExecutionResultResponse)(nil), "temporal.server.api.adminservice.v1.GetWorkflowExecutionResultResponse")
+	proto.RegisterType((*ResetWorkflowExecutionWithOptionsRequest)(nil), "temporal.server.api.adminservice.v1.ResetWorkflowExecutionWithOptionsRequest")
+	proto.RegisterType((*ResetWorkflowExecutionWithOptionsResponse)(nil), "temporal.server.api.adminservice.v1.ResetWorkflowExecutionWithOptionsResponse")
+	proto.RegisterType((*ResetWorkflowExecutionPreview)(nil), "temporal.server.api.adminservice.v1.ResetWorkflowExecutionPreview")
+	proto.RegisterType((*PauseWorkflowExecutionRequest)(nil), "temporal.server.api.adminservice.v1.PauseWorkflowExecutionRequest")
+	proto.RegisterType((*PauseWorkflowExecutionResponse)(nil), "temporal.server.api.adminservice.v1.PauseWorkflowExecutionResponse")
+	proto.RegisterType((*UnpauseWorkflowExecutionRequest)(nil), "temporal.server.api.adminservice.v1.UnpauseWorkflowExecutionRequest")
+	proto.RegisterType((*UnpauseWorkflowExecutionResponse)(nil), "temporal.server.api.adminservice.v1.UnpauseWorkflowExecutionResponse")
 }
 
-func (m *DescribeWorkflowExecutionResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func init() {
+	proto.RegisterFile("temporal/server/api/adminservice/v1/request_response.proto", fileDescriptor_cc07c1a2abe7cb51)
 }
 
-func (m *DescribeWorkflowExecutionResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.BranchId) > 0 {
-		i -= len(m.BranchId)
-		copy(dAtA[i:], m.BranchId)
-		i = encodeVarintRequestResponse(dAtA, i, uint64(len(m.BranchId)))
-		i--
-		dAtA[i] = 0x32
-	}
-	if len(m.TreeId) > 0 {
-		i -= len(m.TreeId)
-		copy(dAtA[i:], m.TreeId)
-		i = encodeVarintRequestResponse(dAtA, i, uint64(len(m.TreeId)))
-		i--
-		dAtA[i] = 0x2a
+var fileDescriptor_cc07c1a2abe7cb51 = []byte{
+	// 3342 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xdd, 0x1b, 0x5b, 0x6c, 0x1c, 0x57,
+	0xb5, 0xb3, 0xeb, 0xc7, 0xee, 0xf1, 0x7b, 0xea, 0xc7, 0x66, 0x13, 0x3b, 0xce, 0xf4, 0x99, 0x42,
+	0xd7, 0x8d, 0x5b, 0xd2, 0x50, 0x84, 0x8a, 0xbd, 0x49, 0xda, 0x48, 0x79, 0xb8, 0xe3, 0x34, 0xad,
+	0x2a, 0x60, 0x98, 0xdd, 0xbd, 0xb6, 0x07, 0xcf, 0xce, 0x0c, 0x33, 0xb3, 0x76, 0x5c, 0x89, 0x96,
+	0x0f, 0x10, 0x54, 0x42, 0x28, 0xfc, 0x22, 0x21, 0x21, 0x01, 0x12, 0x08, 0x21, 0x7e, 0x11, 0x7f,
+	0x88, 0x9f, 0x4a, 0xfc, 0x54, 0x7c, 0x55, 0x80, 0x54, 0x5a, 0x7e, 0xe0, 0x8f, 0xaf, 0xfe, 0x21,
+	0x38, 0xf7, 0x35, 0xaf, 0x9d, 0x5d, 0xaf, 0x1b, 0x93, 0x84, 0x7e, 0x58, 0xd9, 0x7b, 0xee, 0x39,
+	0xe7, 0x9e, 0xd7, 0x3d, 0xf7, 0xdc, 0x73, 0x27, 0xf0, 0x42, 0x48, 0xda, 0x9e, 0xeb, 0x9b, 0xf6,
+	0x4a, 0x40, 0xfc, 0x3d, 0xe2, 0xaf, 0x98, 0x9e, 0xb5, 0x62, 0xb6, 0xda, 0x96, 0x43, 0xc7, 0x56,
+	0x93, 0xac, 0xec, 0x9d, 0x5b, 0xf1, 0xc9, 0x37, 0x3a, 0x24, 0x08, 0x0d, 0x9f, 0x04, 0x9e, 0x8b,
+	0x13, 0x35, 0xcf, 0x77, 0x43, 0x57, 0x7d, 0x44, 0xd2, 0xd6, 0x38, 0x6d, 0x0d, 0x69, 0x6b, 0x49,
+	0xda, 0xda, 0xde, 0xb9, 0xea, 0xe9, 0x6d, 0xd7, 0xdd, 0xb6, 0xc9, 0x0a, 0x23, 0x69, 0x74, 0xb6,
+	0x56, 0x42, 0xab, 0x8d, 0xbc, 0xcc, 0xb6, 0xc7, 0xb9, 0x54, 0xcf, 0xb4, 0x88, 0x47, 0x9c, 0x16,
+	0x71, 0x9a, 0x16, 0x09, 0x56, 0xb6, 0xdd, 0x6d, 0x97, 0xc1, 0xd9, 0x2f, 0x81, 0xa2, 0x45, 0x42,
+	0x52, 0xe9, 0x88, 0xd3, 0x69, 0x07, 0x54, 0xac, 0xa6, 0xdb, 0x6e, 0xbb, 0x8e, 0xc0, 0x79, 0x34,
+	0x85, 0xc3, 0xa7, 0x28, 0x12, 0x2e, 0x16, 0x98, 0xdb, 0x42, 0xe4, 0xea, 0x67, 0xf3, 0xd4, 0x6d,
+	0xda, 0x9d, 0x20, 0xc4, 0xdf, 0x5d, 0xd8, 0x67, 0xf3, 0xb0, 0xf3, 0x97, 0x7f, 0xa2, 0x2f, 0x6a,
+	0x68, 0x06, 0xbb, 0x02, 0xb1, 0x96, 0x87, 0xe8, 0x98, 0xb8, 0xb0, 0x67, 0x72, 0x6b, 0x0f, 0x20,
+	0xf1, 0x8e, 0x15, 0x84, 0xae, 0x7f, 0xd0, 0x8d, 0xfd, 0x4c, 0x1e, 0xb6, 0x4f, 0x3c, 0xdb, 0x6a,
+	0x9a, 0xa1, 0x95, 0x67, 0x91, 0xcf, 0xf4, 0x15, 0x3c, 0x68, 0xee, 0x90, 0x56, 0xc7, 0x96, 0xc8,
+	0x4f, 0xe7, 0x21, 0x4b, 0x9c, 0x6e, 0xde, 0x8f, 0xa5, 0x7c, 0xb2, 0x65, 0x5a, 0x76, 0xc7, 0xcf,
+	0x41, 0x7b, 0x34, 0xdf, 0xbd, 0xfb, 0xae, 0xbf, 0xbb, 0x65, 0xbb, 0xfb, 0xb9, 0xcc, 0x7a, 0x5a,
+	0xe0, 0xf1, 0x14, 0x9a, 0xe4, 0xd1, 0x85, 0xa7, 0xbd, 0xa3, 0xc0, 0xf2, 0x45, 0x12, 0x34, 0x7d,
+	0xab, 0x41, 0x5e, 0x13, 0x58, 0x97, 0x6e, 0x93, 0x66, 0x87, 0x9a, 0x49, 0xe7, 0x01, 0xaf, 0x9e,
+	0x82, 0x72, 0xe4, 0x9a, 0x8a, 0xb2, 0xac, 0x3c, 0x59, 0xd6, 0x63, 0x80, 0xfa, 0x12, 0x94, 0x89,
+	0xa4, 0xa8, 0x14, 0x70, 0x76, 0x6c, 0xf5, 0x6c, 0xe4, 0x5e, 0xb6, 0x19, 0x44, 0x88, 0xec, 0x9d,
+	0xab, 0x75, 0x2f, 0x11, 0xd3, 0x6a, 0xff, 0x56, 0xe0, 0x4c, 0x1f, 0x59, 0xf8, 0xa6, 0x53, 0x4f,
+	0x40, 0x29, 0xd8, 0x31, 0xfd, 0x96, 0x61, 0xb5, 0x84, 0x2c, 0xa3, 0x6c, 0x7c, 0xa5, 0xa5, 0x9e,
+	0x81, 0x71, 0x61, 0x10, 0xc3, 0x6c, 0xb5, 0x7c, 0x26, 0x4c, 0x59, 0x1f, 0x13, 0xb0, 0x35, 0x04,
+	0xa9, 0x35, 0x78, 0xb8, 0x69, 0xa2, 0xa7, 0x8c, 0x76, 0x27, 0x34, 0x1b, 0x36, 0x31, 0x70, 0x0f,
+	0x86, 0xa4, 0x52, 0x64, 0x98, 0x33, 0x6c, 0xea, 0x1a, 0x9f, 0xd9, 0xa4, 0x13, 0xea, 0x73, 0x30,
+	0xdf, 0x32, 0x71, 0x6c, 0x06, 0x59, 0x92, 0x21, 0x46, 0x32, 0x2b, 0x67, 0x53, 0x54, 0x0b, 0x30,
+	0x1a, 0xfa, 0x84, 0x50, 0x11, 0x87, 0x19, 0xda, 0x08, 0x1d, 0xa2, 0x84, 0x27, 0xa1, 0xdc, 0xf0,
+	0x4d, 0xa7, 0xb9, 0x43, 0xa7, 0x46, 0xd8, 0x54, 0x89, 0x03, 0xae, 0xb4, 0xb4, 0x3f, 0x29, 0x50,
+	0x95, 0xfa, 0xbf, 0xcc, 0x65, 0x7e, 0xd9, 0x0d, 0x42, 0xe9, 0x05, 0xaa, 0x1d, 0x0e, 0x99, 0x6a,
+	0xe8, 0x43, 0xa1, 0xfc, 0x18, 0x85, 0xad, 0x71, 0x50, 0xca, 0x36, 0x54, 0xf9, 0xe1, 0xd8, 0x36,
+	0x29, 0x1f, 0x16, 0xb3, 0x3e, 0x7c, 0x1d, 0x54, 0x19, 0x23, 0x46, 0xec, 0xcc, 0xa1, 0xa3, 0x3a,
+	0x73, 0x66, 0x3f, 0x0b, 0xd2, 0xee, 0x14, 0xe0, 0x64, 0xae, 0x52, 0xc2, 0x9d, 0x8f, 0xc0, 0x04,
+	0x13, 0x31, 0x30, 0x30, 0xe0, 0x1b, 0xc4, 0x67, 0x6a, 0x0d, 0xeb, 0xe3, 0x1c, 0x78, 0x9d, 0xc1,
+	0xa8, 0xd9, 0xa4, 0x5e, 0x01, 0x2a, 0x56, 0x44, 0x84, 0x92, 0x50, 0x2c, 0x50, 0xbf, 0x02, 0x53,
+	0x91, 0x22, 0x06, 0xf3, 0x20, 0xd3, 0x6f, 0x6c, 0xf5, 0xb9, 0x5a, 0x5e, 0x66, 0x8e, 0x70, 0xa9,
+	0x0a, 0xd7, 0xe5, 0xa0, 0x4e, 0xe9, 0xae, 0x38, 0x5b, 0xae, 0x3e, 0xe9, 0xa4, 0x60, 0xea, 0x79,
+	0x58, 0xe0, 0x6b, 0x37, 0x5d, 0x27, 0xf4, 0x5d, 0xdb, 0x26, 0x3e, 0x8b, 0x80, 0x4e, 0x20, 0x42,
+	0x60, 0x8e, 0x4d, 0xd7, 0xa3, 0xd9, 0x4d, 0x36, 0xa9, 0x56, 0x60, 0x54, 0x7a, 0x8a, 0xc7, 0x80,
+	0x1c, 0x6a, 0x35, 0x98, 0xa9, 0xdb, 0x6e, 0x40, 0x36, 0x29, 0x9d, 0xf4, 0x6e, 0x36, 0xac, 0x63,
+	0xd7, 0x69, 0xb3, 0xa0, 0x26, 0xf1, 0xb9, 0xe1, 0xb4, 0x3f, 0x2b, 0x30, 0xa3, 0x93, 0xb6, 0xbb,
+	0x47, 0x6e, 0x62, 0x5a, 0x3d, 0x9c, 0x8d, 0x7a, 0x19, 0x4a, 0x98, 0xfd, 0xc8, 0x36, 0x7a, 0x80,
+	0x05, 0xc7, 0xe4, 0xea, 0x53, 0xb9, 0x06, 0x62, 0x99, 0x87, 0x1a, 0x87, 0xf2, 0xad, 0x0b, 0x0a,
+	0x3d, 0xa2, 0x65, 0xc1, 0x8d, 0x33, 0x74, 0x05, 0x6a, 0xe7, 0x22, 0x06, 0x37, 0x0e, 0x71, 0x81,
+	0x2b, 0x30, 0xb5, 0x67, 0x05, 0x56, 0xc3, 0xb2, 0xad, 0xf0, 0xc0, 0xa0, 0x07, 0x9c, 0x88, 0xa0,
+	0x6a, 0x8d, 0x9f, 0x7e, 0x35, 0x79, 0xfa, 0xd5, 0x6e, 0xca, 0xd3, 0x6f, 0x7d, 0xe8, 0xce, 0x07,
+	0xa7, 0x15, 0x7d, 0x32, 0x26, 0xa4, 0x53, 0x54, 0xe5, 0xa4, 0x6e, 0x42, 0xe5, 0xef, 0x15, 0xe1,
+	0x89, 0x97, 0x48, 0xd8, 0x1d, 0x77, 0xe6, 0xbe, 0x08, 0xad, 0x5b, 0xab, 0xf7, 0x36, 0x67, 0xa9,
+	0x8f, 0xc2, 0x24, 0xea, 0xe1, 0x87, 0x06, 0xd9, 0x23, 0x4e, 0x18, 0xdb, 0x64, 0x9c, 0x41, 0x2f,
+	0x51, 0x20, 0x5a, 0x06, 0xb3, 0x4e, 0x12, 0x0b, 0x2d, 0x1d, 0xc8, 0xfd, 0x55, 0xd4, 0x67, 0x62,
+	0xd4, 0x5b, 0x7c, 0x42, 0x5d, 0x86, 0x71, 0xac, 0x05, 0x62, 0x9e, 0xc3, 0x0c, 0x11, 0x10, 0x26,
+	0x39, 0x3e, 0x05, 0x33, 0x31, 0x86, 0xe4, 0x37, 0xc2, 0xd0, 0xa6, 0x24, 0x9a, 0xe4, 0x86, 0xb8,
+	0x6d, 0xf3, 0xb6, 0xd5, 0xee, 0xb4, 0x0d, 0x0f, 0x33, 0xbf, 0x11, 0x58, 0x6f, 0x92, 0xca, 0x28,
+	0x0b, 0x8e, 0x29, 0x31, 0xb1, 0x81, 0xf0, 0x4d, 0x04, 0xab, 0x8f, 0xe3, 0x66, 0x22, 0xb7, 0x43,
+	0x8e, 0x18, 0xba, 0xbb, 0xc4, 0xa9, 0x94, 0x10, 0x73, 0x5c, 0x9f, 0xa0, 0x60, 0x8a, 0x76, 0x93,
+	0x02, 0xb5, 0x8f, 0x15, 0x78, 0xf2, 0x70, 0x57, 0x88, 0x3d, 0x9e, 0xc3, 0x54, 0xc9, 0x61, 0x4a,
+	0x03, 0x48, 0xe6, 0xef, 0x86, 0x19, 0xe2, 0xe6, 0xe3, 0x9b, 0x7d, 0x6c, 0x75, 0xb9, 0x97, 0x6f,
+	0x2e, 0x62, 0xf6, 0x5d, 0xb7, 0xdd, 0x86, 0x3e, 0x29, 0x08, 0xd7, 0x39, 0x9d, 0xfa, 0x1a, 0xc6,
+	0x22, 0x57, 0xdf, 0x10, 0x33, 0x22, 0x29, 0xd4, 0x72, 0x63, 0x5e, 0xe0, 0x50, 0x96, 0xc2, 0x6a,
+	0x42, 0x0b, 0x8c, 0xcc, 0xd4, 0x58, 0xbb, 0xa3, 0xc0, 0x22, 0x2a, 0xae, 0xc7, 0xc5, 0xc4, 0x35,
+	0x7e, 0xa0, 0x06, 0x32, 0xf2, 0xae, 0xc2, 0x08, 0xd3, 0x91, 0x66, 0xe8, 0x62, 0xcf, 0x34, 0x94,
+	0xa8, 0x46, 0xe8, 0xaa, 0x09, 0x7e, 0xcc, 0x16, 0xba, 0xe0, 0x41, 0xb3, 0xbe, 0x28, 0xcc, 0x0c,
+	0x1a, 0xbe, 0xf2, 0x4c, 0x13, 0x30, 0x9a, 0xbf, 0xb4, 0x1f, 0x15, 0x60, 0xa9, 0x97, 0x48, 0xc2,
+	0x03, 0xdf, 0xc4, 0x30, 0x65, 0x69, 0x41, 0x9c, 0xfe, 0x52, 0xb6, 0x5b, 0xb5, 0x01, 0x8a, 0xd7,
+	0x5a, 0x7f, 0xe6, 0x35, 0x96, 0x97, 0x24, 0xf4, 0x12, 0xa6, 0xc1, 0x03, 0x9d, 0xe7, 0x74, 0x09,
+	0xab, 0x1e, 0x80, 0xda, 0x8d, 0xa4, 0x4e, 0x43, 0x71, 0x97, 0x1c, 0x88, 0x34, 0x45, 0x7f, 0xaa,
+	0xd7, 0x60, 0x78, 0xcf, 0xb4, 0x3b, 0x44, 0x6c, 0xc9, 0xe7, 0x8f, 0x68, 0xb9, 0x48, 0x32, 0xce,
+	0xe5, 0x85, 0xc2, 0x05, 0x45, 0xfb, 0xbd, 0x02, 0x8f, 0xa3, 0xfc, 0x51, 0xa2, 0xef, 0xe3, 0xb8,
+	0xcf, 0xc3, 0x09, 0xdb, 0x64, 0xf5, 0x7d, 0xe8, 0x5b, 0xb8, 0xb3, 0x22, 0x6b, 0xc9, 0x64, 0x5a,
+	0xd4, 0xe7, 0x29, 0x82, 0x2e, 0xe7, 0x05, 0x03, 0xdc, 0x8e, 0x92, 0x14, 0x13, 0x5c, 0x13, 0x81,
+	0x69, 0xd2, 0x42, 0x4c, 0xba, 0x21, 0xe7, 0x63, 0xd2, 0xac, 0x83, 0x8b, 0xdd, 0x0e, 0x7e, 0x8b,
+	0xa5, 0xbd, 0xfe, 0x2a, 0x08, 0x47, 0x6f, 0x42, 0x29, 0xe1, 0xe2, 0xbb, 0x32, 0x62, 0xc4, 0x48,
+	0x7b, 0x13, 0x96, 0x71, 0xfd, 0x8b, 0x57, 0x5f, 0xe9, 0x63, 0xbc, 0x5b, 0x00, 0xfc, 0x54, 0xc0,
+	0x33, 0x54, 0x46, 0xd7, 0x51, 0x97, 0xa6, 0xc9, 0x9e, 0x9d, 0xc1, 0xe5, 0x50, 0xfc, 0x0a, 0xb4,
+	0xef, 0x60, 0x51, 0xd8, 0x67, 0x71, 0xa1, 0xf6, 0xd7, 0x60, 0x26, 0xc1, 0xd6, 0xa0, 0xe4, 0x52,
+	0x88, 0x67, 0x3f, 0x81, 0x10, 0xfa, 0xb4, 0x9f, 0x06, 0x04, 0xda, 0xbb, 0x0a, 0xcc, 0xea, 0xc4,
+	0xf4, 0x3c, 0xfb, 0x80, 0x25, 0xd7, 0x60, 0xb0, 0x83, 0x26, 0xbf, 0xb0, 0x2a, 0xdc, 0x7d, 0x61,
+	0xa5, 0x5e, 0x80, 0x11, 0x96, 0xfd, 0x03, 0x91, 0xd8, 0x0e, 0xcf, 0x91, 0x02, 0x5f, 0x5b, 0x80,
+	0xb9, 0x8c, 0x26, 0xe2, 0x7c, 0xfd, 0x4d, 0x01, 0x4e, 0x60, 0x29, 0xb9, 0x49, 0x4c, 0xbf, 0xb9,
+	0xb3, 0x16, 0x62, 0x94, 0x37, 0x3a, 0x21, 0x91, 0x8a, 0xbe, 0x05, 0xd3, 0x01, 0x9b, 0x31, 0x4c,
+	0x39, 0x25, 0x4c, 0xbc, 0x39, 0x50, 0x16, 0xe9, 0xc9, 0xb9, 0x96, 0x01, 0xf3, 0x14, 0x32, 0x15,
+	0xa4, 0xa1, 0xea, 0x63, 0x98, 0xc3, 0x50, 0x79, 0x9f, 0x15, 0x17, 0xec, 0x10, 0xe1, 0xb9, 0x70,
+	0x42, 0x42, 0x59, 0xe2, 0xac, 0xee, 0xc2, 0x6c, 0x1e, 0xbf, 0x64, 0xb6, 0x29, 0xf3, 0x6c, 0xf3,
+	0xc5, 0x64, 0xb6, 0x99, 0x5c, 0x7d, 0x22, 0x6d, 0xc0, 0xa8, 0x0c, 0xba, 0x82, 0xb7, 0xf2, 0xdb,
+	0xa4, 0x75, 0x8b, 0xa2, 0xde, 0x3c, 0xf0, 0x48, 0x32, 0xbb, 0x9c, 0x82, 0x6a, 0x9e, 0x5a, 0xc2,
+	0x9e, 0x15, 0x98, 0x97, 0xa5, 0x6f, 0x9d, 0x6f, 0x67, 0xa1, 0xb1, 0xf6, 0x41, 0x01, 0x16, 0xba,
+	0xa6, 0x44, 0x2c, 0xbf, 0x0d, 0x33, 0x41, 0xc7, 0x43, 0x41, 0x42, 0x4c, 0x23, 0x4d, 0xdb, 0x62,
+	0x3e, 0xe6, 0x86, 0xd6, 0x07, 0x32, 0x74, 0x0f, 0xc6, 0xb5, 0x4d, 0xc9, 0xb5, 0xce, 0x99, 0x72,
+	0x3b, 0x4f, 0x07, 0x19, 0x30, 0x37, 0x34, 0xe5, 0x1e, 0x15, 0x16, 0x91, 0xa1, 0x29, 0x54, 0x96,
+	0x15, 0x78, 0xc4, 0xb6, 0x09, 0x2d, 0xcf, 0x83, 0x1d, 0xcb, 0x63, 0xfb, 0xbe, 0xef, 0x11, 0x2b,
+	0x12, 0x1a, 0x15, 0xf0, 0x5a, 0x44, 0xc6, 0x2b, 0xee, 0x76, 0x6a, 0x5c, 0xad, 0xc3, 0x5c, 0xae,
+	0xa8, 0x39, 0x2e, 0x9c, 0x4d, 0xba, 0xb0, 0x9c, 0xf4, 0xcc, 0xaf, 0x0b, 0x30, 0xc7, 0xf3, 0x46,
+	0x36, 0x53, 0x5d, 0x82, 0xa1, 0x10, 0xdd, 0xc8, 0xd8, 0x4c, 0xae, 0x9e, 0xeb, 0x5f, 0x03, 0x5f,
+	0x24, 0x66, 0xeb, 0x2a, 0x09, 0x51, 0xf0, 0x57, 0x3a, 0x44, 0xf8, 0x9f, 0x91, 0xf7, 0xbb, 0x6b,
+	0x51, 0x03, 0xba, 0x1d, 0x9f, 0x5e, 0x47, 0xb8, 0xd2, 0x22, 0xa9, 0x4f, 0x70, 0xa8, 0xf0, 0x8b,
+	0xfa, 0x3c, 0x54, 0x2c, 0x87, 0x62, 0x58, 0x7b, 0xc4, 0xa0, 0xd5, 0x5c, 0xe2, 0xcc, 0xe0, 0xa5,
+	0xe1, 0x5c, 0x34, 0x7f, 0xc9, 0x49, 0x1c, 0x19, 0xb9, 0x05, 0xdd, 0xf0, 0xc0, 0x05, 0xdd, 0x48,
+	0x5e, 0x41, 0xf7, 0x4f, 0x05, 0xe6, 0xb3, 0xf6, 0x12, 0x01, 0x79, 0x4c, 0x06, 0xcb, 0xcd, 0xd1,
+	0x85, 0x63, 0xcc, 0xd1, 0x79, 0xba, 0x16, 0xf3, 0x74, 0xfd, 0x8b, 0x02, 0x0b, 0x1b, 0x1d, 0x7f,
+	0x9b, 0x7c, 0x1a, 0xa3, 0x43, 0xab, 0x42, 0xa5, 0x5b, 0xb9, 0x38, 0xc3, 0x2f, 0x5c, 0x23, 0x9f,
+	0x52, 0xcd, 0xff, 0x27, 0xfb, 0x62, 0x1d, 0x2a, 0xdd, 0x06, 0x3b, 0xda, 0xbd, 0x46, 0xfb, 0xb6,
+	0x02, 0x27, 0x75, 0xb2, 0x85, 0x97, 0xff, 0x1d, 0x79, 0xb4, 0xb3, 0x80, 0xbd, 0xc7, 0xfd, 0xb5,
+	0x25, 0x38, 0x95, 0x2f, 0x45, 0x1c, 0x1c, 0x8b, 0x38, 0x40, 0x8b, 0x67, 0xb6, 0x5a, 0x90, 0x68,
+	0x41, 0xc5, 0xad, 0x96, 0xa8, 0xff, 0x36, 0x16, 0xc1, 0xd0, 0x07, 0xa7, 0x61, 0x2c, 0x2a, 0x78,
+	0x44, 0x04, 0x94, 0x75, 0x90, 0x20, 0x44, 0x98, 0x83, 0x11, 0xbf, 0xe3, 0xc8, 0x9b, 0x32, 0xe6,
+	0x6c, 0x1c, 0xf1, 0xd8, 0xf0, 0xf1, 0xc6, 0x1f, 0xc6, 0xb1, 0xc1, 0xbb, 0x2b, 0x13, 0x1c, 0x2a,
+	0x63, 0xa3, 0xfb, 0xbe, 0x3d, 0x9c, 0x73, 0xdf, 0xa6, 0x4d, 0x25, 0x86, 0x95, 0xbe, 0x19, 0x73,
+	0xa4, 0x5e, 0x97, 0xec, 0xd1, 0xae, 0x4b, 0x36, 0xea, 0x42, 0x31, 0x24, 0x93, 0x52, 0x84, 0x20,
+	0x58, 0x68, 0xcb, 0xb0, 0xd4, 0xcb, 0x60, 0xc2, 0xa6, 0xf4, 0x18, 0xaa, 0xfb, 0xc4, 0x0c, 0xc9,
+	0xa6, 0xe8, 0x0f, 0x0f, 0xe6, 0x74, 0x5c, 0x5a, 0x36, 0x94, 0x13, 0x66, 0x94, 0x20, 0x94, 0xed,
+	0x12, 0x6e, 0x33, 0x31, 0x12, 0xc7, 0xee, 0xd9, 0xdc, 0x1d, 0x1b, 0xb5, 0xae, 0x31, 0x3a, 0x22,
+	0x11, 0x22, 0x52, 0xbc, 0x2f, 0x4c, 0x58, 0x8e, 0x15, 0x5a, 0xa6, 0x8d, 0x51, 0x8c, 0x57, 0x67,
+	0xd1, 0xb1, 0xa9, 0x0d, 0xcc, 0x6b, 0x83, 0x52, 0xe9, 0xe3, 0x82, 0x09, 0x1b, 0xa9, 0x55, 0x28,
+	0x59, 0x2d, 0x34, 0x21, 0xd6, 0x64, 0xa2, 0xf7, 0x15, 0x8d, 0xd5, 0x45, 0x00, 0xf9, 0x8e, 0x12,
+	0xb5, 0x40, 0xcb, 0x02, 0x82, 0xc9, 0xeb, 0x45, 0x98, 0xcf, 0x9a, 0x4b, 0x6c, 0x36, 0x0c, 0x90,
+	0xa6, 0xeb, 0x6c, 0xa1, 0x99, 0xc3, 0xc4, 0x5e, 0x2b, 0xea, 0x13, 0x12, 0xca, 0xf7, 0xda, 0xeb,
+	0x71, 0x61, 0x75, 0xbc, 0x16, 0xd7, 0xfe, 0xa8, 0x40, 0xa5, 0x9b, 0x75, 0x74, 0x46, 0xc6, 0xee,
+	0x50, 0x3e, 0xb9, 0x3b, 0xd6, 0x60, 0x88, 0x15, 0x52, 0x7c, 0x9b, 0x3f, 0x3d, 0x30, 0x0b, 0x56,
+	0x47, 0x31, 0xd2, 0x1c, 0x3b, 0x15, 0xf3, 0xec, 0xf4, 0x1f, 0x05, 0xe6, 0x5e, 0xf5, 0x5a, 0x0f,
+	0x6c, 0x60, 0x76, 0xab, 0x31, 0x94, 0xa3, 0xc6, 0xdd, 0x84, 0x1a, 0x56, 0xe7, 0x59, 0x03, 0x88,
+	0x4d, 0xfb, 0x5d, 0xbc, 0xeb, 0x6d, 0x98, 0x9d, 0xe0, 0xb8, 0x4d, 0x83, 0xd5, 0xaa, 0x83, 0xb9,
+	0x2c, 0x90, 0x99, 0x8f, 0x0d, 0x52, 0x2a, 0x0c, 0xa5, 0x55, 0xa0, 0x57, 0xb5, 0x8c, 0x20, 0x42,
+	0xc4, 0x77, 0xb0, 0x5c, 0x7b, 0xd5, 0xf1, 0x1e, 0x08, 0x21, 0x4f, 0xc0, 0x42, 0x97, 0x28, 0x42,
+	0xcc, 0x1f, 0x17, 0x60, 0xfe, 0xa6, 0x6f, 0x6d, 0x6f, 0x13, 0xff, 0x98, 0xc5, 0x7c, 0x03, 0x26,
+	0x5d, 0x0c, 0x25, 0xdb, 0xf4, 0x0c, 0xcf, 0xc5, 0x78, 0xe0, 0xfd, 0xbd, 0xc9, 0x1e, 0xa5, 0x64,
+	0x54, 0xb7, 0x48, 0x29, 0x6e, 0x70, 0xda, 0x0d, 0x46, 0xaa, 0x4f, 0xb8, 0xc9, 0xa1, 0x7a, 0x15,
+	0x4a, 0x0d, 0xb3, 0xb9, 0xbb, 0x65, 0xd9, 0x36, 0x2a, 0x4b, 0x0b, 0xd4, 0x67, 0x0e, 0x0d, 0xe1,
+	0x75, 0x41, 0x20, 0xd4, 0xd3, 0x23, 0x0e, 0xfd, 0x42, 0x94, 0x9a, 0xae, 0xcb, 0x3c, 0xc2, 0x74,
+	0x3e, 0xcc, 0x5d, 0x24, 0x36, 0x39, 0xf6, 0xfd, 0x99, 0x14, 0xa7, 0x98, 0x11, 0x87, 0x5d, 0x58,
+	0xd3, 0x6b, 0xca, 0xd6, 0x3b, 0x6e, 0x89, 0xab, 0x56, 0x10, 0xca, 0x89, 0x01, 0x6b, 0x97, 0xdc,
+	0x8a, 0xac, 0x30, 0x70, 0x45, 0x96, 0x5b, 0xbd, 0xff, 0x10, 0x33, 0x57, 0x46, 0x14, 0x91, 0x84,
+	0x37, 0xa0, 0x2c, 0x15, 0x95, 0x37, 0xe6, 0xd5, 0x81, 0x73, 0x0f, 0x65, 0xc9, 0x6f, 0xc4, 0x31,
+	0x93, 0x3c, 0x99, 0x0a, 0x79, 0x32, 0xfd, 0x54, 0x81, 0x25, 0x6e, 0xb9, 0xfb, 0xfc, 0x88, 0xda,
+	0xd7, 0xbd, 0x67, 0xe0, 0x74, 0x4f, 0x21, 0x85, 0x9f, 0x3f, 0x56, 0x60, 0x9a, 0xf5, 0xd0, 0x69,
+	0x5d, 0x83, 0x0a, 0xfa, 0x66, 0x3b, 0xe0, 0x89, 0x14, 0x87, 0x46, 0x74, 0x3f, 0x60, 0x89, 0x14,
+	0x21, 0xb4, 0xee, 0xa7, 0xaf, 0x1b, 0x0d, 0xb3, 0x65, 0x34, 0x2c, 0xc7, 0xf4, 0x0f, 0x0c, 0xb4,
+	0x5d, 0x73, 0x37, 0xe8, 0xb4, 0x45, 0xe8, 0xcd, 0xe0, 0xd4, 0x3a, 0x9b, 0xa9, 0x8b, 0x09, 0x1a,
+	0x14, 0xc1, 0xae, 0xe5, 0x19, 0xcd, 0x8e, 0xef, 0xd3, 0xda, 0xcb, 0xf5, 0x84, 0xab, 0x4b, 0xfa,
+	0x14, 0x9d, 0xa8, 0x73, 0xf8, 0x0d, 0x04, 0xab, 0xe7, 0x60, 0x8e, 0xe1, 0xb2, 0x07, 0x58, 0x4c,
+	0x45, 0x92, 0x88, 0x25, 0xa1, 0x92, 0xae, 0xd2, 0xc9, 0x75, 0x9c, 0xbb, 0xee, 0x86, 0x82, 0x8c,
+	0x3e, 0xd9, 0x3a, 0x78, 0xbf, 0x6c, 0xa1, 0x9e, 0x7e, 0x1b, 0xeb, 0x92, 0x20, 0xb4, 0x9a, 0x86,
+	0xeb, 0xd8, 0x7c, 0xf7, 0x95, 0xf4, 0x59, 0x9c, 0xbd, 0x98, 0x9c, 0xbc, 0x81, 0x73, 0xda, 0xf7,
+	0x8b, 0x50, 0xdd, 0xa4, 0xe5, 0x21, 0xd3, 0x1e, 0xd7, 0xf6, 0xcd, 0xc1, 0xbd, 0x87, 0x35, 0xed,
+	0xd7, 0xdd, 0x46, 0xbc, 0xdf, 0x86, 0x71, 0xc4, 0x53, 0x29, 0x52, 0xfb, 0xd2, 0x11, 0x7c, 0xa0,
+	0xce, 0x63, 0x01, 0x4c, 0xcc, 0x40, 0xbc, 0xff, 0x94, 0x75, 0x31, 0xa2, 0x56, 0x66, 0xaf, 0x1e,
+	0xdc, 0xca, 0x3c, 0x53, 0x94, 0x19, 0x84, 0x59, 0x39, 0xe9, 0xd8, 0x91, 0xcc, 0x49, 0x47, 0x37,
+	0xbd, 0xb5, 0xed, 0x60, 0x11, 0xc7, 0x5a, 0xc8, 0xa3, 0x62, 0xd3, 0x33, 0x10, 0x6d, 0x1b, 0xab,
+	0x75, 0x18, 0x17, 0x08, 0x96, 0xe3, 0x75, 0x42, 0x56, 0xca, 0xf6, 0x69, 0x19, 0x6e, 0x98, 0x07,
+	0xb6, 0x6b, 0xb6, 0x02, 0x5d, 0xb0, 0xbd, 0x42, 0x89, 0xd4, 0xd7, 0x61, 0x9c, 0x87, 0x81, 0xc7,
+	0xc2, 0xa2, 0x52, 0x66, 0x4c, 0x3e, 0x37, 0x50, 0x4f, 0x2a, 0x1b, 0x53, 0xfa, 0x98, 0x9f, 0x08,
+	0xb0, 0x69, 0x28, 0xfa, 0x5e, 0x50, 0x01, 0xfe, 0x12, 0x80, 0x3f, 0xb5, 0x45, 0x38, 0x99, 0xeb,
+	0x0d, 0x11, 0xa6, 0x78, 0xa3, 0x3a, 0xb1, 0x19, 0xba, 0xde, 0x31, 0x3a, 0x2b, 0x76, 0x4b, 0x31,
+	0xe5, 0x96, 0x7e, 0x27, 0xdf, 0x29, 0x1a, 0x33, 0xdd, 0x52, 0x08, 0x21, 0x6f, 0xc2, 0xa2, 0xac,
+	0x17, 0x8f, 0x4f, 0x4e, 0xed, 0x97, 0x45, 0x9a, 0x6a, 0xf2, 0xd9, 0x8a, 0x3c, 0x18, 0x53, 0x2a,
+	0x99, 0x70, 0xe4, 0x9f, 0x2e, 0x08, 0x7e, 0x6c, 0xa0, 0xbe, 0x08, 0xc0, 0xef, 0x4a, 0xec, 0xc1,
+	0xb6, 0x38, 0xe0, 0x83, 0x6d, 0x99, 0xd1, 0x50, 0x28, 0x65, 0xd0, 0xa4, 0xcf, 0xd3, 0x47, 0x7b,
+	0xf1, 0x2d, 0x33, 0x1a, 0xc6, 0x20, 0xb6, 0xfc, 0x70, 0x4f, 0xcb, 0x67, 0x23, 0x7e, 0x15, 0xe6,
+	0x42, 0x37, 0xc4, 0x78, 0x76, 0xa5, 0xf6, 0x46, 0xd3, 0xed, 0x60, 0x5e, 0xe0, 0xb7, 0xb8, 0x87,
+	0xd9, 0x64, 0x64, 0x99, 0x3a, 0x9d, 0x52, 0x2f, 0x40, 0x05, 0x43, 0xdc, 0xa3, 0x09, 0xb0, 0x8b,
+	0x8c, 0xdf, 0xed, 0xe6, 0xe5, 0x7c, 0x86, 0xf2, 0x3c, 0x2c, 0x88, 0xcf, 0x76, 0xba, 0x08, 0xcb,
+	0xbc, 0x21, 0x21, 0xa6, 0xd3, 0x74, 0xda, 0xdb, 0x50, 0xa5, 0xc7, 0x4a, 0xda, 0x4d, 0x03, 0x1e,
+	0x9d, 0x27, 0xa1, 0x9c, 0x3d, 0x32, 0x4b, 0xde, 0x51, 0xcf, 0xca, 0x3f, 0x28, 0xa0, 0xa6, 0x57,
+	0xa7, 0x37, 0x85, 0xff, 0xb3, 0x00, 0xd1, 0x7e, 0xae, 0xc0, 0xc9, 0x5c, 0x3b, 0x8a, 0x78, 0xff,
+	0x2a, 0xd6, 0x82, 0x91, 0x5b, 0xd8, 0xfd, 0xa9, 0xdf, 0xfb, 0x53, 0x6e, 0x6a, 0x4a, 0xd9, 0x07,
+	0xeb, 0xc1, 0x94, 0xb9, 0x06, 0xad, 0x02, 0x7e, 0x5b, 0x80, 0x25, 0x7e, 0xa5, 0xb8, 0xdf, 0x55,
+	0x00, 0x06, 0x4f, 0x87, 0x09, 0x12, 0xf7, 0x59, 0x4a, 0x1c, 0x80, 0x6e, 0x56, 0x61, 0x88, 0x1d,
+	0x13, 0x3c, 0x9b, 0xb1, 0xdf, 0x18, 0xe1, 0xc3, 0xfc, 0x64, 0x18, 0x1e, 0xf0, 0x64, 0xe0, 0xe8,
+	0x7d, 0xf7, 0x28, 0x9e, 0xf3, 0xfb, 0xa6, 0x15, 0x1a, 0x5b, 0xae, 0x6f, 0x98, 0xcd, 0x26, 0xf1,
+	0x42, 0xc2, 0xbb, 0x2c, 0x78, 0xce, 0xd3, 0x89, 0xcb, 0xae, 0xbf, 0x26, 0xc0, 0xf4, 0xdb, 0xa7,
+	0xd3, 0x3d, 0x4d, 0x27, 0xdc, 0x9c, 0x52, 0x4a, 0xc9, 0x28, 0x85, 0x86, 0x95, 0x9b, 0x97, 0x27,
+	0xcc, 0x92, 0x1e, 0x03, 0xe8, 0x63, 0x19, 0x9e, 0x37, 0x1d, 0x3b, 0x3c, 0xec, 0xb1, 0x2c, 0xd2,
+	0x4f, 0xe0, 0xab, 0x2f, 0xc0, 0xa8, 0xd8, 0xdb, 0x22, 0x72, 0x33, 0xa4, 0x62, 0x92, 0xd2, 0x5e,
+	0xe6, 0x3f, 0x75, 0x49, 0xa0, 0xfd, 0x8a, 0xbf, 0x5d, 0xe6, 0x69, 0x84, 0xac, 0xef, 0x71, 0x48,
+	0x60, 0x8d, 0xb0, 0xe5, 0xda, 0xb4, 0x2d, 0xe7, 0x77, 0x9c, 0x40, 0xd4, 0x5b, 0xc0, 0x41, 0x3a,
+	0x42, 0xb4, 0xbf, 0x16, 0x40, 0xeb, 0x27, 0xad, 0xf0, 0x42, 0x4a, 0x20, 0xe5, 0x2e, 0x04, 0xba,
+	0x0c, 0x23, 0xe2, 0x3b, 0x2a, 0xfe, 0xfe, 0x56, 0xeb, 0xf1, 0xfe, 0xd6, 0xc5, 0x84, 0x7f, 0x60,
+	0xa5, 0x0b, 0xea, 0xfb, 0xe3, 0x5b, 0x75, 0x05, 0x66, 0x1d, 0x92, 0x78, 0xd3, 0x35, 0x44, 0x53,
+	0x93, 0x1f, 0x61, 0x33, 0x38, 0x17, 0x2b, 0x4d, 0x1b, 0x9c, 0xda, 0x0f, 0x8a, 0xf0, 0x24, 0x2b,
+	0x80, 0xba, 0xf4, 0x79, 0xcd, 0x0a, 0x31, 0xff, 0x1c, 0xe1, 0x68, 0x38, 0xb6, 0x98, 0xe8, 0x55,
+	0xf3, 0xa4, 0x3b, 0x27, 0x43, 0x99, 0xce, 0x89, 0xfa, 0x25, 0x58, 0x8c, 0x7a, 0xbc, 0xec, 0xd1,
+	0x7f, 0x8b, 0x56, 0xd2, 0x3b, 0xd9, 0x9e, 0xec, 0x89, 0xfd, 0x44, 0x9b, 0xf9, 0x32, 0x43, 0x91,
+	0x9d, 0xd5, 0xcb, 0xb0, 0x9c, 0xe6, 0x20, 0xaf, 0x5c, 0x89, 0x7e, 0x2c, 0xef, 0xd9, 0x9e, 0x4a,
+	0x32, 0x91, 0x77, 0xb5, 0xa8, 0x43, 0x7b, 0x86, 0x96, 0xa4, 0xec, 0x29, 0x9b, 0x57, 0xcd, 0xbc,
+	0xf2, 0x1d, 0x13, 0x30, 0x56, 0x37, 0x2f, 0xc0, 0x68, 0x0b, 0xaf, 0x25, 0xe8, 0x1e, 0x76, 0xc8,
+	0x97, 0xf4, 0x11, 0x1c, 0xa2, 0x4b, 0xb4, 0x9f, 0x28, 0x70, 0x76, 0x00, 0x87, 0xc4, 0x35, 0x95,
+	0xf0, 0xb0, 0x92, 0x6c, 0x5b, 0x7f, 0x19, 0x46, 0x3d, 0x9f, 0xec, 0x59, 0x64, 0x5f, 0x38, 0x62,
+	0x7d, 0xa0, 0x33, 0x27, 0x7f, 0xdd, 0x0d, 0xce, 0x49, 0x97, 0x2c, 0xb5, 0x77, 0x86, 0x78, 0x47,
+	0xbe, 0x27, 0xaa, 0xba, 0x04, 0x63, 0xec, 0x6a, 0x94, 0x92, 0xad, 0x4c, 0x41, 0x2c, 0xea, 0x0e,
+	0x77, 0x55, 0xe1, 0x30, 0x57, 0x61, 0x16, 0xe7, 0x77, 0x25, 0x93, 0xbe, 0x47, 0xa7, 0x5a, 0xf7,
+	0x53, 0xf1, 0x04, 0x5f, 0xed, 0x2a, 0x4c, 0xb6, 0x7c, 0xd7, 0xf3, 0xa4, 0x1b, 0x03, 0xd1, 0x3e,
+	0x79, 0x2c, 0x1d, 0x9d, 0x89, 0xaf, 0xad, 0xc4, 0x67, 0x55, 0x6c, 0x31, 0x7d, 0x42, 0x10, 0xf3,
+	0xcf, 0x12, 0xd4, 0x37, 0x60, 0x86, 0xee, 0x36, 0x64, 0x66, 0x36, 0x43, 0x6b, 0xcf, 0x0a, 0x2d,
+	0x42, 0xbf, 0xa5, 0x2c, 0xa6, 0x3b, 0xa3, 0x94, 0x61, 0xf4, 0x8d, 0x34, 0xdd, 0xe5, 0xc4, 0x69,
+	0x59, 0xce, 0xf6, 0x1a, 0x27, 0x39, 0x60, 0xe7, 0xf9, 0x34, 0xe7, 0xb3, 0x16, 0xb1, 0xc1, 0x92,
+	0x61, 0xda, 0xe3, 0x88, 0x78, 0x61, 0xb5, 0xec, 0x96, 0xcf, 0xde, 0x7f, 0x32, 0x6f, 0x91, 0x3d,
+	0x58, 0xd7, 0x29, 0x41, 0xe4, 0x09, 0xb6, 0xc0, 0x94, 0x97, 0x98, 0x42, 0x5e, 0xea, 0x06, 0x4c,
+	0xf3, 0x20, 0xb4, 0x62, 0x5b, 0x8c, 0x1e, 0xc5, 0x16, 0x53, 0x11, 0x39, 0xb7, 0x86, 0xf6, 0x3b,
+	0x05, 0x16, 0x59, 0x2f, 0xf0, 0x01, 0xee, 0x30, 0xf4, 0xba, 0xdb, 0xd2, 0x87, 0x92, 0x5e, 0xb2,
+	0x8b, 0xcb, 0xd2, 0xcf, 0x68, 0x01, 0xc0, 0xbb, 0x88, 0x0f, 0x72, 0x0b, 0x45, 0x83, 0xe5, 0xde,
+	0x52, 0x72, 0x55, 0xd6, 0xed, 0xf7, 0x3e, 0x5c, 0x7a, 0xe8, 0x7d, 0xfc, 0xfb, 0xd7, 0x87, 0x4b,
+	0xca, 0xb7, 0x3e, 0x5a, 0x52, 0x7e, 0x81, 0x7f, 0xef, 0xe2, 0xdf, 0x7b, 0xf8, 0xf7, 0x37, 0xfc,
+	0xfb, 0xc7, 0x47, 0x38, 0x87, 0xff, 0xde, 0xf9, 0xfb, 0xd2, 0x43, 0xef, 0xe1, 0xdf, 0xfb, 0xf8,
+	0xf7, 0xc6, 0xf9, 0x6d, 0x37, 0x96, 0xd6, 0x72, 0xfb, 0xfc, 0x47, 0x94, 0x2f, 0x24, 0xc7, 0x8d,
+	0x11, 0x56, 0x41, 0x3f, 0xfb, 0x5f, 0xeb, 0x4b, 0x62, 0x26, 0xc3, 0x32, 0x00, 0x00,
+}
+
+func (this *DescribeWorkflowExecutionRequest) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
 	}
-	if len(m.DatabaseMutableState) > 0 {
-		i -= len(m.DatabaseMutableState)
-		copy(dAtA[i:], m.DatabaseMutableState)
-		i = encodeVarintRequestResponse(dAtA, i, uint64(len(m.DatabaseMutableState)))
-		i--
-		dAtA[i] = 0x22
+
+	that1, ok := that.(*DescribeWorkflowExecutionRequest)
+	if !ok {
+		that2, ok := that.(DescribeWorkflowExecutionRequest)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
 	}
-	if len(m.CacheMutableState) > 0 {
-		i -= len(m.CacheMutableState)
-		copy(dAtA[i:], m.CacheMutableState)
-		i = encodeVarintRequestResponse(dAtA, i, uint64(len(m.CacheMutableState)))
-		i--
-		dAtA[i] = 0x1a
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
 	}
-	if len(m.HistoryAddr) > 0 {
-		i -= len(m.HistoryAddr)
-		copy(dAtA[i:], m.HistoryAddr)
-		i = encodeVarintRequestResponse(dAtA, i, uint64(len(m.HistoryAddr)))
-		i--
-		dAtA[i] = 0x12
+	if this.Namespace != that1.Namespace {
+		return false
 	}
-	if len(m.ShardId) > 0 {
-		i -= len(m.ShardId)
-		copy(dAtA[i:], m.ShardId)
-		i = encodeVarintRequestResponse(dAtA, i, uint64(len(m.ShardId)))
-		i--
-		dAtA[i] = 0xa
+	if !this.Execution.Equal(that1.Execution) {
+		return false
 	}
-	return len(dAtA) - i, nil
+	return true
 }
-
-func (m *DescribeHistoryHostRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (this *DescribeWorkflowExecutionResponse) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
 	}
-	return dAtA[:n], nil
-}
-
-func (m *DescribeHistoryHostRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
-}
 
-func (m *DescribeHistoryHostRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.WorkflowExecution != nil {
-		{
-			size, err := m.WorkflowExecution.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintRequestResponse(dAtA, i, uint64(size))
+	that1, ok := that.(*DescribeWorkflowExecutionResponse)
+	if !ok {
+		that2, ok := that.(DescribeWorkflowExecutionResponse)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
 		}
-		i--
-		dAtA[i] = 0x22
 	}
-	if len(m.Namespace) > 0 {
-		i -= len(m.Namespace)
-		copy(dAtA[i:], m.Namespace)
-		i = encodeVarintRequestResponse(dAtA, i, uint64(len(m.Namespace)))
-		i--
-		dAtA[i] = 0x1a
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
 	}
-	if m.ShardId != 0 {
-		i = encodeVarintRequestResponse(dAtA, i, uint64(m.ShardId))
-		i--
-		dAtA[i] = 0x10
+	if this.ShardId != that1.ShardId {
+		return false
 	}
-	if len(m.HostAddress) > 0 {
-		i -= len(m.HostAddress)
-		copy(dAtA[i:], m.HostAddress)
-		i = encodeVarintRequestResponse(dAtA, i, uint64(len(m.HostAddress)))
-		i--
-		dAtA[i] = 0xa
+	if this.HistoryAddr != that1.HistoryAddr {
+		return false
 	}
-	return len(dAtA) - i, nil
-}
-
-func (m *DescribeHistoryHostResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+	if this.CacheMutableState != that1.CacheMutableState {
+		return false
 	}
-	return dAtA[:n], nil
-}
-
-func (m *DescribeHistoryHostResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+	if this.DatabaseMutableState != that1.DatabaseMutableState {
+		return false
+	}
+	if this.TreeId != that1.TreeId {
+		return false
+	}
+	if this.BranchId != that1.BranchId {
+		return false
+	}
+	return true
 }
+func (this *DescribeHistoryHostRequest) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
 
-func (m *DescribeHistoryHostResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Address) > 0 {
-		i -= len(m.Address)
-		copy(dAtA[i:], m.Address)
-		i = encodeVarintRequestResponse(dAtA, i, uint64(len(m.Address)))
-		i--
-		dAtA[i] = 0x2a
+	that1, ok := that.(*DescribeHistoryHostRequest)
+	if !ok {
+		that2, ok := that.(DescribeHistoryHostRequest)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
 	}
-	if len(m.ShardControllerStatus) > 0 {
-		i -= len(m.ShardControllerStatus)
-		copy(dAtA[i:], m.ShardControllerStatus)
-		i = encodeVarintRequestResponse(dAtA, i, uint64(len(m.ShardControllerStatus)))
-		i--
-		dAtA[i] = 0x22
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
 	}
-	if m.NamespaceCache != nil {
-		{
-			size, err := m.NamespaceCache.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintRequestResponse(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x1a
+	if this.HostAddress != that1.HostAddress {
+		return false
 	}
-	if len(m.ShardIds) > 0 {
-		dAtA5 := make([]byte, len(m.ShardIds)*10)
-		var j4 int
-		for _, num1 := range m.ShardIds {
-			num := uint64(num1)
-			for num >= 1<<7 {
-				dAtA5[j4] = uint8(uint64(num)&0x7f | 0x80)
-				num >>= 7
-				j4++
-			}
-			dAtA5[j4] = uint8(num)
-			j4++
-		}
-		i -= j4
-		copy(dAtA[i:], dAtA5[:j4])
-		i = encodeVarintRequestResponse(dAtA, i, uint64(j4))
-		i--
-		dAtA[i] = 0x12
+	if this.ShardId != that1.ShardId {
+		return false
 	}
-	if m.ShardsNumber != 0 {
-		i = encodeVarintRequestResponse(dAtA, i, uint64(m.ShardsNumber))
-		i--
-		dAtA[i] = 0x8
+	if this.Namespace != that1.Namespace {
+		return false
 	}
-	return len(dAtA) - i, nil
-}
-
-func (m *CloseShardRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+	if !this.WorkflowExecution.Equal(that1.WorkflowExecution) {
+		return false
 	}
-	return dAtA[:n], nil
-}
-
-func (m *CloseShardRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+	return true
 }
-
-func (m *CloseShardRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.ShardId != 0 {
-		i = encodeVarintRequestResponse(dAtA, i, uint64(m.ShardId))
-		i--
-		dAtA[i] = 0x8
+func (this *DescribeHistoryHostResponse) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
 	}
-	return len(dAtA) - i, nil
-}
 
-func (m *CloseShardResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+	that1, ok := that.(*DescribeHistoryHostResponse)
+	if !ok {
+		that2, ok := that.(DescribeHistoryHostResponse)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
 	}
-	return dAtA[:n], nil
-}
-
-func (m *CloseShardResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
-}
-
-func (m *CloseShardResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
-}
-
-func (m *RemoveTaskRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
 	}
-	return dAtA[:n], nil
-}
-
-func (m *RemoveTaskRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
-}
-
-func (m *RemoveTaskRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.VisibilityTime != nil {
-		n6, err6 := github_com_gogo_protobuf_types.StdTimeMarshalTo(*m.VisibilityTime, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(*m.VisibilityTime):])
-		if err6 != nil {
-			return 0, err6
+	if this.ShardsNumber != that1.ShardsNumber {
+		return false
+	}
+	if len(this.ShardIds) != len(that1.ShardIds) {
+		return false
+	}
+	for i := range this.ShardIds {
+		if this.ShardIds[i] != that1.ShardIds[i] {
+			return false
 		}
-		i -= n6
-		i = encodeVarintRequestResponse(dAtA, i, uint64(n6))
-		i--
-		dAtA[i] = 0x22
 	}
-	if m.TaskId != 0 {
-		i = encodeVarintRequestResponse(dAtA, i, uint64(m.TaskId))
-		i--
-		dAtA[i] = 0x18
+	if !this.NamespaceCache.Equal(that1.NamespaceCache) {
+		return false
 	}
-	if m.Category != 0 {
-		i = encodeVarintRequestResponse(dAtA, i, uint64(m.Category))
-		i--
-		dAtA[i] = 0x10
+	if this.ShardControllerStatus != that1.ShardControllerStatus {
+		return false
 	}
-	if m.ShardId != 0 {
-		i = encodeVarintRequestResponse(dAtA, i, uint64(m.ShardId))
-		i--
-		dAtA[i] = 0x8
+	if this.Address != that1.Address {
+		return false
 	}
-	return len(dAtA) - i, nil
+	return true
 }
-
-func (m *RemoveTaskResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (this *CloseShardRequest) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
 	}
-	return dAtA[:n], nil
-}
 
-func (m *RemoveTaskResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+	that1, ok := that.(*CloseShardRequest)
+	if !ok {
+		that2, ok := that.(CloseShardRequest)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.ShardId != that1.ShardId {
+		return false
+	}
+	return true
 }
+func (this *CloseShardResponse) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
 
-func (m *RemoveTaskResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+	that1, ok := that.(*CloseShardResponse)
+	if !ok {
+		that2, ok := that.(CloseShardResponse)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	return true
 }
+func (this *RemoveTaskRequest) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
 
-func (m *GetWorkflowExecutionRawHistoryV2Request) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+	that1, ok := that.(*RemoveTaskRequest)
+	if !ok {
+		that2, ok := that.(RemoveTaskRequest)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
 	}
-	return dAtA[:n], nil
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.ShardId != that1.ShardId {
+		return false
+	}
+	if this.Category != that1.Category {
+		return false
+	}
+	if this.TaskId != that1.TaskId {
+		return false
+	}
+	if that1.VisibilityTime == nil {
+		if this.VisibilityTime != nil {
+			return false
+		}
+	} else if !this.VisibilityTime.Equal(*that1.VisibilityTime) {
+		return false
+	}
+	return true
 }
+func (this *RemoveTaskResponse) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
 
-func (m *GetWorkflowExecutionRawHistoryV2Request) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+	that1, ok := that.(*RemoveTaskResponse)
+	if !ok {
+		that2, ok := that.(RemoveTaskResponse)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	return true
 }
+func (this *GetWorkflowExecutionRawHistoryV2Request) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
 
-func (m *GetWorkflowExecutionRawHistoryV2Request) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.NextPageToken) > 0 {
-		i -= len(m.NextPageToken)
-		copy(dAtA[i:], m.NextPageToken)
-		i = encodeVarintRequestResponse(dAtA, i, uint64(len(m.NextPageToken)))
-		i--
-		dAtA[i] = 0x42
+	that1, ok := that.(*GetWorkflowExecutionRawHistoryV2Request)
+	if !ok {
+		that2, ok := that.(GetWorkflowExecutionRawHistoryV2Request)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
 	}
-	if m.MaximumPageSize != 0 {
-		i = encodeVarintRequestResponse(dAtA, i, uint64(m.MaximumPageSize))
-		i--
-		dAtA[i] = 0x38
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
 	}
-	if m.EndEventVersion != 0 {
-		i = encodeVarintRequestResponse(dAtA, i, uint64(m.EndEventVersion))
-		i--
-		dAtA[i] = 0x30
+	if this.Namespace != that1.Namespace {
+		return false
 	}
-	if m.EndEventId != 0 {
-		i = encodeVarintRequestResponse(dAtA, i, uint64(m.EndEventId))
-		i--
-		dAtA[i] = 0x28
+	if !this.Execution.Equal(that1.Execution) {
+		return false
 	}
-	if m.StartEventVersion != 0 {
-		i = encodeVarintRequestResponse(dAtA, i, uint64(m.StartEventVersion))
-		i--
-		dAtA[i] = 0x20
+	if this.StartEventId != that1.StartEventId {
+		return false
 	}
-	if m.StartEventId != 0 {
-		i = encodeVarintRequestResponse(dAtA, i, uint64(m.StartEventId))
-		i--
-		dAtA[i] = 0x18
+	if this.StartEventVersion != that1.StartEventVersion {
+		return false
 	}
-	if m.Execution != nil {
-		{
-			size, err := m.Execution.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintRequestResponse(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x12
+	if this.EndEventId != that1.EndEventId {
+		return false
 	}
-	if len(m.Namespace) > 0 {
-		i -= len(m.Namespace)
-		copy(dAtA[i:], m.Namespace)
-		i = encodeVarintRequestResponse(dAtA, i, uint64(len(m.Namespace)))
-		i--
-		dAtA[i] = 0xa
+	if this.EndEventVersion != that1.EndEventVersion {
+		return false
 	}
-	return len(dAtA) - i, nil
-}
-
-func (m *GetWorkflowExecutionRawHistoryV2Response) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+	if this.MaximumPageSize != that1.MaximumPageSize {
+		return false
 	}
-	return dAtA[:n], nil
-}
-
-func (m *GetWorkflowExecutionRawHistoryV2Response) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+	if !bytes.Equal(this.NextPageToken, that1.NextPageToken) {
+		return false
+	}
+	return true
 }
+func (this *GetWorkflowExecutionRawHistoryV2Response) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
 
-func (m *GetWorkflowExecutionRawHistoryV2Response) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.VersionHistory != nil {
-		{
-			size, err := m.VersionHistory.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintRequestResponse(dAtA, i, uint64(size))
+	that1, ok := that.(*GetWorkflowExecutionRawHistoryV2Response)
+	if !ok {
+		that2, ok := that.(GetWorkflowExecutionRawHistoryV2Response)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
 		}
-		i--
-		dAtA[i] = 0x1a
 	}
-	if len(m.HistoryBatches) > 0 {
-		for iNdEx := len(m.HistoryBatches) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.HistoryBatches[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintRequestResponse(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0x12
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if !bytes.Equal(this.NextPageToken, that1.NextPageToken) {
+		return false
+	}
+	if len(this.HistoryBatches) != len(that1.HistoryBatches) {
+		return false
+	}
+	for i := range this.HistoryBatches {
+		if !this.HistoryBatches[i].Equal(that1.HistoryBatches[i]) {
+			return false
 		}
 	}
-	if len(m.NextPageToken) > 0 {
-		i -= len(m.NextPageToken)
-		copy(dAtA[i:], m.NextPageToken)
-		i = encodeVarintRequestResponse(dAtA, i, uint64(len(m.NextPageToken)))
-		i--
-		dAtA[i] = 0xa
+	if !this.VersionHistory.Equal(that1.VersionHistory) {
+		return false
 	}
-	return len(dAtA) - i, nil
+	return true
 }
-
-func (m *GetReplicationMessagesRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (this *GetReplicationMessagesRequest) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
 	}
-	return dAtA[:n], nil
-}
-
-func (m *GetReplicationMessagesRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
-}
 
-func (m *GetReplicationMessagesRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.ClusterName) > 0 {
-		i -= len(m.ClusterName)
-		copy(dAtA[i:], m.ClusterName)
-		i = encodeVarintRequestResponse(dAtA, i, uint64(len(m.ClusterName)))
-		i--
-		dAtA[i] = 0x12
+	that1, ok := that.(*GetReplicationMessagesRequest)
+	if !ok {
+		that2, ok := that.(GetReplicationMessagesRequest)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
 	}
-	if len(m.Tokens) > 0 {
-		for iNdEx := len(m.Tokens) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.Tokens[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintRequestResponse(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0xa
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if len(this.Tokens) != len(that1.Tokens) {
+		return false
+	}
+	for i := range this.Tokens {
+		if !this.Tokens[i].Equal(that1.Tokens[i]) {
+			return false
 		}
 	}
-	return len(dAtA) - i, nil
-}
-
-func (m *GetReplicationMessagesResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+	if this.ClusterName != that1.ClusterName {
+		return false
 	}
-	return dAtA[:n], nil
-}
-
-func (m *GetReplicationMessagesResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+	return true
 }
+func (this *GetReplicationMessagesResponse) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
 
-func (m *GetReplicationMessagesResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.ShardMessages) > 0 {
-		for k := range m.ShardMessages {
-			v := m.ShardMessages[k]
-			baseI := i
-			if v != nil {
-				{
-					size, err := v.MarshalToSizedBuffer(dAtA[:i])
-					if err != nil {
-						return 0, err
-					}
-					i -= size
-					i = encodeVarintRequestResponse(dAtA, i, uint64(size))
-				}
-				i--
-				dAtA[i] = 0x12
-			}
-			i = encodeVarintRequestResponse(dAtA, i, uint64(k))
-			i--
-			dAtA[i] = 0x8
-			i = encodeVarintRequestResponse(dAtA, i, uint64(baseI-i))
-			i--
-			dAtA[i] = 0xa
+	that1, ok := that.(*GetReplicationMessagesResponse)
+	if !ok {
+		that2, ok := that.(GetReplicationMessagesResponse)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
 		}
 	}
-	return len(dAtA) - i, nil
-}
-
-func (m *GetNamespaceReplicationMessagesRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
 	}
-	return dAtA[:n], nil
-}
-
-func (m *GetNamespaceReplicationMessagesRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+	if len(this.ShardMessages) != len(that1.ShardMessages) {
+		return false
+	}
+	for i := range this.ShardMessages {
+		if !this.ShardMessages[i].Equal(that1.ShardMessages[i]) {
+			return false
+		}
+	}
+	return true
 }
+func (this *GetNamespaceReplicationMessagesRequest) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
 
-func (m *GetNamespaceReplicationMessagesRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.ClusterName) > 0 {
-		i -= len(m.ClusterName)
-		copy(dAtA[i:], m.ClusterName)
-		i = encodeVarintRequestResponse(dAtA, i, uint64(len(m.ClusterName)))
-		i--
-		dAtA[i] = 0x1a
+	that1, ok := that.(*GetNamespaceReplicationMessagesRequest)
+	if !ok {
+		that2, ok := that.(GetNamespaceReplicationMessagesRequest)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
 	}
-	if m.LastProcessedMessageId != 0 {
-		i = encodeVarintRequestResponse(dAtA, i, uint64(m.LastProcessedMessageId))
-		i--
-		dAtA[i] = 0x10
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
 	}
-	if m.LastRetrievedMessageId != 0 {
-		i = encodeVarintRequestResponse(dAtA, i, uint64(m.LastRetrievedMessageId))
-		i--
-		dAtA[i] = 0x8
+	if this.LastRetrievedMessageId != that1.LastRetrievedMessageId {
+		return false
 	}
-	return len(dAtA) - i, nil
-}
-
-func (m *GetNamespaceReplicationMessagesResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+	if this.LastProcessedMessageId != that1.LastProcessedMessageId {
+		return false
 	}
-	return dAtA[:n], nil
-}
-
-func (m *GetNamespaceReplicationMessagesResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+	if this.ClusterName != that1.ClusterName {
+		return false
+	}
+	return true
 }
+func (this *GetNamespaceReplicationMessagesResponse) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
 
-func (m *GetNamespaceReplicationMessagesResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Messages != nil {
-		{
-			size, err := m.Messages.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintRequestResponse(dAtA, i, uint64(size))
+	that1, ok := that.(*GetNamespaceReplicationMessagesResponse)
+	if !ok {
+		that2, ok := that.(GetNamespaceReplicationMessagesResponse)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
 		}
-		i--
-		dAtA[i] = 0xa
 	}
-	return len(dAtA) - i, nil
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if !this.Messages.Equal(that1.Messages) {
+		return false
+	}
+	return true
 }
+func (this *GetDLQReplicationMessagesRequest) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
 
-func (m *GetDLQReplicationMessagesRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+	that1, ok := that.(*GetDLQReplicationMessagesRequest)
+	if !ok {
+		that2, ok := that.(GetDLQReplicationMessagesRequest)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
 	}
-	return dAtA[:n], nil
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if len(this.TaskInfos) != len(that1.TaskInfos) {
+		return false
+	}
+	for i := range this.TaskInfos {
+		if !this.TaskInfos[i].Equal(that1.TaskInfos[i]) {
+			return false
+		}
+	}
+	return true
 }
+func (this *GetDLQReplicationMessagesResponse) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
 
-func (m *GetDLQReplicationMessagesRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
-}
-
-func (m *GetDLQReplicationMessagesRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.TaskInfos) > 0 {
-		for iNdEx := len(m.TaskInfos) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.TaskInfos[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintRequestResponse(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0xa
+	that1, ok := that.(*GetDLQReplicationMessagesResponse)
+	if !ok {
+		that2, ok := that.(GetDLQReplicationMessagesResponse)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
 		}
 	}
-	return len(dAtA) - i, nil
-}
-
-func (m *GetDLQReplicationMessagesResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
 	}
-	return dAtA[:n], nil
-}
-
-func (m *GetDLQReplicationMessagesResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
-}
-
-func (m *GetDLQReplicationMessagesResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.ReplicationTasks) > 0 {
-		for iNdEx := len(m.ReplicationTasks) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.ReplicationTasks[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintRequestResponse(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0xa
+	if len(this.ReplicationTasks) != len(that1.ReplicationTasks) {
+		return false
+	}
+	for i := range this.ReplicationTasks {
+		if !this.ReplicationTasks[i].Equal(that1.ReplicationTasks[i]) {
+			return false
 		}
 	}
-	return len(dAtA) - i, nil
+	return true
 }
+func (this *ReapplyEventsRequest) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
 
-func (m *ReapplyEventsRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+	that1, ok := that.(*ReapplyEventsRequest)
+	if !ok {
+		that2, ok := that.(ReapplyEventsRequest)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
 	}
-	return dAtA[:n], nil
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.Namespace != that1.Namespace {
+		return false
+	}
+	if !this.WorkflowExecution.Equal(that1.WorkflowExecution) {
+		return false
+	}
+	if !this.Events.Equal(that1.Events) {
+		return false
+	}
+	return true
 }
+func (this *ReapplyEventsResponse) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
 
-func (m *ReapplyEventsRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+	that1, ok := that.(*ReapplyEventsResponse)
+	if !ok {
+		that2, ok := that.(ReapplyEventsResponse)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	return true
 }
+func (this *AddSearchAttributeRequest) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
 
-func (m *ReapplyEventsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Events != nil {
-		{
-			size, err := m.Events.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintRequestResponse(dAtA, i, uint64(size))
+	that1, ok := that.(*AddSearchAttributeRequest)
+	if !ok {
+		that2, ok := that.(AddSearchAttributeRequest)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
 		}
-		i--
-		dAtA[i] = 0x1a
 	}
-	if m.WorkflowExecution != nil {
-		{
-			size, err := m.WorkflowExecution.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintRequestResponse(dAtA, i, uint64(size))
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if len(this.SearchAttribute) != len(that1.SearchAttribute) {
+		return false
+	}
+	for i := range this.SearchAttribute {
+		if this.SearchAttribute[i] != that1.SearchAttribute[i] {
+			return false
 		}
-		i--
-		dAtA[i] = 0x12
 	}
-	if len(m.Namespace) > 0 {
-		i -= len(m.Namespace)
-		copy(dAtA[i:], m.Namespace)
-		i = encodeVarintRequestResponse(dAtA, i, uint64(len(m.Namespace)))
-		i--
-		dAtA[i] = 0xa
+	if this.SecurityToken != that1.SecurityToken {
+		return false
 	}
-	return len(dAtA) - i, nil
+	return true
 }
-
-func (m *ReapplyEventsResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (this *AddSearchAttributeResponse) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
 	}
-	return dAtA[:n], nil
-}
-
-func (m *ReapplyEventsResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
-}
 
-func (m *ReapplyEventsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+	that1, ok := that.(*AddSearchAttributeResponse)
+	if !ok {
+		that2, ok := that.(AddSearchAttributeResponse)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	return true
 }
+func (this *DescribeClusterRequest) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
 
-func (m *AddSearchAttributeRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+	that1, ok := that.(*DescribeClusterRequest)
+	if !ok {
+		that2, ok := that.(DescribeClusterRequest)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
 	}
-	return dAtA[:n], nil
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	return true
 }
+func (this *DescribeClusterResponse) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
 
-func (m *AddSearchAttributeRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
-}
-
-func (m *AddSearchAttributeRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.SecurityToken) > 0 {
-		i -= len(m.SecurityToken)
-		copy(dAtA[i:], m.SecurityToken)
-		i = encodeVarintRequestResponse(dAtA, i, uint64(len(m.SecurityToken)))
-		i--
-		dAtA[i] = 0x12
+	that1, ok := that.(*DescribeClusterResponse)
+	if !ok {
+		that2, ok := that.(DescribeClusterResponse)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
 	}
-	if len(m.SearchAttribute) > 0 {
-		for k := range m.SearchAttribute {
-			v := m.SearchAttribute[k]
-			baseI := i
-			i = encodeVarintRequestResponse(dAtA, i, uint64(v))
-			i--
-			dAtA[i] = 0x10
-			i -= len(k)
-			copy(dAtA[i:], k)
-			i = encodeVarintRequestResponse(dAtA, i, uint64(len(k)))
-			i--
-			dAtA[i] = 0xa
-			i = encodeVarintRequestResponse(dAtA, i, uint64(baseI-i))
-			i--
-			dAtA[i] = 0xa
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if len(this.SupportedClients) != len(that1.SupportedClients) {
+		return false
+	}
+	for i := range this.SupportedClients {
+		if this.SupportedClients[i] != that1.SupportedClients[i] {
+			return false
 		}
 	}
-	return len(dAtA) - i, nil
-}
-
-func (m *AddSearchAttributeResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+	if this.ServerVersion != that1.ServerVersion {
+		return false
 	}
-	return dAtA[:n], nil
-}
-
-func (m *AddSearchAttributeResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
-}
-
-func (m *AddSearchAttributeResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
-}
-
-func (m *DescribeClusterRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+	if !this.MembershipInfo.Equal(that1.MembershipInfo) {
+		return false
 	}
-	return dAtA[:n], nil
-}
-
-func (m *DescribeClusterRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
-}
-
-func (m *DescribeClusterRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+	return true
 }
-
-func (m *DescribeClusterResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (this *GetDLQMessagesRequest) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
 	}
-	return dAtA[:n], nil
-}
-
-func (m *DescribeClusterResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
-}
 
-func (m *DescribeClusterResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.MembershipInfo != nil {
-		{
-			size, err := m.MembershipInfo.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintRequestResponse(dAtA, i, uint64(size))
+	that1, ok := that.(*GetDLQMessagesRequest)
+	if !ok {
+		that2, ok := that.(GetDLQMessagesRequest)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
 		}
-		i--
-		dAtA[i] = 0x1a
 	}
-	if len(m.ServerVersion) > 0 {
-		i -= len(m.ServerVersion)
-		copy(dAtA[i:], m.ServerVersion)
-		i = encodeVarintRequestResponse(dAtA, i, uint64(len(m.ServerVersion)))
-		i--
-		dAtA[i] = 0x12
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
 	}
-	if len(m.SupportedClients) > 0 {
-		for k := range m.SupportedClients {
-			v := m.SupportedClients[k]
-			baseI := i
-			i -= len(v)
-			copy(dAtA[i:], v)
-			i = encodeVarintRequestResponse(dAtA, i, uint64(len(v)))
-			i--
-			dAtA[i] = 0x12
-			i -= len(k)
-			copy(dAtA[i:], k)
-			i = encodeVarintRequestResponse(dAtA, i, uint64(len(k)))
-			i--
-			dAtA[i] = 0xa
-			i = encodeVarintRequestResponse(dAtA, i, uint64(baseI-i))
-			i--
-			dAtA[i] = 0xa
-		}
+	if this.Type != that1.Type {
+		return false
 	}
-	return len(dAtA) - i, nil
-}
-
-func (m *GetDLQMessagesRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+	if this.ShardId != that1.ShardId {
+		return false
 	}
-	return dAtA[:n], nil
-}
-
-func (m *GetDLQMessagesRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+	if this.SourceCluster != that1.SourceCluster {
+		return false
+	}
+	if this.InclusiveEndMessageId != that1.InclusiveEndMessageId {
+		return false
+	}
+	if this.MaximumPageSize != that1.MaximumPageSize {
+		return false
+	}
+	if !bytes.Equal(this.NextPageToken, that1.NextPageToken) {
+		return false
+	}
+	return true
 }
+func (this *GetDLQMessagesResponse) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
 
-func (m *GetDLQMessagesRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.NextPageToken) > 0 {
-		i -= len(m.NextPageToken)
-		copy(dAtA[i:], m.NextPageToken)
-		i = encodeVarintRequestResponse(dAtA, i, uint64(len(m.NextPageToken)))
-		i--
-		dAtA[i] = 0x32
+	that1, ok := that.(*GetDLQMessagesResponse)
+	if !ok {
+		that2, ok := that.(GetDLQMessagesResponse)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
 	}
-	if m.MaximumPageSize != 0 {
-		i = encodeVarintRequestResponse(dAtA, i, uint64(m.MaximumPageSize))
-		i--
-		dAtA[i] = 0x28
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
 	}
-	if m.InclusiveEndMessageId != 0 {
-		i = encodeVarintRequestResponse(dAtA, i, uint64(m.InclusiveEndMessageId))
-		i--
-		dAtA[i] = 0x20
+	if this.Type != that1.Type {
+		return false
 	}
-	if len(m.SourceCluster) > 0 {
-		i -= len(m.SourceCluster)
-		copy(dAtA[i:], m.SourceCluster)
-		i = encodeVarintRequestResponse(dAtA, i, uint64(len(m.SourceCluster)))
-		i--
-		dAtA[i] = 0x1a
+	if len(this.ReplicationTasks) != len(that1.ReplicationTasks) {
+		return false
 	}
-	if m.ShardId != 0 {
-		i = encodeVarintRequestResponse(dAtA, i, uint64(m.ShardId))
-		i--
-		dAtA[i] = 0x10
+	for i := range this.ReplicationTasks {
+		if !this.ReplicationTasks[i].Equal(that1.ReplicationTasks[i]) {
+			return false
+		}
 	}
-	if m.Type != 0 {
-		i = encodeVarintRequestResponse(dAtA, i, uint64(m.Type))
-		i--
-		dAtA[i] = 0x8
+	if !bytes.Equal(this.NextPageToken, that1.NextPageToken) {
+		return false
 	}
-	return len(dAtA) - i, nil
+	return true
 }
-
-func (m *GetDLQMessagesResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (this *PurgeDLQMessagesRequest) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
 	}
-	return dAtA[:n], nil
-}
-
-func (m *GetDLQMessagesResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
-}
 
-func (m *GetDLQMessagesResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.NextPageToken) > 0 {
-		i -= len(m.NextPageToken)
-		copy(dAtA[i:], m.NextPageToken)
-		i = encodeVarintRequestResponse(dAtA, i, uint64(len(m.NextPageToken)))
-		i--
-		dAtA[i] = 0x1a
-	}
-	if len(m.ReplicationTasks) > 0 {
-		for iNdEx := len(m.ReplicationTasks) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.ReplicationTasks[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintRequestResponse(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0x12
+	that1, ok := that.(*PurgeDLQMessagesRequest)
+	if !ok {
+		that2, ok := that.(PurgeDLQMessagesRequest)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
 		}
 	}
-	if m.Type != 0 {
-		i = encodeVarintRequestResponse(dAtA, i, uint64(m.Type))
-		i--
-		dAtA[i] = 0x8
-	}
-	return len(dAtA) - i, nil
-}
-
-func (m *PurgeDLQMessagesRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
 	}
-	return dAtA[:n], nil
-}
-
-func (m *PurgeDLQMessagesRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
-}
-
-func (m *PurgeDLQMessagesRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.InclusiveEndMessageId != 0 {
-		i = encodeVarintRequestResponse(dAtA, i, uint64(m.InclusiveEndMessageId))
-		i--
-		dAtA[i] = 0x20
+	if this.Type != that1.Type {
+		return false
 	}
-	if len(m.SourceCluster) > 0 {
-		i -= len(m.SourceCluster)
-		copy(dAtA[i:], m.SourceCluster)
-		i = encodeVarintRequestResponse(dAtA, i, uint64(len(m.SourceCluster)))
-		i--
-		dAtA[i] = 0x1a
+	if this.ShardId != that1.ShardId {
+		return false
 	}
-	if m.ShardId != 0 {
-		i = encodeVarintRequestResponse(dAtA, i, uint64(m.ShardId))
-		i--
-		dAtA[i] = 0x10
+	if this.SourceCluster != that1.SourceCluster {
+		return false
 	}
-	if m.Type != 0 {
-		i = encodeVarintRequestResponse(dAtA, i, uint64(m.Type))
-		i--
-		dAtA[i] = 0x8
+	if this.InclusiveEndMessageId != that1.InclusiveEndMessageId {
+		return false
 	}
-	return len(dAtA) - i, nil
+	return true
 }
-
-func (m *PurgeDLQMessagesResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (this *PurgeDLQMessagesResponse) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
 	}
-	return dAtA[:n], nil
-}
-
-func (m *PurgeDLQMessagesResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
-}
-
-func (m *PurgeDLQMessagesResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
-}
 
-func (m *MergeDLQMessagesRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+	that1, ok := that.(*PurgeDLQMessagesResponse)
+	if !ok {
+		that2, ok := that.(PurgeDLQMessagesResponse)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
 	}
-	return dAtA[:n], nil
-}
-
-func (m *MergeDLQMessagesRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	return true
 }
+func (this *MergeDLQMessagesRequest) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
 
-func (m *MergeDLQMessagesRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.NextPageToken) > 0 {
-		i -= len(m.NextPageToken)
-		copy(dAtA[i:], m.NextPageToken)
-		i = encodeVarintRequestResponse(dAtA, i, uint64(len(m.NextPageToken)))
-		i--
-		dAtA[i] = 0x32
+	that1, ok := that.(*MergeDLQMessagesRequest)
+	if !ok {
+		that2, ok := that.(MergeDLQMessagesRequest)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
 	}
-	if m.MaximumPageSize != 0 {
-		i = encodeVarintRequestResponse(dAtA, i, uint64(m.MaximumPageSize))
-		i--
-		dAtA[i] = 0x28
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
 	}
-	if m.InclusiveEndMessageId != 0 {
-		i = encodeVarintRequestResponse(dAtA, i, uint64(m.InclusiveEndMessageId))
-		i--
-		dAtA[i] = 0x20
+	if this.Type != that1.Type {
+		return false
 	}
-	if len(m.SourceCluster) > 0 {
-		i -= len(m.SourceCluster)
-		copy(dAtA[i:], m.SourceCluster)
-		i = encodeVarintRequestResponse(dAtA, i, uint64(len(m.SourceCluster)))
-		i--
-		dAtA[i] = 0x1a
+	if this.ShardId != that1.ShardId {
+		return false
 	}
-	if m.ShardId != 0 {
-		i = encodeVarintRequestResponse(dAtA, i, uint64(m.ShardId))
-		i--
-		dAtA[i] = 0x10
+	if this.SourceCluster != that1.SourceCluster {
+		return false
 	}
-	if m.Type != 0 {
-		i = encodeVarintRequestResponse(dAtA, i, uint64(m.Type))
-		i--
-		dAtA[i] = 0x8
+	if this.InclusiveEndMessageId != that1.InclusiveEndMessageId {
+		return false
 	}
-	return len(dAtA) - i, nil
-}
-
-func (m *MergeDLQMessagesResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+	if this.MaximumPageSize != that1.MaximumPageSize {
+		return false
 	}
-	return dAtA[:n], nil
-}
-
-func (m *MergeDLQMessagesResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
-}
-
-func (m *MergeDLQMessagesResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.NextPageToken) > 0 {
-		i -= len(m.NextPageToken)
-		copy(dAtA[i:], m.NextPageToken)
-		i = encodeVarintRequestResponse(dAtA, i, uint64(len(m.NextPageToken)))
-		i--
-		dAtA[i] = 0xa
+	if !bytes.Equal(this.NextPageToken, that1.NextPageToken) {
+		return false
 	}
-	return len(dAtA) - i, nil
+	return true
 }
+func (this *MergeDLQMessagesResponse) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
 
-func (m *RefreshWorkflowTasksRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+	that1, ok := that.(*MergeDLQMessagesResponse)
+	if !ok {
+		that2, ok := that.(MergeDLQMessagesResponse)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
 	}
-	return dAtA[:n], nil
-}
-
-func (m *RefreshWorkflowTasksRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if !bytes.Equal(this.NextPageToken, that1.NextPageToken) {
+		return false
+	}
+	return true
 }
+func (this *RefreshWorkflowTasksRequest) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
 
-func (m *RefreshWorkflowTasksRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Execution != nil {
-		{
-			size, err := m.Execution.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintRequestResponse(dAtA, i, uint64(size))
+	that1, ok := that.(*RefreshWorkflowTasksRequest)
+	if !ok {
+		that2, ok := that.(RefreshWorkflowTasksRequest)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
 		}
-		i--
-		dAtA[i] = 0x12
 	}
-	if len(m.Namespace) > 0 {
-		i -= len(m.Namespace)
-		copy(dAtA[i:], m.Namespace)
-		i = encodeVarintRequestResponse(dAtA, i, uint64(len(m.Namespace)))
-		i--
-		dAtA[i] = 0xa
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
 	}
-	return len(dAtA) - i, nil
-}
-
-func (m *RefreshWorkflowTasksResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+	if this.Namespace != that1.Namespace {
+		return false
 	}
-	return dAtA[:n], nil
-}
-
-func (m *RefreshWorkflowTasksResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+	if !this.Execution.Equal(that1.Execution) {
+		return false
+	}
+	return true
 }
+func (this *RefreshWorkflowTasksResponse) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
 
-func (m *RefreshWorkflowTasksResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+	that1, ok := that.(*RefreshWorkflowTasksResponse)
+	if !ok {
+		that2, ok := that.(RefreshWorkflowTasksResponse)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	return true
 }
+func (this *ResendReplicationTasksRequest) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
 
-func (m *ResendReplicationTasksRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+	that1, ok := that.(*ResendReplicationTasksRequest)
+	if !ok {
+		that2, ok := that.(ResendReplicationTasksRequest)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
 	}
-	return dAtA[:n], nil
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.NamespaceId != that1.NamespaceId {
+		return false
+	}
+	if this.WorkflowId != that1.WorkflowId {
+		return false
+	}
+	if this.RunId != that1.RunId {
+		return false
+	}
+	if this.RemoteCluster != that1.RemoteCluster {
+		return false
+	}
+	if this.StartEventId != that1.StartEventId {
+		return false
+	}
+	if this.StartVersion != that1.StartVersion {
+		return false
+	}
+	if this.EndEventId != that1.EndEventId {
+		return false
+	}
+	if this.EndVersion != that1.EndVersion {
+		return false
+	}
+	return true
 }
+func (this *ResendReplicationTasksResponse) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
 
-func (m *ResendReplicationTasksRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+	that1, ok := that.(*ResendReplicationTasksResponse)
+	if !ok {
+		that2, ok := that.(ResendReplicationTasksResponse)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	return true
 }
+func (this *CreateScheduleRequest) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
 
-func (m *ResendReplicationTasksRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.EndVersion != 0 {
-		i = encodeVarintRequestResponse(dAtA, i, uint64(m.EndVersion))
-		i--
-		dAtA[i] = 0x40
+	that1, ok := that.(*CreateScheduleRequest)
+	if !ok {
+		that2, ok := that.(CreateScheduleRequest)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
 	}
-	if m.EndEventId != 0 {
-		i = encodeVarintRequestResponse(dAtA, i, uint64(m.EndEventId))
-		i--
-		dAtA[i] = 0x38
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
 	}
-	if m.StartVersion != 0 {
-		i = encodeVarintRequestResponse(dAtA, i, uint64(m.StartVersion))
-		i--
-		dAtA[i] = 0x30
+	if this.Namespace != that1.Namespace {
+		return false
 	}
-	if m.StartEventId != 0 {
-		i = encodeVarintRequestResponse(dAtA, i, uint64(m.StartEventId))
-		i--
-		dAtA[i] = 0x28
+	if this.ScheduleId != that1.ScheduleId {
+		return false
 	}
-	if len(m.RemoteCluster) > 0 {
-		i -= len(m.RemoteCluster)
-		copy(dAtA[i:], m.RemoteCluster)
-		i = encodeVarintRequestResponse(dAtA, i, uint64(len(m.RemoteCluster)))
-		i--
-		dAtA[i] = 0x22
+	if !this.Schedule.Equal(that1.Schedule) {
+		return false
 	}
-	if len(m.RunId) > 0 {
-		i -= len(m.RunId)
-		copy(dAtA[i:], m.RunId)
-		i = encodeVarintRequestResponse(dAtA, i, uint64(len(m.RunId)))
-		i--
-		dAtA[i] = 0x1a
+	if !this.InitialPatch.Equal(that1.InitialPatch) {
+		return false
 	}
-	if len(m.WorkflowId) > 0 {
-		i -= len(m.WorkflowId)
-		copy(dAtA[i:], m.WorkflowId)
-		i = encodeVarintRequestResponse(dAtA, i, uint64(len(m.WorkflowId)))
-		i--
-		dAtA[i] = 0x12
+	if this.Identity != that1.Identity {
+		return false
 	}
-	if len(m.NamespaceId) > 0 {
-		i -= len(m.NamespaceId)
-		copy(dAtA[i:], m.NamespaceId)
-		i = encodeVarintRequestResponse(dAtA, i, uint64(len(m.NamespaceId)))
-		i--
-		dAtA[i] = 0xa
+	if this.RequestId != that1.RequestId {
+		return false
 	}
-	return len(dAtA) - i, nil
+	return true
 }
+func (this *CreateScheduleResponse) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
 
-func (m *ResendReplicationTasksResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
-}
-
-func (m *ResendReplicationTasksResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
-}
-
-func (m *ResendReplicationTasksResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
-}
-
-func encodeVarintRequestResponse(dAtA []byte, offset int, v uint64) int {
-	offset -= sovRequestResponse(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
-	}
-	dAtA[offset] = uint8(v)
-	return base
-}
-func (m *DescribeWorkflowExecutionRequest) Size() (n int) {
-	if m == nil {
-		return 0
+	that1, ok := that.(*CreateScheduleResponse)
+	if !ok {
+		that2, ok := that.(CreateScheduleResponse)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
 	}
-	var l int
-	_ = l
-	l = len(m.Namespace)
-	if l > 0 {
-		n += 1 + l + sovRequestResponse(uint64(l))
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
 	}
-	if m.Execution != nil {
-		l = m.Execution.Size()
-		n += 1 + l + sovRequestResponse(uint64(l))
+	if this.ConflictToken != that1.ConflictToken {
+		return false
 	}
-	return n
+	return true
 }
-
-func (m *DescribeWorkflowExecutionResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.ShardId)
-	if l > 0 {
-		n += 1 + l + sovRequestResponse(uint64(l))
-	}
-	l = len(m.HistoryAddr)
-	if l > 0 {
-		n += 1 + l + sovRequestResponse(uint64(l))
+func (this *DescribeScheduleRequest) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
 	}
-	l = len(m.CacheMutableState)
-	if l > 0 {
-		n += 1 + l + sovRequestResponse(uint64(l))
+
+	that1, ok := that.(*DescribeScheduleRequest)
+	if !ok {
+		that2, ok := that.(DescribeScheduleRequest)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
 	}
-	l = len(m.DatabaseMutableState)
-	if l > 0 {
-		n += 1 + l + sovRequestResponse(uint64(l))
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
 	}
-	l = len(m.TreeId)
-	if l > 0 {
-		n += 1 + l + sovRequestResponse(uint64(l))
+	if this.Namespace != that1.Namespace {
+		return false
 	}
-	l = len(m.BranchId)
-	if l > 0 {
-		n += 1 + l + sovRequestResponse(uint64(l))
+	if this.ScheduleId != that1.ScheduleId {
+		return false
 	}
-	return n
+	return true
 }
+func (this *DescribeScheduleResponse) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
 
-func (m *DescribeHistoryHostRequest) Size() (n int) {
-	if m == nil {
-		return 0
+	that1, ok := that.(*DescribeScheduleResponse)
+	if !ok {
+		that2, ok := that.(DescribeScheduleResponse)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
 	}
-	var l int
-	_ = l
-	l = len(m.HostAddress)
-	if l > 0 {
-		n += 1 + l + sovRequestResponse(uint64(l))
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
 	}
-	if m.ShardId != 0 {
-		n += 1 + sovRequestResponse(uint64(m.ShardId))
+	if !this.Schedule.Equal(that1.Schedule) {
+		return false
 	}
-	l = len(m.Namespace)
-	if l > 0 {
-		n += 1 + l + sovRequestResponse(uint64(l))
+	if !this.Info.Equal(that1.Info) {
+		return false
 	}
-	if m.WorkflowExecution != nil {
-		l = m.WorkflowExecution.Size()
-		n += 1 + l + sovRequestResponse(uint64(l))
+	if this.ConflictToken != that1.ConflictToken {
+		return false
 	}
-	return n
+	return true
 }
+func (this *UpdateScheduleRequest) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
 
-func (m *DescribeHistoryHostResponse) Size() (n int) {
-	if m == nil {
-		return 0
+	that1, ok := that.(*UpdateScheduleRequest)
+	if !ok {
+		that2, ok := that.(UpdateScheduleRequest)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
 	}
-	var l int
-	_ = l
-	if m.ShardsNumber != 0 {
-		n += 1 + sovRequestResponse(uint64(m.ShardsNumber))
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
 	}
-	if len(m.ShardIds) > 0 {
-		l = 0
-		for _, e := range m.ShardIds {
-			l += sovRequestResponse(uint64(e))
-		}
-		n += 1 + sovRequestResponse(uint64(l)) + l
+	if this.Namespace != that1.Namespace {
+		return false
 	}
-	if m.NamespaceCache != nil {
-		l = m.NamespaceCache.Size()
-		n += 1 + l + sovRequestResponse(uint64(l))
+	if this.ScheduleId != that1.ScheduleId {
+		return false
 	}
-	l = len(m.ShardControllerStatus)
-	if l > 0 {
-		n += 1 + l + sovRequestResponse(uint64(l))
+	if !this.Schedule.Equal(that1.Schedule) {
+		return false
 	}
-	l = len(m.Address)
-	if l > 0 {
-		n += 1 + l + sovRequestResponse(uint64(l))
+	if this.ConflictToken != that1.ConflictToken {
+		return false
 	}
-	return n
-}
-
-func (m *CloseShardRequest) Size() (n int) {
-	if m == nil {
-		return 0
+	if this.Identity != that1.Identity {
+		return false
 	}
-	var l int
-	_ = l
-	if m.ShardId != 0 {
-		n += 1 + sovRequestResponse(uint64(m.ShardId))
+	if this.RequestId != that1.RequestId {
+		return false
 	}
-	return n
+	return true
 }
+func (this *UpdateScheduleResponse) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
 
-func (m *CloseShardResponse) Size() (n int) {
-	if m == nil {
-		return 0
+	that1, ok := that.(*UpdateScheduleResponse)
+	if !ok {
+		that2, ok := that.(UpdateScheduleResponse)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
 	}
-	var l int
-	_ = l
-	return n
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	return true
 }
+func (this *PauseScheduleRequest) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
 
-func (m *RemoveTaskRequest) Size() (n int) {
-	if m == nil {
-		return 0
+	that1, ok := that.(*PauseScheduleRequest)
+	if !ok {
+		that2, ok := that.(PauseScheduleRequest)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
 	}
-	var l int
-	_ = l
-	if m.ShardId != 0 {
-		n += 1 + sovRequestResponse(uint64(m.ShardId))
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
 	}
-	if m.Category != 0 {
-		n += 1 + sovRequestResponse(uint64(m.Category))
+	if this.Namespace != that1.Namespace {
+		return false
 	}
-	if m.TaskId != 0 {
-		n += 1 + sovRequestResponse(uint64(m.TaskId))
+	if this.ScheduleId != that1.ScheduleId {
+		return false
 	}
-	if m.VisibilityTime != nil {
-		l = github_com_gogo_protobuf_types.SizeOfStdTime(*m.VisibilityTime)
-		n += 1 + l + sovRequestResponse(uint64(l))
+	if this.Notes != that1.Notes {
+		return false
 	}
-	return n
-}
-
-func (m *RemoveTaskResponse) Size() (n int) {
-	if m == nil {
-		return 0
+	if this.Identity != that1.Identity {
+		return false
 	}
-	var l int
-	_ = l
-	return n
+	return true
 }
-
-func (m *GetWorkflowExecutionRawHistoryV2Request) Size() (n int) {
-	if m == nil {
-		return 0
+func (this *PauseScheduleResponse) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
 	}
-	var l int
-	_ = l
-	l = len(m.Namespace)
-	if l > 0 {
-		n += 1 + l + sovRequestResponse(uint64(l))
+
+	that1, ok := that.(*PauseScheduleResponse)
+	if !ok {
+		that2, ok := that.(PauseScheduleResponse)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
 	}
-	if m.Execution != nil {
-		l = m.Execution.Size()
-		n += 1 + l + sovRequestResponse(uint64(l))
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
 	}
-	if m.StartEventId != 0 {
-		n += 1 + sovRequestResponse(uint64(m.StartEventId))
+	return true
+}
+func (this *UnpauseScheduleRequest) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
 	}
-	if m.StartEventVersion != 0 {
-		n += 1 + sovRequestResponse(uint64(m.StartEventVersion))
+
+	that1, ok := that.(*UnpauseScheduleRequest)
+	if !ok {
+		that2, ok := that.(UnpauseScheduleRequest)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
 	}
-	if m.EndEventId != 0 {
-		n += 1 + sovRequestResponse(uint64(m.EndEventId))
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
 	}
-	if m.EndEventVersion != 0 {
-		n += 1 + sovRequestResponse(uint64(m.EndEventVersion))
+	if this.Namespace != that1.Namespace {
+		return false
 	}
-	if m.MaximumPageSize != 0 {
-		n += 1 + sovRequestResponse(uint64(m.MaximumPageSize))
+	if this.ScheduleId != that1.ScheduleId {
+		return false
 	}
-	l = len(m.NextPageToken)
-	if l > 0 {
-		n += 1 + l + sovRequestResponse(uint64(l))
+	if this.Notes != that1.Notes {
+		return false
 	}
-	return n
-}
-
-func (m *GetWorkflowExecutionRawHistoryV2Response) Size() (n int) {
-	if m == nil {
-		return 0
+	if this.Identity != that1.Identity {
+		return false
 	}
-	var l int
-	_ = l
-	l = len(m.NextPageToken)
-	if l > 0 {
-		n += 1 + l + sovRequestResponse(uint64(l))
+	return true
+}
+func (this *UnpauseScheduleResponse) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
 	}
-	if len(m.HistoryBatches) > 0 {
-		for _, e := range m.HistoryBatches {
-			l = e.Size()
-			n += 1 + l + sovRequestResponse(uint64(l))
+
+	that1, ok := that.(*UnpauseScheduleResponse)
+	if !ok {
+		that2, ok := that.(UnpauseScheduleResponse)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
 		}
 	}
-	if m.VersionHistory != nil {
-		l = m.VersionHistory.Size()
-		n += 1 + l + sovRequestResponse(uint64(l))
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
 	}
-	return n
+	return true
 }
-
-func (m *GetReplicationMessagesRequest) Size() (n int) {
-	if m == nil {
-		return 0
+func (this *TriggerScheduleRequest) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
 	}
-	var l int
-	_ = l
-	if len(m.Tokens) > 0 {
-		for _, e := range m.Tokens {
-			l = e.Size()
-			n += 1 + l + sovRequestResponse(uint64(l))
+
+	that1, ok := that.(*TriggerScheduleRequest)
+	if !ok {
+		that2, ok := that.(TriggerScheduleRequest)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
 		}
 	}
-	l = len(m.ClusterName)
-	if l > 0 {
-		n += 1 + l + sovRequestResponse(uint64(l))
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
 	}
-	return n
-}
-
-func (m *GetReplicationMessagesResponse) Size() (n int) {
-	if m == nil {
-		return 0
+	if this.Namespace != that1.Namespace {
+		return false
 	}
-	var l int
-	_ = l
-	if len(m.ShardMessages) > 0 {
-		for k, v := range m.ShardMessages {
-			_ = k
-			_ = v
-			l = 0
-			if v != nil {
-				l = v.Size()
-				l += 1 + sovRequestResponse(uint64(l))
-			}
-			mapEntrySize := 1 + sovRequestResponse(uint64(k)) + l
-			n += mapEntrySize + 1 + sovRequestResponse(uint64(mapEntrySize))
-		}
+	if this.ScheduleId != that1.ScheduleId {
+		return false
 	}
-	return n
-}
-
-func (m *GetNamespaceReplicationMessagesRequest) Size() (n int) {
-	if m == nil {
-		return 0
+	if this.OverlapPolicy != that1.OverlapPolicy {
+		return false
 	}
-	var l int
-	_ = l
-	if m.LastRetrievedMessageId != 0 {
-		n += 1 + sovRequestResponse(uint64(m.LastRetrievedMessageId))
+	if len(this.Backfill) != len(that1.Backfill) {
+		return false
 	}
-	if m.LastProcessedMessageId != 0 {
-		n += 1 + sovRequestResponse(uint64(m.LastProcessedMessageId))
+	for i := range this.Backfill {
+		if !this.Backfill[i].Equal(that1.Backfill[i]) {
+			return false
+		}
 	}
-	l = len(m.ClusterName)
-	if l > 0 {
-		n += 1 + l + sovRequestResponse(uint64(l))
+	if this.Identity != that1.Identity {
+		return false
 	}
-	return n
+	return true
 }
-
-func (m *GetNamespaceReplicationMessagesResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.Messages != nil {
-		l = m.Messages.Size()
-		n += 1 + l + sovRequestResponse(uint64(l))
+func (this *TriggerScheduleResponse) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
 	}
-	return n
-}
 
-func (m *GetDLQReplicationMessagesRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if len(m.TaskInfos) > 0 {
-		for _, e := range m.TaskInfos {
-			l = e.Size()
-			n += 1 + l + sovRequestResponse(uint64(l))
+	that1, ok := that.(*TriggerScheduleResponse)
+	if !ok {
+		that2, ok := that.(TriggerScheduleResponse)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
 		}
 	}
-	return n
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	return true
 }
-
-func (m *GetDLQReplicationMessagesResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (this *DeleteScheduleRequest) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
 	}
-	var l int
-	_ = l
-	if len(m.ReplicationTasks) > 0 {
-		for _, e := range m.ReplicationTasks {
-			l = e.Size()
-			n += 1 + l + sovRequestResponse(uint64(l))
+
+	that1, ok := that.(*DeleteScheduleRequest)
+	if !ok {
+		that2, ok := that.(DeleteScheduleRequest)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
 		}
 	}
-	return n
-}
-
-func (m *ReapplyEventsRequest) Size() (n int) {
-	if m == nil {
-		return 0
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
 	}
-	var l int
-	_ = l
-	l = len(m.Namespace)
-	if l > 0 {
-		n += 1 + l + sovRequestResponse(uint64(l))
+	if this.Namespace != that1.Namespace {
+		return false
 	}
-	if m.WorkflowExecution != nil {
-		l = m.WorkflowExecution.Size()
-		n += 1 + l + sovRequestResponse(uint64(l))
+	if this.ScheduleId != that1.ScheduleId {
+		return false
 	}
-	if m.Events != nil {
-		l = m.Events.Size()
-		n += 1 + l + sovRequestResponse(uint64(l))
+	if this.Identity != that1.Identity {
+		return false
 	}
-	return n
+	return true
 }
-
-func (m *ReapplyEventsResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (this *DeleteScheduleResponse) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
 	}
-	var l int
-	_ = l
-	return n
-}
 
-func (m *AddSearchAttributeRequest) Size() (n int) {
-	if m == nil {
-		return 0
+	that1, ok := that.(*DeleteScheduleResponse)
+	if !ok {
+		that2, ok := that.(DeleteScheduleResponse)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
 	}
-	var l int
-	_ = l
-	if len(m.SearchAttribute) > 0 {
-		for k, v := range m.SearchAttribute {
-			_ = k
-			_ = v
-			mapEntrySize := 1 + len(k) + sovRequestResponse(uint64(len(k))) + 1 + sovRequestResponse(uint64(v))
-			n += mapEntrySize + 1 + sovRequestResponse(uint64(mapEntrySize))
-		}
-	}
-	l = len(m.SecurityToken)
-	if l > 0 {
-		n += 1 + l + sovRequestResponse(uint64(l))
-	}
-	return n
-}
-
-func (m *AddSearchAttributeResponse) Size() (n int) {
-	if m == nil {
-		return 0
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
 	}
-	var l int
-	_ = l
-	return n
+	return true
 }
-
-func (m *DescribeClusterRequest) Size() (n int) {
-	if m == nil {
-		return 0
+func (this *ListSchedulesRequest) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
 	}
-	var l int
-	_ = l
-	return n
-}
 
-func (m *DescribeClusterResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if len(m.SupportedClients) > 0 {
-		for k, v := range m.SupportedClients {
-			_ = k
-			_ = v
-			mapEntrySize := 1 + len(k) + sovRequestResponse(uint64(len(k))) + 1 + len(v) + sovRequestResponse(uint64(len(v)))
-			n += mapEntrySize + 1 + sovRequestResponse(uint64(mapEntrySize))
+	that1, ok := that.(*ListSchedulesRequest)
+	if !ok {
+		that2, ok := that.(ListSchedulesRequest)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
 		}
 	}
-	l = len(m.ServerVersion)
-	if l > 0 {
-		n += 1 + l + sovRequestResponse(uint64(l))
-	}
-	if m.MembershipInfo != nil {
-		l = m.MembershipInfo.Size()
-		n += 1 + l + sovRequestResponse(uint64(l))
-	}
-	return n
-}
-
-func (m *GetDLQMessagesRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.Type != 0 {
-		n += 1 + sovRequestResponse(uint64(m.Type))
-	}
-	if m.ShardId != 0 {
-		n += 1 + sovRequestResponse(uint64(m.ShardId))
-	}
-	l = len(m.SourceCluster)
-	if l > 0 {
-		n += 1 + l + sovRequestResponse(uint64(l))
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
 	}
-	if m.InclusiveEndMessageId != 0 {
-		n += 1 + sovRequestResponse(uint64(m.InclusiveEndMessageId))
+	if this.Namespace != that1.Namespace {
+		return false
 	}
-	if m.MaximumPageSize != 0 {
-		n += 1 + sovRequestResponse(uint64(m.MaximumPageSize))
+	if this.MaximumPageSize != that1.MaximumPageSize {
+		return false
 	}
-	l = len(m.NextPageToken)
-	if l > 0 {
-		n += 1 + l + sovRequestResponse(uint64(l))
+	if !bytes.Equal(this.NextPageToken, that1.NextPageToken) {
+		return false
 	}
-	return n
+	return true
 }
+func (this *ListSchedulesResponse) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
 
-func (m *GetDLQMessagesResponse) Size() (n int) {
-	if m == nil {
-		return 0
+	that1, ok := that.(*ListSchedulesResponse)
+	if !ok {
+		that2, ok := that.(ListSchedulesResponse)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
 	}
-	var l int
-	_ = l
-	if m.Type != 0 {
-		n += 1 + sovRequestResponse(uint64(m.Type))
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
 	}
-	if len(m.ReplicationTasks) > 0 {
-		for _, e := range m.ReplicationTasks {
-			l = e.Size()
-			n += 1 + l + sovRequestResponse(uint64(l))
+	if len(this.Schedules) != len(that1.Schedules) {
+		return false
+	}
+	for i := range this.Schedules {
+		if !this.Schedules[i].Equal(that1.Schedules[i]) {
+			return false
 		}
 	}
-	l = len(m.NextPageToken)
-	if l > 0 {
-		n += 1 + l + sovRequestResponse(uint64(l))
+	if !bytes.Equal(this.NextPageToken, that1.NextPageToken) {
+		return false
 	}
-	return n
+	return true
 }
+func (this *DeleteWorkflowExecutionRequest) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
 
-func (m *PurgeDLQMessagesRequest) Size() (n int) {
-	if m == nil {
-		return 0
+	that1, ok := that.(*DeleteWorkflowExecutionRequest)
+	if !ok {
+		that2, ok := that.(DeleteWorkflowExecutionRequest)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
 	}
-	var l int
-	_ = l
-	if m.Type != 0 {
-		n += 1 + sovRequestResponse(uint64(m.Type))
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
 	}
-	if m.ShardId != 0 {
-		n += 1 + sovRequestResponse(uint64(m.ShardId))
+	if this.Namespace != that1.Namespace {
+		return false
 	}
-	l = len(m.SourceCluster)
-	if l > 0 {
-		n += 1 + l + sovRequestResponse(uint64(l))
+	if !this.Execution.Equal(that1.Execution) {
+		return false
 	}
-	if m.InclusiveEndMessageId != 0 {
-		n += 1 + sovRequestResponse(uint64(m.InclusiveEndMessageId))
+	if this.Identity != that1.Identity {
+		return false
 	}
-	return n
+	return true
 }
+func (this *DeleteWorkflowExecutionResponse) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
 
-func (m *PurgeDLQMessagesResponse) Size() (n int) {
-	if m == nil {
-		return 0
+	that1, ok := that.(*DeleteWorkflowExecutionResponse)
+	if !ok {
+		that2, ok := that.(DeleteWorkflowExecutionResponse)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
 	}
-	var l int
-	_ = l
-	return n
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	return true
 }
+func (this *BatchResetParams) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
 
-func (m *MergeDLQMessagesRequest) Size() (n int) {
-	if m == nil {
-		return 0
+	that1, ok := that.(*BatchResetParams)
+	if !ok {
+		that2, ok := that.(BatchResetParams)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
 	}
-	var l int
-	_ = l
-	if m.Type != 0 {
-		n += 1 + sovRequestResponse(uint64(m.Type))
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
 	}
-	if m.ShardId != 0 {
-		n += 1 + sovRequestResponse(uint64(m.ShardId))
+	if this.ResetType != that1.ResetType {
+		return false
 	}
-	l = len(m.SourceCluster)
-	if l > 0 {
-		n += 1 + l + sovRequestResponse(uint64(l))
+	if this.BadBinaryChecksum != that1.BadBinaryChecksum {
+		return false
 	}
-	if m.InclusiveEndMessageId != 0 {
-		n += 1 + sovRequestResponse(uint64(m.InclusiveEndMessageId))
+	if this.SkipCurrentOpen != that1.SkipCurrentOpen {
+		return false
 	}
-	if m.MaximumPageSize != 0 {
-		n += 1 + sovRequestResponse(uint64(m.MaximumPageSize))
+	if this.SkipBaseNotCurrent != that1.SkipBaseNotCurrent {
+		return false
 	}
-	l = len(m.NextPageToken)
-	if l > 0 {
-		n += 1 + l + sovRequestResponse(uint64(l))
+	if this.NonDeterministicOnly != that1.NonDeterministicOnly {
+		return false
 	}
-	return n
+	return true
 }
+func (this *StartBatchOperationRequest) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
 
-func (m *MergeDLQMessagesResponse) Size() (n int) {
-	if m == nil {
-		return 0
+	that1, ok := that.(*StartBatchOperationRequest)
+	if !ok {
+		that2, ok := that.(StartBatchOperationRequest)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
 	}
-	var l int
-	_ = l
-	l = len(m.NextPageToken)
-	if l > 0 {
-		n += 1 + l + sovRequestResponse(uint64(l))
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
 	}
-	return n
-}
-
-func (m *RefreshWorkflowTasksRequest) Size() (n int) {
-	if m == nil {
-		return 0
+	if this.Namespace != that1.Namespace {
+		return false
 	}
-	var l int
-	_ = l
-	l = len(m.Namespace)
-	if l > 0 {
-		n += 1 + l + sovRequestResponse(uint64(l))
+	if this.JobId != that1.JobId {
+		return false
 	}
-	if m.Execution != nil {
-		l = m.Execution.Size()
-		n += 1 + l + sovRequestResponse(uint64(l))
+	if this.Query != that1.Query {
+		return false
 	}
-	return n
-}
-
-func (m *RefreshWorkflowTasksResponse) Size() (n int) {
-	if m == nil {
-		return 0
+	if this.Reason != that1.Reason {
+		return false
 	}
-	var l int
-	_ = l
-	return n
-}
-
-func (m *ResendReplicationTasksRequest) Size() (n int) {
-	if m == nil {
-		return 0
+	if this.BatchType != that1.BatchType {
+		return false
 	}
-	var l int
-	_ = l
-	l = len(m.NamespaceId)
-	if l > 0 {
-		n += 1 + l + sovRequestResponse(uint64(l))
+	if this.Identity != that1.Identity {
+		return false
 	}
-	l = len(m.WorkflowId)
-	if l > 0 {
-		n += 1 + l + sovRequestResponse(uint64(l))
+	if this.SignalName != that1.SignalName {
+		return false
 	}
-	l = len(m.RunId)
-	if l > 0 {
-		n += 1 + l + sovRequestResponse(uint64(l))
+	if !this.SignalInput.Equal(that1.SignalInput) {
+		return false
 	}
-	l = len(m.RemoteCluster)
-	if l > 0 {
-		n += 1 + l + sovRequestResponse(uint64(l))
+	if !this.ResetParams.Equal(that1.ResetParams) {
+		return false
 	}
-	if m.StartEventId != 0 {
-		n += 1 + sovRequestResponse(uint64(m.StartEventId))
+	if this.Rps != that1.Rps {
+		return false
 	}
-	if m.StartVersion != 0 {
-		n += 1 + sovRequestResponse(uint64(m.StartVersion))
+	return true
+}
+func (this *StartBatchOperationResponse) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
 	}
-	if m.EndEventId != 0 {
-		n += 1 + sovRequestResponse(uint64(m.EndEventId))
+
+	that1, ok := that.(*StartBatchOperationResponse)
+	if !ok {
+		that2, ok := that.(StartBatchOperationResponse)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
 	}
-	if m.EndVersion != 0 {
-		n += 1 + sovRequestResponse(uint64(m.EndVersion))
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
 	}
-	return n
+	return true
 }
-
-func (m *ResendReplicationTasksResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (this *StopBatchOperationRequest) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
 	}
-	var l int
-	_ = l
-	return n
-}
 
-func sovRequestResponse(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
-func sozRequestResponse(x uint64) (n int) {
-	return sovRequestResponse(uint64((x << 1) ^ uint64((int64(x) >> 63))))
-}
-func (this *DescribeWorkflowExecutionRequest) String() string {
-	if this == nil {
-		return "nil"
+	that1, ok := that.(*StopBatchOperationRequest)
+	if !ok {
+		that2, ok := that.(StopBatchOperationRequest)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
 	}
-	s := strings.Join([]string{`&DescribeWorkflowExecutionRequest{`,
-		`Namespace:` + fmt.Sprintf("%v", this.Namespace) + `,`,
-		`Execution:` + strings.Replace(fmt.Sprintf("%v", this.Execution), "WorkflowExecution", "v1.WorkflowExecution", 1) + `,`,
-		`}`,
-	}, "")
-	return s
-}
-func (this *DescribeWorkflowExecutionResponse) String() string {
-	if this == nil {
-		return "nil"
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
 	}
-	s := strings.Join([]string{`&DescribeWorkflowExecutionResponse{`,
-		`ShardId:` + fmt.Sprintf("%v", this.ShardId) + `,`,
-		`HistoryAddr:` + fmt.Sprintf("%v", this.HistoryAddr) + `,`,
-		`CacheMutableState:` + fmt.Sprintf("%v", this.CacheMutableState) + `,`,
-		`DatabaseMutableState:` + fmt.Sprintf("%v", this.DatabaseMutableState) + `,`,
-		`TreeId:` + fmt.Sprintf("%v", this.TreeId) + `,`,
-		`BranchId:` + fmt.Sprintf("%v", this.BranchId) + `,`,
-		`}`,
-	}, "")
-	return s
-}
-func (this *DescribeHistoryHostRequest) String() string {
-	if this == nil {
-		return "nil"
+	if this.Namespace != that1.Namespace {
+		return false
 	}
-	s := strings.Join([]string{`&DescribeHistoryHostRequest{`,
-		`HostAddress:` + fmt.Sprintf("%v", this.HostAddress) + `,`,
-		`ShardId:` + fmt.Sprintf("%v", this.ShardId) + `,`,
-		`Namespace:` + fmt.Sprintf("%v", this.Namespace) + `,`,
-		`WorkflowExecution:` + strings.Replace(fmt.Sprintf("%v", this.WorkflowExecution), "WorkflowExecution", "v1.WorkflowExecution", 1) + `,`,
-		`}`,
-	}, "")
-	return s
-}
-func (this *DescribeHistoryHostResponse) String() string {
-	if this == nil {
-		return "nil"
+	if this.JobId != that1.JobId {
+		return false
 	}
-	s := strings.Join([]string{`&DescribeHistoryHostResponse{`,
-		`ShardsNumber:` + fmt.Sprintf("%v", this.ShardsNumber) + `,`,
-		`ShardIds:` + fmt.Sprintf("%v", this.ShardIds) + `,`,
-		`NamespaceCache:` + strings.Replace(fmt.Sprintf("%v", this.NamespaceCache), "NamespaceCacheInfo", "v11.NamespaceCacheInfo", 1) + `,`,
-		`ShardControllerStatus:` + fmt.Sprintf("%v", this.ShardControllerStatus) + `,`,
-		`Address:` + fmt.Sprintf("%v", this.Address) + `,`,
-		`}`,
-	}, "")
-	return s
-}
-func (this *CloseShardRequest) String() string {
-	if this == nil {
-		return "nil"
+	if this.Reason != that1.Reason {
+		return false
 	}
-	s := strings.Join([]string{`&CloseShardRequest{`,
-		`ShardId:` + fmt.Sprintf("%v", this.ShardId) + `,`,
-		`}`,
-	}, "")
-	return s
-}
-func (this *CloseShardResponse) String() string {
-	if this == nil {
-		return "nil"
+	if this.Identity != that1.Identity {
+		return false
 	}
-	s := strings.Join([]string{`&CloseShardResponse{`,
-		`}`,
-	}, "")
-	return s
+	return true
 }
-func (this *RemoveTaskRequest) String() string {
-	if this == nil {
-		return "nil"
+func (this *StopBatchOperationResponse) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
 	}
-	s := strings.Join([]string{`&RemoveTaskRequest{`,
-		`ShardId:` + fmt.Sprintf("%v", this.ShardId) + `,`,
-		`Category:` + fmt.Sprintf("%v", this.Category) + `,`,
-		`TaskId:` + fmt.Sprintf("%v", this.TaskId) + `,`,
-		`VisibilityTime:` + strings.Replace(fmt.Sprintf("%v", this.VisibilityTime), "Timestamp", "types.Timestamp", 1) + `,`,
-		`}`,
-	}, "")
-	return s
-}
-func (this *RemoveTaskResponse) String() string {
-	if this == nil {
-		return "nil"
+
+	that1, ok := that.(*StopBatchOperationResponse)
+	if !ok {
+		that2, ok := that.(StopBatchOperationResponse)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
 	}
-	s := strings.Join([]string{`&RemoveTaskResponse{`,
-		`}`,
-	}, "")
-	return s
-}
-func (this *GetWorkflowExecutionRawHistoryV2Request) String() string {
-	if this == nil {
-		return "nil"
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
 	}
-	s := strings.Join([]string{`&GetWorkflowExecutionRawHistoryV2Request{`,
-		`Namespace:` + fmt.Sprintf("%v", this.Namespace) + `,`,
-		`Execution:` + strings.Replace(fmt.Sprintf("%v", this.Execution), "WorkflowExecution", "v1.WorkflowExecution", 1) + `,`,
-		`StartEventId:` + fmt.Sprintf("%v", this.StartEventId) + `,`,
-		`StartEventVersion:` + fmt.Sprintf("%v", this.StartEventVersion) + `,`,
-		`EndEventId:` + fmt.Sprintf("%v", this.EndEventId) + `,`,
-		`EndEventVersion:` + fmt.Sprintf("%v", this.EndEventVersion) + `,`,
-		`MaximumPageSize:` + fmt.Sprintf("%v", this.MaximumPageSize) + `,`,
-		`NextPageToken:` + fmt.Sprintf("%v", this.NextPageToken) + `,`,
-		`}`,
-	}, "")
-	return s
+	return true
 }
-func (this *GetWorkflowExecutionRawHistoryV2Response) String() string {
-	if this == nil {
-		return "nil"
+func (this *DescribeBatchOperationRequest) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
 	}
-	repeatedStringForHistoryBatches := "[]*DataBlob{"
-	for _, f := range this.HistoryBatches {
-		repeatedStringForHistoryBatches += strings.Replace(fmt.Sprintf("%v", f), "DataBlob", "v1.DataBlob", 1) + ","
+
+	that1, ok := that.(*DescribeBatchOperationRequest)
+	if !ok {
+		that2, ok := that.(DescribeBatchOperationRequest)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
 	}
-	repeatedStringForHistoryBatches += "}"
-	s := strings.Join([]string{`&GetWorkflowExecutionRawHistoryV2Response{`,
-		`NextPageToken:` + fmt.Sprintf("%v", this.NextPageToken) + `,`,
-		`HistoryBatches:` + repeatedStringForHistoryBatches + `,`,
-		`VersionHistory:` + strings.Replace(fmt.Sprintf("%v", this.VersionHistory), "VersionHistory", "v13.VersionHistory", 1) + `,`,
-		`}`,
-	}, "")
-	return s
-}
-func (this *GetReplicationMessagesRequest) String() string {
-	if this == nil {
-		return "nil"
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
 	}
-	repeatedStringForTokens := "[]*ReplicationToken{"
-	for _, f := range this.Tokens {
-		repeatedStringForTokens += strings.Replace(fmt.Sprintf("%v", f), "ReplicationToken", "v14.ReplicationToken", 1) + ","
+	if this.Namespace != that1.Namespace {
+		return false
 	}
-	repeatedStringForTokens += "}"
-	s := strings.Join([]string{`&GetReplicationMessagesRequest{`,
-		`Tokens:` + repeatedStringForTokens + `,`,
-		`ClusterName:` + fmt.Sprintf("%v", this.ClusterName) + `,`,
-		`}`,
-	}, "")
-	return s
+	if this.JobId != that1.JobId {
+		return false
+	}
+	return true
 }
-func (this *GetReplicationMessagesResponse) String() string {
-	if this == nil {
-		return "nil"
+func (this *DescribeBatchOperationResponse) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
 	}
-	keysForShardMessages := make([]int32, 0, len(this.ShardMessages))
-	for k, _ := range this.ShardMessages {
-		keysForShardMessages = append(keysForShardMessages, k)
+
+	that1, ok := that.(*DescribeBatchOperationResponse)
+	if !ok {
+		that2, ok := that.(DescribeBatchOperationResponse)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
 	}
-	github_com_gogo_protobuf_sortkeys.Int32s(keysForShardMessages)
-	mapStringForShardMessages := "map[int32]*v14.ReplicationMessages{"
-	for _, k := range keysForShardMessages {
-		mapStringForShardMessages += fmt.Sprintf("%v: %v,", k, this.ShardMessages[k])
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
 	}
-	mapStringForShardMessages += "}"
-	s := strings.Join([]string{`&GetReplicationMessagesResponse{`,
-		`ShardMessages:` + mapStringForShardMessages + `,`,
-		`}`,
-	}, "")
-	return s
-}
-func (this *GetNamespaceReplicationMessagesRequest) String() string {
-	if this == nil {
-		return "nil"
+	if this.JobId != that1.JobId {
+		return false
 	}
-	s := strings.Join([]string{`&GetNamespaceReplicationMessagesRequest{`,
-		`LastRetrievedMessageId:` + fmt.Sprintf("%v", this.LastRetrievedMessageId) + `,`,
-		`LastProcessedMessageId:` + fmt.Sprintf("%v", this.LastProcessedMessageId) + `,`,
-		`ClusterName:` + fmt.Sprintf("%v", this.ClusterName) + `,`,
-		`}`,
-	}, "")
-	return s
-}
-func (this *GetNamespaceReplicationMessagesResponse) String() string {
-	if this == nil {
-		return "nil"
+	if this.State != that1.State {
+		return false
 	}
-	s := strings.Join([]string{`&GetNamespaceReplicationMessagesResponse{`,
-		`Messages:` + strings.Replace(fmt.Sprintf("%v", this.Messages), "ReplicationMessages", "v14.ReplicationMessages", 1) + `,`,
-		`}`,
-	}, "")
-	return s
-}
-func (this *GetDLQReplicationMessagesRequest) String() string {
-	if this == nil {
-		return "nil"
+	if that1.StartTime == nil {
+		if this.StartTime != nil {
+			return false
+		}
+	} else if !this.StartTime.Equal(*that1.StartTime) {
+		return false
 	}
-	repeatedStringForTaskInfos := "[]*ReplicationTaskInfo{"
-	for _, f := range this.TaskInfos {
-		repeatedStringForTaskInfos += strings.Replace(fmt.Sprintf("%v", f), "ReplicationTaskInfo", "v14.ReplicationTaskInfo", 1) + ","
+	if that1.CloseTime == nil {
+		if this.CloseTime != nil {
+			return false
+		}
+	} else if !this.CloseTime.Equal(*that1.CloseTime) {
+		return false
 	}
-	repeatedStringForTaskInfos += "}"
-	s := strings.Join([]string{`&GetDLQReplicationMessagesRequest{`,
-		`TaskInfos:` + repeatedStringForTaskInfos + `,`,
-		`}`,
-	}, "")
-	return s
-}
-func (this *GetDLQReplicationMessagesResponse) String() string {
-	if this == nil {
-		return "nil"
+	if this.Reason != that1.Reason {
+		return false
 	}
-	repeatedStringForReplicationTasks := "[]*ReplicationTask{"
-	for _, f := range this.ReplicationTasks {
-		repeatedStringForReplicationTasks += strings.Replace(fmt.Sprintf("%v", f), "ReplicationTask", "v14.ReplicationTask", 1) + ","
+	if this.Identity != that1.Identity {
+		return false
 	}
-	repeatedStringForReplicationTasks += "}"
-	s := strings.Join([]string{`&GetDLQReplicationMessagesResponse{`,
-		`ReplicationTasks:` + repeatedStringForReplicationTasks + `,`,
-		`}`,
-	}, "")
-	return s
-}
-func (this *ReapplyEventsRequest) String() string {
-	if this == nil {
-		return "nil"
+	if this.TotalOperationCount != that1.TotalOperationCount {
+		return false
 	}
-	s := strings.Join([]string{`&ReapplyEventsRequest{`,
-		`Namespace:` + fmt.Sprintf("%v", this.Namespace) + `,`,
-		`WorkflowExecution:` + strings.Replace(fmt.Sprintf("%v", this.WorkflowExecution), "WorkflowExecution", "v1.WorkflowExecution", 1) + `,`,
-		`Events:` + strings.Replace(fmt.Sprintf("%v", this.Events), "DataBlob", "v1.DataBlob", 1) + `,`,
-		`}`,
-	}, "")
-	return s
-}
-func (this *ReapplyEventsResponse) String() string {
-	if this == nil {
-		return "nil"
+	if this.CompleteOperationCount != that1.CompleteOperationCount {
+		return false
 	}
-	s := strings.Join([]string{`&ReapplyEventsResponse{`,
-		`}`,
-	}, "")
-	return s
+	if this.FailureOperationCount != that1.FailureOperationCount {
+		return false
+	}
+	return true
 }
-func (this *AddSearchAttributeRequest) String() string {
-	if this == nil {
-		return "nil"
+func (this *ListBatchOperationsRequest) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
 	}
-	keysForSearchAttribute := make([]string, 0, len(this.SearchAttribute))
-	for k, _ := range this.SearchAttribute {
-		keysForSearchAttribute = append(keysForSearchAttribute, k)
+
+	that1, ok := that.(*ListBatchOperationsRequest)
+	if !ok {
+		that2, ok := that.(ListBatchOperationsRequest)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
 	}
-	github_com_gogo_protobuf_sortkeys.Strings(keysForSearchAttribute)
-	mapStringForSearchAttribute := "map[string]v15.IndexedValueType{"
-	for _, k := range keysForSearchAttribute {
-		mapStringForSearchAttribute += fmt.Sprintf("%v: %v,", k, this.SearchAttribute[k])
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
 	}
-	mapStringForSearchAttribute += "}"
-	s := strings.Join([]string{`&AddSearchAttributeRequest{`,
-		`SearchAttribute:` + mapStringForSearchAttribute + `,`,
-		`SecurityToken:` + fmt.Sprintf("%v", this.SecurityToken) + `,`,
-		`}`,
-	}, "")
-	return s
-}
-func (this *AddSearchAttributeResponse) String() string {
-	if this == nil {
-		return "nil"
+	if this.Namespace != that1.Namespace {
+		return false
 	}
-	s := strings.Join([]string{`&AddSearchAttributeResponse{`,
-		`}`,
-	}, "")
-	return s
-}
-func (this *DescribeClusterRequest) String() string {
-	if this == nil {
-		return "nil"
+	if this.PageSize != that1.PageSize {
+		return false
 	}
-	s := strings.Join([]string{`&DescribeClusterRequest{`,
-		`}`,
-	}, "")
-	return s
+	if !bytes.Equal(this.NextPageToken, that1.NextPageToken) {
+		return false
+	}
+	return true
 }
-func (this *DescribeClusterResponse) String() string {
-	if this == nil {
-		return "nil"
+func (this *BatchOperationInfo) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
 	}
-	keysForSupportedClients := make([]string, 0, len(this.SupportedClients))
-	for k, _ := range this.SupportedClients {
-		keysForSupportedClients = append(keysForSupportedClients, k)
+
+	that1, ok := that.(*BatchOperationInfo)
+	if !ok {
+		that2, ok := that.(BatchOperationInfo)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
 	}
-	github_com_gogo_protobuf_sortkeys.Strings(keysForSupportedClients)
-	mapStringForSupportedClients := "map[string]string{"
-	for _, k := range keysForSupportedClients {
-		mapStringForSupportedClients += fmt.Sprintf("%v: %v,", k, this.SupportedClients[k])
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
 	}
-	mapStringForSupportedClients += "}"
-	s := strings.Join([]string{`&DescribeClusterResponse{`,
-		`SupportedClients:` + mapStringForSupportedClients + `,`,
-		`ServerVersion:` + fmt.Sprintf("%v", this.ServerVersion) + `,`,
-		`MembershipInfo:` + strings.Replace(fmt.Sprintf("%v", this.MembershipInfo), "MembershipInfo", "v16.MembershipInfo", 1) + `,`,
-		`}`,
-	}, "")
-	return s
-}
-func (this *GetDLQMessagesRequest) String() string {
-	if this == nil {
-		return "nil"
+	if this.JobId != that1.JobId {
+		return false
 	}
-	s := strings.Join([]string{`&GetDLQMessagesRequest{`,
-		`Type:` + fmt.Sprintf("%v", this.Type) + `,`,
-		`ShardId:` + fmt.Sprintf("%v", this.ShardId) + `,`,
-		`SourceCluster:` + fmt.Sprintf("%v", this.SourceCluster) + `,`,
-		`InclusiveEndMessageId:` + fmt.Sprintf("%v", this.InclusiveEndMessageId) + `,`,
-		`MaximumPageSize:` + fmt.Sprintf("%v", this.MaximumPageSize) + `,`,
-		`NextPageToken:` + fmt.Sprintf("%v", this.NextPageToken) + `,`,
-		`}`,
-	}, "")
-	return s
-}
-func (this *GetDLQMessagesResponse) String() string {
-	if this == nil {
-		return "nil"
+	if this.State != that1.State {
+		return false
 	}
-	repeatedStringForReplicationTasks := "[]*ReplicationTask{"
-	for _, f := range this.ReplicationTasks {
-		repeatedStringForReplicationTasks += strings.Replace(fmt.Sprintf("%v", f), "ReplicationTask", "v14.ReplicationTask", 1) + ","
+	if that1.StartTime == nil {
+		if this.StartTime != nil {
+			return false
+		}
+	} else if !this.StartTime.Equal(*that1.StartTime) {
+		return false
 	}
-	repeatedStringForReplicationTasks += "}"
-	s := strings.Join([]string{`&GetDLQMessagesResponse{`,
-		`Type:` + fmt.Sprintf("%v", this.Type) + `,`,
-		`ReplicationTasks:` + repeatedStringForReplicationTasks + `,`,
-		`NextPageToken:` + fmt.Sprintf("%v", this.NextPageToken) + `,`,
-		`}`,
-	}, "")
-	return s
-}
-func (this *PurgeDLQMessagesRequest) String() string {
-	if this == nil {
-		return "nil"
+	if that1.CloseTime == nil {
+		if this.CloseTime != nil {
+			return false
+		}
+	} else if !this.CloseTime.Equal(*that1.CloseTime) {
+		return false
 	}
-	s := strings.Join([]string{`&PurgeDLQMessagesRequest{`,
-		`Type:` + fmt.Sprintf("%v", this.Type) + `,`,
-		`ShardId:` + fmt.Sprintf("%v", this.ShardId) + `,`,
-		`SourceCluster:` + fmt.Sprintf("%v", this.SourceCluster) + `,`,
-		`InclusiveEndMessageId:` + fmt.Sprintf("%v", this.InclusiveEndMessageId) + `,`,
-		`}`,
-	}, "")
-	return s
+	return true
 }
-func (this *PurgeDLQMessagesResponse) String() string {
-	if this == nil {
-		return "nil"
+func (this *ListBatchOperationsResponse) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
 	}
-	s := strings.Join([]string{`&PurgeDLQMessagesResponse{`,
-		`}`,
-	}, "")
-	return s
+
+	that1, ok := that.(*ListBatchOperationsResponse)
+	if !ok {
+		that2, ok := that.(ListBatchOperationsResponse)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if len(this.OperationInfo) != len(that1.OperationInfo) {
+		return false
+	}
+	for i := range this.OperationInfo {
+		if !this.OperationInfo[i].Equal(that1.OperationInfo[i]) {
+			return false
+		}
+	}
+	if !bytes.Equal(this.NextPageToken, that1.NextPageToken) {
+		return false
+	}
+	return true
 }
-func (this *MergeDLQMessagesRequest) String() string {
-	if this == nil {
-		return "nil"
+func (this *UpdateWorkflowExecutionRequest) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
 	}
-	s := strings.Join([]string{`&MergeDLQMessagesRequest{`,
-		`Type:` + fmt.Sprintf("%v", this.Type) + `,`,
-		`ShardId:` + fmt.Sprintf("%v", this.ShardId) + `,`,
-		`SourceCluster:` + fmt.Sprintf("%v", this.SourceCluster) + `,`,
-		`InclusiveEndMessageId:` + fmt.Sprintf("%v", this.InclusiveEndMessageId) + `,`,
-		`MaximumPageSize:` + fmt.Sprintf("%v", this.MaximumPageSize) + `,`,
-		`NextPageToken:` + fmt.Sprintf("%v", this.NextPageToken) + `,`,
-		`}`,
-	}, "")
-	return s
+
+	that1, ok := that.(*UpdateWorkflowExecutionRequest)
+	if !ok {
+		that2, ok := that.(UpdateWorkflowExecutionRequest)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.Namespace != that1.Namespace {
+		return false
+	}
+	if !this.Execution.Equal(that1.Execution) {
+		return false
+	}
+	if this.UpdateId != that1.UpdateId {
+		return false
+	}
+	if this.Name != that1.Name {
+		return false
+	}
+	if !this.Input.Equal(that1.Input) {
+		return false
+	}
+	if this.Identity != that1.Identity {
+		return false
+	}
+	if this.WaitForAccepted != that1.WaitForAccepted {
+		return false
+	}
+	return true
 }
-func (this *MergeDLQMessagesResponse) String() string {
-	if this == nil {
-		return "nil"
+func (this *UpdateWorkflowExecutionResponse) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
 	}
-	s := strings.Join([]string{`&MergeDLQMessagesResponse{`,
-		`NextPageToken:` + fmt.Sprintf("%v", this.NextPageToken) + `,`,
-		`}`,
-	}, "")
-	return s
+
+	that1, ok := that.(*UpdateWorkflowExecutionResponse)
+	if !ok {
+		that2, ok := that.(UpdateWorkflowExecutionResponse)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.UpdateId != that1.UpdateId {
+		return false
+	}
+	if this.Completed != that1.Completed {
+		return false
+	}
+	if !this.Result.Equal(that1.Result) {
+		return false
+	}
+	if !this.Failure.Equal(that1.Failure) {
+		return false
+	}
+	return true
 }
-func (this *RefreshWorkflowTasksRequest) String() string {
-	if this == nil {
-		return "nil"
+func (this *GetWorkflowExecutionResultRequest) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
 	}
-	s := strings.Join([]string{`&RefreshWorkflowTasksRequest{`,
-		`Namespace:` + fmt.Sprintf("%v", this.Namespace) + `,`,
-		`Execution:` + strings.Replace(fmt.Sprintf("%v", this.Execution), "WorkflowExecution", "v1.WorkflowExecution", 1) + `,`,
-		`}`,
-	}, "")
-	return s
+
+	that1, ok := that.(*GetWorkflowExecutionResultRequest)
+	if !ok {
+		that2, ok := that.(GetWorkflowExecutionResultRequest)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.Namespace != that1.Namespace {
+		return false
+	}
+	if !this.Execution.Equal(that1.Execution) {
+		return false
+	}
+	if this.FollowRuns != that1.FollowRuns {
+		return false
+	}
+	return true
 }
-func (this *RefreshWorkflowTasksResponse) String() string {
-	if this == nil {
-		return "nil"
+func (this *GetWorkflowExecutionResultResponse) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*GetWorkflowExecutionResultResponse)
+	if !ok {
+		that2, ok := that.(GetWorkflowExecutionResultResponse)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if !this.Execution.Equal(that1.Execution) {
+		return false
+	}
+	if this.Status != that1.Status {
+		return false
+	}
+	if !this.Result.Equal(that1.Result) {
+		return false
+	}
+	if !this.Failure.Equal(that1.Failure) {
+		return false
+	}
+	if this.NewExecutionRunId != that1.NewExecutionRunId {
+		return false
+	}
+	return true
+}
+func (this *ResetWorkflowExecutionWithOptionsRequest) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*ResetWorkflowExecutionWithOptionsRequest)
+	if !ok {
+		that2, ok := that.(ResetWorkflowExecutionWithOptionsRequest)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.Namespace != that1.Namespace {
+		return false
+	}
+	if !this.Execution.Equal(that1.Execution) {
+		return false
+	}
+	if this.Reason != that1.Reason {
+		return false
+	}
+	if this.RequestId != that1.RequestId {
+		return false
+	}
+	if this.WorkflowTaskFinishEventId != that1.WorkflowTaskFinishEventId {
+		return false
+	}
+	if this.WorkflowTaskScheduledEventId != that1.WorkflowTaskScheduledEventId {
+		return false
+	}
+	if this.ReapplyType != that1.ReapplyType {
+		return false
+	}
+	if this.DryRun != that1.DryRun {
+		return false
+	}
+	return true
+}
+func (this *ResetWorkflowExecutionWithOptionsResponse) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*ResetWorkflowExecutionWithOptionsResponse)
+	if !ok {
+		that2, ok := that.(ResetWorkflowExecutionWithOptionsResponse)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.RunId != that1.RunId {
+		return false
+	}
+	if !this.Preview.Equal(that1.Preview) {
+		return false
+	}
+	return true
+}
+func (this *ResetWorkflowExecutionPreview) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*ResetWorkflowExecutionPreview)
+	if !ok {
+		that2, ok := that.(ResetWorkflowExecutionPreview)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.BaseRunId != that1.BaseRunId {
+		return false
+	}
+	if this.WorkflowTaskFinishEventId != that1.WorkflowTaskFinishEventId {
+		return false
+	}
+	if this.TerminatedRunId != that1.TerminatedRunId {
+		return false
+	}
+	if len(this.DroppedEvents) != len(that1.DroppedEvents) {
+		return false
+	}
+	for i := range this.DroppedEvents {
+		if !this.DroppedEvents[i].Equal(that1.DroppedEvents[i]) {
+			return false
+		}
+	}
+	if len(this.FailedActivities) != len(that1.FailedActivities) {
+		return false
+	}
+	for i := range this.FailedActivities {
+		if !this.FailedActivities[i].Equal(that1.FailedActivities[i]) {
+			return false
+		}
+	}
+	if len(this.PendingChildren) != len(that1.PendingChildren) {
+		return false
+	}
+	for i := range this.PendingChildren {
+		if !this.PendingChildren[i].Equal(that1.PendingChildren[i]) {
+			return false
+		}
+	}
+	if len(this.ReappliedEvents) != len(that1.ReappliedEvents) {
+		return false
+	}
+	for i := range this.ReappliedEvents {
+		if !this.ReappliedEvents[i].Equal(that1.ReappliedEvents[i]) {
+			return false
+		}
+	}
+	return true
+}
+func (this *PauseWorkflowExecutionRequest) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*PauseWorkflowExecutionRequest)
+	if !ok {
+		that2, ok := that.(PauseWorkflowExecutionRequest)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.Namespace != that1.Namespace {
+		return false
+	}
+	if !this.Execution.Equal(that1.Execution) {
+		return false
+	}
+	if this.Identity != that1.Identity {
+		return false
+	}
+	if this.Reason != that1.Reason {
+		return false
+	}
+	return true
+}
+func (this *PauseWorkflowExecutionResponse) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*PauseWorkflowExecutionResponse)
+	if !ok {
+		that2, ok := that.(PauseWorkflowExecutionResponse)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	return true
+}
+func (this *UnpauseWorkflowExecutionRequest) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*UnpauseWorkflowExecutionRequest)
+	if !ok {
+		that2, ok := that.(UnpauseWorkflowExecutionRequest)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.Namespace != that1.Namespace {
+		return false
+	}
+	if !this.Execution.Equal(that1.Execution) {
+		return false
+	}
+	if this.Identity != that1.Identity {
+		return false
+	}
+	return true
+}
+func (this *UnpauseWorkflowExecutionResponse) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*UnpauseWorkflowExecutionResponse)
+	if !ok {
+		that2, ok := that.(UnpauseWorkflowExecutionResponse)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	return true
+}
+func (this *DescribeWorkflowExecutionRequest) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 6)
+	s = append(s, "&adminservice.DescribeWorkflowExecutionRequest{")
+	s = append(s, "Namespace: "+fmt.Sprintf("%#v", this.Namespace)+",\n")
+	if this.Execution != nil {
+		s = append(s, "Execution: "+fmt.Sprintf("%#v", this.Execution)+",\n")
+	}
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func (this *DescribeWorkflowExecutionResponse) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 10)
+	s = append(s, "&adminservice.DescribeWorkflowExecutionResponse{")
+	s = append(s, "ShardId: "+fmt.Sprintf("%#v", this.ShardId)+",\n")
+	s = append(s, "HistoryAddr: "+fmt.Sprintf("%#v", this.HistoryAddr)+",\n")
+	s = append(s, "CacheMutableState: "+fmt.Sprintf("%#v", this.CacheMutableState)+",\n")
+	s = append(s, "DatabaseMutableState: "+fmt.Sprintf("%#v", this.DatabaseMutableState)+",\n")
+	s = append(s, "TreeId: "+fmt.Sprintf("%#v", this.TreeId)+",\n")
+	s = append(s, "BranchId: "+fmt.Sprintf("%#v", this.BranchId)+",\n")
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func (this *DescribeHistoryHostRequest) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 8)
+	s = append(s, "&adminservice.DescribeHistoryHostRequest{")
+	s = append(s, "HostAddress: "+fmt.Sprintf("%#v", this.HostAddress)+",\n")
+	s = append(s, "ShardId: "+fmt.Sprintf("%#v", this.ShardId)+",\n")
+	s = append(s, "Namespace: "+fmt.Sprintf("%#v", this.Namespace)+",\n")
+	if this.WorkflowExecution != nil {
+		s = append(s, "WorkflowExecution: "+fmt.Sprintf("%#v", this.WorkflowExecution)+",\n")
+	}
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func (this *DescribeHistoryHostResponse) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 9)
+	s = append(s, "&adminservice.DescribeHistoryHostResponse{")
+	s = append(s, "ShardsNumber: "+fmt.Sprintf("%#v", this.ShardsNumber)+",\n")
+	s = append(s, "ShardIds: "+fmt.Sprintf("%#v", this.ShardIds)+",\n")
+	if this.NamespaceCache != nil {
+		s = append(s, "NamespaceCache: "+fmt.Sprintf("%#v", this.NamespaceCache)+",\n")
+	}
+	s = append(s, "ShardControllerStatus: "+fmt.Sprintf("%#v", this.ShardControllerStatus)+",\n")
+	s = append(s, "Address: "+fmt.Sprintf("%#v", this.Address)+",\n")
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func (this *CloseShardRequest) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 5)
+	s = append(s, "&adminservice.CloseShardRequest{")
+	s = append(s, "ShardId: "+fmt.Sprintf("%#v", this.ShardId)+",\n")
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func (this *CloseShardResponse) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 4)
+	s = append(s, "&adminservice.CloseShardResponse{")
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func (this *RemoveTaskRequest) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 8)
+	s = append(s, "&adminservice.RemoveTaskRequest{")
+	s = append(s, "ShardId: "+fmt.Sprintf("%#v", this.ShardId)+",\n")
+	s = append(s, "Category: "+fmt.Sprintf("%#v", this.Category)+",\n")
+	s = append(s, "TaskId: "+fmt.Sprintf("%#v", this.TaskId)+",\n")
+	s = append(s, "VisibilityTime: "+fmt.Sprintf("%#v", this.VisibilityTime)+",\n")
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func (this *RemoveTaskResponse) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 4)
+	s = append(s, "&adminservice.RemoveTaskResponse{")
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func (this *GetWorkflowExecutionRawHistoryV2Request) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 12)
+	s = append(s, "&adminservice.GetWorkflowExecutionRawHistoryV2Request{")
+	s = append(s, "Namespace: "+fmt.Sprintf("%#v", this.Namespace)+",\n")
+	if this.Execution != nil {
+		s = append(s, "Execution: "+fmt.Sprintf("%#v", this.Execution)+",\n")
+	}
+	s = append(s, "StartEventId: "+fmt.Sprintf("%#v", this.StartEventId)+",\n")
+	s = append(s, "StartEventVersion: "+fmt.Sprintf("%#v", this.StartEventVersion)+",\n")
+	s = append(s, "EndEventId: "+fmt.Sprintf("%#v", this.EndEventId)+",\n")
+	s = append(s, "EndEventVersion: "+fmt.Sprintf("%#v", this.EndEventVersion)+",\n")
+	s = append(s, "MaximumPageSize: "+fmt.Sprintf("%#v", this.MaximumPageSize)+",\n")
+	s = append(s, "NextPageToken: "+fmt.Sprintf("%#v", this.NextPageToken)+",\n")
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func (this *GetWorkflowExecutionRawHistoryV2Response) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 7)
+	s = append(s, "&adminservice.GetWorkflowExecutionRawHistoryV2Response{")
+	s = append(s, "NextPageToken: "+fmt.Sprintf("%#v", this.NextPageToken)+",\n")
+	if this.HistoryBatches != nil {
+		s = append(s, "HistoryBatches: "+fmt.Sprintf("%#v", this.HistoryBatches)+",\n")
+	}
+	if this.VersionHistory != nil {
+		s = append(s, "VersionHistory: "+fmt.Sprintf("%#v", this.VersionHistory)+",\n")
+	}
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func (this *GetReplicationMessagesRequest) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 6)
+	s = append(s, "&adminservice.GetReplicationMessagesRequest{")
+	if this.Tokens != nil {
+		s = append(s, "Tokens: "+fmt.Sprintf("%#v", this.Tokens)+",\n")
+	}
+	s = append(s, "ClusterName: "+fmt.Sprintf("%#v", this.ClusterName)+",\n")
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func (this *GetReplicationMessagesResponse) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 5)
+	s = append(s, "&adminservice.GetReplicationMessagesResponse{")
+	keysForShardMessages := make([]int32, 0, len(this.ShardMessages))
+	for k, _ := range this.ShardMessages {
+		keysForShardMessages = append(keysForShardMessages, k)
+	}
+	github_com_gogo_protobuf_sortkeys.Int32s(keysForShardMessages)
+	mapStringForShardMessages := "map[int32]*v14.ReplicationMessages{"
+	for _, k := range keysForShardMessages {
+		mapStringForShardMessages += fmt.Sprintf("%#v: %#v,", k, this.ShardMessages[k])
+	}
+	mapStringForShardMessages += "}"
+	if this.ShardMessages != nil {
+		s = append(s, "ShardMessages: "+mapStringForShardMessages+",\n")
+	}
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func (this *GetNamespaceReplicationMessagesRequest) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 7)
+	s = append(s, "&adminservice.GetNamespaceReplicationMessagesRequest{")
+	s = append(s, "LastRetrievedMessageId: "+fmt.Sprintf("%#v", this.LastRetrievedMessageId)+",\n")
+	s = append(s, "LastProcessedMessageId: "+fmt.Sprintf("%#v", this.LastProcessedMessageId)+",\n")
+	s = append(s, "ClusterName: "+fmt.Sprintf("%#v", this.ClusterName)+",\n")
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func (this *GetNamespaceReplicationMessagesResponse) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 5)
+	s = append(s, "&adminservice.GetNamespaceReplicationMessagesResponse{")
+	if this.Messages != nil {
+		s = append(s, "Messages: "+fmt.Sprintf("%#v", this.Messages)+",\n")
+	}
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func (this *GetDLQReplicationMessagesRequest) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 5)
+	s = append(s, "&adminservice.GetDLQReplicationMessagesRequest{")
+	if this.TaskInfos != nil {
+		s = append(s, "TaskInfos: "+fmt.Sprintf("%#v", this.TaskInfos)+",\n")
+	}
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func (this *GetDLQReplicationMessagesResponse) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 5)
+	s = append(s, "&adminservice.GetDLQReplicationMessagesResponse{")
+	if this.ReplicationTasks != nil {
+		s = append(s, "ReplicationTasks: "+fmt.Sprintf("%#v", this.ReplicationTasks)+",\n")
+	}
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func (this *ReapplyEventsRequest) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 7)
+	s = append(s, "&adminservice.ReapplyEventsRequest{")
+	s = append(s, "Namespace: "+fmt.Sprintf("%#v", this.Namespace)+",\n")
+	if this.WorkflowExecution != nil {
+		s = append(s, "WorkflowExecution: "+fmt.Sprintf("%#v", this.WorkflowExecution)+",\n")
+	}
+	if this.Events != nil {
+		s = append(s, "Events: "+fmt.Sprintf("%#v", this.Events)+",\n")
+	}
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func (this *ReapplyEventsResponse) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 4)
+	s = append(s, "&adminservice.ReapplyEventsResponse{")
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func (this *AddSearchAttributeRequest) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 6)
+	s = append(s, "&adminservice.AddSearchAttributeRequest{")
+	keysForSearchAttribute := make([]string, 0, len(this.SearchAttribute))
+	for k, _ := range this.SearchAttribute {
+		keysForSearchAttribute = append(keysForSearchAttribute, k)
+	}
+	github_com_gogo_protobuf_sortkeys.Strings(keysForSearchAttribute)
+	mapStringForSearchAttribute := "map[string]v15.IndexedValueType{"
+	for _, k := range keysForSearchAttribute {
+		mapStringForSearchAttribute += fmt.Sprintf("%#v: %#v,", k, this.SearchAttribute[k])
+	}
+	mapStringForSearchAttribute += "}"
+	if this.SearchAttribute != nil {
+		s = append(s, "SearchAttribute: "+mapStringForSearchAttribute+",\n")
+	}
+	s = append(s, "SecurityToken: "+fmt.Sprintf("%#v", this.SecurityToken)+",\n")
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func (this *AddSearchAttributeResponse) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 4)
+	s = append(s, "&adminservice.AddSearchAttributeResponse{")
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func (this *DescribeClusterRequest) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 4)
+	s = append(s, "&adminservice.DescribeClusterRequest{")
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func (this *DescribeClusterResponse) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 7)
+	s = append(s, "&adminservice.DescribeClusterResponse{")
+	keysForSupportedClients := make([]string, 0, len(this.SupportedClients))
+	for k, _ := range this.SupportedClients {
+		keysForSupportedClients = append(keysForSupportedClients, k)
+	}
+	github_com_gogo_protobuf_sortkeys.Strings(keysForSupportedClients)
+	mapStringForSupportedClients := "map[string]string{"
+	for _, k := range keysForSupportedClients {
+		mapStringForSupportedClients += fmt.Sprintf("%#v: %#v,", k, this.SupportedClients[k])
+	}
+	mapStringForSupportedClients += "}"
+	if this.SupportedClients != nil {
+		s = append(s, "SupportedClients: "+mapStringForSupportedClients+",\n")
+	}
+	s = append(s, "ServerVersion: "+fmt.Sprintf("%#v", this.ServerVersion)+",\n")
+	if this.MembershipInfo != nil {
+		s = append(s, "MembershipInfo: "+fmt.Sprintf("%#v", this.MembershipInfo)+",\n")
+	}
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func (this *GetDLQMessagesRequest) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 10)
+	s = append(s, "&adminservice.GetDLQMessagesRequest{")
+	s = append(s, "Type: "+fmt.Sprintf("%#v", this.Type)+",\n")
+	s = append(s, "ShardId: "+fmt.Sprintf("%#v", this.ShardId)+",\n")
+	s = append(s, "SourceCluster: "+fmt.Sprintf("%#v", this.SourceCluster)+",\n")
+	s = append(s, "InclusiveEndMessageId: "+fmt.Sprintf("%#v", this.InclusiveEndMessageId)+",\n")
+	s = append(s, "MaximumPageSize: "+fmt.Sprintf("%#v", this.MaximumPageSize)+",\n")
+	s = append(s, "NextPageToken: "+fmt.Sprintf("%#v", this.NextPageToken)+",\n")
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func (this *GetDLQMessagesResponse) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 7)
+	s = append(s, "&adminservice.GetDLQMessagesResponse{")
+	s = append(s, "Type: "+fmt.Sprintf("%#v", this.Type)+",\n")
+	if this.ReplicationTasks != nil {
+		s = append(s, "ReplicationTasks: "+fmt.Sprintf("%#v", this.ReplicationTasks)+",\n")
+	}
+	s = append(s, "NextPageToken: "+fmt.Sprintf("%#v", this.NextPageToken)+",\n")
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func (this *PurgeDLQMessagesRequest) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 8)
+	s = append(s, "&adminservice.PurgeDLQMessagesRequest{")
+	s = append(s, "Type: "+fmt.Sprintf("%#v", this.Type)+",\n")
+	s = append(s, "ShardId: "+fmt.Sprintf("%#v", this.ShardId)+",\n")
+	s = append(s, "SourceCluster: "+fmt.Sprintf("%#v", this.SourceCluster)+",\n")
+	s = append(s, "InclusiveEndMessageId: "+fmt.Sprintf("%#v", this.InclusiveEndMessageId)+",\n")
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func (this *PurgeDLQMessagesResponse) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 4)
+	s = append(s, "&adminservice.PurgeDLQMessagesResponse{")
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func (this *MergeDLQMessagesRequest) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 10)
+	s = append(s, "&adminservice.MergeDLQMessagesRequest{")
+	s = append(s, "Type: "+fmt.Sprintf("%#v", this.Type)+",\n")
+	s = append(s, "ShardId: "+fmt.Sprintf("%#v", this.ShardId)+",\n")
+	s = append(s, "SourceCluster: "+fmt.Sprintf("%#v", this.SourceCluster)+",\n")
+	s = append(s, "InclusiveEndMessageId: "+fmt.Sprintf("%#v", this.InclusiveEndMessageId)+",\n")
+	s = append(s, "MaximumPageSize: "+fmt.Sprintf("%#v", this.MaximumPageSize)+",\n")
+	s = append(s, "NextPageToken: "+fmt.Sprintf("%#v", this.NextPageToken)+",\n")
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func (this *MergeDLQMessagesResponse) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 5)
+	s = append(s, "&adminservice.MergeDLQMessagesResponse{")
+	s = append(s, "NextPageToken: "+fmt.Sprintf("%#v", this.NextPageToken)+",\n")
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func (this *RefreshWorkflowTasksRequest) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 6)
+	s = append(s, "&adminservice.RefreshWorkflowTasksRequest{")
+	s = append(s, "Namespace: "+fmt.Sprintf("%#v", this.Namespace)+",\n")
+	if this.Execution != nil {
+		s = append(s, "Execution: "+fmt.Sprintf("%#v", this.Execution)+",\n")
+	}
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func (this *RefreshWorkflowTasksResponse) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 4)
+	s = append(s, "&adminservice.RefreshWorkflowTasksResponse{")
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func (this *ResendReplicationTasksRequest) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 12)
+	s = append(s, "&adminservice.ResendReplicationTasksRequest{")
+	s = append(s, "NamespaceId: "+fmt.Sprintf("%#v", this.NamespaceId)+",\n")
+	s = append(s, "WorkflowId: "+fmt.Sprintf("%#v", this.WorkflowId)+",\n")
+	s = append(s, "RunId: "+fmt.Sprintf("%#v", this.RunId)+",\n")
+	s = append(s, "RemoteCluster: "+fmt.Sprintf("%#v", this.RemoteCluster)+",\n")
+	s = append(s, "StartEventId: "+fmt.Sprintf("%#v", this.StartEventId)+",\n")
+	s = append(s, "StartVersion: "+fmt.Sprintf("%#v", this.StartVersion)+",\n")
+	s = append(s, "EndEventId: "+fmt.Sprintf("%#v", this.EndEventId)+",\n")
+	s = append(s, "EndVersion: "+fmt.Sprintf("%#v", this.EndVersion)+",\n")
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func (this *ResendReplicationTasksResponse) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 4)
+	s = append(s, "&adminservice.ResendReplicationTasksResponse{")
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func (this *CreateScheduleRequest) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 10)
+	s = append(s, "&adminservice.CreateScheduleRequest{")
+	s = append(s, "Namespace: "+fmt.Sprintf("%#v", this.Namespace)+",\n")
+	s = append(s, "ScheduleId: "+fmt.Sprintf("%#v", this.ScheduleId)+",\n")
+	if this.Schedule != nil {
+		s = append(s, "Schedule: "+fmt.Sprintf("%#v", this.Schedule)+",\n")
+	}
+	if this.InitialPatch != nil {
+		s = append(s, "InitialPatch: "+fmt.Sprintf("%#v", this.InitialPatch)+",\n")
+	}
+	s = append(s, "Identity: "+fmt.Sprintf("%#v", this.Identity)+",\n")
+	s = append(s, "RequestId: "+fmt.Sprintf("%#v", this.RequestId)+",\n")
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func (this *CreateScheduleResponse) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 5)
+	s = append(s, "&adminservice.CreateScheduleResponse{")
+	s = append(s, "ConflictToken: "+fmt.Sprintf("%#v", this.ConflictToken)+",\n")
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func (this *DescribeScheduleRequest) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 6)
+	s = append(s, "&adminservice.DescribeScheduleRequest{")
+	s = append(s, "Namespace: "+fmt.Sprintf("%#v", this.Namespace)+",\n")
+	s = append(s, "ScheduleId: "+fmt.Sprintf("%#v", this.ScheduleId)+",\n")
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func (this *DescribeScheduleResponse) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 7)
+	s = append(s, "&adminservice.DescribeScheduleResponse{")
+	if this.Schedule != nil {
+		s = append(s, "Schedule: "+fmt.Sprintf("%#v", this.Schedule)+",\n")
+	}
+	if this.Info != nil {
+		s = append(s, "Info: "+fmt.Sprintf("%#v", this.Info)+",\n")
+	}
+	s = append(s, "ConflictToken: "+fmt.Sprintf("%#v", this.ConflictToken)+",\n")
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func (this *UpdateScheduleRequest) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 10)
+	s = append(s, "&adminservice.UpdateScheduleRequest{")
+	s = append(s, "Namespace: "+fmt.Sprintf("%#v", this.Namespace)+",\n")
+	s = append(s, "ScheduleId: "+fmt.Sprintf("%#v", this.ScheduleId)+",\n")
+	if this.Schedule != nil {
+		s = append(s, "Schedule: "+fmt.Sprintf("%#v", this.Schedule)+",\n")
+	}
+	s = append(s, "ConflictToken: "+fmt.Sprintf("%#v", this.ConflictToken)+",\n")
+	s = append(s, "Identity: "+fmt.Sprintf("%#v", this.Identity)+",\n")
+	s = append(s, "RequestId: "+fmt.Sprintf("%#v", this.RequestId)+",\n")
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func (this *UpdateScheduleResponse) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 4)
+	s = append(s, "&adminservice.UpdateScheduleResponse{")
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func (this *PauseScheduleRequest) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 8)
+	s = append(s, "&adminservice.PauseScheduleRequest{")
+	s = append(s, "Namespace: "+fmt.Sprintf("%#v", this.Namespace)+",\n")
+	s = append(s, "ScheduleId: "+fmt.Sprintf("%#v", this.ScheduleId)+",\n")
+	s = append(s, "Notes: "+fmt.Sprintf("%#v", this.Notes)+",\n")
+	s = append(s, "Identity: "+fmt.Sprintf("%#v", this.Identity)+",\n")
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func (this *PauseScheduleResponse) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 4)
+	s = append(s, "&adminservice.PauseScheduleResponse{")
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func (this *UnpauseScheduleRequest) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 8)
+	s = append(s, "&adminservice.UnpauseScheduleRequest{")
+	s = append(s, "Namespace: "+fmt.Sprintf("%#v", this.Namespace)+",\n")
+	s = append(s, "ScheduleId: "+fmt.Sprintf("%#v", this.ScheduleId)+",\n")
+	s = append(s, "Notes: "+fmt.Sprintf("%#v", this.Notes)+",\n")
+	s = append(s, "Identity: "+fmt.Sprintf("%#v", this.Identity)+",\n")
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func (this *UnpauseScheduleResponse) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 4)
+	s = append(s, "&adminservice.UnpauseScheduleResponse{")
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func (this *TriggerScheduleRequest) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 9)
+	s = append(s, "&adminservice.TriggerScheduleRequest{")
+	s = append(s, "Namespace: "+fmt.Sprintf("%#v", this.Namespace)+",\n")
+	s = append(s, "ScheduleId: "+fmt.Sprintf("%#v", this.ScheduleId)+",\n")
+	s = append(s, "OverlapPolicy: "+fmt.Sprintf("%#v", this.OverlapPolicy)+",\n")
+	if this.Backfill != nil {
+		s = append(s, "Backfill: "+fmt.Sprintf("%#v", this.Backfill)+",\n")
+	}
+	s = append(s, "Identity: "+fmt.Sprintf("%#v", this.Identity)+",\n")
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func (this *TriggerScheduleResponse) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 4)
+	s = append(s, "&adminservice.TriggerScheduleResponse{")
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func (this *DeleteScheduleRequest) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 7)
+	s = append(s, "&adminservice.DeleteScheduleRequest{")
+	s = append(s, "Namespace: "+fmt.Sprintf("%#v", this.Namespace)+",\n")
+	s = append(s, "ScheduleId: "+fmt.Sprintf("%#v", this.ScheduleId)+",\n")
+	s = append(s, "Identity: "+fmt.Sprintf("%#v", this.Identity)+",\n")
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func (this *DeleteScheduleResponse) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 4)
+	s = append(s, "&adminservice.DeleteScheduleResponse{")
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func (this *ListSchedulesRequest) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 7)
+	s = append(s, "&adminservice.ListSchedulesRequest{")
+	s = append(s, "Namespace: "+fmt.Sprintf("%#v", this.Namespace)+",\n")
+	s = append(s, "MaximumPageSize: "+fmt.Sprintf("%#v", this.MaximumPageSize)+",\n")
+	s = append(s, "NextPageToken: "+fmt.Sprintf("%#v", this.NextPageToken)+",\n")
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func (this *ListSchedulesResponse) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 6)
+	s = append(s, "&adminservice.ListSchedulesResponse{")
+	if this.Schedules != nil {
+		s = append(s, "Schedules: "+fmt.Sprintf("%#v", this.Schedules)+",\n")
+	}
+	s = append(s, "NextPageToken: "+fmt.Sprintf("%#v", this.NextPageToken)+",\n")
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func (this *DeleteWorkflowExecutionRequest) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 7)
+	s = append(s, "&adminservice.DeleteWorkflowExecutionRequest{")
+	s = append(s, "Namespace: "+fmt.Sprintf("%#v", this.Namespace)+",\n")
+	if this.Execution != nil {
+		s = append(s, "Execution: "+fmt.Sprintf("%#v", this.Execution)+",\n")
+	}
+	s = append(s, "Identity: "+fmt.Sprintf("%#v", this.Identity)+",\n")
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func (this *DeleteWorkflowExecutionResponse) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 4)
+	s = append(s, "&adminservice.DeleteWorkflowExecutionResponse{")
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func (this *BatchResetParams) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 9)
+	s = append(s, "&adminservice.BatchResetParams{")
+	s = append(s, "ResetType: "+fmt.Sprintf("%#v", this.ResetType)+",\n")
+	s = append(s, "BadBinaryChecksum: "+fmt.Sprintf("%#v", this.BadBinaryChecksum)+",\n")
+	s = append(s, "SkipCurrentOpen: "+fmt.Sprintf("%#v", this.SkipCurrentOpen)+",\n")
+	s = append(s, "SkipBaseNotCurrent: "+fmt.Sprintf("%#v", this.SkipBaseNotCurrent)+",\n")
+	s = append(s, "NonDeterministicOnly: "+fmt.Sprintf("%#v", this.NonDeterministicOnly)+",\n")
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func (this *StartBatchOperationRequest) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 14)
+	s = append(s, "&adminservice.StartBatchOperationRequest{")
+	s = append(s, "Namespace: "+fmt.Sprintf("%#v", this.Namespace)+",\n")
+	s = append(s, "JobId: "+fmt.Sprintf("%#v", this.JobId)+",\n")
+	s = append(s, "Query: "+fmt.Sprintf("%#v", this.Query)+",\n")
+	s = append(s, "Reason: "+fmt.Sprintf("%#v", this.Reason)+",\n")
+	s = append(s, "BatchType: "+fmt.Sprintf("%#v", this.BatchType)+",\n")
+	s = append(s, "Identity: "+fmt.Sprintf("%#v", this.Identity)+",\n")
+	s = append(s, "SignalName: "+fmt.Sprintf("%#v", this.SignalName)+",\n")
+	if this.SignalInput != nil {
+		s = append(s, "SignalInput: "+fmt.Sprintf("%#v", this.SignalInput)+",\n")
+	}
+	if this.ResetParams != nil {
+		s = append(s, "ResetParams: "+fmt.Sprintf("%#v", this.ResetParams)+",\n")
+	}
+	s = append(s, "Rps: "+fmt.Sprintf("%#v", this.Rps)+",\n")
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func (this *StartBatchOperationResponse) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 4)
+	s = append(s, "&adminservice.StartBatchOperationResponse{")
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func (this *StopBatchOperationRequest) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 8)
+	s = append(s, "&adminservice.StopBatchOperationRequest{")
+	s = append(s, "Namespace: "+fmt.Sprintf("%#v", this.Namespace)+",\n")
+	s = append(s, "JobId: "+fmt.Sprintf("%#v", this.JobId)+",\n")
+	s = append(s, "Reason: "+fmt.Sprintf("%#v", this.Reason)+",\n")
+	s = append(s, "Identity: "+fmt.Sprintf("%#v", this.Identity)+",\n")
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func (this *StopBatchOperationResponse) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 4)
+	s = append(s, "&adminservice.StopBatchOperationResponse{")
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func (this *DescribeBatchOperationRequest) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 6)
+	s = append(s, "&adminservice.DescribeBatchOperationRequest{")
+	s = append(s, "Namespace: "+fmt.Sprintf("%#v", this.Namespace)+",\n")
+	s = append(s, "JobId: "+fmt.Sprintf("%#v", this.JobId)+",\n")
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func (this *DescribeBatchOperationResponse) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 13)
+	s = append(s, "&adminservice.DescribeBatchOperationResponse{")
+	s = append(s, "JobId: "+fmt.Sprintf("%#v", this.JobId)+",\n")
+	s = append(s, "State: "+fmt.Sprintf("%#v", this.State)+",\n")
+	s = append(s, "StartTime: "+fmt.Sprintf("%#v", this.StartTime)+",\n")
+	s = append(s, "CloseTime: "+fmt.Sprintf("%#v", this.CloseTime)+",\n")
+	s = append(s, "Reason: "+fmt.Sprintf("%#v", this.Reason)+",\n")
+	s = append(s, "Identity: "+fmt.Sprintf("%#v", this.Identity)+",\n")
+	s = append(s, "TotalOperationCount: "+fmt.Sprintf("%#v", this.TotalOperationCount)+",\n")
+	s = append(s, "CompleteOperationCount: "+fmt.Sprintf("%#v", this.CompleteOperationCount)+",\n")
+	s = append(s, "FailureOperationCount: "+fmt.Sprintf("%#v", this.FailureOperationCount)+",\n")
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func (this *ListBatchOperationsRequest) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 7)
+	s = append(s, "&adminservice.ListBatchOperationsRequest{")
+	s = append(s, "Namespace: "+fmt.Sprintf("%#v", this.Namespace)+",\n")
+	s = append(s, "PageSize: "+fmt.Sprintf("%#v", this.PageSize)+",\n")
+	s = append(s, "NextPageToken: "+fmt.Sprintf("%#v", this.NextPageToken)+",\n")
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func (this *BatchOperationInfo) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 8)
+	s = append(s, "&adminservice.BatchOperationInfo{")
+	s = append(s, "JobId: "+fmt.Sprintf("%#v", this.JobId)+",\n")
+	s = append(s, "State: "+fmt.Sprintf("%#v", this.State)+",\n")
+	s = append(s, "StartTime: "+fmt.Sprintf("%#v", this.StartTime)+",\n")
+	s = append(s, "CloseTime: "+fmt.Sprintf("%#v", this.CloseTime)+",\n")
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func (this *ListBatchOperationsResponse) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 6)
+	s = append(s, "&adminservice.ListBatchOperationsResponse{")
+	if this.OperationInfo != nil {
+		s = append(s, "OperationInfo: "+fmt.Sprintf("%#v", this.OperationInfo)+",\n")
+	}
+	s = append(s, "NextPageToken: "+fmt.Sprintf("%#v", this.NextPageToken)+",\n")
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func (this *UpdateWorkflowExecutionRequest) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 11)
+	s = append(s, "&adminservice.UpdateWorkflowExecutionRequest{")
+	s = append(s, "Namespace: "+fmt.Sprintf("%#v", this.Namespace)+",\n")
+	if this.Execution != nil {
+		s = append(s, "Execution: "+fmt.Sprintf("%#v", this.Execution)+",\n")
+	}
+	s = append(s, "UpdateId: "+fmt.Sprintf("%#v", this.UpdateId)+",\n")
+	s = append(s, "Name: "+fmt.Sprintf("%#v", this.Name)+",\n")
+	if this.Input != nil {
+		s = append(s, "Input: "+fmt.Sprintf("%#v", this.Input)+",\n")
+	}
+	s = append(s, "Identity: "+fmt.Sprintf("%#v", this.Identity)+",\n")
+	s = append(s, "WaitForAccepted: "+fmt.Sprintf("%#v", this.WaitForAccepted)+",\n")
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func (this *UpdateWorkflowExecutionResponse) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 8)
+	s = append(s, "&adminservice.UpdateWorkflowExecutionResponse{")
+	s = append(s, "UpdateId: "+fmt.Sprintf("%#v", this.UpdateId)+",\n")
+	s = append(s, "Completed: "+fmt.Sprintf("%#v", this.Completed)+",\n")
+	if this.Result != nil {
+		s = append(s, "Result: "+fmt.Sprintf("%#v", this.Result)+",\n")
+	}
+	if this.Failure != nil {
+		s = append(s, "Failure: "+fmt.Sprintf("%#v", this.Failure)+",\n")
+	}
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func (this *GetWorkflowExecutionResultRequest) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 7)
+	s = append(s, "&adminservice.GetWorkflowExecutionResultRequest{")
+	s = append(s, "Namespace: "+fmt.Sprintf("%#v", this.Namespace)+",\n")
+	if this.Execution != nil {
+		s = append(s, "Execution: "+fmt.Sprintf("%#v", this.Execution)+",\n")
+	}
+	s = append(s, "FollowRuns: "+fmt.Sprintf("%#v", this.FollowRuns)+",\n")
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func (this *GetWorkflowExecutionResultResponse) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 9)
+	s = append(s, "&adminservice.GetWorkflowExecutionResultResponse{")
+	if this.Execution != nil {
+		s = append(s, "Execution: "+fmt.Sprintf("%#v", this.Execution)+",\n")
+	}
+	s = append(s, "Status: "+fmt.Sprintf("%#v", this.Status)+",\n")
+	if this.Result != nil {
+		s = append(s, "Result: "+fmt.Sprintf("%#v", this.Result)+",\n")
+	}
+	if this.Failure != nil {
+		s = append(s, "Failure: "+fmt.Sprintf("%#v", this.Failure)+",\n")
+	}
+	s = append(s, "NewExecutionRunId: "+fmt.Sprintf("%#v", this.NewExecutionRunId)+",\n")
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func (this *ResetWorkflowExecutionWithOptionsRequest) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 12)
+	s = append(s, "&adminservice.ResetWorkflowExecutionWithOptionsRequest{")
+	s = append(s, "Namespace: "+fmt.Sprintf("%#v", this.Namespace)+",\n")
+	if this.Execution != nil {
+		s = append(s, "Execution: "+fmt.Sprintf("%#v", this.Execution)+",\n")
+	}
+	s = append(s, "Reason: "+fmt.Sprintf("%#v", this.Reason)+",\n")
+	s = append(s, "RequestId: "+fmt.Sprintf("%#v", this.RequestId)+",\n")
+	s = append(s, "WorkflowTaskFinishEventId: "+fmt.Sprintf("%#v", this.WorkflowTaskFinishEventId)+",\n")
+	s = append(s, "WorkflowTaskScheduledEventId: "+fmt.Sprintf("%#v", this.WorkflowTaskScheduledEventId)+",\n")
+	s = append(s, "ReapplyType: "+fmt.Sprintf("%#v", this.ReapplyType)+",\n")
+	s = append(s, "DryRun: "+fmt.Sprintf("%#v", this.DryRun)+",\n")
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func (this *ResetWorkflowExecutionWithOptionsResponse) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 6)
+	s = append(s, "&adminservice.ResetWorkflowExecutionWithOptionsResponse{")
+	s = append(s, "RunId: "+fmt.Sprintf("%#v", this.RunId)+",\n")
+	if this.Preview != nil {
+		s = append(s, "Preview: "+fmt.Sprintf("%#v", this.Preview)+",\n")
+	}
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func (this *ResetWorkflowExecutionPreview) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 11)
+	s = append(s, "&adminservice.ResetWorkflowExecutionPreview{")
+	s = append(s, "BaseRunId: "+fmt.Sprintf("%#v", this.BaseRunId)+",\n")
+	s = append(s, "WorkflowTaskFinishEventId: "+fmt.Sprintf("%#v", this.WorkflowTaskFinishEventId)+",\n")
+	s = append(s, "TerminatedRunId: "+fmt.Sprintf("%#v", this.TerminatedRunId)+",\n")
+	if this.DroppedEvents != nil {
+		s = append(s, "DroppedEvents: "+fmt.Sprintf("%#v", this.DroppedEvents)+",\n")
+	}
+	if this.FailedActivities != nil {
+		s = append(s, "FailedActivities: "+fmt.Sprintf("%#v", this.FailedActivities)+",\n")
+	}
+	if this.PendingChildren != nil {
+		s = append(s, "PendingChildren: "+fmt.Sprintf("%#v", this.PendingChildren)+",\n")
+	}
+	if this.ReappliedEvents != nil {
+		s = append(s, "ReappliedEvents: "+fmt.Sprintf("%#v", this.ReappliedEvents)+",\n")
+	}
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func (this *PauseWorkflowExecutionRequest) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 8)
+	s = append(s, "&adminservice.PauseWorkflowExecutionRequest{")
+	s = append(s, "Namespace: "+fmt.Sprintf("%#v", this.Namespace)+",\n")
+	if this.Execution != nil {
+		s = append(s, "Execution: "+fmt.Sprintf("%#v", this.Execution)+",\n")
+	}
+	s = append(s, "Identity: "+fmt.Sprintf("%#v", this.Identity)+",\n")
+	s = append(s, "Reason: "+fmt.Sprintf("%#v", this.Reason)+",\n")
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func (this *PauseWorkflowExecutionResponse) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 4)
+	s = append(s, "&adminservice.PauseWorkflowExecutionResponse{")
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func (this *UnpauseWorkflowExecutionRequest) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 7)
+	s = append(s, "&adminservice.UnpauseWorkflowExecutionRequest{")
+	s = append(s, "Namespace: "+fmt.Sprintf("%#v", this.Namespace)+",\n")
+	if this.Execution != nil {
+		s = append(s, "Execution: "+fmt.Sprintf("%#v", this.Execution)+",\n")
+	}
+	s = append(s, "Identity: "+fmt.Sprintf("%#v", this.Identity)+",\n")
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func (this *UnpauseWorkflowExecutionResponse) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 4)
+	s = append(s, "&adminservice.UnpauseWorkflowExecutionResponse{")
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func valueToGoStringRequestResponse(v interface{}, typ string) string {
+	rv := reflect.ValueOf(v)
+	if rv.IsNil() {
+		return "nil"
+	}
+	pv := reflect.Indirect(rv).Interface()
+	return fmt.Sprintf("func(v %v) *%v { return &v } ( %#v )", typ, typ, pv)
+}
+func (m *DescribeWorkflowExecutionRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *DescribeWorkflowExecutionRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *DescribeWorkflowExecutionRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Execution != nil {
+		{
+			size, err := m.Execution.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintRequestResponse(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Namespace) > 0 {
+		i -= len(m.Namespace)
+		copy(dAtA[i:], m.Namespace)
+		i = encodeVarintRequestResponse(dAtA, i, uint64(len(m.Namespace)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *DescribeWorkflowExecutionResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *DescribeWorkflowExecutionResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *DescribeWorkflowExecutionResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.BranchId) > 0 {
+		i -= len(m.BranchId)
+		copy(dAtA[i:], m.BranchId)
+		i = encodeVarintRequestResponse(dAtA, i, uint64(len(m.BranchId)))
+		i--
+		dAtA[i] = 0x32
+	}
+	if len(m.TreeId) > 0 {
+		i -= len(m.TreeId)
+		copy(dAtA[i:], m.TreeId)
+		i = encodeVarintRequestResponse(dAtA, i, uint64(len(m.TreeId)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if len(m.DatabaseMutableState) > 0 {
+		i -= len(m.DatabaseMutableState)
+		copy(dAtA[i:], m.DatabaseMutableState)
+		i = encodeVarintRequestResponse(dAtA, i, uint64(len(m.DatabaseMutableState)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.CacheMutableState) > 0 {
+		i -= len(m.CacheMutableState)
+		copy(dAtA[i:], m.CacheMutableState)
+		i = encodeVarintRequestResponse(dAtA, i, uint64(len(m.CacheMutableState)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.HistoryAddr) > 0 {
+		i -= len(m.HistoryAddr)
+		copy(dAtA[i:], m.HistoryAddr)
+		i = encodeVarintRequestResponse(dAtA, i, uint64(len(m.HistoryAddr)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.ShardId) > 0 {
+		i -= len(m.ShardId)
+		copy(dAtA[i:], m.ShardId)
+		i = encodeVarintRequestResponse(dAtA, i, uint64(len(m.ShardId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *DescribeHistoryHostRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *DescribeHistoryHostRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *DescribeHistoryHostRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.WorkflowExecution != nil {
+		{
+			size, err := m.WorkflowExecution.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintRequestResponse(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.Namespace) > 0 {
+		i -= len(m.Namespace)
+		copy(dAtA[i:], m.Namespace)
+		i = encodeVarintRequestResponse(dAtA, i, uint64(len(m.Namespace)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.ShardId != 0 {
+		i = encodeVarintRequestResponse(dAtA, i, uint64(m.ShardId))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.HostAddress) > 0 {
+		i -= len(m.HostAddress)
+		copy(dAtA[i:], m.HostAddress)
+		i = encodeVarintRequestResponse(dAtA, i, uint64(len(m.HostAddress)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *DescribeHistoryHostResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *DescribeHistoryHostResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *DescribeHistoryHostResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintRequestResponse(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if len(m.ShardControllerStatus) > 0 {
+		i -= len(m.ShardControllerStatus)
+		copy(dAtA[i:], m.ShardControllerStatus)
+		i = encodeVarintRequestResponse(dAtA, i, uint64(len(m.ShardControllerStatus)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if m.NamespaceCache != nil {
+		{
+			size, err := m.NamespaceCache.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintRequestResponse(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.ShardIds) > 0 {
+		dAtA5 := make([]byte, len(m.ShardIds)*10)
+		var j4 int
+		for _, num1 := range m.ShardIds {
+			num := uint64(num1)
+			for num >= 1<<7 {
+				dAtA5[j4] = uint8(uint64(num)&0x7f | 0x80)
+				num >>= 7
+				j4++
+			}
+			dAtA5[j4] = uint8(num)
+			j4++
+		}
+		i -= j4
+		copy(dAtA[i:], dAtA5[:j4])
+		i = encodeVarintRequestResponse(dAtA, i, uint64(j4))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.ShardsNumber != 0 {
+		i = encodeVarintRequestResponse(dAtA, i, uint64(m.ShardsNumber))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *CloseShardRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *CloseShardRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *CloseShardRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.ShardId != 0 {
+		i = encodeVarintRequestResponse(dAtA, i, uint64(m.ShardId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *CloseShardResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *CloseShardResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *CloseShardResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *RemoveTaskRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *RemoveTaskRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *RemoveTaskRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.VisibilityTime != nil {
+		n6, err6 := github_com_gogo_protobuf_types.StdTimeMarshalTo(*m.VisibilityTime, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(*m.VisibilityTime):])
+		if err6 != nil {
+			return 0, err6
+		}
+		i -= n6
+		i = encodeVarintRequestResponse(dAtA, i, uint64(n6))
+		i--
+		dAtA[i] = 0x22
+	}
+	if m.TaskId != 0 {
+		i = encodeVarintRequestResponse(dAtA, i, uint64(m.TaskId))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.Category != 0 {
+		i = encodeVarintRequestResponse(dAtA, i, uint64(m.Category))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.ShardId != 0 {
+		i = encodeVarintRequestResponse(dAtA, i, uint64(m.ShardId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *RemoveTaskResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *RemoveTaskResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *RemoveTaskResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *GetWorkflowExecutionRawHistoryV2Request) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *GetWorkflowExecutionRawHistoryV2Request) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *GetWorkflowExecutionRawHistoryV2Request) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.NextPageToken) > 0 {
+		i -= len(m.NextPageToken)
+		copy(dAtA[i:], m.NextPageToken)
+		i = encodeVarintRequestResponse(dAtA, i, uint64(len(m.NextPageToken)))
+		i--
+		dAtA[i] = 0x42
+	}
+	if m.MaximumPageSize != 0 {
+		i = encodeVarintRequestResponse(dAtA, i, uint64(m.MaximumPageSize))
+		i--
+		dAtA[i] = 0x38
+	}
+	if m.EndEventVersion != 0 {
+		i = encodeVarintRequestResponse(dAtA, i, uint64(m.EndEventVersion))
+		i--
+		dAtA[i] = 0x30
+	}
+	if m.EndEventId != 0 {
+		i = encodeVarintRequestResponse(dAtA, i, uint64(m.EndEventId))
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.StartEventVersion != 0 {
+		i = encodeVarintRequestResponse(dAtA, i, uint64(m.StartEventVersion))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.StartEventId != 0 {
+		i = encodeVarintRequestResponse(dAtA, i, uint64(m.StartEventId))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.Execution != nil {
+		{
+			size, err := m.Execution.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintRequestResponse(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Namespace) > 0 {
+		i -= len(m.Namespace)
+		copy(dAtA[i:], m.Namespace)
+		i = encodeVarintRequestResponse(dAtA, i, uint64(len(m.Namespace)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *GetWorkflowExecutionRawHistoryV2Response) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *GetWorkflowExecutionRawHistoryV2Response) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *GetWorkflowExecutionRawHistoryV2Response) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.VersionHistory != nil {
+		{
+			size, err := m.VersionHistory.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintRequestResponse(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.HistoryBatches) > 0 {
+		for iNdEx := len(m.HistoryBatches) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.HistoryBatches[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintRequestResponse(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.NextPageToken) > 0 {
+		i -= len(m.NextPageToken)
+		copy(dAtA[i:], m.NextPageToken)
+		i = encodeVarintRequestResponse(dAtA, i, uint64(len(m.NextPageToken)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *GetReplicationMessagesRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *GetReplicationMessagesRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *GetReplicationMessagesRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.ClusterName) > 0 {
+		i -= len(m.ClusterName)
+		copy(dAtA[i:], m.ClusterName)
+		i = encodeVarintRequestResponse(dAtA, i, uint64(len(m.ClusterName)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Tokens) > 0 {
+		for iNdEx := len(m.Tokens) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Tokens[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintRequestResponse(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *GetReplicationMessagesResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *GetReplicationMessagesResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *GetReplicationMessagesResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.ShardMessages) > 0 {
+		for k := range m.ShardMessages {
+			v := m.ShardMessages[k]
+			baseI := i
+			if v != nil {
+				{
+					size, err := v.MarshalToSizedBuffer(dAtA[:i])
+					if err != nil {
+						return 0, err
+					}
+					i -= size
+					i = encodeVarintRequestResponse(dAtA, i, uint64(size))
+				}
+				i--
+				dAtA[i] = 0x12
+			}
+			i = encodeVarintRequestResponse(dAtA, i, uint64(k))
+			i--
+			dAtA[i] = 0x8
+			i = encodeVarintRequestResponse(dAtA, i, uint64(baseI-i))
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *GetNamespaceReplicationMessagesRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *GetNamespaceReplicationMessagesRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *GetNamespaceReplicationMessagesRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.ClusterName) > 0 {
+		i -= len(m.ClusterName)
+		copy(dAtA[i:], m.ClusterName)
+		i = encodeVarintRequestResponse(dAtA, i, uint64(len(m.ClusterName)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.LastProcessedMessageId != 0 {
+		i = encodeVarintRequestResponse(dAtA, i, uint64(m.LastProcessedMessageId))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.LastRetrievedMessageId != 0 {
+		i = encodeVarintRequestResponse(dAtA, i, uint64(m.LastRetrievedMessageId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *GetNamespaceReplicationMessagesResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *GetNamespaceReplicationMessagesResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *GetNamespaceReplicationMessagesResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Messages != nil {
+		{
+			size, err := m.Messages.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintRequestResponse(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *GetDLQReplicationMessagesRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *GetDLQReplicationMessagesRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *GetDLQReplicationMessagesRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.TaskInfos) > 0 {
+		for iNdEx := len(m.TaskInfos) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.TaskInfos[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintRequestResponse(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *GetDLQReplicationMessagesResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *GetDLQReplicationMessagesResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *GetDLQReplicationMessagesResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.ReplicationTasks) > 0 {
+		for iNdEx := len(m.ReplicationTasks) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.ReplicationTasks[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintRequestResponse(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ReapplyEventsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ReapplyEventsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ReapplyEventsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Events != nil {
+		{
+			size, err := m.Events.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintRequestResponse(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.WorkflowExecution != nil {
+		{
+			size, err := m.WorkflowExecution.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintRequestResponse(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Namespace) > 0 {
+		i -= len(m.Namespace)
+		copy(dAtA[i:], m.Namespace)
+		i = encodeVarintRequestResponse(dAtA, i, uint64(len(m.Namespace)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ReapplyEventsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ReapplyEventsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ReapplyEventsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *AddSearchAttributeRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *AddSearchAttributeRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *AddSearchAttributeRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.SecurityToken) > 0 {
+		i -= len(m.SecurityToken)
+		copy(dAtA[i:], m.SecurityToken)
+		i = encodeVarintRequestResponse(dAtA, i, uint64(len(m.SecurityToken)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.SearchAttribute) > 0 {
+		for k := range m.SearchAttribute {
+			v := m.SearchAttribute[k]
+			baseI := i
+			i = encodeVarintRequestResponse(dAtA, i, uint64(v))
+			i--
+			dAtA[i] = 0x10
+			i -= len(k)
+			copy(dAtA[i:], k)
+			i = encodeVarintRequestResponse(dAtA, i, uint64(len(k)))
+			i--
+			dAtA[i] = 0xa
+			i = encodeVarintRequestResponse(dAtA, i, uint64(baseI-i))
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *AddSearchAttributeResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *AddSearchAttributeResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *AddSearchAttributeResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *DescribeClusterRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *DescribeClusterRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *DescribeClusterRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *DescribeClusterResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *DescribeClusterResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *DescribeClusterResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.MembershipInfo != nil {
+		{
+			size, err := m.MembershipInfo.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintRequestResponse(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.ServerVersion) > 0 {
+		i -= len(m.ServerVersion)
+		copy(dAtA[i:], m.ServerVersion)
+		i = encodeVarintRequestResponse(dAtA, i, uint64(len(m.ServerVersion)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.SupportedClients) > 0 {
+		for k := range m.SupportedClients {
+			v := m.SupportedClients[k]
+			baseI := i
+			i -= len(v)
+			copy(dAtA[i:], v)
+			i = encodeVarintRequestResponse(dAtA, i, uint64(len(v)))
+			i--
+			dAtA[i] = 0x12
+			i -= len(k)
+			copy(dAtA[i:], k)
+			i = encodeVarintRequestResponse(dAtA, i, uint64(len(k)))
+			i--
+			dAtA[i] = 0xa
+			i = encodeVarintRequestResponse(dAtA, i, uint64(baseI-i))
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *GetDLQMessagesRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *GetDLQMessagesRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *GetDLQMessagesRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.NextPageToken) > 0 {
+		i -= len(m.NextPageToken)
+		copy(dAtA[i:], m.NextPageToken)
+		i = encodeVarintRequestResponse(dAtA, i, uint64(len(m.NextPageToken)))
+		i--
+		dAtA[i] = 0x32
+	}
+	if m.MaximumPageSize != 0 {
+		i = encodeVarintRequestResponse(dAtA, i, uint64(m.MaximumPageSize))
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.InclusiveEndMessageId != 0 {
+		i = encodeVarintRequestResponse(dAtA, i, uint64(m.InclusiveEndMessageId))
+		i--
+		dAtA[i] = 0x20
+	}
+	if len(m.SourceCluster) > 0 {
+		i -= len(m.SourceCluster)
+		copy(dAtA[i:], m.SourceCluster)
+		i = encodeVarintRequestResponse(dAtA, i, uint64(len(m.SourceCluster)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.ShardId != 0 {
+		i = encodeVarintRequestResponse(dAtA, i, uint64(m.ShardId))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.Type != 0 {
+		i = encodeVarintRequestResponse(dAtA, i, uint64(m.Type))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *GetDLQMessagesResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *GetDLQMessagesResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *GetDLQMessagesResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.NextPageToken) > 0 {
+		i -= len(m.NextPageToken)
+		copy(dAtA[i:], m.NextPageToken)
+		i = encodeVarintRequestResponse(dAtA, i, uint64(len(m.NextPageToken)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.ReplicationTasks) > 0 {
+		for iNdEx := len(m.ReplicationTasks) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.ReplicationTasks[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintRequestResponse(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if m.Type != 0 {
+		i = encodeVarintRequestResponse(dAtA, i, uint64(m.Type))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *PurgeDLQMessagesRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *PurgeDLQMessagesRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *PurgeDLQMessagesRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.InclusiveEndMessageId != 0 {
+		i = encodeVarintRequestResponse(dAtA, i, uint64(m.InclusiveEndMessageId))
+		i--
+		dAtA[i] = 0x20
+	}
+	if len(m.SourceCluster) > 0 {
+		i -= len(m.SourceCluster)
+		copy(dAtA[i:], m.SourceCluster)
+		i = encodeVarintRequestResponse(dAtA, i, uint64(len(m.SourceCluster)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.ShardId != 0 {
+		i = encodeVarintRequestResponse(dAtA, i, uint64(m.ShardId))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.Type != 0 {
+		i = encodeVarintRequestResponse(dAtA, i, uint64(m.Type))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *PurgeDLQMessagesResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *PurgeDLQMessagesResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *PurgeDLQMessagesResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MergeDLQMessagesRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MergeDLQMessagesRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MergeDLQMessagesRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.NextPageToken) > 0 {
+		i -= len(m.NextPageToken)
+		copy(dAtA[i:], m.NextPageToken)
+		i = encodeVarintRequestResponse(dAtA, i, uint64(len(m.NextPageToken)))
+		i--
+		dAtA[i] = 0x32
+	}
+	if m.MaximumPageSize != 0 {
+		i = encodeVarintRequestResponse(dAtA, i, uint64(m.MaximumPageSize))
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.InclusiveEndMessageId != 0 {
+		i = encodeVarintRequestResponse(dAtA, i, uint64(m.InclusiveEndMessageId))
+		i--
+		dAtA[i] = 0x20
+	}
+	if len(m.SourceCluster) > 0 {
+		i -= len(m.SourceCluster)
+		copy(dAtA[i:], m.SourceCluster)
+		i = encodeVarintRequestResponse(dAtA, i, uint64(len(m.SourceCluster)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.ShardId != 0 {
+		i = encodeVarintRequestResponse(dAtA, i, uint64(m.ShardId))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.Type != 0 {
+		i = encodeVarintRequestResponse(dAtA, i, uint64(m.Type))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MergeDLQMessagesResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MergeDLQMessagesResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MergeDLQMessagesResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.NextPageToken) > 0 {
+		i -= len(m.NextPageToken)
+		copy(dAtA[i:], m.NextPageToken)
+		i = encodeVarintRequestResponse(dAtA, i, uint64(len(m.NextPageToken)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *RefreshWorkflowTasksRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *RefreshWorkflowTasksRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *RefreshWorkflowTasksRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Execution != nil {
+		{
+			size, err := m.Execution.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintRequestResponse(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Namespace) > 0 {
+		i -= len(m.Namespace)
+		copy(dAtA[i:], m.Namespace)
+		i = encodeVarintRequestResponse(dAtA, i, uint64(len(m.Namespace)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *RefreshWorkflowTasksResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *RefreshWorkflowTasksResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *RefreshWorkflowTasksResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *ResendReplicationTasksRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ResendReplicationTasksRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ResendReplicationTasksRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.EndVersion != 0 {
+		i = encodeVarintRequestResponse(dAtA, i, uint64(m.EndVersion))
+		i--
+		dAtA[i] = 0x40
+	}
+	if m.EndEventId != 0 {
+		i = encodeVarintRequestResponse(dAtA, i, uint64(m.EndEventId))
+		i--
+		dAtA[i] = 0x38
+	}
+	if m.StartVersion != 0 {
+		i = encodeVarintRequestResponse(dAtA, i, uint64(m.StartVersion))
+		i--
+		dAtA[i] = 0x30
+	}
+	if m.StartEventId != 0 {
+		i = encodeVarintRequestResponse(dAtA, i, uint64(m.StartEventId))
+		i--
+		dAtA[i] = 0x28
+	}
+	if len(m.RemoteCluster) > 0 {
+		i -= len(m.RemoteCluster)
+		copy(dAtA[i:], m.RemoteCluster)
+		i = encodeVarintRequestResponse(dAtA, i, uint64(len(m.RemoteCluster)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.RunId) > 0 {
+		i -= len(m.RunId)
+		copy(dAtA[i:], m.RunId)
+		i = encodeVarintRequestResponse(dAtA, i, uint64(len(m.RunId)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.WorkflowId) > 0 {
+		i -= len(m.WorkflowId)
+		copy(dAtA[i:], m.WorkflowId)
+		i = encodeVarintRequestResponse(dAtA, i, uint64(len(m.WorkflowId)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.NamespaceId) > 0 {
+		i -= len(m.NamespaceId)
+		copy(dAtA[i:], m.NamespaceId)
+		i = encodeVarintRequestResponse(dAtA, i, uint64(len(m.NamespaceId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ResendReplicationTasksResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ResendReplicationTasksResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ResendReplicationTasksResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *CreateScheduleRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *CreateScheduleRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *CreateScheduleRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.RequestId) > 0 {
+		i -= len(m.RequestId)
+		copy(dAtA[i:], m.RequestId)
+		i = encodeVarintRequestResponse(dAtA, i, uint64(len(m.RequestId)))
+		i--
+		dAtA[i] = 0x32
+	}
+	if len(m.Identity) > 0 {
+		i -= len(m.Identity)
+		copy(dAtA[i:], m.Identity)
+		i = encodeVarintRequestResponse(dAtA, i, uint64(len(m.Identity)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if m.InitialPatch != nil {
+		{
+			size, err := m.InitialPatch.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintRequestResponse(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x22
+	}
+	if m.Schedule != nil {
+		{
+			size, err := m.Schedule.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintRequestResponse(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.ScheduleId) > 0 {
+		i -= len(m.ScheduleId)
+		copy(dAtA[i:], m.ScheduleId)
+		i = encodeVarintRequestResponse(dAtA, i, uint64(len(m.ScheduleId)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Namespace) > 0 {
+		i -= len(m.Namespace)
+		copy(dAtA[i:], m.Namespace)
+		i = encodeVarintRequestResponse(dAtA, i, uint64(len(m.Namespace)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *CreateScheduleResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *CreateScheduleResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *CreateScheduleResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.ConflictToken != 0 {
+		i = encodeVarintRequestResponse(dAtA, i, uint64(m.ConflictToken))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *DescribeScheduleRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *DescribeScheduleRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *DescribeScheduleRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.ScheduleId) > 0 {
+		i -= len(m.ScheduleId)
+		copy(dAtA[i:], m.ScheduleId)
+		i = encodeVarintRequestResponse(dAtA, i, uint64(len(m.ScheduleId)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Namespace) > 0 {
+		i -= len(m.Namespace)
+		copy(dAtA[i:], m.Namespace)
+		i = encodeVarintRequestResponse(dAtA, i, uint64(len(m.Namespace)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *DescribeScheduleResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *DescribeScheduleResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *DescribeScheduleResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.ConflictToken != 0 {
+		i = encodeVarintRequestResponse(dAtA, i, uint64(m.ConflictToken))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.Info != nil {
+		{
+			size, err := m.Info.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintRequestResponse(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Schedule != nil {
+		{
+			size, err := m.Schedule.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintRequestResponse(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *UpdateScheduleRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *UpdateScheduleRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *UpdateScheduleRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.RequestId) > 0 {
+		i -= len(m.RequestId)
+		copy(dAtA[i:], m.RequestId)
+		i = encodeVarintRequestResponse(dAtA, i, uint64(len(m.RequestId)))
+		i--
+		dAtA[i] = 0x32
+	}
+	if len(m.Identity) > 0 {
+		i -= len(m.Identity)
+		copy(dAtA[i:], m.Identity)
+		i = encodeVarintRequestResponse(dAtA, i, uint64(len(m.Identity)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if m.ConflictToken != 0 {
+		i = encodeVarintRequestResponse(dAtA, i, uint64(m.ConflictToken))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.Schedule != nil {
+		{
+			size, err := m.Schedule.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintRequestResponse(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.ScheduleId) > 0 {
+		i -= len(m.ScheduleId)
+		copy(dAtA[i:], m.ScheduleId)
+		i = encodeVarintRequestResponse(dAtA, i, uint64(len(m.ScheduleId)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Namespace) > 0 {
+		i -= len(m.Namespace)
+		copy(dAtA[i:], m.Namespace)
+		i = encodeVarintRequestResponse(dAtA, i, uint64(len(m.Namespace)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *UpdateScheduleResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *UpdateScheduleResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *UpdateScheduleResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *PauseScheduleRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *PauseScheduleRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *PauseScheduleRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Identity) > 0 {
+		i -= len(m.Identity)
+		copy(dAtA[i:], m.Identity)
+		i = encodeVarintRequestResponse(dAtA, i, uint64(len(m.Identity)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.Notes) > 0 {
+		i -= len(m.Notes)
+		copy(dAtA[i:], m.Notes)
+		i = encodeVarintRequestResponse(dAtA, i, uint64(len(m.Notes)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.ScheduleId) > 0 {
+		i -= len(m.ScheduleId)
+		copy(dAtA[i:], m.ScheduleId)
+		i = encodeVarintRequestResponse(dAtA, i, uint64(len(m.ScheduleId)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Namespace) > 0 {
+		i -= len(m.Namespace)
+		copy(dAtA[i:], m.Namespace)
+		i = encodeVarintRequestResponse(dAtA, i, uint64(len(m.Namespace)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *PauseScheduleResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *PauseScheduleResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *PauseScheduleResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *UnpauseScheduleRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *UnpauseScheduleRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *UnpauseScheduleRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Identity) > 0 {
+		i -= len(m.Identity)
+		copy(dAtA[i:], m.Identity)
+		i = encodeVarintRequestResponse(dAtA, i, uint64(len(m.Identity)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.Notes) > 0 {
+		i -= len(m.Notes)
+		copy(dAtA[i:], m.Notes)
+		i = encodeVarintRequestResponse(dAtA, i, uint64(len(m.Notes)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.ScheduleId) > 0 {
+		i -= len(m.ScheduleId)
+		copy(dAtA[i:], m.ScheduleId)
+		i = encodeVarintRequestResponse(dAtA, i, uint64(len(m.ScheduleId)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Namespace) > 0 {
+		i -= len(m.Namespace)
+		copy(dAtA[i:], m.Namespace)
+		i = encodeVarintRequestResponse(dAtA, i, uint64(len(m.Namespace)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *UnpauseScheduleResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *UnpauseScheduleResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *UnpauseScheduleResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *TriggerScheduleRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *TriggerScheduleRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *TriggerScheduleRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Identity) > 0 {
+		i -= len(m.Identity)
+		copy(dAtA[i:], m.Identity)
+		i = encodeVarintRequestResponse(dAtA, i, uint64(len(m.Identity)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if len(m.Backfill) > 0 {
+		for iNdEx := len(m.Backfill) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Backfill[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintRequestResponse(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	if m.OverlapPolicy != 0 {
+		i = encodeVarintRequestResponse(dAtA, i, uint64(m.OverlapPolicy))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.ScheduleId) > 0 {
+		i -= len(m.ScheduleId)
+		copy(dAtA[i:], m.ScheduleId)
+		i = encodeVarintRequestResponse(dAtA, i, uint64(len(m.ScheduleId)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Namespace) > 0 {
+		i -= len(m.Namespace)
+		copy(dAtA[i:], m.Namespace)
+		i = encodeVarintRequestResponse(dAtA, i, uint64(len(m.Namespace)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *TriggerScheduleResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *TriggerScheduleResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *TriggerScheduleResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *DeleteScheduleRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *DeleteScheduleRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *DeleteScheduleRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Identity) > 0 {
+		i -= len(m.Identity)
+		copy(dAtA[i:], m.Identity)
+		i = encodeVarintRequestResponse(dAtA, i, uint64(len(m.Identity)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.ScheduleId) > 0 {
+		i -= len(m.ScheduleId)
+		copy(dAtA[i:], m.ScheduleId)
+		i = encodeVarintRequestResponse(dAtA, i, uint64(len(m.ScheduleId)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Namespace) > 0 {
+		i -= len(m.Namespace)
+		copy(dAtA[i:], m.Namespace)
+		i = encodeVarintRequestResponse(dAtA, i, uint64(len(m.Namespace)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *DeleteScheduleResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *DeleteScheduleResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *DeleteScheduleResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *ListSchedulesRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ListSchedulesRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ListSchedulesRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.NextPageToken) > 0 {
+		i -= len(m.NextPageToken)
+		copy(dAtA[i:], m.NextPageToken)
+		i = encodeVarintRequestResponse(dAtA, i, uint64(len(m.NextPageToken)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.MaximumPageSize != 0 {
+		i = encodeVarintRequestResponse(dAtA, i, uint64(m.MaximumPageSize))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Namespace) > 0 {
+		i -= len(m.Namespace)
+		copy(dAtA[i:], m.Namespace)
+		i = encodeVarintRequestResponse(dAtA, i, uint64(len(m.Namespace)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ListSchedulesResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ListSchedulesResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ListSchedulesResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.NextPageToken) > 0 {
+		i -= len(m.NextPageToken)
+		copy(dAtA[i:], m.NextPageToken)
+		i = encodeVarintRequestResponse(dAtA, i, uint64(len(m.NextPageToken)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Schedules) > 0 {
+		for iNdEx := len(m.Schedules) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Schedules[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintRequestResponse(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *DeleteWorkflowExecutionRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *DeleteWorkflowExecutionRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *DeleteWorkflowExecutionRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Identity) > 0 {
+		i -= len(m.Identity)
+		copy(dAtA[i:], m.Identity)
+		i = encodeVarintRequestResponse(dAtA, i, uint64(len(m.Identity)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.Execution != nil {
+		{
+			size, err := m.Execution.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintRequestResponse(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Namespace) > 0 {
+		i -= len(m.Namespace)
+		copy(dAtA[i:], m.Namespace)
+		i = encodeVarintRequestResponse(dAtA, i, uint64(len(m.Namespace)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *DeleteWorkflowExecutionResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *DeleteWorkflowExecutionResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *DeleteWorkflowExecutionResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *BatchResetParams) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *BatchResetParams) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *BatchResetParams) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.NonDeterministicOnly {
+		i--
+		if m.NonDeterministicOnly {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.SkipBaseNotCurrent {
+		i--
+		if m.SkipBaseNotCurrent {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.SkipCurrentOpen {
+		i--
+		if m.SkipCurrentOpen {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.BadBinaryChecksum) > 0 {
+		i -= len(m.BadBinaryChecksum)
+		copy(dAtA[i:], m.BadBinaryChecksum)
+		i = encodeVarintRequestResponse(dAtA, i, uint64(len(m.BadBinaryChecksum)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.ResetType) > 0 {
+		i -= len(m.ResetType)
+		copy(dAtA[i:], m.ResetType)
+		i = encodeVarintRequestResponse(dAtA, i, uint64(len(m.ResetType)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *StartBatchOperationRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *StartBatchOperationRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *StartBatchOperationRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Rps != 0 {
+		i = encodeVarintRequestResponse(dAtA, i, uint64(m.Rps))
+		i--
+		dAtA[i] = 0x50
+	}
+	if m.ResetParams != nil {
+		{
+			size, err := m.ResetParams.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintRequestResponse(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x4a
+	}
+	if m.SignalInput != nil {
+		{
+			size, err := m.SignalInput.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintRequestResponse(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x42
+	}
+	if len(m.SignalName) > 0 {
+		i -= len(m.SignalName)
+		copy(dAtA[i:], m.SignalName)
+		i = encodeVarintRequestResponse(dAtA, i, uint64(len(m.SignalName)))
+		i--
+		dAtA[i] = 0x3a
+	}
+	if len(m.Identity) > 0 {
+		i -= len(m.Identity)
+		copy(dAtA[i:], m.Identity)
+		i = encodeVarintRequestResponse(dAtA, i, uint64(len(m.Identity)))
+		i--
+		dAtA[i] = 0x32
+	}
+	if len(m.BatchType) > 0 {
+		i -= len(m.BatchType)
+		copy(dAtA[i:], m.BatchType)
+		i = encodeVarintRequestResponse(dAtA, i, uint64(len(m.BatchType)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if len(m.Reason) > 0 {
+		i -= len(m.Reason)
+		copy(dAtA[i:], m.Reason)
+		i = encodeVarintRequestResponse(dAtA, i, uint64(len(m.Reason)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.Query) > 0 {
+		i -= len(m.Query)
+		copy(dAtA[i:], m.Query)
+		i = encodeVarintRequestResponse(dAtA, i, uint64(len(m.Query)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.JobId) > 0 {
+		i -= len(m.JobId)
+		copy(dAtA[i:], m.JobId)
+		i = encodeVarintRequestResponse(dAtA, i, uint64(len(m.JobId)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Namespace) > 0 {
+		i -= len(m.Namespace)
+		copy(dAtA[i:], m.Namespace)
+		i = encodeVarintRequestResponse(dAtA, i, uint64(len(m.Namespace)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *StartBatchOperationResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *StartBatchOperationResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *StartBatchOperationResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *StopBatchOperationRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *StopBatchOperationRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *StopBatchOperationRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Identity) > 0 {
+		i -= len(m.Identity)
+		copy(dAtA[i:], m.Identity)
+		i = encodeVarintRequestResponse(dAtA, i, uint64(len(m.Identity)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.Reason) > 0 {
+		i -= len(m.Reason)
+		copy(dAtA[i:], m.Reason)
+		i = encodeVarintRequestResponse(dAtA, i, uint64(len(m.Reason)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.JobId) > 0 {
+		i -= len(m.JobId)
+		copy(dAtA[i:], m.JobId)
+		i = encodeVarintRequestResponse(dAtA, i, uint64(len(m.JobId)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Namespace) > 0 {
+		i -= len(m.Namespace)
+		copy(dAtA[i:], m.Namespace)
+		i = encodeVarintRequestResponse(dAtA, i, uint64(len(m.Namespace)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *StopBatchOperationResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *StopBatchOperationResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *StopBatchOperationResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *DescribeBatchOperationRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *DescribeBatchOperationRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *DescribeBatchOperationRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.JobId) > 0 {
+		i -= len(m.JobId)
+		copy(dAtA[i:], m.JobId)
+		i = encodeVarintRequestResponse(dAtA, i, uint64(len(m.JobId)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Namespace) > 0 {
+		i -= len(m.Namespace)
+		copy(dAtA[i:], m.Namespace)
+		i = encodeVarintRequestResponse(dAtA, i, uint64(len(m.Namespace)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *DescribeBatchOperationResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *DescribeBatchOperationResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *DescribeBatchOperationResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.FailureOperationCount != 0 {
+		i = encodeVarintRequestResponse(dAtA, i, uint64(m.FailureOperationCount))
+		i--
+		dAtA[i] = 0x48
+	}
+	if m.CompleteOperationCount != 0 {
+		i = encodeVarintRequestResponse(dAtA, i, uint64(m.CompleteOperationCount))
+		i--
+		dAtA[i] = 0x40
+	}
+	if m.TotalOperationCount != 0 {
+		i = encodeVarintRequestResponse(dAtA, i, uint64(m.TotalOperationCount))
+		i--
+		dAtA[i] = 0x38
+	}
+	if len(m.Identity) > 0 {
+		i -= len(m.Identity)
+		copy(dAtA[i:], m.Identity)
+		i = encodeVarintRequestResponse(dAtA, i, uint64(len(m.Identity)))
+		i--
+		dAtA[i] = 0x32
+	}
+	if len(m.Reason) > 0 {
+		i -= len(m.Reason)
+		copy(dAtA[i:], m.Reason)
+		i = encodeVarintRequestResponse(dAtA, i, uint64(len(m.Reason)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if m.CloseTime != nil {
+		n7, err7 := github_com_gogo_protobuf_types.StdTimeMarshalTo(*m.CloseTime, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(*m.CloseTime):])
+		if err7 != nil {
+			return 0, err7
+		}
+		i -= n7
+		i = encodeVarintRequestResponse(dAtA, i, uint64(n7))
+		i--
+		dAtA[i] = 0x22
+	}
+	if m.StartTime != nil {
+		n8, err8 := github_com_gogo_protobuf_types.StdTimeMarshalTo(*m.StartTime, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(*m.StartTime):])
+		if err8 != nil {
+			return 0, err8
+		}
+		i -= n8
+		i = encodeVarintRequestResponse(dAtA, i, uint64(n8))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.State) > 0 {
+		i -= len(m.State)
+		copy(dAtA[i:], m.State)
+		i = encodeVarintRequestResponse(dAtA, i, uint64(len(m.State)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.JobId) > 0 {
+		i -= len(m.JobId)
+		copy(dAtA[i:], m.JobId)
+		i = encodeVarintRequestResponse(dAtA, i, uint64(len(m.JobId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ListBatchOperationsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ListBatchOperationsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ListBatchOperationsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.NextPageToken) > 0 {
+		i -= len(m.NextPageToken)
+		copy(dAtA[i:], m.NextPageToken)
+		i = encodeVarintRequestResponse(dAtA, i, uint64(len(m.NextPageToken)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.PageSize != 0 {
+		i = encodeVarintRequestResponse(dAtA, i, uint64(m.PageSize))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Namespace) > 0 {
+		i -= len(m.Namespace)
+		copy(dAtA[i:], m.Namespace)
+		i = encodeVarintRequestResponse(dAtA, i, uint64(len(m.Namespace)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *BatchOperationInfo) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *BatchOperationInfo) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *BatchOperationInfo) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.CloseTime != nil {
+		n9, err9 := github_com_gogo_protobuf_types.StdTimeMarshalTo(*m.CloseTime, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(*m.CloseTime):])
+		if err9 != nil {
+			return 0, err9
+		}
+		i -= n9
+		i = encodeVarintRequestResponse(dAtA, i, uint64(n9))
+		i--
+		dAtA[i] = 0x22
+	}
+	if m.StartTime != nil {
+		n10, err10 := github_com_gogo_protobuf_types.StdTimeMarshalTo(*m.StartTime, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(*m.StartTime):])
+		if err10 != nil {
+			return 0, err10
+		}
+		i -= n10
+		i = encodeVarintRequestResponse(dAtA, i, uint64(n10))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.State) > 0 {
+		i -= len(m.State)
+		copy(dAtA[i:], m.State)
+		i = encodeVarintRequestResponse(dAtA, i, uint64(len(m.State)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.JobId) > 0 {
+		i -= len(m.JobId)
+		copy(dAtA[i:], m.JobId)
+		i = encodeVarintRequestResponse(dAtA, i, uint64(len(m.JobId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ListBatchOperationsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ListBatchOperationsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ListBatchOperationsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.NextPageToken) > 0 {
+		i -= len(m.NextPageToken)
+		copy(dAtA[i:], m.NextPageToken)
+		i = encodeVarintRequestResponse(dAtA, i, uint64(len(m.NextPageToken)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.OperationInfo) > 0 {
+		for iNdEx := len(m.OperationInfo) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.OperationInfo[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintRequestResponse(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *UpdateWorkflowExecutionRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *UpdateWorkflowExecutionRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *UpdateWorkflowExecutionRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.WaitForAccepted {
+		i--
+		if m.WaitForAccepted {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x38
+	}
+	if len(m.Identity) > 0 {
+		i -= len(m.Identity)
+		copy(dAtA[i:], m.Identity)
+		i = encodeVarintRequestResponse(dAtA, i, uint64(len(m.Identity)))
+		i--
+		dAtA[i] = 0x32
+	}
+	if m.Input != nil {
+		{
+			size, err := m.Input.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintRequestResponse(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x2a
+	}
+	if len(m.Name) > 0 {
+		i -= len(m.Name)
+		copy(dAtA[i:], m.Name)
+		i = encodeVarintRequestResponse(dAtA, i, uint64(len(m.Name)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.UpdateId) > 0 {
+		i -= len(m.UpdateId)
+		copy(dAtA[i:], m.UpdateId)
+		i = encodeVarintRequestResponse(dAtA, i, uint64(len(m.UpdateId)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.Execution != nil {
+		{
+			size, err := m.Execution.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintRequestResponse(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Namespace) > 0 {
+		i -= len(m.Namespace)
+		copy(dAtA[i:], m.Namespace)
+		i = encodeVarintRequestResponse(dAtA, i, uint64(len(m.Namespace)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *UpdateWorkflowExecutionResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *UpdateWorkflowExecutionResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *UpdateWorkflowExecutionResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Failure != nil {
+		{
+			size, err := m.Failure.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintRequestResponse(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x22
+	}
+	if m.Result != nil {
+		{
+			size, err := m.Result.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintRequestResponse(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.Completed {
+		i--
+		if m.Completed {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.UpdateId) > 0 {
+		i -= len(m.UpdateId)
+		copy(dAtA[i:], m.UpdateId)
+		i = encodeVarintRequestResponse(dAtA, i, uint64(len(m.UpdateId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *GetWorkflowExecutionResultRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *GetWorkflowExecutionResultRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *GetWorkflowExecutionResultRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.FollowRuns {
+		i--
+		if m.FollowRuns {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.Execution != nil {
+		{
+			size, err := m.Execution.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintRequestResponse(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Namespace) > 0 {
+		i -= len(m.Namespace)
+		copy(dAtA[i:], m.Namespace)
+		i = encodeVarintRequestResponse(dAtA, i, uint64(len(m.Namespace)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *GetWorkflowExecutionResultResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *GetWorkflowExecutionResultResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *GetWorkflowExecutionResultResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.NewExecutionRunId) > 0 {
+		i -= len(m.NewExecutionRunId)
+		copy(dAtA[i:], m.NewExecutionRunId)
+		i = encodeVarintRequestResponse(dAtA, i, uint64(len(m.NewExecutionRunId)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if m.Failure != nil {
+		{
+			size, err := m.Failure.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintRequestResponse(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x22
+	}
+	if m.Result != nil {
+		{
+			size, err := m.Result.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintRequestResponse(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.Status != 0 {
+		i = encodeVarintRequestResponse(dAtA, i, uint64(m.Status))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.Execution != nil {
+		{
+			size, err := m.Execution.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintRequestResponse(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ResetWorkflowExecutionWithOptionsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ResetWorkflowExecutionWithOptionsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ResetWorkflowExecutionWithOptionsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.DryRun {
+		i--
+		if m.DryRun {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x40
+	}
+	if len(m.ReapplyType) > 0 {
+		i -= len(m.ReapplyType)
+		copy(dAtA[i:], m.ReapplyType)
+		i = encodeVarintRequestResponse(dAtA, i, uint64(len(m.ReapplyType)))
+		i--
+		dAtA[i] = 0x3a
+	}
+	if m.WorkflowTaskScheduledEventId != 0 {
+		i = encodeVarintRequestResponse(dAtA, i, uint64(m.WorkflowTaskScheduledEventId))
+		i--
+		dAtA[i] = 0x30
+	}
+	if m.WorkflowTaskFinishEventId != 0 {
+		i = encodeVarintRequestResponse(dAtA, i, uint64(m.WorkflowTaskFinishEventId))
+		i--
+		dAtA[i] = 0x28
+	}
+	if len(m.RequestId) > 0 {
+		i -= len(m.RequestId)
+		copy(dAtA[i:], m.RequestId)
+		i = encodeVarintRequestResponse(dAtA, i, uint64(len(m.RequestId)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.Reason) > 0 {
+		i -= len(m.Reason)
+		copy(dAtA[i:], m.Reason)
+		i = encodeVarintRequestResponse(dAtA, i, uint64(len(m.Reason)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.Execution != nil {
+		{
+			size, err := m.Execution.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintRequestResponse(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Namespace) > 0 {
+		i -= len(m.Namespace)
+		copy(dAtA[i:], m.Namespace)
+		i = encodeVarintRequestResponse(dAtA, i, uint64(len(m.Namespace)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ResetWorkflowExecutionWithOptionsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ResetWorkflowExecutionWithOptionsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ResetWorkflowExecutionWithOptionsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Preview != nil {
+		{
+			size, err := m.Preview.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintRequestResponse(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.RunId) > 0 {
+		i -= len(m.RunId)
+		copy(dAtA[i:], m.RunId)
+		i = encodeVarintRequestResponse(dAtA, i, uint64(len(m.RunId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ResetWorkflowExecutionPreview) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ResetWorkflowExecutionPreview) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ResetWorkflowExecutionPreview) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.ReappliedEvents) > 0 {
+		for iNdEx := len(m.ReappliedEvents) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.ReappliedEvents[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintRequestResponse(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x3a
+		}
+	}
+	if len(m.PendingChildren) > 0 {
+		for iNdEx := len(m.PendingChildren) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.PendingChildren[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintRequestResponse(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x32
+		}
+	}
+	if len(m.FailedActivities) > 0 {
+		for iNdEx := len(m.FailedActivities) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.FailedActivities[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintRequestResponse(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x2a
+		}
+	}
+	if len(m.DroppedEvents) > 0 {
+		for iNdEx := len(m.DroppedEvents) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.DroppedEvents[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintRequestResponse(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	if len(m.TerminatedRunId) > 0 {
+		i -= len(m.TerminatedRunId)
+		copy(dAtA[i:], m.TerminatedRunId)
+		i = encodeVarintRequestResponse(dAtA, i, uint64(len(m.TerminatedRunId)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.WorkflowTaskFinishEventId != 0 {
+		i = encodeVarintRequestResponse(dAtA, i, uint64(m.WorkflowTaskFinishEventId))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.BaseRunId) > 0 {
+		i -= len(m.BaseRunId)
+		copy(dAtA[i:], m.BaseRunId)
+		i = encodeVarintRequestResponse(dAtA, i, uint64(len(m.BaseRunId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *PauseWorkflowExecutionRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *PauseWorkflowExecutionRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *PauseWorkflowExecutionRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Reason) > 0 {
+		i -= len(m.Reason)
+		copy(dAtA[i:], m.Reason)
+		i = encodeVarintRequestResponse(dAtA, i, uint64(len(m.Reason)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.Identity) > 0 {
+		i -= len(m.Identity)
+		copy(dAtA[i:], m.Identity)
+		i = encodeVarintRequestResponse(dAtA, i, uint64(len(m.Identity)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.Execution != nil {
+		{
+			size, err := m.Execution.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintRequestResponse(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Namespace) > 0 {
+		i -= len(m.Namespace)
+		copy(dAtA[i:], m.Namespace)
+		i = encodeVarintRequestResponse(dAtA, i, uint64(len(m.Namespace)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *PauseWorkflowExecutionResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *PauseWorkflowExecutionResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *PauseWorkflowExecutionResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *UnpauseWorkflowExecutionRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *UnpauseWorkflowExecutionRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *UnpauseWorkflowExecutionRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Identity) > 0 {
+		i -= len(m.Identity)
+		copy(dAtA[i:], m.Identity)
+		i = encodeVarintRequestResponse(dAtA, i, uint64(len(m.Identity)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.Execution != nil {
+		{
+			size, err := m.Execution.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintRequestResponse(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Namespace) > 0 {
+		i -= len(m.Namespace)
+		copy(dAtA[i:], m.Namespace)
+		i = encodeVarintRequestResponse(dAtA, i, uint64(len(m.Namespace)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *UnpauseWorkflowExecutionResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *UnpauseWorkflowExecutionResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *UnpauseWorkflowExecutionResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintRequestResponse(dAtA []byte, offset int, v uint64) int {
+	offset -= sovRequestResponse(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *DescribeWorkflowExecutionRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Namespace)
+	if l > 0 {
+		n += 1 + l + sovRequestResponse(uint64(l))
+	}
+	if m.Execution != nil {
+		l = m.Execution.Size()
+		n += 1 + l + sovRequestResponse(uint64(l))
+	}
+	return n
+}
+
+func (m *DescribeWorkflowExecutionResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ShardId)
+	if l > 0 {
+		n += 1 + l + sovRequestResponse(uint64(l))
+	}
+	l = len(m.HistoryAddr)
+	if l > 0 {
+		n += 1 + l + sovRequestResponse(uint64(l))
+	}
+	l = len(m.CacheMutableState)
+	if l > 0 {
+		n += 1 + l + sovRequestResponse(uint64(l))
+	}
+	l = len(m.DatabaseMutableState)
+	if l > 0 {
+		n += 1 + l + sovRequestResponse(uint64(l))
+	}
+	l = len(m.TreeId)
+	if l > 0 {
+		n += 1 + l + sovRequestResponse(uint64(l))
+	}
+	l = len(m.BranchId)
+	if l > 0 {
+		n += 1 + l + sovRequestResponse(uint64(l))
+	}
+	return n
+}
+
+func (m *DescribeHistoryHostRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.HostAddress)
+	if l > 0 {
+		n += 1 + l + sovRequestResponse(uint64(l))
+	}
+	if m.ShardId != 0 {
+		n += 1 + sovRequestResponse(uint64(m.ShardId))
+	}
+	l = len(m.Namespace)
+	if l > 0 {
+		n += 1 + l + sovRequestResponse(uint64(l))
+	}
+	if m.WorkflowExecution != nil {
+		l = m.WorkflowExecution.Size()
+		n += 1 + l + sovRequestResponse(uint64(l))
+	}
+	return n
+}
+
+func (m *DescribeHistoryHostResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.ShardsNumber != 0 {
+		n += 1 + sovRequestResponse(uint64(m.ShardsNumber))
+	}
+	if len(m.ShardIds) > 0 {
+		l = 0
+		for _, e := range m.ShardIds {
+			l += sovRequestResponse(uint64(e))
+		}
+		n += 1 + sovRequestResponse(uint64(l)) + l
+	}
+	if m.NamespaceCache != nil {
+		l = m.NamespaceCache.Size()
+		n += 1 + l + sovRequestResponse(uint64(l))
+	}
+	l = len(m.ShardControllerStatus)
+	if l > 0 {
+		n += 1 + l + sovRequestResponse(uint64(l))
+	}
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovRequestResponse(uint64(l))
+	}
+	return n
+}
+
+func (m *CloseShardRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.ShardId != 0 {
+		n += 1 + sovRequestResponse(uint64(m.ShardId))
+	}
+	return n
+}
+
+func (m *CloseShardResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *RemoveTaskRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.ShardId != 0 {
+		n += 1 + sovRequestResponse(uint64(m.ShardId))
+	}
+	if m.Category != 0 {
+		n += 1 + sovRequestResponse(uint64(m.Category))
+	}
+	if m.TaskId != 0 {
+		n += 1 + sovRequestResponse(uint64(m.TaskId))
+	}
+	if m.VisibilityTime != nil {
+		l = github_com_gogo_protobuf_types.SizeOfStdTime(*m.VisibilityTime)
+		n += 1 + l + sovRequestResponse(uint64(l))
+	}
+	return n
+}
+
+func (m *RemoveTaskResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *GetWorkflowExecutionRawHistoryV2Request) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Namespace)
+	if l > 0 {
+		n += 1 + l + sovRequestResponse(uint64(l))
+	}
+	if m.Execution != nil {
+		l = m.Execution.Size()
+		n += 1 + l + sovRequestResponse(uint64(l))
+	}
+	if m.StartEventId != 0 {
+		n += 1 + sovRequestResponse(uint64(m.StartEventId))
+	}
+	if m.StartEventVersion != 0 {
+		n += 1 + sovRequestResponse(uint64(m.StartEventVersion))
+	}
+	if m.EndEventId != 0 {
+		n += 1 + sovRequestResponse(uint64(m.EndEventId))
+	}
+	if m.EndEventVersion != 0 {
+		n += 1 + sovRequestResponse(uint64(m.EndEventVersion))
+	}
+	if m.MaximumPageSize != 0 {
+		n += 1 + sovRequestResponse(uint64(m.MaximumPageSize))
+	}
+	l = len(m.NextPageToken)
+	if l > 0 {
+		n += 1 + l + sovRequestResponse(uint64(l))
+	}
+	return n
+}
+
+func (m *GetWorkflowExecutionRawHistoryV2Response) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.NextPageToken)
+	if l > 0 {
+		n += 1 + l + sovRequestResponse(uint64(l))
+	}
+	if len(m.HistoryBatches) > 0 {
+		for _, e := range m.HistoryBatches {
+			l = e.Size()
+			n += 1 + l + sovRequestResponse(uint64(l))
+		}
+	}
+	if m.VersionHistory != nil {
+		l = m.VersionHistory.Size()
+		n += 1 + l + sovRequestResponse(uint64(l))
+	}
+	return n
+}
+
+func (m *GetReplicationMessagesRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Tokens) > 0 {
+		for _, e := range m.Tokens {
+			l = e.Size()
+			n += 1 + l + sovRequestResponse(uint64(l))
+		}
+	}
+	l = len(m.ClusterName)
+	if l > 0 {
+		n += 1 + l + sovRequestResponse(uint64(l))
+	}
+	return n
+}
+
+func (m *GetReplicationMessagesResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.ShardMessages) > 0 {
+		for k, v := range m.ShardMessages {
+			_ = k
+			_ = v
+			l = 0
+			if v != nil {
+				l = v.Size()
+				l += 1 + sovRequestResponse(uint64(l))
+			}
+			mapEntrySize := 1 + sovRequestResponse(uint64(k)) + l
+			n += mapEntrySize + 1 + sovRequestResponse(uint64(mapEntrySize))
+		}
+	}
+	return n
+}
+
+func (m *GetNamespaceReplicationMessagesRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.LastRetrievedMessageId != 0 {
+		n += 1 + sovRequestResponse(uint64(m.LastRetrievedMessageId))
+	}
+	if m.LastProcessedMessageId != 0 {
+		n += 1 + sovRequestResponse(uint64(m.LastProcessedMessageId))
+	}
+	l = len(m.ClusterName)
+	if l > 0 {
+		n += 1 + l + sovRequestResponse(uint64(l))
+	}
+	return n
+}
+
+func (m *GetNamespaceReplicationMessagesResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Messages != nil {
+		l = m.Messages.Size()
+		n += 1 + l + sovRequestResponse(uint64(l))
+	}
+	return n
+}
+
+func (m *GetDLQReplicationMessagesRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.TaskInfos) > 0 {
+		for _, e := range m.TaskInfos {
+			l = e.Size()
+			n += 1 + l + sovRequestResponse(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *GetDLQReplicationMessagesResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.ReplicationTasks) > 0 {
+		for _, e := range m.ReplicationTasks {
+			l = e.Size()
+			n += 1 + l + sovRequestResponse(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *ReapplyEventsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Namespace)
+	if l > 0 {
+		n += 1 + l + sovRequestResponse(uint64(l))
+	}
+	if m.WorkflowExecution != nil {
+		l = m.WorkflowExecution.Size()
+		n += 1 + l + sovRequestResponse(uint64(l))
+	}
+	if m.Events != nil {
+		l = m.Events.Size()
+		n += 1 + l + sovRequestResponse(uint64(l))
+	}
+	return n
+}
+
+func (m *ReapplyEventsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *AddSearchAttributeRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.SearchAttribute) > 0 {
+		for k, v := range m.SearchAttribute {
+			_ = k
+			_ = v
+			mapEntrySize := 1 + len(k) + sovRequestResponse(uint64(len(k))) + 1 + sovRequestResponse(uint64(v))
+			n += mapEntrySize + 1 + sovRequestResponse(uint64(mapEntrySize))
+		}
+	}
+	l = len(m.SecurityToken)
+	if l > 0 {
+		n += 1 + l + sovRequestResponse(uint64(l))
+	}
+	return n
+}
+
+func (m *AddSearchAttributeResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *DescribeClusterRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *DescribeClusterResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.SupportedClients) > 0 {
+		for k, v := range m.SupportedClients {
+			_ = k
+			_ = v
+			mapEntrySize := 1 + len(k) + sovRequestResponse(uint64(len(k))) + 1 + len(v) + sovRequestResponse(uint64(len(v)))
+			n += mapEntrySize + 1 + sovRequestResponse(uint64(mapEntrySize))
+		}
+	}
+	l = len(m.ServerVersion)
+	if l > 0 {
+		n += 1 + l + sovRequestResponse(uint64(l))
+	}
+	if m.MembershipInfo != nil {
+		l = m.MembershipInfo.Size()
+		n += 1 + l + sovRequestResponse(uint64(l))
+	}
+	return n
+}
+
+func (m *GetDLQMessagesRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Type != 0 {
+		n += 1 + sovRequestResponse(uint64(m.Type))
+	}
+	if m.ShardId != 0 {
+		n += 1 + sovRequestResponse(uint64(m.ShardId))
+	}
+	l = len(m.SourceCluster)
+	if l > 0 {
+		n += 1 + l + sovRequestResponse(uint64(l))
+	}
+	if m.InclusiveEndMessageId != 0 {
+		n += 1 + sovRequestResponse(uint64(m.InclusiveEndMessageId))
+	}
+	if m.MaximumPageSize != 0 {
+		n += 1 + sovRequestResponse(uint64(m.MaximumPageSize))
+	}
+	l = len(m.NextPageToken)
+	if l > 0 {
+		n += 1 + l + sovRequestResponse(uint64(l))
+	}
+	return n
+}
+
+func (m *GetDLQMessagesResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Type != 0 {
+		n += 1 + sovRequestResponse(uint64(m.Type))
+	}
+	if len(m.ReplicationTasks) > 0 {
+		for _, e := range m.ReplicationTasks {
+			l = e.Size()
+			n += 1 + l + sovRequestResponse(uint64(l))
+		}
+	}
+	l = len(m.NextPageToken)
+	if l > 0 {
+		n += 1 + l + sovRequestResponse(uint64(l))
+	}
+	return n
+}
+
+func (m *PurgeDLQMessagesRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Type != 0 {
+		n += 1 + sovRequestResponse(uint64(m.Type))
+	}
+	if m.ShardId != 0 {
+		n += 1 + sovRequestResponse(uint64(m.ShardId))
+	}
+	l = len(m.SourceCluster)
+	if l > 0 {
+		n += 1 + l + sovRequestResponse(uint64(l))
+	}
+	if m.InclusiveEndMessageId != 0 {
+		n += 1 + sovRequestResponse(uint64(m.InclusiveEndMessageId))
+	}
+	return n
+}
+
+func (m *PurgeDLQMessagesResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MergeDLQMessagesRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Type != 0 {
+		n += 1 + sovRequestResponse(uint64(m.Type))
+	}
+	if m.ShardId != 0 {
+		n += 1 + sovRequestResponse(uint64(m.ShardId))
+	}
+	l = len(m.SourceCluster)
+	if l > 0 {
+		n += 1 + l + sovRequestResponse(uint64(l))
+	}
+	if m.InclusiveEndMessageId != 0 {
+		n += 1 + sovRequestResponse(uint64(m.InclusiveEndMessageId))
+	}
+	if m.MaximumPageSize != 0 {
+		n += 1 + sovRequestResponse(uint64(m.MaximumPageSize))
+	}
+	l = len(m.NextPageToken)
+	if l > 0 {
+		n += 1 + l + sovRequestResponse(uint64(l))
+	}
+	return n
+}
+
+func (m *MergeDLQMessagesResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.NextPageToken)
+	if l > 0 {
+		n += 1 + l + sovRequestResponse(uint64(l))
+	}
+	return n
+}
+
+func (m *RefreshWorkflowTasksRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Namespace)
+	if l > 0 {
+		n += 1 + l + sovRequestResponse(uint64(l))
+	}
+	if m.Execution != nil {
+		l = m.Execution.Size()
+		n += 1 + l + sovRequestResponse(uint64(l))
+	}
+	return n
+}
+
+func (m *RefreshWorkflowTasksResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *ResendReplicationTasksRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.NamespaceId)
+	if l > 0 {
+		n += 1 + l + sovRequestResponse(uint64(l))
+	}
+	l = len(m.WorkflowId)
+	if l > 0 {
+		n += 1 + l + sovRequestResponse(uint64(l))
+	}
+	l = len(m.RunId)
+	if l > 0 {
+		n += 1 + l + sovRequestResponse(uint64(l))
+	}
+	l = len(m.RemoteCluster)
+	if l > 0 {
+		n += 1 + l + sovRequestResponse(uint64(l))
+	}
+	if m.StartEventId != 0 {
+		n += 1 + sovRequestResponse(uint64(m.StartEventId))
+	}
+	if m.StartVersion != 0 {
+		n += 1 + sovRequestResponse(uint64(m.StartVersion))
+	}
+	if m.EndEventId != 0 {
+		n += 1 + sovRequestResponse(uint64(m.EndEventId))
+	}
+	if m.EndVersion != 0 {
+		n += 1 + sovRequestResponse(uint64(m.EndVersion))
+	}
+	return n
+}
+
+func (m *ResendReplicationTasksResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+func (m *CreateScheduleRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Namespace)
+	if l > 0 {
+		n += 1 + l + sovRequestResponse(uint64(l))
+	}
+	l = len(m.ScheduleId)
+	if l > 0 {
+		n += 1 + l + sovRequestResponse(uint64(l))
+	}
+	if m.Schedule != nil {
+		l = m.Schedule.Size()
+		n += 1 + l + sovRequestResponse(uint64(l))
+	}
+	if m.InitialPatch != nil {
+		l = m.InitialPatch.Size()
+		n += 1 + l + sovRequestResponse(uint64(l))
+	}
+	l = len(m.Identity)
+	if l > 0 {
+		n += 1 + l + sovRequestResponse(uint64(l))
+	}
+	l = len(m.RequestId)
+	if l > 0 {
+		n += 1 + l + sovRequestResponse(uint64(l))
+	}
+	return n
+}
+
+func (m *CreateScheduleResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.ConflictToken != 0 {
+		n += 1 + sovRequestResponse(uint64(m.ConflictToken))
+	}
+	return n
+}
+
+func (m *DescribeScheduleRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Namespace)
+	if l > 0 {
+		n += 1 + l + sovRequestResponse(uint64(l))
+	}
+	l = len(m.ScheduleId)
+	if l > 0 {
+		n += 1 + l + sovRequestResponse(uint64(l))
+	}
+	return n
+}
+
+func (m *DescribeScheduleResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Schedule != nil {
+		l = m.Schedule.Size()
+		n += 1 + l + sovRequestResponse(uint64(l))
+	}
+	if m.Info != nil {
+		l = m.Info.Size()
+		n += 1 + l + sovRequestResponse(uint64(l))
+	}
+	if m.ConflictToken != 0 {
+		n += 1 + sovRequestResponse(uint64(m.ConflictToken))
+	}
+	return n
+}
+
+func (m *UpdateScheduleRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Namespace)
+	if l > 0 {
+		n += 1 + l + sovRequestResponse(uint64(l))
+	}
+	l = len(m.ScheduleId)
+	if l > 0 {
+		n += 1 + l + sovRequestResponse(uint64(l))
+	}
+	if m.Schedule != nil {
+		l = m.Schedule.Size()
+		n += 1 + l + sovRequestResponse(uint64(l))
+	}
+	if m.ConflictToken != 0 {
+		n += 1 + sovRequestResponse(uint64(m.ConflictToken))
+	}
+	l = len(m.Identity)
+	if l > 0 {
+		n += 1 + l + sovRequestResponse(uint64(l))
+	}
+	l = len(m.RequestId)
+	if l > 0 {
+		n += 1 + l + sovRequestResponse(uint64(l))
+	}
+	return n
+}
+
+func (m *UpdateScheduleResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *PauseScheduleRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Namespace)
+	if l > 0 {
+		n += 1 + l + sovRequestResponse(uint64(l))
+	}
+	l = len(m.ScheduleId)
+	if l > 0 {
+		n += 1 + l + sovRequestResponse(uint64(l))
+	}
+	l = len(m.Notes)
+	if l > 0 {
+		n += 1 + l + sovRequestResponse(uint64(l))
+	}
+	l = len(m.Identity)
+	if l > 0 {
+		n += 1 + l + sovRequestResponse(uint64(l))
+	}
+	return n
+}
+
+func (m *PauseScheduleResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *UnpauseScheduleRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Namespace)
+	if l > 0 {
+		n += 1 + l + sovRequestResponse(uint64(l))
+	}
+	l = len(m.ScheduleId)
+	if l > 0 {
+		n += 1 + l + sovRequestResponse(uint64(l))
+	}
+	l = len(m.Notes)
+	if l > 0 {
+		n += 1 + l + sovRequestResponse(uint64(l))
+	}
+	l = len(m.Identity)
+	if l > 0 {
+		n += 1 + l + sovRequestResponse(uint64(l))
+	}
+	return n
+}
+
+func (m *UnpauseScheduleResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *TriggerScheduleRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Namespace)
+	if l > 0 {
+		n += 1 + l + sovRequestResponse(uint64(l))
+	}
+	l = len(m.ScheduleId)
+	if l > 0 {
+		n += 1 + l + sovRequestResponse(uint64(l))
+	}
+	if m.OverlapPolicy != 0 {
+		n += 1 + sovRequestResponse(uint64(m.OverlapPolicy))
+	}
+	if len(m.Backfill) > 0 {
+		for _, e := range m.Backfill {
+			l = e.Size()
+			n += 1 + l + sovRequestResponse(uint64(l))
+		}
+	}
+	l = len(m.Identity)
+	if l > 0 {
+		n += 1 + l + sovRequestResponse(uint64(l))
+	}
+	return n
+}
+
+func (m *TriggerScheduleResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *DeleteScheduleRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Namespace)
+	if l > 0 {
+		n += 1 + l + sovRequestResponse(uint64(l))
+	}
+	l = len(m.ScheduleId)
+	if l > 0 {
+		n += 1 + l + sovRequestResponse(uint64(l))
+	}
+	l = len(m.Identity)
+	if l > 0 {
+		n += 1 + l + sovRequestResponse(uint64(l))
+	}
+	return n
+}
+
+func (m *DeleteScheduleResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *ListSchedulesRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Namespace)
+	if l > 0 {
+		n += 1 + l + sovRequestResponse(uint64(l))
+	}
+	if m.MaximumPageSize != 0 {
+		n += 1 + sovRequestResponse(uint64(m.MaximumPageSize))
+	}
+	l = len(m.NextPageToken)
+	if l > 0 {
+		n += 1 + l + sovRequestResponse(uint64(l))
+	}
+	return n
+}
+
+func (m *ListSchedulesResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Schedules) > 0 {
+		for _, e := range m.Schedules {
+			l = e.Size()
+			n += 1 + l + sovRequestResponse(uint64(l))
+		}
+	}
+	l = len(m.NextPageToken)
+	if l > 0 {
+		n += 1 + l + sovRequestResponse(uint64(l))
+	}
+	return n
+}
+func (m *DeleteWorkflowExecutionRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Namespace)
+	if l > 0 {
+		n += 1 + l + sovRequestResponse(uint64(l))
+	}
+	if m.Execution != nil {
+		l = m.Execution.Size()
+		n += 1 + l + sovRequestResponse(uint64(l))
+	}
+	l = len(m.Identity)
+	if l > 0 {
+		n += 1 + l + sovRequestResponse(uint64(l))
+	}
+	return n
+}
+
+func (m *DeleteWorkflowExecutionResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+func (m *BatchResetParams) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ResetType)
+	if l > 0 {
+		n += 1 + l + sovRequestResponse(uint64(l))
+	}
+	l = len(m.BadBinaryChecksum)
+	if l > 0 {
+		n += 1 + l + sovRequestResponse(uint64(l))
+	}
+	if m.SkipCurrentOpen {
+		n += 2
+	}
+	if m.SkipBaseNotCurrent {
+		n += 2
+	}
+	if m.NonDeterministicOnly {
+		n += 2
+	}
+	return n
+}
+
+func (m *StartBatchOperationRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Namespace)
+	if l > 0 {
+		n += 1 + l + sovRequestResponse(uint64(l))
+	}
+	l = len(m.JobId)
+	if l > 0 {
+		n += 1 + l + sovRequestResponse(uint64(l))
+	}
+	l = len(m.Query)
+	if l > 0 {
+		n += 1 + l + sovRequestResponse(uint64(l))
+	}
+	l = len(m.Reason)
+	if l > 0 {
+		n += 1 + l + sovRequestResponse(uint64(l))
+	}
+	l = len(m.BatchType)
+	if l > 0 {
+		n += 1 + l + sovRequestResponse(uint64(l))
+	}
+	l = len(m.Identity)
+	if l > 0 {
+		n += 1 + l + sovRequestResponse(uint64(l))
+	}
+	l = len(m.SignalName)
+	if l > 0 {
+		n += 1 + l + sovRequestResponse(uint64(l))
+	}
+	if m.SignalInput != nil {
+		l = m.SignalInput.Size()
+		n += 1 + l + sovRequestResponse(uint64(l))
+	}
+	if m.ResetParams != nil {
+		l = m.ResetParams.Size()
+		n += 1 + l + sovRequestResponse(uint64(l))
+	}
+	if m.Rps != 0 {
+		n += 1 + sovRequestResponse(uint64(m.Rps))
+	}
+	return n
+}
+
+func (m *StartBatchOperationResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *StopBatchOperationRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Namespace)
+	if l > 0 {
+		n += 1 + l + sovRequestResponse(uint64(l))
+	}
+	l = len(m.JobId)
+	if l > 0 {
+		n += 1 + l + sovRequestResponse(uint64(l))
+	}
+	l = len(m.Reason)
+	if l > 0 {
+		n += 1 + l + sovRequestResponse(uint64(l))
+	}
+	l = len(m.Identity)
+	if l > 0 {
+		n += 1 + l + sovRequestResponse(uint64(l))
+	}
+	return n
+}
+
+func (m *StopBatchOperationResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *DescribeBatchOperationRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Namespace)
+	if l > 0 {
+		n += 1 + l + sovRequestResponse(uint64(l))
+	}
+	l = len(m.JobId)
+	if l > 0 {
+		n += 1 + l + sovRequestResponse(uint64(l))
+	}
+	return n
+}
+
+func (m *DescribeBatchOperationResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.JobId)
+	if l > 0 {
+		n += 1 + l + sovRequestResponse(uint64(l))
+	}
+	l = len(m.State)
+	if l > 0 {
+		n += 1 + l + sovRequestResponse(uint64(l))
+	}
+	if m.StartTime != nil {
+		l = github_com_gogo_protobuf_types.SizeOfStdTime(*m.StartTime)
+		n += 1 + l + sovRequestResponse(uint64(l))
+	}
+	if m.CloseTime != nil {
+		l = github_com_gogo_protobuf_types.SizeOfStdTime(*m.CloseTime)
+		n += 1 + l + sovRequestResponse(uint64(l))
+	}
+	l = len(m.Reason)
+	if l > 0 {
+		n += 1 + l + sovRequestResponse(uint64(l))
+	}
+	l = len(m.Identity)
+	if l > 0 {
+		n += 1 + l + sovRequestResponse(uint64(l))
+	}
+	if m.TotalOperationCount != 0 {
+		n += 1 + sovRequestResponse(uint64(m.TotalOperationCount))
+	}
+	if m.CompleteOperationCount != 0 {
+		n += 1 + sovRequestResponse(uint64(m.CompleteOperationCount))
+	}
+	if m.FailureOperationCount != 0 {
+		n += 1 + sovRequestResponse(uint64(m.FailureOperationCount))
+	}
+	return n
+}
+
+func (m *ListBatchOperationsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Namespace)
+	if l > 0 {
+		n += 1 + l + sovRequestResponse(uint64(l))
+	}
+	if m.PageSize != 0 {
+		n += 1 + sovRequestResponse(uint64(m.PageSize))
+	}
+	l = len(m.NextPageToken)
+	if l > 0 {
+		n += 1 + l + sovRequestResponse(uint64(l))
+	}
+	return n
+}
+
+func (m *BatchOperationInfo) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.JobId)
+	if l > 0 {
+		n += 1 + l + sovRequestResponse(uint64(l))
+	}
+	l = len(m.State)
+	if l > 0 {
+		n += 1 + l + sovRequestResponse(uint64(l))
+	}
+	if m.StartTime != nil {
+		l = github_com_gogo_protobuf_types.SizeOfStdTime(*m.StartTime)
+		n += 1 + l + sovRequestResponse(uint64(l))
+	}
+	if m.CloseTime != nil {
+		l = github_com_gogo_protobuf_types.SizeOfStdTime(*m.CloseTime)
+		n += 1 + l + sovRequestResponse(uint64(l))
+	}
+	return n
+}
+
+func (m *ListBatchOperationsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.OperationInfo) > 0 {
+		for _, e := range m.OperationInfo {
+			l = e.Size()
+			n += 1 + l + sovRequestResponse(uint64(l))
+		}
+	}
+	l = len(m.NextPageToken)
+	if l > 0 {
+		n += 1 + l + sovRequestResponse(uint64(l))
+	}
+	return n
+}
+func (m *UpdateWorkflowExecutionRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Namespace)
+	if l > 0 {
+		n += 1 + l + sovRequestResponse(uint64(l))
+	}
+	if m.Execution != nil {
+		l = m.Execution.Size()
+		n += 1 + l + sovRequestResponse(uint64(l))
+	}
+	l = len(m.UpdateId)
+	if l > 0 {
+		n += 1 + l + sovRequestResponse(uint64(l))
+	}
+	l = len(m.Name)
+	if l > 0 {
+		n += 1 + l + sovRequestResponse(uint64(l))
+	}
+	if m.Input != nil {
+		l = m.Input.Size()
+		n += 1 + l + sovRequestResponse(uint64(l))
+	}
+	l = len(m.Identity)
+	if l > 0 {
+		n += 1 + l + sovRequestResponse(uint64(l))
+	}
+	if m.WaitForAccepted {
+		n += 2
+	}
+	return n
+}
+
+func (m *UpdateWorkflowExecutionResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.UpdateId)
+	if l > 0 {
+		n += 1 + l + sovRequestResponse(uint64(l))
+	}
+	if m.Completed {
+		n += 2
+	}
+	if m.Result != nil {
+		l = m.Result.Size()
+		n += 1 + l + sovRequestResponse(uint64(l))
+	}
+	if m.Failure != nil {
+		l = m.Failure.Size()
+		n += 1 + l + sovRequestResponse(uint64(l))
+	}
+	return n
+}
+func (m *GetWorkflowExecutionResultRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Namespace)
+	if l > 0 {
+		n += 1 + l + sovRequestResponse(uint64(l))
+	}
+	if m.Execution != nil {
+		l = m.Execution.Size()
+		n += 1 + l + sovRequestResponse(uint64(l))
+	}
+	if m.FollowRuns {
+		n += 2
+	}
+	return n
+}
+
+func (m *GetWorkflowExecutionResultResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Execution != nil {
+		l = m.Execution.Size()
+		n += 1 + l + sovRequestResponse(uint64(l))
+	}
+	if m.Status != 0 {
+		n += 1 + sovRequestResponse(uint64(m.Status))
+	}
+	if m.Result != nil {
+		l = m.Result.Size()
+		n += 1 + l + sovRequestResponse(uint64(l))
+	}
+	if m.Failure != nil {
+		l = m.Failure.Size()
+		n += 1 + l + sovRequestResponse(uint64(l))
+	}
+	l = len(m.NewExecutionRunId)
+	if l > 0 {
+		n += 1 + l + sovRequestResponse(uint64(l))
+	}
+	return n
+}
+func (m *ResetWorkflowExecutionWithOptionsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Namespace)
+	if l > 0 {
+		n += 1 + l + sovRequestResponse(uint64(l))
+	}
+	if m.Execution != nil {
+		l = m.Execution.Size()
+		n += 1 + l + sovRequestResponse(uint64(l))
+	}
+	l = len(m.Reason)
+	if l > 0 {
+		n += 1 + l + sovRequestResponse(uint64(l))
+	}
+	l = len(m.RequestId)
+	if l > 0 {
+		n += 1 + l + sovRequestResponse(uint64(l))
+	}
+	if m.WorkflowTaskFinishEventId != 0 {
+		n += 1 + sovRequestResponse(uint64(m.WorkflowTaskFinishEventId))
+	}
+	if m.WorkflowTaskScheduledEventId != 0 {
+		n += 1 + sovRequestResponse(uint64(m.WorkflowTaskScheduledEventId))
+	}
+	l = len(m.ReapplyType)
+	if l > 0 {
+		n += 1 + l + sovRequestResponse(uint64(l))
+	}
+	if m.DryRun {
+		n += 2
+	}
+	return n
+}
+
+func (m *ResetWorkflowExecutionWithOptionsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.RunId)
+	if l > 0 {
+		n += 1 + l + sovRequestResponse(uint64(l))
+	}
+	if m.Preview != nil {
+		l = m.Preview.Size()
+		n += 1 + l + sovRequestResponse(uint64(l))
+	}
+	return n
+}
+
+func (m *ResetWorkflowExecutionPreview) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.BaseRunId)
+	if l > 0 {
+		n += 1 + l + sovRequestResponse(uint64(l))
+	}
+	if m.WorkflowTaskFinishEventId != 0 {
+		n += 1 + sovRequestResponse(uint64(m.WorkflowTaskFinishEventId))
+	}
+	l = len(m.TerminatedRunId)
+	if l > 0 {
+		n += 1 + l + sovRequestResponse(uint64(l))
+	}
+	if len(m.DroppedEvents) > 0 {
+		for _, e := range m.DroppedEvents {
+			l = e.Size()
+			n += 1 + l + sovRequestResponse(uint64(l))
+		}
+	}
+	if len(m.FailedActivities) > 0 {
+		for _, e := range m.FailedActivities {
+			l = e.Size()
+			n += 1 + l + sovRequestResponse(uint64(l))
+		}
+	}
+	if len(m.PendingChildren) > 0 {
+		for _, e := range m.PendingChildren {
+			l = e.Size()
+			n += 1 + l + sovRequestResponse(uint64(l))
+		}
+	}
+	if len(m.ReappliedEvents) > 0 {
+		for _, e := range m.ReappliedEvents {
+			l = e.Size()
+			n += 1 + l + sovRequestResponse(uint64(l))
+		}
+	}
+	return n
+}
+func (m *PauseWorkflowExecutionRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Namespace)
+	if l > 0 {
+		n += 1 + l + sovRequestResponse(uint64(l))
+	}
+	if m.Execution != nil {
+		l = m.Execution.Size()
+		n += 1 + l + sovRequestResponse(uint64(l))
+	}
+	l = len(m.Identity)
+	if l > 0 {
+		n += 1 + l + sovRequestResponse(uint64(l))
+	}
+	l = len(m.Reason)
+	if l > 0 {
+		n += 1 + l + sovRequestResponse(uint64(l))
+	}
+	return n
+}
+
+func (m *PauseWorkflowExecutionResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *UnpauseWorkflowExecutionRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Namespace)
+	if l > 0 {
+		n += 1 + l + sovRequestResponse(uint64(l))
+	}
+	if m.Execution != nil {
+		l = m.Execution.Size()
+		n += 1 + l + sovRequestResponse(uint64(l))
+	}
+	l = len(m.Identity)
+	if l > 0 {
+		n += 1 + l + sovRequestResponse(uint64(l))
+	}
+	return n
+}
+
+func (m *UnpauseWorkflowExecutionResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func sovRequestResponse(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozRequestResponse(x uint64) (n int) {
+	return sovRequestResponse(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (this *DescribeWorkflowExecutionRequest) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&DescribeWorkflowExecutionRequest{`,
+		`Namespace:` + fmt.Sprintf("%v", this.Namespace) + `,`,
+		`Execution:` + strings.Replace(fmt.Sprintf("%v", this.Execution), "WorkflowExecution", "v1.WorkflowExecution", 1) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *DescribeWorkflowExecutionResponse) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&DescribeWorkflowExecutionResponse{`,
+		`ShardId:` + fmt.Sprintf("%v", this.ShardId) + `,`,
+		`HistoryAddr:` + fmt.Sprintf("%v", this.HistoryAddr) + `,`,
+		`CacheMutableState:` + fmt.Sprintf("%v", this.CacheMutableState) + `,`,
+		`DatabaseMutableState:` + fmt.Sprintf("%v", this.DatabaseMutableState) + `,`,
+		`TreeId:` + fmt.Sprintf("%v", this.TreeId) + `,`,
+		`BranchId:` + fmt.Sprintf("%v", this.BranchId) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *DescribeHistoryHostRequest) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&DescribeHistoryHostRequest{`,
+		`HostAddress:` + fmt.Sprintf("%v", this.HostAddress) + `,`,
+		`ShardId:` + fmt.Sprintf("%v", this.ShardId) + `,`,
+		`Namespace:` + fmt.Sprintf("%v", this.Namespace) + `,`,
+		`WorkflowExecution:` + strings.Replace(fmt.Sprintf("%v", this.WorkflowExecution), "WorkflowExecution", "v1.WorkflowExecution", 1) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *DescribeHistoryHostResponse) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&DescribeHistoryHostResponse{`,
+		`ShardsNumber:` + fmt.Sprintf("%v", this.ShardsNumber) + `,`,
+		`ShardIds:` + fmt.Sprintf("%v", this.ShardIds) + `,`,
+		`NamespaceCache:` + strings.Replace(fmt.Sprintf("%v", this.NamespaceCache), "NamespaceCacheInfo", "v11.NamespaceCacheInfo", 1) + `,`,
+		`ShardControllerStatus:` + fmt.Sprintf("%v", this.ShardControllerStatus) + `,`,
+		`Address:` + fmt.Sprintf("%v", this.Address) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *CloseShardRequest) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&CloseShardRequest{`,
+		`ShardId:` + fmt.Sprintf("%v", this.ShardId) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *CloseShardResponse) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&CloseShardResponse{`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *RemoveTaskRequest) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&RemoveTaskRequest{`,
+		`ShardId:` + fmt.Sprintf("%v", this.ShardId) + `,`,
+		`Category:` + fmt.Sprintf("%v", this.Category) + `,`,
+		`TaskId:` + fmt.Sprintf("%v", this.TaskId) + `,`,
+		`VisibilityTime:` + strings.Replace(fmt.Sprintf("%v", this.VisibilityTime), "Timestamp", "types.Timestamp", 1) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *RemoveTaskResponse) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&RemoveTaskResponse{`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *GetWorkflowExecutionRawHistoryV2Request) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&GetWorkflowExecutionRawHistoryV2Request{`,
+		`Namespace:` + fmt.Sprintf("%v", this.Namespace) + `,`,
+		`Execution:` + strings.Replace(fmt.Sprintf("%v", this.Execution), "WorkflowExecution", "v1.WorkflowExecution", 1) + `,`,
+		`StartEventId:` + fmt.Sprintf("%v", this.StartEventId) + `,`,
+		`StartEventVersion:` + fmt.Sprintf("%v", this.StartEventVersion) + `,`,
+		`EndEventId:` + fmt.Sprintf("%v", this.EndEventId) + `,`,
+		`EndEventVersion:` + fmt.Sprintf("%v", this.EndEventVersion) + `,`,
+		`MaximumPageSize:` + fmt.Sprintf("%v", this.MaximumPageSize) + `,`,
+		`NextPageToken:` + fmt.Sprintf("%v", this.NextPageToken) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *GetWorkflowExecutionRawHistoryV2Response) String() string {
+	if this == nil {
+		return "nil"
+	}
+	repeatedStringForHistoryBatches := "[]*DataBlob{"
+	for _, f := range this.HistoryBatches {
+		repeatedStringForHistoryBatches += strings.Replace(fmt.Sprintf("%v", f), "DataBlob", "v1.DataBlob", 1) + ","
+	}
+	repeatedStringForHistoryBatches += "}"
+	s := strings.Join([]string{`&GetWorkflowExecutionRawHistoryV2Response{`,
+		`NextPageToken:` + fmt.Sprintf("%v", this.NextPageToken) + `,`,
+		`HistoryBatches:` + repeatedStringForHistoryBatches + `,`,
+		`VersionHistory:` + strings.Replace(fmt.Sprintf("%v", this.VersionHistory), "VersionHistory", "v13.VersionHistory", 1) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *GetReplicationMessagesRequest) String() string {
+	if this == nil {
+		return "nil"
+	}
+	repeatedStringForTokens := "[]*ReplicationToken{"
+	for _, f := range this.Tokens {
+		repeatedStringForTokens += strings.Replace(fmt.Sprintf("%v", f), "ReplicationToken", "v14.ReplicationToken", 1) + ","
+	}
+	repeatedStringForTokens += "}"
+	s := strings.Join([]string{`&GetReplicationMessagesRequest{`,
+		`Tokens:` + repeatedStringForTokens + `,`,
+		`ClusterName:` + fmt.Sprintf("%v", this.ClusterName) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *GetReplicationMessagesResponse) String() string {
+	if this == nil {
+		return "nil"
+	}
+	keysForShardMessages := make([]int32, 0, len(this.ShardMessages))
+	for k, _ := range this.ShardMessages {
+		keysForShardMessages = append(keysForShardMessages, k)
+	}
+	github_com_gogo_protobuf_sortkeys.Int32s(keysForShardMessages)
+	mapStringForShardMessages := "map[int32]*v14.ReplicationMessages{"
+	for _, k := range keysForShardMessages {
+		mapStringForShardMessages += fmt.Sprintf("%v: %v,", k, this.ShardMessages[k])
+	}
+	mapStringForShardMessages += "}"
+	s := strings.Join([]string{`&GetReplicationMessagesResponse{`,
+		`ShardMessages:` + mapStringForShardMessages + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *GetNamespaceReplicationMessagesRequest) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&GetNamespaceReplicationMessagesRequest{`,
+		`LastRetrievedMessageId:` + fmt.Sprintf("%v", this.LastRetrievedMessageId) + `,`,
+		`LastProcessedMessageId:` + fmt.Sprintf("%v", this.LastProcessedMessageId) + `,`,
+		`ClusterName:` + fmt.Sprintf("%v", this.ClusterName) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *GetNamespaceReplicationMessagesResponse) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&GetNamespaceReplicationMessagesResponse{`,
+		`Messages:` + strings.Replace(fmt.Sprintf("%v", this.Messages), "ReplicationMessages", "v14.ReplicationMessages", 1) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *GetDLQReplicationMessagesRequest) String() string {
+	if this == nil {
+		return "nil"
+	}
+	repeatedStringForTaskInfos := "[]*ReplicationTaskInfo{"
+	for _, f := range this.TaskInfos {
+		repeatedStringForTaskInfos += strings.Replace(fmt.Sprintf("%v", f), "ReplicationTaskInfo", "v14.ReplicationTaskInfo", 1) + ","
+	}
+	repeatedStringForTaskInfos += "}"
+	s := strings.Join([]string{`&GetDLQReplicationMessagesRequest{`,
+		`TaskInfos:` + repeatedStringForTaskInfos + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *GetDLQReplicationMessagesResponse) String() string {
+	if this == nil {
+		return "nil"
+	}
+	repeatedStringForReplicationTasks := "[]*ReplicationTask{"
+	for _, f := range this.ReplicationTasks {
+		repeatedStringForReplicationTasks += strings.Replace(fmt.Sprintf("%v", f), "ReplicationTask", "v14.ReplicationTask", 1) + ","
+	}
+	repeatedStringForReplicationTasks += "}"
+	s := strings.Join([]string{`&GetDLQReplicationMessagesResponse{`,
+		`ReplicationTasks:` + repeatedStringForReplicationTasks + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *ReapplyEventsRequest) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&ReapplyEventsRequest{`,
+		`Namespace:` + fmt.Sprintf("%v", this.Namespace) + `,`,
+		`WorkflowExecution:` + strings.Replace(fmt.Sprintf("%v", this.WorkflowExecution), "WorkflowExecution", "v1.WorkflowExecution", 1) + `,`,
+		`Events:` + strings.Replace(fmt.Sprintf("%v", this.Events), "DataBlob", "v1.DataBlob", 1) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *ReapplyEventsResponse) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&ReapplyEventsResponse{`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *AddSearchAttributeRequest) String() string {
+	if this == nil {
+		return "nil"
+	}
+	keysForSearchAttribute := make([]string, 0, len(this.SearchAttribute))
+	for k, _ := range this.SearchAttribute {
+		keysForSearchAttribute = append(keysForSearchAttribute, k)
+	}
+	github_com_gogo_protobuf_sortkeys.Strings(keysForSearchAttribute)
+	mapStringForSearchAttribute := "map[string]v15.IndexedValueType{"
+	for _, k := range keysForSearchAttribute {
+		mapStringForSearchAttribute += fmt.Sprintf("%v: %v,", k, this.SearchAttribute[k])
+	}
+	mapStringForSearchAttribute += "}"
+	s := strings.Join([]string{`&AddSearchAttributeRequest{`,
+		`SearchAttribute:` + mapStringForSearchAttribute + `,`,
+		`SecurityToken:` + fmt.Sprintf("%v", this.SecurityToken) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *AddSearchAttributeResponse) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&AddSearchAttributeResponse{`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *DescribeClusterRequest) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&DescribeClusterRequest{`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *DescribeClusterResponse) String() string {
+	if this == nil {
+		return "nil"
+	}
+	keysForSupportedClients := make([]string, 0, len(this.SupportedClients))
+	for k, _ := range this.SupportedClients {
+		keysForSupportedClients = append(keysForSupportedClients, k)
+	}
+	github_com_gogo_protobuf_sortkeys.Strings(keysForSupportedClients)
+	mapStringForSupportedClients := "map[string]string{"
+	for _, k := range keysForSupportedClients {
+		mapStringForSupportedClients += fmt.Sprintf("%v: %v,", k, this.SupportedClients[k])
+	}
+	mapStringForSupportedClients += "}"
+	s := strings.Join([]string{`&DescribeClusterResponse{`,
+		`SupportedClients:` + mapStringForSupportedClients + `,`,
+		`ServerVersion:` + fmt.Sprintf("%v", this.ServerVersion) + `,`,
+		`MembershipInfo:` + strings.Replace(fmt.Sprintf("%v", this.MembershipInfo), "MembershipInfo", "v16.MembershipInfo", 1) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *GetDLQMessagesRequest) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&GetDLQMessagesRequest{`,
+		`Type:` + fmt.Sprintf("%v", this.Type) + `,`,
+		`ShardId:` + fmt.Sprintf("%v", this.ShardId) + `,`,
+		`SourceCluster:` + fmt.Sprintf("%v", this.SourceCluster) + `,`,
+		`InclusiveEndMessageId:` + fmt.Sprintf("%v", this.InclusiveEndMessageId) + `,`,
+		`MaximumPageSize:` + fmt.Sprintf("%v", this.MaximumPageSize) + `,`,
+		`NextPageToken:` + fmt.Sprintf("%v", this.NextPageToken) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *GetDLQMessagesResponse) String() string {
+	if this == nil {
+		return "nil"
+	}
+	repeatedStringForReplicationTasks := "[]*ReplicationTask{"
+	for _, f := range this.ReplicationTasks {
+		repeatedStringForReplicationTasks += strings.Replace(fmt.Sprintf("%v", f), "ReplicationTask", "v14.ReplicationTask", 1) + ","
+	}
+	repeatedStringForReplicationTasks += "}"
+	s := strings.Join([]string{`&GetDLQMessagesResponse{`,
+		`Type:` + fmt.Sprintf("%v", this.Type) + `,`,
+		`ReplicationTasks:` + repeatedStringForReplicationTasks + `,`,
+		`NextPageToken:` + fmt.Sprintf("%v", this.NextPageToken) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *PurgeDLQMessagesRequest) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&PurgeDLQMessagesRequest{`,
+		`Type:` + fmt.Sprintf("%v", this.Type) + `,`,
+		`ShardId:` + fmt.Sprintf("%v", this.ShardId) + `,`,
+		`SourceCluster:` + fmt.Sprintf("%v", this.SourceCluster) + `,`,
+		`InclusiveEndMessageId:` + fmt.Sprintf("%v", this.InclusiveEndMessageId) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *PurgeDLQMessagesResponse) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&PurgeDLQMessagesResponse{`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *MergeDLQMessagesRequest) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&MergeDLQMessagesRequest{`,
+		`Type:` + fmt.Sprintf("%v", this.Type) + `,`,
+		`ShardId:` + fmt.Sprintf("%v", this.ShardId) + `,`,
+		`SourceCluster:` + fmt.Sprintf("%v", this.SourceCluster) + `,`,
+		`InclusiveEndMessageId:` + fmt.Sprintf("%v", this.InclusiveEndMessageId) + `,`,
+		`MaximumPageSize:` + fmt.Sprintf("%v", this.MaximumPageSize) + `,`,
+		`NextPageToken:` + fmt.Sprintf("%v", this.NextPageToken) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *MergeDLQMessagesResponse) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&MergeDLQMessagesResponse{`,
+		`NextPageToken:` + fmt.Sprintf("%v", this.NextPageToken) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *RefreshWorkflowTasksRequest) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&RefreshWorkflowTasksRequest{`,
+		`Namespace:` + fmt.Sprintf("%v", this.Namespace) + `,`,
+		`Execution:` + strings.Replace(fmt.Sprintf("%v", this.Execution), "WorkflowExecution", "v1.WorkflowExecution", 1) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *RefreshWorkflowTasksResponse) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&RefreshWorkflowTasksResponse{`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *ResendReplicationTasksRequest) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&ResendReplicationTasksRequest{`,
+		`NamespaceId:` + fmt.Sprintf("%v", this.NamespaceId) + `,`,
+		`WorkflowId:` + fmt.Sprintf("%v", this.WorkflowId) + `,`,
+		`RunId:` + fmt.Sprintf("%v", this.RunId) + `,`,
+		`RemoteCluster:` + fmt.Sprintf("%v", this.RemoteCluster) + `,`,
+		`StartEventId:` + fmt.Sprintf("%v", this.StartEventId) + `,`,
+		`StartVersion:` + fmt.Sprintf("%v", this.StartVersion) + `,`,
+		`EndEventId:` + fmt.Sprintf("%v", this.EndEventId) + `,`,
+		`EndVersion:` + fmt.Sprintf("%v", this.EndVersion) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *ResendReplicationTasksResponse) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&ResendReplicationTasksResponse{`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *CreateScheduleRequest) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&CreateScheduleRequest{`,
+		`Namespace:` + fmt.Sprintf("%v", this.Namespace) + `,`,
+		`ScheduleId:` + fmt.Sprintf("%v", this.ScheduleId) + `,`,
+		`Schedule:` + strings.Replace(fmt.Sprintf("%v", this.Schedule), "Schedule", "v17.Schedule", 1) + `,`,
+		`InitialPatch:` + strings.Replace(fmt.Sprintf("%v", this.InitialPatch), "SchedulePatch", "v17.SchedulePatch", 1) + `,`,
+		`Identity:` + fmt.Sprintf("%v", this.Identity) + `,`,
+		`RequestId:` + fmt.Sprintf("%v", this.RequestId) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *CreateScheduleResponse) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&CreateScheduleResponse{`,
+		`ConflictToken:` + fmt.Sprintf("%v", this.ConflictToken) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *DescribeScheduleRequest) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&DescribeScheduleRequest{`,
+		`Namespace:` + fmt.Sprintf("%v", this.Namespace) + `,`,
+		`ScheduleId:` + fmt.Sprintf("%v", this.ScheduleId) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *DescribeScheduleResponse) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&DescribeScheduleResponse{`,
+		`Schedule:` + strings.Replace(fmt.Sprintf("%v", this.Schedule), "Schedule", "v17.Schedule", 1) + `,`,
+		`Info:` + strings.Replace(fmt.Sprintf("%v", this.Info), "ScheduleInfo", "v17.ScheduleInfo", 1) + `,`,
+		`ConflictToken:` + fmt.Sprintf("%v", this.ConflictToken) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *UpdateScheduleRequest) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&UpdateScheduleRequest{`,
+		`Namespace:` + fmt.Sprintf("%v", this.Namespace) + `,`,
+		`ScheduleId:` + fmt.Sprintf("%v", this.ScheduleId) + `,`,
+		`Schedule:` + strings.Replace(fmt.Sprintf("%v", this.Schedule), "Schedule", "v17.Schedule", 1) + `,`,
+		`ConflictToken:` + fmt.Sprintf("%v", this.ConflictToken) + `,`,
+		`Identity:` + fmt.Sprintf("%v", this.Identity) + `,`,
+		`RequestId:` + fmt.Sprintf("%v", this.RequestId) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *UpdateScheduleResponse) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&UpdateScheduleResponse{`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *PauseScheduleRequest) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&PauseScheduleRequest{`,
+		`Namespace:` + fmt.Sprintf("%v", this.Namespace) + `,`,
+		`ScheduleId:` + fmt.Sprintf("%v", this.ScheduleId) + `,`,
+		`Notes:` + fmt.Sprintf("%v", this.Notes) + `,`,
+		`Identity:` + fmt.Sprintf("%v", this.Identity) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *PauseScheduleResponse) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&PauseScheduleResponse{`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *UnpauseScheduleRequest) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&UnpauseScheduleRequest{`,
+		`Namespace:` + fmt.Sprintf("%v", this.Namespace) + `,`,
+		`ScheduleId:` + fmt.Sprintf("%v", this.ScheduleId) + `,`,
+		`Notes:` + fmt.Sprintf("%v", this.Notes) + `,`,
+		`Identity:` + fmt.Sprintf("%v", this.Identity) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *UnpauseScheduleResponse) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&UnpauseScheduleResponse{`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *TriggerScheduleRequest) String() string {
+	if this == nil {
+		return "nil"
+	}
+	repeatedStringForBackfill := "[]*BackfillRequest{"
+	for _, f := range this.Backfill {
+		repeatedStringForBackfill += strings.Replace(fmt.Sprintf("%v", f), "BackfillRequest", "v17.BackfillRequest", 1) + ","
+	}
+	repeatedStringForBackfill += "}"
+	s := strings.Join([]string{`&TriggerScheduleRequest{`,
+		`Namespace:` + fmt.Sprintf("%v", this.Namespace) + `,`,
+		`ScheduleId:` + fmt.Sprintf("%v", this.ScheduleId) + `,`,
+		`OverlapPolicy:` + fmt.Sprintf("%v", this.OverlapPolicy) + `,`,
+		`Backfill:` + repeatedStringForBackfill + `,`,
+		`Identity:` + fmt.Sprintf("%v", this.Identity) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *TriggerScheduleResponse) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&TriggerScheduleResponse{`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *DeleteScheduleRequest) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&DeleteScheduleRequest{`,
+		`Namespace:` + fmt.Sprintf("%v", this.Namespace) + `,`,
+		`ScheduleId:` + fmt.Sprintf("%v", this.ScheduleId) + `,`,
+		`Identity:` + fmt.Sprintf("%v", this.Identity) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *DeleteScheduleResponse) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&DeleteScheduleResponse{`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *ListSchedulesRequest) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&ListSchedulesRequest{`,
+		`Namespace:` + fmt.Sprintf("%v", this.Namespace) + `,`,
+		`MaximumPageSize:` + fmt.Sprintf("%v", this.MaximumPageSize) + `,`,
+		`NextPageToken:` + fmt.Sprintf("%v", this.NextPageToken) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *ListSchedulesResponse) String() string {
+	if this == nil {
+		return "nil"
+	}
+	repeatedStringForSchedules := "[]*ScheduleListEntry{"
+	for _, f := range this.Schedules {
+		repeatedStringForSchedules += strings.Replace(fmt.Sprintf("%v", f), "ScheduleListEntry", "v17.ScheduleListEntry", 1) + ","
+	}
+	repeatedStringForSchedules += "}"
+	s := strings.Join([]string{`&ListSchedulesResponse{`,
+		`Schedules:` + repeatedStringForSchedules + `,`,
+		`NextPageToken:` + fmt.Sprintf("%v", this.NextPageToken) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *DeleteWorkflowExecutionRequest) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&DeleteWorkflowExecutionRequest{`,
+		`Namespace:` + fmt.Sprintf("%v", this.Namespace) + `,`,
+		`Execution:` + strings.Replace(fmt.Sprintf("%v", this.Execution), "WorkflowExecution", "v1.WorkflowExecution", 1) + `,`,
+		`Identity:` + fmt.Sprintf("%v", this.Identity) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *DeleteWorkflowExecutionResponse) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&DeleteWorkflowExecutionResponse{`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *BatchResetParams) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&BatchResetParams{`,
+		`ResetType:` + fmt.Sprintf("%v", this.ResetType) + `,`,
+		`BadBinaryChecksum:` + fmt.Sprintf("%v", this.BadBinaryChecksum) + `,`,
+		`SkipCurrentOpen:` + fmt.Sprintf("%v", this.SkipCurrentOpen) + `,`,
+		`SkipBaseNotCurrent:` + fmt.Sprintf("%v", this.SkipBaseNotCurrent) + `,`,
+		`NonDeterministicOnly:` + fmt.Sprintf("%v", this.NonDeterministicOnly) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *StartBatchOperationRequest) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&StartBatchOperationRequest{`,
+		`Namespace:` + fmt.Sprintf("%v", this.Namespace) + `,`,
+		`JobId:` + fmt.Sprintf("%v", this.JobId) + `,`,
+		`Query:` + fmt.Sprintf("%v", this.Query) + `,`,
+		`Reason:` + fmt.Sprintf("%v", this.Reason) + `,`,
+		`BatchType:` + fmt.Sprintf("%v", this.BatchType) + `,`,
+		`Identity:` + fmt.Sprintf("%v", this.Identity) + `,`,
+		`SignalName:` + fmt.Sprintf("%v", this.SignalName) + `,`,
+		`SignalInput:` + strings.Replace(fmt.Sprintf("%v", this.SignalInput), "Payloads", "v1.Payloads", 1) + `,`,
+		`ResetParams:` + strings.Replace(this.ResetParams.String(), "BatchResetParams", "BatchResetParams", 1) + `,`,
+		`Rps:` + fmt.Sprintf("%v", this.Rps) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *StartBatchOperationResponse) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&StartBatchOperationResponse{`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *StopBatchOperationRequest) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&StopBatchOperationRequest{`,
+		`Namespace:` + fmt.Sprintf("%v", this.Namespace) + `,`,
+		`JobId:` + fmt.Sprintf("%v", this.JobId) + `,`,
+		`Reason:` + fmt.Sprintf("%v", this.Reason) + `,`,
+		`Identity:` + fmt.Sprintf("%v", this.Identity) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *StopBatchOperationResponse) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&StopBatchOperationResponse{`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *DescribeBatchOperationRequest) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&DescribeBatchOperationRequest{`,
+		`Namespace:` + fmt.Sprintf("%v", this.Namespace) + `,`,
+		`JobId:` + fmt.Sprintf("%v", this.JobId) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *DescribeBatchOperationResponse) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&DescribeBatchOperationResponse{`,
+		`JobId:` + fmt.Sprintf("%v", this.JobId) + `,`,
+		`State:` + fmt.Sprintf("%v", this.State) + `,`,
+		`StartTime:` + strings.Replace(fmt.Sprintf("%v", this.StartTime), "Timestamp", "types.Timestamp", 1) + `,`,
+		`CloseTime:` + strings.Replace(fmt.Sprintf("%v", this.CloseTime), "Timestamp", "types.Timestamp", 1) + `,`,
+		`Reason:` + fmt.Sprintf("%v", this.Reason) + `,`,
+		`Identity:` + fmt.Sprintf("%v", this.Identity) + `,`,
+		`TotalOperationCount:` + fmt.Sprintf("%v", this.TotalOperationCount) + `,`,
+		`CompleteOperationCount:` + fmt.Sprintf("%v", this.CompleteOperationCount) + `,`,
+		`FailureOperationCount:` + fmt.Sprintf("%v", this.FailureOperationCount) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *ListBatchOperationsRequest) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&ListBatchOperationsRequest{`,
+		`Namespace:` + fmt.Sprintf("%v", this.Namespace) + `,`,
+		`PageSize:` + fmt.Sprintf("%v", this.PageSize) + `,`,
+		`NextPageToken:` + fmt.Sprintf("%v", this.NextPageToken) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *BatchOperationInfo) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&BatchOperationInfo{`,
+		`JobId:` + fmt.Sprintf("%v", this.JobId) + `,`,
+		`State:` + fmt.Sprintf("%v", this.State) + `,`,
+		`StartTime:` + strings.Replace(fmt.Sprintf("%v", this.StartTime), "Timestamp", "types.Timestamp", 1) + `,`,
+		`CloseTime:` + strings.Replace(fmt.Sprintf("%v", this.CloseTime), "Timestamp", "types.Timestamp", 1) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *ListBatchOperationsResponse) String() string {
+	if this == nil {
+		return "nil"
+	}
+	repeatedStringForOperationInfo := "[]*BatchOperationInfo{"
+	for _, f := range this.OperationInfo {
+		repeatedStringForOperationInfo += strings.Replace(f.String(), "BatchOperationInfo", "BatchOperationInfo", 1) + ","
+	}
+	repeatedStringForOperationInfo += "}"
+	s := strings.Join([]string{`&ListBatchOperationsResponse{`,
+		`OperationInfo:` + repeatedStringForOperationInfo + `,`,
+		`NextPageToken:` + fmt.Sprintf("%v", this.NextPageToken) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *UpdateWorkflowExecutionRequest) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&UpdateWorkflowExecutionRequest{`,
+		`Namespace:` + fmt.Sprintf("%v", this.Namespace) + `,`,
+		`Execution:` + strings.Replace(fmt.Sprintf("%v", this.Execution), "WorkflowExecution", "v1.WorkflowExecution", 1) + `,`,
+		`UpdateId:` + fmt.Sprintf("%v", this.UpdateId) + `,`,
+		`Name:` + fmt.Sprintf("%v", this.Name) + `,`,
+		`Input:` + strings.Replace(fmt.Sprintf("%v", this.Input), "Payloads", "v1.Payloads", 1) + `,`,
+		`Identity:` + fmt.Sprintf("%v", this.Identity) + `,`,
+		`WaitForAccepted:` + fmt.Sprintf("%v", this.WaitForAccepted) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *UpdateWorkflowExecutionResponse) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&UpdateWorkflowExecutionResponse{`,
+		`UpdateId:` + fmt.Sprintf("%v", this.UpdateId) + `,`,
+		`Completed:` + fmt.Sprintf("%v", this.Completed) + `,`,
+		`Result:` + strings.Replace(fmt.Sprintf("%v", this.Result), "Payloads", "v1.Payloads", 1) + `,`,
+		`Failure:` + strings.Replace(fmt.Sprintf("%v", this.Failure), "Failure", "v18.Failure", 1) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *GetWorkflowExecutionResultRequest) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&GetWorkflowExecutionResultRequest{`,
+		`Namespace:` + fmt.Sprintf("%v", this.Namespace) + `,`,
+		`Execution:` + strings.Replace(fmt.Sprintf("%v", this.Execution), "WorkflowExecution", "v1.WorkflowExecution", 1) + `,`,
+		`FollowRuns:` + fmt.Sprintf("%v", this.FollowRuns) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *GetWorkflowExecutionResultResponse) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&GetWorkflowExecutionResultResponse{`,
+		`Execution:` + strings.Replace(fmt.Sprintf("%v", this.Execution), "WorkflowExecution", "v1.WorkflowExecution", 1) + `,`,
+		`Status:` + fmt.Sprintf("%v", this.Status) + `,`,
+		`Result:` + strings.Replace(fmt.Sprintf("%v", this.Result), "Payloads", "v1.Payloads", 1) + `,`,
+		`Failure:` + strings.Replace(fmt.Sprintf("%v", this.Failure), "Failure", "v18.Failure", 1) + `,`,
+		`NewExecutionRunId:` + fmt.Sprintf("%v", this.NewExecutionRunId) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *ResetWorkflowExecutionWithOptionsRequest) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&ResetWorkflowExecutionWithOptionsRequest{`,
+		`Namespace:` + fmt.Sprintf("%v", this.Namespace) + `,`,
+		`Execution:` + strings.Replace(fmt.Sprintf("%v", this.Execution), "WorkflowExecution", "v1.WorkflowExecution", 1) + `,`,
+		`Reason:` + fmt.Sprintf("%v", this.Reason) + `,`,
+		`RequestId:` + fmt.Sprintf("%v", this.RequestId) + `,`,
+		`WorkflowTaskFinishEventId:` + fmt.Sprintf("%v", this.WorkflowTaskFinishEventId) + `,`,
+		`WorkflowTaskScheduledEventId:` + fmt.Sprintf("%v", this.WorkflowTaskScheduledEventId) + `,`,
+		`ReapplyType:` + fmt.Sprintf("%v", this.ReapplyType) + `,`,
+		`DryRun:` + fmt.Sprintf("%v", this.DryRun) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *ResetWorkflowExecutionWithOptionsResponse) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&ResetWorkflowExecutionWithOptionsResponse{`,
+		`RunId:` + fmt.Sprintf("%v", this.RunId) + `,`,
+		`Preview:` + strings.Replace(this.Preview.String(), "ResetWorkflowExecutionPreview", "ResetWorkflowExecutionPreview", 1) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *ResetWorkflowExecutionPreview) String() string {
+	if this == nil {
+		return "nil"
+	}
+	repeatedStringForDroppedEvents := "[]*HistoryEvent{"
+	for _, f := range this.DroppedEvents {
+		repeatedStringForDroppedEvents += strings.Replace(fmt.Sprintf("%v", f), "HistoryEvent", "v19.HistoryEvent", 1) + ","
+	}
+	repeatedStringForDroppedEvents += "}"
+	repeatedStringForFailedActivities := "[]*PendingActivityInfo{"
+	for _, f := range this.FailedActivities {
+		repeatedStringForFailedActivities += strings.Replace(fmt.Sprintf("%v", f), "PendingActivityInfo", "v20.PendingActivityInfo", 1) + ","
+	}
+	repeatedStringForFailedActivities += "}"
+	repeatedStringForPendingChildren := "[]*PendingChildExecutionInfo{"
+	for _, f := range this.PendingChildren {
+		repeatedStringForPendingChildren += strings.Replace(fmt.Sprintf("%v", f), "PendingChildExecutionInfo", "v20.PendingChildExecutionInfo", 1) + ","
+	}
+	repeatedStringForPendingChildren += "}"
+	repeatedStringForReappliedEvents := "[]*HistoryEvent{"
+	for _, f := range this.ReappliedEvents {
+		repeatedStringForReappliedEvents += strings.Replace(fmt.Sprintf("%v", f), "HistoryEvent", "v19.HistoryEvent", 1) + ","
+	}
+	repeatedStringForReappliedEvents += "}"
+	s := strings.Join([]string{`&ResetWorkflowExecutionPreview{`,
+		`BaseRunId:` + fmt.Sprintf("%v", this.BaseRunId) + `,`,
+		`WorkflowTaskFinishEventId:` + fmt.Sprintf("%v", this.WorkflowTaskFinishEventId) + `,`,
+		`TerminatedRunId:` + fmt.Sprintf("%v", this.TerminatedRunId) + `,`,
+		`DroppedEvents:` + repeatedStringForDroppedEvents + `,`,
+		`FailedActivities:` + repeatedStringForFailedActivities + `,`,
+		`PendingChildren:` + repeatedStringForPendingChildren + `,`,
+		`ReappliedEvents:` + repeatedStringForReappliedEvents + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *PauseWorkflowExecutionRequest) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&PauseWorkflowExecutionRequest{`,
+		`Namespace:` + fmt.Sprintf("%v", this.Namespace) + `,`,
+		`Execution:` + strings.Replace(fmt.Sprintf("%v", this.Execution), "WorkflowExecution", "v1.WorkflowExecution", 1) + `,`,
+		`Identity:` + fmt.Sprintf("%v", this.Identity) + `,`,
+		`Reason:` + fmt.Sprintf("%v", this.Reason) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *PauseWorkflowExecutionResponse) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&PauseWorkflowExecutionResponse{`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *UnpauseWorkflowExecutionRequest) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&UnpauseWorkflowExecutionRequest{`,
+		`Namespace:` + fmt.Sprintf("%v", this.Namespace) + `,`,
+		`Execution:` + strings.Replace(fmt.Sprintf("%v", this.Execution), "WorkflowExecution", "v1.WorkflowExecution", 1) + `,`,
+		`Identity:` + fmt.Sprintf("%v", this.Identity) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *UnpauseWorkflowExecutionResponse) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&UnpauseWorkflowExecutionResponse{`,
+		`}`,
+	}, "")
+	return s
+}
+func valueToStringRequestResponse(v interface{}) string {
+	rv := reflect.ValueOf(v)
+	if rv.IsNil() {
+		return "nil"
+	}
+	pv := reflect.Indirect(rv).Interface()
+	return fmt.Sprintf("*%v", pv)
+}
+func (m *DescribeWorkflowExecutionRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowRequestResponse
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: DescribeWorkflowExecutionRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: DescribeWorkflowExecutionRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Namespace", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRequestResponse
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthRequestResponse
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthRequestResponse
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Namespace = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Execution", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRequestResponse
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthRequestResponse
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthRequestResponse
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Execution == nil {
+				m.Execution = &v1.WorkflowExecution{}
+			}
+			if err := m.Execution.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipRequestResponse(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthRequestResponse
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthRequestResponse
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *DescribeWorkflowExecutionResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowRequestResponse
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: DescribeWorkflowExecutionResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: DescribeWorkflowExecutionResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ShardId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRequestResponse
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthRequestResponse
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthRequestResponse
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ShardId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field HistoryAddr", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRequestResponse
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthRequestResponse
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthRequestResponse
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.HistoryAddr = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CacheMutableState", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRequestResponse
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthRequestResponse
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthRequestResponse
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.CacheMutableState = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DatabaseMutableState", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRequestResponse
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthRequestResponse
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthRequestResponse
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DatabaseMutableState = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TreeId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRequestResponse
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthRequestResponse
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthRequestResponse
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TreeId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BranchId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRequestResponse
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthRequestResponse
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthRequestResponse
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.BranchId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipRequestResponse(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthRequestResponse
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthRequestResponse
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *DescribeHistoryHostRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowRequestResponse
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: DescribeHistoryHostRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: DescribeHistoryHostRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field HostAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRequestResponse
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthRequestResponse
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthRequestResponse
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.HostAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ShardId", wireType)
+			}
+			m.ShardId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRequestResponse
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ShardId |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Namespace", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRequestResponse
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthRequestResponse
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthRequestResponse
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Namespace = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field WorkflowExecution", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRequestResponse
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthRequestResponse
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthRequestResponse
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.WorkflowExecution == nil {
+				m.WorkflowExecution = &v1.WorkflowExecution{}
+			}
+			if err := m.WorkflowExecution.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipRequestResponse(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthRequestResponse
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthRequestResponse
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *DescribeHistoryHostResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowRequestResponse
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: DescribeHistoryHostResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: DescribeHistoryHostResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ShardsNumber", wireType)
+			}
+			m.ShardsNumber = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRequestResponse
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ShardsNumber |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType == 0 {
+				var v int32
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowRequestResponse
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= int32(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				m.ShardIds = append(m.ShardIds, v)
+			} else if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowRequestResponse
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					packedLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if packedLen < 0 {
+					return ErrInvalidLengthRequestResponse
+				}
+				postIndex := iNdEx + packedLen
+				if postIndex < 0 {
+					return ErrInvalidLengthRequestResponse
+				}
+				if postIndex > l {
+					return io.ErrUnexpectedEOF
+				}
+				var elementCount int
+				var count int
+				for _, integer := range dAtA[iNdEx:postIndex] {
+					if integer < 128 {
+						count++
+					}
+				}
+				elementCount = count
+				if elementCount != 0 && len(m.ShardIds) == 0 {
+					m.ShardIds = make([]int32, 0, elementCount)
+				}
+				for iNdEx < postIndex {
+					var v int32
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowRequestResponse
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						v |= int32(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					m.ShardIds = append(m.ShardIds, v)
+				}
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field ShardIds", wireType)
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NamespaceCache", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRequestResponse
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthRequestResponse
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthRequestResponse
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.NamespaceCache == nil {
+				m.NamespaceCache = &v11.NamespaceCacheInfo{}
+			}
+			if err := m.NamespaceCache.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ShardControllerStatus", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRequestResponse
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthRequestResponse
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthRequestResponse
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ShardControllerStatus = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRequestResponse
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthRequestResponse
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthRequestResponse
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Address = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipRequestResponse(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthRequestResponse
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthRequestResponse
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *CloseShardRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowRequestResponse
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: CloseShardRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: CloseShardRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ShardId", wireType)
+			}
+			m.ShardId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRequestResponse
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ShardId |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipRequestResponse(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthRequestResponse
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthRequestResponse
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *CloseShardResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowRequestResponse
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: CloseShardResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: CloseShardResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipRequestResponse(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthRequestResponse
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthRequestResponse
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *RemoveTaskRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowRequestResponse
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: RemoveTaskRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: RemoveTaskRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ShardId", wireType)
+			}
+			m.ShardId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRequestResponse
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ShardId |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Category", wireType)
+			}
+			m.Category = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRequestResponse
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Category |= v12.TaskCategory(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TaskId", wireType)
+			}
+			m.TaskId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRequestResponse
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.TaskId |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field VisibilityTime", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRequestResponse
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthRequestResponse
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthRequestResponse
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.VisibilityTime == nil {
+				m.VisibilityTime = new(time.Time)
+			}
+			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(m.VisibilityTime, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipRequestResponse(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthRequestResponse
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthRequestResponse
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *RemoveTaskResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowRequestResponse
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: RemoveTaskResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: RemoveTaskResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipRequestResponse(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthRequestResponse
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthRequestResponse
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *GetWorkflowExecutionRawHistoryV2Request) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowRequestResponse
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: GetWorkflowExecutionRawHistoryV2Request: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: GetWorkflowExecutionRawHistoryV2Request: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Namespace", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRequestResponse
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthRequestResponse
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthRequestResponse
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Namespace = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Execution", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRequestResponse
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthRequestResponse
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthRequestResponse
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Execution == nil {
+				m.Execution = &v1.WorkflowExecution{}
+			}
+			if err := m.Execution.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StartEventId", wireType)
+			}
+			m.StartEventId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRequestResponse
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.StartEventId |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StartEventVersion", wireType)
+			}
+			m.StartEventVersion = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRequestResponse
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.StartEventVersion |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EndEventId", wireType)
+			}
+			m.EndEventId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRequestResponse
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.EndEventId |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EndEventVersion", wireType)
+			}
+			m.EndEventVersion = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRequestResponse
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.EndEventVersion |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaximumPageSize", wireType)
+			}
+			m.MaximumPageSize = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRequestResponse
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaximumPageSize |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NextPageToken", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRequestResponse
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthRequestResponse
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthRequestResponse
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.NextPageToken = append(m.NextPageToken[:0], dAtA[iNdEx:postIndex]...)
+			if m.NextPageToken == nil {
+				m.NextPageToken = []byte{}
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipRequestResponse(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthRequestResponse
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthRequestResponse
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *GetWorkflowExecutionRawHistoryV2Response) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowRequestResponse
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: GetWorkflowExecutionRawHistoryV2Response: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: GetWorkflowExecutionRawHistoryV2Response: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NextPageToken", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRequestResponse
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthRequestResponse
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthRequestResponse
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.NextPageToken = append(m.NextPageToken[:0], dAtA[iNdEx:postIndex]...)
+			if m.NextPageToken == nil {
+				m.NextPageToken = []byte{}
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field HistoryBatches", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRequestResponse
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthRequestResponse
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthRequestResponse
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.HistoryBatches = append(m.HistoryBatches, &v1.DataBlob{})
+			if err := m.HistoryBatches[len(m.HistoryBatches)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field VersionHistory", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRequestResponse
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthRequestResponse
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthRequestResponse
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.VersionHistory == nil {
+				m.VersionHistory = &v13.VersionHistory{}
+			}
+			if err := m.VersionHistory.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipRequestResponse(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthRequestResponse
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthRequestResponse
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *GetReplicationMessagesRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowRequestResponse
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: GetReplicationMessagesRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: GetReplicationMessagesRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Tokens", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRequestResponse
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthRequestResponse
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthRequestResponse
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Tokens = append(m.Tokens, &v14.ReplicationToken{})
+			if err := m.Tokens[len(m.Tokens)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ClusterName", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRequestResponse
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthRequestResponse
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthRequestResponse
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ClusterName = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipRequestResponse(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthRequestResponse
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthRequestResponse
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *GetReplicationMessagesResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowRequestResponse
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: GetReplicationMessagesResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: GetReplicationMessagesResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ShardMessages", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRequestResponse
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthRequestResponse
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthRequestResponse
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.ShardMessages == nil {
+				m.ShardMessages = make(map[int32]*v14.ReplicationMessages)
+			}
+			var mapkey int32
+			var mapvalue *v14.ReplicationMessages
+			for iNdEx < postIndex {
+				entryPreIndex := iNdEx
+				var wire uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowRequestResponse
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					wire |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				fieldNum := int32(wire >> 3)
+				if fieldNum == 1 {
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowRequestResponse
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						mapkey |= int32(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+				} else if fieldNum == 2 {
+					var mapmsglen int
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowRequestResponse
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						mapmsglen |= int(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					if mapmsglen < 0 {
+						return ErrInvalidLengthRequestResponse
+					}
+					postmsgIndex := iNdEx + mapmsglen
+					if postmsgIndex < 0 {
+						return ErrInvalidLengthRequestResponse
+					}
+					if postmsgIndex > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapvalue = &v14.ReplicationMessages{}
+					if err := mapvalue.Unmarshal(dAtA[iNdEx:postmsgIndex]); err != nil {
+						return err
+					}
+					iNdEx = postmsgIndex
+				} else {
+					iNdEx = entryPreIndex
+					skippy, err := skipRequestResponse(dAtA[iNdEx:])
+					if err != nil {
+						return err
+					}
+					if skippy < 0 {
+						return ErrInvalidLengthRequestResponse
+					}
+					if (iNdEx + skippy) > postIndex {
+						return io.ErrUnexpectedEOF
+					}
+					iNdEx += skippy
+				}
+			}
+			m.ShardMessages[mapkey] = mapvalue
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipRequestResponse(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthRequestResponse
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthRequestResponse
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *GetNamespaceReplicationMessagesRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowRequestResponse
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: GetNamespaceReplicationMessagesRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: GetNamespaceReplicationMessagesRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LastRetrievedMessageId", wireType)
+			}
+			m.LastRetrievedMessageId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRequestResponse
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.LastRetrievedMessageId |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LastProcessedMessageId", wireType)
+			}
+			m.LastProcessedMessageId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRequestResponse
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.LastProcessedMessageId |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ClusterName", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRequestResponse
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthRequestResponse
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthRequestResponse
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ClusterName = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipRequestResponse(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthRequestResponse
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthRequestResponse
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *GetNamespaceReplicationMessagesResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowRequestResponse
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: GetNamespaceReplicationMessagesResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: GetNamespaceReplicationMessagesResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Messages", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRequestResponse
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthRequestResponse
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthRequestResponse
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Messages == nil {
+				m.Messages = &v14.ReplicationMessages{}
+			}
+			if err := m.Messages.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipRequestResponse(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthRequestResponse
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthRequestResponse
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *GetDLQReplicationMessagesRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowRequestResponse
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: GetDLQReplicationMessagesRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: GetDLQReplicationMessagesRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TaskInfos", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRequestResponse
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthRequestResponse
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthRequestResponse
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TaskInfos = append(m.TaskInfos, &v14.ReplicationTaskInfo{})
+			if err := m.TaskInfos[len(m.TaskInfos)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipRequestResponse(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthRequestResponse
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthRequestResponse
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *GetDLQReplicationMessagesResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowRequestResponse
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: GetDLQReplicationMessagesResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: GetDLQReplicationMessagesResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ReplicationTasks", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRequestResponse
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthRequestResponse
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthRequestResponse
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ReplicationTasks = append(m.ReplicationTasks, &v14.ReplicationTask{})
+			if err := m.ReplicationTasks[len(m.ReplicationTasks)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipRequestResponse(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthRequestResponse
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthRequestResponse
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ReapplyEventsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowRequestResponse
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ReapplyEventsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ReapplyEventsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Namespace", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRequestResponse
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthRequestResponse
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthRequestResponse
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Namespace = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field WorkflowExecution", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRequestResponse
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthRequestResponse
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthRequestResponse
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.WorkflowExecution == nil {
+				m.WorkflowExecution = &v1.WorkflowExecution{}
+			}
+			if err := m.WorkflowExecution.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Events", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRequestResponse
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthRequestResponse
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthRequestResponse
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Events == nil {
+				m.Events = &v1.DataBlob{}
+			}
+			if err := m.Events.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipRequestResponse(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthRequestResponse
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthRequestResponse
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ReapplyEventsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowRequestResponse
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ReapplyEventsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ReapplyEventsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipRequestResponse(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthRequestResponse
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthRequestResponse
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *AddSearchAttributeRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowRequestResponse
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: AddSearchAttributeRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: AddSearchAttributeRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SearchAttribute", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRequestResponse
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthRequestResponse
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthRequestResponse
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.SearchAttribute == nil {
+				m.SearchAttribute = make(map[string]v15.IndexedValueType)
+			}
+			var mapkey string
+			var mapvalue v15.IndexedValueType
+			for iNdEx < postIndex {
+				entryPreIndex := iNdEx
+				var wire uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowRequestResponse
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					wire |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				fieldNum := int32(wire >> 3)
+				if fieldNum == 1 {
+					var stringLenmapkey uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowRequestResponse
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapkey |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapkey := int(stringLenmapkey)
+					if intStringLenmapkey < 0 {
+						return ErrInvalidLengthRequestResponse
+					}
+					postStringIndexmapkey := iNdEx + intStringLenmapkey
+					if postStringIndexmapkey < 0 {
+						return ErrInvalidLengthRequestResponse
+					}
+					if postStringIndexmapkey > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapkey = string(dAtA[iNdEx:postStringIndexmapkey])
+					iNdEx = postStringIndexmapkey
+				} else if fieldNum == 2 {
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowRequestResponse
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						mapvalue |= v15.IndexedValueType(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+				} else {
+					iNdEx = entryPreIndex
+					skippy, err := skipRequestResponse(dAtA[iNdEx:])
+					if err != nil {
+						return err
+					}
+					if skippy < 0 {
+						return ErrInvalidLengthRequestResponse
+					}
+					if (iNdEx + skippy) > postIndex {
+						return io.ErrUnexpectedEOF
+					}
+					iNdEx += skippy
+				}
+			}
+			m.SearchAttribute[mapkey] = mapvalue
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SecurityToken", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRequestResponse
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthRequestResponse
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthRequestResponse
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SecurityToken = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipRequestResponse(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthRequestResponse
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthRequestResponse
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *AddSearchAttributeResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowRequestResponse
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: AddSearchAttributeResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: AddSearchAttributeResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipRequestResponse(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthRequestResponse
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthRequestResponse
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *DescribeClusterRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowRequestResponse
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: DescribeClusterRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: DescribeClusterRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipRequestResponse(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthRequestResponse
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthRequestResponse
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *DescribeClusterResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowRequestResponse
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: DescribeClusterResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: DescribeClusterResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SupportedClients", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRequestResponse
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthRequestResponse
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthRequestResponse
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.SupportedClients == nil {
+				m.SupportedClients = make(map[string]string)
+			}
+			var mapkey string
+			var mapvalue string
+			for iNdEx < postIndex {
+				entryPreIndex := iNdEx
+				var wire uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowRequestResponse
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					wire |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				fieldNum := int32(wire >> 3)
+				if fieldNum == 1 {
+					var stringLenmapkey uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowRequestResponse
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapkey |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapkey := int(stringLenmapkey)
+					if intStringLenmapkey < 0 {
+						return ErrInvalidLengthRequestResponse
+					}
+					postStringIndexmapkey := iNdEx + intStringLenmapkey
+					if postStringIndexmapkey < 0 {
+						return ErrInvalidLengthRequestResponse
+					}
+					if postStringIndexmapkey > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapkey = string(dAtA[iNdEx:postStringIndexmapkey])
+					iNdEx = postStringIndexmapkey
+				} else if fieldNum == 2 {
+					var stringLenmapvalue uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowRequestResponse
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapvalue |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapvalue := int(stringLenmapvalue)
+					if intStringLenmapvalue < 0 {
+						return ErrInvalidLengthRequestResponse
+					}
+					postStringIndexmapvalue := iNdEx + intStringLenmapvalue
+					if postStringIndexmapvalue < 0 {
+						return ErrInvalidLengthRequestResponse
+					}
+					if postStringIndexmapvalue > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapvalue = string(dAtA[iNdEx:postStringIndexmapvalue])
+					iNdEx = postStringIndexmapvalue
+				} else {
+					iNdEx = entryPreIndex
+					skippy, err := skipRequestResponse(dAtA[iNdEx:])
+					if err != nil {
+						return err
+					}
+					if skippy < 0 {
+						return ErrInvalidLengthRequestResponse
+					}
+					if (iNdEx + skippy) > postIndex {
+						return io.ErrUnexpectedEOF
+					}
+					iNdEx += skippy
+				}
+			}
+			m.SupportedClients[mapkey] = mapvalue
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ServerVersion", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRequestResponse
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthRequestResponse
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthRequestResponse
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ServerVersion = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MembershipInfo", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRequestResponse
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthRequestResponse
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthRequestResponse
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.MembershipInfo == nil {
+				m.MembershipInfo = &v16.MembershipInfo{}
+			}
+			if err := m.MembershipInfo.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipRequestResponse(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthRequestResponse
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthRequestResponse
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *GetDLQMessagesRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowRequestResponse
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: GetDLQMessagesRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: GetDLQMessagesRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Type", wireType)
+			}
+			m.Type = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRequestResponse
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Type |= v12.DeadLetterQueueType(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ShardId", wireType)
+			}
+			m.ShardId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRequestResponse
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ShardId |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SourceCluster", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRequestResponse
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthRequestResponse
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthRequestResponse
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SourceCluster = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field InclusiveEndMessageId", wireType)
+			}
+			m.InclusiveEndMessageId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRequestResponse
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.InclusiveEndMessageId |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaximumPageSize", wireType)
+			}
+			m.MaximumPageSize = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRequestResponse
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaximumPageSize |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NextPageToken", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRequestResponse
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthRequestResponse
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthRequestResponse
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.NextPageToken = append(m.NextPageToken[:0], dAtA[iNdEx:postIndex]...)
+			if m.NextPageToken == nil {
+				m.NextPageToken = []byte{}
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipRequestResponse(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthRequestResponse
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthRequestResponse
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *GetDLQMessagesResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowRequestResponse
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: GetDLQMessagesResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: GetDLQMessagesResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Type", wireType)
+			}
+			m.Type = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRequestResponse
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Type |= v12.DeadLetterQueueType(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ReplicationTasks", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRequestResponse
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthRequestResponse
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthRequestResponse
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ReplicationTasks = append(m.ReplicationTasks, &v14.ReplicationTask{})
+			if err := m.ReplicationTasks[len(m.ReplicationTasks)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NextPageToken", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRequestResponse
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthRequestResponse
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthRequestResponse
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.NextPageToken = append(m.NextPageToken[:0], dAtA[iNdEx:postIndex]...)
+			if m.NextPageToken == nil {
+				m.NextPageToken = []byte{}
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipRequestResponse(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthRequestResponse
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthRequestResponse
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *PurgeDLQMessagesRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowRequestResponse
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: PurgeDLQMessagesRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: PurgeDLQMessagesRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Type", wireType)
+			}
+			m.Type = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRequestResponse
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Type |= v12.DeadLetterQueueType(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ShardId", wireType)
+			}
+			m.ShardId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRequestResponse
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ShardId |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SourceCluster", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRequestResponse
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthRequestResponse
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthRequestResponse
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SourceCluster = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field InclusiveEndMessageId", wireType)
+			}
+			m.InclusiveEndMessageId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRequestResponse
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.InclusiveEndMessageId |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipRequestResponse(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthRequestResponse
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthRequestResponse
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *PurgeDLQMessagesResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowRequestResponse
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: PurgeDLQMessagesResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: PurgeDLQMessagesResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipRequestResponse(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthRequestResponse
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthRequestResponse
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MergeDLQMessagesRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowRequestResponse
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MergeDLQMessagesRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MergeDLQMessagesRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Type", wireType)
+			}
+			m.Type = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRequestResponse
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Type |= v12.DeadLetterQueueType(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ShardId", wireType)
+			}
+			m.ShardId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRequestResponse
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ShardId |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SourceCluster", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRequestResponse
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthRequestResponse
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthRequestResponse
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SourceCluster = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field InclusiveEndMessageId", wireType)
+			}
+			m.InclusiveEndMessageId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRequestResponse
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.InclusiveEndMessageId |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaximumPageSize", wireType)
+			}
+			m.MaximumPageSize = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRequestResponse
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaximumPageSize |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NextPageToken", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRequestResponse
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthRequestResponse
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthRequestResponse
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.NextPageToken = append(m.NextPageToken[:0], dAtA[iNdEx:postIndex]...)
+			if m.NextPageToken == nil {
+				m.NextPageToken = []byte{}
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipRequestResponse(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthRequestResponse
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthRequestResponse
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MergeDLQMessagesResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowRequestResponse
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MergeDLQMessagesResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MergeDLQMessagesResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NextPageToken", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRequestResponse
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthRequestResponse
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthRequestResponse
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.NextPageToken = append(m.NextPageToken[:0], dAtA[iNdEx:postIndex]...)
+			if m.NextPageToken == nil {
+				m.NextPageToken = []byte{}
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipRequestResponse(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthRequestResponse
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthRequestResponse
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *RefreshWorkflowTasksRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowRequestResponse
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: RefreshWorkflowTasksRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: RefreshWorkflowTasksRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Namespace", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRequestResponse
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthRequestResponse
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthRequestResponse
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Namespace = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Execution", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRequestResponse
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthRequestResponse
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthRequestResponse
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Execution == nil {
+				m.Execution = &v1.WorkflowExecution{}
+			}
+			if err := m.Execution.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipRequestResponse(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthRequestResponse
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthRequestResponse
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *RefreshWorkflowTasksResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowRequestResponse
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: RefreshWorkflowTasksResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: RefreshWorkflowTasksResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipRequestResponse(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthRequestResponse
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthRequestResponse
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ResendReplicationTasksRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowRequestResponse
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ResendReplicationTasksRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ResendReplicationTasksRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NamespaceId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRequestResponse
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthRequestResponse
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthRequestResponse
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.NamespaceId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field WorkflowId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRequestResponse
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthRequestResponse
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthRequestResponse
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.WorkflowId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RunId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRequestResponse
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthRequestResponse
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthRequestResponse
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RunId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RemoteCluster", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRequestResponse
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthRequestResponse
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthRequestResponse
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RemoteCluster = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StartEventId", wireType)
+			}
+			m.StartEventId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRequestResponse
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.StartEventId |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StartVersion", wireType)
+			}
+			m.StartVersion = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRequestResponse
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.StartVersion |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EndEventId", wireType)
+			}
+			m.EndEventId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRequestResponse
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.EndEventId |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EndVersion", wireType)
+			}
+			m.EndVersion = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRequestResponse
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.EndVersion |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipRequestResponse(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthRequestResponse
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthRequestResponse
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ResendReplicationTasksResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowRequestResponse
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ResendReplicationTasksResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ResendReplicationTasksResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipRequestResponse(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthRequestResponse
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthRequestResponse
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *CreateScheduleRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowRequestResponse
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: CreateScheduleRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: CreateScheduleRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Namespace", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRequestResponse
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthRequestResponse
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthRequestResponse
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Namespace = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ScheduleId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRequestResponse
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthRequestResponse
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthRequestResponse
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ScheduleId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Schedule", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRequestResponse
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthRequestResponse
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthRequestResponse
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Schedule == nil {
+				m.Schedule = &v17.Schedule{}
+			}
+			if err := m.Schedule.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field InitialPatch", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRequestResponse
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthRequestResponse
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthRequestResponse
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.InitialPatch == nil {
+				m.InitialPatch = &v17.SchedulePatch{}
+			}
+			if err := m.InitialPatch.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Identity", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRequestResponse
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthRequestResponse
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthRequestResponse
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Identity = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RequestId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRequestResponse
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthRequestResponse
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthRequestResponse
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RequestId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipRequestResponse(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthRequestResponse
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthRequestResponse
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *CreateScheduleResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowRequestResponse
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: CreateScheduleResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: CreateScheduleResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ConflictToken", wireType)
+			}
+			m.ConflictToken = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRequestResponse
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ConflictToken |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipRequestResponse(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthRequestResponse
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthRequestResponse
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *DescribeScheduleRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowRequestResponse
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: DescribeScheduleRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: DescribeScheduleRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Namespace", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRequestResponse
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthRequestResponse
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthRequestResponse
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Namespace = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ScheduleId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRequestResponse
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthRequestResponse
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthRequestResponse
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ScheduleId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipRequestResponse(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthRequestResponse
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthRequestResponse
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *DescribeScheduleResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowRequestResponse
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: DescribeScheduleResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: DescribeScheduleResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Schedule", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRequestResponse
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthRequestResponse
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthRequestResponse
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Schedule == nil {
+				m.Schedule = &v17.Schedule{}
+			}
+			if err := m.Schedule.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Info", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRequestResponse
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthRequestResponse
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthRequestResponse
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Info == nil {
+				m.Info = &v17.ScheduleInfo{}
+			}
+			if err := m.Info.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ConflictToken", wireType)
+			}
+			m.ConflictToken = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRequestResponse
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ConflictToken |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipRequestResponse(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthRequestResponse
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthRequestResponse
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *UpdateScheduleRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowRequestResponse
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: UpdateScheduleRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: UpdateScheduleRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Namespace", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRequestResponse
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthRequestResponse
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthRequestResponse
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Namespace = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ScheduleId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRequestResponse
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthRequestResponse
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthRequestResponse
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ScheduleId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Schedule", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRequestResponse
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthRequestResponse
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthRequestResponse
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Schedule == nil {
+				m.Schedule = &v17.Schedule{}
+			}
+			if err := m.Schedule.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ConflictToken", wireType)
+			}
+			m.ConflictToken = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRequestResponse
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ConflictToken |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Identity", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRequestResponse
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthRequestResponse
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthRequestResponse
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Identity = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RequestId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRequestResponse
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthRequestResponse
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthRequestResponse
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RequestId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipRequestResponse(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthRequestResponse
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthRequestResponse
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *UpdateScheduleResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowRequestResponse
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: UpdateScheduleResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: UpdateScheduleResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipRequestResponse(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthRequestResponse
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthRequestResponse
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *PauseScheduleRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowRequestResponse
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: PauseScheduleRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: PauseScheduleRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Namespace", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRequestResponse
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthRequestResponse
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthRequestResponse
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Namespace = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ScheduleId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRequestResponse
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthRequestResponse
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthRequestResponse
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ScheduleId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Notes", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRequestResponse
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthRequestResponse
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthRequestResponse
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Notes = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Identity", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRequestResponse
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthRequestResponse
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthRequestResponse
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Identity = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipRequestResponse(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthRequestResponse
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthRequestResponse
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *PauseScheduleResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowRequestResponse
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: PauseScheduleResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: PauseScheduleResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipRequestResponse(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthRequestResponse
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthRequestResponse
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *UnpauseScheduleRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowRequestResponse
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: UnpauseScheduleRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: UnpauseScheduleRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Namespace", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRequestResponse
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthRequestResponse
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthRequestResponse
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Namespace = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ScheduleId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRequestResponse
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthRequestResponse
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthRequestResponse
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ScheduleId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Notes", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRequestResponse
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthRequestResponse
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthRequestResponse
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Notes = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Identity", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRequestResponse
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthRequestResponse
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthRequestResponse
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Identity = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipRequestResponse(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthRequestResponse
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthRequestResponse
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *UnpauseScheduleResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowRequestResponse
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: UnpauseScheduleResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: UnpauseScheduleResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipRequestResponse(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthRequestResponse
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthRequestResponse
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *TriggerScheduleRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowRequestResponse
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: TriggerScheduleRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: TriggerScheduleRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Namespace", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRequestResponse
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthRequestResponse
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthRequestResponse
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Namespace = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ScheduleId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRequestResponse
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthRequestResponse
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthRequestResponse
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ScheduleId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OverlapPolicy", wireType)
+			}
+			m.OverlapPolicy = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRequestResponse
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.OverlapPolicy |= v12.ScheduleOverlapPolicy(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Backfill", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRequestResponse
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthRequestResponse
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthRequestResponse
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Backfill = append(m.Backfill, &v17.BackfillRequest{})
+			if err := m.Backfill[len(m.Backfill)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Identity", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRequestResponse
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthRequestResponse
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthRequestResponse
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Identity = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipRequestResponse(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthRequestResponse
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthRequestResponse
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *TriggerScheduleResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowRequestResponse
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: TriggerScheduleResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: TriggerScheduleResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipRequestResponse(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthRequestResponse
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthRequestResponse
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *DeleteScheduleRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowRequestResponse
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: DeleteScheduleRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: DeleteScheduleRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Namespace", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRequestResponse
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthRequestResponse
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthRequestResponse
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Namespace = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ScheduleId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRequestResponse
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthRequestResponse
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthRequestResponse
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ScheduleId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Identity", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRequestResponse
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthRequestResponse
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthRequestResponse
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Identity = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipRequestResponse(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthRequestResponse
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthRequestResponse
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *DeleteScheduleResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowRequestResponse
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: DeleteScheduleResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: DeleteScheduleResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipRequestResponse(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthRequestResponse
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthRequestResponse
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ListSchedulesRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowRequestResponse
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ListSchedulesRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ListSchedulesRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Namespace", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRequestResponse
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthRequestResponse
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthRequestResponse
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Namespace = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaximumPageSize", wireType)
+			}
+			m.MaximumPageSize = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRequestResponse
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaximumPageSize |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NextPageToken", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRequestResponse
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthRequestResponse
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthRequestResponse
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.NextPageToken = append(m.NextPageToken[:0], dAtA[iNdEx:postIndex]...)
+			if m.NextPageToken == nil {
+				m.NextPageToken = []byte{}
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipRequestResponse(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthRequestResponse
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthRequestResponse
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
 	}
-	s := strings.Join([]string{`&RefreshWorkflowTasksResponse{`,
-		`}`,
-	}, "")
-	return s
-}
-func (this *ResendReplicationTasksRequest) String() string {
-	if this == nil {
-		return "nil"
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
 	}
-	s := strings.Join([]string{`&ResendReplicationTasksRequest{`,
-		`NamespaceId:` + fmt.Sprintf("%v", this.NamespaceId) + `,`,
-		`WorkflowId:` + fmt.Sprintf("%v", this.WorkflowId) + `,`,
-		`RunId:` + fmt.Sprintf("%v", this.RunId) + `,`,
-		`RemoteCluster:` + fmt.Sprintf("%v", this.RemoteCluster) + `,`,
-		`StartEventId:` + fmt.Sprintf("%v", this.StartEventId) + `,`,
-		`StartVersion:` + fmt.Sprintf("%v", this.StartVersion) + `,`,
-		`EndEventId:` + fmt.Sprintf("%v", this.EndEventId) + `,`,
-		`EndVersion:` + fmt.Sprintf("%v", this.EndVersion) + `,`,
-		`}`,
-	}, "")
-	return s
+	return nil
 }
-func (this *ResendReplicationTasksResponse) String() string {
-	if this == nil {
-		return "nil"
+func (m *ListSchedulesResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowRequestResponse
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ListSchedulesResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ListSchedulesResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Schedules", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRequestResponse
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthRequestResponse
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthRequestResponse
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Schedules = append(m.Schedules, &v17.ScheduleListEntry{})
+			if err := m.Schedules[len(m.Schedules)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NextPageToken", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRequestResponse
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthRequestResponse
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthRequestResponse
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.NextPageToken = append(m.NextPageToken[:0], dAtA[iNdEx:postIndex]...)
+			if m.NextPageToken == nil {
+				m.NextPageToken = []byte{}
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipRequestResponse(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthRequestResponse
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthRequestResponse
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
 	}
-	s := strings.Join([]string{`&ResendReplicationTasksResponse{`,
-		`}`,
-	}, "")
-	return s
-}
-func valueToStringRequestResponse(v interface{}) string {
-	rv := reflect.ValueOf(v)
-	if rv.IsNil() {
-		return "nil"
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
 	}
-	pv := reflect.Indirect(rv).Interface()
-	return fmt.Sprintf("*%v", pv)
+	return nil
 }
-func (m *DescribeWorkflowExecutionRequest) Unmarshal(dAtA []byte) error {
+func (m *DeleteWorkflowExecutionRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -5863,10 +19728,10 @@ func (m *DescribeWorkflowExecutionRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: DescribeWorkflowExecutionRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: DeleteWorkflowExecutionRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: DescribeWorkflowExecutionRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: DeleteWorkflowExecutionRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -5923,20 +19788,105 @@ func (m *DescribeWorkflowExecutionRequest) Unmarshal(dAtA []byte) error {
 			if msglen < 0 {
 				return ErrInvalidLengthRequestResponse
 			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthRequestResponse
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Execution == nil {
+				m.Execution = &v1.WorkflowExecution{}
+			}
+			if err := m.Execution.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Identity", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRequestResponse
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthRequestResponse
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthRequestResponse
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Identity = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipRequestResponse(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthRequestResponse
+			}
+			if (iNdEx + skippy) < 0 {
 				return ErrInvalidLengthRequestResponse
 			}
-			if postIndex > l {
+			if (iNdEx + skippy) > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Execution == nil {
-				m.Execution = &v1.WorkflowExecution{}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *DeleteWorkflowExecutionResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowRequestResponse
 			}
-			if err := m.Execution.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
 			}
-			iNdEx = postIndex
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: DeleteWorkflowExecutionResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: DeleteWorkflowExecutionResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
 		default:
 			iNdEx = preIndex
 			skippy, err := skipRequestResponse(dAtA[iNdEx:])
@@ -5961,7 +19911,7 @@ func (m *DescribeWorkflowExecutionRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *DescribeWorkflowExecutionResponse) Unmarshal(dAtA []byte) error {
+func (m *BatchResetParams) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -5984,15 +19934,15 @@ func (m *DescribeWorkflowExecutionResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: DescribeWorkflowExecutionResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: BatchResetParams: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: DescribeWorkflowExecutionResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: BatchResetParams: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ShardId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ResetType", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -6020,11 +19970,11 @@ func (m *DescribeWorkflowExecutionResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ShardId = string(dAtA[iNdEx:postIndex])
+			m.ResetType = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field HistoryAddr", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field BadBinaryChecksum", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -6052,13 +20002,13 @@ func (m *DescribeWorkflowExecutionResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.HistoryAddr = string(dAtA[iNdEx:postIndex])
+			m.BadBinaryChecksum = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 3:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field CacheMutableState", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SkipCurrentOpen", wireType)
 			}
-			var stringLen uint64
+			var v int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowRequestResponse
@@ -6068,29 +20018,17 @@ func (m *DescribeWorkflowExecutionResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				v |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthRequestResponse
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthRequestResponse
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.CacheMutableState = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
+			m.SkipCurrentOpen = bool(v != 0)
 		case 4:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DatabaseMutableState", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SkipBaseNotCurrent", wireType)
 			}
-			var stringLen uint64
+			var v int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowRequestResponse
@@ -6100,61 +20038,17 @@ func (m *DescribeWorkflowExecutionResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				v |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthRequestResponse
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthRequestResponse
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.DatabaseMutableState = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
+			m.SkipBaseNotCurrent = bool(v != 0)
 		case 5:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TreeId", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowRequestResponse
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthRequestResponse
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthRequestResponse
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.TreeId = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 6:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field BranchId", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NonDeterministicOnly", wireType)
 			}
-			var stringLen uint64
+			var v int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowRequestResponse
@@ -6164,24 +20058,12 @@ func (m *DescribeWorkflowExecutionResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				v |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthRequestResponse
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthRequestResponse
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.BranchId = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
+			m.NonDeterministicOnly = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipRequestResponse(dAtA[iNdEx:])
@@ -6206,7 +20088,7 @@ func (m *DescribeWorkflowExecutionResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *DescribeHistoryHostRequest) Unmarshal(dAtA []byte) error {
+func (m *StartBatchOperationRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -6229,15 +20111,15 @@ func (m *DescribeHistoryHostRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: DescribeHistoryHostRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: StartBatchOperationRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: DescribeHistoryHostRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: StartBatchOperationRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field HostAddress", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Namespace", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -6265,30 +20147,11 @@ func (m *DescribeHistoryHostRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.HostAddress = string(dAtA[iNdEx:postIndex])
+			m.Namespace = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ShardId", wireType)
-			}
-			m.ShardId = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowRequestResponse
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.ShardId |= int32(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Namespace", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field JobId", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -6316,102 +20179,13 @@ func (m *DescribeHistoryHostRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Namespace = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 4:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field WorkflowExecution", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowRequestResponse
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthRequestResponse
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthRequestResponse
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if m.WorkflowExecution == nil {
-				m.WorkflowExecution = &v1.WorkflowExecution{}
-			}
-			if err := m.WorkflowExecution.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.JobId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipRequestResponse(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if skippy < 0 {
-				return ErrInvalidLengthRequestResponse
-			}
-			if (iNdEx + skippy) < 0 {
-				return ErrInvalidLengthRequestResponse
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *DescribeHistoryHostResponse) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowRequestResponse
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: DescribeHistoryHostResponse: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: DescribeHistoryHostResponse: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ShardsNumber", wireType)
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Query", wireType)
 			}
-			m.ShardsNumber = 0
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowRequestResponse
@@ -6421,92 +20195,61 @@ func (m *DescribeHistoryHostResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.ShardsNumber |= int32(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 2:
-			if wireType == 0 {
-				var v int32
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return ErrIntOverflowRequestResponse
-					}
-					if iNdEx >= l {
-						return io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					v |= int32(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-				m.ShardIds = append(m.ShardIds, v)
-			} else if wireType == 2 {
-				var packedLen int
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return ErrIntOverflowRequestResponse
-					}
-					if iNdEx >= l {
-						return io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					packedLen |= int(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-				if packedLen < 0 {
-					return ErrInvalidLengthRequestResponse
-				}
-				postIndex := iNdEx + packedLen
-				if postIndex < 0 {
-					return ErrInvalidLengthRequestResponse
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthRequestResponse
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthRequestResponse
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Query = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Reason", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRequestResponse
 				}
-				if postIndex > l {
+				if iNdEx >= l {
 					return io.ErrUnexpectedEOF
 				}
-				var elementCount int
-				var count int
-				for _, integer := range dAtA[iNdEx:postIndex] {
-					if integer < 128 {
-						count++
-					}
-				}
-				elementCount = count
-				if elementCount != 0 && len(m.ShardIds) == 0 {
-					m.ShardIds = make([]int32, 0, elementCount)
-				}
-				for iNdEx < postIndex {
-					var v int32
-					for shift := uint(0); ; shift += 7 {
-						if shift >= 64 {
-							return ErrIntOverflowRequestResponse
-						}
-						if iNdEx >= l {
-							return io.ErrUnexpectedEOF
-						}
-						b := dAtA[iNdEx]
-						iNdEx++
-						v |= int32(b&0x7F) << shift
-						if b < 0x80 {
-							break
-						}
-					}
-					m.ShardIds = append(m.ShardIds, v)
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
 				}
-			} else {
-				return fmt.Errorf("proto: wrong wireType = %d for field ShardIds", wireType)
 			}
-		case 3:
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthRequestResponse
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthRequestResponse
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Reason = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field NamespaceCache", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field BatchType", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowRequestResponse
@@ -6516,31 +20259,27 @@ func (m *DescribeHistoryHostResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthRequestResponse
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthRequestResponse
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.NamespaceCache == nil {
-				m.NamespaceCache = &v11.NamespaceCacheInfo{}
-			}
-			if err := m.NamespaceCache.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.BatchType = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 4:
+		case 6:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ShardControllerStatus", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Identity", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -6568,11 +20307,11 @@ func (m *DescribeHistoryHostResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ShardControllerStatus = string(dAtA[iNdEx:postIndex])
+			m.Identity = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 5:
+		case 7:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field SignalName", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -6600,66 +20339,85 @@ func (m *DescribeHistoryHostResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Address = string(dAtA[iNdEx:postIndex])
+			m.SignalName = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipRequestResponse(dAtA[iNdEx:])
-			if err != nil {
-				return err
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SignalInput", wireType)
 			}
-			if skippy < 0 {
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRequestResponse
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
 				return ErrInvalidLengthRequestResponse
 			}
-			if (iNdEx + skippy) < 0 {
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
 				return ErrInvalidLengthRequestResponse
 			}
-			if (iNdEx + skippy) > l {
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *CloseShardRequest) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowRequestResponse
+			if m.SignalInput == nil {
+				m.SignalInput = &v1.Payloads{}
 			}
-			if iNdEx >= l {
+			if err := m.SignalInput.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ResetParams", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRequestResponse
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthRequestResponse
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthRequestResponse
+			}
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
+			if m.ResetParams == nil {
+				m.ResetParams = &BatchResetParams{}
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: CloseShardRequest: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: CloseShardRequest: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+			if err := m.ResetParams.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 10:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ShardId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Rps", wireType)
 			}
-			m.ShardId = 0
+			m.Rps = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowRequestResponse
@@ -6669,7 +20427,7 @@ func (m *CloseShardRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.ShardId |= int32(b&0x7F) << shift
+				m.Rps |= int32(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
@@ -6698,7 +20456,7 @@ func (m *CloseShardRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *CloseShardResponse) Unmarshal(dAtA []byte) error {
+func (m *StartBatchOperationResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -6721,10 +20479,10 @@ func (m *CloseShardResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: CloseShardResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: StartBatchOperationResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: CloseShardResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: StartBatchOperationResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -6751,7 +20509,7 @@ func (m *CloseShardResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *RemoveTaskRequest) Unmarshal(dAtA []byte) error {
+func (m *StopBatchOperationRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -6774,17 +20532,17 @@ func (m *RemoveTaskRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: RemoveTaskRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: StopBatchOperationRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: RemoveTaskRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: StopBatchOperationRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ShardId", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Namespace", wireType)
 			}
-			m.ShardId = 0
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowRequestResponse
@@ -6794,16 +20552,29 @@ func (m *RemoveTaskRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.ShardId |= int32(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthRequestResponse
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthRequestResponse
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Namespace = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Category", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field JobId", wireType)
 			}
-			m.Category = 0
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowRequestResponse
@@ -6813,16 +20584,29 @@ func (m *RemoveTaskRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Category |= v12.TaskCategory(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthRequestResponse
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthRequestResponse
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.JobId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		case 3:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TaskId", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Reason", wireType)
 			}
-			m.TaskId = 0
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowRequestResponse
@@ -6832,16 +20616,29 @@ func (m *RemoveTaskRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.TaskId |= int64(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthRequestResponse
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthRequestResponse
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Reason = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field VisibilityTime", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Identity", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowRequestResponse
@@ -6851,27 +20648,23 @@ func (m *RemoveTaskRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthRequestResponse
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthRequestResponse
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.VisibilityTime == nil {
-				m.VisibilityTime = new(time.Time)
-			}
-			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(m.VisibilityTime, dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Identity = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -6897,7 +20690,7 @@ func (m *RemoveTaskRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *RemoveTaskResponse) Unmarshal(dAtA []byte) error {
+func (m *StopBatchOperationResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -6920,10 +20713,10 @@ func (m *RemoveTaskResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: RemoveTaskResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: StopBatchOperationResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: RemoveTaskResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: StopBatchOperationResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -6950,7 +20743,7 @@ func (m *RemoveTaskResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *GetWorkflowExecutionRawHistoryV2Request) Unmarshal(dAtA []byte) error {
+func (m *DescribeBatchOperationRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -6973,10 +20766,10 @@ func (m *GetWorkflowExecutionRawHistoryV2Request) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: GetWorkflowExecutionRawHistoryV2Request: wiretype end group for non-group")
+			return fmt.Errorf("proto: DescribeBatchOperationRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: GetWorkflowExecutionRawHistoryV2Request: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: DescribeBatchOperationRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -7013,140 +20806,9 @@ func (m *GetWorkflowExecutionRawHistoryV2Request) Unmarshal(dAtA []byte) error {
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Execution", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowRequestResponse
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthRequestResponse
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthRequestResponse
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if m.Execution == nil {
-				m.Execution = &v1.WorkflowExecution{}
-			}
-			if err := m.Execution.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 3:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field StartEventId", wireType)
-			}
-			m.StartEventId = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowRequestResponse
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.StartEventId |= int64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 4:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field StartEventVersion", wireType)
-			}
-			m.StartEventVersion = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowRequestResponse
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.StartEventVersion |= int64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 5:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field EndEventId", wireType)
-			}
-			m.EndEventId = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowRequestResponse
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.EndEventId |= int64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 6:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field EndEventVersion", wireType)
-			}
-			m.EndEventVersion = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowRequestResponse
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.EndEventVersion |= int64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 7:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MaximumPageSize", wireType)
-			}
-			m.MaximumPageSize = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowRequestResponse
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.MaximumPageSize |= int32(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 8:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field NextPageToken", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field JobId", wireType)
 			}
-			var byteLen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowRequestResponse
@@ -7156,25 +20818,23 @@ func (m *GetWorkflowExecutionRawHistoryV2Request) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				byteLen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if byteLen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthRequestResponse
 			}
-			postIndex := iNdEx + byteLen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthRequestResponse
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.NextPageToken = append(m.NextPageToken[:0], dAtA[iNdEx:postIndex]...)
-			if m.NextPageToken == nil {
-				m.NextPageToken = []byte{}
-			}
+			m.JobId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -7200,7 +20860,7 @@ func (m *GetWorkflowExecutionRawHistoryV2Request) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *GetWorkflowExecutionRawHistoryV2Response) Unmarshal(dAtA []byte) error {
+func (m *DescribeBatchOperationResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -7223,17 +20883,17 @@ func (m *GetWorkflowExecutionRawHistoryV2Response) Unmarshal(dAtA []byte) error
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: GetWorkflowExecutionRawHistoryV2Response: wiretype end group for non-group")
+			return fmt.Errorf("proto: DescribeBatchOperationResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: GetWorkflowExecutionRawHistoryV2Response: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: DescribeBatchOperationResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field NextPageToken", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field JobId", wireType)
 			}
-			var byteLen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowRequestResponse
@@ -7243,29 +20903,59 @@ func (m *GetWorkflowExecutionRawHistoryV2Response) Unmarshal(dAtA []byte) error
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				byteLen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if byteLen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthRequestResponse
 			}
-			postIndex := iNdEx + byteLen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthRequestResponse
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.NextPageToken = append(m.NextPageToken[:0], dAtA[iNdEx:postIndex]...)
-			if m.NextPageToken == nil {
-				m.NextPageToken = []byte{}
+			m.JobId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field State", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRequestResponse
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthRequestResponse
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthRequestResponse
 			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.State = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 2:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field HistoryBatches", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field StartTime", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -7292,14 +20982,16 @@ func (m *GetWorkflowExecutionRawHistoryV2Response) Unmarshal(dAtA []byte) error
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.HistoryBatches = append(m.HistoryBatches, &v1.DataBlob{})
-			if err := m.HistoryBatches[len(m.HistoryBatches)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if m.StartTime == nil {
+				m.StartTime = new(time.Time)
+			}
+			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(m.StartTime, dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 3:
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field VersionHistory", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field CloseTime", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -7326,71 +21018,18 @@ func (m *GetWorkflowExecutionRawHistoryV2Response) Unmarshal(dAtA []byte) error
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.VersionHistory == nil {
-				m.VersionHistory = &v13.VersionHistory{}
+			if m.CloseTime == nil {
+				m.CloseTime = new(time.Time)
 			}
-			if err := m.VersionHistory.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(m.CloseTime, dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipRequestResponse(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if skippy < 0 {
-				return ErrInvalidLengthRequestResponse
-			}
-			if (iNdEx + skippy) < 0 {
-				return ErrInvalidLengthRequestResponse
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *GetReplicationMessagesRequest) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowRequestResponse
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: GetReplicationMessagesRequest: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: GetReplicationMessagesRequest: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Tokens", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Reason", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowRequestResponse
@@ -7400,29 +21039,27 @@ func (m *GetReplicationMessagesRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthRequestResponse
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthRequestResponse
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Tokens = append(m.Tokens, &v14.ReplicationToken{})
-			if err := m.Tokens[len(m.Tokens)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Reason = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 2:
+		case 6:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ClusterName", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Identity", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -7450,66 +21087,13 @@ func (m *GetReplicationMessagesRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ClusterName = string(dAtA[iNdEx:postIndex])
+			m.Identity = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipRequestResponse(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if skippy < 0 {
-				return ErrInvalidLengthRequestResponse
-			}
-			if (iNdEx + skippy) < 0 {
-				return ErrInvalidLengthRequestResponse
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *GetReplicationMessagesResponse) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowRequestResponse
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: GetReplicationMessagesResponse: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: GetReplicationMessagesResponse: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ShardMessages", wireType)
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TotalOperationCount", wireType)
 			}
-			var msglen int
+			m.TotalOperationCount = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowRequestResponse
@@ -7519,107 +21103,49 @@ func (m *GetReplicationMessagesResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				m.TotalOperationCount |= int64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthRequestResponse
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthRequestResponse
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CompleteOperationCount", wireType)
 			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
+			m.CompleteOperationCount = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRequestResponse
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.CompleteOperationCount |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			if m.ShardMessages == nil {
-				m.ShardMessages = make(map[int32]*v14.ReplicationMessages)
+		case 9:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FailureOperationCount", wireType)
 			}
-			var mapkey int32
-			var mapvalue *v14.ReplicationMessages
-			for iNdEx < postIndex {
-				entryPreIndex := iNdEx
-				var wire uint64
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return ErrIntOverflowRequestResponse
-					}
-					if iNdEx >= l {
-						return io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					wire |= uint64(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
+			m.FailureOperationCount = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRequestResponse
 				}
-				fieldNum := int32(wire >> 3)
-				if fieldNum == 1 {
-					for shift := uint(0); ; shift += 7 {
-						if shift >= 64 {
-							return ErrIntOverflowRequestResponse
-						}
-						if iNdEx >= l {
-							return io.ErrUnexpectedEOF
-						}
-						b := dAtA[iNdEx]
-						iNdEx++
-						mapkey |= int32(b&0x7F) << shift
-						if b < 0x80 {
-							break
-						}
-					}
-				} else if fieldNum == 2 {
-					var mapmsglen int
-					for shift := uint(0); ; shift += 7 {
-						if shift >= 64 {
-							return ErrIntOverflowRequestResponse
-						}
-						if iNdEx >= l {
-							return io.ErrUnexpectedEOF
-						}
-						b := dAtA[iNdEx]
-						iNdEx++
-						mapmsglen |= int(b&0x7F) << shift
-						if b < 0x80 {
-							break
-						}
-					}
-					if mapmsglen < 0 {
-						return ErrInvalidLengthRequestResponse
-					}
-					postmsgIndex := iNdEx + mapmsglen
-					if postmsgIndex < 0 {
-						return ErrInvalidLengthRequestResponse
-					}
-					if postmsgIndex > l {
-						return io.ErrUnexpectedEOF
-					}
-					mapvalue = &v14.ReplicationMessages{}
-					if err := mapvalue.Unmarshal(dAtA[iNdEx:postmsgIndex]); err != nil {
-						return err
-					}
-					iNdEx = postmsgIndex
-				} else {
-					iNdEx = entryPreIndex
-					skippy, err := skipRequestResponse(dAtA[iNdEx:])
-					if err != nil {
-						return err
-					}
-					if skippy < 0 {
-						return ErrInvalidLengthRequestResponse
-					}
-					if (iNdEx + skippy) > postIndex {
-						return io.ErrUnexpectedEOF
-					}
-					iNdEx += skippy
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.FailureOperationCount |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
 				}
 			}
-			m.ShardMessages[mapkey] = mapvalue
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipRequestResponse(dAtA[iNdEx:])
@@ -7644,7 +21170,7 @@ func (m *GetReplicationMessagesResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *GetNamespaceReplicationMessagesRequest) Unmarshal(dAtA []byte) error {
+func (m *ListBatchOperationsRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -7667,17 +21193,17 @@ func (m *GetNamespaceReplicationMessagesRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: GetNamespaceReplicationMessagesRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: ListBatchOperationsRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: GetNamespaceReplicationMessagesRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ListBatchOperationsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field LastRetrievedMessageId", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Namespace", wireType)
 			}
-			m.LastRetrievedMessageId = 0
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowRequestResponse
@@ -7687,16 +21213,29 @@ func (m *GetNamespaceReplicationMessagesRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.LastRetrievedMessageId |= int64(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthRequestResponse
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthRequestResponse
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Namespace = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		case 2:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field LastProcessedMessageId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field PageSize", wireType)
 			}
-			m.LastProcessedMessageId = 0
+			m.PageSize = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowRequestResponse
@@ -7706,16 +21245,16 @@ func (m *GetNamespaceReplicationMessagesRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.LastProcessedMessageId |= int64(b&0x7F) << shift
+				m.PageSize |= int32(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ClusterName", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field NextPageToken", wireType)
 			}
-			var stringLen uint64
+			var byteLen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowRequestResponse
@@ -7725,23 +21264,25 @@ func (m *GetNamespaceReplicationMessagesRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				byteLen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if byteLen < 0 {
 				return ErrInvalidLengthRequestResponse
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + byteLen
 			if postIndex < 0 {
 				return ErrInvalidLengthRequestResponse
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ClusterName = string(dAtA[iNdEx:postIndex])
+			m.NextPageToken = append(m.NextPageToken[:0], dAtA[iNdEx:postIndex]...)
+			if m.NextPageToken == nil {
+				m.NextPageToken = []byte{}
+			}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -7767,7 +21308,7 @@ func (m *GetNamespaceReplicationMessagesRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *GetNamespaceReplicationMessagesResponse) Unmarshal(dAtA []byte) error {
+func (m *BatchOperationInfo) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -7790,15 +21331,79 @@ func (m *GetNamespaceReplicationMessagesResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: GetNamespaceReplicationMessagesResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: BatchOperationInfo: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: GetNamespaceReplicationMessagesResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: BatchOperationInfo: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Messages", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field JobId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRequestResponse
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthRequestResponse
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthRequestResponse
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.JobId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field State", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRequestResponse
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthRequestResponse
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthRequestResponse
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.State = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StartTime", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -7825,10 +21430,46 @@ func (m *GetNamespaceReplicationMessagesResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Messages == nil {
-				m.Messages = &v14.ReplicationMessages{}
+			if m.StartTime == nil {
+				m.StartTime = new(time.Time)
 			}
-			if err := m.Messages.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(m.StartTime, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CloseTime", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRequestResponse
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthRequestResponse
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthRequestResponse
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.CloseTime == nil {
+				m.CloseTime = new(time.Time)
+			}
+			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(m.CloseTime, dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -7856,7 +21497,7 @@ func (m *GetNamespaceReplicationMessagesResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *GetDLQReplicationMessagesRequest) Unmarshal(dAtA []byte) error {
+func (m *ListBatchOperationsResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -7879,15 +21520,15 @@ func (m *GetDLQReplicationMessagesRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: GetDLQReplicationMessagesRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: ListBatchOperationsResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: GetDLQReplicationMessagesRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ListBatchOperationsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TaskInfos", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field OperationInfo", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -7914,11 +21555,45 @@ func (m *GetDLQReplicationMessagesRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.TaskInfos = append(m.TaskInfos, &v14.ReplicationTaskInfo{})
-			if err := m.TaskInfos[len(m.TaskInfos)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.OperationInfo = append(m.OperationInfo, &BatchOperationInfo{})
+			if err := m.OperationInfo[len(m.OperationInfo)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NextPageToken", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRequestResponse
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthRequestResponse
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthRequestResponse
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.NextPageToken = append(m.NextPageToken[:0], dAtA[iNdEx:postIndex]...)
+			if m.NextPageToken == nil {
+				m.NextPageToken = []byte{}
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipRequestResponse(dAtA[iNdEx:])
@@ -7943,7 +21618,7 @@ func (m *GetDLQReplicationMessagesRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *GetDLQReplicationMessagesResponse) Unmarshal(dAtA []byte) error {
+func (m *UpdateWorkflowExecutionRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -7966,15 +21641,47 @@ func (m *GetDLQReplicationMessagesResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: GetDLQReplicationMessagesResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: UpdateWorkflowExecutionRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: GetDLQReplicationMessagesResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: UpdateWorkflowExecutionRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ReplicationTasks", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Namespace", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRequestResponse
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthRequestResponse
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthRequestResponse
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Namespace = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Execution", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -8001,67 +21708,48 @@ func (m *GetDLQReplicationMessagesResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ReplicationTasks = append(m.ReplicationTasks, &v14.ReplicationTask{})
-			if err := m.ReplicationTasks[len(m.ReplicationTasks)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if m.Execution == nil {
+				m.Execution = &v1.WorkflowExecution{}
+			}
+			if err := m.Execution.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipRequestResponse(dAtA[iNdEx:])
-			if err != nil {
-				return err
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UpdateId", wireType)
 			}
-			if skippy < 0 {
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRequestResponse
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthRequestResponse
 			}
-			if (iNdEx + skippy) < 0 {
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
 				return ErrInvalidLengthRequestResponse
 			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *ReapplyEventsRequest) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowRequestResponse
-			}
-			if iNdEx >= l {
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: ReapplyEventsRequest: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ReapplyEventsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+			m.UpdateId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Namespace", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -8089,11 +21777,11 @@ func (m *ReapplyEventsRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Namespace = string(dAtA[iNdEx:postIndex])
+			m.Name = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 2:
+		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field WorkflowExecution", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Input", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -8120,18 +21808,18 @@ func (m *ReapplyEventsRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.WorkflowExecution == nil {
-				m.WorkflowExecution = &v1.WorkflowExecution{}
+			if m.Input == nil {
+				m.Input = &v1.Payloads{}
 			}
-			if err := m.WorkflowExecution.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Input.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 3:
+		case 6:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Events", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Identity", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowRequestResponse
@@ -8141,28 +21829,44 @@ func (m *ReapplyEventsRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthRequestResponse
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthRequestResponse
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Events == nil {
-				m.Events = &v1.DataBlob{}
+			m.Identity = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field WaitForAccepted", wireType)
 			}
-			if err := m.Events.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRequestResponse
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			iNdEx = postIndex
+			m.WaitForAccepted = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipRequestResponse(dAtA[iNdEx:])
@@ -8187,7 +21891,7 @@ func (m *ReapplyEventsRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ReapplyEventsResponse) Unmarshal(dAtA []byte) error {
+func (m *UpdateWorkflowExecutionResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -8210,68 +21914,67 @@ func (m *ReapplyEventsResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ReapplyEventsResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: UpdateWorkflowExecutionResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ReapplyEventsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: UpdateWorkflowExecutionResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
-		default:
-			iNdEx = preIndex
-			skippy, err := skipRequestResponse(dAtA[iNdEx:])
-			if err != nil {
-				return err
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UpdateId", wireType)
 			}
-			if skippy < 0 {
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRequestResponse
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthRequestResponse
 			}
-			if (iNdEx + skippy) < 0 {
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
 				return ErrInvalidLengthRequestResponse
 			}
-			if (iNdEx + skippy) > l {
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *AddSearchAttributeRequest) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowRequestResponse
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
+			m.UpdateId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Completed", wireType)
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRequestResponse
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: AddSearchAttributeRequest: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: AddSearchAttributeRequest: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+			m.Completed = bool(v != 0)
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SearchAttribute", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Result", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -8298,95 +22001,18 @@ func (m *AddSearchAttributeRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.SearchAttribute == nil {
-				m.SearchAttribute = make(map[string]v15.IndexedValueType)
+			if m.Result == nil {
+				m.Result = &v1.Payloads{}
 			}
-			var mapkey string
-			var mapvalue v15.IndexedValueType
-			for iNdEx < postIndex {
-				entryPreIndex := iNdEx
-				var wire uint64
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return ErrIntOverflowRequestResponse
-					}
-					if iNdEx >= l {
-						return io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					wire |= uint64(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-				fieldNum := int32(wire >> 3)
-				if fieldNum == 1 {
-					var stringLenmapkey uint64
-					for shift := uint(0); ; shift += 7 {
-						if shift >= 64 {
-							return ErrIntOverflowRequestResponse
-						}
-						if iNdEx >= l {
-							return io.ErrUnexpectedEOF
-						}
-						b := dAtA[iNdEx]
-						iNdEx++
-						stringLenmapkey |= uint64(b&0x7F) << shift
-						if b < 0x80 {
-							break
-						}
-					}
-					intStringLenmapkey := int(stringLenmapkey)
-					if intStringLenmapkey < 0 {
-						return ErrInvalidLengthRequestResponse
-					}
-					postStringIndexmapkey := iNdEx + intStringLenmapkey
-					if postStringIndexmapkey < 0 {
-						return ErrInvalidLengthRequestResponse
-					}
-					if postStringIndexmapkey > l {
-						return io.ErrUnexpectedEOF
-					}
-					mapkey = string(dAtA[iNdEx:postStringIndexmapkey])
-					iNdEx = postStringIndexmapkey
-				} else if fieldNum == 2 {
-					for shift := uint(0); ; shift += 7 {
-						if shift >= 64 {
-							return ErrIntOverflowRequestResponse
-						}
-						if iNdEx >= l {
-							return io.ErrUnexpectedEOF
-						}
-						b := dAtA[iNdEx]
-						iNdEx++
-						mapvalue |= v15.IndexedValueType(b&0x7F) << shift
-						if b < 0x80 {
-							break
-						}
-					}
-				} else {
-					iNdEx = entryPreIndex
-					skippy, err := skipRequestResponse(dAtA[iNdEx:])
-					if err != nil {
-						return err
-					}
-					if skippy < 0 {
-						return ErrInvalidLengthRequestResponse
-					}
-					if (iNdEx + skippy) > postIndex {
-						return io.ErrUnexpectedEOF
-					}
-					iNdEx += skippy
-				}
+			if err := m.Result.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-			m.SearchAttribute[mapkey] = mapvalue
 			iNdEx = postIndex
-		case 2:
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SecurityToken", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Failure", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowRequestResponse
@@ -8396,23 +22022,27 @@ func (m *AddSearchAttributeRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthRequestResponse
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthRequestResponse
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.SecurityToken = string(dAtA[iNdEx:postIndex])
+			if m.Failure == nil {
+				m.Failure = &v18.Failure{}
+			}
+			if err := m.Failure.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -8438,7 +22068,7 @@ func (m *AddSearchAttributeRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *AddSearchAttributeResponse) Unmarshal(dAtA []byte) error {
+func (m *GetWorkflowExecutionResultRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -8461,65 +22091,100 @@ func (m *AddSearchAttributeResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: AddSearchAttributeResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: GetWorkflowExecutionResultRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: AddSearchAttributeResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: GetWorkflowExecutionResultRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
-		default:
-			iNdEx = preIndex
-			skippy, err := skipRequestResponse(dAtA[iNdEx:])
-			if err != nil {
-				return err
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Namespace", wireType)
 			}
-			if skippy < 0 {
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRequestResponse
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthRequestResponse
 			}
-			if (iNdEx + skippy) < 0 {
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
 				return ErrInvalidLengthRequestResponse
 			}
-			if (iNdEx + skippy) > l {
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *DescribeClusterRequest) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowRequestResponse
+			m.Namespace = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Execution", wireType)
 			}
-			if iNdEx >= l {
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRequestResponse
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthRequestResponse
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthRequestResponse
+			}
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
+			if m.Execution == nil {
+				m.Execution = &v1.WorkflowExecution{}
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: DescribeClusterRequest: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: DescribeClusterRequest: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
+			if err := m.Execution.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FollowRuns", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRequestResponse
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.FollowRuns = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipRequestResponse(dAtA[iNdEx:])
@@ -8544,7 +22209,7 @@ func (m *DescribeClusterRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *DescribeClusterResponse) Unmarshal(dAtA []byte) error {
+func (m *GetWorkflowExecutionResultResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -8567,144 +22232,72 @@ func (m *DescribeClusterResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: DescribeClusterResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: GetWorkflowExecutionResultResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: DescribeClusterResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: GetWorkflowExecutionResultResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SupportedClients", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Execution", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowRequestResponse
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthRequestResponse
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthRequestResponse
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if m.SupportedClients == nil {
-				m.SupportedClients = make(map[string]string)
-			}
-			var mapkey string
-			var mapvalue string
-			for iNdEx < postIndex {
-				entryPreIndex := iNdEx
-				var wire uint64
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return ErrIntOverflowRequestResponse
-					}
-					if iNdEx >= l {
-						return io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					wire |= uint64(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-				fieldNum := int32(wire >> 3)
-				if fieldNum == 1 {
-					var stringLenmapkey uint64
-					for shift := uint(0); ; shift += 7 {
-						if shift >= 64 {
-							return ErrIntOverflowRequestResponse
-						}
-						if iNdEx >= l {
-							return io.ErrUnexpectedEOF
-						}
-						b := dAtA[iNdEx]
-						iNdEx++
-						stringLenmapkey |= uint64(b&0x7F) << shift
-						if b < 0x80 {
-							break
-						}
-					}
-					intStringLenmapkey := int(stringLenmapkey)
-					if intStringLenmapkey < 0 {
-						return ErrInvalidLengthRequestResponse
-					}
-					postStringIndexmapkey := iNdEx + intStringLenmapkey
-					if postStringIndexmapkey < 0 {
-						return ErrInvalidLengthRequestResponse
-					}
-					if postStringIndexmapkey > l {
-						return io.ErrUnexpectedEOF
-					}
-					mapkey = string(dAtA[iNdEx:postStringIndexmapkey])
-					iNdEx = postStringIndexmapkey
-				} else if fieldNum == 2 {
-					var stringLenmapvalue uint64
-					for shift := uint(0); ; shift += 7 {
-						if shift >= 64 {
-							return ErrIntOverflowRequestResponse
-						}
-						if iNdEx >= l {
-							return io.ErrUnexpectedEOF
-						}
-						b := dAtA[iNdEx]
-						iNdEx++
-						stringLenmapvalue |= uint64(b&0x7F) << shift
-						if b < 0x80 {
-							break
-						}
-					}
-					intStringLenmapvalue := int(stringLenmapvalue)
-					if intStringLenmapvalue < 0 {
-						return ErrInvalidLengthRequestResponse
-					}
-					postStringIndexmapvalue := iNdEx + intStringLenmapvalue
-					if postStringIndexmapvalue < 0 {
-						return ErrInvalidLengthRequestResponse
-					}
-					if postStringIndexmapvalue > l {
-						return io.ErrUnexpectedEOF
-					}
-					mapvalue = string(dAtA[iNdEx:postStringIndexmapvalue])
-					iNdEx = postStringIndexmapvalue
-				} else {
-					iNdEx = entryPreIndex
-					skippy, err := skipRequestResponse(dAtA[iNdEx:])
-					if err != nil {
-						return err
-					}
-					if skippy < 0 {
-						return ErrInvalidLengthRequestResponse
-					}
-					if (iNdEx + skippy) > postIndex {
-						return io.ErrUnexpectedEOF
-					}
-					iNdEx += skippy
+				if shift >= 64 {
+					return ErrIntOverflowRequestResponse
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
 				}
 			}
-			m.SupportedClients[mapkey] = mapvalue
+			if msglen < 0 {
+				return ErrInvalidLengthRequestResponse
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthRequestResponse
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Execution == nil {
+				m.Execution = &v1.WorkflowExecution{}
+			}
+			if err := m.Execution.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
+			}
+			m.Status = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRequestResponse
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Status |= v15.WorkflowExecutionStatus(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ServerVersion", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Result", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowRequestResponse
@@ -8714,27 +22307,31 @@ func (m *DescribeClusterResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthRequestResponse
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthRequestResponse
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ServerVersion = string(dAtA[iNdEx:postIndex])
+			if m.Result == nil {
+				m.Result = &v1.Payloads{}
+			}
+			if err := m.Result.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 3:
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MembershipInfo", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Failure", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -8761,13 +22358,45 @@ func (m *DescribeClusterResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.MembershipInfo == nil {
-				m.MembershipInfo = &v16.MembershipInfo{}
+			if m.Failure == nil {
+				m.Failure = &v18.Failure{}
 			}
-			if err := m.MembershipInfo.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Failure.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NewExecutionRunId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRequestResponse
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthRequestResponse
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthRequestResponse
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.NewExecutionRunId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipRequestResponse(dAtA[iNdEx:])
@@ -8792,7 +22421,7 @@ func (m *DescribeClusterResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *GetDLQMessagesRequest) Unmarshal(dAtA []byte) error {
+func (m *ResetWorkflowExecutionWithOptionsRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -8815,17 +22444,17 @@ func (m *GetDLQMessagesRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: GetDLQMessagesRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: ResetWorkflowExecutionWithOptionsRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: GetDLQMessagesRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ResetWorkflowExecutionWithOptionsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Type", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Namespace", wireType)
 			}
-			m.Type = 0
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowRequestResponse
@@ -8835,16 +22464,29 @@ func (m *GetDLQMessagesRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Type |= v12.DeadLetterQueueType(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthRequestResponse
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthRequestResponse
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Namespace = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ShardId", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Execution", wireType)
 			}
-			m.ShardId = 0
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowRequestResponse
@@ -8854,14 +22496,31 @@ func (m *GetDLQMessagesRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.ShardId |= int32(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			if msglen < 0 {
+				return ErrInvalidLengthRequestResponse
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthRequestResponse
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Execution == nil {
+				m.Execution = &v1.WorkflowExecution{}
+			}
+			if err := m.Execution.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SourceCluster", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Reason", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -8889,13 +22548,13 @@ func (m *GetDLQMessagesRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.SourceCluster = string(dAtA[iNdEx:postIndex])
+			m.Reason = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 4:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field InclusiveEndMessageId", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RequestId", wireType)
 			}
-			m.InclusiveEndMessageId = 0
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowRequestResponse
@@ -8905,16 +22564,29 @@ func (m *GetDLQMessagesRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.InclusiveEndMessageId |= int64(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthRequestResponse
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthRequestResponse
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RequestId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		case 5:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MaximumPageSize", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field WorkflowTaskFinishEventId", wireType)
 			}
-			m.MaximumPageSize = 0
+			m.WorkflowTaskFinishEventId = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowRequestResponse
@@ -8924,103 +22596,16 @@ func (m *GetDLQMessagesRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.MaximumPageSize |= int32(b&0x7F) << shift
+				m.WorkflowTaskFinishEventId |= int64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
 		case 6:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field NextPageToken", wireType)
-			}
-			var byteLen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowRequestResponse
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				byteLen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if byteLen < 0 {
-				return ErrInvalidLengthRequestResponse
-			}
-			postIndex := iNdEx + byteLen
-			if postIndex < 0 {
-				return ErrInvalidLengthRequestResponse
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.NextPageToken = append(m.NextPageToken[:0], dAtA[iNdEx:postIndex]...)
-			if m.NextPageToken == nil {
-				m.NextPageToken = []byte{}
-			}
-			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipRequestResponse(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if skippy < 0 {
-				return ErrInvalidLengthRequestResponse
-			}
-			if (iNdEx + skippy) < 0 {
-				return ErrInvalidLengthRequestResponse
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *GetDLQMessagesResponse) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowRequestResponse
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: GetDLQMessagesResponse: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: GetDLQMessagesResponse: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Type", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field WorkflowTaskScheduledEventId", wireType)
 			}
-			m.Type = 0
+			m.WorkflowTaskScheduledEventId = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowRequestResponse
@@ -9030,16 +22615,16 @@ func (m *GetDLQMessagesResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Type |= v12.DeadLetterQueueType(b&0x7F) << shift
+				m.WorkflowTaskScheduledEventId |= int64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 2:
+		case 7:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ReplicationTasks", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ReapplyType", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowRequestResponse
@@ -9049,31 +22634,29 @@ func (m *GetDLQMessagesResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthRequestResponse
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthRequestResponse
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ReplicationTasks = append(m.ReplicationTasks, &v14.ReplicationTask{})
-			if err := m.ReplicationTasks[len(m.ReplicationTasks)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.ReapplyType = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 3:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field NextPageToken", wireType)
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DryRun", wireType)
 			}
-			var byteLen int
+			var v int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowRequestResponse
@@ -9083,26 +22666,12 @@ func (m *GetDLQMessagesResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				byteLen |= int(b&0x7F) << shift
+				v |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if byteLen < 0 {
-				return ErrInvalidLengthRequestResponse
-			}
-			postIndex := iNdEx + byteLen
-			if postIndex < 0 {
-				return ErrInvalidLengthRequestResponse
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.NextPageToken = append(m.NextPageToken[:0], dAtA[iNdEx:postIndex]...)
-			if m.NextPageToken == nil {
-				m.NextPageToken = []byte{}
-			}
-			iNdEx = postIndex
+			m.DryRun = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipRequestResponse(dAtA[iNdEx:])
@@ -9127,7 +22696,7 @@ func (m *GetDLQMessagesResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *PurgeDLQMessagesRequest) Unmarshal(dAtA []byte) error {
+func (m *ResetWorkflowExecutionWithOptionsResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -9150,53 +22719,15 @@ func (m *PurgeDLQMessagesRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: PurgeDLQMessagesRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: ResetWorkflowExecutionWithOptionsResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: PurgeDLQMessagesRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ResetWorkflowExecutionWithOptionsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Type", wireType)
-			}
-			m.Type = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowRequestResponse
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.Type |= v12.DeadLetterQueueType(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ShardId", wireType)
-			}
-			m.ShardId = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowRequestResponse
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.ShardId |= int32(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SourceCluster", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field RunId", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -9224,13 +22755,13 @@ func (m *PurgeDLQMessagesRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.SourceCluster = string(dAtA[iNdEx:postIndex])
+			m.RunId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 4:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field InclusiveEndMessageId", wireType)
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Preview", wireType)
 			}
-			m.InclusiveEndMessageId = 0
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowRequestResponse
@@ -9240,64 +22771,28 @@ func (m *PurgeDLQMessagesRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.InclusiveEndMessageId |= int64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		default:
-			iNdEx = preIndex
-			skippy, err := skipRequestResponse(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if skippy < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthRequestResponse
 			}
-			if (iNdEx + skippy) < 0 {
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
 				return ErrInvalidLengthRequestResponse
 			}
-			if (iNdEx + skippy) > l {
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *PurgeDLQMessagesResponse) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowRequestResponse
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
+			if m.Preview == nil {
+				m.Preview = &ResetWorkflowExecutionPreview{}
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
+			if err := m.Preview.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: PurgeDLQMessagesResponse: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: PurgeDLQMessagesResponse: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipRequestResponse(dAtA[iNdEx:])
@@ -9322,7 +22817,7 @@ func (m *PurgeDLQMessagesResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MergeDLQMessagesRequest) Unmarshal(dAtA []byte) error {
+func (m *ResetWorkflowExecutionPreview) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -9345,17 +22840,17 @@ func (m *MergeDLQMessagesRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MergeDLQMessagesRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: ResetWorkflowExecutionPreview: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MergeDLQMessagesRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ResetWorkflowExecutionPreview: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Type", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BaseRunId", wireType)
 			}
-			m.Type = 0
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowRequestResponse
@@ -9365,16 +22860,29 @@ func (m *MergeDLQMessagesRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Type |= v12.DeadLetterQueueType(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthRequestResponse
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthRequestResponse
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.BaseRunId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		case 2:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ShardId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field WorkflowTaskFinishEventId", wireType)
 			}
-			m.ShardId = 0
+			m.WorkflowTaskFinishEventId = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowRequestResponse
@@ -9384,14 +22892,14 @@ func (m *MergeDLQMessagesRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.ShardId |= int32(b&0x7F) << shift
+				m.WorkflowTaskFinishEventId |= int64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SourceCluster", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field TerminatedRunId", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -9419,13 +22927,13 @@ func (m *MergeDLQMessagesRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.SourceCluster = string(dAtA[iNdEx:postIndex])
+			m.TerminatedRunId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 4:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field InclusiveEndMessageId", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DroppedEvents", wireType)
 			}
-			m.InclusiveEndMessageId = 0
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowRequestResponse
@@ -9435,35 +22943,31 @@ func (m *MergeDLQMessagesRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.InclusiveEndMessageId |= int64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 5:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MaximumPageSize", wireType)
+			if msglen < 0 {
+				return ErrInvalidLengthRequestResponse
 			}
-			m.MaximumPageSize = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowRequestResponse
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.MaximumPageSize |= int32(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthRequestResponse
 			}
-		case 6:
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DroppedEvents = append(m.DroppedEvents, &v19.HistoryEvent{})
+			if err := m.DroppedEvents[len(m.DroppedEvents)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field NextPageToken", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field FailedActivities", wireType)
 			}
-			var byteLen int
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowRequestResponse
@@ -9473,84 +22977,65 @@ func (m *MergeDLQMessagesRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				byteLen |= int(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if byteLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthRequestResponse
 			}
-			postIndex := iNdEx + byteLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthRequestResponse
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.NextPageToken = append(m.NextPageToken[:0], dAtA[iNdEx:postIndex]...)
-			if m.NextPageToken == nil {
-				m.NextPageToken = []byte{}
-			}
-			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipRequestResponse(dAtA[iNdEx:])
-			if err != nil {
+			m.FailedActivities = append(m.FailedActivities, &v20.PendingActivityInfo{})
+			if err := m.FailedActivities[len(m.FailedActivities)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
-			if skippy < 0 {
-				return ErrInvalidLengthRequestResponse
-			}
-			if (iNdEx + skippy) < 0 {
-				return ErrInvalidLengthRequestResponse
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PendingChildren", wireType)
 			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *MergeDLQMessagesResponse) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowRequestResponse
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRequestResponse
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			if iNdEx >= l {
+			if msglen < 0 {
+				return ErrInvalidLengthRequestResponse
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthRequestResponse
+			}
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
+			m.PendingChildren = append(m.PendingChildren, &v20.PendingChildExecutionInfo{})
+			if err := m.PendingChildren[len(m.PendingChildren)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: MergeDLQMessagesResponse: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MergeDLQMessagesResponse: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+			iNdEx = postIndex
+		case 7:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field NextPageToken", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ReappliedEvents", wireType)
 			}
-			var byteLen int
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowRequestResponse
@@ -9560,24 +23045,24 @@ func (m *MergeDLQMessagesResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				byteLen |= int(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if byteLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthRequestResponse
 			}
-			postIndex := iNdEx + byteLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthRequestResponse
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.NextPageToken = append(m.NextPageToken[:0], dAtA[iNdEx:postIndex]...)
-			if m.NextPageToken == nil {
-				m.NextPageToken = []byte{}
+			m.ReappliedEvents = append(m.ReappliedEvents, &v19.HistoryEvent{})
+			if err := m.ReappliedEvents[len(m.ReappliedEvents)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
 			iNdEx = postIndex
 		default:
@@ -9604,7 +23089,7 @@ func (m *MergeDLQMessagesResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *RefreshWorkflowTasksRequest) Unmarshal(dAtA []byte) error {
+func (m *PauseWorkflowExecutionRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -9627,10 +23112,10 @@ func (m *RefreshWorkflowTasksRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: RefreshWorkflowTasksRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: PauseWorkflowExecutionRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: RefreshWorkflowTasksRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: PauseWorkflowExecutionRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -9701,6 +23186,70 @@ func (m *RefreshWorkflowTasksRequest) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Identity", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRequestResponse
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthRequestResponse
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthRequestResponse
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Identity = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Reason", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRequestResponse
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthRequestResponse
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthRequestResponse
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Reason = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipRequestResponse(dAtA[iNdEx:])
@@ -9725,7 +23274,7 @@ func (m *RefreshWorkflowTasksRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *RefreshWorkflowTasksResponse) Unmarshal(dAtA []byte) error {
+func (m *PauseWorkflowExecutionResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -9748,10 +23297,10 @@ func (m *RefreshWorkflowTasksResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: RefreshWorkflowTasksResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: PauseWorkflowExecutionResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: RefreshWorkflowTasksResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: PauseWorkflowExecutionResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -9778,7 +23327,7 @@ func (m *RefreshWorkflowTasksResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ResendReplicationTasksRequest) Unmarshal(dAtA []byte) error {
+func (m *UnpauseWorkflowExecutionRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -9801,15 +23350,15 @@ func (m *ResendReplicationTasksRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ResendReplicationTasksRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: UnpauseWorkflowExecutionRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ResendReplicationTasksRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: UnpauseWorkflowExecutionRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field NamespaceId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Namespace", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -9837,13 +23386,13 @@ func (m *ResendReplicationTasksRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.NamespaceId = string(dAtA[iNdEx:postIndex])
+			m.Namespace = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field WorkflowId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Execution", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowRequestResponse
@@ -9853,59 +23402,31 @@ func (m *ResendReplicationTasksRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthRequestResponse
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthRequestResponse
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.WorkflowId = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 3:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field RunId", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowRequestResponse
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthRequestResponse
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthRequestResponse
+			if m.Execution == nil {
+				m.Execution = &v1.WorkflowExecution{}
 			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
+			if err := m.Execution.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-			m.RunId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 4:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field RemoteCluster", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Identity", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -9933,84 +23454,8 @@ func (m *ResendReplicationTasksRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.RemoteCluster = string(dAtA[iNdEx:postIndex])
+			m.Identity = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 5:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field StartEventId", wireType)
-			}
-			m.StartEventId = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowRequestResponse
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.StartEventId |= int64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 6:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field StartVersion", wireType)
-			}
-			m.StartVersion = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowRequestResponse
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.StartVersion |= int64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 7:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field EndEventId", wireType)
-			}
-			m.EndEventId = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowRequestResponse
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.EndEventId |= int64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 8:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field EndVersion", wireType)
-			}
-			m.EndVersion = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowRequestResponse
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.EndVersion |= int64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipRequestResponse(dAtA[iNdEx:])
@@ -10035,7 +23480,7 @@ func (m *ResendReplicationTasksRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ResendReplicationTasksResponse) Unmarshal(dAtA []byte) error {
+func (m *UnpauseWorkflowExecutionResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -10058,10 +23503,10 @@ func (m *ResendReplicationTasksResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ResendReplicationTasksResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: UnpauseWorkflowExecutionResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ResendReplicationTasksResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: UnpauseWorkflowExecutionResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
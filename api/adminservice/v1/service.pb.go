@@ -54,47 +54,67 @@ func init() {
 }
 
 var fileDescriptor_cf5ca5e0c737570d = []byte{
-	// 633 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x96, 0x3f, 0x6f, 0x13, 0x3f,
-	0x18, 0xc7, 0xcf, 0xcb, 0x6f, 0xb0, 0x7e, 0xfc, 0x91, 0x8b, 0x90, 0xe8, 0x60, 0x10, 0xec, 0x17,
-	0xb5, 0x48, 0x45, 0xb4, 0x40, 0x9b, 0xb6, 0x21, 0x95, 0x68, 0x11, 0x5c, 0x11, 0x48, 0x2c, 0xc8,
-	0xbd, 0x3c, 0x4d, 0x4e, 0xbd, 0xc4, 0x87, 0xed, 0xa4, 0x74, 0x82, 0x11, 0x09, 0x09, 0xc1, 0x84,
-	0x84, 0xc4, 0xc4, 0xc2, 0xc0, 0x6b, 0x40, 0x62, 0x63, 0xcc, 0xd8, 0x91, 0x5c, 0x96, 0x8e, 0x7d,
-	0x09, 0x28, 0x5c, 0xec, 0x5c, 0x93, 0x34, 0xf8, 0x2e, 0xdd, 0x72, 0x92, 0x3f, 0xdf, 0xe7, 0xf3,
-	0xe4, 0x7c, 0x8f, 0x8d, 0xe7, 0x14, 0xd4, 0x23, 0x2e, 0x58, 0x58, 0x90, 0x20, 0x5a, 0x20, 0x0a,
-	0x2c, 0x0a, 0x0a, 0xac, 0x52, 0x0f, 0x1a, 0xbd, 0xe7, 0xc0, 0x87, 0x42, 0x6b, 0xae, 0xd0, 0xff,
-	0xe9, 0x46, 0x82, 0x2b, 0x4e, 0x6e, 0x68, 0xc4, 0x4d, 0x10, 0x97, 0x45, 0x81, 0x9b, 0x46, 0xdc,
-	0xd6, 0xdc, 0xec, 0xa2, 0x4d, 0xae, 0x80, 0x97, 0x4d, 0x90, 0xea, 0x85, 0x00, 0x19, 0xf1, 0x86,
-	0xec, 0x17, 0x98, 0x3f, 0x9a, 0xc1, 0xff, 0x17, 0x7b, 0x4b, 0xb7, 0x93, 0xa5, 0xe4, 0x3b, 0xc2,
-	0x57, 0xd6, 0x41, 0xfa, 0x22, 0xd8, 0x81, 0x67, 0x5c, 0xec, 0xed, 0x86, 0x7c, 0xbf, 0xf4, 0x0a,
-	0xfc, 0xa6, 0x0a, 0x78, 0x83, 0x94, 0x5c, 0x0b, 0x21, 0xf7, 0x54, 0xde, 0x4b, 0x24, 0x66, 0xef,
-	0x4f, 0x1b, 0x93, 0xf4, 0x70, 0xdd, 0x21, 0x9f, 0x11, 0x9e, 0xd1, 0xeb, 0x36, 0x02, 0xa9, 0xb8,
-	0x38, 0xd8, 0xe0, 0x52, 0x91, 0xe5, 0x4c, 0x15, 0x52, 0xa4, 0x56, 0x5c, 0xc9, 0x1f, 0x60, 0xe4,
-	0x5e, 0x63, 0xbc, 0x16, 0x72, 0x09, 0xdb, 0x35, 0x26, 0x2a, 0x64, 0xc1, 0x2a, 0x71, 0x00, 0x68,
-	0x93, 0x5b, 0x99, 0xb9, 0xb4, 0x80, 0x07, 0x75, 0xde, 0x82, 0x27, 0x4c, 0xee, 0x59, 0x0a, 0x0c,
-	0x80, 0x6c, 0x02, 0x69, 0xce, 0x08, 0xfc, 0x44, 0xf8, 0x5a, 0x19, 0xd4, 0xe8, 0x1b, 0x64, 0xfb,
-	0xfd, 0xbf, 0xec, 0xe9, 0x3c, 0xd9, 0xb4, 0xca, 0xff, 0x57, 0x8c, 0xb6, 0xdd, 0x3a, 0xa3, 0x34,
-	0xd3, 0xc3, 0x57, 0x84, 0x2f, 0x97, 0x41, 0x79, 0x10, 0x85, 0x81, 0xcf, 0x7a, 0x0b, 0xb7, 0x40,
-	0x4a, 0x56, 0x05, 0x49, 0x56, 0x6d, 0x6b, 0x8d, 0x81, 0xb5, 0xef, 0xda, 0x54, 0x19, 0xc6, 0xf2,
-	0x07, 0xc2, 0x57, 0xcb, 0xa0, 0x1e, 0xb2, 0x3a, 0xc8, 0x88, 0xf9, 0x30, 0x4e, 0xf7, 0x81, 0x6d,
-	0xa9, 0x49, 0x29, 0xda, 0x7b, 0xf3, 0x6c, 0xc2, 0x4c, 0x03, 0xbd, 0xc1, 0x53, 0x06, 0xb5, 0xbe,
-	0xf9, 0x78, 0x9c, 0x7a, 0xc9, 0xb6, 0xda, 0x78, 0x3e, 0xdb, 0xe0, 0x99, 0x10, 0x63, 0x74, 0xdf,
-	0x22, 0x7c, 0xce, 0x03, 0x16, 0x45, 0xe1, 0x41, 0xa9, 0x05, 0x0d, 0x25, 0xc9, 0x6d, 0xcb, 0xcf,
-	0x24, 0xc5, 0x68, 0xad, 0xc5, 0x3c, 0xa8, 0x51, 0xf9, 0x84, 0x30, 0x29, 0x56, 0x2a, 0xdb, 0xc0,
-	0x84, 0x5f, 0x2b, 0x2a, 0x25, 0x82, 0x9d, 0xa6, 0x02, 0x72, 0xcf, 0x2a, 0x74, 0x14, 0xd4, 0x52,
-	0xcb, 0xb9, 0x79, 0x63, 0xf6, 0x1e, 0xe1, 0x0b, 0x7a, 0x44, 0xae, 0x85, 0x4d, 0xa9, 0x40, 0x90,
-	0xa5, 0x4c, 0x83, 0xb5, 0x4f, 0x69, 0xa7, 0x3b, 0xf9, 0x60, 0x23, 0xf4, 0x0e, 0xe1, 0xf3, 0xc9,
-	0xdb, 0x35, 0x3b, 0x6b, 0x31, 0xc3, 0x96, 0x18, 0xde, 0x4e, 0x4b, 0xb9, 0x58, 0x63, 0xf3, 0x11,
-	0xe1, 0x8b, 0x8f, 0x9a, 0xa2, 0x0a, 0x69, 0x1f, 0xbb, 0x16, 0x87, 0x31, 0x6d, 0x74, 0x37, 0x27,
-	0x7d, 0xc2, 0x69, 0x0b, 0x72, 0x39, 0x0d, 0x63, 0xd9, 0x9c, 0x46, 0x69, 0xe3, 0xf4, 0x05, 0xe1,
-	0x4b, 0x1e, 0xec, 0x0a, 0x90, 0x35, 0x3d, 0xb4, 0x7b, 0xe7, 0x8c, 0x24, 0x2b, 0x96, 0xdf, 0xcd,
-	0x28, 0xaa, 0xdd, 0x8a, 0x53, 0x24, 0x9c, 0x38, 0x21, 0x3c, 0x90, 0xd0, 0xa8, 0xa4, 0x66, 0x46,
-	0x62, 0xb8, 0x6a, 0x99, 0x3f, 0x0e, 0xce, 0x76, 0x42, 0x9c, 0x96, 0xa1, 0x2d, 0x57, 0xc3, 0x76,
-	0x87, 0x3a, 0x87, 0x1d, 0xea, 0x1c, 0x77, 0x28, 0x7a, 0x13, 0x53, 0xf4, 0x2d, 0xa6, 0xe8, 0x57,
-	0x4c, 0x51, 0x3b, 0xa6, 0xe8, 0x77, 0x4c, 0xd1, 0x51, 0x4c, 0x9d, 0xe3, 0x98, 0xa2, 0x0f, 0x5d,
-	0xea, 0xb4, 0xbb, 0xd4, 0x39, 0xec, 0x52, 0xe7, 0xf9, 0x42, 0x95, 0x0f, 0xca, 0x07, 0x7c, 0xc2,
-	0x15, 0x73, 0x29, 0xfd, 0xbc, 0xf3, 0xdf, 0xdf, 0xfb, 0xe5, 0xcd, 0x3f, 0x01, 0x00, 0x00, 0xff,
-	0xff, 0xe1, 0xd9, 0x39, 0x56, 0xf5, 0x0a, 0x00, 0x00,
+	// 951 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xad, 0x98, 0xbb, 0x6f, 0x13, 0x31,
+	0x1c, 0xc7, 0xeb, 0x85, 0xc1, 0xe2, 0xa5, 0x03, 0xf1, 0xe8, 0x10, 0x5e, 0x13, 0x4b, 0xa2, 0x16,
+	0x09, 0x44, 0x4b, 0x5f, 0x79, 0xb4, 0x95, 0x68, 0x4a, 0x49, 0x5a, 0x2a, 0xb1, 0xa0, 0x6b, 0xf2,
+	0x6b, 0x72, 0xea, 0x25, 0x77, 0xd8, 0x4e, 0x4a, 0x27, 0x18, 0x91, 0x90, 0x10, 0x4c, 0x48, 0x48,
+	0x4c, 0x48, 0x08, 0x24, 0x24, 0xfe, 0x03, 0x24, 0x24, 0x06, 0xc6, 0x8e, 0x1d, 0x69, 0x59, 0x18,
+	0x59, 0xd8, 0x71, 0x7a, 0xb5, 0x1b, 0xdf, 0x5d, 0x82, 0x7d, 0xe9, 0x60, 0x25, 0x97, 0xf3, 0xf7,
+	0x7b, 0x1f, 0x9f, 0x7f, 0xfe, 0xfd, 0xec, 0xe0, 0x11, 0x06, 0x0d, 0xdf, 0x23, 0xb6, 0x9b, 0xa1,
+	0x40, 0xda, 0x40, 0x32, 0xb6, 0xef, 0x64, 0xec, 0x6a, 0xc3, 0x69, 0x76, 0xae, 0x9d, 0x0a, 0x64,
+	0xda, 0x23, 0x99, 0x83, 0xaf, 0x69, 0x9f, 0x78, 0xcc, 0xb3, 0xae, 0x09, 0x49, 0x3a, 0x90, 0xa4,
+	0xb9, 0x24, 0xdd, 0x2d, 0x49, 0xb7, 0x47, 0x86, 0xc7, 0x74, 0x7c, 0x09, 0x3c, 0x6e, 0x01, 0x65,
+	0x8f, 0x08, 0x50, 0xdf, 0xe3, 0x37, 0x82, 0x07, 0x8c, 0xfe, 0xbd, 0x8e, 0x8f, 0xcf, 0x74, 0xba,
+	0x96, 0x83, 0xae, 0xd6, 0x67, 0x84, 0x2f, 0xe6, 0x81, 0x56, 0x88, 0xb3, 0x06, 0xab, 0x1e, 0xd9,
+	0x58, 0x77, 0xbd, 0xcd, 0xc2, 0x13, 0xa8, 0xb4, 0x98, 0xe3, 0x35, 0xad, 0x42, 0x5a, 0x03, 0x28,
+	0xdd, 0x53, 0x5f, 0x0a, 0x20, 0x86, 0x67, 0x07, 0xb5, 0x09, 0xc6, 0x70, 0x75, 0xc8, 0x7a, 0x8b,
+	0xf0, 0x19, 0xd1, 0x6f, 0xde, 0xa1, 0xcc, 0x23, 0x5b, 0xf3, 0x1e, 0x65, 0xd6, 0x94, 0xd1, 0x13,
+	0xba, 0x94, 0x02, 0x71, 0x3a, 0xb9, 0x81, 0x84, 0x7b, 0x8a, 0x71, 0xce, 0xf5, 0x28, 0x94, 0xeb,
+	0x36, 0xa9, 0x5a, 0x37, 0xb5, 0x1c, 0x0f, 0x05, 0x82, 0xe4, 0x96, 0xb1, 0xae, 0x1b, 0xa0, 0x04,
+	0x0d, 0xaf, 0x0d, 0xcb, 0x36, 0xdd, 0xd0, 0x04, 0x38, 0x14, 0x98, 0x01, 0x74, 0xeb, 0x24, 0xc0,
+	0x37, 0x84, 0x2f, 0xcf, 0x01, 0x8b, 0xce, 0xa0, 0xbd, 0x79, 0xf0, 0xca, 0x1e, 0x8c, 0x5a, 0x0b,
+	0x5a, 0xfe, 0xff, 0xb3, 0x11, 0xb4, 0xc5, 0x23, 0x72, 0x93, 0x63, 0x78, 0x8f, 0xf0, 0x39, 0xde,
+	0xbd, 0x04, 0xbe, 0xeb, 0x54, 0xec, 0x4e, 0xc7, 0x22, 0x50, 0x6a, 0xd7, 0x80, 0x5a, 0x59, 0xdd,
+	0x67, 0xc5, 0x88, 0x05, 0x6f, 0x6e, 0x20, 0x0f, 0x49, 0xf9, 0x15, 0xe1, 0x4b, 0xbc, 0xd3, 0xa2,
+	0xdd, 0xe0, 0xbf, 0xd9, 0x15, 0x88, 0xc3, 0xbd, 0xab, 0xfb, 0xa8, 0x7e, 0x2e, 0x82, 0x7b, 0xe1,
+	0x68, 0xcc, 0xe4, 0x00, 0x3a, 0x89, 0x87, 0xf7, 0xce, 0x2f, 0xdc, 0x8f, 0x43, 0x2f, 0xe8, 0x3e,
+	0x2d, 0x5e, 0x6f, 0x96, 0x78, 0xfa, 0xd8, 0x48, 0xdc, 0xe7, 0x08, 0x9f, 0x28, 0x81, 0xed, 0xfb,
+	0xee, 0x56, 0xa1, 0x0d, 0x4d, 0x46, 0xad, 0xdb, 0x9a, 0xcb, 0xa4, 0x4b, 0x23, 0xb0, 0xc6, 0x92,
+	0x48, 0x25, 0xca, 0x1b, 0x84, 0xad, 0x99, 0x6a, 0xb5, 0x0c, 0x36, 0xa9, 0xd4, 0x67, 0x18, 0xe3,
+	0x09, 0xa9, 0xc5, 0xc0, 0x9a, 0xd4, 0x32, 0x8d, 0x0a, 0x05, 0xd4, 0x54, 0x62, 0xbd, 0x24, 0x7b,
+	0x89, 0xf0, 0x29, 0x91, 0x22, 0x73, 0x6e, 0x8b, 0x32, 0x20, 0xd6, 0xb8, 0x51, 0x62, 0x3d, 0x50,
+	0x09, 0xa6, 0x3b, 0xc9, 0xc4, 0x12, 0xe8, 0x05, 0xc2, 0x27, 0x83, 0xd9, 0x95, 0x91, 0x35, 0x66,
+	0x10, 0x12, 0xe1, 0x70, 0x1a, 0x4f, 0xa4, 0x95, 0x34, 0xaf, 0x11, 0x3e, 0xbd, 0xd4, 0x22, 0x35,
+	0xe8, 0xe6, 0xd1, 0x1b, 0x62, 0x58, 0x26, 0x88, 0x26, 0x12, 0xaa, 0x15, 0xa6, 0x22, 0x24, 0x62,
+	0x0a, 0xcb, 0xcc, 0x98, 0xa2, 0x6a, 0xc9, 0xf4, 0x0e, 0xe1, 0xb3, 0x25, 0x58, 0xe7, 0x5b, 0x97,
+	0xba, 0x48, 0xda, 0x9d, 0x3a, 0x43, 0xad, 0x69, 0xcd, 0x75, 0x13, 0x95, 0x0a, 0xb6, 0x99, 0x01,
+	0x1c, 0x94, 0x0a, 0xc1, 0x2f, 0xa1, 0x59, 0xed, 0xca, 0x19, 0x01, 0x61, 0x56, 0xd3, 0x3f, 0x4e,
+	0x6c, 0x56, 0x21, 0x7a, 0x79, 0x28, 0xb1, 0x9f, 0x23, 0x60, 0x33, 0x28, 0x57, 0xea, 0x50, 0x6d,
+	0xb9, 0xa0, 0x19, 0xfb, 0xaa, 0xc8, 0x2c, 0xf6, 0xc3, 0x5a, 0x25, 0xce, 0xc4, 0x3a, 0x95, 0x3c,
+	0x66, 0xcb, 0x3b, 0x4c, 0x34, 0x91, 0x50, 0xad, 0xbc, 0xa1, 0x15, 0xbf, 0x6a, 0xfe, 0x86, 0x54,
+	0x91, 0xd9, 0x1b, 0x0a, 0x6b, 0x95, 0x0a, 0xb3, 0x64, 0xb7, 0xe8, 0x21, 0x8c, 0x5e, 0x85, 0x51,
+	0x34, 0x66, 0x15, 0x26, 0x24, 0x55, 0xf2, 0xf8, 0x4a, 0xd3, 0x57, 0x60, 0x34, 0x47, 0xa7, 0xaa,
+	0xcc, 0xf2, 0x78, 0x44, 0xac, 0x00, 0x2d, 0x13, 0xa7, 0x56, 0x03, 0x62, 0x08, 0x14, 0x52, 0x99,
+	0x01, 0x45, 0xc4, 0x4a, 0xe8, 0xe4, 0xc1, 0x05, 0xe3, 0xd0, 0x51, 0x45, 0x66, 0xa1, 0x13, 0xd6,
+	0x2a, 0xa1, 0xb3, 0xc0, 0xb7, 0xb2, 0xe2, 0x96, 0xee, 0xe6, 0x44, 0xd1, 0x98, 0x85, 0x4e, 0x48,
+	0x2a, 0x51, 0x3e, 0x20, 0x7c, 0x3e, 0xe0, 0x8c, 0x9e, 0x26, 0x73, 0x06, 0xa3, 0xec, 0x79, 0x96,
+	0xcc, 0x0f, 0x66, 0xa2, 0x9c, 0x24, 0xcb, 0xcc, 0x26, 0x2c, 0x6b, 0xb3, 0x4a, 0xfd, 0x9e, 0x0f,
+	0x64, 0x3f, 0x8b, 0x6a, 0x9e, 0x24, 0x63, 0x94, 0x66, 0x27, 0xc9, 0x58, 0x03, 0x65, 0x8b, 0x57,
+	0x66, 0x9e, 0x1f, 0x62, 0x9b, 0xd4, 0xb4, 0x0e, 0x0b, 0xcd, 0xb6, 0x78, 0x71, 0x7a, 0xa5, 0xf6,
+	0x89, 0x94, 0x1a, 0xa2, 0xcb, 0x1a, 0xe5, 0xe3, 0x78, 0xc2, 0xdc, 0x40, 0x1e, 0xca, 0xe4, 0x76,
+	0x22, 0x54, 0xed, 0x40, 0x35, 0x27, 0x37, 0x46, 0x69, 0x36, 0xb9, 0xb1, 0x06, 0xca, 0x12, 0x09,
+	0xaa, 0x40, 0xd2, 0x25, 0xd2, 0x43, 0x6d, 0xb6, 0x44, 0x7a, 0x9a, 0x48, 0xd0, 0x2f, 0x08, 0x0f,
+	0xc7, 0x1e, 0x9c, 0x81, 0xb6, 0x5c, 0x66, 0xcd, 0x26, 0x3f, 0x79, 0xef, 0x1b, 0x08, 0xdc, 0xb9,
+	0x81, 0x7d, 0x24, 0xf1, 0x77, 0x84, 0xaf, 0x74, 0x36, 0x46, 0xd1, 0xae, 0xab, 0x0e, 0xe3, 0xb3,
+	0x11, 0x44, 0x41, 0x51, 0x7b, 0x83, 0xd5, 0xd7, 0x47, 0xf0, 0x2f, 0x1e, 0x95, 0x9d, 0xb2, 0xc8,
+	0xf6, 0x6b, 0x73, 0x34, 0x40, 0xb2, 0xfa, 0x85, 0xbd, 0x67, 0x7c, 0xe4, 0x06, 0xf2, 0x90, 0x94,
+	0x9f, 0x10, 0xbe, 0x70, 0x50, 0xb2, 0xa3, 0x9c, 0x79, 0x93, 0x8a, 0xdf, 0x93, 0xb4, 0x30, 0xa0,
+	0x8b, 0x60, 0xcd, 0xba, 0xdb, 0xbb, 0xa9, 0xa1, 0x1d, 0xde, 0xfe, 0xec, 0xa6, 0xd0, 0xb3, 0xbd,
+	0x14, 0xfa, 0xc8, 0xdb, 0x0f, 0xde, 0xb6, 0x79, 0xfb, 0xc9, 0xdb, 0xef, 0x3d, 0x7e, 0x8f, 0x7f,
+	0xbe, 0xfa, 0x95, 0x1a, 0xda, 0xe6, 0x6d, 0x87, 0xb7, 0x87, 0x37, 0x6b, 0xde, 0x21, 0x80, 0xe3,
+	0xf5, 0xf9, 0xbf, 0x75, 0xbc, 0xfb, 0x7a, 0xed, 0xd8, 0xfe, 0x9f, 0xad, 0x37, 0xfe, 0x01, 0x38,
+	0x9a, 0x75, 0x88, 0x02, 0x16, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -142,6 +162,42 @@ type AdminServiceClient interface {
 	RefreshWorkflowTasks(ctx context.Context, in *RefreshWorkflowTasksRequest, opts ...grpc.CallOption) (*RefreshWorkflowTasksResponse, error)
 	// ResendReplicationTasks requests replication tasks from remote cluster and apply tasks to current cluster.
 	ResendReplicationTasks(ctx context.Context, in *ResendReplicationTasksRequest, opts ...grpc.CallOption) (*ResendReplicationTasksResponse, error)
+	// CreateSchedule creates a schedule and starts the scheduler workflow backing it.
+	CreateSchedule(ctx context.Context, in *CreateScheduleRequest, opts ...grpc.CallOption) (*CreateScheduleResponse, error)
+	// DescribeSchedule returns the definition and the runtime information of a schedule.
+	DescribeSchedule(ctx context.Context, in *DescribeScheduleRequest, opts ...grpc.CallOption) (*DescribeScheduleResponse, error)
+	// UpdateSchedule replaces the definition of a schedule.
+	UpdateSchedule(ctx context.Context, in *UpdateScheduleRequest, opts ...grpc.CallOption) (*UpdateScheduleResponse, error)
+	// PauseSchedule stops a schedule from taking actions until it is unpaused.
+	PauseSchedule(ctx context.Context, in *PauseScheduleRequest, opts ...grpc.CallOption) (*PauseScheduleResponse, error)
+	// UnpauseSchedule resumes a paused schedule.
+	UnpauseSchedule(ctx context.Context, in *UnpauseScheduleRequest, opts ...grpc.CallOption) (*UnpauseScheduleResponse, error)
+	// TriggerSchedule takes an action right now, or backfills the actions of past time ranges.
+	TriggerSchedule(ctx context.Context, in *TriggerScheduleRequest, opts ...grpc.CallOption) (*TriggerScheduleResponse, error)
+	// DeleteSchedule deletes a schedule. Workflows started by the schedule are not affected.
+	DeleteSchedule(ctx context.Context, in *DeleteScheduleRequest, opts ...grpc.CallOption) (*DeleteScheduleResponse, error)
+	// ListSchedules lists the schedules of a namespace.
+	ListSchedules(ctx context.Context, in *ListSchedulesRequest, opts ...grpc.CallOption) (*ListSchedulesResponse, error)
+	// DeleteWorkflowExecution terminates a running workflow execution and deletes the history, the mutable state and the visibility records of the execution.
+	DeleteWorkflowExecution(ctx context.Context, in *DeleteWorkflowExecutionRequest, opts ...grpc.CallOption) (*DeleteWorkflowExecutionResponse, error)
+	// StartBatchOperation starts a batch operation on the workflows of a namespace matching a visibility query.
+	StartBatchOperation(ctx context.Context, in *StartBatchOperationRequest, opts ...grpc.CallOption) (*StartBatchOperationResponse, error)
+	// StopBatchOperation stops a running batch operation. Workflows which were already processed are not affected.
+	StopBatchOperation(ctx context.Context, in *StopBatchOperationRequest, opts ...grpc.CallOption) (*StopBatchOperationResponse, error)
+	// DescribeBatchOperation returns the state and the progress of a batch operation.
+	DescribeBatchOperation(ctx context.Context, in *DescribeBatchOperationRequest, opts ...grpc.CallOption) (*DescribeBatchOperationResponse, error)
+	// ListBatchOperations lists the batch operations of a namespace.
+	ListBatchOperations(ctx context.Context, in *ListBatchOperationsRequest, opts ...grpc.CallOption) (*ListBatchOperationsResponse, error)
+	// UpdateWorkflowExecution sends an update to a workflow execution and waits for the workflow to accept or complete it.
+	UpdateWorkflowExecution(ctx context.Context, in *UpdateWorkflowExecutionRequest, opts ...grpc.CallOption) (*UpdateWorkflowExecutionResponse, error)
+	// GetWorkflowExecutionResult long polls for the outcome of a workflow execution.
+	GetWorkflowExecutionResult(ctx context.Context, in *GetWorkflowExecutionResultRequest, opts ...grpc.CallOption) (*GetWorkflowExecutionResultResponse, error)
+	// ResetWorkflowExecutionWithOptions resets a workflow execution with control over the reset point and the reapplied events, or previews the reset.
+	ResetWorkflowExecutionWithOptions(ctx context.Context, in *ResetWorkflowExecutionWithOptionsRequest, opts ...grpc.CallOption) (*ResetWorkflowExecutionWithOptionsResponse, error)
+	// PauseWorkflowExecution stops dispatching the workflow and activity tasks of a workflow execution until it is unpaused.
+	PauseWorkflowExecution(ctx context.Context, in *PauseWorkflowExecutionRequest, opts ...grpc.CallOption) (*PauseWorkflowExecutionResponse, error)
+	// UnpauseWorkflowExecution resumes dispatching the tasks of a paused workflow execution.
+	UnpauseWorkflowExecution(ctx context.Context, in *UnpauseWorkflowExecutionRequest, opts ...grpc.CallOption) (*UnpauseWorkflowExecutionResponse, error)
 }
 
 type adminServiceClient struct {
@@ -296,6 +352,168 @@ func (c *adminServiceClient) ResendReplicationTasks(ctx context.Context, in *Res
 	return out, nil
 }
 
+func (c *adminServiceClient) CreateSchedule(ctx context.Context, in *CreateScheduleRequest, opts ...grpc.CallOption) (*CreateScheduleResponse, error) {
+	out := new(CreateScheduleResponse)
+	err := c.cc.Invoke(ctx, "/temporal.server.api.adminservice.v1.AdminService/CreateSchedule", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *adminServiceClient) DescribeSchedule(ctx context.Context, in *DescribeScheduleRequest, opts ...grpc.CallOption) (*DescribeScheduleResponse, error) {
+	out := new(DescribeScheduleResponse)
+	err := c.cc.Invoke(ctx, "/temporal.server.api.adminservice.v1.AdminService/DescribeSchedule", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *adminServiceClient) UpdateSchedule(ctx context.Context, in *UpdateScheduleRequest, opts ...grpc.CallOption) (*UpdateScheduleResponse, error) {
+	out := new(UpdateScheduleResponse)
+	err := c.cc.Invoke(ctx, "/temporal.server.api.adminservice.v1.AdminService/UpdateSchedule", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *adminServiceClient) PauseSchedule(ctx context.Context, in *PauseScheduleRequest, opts ...grpc.CallOption) (*PauseScheduleResponse, error) {
+	out := new(PauseScheduleResponse)
+	err := c.cc.Invoke(ctx, "/temporal.server.api.adminservice.v1.AdminService/PauseSchedule", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *adminServiceClient) UnpauseSchedule(ctx context.Context, in *UnpauseScheduleRequest, opts ...grpc.CallOption) (*UnpauseScheduleResponse, error) {
+	out := new(UnpauseScheduleResponse)
+	err := c.cc.Invoke(ctx, "/temporal.server.api.adminservice.v1.AdminService/UnpauseSchedule", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *adminServiceClient) TriggerSchedule(ctx context.Context, in *TriggerScheduleRequest, opts ...grpc.CallOption) (*TriggerScheduleResponse, error) {
+	out := new(TriggerScheduleResponse)
+	err := c.cc.Invoke(ctx, "/temporal.server.api.adminservice.v1.AdminService/TriggerSchedule", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *adminServiceClient) DeleteSchedule(ctx context.Context, in *DeleteScheduleRequest, opts ...grpc.CallOption) (*DeleteScheduleResponse, error) {
+	out := new(DeleteScheduleResponse)
+	err := c.cc.Invoke(ctx, "/temporal.server.api.adminservice.v1.AdminService/DeleteSchedule", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *adminServiceClient) ListSchedules(ctx context.Context, in *ListSchedulesRequest, opts ...grpc.CallOption) (*ListSchedulesResponse, error) {
+	out := new(ListSchedulesResponse)
+	err := c.cc.Invoke(ctx, "/temporal.server.api.adminservice.v1.AdminService/ListSchedules", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *adminServiceClient) DeleteWorkflowExecution(ctx context.Context, in *DeleteWorkflowExecutionRequest, opts ...grpc.CallOption) (*DeleteWorkflowExecutionResponse, error) {
+	out := new(DeleteWorkflowExecutionResponse)
+	err := c.cc.Invoke(ctx, "/temporal.server.api.adminservice.v1.AdminService/DeleteWorkflowExecution", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *adminServiceClient) StartBatchOperation(ctx context.Context, in *StartBatchOperationRequest, opts ...grpc.CallOption) (*StartBatchOperationResponse, error) {
+	out := new(StartBatchOperationResponse)
+	err := c.cc.Invoke(ctx, "/temporal.server.api.adminservice.v1.AdminService/StartBatchOperation", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *adminServiceClient) StopBatchOperation(ctx context.Context, in *StopBatchOperationRequest, opts ...grpc.CallOption) (*StopBatchOperationResponse, error) {
+	out := new(StopBatchOperationResponse)
+	err := c.cc.Invoke(ctx, "/temporal.server.api.adminservice.v1.AdminService/StopBatchOperation", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *adminServiceClient) DescribeBatchOperation(ctx context.Context, in *DescribeBatchOperationRequest, opts ...grpc.CallOption) (*DescribeBatchOperationResponse, error) {
+	out := new(DescribeBatchOperationResponse)
+	err := c.cc.Invoke(ctx, "/temporal.server.api.adminservice.v1.AdminService/DescribeBatchOperation", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *adminServiceClient) ListBatchOperations(ctx context.Context, in *ListBatchOperationsRequest, opts ...grpc.CallOption) (*ListBatchOperationsResponse, error) {
+	out := new(ListBatchOperationsResponse)
+	err := c.cc.Invoke(ctx, "/temporal.server.api.adminservice.v1.AdminService/ListBatchOperations", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *adminServiceClient) UpdateWorkflowExecution(ctx context.Context, in *UpdateWorkflowExecutionRequest, opts ...grpc.CallOption) (*UpdateWorkflowExecutionResponse, error) {
+	out := new(UpdateWorkflowExecutionResponse)
+	err := c.cc.Invoke(ctx, "/temporal.server.api.adminservice.v1.AdminService/UpdateWorkflowExecution", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *adminServiceClient) GetWorkflowExecutionResult(ctx context.Context, in *GetWorkflowExecutionResultRequest, opts ...grpc.CallOption) (*GetWorkflowExecutionResultResponse, error) {
+	out := new(GetWorkflowExecutionResultResponse)
+	err := c.cc.Invoke(ctx, "/temporal.server.api.adminservice.v1.AdminService/GetWorkflowExecutionResult", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *adminServiceClient) ResetWorkflowExecutionWithOptions(ctx context.Context, in *ResetWorkflowExecutionWithOptionsRequest, opts ...grpc.CallOption) (*ResetWorkflowExecutionWithOptionsResponse, error) {
+	out := new(ResetWorkflowExecutionWithOptionsResponse)
+	err := c.cc.Invoke(ctx, "/temporal.server.api.adminservice.v1.AdminService/ResetWorkflowExecutionWithOptions", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *adminServiceClient) PauseWorkflowExecution(ctx context.Context, in *PauseWorkflowExecutionRequest, opts ...grpc.CallOption) (*PauseWorkflowExecutionResponse, error) {
+	out := new(PauseWorkflowExecutionResponse)
+	err := c.cc.Invoke(ctx, "/temporal.server.api.adminservice.v1.AdminService/PauseWorkflowExecution", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *adminServiceClient) UnpauseWorkflowExecution(ctx context.Context, in *UnpauseWorkflowExecutionRequest, opts ...grpc.CallOption) (*UnpauseWorkflowExecutionResponse, error) {
+	out := new(UnpauseWorkflowExecutionResponse)
+	err := c.cc.Invoke(ctx, "/temporal.server.api.adminservice.v1.AdminService/UnpauseWorkflowExecution", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // AdminServiceServer is the server API for AdminService service.
 type AdminServiceServer interface {
 	// DescribeWorkflowExecution returns information about the internal states of workflow execution.
@@ -331,6 +549,42 @@ type AdminServiceServer interface {
 	RefreshWorkflowTasks(context.Context, *RefreshWorkflowTasksRequest) (*RefreshWorkflowTasksResponse, error)
 	// ResendReplicationTasks requests replication tasks from remote cluster and apply tasks to current cluster.
 	ResendReplicationTasks(context.Context, *ResendReplicationTasksRequest) (*ResendReplicationTasksResponse, error)
+	// CreateSchedule creates a schedule and starts the scheduler workflow backing it.
+	CreateSchedule(context.Context, *CreateScheduleRequest) (*CreateScheduleResponse, error)
+	// DescribeSchedule returns the definition and the runtime information of a schedule.
+	DescribeSchedule(context.Context, *DescribeScheduleRequest) (*DescribeScheduleResponse, error)
+	// UpdateSchedule replaces the definition of a schedule.
+	UpdateSchedule(context.Context, *UpdateScheduleRequest) (*UpdateScheduleResponse, error)
+	// PauseSchedule stops a schedule from taking actions until it is unpaused.
+	PauseSchedule(context.Context, *PauseScheduleRequest) (*PauseScheduleResponse, error)
+	// UnpauseSchedule resumes a paused schedule.
+	UnpauseSchedule(context.Context, *UnpauseScheduleRequest) (*UnpauseScheduleResponse, error)
+	// TriggerSchedule takes an action right now, or backfills the actions of past time ranges.
+	TriggerSchedule(context.Context, *TriggerScheduleRequest) (*TriggerScheduleResponse, error)
+	// DeleteSchedule deletes a schedule. Workflows started by the schedule are not affected.
+	DeleteSchedule(context.Context, *DeleteScheduleRequest) (*DeleteScheduleResponse, error)
+	// ListSchedules lists the schedules of a namespace.
+	ListSchedules(context.Context, *ListSchedulesRequest) (*ListSchedulesResponse, error)
+	// DeleteWorkflowExecution terminates a running workflow execution and deletes the history, the mutable state and the visibility records of the execution.
+	DeleteWorkflowExecution(context.Context, *DeleteWorkflowExecutionRequest) (*DeleteWorkflowExecutionResponse, error)
+	// StartBatchOperation starts a batch operation on the workflows of a namespace matching a visibility query.
+	StartBatchOperation(context.Context, *StartBatchOperationRequest) (*StartBatchOperationResponse, error)
+	// StopBatchOperation stops a running batch operation. Workflows which were already processed are not affected.
+	StopBatchOperation(context.Context, *StopBatchOperationRequest) (*StopBatchOperationResponse, error)
+	// DescribeBatchOperation returns the state and the progress of a batch operation.
+	DescribeBatchOperation(context.Context, *DescribeBatchOperationRequest) (*DescribeBatchOperationResponse, error)
+	// ListBatchOperations lists the batch operations of a namespace.
+	ListBatchOperations(context.Context, *ListBatchOperationsRequest) (*ListBatchOperationsResponse, error)
+	// UpdateWorkflowExecution sends an update to a workflow execution and waits for the workflow to accept or complete it.
+	UpdateWorkflowExecution(context.Context, *UpdateWorkflowExecutionRequest) (*UpdateWorkflowExecutionResponse, error)
+	// GetWorkflowExecutionResult long polls for the outcome of a workflow execution.
+	GetWorkflowExecutionResult(context.Context, *GetWorkflowExecutionResultRequest) (*GetWorkflowExecutionResultResponse, error)
+	// ResetWorkflowExecutionWithOptions resets a workflow execution with control over the reset point and the reapplied events, or previews the reset.
+	ResetWorkflowExecutionWithOptions(context.Context, *ResetWorkflowExecutionWithOptionsRequest) (*ResetWorkflowExecutionWithOptionsResponse, error)
+	// PauseWorkflowExecution stops dispatching the workflow and activity tasks of a workflow execution until it is unpaused.
+	PauseWorkflowExecution(context.Context, *PauseWorkflowExecutionRequest) (*PauseWorkflowExecutionResponse, error)
+	// UnpauseWorkflowExecution resumes dispatching the tasks of a paused workflow execution.
+	UnpauseWorkflowExecution(context.Context, *UnpauseWorkflowExecutionRequest) (*UnpauseWorkflowExecutionResponse, error)
 }
 
 // UnimplementedAdminServiceServer can be embedded to have forward compatible implementations.
@@ -385,6 +639,60 @@ func (*UnimplementedAdminServiceServer) RefreshWorkflowTasks(ctx context.Context
 func (*UnimplementedAdminServiceServer) ResendReplicationTasks(ctx context.Context, req *ResendReplicationTasksRequest) (*ResendReplicationTasksResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method ResendReplicationTasks not implemented")
 }
+func (*UnimplementedAdminServiceServer) CreateSchedule(ctx context.Context, req *CreateScheduleRequest) (*CreateScheduleResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateSchedule not implemented")
+}
+func (*UnimplementedAdminServiceServer) DescribeSchedule(ctx context.Context, req *DescribeScheduleRequest) (*DescribeScheduleResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DescribeSchedule not implemented")
+}
+func (*UnimplementedAdminServiceServer) UpdateSchedule(ctx context.Context, req *UpdateScheduleRequest) (*UpdateScheduleResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateSchedule not implemented")
+}
+func (*UnimplementedAdminServiceServer) PauseSchedule(ctx context.Context, req *PauseScheduleRequest) (*PauseScheduleResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PauseSchedule not implemented")
+}
+func (*UnimplementedAdminServiceServer) UnpauseSchedule(ctx context.Context, req *UnpauseScheduleRequest) (*UnpauseScheduleResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UnpauseSchedule not implemented")
+}
+func (*UnimplementedAdminServiceServer) TriggerSchedule(ctx context.Context, req *TriggerScheduleRequest) (*TriggerScheduleResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method TriggerSchedule not implemented")
+}
+func (*UnimplementedAdminServiceServer) DeleteSchedule(ctx context.Context, req *DeleteScheduleRequest) (*DeleteScheduleResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteSchedule not implemented")
+}
+func (*UnimplementedAdminServiceServer) ListSchedules(ctx context.Context, req *ListSchedulesRequest) (*ListSchedulesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListSchedules not implemented")
+}
+func (*UnimplementedAdminServiceServer) DeleteWorkflowExecution(ctx context.Context, req *DeleteWorkflowExecutionRequest) (*DeleteWorkflowExecutionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteWorkflowExecution not implemented")
+}
+func (*UnimplementedAdminServiceServer) StartBatchOperation(ctx context.Context, req *StartBatchOperationRequest) (*StartBatchOperationResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method StartBatchOperation not implemented")
+}
+func (*UnimplementedAdminServiceServer) StopBatchOperation(ctx context.Context, req *StopBatchOperationRequest) (*StopBatchOperationResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method StopBatchOperation not implemented")
+}
+func (*UnimplementedAdminServiceServer) DescribeBatchOperation(ctx context.Context, req *DescribeBatchOperationRequest) (*DescribeBatchOperationResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DescribeBatchOperation not implemented")
+}
+func (*UnimplementedAdminServiceServer) ListBatchOperations(ctx context.Context, req *ListBatchOperationsRequest) (*ListBatchOperationsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListBatchOperations not implemented")
+}
+func (*UnimplementedAdminServiceServer) UpdateWorkflowExecution(ctx context.Context, req *UpdateWorkflowExecutionRequest) (*UpdateWorkflowExecutionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateWorkflowExecution not implemented")
+}
+func (*UnimplementedAdminServiceServer) GetWorkflowExecutionResult(ctx context.Context, req *GetWorkflowExecutionResultRequest) (*GetWorkflowExecutionResultResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetWorkflowExecutionResult not implemented")
+}
+func (*UnimplementedAdminServiceServer) ResetWorkflowExecutionWithOptions(ctx context.Context, req *ResetWorkflowExecutionWithOptionsRequest) (*ResetWorkflowExecutionWithOptionsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ResetWorkflowExecutionWithOptions not implemented")
+}
+func (*UnimplementedAdminServiceServer) PauseWorkflowExecution(ctx context.Context, req *PauseWorkflowExecutionRequest) (*PauseWorkflowExecutionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PauseWorkflowExecution not implemented")
+}
+func (*UnimplementedAdminServiceServer) UnpauseWorkflowExecution(ctx context.Context, req *UnpauseWorkflowExecutionRequest) (*UnpauseWorkflowExecutionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UnpauseWorkflowExecution not implemented")
+}
 
 func RegisterAdminServiceServer(s *grpc.Server, srv AdminServiceServer) {
 	s.RegisterService(&_AdminService_serviceDesc, srv)
@@ -678,6 +986,330 @@ func _AdminService_ResendReplicationTasks_Handler(srv interface{}, ctx context.C
 	return interceptor(ctx, in, info, handler)
 }
 
+func _AdminService_CreateSchedule_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateScheduleRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AdminServiceServer).CreateSchedule(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/temporal.server.api.adminservice.v1.AdminService/CreateSchedule",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AdminServiceServer).CreateSchedule(ctx, req.(*CreateScheduleRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AdminService_DescribeSchedule_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DescribeScheduleRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AdminServiceServer).DescribeSchedule(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/temporal.server.api.adminservice.v1.AdminService/DescribeSchedule",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AdminServiceServer).DescribeSchedule(ctx, req.(*DescribeScheduleRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AdminService_UpdateSchedule_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateScheduleRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AdminServiceServer).UpdateSchedule(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/temporal.server.api.adminservice.v1.AdminService/UpdateSchedule",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AdminServiceServer).UpdateSchedule(ctx, req.(*UpdateScheduleRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AdminService_PauseSchedule_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PauseScheduleRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AdminServiceServer).PauseSchedule(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/temporal.server.api.adminservice.v1.AdminService/PauseSchedule",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AdminServiceServer).PauseSchedule(ctx, req.(*PauseScheduleRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AdminService_UnpauseSchedule_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UnpauseScheduleRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AdminServiceServer).UnpauseSchedule(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/temporal.server.api.adminservice.v1.AdminService/UnpauseSchedule",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AdminServiceServer).UnpauseSchedule(ctx, req.(*UnpauseScheduleRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AdminService_TriggerSchedule_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(TriggerScheduleRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AdminServiceServer).TriggerSchedule(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/temporal.server.api.adminservice.v1.AdminService/TriggerSchedule",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AdminServiceServer).TriggerSchedule(ctx, req.(*TriggerScheduleRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AdminService_DeleteSchedule_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteScheduleRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AdminServiceServer).DeleteSchedule(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/temporal.server.api.adminservice.v1.AdminService/DeleteSchedule",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AdminServiceServer).DeleteSchedule(ctx, req.(*DeleteScheduleRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AdminService_ListSchedules_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListSchedulesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AdminServiceServer).ListSchedules(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/temporal.server.api.adminservice.v1.AdminService/ListSchedules",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AdminServiceServer).ListSchedules(ctx, req.(*ListSchedulesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AdminService_DeleteWorkflowExecution_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteWorkflowExecutionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AdminServiceServer).DeleteWorkflowExecution(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/temporal.server.api.adminservice.v1.AdminService/DeleteWorkflowExecution",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AdminServiceServer).DeleteWorkflowExecution(ctx, req.(*DeleteWorkflowExecutionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AdminService_StartBatchOperation_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(StartBatchOperationRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AdminServiceServer).StartBatchOperation(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/temporal.server.api.adminservice.v1.AdminService/StartBatchOperation",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AdminServiceServer).StartBatchOperation(ctx, req.(*StartBatchOperationRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AdminService_StopBatchOperation_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(StopBatchOperationRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AdminServiceServer).StopBatchOperation(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/temporal.server.api.adminservice.v1.AdminService/StopBatchOperation",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AdminServiceServer).StopBatchOperation(ctx, req.(*StopBatchOperationRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AdminService_DescribeBatchOperation_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DescribeBatchOperationRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AdminServiceServer).DescribeBatchOperation(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/temporal.server.api.adminservice.v1.AdminService/DescribeBatchOperation",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AdminServiceServer).DescribeBatchOperation(ctx, req.(*DescribeBatchOperationRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AdminService_ListBatchOperations_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListBatchOperationsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AdminServiceServer).ListBatchOperations(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/temporal.server.api.adminservice.v1.AdminService/ListBatchOperations",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AdminServiceServer).ListBatchOperations(ctx, req.(*ListBatchOperationsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AdminService_UpdateWorkflowExecution_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateWorkflowExecutionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AdminServiceServer).UpdateWorkflowExecution(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/temporal.server.api.adminservice.v1.AdminService/UpdateWorkflowExecution",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AdminServiceServer).UpdateWorkflowExecution(ctx, req.(*UpdateWorkflowExecutionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AdminService_GetWorkflowExecutionResult_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetWorkflowExecutionResultRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AdminServiceServer).GetWorkflowExecutionResult(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/temporal.server.api.adminservice.v1.AdminService/GetWorkflowExecutionResult",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AdminServiceServer).GetWorkflowExecutionResult(ctx, req.(*GetWorkflowExecutionResultRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AdminService_ResetWorkflowExecutionWithOptions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ResetWorkflowExecutionWithOptionsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AdminServiceServer).ResetWorkflowExecutionWithOptions(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/temporal.server.api.adminservice.v1.AdminService/ResetWorkflowExecutionWithOptions",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AdminServiceServer).ResetWorkflowExecutionWithOptions(ctx, req.(*ResetWorkflowExecutionWithOptionsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AdminService_PauseWorkflowExecution_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PauseWorkflowExecutionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AdminServiceServer).PauseWorkflowExecution(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/temporal.server.api.adminservice.v1.AdminService/PauseWorkflowExecution",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AdminServiceServer).PauseWorkflowExecution(ctx, req.(*PauseWorkflowExecutionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AdminService_UnpauseWorkflowExecution_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UnpauseWorkflowExecutionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AdminServiceServer).UnpauseWorkflowExecution(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/temporal.server.api.adminservice.v1.AdminService/UnpauseWorkflowExecution",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AdminServiceServer).UnpauseWorkflowExecution(ctx, req.(*UnpauseWorkflowExecutionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var _AdminService_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "temporal.server.api.adminservice.v1.AdminService",
 	HandlerType: (*AdminServiceServer)(nil),
@@ -746,6 +1378,78 @@ var _AdminService_serviceDesc = grpc.ServiceDesc{
 			MethodName: "ResendReplicationTasks",
 			Handler:    _AdminService_ResendReplicationTasks_Handler,
 		},
+		{
+			MethodName: "CreateSchedule",
+			Handler:    _AdminService_CreateSchedule_Handler,
+		},
+		{
+			MethodName: "DescribeSchedule",
+			Handler:    _AdminService_DescribeSchedule_Handler,
+		},
+		{
+			MethodName: "UpdateSchedule",
+			Handler:    _AdminService_UpdateSchedule_Handler,
+		},
+		{
+			MethodName: "PauseSchedule",
+			Handler:    _AdminService_PauseSchedule_Handler,
+		},
+		{
+			MethodName: "UnpauseSchedule",
+			Handler:    _AdminService_UnpauseSchedule_Handler,
+		},
+		{
+			MethodName: "TriggerSchedule",
+			Handler:    _AdminService_TriggerSchedule_Handler,
+		},
+		{
+			MethodName: "DeleteSchedule",
+			Handler:    _AdminService_DeleteSchedule_Handler,
+		},
+		{
+			MethodName: "ListSchedules",
+			Handler:    _AdminService_ListSchedules_Handler,
+		},
+		{
+			MethodName: "DeleteWorkflowExecution",
+			Handler:    _AdminService_DeleteWorkflowExecution_Handler,
+		},
+		{
+			MethodName: "StartBatchOperation",
+			Handler:    _AdminService_StartBatchOperation_Handler,
+		},
+		{
+			MethodName: "StopBatchOperation",
+			Handler:    _AdminService_StopBatchOperation_Handler,
+		},
+		{
+			MethodName: "DescribeBatchOperation",
+			Handler:    _AdminService_DescribeBatchOperation_Handler,
+		},
+		{
+			MethodName: "ListBatchOperations",
+			Handler:    _AdminService_ListBatchOperations_Handler,
+		},
+		{
+			MethodName: "UpdateWorkflowExecution",
+			Handler:    _AdminService_UpdateWorkflowExecution_Handler,
+		},
+		{
+			MethodName: "GetWorkflowExecutionResult",
+			Handler:    _AdminService_GetWorkflowExecutionResult_Handler,
+		},
+		{
+			MethodName: "ResetWorkflowExecutionWithOptions",
+			Handler:    _AdminService_ResetWorkflowExecutionWithOptions_Handler,
+		},
+		{
+			MethodName: "PauseWorkflowExecution",
+			Handler:    _AdminService_PauseWorkflowExecution_Handler,
+		},
+		{
+			MethodName: "UnpauseWorkflowExecution",
+			Handler:    _AdminService_UnpauseWorkflowExecution_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "temporal/server/api/adminservice/v1/service.proto",
@@ -380,6 +380,366 @@ func (mr *MockAdminServiceClientMockRecorder) ResendReplicationTasks(ctx, in int
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ResendReplicationTasks", reflect.TypeOf((*MockAdminServiceClient)(nil).ResendReplicationTasks), varargs...)
 }
 
+// CreateSchedule mocks base method.
+func (m *MockAdminServiceClient) CreateSchedule(ctx context.Context, in *adminservice.CreateScheduleRequest, opts ...grpc.CallOption) (*adminservice.CreateScheduleResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "CreateSchedule", varargs...)
+	ret0, _ := ret[0].(*adminservice.CreateScheduleResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateSchedule indicates an expected call of CreateSchedule.
+func (mr *MockAdminServiceClientMockRecorder) CreateSchedule(ctx, in interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateSchedule", reflect.TypeOf((*MockAdminServiceClient)(nil).CreateSchedule), varargs...)
+}
+
+// DescribeSchedule mocks base method.
+func (m *MockAdminServiceClient) DescribeSchedule(ctx context.Context, in *adminservice.DescribeScheduleRequest, opts ...grpc.CallOption) (*adminservice.DescribeScheduleResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "DescribeSchedule", varargs...)
+	ret0, _ := ret[0].(*adminservice.DescribeScheduleResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DescribeSchedule indicates an expected call of DescribeSchedule.
+func (mr *MockAdminServiceClientMockRecorder) DescribeSchedule(ctx, in interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DescribeSchedule", reflect.TypeOf((*MockAdminServiceClient)(nil).DescribeSchedule), varargs...)
+}
+
+// UpdateSchedule mocks base method.
+func (m *MockAdminServiceClient) UpdateSchedule(ctx context.Context, in *adminservice.UpdateScheduleRequest, opts ...grpc.CallOption) (*adminservice.UpdateScheduleResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "UpdateSchedule", varargs...)
+	ret0, _ := ret[0].(*adminservice.UpdateScheduleResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpdateSchedule indicates an expected call of UpdateSchedule.
+func (mr *MockAdminServiceClientMockRecorder) UpdateSchedule(ctx, in interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateSchedule", reflect.TypeOf((*MockAdminServiceClient)(nil).UpdateSchedule), varargs...)
+}
+
+// PauseSchedule mocks base method.
+func (m *MockAdminServiceClient) PauseSchedule(ctx context.Context, in *adminservice.PauseScheduleRequest, opts ...grpc.CallOption) (*adminservice.PauseScheduleResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "PauseSchedule", varargs...)
+	ret0, _ := ret[0].(*adminservice.PauseScheduleResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// PauseSchedule indicates an expected call of PauseSchedule.
+func (mr *MockAdminServiceClientMockRecorder) PauseSchedule(ctx, in interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PauseSchedule", reflect.TypeOf((*MockAdminServiceClient)(nil).PauseSchedule), varargs...)
+}
+
+// UnpauseSchedule mocks base method.
+func (m *MockAdminServiceClient) UnpauseSchedule(ctx context.Context, in *adminservice.UnpauseScheduleRequest, opts ...grpc.CallOption) (*adminservice.UnpauseScheduleResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "UnpauseSchedule", varargs...)
+	ret0, _ := ret[0].(*adminservice.UnpauseScheduleResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UnpauseSchedule indicates an expected call of UnpauseSchedule.
+func (mr *MockAdminServiceClientMockRecorder) UnpauseSchedule(ctx, in interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UnpauseSchedule", reflect.TypeOf((*MockAdminServiceClient)(nil).UnpauseSchedule), varargs...)
+}
+
+// TriggerSchedule mocks base method.
+func (m *MockAdminServiceClient) TriggerSchedule(ctx context.Context, in *adminservice.TriggerScheduleRequest, opts ...grpc.CallOption) (*adminservice.TriggerScheduleResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "TriggerSchedule", varargs...)
+	ret0, _ := ret[0].(*adminservice.TriggerScheduleResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// TriggerSchedule indicates an expected call of TriggerSchedule.
+func (mr *MockAdminServiceClientMockRecorder) TriggerSchedule(ctx, in interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "TriggerSchedule", reflect.TypeOf((*MockAdminServiceClient)(nil).TriggerSchedule), varargs...)
+}
+
+// DeleteSchedule mocks base method.
+func (m *MockAdminServiceClient) DeleteSchedule(ctx context.Context, in *adminservice.DeleteScheduleRequest, opts ...grpc.CallOption) (*adminservice.DeleteScheduleResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "DeleteSchedule", varargs...)
+	ret0, _ := ret[0].(*adminservice.DeleteScheduleResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DeleteSchedule indicates an expected call of DeleteSchedule.
+func (mr *MockAdminServiceClientMockRecorder) DeleteSchedule(ctx, in interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteSchedule", reflect.TypeOf((*MockAdminServiceClient)(nil).DeleteSchedule), varargs...)
+}
+
+// ListSchedules mocks base method.
+func (m *MockAdminServiceClient) ListSchedules(ctx context.Context, in *adminservice.ListSchedulesRequest, opts ...grpc.CallOption) (*adminservice.ListSchedulesResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "ListSchedules", varargs...)
+	ret0, _ := ret[0].(*adminservice.ListSchedulesResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListSchedules indicates an expected call of ListSchedules.
+func (mr *MockAdminServiceClientMockRecorder) ListSchedules(ctx, in interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListSchedules", reflect.TypeOf((*MockAdminServiceClient)(nil).ListSchedules), varargs...)
+}
+
+// DeleteWorkflowExecution mocks base method.
+func (m *MockAdminServiceClient) DeleteWorkflowExecution(ctx context.Context, in *adminservice.DeleteWorkflowExecutionRequest, opts ...grpc.CallOption) (*adminservice.DeleteWorkflowExecutionResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "DeleteWorkflowExecution", varargs...)
+	ret0, _ := ret[0].(*adminservice.DeleteWorkflowExecutionResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DeleteWorkflowExecution indicates an expected call of DeleteWorkflowExecution.
+func (mr *MockAdminServiceClientMockRecorder) DeleteWorkflowExecution(ctx, in interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteWorkflowExecution", reflect.TypeOf((*MockAdminServiceClient)(nil).DeleteWorkflowExecution), varargs...)
+}
+
+// StartBatchOperation mocks base method.
+func (m *MockAdminServiceClient) StartBatchOperation(ctx context.Context, in *adminservice.StartBatchOperationRequest, opts ...grpc.CallOption) (*adminservice.StartBatchOperationResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "StartBatchOperation", varargs...)
+	ret0, _ := ret[0].(*adminservice.StartBatchOperationResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// StartBatchOperation indicates an expected call of StartBatchOperation.
+func (mr *MockAdminServiceClientMockRecorder) StartBatchOperation(ctx, in interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "StartBatchOperation", reflect.TypeOf((*MockAdminServiceClient)(nil).StartBatchOperation), varargs...)
+}
+
+// StopBatchOperation mocks base method.
+func (m *MockAdminServiceClient) StopBatchOperation(ctx context.Context, in *adminservice.StopBatchOperationRequest, opts ...grpc.CallOption) (*adminservice.StopBatchOperationResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "StopBatchOperation", varargs...)
+	ret0, _ := ret[0].(*adminservice.StopBatchOperationResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// StopBatchOperation indicates an expected call of StopBatchOperation.
+func (mr *MockAdminServiceClientMockRecorder) StopBatchOperation(ctx, in interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "StopBatchOperation", reflect.TypeOf((*MockAdminServiceClient)(nil).StopBatchOperation), varargs...)
+}
+
+// DescribeBatchOperation mocks base method.
+func (m *MockAdminServiceClient) DescribeBatchOperation(ctx context.Context, in *adminservice.DescribeBatchOperationRequest, opts ...grpc.CallOption) (*adminservice.DescribeBatchOperationResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "DescribeBatchOperation", varargs...)
+	ret0, _ := ret[0].(*adminservice.DescribeBatchOperationResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DescribeBatchOperation indicates an expected call of DescribeBatchOperation.
+func (mr *MockAdminServiceClientMockRecorder) DescribeBatchOperation(ctx, in interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DescribeBatchOperation", reflect.TypeOf((*MockAdminServiceClient)(nil).DescribeBatchOperation), varargs...)
+}
+
+// ListBatchOperations mocks base method.
+func (m *MockAdminServiceClient) ListBatchOperations(ctx context.Context, in *adminservice.ListBatchOperationsRequest, opts ...grpc.CallOption) (*adminservice.ListBatchOperationsResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "ListBatchOperations", varargs...)
+	ret0, _ := ret[0].(*adminservice.ListBatchOperationsResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListBatchOperations indicates an expected call of ListBatchOperations.
+func (mr *MockAdminServiceClientMockRecorder) ListBatchOperations(ctx, in interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListBatchOperations", reflect.TypeOf((*MockAdminServiceClient)(nil).ListBatchOperations), varargs...)
+}
+
+// UpdateWorkflowExecution mocks base method.
+func (m *MockAdminServiceClient) UpdateWorkflowExecution(ctx context.Context, in *adminservice.UpdateWorkflowExecutionRequest, opts ...grpc.CallOption) (*adminservice.UpdateWorkflowExecutionResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "UpdateWorkflowExecution", varargs...)
+	ret0, _ := ret[0].(*adminservice.UpdateWorkflowExecutionResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpdateWorkflowExecution indicates an expected call of UpdateWorkflowExecution.
+func (mr *MockAdminServiceClientMockRecorder) UpdateWorkflowExecution(ctx, in interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateWorkflowExecution", reflect.TypeOf((*MockAdminServiceClient)(nil).UpdateWorkflowExecution), varargs...)
+}
+
+// GetWorkflowExecutionResult mocks base method.
+func (m *MockAdminServiceClient) GetWorkflowExecutionResult(ctx context.Context, in *adminservice.GetWorkflowExecutionResultRequest, opts ...grpc.CallOption) (*adminservice.GetWorkflowExecutionResultResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "GetWorkflowExecutionResult", varargs...)
+	ret0, _ := ret[0].(*adminservice.GetWorkflowExecutionResultResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetWorkflowExecutionResult indicates an expected call of GetWorkflowExecutionResult.
+func (mr *MockAdminServiceClientMockRecorder) GetWorkflowExecutionResult(ctx, in interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetWorkflowExecutionResult", reflect.TypeOf((*MockAdminServiceClient)(nil).GetWorkflowExecutionResult), varargs...)
+}
+
+// ResetWorkflowExecutionWithOptions mocks base method.
+func (m *MockAdminServiceClient) ResetWorkflowExecutionWithOptions(ctx context.Context, in *adminservice.ResetWorkflowExecutionWithOptionsRequest, opts ...grpc.CallOption) (*adminservice.ResetWorkflowExecutionWithOptionsResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "ResetWorkflowExecutionWithOptions", varargs...)
+	ret0, _ := ret[0].(*adminservice.ResetWorkflowExecutionWithOptionsResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ResetWorkflowExecutionWithOptions indicates an expected call of ResetWorkflowExecutionWithOptions.
+func (mr *MockAdminServiceClientMockRecorder) ResetWorkflowExecutionWithOptions(ctx, in interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ResetWorkflowExecutionWithOptions", reflect.TypeOf((*MockAdminServiceClient)(nil).ResetWorkflowExecutionWithOptions), varargs...)
+}
+
+// PauseWorkflowExecution mocks base method.
+func (m *MockAdminServiceClient) PauseWorkflowExecution(ctx context.Context, in *adminservice.PauseWorkflowExecutionRequest, opts ...grpc.CallOption) (*adminservice.PauseWorkflowExecutionResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "PauseWorkflowExecution", varargs...)
+	ret0, _ := ret[0].(*adminservice.PauseWorkflowExecutionResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// PauseWorkflowExecution indicates an expected call of PauseWorkflowExecution.
+func (mr *MockAdminServiceClientMockRecorder) PauseWorkflowExecution(ctx, in interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PauseWorkflowExecution", reflect.TypeOf((*MockAdminServiceClient)(nil).PauseWorkflowExecution), varargs...)
+}
+
+// UnpauseWorkflowExecution mocks base method.
+func (m *MockAdminServiceClient) UnpauseWorkflowExecution(ctx context.Context, in *adminservice.UnpauseWorkflowExecutionRequest, opts ...grpc.CallOption) (*adminservice.UnpauseWorkflowExecutionResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "UnpauseWorkflowExecution", varargs...)
+	ret0, _ := ret[0].(*adminservice.UnpauseWorkflowExecutionResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UnpauseWorkflowExecution indicates an expected call of UnpauseWorkflowExecution.
+func (mr *MockAdminServiceClientMockRecorder) UnpauseWorkflowExecution(ctx, in interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UnpauseWorkflowExecution", reflect.TypeOf((*MockAdminServiceClient)(nil).UnpauseWorkflowExecution), varargs...)
+}
+
 // MockAdminServiceServer is a mock of AdminServiceServer interface.
 type MockAdminServiceServer struct {
 	ctrl     *gomock.Controller
@@ -642,3 +1002,273 @@ func (mr *MockAdminServiceServerMockRecorder) ResendReplicationTasks(arg0, arg1
 	mr.mock.ctrl.T.Helper()
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ResendReplicationTasks", reflect.TypeOf((*MockAdminServiceServer)(nil).ResendReplicationTasks), arg0, arg1)
 }
+
+// CreateSchedule mocks base method.
+func (m *MockAdminServiceServer) CreateSchedule(arg0 context.Context, arg1 *adminservice.CreateScheduleRequest) (*adminservice.CreateScheduleResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateSchedule", arg0, arg1)
+	ret0, _ := ret[0].(*adminservice.CreateScheduleResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateSchedule indicates an expected call of CreateSchedule.
+func (mr *MockAdminServiceServerMockRecorder) CreateSchedule(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateSchedule", reflect.TypeOf((*MockAdminServiceServer)(nil).CreateSchedule), arg0, arg1)
+}
+
+// DescribeSchedule mocks base method.
+func (m *MockAdminServiceServer) DescribeSchedule(arg0 context.Context, arg1 *adminservice.DescribeScheduleRequest) (*adminservice.DescribeScheduleResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DescribeSchedule", arg0, arg1)
+	ret0, _ := ret[0].(*adminservice.DescribeScheduleResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DescribeSchedule indicates an expected call of DescribeSchedule.
+func (mr *MockAdminServiceServerMockRecorder) DescribeSchedule(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DescribeSchedule", reflect.TypeOf((*MockAdminServiceServer)(nil).DescribeSchedule), arg0, arg1)
+}
+
+// UpdateSchedule mocks base method.
+func (m *MockAdminServiceServer) UpdateSchedule(arg0 context.Context, arg1 *adminservice.UpdateScheduleRequest) (*adminservice.UpdateScheduleResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateSchedule", arg0, arg1)
+	ret0, _ := ret[0].(*adminservice.UpdateScheduleResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpdateSchedule indicates an expected call of UpdateSchedule.
+func (mr *MockAdminServiceServerMockRecorder) UpdateSchedule(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateSchedule", reflect.TypeOf((*MockAdminServiceServer)(nil).UpdateSchedule), arg0, arg1)
+}
+
+// PauseSchedule mocks base method.
+func (m *MockAdminServiceServer) PauseSchedule(arg0 context.Context, arg1 *adminservice.PauseScheduleRequest) (*adminservice.PauseScheduleResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "PauseSchedule", arg0, arg1)
+	ret0, _ := ret[0].(*adminservice.PauseScheduleResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// PauseSchedule indicates an expected call of PauseSchedule.
+func (mr *MockAdminServiceServerMockRecorder) PauseSchedule(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PauseSchedule", reflect.TypeOf((*MockAdminServiceServer)(nil).PauseSchedule), arg0, arg1)
+}
+
+// UnpauseSchedule mocks base method.
+func (m *MockAdminServiceServer) UnpauseSchedule(arg0 context.Context, arg1 *adminservice.UnpauseScheduleRequest) (*adminservice.UnpauseScheduleResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UnpauseSchedule", arg0, arg1)
+	ret0, _ := ret[0].(*adminservice.UnpauseScheduleResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UnpauseSchedule indicates an expected call of UnpauseSchedule.
+func (mr *MockAdminServiceServerMockRecorder) UnpauseSchedule(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UnpauseSchedule", reflect.TypeOf((*MockAdminServiceServer)(nil).UnpauseSchedule), arg0, arg1)
+}
+
+// TriggerSchedule mocks base method.
+func (m *MockAdminServiceServer) TriggerSchedule(arg0 context.Context, arg1 *adminservice.TriggerScheduleRequest) (*adminservice.TriggerScheduleResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "TriggerSchedule", arg0, arg1)
+	ret0, _ := ret[0].(*adminservice.TriggerScheduleResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// TriggerSchedule indicates an expected call of TriggerSchedule.
+func (mr *MockAdminServiceServerMockRecorder) TriggerSchedule(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "TriggerSchedule", reflect.TypeOf((*MockAdminServiceServer)(nil).TriggerSchedule), arg0, arg1)
+}
+
+// DeleteSchedule mocks base method.
+func (m *MockAdminServiceServer) DeleteSchedule(arg0 context.Context, arg1 *adminservice.DeleteScheduleRequest) (*adminservice.DeleteScheduleResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteSchedule", arg0, arg1)
+	ret0, _ := ret[0].(*adminservice.DeleteScheduleResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DeleteSchedule indicates an expected call of DeleteSchedule.
+func (mr *MockAdminServiceServerMockRecorder) DeleteSchedule(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteSchedule", reflect.TypeOf((*MockAdminServiceServer)(nil).DeleteSchedule), arg0, arg1)
+}
+
+// ListSchedules mocks base method.
+func (m *MockAdminServiceServer) ListSchedules(arg0 context.Context, arg1 *adminservice.ListSchedulesRequest) (*adminservice.ListSchedulesResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListSchedules", arg0, arg1)
+	ret0, _ := ret[0].(*adminservice.ListSchedulesResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListSchedules indicates an expected call of ListSchedules.
+func (mr *MockAdminServiceServerMockRecorder) ListSchedules(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListSchedules", reflect.TypeOf((*MockAdminServiceServer)(nil).ListSchedules), arg0, arg1)
+}
+
+// DeleteWorkflowExecution mocks base method.
+func (m *MockAdminServiceServer) DeleteWorkflowExecution(arg0 context.Context, arg1 *adminservice.DeleteWorkflowExecutionRequest) (*adminservice.DeleteWorkflowExecutionResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteWorkflowExecution", arg0, arg1)
+	ret0, _ := ret[0].(*adminservice.DeleteWorkflowExecutionResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DeleteWorkflowExecution indicates an expected call of DeleteWorkflowExecution.
+func (mr *MockAdminServiceServerMockRecorder) DeleteWorkflowExecution(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteWorkflowExecution", reflect.TypeOf((*MockAdminServiceServer)(nil).DeleteWorkflowExecution), arg0, arg1)
+}
+
+// StartBatchOperation mocks base method.
+func (m *MockAdminServiceServer) StartBatchOperation(arg0 context.Context, arg1 *adminservice.StartBatchOperationRequest) (*adminservice.StartBatchOperationResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "StartBatchOperation", arg0, arg1)
+	ret0, _ := ret[0].(*adminservice.StartBatchOperationResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// StartBatchOperation indicates an expected call of StartBatchOperation.
+func (mr *MockAdminServiceServerMockRecorder) StartBatchOperation(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "StartBatchOperation", reflect.TypeOf((*MockAdminServiceServer)(nil).StartBatchOperation), arg0, arg1)
+}
+
+// StopBatchOperation mocks base method.
+func (m *MockAdminServiceServer) StopBatchOperation(arg0 context.Context, arg1 *adminservice.StopBatchOperationRequest) (*adminservice.StopBatchOperationResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "StopBatchOperation", arg0, arg1)
+	ret0, _ := ret[0].(*adminservice.StopBatchOperationResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// StopBatchOperation indicates an expected call of StopBatchOperation.
+func (mr *MockAdminServiceServerMockRecorder) StopBatchOperation(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "StopBatchOperation", reflect.TypeOf((*MockAdminServiceServer)(nil).StopBatchOperation), arg0, arg1)
+}
+
+// DescribeBatchOperation mocks base method.
+func (m *MockAdminServiceServer) DescribeBatchOperation(arg0 context.Context, arg1 *adminservice.DescribeBatchOperationRequest) (*adminservice.DescribeBatchOperationResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DescribeBatchOperation", arg0, arg1)
+	ret0, _ := ret[0].(*adminservice.DescribeBatchOperationResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DescribeBatchOperation indicates an expected call of DescribeBatchOperation.
+func (mr *MockAdminServiceServerMockRecorder) DescribeBatchOperation(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DescribeBatchOperation", reflect.TypeOf((*MockAdminServiceServer)(nil).DescribeBatchOperation), arg0, arg1)
+}
+
+// ListBatchOperations mocks base method.
+func (m *MockAdminServiceServer) ListBatchOperations(arg0 context.Context, arg1 *adminservice.ListBatchOperationsRequest) (*adminservice.ListBatchOperationsResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListBatchOperations", arg0, arg1)
+	ret0, _ := ret[0].(*adminservice.ListBatchOperationsResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListBatchOperations indicates an expected call of ListBatchOperations.
+func (mr *MockAdminServiceServerMockRecorder) ListBatchOperations(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListBatchOperations", reflect.TypeOf((*MockAdminServiceServer)(nil).ListBatchOperations), arg0, arg1)
+}
+
+// UpdateWorkflowExecution mocks base method.
+func (m *MockAdminServiceServer) UpdateWorkflowExecution(arg0 context.Context, arg1 *adminservice.UpdateWorkflowExecutionRequest) (*adminservice.UpdateWorkflowExecutionResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateWorkflowExecution", arg0, arg1)
+	ret0, _ := ret[0].(*adminservice.UpdateWorkflowExecutionResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpdateWorkflowExecution indicates an expected call of UpdateWorkflowExecution.
+func (mr *MockAdminServiceServerMockRecorder) UpdateWorkflowExecution(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateWorkflowExecution", reflect.TypeOf((*MockAdminServiceServer)(nil).UpdateWorkflowExecution), arg0, arg1)
+}
+
+// GetWorkflowExecutionResult mocks base method.
+func (m *MockAdminServiceServer) GetWorkflowExecutionResult(arg0 context.Context, arg1 *adminservice.GetWorkflowExecutionResultRequest) (*adminservice.GetWorkflowExecutionResultResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetWorkflowExecutionResult", arg0, arg1)
+	ret0, _ := ret[0].(*adminservice.GetWorkflowExecutionResultResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetWorkflowExecutionResult indicates an expected call of GetWorkflowExecutionResult.
+func (mr *MockAdminServiceServerMockRecorder) GetWorkflowExecutionResult(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetWorkflowExecutionResult", reflect.TypeOf((*MockAdminServiceServer)(nil).GetWorkflowExecutionResult), arg0, arg1)
+}
+
+// ResetWorkflowExecutionWithOptions mocks base method.
+func (m *MockAdminServiceServer) ResetWorkflowExecutionWithOptions(arg0 context.Context, arg1 *adminservice.ResetWorkflowExecutionWithOptionsRequest) (*adminservice.ResetWorkflowExecutionWithOptionsResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ResetWorkflowExecutionWithOptions", arg0, arg1)
+	ret0, _ := ret[0].(*adminservice.ResetWorkflowExecutionWithOptionsResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ResetWorkflowExecutionWithOptions indicates an expected call of ResetWorkflowExecutionWithOptions.
+func (mr *MockAdminServiceServerMockRecorder) ResetWorkflowExecutionWithOptions(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ResetWorkflowExecutionWithOptions", reflect.TypeOf((*MockAdminServiceServer)(nil).ResetWorkflowExecutionWithOptions), arg0, arg1)
+}
+
+// PauseWorkflowExecution mocks base method.
+func (m *MockAdminServiceServer) PauseWorkflowExecution(arg0 context.Context, arg1 *adminservice.PauseWorkflowExecutionRequest) (*adminservice.PauseWorkflowExecutionResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "PauseWorkflowExecution", arg0, arg1)
+	ret0, _ := ret[0].(*adminservice.PauseWorkflowExecutionResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// PauseWorkflowExecution indicates an expected call of PauseWorkflowExecution.
+func (mr *MockAdminServiceServerMockRecorder) PauseWorkflowExecution(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PauseWorkflowExecution", reflect.TypeOf((*MockAdminServiceServer)(nil).PauseWorkflowExecution), arg0, arg1)
+}
+
+// UnpauseWorkflowExecution mocks base method.
+func (m *MockAdminServiceServer) UnpauseWorkflowExecution(arg0 context.Context, arg1 *adminservice.UnpauseWorkflowExecutionRequest) (*adminservice.UnpauseWorkflowExecutionResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UnpauseWorkflowExecution", arg0, arg1)
+	ret0, _ := ret[0].(*adminservice.UnpauseWorkflowExecutionResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UnpauseWorkflowExecution indicates an expected call of UnpauseWorkflowExecution.
+func (mr *MockAdminServiceServerMockRecorder) UnpauseWorkflowExecution(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UnpauseWorkflowExecution", reflect.TypeOf((*MockAdminServiceServer)(nil).UnpauseWorkflowExecution), arg0, arg1)
+}
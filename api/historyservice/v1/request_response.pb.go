@@ -304,6 +304,8 @@ type GetMutableStateResponse struct {
 	WorkflowStatus                        v12.WorkflowExecutionStatus `protobuf:"varint,16,opt,name=workflow_status,json=workflowStatus,proto3,enum=temporal.api.enums.v1.WorkflowExecutionStatus" json:"workflow_status,omitempty"`
 	VersionHistories                      *v17.VersionHistories       `protobuf:"bytes,17,opt,name=version_histories,json=versionHistories,proto3" json:"version_histories,omitempty"`
 	IsStickyTaskQueueEnabled              bool                        `protobuf:"varint,18,opt,name=is_sticky_task_queue_enabled,json=isStickyTaskQueueEnabled,proto3" json:"is_sticky_task_queue_enabled,omitempty"`
+	HistorySizeBytes                      int64                       `protobuf:"varint,19,opt,name=history_size_bytes,json=historySizeBytes,proto3" json:"history_size_bytes,omitempty"`
+	SuggestContinueAsNew                  bool                        `protobuf:"varint,20,opt,name=suggest_continue_as_new,json=suggestContinueAsNew,proto3" json:"suggest_continue_as_new,omitempty"`
 }
 
 func (m *GetMutableStateResponse) Reset()      { *m = GetMutableStateResponse{} }
@@ -436,6 +438,20 @@ func (m *GetMutableStateResponse) GetIsStickyTaskQueueEnabled() bool {
 	return false
 }
 
+func (m *GetMutableStateResponse) GetHistorySizeBytes() int64 {
+	if m != nil {
+		return m.HistorySizeBytes
+	}
+	return 0
+}
+
+func (m *GetMutableStateResponse) GetSuggestContinueAsNew() bool {
+	if m != nil {
+		return m.SuggestContinueAsNew
+	}
+	return false
+}
+
 type PollMutableStateRequest struct {
 	NamespaceId         string                 `protobuf:"bytes,1,opt,name=namespace_id,json=namespaceId,proto3" json:"namespace_id,omitempty"`
 	Execution           *v14.WorkflowExecution `protobuf:"bytes,2,opt,name=execution,proto3" json:"execution,omitempty"`
@@ -821,6 +837,10 @@ type RecordWorkflowTaskStartedResponse struct {
 	ScheduledTime              *time.Time                     `protobuf:"bytes,12,opt,name=scheduled_time,json=scheduledTime,proto3,stdtime" json:"scheduled_time,omitempty"`
 	StartedTime                *time.Time                     `protobuf:"bytes,13,opt,name=started_time,json=startedTime,proto3,stdtime" json:"started_time,omitempty"`
 	Queries                    map[string]*v18.WorkflowQuery  `protobuf:"bytes,14,rep,name=queries,proto3" json:"queries,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	HistorySizeBytes           int64                          `protobuf:"varint,15,opt,name=history_size_bytes,json=historySizeBytes,proto3" json:"history_size_bytes,omitempty"`
+	// Set once the history size or event count exceeds the warn limit of the namespace.
+	SuggestContinueAsNew bool                          `protobuf:"varint,16,opt,name=suggest_continue_as_new,json=suggestContinueAsNew,proto3" json:"suggest_continue_as_new,omitempty"`
+	Updates              []*v110.WorkflowUpdateRequest `protobuf:"bytes,17,rep,name=updates,proto3" json:"updates,omitempty"`
 }
 
 func (m *RecordWorkflowTaskStartedResponse) Reset()      { *m = RecordWorkflowTaskStartedResponse{} }
@@ -953,6 +973,27 @@ func (m *RecordWorkflowTaskStartedResponse) GetQueries() map[string]*v18.Workflo
 	return nil
 }
 
+func (m *RecordWorkflowTaskStartedResponse) GetHistorySizeBytes() int64 {
+	if m != nil {
+		return m.HistorySizeBytes
+	}
+	return 0
+}
+
+func (m *RecordWorkflowTaskStartedResponse) GetSuggestContinueAsNew() bool {
+	if m != nil {
+		return m.SuggestContinueAsNew
+	}
+	return false
+}
+
+func (m *RecordWorkflowTaskStartedResponse) GetUpdates() []*v110.WorkflowUpdateRequest {
+	if m != nil {
+		return m.Updates
+	}
+	return nil
+}
+
 type RecordActivityTaskStartedRequest struct {
 	NamespaceId       string                 `protobuf:"bytes,1,opt,name=namespace_id,json=namespaceId,proto3" json:"namespace_id,omitempty"`
 	WorkflowExecution *v14.WorkflowExecution `protobuf:"bytes,2,opt,name=workflow_execution,json=workflowExecution,proto3" json:"workflow_execution,omitempty"`
@@ -2045,6 +2086,15 @@ var xxx_messageInfo_TerminateWorkflowExecutionResponse proto.InternalMessageInfo
 type ResetWorkflowExecutionRequest struct {
 	NamespaceId  string                            `protobuf:"bytes,1,opt,name=namespace_id,json=namespaceId,proto3" json:"namespace_id,omitempty"`
 	ResetRequest *v1.ResetWorkflowExecutionRequest `protobuf:"bytes,2,opt,name=reset_request,json=resetRequest,proto3" json:"reset_request,omitempty"`
+	// Resets to the workflow task scheduled with this event id instead of reset_request.workflow_task_finish_event_id.
+	// The run in reset_request may be any run of the continue-as-new chain of the current run.
+	WorkflowTaskScheduledEventId int64 `protobuf:"varint,3,opt,name=workflow_task_scheduled_event_id,json=workflowTaskScheduledEventId,proto3" json:"workflow_task_scheduled_event_id,omitempty"`
+	// Signals received after the reset point are not reapplied, updates still are.
+	ReapplyExcludeSignals bool `protobuf:"varint,4,opt,name=reapply_exclude_signals,json=reapplyExcludeSignals,proto3" json:"reapply_exclude_signals,omitempty"`
+	// No events received after the reset point are reapplied.
+	ReapplyExcludeAll bool `protobuf:"varint,5,opt,name=reapply_exclude_all,json=reapplyExcludeAll,proto3" json:"reapply_exclude_all,omitempty"`
+	// Only preview the reset, nothing is persisted.
+	DryRun bool `protobuf:"varint,6,opt,name=dry_run,json=dryRun,proto3" json:"dry_run,omitempty"`
 }
 
 func (m *ResetWorkflowExecutionRequest) Reset()      { *m = ResetWorkflowExecutionRequest{} }
@@ -2093,8 +2143,38 @@ func (m *ResetWorkflowExecutionRequest) GetResetRequest() *v1.ResetWorkflowExecu
 	return nil
 }
 
+func (m *ResetWorkflowExecutionRequest) GetWorkflowTaskScheduledEventId() int64 {
+	if m != nil {
+		return m.WorkflowTaskScheduledEventId
+	}
+	return 0
+}
+
+func (m *ResetWorkflowExecutionRequest) GetReapplyExcludeSignals() bool {
+	if m != nil {
+		return m.ReapplyExcludeSignals
+	}
+	return false
+}
+
+func (m *ResetWorkflowExecutionRequest) GetReapplyExcludeAll() bool {
+	if m != nil {
+		return m.ReapplyExcludeAll
+	}
+	return false
+}
+
+func (m *ResetWorkflowExecutionRequest) GetDryRun() bool {
+	if m != nil {
+		return m.DryRun
+	}
+	return false
+}
+
 type ResetWorkflowExecutionResponse struct {
 	RunId string `protobuf:"bytes,1,opt,name=run_id,json=runId,proto3" json:"run_id,omitempty"`
+	// Set instead of run_id for a dry run.
+	Preview *ResetWorkflowExecutionPreview `protobuf:"bytes,2,opt,name=preview,proto3" json:"preview,omitempty"`
 }
 
 func (m *ResetWorkflowExecutionResponse) Reset()      { *m = ResetWorkflowExecutionResponse{} }
@@ -2136,6 +2216,109 @@ func (m *ResetWorkflowExecutionResponse) GetRunId() string {
 	return ""
 }
 
+func (m *ResetWorkflowExecutionResponse) GetPreview() *ResetWorkflowExecutionPreview {
+	if m != nil {
+		return m.Preview
+	}
+	return nil
+}
+
+type ResetWorkflowExecutionPreview struct {
+	BaseRunId                 string `protobuf:"bytes,1,opt,name=base_run_id,json=baseRunId,proto3" json:"base_run_id,omitempty"`
+	WorkflowTaskFinishEventId int64  `protobuf:"varint,2,opt,name=workflow_task_finish_event_id,json=workflowTaskFinishEventId,proto3" json:"workflow_task_finish_event_id,omitempty"`
+	// The current run which is terminated by the reset, empty if it is already closed.
+	TerminatedRunId string `protobuf:"bytes,3,opt,name=terminated_run_id,json=terminatedRunId,proto3" json:"terminated_run_id,omitempty"`
+	// Events of the base run after the reset point, they are not part of the reset run.
+	DroppedEvents []*v19.HistoryEvent `protobuf:"bytes,4,rep,name=dropped_events,json=droppedEvents,proto3" json:"dropped_events,omitempty"`
+	// Activities started before the reset point, they are failed in the reset run.
+	FailedActivities []*v110.PendingActivityInfo `protobuf:"bytes,5,rep,name=failed_activities,json=failedActivities,proto3" json:"failed_activities,omitempty"`
+	// Child workflows pending at the reset point, a reset with pending children is rejected.
+	PendingChildren []*v110.PendingChildExecutionInfo `protobuf:"bytes,6,rep,name=pending_children,json=pendingChildren,proto3" json:"pending_children,omitempty"`
+	// Events received after the reset point in the base run and the runs it continued as, which are reapplied to the reset run.
+	ReappliedEvents []*v19.HistoryEvent `protobuf:"bytes,7,rep,name=reapplied_events,json=reappliedEvents,proto3" json:"reapplied_events,omitempty"`
+}
+
+func (m *ResetWorkflowExecutionPreview) Reset()      { *m = ResetWorkflowExecutionPreview{} }
+func (*ResetWorkflowExecutionPreview) ProtoMessage() {}
+func (*ResetWorkflowExecutionPreview) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b8c78c1d460a3711, []int{34}
+}
+func (m *ResetWorkflowExecutionPreview) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ResetWorkflowExecutionPreview) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ResetWorkflowExecutionPreview.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ResetWorkflowExecutionPreview) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ResetWorkflowExecutionPreview.Merge(m, src)
+}
+func (m *ResetWorkflowExecutionPreview) XXX_Size() int {
+	return m.Size()
+}
+func (m *ResetWorkflowExecutionPreview) XXX_DiscardUnknown() {
+	xxx_messageInfo_ResetWorkflowExecutionPreview.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ResetWorkflowExecutionPreview proto.InternalMessageInfo
+
+func (m *ResetWorkflowExecutionPreview) GetBaseRunId() string {
+	if m != nil {
+		return m.BaseRunId
+	}
+	return ""
+}
+
+func (m *ResetWorkflowExecutionPreview) GetWorkflowTaskFinishEventId() int64 {
+	if m != nil {
+		return m.WorkflowTaskFinishEventId
+	}
+	return 0
+}
+
+func (m *ResetWorkflowExecutionPreview) GetTerminatedRunId() string {
+	if m != nil {
+		return m.TerminatedRunId
+	}
+	return ""
+}
+
+func (m *ResetWorkflowExecutionPreview) GetDroppedEvents() []*v19.HistoryEvent {
+	if m != nil {
+		return m.DroppedEvents
+	}
+	return nil
+}
+
+func (m *ResetWorkflowExecutionPreview) GetFailedActivities() []*v110.PendingActivityInfo {
+	if m != nil {
+		return m.FailedActivities
+	}
+	return nil
+}
+
+func (m *ResetWorkflowExecutionPreview) GetPendingChildren() []*v110.PendingChildExecutionInfo {
+	if m != nil {
+		return m.PendingChildren
+	}
+	return nil
+}
+
+func (m *ResetWorkflowExecutionPreview) GetReappliedEvents() []*v19.HistoryEvent {
+	if m != nil {
+		return m.ReappliedEvents
+	}
+	return nil
+}
+
 type RequestCancelWorkflowExecutionRequest struct {
 	NamespaceId               string                                    `protobuf:"bytes,1,opt,name=namespace_id,json=namespaceId,proto3" json:"namespace_id,omitempty"`
 	CancelRequest             *v1.RequestCancelWorkflowExecutionRequest `protobuf:"bytes,2,opt,name=cancel_request,json=cancelRequest,proto3" json:"cancel_request,omitempty"`
@@ -2147,7 +2330,7 @@ type RequestCancelWorkflowExecutionRequest struct {
 func (m *RequestCancelWorkflowExecutionRequest) Reset()      { *m = RequestCancelWorkflowExecutionRequest{} }
 func (*RequestCancelWorkflowExecutionRequest) ProtoMessage() {}
 func (*RequestCancelWorkflowExecutionRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_b8c78c1d460a3711, []int{34}
+	return fileDescriptor_b8c78c1d460a3711, []int{35}
 }
 func (m *RequestCancelWorkflowExecutionRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2219,7 +2402,7 @@ func (m *RequestCancelWorkflowExecutionResponse) Reset() {
 }
 func (*RequestCancelWorkflowExecutionResponse) ProtoMessage() {}
 func (*RequestCancelWorkflowExecutionResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_b8c78c1d460a3711, []int{35}
+	return fileDescriptor_b8c78c1d460a3711, []int{36}
 }
 func (m *RequestCancelWorkflowExecutionResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2257,7 +2440,7 @@ type ScheduleWorkflowTaskRequest struct {
 func (m *ScheduleWorkflowTaskRequest) Reset()      { *m = ScheduleWorkflowTaskRequest{} }
 func (*ScheduleWorkflowTaskRequest) ProtoMessage() {}
 func (*ScheduleWorkflowTaskRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_b8c78c1d460a3711, []int{36}
+	return fileDescriptor_b8c78c1d460a3711, []int{37}
 }
 func (m *ScheduleWorkflowTaskRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2313,7 +2496,7 @@ type ScheduleWorkflowTaskResponse struct {
 func (m *ScheduleWorkflowTaskResponse) Reset()      { *m = ScheduleWorkflowTaskResponse{} }
 func (*ScheduleWorkflowTaskResponse) ProtoMessage() {}
 func (*ScheduleWorkflowTaskResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_b8c78c1d460a3711, []int{37}
+	return fileDescriptor_b8c78c1d460a3711, []int{38}
 }
 func (m *ScheduleWorkflowTaskResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2359,7 +2542,7 @@ type RecordChildExecutionCompletedRequest struct {
 func (m *RecordChildExecutionCompletedRequest) Reset()      { *m = RecordChildExecutionCompletedRequest{} }
 func (*RecordChildExecutionCompletedRequest) ProtoMessage() {}
 func (*RecordChildExecutionCompletedRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_b8c78c1d460a3711, []int{38}
+	return fileDescriptor_b8c78c1d460a3711, []int{39}
 }
 func (m *RecordChildExecutionCompletedRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2429,7 +2612,7 @@ type RecordChildExecutionCompletedResponse struct {
 func (m *RecordChildExecutionCompletedResponse) Reset()      { *m = RecordChildExecutionCompletedResponse{} }
 func (*RecordChildExecutionCompletedResponse) ProtoMessage() {}
 func (*RecordChildExecutionCompletedResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_b8c78c1d460a3711, []int{39}
+	return fileDescriptor_b8c78c1d460a3711, []int{40}
 }
 func (m *RecordChildExecutionCompletedResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2466,7 +2649,7 @@ type DescribeWorkflowExecutionRequest struct {
 func (m *DescribeWorkflowExecutionRequest) Reset()      { *m = DescribeWorkflowExecutionRequest{} }
 func (*DescribeWorkflowExecutionRequest) ProtoMessage() {}
 func (*DescribeWorkflowExecutionRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_b8c78c1d460a3711, []int{40}
+	return fileDescriptor_b8c78c1d460a3711, []int{41}
 }
 func (m *DescribeWorkflowExecutionRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2514,12 +2697,18 @@ type DescribeWorkflowExecutionResponse struct {
 	WorkflowExecutionInfo *v110.WorkflowExecutionInfo       `protobuf:"bytes,2,opt,name=workflow_execution_info,json=workflowExecutionInfo,proto3" json:"workflow_execution_info,omitempty"`
 	PendingActivities     []*v110.PendingActivityInfo       `protobuf:"bytes,3,rep,name=pending_activities,json=pendingActivities,proto3" json:"pending_activities,omitempty"`
 	PendingChildren       []*v110.PendingChildExecutionInfo `protobuf:"bytes,4,rep,name=pending_children,json=pendingChildren,proto3" json:"pending_children,omitempty"`
+	HistorySizeBytes      int64                             `protobuf:"varint,5,opt,name=history_size_bytes,json=historySizeBytes,proto3" json:"history_size_bytes,omitempty"`
+	// Set once the history size or event count exceeds the warn limit of the namespace.
+	SuggestContinueAsNew bool `protobuf:"varint,6,opt,name=suggest_continue_as_new,json=suggestContinueAsNew,proto3" json:"suggest_continue_as_new,omitempty"`
+	// Set while the workflow is paused, its workflow and activity tasks are not dispatched.
+	Paused      bool   `protobuf:"varint,7,opt,name=paused,proto3" json:"paused,omitempty"`
+	PauseReason string `protobuf:"bytes,8,opt,name=pause_reason,json=pauseReason,proto3" json:"pause_reason,omitempty"`
 }
 
 func (m *DescribeWorkflowExecutionResponse) Reset()      { *m = DescribeWorkflowExecutionResponse{} }
 func (*DescribeWorkflowExecutionResponse) ProtoMessage() {}
 func (*DescribeWorkflowExecutionResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_b8c78c1d460a3711, []int{41}
+	return fileDescriptor_b8c78c1d460a3711, []int{42}
 }
 func (m *DescribeWorkflowExecutionResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2576,6 +2765,34 @@ func (m *DescribeWorkflowExecutionResponse) GetPendingChildren() []*v110.Pending
 	return nil
 }
 
+func (m *DescribeWorkflowExecutionResponse) GetHistorySizeBytes() int64 {
+	if m != nil {
+		return m.HistorySizeBytes
+	}
+	return 0
+}
+
+func (m *DescribeWorkflowExecutionResponse) GetSuggestContinueAsNew() bool {
+	if m != nil {
+		return m.SuggestContinueAsNew
+	}
+	return false
+}
+
+func (m *DescribeWorkflowExecutionResponse) GetPaused() bool {
+	if m != nil {
+		return m.Paused
+	}
+	return false
+}
+
+func (m *DescribeWorkflowExecutionResponse) GetPauseReason() string {
+	if m != nil {
+		return m.PauseReason
+	}
+	return ""
+}
+
 type ReplicateEventsV2Request struct {
 	NamespaceId         string                    `protobuf:"bytes,1,opt,name=namespace_id,json=namespaceId,proto3" json:"namespace_id,omitempty"`
 	WorkflowExecution   *v14.WorkflowExecution    `protobuf:"bytes,2,opt,name=workflow_execution,json=workflowExecution,proto3" json:"workflow_execution,omitempty"`
@@ -2588,7 +2805,7 @@ type ReplicateEventsV2Request struct {
 func (m *ReplicateEventsV2Request) Reset()      { *m = ReplicateEventsV2Request{} }
 func (*ReplicateEventsV2Request) ProtoMessage() {}
 func (*ReplicateEventsV2Request) Descriptor() ([]byte, []int) {
-	return fileDescriptor_b8c78c1d460a3711, []int{42}
+	return fileDescriptor_b8c78c1d460a3711, []int{43}
 }
 func (m *ReplicateEventsV2Request) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2658,7 +2875,7 @@ type ReplicateEventsV2Response struct {
 func (m *ReplicateEventsV2Response) Reset()      { *m = ReplicateEventsV2Response{} }
 func (*ReplicateEventsV2Response) ProtoMessage() {}
 func (*ReplicateEventsV2Response) Descriptor() ([]byte, []int) {
-	return fileDescriptor_b8c78c1d460a3711, []int{43}
+	return fileDescriptor_b8c78c1d460a3711, []int{44}
 }
 func (m *ReplicateEventsV2Response) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2696,7 +2913,7 @@ type SyncShardStatusRequest struct {
 func (m *SyncShardStatusRequest) Reset()      { *m = SyncShardStatusRequest{} }
 func (*SyncShardStatusRequest) ProtoMessage() {}
 func (*SyncShardStatusRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_b8c78c1d460a3711, []int{44}
+	return fileDescriptor_b8c78c1d460a3711, []int{45}
 }
 func (m *SyncShardStatusRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2752,7 +2969,7 @@ type SyncShardStatusResponse struct {
 func (m *SyncShardStatusResponse) Reset()      { *m = SyncShardStatusResponse{} }
 func (*SyncShardStatusResponse) ProtoMessage() {}
 func (*SyncShardStatusResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_b8c78c1d460a3711, []int{45}
+	return fileDescriptor_b8c78c1d460a3711, []int{46}
 }
 func (m *SyncShardStatusResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2801,7 +3018,7 @@ type SyncActivityRequest struct {
 func (m *SyncActivityRequest) Reset()      { *m = SyncActivityRequest{} }
 func (*SyncActivityRequest) ProtoMessage() {}
 func (*SyncActivityRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_b8c78c1d460a3711, []int{46}
+	return fileDescriptor_b8c78c1d460a3711, []int{47}
 }
 func (m *SyncActivityRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2934,7 +3151,7 @@ type SyncActivityResponse struct {
 func (m *SyncActivityResponse) Reset()      { *m = SyncActivityResponse{} }
 func (*SyncActivityResponse) ProtoMessage() {}
 func (*SyncActivityResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_b8c78c1d460a3711, []int{47}
+	return fileDescriptor_b8c78c1d460a3711, []int{48}
 }
 func (m *SyncActivityResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2971,7 +3188,7 @@ type DescribeMutableStateRequest struct {
 func (m *DescribeMutableStateRequest) Reset()      { *m = DescribeMutableStateRequest{} }
 func (*DescribeMutableStateRequest) ProtoMessage() {}
 func (*DescribeMutableStateRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_b8c78c1d460a3711, []int{48}
+	return fileDescriptor_b8c78c1d460a3711, []int{49}
 }
 func (m *DescribeMutableStateRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3024,7 +3241,7 @@ type DescribeMutableStateResponse struct {
 func (m *DescribeMutableStateResponse) Reset()      { *m = DescribeMutableStateResponse{} }
 func (*DescribeMutableStateResponse) ProtoMessage() {}
 func (*DescribeMutableStateResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_b8c78c1d460a3711, []int{49}
+	return fileDescriptor_b8c78c1d460a3711, []int{50}
 }
 func (m *DescribeMutableStateResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3093,7 +3310,7 @@ type DescribeHistoryHostRequest struct {
 func (m *DescribeHistoryHostRequest) Reset()      { *m = DescribeHistoryHostRequest{} }
 func (*DescribeHistoryHostRequest) ProtoMessage() {}
 func (*DescribeHistoryHostRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_b8c78c1d460a3711, []int{50}
+	return fileDescriptor_b8c78c1d460a3711, []int{51}
 }
 func (m *DescribeHistoryHostRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3161,7 +3378,7 @@ type DescribeHistoryHostResponse struct {
 func (m *DescribeHistoryHostResponse) Reset()      { *m = DescribeHistoryHostResponse{} }
 func (*DescribeHistoryHostResponse) ProtoMessage() {}
 func (*DescribeHistoryHostResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_b8c78c1d460a3711, []int{51}
+	return fileDescriptor_b8c78c1d460a3711, []int{52}
 }
 func (m *DescribeHistoryHostResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3232,7 +3449,7 @@ type CloseShardRequest struct {
 func (m *CloseShardRequest) Reset()      { *m = CloseShardRequest{} }
 func (*CloseShardRequest) ProtoMessage() {}
 func (*CloseShardRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_b8c78c1d460a3711, []int{52}
+	return fileDescriptor_b8c78c1d460a3711, []int{53}
 }
 func (m *CloseShardRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3274,7 +3491,7 @@ type CloseShardResponse struct {
 func (m *CloseShardResponse) Reset()      { *m = CloseShardResponse{} }
 func (*CloseShardResponse) ProtoMessage() {}
 func (*CloseShardResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_b8c78c1d460a3711, []int{53}
+	return fileDescriptor_b8c78c1d460a3711, []int{54}
 }
 func (m *CloseShardResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3313,7 +3530,7 @@ type RemoveTaskRequest struct {
 func (m *RemoveTaskRequest) Reset()      { *m = RemoveTaskRequest{} }
 func (*RemoveTaskRequest) ProtoMessage() {}
 func (*RemoveTaskRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_b8c78c1d460a3711, []int{54}
+	return fileDescriptor_b8c78c1d460a3711, []int{55}
 }
 func (m *RemoveTaskRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3376,7 +3593,7 @@ type RemoveTaskResponse struct {
 func (m *RemoveTaskResponse) Reset()      { *m = RemoveTaskResponse{} }
 func (*RemoveTaskResponse) ProtoMessage() {}
 func (*RemoveTaskResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_b8c78c1d460a3711, []int{55}
+	return fileDescriptor_b8c78c1d460a3711, []int{56}
 }
 func (m *RemoveTaskResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3413,7 +3630,7 @@ type GetReplicationMessagesRequest struct {
 func (m *GetReplicationMessagesRequest) Reset()      { *m = GetReplicationMessagesRequest{} }
 func (*GetReplicationMessagesRequest) ProtoMessage() {}
 func (*GetReplicationMessagesRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_b8c78c1d460a3711, []int{56}
+	return fileDescriptor_b8c78c1d460a3711, []int{57}
 }
 func (m *GetReplicationMessagesRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3463,7 +3680,7 @@ type GetReplicationMessagesResponse struct {
 func (m *GetReplicationMessagesResponse) Reset()      { *m = GetReplicationMessagesResponse{} }
 func (*GetReplicationMessagesResponse) ProtoMessage() {}
 func (*GetReplicationMessagesResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_b8c78c1d460a3711, []int{57}
+	return fileDescriptor_b8c78c1d460a3711, []int{58}
 }
 func (m *GetReplicationMessagesResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3506,7 +3723,7 @@ type GetDLQReplicationMessagesRequest struct {
 func (m *GetDLQReplicationMessagesRequest) Reset()      { *m = GetDLQReplicationMessagesRequest{} }
 func (*GetDLQReplicationMessagesRequest) ProtoMessage() {}
 func (*GetDLQReplicationMessagesRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_b8c78c1d460a3711, []int{58}
+	return fileDescriptor_b8c78c1d460a3711, []int{59}
 }
 func (m *GetDLQReplicationMessagesRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3549,7 +3766,7 @@ type GetDLQReplicationMessagesResponse struct {
 func (m *GetDLQReplicationMessagesResponse) Reset()      { *m = GetDLQReplicationMessagesResponse{} }
 func (*GetDLQReplicationMessagesResponse) ProtoMessage() {}
 func (*GetDLQReplicationMessagesResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_b8c78c1d460a3711, []int{59}
+	return fileDescriptor_b8c78c1d460a3711, []int{60}
 }
 func (m *GetDLQReplicationMessagesResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3593,7 +3810,7 @@ type QueryWorkflowRequest struct {
 func (m *QueryWorkflowRequest) Reset()      { *m = QueryWorkflowRequest{} }
 func (*QueryWorkflowRequest) ProtoMessage() {}
 func (*QueryWorkflowRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_b8c78c1d460a3711, []int{60}
+	return fileDescriptor_b8c78c1d460a3711, []int{61}
 }
 func (m *QueryWorkflowRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3643,7 +3860,7 @@ type QueryWorkflowResponse struct {
 func (m *QueryWorkflowResponse) Reset()      { *m = QueryWorkflowResponse{} }
 func (*QueryWorkflowResponse) ProtoMessage() {}
 func (*QueryWorkflowResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_b8c78c1d460a3711, []int{61}
+	return fileDescriptor_b8c78c1d460a3711, []int{62}
 }
 func (m *QueryWorkflowResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3687,7 +3904,7 @@ type ReapplyEventsRequest struct {
 func (m *ReapplyEventsRequest) Reset()      { *m = ReapplyEventsRequest{} }
 func (*ReapplyEventsRequest) ProtoMessage() {}
 func (*ReapplyEventsRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_b8c78c1d460a3711, []int{62}
+	return fileDescriptor_b8c78c1d460a3711, []int{63}
 }
 func (m *ReapplyEventsRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3736,7 +3953,7 @@ type ReapplyEventsResponse struct {
 func (m *ReapplyEventsResponse) Reset()      { *m = ReapplyEventsResponse{} }
 func (*ReapplyEventsResponse) ProtoMessage() {}
 func (*ReapplyEventsResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_b8c78c1d460a3711, []int{63}
+	return fileDescriptor_b8c78c1d460a3711, []int{64}
 }
 func (m *ReapplyEventsResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3777,7 +3994,7 @@ type GetDLQMessagesRequest struct {
 func (m *GetDLQMessagesRequest) Reset()      { *m = GetDLQMessagesRequest{} }
 func (*GetDLQMessagesRequest) ProtoMessage() {}
 func (*GetDLQMessagesRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_b8c78c1d460a3711, []int{64}
+	return fileDescriptor_b8c78c1d460a3711, []int{65}
 }
 func (m *GetDLQMessagesRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3857,7 +4074,7 @@ type GetDLQMessagesResponse struct {
 func (m *GetDLQMessagesResponse) Reset()      { *m = GetDLQMessagesResponse{} }
 func (*GetDLQMessagesResponse) ProtoMessage() {}
 func (*GetDLQMessagesResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_b8c78c1d460a3711, []int{65}
+	return fileDescriptor_b8c78c1d460a3711, []int{66}
 }
 func (m *GetDLQMessagesResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3917,7 +4134,7 @@ type PurgeDLQMessagesRequest struct {
 func (m *PurgeDLQMessagesRequest) Reset()      { *m = PurgeDLQMessagesRequest{} }
 func (*PurgeDLQMessagesRequest) ProtoMessage() {}
 func (*PurgeDLQMessagesRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_b8c78c1d460a3711, []int{66}
+	return fileDescriptor_b8c78c1d460a3711, []int{67}
 }
 func (m *PurgeDLQMessagesRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3980,7 +4197,7 @@ type PurgeDLQMessagesResponse struct {
 func (m *PurgeDLQMessagesResponse) Reset()      { *m = PurgeDLQMessagesResponse{} }
 func (*PurgeDLQMessagesResponse) ProtoMessage() {}
 func (*PurgeDLQMessagesResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_b8c78c1d460a3711, []int{67}
+	return fileDescriptor_b8c78c1d460a3711, []int{68}
 }
 func (m *PurgeDLQMessagesResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -4021,7 +4238,7 @@ type MergeDLQMessagesRequest struct {
 func (m *MergeDLQMessagesRequest) Reset()      { *m = MergeDLQMessagesRequest{} }
 func (*MergeDLQMessagesRequest) ProtoMessage() {}
 func (*MergeDLQMessagesRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_b8c78c1d460a3711, []int{68}
+	return fileDescriptor_b8c78c1d460a3711, []int{69}
 }
 func (m *MergeDLQMessagesRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -4099,7 +4316,7 @@ type MergeDLQMessagesResponse struct {
 func (m *MergeDLQMessagesResponse) Reset()      { *m = MergeDLQMessagesResponse{} }
 func (*MergeDLQMessagesResponse) ProtoMessage() {}
 func (*MergeDLQMessagesResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_b8c78c1d460a3711, []int{69}
+	return fileDescriptor_b8c78c1d460a3711, []int{70}
 }
 func (m *MergeDLQMessagesResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -4143,7 +4360,7 @@ type RefreshWorkflowTasksRequest struct {
 func (m *RefreshWorkflowTasksRequest) Reset()      { *m = RefreshWorkflowTasksRequest{} }
 func (*RefreshWorkflowTasksRequest) ProtoMessage() {}
 func (*RefreshWorkflowTasksRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_b8c78c1d460a3711, []int{70}
+	return fileDescriptor_b8c78c1d460a3711, []int{71}
 }
 func (m *RefreshWorkflowTasksRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -4192,7 +4409,7 @@ type RefreshWorkflowTasksResponse struct {
 func (m *RefreshWorkflowTasksResponse) Reset()      { *m = RefreshWorkflowTasksResponse{} }
 func (*RefreshWorkflowTasksResponse) ProtoMessage() {}
 func (*RefreshWorkflowTasksResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_b8c78c1d460a3711, []int{71}
+	return fileDescriptor_b8c78c1d460a3711, []int{72}
 }
 func (m *RefreshWorkflowTasksResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -4221,697 +4438,893 @@ func (m *RefreshWorkflowTasksResponse) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_RefreshWorkflowTasksResponse proto.InternalMessageInfo
 
-func init() {
-	proto.RegisterType((*StartWorkflowExecutionRequest)(nil), "temporal.server.api.historyservice.v1.StartWorkflowExecutionRequest")
-	proto.RegisterType((*StartWorkflowExecutionResponse)(nil), "temporal.server.api.historyservice.v1.StartWorkflowExecutionResponse")
-	proto.RegisterType((*GetMutableStateRequest)(nil), "temporal.server.api.historyservice.v1.GetMutableStateRequest")
-	proto.RegisterType((*GetMutableStateResponse)(nil), "temporal.server.api.historyservice.v1.GetMutableStateResponse")
-	proto.RegisterType((*PollMutableStateRequest)(nil), "temporal.server.api.historyservice.v1.PollMutableStateRequest")
-	proto.RegisterType((*PollMutableStateResponse)(nil), "temporal.server.api.historyservice.v1.PollMutableStateResponse")
-	proto.RegisterType((*ResetStickyTaskQueueRequest)(nil), "temporal.server.api.historyservice.v1.ResetStickyTaskQueueRequest")
-	proto.RegisterType((*ResetStickyTaskQueueResponse)(nil), "temporal.server.api.historyservice.v1.ResetStickyTaskQueueResponse")
-	proto.RegisterType((*RecordWorkflowTaskStartedRequest)(nil), "temporal.server.api.historyservice.v1.RecordWorkflowTaskStartedRequest")
-	proto.RegisterType((*RecordWorkflowTaskStartedResponse)(nil), "temporal.server.api.historyservice.v1.RecordWorkflowTaskStartedResponse")
-	proto.RegisterMapType((map[string]*v18.WorkflowQuery)(nil), "temporal.server.api.historyservice.v1.RecordWorkflowTaskStartedResponse.QueriesEntry")
-	proto.RegisterType((*RecordActivityTaskStartedRequest)(nil), "temporal.server.api.historyservice.v1.RecordActivityTaskStartedRequest")
-	proto.RegisterType((*RecordActivityTaskStartedResponse)(nil), "temporal.server.api.historyservice.v1.RecordActivityTaskStartedResponse")
-	proto.RegisterType((*RespondWorkflowTaskCompletedRequest)(nil), "temporal.server.api.historyservice.v1.RespondWorkflowTaskCompletedRequest")
-	proto.RegisterType((*RespondWorkflowTaskCompletedResponse)(nil), "temporal.server.api.historyservice.v1.RespondWorkflowTaskCompletedResponse")
-	proto.RegisterType((*RespondWorkflowTaskFailedRequest)(nil), "temporal.server.api.historyservice.v1.RespondWorkflowTaskFailedRequest")
-	proto.RegisterType((*RespondWorkflowTaskFailedResponse)(nil), "temporal.server.api.historyservice.v1.RespondWorkflowTaskFailedResponse")
-	proto.RegisterType((*RecordActivityTaskHeartbeatRequest)(nil), "temporal.server.api.historyservice.v1.RecordActivityTaskHeartbeatRequest")
-	proto.RegisterType((*RecordActivityTaskHeartbeatResponse)(nil), "temporal.server.api.historyservice.v1.RecordActivityTaskHeartbeatResponse")
-	proto.RegisterType((*RespondActivityTaskCompletedRequest)(nil), "temporal.server.api.historyservice.v1.RespondActivityTaskCompletedRequest")
-	proto.RegisterType((*RespondActivityTaskCompletedResponse)(nil), "temporal.server.api.historyservice.v1.RespondActivityTaskCompletedResponse")
-	proto.RegisterType((*RespondActivityTaskFailedRequest)(nil), "temporal.server.api.historyservice.v1.RespondActivityTaskFailedRequest")
-	proto.RegisterType((*RespondActivityTaskFailedResponse)(nil), "temporal.server.api.historyservice.v1.RespondActivityTaskFailedResponse")
-	proto.RegisterType((*RespondActivityTaskCanceledRequest)(nil), "temporal.server.api.historyservice.v1.RespondActivityTaskCanceledRequest")
-	proto.RegisterType((*RespondActivityTaskCanceledResponse)(nil), "temporal.server.api.historyservice.v1.RespondActivityTaskCanceledResponse")
-	proto.RegisterType((*SignalWorkflowExecutionRequest)(nil), "temporal.server.api.historyservice.v1.SignalWorkflowExecutionRequest")
-	proto.RegisterType((*SignalWorkflowExecutionResponse)(nil), "temporal.server.api.historyservice.v1.SignalWorkflowExecutionResponse")
-	proto.RegisterType((*SignalWithStartWorkflowExecutionRequest)(nil), "temporal.server.api.historyservice.v1.SignalWithStartWorkflowExecutionRequest")
-	proto.RegisterType((*SignalWithStartWorkflowExecutionResponse)(nil), "temporal.server.api.historyservice.v1.SignalWithStartWorkflowExecutionResponse")
-	proto.RegisterType((*RemoveSignalMutableStateRequest)(nil), "temporal.server.api.historyservice.v1.RemoveSignalMutableStateRequest")
-	proto.RegisterType((*RemoveSignalMutableStateResponse)(nil), "temporal.server.api.historyservice.v1.RemoveSignalMutableStateResponse")
-	proto.RegisterType((*TerminateWorkflowExecutionRequest)(nil), "temporal.server.api.historyservice.v1.TerminateWorkflowExecutionRequest")
-	proto.RegisterType((*TerminateWorkflowExecutionResponse)(nil), "temporal.server.api.historyservice.v1.TerminateWorkflowExecutionResponse")
-	proto.RegisterType((*ResetWorkflowExecutionRequest)(nil), "temporal.server.api.historyservice.v1.ResetWorkflowExecutionRequest")
-	proto.RegisterType((*ResetWorkflowExecutionResponse)(nil), "temporal.server.api.historyservice.v1.ResetWorkflowExecutionResponse")
-	proto.RegisterType((*RequestCancelWorkflowExecutionRequest)(nil), "temporal.server.api.historyservice.v1.RequestCancelWorkflowExecutionRequest")
-	proto.RegisterType((*RequestCancelWorkflowExecutionResponse)(nil), "temporal.server.api.historyservice.v1.RequestCancelWorkflowExecutionResponse")
-	proto.RegisterType((*ScheduleWorkflowTaskRequest)(nil), "temporal.server.api.historyservice.v1.ScheduleWorkflowTaskRequest")
-	proto.RegisterType((*ScheduleWorkflowTaskResponse)(nil), "temporal.server.api.historyservice.v1.ScheduleWorkflowTaskResponse")
-	proto.RegisterType((*RecordChildExecutionCompletedRequest)(nil), "temporal.server.api.historyservice.v1.RecordChildExecutionCompletedRequest")
-	proto.RegisterType((*RecordChildExecutionCompletedResponse)(nil), "temporal.server.api.historyservice.v1.RecordChildExecutionCompletedResponse")
-	proto.RegisterType((*DescribeWorkflowExecutionRequest)(nil), "temporal.server.api.historyservice.v1.DescribeWorkflowExecutionRequest")
-	proto.RegisterType((*DescribeWorkflowExecutionResponse)(nil), "temporal.server.api.historyservice.v1.DescribeWorkflowExecutionResponse")
-	proto.RegisterType((*ReplicateEventsV2Request)(nil), "temporal.server.api.historyservice.v1.ReplicateEventsV2Request")
-	proto.RegisterType((*ReplicateEventsV2Response)(nil), "temporal.server.api.historyservice.v1.ReplicateEventsV2Response")
-	proto.RegisterType((*SyncShardStatusRequest)(nil), "temporal.server.api.historyservice.v1.SyncShardStatusRequest")
-	proto.RegisterType((*SyncShardStatusResponse)(nil), "temporal.server.api.historyservice.v1.SyncShardStatusResponse")
-	proto.RegisterType((*SyncActivityRequest)(nil), "temporal.server.api.historyservice.v1.SyncActivityRequest")
-	proto.RegisterType((*SyncActivityResponse)(nil), "temporal.server.api.historyservice.v1.SyncActivityResponse")
-	proto.RegisterType((*DescribeMutableStateRequest)(nil), "temporal.server.api.historyservice.v1.DescribeMutableStateRequest")
-	proto.RegisterType((*DescribeMutableStateResponse)(nil), "temporal.server.api.historyservice.v1.DescribeMutableStateResponse")
-	proto.RegisterType((*DescribeHistoryHostRequest)(nil), "temporal.server.api.historyservice.v1.DescribeHistoryHostRequest")
-	proto.RegisterType((*DescribeHistoryHostResponse)(nil), "temporal.server.api.historyservice.v1.DescribeHistoryHostResponse")
-	proto.RegisterType((*CloseShardRequest)(nil), "temporal.server.api.historyservice.v1.CloseShardRequest")
-	proto.RegisterType((*CloseShardResponse)(nil), "temporal.server.api.historyservice.v1.CloseShardResponse")
-	proto.RegisterType((*RemoveTaskRequest)(nil), "temporal.server.api.historyservice.v1.RemoveTaskRequest")
-	proto.RegisterType((*RemoveTaskResponse)(nil), "temporal.server.api.historyservice.v1.RemoveTaskResponse")
-	proto.RegisterType((*GetReplicationMessagesRequest)(nil), "temporal.server.api.historyservice.v1.GetReplicationMessagesRequest")
-	proto.RegisterType((*GetReplicationMessagesResponse)(nil), "temporal.server.api.historyservice.v1.GetReplicationMessagesResponse")
-	proto.RegisterMapType((map[int32]*v112.ReplicationMessages)(nil), "temporal.server.api.historyservice.v1.GetReplicationMessagesResponse.ShardMessagesEntry")
-	proto.RegisterType((*GetDLQReplicationMessagesRequest)(nil), "temporal.server.api.historyservice.v1.GetDLQReplicationMessagesRequest")
-	proto.RegisterType((*GetDLQReplicationMessagesResponse)(nil), "temporal.server.api.historyservice.v1.GetDLQReplicationMessagesResponse")
-	proto.RegisterType((*QueryWorkflowRequest)(nil), "temporal.server.api.historyservice.v1.QueryWorkflowRequest")
-	proto.RegisterType((*QueryWorkflowResponse)(nil), "temporal.server.api.historyservice.v1.QueryWorkflowResponse")
-	proto.RegisterType((*ReapplyEventsRequest)(nil), "temporal.server.api.historyservice.v1.ReapplyEventsRequest")
-	proto.RegisterType((*ReapplyEventsResponse)(nil), "temporal.server.api.historyservice.v1.ReapplyEventsResponse")
-	proto.RegisterType((*GetDLQMessagesRequest)(nil), "temporal.server.api.historyservice.v1.GetDLQMessagesRequest")
-	proto.RegisterType((*GetDLQMessagesResponse)(nil), "temporal.server.api.historyservice.v1.GetDLQMessagesResponse")
-	proto.RegisterType((*PurgeDLQMessagesRequest)(nil), "temporal.server.api.historyservice.v1.PurgeDLQMessagesRequest")
-	proto.RegisterType((*PurgeDLQMessagesResponse)(nil), "temporal.server.api.historyservice.v1.PurgeDLQMessagesResponse")
-	proto.RegisterType((*MergeDLQMessagesRequest)(nil), "temporal.server.api.historyservice.v1.MergeDLQMessagesRequest")
-	proto.RegisterType((*MergeDLQMessagesResponse)(nil), "temporal.server.api.historyservice.v1.MergeDLQMessagesResponse")
-	proto.RegisterType((*RefreshWorkflowTasksRequest)(nil), "temporal.server.api.historyservice.v1.RefreshWorkflowTasksRequest")
-	proto.RegisterType((*RefreshWorkflowTasksResponse)(nil), "temporal.server.api.historyservice.v1.RefreshWorkflowTasksResponse")
+type DeleteWorkflowExecutionRequest struct {
+	NamespaceId       string                 `protobuf:"bytes,1,opt,name=namespace_id,json=namespaceId,proto3" json:"namespace_id,omitempty"`
+	WorkflowExecution *v14.WorkflowExecution `protobuf:"bytes,2,opt,name=workflow_execution,json=workflowExecution,proto3" json:"workflow_execution,omitempty"`
+	Identity          string                 `protobuf:"bytes,3,opt,name=identity,proto3" json:"identity,omitempty"`
 }
 
-func init() {
-	proto.RegisterFile("temporal/server/api/historyservice/v1/request_response.proto", fileDescriptor_b8c78c1d460a3711)
+func (m *DeleteWorkflowExecutionRequest) Reset()      { *m = DeleteWorkflowExecutionRequest{} }
+func (*DeleteWorkflowExecutionRequest) ProtoMessage() {}
+func (*DeleteWorkflowExecutionRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b8c78c1d460a3711, []int{73}
 }
-
-var fileDescriptor_b8c78c1d460a3711 = []byte{
-	// 3684 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xec, 0x5b, 0x49, 0x70, 0x1b, 0xc7,
-	0xd5, 0xd6, 0x10, 0x5c, 0x80, 0x07, 0x10, 0x04, 0x86, 0x1b, 0x48, 0x4a, 0x10, 0x39, 0xda, 0xe8,
-	0x45, 0xa0, 0x16, 0xff, 0x96, 0xad, 0xff, 0xb7, 0xff, 0x88, 0xd4, 0x06, 0x95, 0x25, 0xd3, 0x43,
-	0x46, 0x72, 0x79, 0x1b, 0x0f, 0x31, 0x4d, 0x72, 0x42, 0x60, 0x06, 0x9a, 0x1e, 0x90, 0x82, 0x73,
-	0xc8, 0x56, 0x39, 0x38, 0xa9, 0x4a, 0xa9, 0x2a, 0x97, 0x54, 0xc5, 0xc9, 0x21, 0x97, 0xf8, 0x92,
-	0xf2, 0x21, 0x87, 0x94, 0x53, 0x95, 0x6b, 0x2a, 0xb7, 0xb8, 0x72, 0x89, 0x2b, 0x39, 0x24, 0x96,
-	0x2f, 0x49, 0x25, 0x07, 0x1f, 0x72, 0x4f, 0xaa, 0xb7, 0xc1, 0x6c, 0xd8, 0x48, 0x29, 0x76, 0x1c,
-	0xdf, 0x30, 0xdd, 0xef, 0xbd, 0xee, 0xf7, 0xfa, 0xbd, 0xaf, 0xbb, 0x5f, 0x3f, 0xc0, 0xff, 0xb9,
-	0xa8, 0x56, 0xb7, 0x1d, 0xbd, 0xba, 0x84, 0x91, 0xb3, 0x8b, 0x9c, 0x25, 0xbd, 0x6e, 0x2e, 0x6d,
-	0x9b, 0xd8, 0xb5, 0x9d, 0x26, 0x69, 0x31, 0x2b, 0x68, 0x69, 0xf7, 0xec, 0x92, 0x83, 0xee, 0x36,
-	0x10, 0x76, 0x35, 0x07, 0xe1, 0xba, 0x6d, 0x61, 0x54, 0xaa, 0x3b, 0xb6, 0x6b, 0xcb, 0x27, 0x04,
-	0x77, 0x89, 0x71, 0x97, 0xf4, 0xba, 0x59, 0x0a, 0x72, 0x97, 0x76, 0xcf, 0xce, 0x16, 0xb7, 0x6c,
-	0x7b, 0xab, 0x8a, 0x96, 0x28, 0xd3, 0x46, 0x63, 0x73, 0xc9, 0x68, 0x38, 0xba, 0x6b, 0xda, 0x16,
-	0x13, 0x33, 0x7b, 0x34, 0xdc, 0xef, 0x9a, 0x35, 0x84, 0x5d, 0xbd, 0x56, 0xe7, 0x04, 0x0b, 0x06,
-	0xaa, 0x23, 0xcb, 0x40, 0x56, 0xc5, 0x44, 0x78, 0x69, 0xcb, 0xde, 0xb2, 0x69, 0x3b, 0xfd, 0xc5,
-	0x49, 0x8e, 0x7b, 0x8a, 0x10, 0x0d, 0x2a, 0x76, 0xad, 0x66, 0x5b, 0x64, 0xe6, 0x35, 0x84, 0xb1,
-	0xbe, 0xc5, 0x27, 0x3c, 0x7b, 0x22, 0x40, 0xc5, 0x67, 0x1a, 0x25, 0x3b, 0x15, 0x20, 0x73, 0x75,
-	0xbc, 0x73, 0xb7, 0x81, 0x1a, 0x28, 0x4a, 0x18, 0x1c, 0x15, 0x59, 0x8d, 0x1a, 0x26, 0x44, 0x7b,
-	0xb6, 0xb3, 0xb3, 0x59, 0xb5, 0xf7, 0x38, 0xd5, 0xc9, 0x00, 0x95, 0xe8, 0x8c, 0x4a, 0x3b, 0x16,
-	0xa0, 0xbb, 0xdb, 0x40, 0x71, 0x73, 0x0b, 0xaa, 0xb0, 0xa9, 0x9b, 0xd5, 0x86, 0x13, 0x33, 0xb3,
-	0x27, 0x3b, 0x2c, 0x6c, 0x94, 0xfa, 0xb1, 0x38, 0x6a, 0x4f, 0x1d, 0x66, 0x4d, 0x4e, 0xfa, 0x44,
-	0x47, 0xd2, 0x90, 0xe6, 0xa7, 0x3a, 0x12, 0x13, 0xc3, 0x72, 0xc2, 0xd3, 0x71, 0x84, 0xed, 0x2d,
-	0x55, 0x8a, 0x23, 0xb7, 0xf4, 0x1a, 0xc2, 0x75, 0xbd, 0x12, 0x63, 0x8d, 0x33, 0x71, 0xf4, 0x0e,
-	0xaa, 0x57, 0xcd, 0x0a, 0x75, 0xc4, 0x28, 0xc7, 0xd3, 0xb1, 0x6b, 0xd6, 0x35, 0x24, 0x66, 0x2f,
-	0xc6, 0x8d, 0xa4, 0x1b, 0x35, 0xd3, 0xea, 0xca, 0xab, 0x7c, 0x77, 0x18, 0x8e, 0xac, 0xb9, 0xba,
-	0xe3, 0xde, 0xe1, 0xc3, 0x5d, 0xb9, 0x87, 0x2a, 0x0d, 0x32, 0x3f, 0x95, 0x31, 0xc8, 0x0b, 0x90,
-	0xf1, 0xb4, 0xd4, 0x4c, 0xa3, 0x20, 0xcd, 0x4b, 0x8b, 0x29, 0x35, 0xed, 0xb5, 0x95, 0x0d, 0xb9,
-	0x02, 0xa3, 0x98, 0xc8, 0xd0, 0xf8, 0x20, 0x85, 0x81, 0x79, 0x69, 0x31, 0x7d, 0xee, 0x79, 0xcf,
-	0x64, 0x34, 0x48, 0x43, 0x0a, 0x95, 0x76, 0xcf, 0x96, 0x3a, 0x8e, 0xac, 0x66, 0xa8, 0x50, 0x31,
-	0x8f, 0x6d, 0x98, 0xac, 0xeb, 0x0e, 0xb2, 0x5c, 0x0d, 0x09, 0x42, 0xcd, 0xb4, 0x36, 0xed, 0x42,
-	0x82, 0x0e, 0xf6, 0x54, 0x29, 0x0e, 0x18, 0x3c, 0xdf, 0xd8, 0x3d, 0x5b, 0x5a, 0xa5, 0xdc, 0xde,
-	0x28, 0x65, 0x6b, 0xd3, 0x56, 0xc7, 0xeb, 0xd1, 0x46, 0xb9, 0x00, 0x23, 0xba, 0x4b, 0xa4, 0xb9,
-	0x85, 0xc1, 0x79, 0x69, 0x71, 0x48, 0x15, 0x9f, 0x72, 0x0d, 0x14, 0x21, 0xd1, 0x37, 0x0b, 0x74,
-	0xaf, 0x6e, 0x32, 0x70, 0xd1, 0x08, 0x8a, 0x14, 0x86, 0xe8, 0x84, 0x66, 0x4b, 0x0c, 0x62, 0x4a,
-	0x02, 0x62, 0x4a, 0xeb, 0x02, 0x62, 0x96, 0x07, 0xef, 0xff, 0xe9, 0xa8, 0xa4, 0x1e, 0xdd, 0x0b,
-	0x6b, 0x7e, 0xc5, 0x93, 0x44, 0x68, 0xe5, 0x6d, 0x98, 0xa9, 0xd8, 0x96, 0x6b, 0x5a, 0x0d, 0xa4,
-	0xe9, 0x58, 0xb3, 0xd0, 0x9e, 0x66, 0x5a, 0xa6, 0x6b, 0xea, 0xae, 0xed, 0x14, 0x86, 0xe7, 0xa5,
-	0xc5, 0xec, 0xb9, 0xd3, 0x41, 0x1b, 0x53, 0x3f, 0x27, 0xca, 0xae, 0x70, 0xbe, 0x4b, 0xf8, 0x16,
-	0xda, 0x2b, 0x0b, 0x26, 0x75, 0xaa, 0x12, 0xdb, 0x2e, 0xdf, 0x84, 0xbc, 0xe8, 0x31, 0x34, 0x1e,
-	0xe0, 0x85, 0x11, 0xaa, 0xc7, 0x7c, 0x70, 0x04, 0xde, 0x49, 0xc6, 0xb8, 0xca, 0x7e, 0xaa, 0x39,
-	0x8f, 0x95, 0xb7, 0xc8, 0xb7, 0x61, 0xaa, 0xaa, 0x63, 0x57, 0xab, 0xd8, 0xb5, 0x7a, 0x15, 0x51,
-	0xcb, 0x38, 0x08, 0x37, 0xaa, 0x6e, 0x21, 0x19, 0x27, 0x93, 0x07, 0x3b, 0x5d, 0xa3, 0x66, 0xd5,
-	0xd6, 0x0d, 0xac, 0x4e, 0x10, 0xfe, 0x15, 0x8f, 0x5d, 0xa5, 0xdc, 0xf2, 0x1b, 0x30, 0xb7, 0x69,
-	0x3a, 0xd8, 0xd5, 0xbc, 0x55, 0x20, 0xf1, 0xac, 0x6d, 0xe8, 0x95, 0x1d, 0x7b, 0x73, 0xb3, 0x90,
-	0xa2, 0xc2, 0x67, 0x22, 0x86, 0xbf, 0xcc, 0xb1, 0x7f, 0x79, 0xf0, 0x07, 0xc4, 0xee, 0x05, 0x2a,
-	0x43, 0xb8, 0xdd, 0xba, 0x8e, 0x77, 0x96, 0x99, 0x00, 0xe5, 0x02, 0x14, 0xdb, 0xb9, 0x24, 0x8b,
-	0x1a, 0x79, 0x12, 0x86, 0x9d, 0x86, 0xd5, 0x8a, 0x83, 0x21, 0xa7, 0x61, 0x95, 0x0d, 0xe5, 0x6f,
-	0x12, 0x4c, 0x5d, 0x43, 0xee, 0xcd, 0x86, 0xab, 0x6f, 0x54, 0xd1, 0x9a, 0xab, 0xbb, 0xa8, 0x8f,
-	0xf8, 0xb9, 0x06, 0x29, 0xcf, 0x9b, 0x78, 0xec, 0x3c, 0xd6, 0xce, 0x42, 0xd1, 0xa9, 0xb5, 0x78,
-	0xe5, 0xf3, 0x30, 0x85, 0xee, 0xd5, 0x51, 0xc5, 0x45, 0x86, 0x66, 0xa1, 0x7b, 0xae, 0x86, 0x76,
-	0x49, 0xc0, 0x98, 0x06, 0x0d, 0x92, 0x84, 0x3a, 0x2e, 0x7a, 0x6f, 0xa1, 0x7b, 0xee, 0x15, 0xd2,
-	0x57, 0x36, 0xe4, 0x33, 0x30, 0x51, 0x69, 0x38, 0x34, 0xb2, 0x36, 0x1c, 0xdd, 0xaa, 0x6c, 0x6b,
-	0xae, 0xbd, 0x83, 0x2c, 0xea, 0xfb, 0x19, 0x55, 0xe6, 0x7d, 0xcb, 0xb4, 0x6b, 0x9d, 0xf4, 0x28,
-	0x3f, 0x4e, 0xc2, 0x74, 0x44, 0x5b, 0x6e, 0xa0, 0x80, 0x2e, 0xd2, 0x01, 0x74, 0x29, 0xc3, 0x68,
-	0x6b, 0x95, 0x9b, 0x75, 0xc4, 0x0d, 0x73, 0xbc, 0x9b, 0xb0, 0xf5, 0x66, 0x1d, 0xa9, 0x99, 0x3d,
-	0xdf, 0x97, 0xac, 0xc0, 0x68, 0x9c, 0x35, 0xd2, 0x96, 0xcf, 0x0a, 0xcf, 0xc2, 0x4c, 0xdd, 0x41,
-	0xbb, 0xa6, 0xdd, 0xc0, 0x1a, 0xc5, 0x1d, 0x64, 0xb4, 0xe8, 0x07, 0x29, 0xfd, 0x94, 0x20, 0x58,
-	0x63, 0xfd, 0x82, 0xf5, 0x34, 0x8c, 0x53, 0x6f, 0x67, 0xae, 0xe9, 0x31, 0x0d, 0x51, 0xa6, 0x1c,
-	0xe9, 0xba, 0x4a, 0x7a, 0x04, 0xf9, 0x0a, 0x00, 0xf5, 0x5a, 0xba, 0xbf, 0xd3, 0x30, 0x8e, 0x68,
-	0xe5, 0x6d, 0xff, 0x44, 0x31, 0xe2, 0xa0, 0x2f, 0x91, 0x0f, 0x35, 0xe5, 0x8a, 0x9f, 0xf2, 0x2a,
-	0xe4, 0xb1, 0x6b, 0x56, 0x76, 0x9a, 0x9a, 0x4f, 0xd6, 0x48, 0x1f, 0xb2, 0xc6, 0x18, 0xbb, 0xd7,
-	0x20, 0x7f, 0x15, 0x9e, 0x88, 0x48, 0xd4, 0x70, 0x65, 0x1b, 0x19, 0x8d, 0x2a, 0xd2, 0x5c, 0x9b,
-	0x59, 0x85, 0x22, 0x9c, 0xdd, 0x70, 0x0b, 0xe9, 0xde, 0x62, 0xed, 0x44, 0x68, 0x98, 0x35, 0x2e,
-	0x70, 0xdd, 0xa6, 0x46, 0x5c, 0x67, 0xd2, 0xe4, 0x12, 0x8c, 0x33, 0xbb, 0x91, 0xc3, 0x02, 0xd2,
-	0x76, 0x91, 0x83, 0x89, 0xff, 0x64, 0x28, 0xfc, 0xe6, 0x69, 0xd7, 0x1a, 0xe9, 0xb9, 0xcd, 0x3a,
-	0xda, 0xfa, 0xec, 0x68, 0x3b, 0x9f, 0x95, 0x5f, 0x85, 0xac, 0xe7, 0x4e, 0x98, 0x78, 0x6c, 0x61,
-	0x8c, 0x02, 0x68, 0xfc, 0xbe, 0xe1, 0xe1, 0x68, 0xc4, 0x45, 0x99, 0xb7, 0x7b, 0xae, 0x49, 0x3f,
-	0xe5, 0x3b, 0x30, 0x16, 0x10, 0xde, 0xc0, 0x85, 0x1c, 0x95, 0x5e, 0x6a, 0x03, 0xcf, 0xb1, 0x62,
-	0x1b, 0x58, 0xcd, 0xfa, 0xe5, 0x36, 0xb0, 0xfc, 0x3a, 0xe4, 0xb9, 0x2d, 0x34, 0x76, 0x90, 0x32,
-	0x11, 0x2e, 0xe4, 0xa9, 0xe9, 0xcf, 0x94, 0x3a, 0x9c, 0x84, 0xc9, 0x18, 0xdc, 0x56, 0xd7, 0x05,
-	0x9f, 0x9a, 0xdb, 0x0d, 0xb5, 0xc8, 0xcf, 0xc3, 0x61, 0x93, 0xb8, 0x7b, 0x78, 0xd9, 0x91, 0x45,
-	0x02, 0xdb, 0x28, 0xc8, 0xf3, 0xd2, 0x62, 0x52, 0x2d, 0x98, 0x78, 0x2d, 0xb8, 0x8a, 0x57, 0x58,
-	0xff, 0x8d, 0xc1, 0x64, 0x32, 0x97, 0xba, 0x31, 0x98, 0x4c, 0xe5, 0xe0, 0xc6, 0x60, 0x12, 0x72,
-	0xe9, 0x1b, 0x83, 0xc9, 0x6c, 0x6e, 0x4c, 0xf9, 0xbb, 0x04, 0xd3, 0xab, 0x76, 0xb5, 0xfa, 0x5f,
-	0x82, 0x87, 0xef, 0x8d, 0x40, 0x21, 0xaa, 0xee, 0x17, 0x80, 0xf8, 0x05, 0x20, 0xee, 0x1b, 0x10,
-	0xdb, 0x39, 0x61, 0xa6, 0x2d, 0xc0, 0xc5, 0x42, 0x45, 0xf6, 0xa1, 0x41, 0xc5, 0x7f, 0x24, 0x7e,
-	0xc6, 0x02, 0xd4, 0x68, 0x2e, 0xab, 0xbc, 0x2d, 0xc1, 0x9c, 0x8a, 0x30, 0x72, 0x43, 0xc0, 0xf6,
-	0x29, 0x80, 0x94, 0x52, 0x84, 0xc3, 0xf1, 0x53, 0x61, 0x00, 0xa2, 0xfc, 0x61, 0x00, 0xe6, 0x55,
-	0x54, 0xb1, 0x1d, 0xc3, 0x7f, 0x64, 0xe5, 0x21, 0xd7, 0xc7, 0x84, 0x5f, 0x06, 0x39, 0x7a, 0x79,
-	0xe9, 0x7f, 0xe6, 0xf9, 0xc8, 0xad, 0x45, 0x3e, 0x0a, 0x69, 0x2f, 0x2e, 0x3c, 0x30, 0x01, 0xd1,
-	0x54, 0x36, 0xe4, 0x69, 0x18, 0xa1, 0x31, 0xe4, 0x21, 0xc7, 0x30, 0xf9, 0x2c, 0x1b, 0xf2, 0x11,
-	0x00, 0x71, 0x31, 0xe5, 0x00, 0x91, 0x52, 0x53, 0xbc, 0xa5, 0x6c, 0xc8, 0x6f, 0x42, 0xa6, 0x6e,
-	0x57, 0xab, 0xde, 0xbd, 0x92, 0x61, 0xc3, 0x73, 0x5d, 0xef, 0x95, 0x04, 0x8c, 0xfd, 0xc6, 0xf2,
-	0xaf, 0xad, 0x9a, 0x26, 0x22, 0xf9, 0x87, 0xf2, 0xcf, 0x11, 0x58, 0xe8, 0x60, 0x5c, 0x8e, 0xe1,
-	0x11, 0xe8, 0x95, 0xf6, 0x0d, 0xbd, 0x1d, 0x61, 0x75, 0xa0, 0x23, 0xac, 0x3e, 0x09, 0xb2, 0xb0,
-	0xa9, 0x11, 0x86, 0xee, 0x9c, 0xd7, 0x23, 0xa8, 0x17, 0x21, 0xd7, 0x06, 0xb6, 0xb3, 0x38, 0x28,
-	0x37, 0xb2, 0x1b, 0x0c, 0x45, 0x77, 0x03, 0xdf, 0x9d, 0x78, 0x38, 0x78, 0x27, 0x7e, 0x06, 0x0a,
-	0x1c, 0x26, 0x7d, 0x37, 0x62, 0x7e, 0x7e, 0x18, 0xa1, 0xe7, 0x87, 0x29, 0xd6, 0xdf, 0xba, 0xe5,
-	0xb2, 0x5e, 0x79, 0xcb, 0xe7, 0x90, 0xcc, 0x3d, 0xc8, 0x75, 0x9e, 0xdd, 0x10, 0x9f, 0xed, 0x06,
-	0x59, 0xeb, 0x8e, 0x6e, 0x61, 0x13, 0x59, 0x81, 0x7b, 0x1c, 0xbd, 0xd3, 0xe7, 0xf6, 0x42, 0x2d,
-	0xf2, 0x16, 0x1c, 0x89, 0xb9, 0xb6, 0xfb, 0xf6, 0x89, 0x54, 0x1f, 0xfb, 0xc4, 0x6c, 0xc4, 0xff,
-	0x5b, 0x5b, 0x46, 0x9b, 0x63, 0x2c, 0xb4, 0x3b, 0xc6, 0x2e, 0x40, 0x26, 0x80, 0xee, 0x69, 0x8a,
-	0xee, 0xe9, 0x0d, 0x1f, 0xac, 0x5f, 0x83, 0x6c, 0x6b, 0xd1, 0x69, 0x7a, 0x21, 0xd3, 0x63, 0x7a,
-	0x61, 0xd4, 0xe3, 0xa3, 0xc9, 0x84, 0x15, 0xc8, 0x08, 0x7f, 0xa0, 0x62, 0x46, 0x7b, 0x14, 0x93,
-	0xe6, 0x5c, 0x54, 0x88, 0x0d, 0x23, 0x77, 0x1b, 0x88, 0x6f, 0x2d, 0x89, 0xc5, 0xf4, 0xb9, 0x2f,
-	0x97, 0x7a, 0xca, 0xc7, 0x96, 0xba, 0xc6, 0x58, 0xe9, 0x25, 0x26, 0xf7, 0x8a, 0xe5, 0x3a, 0x4d,
-	0x55, 0x8c, 0x32, 0xfb, 0x26, 0x64, 0xfc, 0x1d, 0x72, 0x0e, 0x12, 0x3b, 0xa8, 0xc9, 0xe1, 0x8d,
-	0xfc, 0x94, 0x2f, 0xc2, 0xd0, 0xae, 0x5e, 0x6d, 0xb4, 0x39, 0x0e, 0xd1, 0x8c, 0xa6, 0x3f, 0x24,
-	0x89, 0xb4, 0xa6, 0xca, 0x58, 0x2e, 0x0e, 0x3c, 0x23, 0xf9, 0xe0, 0xf5, 0x52, 0xc5, 0x35, 0x77,
-	0x4d, 0xb7, 0xf9, 0x05, 0xbc, 0xf6, 0x00, 0xaf, 0x7e, 0x63, 0xb5, 0x87, 0xd7, 0x6f, 0x0e, 0x0a,
-	0x78, 0x8d, 0x35, 0x2e, 0x87, 0xd7, 0x5b, 0x30, 0x16, 0x02, 0x36, 0x0e, 0xb0, 0x27, 0x82, 0x53,
-	0xf1, 0x85, 0x3f, 0x3b, 0x98, 0x34, 0x29, 0x3c, 0xa9, 0xd9, 0x20, 0xf8, 0x45, 0x5c, 0x7d, 0x60,
-	0x3f, 0xae, 0xee, 0x43, 0xbc, 0x44, 0x10, 0xf1, 0x10, 0x14, 0xc5, 0xd9, 0x8c, 0x37, 0x69, 0xa1,
-	0x10, 0x1d, 0xec, 0x71, 0xc0, 0x39, 0x2e, 0xe7, 0x12, 0x13, 0xb3, 0x16, 0x08, 0xd8, 0x9b, 0x90,
-	0xdf, 0x46, 0xba, 0xe3, 0x6e, 0x20, 0xdd, 0xd5, 0x0c, 0xe4, 0xea, 0x66, 0x15, 0xf3, 0xdc, 0x62,
-	0xf7, 0xfc, 0x59, 0xce, 0x63, 0xbd, 0xcc, 0x38, 0xa3, 0x7b, 0xd8, 0xf0, 0xbe, 0xf7, 0xb0, 0xd3,
-	0x3e, 0x57, 0xf7, 0x42, 0x80, 0x82, 0x7d, 0xaa, 0xe5, 0xbf, 0xb7, 0x44, 0x87, 0xf2, 0xbe, 0x04,
-	0xc7, 0xd8, 0x5a, 0x07, 0x00, 0x80, 0x67, 0xf7, 0xfa, 0x0a, 0x32, 0x1b, 0x72, 0x3c, 0xa7, 0x88,
-	0x42, 0xc9, 0xe6, 0xcb, 0x5d, 0xbd, 0xb6, 0x87, 0x29, 0xa8, 0x63, 0x42, 0xba, 0x70, 0xe0, 0x1f,
-	0x4a, 0x70, 0xbc, 0x33, 0x23, 0xf7, 0x61, 0xdc, 0xda, 0x6e, 0x45, 0x8a, 0x9d, 0x3b, 0xf1, 0xf5,
-	0x87, 0x05, 0x91, 0xe4, 0x8a, 0x12, 0x68, 0x50, 0xde, 0x93, 0x08, 0x76, 0x45, 0x66, 0x77, 0x55,
-	0x37, 0xab, 0x7d, 0x99, 0x75, 0x1b, 0xb2, 0x9b, 0x94, 0x27, 0x64, 0xd4, 0x4b, 0xfb, 0x31, 0x6a,
-	0x60, 0x74, 0x75, 0x74, 0xd3, 0xff, 0xa9, 0x1c, 0x23, 0x78, 0xd0, 0x96, 0x85, 0xab, 0xf5, 0xbe,
-	0x04, 0x4a, 0x14, 0x35, 0xae, 0x0b, 0x8f, 0xee, 0x43, 0xb1, 0xba, 0x3f, 0x86, 0x82, 0xba, 0xad,
-	0xf4, 0xa0, 0x5b, 0xb7, 0x29, 0xf8, 0xc2, 0x4c, 0x28, 0xb8, 0x4a, 0x7c, 0xbd, 0x03, 0x1f, 0x77,
-	0x97, 0xc7, 0x20, 0x57, 0xd1, 0xad, 0x0a, 0xf2, 0xc0, 0x17, 0xb1, 0xf9, 0x27, 0xd5, 0x31, 0xd6,
-	0xae, 0x8a, 0x66, 0x7f, 0xf8, 0xf8, 0x65, 0x7e, 0x4a, 0xe1, 0xd3, 0x69, 0x0a, 0xd1, 0xf0, 0x39,
-	0xe9, 0x45, 0x4f, 0x1b, 0xbe, 0xa8, 0x23, 0xfb, 0x09, 0xff, 0xfd, 0x8e, 0xdc, 0x76, 0xf4, 0xf6,
-	0x8e, 0x1c, 0xc7, 0xc2, 0xd5, 0xfa, 0x39, 0x75, 0xe4, 0xa8, 0xfe, 0x74, 0x85, 0xfb, 0x52, 0xec,
-	0x2b, 0x90, 0x0d, 0xfa, 0x4b, 0x1f, 0x5e, 0xdc, 0x6d, 0x7c, 0x75, 0x34, 0xe0, 0x72, 0xca, 0x89,
-	0x78, 0x7f, 0xf3, 0x98, 0xb8, 0x72, 0xbf, 0x1e, 0x80, 0xe2, 0x9a, 0xb9, 0x65, 0xe9, 0xd5, 0x83,
-	0xbc, 0x1d, 0x6e, 0x42, 0x16, 0x53, 0x21, 0x21, 0xc5, 0xfe, 0xbf, 0xfb, 0xe3, 0x61, 0xc7, 0xb1,
-	0xd5, 0x51, 0x26, 0x56, 0x4c, 0xc5, 0x84, 0x39, 0x74, 0xcf, 0x45, 0x0e, 0x19, 0x29, 0xe6, 0x9c,
-	0x96, 0xe8, 0xf7, 0x9c, 0x36, 0x23, 0xa4, 0x45, 0xba, 0xc8, 0x2d, 0xa0, 0xb2, 0x6d, 0x56, 0x8d,
-	0xd6, 0x38, 0xb6, 0x55, 0x6d, 0xd2, 0x43, 0x41, 0x52, 0xcd, 0xd3, 0x2e, 0xc1, 0xf4, 0xa2, 0x55,
-	0x6d, 0x2a, 0x0b, 0x70, 0xb4, 0xad, 0x2e, 0xdc, 0xd6, 0xbf, 0x93, 0xe0, 0x14, 0xa7, 0x31, 0xdd,
-	0xed, 0x03, 0x3f, 0xd8, 0x7e, 0x4b, 0x82, 0x19, 0x6e, 0xf5, 0x3d, 0xd3, 0xdd, 0xd6, 0xe2, 0x5e,
-	0x6f, 0xaf, 0xf7, 0xba, 0x00, 0xdd, 0x26, 0xa4, 0x4e, 0xe1, 0x20, 0xa1, 0xf0, 0xb3, 0x4b, 0xb0,
-	0xd8, 0x5d, 0x44, 0xe7, 0x77, 0xb7, 0x5f, 0x49, 0x70, 0x54, 0x45, 0x35, 0x7b, 0x17, 0x31, 0x49,
-	0xfb, 0x4c, 0x38, 0x3f, 0xba, 0xb3, 0x7b, 0xf0, 0x04, 0x9e, 0x08, 0x9d, 0xc0, 0x15, 0x85, 0xc0,
-	0x5e, 0xbb, 0xe9, 0xf3, 0xb5, 0xff, 0x85, 0x04, 0x0b, 0xeb, 0xc8, 0xa9, 0x99, 0x96, 0xee, 0xa2,
-	0x83, 0xac, 0xba, 0x0d, 0x79, 0x57, 0xc8, 0x09, 0x2d, 0xf6, 0x72, 0xd7, 0xc5, 0xee, 0x3a, 0x03,
-	0x35, 0xe7, 0x09, 0x17, 0x0b, 0x7c, 0x1c, 0x94, 0x4e, 0x6c, 0x5c, 0xbf, 0x9f, 0x4a, 0x70, 0x84,
-	0x26, 0xc0, 0x0e, 0x58, 0x82, 0xe0, 0x10, 0x19, 0x7d, 0x97, 0x20, 0x74, 0x1c, 0x59, 0xcd, 0x50,
-	0xa1, 0x42, 0x9f, 0x0b, 0x50, 0x6c, 0x47, 0xde, 0xd9, 0x4d, 0xbf, 0x9f, 0x80, 0x13, 0x5c, 0x08,
-	0x83, 0xd1, 0x83, 0xa8, 0x5a, 0x6b, 0xb3, 0x15, 0x5c, 0xed, 0x41, 0xd7, 0x1e, 0xa6, 0x10, 0xda,
-	0x0d, 0xe4, 0xe7, 0x7c, 0xc0, 0xc9, 0xab, 0x0f, 0xa2, 0xe9, 0xa7, 0x82, 0x20, 0x29, 0x0b, 0x0a,
-	0x91, 0x38, 0xea, 0x82, 0xbb, 0x83, 0x8f, 0x1e, 0x77, 0x87, 0xda, 0xe1, 0xee, 0x22, 0x9c, 0xec,
-	0x66, 0x11, 0xee, 0xa2, 0xbf, 0x95, 0x60, 0x4e, 0x5c, 0xce, 0xfc, 0xe7, 0xd6, 0xcf, 0x04, 0xc4,
-	0x9c, 0x87, 0x29, 0x13, 0x6b, 0x31, 0x75, 0x11, 0x74, 0x6d, 0x92, 0xea, 0xb8, 0x89, 0xaf, 0x86,
-	0x0b, 0x1e, 0x94, 0x22, 0x1c, 0x8e, 0x57, 0x88, 0x6b, 0xfc, 0x8f, 0x01, 0x72, 0x72, 0x23, 0xe7,
-	0xd8, 0x15, 0x62, 0x37, 0x6f, 0xb4, 0xfd, 0x9c, 0x3a, 0x1f, 0x9d, 0xea, 0x0b, 0x90, 0x69, 0xb9,
-	0x64, 0xeb, 0x19, 0xcb, 0x6b, 0x2b, 0x1b, 0xf2, 0x2b, 0x30, 0x2e, 0x0e, 0xa5, 0xc6, 0x41, 0xfc,
-	0x4e, 0xf6, 0xa4, 0xb4, 0x86, 0x5f, 0xf5, 0x8e, 0xd3, 0x34, 0xe9, 0x49, 0x13, 0x17, 0x43, 0xfd,
-	0x24, 0x2e, 0xc6, 0x5a, 0xec, 0xb4, 0x41, 0x39, 0x45, 0x70, 0xa2, 0xa3, 0xd5, 0xf9, 0xfa, 0xfc,
-	0x44, 0x82, 0xf9, 0xcb, 0x08, 0x57, 0x1c, 0x73, 0xe3, 0x40, 0x7b, 0xc2, 0xab, 0x30, 0xd2, 0xef,
-	0x49, 0xb9, 0xdb, 0xb0, 0xaa, 0x90, 0xa8, 0xbc, 0x9b, 0x80, 0x85, 0x0e, 0xd4, 0x1c, 0x33, 0x5f,
-	0x83, 0x5c, 0x2b, 0x29, 0x5b, 0xb1, 0xad, 0x4d, 0x73, 0x8b, 0xdf, 0x9c, 0xcf, 0xc6, 0xcf, 0x25,
-	0x76, 0x81, 0x56, 0x28, 0xa3, 0x3a, 0x86, 0x82, 0x0d, 0xf2, 0x16, 0x4c, 0xc7, 0xe4, 0x7e, 0x69,
-	0xa6, 0x99, 0x29, 0xbc, 0xd4, 0xc7, 0x20, 0x34, 0xbf, 0x3c, 0xb9, 0x17, 0xd7, 0x2c, 0xbf, 0x06,
-	0x72, 0x1d, 0x59, 0x86, 0x69, 0x6d, 0x69, 0x3a, 0x3b, 0x36, 0x9b, 0x08, 0x17, 0x12, 0x34, 0x4b,
-	0x7a, 0xba, 0xfd, 0x18, 0xab, 0x8c, 0x47, 0x9c, 0xb4, 0xe9, 0x08, 0xf9, 0x7a, 0xa0, 0xd1, 0x44,
-	0x58, 0x7e, 0x03, 0x72, 0x42, 0x3a, 0x05, 0x32, 0x87, 0x3e, 0x48, 0x13, 0xd9, 0xe7, 0xbb, 0xca,
-	0x0e, 0xfa, 0x12, 0x1d, 0x61, 0xac, 0xee, 0xeb, 0x72, 0x90, 0xa5, 0x7c, 0x23, 0x01, 0x05, 0x95,
-	0x17, 0x27, 0x22, 0xea, 0x8b, 0xf8, 0xf6, 0xb9, 0xcf, 0x44, 0x8c, 0x6f, 0xc2, 0x64, 0xf0, 0x5d,
-	0xb3, 0xa9, 0x99, 0x2e, 0xaa, 0x09, 0xd3, 0x9e, 0xeb, 0xeb, 0x6d, 0xb3, 0x59, 0x76, 0x51, 0x4d,
-	0x1d, 0xdf, 0x8d, 0xb4, 0x61, 0xf9, 0x19, 0x18, 0xa6, 0x11, 0x8c, 0x39, 0x36, 0xb4, 0xcd, 0xb1,
-	0x5d, 0xd6, 0x5d, 0x7d, 0xb9, 0x6a, 0x6f, 0xa8, 0x9c, 0x5e, 0xbe, 0x0a, 0x59, 0x0b, 0xed, 0x69,
-	0x64, 0xe3, 0xe7, 0x12, 0x86, 0x7a, 0x94, 0x90, 0xb1, 0xd0, 0x9e, 0xda, 0x60, 0xb1, 0x8f, 0x95,
-	0x39, 0x98, 0x89, 0x59, 0x02, 0x1e, 0xf0, 0x3f, 0x92, 0x60, 0x6a, 0xad, 0x69, 0x55, 0xd6, 0xb6,
-	0x75, 0xc7, 0xe0, 0xaf, 0x9d, 0x7c, 0x79, 0x4e, 0x40, 0x16, 0xdb, 0x0d, 0xa7, 0x82, 0xb4, 0x4a,
-	0xb5, 0x81, 0x5d, 0xe4, 0xf0, 0x05, 0x1a, 0x65, 0xad, 0x2b, 0xac, 0x51, 0x9e, 0x81, 0x24, 0x26,
-	0xcc, 0xad, 0x87, 0xa6, 0x11, 0xfa, 0x5d, 0x36, 0xe4, 0x4b, 0x90, 0x66, 0xcf, 0xae, 0x2c, 0x7d,
-	0x99, 0xe8, 0x31, 0x7d, 0x09, 0x8c, 0x89, 0x34, 0x2b, 0x33, 0x30, 0x1d, 0x99, 0x9e, 0xb8, 0xbc,
-	0x0c, 0xc1, 0x38, 0xe9, 0x13, 0x3e, 0xde, 0x87, 0x5b, 0x1d, 0x85, 0xb4, 0xe7, 0x56, 0x7c, 0xda,
-	0x29, 0x15, 0x44, 0x53, 0xd9, 0xf0, 0x1d, 0xb8, 0x12, 0xbe, 0x03, 0x97, 0x5c, 0x80, 0x11, 0xf1,
-	0xf8, 0xc2, 0x32, 0xe2, 0xe2, 0x93, 0x0c, 0xda, 0x4a, 0xd6, 0xb6, 0xde, 0xba, 0xbc, 0x36, 0xfa,
-	0xb2, 0x1b, 0x7e, 0x72, 0x19, 0xde, 0xdf, 0x93, 0xcb, 0x11, 0x00, 0x91, 0x13, 0x34, 0xd9, 0x63,
-	0x58, 0x42, 0x4d, 0xf1, 0x16, 0x5a, 0xf7, 0x10, 0x4c, 0x53, 0x27, 0xf7, 0x93, 0xa6, 0x5e, 0xe5,
-	0xb5, 0x16, 0xad, 0x34, 0x17, 0x95, 0x95, 0xea, 0x51, 0x56, 0x9e, 0x30, 0x7b, 0xe9, 0x29, 0x2a,
-	0xf1, 0x22, 0x8c, 0x88, 0x6c, 0x33, 0xf4, 0x98, 0x6d, 0x16, 0x0c, 0xfe, 0xa4, 0x79, 0x3a, 0x98,
-	0x34, 0x5f, 0x81, 0x0c, 0xab, 0x09, 0xe1, 0xc5, 0xa5, 0x99, 0x1e, 0x8b, 0x4b, 0xd3, 0xb4, 0x5c,
-	0x84, 0xd7, 0x95, 0x9e, 0x01, 0x5a, 0x17, 0x4a, 0x8f, 0x39, 0xc8, 0xd1, 0x4c, 0x03, 0x59, 0xae,
-	0xe9, 0x36, 0xe9, 0x5b, 0x56, 0x4a, 0x95, 0x49, 0xdf, 0x1d, 0xda, 0x55, 0xe6, 0x3d, 0xf2, 0x1d,
-	0x18, 0x0b, 0xa1, 0x07, 0xaf, 0x89, 0x28, 0xf5, 0x87, 0x1b, 0x6a, 0x36, 0x88, 0x19, 0xca, 0x14,
-	0x4c, 0x04, 0x7d, 0x9a, 0x3b, 0xfb, 0xdb, 0x12, 0xcc, 0x89, 0x3d, 0xef, 0x53, 0x2e, 0x7f, 0x52,
-	0x7e, 0x29, 0xc1, 0xe1, 0xf8, 0xb9, 0xf0, 0xad, 0x97, 0x9c, 0x98, 0xf5, 0xca, 0x36, 0xd2, 0x6a,
-	0xac, 0x97, 0x57, 0x76, 0xb0, 0x39, 0xe5, 0x69, 0x97, 0x9f, 0x4f, 0x7e, 0x0a, 0xa6, 0x0c, 0xdd,
-	0xd5, 0x37, 0x74, 0x1c, 0x66, 0x61, 0x91, 0x39, 0x21, 0x7a, 0x03, 0x5c, 0xd3, 0x30, 0xe2, 0x3a,
-	0x08, 0xb5, 0x82, 0x74, 0x98, 0x7c, 0x96, 0x0d, 0x79, 0x0e, 0x52, 0xfc, 0xf9, 0x93, 0xbf, 0x5c,
-	0xa5, 0xd4, 0x24, 0x6b, 0x28, 0x1b, 0xca, 0xef, 0x25, 0x98, 0x15, 0x93, 0xe7, 0x46, 0xbf, 0x6e,
-	0x63, 0x7f, 0xf2, 0x77, 0xdb, 0xc6, 0xae, 0xa6, 0x1b, 0x86, 0x83, 0x30, 0x16, 0x76, 0x24, 0x6d,
-	0x97, 0x58, 0x53, 0x04, 0xf0, 0x86, 0x5a, 0x80, 0x17, 0x5e, 0x85, 0x44, 0xaf, 0x3b, 0xda, 0xe0,
-	0xc1, 0x77, 0x34, 0xe5, 0xfe, 0x40, 0xcb, 0x45, 0x02, 0x9a, 0xf1, 0x55, 0x39, 0x06, 0xa3, 0x74,
-	0x9e, 0x58, 0xb3, 0x1a, 0xb5, 0x0d, 0x0e, 0xe7, 0x43, 0x6a, 0x86, 0x35, 0xde, 0xa2, 0x6d, 0xc4,
-	0x76, 0x42, 0x39, 0x5c, 0x18, 0x98, 0x4f, 0x2c, 0x0e, 0xa9, 0x49, 0xae, 0x1d, 0x96, 0x5f, 0x87,
-	0xb1, 0x96, 0x7a, 0x74, 0x19, 0x3b, 0x56, 0xc9, 0x7b, 0xb4, 0x44, 0x05, 0xef, 0xdd, 0x66, 0x85,
-	0xf0, 0xd1, 0xd3, 0x42, 0xd6, 0x0a, 0xb4, 0xc9, 0x4f, 0xc3, 0x34, 0x1b, 0xbb, 0x62, 0x5b, 0xae,
-	0x63, 0x57, 0xab, 0xc8, 0x11, 0x65, 0x3b, 0x6c, 0x15, 0x27, 0x69, 0xf7, 0x8a, 0xd7, 0xcb, 0xab,
-	0x19, 0x09, 0x3a, 0xf0, 0xe5, 0x62, 0x6f, 0x91, 0xe2, 0x53, 0x29, 0x41, 0x7e, 0xa5, 0x6a, 0x63,
-	0x44, 0xb7, 0x0f, 0xb1, 0xc4, 0xfe, 0xf5, 0x93, 0x02, 0xeb, 0xa7, 0x4c, 0x80, 0xec, 0xa7, 0x17,
-	0x95, 0x32, 0x12, 0xe4, 0x59, 0x3a, 0xc5, 0x7f, 0x39, 0x6b, 0x2f, 0x46, 0xbe, 0x0a, 0x49, 0xb2,
-	0xd9, 0x6e, 0x11, 0x58, 0x18, 0xa0, 0x05, 0x47, 0x8f, 0x77, 0x2e, 0x67, 0x62, 0x89, 0x50, 0xc6,
-	0xa1, 0x7a, 0xbc, 0xfe, 0x07, 0xd8, 0x44, 0xe0, 0x01, 0xb6, 0x0c, 0x63, 0xbb, 0x26, 0x36, 0x37,
-	0xcc, 0xaa, 0xe9, 0x36, 0xfb, 0x7b, 0x1b, 0xcc, 0xb6, 0x18, 0xe9, 0x06, 0x3b, 0x01, 0xb2, 0x5f,
-	0x37, 0xae, 0xf2, 0x7d, 0x09, 0x8e, 0x5c, 0x43, 0xae, 0xda, 0xfa, 0x5f, 0xc9, 0x4d, 0xf6, 0x9f,
-	0x12, 0xef, 0x74, 0xf0, 0x02, 0x0c, 0xd3, 0xe2, 0x02, 0x12, 0x22, 0x89, 0xb6, 0x2e, 0xe0, 0xfb,
-	0x63, 0x0a, 0xcb, 0x14, 0x78, 0x9f, 0xb4, 0x0c, 0x41, 0xe5, 0x32, 0x48, 0xe0, 0xf0, 0x43, 0x06,
-	0x7d, 0xf9, 0xe3, 0x71, 0x9f, 0xe6, 0x6d, 0xc4, 0x77, 0x94, 0x77, 0x06, 0xa0, 0xd8, 0x6e, 0x4a,
-	0xdc, 0xc3, 0xbf, 0x06, 0x59, 0xb6, 0x24, 0xfc, 0x0f, 0x30, 0x62, 0x6e, 0x2f, 0xf7, 0xf8, 0x54,
-	0xd6, 0x59, 0x7c, 0x89, 0x7a, 0x85, 0x68, 0x65, 0x05, 0x05, 0x2c, 0xa2, 0x44, 0xdb, 0x6c, 0x13,
-	0xe4, 0x28, 0x91, 0xbf, 0xb8, 0x60, 0x88, 0x15, 0x17, 0xdc, 0x0c, 0x16, 0x17, 0x5c, 0xe8, 0xd3,
-	0x76, 0xde, 0xcc, 0x7c, 0xf5, 0x06, 0x6f, 0xc1, 0xfc, 0x35, 0xe4, 0x5e, 0x7e, 0xe1, 0xa5, 0x0e,
-	0x6b, 0x76, 0x9b, 0x57, 0x44, 0x92, 0x6b, 0x8a, 0xb0, 0x4d, 0xbf, 0x63, 0x7b, 0xf5, 0x30, 0xb4,
-	0x48, 0x92, 0xfc, 0xc2, 0xca, 0xb7, 0x25, 0x58, 0xe8, 0x30, 0x38, 0x5f, 0x9d, 0x37, 0x21, 0xef,
-	0x13, 0x4b, 0x53, 0x09, 0x62, 0x12, 0xe7, 0xf7, 0x31, 0x09, 0x35, 0xe7, 0x04, 0x1b, 0xb0, 0xf2,
-	0x1d, 0x09, 0x26, 0x68, 0x21, 0x86, 0xc0, 0xcb, 0x3e, 0x76, 0xc7, 0x17, 0xc3, 0x37, 0xd6, 0xff,
-	0xe9, 0x7a, 0x63, 0x8d, 0x1b, 0xaa, 0x75, 0x4b, 0xdd, 0x81, 0xc9, 0x10, 0x01, 0xb7, 0x83, 0x0a,
-	0xc9, 0xd0, 0x53, 0xee, 0xd3, 0xfd, 0x0e, 0xc5, 0x1f, 0x6e, 0x3d, 0x39, 0xca, 0xf7, 0x24, 0x98,
-	0x50, 0x91, 0x5e, 0xaf, 0x57, 0x59, 0x0a, 0x00, 0xf7, 0xa1, 0xf9, 0x5a, 0x58, 0xf3, 0xf8, 0x22,
-	0x29, 0xff, 0x3f, 0xbf, 0xd8, 0x72, 0x44, 0x87, 0x6b, 0x69, 0x3f, 0x0d, 0x93, 0x21, 0x02, 0x3e,
-	0xd3, 0x9f, 0x0d, 0xc0, 0x24, 0xf3, 0x95, 0xb0, 0x77, 0x5e, 0x81, 0x41, 0xaf, 0x08, 0x2e, 0xeb,
-	0xbf, 0xa4, 0xc7, 0x21, 0xe6, 0x65, 0xa4, 0x1b, 0x2f, 0x20, 0xd7, 0x45, 0x0e, 0xad, 0x12, 0xa1,
-	0xd5, 0x04, 0x94, 0xbd, 0xd3, 0xf6, 0x1c, 0xbd, 0xd1, 0x24, 0xe2, 0x6e, 0x34, 0x17, 0xa0, 0x60,
-	0x5a, 0x84, 0xc2, 0xdc, 0x45, 0x1a, 0xb2, 0x3c, 0x38, 0x69, 0x15, 0xc2, 0x4c, 0x7a, 0xfd, 0x57,
-	0x2c, 0x11, 0xec, 0x65, 0x43, 0x7e, 0x1c, 0xf2, 0x35, 0xfd, 0x9e, 0x59, 0x6b, 0xd4, 0xb4, 0x3a,
-	0xa1, 0xc7, 0xe6, 0x5b, 0xec, 0x6f, 0x5b, 0x43, 0xea, 0x18, 0xef, 0x58, 0xd5, 0xb7, 0xd0, 0x9a,
-	0xf9, 0x16, 0x92, 0x4f, 0xc2, 0x18, 0xad, 0x8e, 0xa3, 0x84, 0xac, 0x4c, 0x6b, 0x98, 0x96, 0x69,
-	0xd1, 0xa2, 0x39, 0x42, 0xc6, 0x8a, 0xc0, 0xff, 0xca, 0xfe, 0x02, 0x14, 0xb0, 0x17, 0x77, 0xa4,
-	0x87, 0x64, 0xb0, 0xd8, 0xb8, 0x1c, 0x78, 0x88, 0x71, 0x19, 0xa7, 0x6b, 0x22, 0x4e, 0xd7, 0x3f,
-	0x4a, 0x30, 0xbd, 0xda, 0x70, 0xb6, 0xd0, 0xe7, 0xd1, 0x3b, 0x94, 0x59, 0x28, 0x44, 0x95, 0x13,
-	0x0f, 0xd5, 0x03, 0x30, 0x7d, 0x13, 0x7d, 0x4e, 0x35, 0x7f, 0x24, 0x71, 0xb1, 0x0c, 0x85, 0xa8,
-	0xc1, 0x78, 0x60, 0xc4, 0xc8, 0x90, 0xe2, 0x64, 0xbc, 0x43, 0xcb, 0xb5, 0x37, 0x1d, 0x84, 0xb7,
-	0xfd, 0xd9, 0xea, 0x7e, 0xc0, 0xf3, 0x95, 0x30, 0x78, 0x7e, 0xa9, 0x47, 0xf0, 0x6c, 0x3b, 0x6a,
-	0x0b, 0x43, 0x69, 0x05, 0x77, 0x1c, 0x1d, 0x53, 0x73, 0xb9, 0xfe, 0xc1, 0x47, 0xc5, 0x43, 0x1f,
-	0x7e, 0x54, 0x3c, 0xf4, 0xc9, 0x47, 0x45, 0xe9, 0xeb, 0x0f, 0x8a, 0xd2, 0xbb, 0x0f, 0x8a, 0xd2,
-	0x6f, 0x1e, 0x14, 0xa5, 0x0f, 0x1e, 0x14, 0xa5, 0x3f, 0x3f, 0x28, 0x4a, 0x7f, 0x79, 0x50, 0x3c,
-	0xf4, 0xc9, 0x83, 0xa2, 0x74, 0xff, 0xe3, 0xe2, 0xa1, 0x0f, 0x3e, 0x2e, 0x1e, 0xfa, 0xf0, 0xe3,
-	0xe2, 0xa1, 0x57, 0x2e, 0x6e, 0xd9, 0xad, 0x29, 0x9a, 0x76, 0xc7, 0x7f, 0xcb, 0xff, 0x6f, 0xb0,
-	0x65, 0x63, 0x98, 0x1e, 0x2b, 0xcf, 0xff, 0x2b, 0x00, 0x00, 0xff, 0xff, 0x09, 0x46, 0x36, 0xec,
-	0x6c, 0x3f, 0x00, 0x00,
+func (m *DeleteWorkflowExecutionRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
-
-func (this *StartWorkflowExecutionRequest) Equal(that interface{}) bool {
-	if that == nil {
-		return this == nil
-	}
-
-	that1, ok := that.(*StartWorkflowExecutionRequest)
-	if !ok {
-		that2, ok := that.(StartWorkflowExecutionRequest)
-		if ok {
-			that1 = &that2
-		} else {
-			return false
-		}
-	}
-	if that1 == nil {
-		return this == nil
-	} else if this == nil {
-		return false
-	}
-	if this.NamespaceId != that1.NamespaceId {
-		return false
-	}
-	if !this.StartRequest.Equal(that1.StartRequest) {
-		return false
-	}
-	if !this.ParentExecutionInfo.Equal(that1.ParentExecutionInfo) {
-		return false
-	}
-	if this.Attempt != that1.Attempt {
-		return false
-	}
-	if that1.WorkflowExecutionExpirationTime == nil {
-		if this.WorkflowExecutionExpirationTime != nil {
-			return false
+func (m *DeleteWorkflowExecutionRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_DeleteWorkflowExecutionRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
-	} else if !this.WorkflowExecutionExpirationTime.Equal(*that1.WorkflowExecutionExpirationTime) {
-		return false
+		return b[:n], nil
 	}
-	if this.ContinueAsNewInitiator != that1.ContinueAsNewInitiator {
-		return false
+}
+func (m *DeleteWorkflowExecutionRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DeleteWorkflowExecutionRequest.Merge(m, src)
+}
+func (m *DeleteWorkflowExecutionRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *DeleteWorkflowExecutionRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_DeleteWorkflowExecutionRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DeleteWorkflowExecutionRequest proto.InternalMessageInfo
+
+func (m *DeleteWorkflowExecutionRequest) GetNamespaceId() string {
+	if m != nil {
+		return m.NamespaceId
 	}
-	if !this.ContinuedFailure.Equal(that1.ContinuedFailure) {
-		return false
+	return ""
+}
+
+func (m *DeleteWorkflowExecutionRequest) GetWorkflowExecution() *v14.WorkflowExecution {
+	if m != nil {
+		return m.WorkflowExecution
 	}
-	if !this.LastCompletionResult.Equal(that1.LastCompletionResult) {
-		return false
+	return nil
+}
+
+func (m *DeleteWorkflowExecutionRequest) GetIdentity() string {
+	if m != nil {
+		return m.Identity
 	}
-	if this.FirstWorkflowTaskBackoff != nil && that1.FirstWorkflowTaskBackoff != nil {
-		if *this.FirstWorkflowTaskBackoff != *that1.FirstWorkflowTaskBackoff {
-			return false
+	return ""
+}
+
+type DeleteWorkflowExecutionResponse struct {
+}
+
+func (m *DeleteWorkflowExecutionResponse) Reset()      { *m = DeleteWorkflowExecutionResponse{} }
+func (*DeleteWorkflowExecutionResponse) ProtoMessage() {}
+func (*DeleteWorkflowExecutionResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b8c78c1d460a3711, []int{74}
+}
+func (m *DeleteWorkflowExecutionResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *DeleteWorkflowExecutionResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_DeleteWorkflowExecutionResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
-	} else if this.FirstWorkflowTaskBackoff != nil {
-		return false
-	} else if that1.FirstWorkflowTaskBackoff != nil {
-		return false
+		return b[:n], nil
 	}
-	return true
 }
-func (this *StartWorkflowExecutionResponse) Equal(that interface{}) bool {
-	if that == nil {
-		return this == nil
-	}
+func (m *DeleteWorkflowExecutionResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DeleteWorkflowExecutionResponse.Merge(m, src)
+}
+func (m *DeleteWorkflowExecutionResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *DeleteWorkflowExecutionResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_DeleteWorkflowExecutionResponse.DiscardUnknown(m)
+}
 
-	that1, ok := that.(*StartWorkflowExecutionResponse)
-	if !ok {
-		that2, ok := that.(StartWorkflowExecutionResponse)
-		if ok {
-			that1 = &that2
-		} else {
-			return false
+var xxx_messageInfo_DeleteWorkflowExecutionResponse proto.InternalMessageInfo
+
+type UpdateWorkflowExecutionRequest struct {
+	NamespaceId       string                 `protobuf:"bytes,1,opt,name=namespace_id,json=namespaceId,proto3" json:"namespace_id,omitempty"`
+	WorkflowExecution *v14.WorkflowExecution `protobuf:"bytes,2,opt,name=workflow_execution,json=workflowExecution,proto3" json:"workflow_execution,omitempty"`
+	// Callers sending the same update id while the update is in flight share its outcome.
+	UpdateId string        `protobuf:"bytes,3,opt,name=update_id,json=updateId,proto3" json:"update_id,omitempty"`
+	Name     string        `protobuf:"bytes,4,opt,name=name,proto3" json:"name,omitempty"`
+	Input    *v14.Payloads `protobuf:"bytes,5,opt,name=input,proto3" json:"input,omitempty"`
+	Identity string        `protobuf:"bytes,6,opt,name=identity,proto3" json:"identity,omitempty"`
+	// Return as soon as the workflow accepted the update instead of waiting until it is completed.
+	WaitForAccepted bool `protobuf:"varint,7,opt,name=wait_for_accepted,json=waitForAccepted,proto3" json:"wait_for_accepted,omitempty"`
+}
+
+func (m *UpdateWorkflowExecutionRequest) Reset()      { *m = UpdateWorkflowExecutionRequest{} }
+func (*UpdateWorkflowExecutionRequest) ProtoMessage() {}
+func (*UpdateWorkflowExecutionRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b8c78c1d460a3711, []int{75}
+}
+func (m *UpdateWorkflowExecutionRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *UpdateWorkflowExecutionRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_UpdateWorkflowExecutionRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	if that1 == nil {
-		return this == nil
-	} else if this == nil {
-		return false
-	}
-	if this.RunId != that1.RunId {
-		return false
-	}
-	return true
 }
-func (this *GetMutableStateRequest) Equal(that interface{}) bool {
-	if that == nil {
-		return this == nil
-	}
+func (m *UpdateWorkflowExecutionRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_UpdateWorkflowExecutionRequest.Merge(m, src)
+}
+func (m *UpdateWorkflowExecutionRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *UpdateWorkflowExecutionRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_UpdateWorkflowExecutionRequest.DiscardUnknown(m)
+}
 
-	that1, ok := that.(*GetMutableStateRequest)
-	if !ok {
-		that2, ok := that.(GetMutableStateRequest)
-		if ok {
-			that1 = &that2
-		} else {
-			return false
-		}
+var xxx_messageInfo_UpdateWorkflowExecutionRequest proto.InternalMessageInfo
+
+func (m *UpdateWorkflowExecutionRequest) GetNamespaceId() string {
+	if m != nil {
+		return m.NamespaceId
 	}
-	if that1 == nil {
-		return this == nil
-	} else if this == nil {
-		return false
+	return ""
+}
+
+func (m *UpdateWorkflowExecutionRequest) GetWorkflowExecution() *v14.WorkflowExecution {
+	if m != nil {
+		return m.WorkflowExecution
 	}
-	if this.NamespaceId != that1.NamespaceId {
-		return false
+	return nil
+}
+
+func (m *UpdateWorkflowExecutionRequest) GetUpdateId() string {
+	if m != nil {
+		return m.UpdateId
 	}
-	if !this.Execution.Equal(that1.Execution) {
-		return false
+	return ""
+}
+
+func (m *UpdateWorkflowExecutionRequest) GetName() string {
+	if m != nil {
+		return m.Name
 	}
-	if this.ExpectedNextEventId != that1.ExpectedNextEventId {
-		return false
+	return ""
+}
+
+func (m *UpdateWorkflowExecutionRequest) GetInput() *v14.Payloads {
+	if m != nil {
+		return m.Input
 	}
-	if !bytes.Equal(this.CurrentBranchToken, that1.CurrentBranchToken) {
-		return false
+	return nil
+}
+
+func (m *UpdateWorkflowExecutionRequest) GetIdentity() string {
+	if m != nil {
+		return m.Identity
 	}
-	return true
+	return ""
 }
-func (this *GetMutableStateResponse) Equal(that interface{}) bool {
-	if that == nil {
-		return this == nil
+
+func (m *UpdateWorkflowExecutionRequest) GetWaitForAccepted() bool {
+	if m != nil {
+		return m.WaitForAccepted
 	}
+	return false
+}
 
-	that1, ok := that.(*GetMutableStateResponse)
-	if !ok {
-		that2, ok := that.(GetMutableStateResponse)
-		if ok {
-			that1 = &that2
-		} else {
-			return false
+type UpdateWorkflowExecutionResponse struct {
+	// Whether the update was completed, false if it was only accepted.
+	Completed bool          `protobuf:"varint,1,opt,name=completed,proto3" json:"completed,omitempty"`
+	Result    *v14.Payloads `protobuf:"bytes,2,opt,name=result,proto3" json:"result,omitempty"`
+	// Set if the workflow rejected the update or failed to complete it.
+	Failure *v13.Failure `protobuf:"bytes,3,opt,name=failure,proto3" json:"failure,omitempty"`
+}
+
+func (m *UpdateWorkflowExecutionResponse) Reset()      { *m = UpdateWorkflowExecutionResponse{} }
+func (*UpdateWorkflowExecutionResponse) ProtoMessage() {}
+func (*UpdateWorkflowExecutionResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b8c78c1d460a3711, []int{76}
+}
+func (m *UpdateWorkflowExecutionResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *UpdateWorkflowExecutionResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_UpdateWorkflowExecutionResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	if that1 == nil {
-		return this == nil
-	} else if this == nil {
-		return false
+}
+func (m *UpdateWorkflowExecutionResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_UpdateWorkflowExecutionResponse.Merge(m, src)
+}
+func (m *UpdateWorkflowExecutionResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *UpdateWorkflowExecutionResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_UpdateWorkflowExecutionResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_UpdateWorkflowExecutionResponse proto.InternalMessageInfo
+
+func (m *UpdateWorkflowExecutionResponse) GetCompleted() bool {
+	if m != nil {
+		return m.Completed
 	}
-	if !this.Execution.Equal(that1.Execution) {
-		return false
+	return false
+}
+
+func (m *UpdateWorkflowExecutionResponse) GetResult() *v14.Payloads {
+	if m != nil {
+		return m.Result
 	}
-	if !this.WorkflowType.Equal(that1.WorkflowType) {
-		return false
-	}
-	if this.NextEventId != that1.NextEventId {
-		return false
-	}
-	if this.PreviousStartedEventId != that1.PreviousStartedEventId {
-		return false
-	}
-	if this.LastFirstEventId != that1.LastFirstEventId {
-		return false
-	}
-	if !this.TaskQueue.Equal(that1.TaskQueue) {
-		return false
-	}
-	if !this.StickyTaskQueue.Equal(that1.StickyTaskQueue) {
-		return false
-	}
-	if this.StickyTaskQueueScheduleToStartTimeout != nil && that1.StickyTaskQueueScheduleToStartTimeout != nil {
-		if *this.StickyTaskQueueScheduleToStartTimeout != *that1.StickyTaskQueueScheduleToStartTimeout {
-			return false
-		}
-	} else if this.StickyTaskQueueScheduleToStartTimeout != nil {
-		return false
-	} else if that1.StickyTaskQueueScheduleToStartTimeout != nil {
-		return false
-	}
-	if this.EventStoreVersion != that1.EventStoreVersion {
-		return false
-	}
-	if !bytes.Equal(this.CurrentBranchToken, that1.CurrentBranchToken) {
-		return false
-	}
-	if this.WorkflowState != that1.WorkflowState {
-		return false
-	}
-	if this.WorkflowStatus != that1.WorkflowStatus {
-		return false
-	}
-	if !this.VersionHistories.Equal(that1.VersionHistories) {
-		return false
-	}
-	if this.IsStickyTaskQueueEnabled != that1.IsStickyTaskQueueEnabled {
-		return false
-	}
-	return true
+	return nil
 }
-func (this *PollMutableStateRequest) Equal(that interface{}) bool {
-	if that == nil {
-		return this == nil
-	}
 
-	that1, ok := that.(*PollMutableStateRequest)
-	if !ok {
-		that2, ok := that.(PollMutableStateRequest)
-		if ok {
-			that1 = &that2
-		} else {
-			return false
-		}
-	}
-	if that1 == nil {
-		return this == nil
-	} else if this == nil {
-		return false
-	}
-	if this.NamespaceId != that1.NamespaceId {
-		return false
-	}
-	if !this.Execution.Equal(that1.Execution) {
-		return false
-	}
-	if this.ExpectedNextEventId != that1.ExpectedNextEventId {
-		return false
-	}
-	if !bytes.Equal(this.CurrentBranchToken, that1.CurrentBranchToken) {
-		return false
+func (m *UpdateWorkflowExecutionResponse) GetFailure() *v13.Failure {
+	if m != nil {
+		return m.Failure
 	}
-	return true
+	return nil
 }
-func (this *PollMutableStateResponse) Equal(that interface{}) bool {
-	if that == nil {
-		return this == nil
-	}
 
-	that1, ok := that.(*PollMutableStateResponse)
-	if !ok {
-		that2, ok := that.(PollMutableStateResponse)
-		if ok {
-			that1 = &that2
-		} else {
-			return false
+type AcquireShardRequest struct {
+	ShardId int32 `protobuf:"varint,1,opt,name=shard_id,json=shardId,proto3" json:"shard_id,omitempty"`
+}
+
+func (m *AcquireShardRequest) Reset()      { *m = AcquireShardRequest{} }
+func (*AcquireShardRequest) ProtoMessage() {}
+func (*AcquireShardRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b8c78c1d460a3711, []int{77}
+}
+func (m *AcquireShardRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *AcquireShardRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_AcquireShardRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	if that1 == nil {
-		return this == nil
-	} else if this == nil {
-		return false
-	}
-	if !this.Execution.Equal(that1.Execution) {
-		return false
-	}
-	if !this.WorkflowType.Equal(that1.WorkflowType) {
-		return false
-	}
-	if this.NextEventId != that1.NextEventId {
-		return false
-	}
-	if this.PreviousStartedEventId != that1.PreviousStartedEventId {
-		return false
-	}
-	if this.LastFirstEventId != that1.LastFirstEventId {
-		return false
-	}
-	if !this.TaskQueue.Equal(that1.TaskQueue) {
-		return false
-	}
-	if !this.StickyTaskQueue.Equal(that1.StickyTaskQueue) {
-		return false
+}
+func (m *AcquireShardRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_AcquireShardRequest.Merge(m, src)
+}
+func (m *AcquireShardRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *AcquireShardRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_AcquireShardRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_AcquireShardRequest proto.InternalMessageInfo
+
+func (m *AcquireShardRequest) GetShardId() int32 {
+	if m != nil {
+		return m.ShardId
 	}
-	if this.StickyTaskQueueScheduleToStartTimeout != nil && that1.StickyTaskQueueScheduleToStartTimeout != nil {
-		if *this.StickyTaskQueueScheduleToStartTimeout != *that1.StickyTaskQueueScheduleToStartTimeout {
-			return false
+	return 0
+}
+
+type AcquireShardResponse struct {
+}
+
+func (m *AcquireShardResponse) Reset()      { *m = AcquireShardResponse{} }
+func (*AcquireShardResponse) ProtoMessage() {}
+func (*AcquireShardResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b8c78c1d460a3711, []int{78}
+}
+func (m *AcquireShardResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *AcquireShardResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_AcquireShardResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
-	} else if this.StickyTaskQueueScheduleToStartTimeout != nil {
-		return false
-	} else if that1.StickyTaskQueueScheduleToStartTimeout != nil {
-		return false
-	}
-	if !bytes.Equal(this.CurrentBranchToken, that1.CurrentBranchToken) {
-		return false
-	}
-	if !this.VersionHistories.Equal(that1.VersionHistories) {
-		return false
-	}
-	if this.WorkflowState != that1.WorkflowState {
-		return false
-	}
-	if this.WorkflowStatus != that1.WorkflowStatus {
-		return false
+		return b[:n], nil
 	}
-	return true
 }
-func (this *ResetStickyTaskQueueRequest) Equal(that interface{}) bool {
-	if that == nil {
-		return this == nil
-	}
+func (m *AcquireShardResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_AcquireShardResponse.Merge(m, src)
+}
+func (m *AcquireShardResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *AcquireShardResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_AcquireShardResponse.DiscardUnknown(m)
+}
 
-	that1, ok := that.(*ResetStickyTaskQueueRequest)
-	if !ok {
-		that2, ok := that.(ResetStickyTaskQueueRequest)
-		if ok {
-			that1 = &that2
-		} else {
-			return false
+var xxx_messageInfo_AcquireShardResponse proto.InternalMessageInfo
+
+type PauseWorkflowExecutionRequest struct {
+	NamespaceId       string                 `protobuf:"bytes,1,opt,name=namespace_id,json=namespaceId,proto3" json:"namespace_id,omitempty"`
+	WorkflowExecution *v14.WorkflowExecution `protobuf:"bytes,2,opt,name=workflow_execution,json=workflowExecution,proto3" json:"workflow_execution,omitempty"`
+	Identity          string                 `protobuf:"bytes,3,opt,name=identity,proto3" json:"identity,omitempty"`
+	Reason            string                 `protobuf:"bytes,4,opt,name=reason,proto3" json:"reason,omitempty"`
+}
+
+func (m *PauseWorkflowExecutionRequest) Reset()      { *m = PauseWorkflowExecutionRequest{} }
+func (*PauseWorkflowExecutionRequest) ProtoMessage() {}
+func (*PauseWorkflowExecutionRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b8c78c1d460a3711, []int{79}
+}
+func (m *PauseWorkflowExecutionRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *PauseWorkflowExecutionRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_PauseWorkflowExecutionRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	if that1 == nil {
-		return this == nil
-	} else if this == nil {
-		return false
-	}
-	if this.NamespaceId != that1.NamespaceId {
-		return false
-	}
-	if !this.Execution.Equal(that1.Execution) {
-		return false
-	}
-	return true
 }
-func (this *ResetStickyTaskQueueResponse) Equal(that interface{}) bool {
-	if that == nil {
-		return this == nil
+func (m *PauseWorkflowExecutionRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PauseWorkflowExecutionRequest.Merge(m, src)
+}
+func (m *PauseWorkflowExecutionRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *PauseWorkflowExecutionRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_PauseWorkflowExecutionRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PauseWorkflowExecutionRequest proto.InternalMessageInfo
+
+func (m *PauseWorkflowExecutionRequest) GetNamespaceId() string {
+	if m != nil {
+		return m.NamespaceId
 	}
+	return ""
+}
 
-	that1, ok := that.(*ResetStickyTaskQueueResponse)
-	if !ok {
-		that2, ok := that.(ResetStickyTaskQueueResponse)
-		if ok {
-			that1 = &that2
-		} else {
-			return false
-		}
+func (m *PauseWorkflowExecutionRequest) GetWorkflowExecution() *v14.WorkflowExecution {
+	if m != nil {
+		return m.WorkflowExecution
 	}
-	if that1 == nil {
-		return this == nil
-	} else if this == nil {
-		return false
+	return nil
+}
+
+func (m *PauseWorkflowExecutionRequest) GetIdentity() string {
+	if m != nil {
+		return m.Identity
 	}
-	return true
+	return ""
 }
-func (this *RecordWorkflowTaskStartedRequest) Equal(that interface{}) bool {
-	if that == nil {
-		return this == nil
+
+func (m *PauseWorkflowExecutionRequest) GetReason() string {
+	if m != nil {
+		return m.Reason
 	}
+	return ""
+}
 
-	that1, ok := that.(*RecordWorkflowTaskStartedRequest)
-	if !ok {
-		that2, ok := that.(RecordWorkflowTaskStartedRequest)
-		if ok {
-			that1 = &that2
-		} else {
-			return false
+type PauseWorkflowExecutionResponse struct {
+}
+
+func (m *PauseWorkflowExecutionResponse) Reset()      { *m = PauseWorkflowExecutionResponse{} }
+func (*PauseWorkflowExecutionResponse) ProtoMessage() {}
+func (*PauseWorkflowExecutionResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b8c78c1d460a3711, []int{80}
+}
+func (m *PauseWorkflowExecutionResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *PauseWorkflowExecutionResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_PauseWorkflowExecutionResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	if that1 == nil {
-		return this == nil
-	} else if this == nil {
-		return false
-	}
-	if this.NamespaceId != that1.NamespaceId {
-		return false
-	}
-	if !this.WorkflowExecution.Equal(that1.WorkflowExecution) {
-		return false
+}
+func (m *PauseWorkflowExecutionResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PauseWorkflowExecutionResponse.Merge(m, src)
+}
+func (m *PauseWorkflowExecutionResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *PauseWorkflowExecutionResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_PauseWorkflowExecutionResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PauseWorkflowExecutionResponse proto.InternalMessageInfo
+
+type UnpauseWorkflowExecutionRequest struct {
+	NamespaceId       string                 `protobuf:"bytes,1,opt,name=namespace_id,json=namespaceId,proto3" json:"namespace_id,omitempty"`
+	WorkflowExecution *v14.WorkflowExecution `protobuf:"bytes,2,opt,name=workflow_execution,json=workflowExecution,proto3" json:"workflow_execution,omitempty"`
+	Identity          string                 `protobuf:"bytes,3,opt,name=identity,proto3" json:"identity,omitempty"`
+}
+
+func (m *UnpauseWorkflowExecutionRequest) Reset()      { *m = UnpauseWorkflowExecutionRequest{} }
+func (*UnpauseWorkflowExecutionRequest) ProtoMessage() {}
+func (*UnpauseWorkflowExecutionRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b8c78c1d460a3711, []int{81}
+}
+func (m *UnpauseWorkflowExecutionRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *UnpauseWorkflowExecutionRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_UnpauseWorkflowExecutionRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	if this.ScheduleId != that1.ScheduleId {
-		return false
+}
+func (m *UnpauseWorkflowExecutionRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_UnpauseWorkflowExecutionRequest.Merge(m, src)
+}
+func (m *UnpauseWorkflowExecutionRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *UnpauseWorkflowExecutionRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_UnpauseWorkflowExecutionRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_UnpauseWorkflowExecutionRequest proto.InternalMessageInfo
+
+func (m *UnpauseWorkflowExecutionRequest) GetNamespaceId() string {
+	if m != nil {
+		return m.NamespaceId
 	}
-	if this.TaskId != that1.TaskId {
-		return false
+	return ""
+}
+
+func (m *UnpauseWorkflowExecutionRequest) GetWorkflowExecution() *v14.WorkflowExecution {
+	if m != nil {
+		return m.WorkflowExecution
 	}
-	if this.RequestId != that1.RequestId {
-		return false
+	return nil
+}
+
+func (m *UnpauseWorkflowExecutionRequest) GetIdentity() string {
+	if m != nil {
+		return m.Identity
 	}
-	if !this.PollRequest.Equal(that1.PollRequest) {
-		return false
+	return ""
+}
+
+type UnpauseWorkflowExecutionResponse struct {
+}
+
+func (m *UnpauseWorkflowExecutionResponse) Reset()      { *m = UnpauseWorkflowExecutionResponse{} }
+func (*UnpauseWorkflowExecutionResponse) ProtoMessage() {}
+func (*UnpauseWorkflowExecutionResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b8c78c1d460a3711, []int{82}
+}
+func (m *UnpauseWorkflowExecutionResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *UnpauseWorkflowExecutionResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_UnpauseWorkflowExecutionResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return true
 }
-func (this *RecordWorkflowTaskStartedResponse) Equal(that interface{}) bool {
+func (m *UnpauseWorkflowExecutionResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_UnpauseWorkflowExecutionResponse.Merge(m, src)
+}
+func (m *UnpauseWorkflowExecutionResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *UnpauseWorkflowExecutionResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_UnpauseWorkflowExecutionResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_UnpauseWorkflowExecutionResponse proto.InternalMessageInfo
+
+func init() {
+	proto.RegisterType((*StartWorkflowExecutionRequest)(nil), "temporal.server.api.historyservice.v1.StartWorkflowExecutionRequest")
+	proto.RegisterType((*StartWorkflowExecutionResponse)(nil), "temporal.server.api.historyservice.v1.StartWorkflowExecutionResponse")
+	proto.RegisterType((*GetMutableStateRequest)(nil), "temporal.server.api.historyservice.v1.GetMutableStateRequest")
+	proto.RegisterType((*GetMutableStateResponse)(nil), "temporal.server.api.historyservice.v1.GetMutableStateResponse")
+	proto.RegisterType((*PollMutableStateRequest)(nil), "temporal.server.api.historyservice.v1.PollMutableStateRequest")
+	proto.RegisterType((*PollMutableStateResponse)(nil), "temporal.server.api.historyservice.v1.PollMutableStateResponse")
+	proto.RegisterType((*ResetStickyTaskQueueRequest)(nil), "temporal.server.api.historyservice.v1.ResetStickyTaskQueueRequest")
+	proto.RegisterType((*ResetStickyTaskQueueResponse)(nil), "temporal.server.api.historyservice.v1.ResetStickyTaskQueueResponse")
+	proto.RegisterType((*RecordWorkflowTaskStartedRequest)(nil), "temporal.server.api.historyservice.v1.RecordWorkflowTaskStartedRequest")
+	proto.RegisterType((*RecordWorkflowTaskStartedResponse)(nil), "temporal.server.api.historyservice.v1.RecordWorkflowTaskStartedResponse")
+	proto.RegisterMapType((map[string]*v18.WorkflowQuery)(nil), "temporal.server.api.historyservice.v1.RecordWorkflowTaskStartedResponse.QueriesEntry")
+	proto.RegisterType((*RecordActivityTaskStartedRequest)(nil), "temporal.server.api.historyservice.v1.RecordActivityTaskStartedRequest")
+	proto.RegisterType((*RecordActivityTaskStartedResponse)(nil), "temporal.server.api.historyservice.v1.RecordActivityTaskStartedResponse")
+	proto.RegisterType((*RespondWorkflowTaskCompletedRequest)(nil), "temporal.server.api.historyservice.v1.RespondWorkflowTaskCompletedRequest")
+	proto.RegisterType((*RespondWorkflowTaskCompletedResponse)(nil), "temporal.server.api.historyservice.v1.RespondWorkflowTaskCompletedResponse")
+	proto.RegisterType((*RespondWorkflowTaskFailedRequest)(nil), "temporal.server.api.historyservice.v1.RespondWorkflowTaskFailedRequest")
+	proto.RegisterType((*RespondWorkflowTaskFailedResponse)(nil), "temporal.server.api.historyservice.v1.RespondWorkflowTaskFailedResponse")
+	proto.RegisterType((*RecordActivityTaskHeartbeatRequest)(nil), "temporal.server.api.historyservice.v1.RecordActivityTaskHeartbeatRequest")
+	proto.RegisterType((*RecordActivityTaskHeartbeatResponse)(nil), "temporal.server.api.historyservice.v1.RecordActivityTaskHeartbeatResponse")
+	proto.RegisterType((*RespondActivityTaskCompletedRequest)(nil), "temporal.server.api.historyservice.v1.RespondActivityTaskCompletedRequest")
+	proto.RegisterType((*RespondActivityTaskCompletedResponse)(nil), "temporal.server.api.historyservice.v1.RespondActivityTaskCompletedResponse")
+	proto.RegisterType((*RespondActivityTaskFailedRequest)(nil), "temporal.server.api.historyservice.v1.RespondActivityTaskFailedRequest")
+	proto.RegisterType((*RespondActivityTaskFailedResponse)(nil), "temporal.server.api.historyservice.v1.RespondActivityTaskFailedResponse")
+	proto.RegisterType((*RespondActivityTaskCanceledRequest)(nil), "temporal.server.api.historyservice.v1.RespondActivityTaskCanceledRequest")
+	proto.RegisterType((*RespondActivityTaskCanceledResponse)(nil), "temporal.server.api.historyservice.v1.RespondActivityTaskCanceledResponse")
+	proto.RegisterType((*SignalWorkflowExecutionRequest)(nil), "temporal.server.api.historyservice.v1.SignalWorkflowExecutionRequest")
+	proto.RegisterType((*SignalWorkflowExecutionResponse)(nil), "temporal.server.api.historyservice.v1.SignalWorkflowExecutionResponse")
+	proto.RegisterType((*SignalWithStartWorkflowExecutionRequest)(nil), "temporal.server.api.historyservice.v1.SignalWithStartWorkflowExecutionRequest")
+	proto.RegisterType((*SignalWithStartWorkflowExecutionResponse)(nil), "temporal.server.api.historyservice.v1.SignalWithStartWorkflowExecutionResponse")
+	proto.RegisterType((*RemoveSignalMutableStateRequest)(nil), "temporal.server.api.historyservice.v1.RemoveSignalMutableStateRequest")
+	proto.RegisterType((*RemoveSignalMutableStateResponse)(nil), "temporal.server.api.historyservice.v1.RemoveSignalMutableStateResponse")
+	proto.RegisterType((*TerminateWorkflowExecutionRequest)(nil), "temporal.server.api.historyservice.v1.TerminateWorkflowExecutionRequest")
+	proto.RegisterType((*TerminateWorkflowExecutionResponse)(nil), "temporal.server.api.historyservice.v1.TerminateWorkflowExecutionResponse")
+	proto.RegisterType((*ResetWorkflowExecutionRequest)(nil), "temporal.server.api.historyservice.v1.ResetWorkflowExecutionRequest")
+	proto.RegisterType((*ResetWorkflowExecutionResponse)(nil), "temporal.server.api.historyservice.v1.ResetWorkflowExecutionResponse")
+	proto.RegisterType((*ResetWorkflowExecutionPreview)(nil), "temporal.server.api.historyservice.v1.ResetWorkflowExecutionPreview")
+	proto.RegisterType((*RequestCancelWorkflowExecutionRequest)(nil), "temporal.server.api.historyservice.v1.RequestCancelWorkflowExecutionRequest")
+	proto.RegisterType((*RequestCancelWorkflowExecutionResponse)(nil), "temporal.server.api.historyservice.v1.RequestCancelWorkflowExecutionResponse")
+	proto.RegisterType((*ScheduleWorkflowTaskRequest)(nil), "temporal.server.api.historyservice.v1.ScheduleWorkflowTaskRequest")
+	proto.RegisterType((*ScheduleWorkflowTaskResponse)(nil), "temporal.server.api.historyservice.v1.ScheduleWorkflowTaskResponse")
+	proto.RegisterType((*RecordChildExecutionCompletedRequest)(nil), "temporal.server.api.historyservice.v1.RecordChildExecutionCompletedRequest")
+	proto.RegisterType((*RecordChildExecutionCompletedResponse)(nil), "temporal.server.api.historyservice.v1.RecordChildExecutionCompletedResponse")
+	proto.RegisterType((*DescribeWorkflowExecutionRequest)(nil), "temporal.server.api.historyservice.v1.DescribeWorkflowExecutionRequest")
+	proto.RegisterType((*DescribeWorkflowExecutionResponse)(nil), "temporal.server.api.historyservice.v1.DescribeWorkflowExecutionResponse")
+	proto.RegisterType((*ReplicateEventsV2Request)(nil), "temporal.server.api.historyservice.v1.ReplicateEventsV2Request")
+	proto.RegisterType((*ReplicateEventsV2Response)(nil), "temporal.server.api.historyservice.v1.ReplicateEventsV2Response")
+	proto.RegisterType((*SyncShardStatusRequest)(nil), "temporal.server.api.historyservice.v1.SyncShardStatusRequest")
+	proto.RegisterType((*SyncShardStatusResponse)(nil), "temporal.server.api.historyservice.v1.SyncShardStatusResponse")
+	proto.RegisterType((*SyncActivityRequest)(nil), "temporal.server.api.historyservice.v1.SyncActivityRequest")
+	proto.RegisterType((*SyncActivityResponse)(nil), "temporal.server.api.historyservice.v1.SyncActivityResponse")
+	proto.RegisterType((*DescribeMutableStateRequest)(nil), "temporal.server.api.historyservice.v1.DescribeMutableStateRequest")
+	proto.RegisterType((*DescribeMutableStateResponse)(nil), "temporal.server.api.historyservice.v1.DescribeMutableStateResponse")
+	proto.RegisterType((*DescribeHistoryHostRequest)(nil), "temporal.server.api.historyservice.v1.DescribeHistoryHostRequest")
+	proto.RegisterType((*DescribeHistoryHostResponse)(nil), "temporal.server.api.historyservice.v1.DescribeHistoryHostResponse")
+	proto.RegisterType((*CloseShardRequest)(nil), "temporal.server.api.historyservice.v1.CloseShardRequest")
+	proto.RegisterType((*CloseShardResponse)(nil), "temporal.server.api.historyservice.v1.CloseShardResponse")
+	proto.RegisterType((*RemoveTaskRequest)(nil), "temporal.server.api.historyservice.v1.RemoveTaskRequest")
+	proto.RegisterType((*RemoveTaskResponse)(nil), "temporal.server.api.historyservice.v1.RemoveTaskResponse")
+	proto.RegisterType((*GetReplicationMessagesRequest)(nil), "temporal.server.api.historyservice.v1.GetReplicationMessagesRequest")
+	proto.RegisterType((*GetReplicationMessagesResponse)(nil), "temporal.server.api.historyservice.v1.GetReplicationMessagesResponse")
+	proto.RegisterMapType((map[int32]*v112.ReplicationMessages)(nil), "temporal.server.api.historyservice.v1.GetReplicationMessagesResponse.ShardMessagesEntry")
+	proto.RegisterType((*GetDLQReplicationMessagesRequest)(nil), "temporal.server.api.historyservice.v1.GetDLQReplicationMessagesRequest")
+	proto.RegisterType((*GetDLQReplicationMessagesResponse)(nil), "temporal.server.api.historyservice.v1.GetDLQReplicationMessagesResponse")
+	proto.RegisterType((*QueryWorkflowRequest)(nil), "temporal.server.api.historyservice.v1.QueryWorkflowRequest")
+	proto.RegisterType((*QueryWorkflowResponse)(nil), "temporal.server.api.historyservice.v1.QueryWorkflowResponse")
+	proto.RegisterType((*ReapplyEventsRequest)(nil), "temporal.server.api.historyservice.v1.ReapplyEventsRequest")
+	proto.RegisterType((*ReapplyEventsResponse)(nil), "temporal.server.api.historyservice.v1.ReapplyEventsResponse")
+	proto.RegisterType((*GetDLQMessagesRequest)(nil), "temporal.server.api.historyservice.v1.GetDLQMessagesRequest")
+	proto.RegisterType((*GetDLQMessagesResponse)(nil), "temporal.server.api.historyservice.v1.GetDLQMessagesResponse")
+	proto.RegisterType((*PurgeDLQMessagesRequest)(nil), "temporal.server.api.historyservice.v1.PurgeDLQMessagesRequest")
+	proto.RegisterType((*PurgeDLQMessagesResponse)(nil), "temporal.server.api.historyservice.v1.PurgeDLQMessagesResponse")
+	proto.RegisterType((*MergeDLQMessagesRequest)(nil), "temporal.server.api.historyservice.v1.MergeDLQMessagesRequest")
+	proto.RegisterType((*MergeDLQMessagesResponse)(nil), "temporal.server.api.historyservice.v1.MergeDLQMessagesResponse")
+	proto.RegisterType((*RefreshWorkflowTasksRequest)(nil), "temporal.server.api.historyservice.v1.RefreshWorkflowTasksRequest")
+	proto.RegisterType((*RefreshWorkflowTasksResponse)(nil), "temporal.server.api.historyservice.v1.RefreshWorkflowTasksResponse")
+	proto.RegisterType((*DeleteWorkflowExecutionRequest)(nil), "temporal.server.api.historyservice.v1.DeleteWorkflowExecutionRequest")
+	proto.RegisterType((*DeleteWorkflowExecutionResponse)(nil), "temporal.server.api.historyservice.v1.DeleteWorkflowExecutionResponse")
+	proto.RegisterType((*UpdateWorkflowExecutionRequest)(nil), "temporal.server.api.historyservice.v1.UpdateWorkflowExecutionRequest")
+	proto.RegisterType((*UpdateWorkflowExecutionResponse)(nil), "temporal.server.api.historyservice.v1.UpdateWorkflowExecutionResponse")
+	proto.RegisterType((*AcquireShardRequest)(nil), "temporal.server.api.historyservice.v1.AcquireShardRequest")
+	proto.RegisterType((*AcquireShardResponse)(nil), "temporal.server.api.historyservice.v1.AcquireShardResponse")
+	proto.RegisterType((*PauseWorkflowExecutionRequest)(nil), "temporal.server.api.historyservice.v1.PauseWorkflowExecutionRequest")
+	proto.RegisterType((*PauseWorkflowExecutionResponse)(nil), "temporal.server.api.historyservice.v1.PauseWorkflowExecutionResponse")
+	proto.RegisterType((*UnpauseWorkflowExecutionRequest)(nil), "temporal.server.api.historyservice.v1.UnpauseWorkflowExecutionRequest")
+	proto.RegisterType((*UnpauseWorkflowExecutionResponse)(nil), "temporal.server.api.historyservice.v1.UnpauseWorkflowExecutionResponse")
+}
+
+func init() {
+	proto.RegisterFile("temporal/server/api/historyservice/v1/request_response.proto", fileDescriptor_b8c78c1d460a3711)
+}
+
+var fileDescriptor_b8c78c1d460a3711 = []byte{
+	// 4174 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xed, 0x1c, 0x49, 0x73, 0x1c, 0x57,
+	0x39, 0xa3, 0xd1, 0x48, 0x33, 0x9f, 0xa4, 0xd1, 0xa8, 0xb5, 0x8d, 0x64, 0x5b, 0xb2, 0xdb, 0x6b,
+	0x16, 0x8f, 0x1c, 0x3b, 0xab, 0x21, 0x21, 0xda, 0x6c, 0x8b, 0xb2, 0x1d, 0xa5, 0xe5, 0x38, 0x29,
+	0x07, 0x32, 0x69, 0xcd, 0x3c, 0x49, 0x8d, 0x47, 0xdd, 0x93, 0xee, 0x1e, 0x29, 0x0a, 0x07, 0xb6,
+	0xe2, 0x10, 0xa8, 0xa2, 0x5c, 0xc5, 0x01, 0xaa, 0x08, 0x17, 0x4e, 0x5c, 0xa8, 0x1c, 0x28, 0x8a,
+	0x22, 0x55, 0x5c, 0xa9, 0x9c, 0xc0, 0xc5, 0x85, 0x14, 0x1c, 0x20, 0xe1, 0x02, 0x05, 0x87, 0x1c,
+	0xf8, 0x01, 0x7c, 0x6f, 0xeb, 0x65, 0xba, 0x7b, 0x16, 0xc9, 0x26, 0x0b, 0x39, 0x8c, 0xdc, 0xfd,
+	0xde, 0xf7, 0x7d, 0xef, 0x7d, 0xef, 0x7d, 0xdb, 0xfb, 0xde, 0xd7, 0x86, 0x2f, 0xba, 0x64, 0xbb,
+	0x6e, 0xd9, 0x7a, 0x6d, 0xce, 0x21, 0xf6, 0x0e, 0xb1, 0xe7, 0xf4, 0xba, 0x31, 0xb7, 0x65, 0x38,
+	0xae, 0x65, 0xef, 0xd1, 0x16, 0xa3, 0x42, 0xe6, 0x76, 0x1e, 0x9d, 0xb3, 0xc9, 0xeb, 0x0d, 0xe2,
+	0xb8, 0x65, 0x9b, 0x38, 0x75, 0xcb, 0x74, 0x48, 0xa9, 0x6e, 0x5b, 0xae, 0xa5, 0x9c, 0x94, 0xd8,
+	0x25, 0x8e, 0x5d, 0x42, 0xec, 0x52, 0x18, 0xbb, 0xb4, 0xf3, 0xe8, 0xf4, 0xcc, 0xa6, 0x65, 0x6d,
+	0xd6, 0xc8, 0x1c, 0x43, 0x5a, 0x6f, 0x6c, 0xcc, 0x55, 0x1b, 0xb6, 0xee, 0x1a, 0x96, 0xc9, 0xc9,
+	0x4c, 0xcf, 0x36, 0xf7, 0xbb, 0xc6, 0x36, 0x8e, 0xa6, 0x6f, 0xd7, 0x05, 0xc0, 0xb1, 0x2a, 0xa9,
+	0x13, 0xb3, 0x4a, 0xcc, 0x8a, 0x41, 0x9c, 0xb9, 0x4d, 0x6b, 0xd3, 0x62, 0xed, 0xec, 0x49, 0x80,
+	0x9c, 0xf0, 0x18, 0xa1, 0x1c, 0x54, 0xac, 0xed, 0x6d, 0xcb, 0xa4, 0x33, 0x47, 0x42, 0x8e, 0xbe,
+	0x29, 0x26, 0x3c, 0x7d, 0x32, 0x04, 0x25, 0x66, 0x1a, 0x05, 0x3b, 0x1d, 0x02, 0x73, 0x75, 0xe7,
+	0x36, 0xb2, 0xdf, 0x20, 0x51, 0xc0, 0xf0, 0xa8, 0xc4, 0x6c, 0x6c, 0x3b, 0x14, 0x68, 0xd7, 0xb2,
+	0x6f, 0x6f, 0xd4, 0xac, 0x5d, 0x01, 0x75, 0x2a, 0x04, 0x25, 0x3b, 0xa3, 0xd4, 0x8e, 0x87, 0xe0,
+	0x70, 0xc8, 0xb8, 0xb9, 0x85, 0x59, 0xd8, 0xd0, 0x8d, 0x5a, 0xc3, 0x8e, 0x99, 0xd9, 0x23, 0x2d,
+	0x36, 0x36, 0x0a, 0xfd, 0x60, 0x1c, 0xb4, 0xc7, 0x0e, 0x5f, 0x4d, 0x01, 0xfa, 0x70, 0x4b, 0xd0,
+	0x26, 0xce, 0x4f, 0xb7, 0x04, 0xa6, 0x0b, 0x2b, 0x00, 0xcf, 0xc6, 0x01, 0x26, 0xaf, 0x54, 0x29,
+	0x0e, 0xdc, 0xd4, 0x11, 0xa8, 0xae, 0x57, 0x62, 0x56, 0xe3, 0x5c, 0x1c, 0xbc, 0x4d, 0xea, 0x35,
+	0xa3, 0xc2, 0x04, 0x31, 0x8a, 0xf1, 0x44, 0xec, 0x9e, 0xb5, 0x55, 0x89, 0xe9, 0x8b, 0x71, 0x23,
+	0xe9, 0xd5, 0x6d, 0xc3, 0x6c, 0x8b, 0xab, 0x7e, 0xbf, 0x0f, 0x8e, 0xac, 0xb9, 0xba, 0xed, 0xbe,
+	0x24, 0x86, 0x5b, 0x7e, 0x83, 0x54, 0x1a, 0x74, 0x7e, 0x1a, 0x47, 0x50, 0x8e, 0xc1, 0xa0, 0xc7,
+	0x65, 0xd9, 0xa8, 0x16, 0x53, 0x47, 0x53, 0x67, 0x72, 0xda, 0x80, 0xd7, 0xb6, 0x52, 0x55, 0x2a,
+	0x30, 0xe4, 0x50, 0x1a, 0x65, 0x31, 0x48, 0xb1, 0x07, 0x61, 0x06, 0xce, 0x3f, 0xeb, 0x2d, 0x19,
+	0x53, 0xd2, 0x26, 0x86, 0x50, 0x4b, 0x4b, 0x2d, 0x47, 0xd6, 0x06, 0x19, 0x51, 0x39, 0x8f, 0x2d,
+	0x18, 0xaf, 0xeb, 0x36, 0x31, 0xdd, 0x32, 0x91, 0x80, 0x65, 0xc3, 0xdc, 0xb0, 0x8a, 0x69, 0x36,
+	0xd8, 0x63, 0xa5, 0x38, 0xc3, 0xe0, 0xc9, 0x06, 0x0e, 0xb6, 0xca, 0xb0, 0xbd, 0x51, 0x56, 0x10,
+	0x57, 0x1b, 0xad, 0x47, 0x1b, 0x95, 0x22, 0xf4, 0xeb, 0x2e, 0xa5, 0xe6, 0x16, 0x7b, 0x91, 0x76,
+	0x46, 0x93, 0xaf, 0xca, 0x36, 0xa8, 0x92, 0x62, 0x60, 0x16, 0xe4, 0x8d, 0xba, 0xc1, 0x8d, 0x4b,
+	0x99, 0x5a, 0x91, 0x62, 0x86, 0x4d, 0x68, 0xba, 0xc4, 0x4d, 0x4c, 0x49, 0x9a, 0x98, 0xd2, 0x0d,
+	0x69, 0x62, 0x16, 0x7a, 0xef, 0xfc, 0x75, 0x36, 0xa5, 0xcd, 0xee, 0x36, 0x73, 0xbe, 0xec, 0x51,
+	0xa2, 0xb0, 0xc8, 0xf2, 0x54, 0xc5, 0x32, 0x5d, 0xc3, 0x6c, 0x90, 0xb2, 0xee, 0x94, 0x4d, 0xb2,
+	0x8b, 0x1c, 0x1b, 0xae, 0xa1, 0xa3, 0x46, 0x15, 0xfb, 0x70, 0x94, 0xfc, 0xf9, 0xb3, 0xe1, 0x35,
+	0x66, 0x72, 0x4e, 0x99, 0x5d, 0x14, 0x78, 0xf3, 0xce, 0x75, 0xb2, 0xbb, 0x22, 0x91, 0xb4, 0x89,
+	0x4a, 0x6c, 0xbb, 0x72, 0x0d, 0x46, 0x64, 0x4f, 0xb5, 0x2c, 0x14, 0xbc, 0xd8, 0xcf, 0xf8, 0x38,
+	0x1a, 0x1e, 0x41, 0x74, 0xd2, 0x31, 0x2e, 0xf1, 0x47, 0xad, 0xe0, 0xa1, 0x8a, 0x16, 0xe5, 0x26,
+	0x4c, 0xd4, 0x74, 0x14, 0x36, 0xd4, 0xe2, 0x7a, 0x8d, 0xb0, 0x95, 0x41, 0xb9, 0x6b, 0xd4, 0xdc,
+	0x62, 0x36, 0x8e, 0xa6, 0x50, 0x76, 0xb6, 0x47, 0x7b, 0x35, 0x4b, 0xaf, 0x3a, 0xda, 0x18, 0xc5,
+	0x5f, 0xf4, 0xd0, 0x35, 0x86, 0xad, 0xbc, 0x0a, 0x87, 0x36, 0x0c, 0x1b, 0x09, 0x7b, 0xbb, 0x40,
+	0xf5, 0xb9, 0xbc, 0xae, 0x57, 0x6e, 0x5b, 0x1b, 0x1b, 0xc5, 0x1c, 0x23, 0x3e, 0x15, 0x59, 0xf8,
+	0x25, 0x61, 0xfb, 0x17, 0x7a, 0x7f, 0x4c, 0xd7, 0xbd, 0xc8, 0x68, 0x48, 0xb1, 0xbb, 0x81, 0x14,
+	0x16, 0x38, 0x01, 0xf5, 0x49, 0x98, 0x49, 0x12, 0x49, 0xae, 0x35, 0xca, 0x38, 0xf4, 0xd9, 0x0d,
+	0xd3, 0xd7, 0x83, 0x0c, 0xbe, 0xad, 0x54, 0xd5, 0x7f, 0xa5, 0x60, 0xe2, 0x32, 0x71, 0xaf, 0x35,
+	0x5c, 0x7d, 0xbd, 0x46, 0x90, 0x86, 0x4b, 0xba, 0xd0, 0x9f, 0xcb, 0x90, 0xf3, 0xa4, 0x49, 0xe8,
+	0xce, 0x83, 0x49, 0x2b, 0x14, 0x9d, 0x9a, 0x8f, 0xab, 0x5c, 0x80, 0x09, 0x14, 0x46, 0x52, 0x71,
+	0x71, 0x17, 0x4d, 0xf2, 0x06, 0xaa, 0xca, 0x0e, 0x55, 0x18, 0x1c, 0x95, 0x2a, 0x49, 0x5a, 0x1b,
+	0x95, 0xbd, 0xd7, 0xb1, 0x73, 0x99, 0xf6, 0xe1, 0xe8, 0xe7, 0x60, 0xac, 0xd2, 0xb0, 0x99, 0x66,
+	0xad, 0xdb, 0xba, 0x59, 0xd9, 0x2a, 0xbb, 0xd6, 0x6d, 0x62, 0x32, 0xd9, 0x1f, 0xd4, 0x14, 0xd1,
+	0xb7, 0xc0, 0xba, 0x6e, 0xd0, 0x1e, 0xf5, 0x3b, 0x39, 0x98, 0x8c, 0x70, 0x2b, 0x16, 0x28, 0xc4,
+	0x4b, 0xea, 0x00, 0xbc, 0xac, 0xc0, 0x90, 0xbf, 0xcb, 0x7b, 0x75, 0x22, 0x16, 0xe6, 0x44, 0x3b,
+	0x62, 0x37, 0x10, 0x56, 0x1b, 0xdc, 0x0d, 0xbc, 0x29, 0x2a, 0x0c, 0xc5, 0xad, 0xc6, 0x80, 0x19,
+	0x58, 0x85, 0xa7, 0x61, 0xaa, 0x6e, 0x93, 0x1d, 0xc3, 0x6a, 0x38, 0x65, 0x66, 0x77, 0x70, 0x09,
+	0x3d, 0xf8, 0x5e, 0x06, 0x3f, 0x21, 0x01, 0xd6, 0x78, 0xbf, 0x44, 0x3d, 0x0b, 0xa3, 0x4c, 0xda,
+	0xb9, 0x68, 0x7a, 0x48, 0x19, 0x86, 0x54, 0xa0, 0x5d, 0x97, 0x68, 0x8f, 0x04, 0x5f, 0x04, 0x60,
+	0x52, 0xcb, 0xfc, 0x3b, 0x53, 0xe3, 0x08, 0x57, 0x9e, 0xfb, 0xa7, 0x8c, 0x51, 0x01, 0x7d, 0x81,
+	0xbe, 0x68, 0x39, 0x57, 0x3e, 0x2a, 0xab, 0x30, 0xe2, 0xb8, 0x46, 0xe5, 0xf6, 0x5e, 0x39, 0x40,
+	0xab, 0xbf, 0x0b, 0x5a, 0xc3, 0x1c, 0xdd, 0x6b, 0x50, 0xbe, 0x0e, 0x0f, 0x47, 0x28, 0x96, 0x9d,
+	0xca, 0x16, 0xa9, 0x36, 0x6a, 0x04, 0x45, 0x82, 0xaf, 0x0a, 0xb3, 0x70, 0x56, 0xc3, 0x2d, 0x0e,
+	0x74, 0xa6, 0x6b, 0x27, 0x9b, 0x86, 0x59, 0x13, 0x04, 0x6f, 0x58, 0x6c, 0x11, 0x6f, 0x70, 0x6a,
+	0x4a, 0x09, 0x46, 0xf9, 0xba, 0xd1, 0x60, 0x81, 0x94, 0xd1, 0x7c, 0x3b, 0x54, 0x7e, 0x06, 0x99,
+	0xf9, 0x1d, 0x61, 0x5d, 0x6b, 0xb4, 0xe7, 0x26, 0xef, 0x48, 0x94, 0xd9, 0xa1, 0x24, 0x99, 0x55,
+	0x5e, 0x81, 0xbc, 0x27, 0x4e, 0x0e, 0x95, 0xd8, 0xe2, 0x30, 0x33, 0xa0, 0xf1, 0x7e, 0xc3, 0xb3,
+	0xa3, 0x11, 0x11, 0xe5, 0xd2, 0xee, 0x89, 0x26, 0x7b, 0x55, 0x5e, 0x82, 0xe1, 0x10, 0xf1, 0x86,
+	0x53, 0x2c, 0x30, 0xea, 0xa5, 0x04, 0xf3, 0x1c, 0x4b, 0xb6, 0xe1, 0x68, 0xf9, 0x20, 0xdd, 0x86,
+	0xa3, 0x7c, 0x15, 0x46, 0xc4, 0x5a, 0x94, 0x79, 0x20, 0x85, 0xc1, 0x68, 0x71, 0x84, 0x2d, 0xfd,
+	0xb9, 0x52, 0x8b, 0x48, 0x98, 0x8e, 0x21, 0xd6, 0xea, 0x8a, 0xc4, 0xd3, 0x0a, 0x3b, 0x4d, 0x2d,
+	0xca, 0xb3, 0x70, 0xd8, 0xa0, 0xe2, 0xde, 0xbc, 0xed, 0xc4, 0xa4, 0x8a, 0x5d, 0x2d, 0x2a, 0x38,
+	0x52, 0x56, 0x2b, 0x1a, 0x28, 0xf1, 0xa1, 0x5d, 0x5c, 0xe6, 0xfd, 0xca, 0x23, 0xa0, 0x88, 0x01,
+	0xcb, 0x8e, 0xf1, 0x26, 0x29, 0xaf, 0xef, 0xb9, 0x38, 0xbf, 0x51, 0x2e, 0xf8, 0xa2, 0x67, 0x0d,
+	0x3b, 0x16, 0x68, 0xbb, 0xf2, 0x38, 0x4c, 0x3a, 0x8d, 0xcd, 0x4d, 0xc2, 0x1c, 0x43, 0xc8, 0xad,
+	0x15, 0xc7, 0xd8, 0x40, 0x63, 0xa2, 0x3b, 0xe4, 0xbc, 0xbe, 0xdc, 0x9b, 0xcd, 0x16, 0x72, 0xf8,
+	0x37, 0x57, 0x00, 0xfc, 0x0b, 0x85, 0x01, 0xfc, 0x9b, 0x2f, 0x0c, 0xab, 0xff, 0x4e, 0xc1, 0xe4,
+	0xaa, 0x55, 0xab, 0xfd, 0x9f, 0x18, 0xdd, 0x77, 0xfa, 0xa1, 0x18, 0x65, 0xf7, 0x73, 0xab, 0xfb,
+	0xb9, 0xd5, 0xdd, 0xb7, 0xd5, 0x4d, 0x12, 0xc2, 0xc1, 0x44, 0x2b, 0x1a, 0x6b, 0x8f, 0xf2, 0xf7,
+	0xcc, 0x1e, 0x7d, 0x2a, 0x8d, 0x74, 0xac, 0x81, 0x1a, 0x2a, 0xe4, 0xd5, 0xb7, 0x52, 0x70, 0x08,
+	0x35, 0x94, 0xb8, 0x4d, 0xd6, 0xf3, 0x63, 0x30, 0x52, 0xea, 0x0c, 0x1c, 0x8e, 0x9f, 0x0a, 0x37,
+	0x20, 0xea, 0x9f, 0x7b, 0xe0, 0xa8, 0x46, 0x2a, 0x96, 0x5d, 0x0d, 0xc6, 0xc5, 0x42, 0xe5, 0xba,
+	0x98, 0xf0, 0xcb, 0xa0, 0x44, 0x4f, 0x48, 0xdd, 0xcf, 0x7c, 0x24, 0x72, 0x34, 0x52, 0x66, 0x61,
+	0xc0, 0xd3, 0x0b, 0xcf, 0x98, 0x80, 0x6c, 0xc2, 0xa1, 0x27, 0xa1, 0x9f, 0xe9, 0x90, 0x67, 0x39,
+	0xfa, 0xe8, 0x2b, 0x76, 0x1c, 0x01, 0x90, 0xa7, 0x5f, 0x61, 0x20, 0x72, 0x5a, 0x4e, 0xb4, 0x60,
+	0xf7, 0x6b, 0x30, 0x58, 0x47, 0xbb, 0xea, 0x1d, 0x5e, 0xb9, 0x6d, 0x78, 0xa6, 0xed, 0xe1, 0x95,
+	0x1a, 0xe3, 0xe0, 0x62, 0x05, 0xf7, 0x56, 0x1b, 0xa0, 0x24, 0xc5, 0x8b, 0xfa, 0x4e, 0x0e, 0x8e,
+	0xb5, 0x58, 0x5c, 0x61, 0xc3, 0x23, 0xa6, 0x37, 0xb5, 0x6f, 0xd3, 0xdb, 0xd2, 0xac, 0xf6, 0xb4,
+	0x34, 0xab, 0xe8, 0xd2, 0xe5, 0x9a, 0x56, 0x9b, 0x4d, 0x77, 0xc1, 0xeb, 0x91, 0xd0, 0x67, 0xa0,
+	0x90, 0x60, 0xb6, 0xf3, 0x4e, 0x98, 0x6e, 0xc4, 0x1b, 0x64, 0xa2, 0xde, 0x20, 0x70, 0xf0, 0xee,
+	0x0b, 0x1f, 0xbc, 0x9f, 0x82, 0xa2, 0x30, 0x93, 0x81, 0x63, 0xb7, 0x08, 0x52, 0xfa, 0x59, 0xec,
+	0x30, 0xc1, 0xfb, 0xfd, 0xa3, 0xb4, 0x08, 0x51, 0x36, 0x03, 0x02, 0xc9, 0xc5, 0x83, 0xe6, 0x0c,
+	0xf8, 0x31, 0xf4, 0xe9, 0x76, 0x26, 0xeb, 0x06, 0x9a, 0x3e, 0xc7, 0xc0, 0xc9, 0x05, 0xf7, 0x8d,
+	0x25, 0x0e, 0x0a, 0xbb, 0x4d, 0x2d, 0x38, 0xd0, 0x91, 0x98, 0xdc, 0x40, 0xc0, 0x4f, 0xe4, 0xba,
+	0xf0, 0x13, 0xd3, 0x11, 0xf9, 0xf7, 0x5d, 0x46, 0x42, 0xac, 0x0c, 0x49, 0xb1, 0x32, 0x6a, 0x6d,
+	0xc8, 0xba, 0x0f, 0x30, 0xeb, 0x3e, 0xb0, 0x1e, 0x30, 0xeb, 0x97, 0x21, 0xef, 0x6f, 0x3a, 0xcb,
+	0x61, 0x0c, 0x76, 0x98, 0xc3, 0x18, 0xf2, 0xf0, 0x58, 0xc6, 0x62, 0x11, 0x06, 0xa5, 0x3c, 0x30,
+	0x32, 0x43, 0x1d, 0x92, 0x19, 0x10, 0x58, 0x8c, 0x88, 0x05, 0xfd, 0x34, 0x11, 0xc9, 0x5d, 0x4b,
+	0x1a, 0xf1, 0x5f, 0x2c, 0x75, 0x94, 0xf4, 0x2d, 0xb5, 0xd5, 0xb1, 0xd2, 0x0b, 0x9c, 0xee, 0xb2,
+	0xe9, 0xda, 0x7b, 0x9a, 0x1c, 0x25, 0x21, 0x8c, 0x1d, 0xee, 0x3e, 0x8c, 0x2d, 0x24, 0x87, 0xb1,
+	0xa8, 0xde, 0xfd, 0x8d, 0x7a, 0x55, 0x77, 0x59, 0x00, 0x4f, 0xb9, 0x9a, 0x8b, 0xb7, 0x30, 0x41,
+	0xd5, 0x7e, 0x91, 0x21, 0x48, 0x9b, 0x22, 0xf1, 0xa7, 0xd1, 0x62, 0x05, 0x19, 0x51, 0x0a, 0x90,
+	0xbe, 0x4d, 0xf6, 0x84, 0x39, 0xa6, 0x8f, 0xca, 0x45, 0xc8, 0xec, 0xe8, 0xb5, 0x46, 0x42, 0xf8,
+	0xc6, 0xd2, 0xbc, 0xc1, 0x71, 0x28, 0xb5, 0x3d, 0x8d, 0xa3, 0x5c, 0xec, 0x79, 0x2a, 0x15, 0x70,
+	0x07, 0xf3, 0x15, 0xd7, 0xd8, 0x31, 0xdc, 0xbd, 0xcf, 0xdd, 0x41, 0x07, 0xee, 0x20, 0xb8, 0x58,
+	0xc9, 0xee, 0xe0, 0xdb, 0xbd, 0xd2, 0x1d, 0xc4, 0x2e, 0xae, 0x70, 0x07, 0xd7, 0x61, 0xb8, 0xc9,
+	0x10, 0x0b, 0x87, 0x70, 0x32, 0x3c, 0x95, 0x80, 0xb9, 0xe2, 0x81, 0xd4, 0x1e, 0x33, 0xa7, 0x5a,
+	0x3e, 0x6c, 0xac, 0x23, 0xaa, 0xd9, 0xb3, 0x1f, 0xd5, 0x0c, 0x58, 0xe8, 0x74, 0xd8, 0x42, 0x13,
+	0x98, 0x91, 0xb1, 0xa4, 0x68, 0x2a, 0x37, 0x99, 0x94, 0xde, 0x0e, 0x07, 0x3c, 0x24, 0xe8, 0xcc,
+	0x73, 0x32, 0x6b, 0x21, 0x03, 0x73, 0x0d, 0x46, 0xb6, 0x08, 0xce, 0x67, 0x9d, 0xe8, 0x6e, 0xb9,
+	0x4a, 0x5c, 0xdd, 0xa8, 0x39, 0x22, 0xe1, 0xda, 0x3e, 0xa9, 0x58, 0xf0, 0x50, 0x97, 0x38, 0x66,
+	0xd4, 0xe7, 0xf6, 0xed, 0xdb, 0xe7, 0x9e, 0x0d, 0x88, 0xba, 0xa7, 0x02, 0xcc, 0x39, 0xe5, 0x7c,
+	0xf9, 0xbd, 0x2e, 0x3b, 0xd4, 0xdf, 0xa4, 0xe0, 0x38, 0xdf, 0xeb, 0x90, 0xc1, 0x12, 0x29, 0xcf,
+	0xae, 0x94, 0xcc, 0x82, 0x82, 0x48, 0xb4, 0x92, 0xa6, 0x0c, 0xfc, 0x52, 0x5b, 0xa9, 0xed, 0x60,
+	0x0a, 0xda, 0xb0, 0xa4, 0x2e, 0x05, 0xf8, 0x27, 0x29, 0x38, 0xd1, 0x1a, 0x51, 0xc8, 0xb0, 0xe3,
+	0x87, 0x07, 0xf2, 0xde, 0x41, 0x08, 0xf1, 0x95, 0x7b, 0x65, 0xd2, 0xe9, 0x91, 0x2a, 0xd4, 0xa0,
+	0xbe, 0x93, 0xa2, 0xb6, 0x2b, 0x32, 0x3b, 0x9a, 0x9b, 0xee, 0x6a, 0x59, 0xb7, 0x20, 0xbf, 0xc1,
+	0x70, 0x9a, 0x16, 0x75, 0x7e, 0x3f, 0x8b, 0x1a, 0x1a, 0x5d, 0x1b, 0xda, 0x08, 0xbe, 0xaa, 0xc7,
+	0xa9, 0x3d, 0x48, 0x44, 0x11, 0x6c, 0xa1, 0xc0, 0xa8, 0x51, 0xab, 0x71, 0x45, 0x4a, 0x74, 0x17,
+	0x8c, 0xd5, 0x83, 0x3a, 0x14, 0xe6, 0x6d, 0xb1, 0x03, 0xde, 0xda, 0x4d, 0x21, 0xa0, 0x66, 0x92,
+	0xc1, 0x55, 0x2a, 0xeb, 0x2d, 0xf0, 0x84, 0xb8, 0x3c, 0x88, 0x82, 0x8c, 0x41, 0x09, 0xf1, 0x8c,
+	0x2f, 0xe1, 0xf3, 0xcf, 0xa2, 0x08, 0xb2, 0x76, 0x4d, 0x36, 0x07, 0xd5, 0x27, 0x48, 0xf3, 0x63,
+	0x52, 0x9f, 0x56, 0x53, 0x88, 0xaa, 0xcf, 0x29, 0x4f, 0x7b, 0x12, 0xf0, 0xa2, 0x82, 0x1c, 0x04,
+	0xfc, 0xdf, 0x0b, 0x72, 0xe2, 0xe8, 0xc9, 0x82, 0x1c, 0x87, 0x22, 0xd8, 0xfa, 0x25, 0x13, 0xe4,
+	0x28, 0xff, 0x6c, 0x87, 0xbb, 0x62, 0xec, 0x6b, 0x90, 0x0f, 0xcb, 0x4b, 0x17, 0x52, 0xdc, 0x6e,
+	0x7c, 0x6d, 0x28, 0x24, 0x72, 0xea, 0xc9, 0x78, 0x79, 0xf3, 0x90, 0x04, 0x73, 0xbf, 0xeb, 0x81,
+	0x99, 0x35, 0x63, 0xd3, 0xd4, 0x6b, 0x07, 0xb9, 0x50, 0xdd, 0xc0, 0x78, 0x9c, 0x11, 0x69, 0x62,
+	0xec, 0x4b, 0xed, 0x6f, 0x54, 0x5b, 0x8e, 0x8d, 0xe1, 0x3a, 0xeb, 0x97, 0x53, 0x31, 0xe0, 0x10,
+	0x1e, 0xbf, 0x88, 0x4d, 0x47, 0x8a, 0x89, 0xd3, 0xd2, 0xdd, 0xc6, 0x69, 0x53, 0x92, 0x5a, 0xa4,
+	0x8b, 0x9e, 0x5a, 0x2a, 0x5b, 0x46, 0xad, 0xea, 0x8f, 0x63, 0x99, 0xb5, 0x3d, 0x16, 0x14, 0x64,
+	0xb5, 0x11, 0xd6, 0x25, 0x91, 0x9e, 0xc7, 0x0e, 0xf5, 0x18, 0xcc, 0x26, 0xf2, 0x22, 0xd6, 0xfa,
+	0x8f, 0x29, 0x38, 0x2d, 0x60, 0x0c, 0x77, 0xeb, 0xc0, 0xb7, 0xd8, 0xdf, 0x49, 0xc1, 0x94, 0x58,
+	0xf5, 0x5d, 0xa4, 0x57, 0x8e, 0xbb, 0xd2, 0xbe, 0xd2, 0xe9, 0x06, 0xb4, 0x9b, 0x10, 0x9e, 0x57,
+	0xc3, 0x80, 0x52, 0xce, 0xe6, 0xe1, 0x4c, 0x7b, 0x12, 0xad, 0x2f, 0x23, 0x7f, 0x9b, 0x82, 0x59,
+	0x8d, 0x6c, 0x5b, 0x3b, 0x84, 0x53, 0xda, 0x67, 0x82, 0xfc, 0xfe, 0xc5, 0xee, 0xe1, 0x08, 0x3c,
+	0xdd, 0x14, 0x81, 0xab, 0x2a, 0x35, 0x7b, 0x49, 0xd3, 0x17, 0x7b, 0xff, 0xeb, 0x14, 0x1c, 0xbb,
+	0x41, 0xec, 0x6d, 0xc3, 0xc4, 0xd6, 0x83, 0xec, 0xba, 0x05, 0x23, 0xae, 0xa4, 0xd3, 0xb4, 0xd9,
+	0x0b, 0x6d, 0x37, 0xbb, 0xed, 0x0c, 0xb4, 0x82, 0x47, 0x5c, 0x6e, 0xf0, 0x09, 0x50, 0x5b, 0xa1,
+	0x09, 0xfe, 0x3e, 0xea, 0x81, 0x23, 0x2c, 0x61, 0x77, 0xc0, 0xba, 0x0c, 0x9b, 0xd2, 0xe8, 0xba,
+	0x2e, 0xa3, 0xe5, 0xc8, 0xda, 0x20, 0x23, 0x2a, 0xe7, 0x71, 0x09, 0x8e, 0x86, 0x13, 0x2c, 0x89,
+	0xe9, 0xa3, 0xc3, 0xc1, 0x9c, 0xc9, 0x5a, 0x73, 0x2a, 0xe9, 0x09, 0x98, 0xb4, 0x89, 0x5e, 0xaf,
+	0xd7, 0x68, 0x8e, 0xa7, 0x52, 0x6b, 0x54, 0x49, 0x99, 0xab, 0x88, 0x23, 0x8c, 0xc4, 0xb8, 0xe8,
+	0x5e, 0xe6, 0xbd, 0x5c, 0x38, 0x1c, 0x6a, 0x58, 0x9a, 0xf1, 0xf4, 0x5a, 0x8d, 0x9d, 0x09, 0xd0,
+	0xb0, 0x84, 0x71, 0xe6, 0x6b, 0x35, 0x7a, 0x2e, 0xac, 0xe2, 0x41, 0x1f, 0x55, 0x85, 0x05, 0xfb,
+	0x59, 0xad, 0x0f, 0x5f, 0xb5, 0x86, 0xa9, 0xfe, 0x28, 0x05, 0x33, 0x49, 0x8c, 0xb7, 0x54, 0x38,
+	0xe5, 0x55, 0xe8, 0x67, 0xd9, 0x34, 0xb2, 0x1b, 0x0d, 0x1c, 0xda, 0x44, 0xb7, 0x71, 0xc3, 0xad,
+	0x72, 0x5a, 0x9a, 0x24, 0xaa, 0xbe, 0xd5, 0x9b, 0x24, 0x0c, 0x02, 0x54, 0x99, 0x81, 0x81, 0x75,
+	0xdd, 0x21, 0xe5, 0xd0, 0xec, 0x72, 0xb4, 0x49, 0x63, 0x33, 0x7c, 0x2e, 0x90, 0x9c, 0x62, 0x9b,
+	0xb4, 0x61, 0x98, 0x86, 0xb3, 0xd5, 0x9c, 0x14, 0x9c, 0x0a, 0xee, 0xd0, 0x25, 0x06, 0x22, 0xb7,
+	0xe7, 0xa1, 0x80, 0x9e, 0x54, 0xe5, 0x38, 0x5c, 0x75, 0x87, 0xfd, 0x0e, 0x3e, 0xda, 0x55, 0xc8,
+	0x57, 0x6d, 0xab, 0x5e, 0x97, 0x22, 0x40, 0x77, 0x30, 0xdd, 0xf9, 0xc9, 0x75, 0x48, 0x20, 0xb3,
+	0x37, 0x47, 0xb9, 0x05, 0x23, 0x22, 0x7c, 0xd1, 0xb9, 0xe7, 0xa5, 0x89, 0xa1, 0x0c, 0x23, 0x78,
+	0x36, 0x39, 0x85, 0xb2, 0x4a, 0xcc, 0xaa, 0x61, 0x6e, 0x4a, 0x67, 0xcd, 0x93, 0x76, 0x9c, 0xce,
+	0xbc, 0x47, 0x06, 0x77, 0xae, 0x50, 0xe7, 0x80, 0x65, 0xe6, 0x82, 0xf0, 0xdc, 0x89, 0x52, 0x41,
+	0x49, 0x5f, 0x68, 0x4b, 0x7a, 0x91, 0x22, 0x84, 0xcb, 0x89, 0x86, 0xeb, 0x81, 0x2e, 0xa4, 0xa5,
+	0xac, 0x42, 0x81, 0x4b, 0xa0, 0xe1, 0xaf, 0x45, 0x7f, 0x37, 0x6b, 0x31, 0xec, 0xa1, 0xf3, 0xd5,
+	0x50, 0x7f, 0x98, 0x86, 0x93, 0x42, 0xf5, 0x78, 0xf0, 0x71, 0x10, 0x03, 0xb1, 0x9d, 0x10, 0x40,
+	0x5d, 0xea, 0xc0, 0x42, 0x74, 0x30, 0x85, 0xa6, 0x18, 0x4a, 0x79, 0x26, 0x10, 0x6e, 0x88, 0x42,
+	0xa6, 0xa8, 0x95, 0x28, 0x4a, 0x90, 0x15, 0x09, 0x21, 0x45, 0xb0, 0x4d, 0xb4, 0xd2, 0x7b, 0xff,
+	0xa3, 0x95, 0x4c, 0x52, 0xb4, 0x72, 0x06, 0x4e, 0xb5, 0x5b, 0x11, 0x61, 0xd8, 0xff, 0x90, 0x82,
+	0x43, 0xd2, 0xf6, 0x05, 0x4f, 0x7b, 0x9f, 0x08, 0xc7, 0x7c, 0x01, 0x26, 0x0c, 0xa7, 0x1c, 0x53,
+	0x62, 0xc5, 0xf6, 0x26, 0xab, 0x8d, 0x1a, 0xce, 0xa5, 0xe6, 0xda, 0x29, 0x7a, 0xb5, 0x14, 0xcf,
+	0x90, 0xe0, 0xf8, 0x3f, 0x3d, 0xf4, 0xbc, 0x43, 0x4f, 0x7f, 0x61, 0x8d, 0xd9, 0xcf, 0x59, 0xed,
+	0xfe, 0xb1, 0x8e, 0x83, 0xfb, 0x22, 0xe9, 0x5f, 0x56, 0x7b, 0x6d, 0x38, 0xf8, 0x2d, 0x14, 0x0a,
+	0x39, 0xe7, 0x83, 0xc8, 0x9d, 0xe2, 0x51, 0xf1, 0x87, 0x5f, 0xf5, 0x0e, 0xa1, 0xec, 0x6a, 0x83,
+	0xa5, 0xfb, 0x32, 0xdd, 0xa4, 0xfb, 0x86, 0x7d, 0x74, 0xd6, 0xa0, 0x9e, 0xa6, 0x76, 0xa2, 0xe5,
+	0xaa, 0x8b, 0xfd, 0xf9, 0x19, 0x1e, 0x33, 0x97, 0x88, 0x53, 0xb1, 0x8d, 0xf5, 0x03, 0x45, 0x52,
+	0xaf, 0x40, 0x7f, 0xb7, 0xe7, 0xcb, 0x76, 0xc3, 0x6a, 0x92, 0xa2, 0xfa, 0x5e, 0x2f, 0x1c, 0x6b,
+	0x01, 0x2d, 0xfc, 0xf3, 0x57, 0xa0, 0xe0, 0x5f, 0xbd, 0x54, 0x2c, 0x73, 0xc3, 0xd8, 0x14, 0xf9,
+	0xa6, 0x47, 0xdb, 0x27, 0xdb, 0x03, 0x4b, 0x44, 0x11, 0xb5, 0x61, 0x12, 0x6e, 0x50, 0x36, 0x61,
+	0x32, 0xe6, 0x86, 0x87, 0xdd, 0x27, 0x71, 0x86, 0xe7, 0xba, 0x18, 0x84, 0xf9, 0x8b, 0xf1, 0xdd,
+	0xb8, 0x66, 0x64, 0x43, 0x91, 0x5e, 0x29, 0xe0, 0xf2, 0xd2, 0xfb, 0x71, 0x79, 0x23, 0xf5, 0x50,
+	0x63, 0x92, 0xcf, 0xeb, 0xbd, 0x87, 0x3e, 0x2f, 0xfe, 0x36, 0x25, 0xd3, 0xfd, 0x6d, 0x4a, 0x5f,
+	0x8b, 0xdb, 0x94, 0x09, 0xe8, 0xab, 0xeb, 0x0d, 0xc7, 0xbb, 0xfe, 0x13, 0x6f, 0x54, 0x4e, 0xd9,
+	0x13, 0x3a, 0x34, 0xdd, 0x41, 0xe5, 0xcc, 0x72, 0x39, 0x65, 0x6d, 0x1a, 0x6b, 0x52, 0xbf, 0x95,
+	0x86, 0xa2, 0x26, 0xea, 0xb0, 0x09, 0xf7, 0xaa, 0x37, 0xcf, 0x7f, 0x22, 0x6c, 0xd0, 0x06, 0x8c,
+	0x87, 0xab, 0x2b, 0xf6, 0xca, 0x06, 0xd2, 0x93, 0x5b, 0x7f, 0xbe, 0xab, 0x0a, 0x8b, 0xbd, 0x15,
+	0x84, 0xd6, 0x46, 0x77, 0x22, 0x6d, 0x8e, 0xf2, 0x14, 0xf4, 0x79, 0x71, 0x59, 0xcb, 0xcc, 0xf9,
+	0x92, 0xee, 0xea, 0x0b, 0x35, 0x6b, 0x5d, 0x13, 0xf0, 0x18, 0xec, 0xe7, 0x69, 0x15, 0x32, 0x0d,
+	0xff, 0x04, 0x85, 0x4c, 0x87, 0x14, 0x06, 0x11, 0x0f, 0xa3, 0x43, 0x11, 0xc5, 0x1c, 0x82, 0xa9,
+	0x98, 0x2d, 0x10, 0x06, 0xe9, 0xa7, 0x29, 0x98, 0x58, 0xdb, 0x33, 0x2b, 0x6b, 0x5b, 0xba, 0x5d,
+	0x15, 0x35, 0x17, 0x62, 0x7b, 0x4e, 0x42, 0xde, 0xb1, 0x1a, 0x36, 0xee, 0x0d, 0xc6, 0xf3, 0x0e,
+	0x7a, 0x6f, 0xb1, 0x41, 0x43, 0xbc, 0x75, 0x91, 0x37, 0x2a, 0x53, 0x90, 0x75, 0x28, 0xb2, 0x1f,
+	0xd9, 0xf6, 0xb3, 0x77, 0xdc, 0xbd, 0x79, 0x18, 0xe0, 0xc5, 0x1f, 0xfc, 0x52, 0x22, 0xdd, 0xe1,
+	0xa5, 0x04, 0x70, 0x24, 0xda, 0xac, 0x4e, 0xc1, 0x64, 0x64, 0x7a, 0x32, 0x25, 0x91, 0x81, 0x51,
+	0xda, 0x27, 0x75, 0xb0, 0x0b, 0xb1, 0x9a, 0x85, 0x01, 0x4f, 0xac, 0xc4, 0xb4, 0x73, 0x1a, 0xc8,
+	0x26, 0x04, 0xf0, 0x0f, 0x1f, 0xe9, 0xe0, 0xe1, 0xa3, 0x08, 0xfd, 0xf2, 0x0a, 0x98, 0xdf, 0x73,
+	0xc9, 0x57, 0x3a, 0xa8, 0x7f, 0x16, 0xf3, 0x6f, 0xdc, 0xbd, 0x36, 0x56, 0x5f, 0xd2, 0x7c, 0xf1,
+	0xdb, 0xb7, 0xbf, 0x8b, 0x5f, 0x3c, 0xd2, 0xcb, 0x4c, 0xbf, 0xc1, 0x75, 0x32, 0xad, 0xe5, 0x44,
+	0x0b, 0xab, 0xbe, 0x0a, 0x5f, 0x3e, 0x65, 0xf7, 0x73, 0xf9, 0xb4, 0x2a, 0x2a, 0xbe, 0xfc, 0xe4,
+	0x35, 0xa3, 0x95, 0xeb, 0x90, 0xd6, 0x08, 0x45, 0xf6, 0x92, 0xce, 0x8c, 0xe2, 0x45, 0x3c, 0x0b,
+	0x8a, 0x3b, 0x24, 0xe8, 0xf0, 0x0e, 0x49, 0x22, 0x04, 0xaf, 0xc2, 0x06, 0xc2, 0x57, 0x61, 0xc8,
+	0x2c, 0xaf, 0x4c, 0x13, 0x75, 0xf4, 0x83, 0x1d, 0xd6, 0xd1, 0x0f, 0xb0, 0xa2, 0x35, 0x51, 0x42,
+	0x7f, 0x0e, 0x58, 0x09, 0x3c, 0x0b, 0xc3, 0x88, 0x8d, 0x8b, 0x8a, 0x4a, 0x82, 0x02, 0xc5, 0x6e,
+	0xd4, 0x73, 0x9a, 0x42, 0xfb, 0x5e, 0x62, 0x5d, 0x2b, 0xa2, 0x87, 0xd6, 0x37, 0x35, 0x59, 0x0f,
+	0x51, 0x99, 0x55, 0xea, 0xce, 0x6e, 0x68, 0xf9, 0xb0, 0xcd, 0x50, 0x27, 0x60, 0x2c, 0x2c, 0xd3,
+	0x42, 0xd8, 0x69, 0x7d, 0x93, 0xf4, 0xc9, 0x1f, 0x73, 0x11, 0xa6, 0xfa, 0x6e, 0x0a, 0x0e, 0xc7,
+	0xcf, 0x45, 0x84, 0x06, 0x34, 0xa2, 0xd7, 0x51, 0x64, 0xcb, 0xdb, 0xbc, 0x57, 0xd4, 0x97, 0xf1,
+	0x39, 0x8d, 0xb0, 0xae, 0x20, 0x9e, 0xf2, 0x18, 0x4c, 0x54, 0xd1, 0x76, 0xb1, 0x53, 0x75, 0x18,
+	0x85, 0x6b, 0xe6, 0x98, 0xec, 0x0d, 0x61, 0xd1, 0x4b, 0x67, 0x9b, 0x10, 0x5f, 0x49, 0xfb, 0xe8,
+	0x2b, 0x32, 0x7a, 0x08, 0x72, 0xa2, 0x08, 0x43, 0xdc, 0x47, 0xe7, 0xb4, 0x2c, 0x6f, 0x58, 0xa9,
+	0xaa, 0x7f, 0x4a, 0xc1, 0xb4, 0x9c, 0xbc, 0x58, 0xf4, 0x2b, 0x96, 0x13, 0xbc, 0xd2, 0xd9, 0xc2,
+	0xd7, 0xb2, 0x5e, 0x45, 0xff, 0xea, 0x38, 0x72, 0x1d, 0x69, 0xdb, 0x3c, 0x6f, 0x8a, 0x18, 0xbc,
+	0x8c, 0x6f, 0xf0, 0x9a, 0x77, 0x21, 0xdd, 0xa9, 0x47, 0xeb, 0x3d, 0xb8, 0x47, 0x53, 0xef, 0xf4,
+	0xf8, 0x22, 0x12, 0xe2, 0x4c, 0xec, 0xca, 0x71, 0x18, 0x62, 0xf3, 0x44, 0x87, 0xdf, 0xd8, 0x5e,
+	0x17, 0xe6, 0x3c, 0xa3, 0x0d, 0xf2, 0xc6, 0xeb, 0xac, 0x8d, 0xae, 0x9d, 0x64, 0xce, 0x41, 0xee,
+	0xd2, 0x08, 0x90, 0x15, 0xdc, 0xd1, 0x0a, 0xe9, 0x61, 0x9f, 0x3d, 0xb6, 0x8d, 0x2d, 0x3f, 0x08,
+	0xf2, 0x60, 0x29, 0x0b, 0xde, 0x6d, 0xec, 0x22, 0xc5, 0x63, 0xd1, 0x4c, 0xde, 0x0c, 0xb5, 0xd1,
+	0xac, 0x14, 0x1f, 0x9b, 0x06, 0x27, 0xb6, 0x55, 0xab, 0xa1, 0x2e, 0x8a, 0xe2, 0x41, 0xbe, 0x8b,
+	0xe3, 0xac, 0x7b, 0xd1, 0xeb, 0x15, 0x85, 0xdb, 0xd4, 0x3a, 0x88, 0xed, 0xe2, 0x15, 0x06, 0xf2,
+	0x55, 0x2d, 0xc1, 0xc8, 0x62, 0xcd, 0x72, 0x08, 0x73, 0x1f, 0x72, 0x8b, 0x83, 0xfb, 0x97, 0x0a,
+	0xed, 0x9f, 0x3a, 0x06, 0x4a, 0x10, 0x5e, 0xd6, 0xeb, 0xa5, 0x60, 0x84, 0x27, 0x49, 0x83, 0x87,
+	0xc7, 0x64, 0x32, 0xe8, 0xb9, 0xb3, 0xd4, 0xd9, 0x6e, 0x52, 0xb3, 0xd0, 0xc3, 0xca, 0x1e, 0x1f,
+	0x6a, 0x5d, 0x54, 0xc9, 0xaf, 0x37, 0x38, 0x86, 0xe6, 0xe1, 0x06, 0xcb, 0x2a, 0xd2, 0xa1, 0xb2,
+	0x8a, 0x15, 0x34, 0x3f, 0x86, 0x63, 0xac, 0x1b, 0x35, 0xb4, 0x11, 0xdd, 0xdd, 0xf8, 0xe7, 0x7d,
+	0x44, 0xe6, 0x60, 0x91, 0xe5, 0x20, 0x6f, 0x82, 0xe5, 0x3b, 0x29, 0x38, 0x72, 0x99, 0xe6, 0x1d,
+	0xbd, 0x4f, 0xe8, 0xae, 0xf1, 0xcf, 0xe7, 0xbc, 0xe8, 0xe0, 0x2a, 0xf4, 0xb1, 0x12, 0x27, 0xaa,
+	0x22, 0xe9, 0x44, 0x11, 0x08, 0x7c, 0x83, 0xc7, 0x33, 0x19, 0xde, 0x2b, 0x2b, 0x86, 0xd2, 0x04,
+	0x0d, 0xaa, 0x38, 0x22, 0xc8, 0x60, 0xf7, 0xf9, 0x42, 0xef, 0x07, 0x44, 0x1b, 0x95, 0x1d, 0xf5,
+	0xed, 0x1e, 0x98, 0x49, 0x9a, 0x92, 0x90, 0xf0, 0x6f, 0xa0, 0x87, 0x65, 0x5b, 0x22, 0xbe, 0xf5,
+	0x93, 0x73, 0x7b, 0xb9, 0xc3, 0x14, 0x61, 0x6b, 0xf2, 0x25, 0x26, 0x15, 0xb2, 0x95, 0x97, 0x35,
+	0x71, 0x8d, 0x92, 0x6d, 0xd3, 0x7b, 0xa0, 0x44, 0x81, 0x82, 0x25, 0x43, 0x19, 0x5e, 0x32, 0x74,
+	0x2d, 0x5c, 0x32, 0xf4, 0x64, 0x97, 0x6b, 0xe7, 0xcd, 0x2c, 0x50, 0x45, 0xf4, 0x26, 0x1c, 0xc5,
+	0xe9, 0x2f, 0x5d, 0x7d, 0xa1, 0xc5, 0x9e, 0xdd, 0x14, 0x75, 0xd9, 0xf4, 0x18, 0x25, 0xd7, 0xa6,
+	0xdb, 0xb1, 0xbd, 0xaa, 0x3c, 0x56, 0xaa, 0x4d, 0x9f, 0x1c, 0xf5, 0xbb, 0x29, 0x38, 0xd6, 0x62,
+	0x70, 0xb1, 0x3b, 0xaf, 0xc1, 0x48, 0x80, 0x2c, 0x4b, 0x75, 0xc8, 0x49, 0x5c, 0xd8, 0xc7, 0x24,
+	0xb4, 0x82, 0x1d, 0x6e, 0x70, 0xd4, 0xef, 0xa5, 0x60, 0x8c, 0x95, 0x57, 0x49, 0x7b, 0xd9, 0x85,
+	0x77, 0x7c, 0xbe, 0xf9, 0x44, 0xfd, 0x78, 0xdb, 0x13, 0x75, 0xdc, 0x50, 0xfe, 0x29, 0xfa, 0x36,
+	0x8c, 0x37, 0x01, 0x88, 0x75, 0xd0, 0x20, 0xdb, 0x54, 0xa0, 0xf1, 0x44, 0xb7, 0x43, 0x89, 0x72,
+	0x0c, 0x8f, 0x8e, 0xfa, 0x03, 0xe4, 0x5c, 0x13, 0xe9, 0x77, 0x16, 0xe2, 0x77, 0xc1, 0xf9, 0x5a,
+	0x33, 0xe7, 0xf1, 0xa5, 0x9a, 0xc1, 0x8f, 0x5c, 0xf9, 0x76, 0x44, 0x87, 0xf3, 0xb9, 0x9f, 0x84,
+	0xf1, 0x26, 0x00, 0x31, 0xd3, 0x5f, 0xf4, 0xc0, 0x38, 0x97, 0x95, 0x66, 0xe9, 0x5c, 0x86, 0x5e,
+	0xaf, 0x14, 0x37, 0x1f, 0x4c, 0x22, 0xc4, 0x59, 0xcc, 0x25, 0xa2, 0x57, 0xaf, 0x12, 0x0c, 0x02,
+	0x6d, 0x56, 0xfb, 0xc5, 0x6a, 0x84, 0x18, 0x7a, 0x2b, 0xf7, 0x1c, 0x3d, 0xd1, 0xa4, 0xe3, 0x4e,
+	0x34, 0x4f, 0x42, 0xd1, 0x30, 0x29, 0x84, 0xb1, 0x43, 0x3f, 0xcf, 0xf1, 0xcc, 0x89, 0x5f, 0xde,
+	0x36, 0xee, 0xf5, 0x2f, 0x9b, 0x52, 0xd9, 0x79, 0xde, 0x7e, 0x5b, 0x7f, 0xc3, 0xd8, 0x6e, 0x6c,
+	0x97, 0xeb, 0x14, 0x9e, 0x1e, 0xc9, 0x99, 0x4b, 0xca, 0x68, 0xc3, 0xa2, 0x63, 0x15, 0xdb, 0xe9,
+	0x81, 0x5c, 0x39, 0x85, 0xbe, 0x94, 0xd6, 0xe8, 0x32, 0x40, 0x5e, 0x2c, 0xda, 0xc7, 0x8a, 0x45,
+	0x59, 0xe9, 0x2e, 0x05, 0xe3, 0x9f, 0xa2, 0xfc, 0x93, 0x7f, 0xed, 0x18, 0x5a, 0x2f, 0x21, 0x48,
+	0xf7, 0x68, 0xc1, 0x62, 0xf5, 0xb2, 0xe7, 0x1e, 0xea, 0x65, 0x1c, 0xaf, 0xe9, 0x38, 0x5e, 0xff,
+	0x42, 0xbf, 0x32, 0x6a, 0xd8, 0x9b, 0xe4, 0xb3, 0x28, 0x1d, 0xea, 0x34, 0x14, 0xa3, 0xcc, 0xc9,
+	0xf2, 0x93, 0x1e, 0x98, 0xbc, 0x46, 0x3e, 0xa3, 0x9c, 0xdf, 0x17, 0xbd, 0x58, 0x80, 0x62, 0x74,
+	0xc1, 0x84, 0x62, 0xc4, 0xd0, 0x48, 0xc5, 0xd1, 0x78, 0x9b, 0x7d, 0x34, 0xb2, 0x81, 0x56, 0x74,
+	0x2b, 0x98, 0x4d, 0xef, 0xc6, 0x78, 0xde, 0x6a, 0x36, 0x9e, 0xcf, 0x75, 0x68, 0x3c, 0x13, 0x47,
+	0xf5, 0x6d, 0x28, 0xfb, 0x8e, 0x24, 0x0e, 0xce, 0xaf, 0x52, 0x9b, 0x59, 0x22, 0x34, 0xc1, 0x7c,
+	0x90, 0x54, 0xf2, 0xfd, 0x4b, 0xb1, 0x4d, 0x43, 0xd6, 0x3b, 0x4a, 0x73, 0x81, 0xf2, 0xde, 0x69,
+	0xc9, 0x49, 0xe2, 0xd4, 0x05, 0x7b, 0xbf, 0xc7, 0x80, 0x8f, 0x17, 0x65, 0x7f, 0x32, 0xd9, 0xc3,
+	0xa3, 0x12, 0x2f, 0x12, 0xf7, 0x4f, 0x7a, 0x59, 0xde, 0x80, 0xc3, 0x2a, 0xd0, 0xcb, 0x02, 0x59,
+	0x7e, 0x70, 0x61, 0xcf, 0x78, 0xbe, 0xc9, 0x18, 0x66, 0xbd, 0xe1, 0x76, 0x5c, 0x43, 0xcb, 0xc1,
+	0x43, 0xeb, 0xd8, 0x17, 0x5e, 0x47, 0xaa, 0x5a, 0xbb, 0xba, 0xe1, 0x96, 0x37, 0x2c, 0xbb, 0xac,
+	0x57, 0x2a, 0xa4, 0xee, 0x7a, 0x69, 0xda, 0x61, 0xda, 0x71, 0xc9, 0xb2, 0xe7, 0x45, 0xb3, 0xfa,
+	0xab, 0x14, 0xcc, 0x26, 0x2e, 0xa8, 0x50, 0x9d, 0xc3, 0x90, 0xf3, 0x6e, 0x4c, 0x44, 0x3d, 0xa0,
+	0xdf, 0x40, 0x93, 0x99, 0xe2, 0xff, 0x16, 0xe8, 0xe9, 0x90, 0x05, 0x01, 0x4f, 0xb3, 0x3f, 0x32,
+	0x45, 0x93, 0xee, 0x30, 0x45, 0x23, 0x11, 0xd4, 0x73, 0x30, 0x3a, 0x5f, 0x79, 0xbd, 0x61, 0xd8,
+	0x1d, 0x9f, 0xe3, 0x26, 0x60, 0x2c, 0x8c, 0x21, 0x44, 0xea, 0x2e, 0x1e, 0x6b, 0x56, 0x69, 0x7a,
+	0xfa, 0x53, 0xa7, 0x30, 0x34, 0x09, 0x2f, 0xd2, 0xec, 0x5c, 0xa4, 0xc4, 0x9b, 0x7a, 0x14, 0x66,
+	0x92, 0x38, 0x12, 0x4c, 0xbf, 0x4b, 0xb7, 0xdd, 0xac, 0x7f, 0x2a, 0xd9, 0xa6, 0xf5, 0x49, 0xc9,
+	0x73, 0xe7, 0x0c, 0x2e, 0xd4, 0xef, 0x7e, 0x30, 0xf3, 0xc0, 0xfb, 0xf8, 0xfb, 0xe8, 0x83, 0x99,
+	0xd4, 0x37, 0x3f, 0x9c, 0x49, 0xfd, 0x1c, 0x7f, 0xef, 0xe1, 0xef, 0x2e, 0xfe, 0xfe, 0x86, 0xbf,
+	0x7f, 0x7c, 0x88, 0x7d, 0xf8, 0xef, 0x9d, 0xbf, 0xcf, 0x3c, 0x70, 0x17, 0x7f, 0xef, 0xe3, 0xef,
+	0xd6, 0xc5, 0x4d, 0xcb, 0x9f, 0xb5, 0x61, 0xb5, 0xfc, 0x0f, 0x92, 0xbe, 0x10, 0x6e, 0x59, 0xef,
+	0x63, 0xc7, 0xeb, 0x0b, 0xff, 0x05, 0x2e, 0xa5, 0x36, 0x4f, 0x5f, 0x49, 0x00, 0x00,
+}
+
+func (this *StartWorkflowExecutionRequest) Equal(that interface{}) bool {
 	if that == nil {
 		return this == nil
 	}
 
-	that1, ok := that.(*RecordWorkflowTaskStartedResponse)
+	that1, ok := that.(*StartWorkflowExecutionRequest)
 	if !ok {
-		that2, ok := that.(RecordWorkflowTaskStartedResponse)
+		that2, ok := that.(StartWorkflowExecutionRequest)
 		if ok {
 			that1 = &that2
 		} else {
@@ -4923,71 +5336,77 @@ func (this *RecordWorkflowTaskStartedResponse) Equal(that interface{}) bool {
 	} else if this == nil {
 		return false
 	}
-	if !this.WorkflowType.Equal(that1.WorkflowType) {
-		return false
-	}
-	if this.PreviousStartedEventId != that1.PreviousStartedEventId {
-		return false
-	}
-	if this.ScheduledEventId != that1.ScheduledEventId {
+	if this.NamespaceId != that1.NamespaceId {
 		return false
 	}
-	if this.StartedEventId != that1.StartedEventId {
+	if !this.StartRequest.Equal(that1.StartRequest) {
 		return false
 	}
-	if this.NextEventId != that1.NextEventId {
+	if !this.ParentExecutionInfo.Equal(that1.ParentExecutionInfo) {
 		return false
 	}
 	if this.Attempt != that1.Attempt {
 		return false
 	}
-	if this.StickyExecutionEnabled != that1.StickyExecutionEnabled {
-		return false
-	}
-	if !this.WorkflowTaskInfo.Equal(that1.WorkflowTaskInfo) {
+	if that1.WorkflowExecutionExpirationTime == nil {
+		if this.WorkflowExecutionExpirationTime != nil {
+			return false
+		}
+	} else if !this.WorkflowExecutionExpirationTime.Equal(*that1.WorkflowExecutionExpirationTime) {
 		return false
 	}
-	if !this.WorkflowExecutionTaskQueue.Equal(that1.WorkflowExecutionTaskQueue) {
+	if this.ContinueAsNewInitiator != that1.ContinueAsNewInitiator {
 		return false
 	}
-	if this.EventStoreVersion != that1.EventStoreVersion {
+	if !this.ContinuedFailure.Equal(that1.ContinuedFailure) {
 		return false
 	}
-	if !bytes.Equal(this.BranchToken, that1.BranchToken) {
+	if !this.LastCompletionResult.Equal(that1.LastCompletionResult) {
 		return false
 	}
-	if that1.ScheduledTime == nil {
-		if this.ScheduledTime != nil {
+	if this.FirstWorkflowTaskBackoff != nil && that1.FirstWorkflowTaskBackoff != nil {
+		if *this.FirstWorkflowTaskBackoff != *that1.FirstWorkflowTaskBackoff {
 			return false
 		}
-	} else if !this.ScheduledTime.Equal(*that1.ScheduledTime) {
+	} else if this.FirstWorkflowTaskBackoff != nil {
+		return false
+	} else if that1.FirstWorkflowTaskBackoff != nil {
 		return false
 	}
-	if that1.StartedTime == nil {
-		if this.StartedTime != nil {
+	return true
+}
+func (this *StartWorkflowExecutionResponse) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*StartWorkflowExecutionResponse)
+	if !ok {
+		that2, ok := that.(StartWorkflowExecutionResponse)
+		if ok {
+			that1 = &that2
+		} else {
 			return false
 		}
-	} else if !this.StartedTime.Equal(*that1.StartedTime) {
-		return false
 	}
-	if len(this.Queries) != len(that1.Queries) {
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
 		return false
 	}
-	for i := range this.Queries {
-		if !this.Queries[i].Equal(that1.Queries[i]) {
-			return false
-		}
+	if this.RunId != that1.RunId {
+		return false
 	}
 	return true
 }
-func (this *RecordActivityTaskStartedRequest) Equal(that interface{}) bool {
+func (this *GetMutableStateRequest) Equal(that interface{}) bool {
 	if that == nil {
 		return this == nil
 	}
 
-	that1, ok := that.(*RecordActivityTaskStartedRequest)
+	that1, ok := that.(*GetMutableStateRequest)
 	if !ok {
-		that2, ok := that.(RecordActivityTaskStartedRequest)
+		that2, ok := that.(GetMutableStateRequest)
 		if ok {
 			that1 = &that2
 		} else {
@@ -5002,31 +5421,25 @@ func (this *RecordActivityTaskStartedRequest) Equal(that interface{}) bool {
 	if this.NamespaceId != that1.NamespaceId {
 		return false
 	}
-	if !this.WorkflowExecution.Equal(that1.WorkflowExecution) {
-		return false
-	}
-	if this.ScheduleId != that1.ScheduleId {
-		return false
-	}
-	if this.TaskId != that1.TaskId {
+	if !this.Execution.Equal(that1.Execution) {
 		return false
 	}
-	if this.RequestId != that1.RequestId {
+	if this.ExpectedNextEventId != that1.ExpectedNextEventId {
 		return false
 	}
-	if !this.PollRequest.Equal(that1.PollRequest) {
+	if !bytes.Equal(this.CurrentBranchToken, that1.CurrentBranchToken) {
 		return false
 	}
 	return true
 }
-func (this *RecordActivityTaskStartedResponse) Equal(that interface{}) bool {
+func (this *GetMutableStateResponse) Equal(that interface{}) bool {
 	if that == nil {
 		return this == nil
 	}
 
-	that1, ok := that.(*RecordActivityTaskStartedResponse)
+	that1, ok := that.(*GetMutableStateResponse)
 	if !ok {
-		that2, ok := that.(RecordActivityTaskStartedResponse)
+		that2, ok := that.(GetMutableStateResponse)
 		if ok {
 			that1 = &that2
 		} else {
@@ -5038,45 +5451,70 @@ func (this *RecordActivityTaskStartedResponse) Equal(that interface{}) bool {
 	} else if this == nil {
 		return false
 	}
-	if !this.ScheduledEvent.Equal(that1.ScheduledEvent) {
+	if !this.Execution.Equal(that1.Execution) {
 		return false
 	}
-	if that1.StartedTime == nil {
-		if this.StartedTime != nil {
-			return false
-		}
-	} else if !this.StartedTime.Equal(*that1.StartedTime) {
+	if !this.WorkflowType.Equal(that1.WorkflowType) {
 		return false
 	}
-	if this.Attempt != that1.Attempt {
+	if this.NextEventId != that1.NextEventId {
 		return false
 	}
-	if that1.CurrentAttemptScheduledTime == nil {
-		if this.CurrentAttemptScheduledTime != nil {
+	if this.PreviousStartedEventId != that1.PreviousStartedEventId {
+		return false
+	}
+	if this.LastFirstEventId != that1.LastFirstEventId {
+		return false
+	}
+	if !this.TaskQueue.Equal(that1.TaskQueue) {
+		return false
+	}
+	if !this.StickyTaskQueue.Equal(that1.StickyTaskQueue) {
+		return false
+	}
+	if this.StickyTaskQueueScheduleToStartTimeout != nil && that1.StickyTaskQueueScheduleToStartTimeout != nil {
+		if *this.StickyTaskQueueScheduleToStartTimeout != *that1.StickyTaskQueueScheduleToStartTimeout {
 			return false
 		}
-	} else if !this.CurrentAttemptScheduledTime.Equal(*that1.CurrentAttemptScheduledTime) {
+	} else if this.StickyTaskQueueScheduleToStartTimeout != nil {
+		return false
+	} else if that1.StickyTaskQueueScheduleToStartTimeout != nil {
 		return false
 	}
-	if !this.HeartbeatDetails.Equal(that1.HeartbeatDetails) {
+	if this.EventStoreVersion != that1.EventStoreVersion {
 		return false
 	}
-	if !this.WorkflowType.Equal(that1.WorkflowType) {
+	if !bytes.Equal(this.CurrentBranchToken, that1.CurrentBranchToken) {
 		return false
 	}
-	if this.WorkflowNamespace != that1.WorkflowNamespace {
+	if this.WorkflowState != that1.WorkflowState {
+		return false
+	}
+	if this.WorkflowStatus != that1.WorkflowStatus {
+		return false
+	}
+	if !this.VersionHistories.Equal(that1.VersionHistories) {
+		return false
+	}
+	if this.IsStickyTaskQueueEnabled != that1.IsStickyTaskQueueEnabled {
+		return false
+	}
+	if this.HistorySizeBytes != that1.HistorySizeBytes {
+		return false
+	}
+	if this.SuggestContinueAsNew != that1.SuggestContinueAsNew {
 		return false
 	}
 	return true
 }
-func (this *RespondWorkflowTaskCompletedRequest) Equal(that interface{}) bool {
+func (this *PollMutableStateRequest) Equal(that interface{}) bool {
 	if that == nil {
 		return this == nil
 	}
 
-	that1, ok := that.(*RespondWorkflowTaskCompletedRequest)
+	that1, ok := that.(*PollMutableStateRequest)
 	if !ok {
-		that2, ok := that.(RespondWorkflowTaskCompletedRequest)
+		that2, ok := that.(PollMutableStateRequest)
 		if ok {
 			that1 = &that2
 		} else {
@@ -5091,19 +5529,25 @@ func (this *RespondWorkflowTaskCompletedRequest) Equal(that interface{}) bool {
 	if this.NamespaceId != that1.NamespaceId {
 		return false
 	}
-	if !this.CompleteRequest.Equal(that1.CompleteRequest) {
+	if !this.Execution.Equal(that1.Execution) {
+		return false
+	}
+	if this.ExpectedNextEventId != that1.ExpectedNextEventId {
+		return false
+	}
+	if !bytes.Equal(this.CurrentBranchToken, that1.CurrentBranchToken) {
 		return false
 	}
 	return true
 }
-func (this *RespondWorkflowTaskCompletedResponse) Equal(that interface{}) bool {
+func (this *PollMutableStateResponse) Equal(that interface{}) bool {
 	if that == nil {
 		return this == nil
 	}
 
-	that1, ok := that.(*RespondWorkflowTaskCompletedResponse)
+	that1, ok := that.(*PollMutableStateResponse)
 	if !ok {
-		that2, ok := that.(RespondWorkflowTaskCompletedResponse)
+		that2, ok := that.(PollMutableStateResponse)
 		if ok {
 			that1 = &that2
 		} else {
@@ -5115,19 +5559,58 @@ func (this *RespondWorkflowTaskCompletedResponse) Equal(that interface{}) bool {
 	} else if this == nil {
 		return false
 	}
-	if !this.StartedResponse.Equal(that1.StartedResponse) {
+	if !this.Execution.Equal(that1.Execution) {
+		return false
+	}
+	if !this.WorkflowType.Equal(that1.WorkflowType) {
+		return false
+	}
+	if this.NextEventId != that1.NextEventId {
+		return false
+	}
+	if this.PreviousStartedEventId != that1.PreviousStartedEventId {
+		return false
+	}
+	if this.LastFirstEventId != that1.LastFirstEventId {
+		return false
+	}
+	if !this.TaskQueue.Equal(that1.TaskQueue) {
+		return false
+	}
+	if !this.StickyTaskQueue.Equal(that1.StickyTaskQueue) {
+		return false
+	}
+	if this.StickyTaskQueueScheduleToStartTimeout != nil && that1.StickyTaskQueueScheduleToStartTimeout != nil {
+		if *this.StickyTaskQueueScheduleToStartTimeout != *that1.StickyTaskQueueScheduleToStartTimeout {
+			return false
+		}
+	} else if this.StickyTaskQueueScheduleToStartTimeout != nil {
+		return false
+	} else if that1.StickyTaskQueueScheduleToStartTimeout != nil {
+		return false
+	}
+	if !bytes.Equal(this.CurrentBranchToken, that1.CurrentBranchToken) {
+		return false
+	}
+	if !this.VersionHistories.Equal(that1.VersionHistories) {
+		return false
+	}
+	if this.WorkflowState != that1.WorkflowState {
+		return false
+	}
+	if this.WorkflowStatus != that1.WorkflowStatus {
 		return false
 	}
 	return true
 }
-func (this *RespondWorkflowTaskFailedRequest) Equal(that interface{}) bool {
+func (this *ResetStickyTaskQueueRequest) Equal(that interface{}) bool {
 	if that == nil {
 		return this == nil
 	}
 
-	that1, ok := that.(*RespondWorkflowTaskFailedRequest)
+	that1, ok := that.(*ResetStickyTaskQueueRequest)
 	if !ok {
-		that2, ok := that.(RespondWorkflowTaskFailedRequest)
+		that2, ok := that.(ResetStickyTaskQueueRequest)
 		if ok {
 			that1 = &that2
 		} else {
@@ -5142,19 +5625,19 @@ func (this *RespondWorkflowTaskFailedRequest) Equal(that interface{}) bool {
 	if this.NamespaceId != that1.NamespaceId {
 		return false
 	}
-	if !this.FailedRequest.Equal(that1.FailedRequest) {
+	if !this.Execution.Equal(that1.Execution) {
 		return false
 	}
 	return true
 }
-func (this *RespondWorkflowTaskFailedResponse) Equal(that interface{}) bool {
+func (this *ResetStickyTaskQueueResponse) Equal(that interface{}) bool {
 	if that == nil {
 		return this == nil
 	}
 
-	that1, ok := that.(*RespondWorkflowTaskFailedResponse)
+	that1, ok := that.(*ResetStickyTaskQueueResponse)
 	if !ok {
-		that2, ok := that.(RespondWorkflowTaskFailedResponse)
+		that2, ok := that.(ResetStickyTaskQueueResponse)
 		if ok {
 			that1 = &that2
 		} else {
@@ -5168,14 +5651,14 @@ func (this *RespondWorkflowTaskFailedResponse) Equal(that interface{}) bool {
 	}
 	return true
 }
-func (this *RecordActivityTaskHeartbeatRequest) Equal(that interface{}) bool {
+func (this *RecordWorkflowTaskStartedRequest) Equal(that interface{}) bool {
 	if that == nil {
 		return this == nil
 	}
 
-	that1, ok := that.(*RecordActivityTaskHeartbeatRequest)
+	that1, ok := that.(*RecordWorkflowTaskStartedRequest)
 	if !ok {
-		that2, ok := that.(RecordActivityTaskHeartbeatRequest)
+		that2, ok := that.(RecordWorkflowTaskStartedRequest)
 		if ok {
 			that1 = &that2
 		} else {
@@ -5190,19 +5673,31 @@ func (this *RecordActivityTaskHeartbeatRequest) Equal(that interface{}) bool {
 	if this.NamespaceId != that1.NamespaceId {
 		return false
 	}
-	if !this.HeartbeatRequest.Equal(that1.HeartbeatRequest) {
+	if !this.WorkflowExecution.Equal(that1.WorkflowExecution) {
+		return false
+	}
+	if this.ScheduleId != that1.ScheduleId {
+		return false
+	}
+	if this.TaskId != that1.TaskId {
+		return false
+	}
+	if this.RequestId != that1.RequestId {
+		return false
+	}
+	if !this.PollRequest.Equal(that1.PollRequest) {
 		return false
 	}
 	return true
 }
-func (this *RecordActivityTaskHeartbeatResponse) Equal(that interface{}) bool {
+func (this *RecordWorkflowTaskStartedResponse) Equal(that interface{}) bool {
 	if that == nil {
 		return this == nil
 	}
 
-	that1, ok := that.(*RecordActivityTaskHeartbeatResponse)
+	that1, ok := that.(*RecordWorkflowTaskStartedResponse)
 	if !ok {
-		that2, ok := that.(RecordActivityTaskHeartbeatResponse)
+		that2, ok := that.(RecordWorkflowTaskStartedResponse)
 		if ok {
 			that1 = &that2
 		} else {
@@ -5214,19 +5709,85 @@ func (this *RecordActivityTaskHeartbeatResponse) Equal(that interface{}) bool {
 	} else if this == nil {
 		return false
 	}
-	if this.CancelRequested != that1.CancelRequested {
+	if !this.WorkflowType.Equal(that1.WorkflowType) {
+		return false
+	}
+	if this.PreviousStartedEventId != that1.PreviousStartedEventId {
+		return false
+	}
+	if this.ScheduledEventId != that1.ScheduledEventId {
+		return false
+	}
+	if this.StartedEventId != that1.StartedEventId {
+		return false
+	}
+	if this.NextEventId != that1.NextEventId {
+		return false
+	}
+	if this.Attempt != that1.Attempt {
+		return false
+	}
+	if this.StickyExecutionEnabled != that1.StickyExecutionEnabled {
+		return false
+	}
+	if !this.WorkflowTaskInfo.Equal(that1.WorkflowTaskInfo) {
+		return false
+	}
+	if !this.WorkflowExecutionTaskQueue.Equal(that1.WorkflowExecutionTaskQueue) {
+		return false
+	}
+	if this.EventStoreVersion != that1.EventStoreVersion {
+		return false
+	}
+	if !bytes.Equal(this.BranchToken, that1.BranchToken) {
+		return false
+	}
+	if that1.ScheduledTime == nil {
+		if this.ScheduledTime != nil {
+			return false
+		}
+	} else if !this.ScheduledTime.Equal(*that1.ScheduledTime) {
+		return false
+	}
+	if that1.StartedTime == nil {
+		if this.StartedTime != nil {
+			return false
+		}
+	} else if !this.StartedTime.Equal(*that1.StartedTime) {
+		return false
+	}
+	if len(this.Queries) != len(that1.Queries) {
+		return false
+	}
+	for i := range this.Queries {
+		if !this.Queries[i].Equal(that1.Queries[i]) {
+			return false
+		}
+	}
+	if this.HistorySizeBytes != that1.HistorySizeBytes {
+		return false
+	}
+	if this.SuggestContinueAsNew != that1.SuggestContinueAsNew {
+		return false
+	}
+	if len(this.Updates) != len(that1.Updates) {
 		return false
 	}
+	for i := range this.Updates {
+		if !this.Updates[i].Equal(that1.Updates[i]) {
+			return false
+		}
+	}
 	return true
 }
-func (this *RespondActivityTaskCompletedRequest) Equal(that interface{}) bool {
+func (this *RecordActivityTaskStartedRequest) Equal(that interface{}) bool {
 	if that == nil {
 		return this == nil
 	}
 
-	that1, ok := that.(*RespondActivityTaskCompletedRequest)
+	that1, ok := that.(*RecordActivityTaskStartedRequest)
 	if !ok {
-		that2, ok := that.(RespondActivityTaskCompletedRequest)
+		that2, ok := that.(RecordActivityTaskStartedRequest)
 		if ok {
 			that1 = &that2
 		} else {
@@ -5241,19 +5802,31 @@ func (this *RespondActivityTaskCompletedRequest) Equal(that interface{}) bool {
 	if this.NamespaceId != that1.NamespaceId {
 		return false
 	}
-	if !this.CompleteRequest.Equal(that1.CompleteRequest) {
+	if !this.WorkflowExecution.Equal(that1.WorkflowExecution) {
+		return false
+	}
+	if this.ScheduleId != that1.ScheduleId {
+		return false
+	}
+	if this.TaskId != that1.TaskId {
+		return false
+	}
+	if this.RequestId != that1.RequestId {
+		return false
+	}
+	if !this.PollRequest.Equal(that1.PollRequest) {
 		return false
 	}
 	return true
 }
-func (this *RespondActivityTaskCompletedResponse) Equal(that interface{}) bool {
+func (this *RecordActivityTaskStartedResponse) Equal(that interface{}) bool {
 	if that == nil {
 		return this == nil
 	}
 
-	that1, ok := that.(*RespondActivityTaskCompletedResponse)
+	that1, ok := that.(*RecordActivityTaskStartedResponse)
 	if !ok {
-		that2, ok := that.(RespondActivityTaskCompletedResponse)
+		that2, ok := that.(RecordActivityTaskStartedResponse)
 		if ok {
 			that1 = &that2
 		} else {
@@ -5265,16 +5838,45 @@ func (this *RespondActivityTaskCompletedResponse) Equal(that interface{}) bool {
 	} else if this == nil {
 		return false
 	}
+	if !this.ScheduledEvent.Equal(that1.ScheduledEvent) {
+		return false
+	}
+	if that1.StartedTime == nil {
+		if this.StartedTime != nil {
+			return false
+		}
+	} else if !this.StartedTime.Equal(*that1.StartedTime) {
+		return false
+	}
+	if this.Attempt != that1.Attempt {
+		return false
+	}
+	if that1.CurrentAttemptScheduledTime == nil {
+		if this.CurrentAttemptScheduledTime != nil {
+			return false
+		}
+	} else if !this.CurrentAttemptScheduledTime.Equal(*that1.CurrentAttemptScheduledTime) {
+		return false
+	}
+	if !this.HeartbeatDetails.Equal(that1.HeartbeatDetails) {
+		return false
+	}
+	if !this.WorkflowType.Equal(that1.WorkflowType) {
+		return false
+	}
+	if this.WorkflowNamespace != that1.WorkflowNamespace {
+		return false
+	}
 	return true
 }
-func (this *RespondActivityTaskFailedRequest) Equal(that interface{}) bool {
+func (this *RespondWorkflowTaskCompletedRequest) Equal(that interface{}) bool {
 	if that == nil {
 		return this == nil
 	}
 
-	that1, ok := that.(*RespondActivityTaskFailedRequest)
+	that1, ok := that.(*RespondWorkflowTaskCompletedRequest)
 	if !ok {
-		that2, ok := that.(RespondActivityTaskFailedRequest)
+		that2, ok := that.(RespondWorkflowTaskCompletedRequest)
 		if ok {
 			that1 = &that2
 		} else {
@@ -5289,19 +5891,19 @@ func (this *RespondActivityTaskFailedRequest) Equal(that interface{}) bool {
 	if this.NamespaceId != that1.NamespaceId {
 		return false
 	}
-	if !this.FailedRequest.Equal(that1.FailedRequest) {
+	if !this.CompleteRequest.Equal(that1.CompleteRequest) {
 		return false
 	}
 	return true
 }
-func (this *RespondActivityTaskFailedResponse) Equal(that interface{}) bool {
+func (this *RespondWorkflowTaskCompletedResponse) Equal(that interface{}) bool {
 	if that == nil {
 		return this == nil
 	}
 
-	that1, ok := that.(*RespondActivityTaskFailedResponse)
+	that1, ok := that.(*RespondWorkflowTaskCompletedResponse)
 	if !ok {
-		that2, ok := that.(RespondActivityTaskFailedResponse)
+		that2, ok := that.(RespondWorkflowTaskCompletedResponse)
 		if ok {
 			that1 = &that2
 		} else {
@@ -5313,16 +5915,19 @@ func (this *RespondActivityTaskFailedResponse) Equal(that interface{}) bool {
 	} else if this == nil {
 		return false
 	}
+	if !this.StartedResponse.Equal(that1.StartedResponse) {
+		return false
+	}
 	return true
 }
-func (this *RespondActivityTaskCanceledRequest) Equal(that interface{}) bool {
+func (this *RespondWorkflowTaskFailedRequest) Equal(that interface{}) bool {
 	if that == nil {
 		return this == nil
 	}
 
-	that1, ok := that.(*RespondActivityTaskCanceledRequest)
+	that1, ok := that.(*RespondWorkflowTaskFailedRequest)
 	if !ok {
-		that2, ok := that.(RespondActivityTaskCanceledRequest)
+		that2, ok := that.(RespondWorkflowTaskFailedRequest)
 		if ok {
 			that1 = &that2
 		} else {
@@ -5337,19 +5942,19 @@ func (this *RespondActivityTaskCanceledRequest) Equal(that interface{}) bool {
 	if this.NamespaceId != that1.NamespaceId {
 		return false
 	}
-	if !this.CancelRequest.Equal(that1.CancelRequest) {
+	if !this.FailedRequest.Equal(that1.FailedRequest) {
 		return false
 	}
 	return true
 }
-func (this *RespondActivityTaskCanceledResponse) Equal(that interface{}) bool {
+func (this *RespondWorkflowTaskFailedResponse) Equal(that interface{}) bool {
 	if that == nil {
 		return this == nil
 	}
 
-	that1, ok := that.(*RespondActivityTaskCanceledResponse)
+	that1, ok := that.(*RespondWorkflowTaskFailedResponse)
 	if !ok {
-		that2, ok := that.(RespondActivityTaskCanceledResponse)
+		that2, ok := that.(RespondWorkflowTaskFailedResponse)
 		if ok {
 			that1 = &that2
 		} else {
@@ -5363,14 +5968,14 @@ func (this *RespondActivityTaskCanceledResponse) Equal(that interface{}) bool {
 	}
 	return true
 }
-func (this *SignalWorkflowExecutionRequest) Equal(that interface{}) bool {
+func (this *RecordActivityTaskHeartbeatRequest) Equal(that interface{}) bool {
 	if that == nil {
 		return this == nil
 	}
 
-	that1, ok := that.(*SignalWorkflowExecutionRequest)
+	that1, ok := that.(*RecordActivityTaskHeartbeatRequest)
 	if !ok {
-		that2, ok := that.(SignalWorkflowExecutionRequest)
+		that2, ok := that.(RecordActivityTaskHeartbeatRequest)
 		if ok {
 			that1 = &that2
 		} else {
@@ -5385,25 +5990,19 @@ func (this *SignalWorkflowExecutionRequest) Equal(that interface{}) bool {
 	if this.NamespaceId != that1.NamespaceId {
 		return false
 	}
-	if !this.SignalRequest.Equal(that1.SignalRequest) {
-		return false
-	}
-	if !this.ExternalWorkflowExecution.Equal(that1.ExternalWorkflowExecution) {
-		return false
-	}
-	if this.ChildWorkflowOnly != that1.ChildWorkflowOnly {
+	if !this.HeartbeatRequest.Equal(that1.HeartbeatRequest) {
 		return false
 	}
 	return true
 }
-func (this *SignalWorkflowExecutionResponse) Equal(that interface{}) bool {
+func (this *RecordActivityTaskHeartbeatResponse) Equal(that interface{}) bool {
 	if that == nil {
 		return this == nil
 	}
 
-	that1, ok := that.(*SignalWorkflowExecutionResponse)
+	that1, ok := that.(*RecordActivityTaskHeartbeatResponse)
 	if !ok {
-		that2, ok := that.(SignalWorkflowExecutionResponse)
+		that2, ok := that.(RecordActivityTaskHeartbeatResponse)
 		if ok {
 			that1 = &that2
 		} else {
@@ -5415,16 +6014,19 @@ func (this *SignalWorkflowExecutionResponse) Equal(that interface{}) bool {
 	} else if this == nil {
 		return false
 	}
+	if this.CancelRequested != that1.CancelRequested {
+		return false
+	}
 	return true
 }
-func (this *SignalWithStartWorkflowExecutionRequest) Equal(that interface{}) bool {
+func (this *RespondActivityTaskCompletedRequest) Equal(that interface{}) bool {
 	if that == nil {
 		return this == nil
 	}
 
-	that1, ok := that.(*SignalWithStartWorkflowExecutionRequest)
+	that1, ok := that.(*RespondActivityTaskCompletedRequest)
 	if !ok {
-		that2, ok := that.(SignalWithStartWorkflowExecutionRequest)
+		that2, ok := that.(RespondActivityTaskCompletedRequest)
 		if ok {
 			that1 = &that2
 		} else {
@@ -5439,19 +6041,19 @@ func (this *SignalWithStartWorkflowExecutionRequest) Equal(that interface{}) boo
 	if this.NamespaceId != that1.NamespaceId {
 		return false
 	}
-	if !this.SignalWithStartRequest.Equal(that1.SignalWithStartRequest) {
+	if !this.CompleteRequest.Equal(that1.CompleteRequest) {
 		return false
 	}
 	return true
 }
-func (this *SignalWithStartWorkflowExecutionResponse) Equal(that interface{}) bool {
+func (this *RespondActivityTaskCompletedResponse) Equal(that interface{}) bool {
 	if that == nil {
 		return this == nil
 	}
 
-	that1, ok := that.(*SignalWithStartWorkflowExecutionResponse)
+	that1, ok := that.(*RespondActivityTaskCompletedResponse)
 	if !ok {
-		that2, ok := that.(SignalWithStartWorkflowExecutionResponse)
+		that2, ok := that.(RespondActivityTaskCompletedResponse)
 		if ok {
 			that1 = &that2
 		} else {
@@ -5463,19 +6065,16 @@ func (this *SignalWithStartWorkflowExecutionResponse) Equal(that interface{}) bo
 	} else if this == nil {
 		return false
 	}
-	if this.RunId != that1.RunId {
-		return false
-	}
 	return true
 }
-func (this *RemoveSignalMutableStateRequest) Equal(that interface{}) bool {
+func (this *RespondActivityTaskFailedRequest) Equal(that interface{}) bool {
 	if that == nil {
 		return this == nil
 	}
 
-	that1, ok := that.(*RemoveSignalMutableStateRequest)
+	that1, ok := that.(*RespondActivityTaskFailedRequest)
 	if !ok {
-		that2, ok := that.(RemoveSignalMutableStateRequest)
+		that2, ok := that.(RespondActivityTaskFailedRequest)
 		if ok {
 			that1 = &that2
 		} else {
@@ -5490,22 +6089,19 @@ func (this *RemoveSignalMutableStateRequest) Equal(that interface{}) bool {
 	if this.NamespaceId != that1.NamespaceId {
 		return false
 	}
-	if !this.WorkflowExecution.Equal(that1.WorkflowExecution) {
-		return false
-	}
-	if this.RequestId != that1.RequestId {
+	if !this.FailedRequest.Equal(that1.FailedRequest) {
 		return false
 	}
 	return true
 }
-func (this *RemoveSignalMutableStateResponse) Equal(that interface{}) bool {
+func (this *RespondActivityTaskFailedResponse) Equal(that interface{}) bool {
 	if that == nil {
 		return this == nil
 	}
 
-	that1, ok := that.(*RemoveSignalMutableStateResponse)
+	that1, ok := that.(*RespondActivityTaskFailedResponse)
 	if !ok {
-		that2, ok := that.(RemoveSignalMutableStateResponse)
+		that2, ok := that.(RespondActivityTaskFailedResponse)
 		if ok {
 			that1 = &that2
 		} else {
@@ -5519,14 +6115,14 @@ func (this *RemoveSignalMutableStateResponse) Equal(that interface{}) bool {
 	}
 	return true
 }
-func (this *TerminateWorkflowExecutionRequest) Equal(that interface{}) bool {
+func (this *RespondActivityTaskCanceledRequest) Equal(that interface{}) bool {
 	if that == nil {
 		return this == nil
 	}
 
-	that1, ok := that.(*TerminateWorkflowExecutionRequest)
+	that1, ok := that.(*RespondActivityTaskCanceledRequest)
 	if !ok {
-		that2, ok := that.(TerminateWorkflowExecutionRequest)
+		that2, ok := that.(RespondActivityTaskCanceledRequest)
 		if ok {
 			that1 = &that2
 		} else {
@@ -5541,19 +6137,19 @@ func (this *TerminateWorkflowExecutionRequest) Equal(that interface{}) bool {
 	if this.NamespaceId != that1.NamespaceId {
 		return false
 	}
-	if !this.TerminateRequest.Equal(that1.TerminateRequest) {
+	if !this.CancelRequest.Equal(that1.CancelRequest) {
 		return false
 	}
 	return true
 }
-func (this *TerminateWorkflowExecutionResponse) Equal(that interface{}) bool {
+func (this *RespondActivityTaskCanceledResponse) Equal(that interface{}) bool {
 	if that == nil {
 		return this == nil
 	}
 
-	that1, ok := that.(*TerminateWorkflowExecutionResponse)
+	that1, ok := that.(*RespondActivityTaskCanceledResponse)
 	if !ok {
-		that2, ok := that.(TerminateWorkflowExecutionResponse)
+		that2, ok := that.(RespondActivityTaskCanceledResponse)
 		if ok {
 			that1 = &that2
 		} else {
@@ -5567,14 +6163,14 @@ func (this *TerminateWorkflowExecutionResponse) Equal(that interface{}) bool {
 	}
 	return true
 }
-func (this *ResetWorkflowExecutionRequest) Equal(that interface{}) bool {
+func (this *SignalWorkflowExecutionRequest) Equal(that interface{}) bool {
 	if that == nil {
 		return this == nil
 	}
 
-	that1, ok := that.(*ResetWorkflowExecutionRequest)
+	that1, ok := that.(*SignalWorkflowExecutionRequest)
 	if !ok {
-		that2, ok := that.(ResetWorkflowExecutionRequest)
+		that2, ok := that.(SignalWorkflowExecutionRequest)
 		if ok {
 			that1 = &that2
 		} else {
@@ -5589,19 +6185,25 @@ func (this *ResetWorkflowExecutionRequest) Equal(that interface{}) bool {
 	if this.NamespaceId != that1.NamespaceId {
 		return false
 	}
-	if !this.ResetRequest.Equal(that1.ResetRequest) {
+	if !this.SignalRequest.Equal(that1.SignalRequest) {
+		return false
+	}
+	if !this.ExternalWorkflowExecution.Equal(that1.ExternalWorkflowExecution) {
+		return false
+	}
+	if this.ChildWorkflowOnly != that1.ChildWorkflowOnly {
 		return false
 	}
 	return true
 }
-func (this *ResetWorkflowExecutionResponse) Equal(that interface{}) bool {
+func (this *SignalWorkflowExecutionResponse) Equal(that interface{}) bool {
 	if that == nil {
 		return this == nil
 	}
 
-	that1, ok := that.(*ResetWorkflowExecutionResponse)
+	that1, ok := that.(*SignalWorkflowExecutionResponse)
 	if !ok {
-		that2, ok := that.(ResetWorkflowExecutionResponse)
+		that2, ok := that.(SignalWorkflowExecutionResponse)
 		if ok {
 			that1 = &that2
 		} else {
@@ -5613,19 +6215,16 @@ func (this *ResetWorkflowExecutionResponse) Equal(that interface{}) bool {
 	} else if this == nil {
 		return false
 	}
-	if this.RunId != that1.RunId {
-		return false
-	}
 	return true
 }
-func (this *RequestCancelWorkflowExecutionRequest) Equal(that interface{}) bool {
+func (this *SignalWithStartWorkflowExecutionRequest) Equal(that interface{}) bool {
 	if that == nil {
 		return this == nil
 	}
 
-	that1, ok := that.(*RequestCancelWorkflowExecutionRequest)
+	that1, ok := that.(*SignalWithStartWorkflowExecutionRequest)
 	if !ok {
-		that2, ok := that.(RequestCancelWorkflowExecutionRequest)
+		that2, ok := that.(SignalWithStartWorkflowExecutionRequest)
 		if ok {
 			that1 = &that2
 		} else {
@@ -5640,28 +6239,19 @@ func (this *RequestCancelWorkflowExecutionRequest) Equal(that interface{}) bool
 	if this.NamespaceId != that1.NamespaceId {
 		return false
 	}
-	if !this.CancelRequest.Equal(that1.CancelRequest) {
-		return false
-	}
-	if this.ExternalInitiatedEventId != that1.ExternalInitiatedEventId {
-		return false
-	}
-	if !this.ExternalWorkflowExecution.Equal(that1.ExternalWorkflowExecution) {
-		return false
-	}
-	if this.ChildWorkflowOnly != that1.ChildWorkflowOnly {
+	if !this.SignalWithStartRequest.Equal(that1.SignalWithStartRequest) {
 		return false
 	}
 	return true
 }
-func (this *RequestCancelWorkflowExecutionResponse) Equal(that interface{}) bool {
+func (this *SignalWithStartWorkflowExecutionResponse) Equal(that interface{}) bool {
 	if that == nil {
 		return this == nil
 	}
 
-	that1, ok := that.(*RequestCancelWorkflowExecutionResponse)
+	that1, ok := that.(*SignalWithStartWorkflowExecutionResponse)
 	if !ok {
-		that2, ok := that.(RequestCancelWorkflowExecutionResponse)
+		that2, ok := that.(SignalWithStartWorkflowExecutionResponse)
 		if ok {
 			that1 = &that2
 		} else {
@@ -5673,16 +6263,19 @@ func (this *RequestCancelWorkflowExecutionResponse) Equal(that interface{}) bool
 	} else if this == nil {
 		return false
 	}
+	if this.RunId != that1.RunId {
+		return false
+	}
 	return true
 }
-func (this *ScheduleWorkflowTaskRequest) Equal(that interface{}) bool {
+func (this *RemoveSignalMutableStateRequest) Equal(that interface{}) bool {
 	if that == nil {
 		return this == nil
 	}
 
-	that1, ok := that.(*ScheduleWorkflowTaskRequest)
+	that1, ok := that.(*RemoveSignalMutableStateRequest)
 	if !ok {
-		that2, ok := that.(ScheduleWorkflowTaskRequest)
+		that2, ok := that.(RemoveSignalMutableStateRequest)
 		if ok {
 			that1 = &that2
 		} else {
@@ -5700,19 +6293,19 @@ func (this *ScheduleWorkflowTaskRequest) Equal(that interface{}) bool {
 	if !this.WorkflowExecution.Equal(that1.WorkflowExecution) {
 		return false
 	}
-	if this.IsFirstWorkflowTask != that1.IsFirstWorkflowTask {
+	if this.RequestId != that1.RequestId {
 		return false
 	}
 	return true
 }
-func (this *ScheduleWorkflowTaskResponse) Equal(that interface{}) bool {
+func (this *RemoveSignalMutableStateResponse) Equal(that interface{}) bool {
 	if that == nil {
 		return this == nil
 	}
 
-	that1, ok := that.(*ScheduleWorkflowTaskResponse)
+	that1, ok := that.(*RemoveSignalMutableStateResponse)
 	if !ok {
-		that2, ok := that.(ScheduleWorkflowTaskResponse)
+		that2, ok := that.(RemoveSignalMutableStateResponse)
 		if ok {
 			that1 = &that2
 		} else {
@@ -5726,14 +6319,14 @@ func (this *ScheduleWorkflowTaskResponse) Equal(that interface{}) bool {
 	}
 	return true
 }
-func (this *RecordChildExecutionCompletedRequest) Equal(that interface{}) bool {
+func (this *TerminateWorkflowExecutionRequest) Equal(that interface{}) bool {
 	if that == nil {
 		return this == nil
 	}
 
-	that1, ok := that.(*RecordChildExecutionCompletedRequest)
+	that1, ok := that.(*TerminateWorkflowExecutionRequest)
 	if !ok {
-		that2, ok := that.(RecordChildExecutionCompletedRequest)
+		that2, ok := that.(TerminateWorkflowExecutionRequest)
 		if ok {
 			that1 = &that2
 		} else {
@@ -5748,28 +6341,19 @@ func (this *RecordChildExecutionCompletedRequest) Equal(that interface{}) bool {
 	if this.NamespaceId != that1.NamespaceId {
 		return false
 	}
-	if !this.WorkflowExecution.Equal(that1.WorkflowExecution) {
-		return false
-	}
-	if this.InitiatedId != that1.InitiatedId {
-		return false
-	}
-	if !this.CompletedExecution.Equal(that1.CompletedExecution) {
-		return false
-	}
-	if !this.CompletionEvent.Equal(that1.CompletionEvent) {
+	if !this.TerminateRequest.Equal(that1.TerminateRequest) {
 		return false
 	}
 	return true
 }
-func (this *RecordChildExecutionCompletedResponse) Equal(that interface{}) bool {
+func (this *TerminateWorkflowExecutionResponse) Equal(that interface{}) bool {
 	if that == nil {
 		return this == nil
 	}
 
-	that1, ok := that.(*RecordChildExecutionCompletedResponse)
+	that1, ok := that.(*TerminateWorkflowExecutionResponse)
 	if !ok {
-		that2, ok := that.(RecordChildExecutionCompletedResponse)
+		that2, ok := that.(TerminateWorkflowExecutionResponse)
 		if ok {
 			that1 = &that2
 		} else {
@@ -5783,14 +6367,14 @@ func (this *RecordChildExecutionCompletedResponse) Equal(that interface{}) bool
 	}
 	return true
 }
-func (this *DescribeWorkflowExecutionRequest) Equal(that interface{}) bool {
+func (this *ResetWorkflowExecutionRequest) Equal(that interface{}) bool {
 	if that == nil {
 		return this == nil
 	}
 
-	that1, ok := that.(*DescribeWorkflowExecutionRequest)
+	that1, ok := that.(*ResetWorkflowExecutionRequest)
 	if !ok {
-		that2, ok := that.(DescribeWorkflowExecutionRequest)
+		that2, ok := that.(ResetWorkflowExecutionRequest)
 		if ok {
 			that1 = &that2
 		} else {
@@ -5805,19 +6389,31 @@ func (this *DescribeWorkflowExecutionRequest) Equal(that interface{}) bool {
 	if this.NamespaceId != that1.NamespaceId {
 		return false
 	}
-	if !this.Request.Equal(that1.Request) {
+	if !this.ResetRequest.Equal(that1.ResetRequest) {
+		return false
+	}
+	if this.WorkflowTaskScheduledEventId != that1.WorkflowTaskScheduledEventId {
+		return false
+	}
+	if this.ReapplyExcludeSignals != that1.ReapplyExcludeSignals {
+		return false
+	}
+	if this.ReapplyExcludeAll != that1.ReapplyExcludeAll {
+		return false
+	}
+	if this.DryRun != that1.DryRun {
 		return false
 	}
 	return true
 }
-func (this *DescribeWorkflowExecutionResponse) Equal(that interface{}) bool {
+func (this *ResetWorkflowExecutionResponse) Equal(that interface{}) bool {
 	if that == nil {
 		return this == nil
 	}
 
-	that1, ok := that.(*DescribeWorkflowExecutionResponse)
+	that1, ok := that.(*ResetWorkflowExecutionResponse)
 	if !ok {
-		that2, ok := that.(DescribeWorkflowExecutionResponse)
+		that2, ok := that.(ResetWorkflowExecutionResponse)
 		if ok {
 			that1 = &that2
 		} else {
@@ -5829,21 +6425,59 @@ func (this *DescribeWorkflowExecutionResponse) Equal(that interface{}) bool {
 	} else if this == nil {
 		return false
 	}
-	if !this.ExecutionConfig.Equal(that1.ExecutionConfig) {
+	if this.RunId != that1.RunId {
 		return false
 	}
-	if !this.WorkflowExecutionInfo.Equal(that1.WorkflowExecutionInfo) {
+	if !this.Preview.Equal(that1.Preview) {
 		return false
 	}
-	if len(this.PendingActivities) != len(that1.PendingActivities) {
-		return false
+	return true
+}
+func (this *ResetWorkflowExecutionPreview) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
 	}
-	for i := range this.PendingActivities {
-		if !this.PendingActivities[i].Equal(that1.PendingActivities[i]) {
+
+	that1, ok := that.(*ResetWorkflowExecutionPreview)
+	if !ok {
+		that2, ok := that.(ResetWorkflowExecutionPreview)
+		if ok {
+			that1 = &that2
+		} else {
 			return false
 		}
 	}
-	if len(this.PendingChildren) != len(that1.PendingChildren) {
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.BaseRunId != that1.BaseRunId {
+		return false
+	}
+	if this.WorkflowTaskFinishEventId != that1.WorkflowTaskFinishEventId {
+		return false
+	}
+	if this.TerminatedRunId != that1.TerminatedRunId {
+		return false
+	}
+	if len(this.DroppedEvents) != len(that1.DroppedEvents) {
+		return false
+	}
+	for i := range this.DroppedEvents {
+		if !this.DroppedEvents[i].Equal(that1.DroppedEvents[i]) {
+			return false
+		}
+	}
+	if len(this.FailedActivities) != len(that1.FailedActivities) {
+		return false
+	}
+	for i := range this.FailedActivities {
+		if !this.FailedActivities[i].Equal(that1.FailedActivities[i]) {
+			return false
+		}
+	}
+	if len(this.PendingChildren) != len(that1.PendingChildren) {
 		return false
 	}
 	for i := range this.PendingChildren {
@@ -5851,16 +6485,24 @@ func (this *DescribeWorkflowExecutionResponse) Equal(that interface{}) bool {
 			return false
 		}
 	}
+	if len(this.ReappliedEvents) != len(that1.ReappliedEvents) {
+		return false
+	}
+	for i := range this.ReappliedEvents {
+		if !this.ReappliedEvents[i].Equal(that1.ReappliedEvents[i]) {
+			return false
+		}
+	}
 	return true
 }
-func (this *ReplicateEventsV2Request) Equal(that interface{}) bool {
+func (this *RequestCancelWorkflowExecutionRequest) Equal(that interface{}) bool {
 	if that == nil {
 		return this == nil
 	}
 
-	that1, ok := that.(*ReplicateEventsV2Request)
+	that1, ok := that.(*RequestCancelWorkflowExecutionRequest)
 	if !ok {
-		that2, ok := that.(ReplicateEventsV2Request)
+		that2, ok := that.(RequestCancelWorkflowExecutionRequest)
 		if ok {
 			that1 = &that2
 		} else {
@@ -5875,33 +6517,28 @@ func (this *ReplicateEventsV2Request) Equal(that interface{}) bool {
 	if this.NamespaceId != that1.NamespaceId {
 		return false
 	}
-	if !this.WorkflowExecution.Equal(that1.WorkflowExecution) {
+	if !this.CancelRequest.Equal(that1.CancelRequest) {
 		return false
 	}
-	if len(this.VersionHistoryItems) != len(that1.VersionHistoryItems) {
+	if this.ExternalInitiatedEventId != that1.ExternalInitiatedEventId {
 		return false
 	}
-	for i := range this.VersionHistoryItems {
-		if !this.VersionHistoryItems[i].Equal(that1.VersionHistoryItems[i]) {
-			return false
-		}
-	}
-	if !this.Events.Equal(that1.Events) {
+	if !this.ExternalWorkflowExecution.Equal(that1.ExternalWorkflowExecution) {
 		return false
 	}
-	if !this.NewRunEvents.Equal(that1.NewRunEvents) {
+	if this.ChildWorkflowOnly != that1.ChildWorkflowOnly {
 		return false
 	}
 	return true
 }
-func (this *ReplicateEventsV2Response) Equal(that interface{}) bool {
+func (this *RequestCancelWorkflowExecutionResponse) Equal(that interface{}) bool {
 	if that == nil {
 		return this == nil
 	}
 
-	that1, ok := that.(*ReplicateEventsV2Response)
+	that1, ok := that.(*RequestCancelWorkflowExecutionResponse)
 	if !ok {
-		that2, ok := that.(ReplicateEventsV2Response)
+		that2, ok := that.(RequestCancelWorkflowExecutionResponse)
 		if ok {
 			that1 = &that2
 		} else {
@@ -5915,14 +6552,14 @@ func (this *ReplicateEventsV2Response) Equal(that interface{}) bool {
 	}
 	return true
 }
-func (this *SyncShardStatusRequest) Equal(that interface{}) bool {
+func (this *ScheduleWorkflowTaskRequest) Equal(that interface{}) bool {
 	if that == nil {
 		return this == nil
 	}
 
-	that1, ok := that.(*SyncShardStatusRequest)
+	that1, ok := that.(*ScheduleWorkflowTaskRequest)
 	if !ok {
-		that2, ok := that.(SyncShardStatusRequest)
+		that2, ok := that.(ScheduleWorkflowTaskRequest)
 		if ok {
 			that1 = &that2
 		} else {
@@ -5934,29 +6571,25 @@ func (this *SyncShardStatusRequest) Equal(that interface{}) bool {
 	} else if this == nil {
 		return false
 	}
-	if this.SourceCluster != that1.SourceCluster {
+	if this.NamespaceId != that1.NamespaceId {
 		return false
 	}
-	if this.ShardId != that1.ShardId {
+	if !this.WorkflowExecution.Equal(that1.WorkflowExecution) {
 		return false
 	}
-	if that1.StatusTime == nil {
-		if this.StatusTime != nil {
-			return false
-		}
-	} else if !this.StatusTime.Equal(*that1.StatusTime) {
+	if this.IsFirstWorkflowTask != that1.IsFirstWorkflowTask {
 		return false
 	}
 	return true
 }
-func (this *SyncShardStatusResponse) Equal(that interface{}) bool {
+func (this *ScheduleWorkflowTaskResponse) Equal(that interface{}) bool {
 	if that == nil {
 		return this == nil
 	}
 
-	that1, ok := that.(*SyncShardStatusResponse)
+	that1, ok := that.(*ScheduleWorkflowTaskResponse)
 	if !ok {
-		that2, ok := that.(SyncShardStatusResponse)
+		that2, ok := that.(ScheduleWorkflowTaskResponse)
 		if ok {
 			that1 = &that2
 		} else {
@@ -5970,14 +6603,14 @@ func (this *SyncShardStatusResponse) Equal(that interface{}) bool {
 	}
 	return true
 }
-func (this *SyncActivityRequest) Equal(that interface{}) bool {
+func (this *RecordChildExecutionCompletedRequest) Equal(that interface{}) bool {
 	if that == nil {
 		return this == nil
 	}
 
-	that1, ok := that.(*SyncActivityRequest)
+	that1, ok := that.(*RecordChildExecutionCompletedRequest)
 	if !ok {
-		that2, ok := that.(SyncActivityRequest)
+		that2, ok := that.(RecordChildExecutionCompletedRequest)
 		if ok {
 			that1 = &that2
 		} else {
@@ -5992,67 +6625,28 @@ func (this *SyncActivityRequest) Equal(that interface{}) bool {
 	if this.NamespaceId != that1.NamespaceId {
 		return false
 	}
-	if this.WorkflowId != that1.WorkflowId {
-		return false
-	}
-	if this.RunId != that1.RunId {
-		return false
-	}
-	if this.Version != that1.Version {
-		return false
-	}
-	if this.ScheduledId != that1.ScheduledId {
-		return false
-	}
-	if that1.ScheduledTime == nil {
-		if this.ScheduledTime != nil {
-			return false
-		}
-	} else if !this.ScheduledTime.Equal(*that1.ScheduledTime) {
-		return false
-	}
-	if this.StartedId != that1.StartedId {
-		return false
-	}
-	if that1.StartedTime == nil {
-		if this.StartedTime != nil {
-			return false
-		}
-	} else if !this.StartedTime.Equal(*that1.StartedTime) {
-		return false
-	}
-	if that1.LastHeartbeatTime == nil {
-		if this.LastHeartbeatTime != nil {
-			return false
-		}
-	} else if !this.LastHeartbeatTime.Equal(*that1.LastHeartbeatTime) {
-		return false
-	}
-	if !this.Details.Equal(that1.Details) {
-		return false
-	}
-	if this.Attempt != that1.Attempt {
+	if !this.WorkflowExecution.Equal(that1.WorkflowExecution) {
 		return false
 	}
-	if !this.LastFailure.Equal(that1.LastFailure) {
+	if this.InitiatedId != that1.InitiatedId {
 		return false
 	}
-	if this.LastWorkerIdentity != that1.LastWorkerIdentity {
+	if !this.CompletedExecution.Equal(that1.CompletedExecution) {
 		return false
 	}
-	if !this.VersionHistory.Equal(that1.VersionHistory) {
+	if !this.CompletionEvent.Equal(that1.CompletionEvent) {
 		return false
 	}
 	return true
 }
-func (this *SyncActivityResponse) Equal(that interface{}) bool {
+func (this *RecordChildExecutionCompletedResponse) Equal(that interface{}) bool {
 	if that == nil {
 		return this == nil
 	}
 
-	that1, ok := that.(*SyncActivityResponse)
+	that1, ok := that.(*RecordChildExecutionCompletedResponse)
 	if !ok {
-		that2, ok := that.(SyncActivityResponse)
+		that2, ok := that.(RecordChildExecutionCompletedResponse)
 		if ok {
 			that1 = &that2
 		} else {
@@ -6066,14 +6660,14 @@ func (this *SyncActivityResponse) Equal(that interface{}) bool {
 	}
 	return true
 }
-func (this *DescribeMutableStateRequest) Equal(that interface{}) bool {
+func (this *DescribeWorkflowExecutionRequest) Equal(that interface{}) bool {
 	if that == nil {
 		return this == nil
 	}
 
-	that1, ok := that.(*DescribeMutableStateRequest)
+	that1, ok := that.(*DescribeWorkflowExecutionRequest)
 	if !ok {
-		that2, ok := that.(DescribeMutableStateRequest)
+		that2, ok := that.(DescribeWorkflowExecutionRequest)
 		if ok {
 			that1 = &that2
 		} else {
@@ -6088,19 +6682,19 @@ func (this *DescribeMutableStateRequest) Equal(that interface{}) bool {
 	if this.NamespaceId != that1.NamespaceId {
 		return false
 	}
-	if !this.Execution.Equal(that1.Execution) {
+	if !this.Request.Equal(that1.Request) {
 		return false
 	}
 	return true
 }
-func (this *DescribeMutableStateResponse) Equal(that interface{}) bool {
+func (this *DescribeWorkflowExecutionResponse) Equal(that interface{}) bool {
 	if that == nil {
 		return this == nil
 	}
 
-	that1, ok := that.(*DescribeMutableStateResponse)
+	that1, ok := that.(*DescribeWorkflowExecutionResponse)
 	if !ok {
-		that2, ok := that.(DescribeMutableStateResponse)
+		that2, ok := that.(DescribeWorkflowExecutionResponse)
 		if ok {
 			that1 = &that2
 		} else {
@@ -6112,28 +6706,50 @@ func (this *DescribeMutableStateResponse) Equal(that interface{}) bool {
 	} else if this == nil {
 		return false
 	}
-	if this.CacheMutableState != that1.CacheMutableState {
+	if !this.ExecutionConfig.Equal(that1.ExecutionConfig) {
 		return false
 	}
-	if this.DatabaseMutableState != that1.DatabaseMutableState {
+	if !this.WorkflowExecutionInfo.Equal(that1.WorkflowExecutionInfo) {
 		return false
 	}
-	if this.TreeId != that1.TreeId {
+	if len(this.PendingActivities) != len(that1.PendingActivities) {
 		return false
 	}
-	if this.BranchId != that1.BranchId {
+	for i := range this.PendingActivities {
+		if !this.PendingActivities[i].Equal(that1.PendingActivities[i]) {
+			return false
+		}
+	}
+	if len(this.PendingChildren) != len(that1.PendingChildren) {
+		return false
+	}
+	for i := range this.PendingChildren {
+		if !this.PendingChildren[i].Equal(that1.PendingChildren[i]) {
+			return false
+		}
+	}
+	if this.HistorySizeBytes != that1.HistorySizeBytes {
+		return false
+	}
+	if this.SuggestContinueAsNew != that1.SuggestContinueAsNew {
+		return false
+	}
+	if this.Paused != that1.Paused {
+		return false
+	}
+	if this.PauseReason != that1.PauseReason {
 		return false
 	}
 	return true
 }
-func (this *DescribeHistoryHostRequest) Equal(that interface{}) bool {
+func (this *ReplicateEventsV2Request) Equal(that interface{}) bool {
 	if that == nil {
 		return this == nil
 	}
 
-	that1, ok := that.(*DescribeHistoryHostRequest)
+	that1, ok := that.(*ReplicateEventsV2Request)
 	if !ok {
-		that2, ok := that.(DescribeHistoryHostRequest)
+		that2, ok := that.(ReplicateEventsV2Request)
 		if ok {
 			that1 = &that2
 		} else {
@@ -6145,28 +6761,36 @@ func (this *DescribeHistoryHostRequest) Equal(that interface{}) bool {
 	} else if this == nil {
 		return false
 	}
-	if this.HostAddress != that1.HostAddress {
+	if this.NamespaceId != that1.NamespaceId {
 		return false
 	}
-	if this.ShardId != that1.ShardId {
+	if !this.WorkflowExecution.Equal(that1.WorkflowExecution) {
 		return false
 	}
-	if this.NamespaceId != that1.NamespaceId {
+	if len(this.VersionHistoryItems) != len(that1.VersionHistoryItems) {
 		return false
 	}
-	if !this.WorkflowExecution.Equal(that1.WorkflowExecution) {
+	for i := range this.VersionHistoryItems {
+		if !this.VersionHistoryItems[i].Equal(that1.VersionHistoryItems[i]) {
+			return false
+		}
+	}
+	if !this.Events.Equal(that1.Events) {
+		return false
+	}
+	if !this.NewRunEvents.Equal(that1.NewRunEvents) {
 		return false
 	}
 	return true
 }
-func (this *DescribeHistoryHostResponse) Equal(that interface{}) bool {
+func (this *ReplicateEventsV2Response) Equal(that interface{}) bool {
 	if that == nil {
 		return this == nil
 	}
 
-	that1, ok := that.(*DescribeHistoryHostResponse)
+	that1, ok := that.(*ReplicateEventsV2Response)
 	if !ok {
-		that2, ok := that.(DescribeHistoryHostResponse)
+		that2, ok := that.(ReplicateEventsV2Response)
 		if ok {
 			that1 = &that2
 		} else {
@@ -6178,36 +6802,16 @@ func (this *DescribeHistoryHostResponse) Equal(that interface{}) bool {
 	} else if this == nil {
 		return false
 	}
-	if this.ShardsNumber != that1.ShardsNumber {
-		return false
-	}
-	if len(this.ShardIds) != len(that1.ShardIds) {
-		return false
-	}
-	for i := range this.ShardIds {
-		if this.ShardIds[i] != that1.ShardIds[i] {
-			return false
-		}
-	}
-	if !this.NamespaceCache.Equal(that1.NamespaceCache) {
-		return false
-	}
-	if this.ShardControllerStatus != that1.ShardControllerStatus {
-		return false
-	}
-	if this.Address != that1.Address {
-		return false
-	}
 	return true
 }
-func (this *CloseShardRequest) Equal(that interface{}) bool {
+func (this *SyncShardStatusRequest) Equal(that interface{}) bool {
 	if that == nil {
 		return this == nil
 	}
 
-	that1, ok := that.(*CloseShardRequest)
+	that1, ok := that.(*SyncShardStatusRequest)
 	if !ok {
-		that2, ok := that.(CloseShardRequest)
+		that2, ok := that.(SyncShardStatusRequest)
 		if ok {
 			that1 = &that2
 		} else {
@@ -6219,19 +6823,29 @@ func (this *CloseShardRequest) Equal(that interface{}) bool {
 	} else if this == nil {
 		return false
 	}
+	if this.SourceCluster != that1.SourceCluster {
+		return false
+	}
 	if this.ShardId != that1.ShardId {
 		return false
 	}
+	if that1.StatusTime == nil {
+		if this.StatusTime != nil {
+			return false
+		}
+	} else if !this.StatusTime.Equal(*that1.StatusTime) {
+		return false
+	}
 	return true
 }
-func (this *CloseShardResponse) Equal(that interface{}) bool {
+func (this *SyncShardStatusResponse) Equal(that interface{}) bool {
 	if that == nil {
 		return this == nil
 	}
 
-	that1, ok := that.(*CloseShardResponse)
+	that1, ok := that.(*SyncShardStatusResponse)
 	if !ok {
-		that2, ok := that.(CloseShardResponse)
+		that2, ok := that.(SyncShardStatusResponse)
 		if ok {
 			that1 = &that2
 		} else {
@@ -6245,14 +6859,14 @@ func (this *CloseShardResponse) Equal(that interface{}) bool {
 	}
 	return true
 }
-func (this *RemoveTaskRequest) Equal(that interface{}) bool {
+func (this *SyncActivityRequest) Equal(that interface{}) bool {
 	if that == nil {
 		return this == nil
 	}
 
-	that1, ok := that.(*RemoveTaskRequest)
+	that1, ok := that.(*SyncActivityRequest)
 	if !ok {
-		that2, ok := that.(RemoveTaskRequest)
+		that2, ok := that.(SyncActivityRequest)
 		if ok {
 			that1 = &that2
 		} else {
@@ -6264,32 +6878,70 @@ func (this *RemoveTaskRequest) Equal(that interface{}) bool {
 	} else if this == nil {
 		return false
 	}
-	if this.ShardId != that1.ShardId {
+	if this.NamespaceId != that1.NamespaceId {
 		return false
 	}
-	if this.Category != that1.Category {
+	if this.WorkflowId != that1.WorkflowId {
 		return false
 	}
-	if this.TaskId != that1.TaskId {
+	if this.RunId != that1.RunId {
 		return false
 	}
-	if that1.VisibilityTime == nil {
-		if this.VisibilityTime != nil {
+	if this.Version != that1.Version {
+		return false
+	}
+	if this.ScheduledId != that1.ScheduledId {
+		return false
+	}
+	if that1.ScheduledTime == nil {
+		if this.ScheduledTime != nil {
 			return false
 		}
-	} else if !this.VisibilityTime.Equal(*that1.VisibilityTime) {
+	} else if !this.ScheduledTime.Equal(*that1.ScheduledTime) {
+		return false
+	}
+	if this.StartedId != that1.StartedId {
+		return false
+	}
+	if that1.StartedTime == nil {
+		if this.StartedTime != nil {
+			return false
+		}
+	} else if !this.StartedTime.Equal(*that1.StartedTime) {
+		return false
+	}
+	if that1.LastHeartbeatTime == nil {
+		if this.LastHeartbeatTime != nil {
+			return false
+		}
+	} else if !this.LastHeartbeatTime.Equal(*that1.LastHeartbeatTime) {
+		return false
+	}
+	if !this.Details.Equal(that1.Details) {
+		return false
+	}
+	if this.Attempt != that1.Attempt {
+		return false
+	}
+	if !this.LastFailure.Equal(that1.LastFailure) {
+		return false
+	}
+	if this.LastWorkerIdentity != that1.LastWorkerIdentity {
+		return false
+	}
+	if !this.VersionHistory.Equal(that1.VersionHistory) {
 		return false
 	}
 	return true
 }
-func (this *RemoveTaskResponse) Equal(that interface{}) bool {
+func (this *SyncActivityResponse) Equal(that interface{}) bool {
 	if that == nil {
 		return this == nil
 	}
 
-	that1, ok := that.(*RemoveTaskResponse)
+	that1, ok := that.(*SyncActivityResponse)
 	if !ok {
-		that2, ok := that.(RemoveTaskResponse)
+		that2, ok := that.(SyncActivityResponse)
 		if ok {
 			that1 = &that2
 		} else {
@@ -6303,14 +6955,14 @@ func (this *RemoveTaskResponse) Equal(that interface{}) bool {
 	}
 	return true
 }
-func (this *GetReplicationMessagesRequest) Equal(that interface{}) bool {
+func (this *DescribeMutableStateRequest) Equal(that interface{}) bool {
 	if that == nil {
 		return this == nil
 	}
 
-	that1, ok := that.(*GetReplicationMessagesRequest)
+	that1, ok := that.(*DescribeMutableStateRequest)
 	if !ok {
-		that2, ok := that.(GetReplicationMessagesRequest)
+		that2, ok := that.(DescribeMutableStateRequest)
 		if ok {
 			that1 = &that2
 		} else {
@@ -6322,27 +6974,22 @@ func (this *GetReplicationMessagesRequest) Equal(that interface{}) bool {
 	} else if this == nil {
 		return false
 	}
-	if len(this.Tokens) != len(that1.Tokens) {
+	if this.NamespaceId != that1.NamespaceId {
 		return false
 	}
-	for i := range this.Tokens {
-		if !this.Tokens[i].Equal(that1.Tokens[i]) {
-			return false
-		}
-	}
-	if this.ClusterName != that1.ClusterName {
+	if !this.Execution.Equal(that1.Execution) {
 		return false
 	}
 	return true
 }
-func (this *GetReplicationMessagesResponse) Equal(that interface{}) bool {
+func (this *DescribeMutableStateResponse) Equal(that interface{}) bool {
 	if that == nil {
 		return this == nil
 	}
 
-	that1, ok := that.(*GetReplicationMessagesResponse)
+	that1, ok := that.(*DescribeMutableStateResponse)
 	if !ok {
-		that2, ok := that.(GetReplicationMessagesResponse)
+		that2, ok := that.(DescribeMutableStateResponse)
 		if ok {
 			that1 = &that2
 		} else {
@@ -6354,24 +7001,28 @@ func (this *GetReplicationMessagesResponse) Equal(that interface{}) bool {
 	} else if this == nil {
 		return false
 	}
-	if len(this.ShardMessages) != len(that1.ShardMessages) {
+	if this.CacheMutableState != that1.CacheMutableState {
 		return false
 	}
-	for i := range this.ShardMessages {
-		if !this.ShardMessages[i].Equal(that1.ShardMessages[i]) {
-			return false
-		}
+	if this.DatabaseMutableState != that1.DatabaseMutableState {
+		return false
+	}
+	if this.TreeId != that1.TreeId {
+		return false
+	}
+	if this.BranchId != that1.BranchId {
+		return false
 	}
 	return true
 }
-func (this *GetDLQReplicationMessagesRequest) Equal(that interface{}) bool {
+func (this *DescribeHistoryHostRequest) Equal(that interface{}) bool {
 	if that == nil {
 		return this == nil
 	}
 
-	that1, ok := that.(*GetDLQReplicationMessagesRequest)
+	that1, ok := that.(*DescribeHistoryHostRequest)
 	if !ok {
-		that2, ok := that.(GetDLQReplicationMessagesRequest)
+		that2, ok := that.(DescribeHistoryHostRequest)
 		if ok {
 			that1 = &that2
 		} else {
@@ -6383,24 +7034,28 @@ func (this *GetDLQReplicationMessagesRequest) Equal(that interface{}) bool {
 	} else if this == nil {
 		return false
 	}
-	if len(this.TaskInfos) != len(that1.TaskInfos) {
+	if this.HostAddress != that1.HostAddress {
 		return false
 	}
-	for i := range this.TaskInfos {
-		if !this.TaskInfos[i].Equal(that1.TaskInfos[i]) {
-			return false
-		}
+	if this.ShardId != that1.ShardId {
+		return false
+	}
+	if this.NamespaceId != that1.NamespaceId {
+		return false
+	}
+	if !this.WorkflowExecution.Equal(that1.WorkflowExecution) {
+		return false
 	}
 	return true
 }
-func (this *GetDLQReplicationMessagesResponse) Equal(that interface{}) bool {
+func (this *DescribeHistoryHostResponse) Equal(that interface{}) bool {
 	if that == nil {
 		return this == nil
 	}
 
-	that1, ok := that.(*GetDLQReplicationMessagesResponse)
+	that1, ok := that.(*DescribeHistoryHostResponse)
 	if !ok {
-		that2, ok := that.(GetDLQReplicationMessagesResponse)
+		that2, ok := that.(DescribeHistoryHostResponse)
 		if ok {
 			that1 = &that2
 		} else {
@@ -6412,24 +7067,36 @@ func (this *GetDLQReplicationMessagesResponse) Equal(that interface{}) bool {
 	} else if this == nil {
 		return false
 	}
-	if len(this.ReplicationTasks) != len(that1.ReplicationTasks) {
+	if this.ShardsNumber != that1.ShardsNumber {
 		return false
 	}
-	for i := range this.ReplicationTasks {
-		if !this.ReplicationTasks[i].Equal(that1.ReplicationTasks[i]) {
+	if len(this.ShardIds) != len(that1.ShardIds) {
+		return false
+	}
+	for i := range this.ShardIds {
+		if this.ShardIds[i] != that1.ShardIds[i] {
 			return false
 		}
 	}
+	if !this.NamespaceCache.Equal(that1.NamespaceCache) {
+		return false
+	}
+	if this.ShardControllerStatus != that1.ShardControllerStatus {
+		return false
+	}
+	if this.Address != that1.Address {
+		return false
+	}
 	return true
 }
-func (this *QueryWorkflowRequest) Equal(that interface{}) bool {
+func (this *CloseShardRequest) Equal(that interface{}) bool {
 	if that == nil {
 		return this == nil
 	}
 
-	that1, ok := that.(*QueryWorkflowRequest)
+	that1, ok := that.(*CloseShardRequest)
 	if !ok {
-		that2, ok := that.(QueryWorkflowRequest)
+		that2, ok := that.(CloseShardRequest)
 		if ok {
 			that1 = &that2
 		} else {
@@ -6441,22 +7108,19 @@ func (this *QueryWorkflowRequest) Equal(that interface{}) bool {
 	} else if this == nil {
 		return false
 	}
-	if this.NamespaceId != that1.NamespaceId {
-		return false
-	}
-	if !this.Request.Equal(that1.Request) {
+	if this.ShardId != that1.ShardId {
 		return false
 	}
 	return true
 }
-func (this *QueryWorkflowResponse) Equal(that interface{}) bool {
+func (this *CloseShardResponse) Equal(that interface{}) bool {
 	if that == nil {
 		return this == nil
 	}
 
-	that1, ok := that.(*QueryWorkflowResponse)
+	that1, ok := that.(*CloseShardResponse)
 	if !ok {
-		that2, ok := that.(QueryWorkflowResponse)
+		that2, ok := that.(CloseShardResponse)
 		if ok {
 			that1 = &that2
 		} else {
@@ -6468,19 +7132,16 @@ func (this *QueryWorkflowResponse) Equal(that interface{}) bool {
 	} else if this == nil {
 		return false
 	}
-	if !this.Response.Equal(that1.Response) {
-		return false
-	}
 	return true
 }
-func (this *ReapplyEventsRequest) Equal(that interface{}) bool {
+func (this *RemoveTaskRequest) Equal(that interface{}) bool {
 	if that == nil {
 		return this == nil
 	}
 
-	that1, ok := that.(*ReapplyEventsRequest)
+	that1, ok := that.(*RemoveTaskRequest)
 	if !ok {
-		that2, ok := that.(ReapplyEventsRequest)
+		that2, ok := that.(RemoveTaskRequest)
 		if ok {
 			that1 = &that2
 		} else {
@@ -6492,22 +7153,32 @@ func (this *ReapplyEventsRequest) Equal(that interface{}) bool {
 	} else if this == nil {
 		return false
 	}
-	if this.NamespaceId != that1.NamespaceId {
+	if this.ShardId != that1.ShardId {
 		return false
 	}
-	if !this.Request.Equal(that1.Request) {
+	if this.Category != that1.Category {
+		return false
+	}
+	if this.TaskId != that1.TaskId {
+		return false
+	}
+	if that1.VisibilityTime == nil {
+		if this.VisibilityTime != nil {
+			return false
+		}
+	} else if !this.VisibilityTime.Equal(*that1.VisibilityTime) {
 		return false
 	}
 	return true
 }
-func (this *ReapplyEventsResponse) Equal(that interface{}) bool {
+func (this *RemoveTaskResponse) Equal(that interface{}) bool {
 	if that == nil {
 		return this == nil
 	}
 
-	that1, ok := that.(*ReapplyEventsResponse)
+	that1, ok := that.(*RemoveTaskResponse)
 	if !ok {
-		that2, ok := that.(ReapplyEventsResponse)
+		that2, ok := that.(RemoveTaskResponse)
 		if ok {
 			that1 = &that2
 		} else {
@@ -6521,14 +7192,14 @@ func (this *ReapplyEventsResponse) Equal(that interface{}) bool {
 	}
 	return true
 }
-func (this *GetDLQMessagesRequest) Equal(that interface{}) bool {
+func (this *GetReplicationMessagesRequest) Equal(that interface{}) bool {
 	if that == nil {
 		return this == nil
 	}
 
-	that1, ok := that.(*GetDLQMessagesRequest)
+	that1, ok := that.(*GetReplicationMessagesRequest)
 	if !ok {
-		that2, ok := that.(GetDLQMessagesRequest)
+		that2, ok := that.(GetReplicationMessagesRequest)
 		if ok {
 			that1 = &that2
 		} else {
@@ -6540,34 +7211,27 @@ func (this *GetDLQMessagesRequest) Equal(that interface{}) bool {
 	} else if this == nil {
 		return false
 	}
-	if this.Type != that1.Type {
-		return false
-	}
-	if this.ShardId != that1.ShardId {
-		return false
-	}
-	if this.SourceCluster != that1.SourceCluster {
-		return false
-	}
-	if this.InclusiveEndMessageId != that1.InclusiveEndMessageId {
+	if len(this.Tokens) != len(that1.Tokens) {
 		return false
 	}
-	if this.MaximumPageSize != that1.MaximumPageSize {
-		return false
+	for i := range this.Tokens {
+		if !this.Tokens[i].Equal(that1.Tokens[i]) {
+			return false
+		}
 	}
-	if !bytes.Equal(this.NextPageToken, that1.NextPageToken) {
+	if this.ClusterName != that1.ClusterName {
 		return false
 	}
 	return true
 }
-func (this *GetDLQMessagesResponse) Equal(that interface{}) bool {
+func (this *GetReplicationMessagesResponse) Equal(that interface{}) bool {
 	if that == nil {
 		return this == nil
 	}
 
-	that1, ok := that.(*GetDLQMessagesResponse)
+	that1, ok := that.(*GetReplicationMessagesResponse)
 	if !ok {
-		that2, ok := that.(GetDLQMessagesResponse)
+		that2, ok := that.(GetReplicationMessagesResponse)
 		if ok {
 			that1 = &that2
 		} else {
@@ -6579,30 +7243,24 @@ func (this *GetDLQMessagesResponse) Equal(that interface{}) bool {
 	} else if this == nil {
 		return false
 	}
-	if this.Type != that1.Type {
-		return false
-	}
-	if len(this.ReplicationTasks) != len(that1.ReplicationTasks) {
+	if len(this.ShardMessages) != len(that1.ShardMessages) {
 		return false
 	}
-	for i := range this.ReplicationTasks {
-		if !this.ReplicationTasks[i].Equal(that1.ReplicationTasks[i]) {
+	for i := range this.ShardMessages {
+		if !this.ShardMessages[i].Equal(that1.ShardMessages[i]) {
 			return false
 		}
 	}
-	if !bytes.Equal(this.NextPageToken, that1.NextPageToken) {
-		return false
-	}
 	return true
 }
-func (this *PurgeDLQMessagesRequest) Equal(that interface{}) bool {
+func (this *GetDLQReplicationMessagesRequest) Equal(that interface{}) bool {
 	if that == nil {
 		return this == nil
 	}
 
-	that1, ok := that.(*PurgeDLQMessagesRequest)
+	that1, ok := that.(*GetDLQReplicationMessagesRequest)
 	if !ok {
-		that2, ok := that.(PurgeDLQMessagesRequest)
+		that2, ok := that.(GetDLQReplicationMessagesRequest)
 		if ok {
 			that1 = &that2
 		} else {
@@ -6614,28 +7272,24 @@ func (this *PurgeDLQMessagesRequest) Equal(that interface{}) bool {
 	} else if this == nil {
 		return false
 	}
-	if this.Type != that1.Type {
-		return false
-	}
-	if this.ShardId != that1.ShardId {
-		return false
-	}
-	if this.SourceCluster != that1.SourceCluster {
+	if len(this.TaskInfos) != len(that1.TaskInfos) {
 		return false
 	}
-	if this.InclusiveEndMessageId != that1.InclusiveEndMessageId {
-		return false
+	for i := range this.TaskInfos {
+		if !this.TaskInfos[i].Equal(that1.TaskInfos[i]) {
+			return false
+		}
 	}
 	return true
 }
-func (this *PurgeDLQMessagesResponse) Equal(that interface{}) bool {
+func (this *GetDLQReplicationMessagesResponse) Equal(that interface{}) bool {
 	if that == nil {
 		return this == nil
 	}
 
-	that1, ok := that.(*PurgeDLQMessagesResponse)
+	that1, ok := that.(*GetDLQReplicationMessagesResponse)
 	if !ok {
-		that2, ok := that.(PurgeDLQMessagesResponse)
+		that2, ok := that.(GetDLQReplicationMessagesResponse)
 		if ok {
 			that1 = &that2
 		} else {
@@ -6647,16 +7301,24 @@ func (this *PurgeDLQMessagesResponse) Equal(that interface{}) bool {
 	} else if this == nil {
 		return false
 	}
+	if len(this.ReplicationTasks) != len(that1.ReplicationTasks) {
+		return false
+	}
+	for i := range this.ReplicationTasks {
+		if !this.ReplicationTasks[i].Equal(that1.ReplicationTasks[i]) {
+			return false
+		}
+	}
 	return true
 }
-func (this *MergeDLQMessagesRequest) Equal(that interface{}) bool {
+func (this *QueryWorkflowRequest) Equal(that interface{}) bool {
 	if that == nil {
 		return this == nil
 	}
 
-	that1, ok := that.(*MergeDLQMessagesRequest)
+	that1, ok := that.(*QueryWorkflowRequest)
 	if !ok {
-		that2, ok := that.(MergeDLQMessagesRequest)
+		that2, ok := that.(QueryWorkflowRequest)
 		if ok {
 			that1 = &that2
 		} else {
@@ -6668,34 +7330,22 @@ func (this *MergeDLQMessagesRequest) Equal(that interface{}) bool {
 	} else if this == nil {
 		return false
 	}
-	if this.Type != that1.Type {
-		return false
-	}
-	if this.ShardId != that1.ShardId {
-		return false
-	}
-	if this.SourceCluster != that1.SourceCluster {
-		return false
-	}
-	if this.InclusiveEndMessageId != that1.InclusiveEndMessageId {
-		return false
-	}
-	if this.MaximumPageSize != that1.MaximumPageSize {
+	if this.NamespaceId != that1.NamespaceId {
 		return false
 	}
-	if !bytes.Equal(this.NextPageToken, that1.NextPageToken) {
+	if !this.Request.Equal(that1.Request) {
 		return false
 	}
 	return true
 }
-func (this *MergeDLQMessagesResponse) Equal(that interface{}) bool {
+func (this *QueryWorkflowResponse) Equal(that interface{}) bool {
 	if that == nil {
 		return this == nil
 	}
 
-	that1, ok := that.(*MergeDLQMessagesResponse)
+	that1, ok := that.(*QueryWorkflowResponse)
 	if !ok {
-		that2, ok := that.(MergeDLQMessagesResponse)
+		that2, ok := that.(QueryWorkflowResponse)
 		if ok {
 			that1 = &that2
 		} else {
@@ -6707,19 +7357,19 @@ func (this *MergeDLQMessagesResponse) Equal(that interface{}) bool {
 	} else if this == nil {
 		return false
 	}
-	if !bytes.Equal(this.NextPageToken, that1.NextPageToken) {
+	if !this.Response.Equal(that1.Response) {
 		return false
 	}
 	return true
 }
-func (this *RefreshWorkflowTasksRequest) Equal(that interface{}) bool {
+func (this *ReapplyEventsRequest) Equal(that interface{}) bool {
 	if that == nil {
 		return this == nil
 	}
 
-	that1, ok := that.(*RefreshWorkflowTasksRequest)
+	that1, ok := that.(*ReapplyEventsRequest)
 	if !ok {
-		that2, ok := that.(RefreshWorkflowTasksRequest)
+		that2, ok := that.(ReapplyEventsRequest)
 		if ok {
 			that1 = &that2
 		} else {
@@ -6739,14 +7389,14 @@ func (this *RefreshWorkflowTasksRequest) Equal(that interface{}) bool {
 	}
 	return true
 }
-func (this *RefreshWorkflowTasksResponse) Equal(that interface{}) bool {
+func (this *ReapplyEventsResponse) Equal(that interface{}) bool {
 	if that == nil {
 		return this == nil
 	}
 
-	that1, ok := that.(*RefreshWorkflowTasksResponse)
+	that1, ok := that.(*ReapplyEventsResponse)
 	if !ok {
-		that2, ok := that.(RefreshWorkflowTasksResponse)
+		that2, ok := that.(ReapplyEventsResponse)
 		if ok {
 			that1 = &that2
 		} else {
@@ -6760,1632 +7410,1737 @@ func (this *RefreshWorkflowTasksResponse) Equal(that interface{}) bool {
 	}
 	return true
 }
-func (this *StartWorkflowExecutionRequest) GoString() string {
-	if this == nil {
-		return "nil"
+func (this *GetDLQMessagesRequest) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
 	}
-	s := make([]string, 0, 13)
-	s = append(s, "&historyservice.StartWorkflowExecutionRequest{")
-	s = append(s, "NamespaceId: "+fmt.Sprintf("%#v", this.NamespaceId)+",\n")
-	if this.StartRequest != nil {
-		s = append(s, "StartRequest: "+fmt.Sprintf("%#v", this.StartRequest)+",\n")
+
+	that1, ok := that.(*GetDLQMessagesRequest)
+	if !ok {
+		that2, ok := that.(GetDLQMessagesRequest)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
 	}
-	if this.ParentExecutionInfo != nil {
-		s = append(s, "ParentExecutionInfo: "+fmt.Sprintf("%#v", this.ParentExecutionInfo)+",\n")
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
 	}
-	s = append(s, "Attempt: "+fmt.Sprintf("%#v", this.Attempt)+",\n")
-	s = append(s, "WorkflowExecutionExpirationTime: "+fmt.Sprintf("%#v", this.WorkflowExecutionExpirationTime)+",\n")
-	s = append(s, "ContinueAsNewInitiator: "+fmt.Sprintf("%#v", this.ContinueAsNewInitiator)+",\n")
-	if this.ContinuedFailure != nil {
-		s = append(s, "ContinuedFailure: "+fmt.Sprintf("%#v", this.ContinuedFailure)+",\n")
+	if this.Type != that1.Type {
+		return false
 	}
-	if this.LastCompletionResult != nil {
-		s = append(s, "LastCompletionResult: "+fmt.Sprintf("%#v", this.LastCompletionResult)+",\n")
+	if this.ShardId != that1.ShardId {
+		return false
 	}
-	s = append(s, "FirstWorkflowTaskBackoff: "+fmt.Sprintf("%#v", this.FirstWorkflowTaskBackoff)+",\n")
-	s = append(s, "}")
-	return strings.Join(s, "")
-}
-func (this *StartWorkflowExecutionResponse) GoString() string {
-	if this == nil {
-		return "nil"
+	if this.SourceCluster != that1.SourceCluster {
+		return false
 	}
-	s := make([]string, 0, 5)
-	s = append(s, "&historyservice.StartWorkflowExecutionResponse{")
-	s = append(s, "RunId: "+fmt.Sprintf("%#v", this.RunId)+",\n")
-	s = append(s, "}")
-	return strings.Join(s, "")
-}
-func (this *GetMutableStateRequest) GoString() string {
-	if this == nil {
-		return "nil"
+	if this.InclusiveEndMessageId != that1.InclusiveEndMessageId {
+		return false
 	}
-	s := make([]string, 0, 8)
-	s = append(s, "&historyservice.GetMutableStateRequest{")
-	s = append(s, "NamespaceId: "+fmt.Sprintf("%#v", this.NamespaceId)+",\n")
-	if this.Execution != nil {
-		s = append(s, "Execution: "+fmt.Sprintf("%#v", this.Execution)+",\n")
+	if this.MaximumPageSize != that1.MaximumPageSize {
+		return false
 	}
-	s = append(s, "ExpectedNextEventId: "+fmt.Sprintf("%#v", this.ExpectedNextEventId)+",\n")
-	s = append(s, "CurrentBranchToken: "+fmt.Sprintf("%#v", this.CurrentBranchToken)+",\n")
-	s = append(s, "}")
-	return strings.Join(s, "")
-}
-func (this *GetMutableStateResponse) GoString() string {
-	if this == nil {
-		return "nil"
+	if !bytes.Equal(this.NextPageToken, that1.NextPageToken) {
+		return false
 	}
-	s := make([]string, 0, 18)
-	s = append(s, "&historyservice.GetMutableStateResponse{")
-	if this.Execution != nil {
-		s = append(s, "Execution: "+fmt.Sprintf("%#v", this.Execution)+",\n")
+	return true
+}
+func (this *GetDLQMessagesResponse) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
 	}
-	if this.WorkflowType != nil {
-		s = append(s, "WorkflowType: "+fmt.Sprintf("%#v", this.WorkflowType)+",\n")
+
+	that1, ok := that.(*GetDLQMessagesResponse)
+	if !ok {
+		that2, ok := that.(GetDLQMessagesResponse)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
 	}
-	s = append(s, "NextEventId: "+fmt.Sprintf("%#v", this.NextEventId)+",\n")
-	s = append(s, "PreviousStartedEventId: "+fmt.Sprintf("%#v", this.PreviousStartedEventId)+",\n")
-	s = append(s, "LastFirstEventId: "+fmt.Sprintf("%#v", this.LastFirstEventId)+",\n")
-	if this.TaskQueue != nil {
-		s = append(s, "TaskQueue: "+fmt.Sprintf("%#v", this.TaskQueue)+",\n")
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
 	}
-	if this.StickyTaskQueue != nil {
-		s = append(s, "StickyTaskQueue: "+fmt.Sprintf("%#v", this.StickyTaskQueue)+",\n")
+	if this.Type != that1.Type {
+		return false
 	}
-	s = append(s, "StickyTaskQueueScheduleToStartTimeout: "+fmt.Sprintf("%#v", this.StickyTaskQueueScheduleToStartTimeout)+",\n")
-	s = append(s, "EventStoreVersion: "+fmt.Sprintf("%#v", this.EventStoreVersion)+",\n")
-	s = append(s, "CurrentBranchToken: "+fmt.Sprintf("%#v", this.CurrentBranchToken)+",\n")
-	s = append(s, "WorkflowState: "+fmt.Sprintf("%#v", this.WorkflowState)+",\n")
-	s = append(s, "WorkflowStatus: "+fmt.Sprintf("%#v", this.WorkflowStatus)+",\n")
-	if this.VersionHistories != nil {
-		s = append(s, "VersionHistories: "+fmt.Sprintf("%#v", this.VersionHistories)+",\n")
+	if len(this.ReplicationTasks) != len(that1.ReplicationTasks) {
+		return false
 	}
-	s = append(s, "IsStickyTaskQueueEnabled: "+fmt.Sprintf("%#v", this.IsStickyTaskQueueEnabled)+",\n")
-	s = append(s, "}")
-	return strings.Join(s, "")
-}
-func (this *PollMutableStateRequest) GoString() string {
-	if this == nil {
-		return "nil"
+	for i := range this.ReplicationTasks {
+		if !this.ReplicationTasks[i].Equal(that1.ReplicationTasks[i]) {
+			return false
+		}
 	}
-	s := make([]string, 0, 8)
-	s = append(s, "&historyservice.PollMutableStateRequest{")
-	s = append(s, "NamespaceId: "+fmt.Sprintf("%#v", this.NamespaceId)+",\n")
-	if this.Execution != nil {
-		s = append(s, "Execution: "+fmt.Sprintf("%#v", this.Execution)+",\n")
+	if !bytes.Equal(this.NextPageToken, that1.NextPageToken) {
+		return false
 	}
-	s = append(s, "ExpectedNextEventId: "+fmt.Sprintf("%#v", this.ExpectedNextEventId)+",\n")
-	s = append(s, "CurrentBranchToken: "+fmt.Sprintf("%#v", this.CurrentBranchToken)+",\n")
-	s = append(s, "}")
-	return strings.Join(s, "")
+	return true
 }
-func (this *PollMutableStateResponse) GoString() string {
-	if this == nil {
-		return "nil"
+func (this *PurgeDLQMessagesRequest) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
 	}
-	s := make([]string, 0, 16)
-	s = append(s, "&historyservice.PollMutableStateResponse{")
-	if this.Execution != nil {
-		s = append(s, "Execution: "+fmt.Sprintf("%#v", this.Execution)+",\n")
+
+	that1, ok := that.(*PurgeDLQMessagesRequest)
+	if !ok {
+		that2, ok := that.(PurgeDLQMessagesRequest)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
 	}
-	if this.WorkflowType != nil {
-		s = append(s, "WorkflowType: "+fmt.Sprintf("%#v", this.WorkflowType)+",\n")
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
 	}
-	s = append(s, "NextEventId: "+fmt.Sprintf("%#v", this.NextEventId)+",\n")
-	s = append(s, "PreviousStartedEventId: "+fmt.Sprintf("%#v", this.PreviousStartedEventId)+",\n")
-	s = append(s, "LastFirstEventId: "+fmt.Sprintf("%#v", this.LastFirstEventId)+",\n")
-	if this.TaskQueue != nil {
-		s = append(s, "TaskQueue: "+fmt.Sprintf("%#v", this.TaskQueue)+",\n")
+	if this.Type != that1.Type {
+		return false
 	}
-	if this.StickyTaskQueue != nil {
-		s = append(s, "StickyTaskQueue: "+fmt.Sprintf("%#v", this.StickyTaskQueue)+",\n")
+	if this.ShardId != that1.ShardId {
+		return false
 	}
-	s = append(s, "StickyTaskQueueScheduleToStartTimeout: "+fmt.Sprintf("%#v", this.StickyTaskQueueScheduleToStartTimeout)+",\n")
-	s = append(s, "CurrentBranchToken: "+fmt.Sprintf("%#v", this.CurrentBranchToken)+",\n")
-	if this.VersionHistories != nil {
-		s = append(s, "VersionHistories: "+fmt.Sprintf("%#v", this.VersionHistories)+",\n")
+	if this.SourceCluster != that1.SourceCluster {
+		return false
 	}
-	s = append(s, "WorkflowState: "+fmt.Sprintf("%#v", this.WorkflowState)+",\n")
-	s = append(s, "WorkflowStatus: "+fmt.Sprintf("%#v", this.WorkflowStatus)+",\n")
-	s = append(s, "}")
-	return strings.Join(s, "")
+	if this.InclusiveEndMessageId != that1.InclusiveEndMessageId {
+		return false
+	}
+	return true
 }
-func (this *ResetStickyTaskQueueRequest) GoString() string {
-	if this == nil {
-		return "nil"
+func (this *PurgeDLQMessagesResponse) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
 	}
-	s := make([]string, 0, 6)
-	s = append(s, "&historyservice.ResetStickyTaskQueueRequest{")
-	s = append(s, "NamespaceId: "+fmt.Sprintf("%#v", this.NamespaceId)+",\n")
-	if this.Execution != nil {
-		s = append(s, "Execution: "+fmt.Sprintf("%#v", this.Execution)+",\n")
+
+	that1, ok := that.(*PurgeDLQMessagesResponse)
+	if !ok {
+		that2, ok := that.(PurgeDLQMessagesResponse)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
 	}
-	s = append(s, "}")
-	return strings.Join(s, "")
-}
-func (this *ResetStickyTaskQueueResponse) GoString() string {
-	if this == nil {
-		return "nil"
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
 	}
-	s := make([]string, 0, 4)
-	s = append(s, "&historyservice.ResetStickyTaskQueueResponse{")
-	s = append(s, "}")
-	return strings.Join(s, "")
+	return true
 }
-func (this *RecordWorkflowTaskStartedRequest) GoString() string {
-	if this == nil {
-		return "nil"
-	}
-	s := make([]string, 0, 10)
-	s = append(s, "&historyservice.RecordWorkflowTaskStartedRequest{")
-	s = append(s, "NamespaceId: "+fmt.Sprintf("%#v", this.NamespaceId)+",\n")
-	if this.WorkflowExecution != nil {
-		s = append(s, "WorkflowExecution: "+fmt.Sprintf("%#v", this.WorkflowExecution)+",\n")
+func (this *MergeDLQMessagesRequest) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
 	}
-	s = append(s, "ScheduleId: "+fmt.Sprintf("%#v", this.ScheduleId)+",\n")
-	s = append(s, "TaskId: "+fmt.Sprintf("%#v", this.TaskId)+",\n")
-	s = append(s, "RequestId: "+fmt.Sprintf("%#v", this.RequestId)+",\n")
-	if this.PollRequest != nil {
-		s = append(s, "PollRequest: "+fmt.Sprintf("%#v", this.PollRequest)+",\n")
+
+	that1, ok := that.(*MergeDLQMessagesRequest)
+	if !ok {
+		that2, ok := that.(MergeDLQMessagesRequest)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
 	}
-	s = append(s, "}")
-	return strings.Join(s, "")
-}
-func (this *RecordWorkflowTaskStartedResponse) GoString() string {
-	if this == nil {
-		return "nil"
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
 	}
-	s := make([]string, 0, 18)
-	s = append(s, "&historyservice.RecordWorkflowTaskStartedResponse{")
-	if this.WorkflowType != nil {
-		s = append(s, "WorkflowType: "+fmt.Sprintf("%#v", this.WorkflowType)+",\n")
+	if this.Type != that1.Type {
+		return false
 	}
-	s = append(s, "PreviousStartedEventId: "+fmt.Sprintf("%#v", this.PreviousStartedEventId)+",\n")
-	s = append(s, "ScheduledEventId: "+fmt.Sprintf("%#v", this.ScheduledEventId)+",\n")
-	s = append(s, "StartedEventId: "+fmt.Sprintf("%#v", this.StartedEventId)+",\n")
-	s = append(s, "NextEventId: "+fmt.Sprintf("%#v", this.NextEventId)+",\n")
-	s = append(s, "Attempt: "+fmt.Sprintf("%#v", this.Attempt)+",\n")
-	s = append(s, "StickyExecutionEnabled: "+fmt.Sprintf("%#v", this.StickyExecutionEnabled)+",\n")
-	if this.WorkflowTaskInfo != nil {
-		s = append(s, "WorkflowTaskInfo: "+fmt.Sprintf("%#v", this.WorkflowTaskInfo)+",\n")
+	if this.ShardId != that1.ShardId {
+		return false
 	}
-	if this.WorkflowExecutionTaskQueue != nil {
-		s = append(s, "WorkflowExecutionTaskQueue: "+fmt.Sprintf("%#v", this.WorkflowExecutionTaskQueue)+",\n")
+	if this.SourceCluster != that1.SourceCluster {
+		return false
 	}
-	s = append(s, "EventStoreVersion: "+fmt.Sprintf("%#v", this.EventStoreVersion)+",\n")
-	s = append(s, "BranchToken: "+fmt.Sprintf("%#v", this.BranchToken)+",\n")
-	s = append(s, "ScheduledTime: "+fmt.Sprintf("%#v", this.ScheduledTime)+",\n")
-	s = append(s, "StartedTime: "+fmt.Sprintf("%#v", this.StartedTime)+",\n")
-	keysForQueries := make([]string, 0, len(this.Queries))
-	for k, _ := range this.Queries {
-		keysForQueries = append(keysForQueries, k)
+	if this.InclusiveEndMessageId != that1.InclusiveEndMessageId {
+		return false
 	}
-	github_com_gogo_protobuf_sortkeys.Strings(keysForQueries)
-	mapStringForQueries := "map[string]*v18.WorkflowQuery{"
-	for _, k := range keysForQueries {
-		mapStringForQueries += fmt.Sprintf("%#v: %#v,", k, this.Queries[k])
+	if this.MaximumPageSize != that1.MaximumPageSize {
+		return false
 	}
-	mapStringForQueries += "}"
-	if this.Queries != nil {
-		s = append(s, "Queries: "+mapStringForQueries+",\n")
+	if !bytes.Equal(this.NextPageToken, that1.NextPageToken) {
+		return false
 	}
-	s = append(s, "}")
-	return strings.Join(s, "")
+	return true
 }
-func (this *RecordActivityTaskStartedRequest) GoString() string {
-	if this == nil {
-		return "nil"
+func (this *MergeDLQMessagesResponse) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
 	}
-	s := make([]string, 0, 10)
-	s = append(s, "&historyservice.RecordActivityTaskStartedRequest{")
-	s = append(s, "NamespaceId: "+fmt.Sprintf("%#v", this.NamespaceId)+",\n")
-	if this.WorkflowExecution != nil {
-		s = append(s, "WorkflowExecution: "+fmt.Sprintf("%#v", this.WorkflowExecution)+",\n")
+
+	that1, ok := that.(*MergeDLQMessagesResponse)
+	if !ok {
+		that2, ok := that.(MergeDLQMessagesResponse)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
 	}
-	s = append(s, "ScheduleId: "+fmt.Sprintf("%#v", this.ScheduleId)+",\n")
-	s = append(s, "TaskId: "+fmt.Sprintf("%#v", this.TaskId)+",\n")
-	s = append(s, "RequestId: "+fmt.Sprintf("%#v", this.RequestId)+",\n")
-	if this.PollRequest != nil {
-		s = append(s, "PollRequest: "+fmt.Sprintf("%#v", this.PollRequest)+",\n")
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
 	}
-	s = append(s, "}")
-	return strings.Join(s, "")
-}
-func (this *RecordActivityTaskStartedResponse) GoString() string {
-	if this == nil {
-		return "nil"
+	if !bytes.Equal(this.NextPageToken, that1.NextPageToken) {
+		return false
 	}
-	s := make([]string, 0, 11)
-	s = append(s, "&historyservice.RecordActivityTaskStartedResponse{")
-	if this.ScheduledEvent != nil {
-		s = append(s, "ScheduledEvent: "+fmt.Sprintf("%#v", this.ScheduledEvent)+",\n")
+	return true
+}
+func (this *RefreshWorkflowTasksRequest) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
 	}
-	s = append(s, "StartedTime: "+fmt.Sprintf("%#v", this.StartedTime)+",\n")
-	s = append(s, "Attempt: "+fmt.Sprintf("%#v", this.Attempt)+",\n")
-	s = append(s, "CurrentAttemptScheduledTime: "+fmt.Sprintf("%#v", this.CurrentAttemptScheduledTime)+",\n")
-	if this.HeartbeatDetails != nil {
-		s = append(s, "HeartbeatDetails: "+fmt.Sprintf("%#v", this.HeartbeatDetails)+",\n")
+
+	that1, ok := that.(*RefreshWorkflowTasksRequest)
+	if !ok {
+		that2, ok := that.(RefreshWorkflowTasksRequest)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
 	}
-	if this.WorkflowType != nil {
-		s = append(s, "WorkflowType: "+fmt.Sprintf("%#v", this.WorkflowType)+",\n")
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
 	}
-	s = append(s, "WorkflowNamespace: "+fmt.Sprintf("%#v", this.WorkflowNamespace)+",\n")
-	s = append(s, "}")
-	return strings.Join(s, "")
-}
-func (this *RespondWorkflowTaskCompletedRequest) GoString() string {
-	if this == nil {
-		return "nil"
+	if this.NamespaceId != that1.NamespaceId {
+		return false
 	}
-	s := make([]string, 0, 6)
-	s = append(s, "&historyservice.RespondWorkflowTaskCompletedRequest{")
-	s = append(s, "NamespaceId: "+fmt.Sprintf("%#v", this.NamespaceId)+",\n")
-	if this.CompleteRequest != nil {
-		s = append(s, "CompleteRequest: "+fmt.Sprintf("%#v", this.CompleteRequest)+",\n")
+	if !this.Request.Equal(that1.Request) {
+		return false
 	}
-	s = append(s, "}")
-	return strings.Join(s, "")
+	return true
 }
-func (this *RespondWorkflowTaskCompletedResponse) GoString() string {
-	if this == nil {
-		return "nil"
+func (this *RefreshWorkflowTasksResponse) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
 	}
-	s := make([]string, 0, 5)
-	s = append(s, "&historyservice.RespondWorkflowTaskCompletedResponse{")
-	if this.StartedResponse != nil {
-		s = append(s, "StartedResponse: "+fmt.Sprintf("%#v", this.StartedResponse)+",\n")
+
+	that1, ok := that.(*RefreshWorkflowTasksResponse)
+	if !ok {
+		that2, ok := that.(RefreshWorkflowTasksResponse)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
 	}
-	s = append(s, "}")
-	return strings.Join(s, "")
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	return true
 }
-func (this *RespondWorkflowTaskFailedRequest) GoString() string {
-	if this == nil {
-		return "nil"
+func (this *DeleteWorkflowExecutionRequest) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
 	}
-	s := make([]string, 0, 6)
-	s = append(s, "&historyservice.RespondWorkflowTaskFailedRequest{")
-	s = append(s, "NamespaceId: "+fmt.Sprintf("%#v", this.NamespaceId)+",\n")
-	if this.FailedRequest != nil {
-		s = append(s, "FailedRequest: "+fmt.Sprintf("%#v", this.FailedRequest)+",\n")
+
+	that1, ok := that.(*DeleteWorkflowExecutionRequest)
+	if !ok {
+		that2, ok := that.(DeleteWorkflowExecutionRequest)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
 	}
-	s = append(s, "}")
-	return strings.Join(s, "")
-}
-func (this *RespondWorkflowTaskFailedResponse) GoString() string {
-	if this == nil {
-		return "nil"
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
 	}
-	s := make([]string, 0, 4)
-	s = append(s, "&historyservice.RespondWorkflowTaskFailedResponse{")
-	s = append(s, "}")
-	return strings.Join(s, "")
-}
-func (this *RecordActivityTaskHeartbeatRequest) GoString() string {
-	if this == nil {
-		return "nil"
+	if this.NamespaceId != that1.NamespaceId {
+		return false
 	}
-	s := make([]string, 0, 6)
-	s = append(s, "&historyservice.RecordActivityTaskHeartbeatRequest{")
-	s = append(s, "NamespaceId: "+fmt.Sprintf("%#v", this.NamespaceId)+",\n")
-	if this.HeartbeatRequest != nil {
-		s = append(s, "HeartbeatRequest: "+fmt.Sprintf("%#v", this.HeartbeatRequest)+",\n")
+	if !this.WorkflowExecution.Equal(that1.WorkflowExecution) {
+		return false
 	}
-	s = append(s, "}")
-	return strings.Join(s, "")
-}
-func (this *RecordActivityTaskHeartbeatResponse) GoString() string {
-	if this == nil {
-		return "nil"
+	if this.Identity != that1.Identity {
+		return false
 	}
-	s := make([]string, 0, 5)
-	s = append(s, "&historyservice.RecordActivityTaskHeartbeatResponse{")
-	s = append(s, "CancelRequested: "+fmt.Sprintf("%#v", this.CancelRequested)+",\n")
-	s = append(s, "}")
-	return strings.Join(s, "")
+	return true
 }
-func (this *RespondActivityTaskCompletedRequest) GoString() string {
-	if this == nil {
-		return "nil"
-	}
-	s := make([]string, 0, 6)
-	s = append(s, "&historyservice.RespondActivityTaskCompletedRequest{")
-	s = append(s, "NamespaceId: "+fmt.Sprintf("%#v", this.NamespaceId)+",\n")
-	if this.CompleteRequest != nil {
-		s = append(s, "CompleteRequest: "+fmt.Sprintf("%#v", this.CompleteRequest)+",\n")
-	}
-	s = append(s, "}")
-	return strings.Join(s, "")
-}
-func (this *RespondActivityTaskCompletedResponse) GoString() string {
-	if this == nil {
-		return "nil"
+func (this *DeleteWorkflowExecutionResponse) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
 	}
-	s := make([]string, 0, 4)
-	s = append(s, "&historyservice.RespondActivityTaskCompletedResponse{")
-	s = append(s, "}")
-	return strings.Join(s, "")
-}
-func (this *RespondActivityTaskFailedRequest) GoString() string {
-	if this == nil {
-		return "nil"
+
+	that1, ok := that.(*DeleteWorkflowExecutionResponse)
+	if !ok {
+		that2, ok := that.(DeleteWorkflowExecutionResponse)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
 	}
-	s := make([]string, 0, 6)
-	s = append(s, "&historyservice.RespondActivityTaskFailedRequest{")
-	s = append(s, "NamespaceId: "+fmt.Sprintf("%#v", this.NamespaceId)+",\n")
-	if this.FailedRequest != nil {
-		s = append(s, "FailedRequest: "+fmt.Sprintf("%#v", this.FailedRequest)+",\n")
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
 	}
-	s = append(s, "}")
-	return strings.Join(s, "")
+	return true
 }
-func (this *RespondActivityTaskFailedResponse) GoString() string {
-	if this == nil {
-		return "nil"
+func (this *UpdateWorkflowExecutionRequest) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
 	}
-	s := make([]string, 0, 4)
-	s = append(s, "&historyservice.RespondActivityTaskFailedResponse{")
-	s = append(s, "}")
-	return strings.Join(s, "")
-}
-func (this *RespondActivityTaskCanceledRequest) GoString() string {
-	if this == nil {
-		return "nil"
+
+	that1, ok := that.(*UpdateWorkflowExecutionRequest)
+	if !ok {
+		that2, ok := that.(UpdateWorkflowExecutionRequest)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
 	}
-	s := make([]string, 0, 6)
-	s = append(s, "&historyservice.RespondActivityTaskCanceledRequest{")
-	s = append(s, "NamespaceId: "+fmt.Sprintf("%#v", this.NamespaceId)+",\n")
-	if this.CancelRequest != nil {
-		s = append(s, "CancelRequest: "+fmt.Sprintf("%#v", this.CancelRequest)+",\n")
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
 	}
-	s = append(s, "}")
-	return strings.Join(s, "")
-}
-func (this *RespondActivityTaskCanceledResponse) GoString() string {
-	if this == nil {
-		return "nil"
+	if this.NamespaceId != that1.NamespaceId {
+		return false
 	}
-	s := make([]string, 0, 4)
-	s = append(s, "&historyservice.RespondActivityTaskCanceledResponse{")
-	s = append(s, "}")
-	return strings.Join(s, "")
-}
-func (this *SignalWorkflowExecutionRequest) GoString() string {
-	if this == nil {
-		return "nil"
+	if !this.WorkflowExecution.Equal(that1.WorkflowExecution) {
+		return false
 	}
-	s := make([]string, 0, 8)
-	s = append(s, "&historyservice.SignalWorkflowExecutionRequest{")
-	s = append(s, "NamespaceId: "+fmt.Sprintf("%#v", this.NamespaceId)+",\n")
-	if this.SignalRequest != nil {
-		s = append(s, "SignalRequest: "+fmt.Sprintf("%#v", this.SignalRequest)+",\n")
+	if this.UpdateId != that1.UpdateId {
+		return false
 	}
-	if this.ExternalWorkflowExecution != nil {
-		s = append(s, "ExternalWorkflowExecution: "+fmt.Sprintf("%#v", this.ExternalWorkflowExecution)+",\n")
+	if this.Name != that1.Name {
+		return false
 	}
-	s = append(s, "ChildWorkflowOnly: "+fmt.Sprintf("%#v", this.ChildWorkflowOnly)+",\n")
-	s = append(s, "}")
-	return strings.Join(s, "")
-}
-func (this *SignalWorkflowExecutionResponse) GoString() string {
-	if this == nil {
-		return "nil"
+	if !this.Input.Equal(that1.Input) {
+		return false
 	}
-	s := make([]string, 0, 4)
-	s = append(s, "&historyservice.SignalWorkflowExecutionResponse{")
-	s = append(s, "}")
-	return strings.Join(s, "")
-}
-func (this *SignalWithStartWorkflowExecutionRequest) GoString() string {
-	if this == nil {
-		return "nil"
+	if this.Identity != that1.Identity {
+		return false
 	}
-	s := make([]string, 0, 6)
-	s = append(s, "&historyservice.SignalWithStartWorkflowExecutionRequest{")
-	s = append(s, "NamespaceId: "+fmt.Sprintf("%#v", this.NamespaceId)+",\n")
-	if this.SignalWithStartRequest != nil {
-		s = append(s, "SignalWithStartRequest: "+fmt.Sprintf("%#v", this.SignalWithStartRequest)+",\n")
+	if this.WaitForAccepted != that1.WaitForAccepted {
+		return false
 	}
-	s = append(s, "}")
-	return strings.Join(s, "")
+	return true
 }
-func (this *SignalWithStartWorkflowExecutionResponse) GoString() string {
-	if this == nil {
-		return "nil"
+func (this *UpdateWorkflowExecutionResponse) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
 	}
-	s := make([]string, 0, 5)
-	s = append(s, "&historyservice.SignalWithStartWorkflowExecutionResponse{")
-	s = append(s, "RunId: "+fmt.Sprintf("%#v", this.RunId)+",\n")
-	s = append(s, "}")
-	return strings.Join(s, "")
-}
-func (this *RemoveSignalMutableStateRequest) GoString() string {
-	if this == nil {
-		return "nil"
+
+	that1, ok := that.(*UpdateWorkflowExecutionResponse)
+	if !ok {
+		that2, ok := that.(UpdateWorkflowExecutionResponse)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
 	}
-	s := make([]string, 0, 7)
-	s = append(s, "&historyservice.RemoveSignalMutableStateRequest{")
-	s = append(s, "NamespaceId: "+fmt.Sprintf("%#v", this.NamespaceId)+",\n")
-	if this.WorkflowExecution != nil {
-		s = append(s, "WorkflowExecution: "+fmt.Sprintf("%#v", this.WorkflowExecution)+",\n")
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
 	}
-	s = append(s, "RequestId: "+fmt.Sprintf("%#v", this.RequestId)+",\n")
-	s = append(s, "}")
-	return strings.Join(s, "")
-}
-func (this *RemoveSignalMutableStateResponse) GoString() string {
-	if this == nil {
-		return "nil"
+	if this.Completed != that1.Completed {
+		return false
 	}
-	s := make([]string, 0, 4)
-	s = append(s, "&historyservice.RemoveSignalMutableStateResponse{")
-	s = append(s, "}")
-	return strings.Join(s, "")
-}
-func (this *TerminateWorkflowExecutionRequest) GoString() string {
-	if this == nil {
-		return "nil"
+	if !this.Result.Equal(that1.Result) {
+		return false
 	}
-	s := make([]string, 0, 6)
-	s = append(s, "&historyservice.TerminateWorkflowExecutionRequest{")
-	s = append(s, "NamespaceId: "+fmt.Sprintf("%#v", this.NamespaceId)+",\n")
-	if this.TerminateRequest != nil {
-		s = append(s, "TerminateRequest: "+fmt.Sprintf("%#v", this.TerminateRequest)+",\n")
+	if !this.Failure.Equal(that1.Failure) {
+		return false
 	}
-	s = append(s, "}")
-	return strings.Join(s, "")
+	return true
 }
-func (this *TerminateWorkflowExecutionResponse) GoString() string {
-	if this == nil {
-		return "nil"
+func (this *AcquireShardRequest) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
 	}
-	s := make([]string, 0, 4)
-	s = append(s, "&historyservice.TerminateWorkflowExecutionResponse{")
-	s = append(s, "}")
-	return strings.Join(s, "")
-}
-func (this *ResetWorkflowExecutionRequest) GoString() string {
-	if this == nil {
-		return "nil"
+
+	that1, ok := that.(*AcquireShardRequest)
+	if !ok {
+		that2, ok := that.(AcquireShardRequest)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
 	}
-	s := make([]string, 0, 6)
-	s = append(s, "&historyservice.ResetWorkflowExecutionRequest{")
-	s = append(s, "NamespaceId: "+fmt.Sprintf("%#v", this.NamespaceId)+",\n")
-	if this.ResetRequest != nil {
-		s = append(s, "ResetRequest: "+fmt.Sprintf("%#v", this.ResetRequest)+",\n")
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
 	}
-	s = append(s, "}")
-	return strings.Join(s, "")
-}
-func (this *ResetWorkflowExecutionResponse) GoString() string {
-	if this == nil {
-		return "nil"
+	if this.ShardId != that1.ShardId {
+		return false
 	}
-	s := make([]string, 0, 5)
-	s = append(s, "&historyservice.ResetWorkflowExecutionResponse{")
-	s = append(s, "RunId: "+fmt.Sprintf("%#v", this.RunId)+",\n")
-	s = append(s, "}")
-	return strings.Join(s, "")
+	return true
 }
-func (this *RequestCancelWorkflowExecutionRequest) GoString() string {
-	if this == nil {
-		return "nil"
+func (this *AcquireShardResponse) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
 	}
-	s := make([]string, 0, 9)
-	s = append(s, "&historyservice.RequestCancelWorkflowExecutionRequest{")
-	s = append(s, "NamespaceId: "+fmt.Sprintf("%#v", this.NamespaceId)+",\n")
-	if this.CancelRequest != nil {
-		s = append(s, "CancelRequest: "+fmt.Sprintf("%#v", this.CancelRequest)+",\n")
+
+	that1, ok := that.(*AcquireShardResponse)
+	if !ok {
+		that2, ok := that.(AcquireShardResponse)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
 	}
-	s = append(s, "ExternalInitiatedEventId: "+fmt.Sprintf("%#v", this.ExternalInitiatedEventId)+",\n")
-	if this.ExternalWorkflowExecution != nil {
-		s = append(s, "ExternalWorkflowExecution: "+fmt.Sprintf("%#v", this.ExternalWorkflowExecution)+",\n")
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
 	}
-	s = append(s, "ChildWorkflowOnly: "+fmt.Sprintf("%#v", this.ChildWorkflowOnly)+",\n")
-	s = append(s, "}")
-	return strings.Join(s, "")
+	return true
 }
-func (this *RequestCancelWorkflowExecutionResponse) GoString() string {
-	if this == nil {
-		return "nil"
+func (this *PauseWorkflowExecutionRequest) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
 	}
-	s := make([]string, 0, 4)
-	s = append(s, "&historyservice.RequestCancelWorkflowExecutionResponse{")
-	s = append(s, "}")
-	return strings.Join(s, "")
+
+	that1, ok := that.(*PauseWorkflowExecutionRequest)
+	if !ok {
+		that2, ok := that.(PauseWorkflowExecutionRequest)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.NamespaceId != that1.NamespaceId {
+		return false
+	}
+	if !this.WorkflowExecution.Equal(that1.WorkflowExecution) {
+		return false
+	}
+	if this.Identity != that1.Identity {
+		return false
+	}
+	if this.Reason != that1.Reason {
+		return false
+	}
+	return true
 }
-func (this *ScheduleWorkflowTaskRequest) GoString() string {
-	if this == nil {
-		return "nil"
+func (this *PauseWorkflowExecutionResponse) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
 	}
-	s := make([]string, 0, 7)
-	s = append(s, "&historyservice.ScheduleWorkflowTaskRequest{")
-	s = append(s, "NamespaceId: "+fmt.Sprintf("%#v", this.NamespaceId)+",\n")
-	if this.WorkflowExecution != nil {
-		s = append(s, "WorkflowExecution: "+fmt.Sprintf("%#v", this.WorkflowExecution)+",\n")
+
+	that1, ok := that.(*PauseWorkflowExecutionResponse)
+	if !ok {
+		that2, ok := that.(PauseWorkflowExecutionResponse)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
 	}
-	s = append(s, "IsFirstWorkflowTask: "+fmt.Sprintf("%#v", this.IsFirstWorkflowTask)+",\n")
-	s = append(s, "}")
-	return strings.Join(s, "")
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	return true
 }
-func (this *ScheduleWorkflowTaskResponse) GoString() string {
-	if this == nil {
-		return "nil"
+func (this *UnpauseWorkflowExecutionRequest) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
 	}
-	s := make([]string, 0, 4)
-	s = append(s, "&historyservice.ScheduleWorkflowTaskResponse{")
-	s = append(s, "}")
-	return strings.Join(s, "")
+
+	that1, ok := that.(*UnpauseWorkflowExecutionRequest)
+	if !ok {
+		that2, ok := that.(UnpauseWorkflowExecutionRequest)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.NamespaceId != that1.NamespaceId {
+		return false
+	}
+	if !this.WorkflowExecution.Equal(that1.WorkflowExecution) {
+		return false
+	}
+	if this.Identity != that1.Identity {
+		return false
+	}
+	return true
 }
-func (this *RecordChildExecutionCompletedRequest) GoString() string {
+func (this *UnpauseWorkflowExecutionResponse) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*UnpauseWorkflowExecutionResponse)
+	if !ok {
+		that2, ok := that.(UnpauseWorkflowExecutionResponse)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	return true
+}
+func (this *StartWorkflowExecutionRequest) GoString() string {
 	if this == nil {
 		return "nil"
 	}
-	s := make([]string, 0, 9)
-	s = append(s, "&historyservice.RecordChildExecutionCompletedRequest{")
+	s := make([]string, 0, 13)
+	s = append(s, "&historyservice.StartWorkflowExecutionRequest{")
 	s = append(s, "NamespaceId: "+fmt.Sprintf("%#v", this.NamespaceId)+",\n")
-	if this.WorkflowExecution != nil {
-		s = append(s, "WorkflowExecution: "+fmt.Sprintf("%#v", this.WorkflowExecution)+",\n")
+	if this.StartRequest != nil {
+		s = append(s, "StartRequest: "+fmt.Sprintf("%#v", this.StartRequest)+",\n")
 	}
-	s = append(s, "InitiatedId: "+fmt.Sprintf("%#v", this.InitiatedId)+",\n")
-	if this.CompletedExecution != nil {
-		s = append(s, "CompletedExecution: "+fmt.Sprintf("%#v", this.CompletedExecution)+",\n")
+	if this.ParentExecutionInfo != nil {
+		s = append(s, "ParentExecutionInfo: "+fmt.Sprintf("%#v", this.ParentExecutionInfo)+",\n")
 	}
-	if this.CompletionEvent != nil {
-		s = append(s, "CompletionEvent: "+fmt.Sprintf("%#v", this.CompletionEvent)+",\n")
+	s = append(s, "Attempt: "+fmt.Sprintf("%#v", this.Attempt)+",\n")
+	s = append(s, "WorkflowExecutionExpirationTime: "+fmt.Sprintf("%#v", this.WorkflowExecutionExpirationTime)+",\n")
+	s = append(s, "ContinueAsNewInitiator: "+fmt.Sprintf("%#v", this.ContinueAsNewInitiator)+",\n")
+	if this.ContinuedFailure != nil {
+		s = append(s, "ContinuedFailure: "+fmt.Sprintf("%#v", this.ContinuedFailure)+",\n")
+	}
+	if this.LastCompletionResult != nil {
+		s = append(s, "LastCompletionResult: "+fmt.Sprintf("%#v", this.LastCompletionResult)+",\n")
 	}
+	s = append(s, "FirstWorkflowTaskBackoff: "+fmt.Sprintf("%#v", this.FirstWorkflowTaskBackoff)+",\n")
 	s = append(s, "}")
 	return strings.Join(s, "")
 }
-func (this *RecordChildExecutionCompletedResponse) GoString() string {
+func (this *StartWorkflowExecutionResponse) GoString() string {
 	if this == nil {
 		return "nil"
 	}
-	s := make([]string, 0, 4)
-	s = append(s, "&historyservice.RecordChildExecutionCompletedResponse{")
+	s := make([]string, 0, 5)
+	s = append(s, "&historyservice.StartWorkflowExecutionResponse{")
+	s = append(s, "RunId: "+fmt.Sprintf("%#v", this.RunId)+",\n")
 	s = append(s, "}")
 	return strings.Join(s, "")
 }
-func (this *DescribeWorkflowExecutionRequest) GoString() string {
+func (this *GetMutableStateRequest) GoString() string {
 	if this == nil {
 		return "nil"
 	}
-	s := make([]string, 0, 6)
-	s = append(s, "&historyservice.DescribeWorkflowExecutionRequest{")
+	s := make([]string, 0, 8)
+	s = append(s, "&historyservice.GetMutableStateRequest{")
 	s = append(s, "NamespaceId: "+fmt.Sprintf("%#v", this.NamespaceId)+",\n")
-	if this.Request != nil {
-		s = append(s, "Request: "+fmt.Sprintf("%#v", this.Request)+",\n")
+	if this.Execution != nil {
+		s = append(s, "Execution: "+fmt.Sprintf("%#v", this.Execution)+",\n")
 	}
+	s = append(s, "ExpectedNextEventId: "+fmt.Sprintf("%#v", this.ExpectedNextEventId)+",\n")
+	s = append(s, "CurrentBranchToken: "+fmt.Sprintf("%#v", this.CurrentBranchToken)+",\n")
 	s = append(s, "}")
 	return strings.Join(s, "")
 }
-func (this *DescribeWorkflowExecutionResponse) GoString() string {
+func (this *GetMutableStateResponse) GoString() string {
 	if this == nil {
 		return "nil"
 	}
-	s := make([]string, 0, 8)
-	s = append(s, "&historyservice.DescribeWorkflowExecutionResponse{")
-	if this.ExecutionConfig != nil {
-		s = append(s, "ExecutionConfig: "+fmt.Sprintf("%#v", this.ExecutionConfig)+",\n")
+	s := make([]string, 0, 20)
+	s = append(s, "&historyservice.GetMutableStateResponse{")
+	if this.Execution != nil {
+		s = append(s, "Execution: "+fmt.Sprintf("%#v", this.Execution)+",\n")
 	}
-	if this.WorkflowExecutionInfo != nil {
-		s = append(s, "WorkflowExecutionInfo: "+fmt.Sprintf("%#v", this.WorkflowExecutionInfo)+",\n")
+	if this.WorkflowType != nil {
+		s = append(s, "WorkflowType: "+fmt.Sprintf("%#v", this.WorkflowType)+",\n")
 	}
-	if this.PendingActivities != nil {
-		s = append(s, "PendingActivities: "+fmt.Sprintf("%#v", this.PendingActivities)+",\n")
+	s = append(s, "NextEventId: "+fmt.Sprintf("%#v", this.NextEventId)+",\n")
+	s = append(s, "PreviousStartedEventId: "+fmt.Sprintf("%#v", this.PreviousStartedEventId)+",\n")
+	s = append(s, "LastFirstEventId: "+fmt.Sprintf("%#v", this.LastFirstEventId)+",\n")
+	if this.TaskQueue != nil {
+		s = append(s, "TaskQueue: "+fmt.Sprintf("%#v", this.TaskQueue)+",\n")
 	}
-	if this.PendingChildren != nil {
-		s = append(s, "PendingChildren: "+fmt.Sprintf("%#v", this.PendingChildren)+",\n")
+	if this.StickyTaskQueue != nil {
+		s = append(s, "StickyTaskQueue: "+fmt.Sprintf("%#v", this.StickyTaskQueue)+",\n")
+	}
+	s = append(s, "StickyTaskQueueScheduleToStartTimeout: "+fmt.Sprintf("%#v", this.StickyTaskQueueScheduleToStartTimeout)+",\n")
+	s = append(s, "EventStoreVersion: "+fmt.Sprintf("%#v", this.EventStoreVersion)+",\n")
+	s = append(s, "CurrentBranchToken: "+fmt.Sprintf("%#v", this.CurrentBranchToken)+",\n")
+	s = append(s, "WorkflowState: "+fmt.Sprintf("%#v", this.WorkflowState)+",\n")
+	s = append(s, "WorkflowStatus: "+fmt.Sprintf("%#v", this.WorkflowStatus)+",\n")
+	if this.VersionHistories != nil {
+		s = append(s, "VersionHistories: "+fmt.Sprintf("%#v", this.VersionHistories)+",\n")
 	}
+	s = append(s, "IsStickyTaskQueueEnabled: "+fmt.Sprintf("%#v", this.IsStickyTaskQueueEnabled)+",\n")
+	s = append(s, "HistorySizeBytes: "+fmt.Sprintf("%#v", this.HistorySizeBytes)+",\n")
+	s = append(s, "SuggestContinueAsNew: "+fmt.Sprintf("%#v", this.SuggestContinueAsNew)+",\n")
 	s = append(s, "}")
 	return strings.Join(s, "")
 }
-func (this *ReplicateEventsV2Request) GoString() string {
+func (this *PollMutableStateRequest) GoString() string {
 	if this == nil {
 		return "nil"
 	}
-	s := make([]string, 0, 9)
-	s = append(s, "&historyservice.ReplicateEventsV2Request{")
+	s := make([]string, 0, 8)
+	s = append(s, "&historyservice.PollMutableStateRequest{")
 	s = append(s, "NamespaceId: "+fmt.Sprintf("%#v", this.NamespaceId)+",\n")
-	if this.WorkflowExecution != nil {
-		s = append(s, "WorkflowExecution: "+fmt.Sprintf("%#v", this.WorkflowExecution)+",\n")
-	}
-	if this.VersionHistoryItems != nil {
-		s = append(s, "VersionHistoryItems: "+fmt.Sprintf("%#v", this.VersionHistoryItems)+",\n")
-	}
-	if this.Events != nil {
-		s = append(s, "Events: "+fmt.Sprintf("%#v", this.Events)+",\n")
-	}
-	if this.NewRunEvents != nil {
-		s = append(s, "NewRunEvents: "+fmt.Sprintf("%#v", this.NewRunEvents)+",\n")
+	if this.Execution != nil {
+		s = append(s, "Execution: "+fmt.Sprintf("%#v", this.Execution)+",\n")
 	}
+	s = append(s, "ExpectedNextEventId: "+fmt.Sprintf("%#v", this.ExpectedNextEventId)+",\n")
+	s = append(s, "CurrentBranchToken: "+fmt.Sprintf("%#v", this.CurrentBranchToken)+",\n")
 	s = append(s, "}")
 	return strings.Join(s, "")
 }
-func (this *ReplicateEventsV2Response) GoString() string {
+func (this *PollMutableStateResponse) GoString() string {
 	if this == nil {
 		return "nil"
 	}
-	s := make([]string, 0, 4)
-	s = append(s, "&historyservice.ReplicateEventsV2Response{")
+	s := make([]string, 0, 16)
+	s = append(s, "&historyservice.PollMutableStateResponse{")
+	if this.Execution != nil {
+		s = append(s, "Execution: "+fmt.Sprintf("%#v", this.Execution)+",\n")
+	}
+	if this.WorkflowType != nil {
+		s = append(s, "WorkflowType: "+fmt.Sprintf("%#v", this.WorkflowType)+",\n")
+	}
+	s = append(s, "NextEventId: "+fmt.Sprintf("%#v", this.NextEventId)+",\n")
+	s = append(s, "PreviousStartedEventId: "+fmt.Sprintf("%#v", this.PreviousStartedEventId)+",\n")
+	s = append(s, "LastFirstEventId: "+fmt.Sprintf("%#v", this.LastFirstEventId)+",\n")
+	if this.TaskQueue != nil {
+		s = append(s, "TaskQueue: "+fmt.Sprintf("%#v", this.TaskQueue)+",\n")
+	}
+	if this.StickyTaskQueue != nil {
+		s = append(s, "StickyTaskQueue: "+fmt.Sprintf("%#v", this.StickyTaskQueue)+",\n")
+	}
+	s = append(s, "StickyTaskQueueScheduleToStartTimeout: "+fmt.Sprintf("%#v", this.StickyTaskQueueScheduleToStartTimeout)+",\n")
+	s = append(s, "CurrentBranchToken: "+fmt.Sprintf("%#v", this.CurrentBranchToken)+",\n")
+	if this.VersionHistories != nil {
+		s = append(s, "VersionHistories: "+fmt.Sprintf("%#v", this.VersionHistories)+",\n")
+	}
+	s = append(s, "WorkflowState: "+fmt.Sprintf("%#v", this.WorkflowState)+",\n")
+	s = append(s, "WorkflowStatus: "+fmt.Sprintf("%#v", this.WorkflowStatus)+",\n")
 	s = append(s, "}")
 	return strings.Join(s, "")
 }
-func (this *SyncShardStatusRequest) GoString() string {
+func (this *ResetStickyTaskQueueRequest) GoString() string {
 	if this == nil {
 		return "nil"
 	}
-	s := make([]string, 0, 7)
-	s = append(s, "&historyservice.SyncShardStatusRequest{")
-	s = append(s, "SourceCluster: "+fmt.Sprintf("%#v", this.SourceCluster)+",\n")
-	s = append(s, "ShardId: "+fmt.Sprintf("%#v", this.ShardId)+",\n")
-	s = append(s, "StatusTime: "+fmt.Sprintf("%#v", this.StatusTime)+",\n")
+	s := make([]string, 0, 6)
+	s = append(s, "&historyservice.ResetStickyTaskQueueRequest{")
+	s = append(s, "NamespaceId: "+fmt.Sprintf("%#v", this.NamespaceId)+",\n")
+	if this.Execution != nil {
+		s = append(s, "Execution: "+fmt.Sprintf("%#v", this.Execution)+",\n")
+	}
 	s = append(s, "}")
 	return strings.Join(s, "")
 }
-func (this *SyncShardStatusResponse) GoString() string {
+func (this *ResetStickyTaskQueueResponse) GoString() string {
 	if this == nil {
 		return "nil"
 	}
 	s := make([]string, 0, 4)
-	s = append(s, "&historyservice.SyncShardStatusResponse{")
+	s = append(s, "&historyservice.ResetStickyTaskQueueResponse{")
 	s = append(s, "}")
 	return strings.Join(s, "")
 }
-func (this *SyncActivityRequest) GoString() string {
+func (this *RecordWorkflowTaskStartedRequest) GoString() string {
 	if this == nil {
 		return "nil"
 	}
-	s := make([]string, 0, 18)
-	s = append(s, "&historyservice.SyncActivityRequest{")
+	s := make([]string, 0, 10)
+	s = append(s, "&historyservice.RecordWorkflowTaskStartedRequest{")
 	s = append(s, "NamespaceId: "+fmt.Sprintf("%#v", this.NamespaceId)+",\n")
-	s = append(s, "WorkflowId: "+fmt.Sprintf("%#v", this.WorkflowId)+",\n")
-	s = append(s, "RunId: "+fmt.Sprintf("%#v", this.RunId)+",\n")
-	s = append(s, "Version: "+fmt.Sprintf("%#v", this.Version)+",\n")
-	s = append(s, "ScheduledId: "+fmt.Sprintf("%#v", this.ScheduledId)+",\n")
-	s = append(s, "ScheduledTime: "+fmt.Sprintf("%#v", this.ScheduledTime)+",\n")
-	s = append(s, "StartedId: "+fmt.Sprintf("%#v", this.StartedId)+",\n")
-	s = append(s, "StartedTime: "+fmt.Sprintf("%#v", this.StartedTime)+",\n")
-	s = append(s, "LastHeartbeatTime: "+fmt.Sprintf("%#v", this.LastHeartbeatTime)+",\n")
-	if this.Details != nil {
-		s = append(s, "Details: "+fmt.Sprintf("%#v", this.Details)+",\n")
-	}
-	s = append(s, "Attempt: "+fmt.Sprintf("%#v", this.Attempt)+",\n")
-	if this.LastFailure != nil {
-		s = append(s, "LastFailure: "+fmt.Sprintf("%#v", this.LastFailure)+",\n")
+	if this.WorkflowExecution != nil {
+		s = append(s, "WorkflowExecution: "+fmt.Sprintf("%#v", this.WorkflowExecution)+",\n")
 	}
-	s = append(s, "LastWorkerIdentity: "+fmt.Sprintf("%#v", this.LastWorkerIdentity)+",\n")
-	if this.VersionHistory != nil {
-		s = append(s, "VersionHistory: "+fmt.Sprintf("%#v", this.VersionHistory)+",\n")
+	s = append(s, "ScheduleId: "+fmt.Sprintf("%#v", this.ScheduleId)+",\n")
+	s = append(s, "TaskId: "+fmt.Sprintf("%#v", this.TaskId)+",\n")
+	s = append(s, "RequestId: "+fmt.Sprintf("%#v", this.RequestId)+",\n")
+	if this.PollRequest != nil {
+		s = append(s, "PollRequest: "+fmt.Sprintf("%#v", this.PollRequest)+",\n")
 	}
 	s = append(s, "}")
 	return strings.Join(s, "")
 }
-func (this *SyncActivityResponse) GoString() string {
+func (this *RecordWorkflowTaskStartedResponse) GoString() string {
 	if this == nil {
 		return "nil"
 	}
-	s := make([]string, 0, 4)
-	s = append(s, "&historyservice.SyncActivityResponse{")
+	s := make([]string, 0, 21)
+	s = append(s, "&historyservice.RecordWorkflowTaskStartedResponse{")
+	if this.WorkflowType != nil {
+		s = append(s, "WorkflowType: "+fmt.Sprintf("%#v", this.WorkflowType)+",\n")
+	}
+	s = append(s, "PreviousStartedEventId: "+fmt.Sprintf("%#v", this.PreviousStartedEventId)+",\n")
+	s = append(s, "ScheduledEventId: "+fmt.Sprintf("%#v", this.ScheduledEventId)+",\n")
+	s = append(s, "StartedEventId: "+fmt.Sprintf("%#v", this.StartedEventId)+",\n")
+	s = append(s, "NextEventId: "+fmt.Sprintf("%#v", this.NextEventId)+",\n")
+	s = append(s, "Attempt: "+fmt.Sprintf("%#v", this.Attempt)+",\n")
+	s = append(s, "StickyExecutionEnabled: "+fmt.Sprintf("%#v", this.StickyExecutionEnabled)+",\n")
+	if this.WorkflowTaskInfo != nil {
+		s = append(s, "WorkflowTaskInfo: "+fmt.Sprintf("%#v", this.WorkflowTaskInfo)+",\n")
+	}
+	if this.WorkflowExecutionTaskQueue != nil {
+		s = append(s, "WorkflowExecutionTaskQueue: "+fmt.Sprintf("%#v", this.WorkflowExecutionTaskQueue)+",\n")
+	}
+	s = append(s, "EventStoreVersion: "+fmt.Sprintf("%#v", this.EventStoreVersion)+",\n")
+	s = append(s, "BranchToken: "+fmt.Sprintf("%#v", this.BranchToken)+",\n")
+	s = append(s, "ScheduledTime: "+fmt.Sprintf("%#v", this.ScheduledTime)+",\n")
+	s = append(s, "StartedTime: "+fmt.Sprintf("%#v", this.StartedTime)+",\n")
+	keysForQueries := make([]string, 0, len(this.Queries))
+	for k, _ := range this.Queries {
+		keysForQueries = append(keysForQueries, k)
+	}
+	github_com_gogo_protobuf_sortkeys.Strings(keysForQueries)
+	mapStringForQueries := "map[string]*v18.WorkflowQuery{"
+	for _, k := range keysForQueries {
+		mapStringForQueries += fmt.Sprintf("%#v: %#v,", k, this.Queries[k])
+	}
+	mapStringForQueries += "}"
+	if this.Queries != nil {
+		s = append(s, "Queries: "+mapStringForQueries+",\n")
+	}
+	s = append(s, "HistorySizeBytes: "+fmt.Sprintf("%#v", this.HistorySizeBytes)+",\n")
+	s = append(s, "SuggestContinueAsNew: "+fmt.Sprintf("%#v", this.SuggestContinueAsNew)+",\n")
+	if this.Updates != nil {
+		s = append(s, "Updates: "+fmt.Sprintf("%#v", this.Updates)+",\n")
+	}
 	s = append(s, "}")
 	return strings.Join(s, "")
 }
-func (this *DescribeMutableStateRequest) GoString() string {
+func (this *RecordActivityTaskStartedRequest) GoString() string {
 	if this == nil {
 		return "nil"
 	}
-	s := make([]string, 0, 6)
-	s = append(s, "&historyservice.DescribeMutableStateRequest{")
+	s := make([]string, 0, 10)
+	s = append(s, "&historyservice.RecordActivityTaskStartedRequest{")
 	s = append(s, "NamespaceId: "+fmt.Sprintf("%#v", this.NamespaceId)+",\n")
-	if this.Execution != nil {
-		s = append(s, "Execution: "+fmt.Sprintf("%#v", this.Execution)+",\n")
+	if this.WorkflowExecution != nil {
+		s = append(s, "WorkflowExecution: "+fmt.Sprintf("%#v", this.WorkflowExecution)+",\n")
+	}
+	s = append(s, "ScheduleId: "+fmt.Sprintf("%#v", this.ScheduleId)+",\n")
+	s = append(s, "TaskId: "+fmt.Sprintf("%#v", this.TaskId)+",\n")
+	s = append(s, "RequestId: "+fmt.Sprintf("%#v", this.RequestId)+",\n")
+	if this.PollRequest != nil {
+		s = append(s, "PollRequest: "+fmt.Sprintf("%#v", this.PollRequest)+",\n")
 	}
 	s = append(s, "}")
 	return strings.Join(s, "")
 }
-func (this *DescribeMutableStateResponse) GoString() string {
+func (this *RecordActivityTaskStartedResponse) GoString() string {
 	if this == nil {
 		return "nil"
 	}
-	s := make([]string, 0, 8)
-	s = append(s, "&historyservice.DescribeMutableStateResponse{")
-	s = append(s, "CacheMutableState: "+fmt.Sprintf("%#v", this.CacheMutableState)+",\n")
-	s = append(s, "DatabaseMutableState: "+fmt.Sprintf("%#v", this.DatabaseMutableState)+",\n")
-	s = append(s, "TreeId: "+fmt.Sprintf("%#v", this.TreeId)+",\n")
-	s = append(s, "BranchId: "+fmt.Sprintf("%#v", this.BranchId)+",\n")
+	s := make([]string, 0, 11)
+	s = append(s, "&historyservice.RecordActivityTaskStartedResponse{")
+	if this.ScheduledEvent != nil {
+		s = append(s, "ScheduledEvent: "+fmt.Sprintf("%#v", this.ScheduledEvent)+",\n")
+	}
+	s = append(s, "StartedTime: "+fmt.Sprintf("%#v", this.StartedTime)+",\n")
+	s = append(s, "Attempt: "+fmt.Sprintf("%#v", this.Attempt)+",\n")
+	s = append(s, "CurrentAttemptScheduledTime: "+fmt.Sprintf("%#v", this.CurrentAttemptScheduledTime)+",\n")
+	if this.HeartbeatDetails != nil {
+		s = append(s, "HeartbeatDetails: "+fmt.Sprintf("%#v", this.HeartbeatDetails)+",\n")
+	}
+	if this.WorkflowType != nil {
+		s = append(s, "WorkflowType: "+fmt.Sprintf("%#v", this.WorkflowType)+",\n")
+	}
+	s = append(s, "WorkflowNamespace: "+fmt.Sprintf("%#v", this.WorkflowNamespace)+",\n")
 	s = append(s, "}")
 	return strings.Join(s, "")
 }
-func (this *DescribeHistoryHostRequest) GoString() string {
+func (this *RespondWorkflowTaskCompletedRequest) GoString() string {
 	if this == nil {
 		return "nil"
 	}
-	s := make([]string, 0, 8)
-	s = append(s, "&historyservice.DescribeHistoryHostRequest{")
-	s = append(s, "HostAddress: "+fmt.Sprintf("%#v", this.HostAddress)+",\n")
-	s = append(s, "ShardId: "+fmt.Sprintf("%#v", this.ShardId)+",\n")
+	s := make([]string, 0, 6)
+	s = append(s, "&historyservice.RespondWorkflowTaskCompletedRequest{")
 	s = append(s, "NamespaceId: "+fmt.Sprintf("%#v", this.NamespaceId)+",\n")
-	if this.WorkflowExecution != nil {
-		s = append(s, "WorkflowExecution: "+fmt.Sprintf("%#v", this.WorkflowExecution)+",\n")
+	if this.CompleteRequest != nil {
+		s = append(s, "CompleteRequest: "+fmt.Sprintf("%#v", this.CompleteRequest)+",\n")
 	}
 	s = append(s, "}")
 	return strings.Join(s, "")
 }
-func (this *DescribeHistoryHostResponse) GoString() string {
+func (this *RespondWorkflowTaskCompletedResponse) GoString() string {
 	if this == nil {
 		return "nil"
 	}
-	s := make([]string, 0, 9)
-	s = append(s, "&historyservice.DescribeHistoryHostResponse{")
-	s = append(s, "ShardsNumber: "+fmt.Sprintf("%#v", this.ShardsNumber)+",\n")
-	s = append(s, "ShardIds: "+fmt.Sprintf("%#v", this.ShardIds)+",\n")
-	if this.NamespaceCache != nil {
-		s = append(s, "NamespaceCache: "+fmt.Sprintf("%#v", this.NamespaceCache)+",\n")
+	s := make([]string, 0, 5)
+	s = append(s, "&historyservice.RespondWorkflowTaskCompletedResponse{")
+	if this.StartedResponse != nil {
+		s = append(s, "StartedResponse: "+fmt.Sprintf("%#v", this.StartedResponse)+",\n")
 	}
-	s = append(s, "ShardControllerStatus: "+fmt.Sprintf("%#v", this.ShardControllerStatus)+",\n")
-	s = append(s, "Address: "+fmt.Sprintf("%#v", this.Address)+",\n")
 	s = append(s, "}")
 	return strings.Join(s, "")
 }
-func (this *CloseShardRequest) GoString() string {
+func (this *RespondWorkflowTaskFailedRequest) GoString() string {
 	if this == nil {
 		return "nil"
 	}
-	s := make([]string, 0, 5)
-	s = append(s, "&historyservice.CloseShardRequest{")
-	s = append(s, "ShardId: "+fmt.Sprintf("%#v", this.ShardId)+",\n")
+	s := make([]string, 0, 6)
+	s = append(s, "&historyservice.RespondWorkflowTaskFailedRequest{")
+	s = append(s, "NamespaceId: "+fmt.Sprintf("%#v", this.NamespaceId)+",\n")
+	if this.FailedRequest != nil {
+		s = append(s, "FailedRequest: "+fmt.Sprintf("%#v", this.FailedRequest)+",\n")
+	}
 	s = append(s, "}")
 	return strings.Join(s, "")
 }
-func (this *CloseShardResponse) GoString() string {
+func (this *RespondWorkflowTaskFailedResponse) GoString() string {
 	if this == nil {
 		return "nil"
 	}
 	s := make([]string, 0, 4)
-	s = append(s, "&historyservice.CloseShardResponse{")
+	s = append(s, "&historyservice.RespondWorkflowTaskFailedResponse{")
 	s = append(s, "}")
 	return strings.Join(s, "")
 }
-func (this *RemoveTaskRequest) GoString() string {
+func (this *RecordActivityTaskHeartbeatRequest) GoString() string {
 	if this == nil {
 		return "nil"
 	}
-	s := make([]string, 0, 8)
-	s = append(s, "&historyservice.RemoveTaskRequest{")
-	s = append(s, "ShardId: "+fmt.Sprintf("%#v", this.ShardId)+",\n")
-	s = append(s, "Category: "+fmt.Sprintf("%#v", this.Category)+",\n")
-	s = append(s, "TaskId: "+fmt.Sprintf("%#v", this.TaskId)+",\n")
-	s = append(s, "VisibilityTime: "+fmt.Sprintf("%#v", this.VisibilityTime)+",\n")
+	s := make([]string, 0, 6)
+	s = append(s, "&historyservice.RecordActivityTaskHeartbeatRequest{")
+	s = append(s, "NamespaceId: "+fmt.Sprintf("%#v", this.NamespaceId)+",\n")
+	if this.HeartbeatRequest != nil {
+		s = append(s, "HeartbeatRequest: "+fmt.Sprintf("%#v", this.HeartbeatRequest)+",\n")
+	}
 	s = append(s, "}")
 	return strings.Join(s, "")
 }
-func (this *RemoveTaskResponse) GoString() string {
+func (this *RecordActivityTaskHeartbeatResponse) GoString() string {
 	if this == nil {
 		return "nil"
 	}
-	s := make([]string, 0, 4)
-	s = append(s, "&historyservice.RemoveTaskResponse{")
+	s := make([]string, 0, 5)
+	s = append(s, "&historyservice.RecordActivityTaskHeartbeatResponse{")
+	s = append(s, "CancelRequested: "+fmt.Sprintf("%#v", this.CancelRequested)+",\n")
 	s = append(s, "}")
 	return strings.Join(s, "")
 }
-func (this *GetReplicationMessagesRequest) GoString() string {
+func (this *RespondActivityTaskCompletedRequest) GoString() string {
 	if this == nil {
 		return "nil"
 	}
 	s := make([]string, 0, 6)
-	s = append(s, "&historyservice.GetReplicationMessagesRequest{")
-	if this.Tokens != nil {
-		s = append(s, "Tokens: "+fmt.Sprintf("%#v", this.Tokens)+",\n")
+	s = append(s, "&historyservice.RespondActivityTaskCompletedRequest{")
+	s = append(s, "NamespaceId: "+fmt.Sprintf("%#v", this.NamespaceId)+",\n")
+	if this.CompleteRequest != nil {
+		s = append(s, "CompleteRequest: "+fmt.Sprintf("%#v", this.CompleteRequest)+",\n")
 	}
-	s = append(s, "ClusterName: "+fmt.Sprintf("%#v", this.ClusterName)+",\n")
 	s = append(s, "}")
 	return strings.Join(s, "")
 }
-func (this *GetReplicationMessagesResponse) GoString() string {
+func (this *RespondActivityTaskCompletedResponse) GoString() string {
 	if this == nil {
 		return "nil"
 	}
-	s := make([]string, 0, 5)
-	s = append(s, "&historyservice.GetReplicationMessagesResponse{")
-	keysForShardMessages := make([]int32, 0, len(this.ShardMessages))
-	for k, _ := range this.ShardMessages {
-		keysForShardMessages = append(keysForShardMessages, k)
-	}
-	github_com_gogo_protobuf_sortkeys.Int32s(keysForShardMessages)
-	mapStringForShardMessages := "map[int32]*v112.ReplicationMessages{"
-	for _, k := range keysForShardMessages {
-		mapStringForShardMessages += fmt.Sprintf("%#v: %#v,", k, this.ShardMessages[k])
-	}
-	mapStringForShardMessages += "}"
-	if this.ShardMessages != nil {
-		s = append(s, "ShardMessages: "+mapStringForShardMessages+",\n")
-	}
+	s := make([]string, 0, 4)
+	s = append(s, "&historyservice.RespondActivityTaskCompletedResponse{")
 	s = append(s, "}")
 	return strings.Join(s, "")
 }
-func (this *GetDLQReplicationMessagesRequest) GoString() string {
+func (this *RespondActivityTaskFailedRequest) GoString() string {
 	if this == nil {
 		return "nil"
 	}
-	s := make([]string, 0, 5)
-	s = append(s, "&historyservice.GetDLQReplicationMessagesRequest{")
-	if this.TaskInfos != nil {
-		s = append(s, "TaskInfos: "+fmt.Sprintf("%#v", this.TaskInfos)+",\n")
+	s := make([]string, 0, 6)
+	s = append(s, "&historyservice.RespondActivityTaskFailedRequest{")
+	s = append(s, "NamespaceId: "+fmt.Sprintf("%#v", this.NamespaceId)+",\n")
+	if this.FailedRequest != nil {
+		s = append(s, "FailedRequest: "+fmt.Sprintf("%#v", this.FailedRequest)+",\n")
 	}
 	s = append(s, "}")
 	return strings.Join(s, "")
 }
-func (this *GetDLQReplicationMessagesResponse) GoString() string {
+func (this *RespondActivityTaskFailedResponse) GoString() string {
 	if this == nil {
 		return "nil"
 	}
-	s := make([]string, 0, 5)
-	s = append(s, "&historyservice.GetDLQReplicationMessagesResponse{")
-	if this.ReplicationTasks != nil {
-		s = append(s, "ReplicationTasks: "+fmt.Sprintf("%#v", this.ReplicationTasks)+",\n")
-	}
+	s := make([]string, 0, 4)
+	s = append(s, "&historyservice.RespondActivityTaskFailedResponse{")
 	s = append(s, "}")
 	return strings.Join(s, "")
 }
-func (this *QueryWorkflowRequest) GoString() string {
+func (this *RespondActivityTaskCanceledRequest) GoString() string {
 	if this == nil {
 		return "nil"
 	}
 	s := make([]string, 0, 6)
-	s = append(s, "&historyservice.QueryWorkflowRequest{")
+	s = append(s, "&historyservice.RespondActivityTaskCanceledRequest{")
 	s = append(s, "NamespaceId: "+fmt.Sprintf("%#v", this.NamespaceId)+",\n")
-	if this.Request != nil {
-		s = append(s, "Request: "+fmt.Sprintf("%#v", this.Request)+",\n")
+	if this.CancelRequest != nil {
+		s = append(s, "CancelRequest: "+fmt.Sprintf("%#v", this.CancelRequest)+",\n")
 	}
 	s = append(s, "}")
 	return strings.Join(s, "")
 }
-func (this *QueryWorkflowResponse) GoString() string {
+func (this *RespondActivityTaskCanceledResponse) GoString() string {
 	if this == nil {
 		return "nil"
 	}
-	s := make([]string, 0, 5)
-	s = append(s, "&historyservice.QueryWorkflowResponse{")
-	if this.Response != nil {
-		s = append(s, "Response: "+fmt.Sprintf("%#v", this.Response)+",\n")
-	}
+	s := make([]string, 0, 4)
+	s = append(s, "&historyservice.RespondActivityTaskCanceledResponse{")
 	s = append(s, "}")
 	return strings.Join(s, "")
 }
-func (this *ReapplyEventsRequest) GoString() string {
+func (this *SignalWorkflowExecutionRequest) GoString() string {
 	if this == nil {
 		return "nil"
 	}
-	s := make([]string, 0, 6)
-	s = append(s, "&historyservice.ReapplyEventsRequest{")
+	s := make([]string, 0, 8)
+	s = append(s, "&historyservice.SignalWorkflowExecutionRequest{")
 	s = append(s, "NamespaceId: "+fmt.Sprintf("%#v", this.NamespaceId)+",\n")
-	if this.Request != nil {
-		s = append(s, "Request: "+fmt.Sprintf("%#v", this.Request)+",\n")
+	if this.SignalRequest != nil {
+		s = append(s, "SignalRequest: "+fmt.Sprintf("%#v", this.SignalRequest)+",\n")
 	}
+	if this.ExternalWorkflowExecution != nil {
+		s = append(s, "ExternalWorkflowExecution: "+fmt.Sprintf("%#v", this.ExternalWorkflowExecution)+",\n")
+	}
+	s = append(s, "ChildWorkflowOnly: "+fmt.Sprintf("%#v", this.ChildWorkflowOnly)+",\n")
 	s = append(s, "}")
 	return strings.Join(s, "")
 }
-func (this *ReapplyEventsResponse) GoString() string {
+func (this *SignalWorkflowExecutionResponse) GoString() string {
 	if this == nil {
 		return "nil"
 	}
 	s := make([]string, 0, 4)
-	s = append(s, "&historyservice.ReapplyEventsResponse{")
+	s = append(s, "&historyservice.SignalWorkflowExecutionResponse{")
 	s = append(s, "}")
 	return strings.Join(s, "")
 }
-func (this *GetDLQMessagesRequest) GoString() string {
+func (this *SignalWithStartWorkflowExecutionRequest) GoString() string {
 	if this == nil {
 		return "nil"
 	}
-	s := make([]string, 0, 10)
-	s = append(s, "&historyservice.GetDLQMessagesRequest{")
-	s = append(s, "Type: "+fmt.Sprintf("%#v", this.Type)+",\n")
-	s = append(s, "ShardId: "+fmt.Sprintf("%#v", this.ShardId)+",\n")
-	s = append(s, "SourceCluster: "+fmt.Sprintf("%#v", this.SourceCluster)+",\n")
-	s = append(s, "InclusiveEndMessageId: "+fmt.Sprintf("%#v", this.InclusiveEndMessageId)+",\n")
-	s = append(s, "MaximumPageSize: "+fmt.Sprintf("%#v", this.MaximumPageSize)+",\n")
-	s = append(s, "NextPageToken: "+fmt.Sprintf("%#v", this.NextPageToken)+",\n")
-	s = append(s, "}")
-	return strings.Join(s, "")
+	s := make([]string, 0, 6)
+	s = append(s, "&historyservice.SignalWithStartWorkflowExecutionRequest{")
+	s = append(s, "NamespaceId: "+fmt.Sprintf("%#v", this.NamespaceId)+",\n")
+	if this.SignalWithStartRequest != nil {
+		s = append(s, "SignalWithStartRequest: "+fmt.Sprintf("%#v", this.SignalWithStartRequest)+",\n")
+	}
+	s = append(s, "}")
+	return strings.Join(s, "")
 }
-func (this *GetDLQMessagesResponse) GoString() string {
+func (this *SignalWithStartWorkflowExecutionResponse) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 5)
+	s = append(s, "&historyservice.SignalWithStartWorkflowExecutionResponse{")
+	s = append(s, "RunId: "+fmt.Sprintf("%#v", this.RunId)+",\n")
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func (this *RemoveSignalMutableStateRequest) GoString() string {
 	if this == nil {
 		return "nil"
 	}
 	s := make([]string, 0, 7)
-	s = append(s, "&historyservice.GetDLQMessagesResponse{")
-	s = append(s, "Type: "+fmt.Sprintf("%#v", this.Type)+",\n")
-	if this.ReplicationTasks != nil {
-		s = append(s, "ReplicationTasks: "+fmt.Sprintf("%#v", this.ReplicationTasks)+",\n")
+	s = append(s, "&historyservice.RemoveSignalMutableStateRequest{")
+	s = append(s, "NamespaceId: "+fmt.Sprintf("%#v", this.NamespaceId)+",\n")
+	if this.WorkflowExecution != nil {
+		s = append(s, "WorkflowExecution: "+fmt.Sprintf("%#v", this.WorkflowExecution)+",\n")
 	}
-	s = append(s, "NextPageToken: "+fmt.Sprintf("%#v", this.NextPageToken)+",\n")
+	s = append(s, "RequestId: "+fmt.Sprintf("%#v", this.RequestId)+",\n")
 	s = append(s, "}")
 	return strings.Join(s, "")
 }
-func (this *PurgeDLQMessagesRequest) GoString() string {
+func (this *RemoveSignalMutableStateResponse) GoString() string {
 	if this == nil {
 		return "nil"
 	}
-	s := make([]string, 0, 8)
-	s = append(s, "&historyservice.PurgeDLQMessagesRequest{")
-	s = append(s, "Type: "+fmt.Sprintf("%#v", this.Type)+",\n")
-	s = append(s, "ShardId: "+fmt.Sprintf("%#v", this.ShardId)+",\n")
-	s = append(s, "SourceCluster: "+fmt.Sprintf("%#v", this.SourceCluster)+",\n")
-	s = append(s, "InclusiveEndMessageId: "+fmt.Sprintf("%#v", this.InclusiveEndMessageId)+",\n")
+	s := make([]string, 0, 4)
+	s = append(s, "&historyservice.RemoveSignalMutableStateResponse{")
 	s = append(s, "}")
 	return strings.Join(s, "")
 }
-func (this *PurgeDLQMessagesResponse) GoString() string {
+func (this *TerminateWorkflowExecutionRequest) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 6)
+	s = append(s, "&historyservice.TerminateWorkflowExecutionRequest{")
+	s = append(s, "NamespaceId: "+fmt.Sprintf("%#v", this.NamespaceId)+",\n")
+	if this.TerminateRequest != nil {
+		s = append(s, "TerminateRequest: "+fmt.Sprintf("%#v", this.TerminateRequest)+",\n")
+	}
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func (this *TerminateWorkflowExecutionResponse) GoString() string {
 	if this == nil {
 		return "nil"
 	}
 	s := make([]string, 0, 4)
-	s = append(s, "&historyservice.PurgeDLQMessagesResponse{")
+	s = append(s, "&historyservice.TerminateWorkflowExecutionResponse{")
 	s = append(s, "}")
 	return strings.Join(s, "")
 }
-func (this *MergeDLQMessagesRequest) GoString() string {
+func (this *ResetWorkflowExecutionRequest) GoString() string {
 	if this == nil {
 		return "nil"
 	}
 	s := make([]string, 0, 10)
-	s = append(s, "&historyservice.MergeDLQMessagesRequest{")
-	s = append(s, "Type: "+fmt.Sprintf("%#v", this.Type)+",\n")
-	s = append(s, "ShardId: "+fmt.Sprintf("%#v", this.ShardId)+",\n")
-	s = append(s, "SourceCluster: "+fmt.Sprintf("%#v", this.SourceCluster)+",\n")
-	s = append(s, "InclusiveEndMessageId: "+fmt.Sprintf("%#v", this.InclusiveEndMessageId)+",\n")
-	s = append(s, "MaximumPageSize: "+fmt.Sprintf("%#v", this.MaximumPageSize)+",\n")
-	s = append(s, "NextPageToken: "+fmt.Sprintf("%#v", this.NextPageToken)+",\n")
+	s = append(s, "&historyservice.ResetWorkflowExecutionRequest{")
+	s = append(s, "NamespaceId: "+fmt.Sprintf("%#v", this.NamespaceId)+",\n")
+	if this.ResetRequest != nil {
+		s = append(s, "ResetRequest: "+fmt.Sprintf("%#v", this.ResetRequest)+",\n")
+	}
+	s = append(s, "WorkflowTaskScheduledEventId: "+fmt.Sprintf("%#v", this.WorkflowTaskScheduledEventId)+",\n")
+	s = append(s, "ReapplyExcludeSignals: "+fmt.Sprintf("%#v", this.ReapplyExcludeSignals)+",\n")
+	s = append(s, "ReapplyExcludeAll: "+fmt.Sprintf("%#v", this.ReapplyExcludeAll)+",\n")
+	s = append(s, "DryRun: "+fmt.Sprintf("%#v", this.DryRun)+",\n")
 	s = append(s, "}")
 	return strings.Join(s, "")
 }
-func (this *MergeDLQMessagesResponse) GoString() string {
+func (this *ResetWorkflowExecutionResponse) GoString() string {
 	if this == nil {
 		return "nil"
 	}
-	s := make([]string, 0, 5)
-	s = append(s, "&historyservice.MergeDLQMessagesResponse{")
-	s = append(s, "NextPageToken: "+fmt.Sprintf("%#v", this.NextPageToken)+",\n")
+	s := make([]string, 0, 6)
+	s = append(s, "&historyservice.ResetWorkflowExecutionResponse{")
+	s = append(s, "RunId: "+fmt.Sprintf("%#v", this.RunId)+",\n")
+	if this.Preview != nil {
+		s = append(s, "Preview: "+fmt.Sprintf("%#v", this.Preview)+",\n")
+	}
 	s = append(s, "}")
 	return strings.Join(s, "")
 }
-func (this *RefreshWorkflowTasksRequest) GoString() string {
+func (this *ResetWorkflowExecutionPreview) GoString() string {
 	if this == nil {
 		return "nil"
 	}
-	s := make([]string, 0, 6)
-	s = append(s, "&historyservice.RefreshWorkflowTasksRequest{")
+	s := make([]string, 0, 11)
+	s = append(s, "&historyservice.ResetWorkflowExecutionPreview{")
+	s = append(s, "BaseRunId: "+fmt.Sprintf("%#v", this.BaseRunId)+",\n")
+	s = append(s, "WorkflowTaskFinishEventId: "+fmt.Sprintf("%#v", this.WorkflowTaskFinishEventId)+",\n")
+	s = append(s, "TerminatedRunId: "+fmt.Sprintf("%#v", this.TerminatedRunId)+",\n")
+	if this.DroppedEvents != nil {
+		s = append(s, "DroppedEvents: "+fmt.Sprintf("%#v", this.DroppedEvents)+",\n")
+	}
+	if this.FailedActivities != nil {
+		s = append(s, "FailedActivities: "+fmt.Sprintf("%#v", this.FailedActivities)+",\n")
+	}
+	if this.PendingChildren != nil {
+		s = append(s, "PendingChildren: "+fmt.Sprintf("%#v", this.PendingChildren)+",\n")
+	}
+	if this.ReappliedEvents != nil {
+		s = append(s, "ReappliedEvents: "+fmt.Sprintf("%#v", this.ReappliedEvents)+",\n")
+	}
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func (this *RequestCancelWorkflowExecutionRequest) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 9)
+	s = append(s, "&historyservice.RequestCancelWorkflowExecutionRequest{")
 	s = append(s, "NamespaceId: "+fmt.Sprintf("%#v", this.NamespaceId)+",\n")
-	if this.Request != nil {
-		s = append(s, "Request: "+fmt.Sprintf("%#v", this.Request)+",\n")
+	if this.CancelRequest != nil {
+		s = append(s, "CancelRequest: "+fmt.Sprintf("%#v", this.CancelRequest)+",\n")
+	}
+	s = append(s, "ExternalInitiatedEventId: "+fmt.Sprintf("%#v", this.ExternalInitiatedEventId)+",\n")
+	if this.ExternalWorkflowExecution != nil {
+		s = append(s, "ExternalWorkflowExecution: "+fmt.Sprintf("%#v", this.ExternalWorkflowExecution)+",\n")
 	}
+	s = append(s, "ChildWorkflowOnly: "+fmt.Sprintf("%#v", this.ChildWorkflowOnly)+",\n")
 	s = append(s, "}")
 	return strings.Join(s, "")
 }
-func (this *RefreshWorkflowTasksResponse) GoString() string {
+func (this *RequestCancelWorkflowExecutionResponse) GoString() string {
 	if this == nil {
 		return "nil"
 	}
 	s := make([]string, 0, 4)
-	s = append(s, "&historyservice.RefreshWorkflowTasksResponse{")
+	s = append(s, "&historyservice.RequestCancelWorkflowExecutionResponse{")
 	s = append(s, "}")
 	return strings.Join(s, "")
 }
-func valueToGoStringRequestResponse(v interface{}, typ string) string {
-	rv := reflect.ValueOf(v)
-	if rv.IsNil() {
+func (this *ScheduleWorkflowTaskRequest) GoString() string {
+	if this == nil {
 		return "nil"
 	}
-	pv := reflect.Indirect(rv).Interface()
-	return fmt.Sprintf("func(v %v) *%v { return &v } ( %#v )", typ, typ, pv)
-}
-func (m *StartWorkflowExecutionRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+	s := make([]string, 0, 7)
+	s = append(s, "&historyservice.ScheduleWorkflowTaskRequest{")
+	s = append(s, "NamespaceId: "+fmt.Sprintf("%#v", this.NamespaceId)+",\n")
+	if this.WorkflowExecution != nil {
+		s = append(s, "WorkflowExecution: "+fmt.Sprintf("%#v", this.WorkflowExecution)+",\n")
 	}
-	return dAtA[:n], nil
-}
-
-func (m *StartWorkflowExecutionRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+	s = append(s, "IsFirstWorkflowTask: "+fmt.Sprintf("%#v", this.IsFirstWorkflowTask)+",\n")
+	s = append(s, "}")
+	return strings.Join(s, "")
 }
-
-func (m *StartWorkflowExecutionRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.FirstWorkflowTaskBackoff != nil {
-		n1, err1 := github_com_gogo_protobuf_types.StdDurationMarshalTo(*m.FirstWorkflowTaskBackoff, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdDuration(*m.FirstWorkflowTaskBackoff):])
-		if err1 != nil {
-			return 0, err1
-		}
-		i -= n1
-		i = encodeVarintRequestResponse(dAtA, i, uint64(n1))
-		i--
-		dAtA[i] = 0x4a
+func (this *ScheduleWorkflowTaskResponse) GoString() string {
+	if this == nil {
+		return "nil"
 	}
-	if m.LastCompletionResult != nil {
-		{
-			size, err := m.LastCompletionResult.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintRequestResponse(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x42
+	s := make([]string, 0, 4)
+	s = append(s, "&historyservice.ScheduleWorkflowTaskResponse{")
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func (this *RecordChildExecutionCompletedRequest) GoString() string {
+	if this == nil {
+		return "nil"
 	}
-	if m.ContinuedFailure != nil {
-		{
-			size, err := m.ContinuedFailure.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintRequestResponse(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x3a
+	s := make([]string, 0, 9)
+	s = append(s, "&historyservice.RecordChildExecutionCompletedRequest{")
+	s = append(s, "NamespaceId: "+fmt.Sprintf("%#v", this.NamespaceId)+",\n")
+	if this.WorkflowExecution != nil {
+		s = append(s, "WorkflowExecution: "+fmt.Sprintf("%#v", this.WorkflowExecution)+",\n")
 	}
-	if m.ContinueAsNewInitiator != 0 {
-		i = encodeVarintRequestResponse(dAtA, i, uint64(m.ContinueAsNewInitiator))
-		i--
-		dAtA[i] = 0x30
+	s = append(s, "InitiatedId: "+fmt.Sprintf("%#v", this.InitiatedId)+",\n")
+	if this.CompletedExecution != nil {
+		s = append(s, "CompletedExecution: "+fmt.Sprintf("%#v", this.CompletedExecution)+",\n")
 	}
-	if m.WorkflowExecutionExpirationTime != nil {
-		n4, err4 := github_com_gogo_protobuf_types.StdTimeMarshalTo(*m.WorkflowExecutionExpirationTime, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(*m.WorkflowExecutionExpirationTime):])
-		if err4 != nil {
-			return 0, err4
-		}
-		i -= n4
-		i = encodeVarintRequestResponse(dAtA, i, uint64(n4))
-		i--
-		dAtA[i] = 0x2a
+	if this.CompletionEvent != nil {
+		s = append(s, "CompletionEvent: "+fmt.Sprintf("%#v", this.CompletionEvent)+",\n")
 	}
-	if m.Attempt != 0 {
-		i = encodeVarintRequestResponse(dAtA, i, uint64(m.Attempt))
-		i--
-		dAtA[i] = 0x20
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func (this *RecordChildExecutionCompletedResponse) GoString() string {
+	if this == nil {
+		return "nil"
 	}
-	if m.ParentExecutionInfo != nil {
-		{
-			size, err := m.ParentExecutionInfo.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintRequestResponse(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x1a
+	s := make([]string, 0, 4)
+	s = append(s, "&historyservice.RecordChildExecutionCompletedResponse{")
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func (this *DescribeWorkflowExecutionRequest) GoString() string {
+	if this == nil {
+		return "nil"
 	}
-	if m.StartRequest != nil {
-		{
-			size, err := m.StartRequest.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintRequestResponse(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x12
+	s := make([]string, 0, 6)
+	s = append(s, "&historyservice.DescribeWorkflowExecutionRequest{")
+	s = append(s, "NamespaceId: "+fmt.Sprintf("%#v", this.NamespaceId)+",\n")
+	if this.Request != nil {
+		s = append(s, "Request: "+fmt.Sprintf("%#v", this.Request)+",\n")
 	}
-	if len(m.NamespaceId) > 0 {
-		i -= len(m.NamespaceId)
-		copy(dAtA[i:], m.NamespaceId)
-		i = encodeVarintRequestResponse(dAtA, i, uint64(len(m.NamespaceId)))
-		i--
-		dAtA[i] = 0xa
-	}
-	return len(dAtA) - i, nil
+	s = append(s, "}")
+	return strings.Join(s, "")
 }
-
-func (m *StartWorkflowExecutionResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (this *DescribeWorkflowExecutionResponse) GoString() string {
+	if this == nil {
+		return "nil"
 	}
-	return dAtA[:n], nil
-}
-
-func (m *StartWorkflowExecutionResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
-}
-
-func (m *StartWorkflowExecutionResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.RunId) > 0 {
-		i -= len(m.RunId)
-		copy(dAtA[i:], m.RunId)
-		i = encodeVarintRequestResponse(dAtA, i, uint64(len(m.RunId)))
-		i--
-		dAtA[i] = 0xa
+	s := make([]string, 0, 12)
+	s = append(s, "&historyservice.DescribeWorkflowExecutionResponse{")
+	if this.ExecutionConfig != nil {
+		s = append(s, "ExecutionConfig: "+fmt.Sprintf("%#v", this.ExecutionConfig)+",\n")
 	}
-	return len(dAtA) - i, nil
-}
-
-func (m *GetMutableStateRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+	if this.WorkflowExecutionInfo != nil {
+		s = append(s, "WorkflowExecutionInfo: "+fmt.Sprintf("%#v", this.WorkflowExecutionInfo)+",\n")
 	}
-	return dAtA[:n], nil
-}
-
-func (m *GetMutableStateRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+	if this.PendingActivities != nil {
+		s = append(s, "PendingActivities: "+fmt.Sprintf("%#v", this.PendingActivities)+",\n")
+	}
+	if this.PendingChildren != nil {
+		s = append(s, "PendingChildren: "+fmt.Sprintf("%#v", this.PendingChildren)+",\n")
+	}
+	s = append(s, "HistorySizeBytes: "+fmt.Sprintf("%#v", this.HistorySizeBytes)+",\n")
+	s = append(s, "SuggestContinueAsNew: "+fmt.Sprintf("%#v", this.SuggestContinueAsNew)+",\n")
+	s = append(s, "Paused: "+fmt.Sprintf("%#v", this.Paused)+",\n")
+	s = append(s, "PauseReason: "+fmt.Sprintf("%#v", this.PauseReason)+",\n")
+	s = append(s, "}")
+	return strings.Join(s, "")
 }
-
-func (m *GetMutableStateRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.CurrentBranchToken) > 0 {
-		i -= len(m.CurrentBranchToken)
-		copy(dAtA[i:], m.CurrentBranchToken)
-		i = encodeVarintRequestResponse(dAtA, i, uint64(len(m.CurrentBranchToken)))
-		i--
-		dAtA[i] = 0x22
+func (this *ReplicateEventsV2Request) GoString() string {
+	if this == nil {
+		return "nil"
 	}
-	if m.ExpectedNextEventId != 0 {
-		i = encodeVarintRequestResponse(dAtA, i, uint64(m.ExpectedNextEventId))
-		i--
-		dAtA[i] = 0x18
+	s := make([]string, 0, 9)
+	s = append(s, "&historyservice.ReplicateEventsV2Request{")
+	s = append(s, "NamespaceId: "+fmt.Sprintf("%#v", this.NamespaceId)+",\n")
+	if this.WorkflowExecution != nil {
+		s = append(s, "WorkflowExecution: "+fmt.Sprintf("%#v", this.WorkflowExecution)+",\n")
 	}
-	if m.Execution != nil {
-		{
-			size, err := m.Execution.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintRequestResponse(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x12
+	if this.VersionHistoryItems != nil {
+		s = append(s, "VersionHistoryItems: "+fmt.Sprintf("%#v", this.VersionHistoryItems)+",\n")
 	}
-	if len(m.NamespaceId) > 0 {
-		i -= len(m.NamespaceId)
-		copy(dAtA[i:], m.NamespaceId)
-		i = encodeVarintRequestResponse(dAtA, i, uint64(len(m.NamespaceId)))
-		i--
-		dAtA[i] = 0xa
+	if this.Events != nil {
+		s = append(s, "Events: "+fmt.Sprintf("%#v", this.Events)+",\n")
 	}
-	return len(dAtA) - i, nil
+	if this.NewRunEvents != nil {
+		s = append(s, "NewRunEvents: "+fmt.Sprintf("%#v", this.NewRunEvents)+",\n")
+	}
+	s = append(s, "}")
+	return strings.Join(s, "")
 }
-
-func (m *GetMutableStateResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (this *ReplicateEventsV2Response) GoString() string {
+	if this == nil {
+		return "nil"
 	}
-	return dAtA[:n], nil
+	s := make([]string, 0, 4)
+	s = append(s, "&historyservice.ReplicateEventsV2Response{")
+	s = append(s, "}")
+	return strings.Join(s, "")
 }
-
-func (m *GetMutableStateResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (this *SyncShardStatusRequest) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 7)
+	s = append(s, "&historyservice.SyncShardStatusRequest{")
+	s = append(s, "SourceCluster: "+fmt.Sprintf("%#v", this.SourceCluster)+",\n")
+	s = append(s, "ShardId: "+fmt.Sprintf("%#v", this.ShardId)+",\n")
+	s = append(s, "StatusTime: "+fmt.Sprintf("%#v", this.StatusTime)+",\n")
+	s = append(s, "}")
+	return strings.Join(s, "")
 }
-
-func (m *GetMutableStateResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.IsStickyTaskQueueEnabled {
-		i--
-		if m.IsStickyTaskQueueEnabled {
-			dAtA[i] = 1
-		} else {
-			dAtA[i] = 0
-		}
-		i--
-		dAtA[i] = 0x1
-		i--
-		dAtA[i] = 0x90
+func (this *SyncShardStatusResponse) GoString() string {
+	if this == nil {
+		return "nil"
 	}
-	if m.VersionHistories != nil {
-		{
-			size, err := m.VersionHistories.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintRequestResponse(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x1
-		i--
-		dAtA[i] = 0x8a
+	s := make([]string, 0, 4)
+	s = append(s, "&historyservice.SyncShardStatusResponse{")
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func (this *SyncActivityRequest) GoString() string {
+	if this == nil {
+		return "nil"
 	}
-	if m.WorkflowStatus != 0 {
-		i = encodeVarintRequestResponse(dAtA, i, uint64(m.WorkflowStatus))
-		i--
-		dAtA[i] = 0x1
-		i--
-		dAtA[i] = 0x80
+	s := make([]string, 0, 18)
+	s = append(s, "&historyservice.SyncActivityRequest{")
+	s = append(s, "NamespaceId: "+fmt.Sprintf("%#v", this.NamespaceId)+",\n")
+	s = append(s, "WorkflowId: "+fmt.Sprintf("%#v", this.WorkflowId)+",\n")
+	s = append(s, "RunId: "+fmt.Sprintf("%#v", this.RunId)+",\n")
+	s = append(s, "Version: "+fmt.Sprintf("%#v", this.Version)+",\n")
+	s = append(s, "ScheduledId: "+fmt.Sprintf("%#v", this.ScheduledId)+",\n")
+	s = append(s, "ScheduledTime: "+fmt.Sprintf("%#v", this.ScheduledTime)+",\n")
+	s = append(s, "StartedId: "+fmt.Sprintf("%#v", this.StartedId)+",\n")
+	s = append(s, "StartedTime: "+fmt.Sprintf("%#v", this.StartedTime)+",\n")
+	s = append(s, "LastHeartbeatTime: "+fmt.Sprintf("%#v", this.LastHeartbeatTime)+",\n")
+	if this.Details != nil {
+		s = append(s, "Details: "+fmt.Sprintf("%#v", this.Details)+",\n")
 	}
-	if m.WorkflowState != 0 {
-		i = encodeVarintRequestResponse(dAtA, i, uint64(m.WorkflowState))
-		i--
-		dAtA[i] = 0x78
+	s = append(s, "Attempt: "+fmt.Sprintf("%#v", this.Attempt)+",\n")
+	if this.LastFailure != nil {
+		s = append(s, "LastFailure: "+fmt.Sprintf("%#v", this.LastFailure)+",\n")
 	}
-	if len(m.CurrentBranchToken) > 0 {
-		i -= len(m.CurrentBranchToken)
-		copy(dAtA[i:], m.CurrentBranchToken)
-		i = encodeVarintRequestResponse(dAtA, i, uint64(len(m.CurrentBranchToken)))
-		i--
-		dAtA[i] = 0x6a
+	s = append(s, "LastWorkerIdentity: "+fmt.Sprintf("%#v", this.LastWorkerIdentity)+",\n")
+	if this.VersionHistory != nil {
+		s = append(s, "VersionHistory: "+fmt.Sprintf("%#v", this.VersionHistory)+",\n")
 	}
-	if m.EventStoreVersion != 0 {
-		i = encodeVarintRequestResponse(dAtA, i, uint64(m.EventStoreVersion))
-		i--
-		dAtA[i] = 0x60
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func (this *SyncActivityResponse) GoString() string {
+	if this == nil {
+		return "nil"
 	}
-	if m.StickyTaskQueueScheduleToStartTimeout != nil {
-		n9, err9 := github_com_gogo_protobuf_types.StdDurationMarshalTo(*m.StickyTaskQueueScheduleToStartTimeout, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdDuration(*m.StickyTaskQueueScheduleToStartTimeout):])
-		if err9 != nil {
-			return 0, err9
-		}
-		i -= n9
-		i = encodeVarintRequestResponse(dAtA, i, uint64(n9))
-		i--
-		dAtA[i] = 0x5a
+	s := make([]string, 0, 4)
+	s = append(s, "&historyservice.SyncActivityResponse{")
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func (this *DescribeMutableStateRequest) GoString() string {
+	if this == nil {
+		return "nil"
 	}
-	if m.StickyTaskQueue != nil {
-		{
-			size, err := m.StickyTaskQueue.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintRequestResponse(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x3a
-	}
-	if m.TaskQueue != nil {
-		{
-			size, err := m.TaskQueue.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintRequestResponse(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x32
+	s := make([]string, 0, 6)
+	s = append(s, "&historyservice.DescribeMutableStateRequest{")
+	s = append(s, "NamespaceId: "+fmt.Sprintf("%#v", this.NamespaceId)+",\n")
+	if this.Execution != nil {
+		s = append(s, "Execution: "+fmt.Sprintf("%#v", this.Execution)+",\n")
 	}
-	if m.LastFirstEventId != 0 {
-		i = encodeVarintRequestResponse(dAtA, i, uint64(m.LastFirstEventId))
-		i--
-		dAtA[i] = 0x28
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func (this *DescribeMutableStateResponse) GoString() string {
+	if this == nil {
+		return "nil"
 	}
-	if m.PreviousStartedEventId != 0 {
-		i = encodeVarintRequestResponse(dAtA, i, uint64(m.PreviousStartedEventId))
-		i--
-		dAtA[i] = 0x20
+	s := make([]string, 0, 8)
+	s = append(s, "&historyservice.DescribeMutableStateResponse{")
+	s = append(s, "CacheMutableState: "+fmt.Sprintf("%#v", this.CacheMutableState)+",\n")
+	s = append(s, "DatabaseMutableState: "+fmt.Sprintf("%#v", this.DatabaseMutableState)+",\n")
+	s = append(s, "TreeId: "+fmt.Sprintf("%#v", this.TreeId)+",\n")
+	s = append(s, "BranchId: "+fmt.Sprintf("%#v", this.BranchId)+",\n")
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func (this *DescribeHistoryHostRequest) GoString() string {
+	if this == nil {
+		return "nil"
 	}
-	if m.NextEventId != 0 {
-		i = encodeVarintRequestResponse(dAtA, i, uint64(m.NextEventId))
-		i--
-		dAtA[i] = 0x18
+	s := make([]string, 0, 8)
+	s = append(s, "&historyservice.DescribeHistoryHostRequest{")
+	s = append(s, "HostAddress: "+fmt.Sprintf("%#v", this.HostAddress)+",\n")
+	s = append(s, "ShardId: "+fmt.Sprintf("%#v", this.ShardId)+",\n")
+	s = append(s, "NamespaceId: "+fmt.Sprintf("%#v", this.NamespaceId)+",\n")
+	if this.WorkflowExecution != nil {
+		s = append(s, "WorkflowExecution: "+fmt.Sprintf("%#v", this.WorkflowExecution)+",\n")
 	}
-	if m.WorkflowType != nil {
-		{
-			size, err := m.WorkflowType.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintRequestResponse(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x12
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func (this *DescribeHistoryHostResponse) GoString() string {
+	if this == nil {
+		return "nil"
 	}
-	if m.Execution != nil {
-		{
-			size, err := m.Execution.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintRequestResponse(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0xa
+	s := make([]string, 0, 9)
+	s = append(s, "&historyservice.DescribeHistoryHostResponse{")
+	s = append(s, "ShardsNumber: "+fmt.Sprintf("%#v", this.ShardsNumber)+",\n")
+	s = append(s, "ShardIds: "+fmt.Sprintf("%#v", this.ShardIds)+",\n")
+	if this.NamespaceCache != nil {
+		s = append(s, "NamespaceCache: "+fmt.Sprintf("%#v", this.NamespaceCache)+",\n")
 	}
-	return len(dAtA) - i, nil
+	s = append(s, "ShardControllerStatus: "+fmt.Sprintf("%#v", this.ShardControllerStatus)+",\n")
+	s = append(s, "Address: "+fmt.Sprintf("%#v", this.Address)+",\n")
+	s = append(s, "}")
+	return strings.Join(s, "")
 }
-
-func (m *PollMutableStateRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (this *CloseShardRequest) GoString() string {
+	if this == nil {
+		return "nil"
 	}
-	return dAtA[:n], nil
-}
-
-func (m *PollMutableStateRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+	s := make([]string, 0, 5)
+	s = append(s, "&historyservice.CloseShardRequest{")
+	s = append(s, "ShardId: "+fmt.Sprintf("%#v", this.ShardId)+",\n")
+	s = append(s, "}")
+	return strings.Join(s, "")
 }
-
-func (m *PollMutableStateRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.CurrentBranchToken) > 0 {
-		i -= len(m.CurrentBranchToken)
-		copy(dAtA[i:], m.CurrentBranchToken)
-		i = encodeVarintRequestResponse(dAtA, i, uint64(len(m.CurrentBranchToken)))
-		i--
-		dAtA[i] = 0x22
-	}
-	if m.ExpectedNextEventId != 0 {
-		i = encodeVarintRequestResponse(dAtA, i, uint64(m.ExpectedNextEventId))
-		i--
-		dAtA[i] = 0x18
-	}
-	if m.Execution != nil {
-		{
-			size, err := m.Execution.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintRequestResponse(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x12
+func (this *CloseShardResponse) GoString() string {
+	if this == nil {
+		return "nil"
 	}
-	if len(m.NamespaceId) > 0 {
-		i -= len(m.NamespaceId)
-		copy(dAtA[i:], m.NamespaceId)
-		i = encodeVarintRequestResponse(dAtA, i, uint64(len(m.NamespaceId)))
-		i--
-		dAtA[i] = 0xa
+	s := make([]string, 0, 4)
+	s = append(s, "&historyservice.CloseShardResponse{")
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func (this *RemoveTaskRequest) GoString() string {
+	if this == nil {
+		return "nil"
 	}
-	return len(dAtA) - i, nil
+	s := make([]string, 0, 8)
+	s = append(s, "&historyservice.RemoveTaskRequest{")
+	s = append(s, "ShardId: "+fmt.Sprintf("%#v", this.ShardId)+",\n")
+	s = append(s, "Category: "+fmt.Sprintf("%#v", this.Category)+",\n")
+	s = append(s, "TaskId: "+fmt.Sprintf("%#v", this.TaskId)+",\n")
+	s = append(s, "VisibilityTime: "+fmt.Sprintf("%#v", this.VisibilityTime)+",\n")
+	s = append(s, "}")
+	return strings.Join(s, "")
 }
-
-func (m *PollMutableStateResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (this *RemoveTaskResponse) GoString() string {
+	if this == nil {
+		return "nil"
 	}
-	return dAtA[:n], nil
+	s := make([]string, 0, 4)
+	s = append(s, "&historyservice.RemoveTaskResponse{")
+	s = append(s, "}")
+	return strings.Join(s, "")
 }
-
-func (m *PollMutableStateResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (this *GetReplicationMessagesRequest) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 6)
+	s = append(s, "&historyservice.GetReplicationMessagesRequest{")
+	if this.Tokens != nil {
+		s = append(s, "Tokens: "+fmt.Sprintf("%#v", this.Tokens)+",\n")
+	}
+	s = append(s, "ClusterName: "+fmt.Sprintf("%#v", this.ClusterName)+",\n")
+	s = append(s, "}")
+	return strings.Join(s, "")
 }
-
-func (m *PollMutableStateResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.WorkflowStatus != 0 {
-		i = encodeVarintRequestResponse(dAtA, i, uint64(m.WorkflowStatus))
-		i--
-		dAtA[i] = 0x1
-		i--
-		dAtA[i] = 0x80
+func (this *GetReplicationMessagesResponse) GoString() string {
+	if this == nil {
+		return "nil"
 	}
-	if m.WorkflowState != 0 {
-		i = encodeVarintRequestResponse(dAtA, i, uint64(m.WorkflowState))
-		i--
-		dAtA[i] = 0x78
+	s := make([]string, 0, 5)
+	s = append(s, "&historyservice.GetReplicationMessagesResponse{")
+	keysForShardMessages := make([]int32, 0, len(this.ShardMessages))
+	for k, _ := range this.ShardMessages {
+		keysForShardMessages = append(keysForShardMessages, k)
 	}
-	if m.VersionHistories != nil {
-		{
-			size, err := m.VersionHistories.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintRequestResponse(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x72
+	github_com_gogo_protobuf_sortkeys.Int32s(keysForShardMessages)
+	mapStringForShardMessages := "map[int32]*v112.ReplicationMessages{"
+	for _, k := range keysForShardMessages {
+		mapStringForShardMessages += fmt.Sprintf("%#v: %#v,", k, this.ShardMessages[k])
 	}
-	if len(m.CurrentBranchToken) > 0 {
-		i -= len(m.CurrentBranchToken)
-		copy(dAtA[i:], m.CurrentBranchToken)
-		i = encodeVarintRequestResponse(dAtA, i, uint64(len(m.CurrentBranchToken)))
-		i--
-		dAtA[i] = 0x62
+	mapStringForShardMessages += "}"
+	if this.ShardMessages != nil {
+		s = append(s, "ShardMessages: "+mapStringForShardMessages+",\n")
 	}
-	if m.StickyTaskQueueScheduleToStartTimeout != nil {
-		n16, err16 := github_com_gogo_protobuf_types.StdDurationMarshalTo(*m.StickyTaskQueueScheduleToStartTimeout, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdDuration(*m.StickyTaskQueueScheduleToStartTimeout):])
-		if err16 != nil {
-			return 0, err16
-		}
-		i -= n16
-		i = encodeVarintRequestResponse(dAtA, i, uint64(n16))
-		i--
-		dAtA[i] = 0x5a
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func (this *GetDLQReplicationMessagesRequest) GoString() string {
+	if this == nil {
+		return "nil"
 	}
-	if m.StickyTaskQueue != nil {
-		{
-			size, err := m.StickyTaskQueue.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintRequestResponse(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x3a
+	s := make([]string, 0, 5)
+	s = append(s, "&historyservice.GetDLQReplicationMessagesRequest{")
+	if this.TaskInfos != nil {
+		s = append(s, "TaskInfos: "+fmt.Sprintf("%#v", this.TaskInfos)+",\n")
 	}
-	if m.TaskQueue != nil {
-		{
-			size, err := m.TaskQueue.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintRequestResponse(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x32
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func (this *GetDLQReplicationMessagesResponse) GoString() string {
+	if this == nil {
+		return "nil"
 	}
-	if m.LastFirstEventId != 0 {
-		i = encodeVarintRequestResponse(dAtA, i, uint64(m.LastFirstEventId))
-		i--
-		dAtA[i] = 0x28
+	s := make([]string, 0, 5)
+	s = append(s, "&historyservice.GetDLQReplicationMessagesResponse{")
+	if this.ReplicationTasks != nil {
+		s = append(s, "ReplicationTasks: "+fmt.Sprintf("%#v", this.ReplicationTasks)+",\n")
 	}
-	if m.PreviousStartedEventId != 0 {
-		i = encodeVarintRequestResponse(dAtA, i, uint64(m.PreviousStartedEventId))
-		i--
-		dAtA[i] = 0x20
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func (this *QueryWorkflowRequest) GoString() string {
+	if this == nil {
+		return "nil"
 	}
-	if m.NextEventId != 0 {
-		i = encodeVarintRequestResponse(dAtA, i, uint64(m.NextEventId))
-		i--
-		dAtA[i] = 0x18
+	s := make([]string, 0, 6)
+	s = append(s, "&historyservice.QueryWorkflowRequest{")
+	s = append(s, "NamespaceId: "+fmt.Sprintf("%#v", this.NamespaceId)+",\n")
+	if this.Request != nil {
+		s = append(s, "Request: "+fmt.Sprintf("%#v", this.Request)+",\n")
 	}
-	if m.WorkflowType != nil {
-		{
-			size, err := m.WorkflowType.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintRequestResponse(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x12
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func (this *QueryWorkflowResponse) GoString() string {
+	if this == nil {
+		return "nil"
 	}
-	if m.Execution != nil {
-		{
-			size, err := m.Execution.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintRequestResponse(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0xa
+	s := make([]string, 0, 5)
+	s = append(s, "&historyservice.QueryWorkflowResponse{")
+	if this.Response != nil {
+		s = append(s, "Response: "+fmt.Sprintf("%#v", this.Response)+",\n")
 	}
-	return len(dAtA) - i, nil
+	s = append(s, "}")
+	return strings.Join(s, "")
 }
-
-func (m *ResetStickyTaskQueueRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (this *ReapplyEventsRequest) GoString() string {
+	if this == nil {
+		return "nil"
 	}
-	return dAtA[:n], nil
+	s := make([]string, 0, 6)
+	s = append(s, "&historyservice.ReapplyEventsRequest{")
+	s = append(s, "NamespaceId: "+fmt.Sprintf("%#v", this.NamespaceId)+",\n")
+	if this.Request != nil {
+		s = append(s, "Request: "+fmt.Sprintf("%#v", this.Request)+",\n")
+	}
+	s = append(s, "}")
+	return strings.Join(s, "")
 }
-
-func (m *ResetStickyTaskQueueRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (this *ReapplyEventsResponse) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 4)
+	s = append(s, "&historyservice.ReapplyEventsResponse{")
+	s = append(s, "}")
+	return strings.Join(s, "")
 }
-
-func (m *ResetStickyTaskQueueRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Execution != nil {
-		{
-			size, err := m.Execution.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintRequestResponse(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x12
+func (this *GetDLQMessagesRequest) GoString() string {
+	if this == nil {
+		return "nil"
 	}
-	if len(m.NamespaceId) > 0 {
-		i -= len(m.NamespaceId)
-		copy(dAtA[i:], m.NamespaceId)
-		i = encodeVarintRequestResponse(dAtA, i, uint64(len(m.NamespaceId)))
-		i--
-		dAtA[i] = 0xa
+	s := make([]string, 0, 10)
+	s = append(s, "&historyservice.GetDLQMessagesRequest{")
+	s = append(s, "Type: "+fmt.Sprintf("%#v", this.Type)+",\n")
+	s = append(s, "ShardId: "+fmt.Sprintf("%#v", this.ShardId)+",\n")
+	s = append(s, "SourceCluster: "+fmt.Sprintf("%#v", this.SourceCluster)+",\n")
+	s = append(s, "InclusiveEndMessageId: "+fmt.Sprintf("%#v", this.InclusiveEndMessageId)+",\n")
+	s = append(s, "MaximumPageSize: "+fmt.Sprintf("%#v", this.MaximumPageSize)+",\n")
+	s = append(s, "NextPageToken: "+fmt.Sprintf("%#v", this.NextPageToken)+",\n")
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func (this *GetDLQMessagesResponse) GoString() string {
+	if this == nil {
+		return "nil"
 	}
-	return len(dAtA) - i, nil
+	s := make([]string, 0, 7)
+	s = append(s, "&historyservice.GetDLQMessagesResponse{")
+	s = append(s, "Type: "+fmt.Sprintf("%#v", this.Type)+",\n")
+	if this.ReplicationTasks != nil {
+		s = append(s, "ReplicationTasks: "+fmt.Sprintf("%#v", this.ReplicationTasks)+",\n")
+	}
+	s = append(s, "NextPageToken: "+fmt.Sprintf("%#v", this.NextPageToken)+",\n")
+	s = append(s, "}")
+	return strings.Join(s, "")
 }
-
-func (m *ResetStickyTaskQueueResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (this *PurgeDLQMessagesRequest) GoString() string {
+	if this == nil {
+		return "nil"
 	}
-	return dAtA[:n], nil
+	s := make([]string, 0, 8)
+	s = append(s, "&historyservice.PurgeDLQMessagesRequest{")
+	s = append(s, "Type: "+fmt.Sprintf("%#v", this.Type)+",\n")
+	s = append(s, "ShardId: "+fmt.Sprintf("%#v", this.ShardId)+",\n")
+	s = append(s, "SourceCluster: "+fmt.Sprintf("%#v", this.SourceCluster)+",\n")
+	s = append(s, "InclusiveEndMessageId: "+fmt.Sprintf("%#v", this.InclusiveEndMessageId)+",\n")
+	s = append(s, "}")
+	return strings.Join(s, "")
 }
-
-func (m *ResetStickyTaskQueueResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (this *PurgeDLQMessagesResponse) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 4)
+	s = append(s, "&historyservice.PurgeDLQMessagesResponse{")
+	s = append(s, "}")
+	return strings.Join(s, "")
 }
-
-func (m *ResetStickyTaskQueueResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+func (this *MergeDLQMessagesRequest) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 10)
+	s = append(s, "&historyservice.MergeDLQMessagesRequest{")
+	s = append(s, "Type: "+fmt.Sprintf("%#v", this.Type)+",\n")
+	s = append(s, "ShardId: "+fmt.Sprintf("%#v", this.ShardId)+",\n")
+	s = append(s, "SourceCluster: "+fmt.Sprintf("%#v", this.SourceCluster)+",\n")
+	s = append(s, "InclusiveEndMessageId: "+fmt.Sprintf("%#v", this.InclusiveEndMessageId)+",\n")
+	s = append(s, "MaximumPageSize: "+fmt.Sprintf("%#v", this.MaximumPageSize)+",\n")
+	s = append(s, "NextPageToken: "+fmt.Sprintf("%#v", this.NextPageToken)+",\n")
+	s = append(s, "}")
+	return strings.Join(s, "")
 }
-
-func (m *RecordWorkflowTaskStartedRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (this *MergeDLQMessagesResponse) GoString() string {
+	if this == nil {
+		return "nil"
 	}
-	return dAtA[:n], nil
+	s := make([]string, 0, 5)
+	s = append(s, "&historyservice.MergeDLQMessagesResponse{")
+	s = append(s, "NextPageToken: "+fmt.Sprintf("%#v", this.NextPageToken)+",\n")
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func (this *RefreshWorkflowTasksRequest) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 6)
+	s = append(s, "&historyservice.RefreshWorkflowTasksRequest{")
+	s = append(s, "NamespaceId: "+fmt.Sprintf("%#v", this.NamespaceId)+",\n")
+	if this.Request != nil {
+		s = append(s, "Request: "+fmt.Sprintf("%#v", this.Request)+",\n")
+	}
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func (this *RefreshWorkflowTasksResponse) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 4)
+	s = append(s, "&historyservice.RefreshWorkflowTasksResponse{")
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func (this *DeleteWorkflowExecutionRequest) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 7)
+	s = append(s, "&historyservice.DeleteWorkflowExecutionRequest{")
+	s = append(s, "NamespaceId: "+fmt.Sprintf("%#v", this.NamespaceId)+",\n")
+	if this.WorkflowExecution != nil {
+		s = append(s, "WorkflowExecution: "+fmt.Sprintf("%#v", this.WorkflowExecution)+",\n")
+	}
+	s = append(s, "Identity: "+fmt.Sprintf("%#v", this.Identity)+",\n")
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func (this *DeleteWorkflowExecutionResponse) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 4)
+	s = append(s, "&historyservice.DeleteWorkflowExecutionResponse{")
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func (this *UpdateWorkflowExecutionRequest) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 11)
+	s = append(s, "&historyservice.UpdateWorkflowExecutionRequest{")
+	s = append(s, "NamespaceId: "+fmt.Sprintf("%#v", this.NamespaceId)+",\n")
+	if this.WorkflowExecution != nil {
+		s = append(s, "WorkflowExecution: "+fmt.Sprintf("%#v", this.WorkflowExecution)+",\n")
+	}
+	s = append(s, "UpdateId: "+fmt.Sprintf("%#v", this.UpdateId)+",\n")
+	s = append(s, "Name: "+fmt.Sprintf("%#v", this.Name)+",\n")
+	if this.Input != nil {
+		s = append(s, "Input: "+fmt.Sprintf("%#v", this.Input)+",\n")
+	}
+	s = append(s, "Identity: "+fmt.Sprintf("%#v", this.Identity)+",\n")
+	s = append(s, "WaitForAccepted: "+fmt.Sprintf("%#v", this.WaitForAccepted)+",\n")
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func (this *UpdateWorkflowExecutionResponse) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 7)
+	s = append(s, "&historyservice.UpdateWorkflowExecutionResponse{")
+	s = append(s, "Completed: "+fmt.Sprintf("%#v", this.Completed)+",\n")
+	if this.Result != nil {
+		s = append(s, "Result: "+fmt.Sprintf("%#v", this.Result)+",\n")
+	}
+	if this.Failure != nil {
+		s = append(s, "Failure: "+fmt.Sprintf("%#v", this.Failure)+",\n")
+	}
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func (this *AcquireShardRequest) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 5)
+	s = append(s, "&historyservice.AcquireShardRequest{")
+	s = append(s, "ShardId: "+fmt.Sprintf("%#v", this.ShardId)+",\n")
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func (this *AcquireShardResponse) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 4)
+	s = append(s, "&historyservice.AcquireShardResponse{")
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func (this *PauseWorkflowExecutionRequest) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 8)
+	s = append(s, "&historyservice.PauseWorkflowExecutionRequest{")
+	s = append(s, "NamespaceId: "+fmt.Sprintf("%#v", this.NamespaceId)+",\n")
+	if this.WorkflowExecution != nil {
+		s = append(s, "WorkflowExecution: "+fmt.Sprintf("%#v", this.WorkflowExecution)+",\n")
+	}
+	s = append(s, "Identity: "+fmt.Sprintf("%#v", this.Identity)+",\n")
+	s = append(s, "Reason: "+fmt.Sprintf("%#v", this.Reason)+",\n")
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func (this *PauseWorkflowExecutionResponse) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 4)
+	s = append(s, "&historyservice.PauseWorkflowExecutionResponse{")
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func (this *UnpauseWorkflowExecutionRequest) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 7)
+	s = append(s, "&historyservice.UnpauseWorkflowExecutionRequest{")
+	s = append(s, "NamespaceId: "+fmt.Sprintf("%#v", this.NamespaceId)+",\n")
+	if this.WorkflowExecution != nil {
+		s = append(s, "WorkflowExecution: "+fmt.Sprintf("%#v", this.WorkflowExecution)+",\n")
+	}
+	s = append(s, "Identity: "+fmt.Sprintf("%#v", this.Identity)+",\n")
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func (this *UnpauseWorkflowExecutionResponse) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 4)
+	s = append(s, "&historyservice.UnpauseWorkflowExecutionResponse{")
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func valueToGoStringRequestResponse(v interface{}, typ string) string {
+	rv := reflect.ValueOf(v)
+	if rv.IsNil() {
+		return "nil"
+	}
+	pv := reflect.Indirect(rv).Interface()
+	return fmt.Sprintf("func(v %v) *%v { return &v } ( %#v )", typ, typ, pv)
+}
+func (m *StartWorkflowExecutionRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
 }
 
-func (m *RecordWorkflowTaskStartedRequest) MarshalTo(dAtA []byte) (int, error) {
+func (m *StartWorkflowExecutionRequest) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *RecordWorkflowTaskStartedRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *StartWorkflowExecutionRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if m.PollRequest != nil {
+	if m.FirstWorkflowTaskBackoff != nil {
+		n1, err1 := github_com_gogo_protobuf_types.StdDurationMarshalTo(*m.FirstWorkflowTaskBackoff, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdDuration(*m.FirstWorkflowTaskBackoff):])
+		if err1 != nil {
+			return 0, err1
+		}
+		i -= n1
+		i = encodeVarintRequestResponse(dAtA, i, uint64(n1))
+		i--
+		dAtA[i] = 0x4a
+	}
+	if m.LastCompletionResult != nil {
 		{
-			size, err := m.PollRequest.MarshalToSizedBuffer(dAtA[:i])
+			size, err := m.LastCompletionResult.MarshalToSizedBuffer(dAtA[:i])
 			if err != nil {
 				return 0, err
 			}
@@ -8393,28 +9148,55 @@ func (m *RecordWorkflowTaskStartedRequest) MarshalToSizedBuffer(dAtA []byte) (in
 			i = encodeVarintRequestResponse(dAtA, i, uint64(size))
 		}
 		i--
-		dAtA[i] = 0x32
+		dAtA[i] = 0x42
 	}
-	if len(m.RequestId) > 0 {
-		i -= len(m.RequestId)
-		copy(dAtA[i:], m.RequestId)
-		i = encodeVarintRequestResponse(dAtA, i, uint64(len(m.RequestId)))
+	if m.ContinuedFailure != nil {
+		{
+			size, err := m.ContinuedFailure.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintRequestResponse(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x3a
+	}
+	if m.ContinueAsNewInitiator != 0 {
+		i = encodeVarintRequestResponse(dAtA, i, uint64(m.ContinueAsNewInitiator))
+		i--
+		dAtA[i] = 0x30
+	}
+	if m.WorkflowExecutionExpirationTime != nil {
+		n4, err4 := github_com_gogo_protobuf_types.StdTimeMarshalTo(*m.WorkflowExecutionExpirationTime, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(*m.WorkflowExecutionExpirationTime):])
+		if err4 != nil {
+			return 0, err4
+		}
+		i -= n4
+		i = encodeVarintRequestResponse(dAtA, i, uint64(n4))
 		i--
 		dAtA[i] = 0x2a
 	}
-	if m.TaskId != 0 {
-		i = encodeVarintRequestResponse(dAtA, i, uint64(m.TaskId))
+	if m.Attempt != 0 {
+		i = encodeVarintRequestResponse(dAtA, i, uint64(m.Attempt))
 		i--
 		dAtA[i] = 0x20
 	}
-	if m.ScheduleId != 0 {
-		i = encodeVarintRequestResponse(dAtA, i, uint64(m.ScheduleId))
+	if m.ParentExecutionInfo != nil {
+		{
+			size, err := m.ParentExecutionInfo.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintRequestResponse(dAtA, i, uint64(size))
+		}
 		i--
-		dAtA[i] = 0x18
+		dAtA[i] = 0x1a
 	}
-	if m.WorkflowExecution != nil {
+	if m.StartRequest != nil {
 		{
-			size, err := m.WorkflowExecution.MarshalToSizedBuffer(dAtA[:i])
+			size, err := m.StartRequest.MarshalToSizedBuffer(dAtA[:i])
 			if err != nil {
 				return 0, err
 			}
@@ -8434,7 +9216,7 @@ func (m *RecordWorkflowTaskStartedRequest) MarshalToSizedBuffer(dAtA []byte) (in
 	return len(dAtA) - i, nil
 }
 
-func (m *RecordWorkflowTaskStartedResponse) Marshal() (dAtA []byte, err error) {
+func (m *StartWorkflowExecutionResponse) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -8444,149 +9226,27 @@ func (m *RecordWorkflowTaskStartedResponse) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *RecordWorkflowTaskStartedResponse) MarshalTo(dAtA []byte) (int, error) {
+func (m *StartWorkflowExecutionResponse) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *RecordWorkflowTaskStartedResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *StartWorkflowExecutionResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if len(m.Queries) > 0 {
-		for k := range m.Queries {
-			v := m.Queries[k]
-			baseI := i
-			if v != nil {
-				{
-					size, err := v.MarshalToSizedBuffer(dAtA[:i])
-					if err != nil {
-						return 0, err
-					}
-					i -= size
-					i = encodeVarintRequestResponse(dAtA, i, uint64(size))
-				}
-				i--
-				dAtA[i] = 0x12
-			}
-			i -= len(k)
-			copy(dAtA[i:], k)
-			i = encodeVarintRequestResponse(dAtA, i, uint64(len(k)))
-			i--
-			dAtA[i] = 0xa
-			i = encodeVarintRequestResponse(dAtA, i, uint64(baseI-i))
-			i--
-			dAtA[i] = 0x72
-		}
-	}
-	if m.StartedTime != nil {
-		n25, err25 := github_com_gogo_protobuf_types.StdTimeMarshalTo(*m.StartedTime, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(*m.StartedTime):])
-		if err25 != nil {
-			return 0, err25
-		}
-		i -= n25
-		i = encodeVarintRequestResponse(dAtA, i, uint64(n25))
-		i--
-		dAtA[i] = 0x6a
-	}
-	if m.ScheduledTime != nil {
-		n26, err26 := github_com_gogo_protobuf_types.StdTimeMarshalTo(*m.ScheduledTime, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(*m.ScheduledTime):])
-		if err26 != nil {
-			return 0, err26
-		}
-		i -= n26
-		i = encodeVarintRequestResponse(dAtA, i, uint64(n26))
+	if len(m.RunId) > 0 {
+		i -= len(m.RunId)
+		copy(dAtA[i:], m.RunId)
+		i = encodeVarintRequestResponse(dAtA, i, uint64(len(m.RunId)))
 		i--
-		dAtA[i] = 0x62
-	}
-	if len(m.BranchToken) > 0 {
-		i -= len(m.BranchToken)
-		copy(dAtA[i:], m.BranchToken)
-		i = encodeVarintRequestResponse(dAtA, i, uint64(len(m.BranchToken)))
-		i--
-		dAtA[i] = 0x5a
-	}
-	if m.EventStoreVersion != 0 {
-		i = encodeVarintRequestResponse(dAtA, i, uint64(m.EventStoreVersion))
-		i--
-		dAtA[i] = 0x50
-	}
-	if m.WorkflowExecutionTaskQueue != nil {
-		{
-			size, err := m.WorkflowExecutionTaskQueue.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintRequestResponse(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x4a
-	}
-	if m.WorkflowTaskInfo != nil {
-		{
-			size, err := m.WorkflowTaskInfo.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintRequestResponse(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x42
-	}
-	if m.StickyExecutionEnabled {
-		i--
-		if m.StickyExecutionEnabled {
-			dAtA[i] = 1
-		} else {
-			dAtA[i] = 0
-		}
-		i--
-		dAtA[i] = 0x38
-	}
-	if m.Attempt != 0 {
-		i = encodeVarintRequestResponse(dAtA, i, uint64(m.Attempt))
-		i--
-		dAtA[i] = 0x30
-	}
-	if m.NextEventId != 0 {
-		i = encodeVarintRequestResponse(dAtA, i, uint64(m.NextEventId))
-		i--
-		dAtA[i] = 0x28
-	}
-	if m.StartedEventId != 0 {
-		i = encodeVarintRequestResponse(dAtA, i, uint64(m.StartedEventId))
-		i--
-		dAtA[i] = 0x20
-	}
-	if m.ScheduledEventId != 0 {
-		i = encodeVarintRequestResponse(dAtA, i, uint64(m.ScheduledEventId))
-		i--
-		dAtA[i] = 0x18
-	}
-	if m.PreviousStartedEventId != 0 {
-		i = encodeVarintRequestResponse(dAtA, i, uint64(m.PreviousStartedEventId))
-		i--
-		dAtA[i] = 0x10
-	}
-	if m.WorkflowType != nil {
-		{
-			size, err := m.WorkflowType.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintRequestResponse(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0xa
+		dAtA[i] = 0xa
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *RecordActivityTaskStartedRequest) Marshal() (dAtA []byte, err error) {
+func (m *GetMutableStateRequest) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -8596,48 +9256,31 @@ func (m *RecordActivityTaskStartedRequest) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *RecordActivityTaskStartedRequest) MarshalTo(dAtA []byte) (int, error) {
+func (m *GetMutableStateRequest) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *RecordActivityTaskStartedRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *GetMutableStateRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if m.PollRequest != nil {
-		{
-			size, err := m.PollRequest.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintRequestResponse(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x32
-	}
-	if len(m.RequestId) > 0 {
-		i -= len(m.RequestId)
-		copy(dAtA[i:], m.RequestId)
-		i = encodeVarintRequestResponse(dAtA, i, uint64(len(m.RequestId)))
-		i--
-		dAtA[i] = 0x2a
-	}
-	if m.TaskId != 0 {
-		i = encodeVarintRequestResponse(dAtA, i, uint64(m.TaskId))
+	if len(m.CurrentBranchToken) > 0 {
+		i -= len(m.CurrentBranchToken)
+		copy(dAtA[i:], m.CurrentBranchToken)
+		i = encodeVarintRequestResponse(dAtA, i, uint64(len(m.CurrentBranchToken)))
 		i--
-		dAtA[i] = 0x20
+		dAtA[i] = 0x22
 	}
-	if m.ScheduleId != 0 {
-		i = encodeVarintRequestResponse(dAtA, i, uint64(m.ScheduleId))
+	if m.ExpectedNextEventId != 0 {
+		i = encodeVarintRequestResponse(dAtA, i, uint64(m.ExpectedNextEventId))
 		i--
 		dAtA[i] = 0x18
 	}
-	if m.WorkflowExecution != nil {
+	if m.Execution != nil {
 		{
-			size, err := m.WorkflowExecution.MarshalToSizedBuffer(dAtA[:i])
+			size, err := m.Execution.MarshalToSizedBuffer(dAtA[:i])
 			if err != nil {
 				return 0, err
 			}
@@ -8657,7 +9300,7 @@ func (m *RecordActivityTaskStartedRequest) MarshalToSizedBuffer(dAtA []byte) (in
 	return len(dAtA) - i, nil
 }
 
-func (m *RecordActivityTaskStartedResponse) Marshal() (dAtA []byte, err error) {
+func (m *GetMutableStateResponse) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -8667,26 +9310,50 @@ func (m *RecordActivityTaskStartedResponse) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *RecordActivityTaskStartedResponse) MarshalTo(dAtA []byte) (int, error) {
+func (m *GetMutableStateResponse) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *RecordActivityTaskStartedResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *GetMutableStateResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if len(m.WorkflowNamespace) > 0 {
-		i -= len(m.WorkflowNamespace)
-		copy(dAtA[i:], m.WorkflowNamespace)
-		i = encodeVarintRequestResponse(dAtA, i, uint64(len(m.WorkflowNamespace)))
+	if m.SuggestContinueAsNew {
 		i--
-		dAtA[i] = 0x3a
+		if m.SuggestContinueAsNew {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xa0
 	}
-	if m.WorkflowType != nil {
+	if m.HistorySizeBytes != 0 {
+		i = encodeVarintRequestResponse(dAtA, i, uint64(m.HistorySizeBytes))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x98
+	}
+	if m.IsStickyTaskQueueEnabled {
+		i--
+		if m.IsStickyTaskQueueEnabled {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x90
+	}
+	if m.VersionHistories != nil {
 		{
-			size, err := m.WorkflowType.MarshalToSizedBuffer(dAtA[:i])
+			size, err := m.VersionHistories.MarshalToSizedBuffer(dAtA[:i])
 			if err != nil {
 				return 0, err
 			}
@@ -8694,11 +9361,47 @@ func (m *RecordActivityTaskStartedResponse) MarshalToSizedBuffer(dAtA []byte) (i
 			i = encodeVarintRequestResponse(dAtA, i, uint64(size))
 		}
 		i--
-		dAtA[i] = 0x32
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x8a
 	}
-	if m.HeartbeatDetails != nil {
+	if m.WorkflowStatus != 0 {
+		i = encodeVarintRequestResponse(dAtA, i, uint64(m.WorkflowStatus))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x80
+	}
+	if m.WorkflowState != 0 {
+		i = encodeVarintRequestResponse(dAtA, i, uint64(m.WorkflowState))
+		i--
+		dAtA[i] = 0x78
+	}
+	if len(m.CurrentBranchToken) > 0 {
+		i -= len(m.CurrentBranchToken)
+		copy(dAtA[i:], m.CurrentBranchToken)
+		i = encodeVarintRequestResponse(dAtA, i, uint64(len(m.CurrentBranchToken)))
+		i--
+		dAtA[i] = 0x6a
+	}
+	if m.EventStoreVersion != 0 {
+		i = encodeVarintRequestResponse(dAtA, i, uint64(m.EventStoreVersion))
+		i--
+		dAtA[i] = 0x60
+	}
+	if m.StickyTaskQueueScheduleToStartTimeout != nil {
+		n9, err9 := github_com_gogo_protobuf_types.StdDurationMarshalTo(*m.StickyTaskQueueScheduleToStartTimeout, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdDuration(*m.StickyTaskQueueScheduleToStartTimeout):])
+		if err9 != nil {
+			return 0, err9
+		}
+		i -= n9
+		i = encodeVarintRequestResponse(dAtA, i, uint64(n9))
+		i--
+		dAtA[i] = 0x5a
+	}
+	if m.StickyTaskQueue != nil {
 		{
-			size, err := m.HeartbeatDetails.MarshalToSizedBuffer(dAtA[:i])
+			size, err := m.StickyTaskQueue.MarshalToSizedBuffer(dAtA[:i])
 			if err != nil {
 				return 0, err
 			}
@@ -8706,36 +9409,50 @@ func (m *RecordActivityTaskStartedResponse) MarshalToSizedBuffer(dAtA []byte) (i
 			i = encodeVarintRequestResponse(dAtA, i, uint64(size))
 		}
 		i--
-		dAtA[i] = 0x2a
+		dAtA[i] = 0x3a
 	}
-	if m.CurrentAttemptScheduledTime != nil {
-		n34, err34 := github_com_gogo_protobuf_types.StdTimeMarshalTo(*m.CurrentAttemptScheduledTime, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(*m.CurrentAttemptScheduledTime):])
-		if err34 != nil {
-			return 0, err34
+	if m.TaskQueue != nil {
+		{
+			size, err := m.TaskQueue.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintRequestResponse(dAtA, i, uint64(size))
 		}
-		i -= n34
-		i = encodeVarintRequestResponse(dAtA, i, uint64(n34))
 		i--
-		dAtA[i] = 0x22
+		dAtA[i] = 0x32
 	}
-	if m.Attempt != 0 {
-		i = encodeVarintRequestResponse(dAtA, i, uint64(m.Attempt))
+	if m.LastFirstEventId != 0 {
+		i = encodeVarintRequestResponse(dAtA, i, uint64(m.LastFirstEventId))
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.PreviousStartedEventId != 0 {
+		i = encodeVarintRequestResponse(dAtA, i, uint64(m.PreviousStartedEventId))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.NextEventId != 0 {
+		i = encodeVarintRequestResponse(dAtA, i, uint64(m.NextEventId))
 		i--
 		dAtA[i] = 0x18
 	}
-	if m.StartedTime != nil {
-		n35, err35 := github_com_gogo_protobuf_types.StdTimeMarshalTo(*m.StartedTime, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(*m.StartedTime):])
-		if err35 != nil {
-			return 0, err35
+	if m.WorkflowType != nil {
+		{
+			size, err := m.WorkflowType.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintRequestResponse(dAtA, i, uint64(size))
 		}
-		i -= n35
-		i = encodeVarintRequestResponse(dAtA, i, uint64(n35))
 		i--
 		dAtA[i] = 0x12
 	}
-	if m.ScheduledEvent != nil {
+	if m.Execution != nil {
 		{
-			size, err := m.ScheduledEvent.MarshalToSizedBuffer(dAtA[:i])
+			size, err := m.Execution.MarshalToSizedBuffer(dAtA[:i])
 			if err != nil {
 				return 0, err
 			}
@@ -8748,7 +9465,7 @@ func (m *RecordActivityTaskStartedResponse) MarshalToSizedBuffer(dAtA []byte) (i
 	return len(dAtA) - i, nil
 }
 
-func (m *RespondWorkflowTaskCompletedRequest) Marshal() (dAtA []byte, err error) {
+func (m *PollMutableStateRequest) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -8758,19 +9475,31 @@ func (m *RespondWorkflowTaskCompletedRequest) Marshal() (dAtA []byte, err error)
 	return dAtA[:n], nil
 }
 
-func (m *RespondWorkflowTaskCompletedRequest) MarshalTo(dAtA []byte) (int, error) {
+func (m *PollMutableStateRequest) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *RespondWorkflowTaskCompletedRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *PollMutableStateRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if m.CompleteRequest != nil {
+	if len(m.CurrentBranchToken) > 0 {
+		i -= len(m.CurrentBranchToken)
+		copy(dAtA[i:], m.CurrentBranchToken)
+		i = encodeVarintRequestResponse(dAtA, i, uint64(len(m.CurrentBranchToken)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if m.ExpectedNextEventId != 0 {
+		i = encodeVarintRequestResponse(dAtA, i, uint64(m.ExpectedNextEventId))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.Execution != nil {
 		{
-			size, err := m.CompleteRequest.MarshalToSizedBuffer(dAtA[:i])
+			size, err := m.Execution.MarshalToSizedBuffer(dAtA[:i])
 			if err != nil {
 				return 0, err
 			}
@@ -8790,7 +9519,7 @@ func (m *RespondWorkflowTaskCompletedRequest) MarshalToSizedBuffer(dAtA []byte)
 	return len(dAtA) - i, nil
 }
 
-func (m *RespondWorkflowTaskCompletedResponse) Marshal() (dAtA []byte, err error) {
+func (m *PollMutableStateResponse) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -8800,19 +9529,111 @@ func (m *RespondWorkflowTaskCompletedResponse) Marshal() (dAtA []byte, err error
 	return dAtA[:n], nil
 }
 
-func (m *RespondWorkflowTaskCompletedResponse) MarshalTo(dAtA []byte) (int, error) {
+func (m *PollMutableStateResponse) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *RespondWorkflowTaskCompletedResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *PollMutableStateResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if m.StartedResponse != nil {
+	if m.WorkflowStatus != 0 {
+		i = encodeVarintRequestResponse(dAtA, i, uint64(m.WorkflowStatus))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x80
+	}
+	if m.WorkflowState != 0 {
+		i = encodeVarintRequestResponse(dAtA, i, uint64(m.WorkflowState))
+		i--
+		dAtA[i] = 0x78
+	}
+	if m.VersionHistories != nil {
 		{
-			size, err := m.StartedResponse.MarshalToSizedBuffer(dAtA[:i])
+			size, err := m.VersionHistories.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintRequestResponse(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x72
+	}
+	if len(m.CurrentBranchToken) > 0 {
+		i -= len(m.CurrentBranchToken)
+		copy(dAtA[i:], m.CurrentBranchToken)
+		i = encodeVarintRequestResponse(dAtA, i, uint64(len(m.CurrentBranchToken)))
+		i--
+		dAtA[i] = 0x62
+	}
+	if m.StickyTaskQueueScheduleToStartTimeout != nil {
+		n16, err16 := github_com_gogo_protobuf_types.StdDurationMarshalTo(*m.StickyTaskQueueScheduleToStartTimeout, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdDuration(*m.StickyTaskQueueScheduleToStartTimeout):])
+		if err16 != nil {
+			return 0, err16
+		}
+		i -= n16
+		i = encodeVarintRequestResponse(dAtA, i, uint64(n16))
+		i--
+		dAtA[i] = 0x5a
+	}
+	if m.StickyTaskQueue != nil {
+		{
+			size, err := m.StickyTaskQueue.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintRequestResponse(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x3a
+	}
+	if m.TaskQueue != nil {
+		{
+			size, err := m.TaskQueue.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintRequestResponse(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x32
+	}
+	if m.LastFirstEventId != 0 {
+		i = encodeVarintRequestResponse(dAtA, i, uint64(m.LastFirstEventId))
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.PreviousStartedEventId != 0 {
+		i = encodeVarintRequestResponse(dAtA, i, uint64(m.PreviousStartedEventId))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.NextEventId != 0 {
+		i = encodeVarintRequestResponse(dAtA, i, uint64(m.NextEventId))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.WorkflowType != nil {
+		{
+			size, err := m.WorkflowType.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintRequestResponse(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Execution != nil {
+		{
+			size, err := m.Execution.MarshalToSizedBuffer(dAtA[:i])
 			if err != nil {
 				return 0, err
 			}
@@ -8825,7 +9646,7 @@ func (m *RespondWorkflowTaskCompletedResponse) MarshalToSizedBuffer(dAtA []byte)
 	return len(dAtA) - i, nil
 }
 
-func (m *RespondWorkflowTaskFailedRequest) Marshal() (dAtA []byte, err error) {
+func (m *ResetStickyTaskQueueRequest) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -8835,19 +9656,19 @@ func (m *RespondWorkflowTaskFailedRequest) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *RespondWorkflowTaskFailedRequest) MarshalTo(dAtA []byte) (int, error) {
+func (m *ResetStickyTaskQueueRequest) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *RespondWorkflowTaskFailedRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *ResetStickyTaskQueueRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if m.FailedRequest != nil {
+	if m.Execution != nil {
 		{
-			size, err := m.FailedRequest.MarshalToSizedBuffer(dAtA[:i])
+			size, err := m.Execution.MarshalToSizedBuffer(dAtA[:i])
 			if err != nil {
 				return 0, err
 			}
@@ -8867,7 +9688,7 @@ func (m *RespondWorkflowTaskFailedRequest) MarshalToSizedBuffer(dAtA []byte) (in
 	return len(dAtA) - i, nil
 }
 
-func (m *RespondWorkflowTaskFailedResponse) Marshal() (dAtA []byte, err error) {
+func (m *ResetStickyTaskQueueResponse) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -8877,12 +9698,12 @@ func (m *RespondWorkflowTaskFailedResponse) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *RespondWorkflowTaskFailedResponse) MarshalTo(dAtA []byte) (int, error) {
+func (m *ResetStickyTaskQueueResponse) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *RespondWorkflowTaskFailedResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *ResetStickyTaskQueueResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
@@ -8890,7 +9711,7 @@ func (m *RespondWorkflowTaskFailedResponse) MarshalToSizedBuffer(dAtA []byte) (i
 	return len(dAtA) - i, nil
 }
 
-func (m *RecordActivityTaskHeartbeatRequest) Marshal() (dAtA []byte, err error) {
+func (m *RecordWorkflowTaskStartedRequest) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -8900,19 +9721,19 @@ func (m *RecordActivityTaskHeartbeatRequest) Marshal() (dAtA []byte, err error)
 	return dAtA[:n], nil
 }
 
-func (m *RecordActivityTaskHeartbeatRequest) MarshalTo(dAtA []byte) (int, error) {
+func (m *RecordWorkflowTaskStartedRequest) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *RecordActivityTaskHeartbeatRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *RecordWorkflowTaskStartedRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if m.HeartbeatRequest != nil {
+	if m.PollRequest != nil {
 		{
-			size, err := m.HeartbeatRequest.MarshalToSizedBuffer(dAtA[:i])
+			size, err := m.PollRequest.MarshalToSizedBuffer(dAtA[:i])
 			if err != nil {
 				return 0, err
 			}
@@ -8920,74 +9741,28 @@ func (m *RecordActivityTaskHeartbeatRequest) MarshalToSizedBuffer(dAtA []byte) (
 			i = encodeVarintRequestResponse(dAtA, i, uint64(size))
 		}
 		i--
-		dAtA[i] = 0x12
+		dAtA[i] = 0x32
 	}
-	if len(m.NamespaceId) > 0 {
-		i -= len(m.NamespaceId)
-		copy(dAtA[i:], m.NamespaceId)
-		i = encodeVarintRequestResponse(dAtA, i, uint64(len(m.NamespaceId)))
+	if len(m.RequestId) > 0 {
+		i -= len(m.RequestId)
+		copy(dAtA[i:], m.RequestId)
+		i = encodeVarintRequestResponse(dAtA, i, uint64(len(m.RequestId)))
 		i--
-		dAtA[i] = 0xa
-	}
-	return len(dAtA) - i, nil
-}
-
-func (m *RecordActivityTaskHeartbeatResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+		dAtA[i] = 0x2a
 	}
-	return dAtA[:n], nil
-}
-
-func (m *RecordActivityTaskHeartbeatResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
-}
-
-func (m *RecordActivityTaskHeartbeatResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.CancelRequested {
-		i--
-		if m.CancelRequested {
-			dAtA[i] = 1
-		} else {
-			dAtA[i] = 0
-		}
+	if m.TaskId != 0 {
+		i = encodeVarintRequestResponse(dAtA, i, uint64(m.TaskId))
 		i--
-		dAtA[i] = 0x8
+		dAtA[i] = 0x20
 	}
-	return len(dAtA) - i, nil
-}
-
-func (m *RespondActivityTaskCompletedRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+	if m.ScheduleId != 0 {
+		i = encodeVarintRequestResponse(dAtA, i, uint64(m.ScheduleId))
+		i--
+		dAtA[i] = 0x18
 	}
-	return dAtA[:n], nil
-}
-
-func (m *RespondActivityTaskCompletedRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
-}
-
-func (m *RespondActivityTaskCompletedRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.CompleteRequest != nil {
+	if m.WorkflowExecution != nil {
 		{
-			size, err := m.CompleteRequest.MarshalToSizedBuffer(dAtA[:i])
+			size, err := m.WorkflowExecution.MarshalToSizedBuffer(dAtA[:i])
 			if err != nil {
 				return 0, err
 			}
@@ -9007,7 +9782,7 @@ func (m *RespondActivityTaskCompletedRequest) MarshalToSizedBuffer(dAtA []byte)
 	return len(dAtA) - i, nil
 }
 
-func (m *RespondActivityTaskCompletedResponse) Marshal() (dAtA []byte, err error) {
+func (m *RecordWorkflowTaskStartedResponse) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -9017,42 +9792,110 @@ func (m *RespondActivityTaskCompletedResponse) Marshal() (dAtA []byte, err error
 	return dAtA[:n], nil
 }
 
-func (m *RespondActivityTaskCompletedResponse) MarshalTo(dAtA []byte) (int, error) {
+func (m *RecordWorkflowTaskStartedResponse) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *RespondActivityTaskCompletedResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *RecordWorkflowTaskStartedResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	return len(dAtA) - i, nil
-}
-
-func (m *RespondActivityTaskFailedRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+	if len(m.Updates) > 0 {
+		for iNdEx := len(m.Updates) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Updates[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintRequestResponse(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0x8a
+		}
 	}
-	return dAtA[:n], nil
-}
-
-func (m *RespondActivityTaskFailedRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
-}
-
-func (m *RespondActivityTaskFailedRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.FailedRequest != nil {
+	if m.SuggestContinueAsNew {
+		i--
+		if m.SuggestContinueAsNew {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x80
+	}
+	if m.HistorySizeBytes != 0 {
+		i = encodeVarintRequestResponse(dAtA, i, uint64(m.HistorySizeBytes))
+		i--
+		dAtA[i] = 0x78
+	}
+	if len(m.Queries) > 0 {
+		for k := range m.Queries {
+			v := m.Queries[k]
+			baseI := i
+			if v != nil {
+				{
+					size, err := v.MarshalToSizedBuffer(dAtA[:i])
+					if err != nil {
+						return 0, err
+					}
+					i -= size
+					i = encodeVarintRequestResponse(dAtA, i, uint64(size))
+				}
+				i--
+				dAtA[i] = 0x12
+			}
+			i -= len(k)
+			copy(dAtA[i:], k)
+			i = encodeVarintRequestResponse(dAtA, i, uint64(len(k)))
+			i--
+			dAtA[i] = 0xa
+			i = encodeVarintRequestResponse(dAtA, i, uint64(baseI-i))
+			i--
+			dAtA[i] = 0x72
+		}
+	}
+	if m.StartedTime != nil {
+		n25, err25 := github_com_gogo_protobuf_types.StdTimeMarshalTo(*m.StartedTime, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(*m.StartedTime):])
+		if err25 != nil {
+			return 0, err25
+		}
+		i -= n25
+		i = encodeVarintRequestResponse(dAtA, i, uint64(n25))
+		i--
+		dAtA[i] = 0x6a
+	}
+	if m.ScheduledTime != nil {
+		n26, err26 := github_com_gogo_protobuf_types.StdTimeMarshalTo(*m.ScheduledTime, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(*m.ScheduledTime):])
+		if err26 != nil {
+			return 0, err26
+		}
+		i -= n26
+		i = encodeVarintRequestResponse(dAtA, i, uint64(n26))
+		i--
+		dAtA[i] = 0x62
+	}
+	if len(m.BranchToken) > 0 {
+		i -= len(m.BranchToken)
+		copy(dAtA[i:], m.BranchToken)
+		i = encodeVarintRequestResponse(dAtA, i, uint64(len(m.BranchToken)))
+		i--
+		dAtA[i] = 0x5a
+	}
+	if m.EventStoreVersion != 0 {
+		i = encodeVarintRequestResponse(dAtA, i, uint64(m.EventStoreVersion))
+		i--
+		dAtA[i] = 0x50
+	}
+	if m.WorkflowExecutionTaskQueue != nil {
 		{
-			size, err := m.FailedRequest.MarshalToSizedBuffer(dAtA[:i])
+			size, err := m.WorkflowExecutionTaskQueue.MarshalToSizedBuffer(dAtA[:i])
 			if err != nil {
 				return 0, err
 			}
@@ -9060,19 +9903,71 @@ func (m *RespondActivityTaskFailedRequest) MarshalToSizedBuffer(dAtA []byte) (in
 			i = encodeVarintRequestResponse(dAtA, i, uint64(size))
 		}
 		i--
-		dAtA[i] = 0x12
+		dAtA[i] = 0x4a
 	}
-	if len(m.NamespaceId) > 0 {
-		i -= len(m.NamespaceId)
-		copy(dAtA[i:], m.NamespaceId)
-		i = encodeVarintRequestResponse(dAtA, i, uint64(len(m.NamespaceId)))
+	if m.WorkflowTaskInfo != nil {
+		{
+			size, err := m.WorkflowTaskInfo.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintRequestResponse(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x42
+	}
+	if m.StickyExecutionEnabled {
+		i--
+		if m.StickyExecutionEnabled {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x38
+	}
+	if m.Attempt != 0 {
+		i = encodeVarintRequestResponse(dAtA, i, uint64(m.Attempt))
+		i--
+		dAtA[i] = 0x30
+	}
+	if m.NextEventId != 0 {
+		i = encodeVarintRequestResponse(dAtA, i, uint64(m.NextEventId))
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.StartedEventId != 0 {
+		i = encodeVarintRequestResponse(dAtA, i, uint64(m.StartedEventId))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.ScheduledEventId != 0 {
+		i = encodeVarintRequestResponse(dAtA, i, uint64(m.ScheduledEventId))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.PreviousStartedEventId != 0 {
+		i = encodeVarintRequestResponse(dAtA, i, uint64(m.PreviousStartedEventId))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.WorkflowType != nil {
+		{
+			size, err := m.WorkflowType.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintRequestResponse(dAtA, i, uint64(size))
+		}
 		i--
 		dAtA[i] = 0xa
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *RespondActivityTaskFailedResponse) Marshal() (dAtA []byte, err error) {
+func (m *RecordActivityTaskStartedRequest) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -9082,42 +9977,48 @@ func (m *RespondActivityTaskFailedResponse) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *RespondActivityTaskFailedResponse) MarshalTo(dAtA []byte) (int, error) {
+func (m *RecordActivityTaskStartedRequest) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *RespondActivityTaskFailedResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *RecordActivityTaskStartedRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	return len(dAtA) - i, nil
-}
-
-func (m *RespondActivityTaskCanceledRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+	if m.PollRequest != nil {
+		{
+			size, err := m.PollRequest.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintRequestResponse(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x32
 	}
-	return dAtA[:n], nil
-}
-
-func (m *RespondActivityTaskCanceledRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
-}
-
-func (m *RespondActivityTaskCanceledRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.CancelRequest != nil {
+	if len(m.RequestId) > 0 {
+		i -= len(m.RequestId)
+		copy(dAtA[i:], m.RequestId)
+		i = encodeVarintRequestResponse(dAtA, i, uint64(len(m.RequestId)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if m.TaskId != 0 {
+		i = encodeVarintRequestResponse(dAtA, i, uint64(m.TaskId))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.ScheduleId != 0 {
+		i = encodeVarintRequestResponse(dAtA, i, uint64(m.ScheduleId))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.WorkflowExecution != nil {
 		{
-			size, err := m.CancelRequest.MarshalToSizedBuffer(dAtA[:i])
+			size, err := m.WorkflowExecution.MarshalToSizedBuffer(dAtA[:i])
 			if err != nil {
 				return 0, err
 			}
@@ -9137,30 +10038,7 @@ func (m *RespondActivityTaskCanceledRequest) MarshalToSizedBuffer(dAtA []byte) (
 	return len(dAtA) - i, nil
 }
 
-func (m *RespondActivityTaskCanceledResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
-}
-
-func (m *RespondActivityTaskCanceledResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
-}
-
-func (m *RespondActivityTaskCanceledResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
-}
-
-func (m *SignalWorkflowExecutionRequest) Marshal() (dAtA []byte, err error) {
+func (m *RecordActivityTaskStartedResponse) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -9170,29 +10048,26 @@ func (m *SignalWorkflowExecutionRequest) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *SignalWorkflowExecutionRequest) MarshalTo(dAtA []byte) (int, error) {
+func (m *RecordActivityTaskStartedResponse) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *SignalWorkflowExecutionRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *RecordActivityTaskStartedResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if m.ChildWorkflowOnly {
-		i--
-		if m.ChildWorkflowOnly {
-			dAtA[i] = 1
-		} else {
-			dAtA[i] = 0
-		}
+	if len(m.WorkflowNamespace) > 0 {
+		i -= len(m.WorkflowNamespace)
+		copy(dAtA[i:], m.WorkflowNamespace)
+		i = encodeVarintRequestResponse(dAtA, i, uint64(len(m.WorkflowNamespace)))
 		i--
-		dAtA[i] = 0x20
+		dAtA[i] = 0x3a
 	}
-	if m.ExternalWorkflowExecution != nil {
+	if m.WorkflowType != nil {
 		{
-			size, err := m.ExternalWorkflowExecution.MarshalToSizedBuffer(dAtA[:i])
+			size, err := m.WorkflowType.MarshalToSizedBuffer(dAtA[:i])
 			if err != nil {
 				return 0, err
 			}
@@ -9200,11 +10075,11 @@ func (m *SignalWorkflowExecutionRequest) MarshalToSizedBuffer(dAtA []byte) (int,
 			i = encodeVarintRequestResponse(dAtA, i, uint64(size))
 		}
 		i--
-		dAtA[i] = 0x1a
+		dAtA[i] = 0x32
 	}
-	if m.SignalRequest != nil {
+	if m.HeartbeatDetails != nil {
 		{
-			size, err := m.SignalRequest.MarshalToSizedBuffer(dAtA[:i])
+			size, err := m.HeartbeatDetails.MarshalToSizedBuffer(dAtA[:i])
 			if err != nil {
 				return 0, err
 			}
@@ -9212,42 +10087,49 @@ func (m *SignalWorkflowExecutionRequest) MarshalToSizedBuffer(dAtA []byte) (int,
 			i = encodeVarintRequestResponse(dAtA, i, uint64(size))
 		}
 		i--
-		dAtA[i] = 0x12
+		dAtA[i] = 0x2a
 	}
-	if len(m.NamespaceId) > 0 {
-		i -= len(m.NamespaceId)
-		copy(dAtA[i:], m.NamespaceId)
-		i = encodeVarintRequestResponse(dAtA, i, uint64(len(m.NamespaceId)))
+	if m.CurrentAttemptScheduledTime != nil {
+		n34, err34 := github_com_gogo_protobuf_types.StdTimeMarshalTo(*m.CurrentAttemptScheduledTime, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(*m.CurrentAttemptScheduledTime):])
+		if err34 != nil {
+			return 0, err34
+		}
+		i -= n34
+		i = encodeVarintRequestResponse(dAtA, i, uint64(n34))
 		i--
-		dAtA[i] = 0xa
+		dAtA[i] = 0x22
 	}
-	return len(dAtA) - i, nil
-}
-
-func (m *SignalWorkflowExecutionResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+	if m.Attempt != 0 {
+		i = encodeVarintRequestResponse(dAtA, i, uint64(m.Attempt))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.StartedTime != nil {
+		n35, err35 := github_com_gogo_protobuf_types.StdTimeMarshalTo(*m.StartedTime, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(*m.StartedTime):])
+		if err35 != nil {
+			return 0, err35
+		}
+		i -= n35
+		i = encodeVarintRequestResponse(dAtA, i, uint64(n35))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.ScheduledEvent != nil {
+		{
+			size, err := m.ScheduledEvent.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintRequestResponse(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
 	}
-	return dAtA[:n], nil
-}
-
-func (m *SignalWorkflowExecutionResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
-}
-
-func (m *SignalWorkflowExecutionResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
 	return len(dAtA) - i, nil
 }
 
-func (m *SignalWithStartWorkflowExecutionRequest) Marshal() (dAtA []byte, err error) {
+func (m *RespondWorkflowTaskCompletedRequest) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -9257,19 +10139,19 @@ func (m *SignalWithStartWorkflowExecutionRequest) Marshal() (dAtA []byte, err er
 	return dAtA[:n], nil
 }
 
-func (m *SignalWithStartWorkflowExecutionRequest) MarshalTo(dAtA []byte) (int, error) {
+func (m *RespondWorkflowTaskCompletedRequest) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *SignalWithStartWorkflowExecutionRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *RespondWorkflowTaskCompletedRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if m.SignalWithStartRequest != nil {
+	if m.CompleteRequest != nil {
 		{
-			size, err := m.SignalWithStartRequest.MarshalToSizedBuffer(dAtA[:i])
+			size, err := m.CompleteRequest.MarshalToSizedBuffer(dAtA[:i])
 			if err != nil {
 				return 0, err
 			}
@@ -9289,7 +10171,7 @@ func (m *SignalWithStartWorkflowExecutionRequest) MarshalToSizedBuffer(dAtA []by
 	return len(dAtA) - i, nil
 }
 
-func (m *SignalWithStartWorkflowExecutionResponse) Marshal() (dAtA []byte, err error) {
+func (m *RespondWorkflowTaskCompletedResponse) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -9299,27 +10181,32 @@ func (m *SignalWithStartWorkflowExecutionResponse) Marshal() (dAtA []byte, err e
 	return dAtA[:n], nil
 }
 
-func (m *SignalWithStartWorkflowExecutionResponse) MarshalTo(dAtA []byte) (int, error) {
+func (m *RespondWorkflowTaskCompletedResponse) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *SignalWithStartWorkflowExecutionResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *RespondWorkflowTaskCompletedResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if len(m.RunId) > 0 {
-		i -= len(m.RunId)
-		copy(dAtA[i:], m.RunId)
-		i = encodeVarintRequestResponse(dAtA, i, uint64(len(m.RunId)))
+	if m.StartedResponse != nil {
+		{
+			size, err := m.StartedResponse.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintRequestResponse(dAtA, i, uint64(size))
+		}
 		i--
 		dAtA[i] = 0xa
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *RemoveSignalMutableStateRequest) Marshal() (dAtA []byte, err error) {
+func (m *RespondWorkflowTaskFailedRequest) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -9329,26 +10216,19 @@ func (m *RemoveSignalMutableStateRequest) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *RemoveSignalMutableStateRequest) MarshalTo(dAtA []byte) (int, error) {
+func (m *RespondWorkflowTaskFailedRequest) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *RemoveSignalMutableStateRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *RespondWorkflowTaskFailedRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if len(m.RequestId) > 0 {
-		i -= len(m.RequestId)
-		copy(dAtA[i:], m.RequestId)
-		i = encodeVarintRequestResponse(dAtA, i, uint64(len(m.RequestId)))
-		i--
-		dAtA[i] = 0x1a
-	}
-	if m.WorkflowExecution != nil {
+	if m.FailedRequest != nil {
 		{
-			size, err := m.WorkflowExecution.MarshalToSizedBuffer(dAtA[:i])
+			size, err := m.FailedRequest.MarshalToSizedBuffer(dAtA[:i])
 			if err != nil {
 				return 0, err
 			}
@@ -9368,7 +10248,7 @@ func (m *RemoveSignalMutableStateRequest) MarshalToSizedBuffer(dAtA []byte) (int
 	return len(dAtA) - i, nil
 }
 
-func (m *RemoveSignalMutableStateResponse) Marshal() (dAtA []byte, err error) {
+func (m *RespondWorkflowTaskFailedResponse) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -9378,12 +10258,12 @@ func (m *RemoveSignalMutableStateResponse) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *RemoveSignalMutableStateResponse) MarshalTo(dAtA []byte) (int, error) {
+func (m *RespondWorkflowTaskFailedResponse) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *RemoveSignalMutableStateResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *RespondWorkflowTaskFailedResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
@@ -9391,7 +10271,7 @@ func (m *RemoveSignalMutableStateResponse) MarshalToSizedBuffer(dAtA []byte) (in
 	return len(dAtA) - i, nil
 }
 
-func (m *TerminateWorkflowExecutionRequest) Marshal() (dAtA []byte, err error) {
+func (m *RecordActivityTaskHeartbeatRequest) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -9401,19 +10281,19 @@ func (m *TerminateWorkflowExecutionRequest) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *TerminateWorkflowExecutionRequest) MarshalTo(dAtA []byte) (int, error) {
+func (m *RecordActivityTaskHeartbeatRequest) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *TerminateWorkflowExecutionRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *RecordActivityTaskHeartbeatRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if m.TerminateRequest != nil {
+	if m.HeartbeatRequest != nil {
 		{
-			size, err := m.TerminateRequest.MarshalToSizedBuffer(dAtA[:i])
+			size, err := m.HeartbeatRequest.MarshalToSizedBuffer(dAtA[:i])
 			if err != nil {
 				return 0, err
 			}
@@ -9433,7 +10313,7 @@ func (m *TerminateWorkflowExecutionRequest) MarshalToSizedBuffer(dAtA []byte) (i
 	return len(dAtA) - i, nil
 }
 
-func (m *TerminateWorkflowExecutionResponse) Marshal() (dAtA []byte, err error) {
+func (m *RecordActivityTaskHeartbeatResponse) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -9443,20 +10323,30 @@ func (m *TerminateWorkflowExecutionResponse) Marshal() (dAtA []byte, err error)
 	return dAtA[:n], nil
 }
 
-func (m *TerminateWorkflowExecutionResponse) MarshalTo(dAtA []byte) (int, error) {
+func (m *RecordActivityTaskHeartbeatResponse) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *TerminateWorkflowExecutionResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *RecordActivityTaskHeartbeatResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
+	if m.CancelRequested {
+		i--
+		if m.CancelRequested {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x8
+	}
 	return len(dAtA) - i, nil
 }
 
-func (m *ResetWorkflowExecutionRequest) Marshal() (dAtA []byte, err error) {
+func (m *RespondActivityTaskCompletedRequest) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -9466,19 +10356,19 @@ func (m *ResetWorkflowExecutionRequest) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *ResetWorkflowExecutionRequest) MarshalTo(dAtA []byte) (int, error) {
+func (m *RespondActivityTaskCompletedRequest) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *ResetWorkflowExecutionRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *RespondActivityTaskCompletedRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if m.ResetRequest != nil {
+	if m.CompleteRequest != nil {
 		{
-			size, err := m.ResetRequest.MarshalToSizedBuffer(dAtA[:i])
+			size, err := m.CompleteRequest.MarshalToSizedBuffer(dAtA[:i])
 			if err != nil {
 				return 0, err
 			}
@@ -9498,7 +10388,7 @@ func (m *ResetWorkflowExecutionRequest) MarshalToSizedBuffer(dAtA []byte) (int,
 	return len(dAtA) - i, nil
 }
 
-func (m *ResetWorkflowExecutionResponse) Marshal() (dAtA []byte, err error) {
+func (m *RespondActivityTaskCompletedResponse) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -9508,27 +10398,20 @@ func (m *ResetWorkflowExecutionResponse) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *ResetWorkflowExecutionResponse) MarshalTo(dAtA []byte) (int, error) {
+func (m *RespondActivityTaskCompletedResponse) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *ResetWorkflowExecutionResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *RespondActivityTaskCompletedResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if len(m.RunId) > 0 {
-		i -= len(m.RunId)
-		copy(dAtA[i:], m.RunId)
-		i = encodeVarintRequestResponse(dAtA, i, uint64(len(m.RunId)))
-		i--
-		dAtA[i] = 0xa
-	}
 	return len(dAtA) - i, nil
 }
 
-func (m *RequestCancelWorkflowExecutionRequest) Marshal() (dAtA []byte, err error) {
+func (m *RespondActivityTaskFailedRequest) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -9538,46 +10421,19 @@ func (m *RequestCancelWorkflowExecutionRequest) Marshal() (dAtA []byte, err erro
 	return dAtA[:n], nil
 }
 
-func (m *RequestCancelWorkflowExecutionRequest) MarshalTo(dAtA []byte) (int, error) {
+func (m *RespondActivityTaskFailedRequest) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *RequestCancelWorkflowExecutionRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *RespondActivityTaskFailedRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if m.ChildWorkflowOnly {
-		i--
-		if m.ChildWorkflowOnly {
-			dAtA[i] = 1
-		} else {
-			dAtA[i] = 0
-		}
-		i--
-		dAtA[i] = 0x28
-	}
-	if m.ExternalWorkflowExecution != nil {
-		{
-			size, err := m.ExternalWorkflowExecution.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintRequestResponse(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x22
-	}
-	if m.ExternalInitiatedEventId != 0 {
-		i = encodeVarintRequestResponse(dAtA, i, uint64(m.ExternalInitiatedEventId))
-		i--
-		dAtA[i] = 0x18
-	}
-	if m.CancelRequest != nil {
+	if m.FailedRequest != nil {
 		{
-			size, err := m.CancelRequest.MarshalToSizedBuffer(dAtA[:i])
+			size, err := m.FailedRequest.MarshalToSizedBuffer(dAtA[:i])
 			if err != nil {
 				return 0, err
 			}
@@ -9597,7 +10453,7 @@ func (m *RequestCancelWorkflowExecutionRequest) MarshalToSizedBuffer(dAtA []byte
 	return len(dAtA) - i, nil
 }
 
-func (m *RequestCancelWorkflowExecutionResponse) Marshal() (dAtA []byte, err error) {
+func (m *RespondActivityTaskFailedResponse) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -9607,12 +10463,12 @@ func (m *RequestCancelWorkflowExecutionResponse) Marshal() (dAtA []byte, err err
 	return dAtA[:n], nil
 }
 
-func (m *RequestCancelWorkflowExecutionResponse) MarshalTo(dAtA []byte) (int, error) {
+func (m *RespondActivityTaskFailedResponse) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *RequestCancelWorkflowExecutionResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *RespondActivityTaskFailedResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
@@ -9620,7 +10476,7 @@ func (m *RequestCancelWorkflowExecutionResponse) MarshalToSizedBuffer(dAtA []byt
 	return len(dAtA) - i, nil
 }
 
-func (m *ScheduleWorkflowTaskRequest) Marshal() (dAtA []byte, err error) {
+func (m *RespondActivityTaskCanceledRequest) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -9630,29 +10486,19 @@ func (m *ScheduleWorkflowTaskRequest) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *ScheduleWorkflowTaskRequest) MarshalTo(dAtA []byte) (int, error) {
+func (m *RespondActivityTaskCanceledRequest) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *ScheduleWorkflowTaskRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *RespondActivityTaskCanceledRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if m.IsFirstWorkflowTask {
-		i--
-		if m.IsFirstWorkflowTask {
-			dAtA[i] = 1
-		} else {
-			dAtA[i] = 0
-		}
-		i--
-		dAtA[i] = 0x18
-	}
-	if m.WorkflowExecution != nil {
+	if m.CancelRequest != nil {
 		{
-			size, err := m.WorkflowExecution.MarshalToSizedBuffer(dAtA[:i])
+			size, err := m.CancelRequest.MarshalToSizedBuffer(dAtA[:i])
 			if err != nil {
 				return 0, err
 			}
@@ -9672,7 +10518,7 @@ func (m *ScheduleWorkflowTaskRequest) MarshalToSizedBuffer(dAtA []byte) (int, er
 	return len(dAtA) - i, nil
 }
 
-func (m *ScheduleWorkflowTaskResponse) Marshal() (dAtA []byte, err error) {
+func (m *RespondActivityTaskCanceledResponse) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -9682,12 +10528,12 @@ func (m *ScheduleWorkflowTaskResponse) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *ScheduleWorkflowTaskResponse) MarshalTo(dAtA []byte) (int, error) {
+func (m *RespondActivityTaskCanceledResponse) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *ScheduleWorkflowTaskResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *RespondActivityTaskCanceledResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
@@ -9695,7 +10541,7 @@ func (m *ScheduleWorkflowTaskResponse) MarshalToSizedBuffer(dAtA []byte) (int, e
 	return len(dAtA) - i, nil
 }
 
-func (m *RecordChildExecutionCompletedRequest) Marshal() (dAtA []byte, err error) {
+func (m *SignalWorkflowExecutionRequest) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -9705,19 +10551,29 @@ func (m *RecordChildExecutionCompletedRequest) Marshal() (dAtA []byte, err error
 	return dAtA[:n], nil
 }
 
-func (m *RecordChildExecutionCompletedRequest) MarshalTo(dAtA []byte) (int, error) {
+func (m *SignalWorkflowExecutionRequest) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *RecordChildExecutionCompletedRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *SignalWorkflowExecutionRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if m.CompletionEvent != nil {
+	if m.ChildWorkflowOnly {
+		i--
+		if m.ChildWorkflowOnly {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.ExternalWorkflowExecution != nil {
 		{
-			size, err := m.CompletionEvent.MarshalToSizedBuffer(dAtA[:i])
+			size, err := m.ExternalWorkflowExecution.MarshalToSizedBuffer(dAtA[:i])
 			if err != nil {
 				return 0, err
 			}
@@ -9725,28 +10581,11 @@ func (m *RecordChildExecutionCompletedRequest) MarshalToSizedBuffer(dAtA []byte)
 			i = encodeVarintRequestResponse(dAtA, i, uint64(size))
 		}
 		i--
-		dAtA[i] = 0x2a
+		dAtA[i] = 0x1a
 	}
-	if m.CompletedExecution != nil {
+	if m.SignalRequest != nil {
 		{
-			size, err := m.CompletedExecution.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintRequestResponse(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x22
-	}
-	if m.InitiatedId != 0 {
-		i = encodeVarintRequestResponse(dAtA, i, uint64(m.InitiatedId))
-		i--
-		dAtA[i] = 0x18
-	}
-	if m.WorkflowExecution != nil {
-		{
-			size, err := m.WorkflowExecution.MarshalToSizedBuffer(dAtA[:i])
+			size, err := m.SignalRequest.MarshalToSizedBuffer(dAtA[:i])
 			if err != nil {
 				return 0, err
 			}
@@ -9766,7 +10605,7 @@ func (m *RecordChildExecutionCompletedRequest) MarshalToSizedBuffer(dAtA []byte)
 	return len(dAtA) - i, nil
 }
 
-func (m *RecordChildExecutionCompletedResponse) Marshal() (dAtA []byte, err error) {
+func (m *SignalWorkflowExecutionResponse) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -9776,12 +10615,12 @@ func (m *RecordChildExecutionCompletedResponse) Marshal() (dAtA []byte, err erro
 	return dAtA[:n], nil
 }
 
-func (m *RecordChildExecutionCompletedResponse) MarshalTo(dAtA []byte) (int, error) {
+func (m *SignalWorkflowExecutionResponse) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *RecordChildExecutionCompletedResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *SignalWorkflowExecutionResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
@@ -9789,7 +10628,7 @@ func (m *RecordChildExecutionCompletedResponse) MarshalToSizedBuffer(dAtA []byte
 	return len(dAtA) - i, nil
 }
 
-func (m *DescribeWorkflowExecutionRequest) Marshal() (dAtA []byte, err error) {
+func (m *SignalWithStartWorkflowExecutionRequest) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -9799,19 +10638,19 @@ func (m *DescribeWorkflowExecutionRequest) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *DescribeWorkflowExecutionRequest) MarshalTo(dAtA []byte) (int, error) {
+func (m *SignalWithStartWorkflowExecutionRequest) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *DescribeWorkflowExecutionRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *SignalWithStartWorkflowExecutionRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if m.Request != nil {
+	if m.SignalWithStartRequest != nil {
 		{
-			size, err := m.Request.MarshalToSizedBuffer(dAtA[:i])
+			size, err := m.SignalWithStartRequest.MarshalToSizedBuffer(dAtA[:i])
 			if err != nil {
 				return 0, err
 			}
@@ -9831,7 +10670,7 @@ func (m *DescribeWorkflowExecutionRequest) MarshalToSizedBuffer(dAtA []byte) (in
 	return len(dAtA) - i, nil
 }
 
-func (m *DescribeWorkflowExecutionResponse) Marshal() (dAtA []byte, err error) {
+func (m *SignalWithStartWorkflowExecutionResponse) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -9841,72 +10680,27 @@ func (m *DescribeWorkflowExecutionResponse) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *DescribeWorkflowExecutionResponse) MarshalTo(dAtA []byte) (int, error) {
+func (m *SignalWithStartWorkflowExecutionResponse) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *DescribeWorkflowExecutionResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *SignalWithStartWorkflowExecutionResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if len(m.PendingChildren) > 0 {
-		for iNdEx := len(m.PendingChildren) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.PendingChildren[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintRequestResponse(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0x22
-		}
-	}
-	if len(m.PendingActivities) > 0 {
-		for iNdEx := len(m.PendingActivities) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.PendingActivities[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintRequestResponse(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0x1a
-		}
-	}
-	if m.WorkflowExecutionInfo != nil {
-		{
-			size, err := m.WorkflowExecutionInfo.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintRequestResponse(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x12
-	}
-	if m.ExecutionConfig != nil {
-		{
-			size, err := m.ExecutionConfig.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintRequestResponse(dAtA, i, uint64(size))
-		}
+	if len(m.RunId) > 0 {
+		i -= len(m.RunId)
+		copy(dAtA[i:], m.RunId)
+		i = encodeVarintRequestResponse(dAtA, i, uint64(len(m.RunId)))
 		i--
 		dAtA[i] = 0xa
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *ReplicateEventsV2Request) Marshal() (dAtA []byte, err error) {
+func (m *RemoveSignalMutableStateRequest) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -9916,53 +10710,22 @@ func (m *ReplicateEventsV2Request) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *ReplicateEventsV2Request) MarshalTo(dAtA []byte) (int, error) {
+func (m *RemoveSignalMutableStateRequest) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *ReplicateEventsV2Request) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *RemoveSignalMutableStateRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if m.NewRunEvents != nil {
-		{
-			size, err := m.NewRunEvents.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintRequestResponse(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x2a
-	}
-	if m.Events != nil {
-		{
-			size, err := m.Events.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintRequestResponse(dAtA, i, uint64(size))
-		}
+	if len(m.RequestId) > 0 {
+		i -= len(m.RequestId)
+		copy(dAtA[i:], m.RequestId)
+		i = encodeVarintRequestResponse(dAtA, i, uint64(len(m.RequestId)))
 		i--
-		dAtA[i] = 0x22
-	}
-	if len(m.VersionHistoryItems) > 0 {
-		for iNdEx := len(m.VersionHistoryItems) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.VersionHistoryItems[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintRequestResponse(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0x1a
-		}
+		dAtA[i] = 0x1a
 	}
 	if m.WorkflowExecution != nil {
 		{
@@ -9986,7 +10749,7 @@ func (m *ReplicateEventsV2Request) MarshalToSizedBuffer(dAtA []byte) (int, error
 	return len(dAtA) - i, nil
 }
 
-func (m *ReplicateEventsV2Response) Marshal() (dAtA []byte, err error) {
+func (m *RemoveSignalMutableStateResponse) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -9996,12 +10759,12 @@ func (m *ReplicateEventsV2Response) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *ReplicateEventsV2Response) MarshalTo(dAtA []byte) (int, error) {
+func (m *RemoveSignalMutableStateResponse) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *ReplicateEventsV2Response) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *RemoveSignalMutableStateResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
@@ -10009,7 +10772,7 @@ func (m *ReplicateEventsV2Response) MarshalToSizedBuffer(dAtA []byte) (int, erro
 	return len(dAtA) - i, nil
 }
 
-func (m *SyncShardStatusRequest) Marshal() (dAtA []byte, err error) {
+func (m *TerminateWorkflowExecutionRequest) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -10019,42 +10782,39 @@ func (m *SyncShardStatusRequest) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *SyncShardStatusRequest) MarshalTo(dAtA []byte) (int, error) {
+func (m *TerminateWorkflowExecutionRequest) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *SyncShardStatusRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *TerminateWorkflowExecutionRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if m.StatusTime != nil {
-		n62, err62 := github_com_gogo_protobuf_types.StdTimeMarshalTo(*m.StatusTime, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(*m.StatusTime):])
-		if err62 != nil {
-			return 0, err62
+	if m.TerminateRequest != nil {
+		{
+			size, err := m.TerminateRequest.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintRequestResponse(dAtA, i, uint64(size))
 		}
-		i -= n62
-		i = encodeVarintRequestResponse(dAtA, i, uint64(n62))
-		i--
-		dAtA[i] = 0x1a
-	}
-	if m.ShardId != 0 {
-		i = encodeVarintRequestResponse(dAtA, i, uint64(m.ShardId))
 		i--
-		dAtA[i] = 0x10
+		dAtA[i] = 0x12
 	}
-	if len(m.SourceCluster) > 0 {
-		i -= len(m.SourceCluster)
-		copy(dAtA[i:], m.SourceCluster)
-		i = encodeVarintRequestResponse(dAtA, i, uint64(len(m.SourceCluster)))
+	if len(m.NamespaceId) > 0 {
+		i -= len(m.NamespaceId)
+		copy(dAtA[i:], m.NamespaceId)
+		i = encodeVarintRequestResponse(dAtA, i, uint64(len(m.NamespaceId)))
 		i--
 		dAtA[i] = 0xa
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *SyncShardStatusResponse) Marshal() (dAtA []byte, err error) {
+func (m *TerminateWorkflowExecutionResponse) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -10064,12 +10824,12 @@ func (m *SyncShardStatusResponse) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *SyncShardStatusResponse) MarshalTo(dAtA []byte) (int, error) {
+func (m *TerminateWorkflowExecutionResponse) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *SyncShardStatusResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *TerminateWorkflowExecutionResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
@@ -10077,7 +10837,7 @@ func (m *SyncShardStatusResponse) MarshalToSizedBuffer(dAtA []byte) (int, error)
 	return len(dAtA) - i, nil
 }
 
-func (m *SyncActivityRequest) Marshal() (dAtA []byte, err error) {
+func (m *ResetWorkflowExecutionRequest) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -10087,55 +10847,54 @@ func (m *SyncActivityRequest) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *SyncActivityRequest) MarshalTo(dAtA []byte) (int, error) {
+func (m *ResetWorkflowExecutionRequest) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *SyncActivityRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *ResetWorkflowExecutionRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if m.VersionHistory != nil {
-		{
-			size, err := m.VersionHistory.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintRequestResponse(dAtA, i, uint64(size))
+	if m.DryRun {
+		i--
+		if m.DryRun {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
 		}
 		i--
-		dAtA[i] = 0x72
+		dAtA[i] = 0x30
 	}
-	if len(m.LastWorkerIdentity) > 0 {
-		i -= len(m.LastWorkerIdentity)
-		copy(dAtA[i:], m.LastWorkerIdentity)
-		i = encodeVarintRequestResponse(dAtA, i, uint64(len(m.LastWorkerIdentity)))
+	if m.ReapplyExcludeAll {
 		i--
-		dAtA[i] = 0x6a
+		if m.ReapplyExcludeAll {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x28
 	}
-	if m.LastFailure != nil {
-		{
-			size, err := m.LastFailure.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintRequestResponse(dAtA, i, uint64(size))
+	if m.ReapplyExcludeSignals {
+		i--
+		if m.ReapplyExcludeSignals {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
 		}
 		i--
-		dAtA[i] = 0x62
+		dAtA[i] = 0x20
 	}
-	if m.Attempt != 0 {
-		i = encodeVarintRequestResponse(dAtA, i, uint64(m.Attempt))
+	if m.WorkflowTaskScheduledEventId != 0 {
+		i = encodeVarintRequestResponse(dAtA, i, uint64(m.WorkflowTaskScheduledEventId))
 		i--
-		dAtA[i] = 0x58
+		dAtA[i] = 0x18
 	}
-	if m.Details != nil {
+	if m.ResetRequest != nil {
 		{
-			size, err := m.Details.MarshalToSizedBuffer(dAtA[:i])
+			size, err := m.ResetRequest.MarshalToSizedBuffer(dAtA[:i])
 			if err != nil {
 				return 0, err
 			}
@@ -10143,78 +10902,61 @@ func (m *SyncActivityRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 			i = encodeVarintRequestResponse(dAtA, i, uint64(size))
 		}
 		i--
-		dAtA[i] = 0x52
-	}
-	if m.LastHeartbeatTime != nil {
-		n66, err66 := github_com_gogo_protobuf_types.StdTimeMarshalTo(*m.LastHeartbeatTime, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(*m.LastHeartbeatTime):])
-		if err66 != nil {
-			return 0, err66
-		}
-		i -= n66
-		i = encodeVarintRequestResponse(dAtA, i, uint64(n66))
-		i--
-		dAtA[i] = 0x4a
+		dAtA[i] = 0x12
 	}
-	if m.StartedTime != nil {
-		n67, err67 := github_com_gogo_protobuf_types.StdTimeMarshalTo(*m.StartedTime, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(*m.StartedTime):])
-		if err67 != nil {
-			return 0, err67
-		}
-		i -= n67
-		i = encodeVarintRequestResponse(dAtA, i, uint64(n67))
+	if len(m.NamespaceId) > 0 {
+		i -= len(m.NamespaceId)
+		copy(dAtA[i:], m.NamespaceId)
+		i = encodeVarintRequestResponse(dAtA, i, uint64(len(m.NamespaceId)))
 		i--
-		dAtA[i] = 0x42
+		dAtA[i] = 0xa
 	}
-	if m.StartedId != 0 {
-		i = encodeVarintRequestResponse(dAtA, i, uint64(m.StartedId))
-		i--
-		dAtA[i] = 0x38
+	return len(dAtA) - i, nil
+}
+
+func (m *ResetWorkflowExecutionResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	if m.ScheduledTime != nil {
-		n68, err68 := github_com_gogo_protobuf_types.StdTimeMarshalTo(*m.ScheduledTime, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(*m.ScheduledTime):])
-		if err68 != nil {
-			return 0, err68
+	return dAtA[:n], nil
+}
+
+func (m *ResetWorkflowExecutionResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ResetWorkflowExecutionResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Preview != nil {
+		{
+			size, err := m.Preview.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintRequestResponse(dAtA, i, uint64(size))
 		}
-		i -= n68
-		i = encodeVarintRequestResponse(dAtA, i, uint64(n68))
-		i--
-		dAtA[i] = 0x32
-	}
-	if m.ScheduledId != 0 {
-		i = encodeVarintRequestResponse(dAtA, i, uint64(m.ScheduledId))
-		i--
-		dAtA[i] = 0x28
-	}
-	if m.Version != 0 {
-		i = encodeVarintRequestResponse(dAtA, i, uint64(m.Version))
 		i--
-		dAtA[i] = 0x20
+		dAtA[i] = 0x12
 	}
 	if len(m.RunId) > 0 {
 		i -= len(m.RunId)
 		copy(dAtA[i:], m.RunId)
 		i = encodeVarintRequestResponse(dAtA, i, uint64(len(m.RunId)))
 		i--
-		dAtA[i] = 0x1a
-	}
-	if len(m.WorkflowId) > 0 {
-		i -= len(m.WorkflowId)
-		copy(dAtA[i:], m.WorkflowId)
-		i = encodeVarintRequestResponse(dAtA, i, uint64(len(m.WorkflowId)))
-		i--
-		dAtA[i] = 0x12
-	}
-	if len(m.NamespaceId) > 0 {
-		i -= len(m.NamespaceId)
-		copy(dAtA[i:], m.NamespaceId)
-		i = encodeVarintRequestResponse(dAtA, i, uint64(len(m.NamespaceId)))
-		i--
 		dAtA[i] = 0xa
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *SyncActivityResponse) Marshal() (dAtA []byte, err error) {
+func (m *ResetWorkflowExecutionPreview) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -10224,20 +10966,95 @@ func (m *SyncActivityResponse) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *SyncActivityResponse) MarshalTo(dAtA []byte) (int, error) {
+func (m *ResetWorkflowExecutionPreview) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *SyncActivityResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *ResetWorkflowExecutionPreview) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
+	if len(m.ReappliedEvents) > 0 {
+		for iNdEx := len(m.ReappliedEvents) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.ReappliedEvents[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintRequestResponse(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x3a
+		}
+	}
+	if len(m.PendingChildren) > 0 {
+		for iNdEx := len(m.PendingChildren) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.PendingChildren[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintRequestResponse(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x32
+		}
+	}
+	if len(m.FailedActivities) > 0 {
+		for iNdEx := len(m.FailedActivities) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.FailedActivities[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintRequestResponse(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x2a
+		}
+	}
+	if len(m.DroppedEvents) > 0 {
+		for iNdEx := len(m.DroppedEvents) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.DroppedEvents[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintRequestResponse(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	if len(m.TerminatedRunId) > 0 {
+		i -= len(m.TerminatedRunId)
+		copy(dAtA[i:], m.TerminatedRunId)
+		i = encodeVarintRequestResponse(dAtA, i, uint64(len(m.TerminatedRunId)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.WorkflowTaskFinishEventId != 0 {
+		i = encodeVarintRequestResponse(dAtA, i, uint64(m.WorkflowTaskFinishEventId))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.BaseRunId) > 0 {
+		i -= len(m.BaseRunId)
+		copy(dAtA[i:], m.BaseRunId)
+		i = encodeVarintRequestResponse(dAtA, i, uint64(len(m.BaseRunId)))
+		i--
+		dAtA[i] = 0xa
+	}
 	return len(dAtA) - i, nil
 }
 
-func (m *DescribeMutableStateRequest) Marshal() (dAtA []byte, err error) {
+func (m *RequestCancelWorkflowExecutionRequest) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -10247,19 +11064,46 @@ func (m *DescribeMutableStateRequest) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *DescribeMutableStateRequest) MarshalTo(dAtA []byte) (int, error) {
+func (m *RequestCancelWorkflowExecutionRequest) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *DescribeMutableStateRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *RequestCancelWorkflowExecutionRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if m.Execution != nil {
+	if m.ChildWorkflowOnly {
+		i--
+		if m.ChildWorkflowOnly {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.ExternalWorkflowExecution != nil {
 		{
-			size, err := m.Execution.MarshalToSizedBuffer(dAtA[:i])
+			size, err := m.ExternalWorkflowExecution.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintRequestResponse(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x22
+	}
+	if m.ExternalInitiatedEventId != 0 {
+		i = encodeVarintRequestResponse(dAtA, i, uint64(m.ExternalInitiatedEventId))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.CancelRequest != nil {
+		{
+			size, err := m.CancelRequest.MarshalToSizedBuffer(dAtA[:i])
 			if err != nil {
 				return 0, err
 			}
@@ -10279,7 +11123,7 @@ func (m *DescribeMutableStateRequest) MarshalToSizedBuffer(dAtA []byte) (int, er
 	return len(dAtA) - i, nil
 }
 
-func (m *DescribeMutableStateResponse) Marshal() (dAtA []byte, err error) {
+func (m *RequestCancelWorkflowExecutionResponse) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -10289,48 +11133,20 @@ func (m *DescribeMutableStateResponse) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *DescribeMutableStateResponse) MarshalTo(dAtA []byte) (int, error) {
+func (m *RequestCancelWorkflowExecutionResponse) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *DescribeMutableStateResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *RequestCancelWorkflowExecutionResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if len(m.BranchId) > 0 {
-		i -= len(m.BranchId)
-		copy(dAtA[i:], m.BranchId)
-		i = encodeVarintRequestResponse(dAtA, i, uint64(len(m.BranchId)))
-		i--
-		dAtA[i] = 0x22
-	}
-	if len(m.TreeId) > 0 {
-		i -= len(m.TreeId)
-		copy(dAtA[i:], m.TreeId)
-		i = encodeVarintRequestResponse(dAtA, i, uint64(len(m.TreeId)))
-		i--
-		dAtA[i] = 0x1a
-	}
-	if len(m.DatabaseMutableState) > 0 {
-		i -= len(m.DatabaseMutableState)
-		copy(dAtA[i:], m.DatabaseMutableState)
-		i = encodeVarintRequestResponse(dAtA, i, uint64(len(m.DatabaseMutableState)))
-		i--
-		dAtA[i] = 0x12
-	}
-	if len(m.CacheMutableState) > 0 {
-		i -= len(m.CacheMutableState)
-		copy(dAtA[i:], m.CacheMutableState)
-		i = encodeVarintRequestResponse(dAtA, i, uint64(len(m.CacheMutableState)))
-		i--
-		dAtA[i] = 0xa
-	}
 	return len(dAtA) - i, nil
 }
 
-func (m *DescribeHistoryHostRequest) Marshal() (dAtA []byte, err error) {
+func (m *ScheduleWorkflowTaskRequest) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -10340,16 +11156,26 @@ func (m *DescribeHistoryHostRequest) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *DescribeHistoryHostRequest) MarshalTo(dAtA []byte) (int, error) {
+func (m *ScheduleWorkflowTaskRequest) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *DescribeHistoryHostRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *ScheduleWorkflowTaskRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
+	if m.IsFirstWorkflowTask {
+		i--
+		if m.IsFirstWorkflowTask {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x18
+	}
 	if m.WorkflowExecution != nil {
 		{
 			size, err := m.WorkflowExecution.MarshalToSizedBuffer(dAtA[:i])
@@ -10360,31 +11186,19 @@ func (m *DescribeHistoryHostRequest) MarshalToSizedBuffer(dAtA []byte) (int, err
 			i = encodeVarintRequestResponse(dAtA, i, uint64(size))
 		}
 		i--
-		dAtA[i] = 0x22
+		dAtA[i] = 0x12
 	}
 	if len(m.NamespaceId) > 0 {
 		i -= len(m.NamespaceId)
 		copy(dAtA[i:], m.NamespaceId)
 		i = encodeVarintRequestResponse(dAtA, i, uint64(len(m.NamespaceId)))
 		i--
-		dAtA[i] = 0x1a
-	}
-	if m.ShardId != 0 {
-		i = encodeVarintRequestResponse(dAtA, i, uint64(m.ShardId))
-		i--
-		dAtA[i] = 0x10
-	}
-	if len(m.HostAddress) > 0 {
-		i -= len(m.HostAddress)
-		copy(dAtA[i:], m.HostAddress)
-		i = encodeVarintRequestResponse(dAtA, i, uint64(len(m.HostAddress)))
-		i--
 		dAtA[i] = 0xa
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *DescribeHistoryHostResponse) Marshal() (dAtA []byte, err error) {
+func (m *ScheduleWorkflowTaskResponse) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -10394,70 +11208,91 @@ func (m *DescribeHistoryHostResponse) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *DescribeHistoryHostResponse) MarshalTo(dAtA []byte) (int, error) {
+func (m *ScheduleWorkflowTaskResponse) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *DescribeHistoryHostResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *ScheduleWorkflowTaskResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if len(m.Address) > 0 {
-		i -= len(m.Address)
-		copy(dAtA[i:], m.Address)
-		i = encodeVarintRequestResponse(dAtA, i, uint64(len(m.Address)))
-		i--
-		dAtA[i] = 0x2a
-	}
-	if len(m.ShardControllerStatus) > 0 {
-		i -= len(m.ShardControllerStatus)
-		copy(dAtA[i:], m.ShardControllerStatus)
-		i = encodeVarintRequestResponse(dAtA, i, uint64(len(m.ShardControllerStatus)))
-		i--
-		dAtA[i] = 0x22
-	}
-	if m.NamespaceCache != nil {
-		{
-			size, err := m.NamespaceCache.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintRequestResponse(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x1a
+	return len(dAtA) - i, nil
+}
+
+func (m *RecordChildExecutionCompletedRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	if len(m.ShardIds) > 0 {
-		dAtA73 := make([]byte, len(m.ShardIds)*10)
-		var j72 int
-		for _, num1 := range m.ShardIds {
-			num := uint64(num1)
-			for num >= 1<<7 {
-				dAtA73[j72] = uint8(uint64(num)&0x7f | 0x80)
-				num >>= 7
-				j72++
+	return dAtA[:n], nil
+}
+
+func (m *RecordChildExecutionCompletedRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *RecordChildExecutionCompletedRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.CompletionEvent != nil {
+		{
+			size, err := m.CompletionEvent.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintRequestResponse(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x2a
+	}
+	if m.CompletedExecution != nil {
+		{
+			size, err := m.CompletedExecution.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintRequestResponse(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x22
+	}
+	if m.InitiatedId != 0 {
+		i = encodeVarintRequestResponse(dAtA, i, uint64(m.InitiatedId))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.WorkflowExecution != nil {
+		{
+			size, err := m.WorkflowExecution.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
 			}
-			dAtA73[j72] = uint8(num)
-			j72++
+			i -= size
+			i = encodeVarintRequestResponse(dAtA, i, uint64(size))
 		}
-		i -= j72
-		copy(dAtA[i:], dAtA73[:j72])
-		i = encodeVarintRequestResponse(dAtA, i, uint64(j72))
 		i--
 		dAtA[i] = 0x12
 	}
-	if m.ShardsNumber != 0 {
-		i = encodeVarintRequestResponse(dAtA, i, uint64(m.ShardsNumber))
+	if len(m.NamespaceId) > 0 {
+		i -= len(m.NamespaceId)
+		copy(dAtA[i:], m.NamespaceId)
+		i = encodeVarintRequestResponse(dAtA, i, uint64(len(m.NamespaceId)))
 		i--
-		dAtA[i] = 0x8
+		dAtA[i] = 0xa
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *CloseShardRequest) Marshal() (dAtA []byte, err error) {
+func (m *RecordChildExecutionCompletedResponse) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -10467,25 +11302,20 @@ func (m *CloseShardRequest) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *CloseShardRequest) MarshalTo(dAtA []byte) (int, error) {
+func (m *RecordChildExecutionCompletedResponse) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *CloseShardRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *RecordChildExecutionCompletedResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if m.ShardId != 0 {
-		i = encodeVarintRequestResponse(dAtA, i, uint64(m.ShardId))
-		i--
-		dAtA[i] = 0x8
-	}
 	return len(dAtA) - i, nil
 }
 
-func (m *CloseShardResponse) Marshal() (dAtA []byte, err error) {
+func (m *DescribeWorkflowExecutionRequest) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -10495,20 +11325,39 @@ func (m *CloseShardResponse) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *CloseShardResponse) MarshalTo(dAtA []byte) (int, error) {
+func (m *DescribeWorkflowExecutionRequest) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *CloseShardResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *DescribeWorkflowExecutionRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
+	if m.Request != nil {
+		{
+			size, err := m.Request.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintRequestResponse(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.NamespaceId) > 0 {
+		i -= len(m.NamespaceId)
+		copy(dAtA[i:], m.NamespaceId)
+		i = encodeVarintRequestResponse(dAtA, i, uint64(len(m.NamespaceId)))
+		i--
+		dAtA[i] = 0xa
+	}
 	return len(dAtA) - i, nil
 }
 
-func (m *RemoveTaskRequest) Marshal() (dAtA []byte, err error) {
+func (m *DescribeWorkflowExecutionResponse) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -10518,45 +11367,104 @@ func (m *RemoveTaskRequest) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *RemoveTaskRequest) MarshalTo(dAtA []byte) (int, error) {
+func (m *DescribeWorkflowExecutionResponse) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *RemoveTaskRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *DescribeWorkflowExecutionResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if m.VisibilityTime != nil {
-		n74, err74 := github_com_gogo_protobuf_types.StdTimeMarshalTo(*m.VisibilityTime, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(*m.VisibilityTime):])
-		if err74 != nil {
-			return 0, err74
+	if len(m.PauseReason) > 0 {
+		i -= len(m.PauseReason)
+		copy(dAtA[i:], m.PauseReason)
+		i = encodeVarintRequestResponse(dAtA, i, uint64(len(m.PauseReason)))
+		i--
+		dAtA[i] = 0x42
+	}
+	if m.Paused {
+		i--
+		if m.Paused {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
 		}
-		i -= n74
-		i = encodeVarintRequestResponse(dAtA, i, uint64(n74))
 		i--
-		dAtA[i] = 0x22
+		dAtA[i] = 0x38
 	}
-	if m.TaskId != 0 {
-		i = encodeVarintRequestResponse(dAtA, i, uint64(m.TaskId))
+	if m.SuggestContinueAsNew {
 		i--
-		dAtA[i] = 0x18
+		if m.SuggestContinueAsNew {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x30
 	}
-	if m.Category != 0 {
-		i = encodeVarintRequestResponse(dAtA, i, uint64(m.Category))
+	if m.HistorySizeBytes != 0 {
+		i = encodeVarintRequestResponse(dAtA, i, uint64(m.HistorySizeBytes))
 		i--
-		dAtA[i] = 0x10
+		dAtA[i] = 0x28
 	}
-	if m.ShardId != 0 {
-		i = encodeVarintRequestResponse(dAtA, i, uint64(m.ShardId))
+	if len(m.PendingChildren) > 0 {
+		for iNdEx := len(m.PendingChildren) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.PendingChildren[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintRequestResponse(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	if len(m.PendingActivities) > 0 {
+		for iNdEx := len(m.PendingActivities) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.PendingActivities[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintRequestResponse(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if m.WorkflowExecutionInfo != nil {
+		{
+			size, err := m.WorkflowExecutionInfo.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintRequestResponse(dAtA, i, uint64(size))
+		}
 		i--
-		dAtA[i] = 0x8
+		dAtA[i] = 0x12
+	}
+	if m.ExecutionConfig != nil {
+		{
+			size, err := m.ExecutionConfig.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintRequestResponse(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *RemoveTaskResponse) Marshal() (dAtA []byte, err error) {
+func (m *ReplicateEventsV2Request) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -10566,20 +11474,77 @@ func (m *RemoveTaskResponse) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *RemoveTaskResponse) MarshalTo(dAtA []byte) (int, error) {
+func (m *ReplicateEventsV2Request) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *RemoveTaskResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *ReplicateEventsV2Request) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
+	if m.NewRunEvents != nil {
+		{
+			size, err := m.NewRunEvents.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintRequestResponse(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x2a
+	}
+	if m.Events != nil {
+		{
+			size, err := m.Events.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintRequestResponse(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.VersionHistoryItems) > 0 {
+		for iNdEx := len(m.VersionHistoryItems) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.VersionHistoryItems[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintRequestResponse(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if m.WorkflowExecution != nil {
+		{
+			size, err := m.WorkflowExecution.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintRequestResponse(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.NamespaceId) > 0 {
+		i -= len(m.NamespaceId)
+		copy(dAtA[i:], m.NamespaceId)
+		i = encodeVarintRequestResponse(dAtA, i, uint64(len(m.NamespaceId)))
+		i--
+		dAtA[i] = 0xa
+	}
 	return len(dAtA) - i, nil
 }
 
-func (m *GetReplicationMessagesRequest) Marshal() (dAtA []byte, err error) {
+func (m *ReplicateEventsV2Response) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -10589,41 +11554,20 @@ func (m *GetReplicationMessagesRequest) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *GetReplicationMessagesRequest) MarshalTo(dAtA []byte) (int, error) {
+func (m *ReplicateEventsV2Response) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *GetReplicationMessagesRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *ReplicateEventsV2Response) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if len(m.ClusterName) > 0 {
-		i -= len(m.ClusterName)
-		copy(dAtA[i:], m.ClusterName)
-		i = encodeVarintRequestResponse(dAtA, i, uint64(len(m.ClusterName)))
-		i--
-		dAtA[i] = 0x12
-	}
-	if len(m.Tokens) > 0 {
-		for iNdEx := len(m.Tokens) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.Tokens[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintRequestResponse(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0xa
-		}
-	}
 	return len(dAtA) - i, nil
 }
 
-func (m *GetReplicationMessagesResponse) Marshal() (dAtA []byte, err error) {
+func (m *SyncShardStatusRequest) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -10633,44 +11577,42 @@ func (m *GetReplicationMessagesResponse) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *GetReplicationMessagesResponse) MarshalTo(dAtA []byte) (int, error) {
+func (m *SyncShardStatusRequest) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *GetReplicationMessagesResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *SyncShardStatusRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if len(m.ShardMessages) > 0 {
-		for k := range m.ShardMessages {
-			v := m.ShardMessages[k]
-			baseI := i
-			if v != nil {
-				{
-					size, err := v.MarshalToSizedBuffer(dAtA[:i])
-					if err != nil {
-						return 0, err
-					}
-					i -= size
-					i = encodeVarintRequestResponse(dAtA, i, uint64(size))
-				}
-				i--
-				dAtA[i] = 0x12
-			}
-			i = encodeVarintRequestResponse(dAtA, i, uint64(k))
-			i--
-			dAtA[i] = 0x8
-			i = encodeVarintRequestResponse(dAtA, i, uint64(baseI-i))
-			i--
-			dAtA[i] = 0xa
+	if m.StatusTime != nil {
+		n63, err63 := github_com_gogo_protobuf_types.StdTimeMarshalTo(*m.StatusTime, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(*m.StatusTime):])
+		if err63 != nil {
+			return 0, err63
 		}
+		i -= n63
+		i = encodeVarintRequestResponse(dAtA, i, uint64(n63))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.ShardId != 0 {
+		i = encodeVarintRequestResponse(dAtA, i, uint64(m.ShardId))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.SourceCluster) > 0 {
+		i -= len(m.SourceCluster)
+		copy(dAtA[i:], m.SourceCluster)
+		i = encodeVarintRequestResponse(dAtA, i, uint64(len(m.SourceCluster)))
+		i--
+		dAtA[i] = 0xa
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *GetDLQReplicationMessagesRequest) Marshal() (dAtA []byte, err error) {
+func (m *SyncShardStatusResponse) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -10680,34 +11622,20 @@ func (m *GetDLQReplicationMessagesRequest) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *GetDLQReplicationMessagesRequest) MarshalTo(dAtA []byte) (int, error) {
+func (m *SyncShardStatusResponse) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *GetDLQReplicationMessagesRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *SyncShardStatusResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if len(m.TaskInfos) > 0 {
-		for iNdEx := len(m.TaskInfos) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.TaskInfos[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintRequestResponse(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0xa
-		}
-	}
 	return len(dAtA) - i, nil
 }
 
-func (m *GetDLQReplicationMessagesResponse) Marshal() (dAtA []byte, err error) {
+func (m *SyncActivityRequest) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -10717,56 +11645,55 @@ func (m *GetDLQReplicationMessagesResponse) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *GetDLQReplicationMessagesResponse) MarshalTo(dAtA []byte) (int, error) {
+func (m *SyncActivityRequest) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *GetDLQReplicationMessagesResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *SyncActivityRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if len(m.ReplicationTasks) > 0 {
-		for iNdEx := len(m.ReplicationTasks) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.ReplicationTasks[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintRequestResponse(dAtA, i, uint64(size))
+	if m.VersionHistory != nil {
+		{
+			size, err := m.VersionHistory.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
 			}
-			i--
-			dAtA[i] = 0xa
+			i -= size
+			i = encodeVarintRequestResponse(dAtA, i, uint64(size))
 		}
+		i--
+		dAtA[i] = 0x72
 	}
-	return len(dAtA) - i, nil
-}
-
-func (m *QueryWorkflowRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+	if len(m.LastWorkerIdentity) > 0 {
+		i -= len(m.LastWorkerIdentity)
+		copy(dAtA[i:], m.LastWorkerIdentity)
+		i = encodeVarintRequestResponse(dAtA, i, uint64(len(m.LastWorkerIdentity)))
+		i--
+		dAtA[i] = 0x6a
 	}
-	return dAtA[:n], nil
-}
-
-func (m *QueryWorkflowRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
-}
-
-func (m *QueryWorkflowRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Request != nil {
+	if m.LastFailure != nil {
 		{
-			size, err := m.Request.MarshalToSizedBuffer(dAtA[:i])
+			size, err := m.LastFailure.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintRequestResponse(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x62
+	}
+	if m.Attempt != 0 {
+		i = encodeVarintRequestResponse(dAtA, i, uint64(m.Attempt))
+		i--
+		dAtA[i] = 0x58
+	}
+	if m.Details != nil {
+		{
+			size, err := m.Details.MarshalToSizedBuffer(dAtA[:i])
 			if err != nil {
 				return 0, err
 			}
@@ -10774,6 +11701,65 @@ func (m *QueryWorkflowRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 			i = encodeVarintRequestResponse(dAtA, i, uint64(size))
 		}
 		i--
+		dAtA[i] = 0x52
+	}
+	if m.LastHeartbeatTime != nil {
+		n67, err67 := github_com_gogo_protobuf_types.StdTimeMarshalTo(*m.LastHeartbeatTime, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(*m.LastHeartbeatTime):])
+		if err67 != nil {
+			return 0, err67
+		}
+		i -= n67
+		i = encodeVarintRequestResponse(dAtA, i, uint64(n67))
+		i--
+		dAtA[i] = 0x4a
+	}
+	if m.StartedTime != nil {
+		n68, err68 := github_com_gogo_protobuf_types.StdTimeMarshalTo(*m.StartedTime, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(*m.StartedTime):])
+		if err68 != nil {
+			return 0, err68
+		}
+		i -= n68
+		i = encodeVarintRequestResponse(dAtA, i, uint64(n68))
+		i--
+		dAtA[i] = 0x42
+	}
+	if m.StartedId != 0 {
+		i = encodeVarintRequestResponse(dAtA, i, uint64(m.StartedId))
+		i--
+		dAtA[i] = 0x38
+	}
+	if m.ScheduledTime != nil {
+		n69, err69 := github_com_gogo_protobuf_types.StdTimeMarshalTo(*m.ScheduledTime, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(*m.ScheduledTime):])
+		if err69 != nil {
+			return 0, err69
+		}
+		i -= n69
+		i = encodeVarintRequestResponse(dAtA, i, uint64(n69))
+		i--
+		dAtA[i] = 0x32
+	}
+	if m.ScheduledId != 0 {
+		i = encodeVarintRequestResponse(dAtA, i, uint64(m.ScheduledId))
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.Version != 0 {
+		i = encodeVarintRequestResponse(dAtA, i, uint64(m.Version))
+		i--
+		dAtA[i] = 0x20
+	}
+	if len(m.RunId) > 0 {
+		i -= len(m.RunId)
+		copy(dAtA[i:], m.RunId)
+		i = encodeVarintRequestResponse(dAtA, i, uint64(len(m.RunId)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.WorkflowId) > 0 {
+		i -= len(m.WorkflowId)
+		copy(dAtA[i:], m.WorkflowId)
+		i = encodeVarintRequestResponse(dAtA, i, uint64(len(m.WorkflowId)))
+		i--
 		dAtA[i] = 0x12
 	}
 	if len(m.NamespaceId) > 0 {
@@ -10786,7 +11772,7 @@ func (m *QueryWorkflowRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
-func (m *QueryWorkflowResponse) Marshal() (dAtA []byte, err error) {
+func (m *SyncActivityResponse) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -10796,32 +11782,20 @@ func (m *QueryWorkflowResponse) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *QueryWorkflowResponse) MarshalTo(dAtA []byte) (int, error) {
+func (m *SyncActivityResponse) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QueryWorkflowResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *SyncActivityResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if m.Response != nil {
-		{
-			size, err := m.Response.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintRequestResponse(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0xa
-	}
 	return len(dAtA) - i, nil
 }
 
-func (m *ReapplyEventsRequest) Marshal() (dAtA []byte, err error) {
+func (m *DescribeMutableStateRequest) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -10831,19 +11805,19 @@ func (m *ReapplyEventsRequest) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *ReapplyEventsRequest) MarshalTo(dAtA []byte) (int, error) {
+func (m *DescribeMutableStateRequest) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *ReapplyEventsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *DescribeMutableStateRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if m.Request != nil {
+	if m.Execution != nil {
 		{
-			size, err := m.Request.MarshalToSizedBuffer(dAtA[:i])
+			size, err := m.Execution.MarshalToSizedBuffer(dAtA[:i])
 			if err != nil {
 				return 0, err
 			}
@@ -10863,7 +11837,7 @@ func (m *ReapplyEventsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
-func (m *ReapplyEventsResponse) Marshal() (dAtA []byte, err error) {
+func (m *DescribeMutableStateResponse) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -10873,60 +11847,83 @@ func (m *ReapplyEventsResponse) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *ReapplyEventsResponse) MarshalTo(dAtA []byte) (int, error) {
+func (m *DescribeMutableStateResponse) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *ReapplyEventsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *DescribeMutableStateResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	return len(dAtA) - i, nil
-}
-
-func (m *GetDLQMessagesRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+	if len(m.BranchId) > 0 {
+		i -= len(m.BranchId)
+		copy(dAtA[i:], m.BranchId)
+		i = encodeVarintRequestResponse(dAtA, i, uint64(len(m.BranchId)))
+		i--
+		dAtA[i] = 0x22
 	}
-	return dAtA[:n], nil
-}
-
-func (m *GetDLQMessagesRequest) MarshalTo(dAtA []byte) (int, error) {
+	if len(m.TreeId) > 0 {
+		i -= len(m.TreeId)
+		copy(dAtA[i:], m.TreeId)
+		i = encodeVarintRequestResponse(dAtA, i, uint64(len(m.TreeId)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.DatabaseMutableState) > 0 {
+		i -= len(m.DatabaseMutableState)
+		copy(dAtA[i:], m.DatabaseMutableState)
+		i = encodeVarintRequestResponse(dAtA, i, uint64(len(m.DatabaseMutableState)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.CacheMutableState) > 0 {
+		i -= len(m.CacheMutableState)
+		copy(dAtA[i:], m.CacheMutableState)
+		i = encodeVarintRequestResponse(dAtA, i, uint64(len(m.CacheMutableState)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *DescribeHistoryHostRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *DescribeHistoryHostRequest) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *GetDLQMessagesRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *DescribeHistoryHostRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if len(m.NextPageToken) > 0 {
-		i -= len(m.NextPageToken)
-		copy(dAtA[i:], m.NextPageToken)
-		i = encodeVarintRequestResponse(dAtA, i, uint64(len(m.NextPageToken)))
-		i--
-		dAtA[i] = 0x32
-	}
-	if m.MaximumPageSize != 0 {
-		i = encodeVarintRequestResponse(dAtA, i, uint64(m.MaximumPageSize))
-		i--
-		dAtA[i] = 0x28
-	}
-	if m.InclusiveEndMessageId != 0 {
-		i = encodeVarintRequestResponse(dAtA, i, uint64(m.InclusiveEndMessageId))
+	if m.WorkflowExecution != nil {
+		{
+			size, err := m.WorkflowExecution.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintRequestResponse(dAtA, i, uint64(size))
+		}
 		i--
-		dAtA[i] = 0x20
+		dAtA[i] = 0x22
 	}
-	if len(m.SourceCluster) > 0 {
-		i -= len(m.SourceCluster)
-		copy(dAtA[i:], m.SourceCluster)
-		i = encodeVarintRequestResponse(dAtA, i, uint64(len(m.SourceCluster)))
+	if len(m.NamespaceId) > 0 {
+		i -= len(m.NamespaceId)
+		copy(dAtA[i:], m.NamespaceId)
+		i = encodeVarintRequestResponse(dAtA, i, uint64(len(m.NamespaceId)))
 		i--
 		dAtA[i] = 0x1a
 	}
@@ -10935,15 +11932,17 @@ func (m *GetDLQMessagesRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i--
 		dAtA[i] = 0x10
 	}
-	if m.Type != 0 {
-		i = encodeVarintRequestResponse(dAtA, i, uint64(m.Type))
+	if len(m.HostAddress) > 0 {
+		i -= len(m.HostAddress)
+		copy(dAtA[i:], m.HostAddress)
+		i = encodeVarintRequestResponse(dAtA, i, uint64(len(m.HostAddress)))
 		i--
-		dAtA[i] = 0x8
+		dAtA[i] = 0xa
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *GetDLQMessagesResponse) Marshal() (dAtA []byte, err error) {
+func (m *DescribeHistoryHostResponse) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -10953,46 +11952,70 @@ func (m *GetDLQMessagesResponse) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *GetDLQMessagesResponse) MarshalTo(dAtA []byte) (int, error) {
+func (m *DescribeHistoryHostResponse) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *GetDLQMessagesResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *DescribeHistoryHostResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if len(m.NextPageToken) > 0 {
-		i -= len(m.NextPageToken)
-		copy(dAtA[i:], m.NextPageToken)
-		i = encodeVarintRequestResponse(dAtA, i, uint64(len(m.NextPageToken)))
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintRequestResponse(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if len(m.ShardControllerStatus) > 0 {
+		i -= len(m.ShardControllerStatus)
+		copy(dAtA[i:], m.ShardControllerStatus)
+		i = encodeVarintRequestResponse(dAtA, i, uint64(len(m.ShardControllerStatus)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if m.NamespaceCache != nil {
+		{
+			size, err := m.NamespaceCache.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintRequestResponse(dAtA, i, uint64(size))
+		}
 		i--
 		dAtA[i] = 0x1a
 	}
-	if len(m.ReplicationTasks) > 0 {
-		for iNdEx := len(m.ReplicationTasks) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.ReplicationTasks[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintRequestResponse(dAtA, i, uint64(size))
+	if len(m.ShardIds) > 0 {
+		dAtA74 := make([]byte, len(m.ShardIds)*10)
+		var j73 int
+		for _, num1 := range m.ShardIds {
+			num := uint64(num1)
+			for num >= 1<<7 {
+				dAtA74[j73] = uint8(uint64(num)&0x7f | 0x80)
+				num >>= 7
+				j73++
 			}
-			i--
-			dAtA[i] = 0x12
+			dAtA74[j73] = uint8(num)
+			j73++
 		}
+		i -= j73
+		copy(dAtA[i:], dAtA74[:j73])
+		i = encodeVarintRequestResponse(dAtA, i, uint64(j73))
+		i--
+		dAtA[i] = 0x12
 	}
-	if m.Type != 0 {
-		i = encodeVarintRequestResponse(dAtA, i, uint64(m.Type))
+	if m.ShardsNumber != 0 {
+		i = encodeVarintRequestResponse(dAtA, i, uint64(m.ShardsNumber))
 		i--
 		dAtA[i] = 0x8
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *PurgeDLQMessagesRequest) Marshal() (dAtA []byte, err error) {
+func (m *CloseShardRequest) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -11002,42 +12025,25 @@ func (m *PurgeDLQMessagesRequest) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *PurgeDLQMessagesRequest) MarshalTo(dAtA []byte) (int, error) {
+func (m *CloseShardRequest) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *PurgeDLQMessagesRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *CloseShardRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if m.InclusiveEndMessageId != 0 {
-		i = encodeVarintRequestResponse(dAtA, i, uint64(m.InclusiveEndMessageId))
-		i--
-		dAtA[i] = 0x20
-	}
-	if len(m.SourceCluster) > 0 {
-		i -= len(m.SourceCluster)
-		copy(dAtA[i:], m.SourceCluster)
-		i = encodeVarintRequestResponse(dAtA, i, uint64(len(m.SourceCluster)))
-		i--
-		dAtA[i] = 0x1a
-	}
 	if m.ShardId != 0 {
 		i = encodeVarintRequestResponse(dAtA, i, uint64(m.ShardId))
 		i--
-		dAtA[i] = 0x10
-	}
-	if m.Type != 0 {
-		i = encodeVarintRequestResponse(dAtA, i, uint64(m.Type))
-		i--
 		dAtA[i] = 0x8
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *PurgeDLQMessagesResponse) Marshal() (dAtA []byte, err error) {
+func (m *CloseShardResponse) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -11047,12 +12053,12 @@ func (m *PurgeDLQMessagesResponse) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *PurgeDLQMessagesResponse) MarshalTo(dAtA []byte) (int, error) {
+func (m *CloseShardResponse) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *PurgeDLQMessagesResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *CloseShardResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
@@ -11060,7 +12066,7 @@ func (m *PurgeDLQMessagesResponse) MarshalToSizedBuffer(dAtA []byte) (int, error
 	return len(dAtA) - i, nil
 }
 
-func (m *MergeDLQMessagesRequest) Marshal() (dAtA []byte, err error) {
+func (m *RemoveTaskRequest) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -11070,54 +12076,45 @@ func (m *MergeDLQMessagesRequest) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *MergeDLQMessagesRequest) MarshalTo(dAtA []byte) (int, error) {
+func (m *RemoveTaskRequest) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *MergeDLQMessagesRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *RemoveTaskRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if len(m.NextPageToken) > 0 {
-		i -= len(m.NextPageToken)
-		copy(dAtA[i:], m.NextPageToken)
-		i = encodeVarintRequestResponse(dAtA, i, uint64(len(m.NextPageToken)))
-		i--
-		dAtA[i] = 0x32
-	}
-	if m.MaximumPageSize != 0 {
-		i = encodeVarintRequestResponse(dAtA, i, uint64(m.MaximumPageSize))
+	if m.VisibilityTime != nil {
+		n75, err75 := github_com_gogo_protobuf_types.StdTimeMarshalTo(*m.VisibilityTime, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(*m.VisibilityTime):])
+		if err75 != nil {
+			return 0, err75
+		}
+		i -= n75
+		i = encodeVarintRequestResponse(dAtA, i, uint64(n75))
 		i--
-		dAtA[i] = 0x28
+		dAtA[i] = 0x22
 	}
-	if m.InclusiveEndMessageId != 0 {
-		i = encodeVarintRequestResponse(dAtA, i, uint64(m.InclusiveEndMessageId))
+	if m.TaskId != 0 {
+		i = encodeVarintRequestResponse(dAtA, i, uint64(m.TaskId))
 		i--
-		dAtA[i] = 0x20
+		dAtA[i] = 0x18
 	}
-	if len(m.SourceCluster) > 0 {
-		i -= len(m.SourceCluster)
-		copy(dAtA[i:], m.SourceCluster)
-		i = encodeVarintRequestResponse(dAtA, i, uint64(len(m.SourceCluster)))
+	if m.Category != 0 {
+		i = encodeVarintRequestResponse(dAtA, i, uint64(m.Category))
 		i--
-		dAtA[i] = 0x1a
+		dAtA[i] = 0x10
 	}
 	if m.ShardId != 0 {
 		i = encodeVarintRequestResponse(dAtA, i, uint64(m.ShardId))
 		i--
-		dAtA[i] = 0x10
-	}
-	if m.Type != 0 {
-		i = encodeVarintRequestResponse(dAtA, i, uint64(m.Type))
-		i--
 		dAtA[i] = 0x8
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *MergeDLQMessagesResponse) Marshal() (dAtA []byte, err error) {
+func (m *RemoveTaskResponse) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -11127,27 +12124,20 @@ func (m *MergeDLQMessagesResponse) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *MergeDLQMessagesResponse) MarshalTo(dAtA []byte) (int, error) {
+func (m *RemoveTaskResponse) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *MergeDLQMessagesResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *RemoveTaskResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if len(m.NextPageToken) > 0 {
-		i -= len(m.NextPageToken)
-		copy(dAtA[i:], m.NextPageToken)
-		i = encodeVarintRequestResponse(dAtA, i, uint64(len(m.NextPageToken)))
-		i--
-		dAtA[i] = 0xa
-	}
 	return len(dAtA) - i, nil
 }
 
-func (m *RefreshWorkflowTasksRequest) Marshal() (dAtA []byte, err error) {
+func (m *GetReplicationMessagesRequest) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -11157,39 +12147,41 @@ func (m *RefreshWorkflowTasksRequest) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *RefreshWorkflowTasksRequest) MarshalTo(dAtA []byte) (int, error) {
+func (m *GetReplicationMessagesRequest) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *RefreshWorkflowTasksRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *GetReplicationMessagesRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if m.Request != nil {
-		{
-			size, err := m.Request.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintRequestResponse(dAtA, i, uint64(size))
-		}
+	if len(m.ClusterName) > 0 {
+		i -= len(m.ClusterName)
+		copy(dAtA[i:], m.ClusterName)
+		i = encodeVarintRequestResponse(dAtA, i, uint64(len(m.ClusterName)))
 		i--
 		dAtA[i] = 0x12
 	}
-	if len(m.NamespaceId) > 0 {
-		i -= len(m.NamespaceId)
-		copy(dAtA[i:], m.NamespaceId)
-		i = encodeVarintRequestResponse(dAtA, i, uint64(len(m.NamespaceId)))
-		i--
-		dAtA[i] = 0xa
+	if len(m.Tokens) > 0 {
+		for iNdEx := len(m.Tokens) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Tokens[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintRequestResponse(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *RefreshWorkflowTasksResponse) Marshal() (dAtA []byte, err error) {
+func (m *GetReplicationMessagesResponse) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -11199,813 +12191,1013 @@ func (m *RefreshWorkflowTasksResponse) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *RefreshWorkflowTasksResponse) MarshalTo(dAtA []byte) (int, error) {
+func (m *GetReplicationMessagesResponse) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *RefreshWorkflowTasksResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *GetReplicationMessagesResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
+	if len(m.ShardMessages) > 0 {
+		for k := range m.ShardMessages {
+			v := m.ShardMessages[k]
+			baseI := i
+			if v != nil {
+				{
+					size, err := v.MarshalToSizedBuffer(dAtA[:i])
+					if err != nil {
+						return 0, err
+					}
+					i -= size
+					i = encodeVarintRequestResponse(dAtA, i, uint64(size))
+				}
+				i--
+				dAtA[i] = 0x12
+			}
+			i = encodeVarintRequestResponse(dAtA, i, uint64(k))
+			i--
+			dAtA[i] = 0x8
+			i = encodeVarintRequestResponse(dAtA, i, uint64(baseI-i))
+			i--
+			dAtA[i] = 0xa
+		}
+	}
 	return len(dAtA) - i, nil
 }
 
-func encodeVarintRequestResponse(dAtA []byte, offset int, v uint64) int {
-	offset -= sovRequestResponse(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+func (m *GetDLQReplicationMessagesRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	dAtA[offset] = uint8(v)
-	return base
+	return dAtA[:n], nil
 }
-func (m *StartWorkflowExecutionRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+
+func (m *GetDLQReplicationMessagesRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *GetDLQReplicationMessagesRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.NamespaceId)
-	if l > 0 {
-		n += 1 + l + sovRequestResponse(uint64(l))
-	}
-	if m.StartRequest != nil {
-		l = m.StartRequest.Size()
-		n += 1 + l + sovRequestResponse(uint64(l))
-	}
-	if m.ParentExecutionInfo != nil {
-		l = m.ParentExecutionInfo.Size()
-		n += 1 + l + sovRequestResponse(uint64(l))
-	}
-	if m.Attempt != 0 {
-		n += 1 + sovRequestResponse(uint64(m.Attempt))
-	}
-	if m.WorkflowExecutionExpirationTime != nil {
-		l = github_com_gogo_protobuf_types.SizeOfStdTime(*m.WorkflowExecutionExpirationTime)
-		n += 1 + l + sovRequestResponse(uint64(l))
-	}
-	if m.ContinueAsNewInitiator != 0 {
-		n += 1 + sovRequestResponse(uint64(m.ContinueAsNewInitiator))
-	}
-	if m.ContinuedFailure != nil {
-		l = m.ContinuedFailure.Size()
-		n += 1 + l + sovRequestResponse(uint64(l))
-	}
-	if m.LastCompletionResult != nil {
-		l = m.LastCompletionResult.Size()
-		n += 1 + l + sovRequestResponse(uint64(l))
-	}
-	if m.FirstWorkflowTaskBackoff != nil {
-		l = github_com_gogo_protobuf_types.SizeOfStdDuration(*m.FirstWorkflowTaskBackoff)
-		n += 1 + l + sovRequestResponse(uint64(l))
+	if len(m.TaskInfos) > 0 {
+		for iNdEx := len(m.TaskInfos) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.TaskInfos[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintRequestResponse(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *StartWorkflowExecutionResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *GetDLQReplicationMessagesResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *GetDLQReplicationMessagesResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *GetDLQReplicationMessagesResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.RunId)
-	if l > 0 {
-		n += 1 + l + sovRequestResponse(uint64(l))
+	if len(m.ReplicationTasks) > 0 {
+		for iNdEx := len(m.ReplicationTasks) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.ReplicationTasks[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintRequestResponse(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *GetMutableStateRequest) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *QueryWorkflowRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryWorkflowRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryWorkflowRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.NamespaceId)
-	if l > 0 {
-		n += 1 + l + sovRequestResponse(uint64(l))
-	}
-	if m.Execution != nil {
-		l = m.Execution.Size()
-		n += 1 + l + sovRequestResponse(uint64(l))
-	}
-	if m.ExpectedNextEventId != 0 {
-		n += 1 + sovRequestResponse(uint64(m.ExpectedNextEventId))
+	if m.Request != nil {
+		{
+			size, err := m.Request.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintRequestResponse(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
 	}
-	l = len(m.CurrentBranchToken)
-	if l > 0 {
-		n += 1 + l + sovRequestResponse(uint64(l))
+	if len(m.NamespaceId) > 0 {
+		i -= len(m.NamespaceId)
+		copy(dAtA[i:], m.NamespaceId)
+		i = encodeVarintRequestResponse(dAtA, i, uint64(len(m.NamespaceId)))
+		i--
+		dAtA[i] = 0xa
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *GetMutableStateResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *QueryWorkflowResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryWorkflowResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryWorkflowResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if m.Execution != nil {
-		l = m.Execution.Size()
-		n += 1 + l + sovRequestResponse(uint64(l))
-	}
-	if m.WorkflowType != nil {
-		l = m.WorkflowType.Size()
-		n += 1 + l + sovRequestResponse(uint64(l))
-	}
-	if m.NextEventId != 0 {
-		n += 1 + sovRequestResponse(uint64(m.NextEventId))
-	}
-	if m.PreviousStartedEventId != 0 {
-		n += 1 + sovRequestResponse(uint64(m.PreviousStartedEventId))
-	}
-	if m.LastFirstEventId != 0 {
-		n += 1 + sovRequestResponse(uint64(m.LastFirstEventId))
-	}
-	if m.TaskQueue != nil {
-		l = m.TaskQueue.Size()
-		n += 1 + l + sovRequestResponse(uint64(l))
+	if m.Response != nil {
+		{
+			size, err := m.Response.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintRequestResponse(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
 	}
-	if m.StickyTaskQueue != nil {
-		l = m.StickyTaskQueue.Size()
-		n += 1 + l + sovRequestResponse(uint64(l))
+	return len(dAtA) - i, nil
+}
+
+func (m *ReapplyEventsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	if m.StickyTaskQueueScheduleToStartTimeout != nil {
-		l = github_com_gogo_protobuf_types.SizeOfStdDuration(*m.StickyTaskQueueScheduleToStartTimeout)
-		n += 1 + l + sovRequestResponse(uint64(l))
-	}
-	if m.EventStoreVersion != 0 {
-		n += 1 + sovRequestResponse(uint64(m.EventStoreVersion))
-	}
-	l = len(m.CurrentBranchToken)
-	if l > 0 {
-		n += 1 + l + sovRequestResponse(uint64(l))
-	}
-	if m.WorkflowState != 0 {
-		n += 1 + sovRequestResponse(uint64(m.WorkflowState))
-	}
-	if m.WorkflowStatus != 0 {
-		n += 2 + sovRequestResponse(uint64(m.WorkflowStatus))
-	}
-	if m.VersionHistories != nil {
-		l = m.VersionHistories.Size()
-		n += 2 + l + sovRequestResponse(uint64(l))
-	}
-	if m.IsStickyTaskQueueEnabled {
-		n += 3
-	}
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *PollMutableStateRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.NamespaceId)
-	if l > 0 {
-		n += 1 + l + sovRequestResponse(uint64(l))
-	}
-	if m.Execution != nil {
-		l = m.Execution.Size()
-		n += 1 + l + sovRequestResponse(uint64(l))
-	}
-	if m.ExpectedNextEventId != 0 {
-		n += 1 + sovRequestResponse(uint64(m.ExpectedNextEventId))
-	}
-	l = len(m.CurrentBranchToken)
-	if l > 0 {
-		n += 1 + l + sovRequestResponse(uint64(l))
-	}
-	return n
+func (m *ReapplyEventsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *PollMutableStateResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *ReapplyEventsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if m.Execution != nil {
-		l = m.Execution.Size()
-		n += 1 + l + sovRequestResponse(uint64(l))
-	}
-	if m.WorkflowType != nil {
-		l = m.WorkflowType.Size()
-		n += 1 + l + sovRequestResponse(uint64(l))
-	}
-	if m.NextEventId != 0 {
-		n += 1 + sovRequestResponse(uint64(m.NextEventId))
-	}
-	if m.PreviousStartedEventId != 0 {
-		n += 1 + sovRequestResponse(uint64(m.PreviousStartedEventId))
-	}
-	if m.LastFirstEventId != 0 {
-		n += 1 + sovRequestResponse(uint64(m.LastFirstEventId))
-	}
-	if m.TaskQueue != nil {
-		l = m.TaskQueue.Size()
-		n += 1 + l + sovRequestResponse(uint64(l))
-	}
-	if m.StickyTaskQueue != nil {
-		l = m.StickyTaskQueue.Size()
-		n += 1 + l + sovRequestResponse(uint64(l))
-	}
-	if m.StickyTaskQueueScheduleToStartTimeout != nil {
-		l = github_com_gogo_protobuf_types.SizeOfStdDuration(*m.StickyTaskQueueScheduleToStartTimeout)
-		n += 1 + l + sovRequestResponse(uint64(l))
-	}
-	l = len(m.CurrentBranchToken)
-	if l > 0 {
-		n += 1 + l + sovRequestResponse(uint64(l))
-	}
-	if m.VersionHistories != nil {
-		l = m.VersionHistories.Size()
-		n += 1 + l + sovRequestResponse(uint64(l))
-	}
-	if m.WorkflowState != 0 {
-		n += 1 + sovRequestResponse(uint64(m.WorkflowState))
+	if m.Request != nil {
+		{
+			size, err := m.Request.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintRequestResponse(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
 	}
-	if m.WorkflowStatus != 0 {
-		n += 2 + sovRequestResponse(uint64(m.WorkflowStatus))
+	if len(m.NamespaceId) > 0 {
+		i -= len(m.NamespaceId)
+		copy(dAtA[i:], m.NamespaceId)
+		i = encodeVarintRequestResponse(dAtA, i, uint64(len(m.NamespaceId)))
+		i--
+		dAtA[i] = 0xa
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *ResetStickyTaskQueueRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.NamespaceId)
-	if l > 0 {
-		n += 1 + l + sovRequestResponse(uint64(l))
-	}
-	if m.Execution != nil {
-		l = m.Execution.Size()
-		n += 1 + l + sovRequestResponse(uint64(l))
+func (m *ReapplyEventsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *ResetStickyTaskQueueResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *ReapplyEventsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ReapplyEventsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *RecordWorkflowTaskStartedRequest) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *GetDLQMessagesRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *GetDLQMessagesRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *GetDLQMessagesRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.NamespaceId)
-	if l > 0 {
-		n += 1 + l + sovRequestResponse(uint64(l))
+	if len(m.NextPageToken) > 0 {
+		i -= len(m.NextPageToken)
+		copy(dAtA[i:], m.NextPageToken)
+		i = encodeVarintRequestResponse(dAtA, i, uint64(len(m.NextPageToken)))
+		i--
+		dAtA[i] = 0x32
 	}
-	if m.WorkflowExecution != nil {
-		l = m.WorkflowExecution.Size()
-		n += 1 + l + sovRequestResponse(uint64(l))
+	if m.MaximumPageSize != 0 {
+		i = encodeVarintRequestResponse(dAtA, i, uint64(m.MaximumPageSize))
+		i--
+		dAtA[i] = 0x28
 	}
-	if m.ScheduleId != 0 {
-		n += 1 + sovRequestResponse(uint64(m.ScheduleId))
+	if m.InclusiveEndMessageId != 0 {
+		i = encodeVarintRequestResponse(dAtA, i, uint64(m.InclusiveEndMessageId))
+		i--
+		dAtA[i] = 0x20
 	}
-	if m.TaskId != 0 {
-		n += 1 + sovRequestResponse(uint64(m.TaskId))
+	if len(m.SourceCluster) > 0 {
+		i -= len(m.SourceCluster)
+		copy(dAtA[i:], m.SourceCluster)
+		i = encodeVarintRequestResponse(dAtA, i, uint64(len(m.SourceCluster)))
+		i--
+		dAtA[i] = 0x1a
 	}
-	l = len(m.RequestId)
-	if l > 0 {
-		n += 1 + l + sovRequestResponse(uint64(l))
+	if m.ShardId != 0 {
+		i = encodeVarintRequestResponse(dAtA, i, uint64(m.ShardId))
+		i--
+		dAtA[i] = 0x10
 	}
-	if m.PollRequest != nil {
-		l = m.PollRequest.Size()
-		n += 1 + l + sovRequestResponse(uint64(l))
+	if m.Type != 0 {
+		i = encodeVarintRequestResponse(dAtA, i, uint64(m.Type))
+		i--
+		dAtA[i] = 0x8
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *RecordWorkflowTaskStartedResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *GetDLQMessagesResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *GetDLQMessagesResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *GetDLQMessagesResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if m.WorkflowType != nil {
-		l = m.WorkflowType.Size()
-		n += 1 + l + sovRequestResponse(uint64(l))
-	}
-	if m.PreviousStartedEventId != 0 {
-		n += 1 + sovRequestResponse(uint64(m.PreviousStartedEventId))
-	}
-	if m.ScheduledEventId != 0 {
-		n += 1 + sovRequestResponse(uint64(m.ScheduledEventId))
+	if len(m.NextPageToken) > 0 {
+		i -= len(m.NextPageToken)
+		copy(dAtA[i:], m.NextPageToken)
+		i = encodeVarintRequestResponse(dAtA, i, uint64(len(m.NextPageToken)))
+		i--
+		dAtA[i] = 0x1a
 	}
-	if m.StartedEventId != 0 {
-		n += 1 + sovRequestResponse(uint64(m.StartedEventId))
-	}
-	if m.NextEventId != 0 {
-		n += 1 + sovRequestResponse(uint64(m.NextEventId))
-	}
-	if m.Attempt != 0 {
-		n += 1 + sovRequestResponse(uint64(m.Attempt))
-	}
-	if m.StickyExecutionEnabled {
-		n += 2
-	}
-	if m.WorkflowTaskInfo != nil {
-		l = m.WorkflowTaskInfo.Size()
-		n += 1 + l + sovRequestResponse(uint64(l))
-	}
-	if m.WorkflowExecutionTaskQueue != nil {
-		l = m.WorkflowExecutionTaskQueue.Size()
-		n += 1 + l + sovRequestResponse(uint64(l))
-	}
-	if m.EventStoreVersion != 0 {
-		n += 1 + sovRequestResponse(uint64(m.EventStoreVersion))
-	}
-	l = len(m.BranchToken)
-	if l > 0 {
-		n += 1 + l + sovRequestResponse(uint64(l))
-	}
-	if m.ScheduledTime != nil {
-		l = github_com_gogo_protobuf_types.SizeOfStdTime(*m.ScheduledTime)
-		n += 1 + l + sovRequestResponse(uint64(l))
-	}
-	if m.StartedTime != nil {
-		l = github_com_gogo_protobuf_types.SizeOfStdTime(*m.StartedTime)
-		n += 1 + l + sovRequestResponse(uint64(l))
-	}
-	if len(m.Queries) > 0 {
-		for k, v := range m.Queries {
-			_ = k
-			_ = v
-			l = 0
-			if v != nil {
-				l = v.Size()
-				l += 1 + sovRequestResponse(uint64(l))
+	if len(m.ReplicationTasks) > 0 {
+		for iNdEx := len(m.ReplicationTasks) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.ReplicationTasks[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintRequestResponse(dAtA, i, uint64(size))
 			}
-			mapEntrySize := 1 + len(k) + sovRequestResponse(uint64(len(k))) + l
-			n += mapEntrySize + 1 + sovRequestResponse(uint64(mapEntrySize))
+			i--
+			dAtA[i] = 0x12
 		}
 	}
-	return n
+	if m.Type != 0 {
+		i = encodeVarintRequestResponse(dAtA, i, uint64(m.Type))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
 }
 
-func (m *RecordActivityTaskStartedRequest) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *PurgeDLQMessagesRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *PurgeDLQMessagesRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *PurgeDLQMessagesRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.NamespaceId)
-	if l > 0 {
-		n += 1 + l + sovRequestResponse(uint64(l))
-	}
-	if m.WorkflowExecution != nil {
-		l = m.WorkflowExecution.Size()
-		n += 1 + l + sovRequestResponse(uint64(l))
-	}
-	if m.ScheduleId != 0 {
-		n += 1 + sovRequestResponse(uint64(m.ScheduleId))
+	if m.InclusiveEndMessageId != 0 {
+		i = encodeVarintRequestResponse(dAtA, i, uint64(m.InclusiveEndMessageId))
+		i--
+		dAtA[i] = 0x20
 	}
-	if m.TaskId != 0 {
-		n += 1 + sovRequestResponse(uint64(m.TaskId))
+	if len(m.SourceCluster) > 0 {
+		i -= len(m.SourceCluster)
+		copy(dAtA[i:], m.SourceCluster)
+		i = encodeVarintRequestResponse(dAtA, i, uint64(len(m.SourceCluster)))
+		i--
+		dAtA[i] = 0x1a
 	}
-	l = len(m.RequestId)
-	if l > 0 {
-		n += 1 + l + sovRequestResponse(uint64(l))
+	if m.ShardId != 0 {
+		i = encodeVarintRequestResponse(dAtA, i, uint64(m.ShardId))
+		i--
+		dAtA[i] = 0x10
 	}
-	if m.PollRequest != nil {
-		l = m.PollRequest.Size()
-		n += 1 + l + sovRequestResponse(uint64(l))
+	if m.Type != 0 {
+		i = encodeVarintRequestResponse(dAtA, i, uint64(m.Type))
+		i--
+		dAtA[i] = 0x8
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *RecordActivityTaskStartedResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *PurgeDLQMessagesResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *PurgeDLQMessagesResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *PurgeDLQMessagesResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if m.ScheduledEvent != nil {
-		l = m.ScheduledEvent.Size()
-		n += 1 + l + sovRequestResponse(uint64(l))
+	return len(dAtA) - i, nil
+}
+
+func (m *MergeDLQMessagesRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	if m.StartedTime != nil {
-		l = github_com_gogo_protobuf_types.SizeOfStdTime(*m.StartedTime)
-		n += 1 + l + sovRequestResponse(uint64(l))
+	return dAtA[:n], nil
+}
+
+func (m *MergeDLQMessagesRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MergeDLQMessagesRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.NextPageToken) > 0 {
+		i -= len(m.NextPageToken)
+		copy(dAtA[i:], m.NextPageToken)
+		i = encodeVarintRequestResponse(dAtA, i, uint64(len(m.NextPageToken)))
+		i--
+		dAtA[i] = 0x32
 	}
-	if m.Attempt != 0 {
-		n += 1 + sovRequestResponse(uint64(m.Attempt))
+	if m.MaximumPageSize != 0 {
+		i = encodeVarintRequestResponse(dAtA, i, uint64(m.MaximumPageSize))
+		i--
+		dAtA[i] = 0x28
 	}
-	if m.CurrentAttemptScheduledTime != nil {
-		l = github_com_gogo_protobuf_types.SizeOfStdTime(*m.CurrentAttemptScheduledTime)
-		n += 1 + l + sovRequestResponse(uint64(l))
+	if m.InclusiveEndMessageId != 0 {
+		i = encodeVarintRequestResponse(dAtA, i, uint64(m.InclusiveEndMessageId))
+		i--
+		dAtA[i] = 0x20
 	}
-	if m.HeartbeatDetails != nil {
-		l = m.HeartbeatDetails.Size()
-		n += 1 + l + sovRequestResponse(uint64(l))
+	if len(m.SourceCluster) > 0 {
+		i -= len(m.SourceCluster)
+		copy(dAtA[i:], m.SourceCluster)
+		i = encodeVarintRequestResponse(dAtA, i, uint64(len(m.SourceCluster)))
+		i--
+		dAtA[i] = 0x1a
 	}
-	if m.WorkflowType != nil {
-		l = m.WorkflowType.Size()
-		n += 1 + l + sovRequestResponse(uint64(l))
+	if m.ShardId != 0 {
+		i = encodeVarintRequestResponse(dAtA, i, uint64(m.ShardId))
+		i--
+		dAtA[i] = 0x10
 	}
-	l = len(m.WorkflowNamespace)
-	if l > 0 {
-		n += 1 + l + sovRequestResponse(uint64(l))
+	if m.Type != 0 {
+		i = encodeVarintRequestResponse(dAtA, i, uint64(m.Type))
+		i--
+		dAtA[i] = 0x8
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *RespondWorkflowTaskCompletedRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.NamespaceId)
-	if l > 0 {
-		n += 1 + l + sovRequestResponse(uint64(l))
-	}
-	if m.CompleteRequest != nil {
-		l = m.CompleteRequest.Size()
-		n += 1 + l + sovRequestResponse(uint64(l))
+func (m *MergeDLQMessagesResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *RespondWorkflowTaskCompletedResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *MergeDLQMessagesResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MergeDLQMessagesResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if m.StartedResponse != nil {
-		l = m.StartedResponse.Size()
-		n += 1 + l + sovRequestResponse(uint64(l))
+	if len(m.NextPageToken) > 0 {
+		i -= len(m.NextPageToken)
+		copy(dAtA[i:], m.NextPageToken)
+		i = encodeVarintRequestResponse(dAtA, i, uint64(len(m.NextPageToken)))
+		i--
+		dAtA[i] = 0xa
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *RespondWorkflowTaskFailedRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.NamespaceId)
-	if l > 0 {
-		n += 1 + l + sovRequestResponse(uint64(l))
-	}
-	if m.FailedRequest != nil {
-		l = m.FailedRequest.Size()
-		n += 1 + l + sovRequestResponse(uint64(l))
+func (m *RefreshWorkflowTasksRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *RespondWorkflowTaskFailedResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	return n
+func (m *RefreshWorkflowTasksRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *RecordActivityTaskHeartbeatRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *RefreshWorkflowTasksRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.NamespaceId)
-	if l > 0 {
-		n += 1 + l + sovRequestResponse(uint64(l))
+	if m.Request != nil {
+		{
+			size, err := m.Request.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintRequestResponse(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
 	}
-	if m.HeartbeatRequest != nil {
-		l = m.HeartbeatRequest.Size()
-		n += 1 + l + sovRequestResponse(uint64(l))
+	if len(m.NamespaceId) > 0 {
+		i -= len(m.NamespaceId)
+		copy(dAtA[i:], m.NamespaceId)
+		i = encodeVarintRequestResponse(dAtA, i, uint64(len(m.NamespaceId)))
+		i--
+		dAtA[i] = 0xa
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *RecordActivityTaskHeartbeatResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.CancelRequested {
-		n += 2
+func (m *RefreshWorkflowTasksResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *RespondActivityTaskCompletedRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.NamespaceId)
-	if l > 0 {
-		n += 1 + l + sovRequestResponse(uint64(l))
-	}
-	if m.CompleteRequest != nil {
-		l = m.CompleteRequest.Size()
-		n += 1 + l + sovRequestResponse(uint64(l))
-	}
-	return n
+func (m *RefreshWorkflowTasksResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *RespondActivityTaskCompletedResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *RefreshWorkflowTasksResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *RespondActivityTaskFailedRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.NamespaceId)
-	if l > 0 {
-		n += 1 + l + sovRequestResponse(uint64(l))
-	}
-	if m.FailedRequest != nil {
-		l = m.FailedRequest.Size()
-		n += 1 + l + sovRequestResponse(uint64(l))
+func (m *DeleteWorkflowExecutionRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *RespondActivityTaskFailedResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	return n
+func (m *DeleteWorkflowExecutionRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *RespondActivityTaskCanceledRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *DeleteWorkflowExecutionRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.NamespaceId)
-	if l > 0 {
-		n += 1 + l + sovRequestResponse(uint64(l))
+	if len(m.Identity) > 0 {
+		i -= len(m.Identity)
+		copy(dAtA[i:], m.Identity)
+		i = encodeVarintRequestResponse(dAtA, i, uint64(len(m.Identity)))
+		i--
+		dAtA[i] = 0x1a
 	}
-	if m.CancelRequest != nil {
-		l = m.CancelRequest.Size()
-		n += 1 + l + sovRequestResponse(uint64(l))
+	if m.WorkflowExecution != nil {
+		{
+			size, err := m.WorkflowExecution.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintRequestResponse(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
 	}
-	return n
+	if len(m.NamespaceId) > 0 {
+		i -= len(m.NamespaceId)
+		copy(dAtA[i:], m.NamespaceId)
+		i = encodeVarintRequestResponse(dAtA, i, uint64(len(m.NamespaceId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
 }
 
-func (m *RespondActivityTaskCanceledResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *DeleteWorkflowExecutionResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *SignalWorkflowExecutionRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.NamespaceId)
-	if l > 0 {
-		n += 1 + l + sovRequestResponse(uint64(l))
-	}
-	if m.SignalRequest != nil {
-		l = m.SignalRequest.Size()
-		n += 1 + l + sovRequestResponse(uint64(l))
-	}
-	if m.ExternalWorkflowExecution != nil {
-		l = m.ExternalWorkflowExecution.Size()
-		n += 1 + l + sovRequestResponse(uint64(l))
-	}
-	if m.ChildWorkflowOnly {
-		n += 2
-	}
-	return n
+func (m *DeleteWorkflowExecutionResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *SignalWorkflowExecutionResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *DeleteWorkflowExecutionResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *SignalWithStartWorkflowExecutionRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.NamespaceId)
-	if l > 0 {
-		n += 1 + l + sovRequestResponse(uint64(l))
-	}
-	if m.SignalWithStartRequest != nil {
-		l = m.SignalWithStartRequest.Size()
-		n += 1 + l + sovRequestResponse(uint64(l))
+func (m *UpdateWorkflowExecutionRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *SignalWithStartWorkflowExecutionResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.RunId)
-	if l > 0 {
-		n += 1 + l + sovRequestResponse(uint64(l))
-	}
-	return n
+func (m *UpdateWorkflowExecutionRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *RemoveSignalMutableStateRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *UpdateWorkflowExecutionRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.NamespaceId)
-	if l > 0 {
-		n += 1 + l + sovRequestResponse(uint64(l))
-	}
-	if m.WorkflowExecution != nil {
-		l = m.WorkflowExecution.Size()
-		n += 1 + l + sovRequestResponse(uint64(l))
+	if m.WaitForAccepted {
+		i--
+		if m.WaitForAccepted {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x38
 	}
-	l = len(m.RequestId)
-	if l > 0 {
-		n += 1 + l + sovRequestResponse(uint64(l))
+	if len(m.Identity) > 0 {
+		i -= len(m.Identity)
+		copy(dAtA[i:], m.Identity)
+		i = encodeVarintRequestResponse(dAtA, i, uint64(len(m.Identity)))
+		i--
+		dAtA[i] = 0x32
 	}
-	return n
+	if m.Input != nil {
+		{
+			size, err := m.Input.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintRequestResponse(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x2a
+	}
+	if len(m.Name) > 0 {
+		i -= len(m.Name)
+		copy(dAtA[i:], m.Name)
+		i = encodeVarintRequestResponse(dAtA, i, uint64(len(m.Name)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.UpdateId) > 0 {
+		i -= len(m.UpdateId)
+		copy(dAtA[i:], m.UpdateId)
+		i = encodeVarintRequestResponse(dAtA, i, uint64(len(m.UpdateId)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.WorkflowExecution != nil {
+		{
+			size, err := m.WorkflowExecution.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintRequestResponse(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.NamespaceId) > 0 {
+		i -= len(m.NamespaceId)
+		copy(dAtA[i:], m.NamespaceId)
+		i = encodeVarintRequestResponse(dAtA, i, uint64(len(m.NamespaceId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
 }
 
-func (m *RemoveSignalMutableStateResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *UpdateWorkflowExecutionResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *TerminateWorkflowExecutionRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *UpdateWorkflowExecutionResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *UpdateWorkflowExecutionResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.NamespaceId)
-	if l > 0 {
-		n += 1 + l + sovRequestResponse(uint64(l))
+	if m.Failure != nil {
+		{
+			size, err := m.Failure.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintRequestResponse(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1a
 	}
-	if m.TerminateRequest != nil {
-		l = m.TerminateRequest.Size()
-		n += 1 + l + sovRequestResponse(uint64(l))
+	if m.Result != nil {
+		{
+			size, err := m.Result.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintRequestResponse(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
 	}
-	return n
+	if m.Completed {
+		i--
+		if m.Completed {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
 }
 
-func (m *TerminateWorkflowExecutionResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *AcquireShardRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *ResetWorkflowExecutionRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *AcquireShardRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *AcquireShardRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.NamespaceId)
-	if l > 0 {
-		n += 1 + l + sovRequestResponse(uint64(l))
-	}
-	if m.ResetRequest != nil {
-		l = m.ResetRequest.Size()
-		n += 1 + l + sovRequestResponse(uint64(l))
+	if m.ShardId != 0 {
+		i = encodeVarintRequestResponse(dAtA, i, uint64(m.ShardId))
+		i--
+		dAtA[i] = 0x8
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *ResetWorkflowExecutionResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *AcquireShardResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *AcquireShardResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *AcquireShardResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.RunId)
-	if l > 0 {
-		n += 1 + l + sovRequestResponse(uint64(l))
-	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *RequestCancelWorkflowExecutionRequest) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *PauseWorkflowExecutionRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *PauseWorkflowExecutionRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *PauseWorkflowExecutionRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.NamespaceId)
-	if l > 0 {
-		n += 1 + l + sovRequestResponse(uint64(l))
-	}
-	if m.CancelRequest != nil {
-		l = m.CancelRequest.Size()
-		n += 1 + l + sovRequestResponse(uint64(l))
+	if len(m.Reason) > 0 {
+		i -= len(m.Reason)
+		copy(dAtA[i:], m.Reason)
+		i = encodeVarintRequestResponse(dAtA, i, uint64(len(m.Reason)))
+		i--
+		dAtA[i] = 0x22
 	}
-	if m.ExternalInitiatedEventId != 0 {
-		n += 1 + sovRequestResponse(uint64(m.ExternalInitiatedEventId))
+	if len(m.Identity) > 0 {
+		i -= len(m.Identity)
+		copy(dAtA[i:], m.Identity)
+		i = encodeVarintRequestResponse(dAtA, i, uint64(len(m.Identity)))
+		i--
+		dAtA[i] = 0x1a
 	}
-	if m.ExternalWorkflowExecution != nil {
-		l = m.ExternalWorkflowExecution.Size()
-		n += 1 + l + sovRequestResponse(uint64(l))
+	if m.WorkflowExecution != nil {
+		{
+			size, err := m.WorkflowExecution.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintRequestResponse(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
 	}
-	if m.ChildWorkflowOnly {
-		n += 2
+	if len(m.NamespaceId) > 0 {
+		i -= len(m.NamespaceId)
+		copy(dAtA[i:], m.NamespaceId)
+		i = encodeVarintRequestResponse(dAtA, i, uint64(len(m.NamespaceId)))
+		i--
+		dAtA[i] = 0xa
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *RequestCancelWorkflowExecutionResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *PauseWorkflowExecutionResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *PauseWorkflowExecutionResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *PauseWorkflowExecutionResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *ScheduleWorkflowTaskRequest) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *UnpauseWorkflowExecutionRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *UnpauseWorkflowExecutionRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *UnpauseWorkflowExecutionRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.NamespaceId)
-	if l > 0 {
-		n += 1 + l + sovRequestResponse(uint64(l))
+	if len(m.Identity) > 0 {
+		i -= len(m.Identity)
+		copy(dAtA[i:], m.Identity)
+		i = encodeVarintRequestResponse(dAtA, i, uint64(len(m.Identity)))
+		i--
+		dAtA[i] = 0x1a
 	}
 	if m.WorkflowExecution != nil {
-		l = m.WorkflowExecution.Size()
-		n += 1 + l + sovRequestResponse(uint64(l))
+		{
+			size, err := m.WorkflowExecution.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintRequestResponse(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
 	}
-	if m.IsFirstWorkflowTask {
-		n += 2
+	if len(m.NamespaceId) > 0 {
+		i -= len(m.NamespaceId)
+		copy(dAtA[i:], m.NamespaceId)
+		i = encodeVarintRequestResponse(dAtA, i, uint64(len(m.NamespaceId)))
+		i--
+		dAtA[i] = 0xa
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *ScheduleWorkflowTaskResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *UnpauseWorkflowExecutionResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *UnpauseWorkflowExecutionResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *UnpauseWorkflowExecutionResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *RecordChildExecutionCompletedRequest) Size() (n int) {
+func encodeVarintRequestResponse(dAtA []byte, offset int, v uint64) int {
+	offset -= sovRequestResponse(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *StartWorkflowExecutionRequest) Size() (n int) {
 	if m == nil {
 		return 0
 	}
@@ -12015,34 +13207,53 @@ func (m *RecordChildExecutionCompletedRequest) Size() (n int) {
 	if l > 0 {
 		n += 1 + l + sovRequestResponse(uint64(l))
 	}
-	if m.WorkflowExecution != nil {
-		l = m.WorkflowExecution.Size()
+	if m.StartRequest != nil {
+		l = m.StartRequest.Size()
 		n += 1 + l + sovRequestResponse(uint64(l))
 	}
-	if m.InitiatedId != 0 {
-		n += 1 + sovRequestResponse(uint64(m.InitiatedId))
+	if m.ParentExecutionInfo != nil {
+		l = m.ParentExecutionInfo.Size()
+		n += 1 + l + sovRequestResponse(uint64(l))
 	}
-	if m.CompletedExecution != nil {
-		l = m.CompletedExecution.Size()
+	if m.Attempt != 0 {
+		n += 1 + sovRequestResponse(uint64(m.Attempt))
+	}
+	if m.WorkflowExecutionExpirationTime != nil {
+		l = github_com_gogo_protobuf_types.SizeOfStdTime(*m.WorkflowExecutionExpirationTime)
 		n += 1 + l + sovRequestResponse(uint64(l))
 	}
-	if m.CompletionEvent != nil {
-		l = m.CompletionEvent.Size()
+	if m.ContinueAsNewInitiator != 0 {
+		n += 1 + sovRequestResponse(uint64(m.ContinueAsNewInitiator))
+	}
+	if m.ContinuedFailure != nil {
+		l = m.ContinuedFailure.Size()
+		n += 1 + l + sovRequestResponse(uint64(l))
+	}
+	if m.LastCompletionResult != nil {
+		l = m.LastCompletionResult.Size()
+		n += 1 + l + sovRequestResponse(uint64(l))
+	}
+	if m.FirstWorkflowTaskBackoff != nil {
+		l = github_com_gogo_protobuf_types.SizeOfStdDuration(*m.FirstWorkflowTaskBackoff)
 		n += 1 + l + sovRequestResponse(uint64(l))
 	}
 	return n
 }
 
-func (m *RecordChildExecutionCompletedResponse) Size() (n int) {
+func (m *StartWorkflowExecutionResponse) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
+	l = len(m.RunId)
+	if l > 0 {
+		n += 1 + l + sovRequestResponse(uint64(l))
+	}
 	return n
 }
 
-func (m *DescribeWorkflowExecutionRequest) Size() (n int) {
+func (m *GetMutableStateRequest) Size() (n int) {
 	if m == nil {
 		return 0
 	}
@@ -12052,43 +13263,85 @@ func (m *DescribeWorkflowExecutionRequest) Size() (n int) {
 	if l > 0 {
 		n += 1 + l + sovRequestResponse(uint64(l))
 	}
-	if m.Request != nil {
-		l = m.Request.Size()
+	if m.Execution != nil {
+		l = m.Execution.Size()
+		n += 1 + l + sovRequestResponse(uint64(l))
+	}
+	if m.ExpectedNextEventId != 0 {
+		n += 1 + sovRequestResponse(uint64(m.ExpectedNextEventId))
+	}
+	l = len(m.CurrentBranchToken)
+	if l > 0 {
 		n += 1 + l + sovRequestResponse(uint64(l))
 	}
 	return n
 }
 
-func (m *DescribeWorkflowExecutionResponse) Size() (n int) {
+func (m *GetMutableStateResponse) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
-	if m.ExecutionConfig != nil {
-		l = m.ExecutionConfig.Size()
+	if m.Execution != nil {
+		l = m.Execution.Size()
 		n += 1 + l + sovRequestResponse(uint64(l))
 	}
-	if m.WorkflowExecutionInfo != nil {
-		l = m.WorkflowExecutionInfo.Size()
+	if m.WorkflowType != nil {
+		l = m.WorkflowType.Size()
 		n += 1 + l + sovRequestResponse(uint64(l))
 	}
-	if len(m.PendingActivities) > 0 {
-		for _, e := range m.PendingActivities {
-			l = e.Size()
-			n += 1 + l + sovRequestResponse(uint64(l))
-		}
+	if m.NextEventId != 0 {
+		n += 1 + sovRequestResponse(uint64(m.NextEventId))
 	}
-	if len(m.PendingChildren) > 0 {
-		for _, e := range m.PendingChildren {
-			l = e.Size()
-			n += 1 + l + sovRequestResponse(uint64(l))
-		}
+	if m.PreviousStartedEventId != 0 {
+		n += 1 + sovRequestResponse(uint64(m.PreviousStartedEventId))
+	}
+	if m.LastFirstEventId != 0 {
+		n += 1 + sovRequestResponse(uint64(m.LastFirstEventId))
+	}
+	if m.TaskQueue != nil {
+		l = m.TaskQueue.Size()
+		n += 1 + l + sovRequestResponse(uint64(l))
+	}
+	if m.StickyTaskQueue != nil {
+		l = m.StickyTaskQueue.Size()
+		n += 1 + l + sovRequestResponse(uint64(l))
+	}
+	if m.StickyTaskQueueScheduleToStartTimeout != nil {
+		l = github_com_gogo_protobuf_types.SizeOfStdDuration(*m.StickyTaskQueueScheduleToStartTimeout)
+		n += 1 + l + sovRequestResponse(uint64(l))
+	}
+	if m.EventStoreVersion != 0 {
+		n += 1 + sovRequestResponse(uint64(m.EventStoreVersion))
+	}
+	l = len(m.CurrentBranchToken)
+	if l > 0 {
+		n += 1 + l + sovRequestResponse(uint64(l))
+	}
+	if m.WorkflowState != 0 {
+		n += 1 + sovRequestResponse(uint64(m.WorkflowState))
+	}
+	if m.WorkflowStatus != 0 {
+		n += 2 + sovRequestResponse(uint64(m.WorkflowStatus))
+	}
+	if m.VersionHistories != nil {
+		l = m.VersionHistories.Size()
+		n += 2 + l + sovRequestResponse(uint64(l))
+	}
+	if m.IsStickyTaskQueueEnabled {
+		n += 3
+	}
+	if m.HistorySizeBytes != 0 {
+		n += 2 + sovRequestResponse(uint64(m.HistorySizeBytes))
+	}
+	if m.SuggestContinueAsNew {
+		n += 3
 	}
 	return n
 }
 
-func (m *ReplicateEventsV2Request) Size() (n int) {
+func (m *PollMutableStateRequest) Size() (n int) {
 	if m == nil {
 		return 0
 	}
@@ -12098,57 +13351,90 @@ func (m *ReplicateEventsV2Request) Size() (n int) {
 	if l > 0 {
 		n += 1 + l + sovRequestResponse(uint64(l))
 	}
-	if m.WorkflowExecution != nil {
-		l = m.WorkflowExecution.Size()
+	if m.Execution != nil {
+		l = m.Execution.Size()
 		n += 1 + l + sovRequestResponse(uint64(l))
 	}
-	if len(m.VersionHistoryItems) > 0 {
-		for _, e := range m.VersionHistoryItems {
-			l = e.Size()
-			n += 1 + l + sovRequestResponse(uint64(l))
-		}
-	}
-	if m.Events != nil {
-		l = m.Events.Size()
-		n += 1 + l + sovRequestResponse(uint64(l))
+	if m.ExpectedNextEventId != 0 {
+		n += 1 + sovRequestResponse(uint64(m.ExpectedNextEventId))
 	}
-	if m.NewRunEvents != nil {
-		l = m.NewRunEvents.Size()
+	l = len(m.CurrentBranchToken)
+	if l > 0 {
 		n += 1 + l + sovRequestResponse(uint64(l))
 	}
 	return n
 }
 
-func (m *ReplicateEventsV2Response) Size() (n int) {
+func (m *PollMutableStateResponse) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
+	if m.Execution != nil {
+		l = m.Execution.Size()
+		n += 1 + l + sovRequestResponse(uint64(l))
+	}
+	if m.WorkflowType != nil {
+		l = m.WorkflowType.Size()
+		n += 1 + l + sovRequestResponse(uint64(l))
+	}
+	if m.NextEventId != 0 {
+		n += 1 + sovRequestResponse(uint64(m.NextEventId))
+	}
+	if m.PreviousStartedEventId != 0 {
+		n += 1 + sovRequestResponse(uint64(m.PreviousStartedEventId))
+	}
+	if m.LastFirstEventId != 0 {
+		n += 1 + sovRequestResponse(uint64(m.LastFirstEventId))
+	}
+	if m.TaskQueue != nil {
+		l = m.TaskQueue.Size()
+		n += 1 + l + sovRequestResponse(uint64(l))
+	}
+	if m.StickyTaskQueue != nil {
+		l = m.StickyTaskQueue.Size()
+		n += 1 + l + sovRequestResponse(uint64(l))
+	}
+	if m.StickyTaskQueueScheduleToStartTimeout != nil {
+		l = github_com_gogo_protobuf_types.SizeOfStdDuration(*m.StickyTaskQueueScheduleToStartTimeout)
+		n += 1 + l + sovRequestResponse(uint64(l))
+	}
+	l = len(m.CurrentBranchToken)
+	if l > 0 {
+		n += 1 + l + sovRequestResponse(uint64(l))
+	}
+	if m.VersionHistories != nil {
+		l = m.VersionHistories.Size()
+		n += 1 + l + sovRequestResponse(uint64(l))
+	}
+	if m.WorkflowState != 0 {
+		n += 1 + sovRequestResponse(uint64(m.WorkflowState))
+	}
+	if m.WorkflowStatus != 0 {
+		n += 2 + sovRequestResponse(uint64(m.WorkflowStatus))
+	}
 	return n
 }
 
-func (m *SyncShardStatusRequest) Size() (n int) {
+func (m *ResetStickyTaskQueueRequest) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
-	l = len(m.SourceCluster)
+	l = len(m.NamespaceId)
 	if l > 0 {
 		n += 1 + l + sovRequestResponse(uint64(l))
 	}
-	if m.ShardId != 0 {
-		n += 1 + sovRequestResponse(uint64(m.ShardId))
-	}
-	if m.StatusTime != nil {
-		l = github_com_gogo_protobuf_types.SizeOfStdTime(*m.StatusTime)
+	if m.Execution != nil {
+		l = m.Execution.Size()
 		n += 1 + l + sovRequestResponse(uint64(l))
 	}
 	return n
 }
 
-func (m *SyncShardStatusResponse) Size() (n int) {
+func (m *ResetStickyTaskQueueResponse) Size() (n int) {
 	if m == nil {
 		return 0
 	}
@@ -12157,7 +13443,7 @@ func (m *SyncShardStatusResponse) Size() (n int) {
 	return n
 }
 
-func (m *SyncActivityRequest) Size() (n int) {
+func (m *RecordWorkflowTaskStartedRequest) Size() (n int) {
 	if m == nil {
 		return 0
 	}
@@ -12167,67 +13453,107 @@ func (m *SyncActivityRequest) Size() (n int) {
 	if l > 0 {
 		n += 1 + l + sovRequestResponse(uint64(l))
 	}
-	l = len(m.WorkflowId)
-	if l > 0 {
+	if m.WorkflowExecution != nil {
+		l = m.WorkflowExecution.Size()
 		n += 1 + l + sovRequestResponse(uint64(l))
 	}
-	l = len(m.RunId)
+	if m.ScheduleId != 0 {
+		n += 1 + sovRequestResponse(uint64(m.ScheduleId))
+	}
+	if m.TaskId != 0 {
+		n += 1 + sovRequestResponse(uint64(m.TaskId))
+	}
+	l = len(m.RequestId)
 	if l > 0 {
 		n += 1 + l + sovRequestResponse(uint64(l))
 	}
-	if m.Version != 0 {
-		n += 1 + sovRequestResponse(uint64(m.Version))
+	if m.PollRequest != nil {
+		l = m.PollRequest.Size()
+		n += 1 + l + sovRequestResponse(uint64(l))
 	}
-	if m.ScheduledId != 0 {
-		n += 1 + sovRequestResponse(uint64(m.ScheduledId))
+	return n
+}
+
+func (m *RecordWorkflowTaskStartedResponse) Size() (n int) {
+	if m == nil {
+		return 0
 	}
-	if m.ScheduledTime != nil {
-		l = github_com_gogo_protobuf_types.SizeOfStdTime(*m.ScheduledTime)
+	var l int
+	_ = l
+	if m.WorkflowType != nil {
+		l = m.WorkflowType.Size()
 		n += 1 + l + sovRequestResponse(uint64(l))
 	}
-	if m.StartedId != 0 {
-		n += 1 + sovRequestResponse(uint64(m.StartedId))
+	if m.PreviousStartedEventId != 0 {
+		n += 1 + sovRequestResponse(uint64(m.PreviousStartedEventId))
 	}
-	if m.StartedTime != nil {
-		l = github_com_gogo_protobuf_types.SizeOfStdTime(*m.StartedTime)
-		n += 1 + l + sovRequestResponse(uint64(l))
+	if m.ScheduledEventId != 0 {
+		n += 1 + sovRequestResponse(uint64(m.ScheduledEventId))
 	}
-	if m.LastHeartbeatTime != nil {
-		l = github_com_gogo_protobuf_types.SizeOfStdTime(*m.LastHeartbeatTime)
-		n += 1 + l + sovRequestResponse(uint64(l))
+	if m.StartedEventId != 0 {
+		n += 1 + sovRequestResponse(uint64(m.StartedEventId))
 	}
-	if m.Details != nil {
-		l = m.Details.Size()
-		n += 1 + l + sovRequestResponse(uint64(l))
+	if m.NextEventId != 0 {
+		n += 1 + sovRequestResponse(uint64(m.NextEventId))
 	}
 	if m.Attempt != 0 {
 		n += 1 + sovRequestResponse(uint64(m.Attempt))
 	}
-	if m.LastFailure != nil {
-		l = m.LastFailure.Size()
+	if m.StickyExecutionEnabled {
+		n += 2
+	}
+	if m.WorkflowTaskInfo != nil {
+		l = m.WorkflowTaskInfo.Size()
 		n += 1 + l + sovRequestResponse(uint64(l))
 	}
-	l = len(m.LastWorkerIdentity)
+	if m.WorkflowExecutionTaskQueue != nil {
+		l = m.WorkflowExecutionTaskQueue.Size()
+		n += 1 + l + sovRequestResponse(uint64(l))
+	}
+	if m.EventStoreVersion != 0 {
+		n += 1 + sovRequestResponse(uint64(m.EventStoreVersion))
+	}
+	l = len(m.BranchToken)
 	if l > 0 {
 		n += 1 + l + sovRequestResponse(uint64(l))
 	}
-	if m.VersionHistory != nil {
-		l = m.VersionHistory.Size()
+	if m.ScheduledTime != nil {
+		l = github_com_gogo_protobuf_types.SizeOfStdTime(*m.ScheduledTime)
 		n += 1 + l + sovRequestResponse(uint64(l))
 	}
-	return n
-}
-
-func (m *SyncActivityResponse) Size() (n int) {
-	if m == nil {
-		return 0
+	if m.StartedTime != nil {
+		l = github_com_gogo_protobuf_types.SizeOfStdTime(*m.StartedTime)
+		n += 1 + l + sovRequestResponse(uint64(l))
+	}
+	if len(m.Queries) > 0 {
+		for k, v := range m.Queries {
+			_ = k
+			_ = v
+			l = 0
+			if v != nil {
+				l = v.Size()
+				l += 1 + sovRequestResponse(uint64(l))
+			}
+			mapEntrySize := 1 + len(k) + sovRequestResponse(uint64(len(k))) + l
+			n += mapEntrySize + 1 + sovRequestResponse(uint64(mapEntrySize))
+		}
+	}
+	if m.HistorySizeBytes != 0 {
+		n += 1 + sovRequestResponse(uint64(m.HistorySizeBytes))
+	}
+	if m.SuggestContinueAsNew {
+		n += 3
+	}
+	if len(m.Updates) > 0 {
+		for _, e := range m.Updates {
+			l = e.Size()
+			n += 2 + l + sovRequestResponse(uint64(l))
+		}
 	}
-	var l int
-	_ = l
 	return n
 }
 
-func (m *DescribeMutableStateRequest) Size() (n int) {
+func (m *RecordActivityTaskStartedRequest) Size() (n int) {
 	if m == nil {
 		return 0
 	}
@@ -12237,106 +13563,111 @@ func (m *DescribeMutableStateRequest) Size() (n int) {
 	if l > 0 {
 		n += 1 + l + sovRequestResponse(uint64(l))
 	}
-	if m.Execution != nil {
-		l = m.Execution.Size()
+	if m.WorkflowExecution != nil {
+		l = m.WorkflowExecution.Size()
+		n += 1 + l + sovRequestResponse(uint64(l))
+	}
+	if m.ScheduleId != 0 {
+		n += 1 + sovRequestResponse(uint64(m.ScheduleId))
+	}
+	if m.TaskId != 0 {
+		n += 1 + sovRequestResponse(uint64(m.TaskId))
+	}
+	l = len(m.RequestId)
+	if l > 0 {
+		n += 1 + l + sovRequestResponse(uint64(l))
+	}
+	if m.PollRequest != nil {
+		l = m.PollRequest.Size()
 		n += 1 + l + sovRequestResponse(uint64(l))
 	}
 	return n
 }
 
-func (m *DescribeMutableStateResponse) Size() (n int) {
+func (m *RecordActivityTaskStartedResponse) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
-	l = len(m.CacheMutableState)
-	if l > 0 {
+	if m.ScheduledEvent != nil {
+		l = m.ScheduledEvent.Size()
 		n += 1 + l + sovRequestResponse(uint64(l))
 	}
-	l = len(m.DatabaseMutableState)
-	if l > 0 {
+	if m.StartedTime != nil {
+		l = github_com_gogo_protobuf_types.SizeOfStdTime(*m.StartedTime)
 		n += 1 + l + sovRequestResponse(uint64(l))
 	}
-	l = len(m.TreeId)
-	if l > 0 {
+	if m.Attempt != 0 {
+		n += 1 + sovRequestResponse(uint64(m.Attempt))
+	}
+	if m.CurrentAttemptScheduledTime != nil {
+		l = github_com_gogo_protobuf_types.SizeOfStdTime(*m.CurrentAttemptScheduledTime)
 		n += 1 + l + sovRequestResponse(uint64(l))
 	}
-	l = len(m.BranchId)
+	if m.HeartbeatDetails != nil {
+		l = m.HeartbeatDetails.Size()
+		n += 1 + l + sovRequestResponse(uint64(l))
+	}
+	if m.WorkflowType != nil {
+		l = m.WorkflowType.Size()
+		n += 1 + l + sovRequestResponse(uint64(l))
+	}
+	l = len(m.WorkflowNamespace)
 	if l > 0 {
 		n += 1 + l + sovRequestResponse(uint64(l))
 	}
 	return n
 }
 
-func (m *DescribeHistoryHostRequest) Size() (n int) {
+func (m *RespondWorkflowTaskCompletedRequest) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
-	l = len(m.HostAddress)
-	if l > 0 {
-		n += 1 + l + sovRequestResponse(uint64(l))
-	}
-	if m.ShardId != 0 {
-		n += 1 + sovRequestResponse(uint64(m.ShardId))
-	}
 	l = len(m.NamespaceId)
 	if l > 0 {
 		n += 1 + l + sovRequestResponse(uint64(l))
 	}
-	if m.WorkflowExecution != nil {
-		l = m.WorkflowExecution.Size()
+	if m.CompleteRequest != nil {
+		l = m.CompleteRequest.Size()
 		n += 1 + l + sovRequestResponse(uint64(l))
 	}
 	return n
 }
 
-func (m *DescribeHistoryHostResponse) Size() (n int) {
+func (m *RespondWorkflowTaskCompletedResponse) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
-	if m.ShardsNumber != 0 {
-		n += 1 + sovRequestResponse(uint64(m.ShardsNumber))
-	}
-	if len(m.ShardIds) > 0 {
-		l = 0
-		for _, e := range m.ShardIds {
-			l += sovRequestResponse(uint64(e))
-		}
-		n += 1 + sovRequestResponse(uint64(l)) + l
-	}
-	if m.NamespaceCache != nil {
-		l = m.NamespaceCache.Size()
-		n += 1 + l + sovRequestResponse(uint64(l))
-	}
-	l = len(m.ShardControllerStatus)
-	if l > 0 {
-		n += 1 + l + sovRequestResponse(uint64(l))
-	}
-	l = len(m.Address)
-	if l > 0 {
+	if m.StartedResponse != nil {
+		l = m.StartedResponse.Size()
 		n += 1 + l + sovRequestResponse(uint64(l))
 	}
 	return n
 }
 
-func (m *CloseShardRequest) Size() (n int) {
+func (m *RespondWorkflowTaskFailedRequest) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
-	if m.ShardId != 0 {
-		n += 1 + sovRequestResponse(uint64(m.ShardId))
+	l = len(m.NamespaceId)
+	if l > 0 {
+		n += 1 + l + sovRequestResponse(uint64(l))
+	}
+	if m.FailedRequest != nil {
+		l = m.FailedRequest.Size()
+		n += 1 + l + sovRequestResponse(uint64(l))
 	}
 	return n
 }
 
-func (m *CloseShardResponse) Size() (n int) {
+func (m *RespondWorkflowTaskFailedResponse) Size() (n int) {
 	if m == nil {
 		return 0
 	}
@@ -12345,109 +13676,88 @@ func (m *CloseShardResponse) Size() (n int) {
 	return n
 }
 
-func (m *RemoveTaskRequest) Size() (n int) {
+func (m *RecordActivityTaskHeartbeatRequest) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
-	if m.ShardId != 0 {
-		n += 1 + sovRequestResponse(uint64(m.ShardId))
-	}
-	if m.Category != 0 {
-		n += 1 + sovRequestResponse(uint64(m.Category))
-	}
-	if m.TaskId != 0 {
-		n += 1 + sovRequestResponse(uint64(m.TaskId))
+	l = len(m.NamespaceId)
+	if l > 0 {
+		n += 1 + l + sovRequestResponse(uint64(l))
 	}
-	if m.VisibilityTime != nil {
-		l = github_com_gogo_protobuf_types.SizeOfStdTime(*m.VisibilityTime)
+	if m.HeartbeatRequest != nil {
+		l = m.HeartbeatRequest.Size()
 		n += 1 + l + sovRequestResponse(uint64(l))
 	}
 	return n
 }
 
-func (m *RemoveTaskResponse) Size() (n int) {
+func (m *RecordActivityTaskHeartbeatResponse) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
+	if m.CancelRequested {
+		n += 2
+	}
 	return n
 }
 
-func (m *GetReplicationMessagesRequest) Size() (n int) {
+func (m *RespondActivityTaskCompletedRequest) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
-	if len(m.Tokens) > 0 {
-		for _, e := range m.Tokens {
-			l = e.Size()
-			n += 1 + l + sovRequestResponse(uint64(l))
-		}
-	}
-	l = len(m.ClusterName)
+	l = len(m.NamespaceId)
 	if l > 0 {
 		n += 1 + l + sovRequestResponse(uint64(l))
 	}
+	if m.CompleteRequest != nil {
+		l = m.CompleteRequest.Size()
+		n += 1 + l + sovRequestResponse(uint64(l))
+	}
 	return n
 }
 
-func (m *GetReplicationMessagesResponse) Size() (n int) {
+func (m *RespondActivityTaskCompletedResponse) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
-	if len(m.ShardMessages) > 0 {
-		for k, v := range m.ShardMessages {
-			_ = k
-			_ = v
-			l = 0
-			if v != nil {
-				l = v.Size()
-				l += 1 + sovRequestResponse(uint64(l))
-			}
-			mapEntrySize := 1 + sovRequestResponse(uint64(k)) + l
-			n += mapEntrySize + 1 + sovRequestResponse(uint64(mapEntrySize))
-		}
-	}
 	return n
 }
 
-func (m *GetDLQReplicationMessagesRequest) Size() (n int) {
+func (m *RespondActivityTaskFailedRequest) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
-	if len(m.TaskInfos) > 0 {
-		for _, e := range m.TaskInfos {
-			l = e.Size()
-			n += 1 + l + sovRequestResponse(uint64(l))
-		}
+	l = len(m.NamespaceId)
+	if l > 0 {
+		n += 1 + l + sovRequestResponse(uint64(l))
 	}
-	return n
-}
-
-func (m *GetDLQReplicationMessagesResponse) Size() (n int) {
+	if m.FailedRequest != nil {
+		l = m.FailedRequest.Size()
+		n += 1 + l + sovRequestResponse(uint64(l))
+	}
+	return n
+}
+
+func (m *RespondActivityTaskFailedResponse) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
-	if len(m.ReplicationTasks) > 0 {
-		for _, e := range m.ReplicationTasks {
-			l = e.Size()
-			n += 1 + l + sovRequestResponse(uint64(l))
-		}
-	}
 	return n
 }
 
-func (m *QueryWorkflowRequest) Size() (n int) {
+func (m *RespondActivityTaskCanceledRequest) Size() (n int) {
 	if m == nil {
 		return 0
 	}
@@ -12457,27 +13767,23 @@ func (m *QueryWorkflowRequest) Size() (n int) {
 	if l > 0 {
 		n += 1 + l + sovRequestResponse(uint64(l))
 	}
-	if m.Request != nil {
-		l = m.Request.Size()
+	if m.CancelRequest != nil {
+		l = m.CancelRequest.Size()
 		n += 1 + l + sovRequestResponse(uint64(l))
 	}
 	return n
 }
 
-func (m *QueryWorkflowResponse) Size() (n int) {
+func (m *RespondActivityTaskCanceledResponse) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
-	if m.Response != nil {
-		l = m.Response.Size()
-		n += 1 + l + sovRequestResponse(uint64(l))
-	}
 	return n
 }
 
-func (m *ReapplyEventsRequest) Size() (n int) {
+func (m *SignalWorkflowExecutionRequest) Size() (n int) {
 	if m == nil {
 		return 0
 	}
@@ -12487,14 +13793,21 @@ func (m *ReapplyEventsRequest) Size() (n int) {
 	if l > 0 {
 		n += 1 + l + sovRequestResponse(uint64(l))
 	}
-	if m.Request != nil {
-		l = m.Request.Size()
+	if m.SignalRequest != nil {
+		l = m.SignalRequest.Size()
+		n += 1 + l + sovRequestResponse(uint64(l))
+	}
+	if m.ExternalWorkflowExecution != nil {
+		l = m.ExternalWorkflowExecution.Size()
 		n += 1 + l + sovRequestResponse(uint64(l))
 	}
+	if m.ChildWorkflowOnly {
+		n += 2
+	}
 	return n
 }
 
-func (m *ReapplyEventsResponse) Size() (n int) {
+func (m *SignalWorkflowExecutionResponse) Size() (n int) {
 	if m == nil {
 		return 0
 	}
@@ -12503,80 +13816,84 @@ func (m *ReapplyEventsResponse) Size() (n int) {
 	return n
 }
 
-func (m *GetDLQMessagesRequest) Size() (n int) {
+func (m *SignalWithStartWorkflowExecutionRequest) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
-	if m.Type != 0 {
-		n += 1 + sovRequestResponse(uint64(m.Type))
-	}
-	if m.ShardId != 0 {
-		n += 1 + sovRequestResponse(uint64(m.ShardId))
-	}
-	l = len(m.SourceCluster)
+	l = len(m.NamespaceId)
 	if l > 0 {
 		n += 1 + l + sovRequestResponse(uint64(l))
 	}
-	if m.InclusiveEndMessageId != 0 {
-		n += 1 + sovRequestResponse(uint64(m.InclusiveEndMessageId))
+	if m.SignalWithStartRequest != nil {
+		l = m.SignalWithStartRequest.Size()
+		n += 1 + l + sovRequestResponse(uint64(l))
 	}
-	if m.MaximumPageSize != 0 {
-		n += 1 + sovRequestResponse(uint64(m.MaximumPageSize))
+	return n
+}
+
+func (m *SignalWithStartWorkflowExecutionResponse) Size() (n int) {
+	if m == nil {
+		return 0
 	}
-	l = len(m.NextPageToken)
+	var l int
+	_ = l
+	l = len(m.RunId)
 	if l > 0 {
 		n += 1 + l + sovRequestResponse(uint64(l))
 	}
 	return n
 }
 
-func (m *GetDLQMessagesResponse) Size() (n int) {
+func (m *RemoveSignalMutableStateRequest) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
-	if m.Type != 0 {
-		n += 1 + sovRequestResponse(uint64(m.Type))
+	l = len(m.NamespaceId)
+	if l > 0 {
+		n += 1 + l + sovRequestResponse(uint64(l))
 	}
-	if len(m.ReplicationTasks) > 0 {
-		for _, e := range m.ReplicationTasks {
-			l = e.Size()
-			n += 1 + l + sovRequestResponse(uint64(l))
-		}
+	if m.WorkflowExecution != nil {
+		l = m.WorkflowExecution.Size()
+		n += 1 + l + sovRequestResponse(uint64(l))
 	}
-	l = len(m.NextPageToken)
+	l = len(m.RequestId)
 	if l > 0 {
 		n += 1 + l + sovRequestResponse(uint64(l))
 	}
 	return n
 }
 
-func (m *PurgeDLQMessagesRequest) Size() (n int) {
+func (m *RemoveSignalMutableStateResponse) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
-	if m.Type != 0 {
-		n += 1 + sovRequestResponse(uint64(m.Type))
-	}
-	if m.ShardId != 0 {
-		n += 1 + sovRequestResponse(uint64(m.ShardId))
+	return n
+}
+
+func (m *TerminateWorkflowExecutionRequest) Size() (n int) {
+	if m == nil {
+		return 0
 	}
-	l = len(m.SourceCluster)
+	var l int
+	_ = l
+	l = len(m.NamespaceId)
 	if l > 0 {
 		n += 1 + l + sovRequestResponse(uint64(l))
 	}
-	if m.InclusiveEndMessageId != 0 {
-		n += 1 + sovRequestResponse(uint64(m.InclusiveEndMessageId))
+	if m.TerminateRequest != nil {
+		l = m.TerminateRequest.Size()
+		n += 1 + l + sovRequestResponse(uint64(l))
 	}
 	return n
 }
 
-func (m *PurgeDLQMessagesResponse) Size() (n int) {
+func (m *TerminateWorkflowExecutionResponse) Size() (n int) {
 	if m == nil {
 		return 0
 	}
@@ -12585,49 +13902,97 @@ func (m *PurgeDLQMessagesResponse) Size() (n int) {
 	return n
 }
 
-func (m *MergeDLQMessagesRequest) Size() (n int) {
+func (m *ResetWorkflowExecutionRequest) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
-	if m.Type != 0 {
-		n += 1 + sovRequestResponse(uint64(m.Type))
-	}
-	if m.ShardId != 0 {
-		n += 1 + sovRequestResponse(uint64(m.ShardId))
-	}
-	l = len(m.SourceCluster)
+	l = len(m.NamespaceId)
 	if l > 0 {
 		n += 1 + l + sovRequestResponse(uint64(l))
 	}
-	if m.InclusiveEndMessageId != 0 {
-		n += 1 + sovRequestResponse(uint64(m.InclusiveEndMessageId))
+	if m.ResetRequest != nil {
+		l = m.ResetRequest.Size()
+		n += 1 + l + sovRequestResponse(uint64(l))
 	}
-	if m.MaximumPageSize != 0 {
-		n += 1 + sovRequestResponse(uint64(m.MaximumPageSize))
+	if m.WorkflowTaskScheduledEventId != 0 {
+		n += 1 + sovRequestResponse(uint64(m.WorkflowTaskScheduledEventId))
 	}
-	l = len(m.NextPageToken)
+	if m.ReapplyExcludeSignals {
+		n += 2
+	}
+	if m.ReapplyExcludeAll {
+		n += 2
+	}
+	if m.DryRun {
+		n += 2
+	}
+	return n
+}
+
+func (m *ResetWorkflowExecutionResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.RunId)
 	if l > 0 {
 		n += 1 + l + sovRequestResponse(uint64(l))
 	}
+	if m.Preview != nil {
+		l = m.Preview.Size()
+		n += 1 + l + sovRequestResponse(uint64(l))
+	}
 	return n
 }
 
-func (m *MergeDLQMessagesResponse) Size() (n int) {
+func (m *ResetWorkflowExecutionPreview) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
-	l = len(m.NextPageToken)
+	l = len(m.BaseRunId)
+	if l > 0 {
+		n += 1 + l + sovRequestResponse(uint64(l))
+	}
+	if m.WorkflowTaskFinishEventId != 0 {
+		n += 1 + sovRequestResponse(uint64(m.WorkflowTaskFinishEventId))
+	}
+	l = len(m.TerminatedRunId)
 	if l > 0 {
 		n += 1 + l + sovRequestResponse(uint64(l))
 	}
+	if len(m.DroppedEvents) > 0 {
+		for _, e := range m.DroppedEvents {
+			l = e.Size()
+			n += 1 + l + sovRequestResponse(uint64(l))
+		}
+	}
+	if len(m.FailedActivities) > 0 {
+		for _, e := range m.FailedActivities {
+			l = e.Size()
+			n += 1 + l + sovRequestResponse(uint64(l))
+		}
+	}
+	if len(m.PendingChildren) > 0 {
+		for _, e := range m.PendingChildren {
+			l = e.Size()
+			n += 1 + l + sovRequestResponse(uint64(l))
+		}
+	}
+	if len(m.ReappliedEvents) > 0 {
+		for _, e := range m.ReappliedEvents {
+			l = e.Size()
+			n += 1 + l + sovRequestResponse(uint64(l))
+		}
+	}
 	return n
 }
 
-func (m *RefreshWorkflowTasksRequest) Size() (n int) {
+func (m *RequestCancelWorkflowExecutionRequest) Size() (n int) {
 	if m == nil {
 		return 0
 	}
@@ -12637,14 +14002,24 @@ func (m *RefreshWorkflowTasksRequest) Size() (n int) {
 	if l > 0 {
 		n += 1 + l + sovRequestResponse(uint64(l))
 	}
-	if m.Request != nil {
-		l = m.Request.Size()
+	if m.CancelRequest != nil {
+		l = m.CancelRequest.Size()
 		n += 1 + l + sovRequestResponse(uint64(l))
 	}
+	if m.ExternalInitiatedEventId != 0 {
+		n += 1 + sovRequestResponse(uint64(m.ExternalInitiatedEventId))
+	}
+	if m.ExternalWorkflowExecution != nil {
+		l = m.ExternalWorkflowExecution.Size()
+		n += 1 + l + sovRequestResponse(uint64(l))
+	}
+	if m.ChildWorkflowOnly {
+		n += 2
+	}
 	return n
 }
 
-func (m *RefreshWorkflowTasksResponse) Size() (n int) {
+func (m *RequestCancelWorkflowExecutionResponse) Size() (n int) {
 	if m == nil {
 		return 0
 	}
@@ -12653,925 +14028,2550 @@ func (m *RefreshWorkflowTasksResponse) Size() (n int) {
 	return n
 }
 
-func sovRequestResponse(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
-func sozRequestResponse(x uint64) (n int) {
-	return sovRequestResponse(uint64((x << 1) ^ uint64((int64(x) >> 63))))
-}
-func (this *StartWorkflowExecutionRequest) String() string {
-	if this == nil {
-		return "nil"
+func (m *ScheduleWorkflowTaskRequest) Size() (n int) {
+	if m == nil {
+		return 0
 	}
-	s := strings.Join([]string{`&StartWorkflowExecutionRequest{`,
-		`NamespaceId:` + fmt.Sprintf("%v", this.NamespaceId) + `,`,
-		`StartRequest:` + strings.Replace(fmt.Sprintf("%v", this.StartRequest), "StartWorkflowExecutionRequest", "v1.StartWorkflowExecutionRequest", 1) + `,`,
-		`ParentExecutionInfo:` + strings.Replace(fmt.Sprintf("%v", this.ParentExecutionInfo), "ParentExecutionInfo", "v11.ParentExecutionInfo", 1) + `,`,
-		`Attempt:` + fmt.Sprintf("%v", this.Attempt) + `,`,
-		`WorkflowExecutionExpirationTime:` + strings.Replace(fmt.Sprintf("%v", this.WorkflowExecutionExpirationTime), "Timestamp", "types.Timestamp", 1) + `,`,
-		`ContinueAsNewInitiator:` + fmt.Sprintf("%v", this.ContinueAsNewInitiator) + `,`,
-		`ContinuedFailure:` + strings.Replace(fmt.Sprintf("%v", this.ContinuedFailure), "Failure", "v13.Failure", 1) + `,`,
-		`LastCompletionResult:` + strings.Replace(fmt.Sprintf("%v", this.LastCompletionResult), "Payloads", "v14.Payloads", 1) + `,`,
-		`FirstWorkflowTaskBackoff:` + strings.Replace(fmt.Sprintf("%v", this.FirstWorkflowTaskBackoff), "Duration", "types.Duration", 1) + `,`,
-		`}`,
-	}, "")
-	return s
-}
-func (this *StartWorkflowExecutionResponse) String() string {
-	if this == nil {
-		return "nil"
+	var l int
+	_ = l
+	l = len(m.NamespaceId)
+	if l > 0 {
+		n += 1 + l + sovRequestResponse(uint64(l))
 	}
-	s := strings.Join([]string{`&StartWorkflowExecutionResponse{`,
-		`RunId:` + fmt.Sprintf("%v", this.RunId) + `,`,
-		`}`,
-	}, "")
-	return s
-}
-func (this *GetMutableStateRequest) String() string {
-	if this == nil {
-		return "nil"
+	if m.WorkflowExecution != nil {
+		l = m.WorkflowExecution.Size()
+		n += 1 + l + sovRequestResponse(uint64(l))
 	}
-	s := strings.Join([]string{`&GetMutableStateRequest{`,
-		`NamespaceId:` + fmt.Sprintf("%v", this.NamespaceId) + `,`,
-		`Execution:` + strings.Replace(fmt.Sprintf("%v", this.Execution), "WorkflowExecution", "v14.WorkflowExecution", 1) + `,`,
-		`ExpectedNextEventId:` + fmt.Sprintf("%v", this.ExpectedNextEventId) + `,`,
-		`CurrentBranchToken:` + fmt.Sprintf("%v", this.CurrentBranchToken) + `,`,
-		`}`,
-	}, "")
-	return s
+	if m.IsFirstWorkflowTask {
+		n += 2
+	}
+	return n
 }
-func (this *GetMutableStateResponse) String() string {
-	if this == nil {
-		return "nil"
+
+func (m *ScheduleWorkflowTaskResponse) Size() (n int) {
+	if m == nil {
+		return 0
 	}
-	s := strings.Join([]string{`&GetMutableStateResponse{`,
-		`Execution:` + strings.Replace(fmt.Sprintf("%v", this.Execution), "WorkflowExecution", "v14.WorkflowExecution", 1) + `,`,
-		`WorkflowType:` + strings.Replace(fmt.Sprintf("%v", this.WorkflowType), "WorkflowType", "v14.WorkflowType", 1) + `,`,
-		`NextEventId:` + fmt.Sprintf("%v", this.NextEventId) + `,`,
-		`PreviousStartedEventId:` + fmt.Sprintf("%v", this.PreviousStartedEventId) + `,`,
-		`LastFirstEventId:` + fmt.Sprintf("%v", this.LastFirstEventId) + `,`,
-		`TaskQueue:` + strings.Replace(fmt.Sprintf("%v", this.TaskQueue), "TaskQueue", "v15.TaskQueue", 1) + `,`,
-		`StickyTaskQueue:` + strings.Replace(fmt.Sprintf("%v", this.StickyTaskQueue), "TaskQueue", "v15.TaskQueue", 1) + `,`,
-		`StickyTaskQueueScheduleToStartTimeout:` + strings.Replace(fmt.Sprintf("%v", this.StickyTaskQueueScheduleToStartTimeout), "Duration", "types.Duration", 1) + `,`,
-		`EventStoreVersion:` + fmt.Sprintf("%v", this.EventStoreVersion) + `,`,
-		`CurrentBranchToken:` + fmt.Sprintf("%v", this.CurrentBranchToken) + `,`,
-		`WorkflowState:` + fmt.Sprintf("%v", this.WorkflowState) + `,`,
-		`WorkflowStatus:` + fmt.Sprintf("%v", this.WorkflowStatus) + `,`,
-		`VersionHistories:` + strings.Replace(fmt.Sprintf("%v", this.VersionHistories), "VersionHistories", "v17.VersionHistories", 1) + `,`,
-		`IsStickyTaskQueueEnabled:` + fmt.Sprintf("%v", this.IsStickyTaskQueueEnabled) + `,`,
-		`}`,
-	}, "")
-	return s
+	var l int
+	_ = l
+	return n
 }
-func (this *PollMutableStateRequest) String() string {
-	if this == nil {
-		return "nil"
+
+func (m *RecordChildExecutionCompletedRequest) Size() (n int) {
+	if m == nil {
+		return 0
 	}
-	s := strings.Join([]string{`&PollMutableStateRequest{`,
-		`NamespaceId:` + fmt.Sprintf("%v", this.NamespaceId) + `,`,
-		`Execution:` + strings.Replace(fmt.Sprintf("%v", this.Execution), "WorkflowExecution", "v14.WorkflowExecution", 1) + `,`,
-		`ExpectedNextEventId:` + fmt.Sprintf("%v", this.ExpectedNextEventId) + `,`,
-		`CurrentBranchToken:` + fmt.Sprintf("%v", this.CurrentBranchToken) + `,`,
-		`}`,
-	}, "")
-	return s
-}
-func (this *PollMutableStateResponse) String() string {
-	if this == nil {
-		return "nil"
+	var l int
+	_ = l
+	l = len(m.NamespaceId)
+	if l > 0 {
+		n += 1 + l + sovRequestResponse(uint64(l))
 	}
-	s := strings.Join([]string{`&PollMutableStateResponse{`,
-		`Execution:` + strings.Replace(fmt.Sprintf("%v", this.Execution), "WorkflowExecution", "v14.WorkflowExecution", 1) + `,`,
-		`WorkflowType:` + strings.Replace(fmt.Sprintf("%v", this.WorkflowType), "WorkflowType", "v14.WorkflowType", 1) + `,`,
-		`NextEventId:` + fmt.Sprintf("%v", this.NextEventId) + `,`,
-		`PreviousStartedEventId:` + fmt.Sprintf("%v", this.PreviousStartedEventId) + `,`,
-		`LastFirstEventId:` + fmt.Sprintf("%v", this.LastFirstEventId) + `,`,
-		`TaskQueue:` + strings.Replace(fmt.Sprintf("%v", this.TaskQueue), "TaskQueue", "v15.TaskQueue", 1) + `,`,
-		`StickyTaskQueue:` + strings.Replace(fmt.Sprintf("%v", this.StickyTaskQueue), "TaskQueue", "v15.TaskQueue", 1) + `,`,
-		`StickyTaskQueueScheduleToStartTimeout:` + strings.Replace(fmt.Sprintf("%v", this.StickyTaskQueueScheduleToStartTimeout), "Duration", "types.Duration", 1) + `,`,
-		`CurrentBranchToken:` + fmt.Sprintf("%v", this.CurrentBranchToken) + `,`,
-		`VersionHistories:` + strings.Replace(fmt.Sprintf("%v", this.VersionHistories), "VersionHistories", "v17.VersionHistories", 1) + `,`,
-		`WorkflowState:` + fmt.Sprintf("%v", this.WorkflowState) + `,`,
-		`WorkflowStatus:` + fmt.Sprintf("%v", this.WorkflowStatus) + `,`,
-		`}`,
-	}, "")
-	return s
-}
-func (this *ResetStickyTaskQueueRequest) String() string {
-	if this == nil {
-		return "nil"
+	if m.WorkflowExecution != nil {
+		l = m.WorkflowExecution.Size()
+		n += 1 + l + sovRequestResponse(uint64(l))
 	}
-	s := strings.Join([]string{`&ResetStickyTaskQueueRequest{`,
-		`NamespaceId:` + fmt.Sprintf("%v", this.NamespaceId) + `,`,
-		`Execution:` + strings.Replace(fmt.Sprintf("%v", this.Execution), "WorkflowExecution", "v14.WorkflowExecution", 1) + `,`,
-		`}`,
-	}, "")
-	return s
-}
-func (this *ResetStickyTaskQueueResponse) String() string {
-	if this == nil {
-		return "nil"
+	if m.InitiatedId != 0 {
+		n += 1 + sovRequestResponse(uint64(m.InitiatedId))
 	}
-	s := strings.Join([]string{`&ResetStickyTaskQueueResponse{`,
-		`}`,
-	}, "")
-	return s
+	if m.CompletedExecution != nil {
+		l = m.CompletedExecution.Size()
+		n += 1 + l + sovRequestResponse(uint64(l))
+	}
+	if m.CompletionEvent != nil {
+		l = m.CompletionEvent.Size()
+		n += 1 + l + sovRequestResponse(uint64(l))
+	}
+	return n
 }
-func (this *RecordWorkflowTaskStartedRequest) String() string {
-	if this == nil {
-		return "nil"
+
+func (m *RecordChildExecutionCompletedResponse) Size() (n int) {
+	if m == nil {
+		return 0
 	}
-	s := strings.Join([]string{`&RecordWorkflowTaskStartedRequest{`,
-		`NamespaceId:` + fmt.Sprintf("%v", this.NamespaceId) + `,`,
-		`WorkflowExecution:` + strings.Replace(fmt.Sprintf("%v", this.WorkflowExecution), "WorkflowExecution", "v14.WorkflowExecution", 1) + `,`,
-		`ScheduleId:` + fmt.Sprintf("%v", this.ScheduleId) + `,`,
-		`TaskId:` + fmt.Sprintf("%v", this.TaskId) + `,`,
-		`RequestId:` + fmt.Sprintf("%v", this.RequestId) + `,`,
-		`PollRequest:` + strings.Replace(fmt.Sprintf("%v", this.PollRequest), "PollWorkflowTaskQueueRequest", "v1.PollWorkflowTaskQueueRequest", 1) + `,`,
-		`}`,
-	}, "")
-	return s
+	var l int
+	_ = l
+	return n
 }
-func (this *RecordWorkflowTaskStartedResponse) String() string {
-	if this == nil {
-		return "nil"
+
+func (m *DescribeWorkflowExecutionRequest) Size() (n int) {
+	if m == nil {
+		return 0
 	}
-	keysForQueries := make([]string, 0, len(this.Queries))
-	for k, _ := range this.Queries {
-		keysForQueries = append(keysForQueries, k)
+	var l int
+	_ = l
+	l = len(m.NamespaceId)
+	if l > 0 {
+		n += 1 + l + sovRequestResponse(uint64(l))
 	}
-	github_com_gogo_protobuf_sortkeys.Strings(keysForQueries)
-	mapStringForQueries := "map[string]*v18.WorkflowQuery{"
-	for _, k := range keysForQueries {
-		mapStringForQueries += fmt.Sprintf("%v: %v,", k, this.Queries[k])
+	if m.Request != nil {
+		l = m.Request.Size()
+		n += 1 + l + sovRequestResponse(uint64(l))
 	}
-	mapStringForQueries += "}"
-	s := strings.Join([]string{`&RecordWorkflowTaskStartedResponse{`,
-		`WorkflowType:` + strings.Replace(fmt.Sprintf("%v", this.WorkflowType), "WorkflowType", "v14.WorkflowType", 1) + `,`,
-		`PreviousStartedEventId:` + fmt.Sprintf("%v", this.PreviousStartedEventId) + `,`,
-		`ScheduledEventId:` + fmt.Sprintf("%v", this.ScheduledEventId) + `,`,
-		`StartedEventId:` + fmt.Sprintf("%v", this.StartedEventId) + `,`,
-		`NextEventId:` + fmt.Sprintf("%v", this.NextEventId) + `,`,
-		`Attempt:` + fmt.Sprintf("%v", this.Attempt) + `,`,
-		`StickyExecutionEnabled:` + fmt.Sprintf("%v", this.StickyExecutionEnabled) + `,`,
-		`WorkflowTaskInfo:` + strings.Replace(fmt.Sprintf("%v", this.WorkflowTaskInfo), "TransientWorkflowTaskInfo", "v17.TransientWorkflowTaskInfo", 1) + `,`,
-		`WorkflowExecutionTaskQueue:` + strings.Replace(fmt.Sprintf("%v", this.WorkflowExecutionTaskQueue), "TaskQueue", "v15.TaskQueue", 1) + `,`,
-		`EventStoreVersion:` + fmt.Sprintf("%v", this.EventStoreVersion) + `,`,
-		`BranchToken:` + fmt.Sprintf("%v", this.BranchToken) + `,`,
-		`ScheduledTime:` + strings.Replace(fmt.Sprintf("%v", this.ScheduledTime), "Timestamp", "types.Timestamp", 1) + `,`,
-		`StartedTime:` + strings.Replace(fmt.Sprintf("%v", this.StartedTime), "Timestamp", "types.Timestamp", 1) + `,`,
-		`Queries:` + mapStringForQueries + `,`,
-		`}`,
-	}, "")
-	return s
+	return n
 }
-func (this *RecordActivityTaskStartedRequest) String() string {
-	if this == nil {
-		return "nil"
+
+func (m *DescribeWorkflowExecutionResponse) Size() (n int) {
+	if m == nil {
+		return 0
 	}
-	s := strings.Join([]string{`&RecordActivityTaskStartedRequest{`,
-		`NamespaceId:` + fmt.Sprintf("%v", this.NamespaceId) + `,`,
-		`WorkflowExecution:` + strings.Replace(fmt.Sprintf("%v", this.WorkflowExecution), "WorkflowExecution", "v14.WorkflowExecution", 1) + `,`,
-		`ScheduleId:` + fmt.Sprintf("%v", this.ScheduleId) + `,`,
-		`TaskId:` + fmt.Sprintf("%v", this.TaskId) + `,`,
-		`RequestId:` + fmt.Sprintf("%v", this.RequestId) + `,`,
-		`PollRequest:` + strings.Replace(fmt.Sprintf("%v", this.PollRequest), "PollActivityTaskQueueRequest", "v1.PollActivityTaskQueueRequest", 1) + `,`,
-		`}`,
-	}, "")
-	return s
-}
-func (this *RecordActivityTaskStartedResponse) String() string {
-	if this == nil {
-		return "nil"
+	var l int
+	_ = l
+	if m.ExecutionConfig != nil {
+		l = m.ExecutionConfig.Size()
+		n += 1 + l + sovRequestResponse(uint64(l))
 	}
-	s := strings.Join([]string{`&RecordActivityTaskStartedResponse{`,
-		`ScheduledEvent:` + strings.Replace(fmt.Sprintf("%v", this.ScheduledEvent), "HistoryEvent", "v19.HistoryEvent", 1) + `,`,
-		`StartedTime:` + strings.Replace(fmt.Sprintf("%v", this.StartedTime), "Timestamp", "types.Timestamp", 1) + `,`,
-		`Attempt:` + fmt.Sprintf("%v", this.Attempt) + `,`,
-		`CurrentAttemptScheduledTime:` + strings.Replace(fmt.Sprintf("%v", this.CurrentAttemptScheduledTime), "Timestamp", "types.Timestamp", 1) + `,`,
-		`HeartbeatDetails:` + strings.Replace(fmt.Sprintf("%v", this.HeartbeatDetails), "Payloads", "v14.Payloads", 1) + `,`,
-		`WorkflowType:` + strings.Replace(fmt.Sprintf("%v", this.WorkflowType), "WorkflowType", "v14.WorkflowType", 1) + `,`,
-		`WorkflowNamespace:` + fmt.Sprintf("%v", this.WorkflowNamespace) + `,`,
-		`}`,
-	}, "")
-	return s
-}
-func (this *RespondWorkflowTaskCompletedRequest) String() string {
-	if this == nil {
-		return "nil"
+	if m.WorkflowExecutionInfo != nil {
+		l = m.WorkflowExecutionInfo.Size()
+		n += 1 + l + sovRequestResponse(uint64(l))
 	}
-	s := strings.Join([]string{`&RespondWorkflowTaskCompletedRequest{`,
-		`NamespaceId:` + fmt.Sprintf("%v", this.NamespaceId) + `,`,
-		`CompleteRequest:` + strings.Replace(fmt.Sprintf("%v", this.CompleteRequest), "RespondWorkflowTaskCompletedRequest", "v1.RespondWorkflowTaskCompletedRequest", 1) + `,`,
-		`}`,
-	}, "")
-	return s
-}
-func (this *RespondWorkflowTaskCompletedResponse) String() string {
-	if this == nil {
-		return "nil"
+	if len(m.PendingActivities) > 0 {
+		for _, e := range m.PendingActivities {
+			l = e.Size()
+			n += 1 + l + sovRequestResponse(uint64(l))
+		}
 	}
-	s := strings.Join([]string{`&RespondWorkflowTaskCompletedResponse{`,
-		`StartedResponse:` + strings.Replace(this.StartedResponse.String(), "RecordWorkflowTaskStartedResponse", "RecordWorkflowTaskStartedResponse", 1) + `,`,
-		`}`,
-	}, "")
-	return s
-}
-func (this *RespondWorkflowTaskFailedRequest) String() string {
-	if this == nil {
-		return "nil"
+	if len(m.PendingChildren) > 0 {
+		for _, e := range m.PendingChildren {
+			l = e.Size()
+			n += 1 + l + sovRequestResponse(uint64(l))
+		}
 	}
-	s := strings.Join([]string{`&RespondWorkflowTaskFailedRequest{`,
-		`NamespaceId:` + fmt.Sprintf("%v", this.NamespaceId) + `,`,
-		`FailedRequest:` + strings.Replace(fmt.Sprintf("%v", this.FailedRequest), "RespondWorkflowTaskFailedRequest", "v1.RespondWorkflowTaskFailedRequest", 1) + `,`,
-		`}`,
-	}, "")
-	return s
-}
-func (this *RespondWorkflowTaskFailedResponse) String() string {
-	if this == nil {
-		return "nil"
+	if m.HistorySizeBytes != 0 {
+		n += 1 + sovRequestResponse(uint64(m.HistorySizeBytes))
 	}
-	s := strings.Join([]string{`&RespondWorkflowTaskFailedResponse{`,
-		`}`,
-	}, "")
-	return s
-}
-func (this *RecordActivityTaskHeartbeatRequest) String() string {
-	if this == nil {
-		return "nil"
+	if m.SuggestContinueAsNew {
+		n += 2
 	}
-	s := strings.Join([]string{`&RecordActivityTaskHeartbeatRequest{`,
-		`NamespaceId:` + fmt.Sprintf("%v", this.NamespaceId) + `,`,
-		`HeartbeatRequest:` + strings.Replace(fmt.Sprintf("%v", this.HeartbeatRequest), "RecordActivityTaskHeartbeatRequest", "v1.RecordActivityTaskHeartbeatRequest", 1) + `,`,
-		`}`,
-	}, "")
-	return s
-}
-func (this *RecordActivityTaskHeartbeatResponse) String() string {
-	if this == nil {
-		return "nil"
+	if m.Paused {
+		n += 2
 	}
-	s := strings.Join([]string{`&RecordActivityTaskHeartbeatResponse{`,
-		`CancelRequested:` + fmt.Sprintf("%v", this.CancelRequested) + `,`,
-		`}`,
-	}, "")
-	return s
-}
-func (this *RespondActivityTaskCompletedRequest) String() string {
-	if this == nil {
-		return "nil"
+	l = len(m.PauseReason)
+	if l > 0 {
+		n += 1 + l + sovRequestResponse(uint64(l))
 	}
-	s := strings.Join([]string{`&RespondActivityTaskCompletedRequest{`,
-		`NamespaceId:` + fmt.Sprintf("%v", this.NamespaceId) + `,`,
-		`CompleteRequest:` + strings.Replace(fmt.Sprintf("%v", this.CompleteRequest), "RespondActivityTaskCompletedRequest", "v1.RespondActivityTaskCompletedRequest", 1) + `,`,
-		`}`,
-	}, "")
-	return s
+	return n
 }
-func (this *RespondActivityTaskCompletedResponse) String() string {
-	if this == nil {
-		return "nil"
+
+func (m *ReplicateEventsV2Request) Size() (n int) {
+	if m == nil {
+		return 0
 	}
-	s := strings.Join([]string{`&RespondActivityTaskCompletedResponse{`,
-		`}`,
-	}, "")
-	return s
-}
-func (this *RespondActivityTaskFailedRequest) String() string {
-	if this == nil {
-		return "nil"
+	var l int
+	_ = l
+	l = len(m.NamespaceId)
+	if l > 0 {
+		n += 1 + l + sovRequestResponse(uint64(l))
 	}
-	s := strings.Join([]string{`&RespondActivityTaskFailedRequest{`,
-		`NamespaceId:` + fmt.Sprintf("%v", this.NamespaceId) + `,`,
-		`FailedRequest:` + strings.Replace(fmt.Sprintf("%v", this.FailedRequest), "RespondActivityTaskFailedRequest", "v1.RespondActivityTaskFailedRequest", 1) + `,`,
-		`}`,
-	}, "")
-	return s
-}
-func (this *RespondActivityTaskFailedResponse) String() string {
-	if this == nil {
-		return "nil"
+	if m.WorkflowExecution != nil {
+		l = m.WorkflowExecution.Size()
+		n += 1 + l + sovRequestResponse(uint64(l))
 	}
-	s := strings.Join([]string{`&RespondActivityTaskFailedResponse{`,
-		`}`,
-	}, "")
-	return s
-}
-func (this *RespondActivityTaskCanceledRequest) String() string {
-	if this == nil {
-		return "nil"
+	if len(m.VersionHistoryItems) > 0 {
+		for _, e := range m.VersionHistoryItems {
+			l = e.Size()
+			n += 1 + l + sovRequestResponse(uint64(l))
+		}
 	}
-	s := strings.Join([]string{`&RespondActivityTaskCanceledRequest{`,
-		`NamespaceId:` + fmt.Sprintf("%v", this.NamespaceId) + `,`,
-		`CancelRequest:` + strings.Replace(fmt.Sprintf("%v", this.CancelRequest), "RespondActivityTaskCanceledRequest", "v1.RespondActivityTaskCanceledRequest", 1) + `,`,
-		`}`,
-	}, "")
-	return s
-}
-func (this *RespondActivityTaskCanceledResponse) String() string {
-	if this == nil {
-		return "nil"
+	if m.Events != nil {
+		l = m.Events.Size()
+		n += 1 + l + sovRequestResponse(uint64(l))
 	}
-	s := strings.Join([]string{`&RespondActivityTaskCanceledResponse{`,
-		`}`,
-	}, "")
-	return s
-}
-func (this *SignalWorkflowExecutionRequest) String() string {
-	if this == nil {
-		return "nil"
+	if m.NewRunEvents != nil {
+		l = m.NewRunEvents.Size()
+		n += 1 + l + sovRequestResponse(uint64(l))
 	}
-	s := strings.Join([]string{`&SignalWorkflowExecutionRequest{`,
-		`NamespaceId:` + fmt.Sprintf("%v", this.NamespaceId) + `,`,
-		`SignalRequest:` + strings.Replace(fmt.Sprintf("%v", this.SignalRequest), "SignalWorkflowExecutionRequest", "v1.SignalWorkflowExecutionRequest", 1) + `,`,
-		`ExternalWorkflowExecution:` + strings.Replace(fmt.Sprintf("%v", this.ExternalWorkflowExecution), "WorkflowExecution", "v14.WorkflowExecution", 1) + `,`,
-		`ChildWorkflowOnly:` + fmt.Sprintf("%v", this.ChildWorkflowOnly) + `,`,
-		`}`,
-	}, "")
-	return s
+	return n
 }
-func (this *SignalWorkflowExecutionResponse) String() string {
-	if this == nil {
-		return "nil"
+
+func (m *ReplicateEventsV2Response) Size() (n int) {
+	if m == nil {
+		return 0
 	}
-	s := strings.Join([]string{`&SignalWorkflowExecutionResponse{`,
-		`}`,
-	}, "")
-	return s
+	var l int
+	_ = l
+	return n
 }
-func (this *SignalWithStartWorkflowExecutionRequest) String() string {
-	if this == nil {
-		return "nil"
+
+func (m *SyncShardStatusRequest) Size() (n int) {
+	if m == nil {
+		return 0
 	}
-	s := strings.Join([]string{`&SignalWithStartWorkflowExecutionRequest{`,
-		`NamespaceId:` + fmt.Sprintf("%v", this.NamespaceId) + `,`,
-		`SignalWithStartRequest:` + strings.Replace(fmt.Sprintf("%v", this.SignalWithStartRequest), "SignalWithStartWorkflowExecutionRequest", "v1.SignalWithStartWorkflowExecutionRequest", 1) + `,`,
-		`}`,
-	}, "")
-	return s
-}
-func (this *SignalWithStartWorkflowExecutionResponse) String() string {
-	if this == nil {
-		return "nil"
+	var l int
+	_ = l
+	l = len(m.SourceCluster)
+	if l > 0 {
+		n += 1 + l + sovRequestResponse(uint64(l))
 	}
-	s := strings.Join([]string{`&SignalWithStartWorkflowExecutionResponse{`,
-		`RunId:` + fmt.Sprintf("%v", this.RunId) + `,`,
-		`}`,
-	}, "")
-	return s
-}
-func (this *RemoveSignalMutableStateRequest) String() string {
-	if this == nil {
-		return "nil"
+	if m.ShardId != 0 {
+		n += 1 + sovRequestResponse(uint64(m.ShardId))
 	}
-	s := strings.Join([]string{`&RemoveSignalMutableStateRequest{`,
-		`NamespaceId:` + fmt.Sprintf("%v", this.NamespaceId) + `,`,
-		`WorkflowExecution:` + strings.Replace(fmt.Sprintf("%v", this.WorkflowExecution), "WorkflowExecution", "v14.WorkflowExecution", 1) + `,`,
-		`RequestId:` + fmt.Sprintf("%v", this.RequestId) + `,`,
-		`}`,
-	}, "")
-	return s
-}
-func (this *RemoveSignalMutableStateResponse) String() string {
-	if this == nil {
-		return "nil"
+	if m.StatusTime != nil {
+		l = github_com_gogo_protobuf_types.SizeOfStdTime(*m.StatusTime)
+		n += 1 + l + sovRequestResponse(uint64(l))
 	}
-	s := strings.Join([]string{`&RemoveSignalMutableStateResponse{`,
-		`}`,
-	}, "")
-	return s
+	return n
 }
-func (this *TerminateWorkflowExecutionRequest) String() string {
-	if this == nil {
-		return "nil"
+
+func (m *SyncShardStatusResponse) Size() (n int) {
+	if m == nil {
+		return 0
 	}
-	s := strings.Join([]string{`&TerminateWorkflowExecutionRequest{`,
-		`NamespaceId:` + fmt.Sprintf("%v", this.NamespaceId) + `,`,
-		`TerminateRequest:` + strings.Replace(fmt.Sprintf("%v", this.TerminateRequest), "TerminateWorkflowExecutionRequest", "v1.TerminateWorkflowExecutionRequest", 1) + `,`,
-		`}`,
-	}, "")
-	return s
+	var l int
+	_ = l
+	return n
 }
-func (this *TerminateWorkflowExecutionResponse) String() string {
-	if this == nil {
-		return "nil"
+
+func (m *SyncActivityRequest) Size() (n int) {
+	if m == nil {
+		return 0
 	}
-	s := strings.Join([]string{`&TerminateWorkflowExecutionResponse{`,
-		`}`,
-	}, "")
-	return s
-}
-func (this *ResetWorkflowExecutionRequest) String() string {
-	if this == nil {
-		return "nil"
+	var l int
+	_ = l
+	l = len(m.NamespaceId)
+	if l > 0 {
+		n += 1 + l + sovRequestResponse(uint64(l))
 	}
-	s := strings.Join([]string{`&ResetWorkflowExecutionRequest{`,
-		`NamespaceId:` + fmt.Sprintf("%v", this.NamespaceId) + `,`,
-		`ResetRequest:` + strings.Replace(fmt.Sprintf("%v", this.ResetRequest), "ResetWorkflowExecutionRequest", "v1.ResetWorkflowExecutionRequest", 1) + `,`,
-		`}`,
-	}, "")
-	return s
-}
-func (this *ResetWorkflowExecutionResponse) String() string {
-	if this == nil {
-		return "nil"
+	l = len(m.WorkflowId)
+	if l > 0 {
+		n += 1 + l + sovRequestResponse(uint64(l))
 	}
-	s := strings.Join([]string{`&ResetWorkflowExecutionResponse{`,
-		`RunId:` + fmt.Sprintf("%v", this.RunId) + `,`,
-		`}`,
-	}, "")
-	return s
-}
-func (this *RequestCancelWorkflowExecutionRequest) String() string {
-	if this == nil {
-		return "nil"
+	l = len(m.RunId)
+	if l > 0 {
+		n += 1 + l + sovRequestResponse(uint64(l))
 	}
-	s := strings.Join([]string{`&RequestCancelWorkflowExecutionRequest{`,
-		`NamespaceId:` + fmt.Sprintf("%v", this.NamespaceId) + `,`,
-		`CancelRequest:` + strings.Replace(fmt.Sprintf("%v", this.CancelRequest), "RequestCancelWorkflowExecutionRequest", "v1.RequestCancelWorkflowExecutionRequest", 1) + `,`,
-		`ExternalInitiatedEventId:` + fmt.Sprintf("%v", this.ExternalInitiatedEventId) + `,`,
-		`ExternalWorkflowExecution:` + strings.Replace(fmt.Sprintf("%v", this.ExternalWorkflowExecution), "WorkflowExecution", "v14.WorkflowExecution", 1) + `,`,
-		`ChildWorkflowOnly:` + fmt.Sprintf("%v", this.ChildWorkflowOnly) + `,`,
-		`}`,
-	}, "")
-	return s
-}
-func (this *RequestCancelWorkflowExecutionResponse) String() string {
-	if this == nil {
-		return "nil"
+	if m.Version != 0 {
+		n += 1 + sovRequestResponse(uint64(m.Version))
 	}
-	s := strings.Join([]string{`&RequestCancelWorkflowExecutionResponse{`,
-		`}`,
-	}, "")
-	return s
-}
-func (this *ScheduleWorkflowTaskRequest) String() string {
-	if this == nil {
-		return "nil"
+	if m.ScheduledId != 0 {
+		n += 1 + sovRequestResponse(uint64(m.ScheduledId))
 	}
-	s := strings.Join([]string{`&ScheduleWorkflowTaskRequest{`,
-		`NamespaceId:` + fmt.Sprintf("%v", this.NamespaceId) + `,`,
-		`WorkflowExecution:` + strings.Replace(fmt.Sprintf("%v", this.WorkflowExecution), "WorkflowExecution", "v14.WorkflowExecution", 1) + `,`,
-		`IsFirstWorkflowTask:` + fmt.Sprintf("%v", this.IsFirstWorkflowTask) + `,`,
-		`}`,
-	}, "")
-	return s
-}
-func (this *ScheduleWorkflowTaskResponse) String() string {
-	if this == nil {
-		return "nil"
+	if m.ScheduledTime != nil {
+		l = github_com_gogo_protobuf_types.SizeOfStdTime(*m.ScheduledTime)
+		n += 1 + l + sovRequestResponse(uint64(l))
 	}
-	s := strings.Join([]string{`&ScheduleWorkflowTaskResponse{`,
-		`}`,
-	}, "")
-	return s
-}
-func (this *RecordChildExecutionCompletedRequest) String() string {
-	if this == nil {
-		return "nil"
+	if m.StartedId != 0 {
+		n += 1 + sovRequestResponse(uint64(m.StartedId))
 	}
-	s := strings.Join([]string{`&RecordChildExecutionCompletedRequest{`,
-		`NamespaceId:` + fmt.Sprintf("%v", this.NamespaceId) + `,`,
-		`WorkflowExecution:` + strings.Replace(fmt.Sprintf("%v", this.WorkflowExecution), "WorkflowExecution", "v14.WorkflowExecution", 1) + `,`,
-		`InitiatedId:` + fmt.Sprintf("%v", this.InitiatedId) + `,`,
-		`CompletedExecution:` + strings.Replace(fmt.Sprintf("%v", this.CompletedExecution), "WorkflowExecution", "v14.WorkflowExecution", 1) + `,`,
-		`CompletionEvent:` + strings.Replace(fmt.Sprintf("%v", this.CompletionEvent), "HistoryEvent", "v19.HistoryEvent", 1) + `,`,
-		`}`,
-	}, "")
-	return s
-}
-func (this *RecordChildExecutionCompletedResponse) String() string {
-	if this == nil {
-		return "nil"
+	if m.StartedTime != nil {
+		l = github_com_gogo_protobuf_types.SizeOfStdTime(*m.StartedTime)
+		n += 1 + l + sovRequestResponse(uint64(l))
 	}
-	s := strings.Join([]string{`&RecordChildExecutionCompletedResponse{`,
-		`}`,
-	}, "")
-	return s
-}
-func (this *DescribeWorkflowExecutionRequest) String() string {
-	if this == nil {
-		return "nil"
+	if m.LastHeartbeatTime != nil {
+		l = github_com_gogo_protobuf_types.SizeOfStdTime(*m.LastHeartbeatTime)
+		n += 1 + l + sovRequestResponse(uint64(l))
 	}
-	s := strings.Join([]string{`&DescribeWorkflowExecutionRequest{`,
-		`NamespaceId:` + fmt.Sprintf("%v", this.NamespaceId) + `,`,
-		`Request:` + strings.Replace(fmt.Sprintf("%v", this.Request), "DescribeWorkflowExecutionRequest", "v1.DescribeWorkflowExecutionRequest", 1) + `,`,
-		`}`,
-	}, "")
-	return s
-}
-func (this *DescribeWorkflowExecutionResponse) String() string {
-	if this == nil {
-		return "nil"
+	if m.Details != nil {
+		l = m.Details.Size()
+		n += 1 + l + sovRequestResponse(uint64(l))
 	}
-	repeatedStringForPendingActivities := "[]*PendingActivityInfo{"
-	for _, f := range this.PendingActivities {
-		repeatedStringForPendingActivities += strings.Replace(fmt.Sprintf("%v", f), "PendingActivityInfo", "v110.PendingActivityInfo", 1) + ","
+	if m.Attempt != 0 {
+		n += 1 + sovRequestResponse(uint64(m.Attempt))
 	}
-	repeatedStringForPendingActivities += "}"
-	repeatedStringForPendingChildren := "[]*PendingChildExecutionInfo{"
-	for _, f := range this.PendingChildren {
-		repeatedStringForPendingChildren += strings.Replace(fmt.Sprintf("%v", f), "PendingChildExecutionInfo", "v110.PendingChildExecutionInfo", 1) + ","
+	if m.LastFailure != nil {
+		l = m.LastFailure.Size()
+		n += 1 + l + sovRequestResponse(uint64(l))
 	}
-	repeatedStringForPendingChildren += "}"
-	s := strings.Join([]string{`&DescribeWorkflowExecutionResponse{`,
-		`ExecutionConfig:` + strings.Replace(fmt.Sprintf("%v", this.ExecutionConfig), "WorkflowExecutionConfig", "v110.WorkflowExecutionConfig", 1) + `,`,
-		`WorkflowExecutionInfo:` + strings.Replace(fmt.Sprintf("%v", this.WorkflowExecutionInfo), "WorkflowExecutionInfo", "v110.WorkflowExecutionInfo", 1) + `,`,
-		`PendingActivities:` + repeatedStringForPendingActivities + `,`,
-		`PendingChildren:` + repeatedStringForPendingChildren + `,`,
-		`}`,
-	}, "")
-	return s
-}
-func (this *ReplicateEventsV2Request) String() string {
-	if this == nil {
-		return "nil"
+	l = len(m.LastWorkerIdentity)
+	if l > 0 {
+		n += 1 + l + sovRequestResponse(uint64(l))
 	}
-	repeatedStringForVersionHistoryItems := "[]*VersionHistoryItem{"
-	for _, f := range this.VersionHistoryItems {
-		repeatedStringForVersionHistoryItems += strings.Replace(fmt.Sprintf("%v", f), "VersionHistoryItem", "v17.VersionHistoryItem", 1) + ","
+	if m.VersionHistory != nil {
+		l = m.VersionHistory.Size()
+		n += 1 + l + sovRequestResponse(uint64(l))
 	}
-	repeatedStringForVersionHistoryItems += "}"
-	s := strings.Join([]string{`&ReplicateEventsV2Request{`,
-		`NamespaceId:` + fmt.Sprintf("%v", this.NamespaceId) + `,`,
-		`WorkflowExecution:` + strings.Replace(fmt.Sprintf("%v", this.WorkflowExecution), "WorkflowExecution", "v14.WorkflowExecution", 1) + `,`,
-		`VersionHistoryItems:` + repeatedStringForVersionHistoryItems + `,`,
-		`Events:` + strings.Replace(fmt.Sprintf("%v", this.Events), "DataBlob", "v14.DataBlob", 1) + `,`,
-		`NewRunEvents:` + strings.Replace(fmt.Sprintf("%v", this.NewRunEvents), "DataBlob", "v14.DataBlob", 1) + `,`,
-		`}`,
-	}, "")
-	return s
+	return n
 }
-func (this *ReplicateEventsV2Response) String() string {
-	if this == nil {
-		return "nil"
+
+func (m *SyncActivityResponse) Size() (n int) {
+	if m == nil {
+		return 0
 	}
-	s := strings.Join([]string{`&ReplicateEventsV2Response{`,
-		`}`,
-	}, "")
-	return s
+	var l int
+	_ = l
+	return n
 }
-func (this *SyncShardStatusRequest) String() string {
-	if this == nil {
-		return "nil"
+
+func (m *DescribeMutableStateRequest) Size() (n int) {
+	if m == nil {
+		return 0
 	}
-	s := strings.Join([]string{`&SyncShardStatusRequest{`,
-		`SourceCluster:` + fmt.Sprintf("%v", this.SourceCluster) + `,`,
-		`ShardId:` + fmt.Sprintf("%v", this.ShardId) + `,`,
-		`StatusTime:` + strings.Replace(fmt.Sprintf("%v", this.StatusTime), "Timestamp", "types.Timestamp", 1) + `,`,
-		`}`,
-	}, "")
-	return s
-}
-func (this *SyncShardStatusResponse) String() string {
-	if this == nil {
-		return "nil"
+	var l int
+	_ = l
+	l = len(m.NamespaceId)
+	if l > 0 {
+		n += 1 + l + sovRequestResponse(uint64(l))
 	}
-	s := strings.Join([]string{`&SyncShardStatusResponse{`,
-		`}`,
-	}, "")
-	return s
-}
-func (this *SyncActivityRequest) String() string {
-	if this == nil {
-		return "nil"
+	if m.Execution != nil {
+		l = m.Execution.Size()
+		n += 1 + l + sovRequestResponse(uint64(l))
 	}
-	s := strings.Join([]string{`&SyncActivityRequest{`,
-		`NamespaceId:` + fmt.Sprintf("%v", this.NamespaceId) + `,`,
-		`WorkflowId:` + fmt.Sprintf("%v", this.WorkflowId) + `,`,
-		`RunId:` + fmt.Sprintf("%v", this.RunId) + `,`,
-		`Version:` + fmt.Sprintf("%v", this.Version) + `,`,
-		`ScheduledId:` + fmt.Sprintf("%v", this.ScheduledId) + `,`,
-		`ScheduledTime:` + strings.Replace(fmt.Sprintf("%v", this.ScheduledTime), "Timestamp", "types.Timestamp", 1) + `,`,
-		`StartedId:` + fmt.Sprintf("%v", this.StartedId) + `,`,
-		`StartedTime:` + strings.Replace(fmt.Sprintf("%v", this.StartedTime), "Timestamp", "types.Timestamp", 1) + `,`,
-		`LastHeartbeatTime:` + strings.Replace(fmt.Sprintf("%v", this.LastHeartbeatTime), "Timestamp", "types.Timestamp", 1) + `,`,
-		`Details:` + strings.Replace(fmt.Sprintf("%v", this.Details), "Payloads", "v14.Payloads", 1) + `,`,
-		`Attempt:` + fmt.Sprintf("%v", this.Attempt) + `,`,
-		`LastFailure:` + strings.Replace(fmt.Sprintf("%v", this.LastFailure), "Failure", "v13.Failure", 1) + `,`,
-		`LastWorkerIdentity:` + fmt.Sprintf("%v", this.LastWorkerIdentity) + `,`,
-		`VersionHistory:` + strings.Replace(fmt.Sprintf("%v", this.VersionHistory), "VersionHistory", "v17.VersionHistory", 1) + `,`,
-		`}`,
-	}, "")
-	return s
+	return n
 }
-func (this *SyncActivityResponse) String() string {
-	if this == nil {
-		return "nil"
+
+func (m *DescribeMutableStateResponse) Size() (n int) {
+	if m == nil {
+		return 0
 	}
-	s := strings.Join([]string{`&SyncActivityResponse{`,
-		`}`,
-	}, "")
-	return s
-}
-func (this *DescribeMutableStateRequest) String() string {
-	if this == nil {
-		return "nil"
+	var l int
+	_ = l
+	l = len(m.CacheMutableState)
+	if l > 0 {
+		n += 1 + l + sovRequestResponse(uint64(l))
 	}
-	s := strings.Join([]string{`&DescribeMutableStateRequest{`,
-		`NamespaceId:` + fmt.Sprintf("%v", this.NamespaceId) + `,`,
-		`Execution:` + strings.Replace(fmt.Sprintf("%v", this.Execution), "WorkflowExecution", "v14.WorkflowExecution", 1) + `,`,
-		`}`,
-	}, "")
-	return s
-}
-func (this *DescribeMutableStateResponse) String() string {
-	if this == nil {
-		return "nil"
+	l = len(m.DatabaseMutableState)
+	if l > 0 {
+		n += 1 + l + sovRequestResponse(uint64(l))
 	}
-	s := strings.Join([]string{`&DescribeMutableStateResponse{`,
-		`CacheMutableState:` + fmt.Sprintf("%v", this.CacheMutableState) + `,`,
-		`DatabaseMutableState:` + fmt.Sprintf("%v", this.DatabaseMutableState) + `,`,
-		`TreeId:` + fmt.Sprintf("%v", this.TreeId) + `,`,
-		`BranchId:` + fmt.Sprintf("%v", this.BranchId) + `,`,
-		`}`,
-	}, "")
-	return s
-}
-func (this *DescribeHistoryHostRequest) String() string {
-	if this == nil {
-		return "nil"
+	l = len(m.TreeId)
+	if l > 0 {
+		n += 1 + l + sovRequestResponse(uint64(l))
 	}
-	s := strings.Join([]string{`&DescribeHistoryHostRequest{`,
-		`HostAddress:` + fmt.Sprintf("%v", this.HostAddress) + `,`,
-		`ShardId:` + fmt.Sprintf("%v", this.ShardId) + `,`,
-		`NamespaceId:` + fmt.Sprintf("%v", this.NamespaceId) + `,`,
-		`WorkflowExecution:` + strings.Replace(fmt.Sprintf("%v", this.WorkflowExecution), "WorkflowExecution", "v14.WorkflowExecution", 1) + `,`,
-		`}`,
-	}, "")
-	return s
-}
-func (this *DescribeHistoryHostResponse) String() string {
-	if this == nil {
-		return "nil"
+	l = len(m.BranchId)
+	if l > 0 {
+		n += 1 + l + sovRequestResponse(uint64(l))
 	}
-	s := strings.Join([]string{`&DescribeHistoryHostResponse{`,
-		`ShardsNumber:` + fmt.Sprintf("%v", this.ShardsNumber) + `,`,
-		`ShardIds:` + fmt.Sprintf("%v", this.ShardIds) + `,`,
-		`NamespaceCache:` + strings.Replace(fmt.Sprintf("%v", this.NamespaceCache), "NamespaceCacheInfo", "v111.NamespaceCacheInfo", 1) + `,`,
-		`ShardControllerStatus:` + fmt.Sprintf("%v", this.ShardControllerStatus) + `,`,
-		`Address:` + fmt.Sprintf("%v", this.Address) + `,`,
-		`}`,
-	}, "")
-	return s
+	return n
 }
-func (this *CloseShardRequest) String() string {
-	if this == nil {
-		return "nil"
+
+func (m *DescribeHistoryHostRequest) Size() (n int) {
+	if m == nil {
+		return 0
 	}
-	s := strings.Join([]string{`&CloseShardRequest{`,
-		`ShardId:` + fmt.Sprintf("%v", this.ShardId) + `,`,
-		`}`,
-	}, "")
-	return s
-}
-func (this *CloseShardResponse) String() string {
-	if this == nil {
-		return "nil"
-	}
-	s := strings.Join([]string{`&CloseShardResponse{`,
-		`}`,
-	}, "")
-	return s
-}
-func (this *RemoveTaskRequest) String() string {
-	if this == nil {
-		return "nil"
+	var l int
+	_ = l
+	l = len(m.HostAddress)
+	if l > 0 {
+		n += 1 + l + sovRequestResponse(uint64(l))
 	}
-	s := strings.Join([]string{`&RemoveTaskRequest{`,
-		`ShardId:` + fmt.Sprintf("%v", this.ShardId) + `,`,
-		`Category:` + fmt.Sprintf("%v", this.Category) + `,`,
-		`TaskId:` + fmt.Sprintf("%v", this.TaskId) + `,`,
-		`VisibilityTime:` + strings.Replace(fmt.Sprintf("%v", this.VisibilityTime), "Timestamp", "types.Timestamp", 1) + `,`,
-		`}`,
-	}, "")
-	return s
-}
-func (this *RemoveTaskResponse) String() string {
-	if this == nil {
-		return "nil"
+	if m.ShardId != 0 {
+		n += 1 + sovRequestResponse(uint64(m.ShardId))
 	}
-	s := strings.Join([]string{`&RemoveTaskResponse{`,
-		`}`,
-	}, "")
-	return s
-}
-func (this *GetReplicationMessagesRequest) String() string {
-	if this == nil {
-		return "nil"
+	l = len(m.NamespaceId)
+	if l > 0 {
+		n += 1 + l + sovRequestResponse(uint64(l))
 	}
-	repeatedStringForTokens := "[]*ReplicationToken{"
-	for _, f := range this.Tokens {
-		repeatedStringForTokens += strings.Replace(fmt.Sprintf("%v", f), "ReplicationToken", "v112.ReplicationToken", 1) + ","
+	if m.WorkflowExecution != nil {
+		l = m.WorkflowExecution.Size()
+		n += 1 + l + sovRequestResponse(uint64(l))
 	}
-	repeatedStringForTokens += "}"
-	s := strings.Join([]string{`&GetReplicationMessagesRequest{`,
-		`Tokens:` + repeatedStringForTokens + `,`,
-		`ClusterName:` + fmt.Sprintf("%v", this.ClusterName) + `,`,
-		`}`,
-	}, "")
-	return s
+	return n
 }
-func (this *GetReplicationMessagesResponse) String() string {
-	if this == nil {
-		return "nil"
-	}
-	keysForShardMessages := make([]int32, 0, len(this.ShardMessages))
-	for k, _ := range this.ShardMessages {
-		keysForShardMessages = append(keysForShardMessages, k)
+
+func (m *DescribeHistoryHostResponse) Size() (n int) {
+	if m == nil {
+		return 0
 	}
-	github_com_gogo_protobuf_sortkeys.Int32s(keysForShardMessages)
-	mapStringForShardMessages := "map[int32]*v112.ReplicationMessages{"
-	for _, k := range keysForShardMessages {
-		mapStringForShardMessages += fmt.Sprintf("%v: %v,", k, this.ShardMessages[k])
+	var l int
+	_ = l
+	if m.ShardsNumber != 0 {
+		n += 1 + sovRequestResponse(uint64(m.ShardsNumber))
 	}
-	mapStringForShardMessages += "}"
-	s := strings.Join([]string{`&GetReplicationMessagesResponse{`,
-		`ShardMessages:` + mapStringForShardMessages + `,`,
-		`}`,
-	}, "")
-	return s
-}
-func (this *GetDLQReplicationMessagesRequest) String() string {
-	if this == nil {
-		return "nil"
+	if len(m.ShardIds) > 0 {
+		l = 0
+		for _, e := range m.ShardIds {
+			l += sovRequestResponse(uint64(e))
+		}
+		n += 1 + sovRequestResponse(uint64(l)) + l
 	}
-	repeatedStringForTaskInfos := "[]*ReplicationTaskInfo{"
-	for _, f := range this.TaskInfos {
-		repeatedStringForTaskInfos += strings.Replace(fmt.Sprintf("%v", f), "ReplicationTaskInfo", "v112.ReplicationTaskInfo", 1) + ","
+	if m.NamespaceCache != nil {
+		l = m.NamespaceCache.Size()
+		n += 1 + l + sovRequestResponse(uint64(l))
 	}
-	repeatedStringForTaskInfos += "}"
-	s := strings.Join([]string{`&GetDLQReplicationMessagesRequest{`,
-		`TaskInfos:` + repeatedStringForTaskInfos + `,`,
-		`}`,
-	}, "")
-	return s
-}
-func (this *GetDLQReplicationMessagesResponse) String() string {
-	if this == nil {
-		return "nil"
+	l = len(m.ShardControllerStatus)
+	if l > 0 {
+		n += 1 + l + sovRequestResponse(uint64(l))
 	}
-	repeatedStringForReplicationTasks := "[]*ReplicationTask{"
-	for _, f := range this.ReplicationTasks {
-		repeatedStringForReplicationTasks += strings.Replace(fmt.Sprintf("%v", f), "ReplicationTask", "v112.ReplicationTask", 1) + ","
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovRequestResponse(uint64(l))
 	}
-	repeatedStringForReplicationTasks += "}"
-	s := strings.Join([]string{`&GetDLQReplicationMessagesResponse{`,
-		`ReplicationTasks:` + repeatedStringForReplicationTasks + `,`,
-		`}`,
-	}, "")
-	return s
+	return n
 }
-func (this *QueryWorkflowRequest) String() string {
-	if this == nil {
-		return "nil"
+
+func (m *CloseShardRequest) Size() (n int) {
+	if m == nil {
+		return 0
 	}
-	s := strings.Join([]string{`&QueryWorkflowRequest{`,
-		`NamespaceId:` + fmt.Sprintf("%v", this.NamespaceId) + `,`,
-		`Request:` + strings.Replace(fmt.Sprintf("%v", this.Request), "QueryWorkflowRequest", "v1.QueryWorkflowRequest", 1) + `,`,
-		`}`,
-	}, "")
-	return s
-}
-func (this *QueryWorkflowResponse) String() string {
-	if this == nil {
-		return "nil"
+	var l int
+	_ = l
+	if m.ShardId != 0 {
+		n += 1 + sovRequestResponse(uint64(m.ShardId))
 	}
-	s := strings.Join([]string{`&QueryWorkflowResponse{`,
-		`Response:` + strings.Replace(fmt.Sprintf("%v", this.Response), "QueryWorkflowResponse", "v1.QueryWorkflowResponse", 1) + `,`,
-		`}`,
-	}, "")
-	return s
+	return n
 }
-func (this *ReapplyEventsRequest) String() string {
-	if this == nil {
-		return "nil"
+
+func (m *CloseShardResponse) Size() (n int) {
+	if m == nil {
+		return 0
 	}
-	s := strings.Join([]string{`&ReapplyEventsRequest{`,
-		`NamespaceId:` + fmt.Sprintf("%v", this.NamespaceId) + `,`,
-		`Request:` + strings.Replace(fmt.Sprintf("%v", this.Request), "ReapplyEventsRequest", "v113.ReapplyEventsRequest", 1) + `,`,
-		`}`,
-	}, "")
-	return s
+	var l int
+	_ = l
+	return n
 }
-func (this *ReapplyEventsResponse) String() string {
-	if this == nil {
-		return "nil"
+
+func (m *RemoveTaskRequest) Size() (n int) {
+	if m == nil {
+		return 0
 	}
-	s := strings.Join([]string{`&ReapplyEventsResponse{`,
-		`}`,
-	}, "")
-	return s
-}
-func (this *GetDLQMessagesRequest) String() string {
-	if this == nil {
-		return "nil"
+	var l int
+	_ = l
+	if m.ShardId != 0 {
+		n += 1 + sovRequestResponse(uint64(m.ShardId))
 	}
-	s := strings.Join([]string{`&GetDLQMessagesRequest{`,
-		`Type:` + fmt.Sprintf("%v", this.Type) + `,`,
-		`ShardId:` + fmt.Sprintf("%v", this.ShardId) + `,`,
-		`SourceCluster:` + fmt.Sprintf("%v", this.SourceCluster) + `,`,
-		`InclusiveEndMessageId:` + fmt.Sprintf("%v", this.InclusiveEndMessageId) + `,`,
-		`MaximumPageSize:` + fmt.Sprintf("%v", this.MaximumPageSize) + `,`,
-		`NextPageToken:` + fmt.Sprintf("%v", this.NextPageToken) + `,`,
-		`}`,
-	}, "")
-	return s
-}
-func (this *GetDLQMessagesResponse) String() string {
-	if this == nil {
-		return "nil"
+	if m.Category != 0 {
+		n += 1 + sovRequestResponse(uint64(m.Category))
 	}
-	repeatedStringForReplicationTasks := "[]*ReplicationTask{"
-	for _, f := range this.ReplicationTasks {
-		repeatedStringForReplicationTasks += strings.Replace(fmt.Sprintf("%v", f), "ReplicationTask", "v112.ReplicationTask", 1) + ","
+	if m.TaskId != 0 {
+		n += 1 + sovRequestResponse(uint64(m.TaskId))
 	}
-	repeatedStringForReplicationTasks += "}"
-	s := strings.Join([]string{`&GetDLQMessagesResponse{`,
-		`Type:` + fmt.Sprintf("%v", this.Type) + `,`,
-		`ReplicationTasks:` + repeatedStringForReplicationTasks + `,`,
-		`NextPageToken:` + fmt.Sprintf("%v", this.NextPageToken) + `,`,
-		`}`,
-	}, "")
-	return s
-}
-func (this *PurgeDLQMessagesRequest) String() string {
-	if this == nil {
-		return "nil"
+	if m.VisibilityTime != nil {
+		l = github_com_gogo_protobuf_types.SizeOfStdTime(*m.VisibilityTime)
+		n += 1 + l + sovRequestResponse(uint64(l))
 	}
-	s := strings.Join([]string{`&PurgeDLQMessagesRequest{`,
-		`Type:` + fmt.Sprintf("%v", this.Type) + `,`,
-		`ShardId:` + fmt.Sprintf("%v", this.ShardId) + `,`,
-		`SourceCluster:` + fmt.Sprintf("%v", this.SourceCluster) + `,`,
-		`InclusiveEndMessageId:` + fmt.Sprintf("%v", this.InclusiveEndMessageId) + `,`,
-		`}`,
-	}, "")
-	return s
+	return n
 }
-func (this *PurgeDLQMessagesResponse) String() string {
-	if this == nil {
-		return "nil"
+
+func (m *RemoveTaskResponse) Size() (n int) {
+	if m == nil {
+		return 0
 	}
-	s := strings.Join([]string{`&PurgeDLQMessagesResponse{`,
-		`}`,
-	}, "")
-	return s
+	var l int
+	_ = l
+	return n
 }
-func (this *MergeDLQMessagesRequest) String() string {
-	if this == nil {
-		return "nil"
+
+func (m *GetReplicationMessagesRequest) Size() (n int) {
+	if m == nil {
+		return 0
 	}
-	s := strings.Join([]string{`&MergeDLQMessagesRequest{`,
-		`Type:` + fmt.Sprintf("%v", this.Type) + `,`,
-		`ShardId:` + fmt.Sprintf("%v", this.ShardId) + `,`,
-		`SourceCluster:` + fmt.Sprintf("%v", this.SourceCluster) + `,`,
-		`InclusiveEndMessageId:` + fmt.Sprintf("%v", this.InclusiveEndMessageId) + `,`,
-		`MaximumPageSize:` + fmt.Sprintf("%v", this.MaximumPageSize) + `,`,
-		`NextPageToken:` + fmt.Sprintf("%v", this.NextPageToken) + `,`,
-		`}`,
-	}, "")
-	return s
+	var l int
+	_ = l
+	if len(m.Tokens) > 0 {
+		for _, e := range m.Tokens {
+			l = e.Size()
+			n += 1 + l + sovRequestResponse(uint64(l))
+		}
+	}
+	l = len(m.ClusterName)
+	if l > 0 {
+		n += 1 + l + sovRequestResponse(uint64(l))
+	}
+	return n
 }
-func (this *MergeDLQMessagesResponse) String() string {
-	if this == nil {
-		return "nil"
+
+func (m *GetReplicationMessagesResponse) Size() (n int) {
+	if m == nil {
+		return 0
 	}
-	s := strings.Join([]string{`&MergeDLQMessagesResponse{`,
-		`NextPageToken:` + fmt.Sprintf("%v", this.NextPageToken) + `,`,
-		`}`,
-	}, "")
-	return s
+	var l int
+	_ = l
+	if len(m.ShardMessages) > 0 {
+		for k, v := range m.ShardMessages {
+			_ = k
+			_ = v
+			l = 0
+			if v != nil {
+				l = v.Size()
+				l += 1 + sovRequestResponse(uint64(l))
+			}
+			mapEntrySize := 1 + sovRequestResponse(uint64(k)) + l
+			n += mapEntrySize + 1 + sovRequestResponse(uint64(mapEntrySize))
+		}
+	}
+	return n
 }
-func (this *RefreshWorkflowTasksRequest) String() string {
-	if this == nil {
-		return "nil"
+
+func (m *GetDLQReplicationMessagesRequest) Size() (n int) {
+	if m == nil {
+		return 0
 	}
-	s := strings.Join([]string{`&RefreshWorkflowTasksRequest{`,
-		`NamespaceId:` + fmt.Sprintf("%v", this.NamespaceId) + `,`,
-		`Request:` + strings.Replace(fmt.Sprintf("%v", this.Request), "RefreshWorkflowTasksRequest", "v113.RefreshWorkflowTasksRequest", 1) + `,`,
-		`}`,
-	}, "")
-	return s
+	var l int
+	_ = l
+	if len(m.TaskInfos) > 0 {
+		for _, e := range m.TaskInfos {
+			l = e.Size()
+			n += 1 + l + sovRequestResponse(uint64(l))
+		}
+	}
+	return n
 }
-func (this *RefreshWorkflowTasksResponse) String() string {
-	if this == nil {
-		return "nil"
+
+func (m *GetDLQReplicationMessagesResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.ReplicationTasks) > 0 {
+		for _, e := range m.ReplicationTasks {
+			l = e.Size()
+			n += 1 + l + sovRequestResponse(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *QueryWorkflowRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.NamespaceId)
+	if l > 0 {
+		n += 1 + l + sovRequestResponse(uint64(l))
+	}
+	if m.Request != nil {
+		l = m.Request.Size()
+		n += 1 + l + sovRequestResponse(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryWorkflowResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Response != nil {
+		l = m.Response.Size()
+		n += 1 + l + sovRequestResponse(uint64(l))
+	}
+	return n
+}
+
+func (m *ReapplyEventsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.NamespaceId)
+	if l > 0 {
+		n += 1 + l + sovRequestResponse(uint64(l))
+	}
+	if m.Request != nil {
+		l = m.Request.Size()
+		n += 1 + l + sovRequestResponse(uint64(l))
+	}
+	return n
+}
+
+func (m *ReapplyEventsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *GetDLQMessagesRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Type != 0 {
+		n += 1 + sovRequestResponse(uint64(m.Type))
+	}
+	if m.ShardId != 0 {
+		n += 1 + sovRequestResponse(uint64(m.ShardId))
+	}
+	l = len(m.SourceCluster)
+	if l > 0 {
+		n += 1 + l + sovRequestResponse(uint64(l))
+	}
+	if m.InclusiveEndMessageId != 0 {
+		n += 1 + sovRequestResponse(uint64(m.InclusiveEndMessageId))
+	}
+	if m.MaximumPageSize != 0 {
+		n += 1 + sovRequestResponse(uint64(m.MaximumPageSize))
+	}
+	l = len(m.NextPageToken)
+	if l > 0 {
+		n += 1 + l + sovRequestResponse(uint64(l))
+	}
+	return n
+}
+
+func (m *GetDLQMessagesResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Type != 0 {
+		n += 1 + sovRequestResponse(uint64(m.Type))
+	}
+	if len(m.ReplicationTasks) > 0 {
+		for _, e := range m.ReplicationTasks {
+			l = e.Size()
+			n += 1 + l + sovRequestResponse(uint64(l))
+		}
+	}
+	l = len(m.NextPageToken)
+	if l > 0 {
+		n += 1 + l + sovRequestResponse(uint64(l))
+	}
+	return n
+}
+
+func (m *PurgeDLQMessagesRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Type != 0 {
+		n += 1 + sovRequestResponse(uint64(m.Type))
+	}
+	if m.ShardId != 0 {
+		n += 1 + sovRequestResponse(uint64(m.ShardId))
+	}
+	l = len(m.SourceCluster)
+	if l > 0 {
+		n += 1 + l + sovRequestResponse(uint64(l))
+	}
+	if m.InclusiveEndMessageId != 0 {
+		n += 1 + sovRequestResponse(uint64(m.InclusiveEndMessageId))
+	}
+	return n
+}
+
+func (m *PurgeDLQMessagesResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MergeDLQMessagesRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Type != 0 {
+		n += 1 + sovRequestResponse(uint64(m.Type))
+	}
+	if m.ShardId != 0 {
+		n += 1 + sovRequestResponse(uint64(m.ShardId))
+	}
+	l = len(m.SourceCluster)
+	if l > 0 {
+		n += 1 + l + sovRequestResponse(uint64(l))
+	}
+	if m.InclusiveEndMessageId != 0 {
+		n += 1 + sovRequestResponse(uint64(m.InclusiveEndMessageId))
+	}
+	if m.MaximumPageSize != 0 {
+		n += 1 + sovRequestResponse(uint64(m.MaximumPageSize))
+	}
+	l = len(m.NextPageToken)
+	if l > 0 {
+		n += 1 + l + sovRequestResponse(uint64(l))
+	}
+	return n
+}
+
+func (m *MergeDLQMessagesResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.NextPageToken)
+	if l > 0 {
+		n += 1 + l + sovRequestResponse(uint64(l))
+	}
+	return n
+}
+
+func (m *RefreshWorkflowTasksRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.NamespaceId)
+	if l > 0 {
+		n += 1 + l + sovRequestResponse(uint64(l))
+	}
+	if m.Request != nil {
+		l = m.Request.Size()
+		n += 1 + l + sovRequestResponse(uint64(l))
+	}
+	return n
+}
+
+func (m *RefreshWorkflowTasksResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *DeleteWorkflowExecutionRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.NamespaceId)
+	if l > 0 {
+		n += 1 + l + sovRequestResponse(uint64(l))
+	}
+	if m.WorkflowExecution != nil {
+		l = m.WorkflowExecution.Size()
+		n += 1 + l + sovRequestResponse(uint64(l))
+	}
+	l = len(m.Identity)
+	if l > 0 {
+		n += 1 + l + sovRequestResponse(uint64(l))
+	}
+	return n
+}
+
+func (m *DeleteWorkflowExecutionResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *UpdateWorkflowExecutionRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.NamespaceId)
+	if l > 0 {
+		n += 1 + l + sovRequestResponse(uint64(l))
+	}
+	if m.WorkflowExecution != nil {
+		l = m.WorkflowExecution.Size()
+		n += 1 + l + sovRequestResponse(uint64(l))
+	}
+	l = len(m.UpdateId)
+	if l > 0 {
+		n += 1 + l + sovRequestResponse(uint64(l))
+	}
+	l = len(m.Name)
+	if l > 0 {
+		n += 1 + l + sovRequestResponse(uint64(l))
+	}
+	if m.Input != nil {
+		l = m.Input.Size()
+		n += 1 + l + sovRequestResponse(uint64(l))
+	}
+	l = len(m.Identity)
+	if l > 0 {
+		n += 1 + l + sovRequestResponse(uint64(l))
+	}
+	if m.WaitForAccepted {
+		n += 2
+	}
+	return n
+}
+
+func (m *UpdateWorkflowExecutionResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Completed {
+		n += 2
+	}
+	if m.Result != nil {
+		l = m.Result.Size()
+		n += 1 + l + sovRequestResponse(uint64(l))
+	}
+	if m.Failure != nil {
+		l = m.Failure.Size()
+		n += 1 + l + sovRequestResponse(uint64(l))
+	}
+	return n
+}
+
+func (m *AcquireShardRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.ShardId != 0 {
+		n += 1 + sovRequestResponse(uint64(m.ShardId))
+	}
+	return n
+}
+
+func (m *AcquireShardResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *PauseWorkflowExecutionRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.NamespaceId)
+	if l > 0 {
+		n += 1 + l + sovRequestResponse(uint64(l))
+	}
+	if m.WorkflowExecution != nil {
+		l = m.WorkflowExecution.Size()
+		n += 1 + l + sovRequestResponse(uint64(l))
+	}
+	l = len(m.Identity)
+	if l > 0 {
+		n += 1 + l + sovRequestResponse(uint64(l))
+	}
+	l = len(m.Reason)
+	if l > 0 {
+		n += 1 + l + sovRequestResponse(uint64(l))
+	}
+	return n
+}
+
+func (m *PauseWorkflowExecutionResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *UnpauseWorkflowExecutionRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.NamespaceId)
+	if l > 0 {
+		n += 1 + l + sovRequestResponse(uint64(l))
+	}
+	if m.WorkflowExecution != nil {
+		l = m.WorkflowExecution.Size()
+		n += 1 + l + sovRequestResponse(uint64(l))
+	}
+	l = len(m.Identity)
+	if l > 0 {
+		n += 1 + l + sovRequestResponse(uint64(l))
+	}
+	return n
+}
+
+func (m *UnpauseWorkflowExecutionResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func sovRequestResponse(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozRequestResponse(x uint64) (n int) {
+	return sovRequestResponse(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (this *StartWorkflowExecutionRequest) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&StartWorkflowExecutionRequest{`,
+		`NamespaceId:` + fmt.Sprintf("%v", this.NamespaceId) + `,`,
+		`StartRequest:` + strings.Replace(fmt.Sprintf("%v", this.StartRequest), "StartWorkflowExecutionRequest", "v1.StartWorkflowExecutionRequest", 1) + `,`,
+		`ParentExecutionInfo:` + strings.Replace(fmt.Sprintf("%v", this.ParentExecutionInfo), "ParentExecutionInfo", "v11.ParentExecutionInfo", 1) + `,`,
+		`Attempt:` + fmt.Sprintf("%v", this.Attempt) + `,`,
+		`WorkflowExecutionExpirationTime:` + strings.Replace(fmt.Sprintf("%v", this.WorkflowExecutionExpirationTime), "Timestamp", "types.Timestamp", 1) + `,`,
+		`ContinueAsNewInitiator:` + fmt.Sprintf("%v", this.ContinueAsNewInitiator) + `,`,
+		`ContinuedFailure:` + strings.Replace(fmt.Sprintf("%v", this.ContinuedFailure), "Failure", "v13.Failure", 1) + `,`,
+		`LastCompletionResult:` + strings.Replace(fmt.Sprintf("%v", this.LastCompletionResult), "Payloads", "v14.Payloads", 1) + `,`,
+		`FirstWorkflowTaskBackoff:` + strings.Replace(fmt.Sprintf("%v", this.FirstWorkflowTaskBackoff), "Duration", "types.Duration", 1) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *StartWorkflowExecutionResponse) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&StartWorkflowExecutionResponse{`,
+		`RunId:` + fmt.Sprintf("%v", this.RunId) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *GetMutableStateRequest) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&GetMutableStateRequest{`,
+		`NamespaceId:` + fmt.Sprintf("%v", this.NamespaceId) + `,`,
+		`Execution:` + strings.Replace(fmt.Sprintf("%v", this.Execution), "WorkflowExecution", "v14.WorkflowExecution", 1) + `,`,
+		`ExpectedNextEventId:` + fmt.Sprintf("%v", this.ExpectedNextEventId) + `,`,
+		`CurrentBranchToken:` + fmt.Sprintf("%v", this.CurrentBranchToken) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *GetMutableStateResponse) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&GetMutableStateResponse{`,
+		`Execution:` + strings.Replace(fmt.Sprintf("%v", this.Execution), "WorkflowExecution", "v14.WorkflowExecution", 1) + `,`,
+		`WorkflowType:` + strings.Replace(fmt.Sprintf("%v", this.WorkflowType), "WorkflowType", "v14.WorkflowType", 1) + `,`,
+		`NextEventId:` + fmt.Sprintf("%v", this.NextEventId) + `,`,
+		`PreviousStartedEventId:` + fmt.Sprintf("%v", this.PreviousStartedEventId) + `,`,
+		`LastFirstEventId:` + fmt.Sprintf("%v", this.LastFirstEventId) + `,`,
+		`TaskQueue:` + strings.Replace(fmt.Sprintf("%v", this.TaskQueue), "TaskQueue", "v15.TaskQueue", 1) + `,`,
+		`StickyTaskQueue:` + strings.Replace(fmt.Sprintf("%v", this.StickyTaskQueue), "TaskQueue", "v15.TaskQueue", 1) + `,`,
+		`StickyTaskQueueScheduleToStartTimeout:` + strings.Replace(fmt.Sprintf("%v", this.StickyTaskQueueScheduleToStartTimeout), "Duration", "types.Duration", 1) + `,`,
+		`EventStoreVersion:` + fmt.Sprintf("%v", this.EventStoreVersion) + `,`,
+		`CurrentBranchToken:` + fmt.Sprintf("%v", this.CurrentBranchToken) + `,`,
+		`WorkflowState:` + fmt.Sprintf("%v", this.WorkflowState) + `,`,
+		`WorkflowStatus:` + fmt.Sprintf("%v", this.WorkflowStatus) + `,`,
+		`VersionHistories:` + strings.Replace(fmt.Sprintf("%v", this.VersionHistories), "VersionHistories", "v17.VersionHistories", 1) + `,`,
+		`IsStickyTaskQueueEnabled:` + fmt.Sprintf("%v", this.IsStickyTaskQueueEnabled) + `,`,
+		`HistorySizeBytes:` + fmt.Sprintf("%v", this.HistorySizeBytes) + `,`,
+		`SuggestContinueAsNew:` + fmt.Sprintf("%v", this.SuggestContinueAsNew) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *PollMutableStateRequest) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&PollMutableStateRequest{`,
+		`NamespaceId:` + fmt.Sprintf("%v", this.NamespaceId) + `,`,
+		`Execution:` + strings.Replace(fmt.Sprintf("%v", this.Execution), "WorkflowExecution", "v14.WorkflowExecution", 1) + `,`,
+		`ExpectedNextEventId:` + fmt.Sprintf("%v", this.ExpectedNextEventId) + `,`,
+		`CurrentBranchToken:` + fmt.Sprintf("%v", this.CurrentBranchToken) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *PollMutableStateResponse) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&PollMutableStateResponse{`,
+		`Execution:` + strings.Replace(fmt.Sprintf("%v", this.Execution), "WorkflowExecution", "v14.WorkflowExecution", 1) + `,`,
+		`WorkflowType:` + strings.Replace(fmt.Sprintf("%v", this.WorkflowType), "WorkflowType", "v14.WorkflowType", 1) + `,`,
+		`NextEventId:` + fmt.Sprintf("%v", this.NextEventId) + `,`,
+		`PreviousStartedEventId:` + fmt.Sprintf("%v", this.PreviousStartedEventId) + `,`,
+		`LastFirstEventId:` + fmt.Sprintf("%v", this.LastFirstEventId) + `,`,
+		`TaskQueue:` + strings.Replace(fmt.Sprintf("%v", this.TaskQueue), "TaskQueue", "v15.TaskQueue", 1) + `,`,
+		`StickyTaskQueue:` + strings.Replace(fmt.Sprintf("%v", this.StickyTaskQueue), "TaskQueue", "v15.TaskQueue", 1) + `,`,
+		`StickyTaskQueueScheduleToStartTimeout:` + strings.Replace(fmt.Sprintf("%v", this.StickyTaskQueueScheduleToStartTimeout), "Duration", "types.Duration", 1) + `,`,
+		`CurrentBranchToken:` + fmt.Sprintf("%v", this.CurrentBranchToken) + `,`,
+		`VersionHistories:` + strings.Replace(fmt.Sprintf("%v", this.VersionHistories), "VersionHistories", "v17.VersionHistories", 1) + `,`,
+		`WorkflowState:` + fmt.Sprintf("%v", this.WorkflowState) + `,`,
+		`WorkflowStatus:` + fmt.Sprintf("%v", this.WorkflowStatus) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *ResetStickyTaskQueueRequest) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&ResetStickyTaskQueueRequest{`,
+		`NamespaceId:` + fmt.Sprintf("%v", this.NamespaceId) + `,`,
+		`Execution:` + strings.Replace(fmt.Sprintf("%v", this.Execution), "WorkflowExecution", "v14.WorkflowExecution", 1) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *ResetStickyTaskQueueResponse) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&ResetStickyTaskQueueResponse{`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *RecordWorkflowTaskStartedRequest) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&RecordWorkflowTaskStartedRequest{`,
+		`NamespaceId:` + fmt.Sprintf("%v", this.NamespaceId) + `,`,
+		`WorkflowExecution:` + strings.Replace(fmt.Sprintf("%v", this.WorkflowExecution), "WorkflowExecution", "v14.WorkflowExecution", 1) + `,`,
+		`ScheduleId:` + fmt.Sprintf("%v", this.ScheduleId) + `,`,
+		`TaskId:` + fmt.Sprintf("%v", this.TaskId) + `,`,
+		`RequestId:` + fmt.Sprintf("%v", this.RequestId) + `,`,
+		`PollRequest:` + strings.Replace(fmt.Sprintf("%v", this.PollRequest), "PollWorkflowTaskQueueRequest", "v1.PollWorkflowTaskQueueRequest", 1) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *RecordWorkflowTaskStartedResponse) String() string {
+	if this == nil {
+		return "nil"
+	}
+	keysForQueries := make([]string, 0, len(this.Queries))
+	for k, _ := range this.Queries {
+		keysForQueries = append(keysForQueries, k)
+	}
+	github_com_gogo_protobuf_sortkeys.Strings(keysForQueries)
+	mapStringForQueries := "map[string]*v18.WorkflowQuery{"
+	for _, k := range keysForQueries {
+		mapStringForQueries += fmt.Sprintf("%v: %v,", k, this.Queries[k])
+	}
+	mapStringForQueries += "}"
+	repeatedStringForUpdates := "[]*WorkflowUpdateRequest{"
+	for _, f := range this.Updates {
+		repeatedStringForUpdates += strings.Replace(fmt.Sprintf("%v", f), "WorkflowUpdateRequest", "v110.WorkflowUpdateRequest", 1) + ","
+	}
+	repeatedStringForUpdates += "}"
+	s := strings.Join([]string{`&RecordWorkflowTaskStartedResponse{`,
+		`WorkflowType:` + strings.Replace(fmt.Sprintf("%v", this.WorkflowType), "WorkflowType", "v14.WorkflowType", 1) + `,`,
+		`PreviousStartedEventId:` + fmt.Sprintf("%v", this.PreviousStartedEventId) + `,`,
+		`ScheduledEventId:` + fmt.Sprintf("%v", this.ScheduledEventId) + `,`,
+		`StartedEventId:` + fmt.Sprintf("%v", this.StartedEventId) + `,`,
+		`NextEventId:` + fmt.Sprintf("%v", this.NextEventId) + `,`,
+		`Attempt:` + fmt.Sprintf("%v", this.Attempt) + `,`,
+		`StickyExecutionEnabled:` + fmt.Sprintf("%v", this.StickyExecutionEnabled) + `,`,
+		`WorkflowTaskInfo:` + strings.Replace(fmt.Sprintf("%v", this.WorkflowTaskInfo), "TransientWorkflowTaskInfo", "v17.TransientWorkflowTaskInfo", 1) + `,`,
+		`WorkflowExecutionTaskQueue:` + strings.Replace(fmt.Sprintf("%v", this.WorkflowExecutionTaskQueue), "TaskQueue", "v15.TaskQueue", 1) + `,`,
+		`EventStoreVersion:` + fmt.Sprintf("%v", this.EventStoreVersion) + `,`,
+		`BranchToken:` + fmt.Sprintf("%v", this.BranchToken) + `,`,
+		`ScheduledTime:` + strings.Replace(fmt.Sprintf("%v", this.ScheduledTime), "Timestamp", "types.Timestamp", 1) + `,`,
+		`StartedTime:` + strings.Replace(fmt.Sprintf("%v", this.StartedTime), "Timestamp", "types.Timestamp", 1) + `,`,
+		`Queries:` + mapStringForQueries + `,`,
+		`HistorySizeBytes:` + fmt.Sprintf("%v", this.HistorySizeBytes) + `,`,
+		`SuggestContinueAsNew:` + fmt.Sprintf("%v", this.SuggestContinueAsNew) + `,`,
+		`Updates:` + repeatedStringForUpdates + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *RecordActivityTaskStartedRequest) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&RecordActivityTaskStartedRequest{`,
+		`NamespaceId:` + fmt.Sprintf("%v", this.NamespaceId) + `,`,
+		`WorkflowExecution:` + strings.Replace(fmt.Sprintf("%v", this.WorkflowExecution), "WorkflowExecution", "v14.WorkflowExecution", 1) + `,`,
+		`ScheduleId:` + fmt.Sprintf("%v", this.ScheduleId) + `,`,
+		`TaskId:` + fmt.Sprintf("%v", this.TaskId) + `,`,
+		`RequestId:` + fmt.Sprintf("%v", this.RequestId) + `,`,
+		`PollRequest:` + strings.Replace(fmt.Sprintf("%v", this.PollRequest), "PollActivityTaskQueueRequest", "v1.PollActivityTaskQueueRequest", 1) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *RecordActivityTaskStartedResponse) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&RecordActivityTaskStartedResponse{`,
+		`ScheduledEvent:` + strings.Replace(fmt.Sprintf("%v", this.ScheduledEvent), "HistoryEvent", "v19.HistoryEvent", 1) + `,`,
+		`StartedTime:` + strings.Replace(fmt.Sprintf("%v", this.StartedTime), "Timestamp", "types.Timestamp", 1) + `,`,
+		`Attempt:` + fmt.Sprintf("%v", this.Attempt) + `,`,
+		`CurrentAttemptScheduledTime:` + strings.Replace(fmt.Sprintf("%v", this.CurrentAttemptScheduledTime), "Timestamp", "types.Timestamp", 1) + `,`,
+		`HeartbeatDetails:` + strings.Replace(fmt.Sprintf("%v", this.HeartbeatDetails), "Payloads", "v14.Payloads", 1) + `,`,
+		`WorkflowType:` + strings.Replace(fmt.Sprintf("%v", this.WorkflowType), "WorkflowType", "v14.WorkflowType", 1) + `,`,
+		`WorkflowNamespace:` + fmt.Sprintf("%v", this.WorkflowNamespace) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *RespondWorkflowTaskCompletedRequest) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&RespondWorkflowTaskCompletedRequest{`,
+		`NamespaceId:` + fmt.Sprintf("%v", this.NamespaceId) + `,`,
+		`CompleteRequest:` + strings.Replace(fmt.Sprintf("%v", this.CompleteRequest), "RespondWorkflowTaskCompletedRequest", "v1.RespondWorkflowTaskCompletedRequest", 1) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *RespondWorkflowTaskCompletedResponse) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&RespondWorkflowTaskCompletedResponse{`,
+		`StartedResponse:` + strings.Replace(this.StartedResponse.String(), "RecordWorkflowTaskStartedResponse", "RecordWorkflowTaskStartedResponse", 1) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *RespondWorkflowTaskFailedRequest) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&RespondWorkflowTaskFailedRequest{`,
+		`NamespaceId:` + fmt.Sprintf("%v", this.NamespaceId) + `,`,
+		`FailedRequest:` + strings.Replace(fmt.Sprintf("%v", this.FailedRequest), "RespondWorkflowTaskFailedRequest", "v1.RespondWorkflowTaskFailedRequest", 1) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *RespondWorkflowTaskFailedResponse) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&RespondWorkflowTaskFailedResponse{`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *RecordActivityTaskHeartbeatRequest) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&RecordActivityTaskHeartbeatRequest{`,
+		`NamespaceId:` + fmt.Sprintf("%v", this.NamespaceId) + `,`,
+		`HeartbeatRequest:` + strings.Replace(fmt.Sprintf("%v", this.HeartbeatRequest), "RecordActivityTaskHeartbeatRequest", "v1.RecordActivityTaskHeartbeatRequest", 1) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *RecordActivityTaskHeartbeatResponse) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&RecordActivityTaskHeartbeatResponse{`,
+		`CancelRequested:` + fmt.Sprintf("%v", this.CancelRequested) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *RespondActivityTaskCompletedRequest) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&RespondActivityTaskCompletedRequest{`,
+		`NamespaceId:` + fmt.Sprintf("%v", this.NamespaceId) + `,`,
+		`CompleteRequest:` + strings.Replace(fmt.Sprintf("%v", this.CompleteRequest), "RespondActivityTaskCompletedRequest", "v1.RespondActivityTaskCompletedRequest", 1) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *RespondActivityTaskCompletedResponse) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&RespondActivityTaskCompletedResponse{`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *RespondActivityTaskFailedRequest) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&RespondActivityTaskFailedRequest{`,
+		`NamespaceId:` + fmt.Sprintf("%v", this.NamespaceId) + `,`,
+		`FailedRequest:` + strings.Replace(fmt.Sprintf("%v", this.FailedRequest), "RespondActivityTaskFailedRequest", "v1.RespondActivityTaskFailedRequest", 1) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *RespondActivityTaskFailedResponse) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&RespondActivityTaskFailedResponse{`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *RespondActivityTaskCanceledRequest) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&RespondActivityTaskCanceledRequest{`,
+		`NamespaceId:` + fmt.Sprintf("%v", this.NamespaceId) + `,`,
+		`CancelRequest:` + strings.Replace(fmt.Sprintf("%v", this.CancelRequest), "RespondActivityTaskCanceledRequest", "v1.RespondActivityTaskCanceledRequest", 1) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *RespondActivityTaskCanceledResponse) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&RespondActivityTaskCanceledResponse{`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *SignalWorkflowExecutionRequest) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&SignalWorkflowExecutionRequest{`,
+		`NamespaceId:` + fmt.Sprintf("%v", this.NamespaceId) + `,`,
+		`SignalRequest:` + strings.Replace(fmt.Sprintf("%v", this.SignalRequest), "SignalWorkflowExecutionRequest", "v1.SignalWorkflowExecutionRequest", 1) + `,`,
+		`ExternalWorkflowExecution:` + strings.Replace(fmt.Sprintf("%v", this.ExternalWorkflowExecution), "WorkflowExecution", "v14.WorkflowExecution", 1) + `,`,
+		`ChildWorkflowOnly:` + fmt.Sprintf("%v", this.ChildWorkflowOnly) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *SignalWorkflowExecutionResponse) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&SignalWorkflowExecutionResponse{`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *SignalWithStartWorkflowExecutionRequest) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&SignalWithStartWorkflowExecutionRequest{`,
+		`NamespaceId:` + fmt.Sprintf("%v", this.NamespaceId) + `,`,
+		`SignalWithStartRequest:` + strings.Replace(fmt.Sprintf("%v", this.SignalWithStartRequest), "SignalWithStartWorkflowExecutionRequest", "v1.SignalWithStartWorkflowExecutionRequest", 1) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *SignalWithStartWorkflowExecutionResponse) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&SignalWithStartWorkflowExecutionResponse{`,
+		`RunId:` + fmt.Sprintf("%v", this.RunId) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *RemoveSignalMutableStateRequest) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&RemoveSignalMutableStateRequest{`,
+		`NamespaceId:` + fmt.Sprintf("%v", this.NamespaceId) + `,`,
+		`WorkflowExecution:` + strings.Replace(fmt.Sprintf("%v", this.WorkflowExecution), "WorkflowExecution", "v14.WorkflowExecution", 1) + `,`,
+		`RequestId:` + fmt.Sprintf("%v", this.RequestId) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *RemoveSignalMutableStateResponse) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&RemoveSignalMutableStateResponse{`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *TerminateWorkflowExecutionRequest) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&TerminateWorkflowExecutionRequest{`,
+		`NamespaceId:` + fmt.Sprintf("%v", this.NamespaceId) + `,`,
+		`TerminateRequest:` + strings.Replace(fmt.Sprintf("%v", this.TerminateRequest), "TerminateWorkflowExecutionRequest", "v1.TerminateWorkflowExecutionRequest", 1) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *TerminateWorkflowExecutionResponse) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&TerminateWorkflowExecutionResponse{`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *ResetWorkflowExecutionRequest) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&ResetWorkflowExecutionRequest{`,
+		`NamespaceId:` + fmt.Sprintf("%v", this.NamespaceId) + `,`,
+		`ResetRequest:` + strings.Replace(fmt.Sprintf("%v", this.ResetRequest), "ResetWorkflowExecutionRequest", "v1.ResetWorkflowExecutionRequest", 1) + `,`,
+		`WorkflowTaskScheduledEventId:` + fmt.Sprintf("%v", this.WorkflowTaskScheduledEventId) + `,`,
+		`ReapplyExcludeSignals:` + fmt.Sprintf("%v", this.ReapplyExcludeSignals) + `,`,
+		`ReapplyExcludeAll:` + fmt.Sprintf("%v", this.ReapplyExcludeAll) + `,`,
+		`DryRun:` + fmt.Sprintf("%v", this.DryRun) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *ResetWorkflowExecutionResponse) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&ResetWorkflowExecutionResponse{`,
+		`RunId:` + fmt.Sprintf("%v", this.RunId) + `,`,
+		`Preview:` + strings.Replace(this.Preview.String(), "ResetWorkflowExecutionPreview", "ResetWorkflowExecutionPreview", 1) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *ResetWorkflowExecutionPreview) String() string {
+	if this == nil {
+		return "nil"
+	}
+	repeatedStringForDroppedEvents := "[]*HistoryEvent{"
+	for _, f := range this.DroppedEvents {
+		repeatedStringForDroppedEvents += strings.Replace(fmt.Sprintf("%v", f), "HistoryEvent", "v19.HistoryEvent", 1) + ","
+	}
+	repeatedStringForDroppedEvents += "}"
+	repeatedStringForFailedActivities := "[]*PendingActivityInfo{"
+	for _, f := range this.FailedActivities {
+		repeatedStringForFailedActivities += strings.Replace(fmt.Sprintf("%v", f), "PendingActivityInfo", "v110.PendingActivityInfo", 1) + ","
+	}
+	repeatedStringForFailedActivities += "}"
+	repeatedStringForPendingChildren := "[]*PendingChildExecutionInfo{"
+	for _, f := range this.PendingChildren {
+		repeatedStringForPendingChildren += strings.Replace(fmt.Sprintf("%v", f), "PendingChildExecutionInfo", "v110.PendingChildExecutionInfo", 1) + ","
+	}
+	repeatedStringForPendingChildren += "}"
+	repeatedStringForReappliedEvents := "[]*HistoryEvent{"
+	for _, f := range this.ReappliedEvents {
+		repeatedStringForReappliedEvents += strings.Replace(fmt.Sprintf("%v", f), "HistoryEvent", "v19.HistoryEvent", 1) + ","
+	}
+	repeatedStringForReappliedEvents += "}"
+	s := strings.Join([]string{`&ResetWorkflowExecutionPreview{`,
+		`BaseRunId:` + fmt.Sprintf("%v", this.BaseRunId) + `,`,
+		`WorkflowTaskFinishEventId:` + fmt.Sprintf("%v", this.WorkflowTaskFinishEventId) + `,`,
+		`TerminatedRunId:` + fmt.Sprintf("%v", this.TerminatedRunId) + `,`,
+		`DroppedEvents:` + repeatedStringForDroppedEvents + `,`,
+		`FailedActivities:` + repeatedStringForFailedActivities + `,`,
+		`PendingChildren:` + repeatedStringForPendingChildren + `,`,
+		`ReappliedEvents:` + repeatedStringForReappliedEvents + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *RequestCancelWorkflowExecutionRequest) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&RequestCancelWorkflowExecutionRequest{`,
+		`NamespaceId:` + fmt.Sprintf("%v", this.NamespaceId) + `,`,
+		`CancelRequest:` + strings.Replace(fmt.Sprintf("%v", this.CancelRequest), "RequestCancelWorkflowExecutionRequest", "v1.RequestCancelWorkflowExecutionRequest", 1) + `,`,
+		`ExternalInitiatedEventId:` + fmt.Sprintf("%v", this.ExternalInitiatedEventId) + `,`,
+		`ExternalWorkflowExecution:` + strings.Replace(fmt.Sprintf("%v", this.ExternalWorkflowExecution), "WorkflowExecution", "v14.WorkflowExecution", 1) + `,`,
+		`ChildWorkflowOnly:` + fmt.Sprintf("%v", this.ChildWorkflowOnly) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *RequestCancelWorkflowExecutionResponse) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&RequestCancelWorkflowExecutionResponse{`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *ScheduleWorkflowTaskRequest) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&ScheduleWorkflowTaskRequest{`,
+		`NamespaceId:` + fmt.Sprintf("%v", this.NamespaceId) + `,`,
+		`WorkflowExecution:` + strings.Replace(fmt.Sprintf("%v", this.WorkflowExecution), "WorkflowExecution", "v14.WorkflowExecution", 1) + `,`,
+		`IsFirstWorkflowTask:` + fmt.Sprintf("%v", this.IsFirstWorkflowTask) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *ScheduleWorkflowTaskResponse) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&ScheduleWorkflowTaskResponse{`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *RecordChildExecutionCompletedRequest) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&RecordChildExecutionCompletedRequest{`,
+		`NamespaceId:` + fmt.Sprintf("%v", this.NamespaceId) + `,`,
+		`WorkflowExecution:` + strings.Replace(fmt.Sprintf("%v", this.WorkflowExecution), "WorkflowExecution", "v14.WorkflowExecution", 1) + `,`,
+		`InitiatedId:` + fmt.Sprintf("%v", this.InitiatedId) + `,`,
+		`CompletedExecution:` + strings.Replace(fmt.Sprintf("%v", this.CompletedExecution), "WorkflowExecution", "v14.WorkflowExecution", 1) + `,`,
+		`CompletionEvent:` + strings.Replace(fmt.Sprintf("%v", this.CompletionEvent), "HistoryEvent", "v19.HistoryEvent", 1) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *RecordChildExecutionCompletedResponse) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&RecordChildExecutionCompletedResponse{`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *DescribeWorkflowExecutionRequest) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&DescribeWorkflowExecutionRequest{`,
+		`NamespaceId:` + fmt.Sprintf("%v", this.NamespaceId) + `,`,
+		`Request:` + strings.Replace(fmt.Sprintf("%v", this.Request), "DescribeWorkflowExecutionRequest", "v1.DescribeWorkflowExecutionRequest", 1) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *DescribeWorkflowExecutionResponse) String() string {
+	if this == nil {
+		return "nil"
+	}
+	repeatedStringForPendingActivities := "[]*PendingActivityInfo{"
+	for _, f := range this.PendingActivities {
+		repeatedStringForPendingActivities += strings.Replace(fmt.Sprintf("%v", f), "PendingActivityInfo", "v110.PendingActivityInfo", 1) + ","
+	}
+	repeatedStringForPendingActivities += "}"
+	repeatedStringForPendingChildren := "[]*PendingChildExecutionInfo{"
+	for _, f := range this.PendingChildren {
+		repeatedStringForPendingChildren += strings.Replace(fmt.Sprintf("%v", f), "PendingChildExecutionInfo", "v110.PendingChildExecutionInfo", 1) + ","
+	}
+	repeatedStringForPendingChildren += "}"
+	s := strings.Join([]string{`&DescribeWorkflowExecutionResponse{`,
+		`ExecutionConfig:` + strings.Replace(fmt.Sprintf("%v", this.ExecutionConfig), "WorkflowExecutionConfig", "v110.WorkflowExecutionConfig", 1) + `,`,
+		`WorkflowExecutionInfo:` + strings.Replace(fmt.Sprintf("%v", this.WorkflowExecutionInfo), "WorkflowExecutionInfo", "v110.WorkflowExecutionInfo", 1) + `,`,
+		`PendingActivities:` + repeatedStringForPendingActivities + `,`,
+		`PendingChildren:` + repeatedStringForPendingChildren + `,`,
+		`HistorySizeBytes:` + fmt.Sprintf("%v", this.HistorySizeBytes) + `,`,
+		`SuggestContinueAsNew:` + fmt.Sprintf("%v", this.SuggestContinueAsNew) + `,`,
+		`Paused:` + fmt.Sprintf("%v", this.Paused) + `,`,
+		`PauseReason:` + fmt.Sprintf("%v", this.PauseReason) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *ReplicateEventsV2Request) String() string {
+	if this == nil {
+		return "nil"
+	}
+	repeatedStringForVersionHistoryItems := "[]*VersionHistoryItem{"
+	for _, f := range this.VersionHistoryItems {
+		repeatedStringForVersionHistoryItems += strings.Replace(fmt.Sprintf("%v", f), "VersionHistoryItem", "v17.VersionHistoryItem", 1) + ","
+	}
+	repeatedStringForVersionHistoryItems += "}"
+	s := strings.Join([]string{`&ReplicateEventsV2Request{`,
+		`NamespaceId:` + fmt.Sprintf("%v", this.NamespaceId) + `,`,
+		`WorkflowExecution:` + strings.Replace(fmt.Sprintf("%v", this.WorkflowExecution), "WorkflowExecution", "v14.WorkflowExecution", 1) + `,`,
+		`VersionHistoryItems:` + repeatedStringForVersionHistoryItems + `,`,
+		`Events:` + strings.Replace(fmt.Sprintf("%v", this.Events), "DataBlob", "v14.DataBlob", 1) + `,`,
+		`NewRunEvents:` + strings.Replace(fmt.Sprintf("%v", this.NewRunEvents), "DataBlob", "v14.DataBlob", 1) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *ReplicateEventsV2Response) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&ReplicateEventsV2Response{`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *SyncShardStatusRequest) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&SyncShardStatusRequest{`,
+		`SourceCluster:` + fmt.Sprintf("%v", this.SourceCluster) + `,`,
+		`ShardId:` + fmt.Sprintf("%v", this.ShardId) + `,`,
+		`StatusTime:` + strings.Replace(fmt.Sprintf("%v", this.StatusTime), "Timestamp", "types.Timestamp", 1) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *SyncShardStatusResponse) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&SyncShardStatusResponse{`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *SyncActivityRequest) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&SyncActivityRequest{`,
+		`NamespaceId:` + fmt.Sprintf("%v", this.NamespaceId) + `,`,
+		`WorkflowId:` + fmt.Sprintf("%v", this.WorkflowId) + `,`,
+		`RunId:` + fmt.Sprintf("%v", this.RunId) + `,`,
+		`Version:` + fmt.Sprintf("%v", this.Version) + `,`,
+		`ScheduledId:` + fmt.Sprintf("%v", this.ScheduledId) + `,`,
+		`ScheduledTime:` + strings.Replace(fmt.Sprintf("%v", this.ScheduledTime), "Timestamp", "types.Timestamp", 1) + `,`,
+		`StartedId:` + fmt.Sprintf("%v", this.StartedId) + `,`,
+		`StartedTime:` + strings.Replace(fmt.Sprintf("%v", this.StartedTime), "Timestamp", "types.Timestamp", 1) + `,`,
+		`LastHeartbeatTime:` + strings.Replace(fmt.Sprintf("%v", this.LastHeartbeatTime), "Timestamp", "types.Timestamp", 1) + `,`,
+		`Details:` + strings.Replace(fmt.Sprintf("%v", this.Details), "Payloads", "v14.Payloads", 1) + `,`,
+		`Attempt:` + fmt.Sprintf("%v", this.Attempt) + `,`,
+		`LastFailure:` + strings.Replace(fmt.Sprintf("%v", this.LastFailure), "Failure", "v13.Failure", 1) + `,`,
+		`LastWorkerIdentity:` + fmt.Sprintf("%v", this.LastWorkerIdentity) + `,`,
+		`VersionHistory:` + strings.Replace(fmt.Sprintf("%v", this.VersionHistory), "VersionHistory", "v17.VersionHistory", 1) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *SyncActivityResponse) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&SyncActivityResponse{`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *DescribeMutableStateRequest) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&DescribeMutableStateRequest{`,
+		`NamespaceId:` + fmt.Sprintf("%v", this.NamespaceId) + `,`,
+		`Execution:` + strings.Replace(fmt.Sprintf("%v", this.Execution), "WorkflowExecution", "v14.WorkflowExecution", 1) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *DescribeMutableStateResponse) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&DescribeMutableStateResponse{`,
+		`CacheMutableState:` + fmt.Sprintf("%v", this.CacheMutableState) + `,`,
+		`DatabaseMutableState:` + fmt.Sprintf("%v", this.DatabaseMutableState) + `,`,
+		`TreeId:` + fmt.Sprintf("%v", this.TreeId) + `,`,
+		`BranchId:` + fmt.Sprintf("%v", this.BranchId) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *DescribeHistoryHostRequest) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&DescribeHistoryHostRequest{`,
+		`HostAddress:` + fmt.Sprintf("%v", this.HostAddress) + `,`,
+		`ShardId:` + fmt.Sprintf("%v", this.ShardId) + `,`,
+		`NamespaceId:` + fmt.Sprintf("%v", this.NamespaceId) + `,`,
+		`WorkflowExecution:` + strings.Replace(fmt.Sprintf("%v", this.WorkflowExecution), "WorkflowExecution", "v14.WorkflowExecution", 1) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *DescribeHistoryHostResponse) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&DescribeHistoryHostResponse{`,
+		`ShardsNumber:` + fmt.Sprintf("%v", this.ShardsNumber) + `,`,
+		`ShardIds:` + fmt.Sprintf("%v", this.ShardIds) + `,`,
+		`NamespaceCache:` + strings.Replace(fmt.Sprintf("%v", this.NamespaceCache), "NamespaceCacheInfo", "v111.NamespaceCacheInfo", 1) + `,`,
+		`ShardControllerStatus:` + fmt.Sprintf("%v", this.ShardControllerStatus) + `,`,
+		`Address:` + fmt.Sprintf("%v", this.Address) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *CloseShardRequest) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&CloseShardRequest{`,
+		`ShardId:` + fmt.Sprintf("%v", this.ShardId) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *CloseShardResponse) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&CloseShardResponse{`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *RemoveTaskRequest) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&RemoveTaskRequest{`,
+		`ShardId:` + fmt.Sprintf("%v", this.ShardId) + `,`,
+		`Category:` + fmt.Sprintf("%v", this.Category) + `,`,
+		`TaskId:` + fmt.Sprintf("%v", this.TaskId) + `,`,
+		`VisibilityTime:` + strings.Replace(fmt.Sprintf("%v", this.VisibilityTime), "Timestamp", "types.Timestamp", 1) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *RemoveTaskResponse) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&RemoveTaskResponse{`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *GetReplicationMessagesRequest) String() string {
+	if this == nil {
+		return "nil"
+	}
+	repeatedStringForTokens := "[]*ReplicationToken{"
+	for _, f := range this.Tokens {
+		repeatedStringForTokens += strings.Replace(fmt.Sprintf("%v", f), "ReplicationToken", "v112.ReplicationToken", 1) + ","
+	}
+	repeatedStringForTokens += "}"
+	s := strings.Join([]string{`&GetReplicationMessagesRequest{`,
+		`Tokens:` + repeatedStringForTokens + `,`,
+		`ClusterName:` + fmt.Sprintf("%v", this.ClusterName) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *GetReplicationMessagesResponse) String() string {
+	if this == nil {
+		return "nil"
+	}
+	keysForShardMessages := make([]int32, 0, len(this.ShardMessages))
+	for k, _ := range this.ShardMessages {
+		keysForShardMessages = append(keysForShardMessages, k)
+	}
+	github_com_gogo_protobuf_sortkeys.Int32s(keysForShardMessages)
+	mapStringForShardMessages := "map[int32]*v112.ReplicationMessages{"
+	for _, k := range keysForShardMessages {
+		mapStringForShardMessages += fmt.Sprintf("%v: %v,", k, this.ShardMessages[k])
+	}
+	mapStringForShardMessages += "}"
+	s := strings.Join([]string{`&GetReplicationMessagesResponse{`,
+		`ShardMessages:` + mapStringForShardMessages + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *GetDLQReplicationMessagesRequest) String() string {
+	if this == nil {
+		return "nil"
+	}
+	repeatedStringForTaskInfos := "[]*ReplicationTaskInfo{"
+	for _, f := range this.TaskInfos {
+		repeatedStringForTaskInfos += strings.Replace(fmt.Sprintf("%v", f), "ReplicationTaskInfo", "v112.ReplicationTaskInfo", 1) + ","
+	}
+	repeatedStringForTaskInfos += "}"
+	s := strings.Join([]string{`&GetDLQReplicationMessagesRequest{`,
+		`TaskInfos:` + repeatedStringForTaskInfos + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *GetDLQReplicationMessagesResponse) String() string {
+	if this == nil {
+		return "nil"
+	}
+	repeatedStringForReplicationTasks := "[]*ReplicationTask{"
+	for _, f := range this.ReplicationTasks {
+		repeatedStringForReplicationTasks += strings.Replace(fmt.Sprintf("%v", f), "ReplicationTask", "v112.ReplicationTask", 1) + ","
+	}
+	repeatedStringForReplicationTasks += "}"
+	s := strings.Join([]string{`&GetDLQReplicationMessagesResponse{`,
+		`ReplicationTasks:` + repeatedStringForReplicationTasks + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *QueryWorkflowRequest) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&QueryWorkflowRequest{`,
+		`NamespaceId:` + fmt.Sprintf("%v", this.NamespaceId) + `,`,
+		`Request:` + strings.Replace(fmt.Sprintf("%v", this.Request), "QueryWorkflowRequest", "v1.QueryWorkflowRequest", 1) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *QueryWorkflowResponse) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&QueryWorkflowResponse{`,
+		`Response:` + strings.Replace(fmt.Sprintf("%v", this.Response), "QueryWorkflowResponse", "v1.QueryWorkflowResponse", 1) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *ReapplyEventsRequest) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&ReapplyEventsRequest{`,
+		`NamespaceId:` + fmt.Sprintf("%v", this.NamespaceId) + `,`,
+		`Request:` + strings.Replace(fmt.Sprintf("%v", this.Request), "ReapplyEventsRequest", "v113.ReapplyEventsRequest", 1) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *ReapplyEventsResponse) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&ReapplyEventsResponse{`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *GetDLQMessagesRequest) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&GetDLQMessagesRequest{`,
+		`Type:` + fmt.Sprintf("%v", this.Type) + `,`,
+		`ShardId:` + fmt.Sprintf("%v", this.ShardId) + `,`,
+		`SourceCluster:` + fmt.Sprintf("%v", this.SourceCluster) + `,`,
+		`InclusiveEndMessageId:` + fmt.Sprintf("%v", this.InclusiveEndMessageId) + `,`,
+		`MaximumPageSize:` + fmt.Sprintf("%v", this.MaximumPageSize) + `,`,
+		`NextPageToken:` + fmt.Sprintf("%v", this.NextPageToken) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *GetDLQMessagesResponse) String() string {
+	if this == nil {
+		return "nil"
+	}
+	repeatedStringForReplicationTasks := "[]*ReplicationTask{"
+	for _, f := range this.ReplicationTasks {
+		repeatedStringForReplicationTasks += strings.Replace(fmt.Sprintf("%v", f), "ReplicationTask", "v112.ReplicationTask", 1) + ","
+	}
+	repeatedStringForReplicationTasks += "}"
+	s := strings.Join([]string{`&GetDLQMessagesResponse{`,
+		`Type:` + fmt.Sprintf("%v", this.Type) + `,`,
+		`ReplicationTasks:` + repeatedStringForReplicationTasks + `,`,
+		`NextPageToken:` + fmt.Sprintf("%v", this.NextPageToken) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *PurgeDLQMessagesRequest) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&PurgeDLQMessagesRequest{`,
+		`Type:` + fmt.Sprintf("%v", this.Type) + `,`,
+		`ShardId:` + fmt.Sprintf("%v", this.ShardId) + `,`,
+		`SourceCluster:` + fmt.Sprintf("%v", this.SourceCluster) + `,`,
+		`InclusiveEndMessageId:` + fmt.Sprintf("%v", this.InclusiveEndMessageId) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *PurgeDLQMessagesResponse) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&PurgeDLQMessagesResponse{`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *MergeDLQMessagesRequest) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&MergeDLQMessagesRequest{`,
+		`Type:` + fmt.Sprintf("%v", this.Type) + `,`,
+		`ShardId:` + fmt.Sprintf("%v", this.ShardId) + `,`,
+		`SourceCluster:` + fmt.Sprintf("%v", this.SourceCluster) + `,`,
+		`InclusiveEndMessageId:` + fmt.Sprintf("%v", this.InclusiveEndMessageId) + `,`,
+		`MaximumPageSize:` + fmt.Sprintf("%v", this.MaximumPageSize) + `,`,
+		`NextPageToken:` + fmt.Sprintf("%v", this.NextPageToken) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *MergeDLQMessagesResponse) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&MergeDLQMessagesResponse{`,
+		`NextPageToken:` + fmt.Sprintf("%v", this.NextPageToken) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *RefreshWorkflowTasksRequest) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&RefreshWorkflowTasksRequest{`,
+		`NamespaceId:` + fmt.Sprintf("%v", this.NamespaceId) + `,`,
+		`Request:` + strings.Replace(fmt.Sprintf("%v", this.Request), "RefreshWorkflowTasksRequest", "v113.RefreshWorkflowTasksRequest", 1) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *RefreshWorkflowTasksResponse) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&RefreshWorkflowTasksResponse{`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *DeleteWorkflowExecutionRequest) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&DeleteWorkflowExecutionRequest{`,
+		`NamespaceId:` + fmt.Sprintf("%v", this.NamespaceId) + `,`,
+		`WorkflowExecution:` + strings.Replace(fmt.Sprintf("%v", this.WorkflowExecution), "WorkflowExecution", "v14.WorkflowExecution", 1) + `,`,
+		`Identity:` + fmt.Sprintf("%v", this.Identity) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *DeleteWorkflowExecutionResponse) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&DeleteWorkflowExecutionResponse{`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *UpdateWorkflowExecutionRequest) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&UpdateWorkflowExecutionRequest{`,
+		`NamespaceId:` + fmt.Sprintf("%v", this.NamespaceId) + `,`,
+		`WorkflowExecution:` + strings.Replace(fmt.Sprintf("%v", this.WorkflowExecution), "WorkflowExecution", "v14.WorkflowExecution", 1) + `,`,
+		`UpdateId:` + fmt.Sprintf("%v", this.UpdateId) + `,`,
+		`Name:` + fmt.Sprintf("%v", this.Name) + `,`,
+		`Input:` + strings.Replace(fmt.Sprintf("%v", this.Input), "Payloads", "v14.Payloads", 1) + `,`,
+		`Identity:` + fmt.Sprintf("%v", this.Identity) + `,`,
+		`WaitForAccepted:` + fmt.Sprintf("%v", this.WaitForAccepted) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *UpdateWorkflowExecutionResponse) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&UpdateWorkflowExecutionResponse{`,
+		`Completed:` + fmt.Sprintf("%v", this.Completed) + `,`,
+		`Result:` + strings.Replace(fmt.Sprintf("%v", this.Result), "Payloads", "v14.Payloads", 1) + `,`,
+		`Failure:` + strings.Replace(fmt.Sprintf("%v", this.Failure), "Failure", "v13.Failure", 1) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *AcquireShardRequest) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&AcquireShardRequest{`,
+		`ShardId:` + fmt.Sprintf("%v", this.ShardId) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *AcquireShardResponse) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&AcquireShardResponse{`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *PauseWorkflowExecutionRequest) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&PauseWorkflowExecutionRequest{`,
+		`NamespaceId:` + fmt.Sprintf("%v", this.NamespaceId) + `,`,
+		`WorkflowExecution:` + strings.Replace(fmt.Sprintf("%v", this.WorkflowExecution), "WorkflowExecution", "v14.WorkflowExecution", 1) + `,`,
+		`Identity:` + fmt.Sprintf("%v", this.Identity) + `,`,
+		`Reason:` + fmt.Sprintf("%v", this.Reason) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *PauseWorkflowExecutionResponse) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&PauseWorkflowExecutionResponse{`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *UnpauseWorkflowExecutionRequest) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&UnpauseWorkflowExecutionRequest{`,
+		`NamespaceId:` + fmt.Sprintf("%v", this.NamespaceId) + `,`,
+		`WorkflowExecution:` + strings.Replace(fmt.Sprintf("%v", this.WorkflowExecution), "WorkflowExecution", "v14.WorkflowExecution", 1) + `,`,
+		`Identity:` + fmt.Sprintf("%v", this.Identity) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *UnpauseWorkflowExecutionResponse) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&UnpauseWorkflowExecutionResponse{`,
+		`}`,
+	}, "")
+	return s
+}
+func valueToStringRequestResponse(v interface{}) string {
+	rv := reflect.ValueOf(v)
+	if rv.IsNil() {
+		return "nil"
+	}
+	pv := reflect.Indirect(rv).Interface()
+	return fmt.Sprintf("*%v", pv)
+}
+func (m *StartWorkflowExecutionRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowRequestResponse
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: StartWorkflowExecutionRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: StartWorkflowExecutionRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NamespaceId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRequestResponse
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthRequestResponse
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthRequestResponse
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.NamespaceId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StartRequest", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRequestResponse
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthRequestResponse
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthRequestResponse
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.StartRequest == nil {
+				m.StartRequest = &v1.StartWorkflowExecutionRequest{}
+			}
+			if err := m.StartRequest.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ParentExecutionInfo", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRequestResponse
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthRequestResponse
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthRequestResponse
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.ParentExecutionInfo == nil {
+				m.ParentExecutionInfo = &v11.ParentExecutionInfo{}
+			}
+			if err := m.ParentExecutionInfo.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Attempt", wireType)
+			}
+			m.Attempt = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRequestResponse
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Attempt |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field WorkflowExecutionExpirationTime", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRequestResponse
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthRequestResponse
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthRequestResponse
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.WorkflowExecutionExpirationTime == nil {
+				m.WorkflowExecutionExpirationTime = new(time.Time)
+			}
+			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(m.WorkflowExecutionExpirationTime, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ContinueAsNewInitiator", wireType)
+			}
+			m.ContinueAsNewInitiator = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRequestResponse
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ContinueAsNewInitiator |= v12.ContinueAsNewInitiator(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ContinuedFailure", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRequestResponse
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthRequestResponse
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthRequestResponse
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.ContinuedFailure == nil {
+				m.ContinuedFailure = &v13.Failure{}
+			}
+			if err := m.ContinuedFailure.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LastCompletionResult", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRequestResponse
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthRequestResponse
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthRequestResponse
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.LastCompletionResult == nil {
+				m.LastCompletionResult = &v14.Payloads{}
+			}
+			if err := m.LastCompletionResult.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FirstWorkflowTaskBackoff", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRequestResponse
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthRequestResponse
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthRequestResponse
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.FirstWorkflowTaskBackoff == nil {
+				m.FirstWorkflowTaskBackoff = new(time.Duration)
+			}
+			if err := github_com_gogo_protobuf_types.StdDurationUnmarshal(m.FirstWorkflowTaskBackoff, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipRequestResponse(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthRequestResponse
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthRequestResponse
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *StartWorkflowExecutionResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowRequestResponse
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: StartWorkflowExecutionResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: StartWorkflowExecutionResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RunId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRequestResponse
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthRequestResponse
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthRequestResponse
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RunId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipRequestResponse(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthRequestResponse
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthRequestResponse
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *GetMutableStateRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowRequestResponse
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: GetMutableStateRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: GetMutableStateRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NamespaceId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRequestResponse
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthRequestResponse
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthRequestResponse
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.NamespaceId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Execution", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRequestResponse
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthRequestResponse
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthRequestResponse
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Execution == nil {
+				m.Execution = &v14.WorkflowExecution{}
+			}
+			if err := m.Execution.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExpectedNextEventId", wireType)
+			}
+			m.ExpectedNextEventId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRequestResponse
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ExpectedNextEventId |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CurrentBranchToken", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRequestResponse
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthRequestResponse
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthRequestResponse
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.CurrentBranchToken = append(m.CurrentBranchToken[:0], dAtA[iNdEx:postIndex]...)
+			if m.CurrentBranchToken == nil {
+				m.CurrentBranchToken = []byte{}
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipRequestResponse(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthRequestResponse
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthRequestResponse
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
 	}
-	s := strings.Join([]string{`&RefreshWorkflowTasksResponse{`,
-		`}`,
-	}, "")
-	return s
-}
-func valueToStringRequestResponse(v interface{}) string {
-	rv := reflect.ValueOf(v)
-	if rv.IsNil() {
-		return "nil"
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
 	}
-	pv := reflect.Indirect(rv).Interface()
-	return fmt.Sprintf("*%v", pv)
+	return nil
 }
-func (m *StartWorkflowExecutionRequest) Unmarshal(dAtA []byte) error {
+func (m *GetMutableStateResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -13594,17 +16594,17 @@ func (m *StartWorkflowExecutionRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: StartWorkflowExecutionRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: GetMutableStateResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: StartWorkflowExecutionRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: GetMutableStateResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field NamespaceId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Execution", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowRequestResponse
@@ -13614,27 +16614,31 @@ func (m *StartWorkflowExecutionRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthRequestResponse
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthRequestResponse
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.NamespaceId = string(dAtA[iNdEx:postIndex])
+			if m.Execution == nil {
+				m.Execution = &v14.WorkflowExecution{}
+			}
+			if err := m.Execution.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field StartRequest", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field WorkflowType", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -13661,18 +16665,18 @@ func (m *StartWorkflowExecutionRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.StartRequest == nil {
-				m.StartRequest = &v1.StartWorkflowExecutionRequest{}
+			if m.WorkflowType == nil {
+				m.WorkflowType = &v14.WorkflowType{}
 			}
-			if err := m.StartRequest.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.WorkflowType.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
 		case 3:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ParentExecutionInfo", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NextEventId", wireType)
 			}
-			var msglen int
+			m.NextEventId = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowRequestResponse
@@ -13682,33 +16686,16 @@ func (m *StartWorkflowExecutionRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				m.NextEventId |= int64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthRequestResponse
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthRequestResponse
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if m.ParentExecutionInfo == nil {
-				m.ParentExecutionInfo = &v11.ParentExecutionInfo{}
-			}
-			if err := m.ParentExecutionInfo.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
 		case 4:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Attempt", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field PreviousStartedEventId", wireType)
 			}
-			m.Attempt = 0
+			m.PreviousStartedEventId = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowRequestResponse
@@ -13718,14 +16705,33 @@ func (m *StartWorkflowExecutionRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Attempt |= int32(b&0x7F) << shift
+				m.PreviousStartedEventId |= int64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
 		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LastFirstEventId", wireType)
+			}
+			m.LastFirstEventId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRequestResponse
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.LastFirstEventId |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field WorkflowExecutionExpirationTime", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field TaskQueue", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -13752,18 +16758,18 @@ func (m *StartWorkflowExecutionRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.WorkflowExecutionExpirationTime == nil {
-				m.WorkflowExecutionExpirationTime = new(time.Time)
+			if m.TaskQueue == nil {
+				m.TaskQueue = &v15.TaskQueue{}
 			}
-			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(m.WorkflowExecutionExpirationTime, dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.TaskQueue.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 6:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ContinueAsNewInitiator", wireType)
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StickyTaskQueue", wireType)
 			}
-			m.ContinueAsNewInitiator = 0
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowRequestResponse
@@ -13773,14 +16779,31 @@ func (m *StartWorkflowExecutionRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.ContinueAsNewInitiator |= v12.ContinueAsNewInitiator(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 7:
+			if msglen < 0 {
+				return ErrInvalidLengthRequestResponse
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthRequestResponse
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.StickyTaskQueue == nil {
+				m.StickyTaskQueue = &v15.TaskQueue{}
+			}
+			if err := m.StickyTaskQueue.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 11:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ContinuedFailure", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field StickyTaskQueueScheduleToStartTimeout", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -13807,18 +16830,37 @@ func (m *StartWorkflowExecutionRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.ContinuedFailure == nil {
-				m.ContinuedFailure = &v13.Failure{}
+			if m.StickyTaskQueueScheduleToStartTimeout == nil {
+				m.StickyTaskQueueScheduleToStartTimeout = new(time.Duration)
 			}
-			if err := m.ContinuedFailure.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := github_com_gogo_protobuf_types.StdDurationUnmarshal(m.StickyTaskQueueScheduleToStartTimeout, dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 8:
+		case 12:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EventStoreVersion", wireType)
+			}
+			m.EventStoreVersion = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRequestResponse
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.EventStoreVersion |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 13:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field LastCompletionResult", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field CurrentBranchToken", wireType)
 			}
-			var msglen int
+			var byteLen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowRequestResponse
@@ -13828,31 +16870,67 @@ func (m *StartWorkflowExecutionRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				byteLen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			if byteLen < 0 {
 				return ErrInvalidLengthRequestResponse
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + byteLen
 			if postIndex < 0 {
 				return ErrInvalidLengthRequestResponse
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.LastCompletionResult == nil {
-				m.LastCompletionResult = &v14.Payloads{}
-			}
-			if err := m.LastCompletionResult.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			m.CurrentBranchToken = append(m.CurrentBranchToken[:0], dAtA[iNdEx:postIndex]...)
+			if m.CurrentBranchToken == nil {
+				m.CurrentBranchToken = []byte{}
 			}
 			iNdEx = postIndex
-		case 9:
+		case 15:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field WorkflowState", wireType)
+			}
+			m.WorkflowState = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRequestResponse
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.WorkflowState |= v16.WorkflowExecutionState(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 16:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field WorkflowStatus", wireType)
+			}
+			m.WorkflowStatus = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRequestResponse
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.WorkflowStatus |= v12.WorkflowExecutionStatus(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 17:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field FirstWorkflowTaskBackoff", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field VersionHistories", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -13879,71 +16957,38 @@ func (m *StartWorkflowExecutionRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.FirstWorkflowTaskBackoff == nil {
-				m.FirstWorkflowTaskBackoff = new(time.Duration)
+			if m.VersionHistories == nil {
+				m.VersionHistories = &v17.VersionHistories{}
 			}
-			if err := github_com_gogo_protobuf_types.StdDurationUnmarshal(m.FirstWorkflowTaskBackoff, dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.VersionHistories.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipRequestResponse(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if skippy < 0 {
-				return ErrInvalidLengthRequestResponse
-			}
-			if (iNdEx + skippy) < 0 {
-				return ErrInvalidLengthRequestResponse
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *StartWorkflowExecutionResponse) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowRequestResponse
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
+		case 18:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field IsStickyTaskQueueEnabled", wireType)
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRequestResponse
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: StartWorkflowExecutionResponse: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: StartWorkflowExecutionResponse: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field RunId", wireType)
+			m.IsStickyTaskQueueEnabled = bool(v != 0)
+		case 19:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field HistorySizeBytes", wireType)
 			}
-			var stringLen uint64
+			m.HistorySizeBytes = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowRequestResponse
@@ -13953,24 +16998,31 @@ func (m *StartWorkflowExecutionResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.HistorySizeBytes |= int64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthRequestResponse
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthRequestResponse
+		case 20:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SuggestContinueAsNew", wireType)
 			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRequestResponse
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			m.RunId = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
+			m.SuggestContinueAsNew = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipRequestResponse(dAtA[iNdEx:])
@@ -13995,7 +17047,7 @@ func (m *StartWorkflowExecutionResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *GetMutableStateRequest) Unmarshal(dAtA []byte) error {
+func (m *PollMutableStateRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -14018,10 +17070,10 @@ func (m *GetMutableStateRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: GetMutableStateRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: PollMutableStateRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: GetMutableStateRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: PollMutableStateRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -14169,7 +17221,7 @@ func (m *GetMutableStateRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *GetMutableStateResponse) Unmarshal(dAtA []byte) error {
+func (m *PollMutableStateResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -14192,10 +17244,10 @@ func (m *GetMutableStateResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: GetMutableStateResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: PollMutableStateResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: GetMutableStateResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: PollMutableStateResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -14436,10 +17488,80 @@ func (m *GetMutableStateResponse) Unmarshal(dAtA []byte) error {
 			}
 			iNdEx = postIndex
 		case 12:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CurrentBranchToken", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRequestResponse
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthRequestResponse
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthRequestResponse
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.CurrentBranchToken = append(m.CurrentBranchToken[:0], dAtA[iNdEx:postIndex]...)
+			if m.CurrentBranchToken == nil {
+				m.CurrentBranchToken = []byte{}
+			}
+			iNdEx = postIndex
+		case 14:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field VersionHistories", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRequestResponse
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthRequestResponse
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthRequestResponse
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.VersionHistories == nil {
+				m.VersionHistories = &v17.VersionHistories{}
+			}
+			if err := m.VersionHistories.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 15:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field EventStoreVersion", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field WorkflowState", wireType)
 			}
-			m.EventStoreVersion = 0
+			m.WorkflowState = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowRequestResponse
@@ -14449,16 +17571,88 @@ func (m *GetMutableStateResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.EventStoreVersion |= int32(b&0x7F) << shift
+				m.WorkflowState |= v16.WorkflowExecutionState(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 16:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field WorkflowStatus", wireType)
+			}
+			m.WorkflowStatus = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRequestResponse
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.WorkflowStatus |= v12.WorkflowExecutionStatus(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 13:
+		default:
+			iNdEx = preIndex
+			skippy, err := skipRequestResponse(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthRequestResponse
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthRequestResponse
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ResetStickyTaskQueueRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowRequestResponse
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ResetStickyTaskQueueRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ResetStickyTaskQueueRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field CurrentBranchToken", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field NamespaceId", wireType)
 			}
-			var byteLen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowRequestResponse
@@ -14468,67 +17662,27 @@ func (m *GetMutableStateResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				byteLen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if byteLen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthRequestResponse
 			}
-			postIndex := iNdEx + byteLen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthRequestResponse
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.CurrentBranchToken = append(m.CurrentBranchToken[:0], dAtA[iNdEx:postIndex]...)
-			if m.CurrentBranchToken == nil {
-				m.CurrentBranchToken = []byte{}
-			}
+			m.NamespaceId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 15:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field WorkflowState", wireType)
-			}
-			m.WorkflowState = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowRequestResponse
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.WorkflowState |= v16.WorkflowExecutionState(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 16:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field WorkflowStatus", wireType)
-			}
-			m.WorkflowStatus = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowRequestResponse
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.WorkflowStatus |= v12.WorkflowExecutionStatus(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 17:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field VersionHistories", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Execution", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -14555,33 +17709,66 @@ func (m *GetMutableStateResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.VersionHistories == nil {
-				m.VersionHistories = &v17.VersionHistories{}
+			if m.Execution == nil {
+				m.Execution = &v14.WorkflowExecution{}
 			}
-			if err := m.VersionHistories.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Execution.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 18:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field IsStickyTaskQueueEnabled", wireType)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipRequestResponse(dAtA[iNdEx:])
+			if err != nil {
+				return err
 			}
-			var v int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowRequestResponse
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				v |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+			if skippy < 0 {
+				return ErrInvalidLengthRequestResponse
 			}
-			m.IsStickyTaskQueueEnabled = bool(v != 0)
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthRequestResponse
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ResetStickyTaskQueueResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowRequestResponse
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ResetStickyTaskQueueResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ResetStickyTaskQueueResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
 		default:
 			iNdEx = preIndex
 			skippy, err := skipRequestResponse(dAtA[iNdEx:])
@@ -14606,7 +17793,7 @@ func (m *GetMutableStateResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *PollMutableStateRequest) Unmarshal(dAtA []byte) error {
+func (m *RecordWorkflowTaskStartedRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -14629,15 +17816,121 @@ func (m *PollMutableStateRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: PollMutableStateRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: RecordWorkflowTaskStartedRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: PollMutableStateRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: RecordWorkflowTaskStartedRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field NamespaceId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field NamespaceId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRequestResponse
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthRequestResponse
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthRequestResponse
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.NamespaceId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field WorkflowExecution", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRequestResponse
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthRequestResponse
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthRequestResponse
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.WorkflowExecution == nil {
+				m.WorkflowExecution = &v14.WorkflowExecution{}
+			}
+			if err := m.WorkflowExecution.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ScheduleId", wireType)
+			}
+			m.ScheduleId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRequestResponse
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ScheduleId |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TaskId", wireType)
+			}
+			m.TaskId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRequestResponse
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.TaskId |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RequestId", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -14665,11 +17958,11 @@ func (m *PollMutableStateRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.NamespaceId = string(dAtA[iNdEx:postIndex])
+			m.RequestId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 2:
+		case 6:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Execution", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field PollRequest", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -14696,66 +17989,13 @@ func (m *PollMutableStateRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Execution == nil {
-				m.Execution = &v14.WorkflowExecution{}
+			if m.PollRequest == nil {
+				m.PollRequest = &v1.PollWorkflowTaskQueueRequest{}
 			}
-			if err := m.Execution.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.PollRequest.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 3:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ExpectedNextEventId", wireType)
-			}
-			m.ExpectedNextEventId = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowRequestResponse
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.ExpectedNextEventId |= int64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 4:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field CurrentBranchToken", wireType)
-			}
-			var byteLen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowRequestResponse
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				byteLen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if byteLen < 0 {
-				return ErrInvalidLengthRequestResponse
-			}
-			postIndex := iNdEx + byteLen
-			if postIndex < 0 {
-				return ErrInvalidLengthRequestResponse
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.CurrentBranchToken = append(m.CurrentBranchToken[:0], dAtA[iNdEx:postIndex]...)
-			if m.CurrentBranchToken == nil {
-				m.CurrentBranchToken = []byte{}
-			}
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipRequestResponse(dAtA[iNdEx:])
@@ -14780,7 +18020,7 @@ func (m *PollMutableStateRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *PollMutableStateResponse) Unmarshal(dAtA []byte) error {
+func (m *RecordWorkflowTaskStartedResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -14803,15 +18043,15 @@ func (m *PollMutableStateResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: PollMutableStateResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: RecordWorkflowTaskStartedResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: PollMutableStateResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: RecordWorkflowTaskStartedResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Execution", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field WorkflowType", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -14838,18 +18078,18 @@ func (m *PollMutableStateResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Execution == nil {
-				m.Execution = &v14.WorkflowExecution{}
+			if m.WorkflowType == nil {
+				m.WorkflowType = &v14.WorkflowType{}
 			}
-			if err := m.Execution.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.WorkflowType.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
 		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field WorkflowType", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PreviousStartedEventId", wireType)
 			}
-			var msglen int
+			m.PreviousStartedEventId = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowRequestResponse
@@ -14859,29 +18099,50 @@ func (m *PollMutableStateResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				m.PreviousStartedEventId |= int64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthRequestResponse
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthRequestResponse
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ScheduledEventId", wireType)
 			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
+			m.ScheduledEventId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRequestResponse
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ScheduledEventId |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			if m.WorkflowType == nil {
-				m.WorkflowType = &v14.WorkflowType{}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StartedEventId", wireType)
 			}
-			if err := m.WorkflowType.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			m.StartedEventId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRequestResponse
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.StartedEventId |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			iNdEx = postIndex
-		case 3:
+		case 5:
 			if wireType != 0 {
 				return fmt.Errorf("proto: wrong wireType = %d for field NextEventId", wireType)
 			}
@@ -14900,11 +18161,11 @@ func (m *PollMutableStateResponse) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
-		case 4:
+		case 6:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PreviousStartedEventId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Attempt", wireType)
 			}
-			m.PreviousStartedEventId = 0
+			m.Attempt = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowRequestResponse
@@ -14914,16 +18175,16 @@ func (m *PollMutableStateResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.PreviousStartedEventId |= int64(b&0x7F) << shift
+				m.Attempt |= int32(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 5:
+		case 7:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field LastFirstEventId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field StickyExecutionEnabled", wireType)
 			}
-			m.LastFirstEventId = 0
+			var v int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowRequestResponse
@@ -14933,14 +18194,15 @@ func (m *PollMutableStateResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.LastFirstEventId |= int64(b&0x7F) << shift
+				v |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 6:
+			m.StickyExecutionEnabled = bool(v != 0)
+		case 8:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TaskQueue", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field WorkflowTaskInfo", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -14967,16 +18229,16 @@ func (m *PollMutableStateResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.TaskQueue == nil {
-				m.TaskQueue = &v15.TaskQueue{}
+			if m.WorkflowTaskInfo == nil {
+				m.WorkflowTaskInfo = &v17.TransientWorkflowTaskInfo{}
 			}
-			if err := m.TaskQueue.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.WorkflowTaskInfo.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 7:
+		case 9:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field StickyTaskQueue", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field WorkflowExecutionTaskQueue", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -15003,16 +18265,69 @@ func (m *PollMutableStateResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.StickyTaskQueue == nil {
-				m.StickyTaskQueue = &v15.TaskQueue{}
+			if m.WorkflowExecutionTaskQueue == nil {
+				m.WorkflowExecutionTaskQueue = &v15.TaskQueue{}
 			}
-			if err := m.StickyTaskQueue.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.WorkflowExecutionTaskQueue.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
+		case 10:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EventStoreVersion", wireType)
+			}
+			m.EventStoreVersion = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRequestResponse
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.EventStoreVersion |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		case 11:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field StickyTaskQueueScheduleToStartTimeout", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field BranchToken", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRequestResponse
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthRequestResponse
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthRequestResponse
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.BranchToken = append(m.BranchToken[:0], dAtA[iNdEx:postIndex]...)
+			if m.BranchToken == nil {
+				m.BranchToken = []byte{}
+			}
+			iNdEx = postIndex
+		case 12:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ScheduledTime", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -15039,18 +18354,18 @@ func (m *PollMutableStateResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.StickyTaskQueueScheduleToStartTimeout == nil {
-				m.StickyTaskQueueScheduleToStartTimeout = new(time.Duration)
+			if m.ScheduledTime == nil {
+				m.ScheduledTime = new(time.Time)
 			}
-			if err := github_com_gogo_protobuf_types.StdDurationUnmarshal(m.StickyTaskQueueScheduleToStartTimeout, dAtA[iNdEx:postIndex]); err != nil {
+			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(m.ScheduledTime, dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 12:
+		case 13:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field CurrentBranchToken", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field StartedTime", wireType)
 			}
-			var byteLen int
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowRequestResponse
@@ -15060,29 +18375,31 @@ func (m *PollMutableStateResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				byteLen |= int(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if byteLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthRequestResponse
 			}
-			postIndex := iNdEx + byteLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthRequestResponse
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.CurrentBranchToken = append(m.CurrentBranchToken[:0], dAtA[iNdEx:postIndex]...)
-			if m.CurrentBranchToken == nil {
-				m.CurrentBranchToken = []byte{}
+			if m.StartedTime == nil {
+				m.StartedTime = new(time.Time)
+			}
+			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(m.StartedTime, dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
 			iNdEx = postIndex
 		case 14:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field VersionHistories", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Queries", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -15109,18 +18426,111 @@ func (m *PollMutableStateResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.VersionHistories == nil {
-				m.VersionHistories = &v17.VersionHistories{}
+			if m.Queries == nil {
+				m.Queries = make(map[string]*v18.WorkflowQuery)
 			}
-			if err := m.VersionHistories.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			var mapkey string
+			var mapvalue *v18.WorkflowQuery
+			for iNdEx < postIndex {
+				entryPreIndex := iNdEx
+				var wire uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowRequestResponse
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					wire |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				fieldNum := int32(wire >> 3)
+				if fieldNum == 1 {
+					var stringLenmapkey uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowRequestResponse
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapkey |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapkey := int(stringLenmapkey)
+					if intStringLenmapkey < 0 {
+						return ErrInvalidLengthRequestResponse
+					}
+					postStringIndexmapkey := iNdEx + intStringLenmapkey
+					if postStringIndexmapkey < 0 {
+						return ErrInvalidLengthRequestResponse
+					}
+					if postStringIndexmapkey > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapkey = string(dAtA[iNdEx:postStringIndexmapkey])
+					iNdEx = postStringIndexmapkey
+				} else if fieldNum == 2 {
+					var mapmsglen int
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowRequestResponse
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						mapmsglen |= int(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					if mapmsglen < 0 {
+						return ErrInvalidLengthRequestResponse
+					}
+					postmsgIndex := iNdEx + mapmsglen
+					if postmsgIndex < 0 {
+						return ErrInvalidLengthRequestResponse
+					}
+					if postmsgIndex > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapvalue = &v18.WorkflowQuery{}
+					if err := mapvalue.Unmarshal(dAtA[iNdEx:postmsgIndex]); err != nil {
+						return err
+					}
+					iNdEx = postmsgIndex
+				} else {
+					iNdEx = entryPreIndex
+					skippy, err := skipRequestResponse(dAtA[iNdEx:])
+					if err != nil {
+						return err
+					}
+					if skippy < 0 {
+						return ErrInvalidLengthRequestResponse
+					}
+					if (iNdEx + skippy) > postIndex {
+						return io.ErrUnexpectedEOF
+					}
+					iNdEx += skippy
+				}
 			}
+			m.Queries[mapkey] = mapvalue
 			iNdEx = postIndex
 		case 15:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field WorkflowState", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field HistorySizeBytes", wireType)
 			}
-			m.WorkflowState = 0
+			m.HistorySizeBytes = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowRequestResponse
@@ -15130,16 +18540,16 @@ func (m *PollMutableStateResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.WorkflowState |= v16.WorkflowExecutionState(b&0x7F) << shift
+				m.HistorySizeBytes |= int64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
 		case 16:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field WorkflowStatus", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field SuggestContinueAsNew", wireType)
 			}
-			m.WorkflowStatus = 0
+			var v int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowRequestResponse
@@ -15149,11 +18559,46 @@ func (m *PollMutableStateResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.WorkflowStatus |= v12.WorkflowExecutionStatus(b&0x7F) << shift
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.SuggestContinueAsNew = bool(v != 0)
+		case 17:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Updates", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRequestResponse
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			if msglen < 0 {
+				return ErrInvalidLengthRequestResponse
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthRequestResponse
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Updates = append(m.Updates, &v110.WorkflowUpdateRequest{})
+			if err := m.Updates[len(m.Updates)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipRequestResponse(dAtA[iNdEx:])
@@ -15178,7 +18623,7 @@ func (m *PollMutableStateResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ResetStickyTaskQueueRequest) Unmarshal(dAtA []byte) error {
+func (m *RecordActivityTaskStartedRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -15201,10 +18646,10 @@ func (m *ResetStickyTaskQueueRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ResetStickyTaskQueueRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: RecordActivityTaskStartedRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ResetStickyTaskQueueRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: RecordActivityTaskStartedRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -15241,7 +18686,7 @@ func (m *ResetStickyTaskQueueRequest) Unmarshal(dAtA []byte) error {
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Execution", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field WorkflowExecution", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -15268,66 +18713,119 @@ func (m *ResetStickyTaskQueueRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Execution == nil {
-				m.Execution = &v14.WorkflowExecution{}
+			if m.WorkflowExecution == nil {
+				m.WorkflowExecution = &v14.WorkflowExecution{}
 			}
-			if err := m.Execution.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.WorkflowExecution.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipRequestResponse(dAtA[iNdEx:])
-			if err != nil {
-				return err
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ScheduleId", wireType)
 			}
-			if skippy < 0 {
+			m.ScheduleId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRequestResponse
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ScheduleId |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TaskId", wireType)
+			}
+			m.TaskId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRequestResponse
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.TaskId |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RequestId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRequestResponse
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthRequestResponse
 			}
-			if (iNdEx + skippy) < 0 {
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
 				return ErrInvalidLengthRequestResponse
 			}
-			if (iNdEx + skippy) > l {
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *ResetStickyTaskQueueResponse) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowRequestResponse
+			m.RequestId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PollRequest", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRequestResponse
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			if iNdEx >= l {
+			if msglen < 0 {
+				return ErrInvalidLengthRequestResponse
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthRequestResponse
+			}
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
+			if m.PollRequest == nil {
+				m.PollRequest = &v1.PollActivityTaskQueueRequest{}
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: ResetStickyTaskQueueResponse: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ResetStickyTaskQueueResponse: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
+			if err := m.PollRequest.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipRequestResponse(dAtA[iNdEx:])
@@ -15352,7 +18850,7 @@ func (m *ResetStickyTaskQueueResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *RecordWorkflowTaskStartedRequest) Unmarshal(dAtA []byte) error {
+func (m *RecordActivityTaskStartedResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -15375,17 +18873,17 @@ func (m *RecordWorkflowTaskStartedRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: RecordWorkflowTaskStartedRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: RecordActivityTaskStartedResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: RecordWorkflowTaskStartedRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: RecordActivityTaskStartedResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field NamespaceId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ScheduledEvent", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowRequestResponse
@@ -15395,27 +18893,31 @@ func (m *RecordWorkflowTaskStartedRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthRequestResponse
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthRequestResponse
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.NamespaceId = string(dAtA[iNdEx:postIndex])
+			if m.ScheduledEvent == nil {
+				m.ScheduledEvent = &v19.HistoryEvent{}
+			}
+			if err := m.ScheduledEvent.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field WorkflowExecution", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field StartedTime", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -15442,18 +18944,18 @@ func (m *RecordWorkflowTaskStartedRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.WorkflowExecution == nil {
-				m.WorkflowExecution = &v14.WorkflowExecution{}
+			if m.StartedTime == nil {
+				m.StartedTime = new(time.Time)
 			}
-			if err := m.WorkflowExecution.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(m.StartedTime, dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
 		case 3:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ScheduleId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Attempt", wireType)
 			}
-			m.ScheduleId = 0
+			m.Attempt = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowRequestResponse
@@ -15463,16 +18965,16 @@ func (m *RecordWorkflowTaskStartedRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.ScheduleId |= int64(b&0x7F) << shift
+				m.Attempt |= int32(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
 		case 4:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TaskId", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CurrentAttemptScheduledTime", wireType)
 			}
-			m.TaskId = 0
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowRequestResponse
@@ -15482,14 +18984,188 @@ func (m *RecordWorkflowTaskStartedRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.TaskId |= int64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			if msglen < 0 {
+				return ErrInvalidLengthRequestResponse
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthRequestResponse
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.CurrentAttemptScheduledTime == nil {
+				m.CurrentAttemptScheduledTime = new(time.Time)
+			}
+			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(m.CurrentAttemptScheduledTime, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field RequestId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field HeartbeatDetails", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRequestResponse
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthRequestResponse
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthRequestResponse
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.HeartbeatDetails == nil {
+				m.HeartbeatDetails = &v14.Payloads{}
+			}
+			if err := m.HeartbeatDetails.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field WorkflowType", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRequestResponse
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthRequestResponse
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthRequestResponse
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.WorkflowType == nil {
+				m.WorkflowType = &v14.WorkflowType{}
+			}
+			if err := m.WorkflowType.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field WorkflowNamespace", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRequestResponse
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthRequestResponse
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthRequestResponse
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.WorkflowNamespace = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipRequestResponse(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthRequestResponse
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthRequestResponse
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *RespondWorkflowTaskCompletedRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowRequestResponse
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: RespondWorkflowTaskCompletedRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: RespondWorkflowTaskCompletedRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NamespaceId", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -15517,11 +19193,11 @@ func (m *RecordWorkflowTaskStartedRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.RequestId = string(dAtA[iNdEx:postIndex])
+			m.NamespaceId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 6:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PollRequest", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field CompleteRequest", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -15548,10 +19224,10 @@ func (m *RecordWorkflowTaskStartedRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.PollRequest == nil {
-				m.PollRequest = &v1.PollWorkflowTaskQueueRequest{}
+			if m.CompleteRequest == nil {
+				m.CompleteRequest = &v1.RespondWorkflowTaskCompletedRequest{}
 			}
-			if err := m.PollRequest.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.CompleteRequest.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -15579,7 +19255,7 @@ func (m *RecordWorkflowTaskStartedRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *RecordWorkflowTaskStartedResponse) Unmarshal(dAtA []byte) error {
+func (m *RespondWorkflowTaskCompletedResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -15602,15 +19278,15 @@ func (m *RecordWorkflowTaskStartedResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: RecordWorkflowTaskStartedResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: RespondWorkflowTaskCompletedResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: RecordWorkflowTaskStartedResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: RespondWorkflowTaskCompletedResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field WorkflowType", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field StartedResponse", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -15637,75 +19313,71 @@ func (m *RecordWorkflowTaskStartedResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.WorkflowType == nil {
-				m.WorkflowType = &v14.WorkflowType{}
+			if m.StartedResponse == nil {
+				m.StartedResponse = &RecordWorkflowTaskStartedResponse{}
 			}
-			if err := m.WorkflowType.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.StartedResponse.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PreviousStartedEventId", wireType)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipRequestResponse(dAtA[iNdEx:])
+			if err != nil {
+				return err
 			}
-			m.PreviousStartedEventId = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowRequestResponse
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.PreviousStartedEventId |= int64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+			if skippy < 0 {
+				return ErrInvalidLengthRequestResponse
 			}
-		case 3:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ScheduledEventId", wireType)
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthRequestResponse
 			}
-			m.ScheduledEventId = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowRequestResponse
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.ScheduledEventId |= int64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
 			}
-		case 4:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field StartedEventId", wireType)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *RespondWorkflowTaskFailedRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowRequestResponse
 			}
-			m.StartedEventId = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowRequestResponse
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.StartedEventId |= int64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
 			}
-		case 5:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field NextEventId", wireType)
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
 			}
-			m.NextEventId = 0
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: RespondWorkflowTaskFailedRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: RespondWorkflowTaskFailedRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NamespaceId", wireType)
+			}
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowRequestResponse
@@ -15715,16 +19387,29 @@ func (m *RecordWorkflowTaskStartedResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.NextEventId |= int64(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 6:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Attempt", wireType)
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthRequestResponse
 			}
-			m.Attempt = 0
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthRequestResponse
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.NamespaceId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FailedRequest", wireType)
+			}
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowRequestResponse
@@ -15734,36 +19419,139 @@ func (m *RecordWorkflowTaskStartedResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Attempt |= int32(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 7:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field StickyExecutionEnabled", wireType)
+			if msglen < 0 {
+				return ErrInvalidLengthRequestResponse
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthRequestResponse
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.FailedRequest == nil {
+				m.FailedRequest = &v1.RespondWorkflowTaskFailedRequest{}
+			}
+			if err := m.FailedRequest.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipRequestResponse(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthRequestResponse
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthRequestResponse
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *RespondWorkflowTaskFailedResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowRequestResponse
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: RespondWorkflowTaskFailedResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: RespondWorkflowTaskFailedResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipRequestResponse(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthRequestResponse
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthRequestResponse
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *RecordActivityTaskHeartbeatRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowRequestResponse
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
 			}
-			var v int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowRequestResponse
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				v |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
 			}
-			m.StickyExecutionEnabled = bool(v != 0)
-		case 8:
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: RecordActivityTaskHeartbeatRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: RecordActivityTaskHeartbeatRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field WorkflowTaskInfo", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field NamespaceId", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowRequestResponse
@@ -15773,31 +19561,27 @@ func (m *RecordWorkflowTaskStartedResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthRequestResponse
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthRequestResponse
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.WorkflowTaskInfo == nil {
-				m.WorkflowTaskInfo = &v17.TransientWorkflowTaskInfo{}
-			}
-			if err := m.WorkflowTaskInfo.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.NamespaceId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 9:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field WorkflowExecutionTaskQueue", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field HeartbeatRequest", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -15824,71 +19608,71 @@ func (m *RecordWorkflowTaskStartedResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.WorkflowExecutionTaskQueue == nil {
-				m.WorkflowExecutionTaskQueue = &v15.TaskQueue{}
+			if m.HeartbeatRequest == nil {
+				m.HeartbeatRequest = &v1.RecordActivityTaskHeartbeatRequest{}
 			}
-			if err := m.WorkflowExecutionTaskQueue.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.HeartbeatRequest.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 10:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field EventStoreVersion", wireType)
-			}
-			m.EventStoreVersion = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowRequestResponse
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.EventStoreVersion |= int32(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 11:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field BranchToken", wireType)
-			}
-			var byteLen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowRequestResponse
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				byteLen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipRequestResponse(dAtA[iNdEx:])
+			if err != nil {
+				return err
 			}
-			if byteLen < 0 {
+			if skippy < 0 {
 				return ErrInvalidLengthRequestResponse
 			}
-			postIndex := iNdEx + byteLen
-			if postIndex < 0 {
+			if (iNdEx + skippy) < 0 {
 				return ErrInvalidLengthRequestResponse
 			}
-			if postIndex > l {
+			if (iNdEx + skippy) > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.BranchToken = append(m.BranchToken[:0], dAtA[iNdEx:postIndex]...)
-			if m.BranchToken == nil {
-				m.BranchToken = []byte{}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *RecordActivityTaskHeartbeatResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowRequestResponse
 			}
-			iNdEx = postIndex
-		case 12:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ScheduledTime", wireType)
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
 			}
-			var msglen int
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: RecordActivityTaskHeartbeatResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: RecordActivityTaskHeartbeatResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CancelRequested", wireType)
+			}
+			var v int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowRequestResponse
@@ -15898,33 +19682,70 @@ func (m *RecordWorkflowTaskStartedResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				v |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			m.CancelRequested = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipRequestResponse(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
 				return ErrInvalidLengthRequestResponse
 			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
+			if (iNdEx + skippy) < 0 {
 				return ErrInvalidLengthRequestResponse
 			}
-			if postIndex > l {
+			if (iNdEx + skippy) > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.ScheduledTime == nil {
-				m.ScheduledTime = new(time.Time)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *RespondActivityTaskCompletedRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowRequestResponse
 			}
-			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(m.ScheduledTime, dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
 			}
-			iNdEx = postIndex
-		case 13:
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: RespondActivityTaskCompletedRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: RespondActivityTaskCompletedRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field StartedTime", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field NamespaceId", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowRequestResponse
@@ -15934,31 +19755,27 @@ func (m *RecordWorkflowTaskStartedResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthRequestResponse
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthRequestResponse
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.StartedTime == nil {
-				m.StartedTime = new(time.Time)
-			}
-			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(m.StartedTime, dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.NamespaceId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 14:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Queries", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field CompleteRequest", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -15985,105 +19802,12 @@ func (m *RecordWorkflowTaskStartedResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Queries == nil {
-				m.Queries = make(map[string]*v18.WorkflowQuery)
+			if m.CompleteRequest == nil {
+				m.CompleteRequest = &v1.RespondActivityTaskCompletedRequest{}
 			}
-			var mapkey string
-			var mapvalue *v18.WorkflowQuery
-			for iNdEx < postIndex {
-				entryPreIndex := iNdEx
-				var wire uint64
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return ErrIntOverflowRequestResponse
-					}
-					if iNdEx >= l {
-						return io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					wire |= uint64(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-				fieldNum := int32(wire >> 3)
-				if fieldNum == 1 {
-					var stringLenmapkey uint64
-					for shift := uint(0); ; shift += 7 {
-						if shift >= 64 {
-							return ErrIntOverflowRequestResponse
-						}
-						if iNdEx >= l {
-							return io.ErrUnexpectedEOF
-						}
-						b := dAtA[iNdEx]
-						iNdEx++
-						stringLenmapkey |= uint64(b&0x7F) << shift
-						if b < 0x80 {
-							break
-						}
-					}
-					intStringLenmapkey := int(stringLenmapkey)
-					if intStringLenmapkey < 0 {
-						return ErrInvalidLengthRequestResponse
-					}
-					postStringIndexmapkey := iNdEx + intStringLenmapkey
-					if postStringIndexmapkey < 0 {
-						return ErrInvalidLengthRequestResponse
-					}
-					if postStringIndexmapkey > l {
-						return io.ErrUnexpectedEOF
-					}
-					mapkey = string(dAtA[iNdEx:postStringIndexmapkey])
-					iNdEx = postStringIndexmapkey
-				} else if fieldNum == 2 {
-					var mapmsglen int
-					for shift := uint(0); ; shift += 7 {
-						if shift >= 64 {
-							return ErrIntOverflowRequestResponse
-						}
-						if iNdEx >= l {
-							return io.ErrUnexpectedEOF
-						}
-						b := dAtA[iNdEx]
-						iNdEx++
-						mapmsglen |= int(b&0x7F) << shift
-						if b < 0x80 {
-							break
-						}
-					}
-					if mapmsglen < 0 {
-						return ErrInvalidLengthRequestResponse
-					}
-					postmsgIndex := iNdEx + mapmsglen
-					if postmsgIndex < 0 {
-						return ErrInvalidLengthRequestResponse
-					}
-					if postmsgIndex > l {
-						return io.ErrUnexpectedEOF
-					}
-					mapvalue = &v18.WorkflowQuery{}
-					if err := mapvalue.Unmarshal(dAtA[iNdEx:postmsgIndex]); err != nil {
-						return err
-					}
-					iNdEx = postmsgIndex
-				} else {
-					iNdEx = entryPreIndex
-					skippy, err := skipRequestResponse(dAtA[iNdEx:])
-					if err != nil {
-						return err
-					}
-					if skippy < 0 {
-						return ErrInvalidLengthRequestResponse
-					}
-					if (iNdEx + skippy) > postIndex {
-						return io.ErrUnexpectedEOF
-					}
-					iNdEx += skippy
-				}
+			if err := m.CompleteRequest.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-			m.Queries[mapkey] = mapvalue
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -16109,7 +19833,7 @@ func (m *RecordWorkflowTaskStartedResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *RecordActivityTaskStartedRequest) Unmarshal(dAtA []byte) error {
+func (m *RespondActivityTaskCompletedResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -16132,10 +19856,63 @@ func (m *RecordActivityTaskStartedRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: RecordActivityTaskStartedRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: RespondActivityTaskCompletedResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: RecordActivityTaskStartedRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: RespondActivityTaskCompletedResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipRequestResponse(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthRequestResponse
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthRequestResponse
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *RespondActivityTaskFailedRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowRequestResponse
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: RespondActivityTaskFailedRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: RespondActivityTaskFailedRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -16172,7 +19949,7 @@ func (m *RecordActivityTaskStartedRequest) Unmarshal(dAtA []byte) error {
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field WorkflowExecution", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field FailedRequest", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -16199,54 +19976,122 @@ func (m *RecordActivityTaskStartedRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.WorkflowExecution == nil {
-				m.WorkflowExecution = &v14.WorkflowExecution{}
+			if m.FailedRequest == nil {
+				m.FailedRequest = &v1.RespondActivityTaskFailedRequest{}
 			}
-			if err := m.WorkflowExecution.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.FailedRequest.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 3:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ScheduleId", wireType)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipRequestResponse(dAtA[iNdEx:])
+			if err != nil {
+				return err
 			}
-			m.ScheduleId = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowRequestResponse
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.ScheduleId |= int64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+			if skippy < 0 {
+				return ErrInvalidLengthRequestResponse
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthRequestResponse
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *RespondActivityTaskFailedResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowRequestResponse
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: RespondActivityTaskFailedResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: RespondActivityTaskFailedResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipRequestResponse(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthRequestResponse
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthRequestResponse
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *RespondActivityTaskCanceledRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowRequestResponse
 			}
-		case 4:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TaskId", wireType)
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
 			}
-			m.TaskId = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowRequestResponse
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.TaskId |= int64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
 			}
-		case 5:
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: RespondActivityTaskCanceledRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: RespondActivityTaskCanceledRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field RequestId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field NamespaceId", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -16274,11 +20119,11 @@ func (m *RecordActivityTaskStartedRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.RequestId = string(dAtA[iNdEx:postIndex])
+			m.NamespaceId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 6:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PollRequest", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field CancelRequest", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -16305,10 +20150,10 @@ func (m *RecordActivityTaskStartedRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.PollRequest == nil {
-				m.PollRequest = &v1.PollActivityTaskQueueRequest{}
+			if m.CancelRequest == nil {
+				m.CancelRequest = &v1.RespondActivityTaskCanceledRequest{}
 			}
-			if err := m.PollRequest.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.CancelRequest.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -16336,7 +20181,7 @@ func (m *RecordActivityTaskStartedRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *RecordActivityTaskStartedResponse) Unmarshal(dAtA []byte) error {
+func (m *RespondActivityTaskCanceledResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -16359,108 +20204,70 @@ func (m *RecordActivityTaskStartedResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: RecordActivityTaskStartedResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: RespondActivityTaskCanceledResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: RecordActivityTaskStartedResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: RespondActivityTaskCanceledResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
-		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ScheduledEvent", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowRequestResponse
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthRequestResponse
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthRequestResponse
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if m.ScheduledEvent == nil {
-				m.ScheduledEvent = &v19.HistoryEvent{}
-			}
-			if err := m.ScheduledEvent.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipRequestResponse(dAtA[iNdEx:])
+			if err != nil {
 				return err
 			}
-			iNdEx = postIndex
-		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field StartedTime", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowRequestResponse
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
+			if skippy < 0 {
 				return ErrInvalidLengthRequestResponse
 			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
+			if (iNdEx + skippy) < 0 {
 				return ErrInvalidLengthRequestResponse
 			}
-			if postIndex > l {
+			if (iNdEx + skippy) > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.StartedTime == nil {
-				m.StartedTime = new(time.Time)
-			}
-			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(m.StartedTime, dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *SignalWorkflowExecutionRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowRequestResponse
 			}
-			iNdEx = postIndex
-		case 3:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Attempt", wireType)
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
 			}
-			m.Attempt = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowRequestResponse
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.Attempt |= int32(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
 			}
-		case 4:
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: SignalWorkflowExecutionRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: SignalWorkflowExecutionRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field CurrentAttemptScheduledTime", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field NamespaceId", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowRequestResponse
@@ -16470,31 +20277,27 @@ func (m *RecordActivityTaskStartedResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthRequestResponse
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthRequestResponse
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.CurrentAttemptScheduledTime == nil {
-				m.CurrentAttemptScheduledTime = new(time.Time)
-			}
-			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(m.CurrentAttemptScheduledTime, dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.NamespaceId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 5:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field HeartbeatDetails", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field SignalRequest", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -16521,16 +20324,16 @@ func (m *RecordActivityTaskStartedResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.HeartbeatDetails == nil {
-				m.HeartbeatDetails = &v14.Payloads{}
+			if m.SignalRequest == nil {
+				m.SignalRequest = &v1.SignalWorkflowExecutionRequest{}
 			}
-			if err := m.HeartbeatDetails.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.SignalRequest.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 6:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field WorkflowType", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ExternalWorkflowExecution", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -16557,18 +20360,18 @@ func (m *RecordActivityTaskStartedResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.WorkflowType == nil {
-				m.WorkflowType = &v14.WorkflowType{}
+			if m.ExternalWorkflowExecution == nil {
+				m.ExternalWorkflowExecution = &v14.WorkflowExecution{}
 			}
-			if err := m.WorkflowType.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.ExternalWorkflowExecution.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 7:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field WorkflowNamespace", wireType)
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChildWorkflowOnly", wireType)
 			}
-			var stringLen uint64
+			var v int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowRequestResponse
@@ -16578,24 +20381,65 @@ func (m *RecordActivityTaskStartedResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				v |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			m.ChildWorkflowOnly = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipRequestResponse(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
 				return ErrInvalidLengthRequestResponse
 			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
+			if (iNdEx + skippy) < 0 {
 				return ErrInvalidLengthRequestResponse
 			}
-			if postIndex > l {
+			if (iNdEx + skippy) > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.WorkflowNamespace = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *SignalWorkflowExecutionResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowRequestResponse
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: SignalWorkflowExecutionResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: SignalWorkflowExecutionResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
 		default:
 			iNdEx = preIndex
 			skippy, err := skipRequestResponse(dAtA[iNdEx:])
@@ -16620,7 +20464,7 @@ func (m *RecordActivityTaskStartedResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *RespondWorkflowTaskCompletedRequest) Unmarshal(dAtA []byte) error {
+func (m *SignalWithStartWorkflowExecutionRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -16643,10 +20487,10 @@ func (m *RespondWorkflowTaskCompletedRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: RespondWorkflowTaskCompletedRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: SignalWithStartWorkflowExecutionRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: RespondWorkflowTaskCompletedRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: SignalWithStartWorkflowExecutionRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -16683,7 +20527,7 @@ func (m *RespondWorkflowTaskCompletedRequest) Unmarshal(dAtA []byte) error {
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field CompleteRequest", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field SignalWithStartRequest", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -16710,10 +20554,10 @@ func (m *RespondWorkflowTaskCompletedRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.CompleteRequest == nil {
-				m.CompleteRequest = &v1.RespondWorkflowTaskCompletedRequest{}
+			if m.SignalWithStartRequest == nil {
+				m.SignalWithStartRequest = &v1.SignalWithStartWorkflowExecutionRequest{}
 			}
-			if err := m.CompleteRequest.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.SignalWithStartRequest.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -16741,7 +20585,7 @@ func (m *RespondWorkflowTaskCompletedRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *RespondWorkflowTaskCompletedResponse) Unmarshal(dAtA []byte) error {
+func (m *SignalWithStartWorkflowExecutionResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -16764,17 +20608,17 @@ func (m *RespondWorkflowTaskCompletedResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: RespondWorkflowTaskCompletedResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: SignalWithStartWorkflowExecutionResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: RespondWorkflowTaskCompletedResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: SignalWithStartWorkflowExecutionResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field StartedResponse", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field RunId", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowRequestResponse
@@ -16784,27 +20628,23 @@ func (m *RespondWorkflowTaskCompletedResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthRequestResponse
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthRequestResponse
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.StartedResponse == nil {
-				m.StartedResponse = &RecordWorkflowTaskStartedResponse{}
-			}
-			if err := m.StartedResponse.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.RunId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -16830,7 +20670,7 @@ func (m *RespondWorkflowTaskCompletedResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *RespondWorkflowTaskFailedRequest) Unmarshal(dAtA []byte) error {
+func (m *RemoveSignalMutableStateRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -16853,10 +20693,10 @@ func (m *RespondWorkflowTaskFailedRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: RespondWorkflowTaskFailedRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: RemoveSignalMutableStateRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: RespondWorkflowTaskFailedRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: RemoveSignalMutableStateRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -16893,7 +20733,7 @@ func (m *RespondWorkflowTaskFailedRequest) Unmarshal(dAtA []byte) error {
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field FailedRequest", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field WorkflowExecution", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -16920,13 +20760,45 @@ func (m *RespondWorkflowTaskFailedRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.FailedRequest == nil {
-				m.FailedRequest = &v1.RespondWorkflowTaskFailedRequest{}
+			if m.WorkflowExecution == nil {
+				m.WorkflowExecution = &v14.WorkflowExecution{}
 			}
-			if err := m.FailedRequest.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.WorkflowExecution.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RequestId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRequestResponse
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthRequestResponse
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthRequestResponse
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RequestId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipRequestResponse(dAtA[iNdEx:])
@@ -16951,7 +20823,7 @@ func (m *RespondWorkflowTaskFailedRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *RespondWorkflowTaskFailedResponse) Unmarshal(dAtA []byte) error {
+func (m *RemoveSignalMutableStateResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -16974,10 +20846,10 @@ func (m *RespondWorkflowTaskFailedResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: RespondWorkflowTaskFailedResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: RemoveSignalMutableStateResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: RespondWorkflowTaskFailedResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: RemoveSignalMutableStateResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -17004,7 +20876,7 @@ func (m *RespondWorkflowTaskFailedResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *RecordActivityTaskHeartbeatRequest) Unmarshal(dAtA []byte) error {
+func (m *TerminateWorkflowExecutionRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -17027,10 +20899,10 @@ func (m *RecordActivityTaskHeartbeatRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: RecordActivityTaskHeartbeatRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: TerminateWorkflowExecutionRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: RecordActivityTaskHeartbeatRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: TerminateWorkflowExecutionRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -17067,7 +20939,7 @@ func (m *RecordActivityTaskHeartbeatRequest) Unmarshal(dAtA []byte) error {
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field HeartbeatRequest", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field TerminateRequest", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -17094,10 +20966,10 @@ func (m *RecordActivityTaskHeartbeatRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.HeartbeatRequest == nil {
-				m.HeartbeatRequest = &v1.RecordActivityTaskHeartbeatRequest{}
+			if m.TerminateRequest == nil {
+				m.TerminateRequest = &v1.TerminateWorkflowExecutionRequest{}
 			}
-			if err := m.HeartbeatRequest.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.TerminateRequest.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -17125,7 +20997,7 @@ func (m *RecordActivityTaskHeartbeatRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *RecordActivityTaskHeartbeatResponse) Unmarshal(dAtA []byte) error {
+func (m *TerminateWorkflowExecutionResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -17148,32 +21020,12 @@ func (m *RecordActivityTaskHeartbeatResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: RecordActivityTaskHeartbeatResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: TerminateWorkflowExecutionResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: RecordActivityTaskHeartbeatResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: TerminateWorkflowExecutionResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
-		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field CancelRequested", wireType)
-			}
-			var v int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowRequestResponse
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				v |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			m.CancelRequested = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipRequestResponse(dAtA[iNdEx:])
@@ -17198,7 +21050,7 @@ func (m *RecordActivityTaskHeartbeatResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *RespondActivityTaskCompletedRequest) Unmarshal(dAtA []byte) error {
+func (m *ResetWorkflowExecutionRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -17221,10 +21073,10 @@ func (m *RespondActivityTaskCompletedRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: RespondActivityTaskCompletedRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: ResetWorkflowExecutionRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: RespondActivityTaskCompletedRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ResetWorkflowExecutionRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -17261,7 +21113,7 @@ func (m *RespondActivityTaskCompletedRequest) Unmarshal(dAtA []byte) error {
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field CompleteRequest", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ResetRequest", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -17288,66 +21140,92 @@ func (m *RespondActivityTaskCompletedRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.CompleteRequest == nil {
-				m.CompleteRequest = &v1.RespondActivityTaskCompletedRequest{}
+			if m.ResetRequest == nil {
+				m.ResetRequest = &v1.ResetWorkflowExecutionRequest{}
 			}
-			if err := m.CompleteRequest.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.ResetRequest.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipRequestResponse(dAtA[iNdEx:])
-			if err != nil {
-				return err
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field WorkflowTaskScheduledEventId", wireType)
 			}
-			if skippy < 0 {
-				return ErrInvalidLengthRequestResponse
+			m.WorkflowTaskScheduledEventId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRequestResponse
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.WorkflowTaskScheduledEventId |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			if (iNdEx + skippy) < 0 {
-				return ErrInvalidLengthRequestResponse
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ReapplyExcludeSignals", wireType)
 			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRequestResponse
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *RespondActivityTaskCompletedResponse) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowRequestResponse
+			m.ReapplyExcludeSignals = bool(v != 0)
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ReapplyExcludeAll", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRequestResponse
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
+			m.ReapplyExcludeAll = bool(v != 0)
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DryRun", wireType)
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRequestResponse
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: RespondActivityTaskCompletedResponse: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: RespondActivityTaskCompletedResponse: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
+			m.DryRun = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipRequestResponse(dAtA[iNdEx:])
@@ -17372,7 +21250,7 @@ func (m *RespondActivityTaskCompletedResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *RespondActivityTaskFailedRequest) Unmarshal(dAtA []byte) error {
+func (m *ResetWorkflowExecutionResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -17395,15 +21273,15 @@ func (m *RespondActivityTaskFailedRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: RespondActivityTaskFailedRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: ResetWorkflowExecutionResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: RespondActivityTaskFailedRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ResetWorkflowExecutionResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field NamespaceId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field RunId", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -17431,11 +21309,11 @@ func (m *RespondActivityTaskFailedRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.NamespaceId = string(dAtA[iNdEx:postIndex])
+			m.RunId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field FailedRequest", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Preview", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -17462,10 +21340,10 @@ func (m *RespondActivityTaskFailedRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.FailedRequest == nil {
-				m.FailedRequest = &v1.RespondActivityTaskFailedRequest{}
+			if m.Preview == nil {
+				m.Preview = &ResetWorkflowExecutionPreview{}
 			}
-			if err := m.FailedRequest.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Preview.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -17493,7 +21371,7 @@ func (m *RespondActivityTaskFailedRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *RespondActivityTaskFailedResponse) Unmarshal(dAtA []byte) error {
+func (m *ResetWorkflowExecutionPreview) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -17516,68 +21394,66 @@ func (m *RespondActivityTaskFailedResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: RespondActivityTaskFailedResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: ResetWorkflowExecutionPreview: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: RespondActivityTaskFailedResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ResetWorkflowExecutionPreview: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
-		default:
-			iNdEx = preIndex
-			skippy, err := skipRequestResponse(dAtA[iNdEx:])
-			if err != nil {
-				return err
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BaseRunId", wireType)
 			}
-			if skippy < 0 {
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRequestResponse
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthRequestResponse
 			}
-			if (iNdEx + skippy) < 0 {
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
 				return ErrInvalidLengthRequestResponse
 			}
-			if (iNdEx + skippy) > l {
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *RespondActivityTaskCanceledRequest) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowRequestResponse
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
+			m.BaseRunId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field WorkflowTaskFinishEventId", wireType)
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
+			m.WorkflowTaskFinishEventId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRequestResponse
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.WorkflowTaskFinishEventId |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: RespondActivityTaskCanceledRequest: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: RespondActivityTaskCanceledRequest: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field NamespaceId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field TerminatedRunId", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -17605,11 +21481,11 @@ func (m *RespondActivityTaskCanceledRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.NamespaceId = string(dAtA[iNdEx:postIndex])
+			m.TerminatedRunId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 2:
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field CancelRequest", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field DroppedEvents", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -17636,66 +21512,113 @@ func (m *RespondActivityTaskCanceledRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.CancelRequest == nil {
-				m.CancelRequest = &v1.RespondActivityTaskCanceledRequest{}
-			}
-			if err := m.CancelRequest.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.DroppedEvents = append(m.DroppedEvents, &v19.HistoryEvent{})
+			if err := m.DroppedEvents[len(m.DroppedEvents)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipRequestResponse(dAtA[iNdEx:])
-			if err != nil {
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FailedActivities", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRequestResponse
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthRequestResponse
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthRequestResponse
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.FailedActivities = append(m.FailedActivities, &v110.PendingActivityInfo{})
+			if err := m.FailedActivities[len(m.FailedActivities)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
-			if skippy < 0 {
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PendingChildren", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRequestResponse
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
 				return ErrInvalidLengthRequestResponse
 			}
-			if (iNdEx + skippy) < 0 {
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
 				return ErrInvalidLengthRequestResponse
 			}
-			if (iNdEx + skippy) > l {
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *RespondActivityTaskCanceledResponse) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowRequestResponse
+			m.PendingChildren = append(m.PendingChildren, &v110.PendingChildExecutionInfo{})
+			if err := m.PendingChildren[len(m.PendingChildren)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-			if iNdEx >= l {
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ReappliedEvents", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRequestResponse
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthRequestResponse
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthRequestResponse
+			}
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
+			m.ReappliedEvents = append(m.ReappliedEvents, &v19.HistoryEvent{})
+			if err := m.ReappliedEvents[len(m.ReappliedEvents)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: RespondActivityTaskCanceledResponse: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: RespondActivityTaskCanceledResponse: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipRequestResponse(dAtA[iNdEx:])
@@ -17720,7 +21643,7 @@ func (m *RespondActivityTaskCanceledResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *SignalWorkflowExecutionRequest) Unmarshal(dAtA []byte) error {
+func (m *RequestCancelWorkflowExecutionRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -17743,10 +21666,10 @@ func (m *SignalWorkflowExecutionRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: SignalWorkflowExecutionRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: RequestCancelWorkflowExecutionRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: SignalWorkflowExecutionRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: RequestCancelWorkflowExecutionRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -17783,7 +21706,7 @@ func (m *SignalWorkflowExecutionRequest) Unmarshal(dAtA []byte) error {
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SignalRequest", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field CancelRequest", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -17810,14 +21733,33 @@ func (m *SignalWorkflowExecutionRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.SignalRequest == nil {
-				m.SignalRequest = &v1.SignalWorkflowExecutionRequest{}
+			if m.CancelRequest == nil {
+				m.CancelRequest = &v1.RequestCancelWorkflowExecutionRequest{}
 			}
-			if err := m.SignalRequest.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.CancelRequest.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
 		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExternalInitiatedEventId", wireType)
+			}
+			m.ExternalInitiatedEventId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRequestResponse
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ExternalInitiatedEventId |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
 			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field ExternalWorkflowExecution", wireType)
 			}
@@ -17853,7 +21795,7 @@ func (m *SignalWorkflowExecutionRequest) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
-		case 4:
+		case 5:
 			if wireType != 0 {
 				return fmt.Errorf("proto: wrong wireType = %d for field ChildWorkflowOnly", wireType)
 			}
@@ -17897,7 +21839,7 @@ func (m *SignalWorkflowExecutionRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *SignalWorkflowExecutionResponse) Unmarshal(dAtA []byte) error {
+func (m *RequestCancelWorkflowExecutionResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -17920,10 +21862,10 @@ func (m *SignalWorkflowExecutionResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: SignalWorkflowExecutionResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: RequestCancelWorkflowExecutionResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: SignalWorkflowExecutionResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: RequestCancelWorkflowExecutionResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -17950,7 +21892,7 @@ func (m *SignalWorkflowExecutionResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *SignalWithStartWorkflowExecutionRequest) Unmarshal(dAtA []byte) error {
+func (m *ScheduleWorkflowTaskRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -17973,10 +21915,10 @@ func (m *SignalWithStartWorkflowExecutionRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: SignalWithStartWorkflowExecutionRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: ScheduleWorkflowTaskRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: SignalWithStartWorkflowExecutionRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ScheduleWorkflowTaskRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -18013,7 +21955,7 @@ func (m *SignalWithStartWorkflowExecutionRequest) Unmarshal(dAtA []byte) error {
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SignalWithStartRequest", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field WorkflowExecution", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -18040,13 +21982,33 @@ func (m *SignalWithStartWorkflowExecutionRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.SignalWithStartRequest == nil {
-				m.SignalWithStartRequest = &v1.SignalWithStartWorkflowExecutionRequest{}
+			if m.WorkflowExecution == nil {
+				m.WorkflowExecution = &v14.WorkflowExecution{}
 			}
-			if err := m.SignalWithStartRequest.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.WorkflowExecution.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field IsFirstWorkflowTask", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRequestResponse
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.IsFirstWorkflowTask = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipRequestResponse(dAtA[iNdEx:])
@@ -18071,7 +22033,7 @@ func (m *SignalWithStartWorkflowExecutionRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *SignalWithStartWorkflowExecutionResponse) Unmarshal(dAtA []byte) error {
+func (m *ScheduleWorkflowTaskResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -18094,44 +22056,12 @@ func (m *SignalWithStartWorkflowExecutionResponse) Unmarshal(dAtA []byte) error
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: SignalWithStartWorkflowExecutionResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: ScheduleWorkflowTaskResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: SignalWithStartWorkflowExecutionResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ScheduleWorkflowTaskResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
-		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field RunId", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowRequestResponse
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthRequestResponse
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthRequestResponse
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.RunId = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipRequestResponse(dAtA[iNdEx:])
@@ -18156,7 +22086,7 @@ func (m *SignalWithStartWorkflowExecutionResponse) Unmarshal(dAtA []byte) error
 	}
 	return nil
 }
-func (m *RemoveSignalMutableStateRequest) Unmarshal(dAtA []byte) error {
+func (m *RecordChildExecutionCompletedRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -18179,10 +22109,10 @@ func (m *RemoveSignalMutableStateRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: RemoveSignalMutableStateRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: RecordChildExecutionCompletedRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: RemoveSignalMutableStateRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: RecordChildExecutionCompletedRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -18254,148 +22184,29 @@ func (m *RemoveSignalMutableStateRequest) Unmarshal(dAtA []byte) error {
 			}
 			iNdEx = postIndex
 		case 3:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field RequestId", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowRequestResponse
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthRequestResponse
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthRequestResponse
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.RequestId = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipRequestResponse(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if skippy < 0 {
-				return ErrInvalidLengthRequestResponse
-			}
-			if (iNdEx + skippy) < 0 {
-				return ErrInvalidLengthRequestResponse
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *RemoveSignalMutableStateResponse) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowRequestResponse
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: RemoveSignalMutableStateResponse: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: RemoveSignalMutableStateResponse: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		default:
-			iNdEx = preIndex
-			skippy, err := skipRequestResponse(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if skippy < 0 {
-				return ErrInvalidLengthRequestResponse
-			}
-			if (iNdEx + skippy) < 0 {
-				return ErrInvalidLengthRequestResponse
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *TerminateWorkflowExecutionRequest) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowRequestResponse
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field InitiatedId", wireType)
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
+			m.InitiatedId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRequestResponse
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.InitiatedId |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: TerminateWorkflowExecutionRequest: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: TerminateWorkflowExecutionRequest: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field NamespaceId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field CompletedExecution", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowRequestResponse
@@ -18405,27 +22216,31 @@ func (m *TerminateWorkflowExecutionRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthRequestResponse
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthRequestResponse
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.NamespaceId = string(dAtA[iNdEx:postIndex])
+			if m.CompletedExecution == nil {
+				m.CompletedExecution = &v14.WorkflowExecution{}
+			}
+			if err := m.CompletedExecution.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 2:
+		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TerminateRequest", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field CompletionEvent", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -18452,10 +22267,10 @@ func (m *TerminateWorkflowExecutionRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.TerminateRequest == nil {
-				m.TerminateRequest = &v1.TerminateWorkflowExecutionRequest{}
+			if m.CompletionEvent == nil {
+				m.CompletionEvent = &v19.HistoryEvent{}
 			}
-			if err := m.TerminateRequest.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.CompletionEvent.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -18483,7 +22298,7 @@ func (m *TerminateWorkflowExecutionRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *TerminateWorkflowExecutionResponse) Unmarshal(dAtA []byte) error {
+func (m *RecordChildExecutionCompletedResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -18506,10 +22321,10 @@ func (m *TerminateWorkflowExecutionResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: TerminateWorkflowExecutionResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: RecordChildExecutionCompletedResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: TerminateWorkflowExecutionResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: RecordChildExecutionCompletedResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -18536,7 +22351,7 @@ func (m *TerminateWorkflowExecutionResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ResetWorkflowExecutionRequest) Unmarshal(dAtA []byte) error {
+func (m *DescribeWorkflowExecutionRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -18559,10 +22374,10 @@ func (m *ResetWorkflowExecutionRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ResetWorkflowExecutionRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: DescribeWorkflowExecutionRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ResetWorkflowExecutionRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: DescribeWorkflowExecutionRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -18599,7 +22414,7 @@ func (m *ResetWorkflowExecutionRequest) Unmarshal(dAtA []byte) error {
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ResetRequest", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Request", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -18626,10 +22441,10 @@ func (m *ResetWorkflowExecutionRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.ResetRequest == nil {
-				m.ResetRequest = &v1.ResetWorkflowExecutionRequest{}
+			if m.Request == nil {
+				m.Request = &v1.DescribeWorkflowExecutionRequest{}
 			}
-			if err := m.ResetRequest.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Request.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -18657,7 +22472,7 @@ func (m *ResetWorkflowExecutionRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ResetWorkflowExecutionResponse) Unmarshal(dAtA []byte) error {
+func (m *DescribeWorkflowExecutionResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -18680,17 +22495,17 @@ func (m *ResetWorkflowExecutionResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ResetWorkflowExecutionResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: DescribeWorkflowExecutionResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ResetWorkflowExecutionResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: DescribeWorkflowExecutionResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field RunId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ExecutionConfig", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowRequestResponse
@@ -18700,82 +22515,69 @@ func (m *ResetWorkflowExecutionResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthRequestResponse
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthRequestResponse
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.RunId = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipRequestResponse(dAtA[iNdEx:])
-			if err != nil {
+			if m.ExecutionConfig == nil {
+				m.ExecutionConfig = &v110.WorkflowExecutionConfig{}
+			}
+			if err := m.ExecutionConfig.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
-			if skippy < 0 {
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field WorkflowExecutionInfo", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRequestResponse
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
 				return ErrInvalidLengthRequestResponse
 			}
-			if (iNdEx + skippy) < 0 {
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
 				return ErrInvalidLengthRequestResponse
 			}
-			if (iNdEx + skippy) > l {
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *RequestCancelWorkflowExecutionRequest) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowRequestResponse
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
+			if m.WorkflowExecutionInfo == nil {
+				m.WorkflowExecutionInfo = &v110.WorkflowExecutionInfo{}
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
+			if err := m.WorkflowExecutionInfo.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: RequestCancelWorkflowExecutionRequest: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: RequestCancelWorkflowExecutionRequest: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+			iNdEx = postIndex
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field NamespaceId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field PendingActivities", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowRequestResponse
@@ -18785,27 +22587,29 @@ func (m *RequestCancelWorkflowExecutionRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthRequestResponse
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthRequestResponse
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.NamespaceId = string(dAtA[iNdEx:postIndex])
+			m.PendingActivities = append(m.PendingActivities, &v110.PendingActivityInfo{})
+			if err := m.PendingActivities[len(m.PendingActivities)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 2:
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field CancelRequest", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field PendingChildren", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -18832,18 +22636,16 @@ func (m *RequestCancelWorkflowExecutionRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.CancelRequest == nil {
-				m.CancelRequest = &v1.RequestCancelWorkflowExecutionRequest{}
-			}
-			if err := m.CancelRequest.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.PendingChildren = append(m.PendingChildren, &v110.PendingChildExecutionInfo{})
+			if err := m.PendingChildren[len(m.PendingChildren)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 3:
+		case 5:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ExternalInitiatedEventId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field HistorySizeBytes", wireType)
 			}
-			m.ExternalInitiatedEventId = 0
+			m.HistorySizeBytes = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowRequestResponse
@@ -18853,16 +22655,16 @@ func (m *RequestCancelWorkflowExecutionRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.ExternalInitiatedEventId |= int64(b&0x7F) << shift
+				m.HistorySizeBytes |= int64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 4:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ExternalWorkflowExecution", wireType)
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SuggestContinueAsNew", wireType)
 			}
-			var msglen int
+			var v int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowRequestResponse
@@ -18872,31 +22674,15 @@ func (m *RequestCancelWorkflowExecutionRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				v |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthRequestResponse
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthRequestResponse
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if m.ExternalWorkflowExecution == nil {
-				m.ExternalWorkflowExecution = &v14.WorkflowExecution{}
-			}
-			if err := m.ExternalWorkflowExecution.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 5:
+			m.SuggestContinueAsNew = bool(v != 0)
+		case 7:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ChildWorkflowOnly", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Paused", wireType)
 			}
 			var v int
 			for shift := uint(0); ; shift += 7 {
@@ -18908,65 +22694,44 @@ func (m *RequestCancelWorkflowExecutionRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				v |= int(b&0x7F) << shift
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Paused = bool(v != 0)
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PauseReason", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRequestResponse
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			m.ChildWorkflowOnly = bool(v != 0)
-		default:
-			iNdEx = preIndex
-			skippy, err := skipRequestResponse(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if skippy < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthRequestResponse
 			}
-			if (iNdEx + skippy) < 0 {
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
 				return ErrInvalidLengthRequestResponse
 			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *RequestCancelWorkflowExecutionResponse) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowRequestResponse
-			}
-			if iNdEx >= l {
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: RequestCancelWorkflowExecutionResponse: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: RequestCancelWorkflowExecutionResponse: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
+			m.PauseReason = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipRequestResponse(dAtA[iNdEx:])
@@ -18991,7 +22756,7 @@ func (m *RequestCancelWorkflowExecutionResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ScheduleWorkflowTaskRequest) Unmarshal(dAtA []byte) error {
+func (m *ReplicateEventsV2Request) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -19014,10 +22779,10 @@ func (m *ScheduleWorkflowTaskRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ScheduleWorkflowTaskRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: ReplicateEventsV2Request: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ScheduleWorkflowTaskRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ReplicateEventsV2Request: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -19089,10 +22854,10 @@ func (m *ScheduleWorkflowTaskRequest) Unmarshal(dAtA []byte) error {
 			}
 			iNdEx = postIndex
 		case 3:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field IsFirstWorkflowTask", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field VersionHistoryItems", wireType)
 			}
-			var v int
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowRequestResponse
@@ -19102,12 +22867,98 @@ func (m *ScheduleWorkflowTaskRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				v |= int(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			m.IsFirstWorkflowTask = bool(v != 0)
+			if msglen < 0 {
+				return ErrInvalidLengthRequestResponse
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthRequestResponse
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.VersionHistoryItems = append(m.VersionHistoryItems, &v17.VersionHistoryItem{})
+			if err := m.VersionHistoryItems[len(m.VersionHistoryItems)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Events", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRequestResponse
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthRequestResponse
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthRequestResponse
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Events == nil {
+				m.Events = &v14.DataBlob{}
+			}
+			if err := m.Events.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NewRunEvents", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRequestResponse
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthRequestResponse
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthRequestResponse
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.NewRunEvents == nil {
+				m.NewRunEvents = &v14.DataBlob{}
+			}
+			if err := m.NewRunEvents.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipRequestResponse(dAtA[iNdEx:])
@@ -19132,7 +22983,7 @@ func (m *ScheduleWorkflowTaskRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ScheduleWorkflowTaskResponse) Unmarshal(dAtA []byte) error {
+func (m *ReplicateEventsV2Response) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -19155,10 +23006,10 @@ func (m *ScheduleWorkflowTaskResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ScheduleWorkflowTaskResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: ReplicateEventsV2Response: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ScheduleWorkflowTaskResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ReplicateEventsV2Response: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -19185,7 +23036,7 @@ func (m *ScheduleWorkflowTaskResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *RecordChildExecutionCompletedRequest) Unmarshal(dAtA []byte) error {
+func (m *SyncShardStatusRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -19208,15 +23059,15 @@ func (m *RecordChildExecutionCompletedRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: RecordChildExecutionCompletedRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: SyncShardStatusRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: RecordChildExecutionCompletedRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: SyncShardStatusRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field NamespaceId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field SourceCluster", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -19244,102 +23095,30 @@ func (m *RecordChildExecutionCompletedRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.NamespaceId = string(dAtA[iNdEx:postIndex])
+			m.SourceCluster = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field WorkflowExecution", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowRequestResponse
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthRequestResponse
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthRequestResponse
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if m.WorkflowExecution == nil {
-				m.WorkflowExecution = &v14.WorkflowExecution{}
-			}
-			if err := m.WorkflowExecution.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 3:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field InitiatedId", wireType)
-			}
-			m.InitiatedId = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowRequestResponse
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.InitiatedId |= int64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 4:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field CompletedExecution", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ShardId", wireType)
 			}
-			var msglen int
+			m.ShardId = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowRequestResponse
 				}
 				if iNdEx >= l {
 					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthRequestResponse
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthRequestResponse
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if m.CompletedExecution == nil {
-				m.CompletedExecution = &v14.WorkflowExecution{}
-			}
-			if err := m.CompletedExecution.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ShardId |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			iNdEx = postIndex
-		case 5:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field CompletionEvent", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field StatusTime", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -19366,10 +23145,10 @@ func (m *RecordChildExecutionCompletedRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.CompletionEvent == nil {
-				m.CompletionEvent = &v19.HistoryEvent{}
+			if m.StatusTime == nil {
+				m.StatusTime = new(time.Time)
 			}
-			if err := m.CompletionEvent.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(m.StatusTime, dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -19397,7 +23176,7 @@ func (m *RecordChildExecutionCompletedRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *RecordChildExecutionCompletedResponse) Unmarshal(dAtA []byte) error {
+func (m *SyncShardStatusResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -19420,10 +23199,10 @@ func (m *RecordChildExecutionCompletedResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: RecordChildExecutionCompletedResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: SyncShardStatusResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: RecordChildExecutionCompletedResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: SyncShardStatusResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -19450,7 +23229,7 @@ func (m *RecordChildExecutionCompletedResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *DescribeWorkflowExecutionRequest) Unmarshal(dAtA []byte) error {
+func (m *SyncActivityRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -19473,10 +23252,10 @@ func (m *DescribeWorkflowExecutionRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: DescribeWorkflowExecutionRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: SyncActivityRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: DescribeWorkflowExecutionRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: SyncActivityRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -19513,9 +23292,9 @@ func (m *DescribeWorkflowExecutionRequest) Unmarshal(dAtA []byte) error {
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Request", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field WorkflowId", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowRequestResponse
@@ -19525,86 +23304,29 @@ func (m *DescribeWorkflowExecutionRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthRequestResponse
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthRequestResponse
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Request == nil {
-				m.Request = &v1.DescribeWorkflowExecutionRequest{}
-			}
-			if err := m.Request.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.WorkflowId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipRequestResponse(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if skippy < 0 {
-				return ErrInvalidLengthRequestResponse
-			}
-			if (iNdEx + skippy) < 0 {
-				return ErrInvalidLengthRequestResponse
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *DescribeWorkflowExecutionResponse) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowRequestResponse
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: DescribeWorkflowExecutionResponse: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: DescribeWorkflowExecutionResponse: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ExecutionConfig", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field RunId", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowRequestResponse
@@ -19614,33 +23336,29 @@ func (m *DescribeWorkflowExecutionResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthRequestResponse
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthRequestResponse
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.ExecutionConfig == nil {
-				m.ExecutionConfig = &v110.WorkflowExecutionConfig{}
-			}
-			if err := m.ExecutionConfig.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.RunId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field WorkflowExecutionInfo", wireType)
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Version", wireType)
 			}
-			var msglen int
+			m.Version = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowRequestResponse
@@ -19650,31 +23368,33 @@ func (m *DescribeWorkflowExecutionResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				m.Version |= int64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthRequestResponse
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthRequestResponse
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if m.WorkflowExecutionInfo == nil {
-				m.WorkflowExecutionInfo = &v110.WorkflowExecutionInfo{}
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ScheduledId", wireType)
 			}
-			if err := m.WorkflowExecutionInfo.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			m.ScheduledId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRequestResponse
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ScheduledId |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			iNdEx = postIndex
-		case 3:
+		case 6:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PendingActivities", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ScheduledTime", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -19701,14 +23421,35 @@ func (m *DescribeWorkflowExecutionResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.PendingActivities = append(m.PendingActivities, &v110.PendingActivityInfo{})
-			if err := m.PendingActivities[len(m.PendingActivities)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if m.ScheduledTime == nil {
+				m.ScheduledTime = new(time.Time)
+			}
+			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(m.ScheduledTime, dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 4:
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StartedId", wireType)
+			}
+			m.StartedId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRequestResponse
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.StartedId |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 8:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PendingChildren", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field StartedTime", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -19735,69 +23476,18 @@ func (m *DescribeWorkflowExecutionResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.PendingChildren = append(m.PendingChildren, &v110.PendingChildExecutionInfo{})
-			if err := m.PendingChildren[len(m.PendingChildren)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			if m.StartedTime == nil {
+				m.StartedTime = new(time.Time)
 			}
-			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipRequestResponse(dAtA[iNdEx:])
-			if err != nil {
+			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(m.StartedTime, dAtA[iNdEx:postIndex]); err != nil {
 				return err
-			}
-			if skippy < 0 {
-				return ErrInvalidLengthRequestResponse
-			}
-			if (iNdEx + skippy) < 0 {
-				return ErrInvalidLengthRequestResponse
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *ReplicateEventsV2Request) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowRequestResponse
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: ReplicateEventsV2Request: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ReplicateEventsV2Request: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+			}
+			iNdEx = postIndex
+		case 9:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field NamespaceId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field LastHeartbeatTime", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowRequestResponse
@@ -19807,27 +23497,31 @@ func (m *ReplicateEventsV2Request) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthRequestResponse
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthRequestResponse
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.NamespaceId = string(dAtA[iNdEx:postIndex])
+			if m.LastHeartbeatTime == nil {
+				m.LastHeartbeatTime = new(time.Time)
+			}
+			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(m.LastHeartbeatTime, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 2:
+		case 10:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field WorkflowExecution", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Details", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -19854,16 +23548,35 @@ func (m *ReplicateEventsV2Request) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.WorkflowExecution == nil {
-				m.WorkflowExecution = &v14.WorkflowExecution{}
+			if m.Details == nil {
+				m.Details = &v14.Payloads{}
 			}
-			if err := m.WorkflowExecution.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Details.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 3:
+		case 11:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Attempt", wireType)
+			}
+			m.Attempt = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRequestResponse
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Attempt |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 12:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field VersionHistoryItems", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field LastFailure", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -19890,16 +23603,18 @@ func (m *ReplicateEventsV2Request) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.VersionHistoryItems = append(m.VersionHistoryItems, &v17.VersionHistoryItem{})
-			if err := m.VersionHistoryItems[len(m.VersionHistoryItems)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if m.LastFailure == nil {
+				m.LastFailure = &v13.Failure{}
+			}
+			if err := m.LastFailure.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 4:
+		case 13:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Events", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field LastWorkerIdentity", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowRequestResponse
@@ -19909,31 +23624,27 @@ func (m *ReplicateEventsV2Request) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthRequestResponse
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthRequestResponse
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Events == nil {
-				m.Events = &v14.DataBlob{}
-			}
-			if err := m.Events.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.LastWorkerIdentity = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 5:
+		case 14:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field NewRunEvents", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field VersionHistory", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -19960,10 +23671,10 @@ func (m *ReplicateEventsV2Request) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.NewRunEvents == nil {
-				m.NewRunEvents = &v14.DataBlob{}
+			if m.VersionHistory == nil {
+				m.VersionHistory = &v17.VersionHistory{}
 			}
-			if err := m.NewRunEvents.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.VersionHistory.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -19991,7 +23702,7 @@ func (m *ReplicateEventsV2Request) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ReplicateEventsV2Response) Unmarshal(dAtA []byte) error {
+func (m *SyncActivityResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -20014,10 +23725,10 @@ func (m *ReplicateEventsV2Response) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ReplicateEventsV2Response: wiretype end group for non-group")
+			return fmt.Errorf("proto: SyncActivityResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ReplicateEventsV2Response: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: SyncActivityResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -20044,7 +23755,7 @@ func (m *ReplicateEventsV2Response) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *SyncShardStatusRequest) Unmarshal(dAtA []byte) error {
+func (m *DescribeMutableStateRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -20067,15 +23778,15 @@ func (m *SyncShardStatusRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: SyncShardStatusRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: DescribeMutableStateRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: SyncShardStatusRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: DescribeMutableStateRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SourceCluster", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field NamespaceId", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -20103,30 +23814,11 @@ func (m *SyncShardStatusRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.SourceCluster = string(dAtA[iNdEx:postIndex])
+			m.NamespaceId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ShardId", wireType)
-			}
-			m.ShardId = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowRequestResponse
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.ShardId |= int64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field StatusTime", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Execution", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -20153,10 +23845,10 @@ func (m *SyncShardStatusRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.StatusTime == nil {
-				m.StatusTime = new(time.Time)
+			if m.Execution == nil {
+				m.Execution = &v14.WorkflowExecution{}
 			}
-			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(m.StatusTime, dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Execution.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -20184,7 +23876,7 @@ func (m *SyncShardStatusRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *SyncShardStatusResponse) Unmarshal(dAtA []byte) error {
+func (m *DescribeMutableStateResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -20207,12 +23899,140 @@ func (m *SyncShardStatusResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: SyncShardStatusResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: DescribeMutableStateResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: SyncShardStatusResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: DescribeMutableStateResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CacheMutableState", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRequestResponse
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthRequestResponse
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthRequestResponse
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.CacheMutableState = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DatabaseMutableState", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRequestResponse
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthRequestResponse
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthRequestResponse
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DatabaseMutableState = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TreeId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRequestResponse
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthRequestResponse
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthRequestResponse
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TreeId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BranchId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRequestResponse
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthRequestResponse
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthRequestResponse
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.BranchId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipRequestResponse(dAtA[iNdEx:])
@@ -20237,7 +24057,7 @@ func (m *SyncShardStatusResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *SyncActivityRequest) Unmarshal(dAtA []byte) error {
+func (m *DescribeHistoryHostRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -20260,15 +24080,15 @@ func (m *SyncActivityRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: SyncActivityRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: DescribeHistoryHostRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: SyncActivityRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: DescribeHistoryHostRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field NamespaceId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field HostAddress", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -20296,13 +24116,13 @@ func (m *SyncActivityRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.NamespaceId = string(dAtA[iNdEx:postIndex])
+			m.HostAddress = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field WorkflowId", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ShardId", wireType)
 			}
-			var stringLen uint64
+			m.ShardId = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowRequestResponse
@@ -20312,27 +24132,14 @@ func (m *SyncActivityRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.ShardId |= int32(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthRequestResponse
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthRequestResponse
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.WorkflowId = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field RunId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field NamespaceId", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -20360,13 +24167,13 @@ func (m *SyncActivityRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.RunId = string(dAtA[iNdEx:postIndex])
+			m.NamespaceId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 4:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Version", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field WorkflowExecution", wireType)
 			}
-			m.Version = 0
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowRequestResponse
@@ -20376,16 +24183,86 @@ func (m *SyncActivityRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Version |= int64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 5:
+			if msglen < 0 {
+				return ErrInvalidLengthRequestResponse
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthRequestResponse
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.WorkflowExecution == nil {
+				m.WorkflowExecution = &v14.WorkflowExecution{}
+			}
+			if err := m.WorkflowExecution.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipRequestResponse(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthRequestResponse
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthRequestResponse
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *DescribeHistoryHostResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowRequestResponse
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: DescribeHistoryHostResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: DescribeHistoryHostResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ScheduledId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ShardsNumber", wireType)
 			}
-			m.ScheduledId = 0
+			m.ShardsNumber = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowRequestResponse
@@ -20395,14 +24272,90 @@ func (m *SyncActivityRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.ScheduledId |= int64(b&0x7F) << shift
+				m.ShardsNumber |= int32(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 6:
+		case 2:
+			if wireType == 0 {
+				var v int32
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowRequestResponse
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= int32(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				m.ShardIds = append(m.ShardIds, v)
+			} else if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowRequestResponse
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					packedLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if packedLen < 0 {
+					return ErrInvalidLengthRequestResponse
+				}
+				postIndex := iNdEx + packedLen
+				if postIndex < 0 {
+					return ErrInvalidLengthRequestResponse
+				}
+				if postIndex > l {
+					return io.ErrUnexpectedEOF
+				}
+				var elementCount int
+				var count int
+				for _, integer := range dAtA[iNdEx:postIndex] {
+					if integer < 128 {
+						count++
+					}
+				}
+				elementCount = count
+				if elementCount != 0 && len(m.ShardIds) == 0 {
+					m.ShardIds = make([]int32, 0, elementCount)
+				}
+				for iNdEx < postIndex {
+					var v int32
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowRequestResponse
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						v |= int32(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					m.ShardIds = append(m.ShardIds, v)
+				}
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field ShardIds", wireType)
+			}
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ScheduledTime", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field NamespaceCache", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -20429,37 +24382,18 @@ func (m *SyncActivityRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.ScheduledTime == nil {
-				m.ScheduledTime = new(time.Time)
+			if m.NamespaceCache == nil {
+				m.NamespaceCache = &v111.NamespaceCacheInfo{}
 			}
-			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(m.ScheduledTime, dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.NamespaceCache.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 7:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field StartedId", wireType)
-			}
-			m.StartedId = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowRequestResponse
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.StartedId |= int64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 8:
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field StartedTime", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ShardControllerStatus", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowRequestResponse
@@ -20469,33 +24403,29 @@ func (m *SyncActivityRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthRequestResponse
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthRequestResponse
 			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if m.StartedTime == nil {
-				m.StartedTime = new(time.Time)
-			}
-			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(m.StartedTime, dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
 			}
+			m.ShardControllerStatus = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 9:
+		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field LastHeartbeatTime", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowRequestResponse
@@ -20505,33 +24435,82 @@ func (m *SyncActivityRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthRequestResponse
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthRequestResponse
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.LastHeartbeatTime == nil {
-				m.LastHeartbeatTime = new(time.Time)
-			}
-			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(m.LastHeartbeatTime, dAtA[iNdEx:postIndex]); err != nil {
+			m.Address = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipRequestResponse(dAtA[iNdEx:])
+			if err != nil {
 				return err
 			}
-			iNdEx = postIndex
-		case 10:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Details", wireType)
+			if skippy < 0 {
+				return ErrInvalidLengthRequestResponse
 			}
-			var msglen int
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthRequestResponse
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *CloseShardRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowRequestResponse
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: CloseShardRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: CloseShardRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ShardId", wireType)
+			}
+			m.ShardId = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowRequestResponse
@@ -20541,33 +24520,122 @@ func (m *SyncActivityRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				m.ShardId |= int32(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipRequestResponse(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
 				return ErrInvalidLengthRequestResponse
 			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
+			if (iNdEx + skippy) < 0 {
 				return ErrInvalidLengthRequestResponse
 			}
-			if postIndex > l {
+			if (iNdEx + skippy) > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Details == nil {
-				m.Details = &v14.Payloads{}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *CloseShardResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowRequestResponse
 			}
-			if err := m.Details.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: CloseShardResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: CloseShardResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipRequestResponse(dAtA[iNdEx:])
+			if err != nil {
 				return err
 			}
-			iNdEx = postIndex
-		case 11:
+			if skippy < 0 {
+				return ErrInvalidLengthRequestResponse
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthRequestResponse
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *RemoveTaskRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowRequestResponse
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: RemoveTaskRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: RemoveTaskRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Attempt", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ShardId", wireType)
 			}
-			m.Attempt = 0
+			m.ShardId = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowRequestResponse
@@ -20577,16 +24645,16 @@ func (m *SyncActivityRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Attempt |= int32(b&0x7F) << shift
+				m.ShardId |= int32(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 12:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field LastFailure", wireType)
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Category", wireType)
 			}
-			var msglen int
+			m.Category = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowRequestResponse
@@ -20596,33 +24664,16 @@ func (m *SyncActivityRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				m.Category |= v16.TaskCategory(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthRequestResponse
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthRequestResponse
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if m.LastFailure == nil {
-				m.LastFailure = &v13.Failure{}
-			}
-			if err := m.LastFailure.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 13:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field LastWorkerIdentity", wireType)
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TaskId", wireType)
 			}
-			var stringLen uint64
+			m.TaskId = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowRequestResponse
@@ -20632,27 +24683,14 @@ func (m *SyncActivityRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.TaskId |= int64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthRequestResponse
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthRequestResponse
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.LastWorkerIdentity = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 14:
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field VersionHistory", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field VisibilityTime", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -20679,10 +24717,10 @@ func (m *SyncActivityRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.VersionHistory == nil {
-				m.VersionHistory = &v17.VersionHistory{}
+			if m.VisibilityTime == nil {
+				m.VisibilityTime = new(time.Time)
 			}
-			if err := m.VersionHistory.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(m.VisibilityTime, dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -20710,7 +24748,7 @@ func (m *SyncActivityRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *SyncActivityResponse) Unmarshal(dAtA []byte) error {
+func (m *RemoveTaskResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -20733,10 +24771,10 @@ func (m *SyncActivityResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: SyncActivityResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: RemoveTaskResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: SyncActivityResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: RemoveTaskResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -20763,7 +24801,7 @@ func (m *SyncActivityResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *DescribeMutableStateRequest) Unmarshal(dAtA []byte) error {
+func (m *GetReplicationMessagesRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -20786,17 +24824,17 @@ func (m *DescribeMutableStateRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: DescribeMutableStateRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: GetReplicationMessagesRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: DescribeMutableStateRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: GetReplicationMessagesRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field NamespaceId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Tokens", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowRequestResponse
@@ -20806,29 +24844,31 @@ func (m *DescribeMutableStateRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthRequestResponse
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthRequestResponse
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.NamespaceId = string(dAtA[iNdEx:postIndex])
+			m.Tokens = append(m.Tokens, &v112.ReplicationToken{})
+			if err := m.Tokens[len(m.Tokens)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Execution", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ClusterName", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowRequestResponse
@@ -20838,27 +24878,23 @@ func (m *DescribeMutableStateRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthRequestResponse
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthRequestResponse
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Execution == nil {
-				m.Execution = &v14.WorkflowExecution{}
-			}
-			if err := m.Execution.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.ClusterName = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -20884,7 +24920,7 @@ func (m *DescribeMutableStateRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *DescribeMutableStateResponse) Unmarshal(dAtA []byte) error {
+func (m *GetReplicationMessagesResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -20907,17 +24943,17 @@ func (m *DescribeMutableStateResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: DescribeMutableStateResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: GetReplicationMessagesResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: DescribeMutableStateResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: GetReplicationMessagesResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field CacheMutableState", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ShardMessages", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowRequestResponse
@@ -20927,93 +24963,165 @@ func (m *DescribeMutableStateResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthRequestResponse
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthRequestResponse
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.CacheMutableState = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DatabaseMutableState", wireType)
+			if m.ShardMessages == nil {
+				m.ShardMessages = make(map[int32]*v112.ReplicationMessages)
 			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowRequestResponse
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
+			var mapkey int32
+			var mapvalue *v112.ReplicationMessages
+			for iNdEx < postIndex {
+				entryPreIndex := iNdEx
+				var wire uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowRequestResponse
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					wire |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
 				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
+				fieldNum := int32(wire >> 3)
+				if fieldNum == 1 {
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowRequestResponse
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						mapkey |= int32(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+				} else if fieldNum == 2 {
+					var mapmsglen int
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowRequestResponse
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						mapmsglen |= int(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					if mapmsglen < 0 {
+						return ErrInvalidLengthRequestResponse
+					}
+					postmsgIndex := iNdEx + mapmsglen
+					if postmsgIndex < 0 {
+						return ErrInvalidLengthRequestResponse
+					}
+					if postmsgIndex > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapvalue = &v112.ReplicationMessages{}
+					if err := mapvalue.Unmarshal(dAtA[iNdEx:postmsgIndex]); err != nil {
+						return err
+					}
+					iNdEx = postmsgIndex
+				} else {
+					iNdEx = entryPreIndex
+					skippy, err := skipRequestResponse(dAtA[iNdEx:])
+					if err != nil {
+						return err
+					}
+					if skippy < 0 {
+						return ErrInvalidLengthRequestResponse
+					}
+					if (iNdEx + skippy) > postIndex {
+						return io.ErrUnexpectedEOF
+					}
+					iNdEx += skippy
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			m.ShardMessages[mapkey] = mapvalue
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipRequestResponse(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
 				return ErrInvalidLengthRequestResponse
 			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
+			if (iNdEx + skippy) < 0 {
 				return ErrInvalidLengthRequestResponse
 			}
-			if postIndex > l {
+			if (iNdEx + skippy) > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.DatabaseMutableState = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 3:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TreeId", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowRequestResponse
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthRequestResponse
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthRequestResponse
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *GetDLQReplicationMessagesRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowRequestResponse
 			}
-			if postIndex > l {
+			if iNdEx >= l {
 				return io.ErrUnexpectedEOF
 			}
-			m.TreeId = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 4:
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: GetDLQReplicationMessagesRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: GetDLQReplicationMessagesRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field BranchId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field TaskInfos", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowRequestResponse
@@ -21023,23 +25131,25 @@ func (m *DescribeMutableStateResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			}
+			if msglen < 0 {
 				return ErrInvalidLengthRequestResponse
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthRequestResponse
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.BranchId = string(dAtA[iNdEx:postIndex])
+			m.TaskInfos = append(m.TaskInfos, &v112.ReplicationTaskInfo{})
+			if err := m.TaskInfos[len(m.TaskInfos)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -21065,7 +25175,7 @@ func (m *DescribeMutableStateResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *DescribeHistoryHostRequest) Unmarshal(dAtA []byte) error {
+func (m *GetDLQReplicationMessagesResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -21088,98 +25198,15 @@ func (m *DescribeHistoryHostRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: DescribeHistoryHostRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: GetDLQReplicationMessagesResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: DescribeHistoryHostRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: GetDLQReplicationMessagesResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field HostAddress", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowRequestResponse
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthRequestResponse
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthRequestResponse
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.HostAddress = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ShardId", wireType)
-			}
-			m.ShardId = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowRequestResponse
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.ShardId |= int32(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 3:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field NamespaceId", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowRequestResponse
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthRequestResponse
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthRequestResponse
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.NamespaceId = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 4:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field WorkflowExecution", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ReplicationTasks", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -21206,10 +25233,8 @@ func (m *DescribeHistoryHostRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.WorkflowExecution == nil {
-				m.WorkflowExecution = &v14.WorkflowExecution{}
-			}
-			if err := m.WorkflowExecution.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.ReplicationTasks = append(m.ReplicationTasks, &v112.ReplicationTask{})
+			if err := m.ReplicationTasks[len(m.ReplicationTasks)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -21237,7 +25262,7 @@ func (m *DescribeHistoryHostRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *DescribeHistoryHostResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryWorkflowRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -21260,17 +25285,17 @@ func (m *DescribeHistoryHostResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: DescribeHistoryHostResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryWorkflowRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: DescribeHistoryHostResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryWorkflowRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ShardsNumber", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NamespaceId", wireType)
 			}
-			m.ShardsNumber = 0
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowRequestResponse
@@ -21280,90 +25305,27 @@ func (m *DescribeHistoryHostResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.ShardsNumber |= int32(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 2:
-			if wireType == 0 {
-				var v int32
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return ErrIntOverflowRequestResponse
-					}
-					if iNdEx >= l {
-						return io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					v |= int32(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-				m.ShardIds = append(m.ShardIds, v)
-			} else if wireType == 2 {
-				var packedLen int
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return ErrIntOverflowRequestResponse
-					}
-					if iNdEx >= l {
-						return io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					packedLen |= int(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-				if packedLen < 0 {
-					return ErrInvalidLengthRequestResponse
-				}
-				postIndex := iNdEx + packedLen
-				if postIndex < 0 {
-					return ErrInvalidLengthRequestResponse
-				}
-				if postIndex > l {
-					return io.ErrUnexpectedEOF
-				}
-				var elementCount int
-				var count int
-				for _, integer := range dAtA[iNdEx:postIndex] {
-					if integer < 128 {
-						count++
-					}
-				}
-				elementCount = count
-				if elementCount != 0 && len(m.ShardIds) == 0 {
-					m.ShardIds = make([]int32, 0, elementCount)
-				}
-				for iNdEx < postIndex {
-					var v int32
-					for shift := uint(0); ; shift += 7 {
-						if shift >= 64 {
-							return ErrIntOverflowRequestResponse
-						}
-						if iNdEx >= l {
-							return io.ErrUnexpectedEOF
-						}
-						b := dAtA[iNdEx]
-						iNdEx++
-						v |= int32(b&0x7F) << shift
-						if b < 0x80 {
-							break
-						}
-					}
-					m.ShardIds = append(m.ShardIds, v)
-				}
-			} else {
-				return fmt.Errorf("proto: wrong wireType = %d for field ShardIds", wireType)
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthRequestResponse
 			}
-		case 3:
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthRequestResponse
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.NamespaceId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field NamespaceCache", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Request", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -21390,50 +25352,71 @@ func (m *DescribeHistoryHostResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.NamespaceCache == nil {
-				m.NamespaceCache = &v111.NamespaceCacheInfo{}
+			if m.Request == nil {
+				m.Request = &v1.QueryWorkflowRequest{}
 			}
-			if err := m.NamespaceCache.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Request.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 4:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ShardControllerStatus", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowRequestResponse
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipRequestResponse(dAtA[iNdEx:])
+			if err != nil {
+				return err
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if skippy < 0 {
 				return ErrInvalidLengthRequestResponse
 			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
+			if (iNdEx + skippy) < 0 {
 				return ErrInvalidLengthRequestResponse
 			}
-			if postIndex > l {
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryWorkflowResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowRequestResponse
+			}
+			if iNdEx >= l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ShardControllerStatus = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 5:
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryWorkflowResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryWorkflowResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Response", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowRequestResponse
@@ -21443,23 +25426,27 @@ func (m *DescribeHistoryHostResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthRequestResponse
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthRequestResponse
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Address = string(dAtA[iNdEx:postIndex])
+			if m.Response == nil {
+				m.Response = &v1.QueryWorkflowResponse{}
+			}
+			if err := m.Response.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -21485,7 +25472,7 @@ func (m *DescribeHistoryHostResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *CloseShardRequest) Unmarshal(dAtA []byte) error {
+func (m *ReapplyEventsRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -21508,17 +25495,17 @@ func (m *CloseShardRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: CloseShardRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: ReapplyEventsRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: CloseShardRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ReapplyEventsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ShardId", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NamespaceId", wireType)
 			}
-			m.ShardId = 0
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowRequestResponse
@@ -21528,11 +25515,60 @@ func (m *CloseShardRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.ShardId |= int32(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthRequestResponse
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthRequestResponse
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.NamespaceId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Request", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRequestResponse
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			if msglen < 0 {
+				return ErrInvalidLengthRequestResponse
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthRequestResponse
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Request == nil {
+				m.Request = &v113.ReapplyEventsRequest{}
+			}
+			if err := m.Request.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipRequestResponse(dAtA[iNdEx:])
@@ -21557,7 +25593,7 @@ func (m *CloseShardRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *CloseShardResponse) Unmarshal(dAtA []byte) error {
+func (m *ReapplyEventsResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -21580,10 +25616,10 @@ func (m *CloseShardResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: CloseShardResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: ReapplyEventsResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: CloseShardResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ReapplyEventsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -21610,7 +25646,7 @@ func (m *CloseShardResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *RemoveTaskRequest) Unmarshal(dAtA []byte) error {
+func (m *GetDLQMessagesRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -21633,17 +25669,17 @@ func (m *RemoveTaskRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: RemoveTaskRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: GetDLQMessagesRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: RemoveTaskRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: GetDLQMessagesRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ShardId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Type", wireType)
 			}
-			m.ShardId = 0
+			m.Type = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowRequestResponse
@@ -21653,16 +25689,16 @@ func (m *RemoveTaskRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.ShardId |= int32(b&0x7F) << shift
+				m.Type |= v16.DeadLetterQueueType(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
 		case 2:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Category", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ShardId", wireType)
 			}
-			m.Category = 0
+			m.ShardId = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowRequestResponse
@@ -21672,16 +25708,16 @@ func (m *RemoveTaskRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Category |= v16.TaskCategory(b&0x7F) << shift
+				m.ShardId |= int32(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
 		case 3:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TaskId", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SourceCluster", wireType)
 			}
-			m.TaskId = 0
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowRequestResponse
@@ -21691,16 +25727,29 @@ func (m *RemoveTaskRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.TaskId |= int64(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthRequestResponse
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthRequestResponse
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SourceCluster = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		case 4:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field VisibilityTime", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field InclusiveEndMessageId", wireType)
 			}
-			var msglen int
+			m.InclusiveEndMessageId = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowRequestResponse
@@ -21710,81 +25759,64 @@ func (m *RemoveTaskRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				m.InclusiveEndMessageId |= int64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthRequestResponse
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthRequestResponse
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaximumPageSize", wireType)
 			}
-			if m.VisibilityTime == nil {
-				m.VisibilityTime = new(time.Time)
+			m.MaximumPageSize = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRequestResponse
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaximumPageSize |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(m.VisibilityTime, dAtA[iNdEx:postIndex]); err != nil {
-				return err
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NextPageToken", wireType)
 			}
-			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipRequestResponse(dAtA[iNdEx:])
-			if err != nil {
-				return err
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRequestResponse
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			if skippy < 0 {
+			if byteLen < 0 {
 				return ErrInvalidLengthRequestResponse
 			}
-			if (iNdEx + skippy) < 0 {
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
 				return ErrInvalidLengthRequestResponse
 			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *RemoveTaskResponse) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowRequestResponse
-			}
-			if iNdEx >= l {
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: RemoveTaskResponse: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: RemoveTaskResponse: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
+			m.NextPageToken = append(m.NextPageToken[:0], dAtA[iNdEx:postIndex]...)
+			if m.NextPageToken == nil {
+				m.NextPageToken = []byte{}
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipRequestResponse(dAtA[iNdEx:])
@@ -21809,7 +25841,7 @@ func (m *RemoveTaskResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *GetReplicationMessagesRequest) Unmarshal(dAtA []byte) error {
+func (m *GetDLQMessagesResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -21832,15 +25864,34 @@ func (m *GetReplicationMessagesRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: GetReplicationMessagesRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: GetDLQMessagesResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: GetReplicationMessagesRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: GetDLQMessagesResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Type", wireType)
+			}
+			m.Type = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRequestResponse
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Type |= v16.DeadLetterQueueType(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Tokens", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ReplicationTasks", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -21867,16 +25918,16 @@ func (m *GetReplicationMessagesRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Tokens = append(m.Tokens, &v112.ReplicationToken{})
-			if err := m.Tokens[len(m.Tokens)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.ReplicationTasks = append(m.ReplicationTasks, &v112.ReplicationTask{})
+			if err := m.ReplicationTasks[len(m.ReplicationTasks)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 2:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ClusterName", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field NextPageToken", wireType)
 			}
-			var stringLen uint64
+			var byteLen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowRequestResponse
@@ -21886,23 +25937,25 @@ func (m *GetReplicationMessagesRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				byteLen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if byteLen < 0 {
 				return ErrInvalidLengthRequestResponse
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + byteLen
 			if postIndex < 0 {
 				return ErrInvalidLengthRequestResponse
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ClusterName = string(dAtA[iNdEx:postIndex])
+			m.NextPageToken = append(m.NextPageToken[:0], dAtA[iNdEx:postIndex]...)
+			if m.NextPageToken == nil {
+				m.NextPageToken = []byte{}
+			}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -21928,7 +25981,7 @@ func (m *GetReplicationMessagesRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *GetReplicationMessagesResponse) Unmarshal(dAtA []byte) error {
+func (m *PurgeDLQMessagesRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -21951,17 +26004,55 @@ func (m *GetReplicationMessagesResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: GetReplicationMessagesResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: PurgeDLQMessagesRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: GetReplicationMessagesResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: PurgeDLQMessagesRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Type", wireType)
+			}
+			m.Type = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRequestResponse
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Type |= v16.DeadLetterQueueType(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ShardId", wireType)
+			}
+			m.ShardId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRequestResponse
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ShardId |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ShardMessages", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field SourceCluster", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowRequestResponse
@@ -21971,107 +26062,43 @@ func (m *GetReplicationMessagesResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthRequestResponse
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthRequestResponse
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.ShardMessages == nil {
-				m.ShardMessages = make(map[int32]*v112.ReplicationMessages)
+			m.SourceCluster = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field InclusiveEndMessageId", wireType)
 			}
-			var mapkey int32
-			var mapvalue *v112.ReplicationMessages
-			for iNdEx < postIndex {
-				entryPreIndex := iNdEx
-				var wire uint64
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return ErrIntOverflowRequestResponse
-					}
-					if iNdEx >= l {
-						return io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					wire |= uint64(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
+			m.InclusiveEndMessageId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRequestResponse
 				}
-				fieldNum := int32(wire >> 3)
-				if fieldNum == 1 {
-					for shift := uint(0); ; shift += 7 {
-						if shift >= 64 {
-							return ErrIntOverflowRequestResponse
-						}
-						if iNdEx >= l {
-							return io.ErrUnexpectedEOF
-						}
-						b := dAtA[iNdEx]
-						iNdEx++
-						mapkey |= int32(b&0x7F) << shift
-						if b < 0x80 {
-							break
-						}
-					}
-				} else if fieldNum == 2 {
-					var mapmsglen int
-					for shift := uint(0); ; shift += 7 {
-						if shift >= 64 {
-							return ErrIntOverflowRequestResponse
-						}
-						if iNdEx >= l {
-							return io.ErrUnexpectedEOF
-						}
-						b := dAtA[iNdEx]
-						iNdEx++
-						mapmsglen |= int(b&0x7F) << shift
-						if b < 0x80 {
-							break
-						}
-					}
-					if mapmsglen < 0 {
-						return ErrInvalidLengthRequestResponse
-					}
-					postmsgIndex := iNdEx + mapmsglen
-					if postmsgIndex < 0 {
-						return ErrInvalidLengthRequestResponse
-					}
-					if postmsgIndex > l {
-						return io.ErrUnexpectedEOF
-					}
-					mapvalue = &v112.ReplicationMessages{}
-					if err := mapvalue.Unmarshal(dAtA[iNdEx:postmsgIndex]); err != nil {
-						return err
-					}
-					iNdEx = postmsgIndex
-				} else {
-					iNdEx = entryPreIndex
-					skippy, err := skipRequestResponse(dAtA[iNdEx:])
-					if err != nil {
-						return err
-					}
-					if skippy < 0 {
-						return ErrInvalidLengthRequestResponse
-					}
-					if (iNdEx + skippy) > postIndex {
-						return io.ErrUnexpectedEOF
-					}
-					iNdEx += skippy
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.InclusiveEndMessageId |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
 				}
 			}
-			m.ShardMessages[mapkey] = mapvalue
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipRequestResponse(dAtA[iNdEx:])
@@ -22096,7 +26123,60 @@ func (m *GetReplicationMessagesResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *GetDLQReplicationMessagesRequest) Unmarshal(dAtA []byte) error {
+func (m *PurgeDLQMessagesResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowRequestResponse
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: PurgeDLQMessagesResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: PurgeDLQMessagesResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipRequestResponse(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthRequestResponse
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthRequestResponse
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MergeDLQMessagesRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -22119,17 +26199,55 @@ func (m *GetDLQReplicationMessagesRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: GetDLQReplicationMessagesRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: MergeDLQMessagesRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: GetDLQReplicationMessagesRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MergeDLQMessagesRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Type", wireType)
+			}
+			m.Type = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRequestResponse
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Type |= v16.DeadLetterQueueType(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ShardId", wireType)
+			}
+			m.ShardId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRequestResponse
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ShardId |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TaskInfos", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field SourceCluster", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowRequestResponse
@@ -22139,24 +26257,94 @@ func (m *GetDLQReplicationMessagesRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthRequestResponse
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthRequestResponse
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.TaskInfos = append(m.TaskInfos, &v112.ReplicationTaskInfo{})
-			if err := m.TaskInfos[len(m.TaskInfos)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			m.SourceCluster = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field InclusiveEndMessageId", wireType)
+			}
+			m.InclusiveEndMessageId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRequestResponse
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.InclusiveEndMessageId |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaximumPageSize", wireType)
+			}
+			m.MaximumPageSize = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRequestResponse
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaximumPageSize |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NextPageToken", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRequestResponse
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthRequestResponse
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthRequestResponse
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.NextPageToken = append(m.NextPageToken[:0], dAtA[iNdEx:postIndex]...)
+			if m.NextPageToken == nil {
+				m.NextPageToken = []byte{}
 			}
 			iNdEx = postIndex
 		default:
@@ -22183,7 +26371,7 @@ func (m *GetDLQReplicationMessagesRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *GetDLQReplicationMessagesResponse) Unmarshal(dAtA []byte) error {
+func (m *MergeDLQMessagesResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -22206,17 +26394,17 @@ func (m *GetDLQReplicationMessagesResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: GetDLQReplicationMessagesResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MergeDLQMessagesResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: GetDLQReplicationMessagesResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MergeDLQMessagesResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ReplicationTasks", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field NextPageToken", wireType)
 			}
-			var msglen int
+			var byteLen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowRequestResponse
@@ -22226,24 +26414,24 @@ func (m *GetDLQReplicationMessagesResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				byteLen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			if byteLen < 0 {
 				return ErrInvalidLengthRequestResponse
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + byteLen
 			if postIndex < 0 {
 				return ErrInvalidLengthRequestResponse
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ReplicationTasks = append(m.ReplicationTasks, &v112.ReplicationTask{})
-			if err := m.ReplicationTasks[len(m.ReplicationTasks)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			m.NextPageToken = append(m.NextPageToken[:0], dAtA[iNdEx:postIndex]...)
+			if m.NextPageToken == nil {
+				m.NextPageToken = []byte{}
 			}
 			iNdEx = postIndex
 		default:
@@ -22270,7 +26458,7 @@ func (m *GetDLQReplicationMessagesResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryWorkflowRequest) Unmarshal(dAtA []byte) error {
+func (m *RefreshWorkflowTasksRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -22293,10 +26481,10 @@ func (m *QueryWorkflowRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryWorkflowRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: RefreshWorkflowTasksRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryWorkflowRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: RefreshWorkflowTasksRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -22361,7 +26549,7 @@ func (m *QueryWorkflowRequest) Unmarshal(dAtA []byte) error {
 				return io.ErrUnexpectedEOF
 			}
 			if m.Request == nil {
-				m.Request = &v1.QueryWorkflowRequest{}
+				m.Request = &v113.RefreshWorkflowTasksRequest{}
 			}
 			if err := m.Request.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
@@ -22391,7 +26579,7 @@ func (m *QueryWorkflowRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryWorkflowResponse) Unmarshal(dAtA []byte) error {
+func (m *RefreshWorkflowTasksResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -22414,48 +26602,12 @@ func (m *QueryWorkflowResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryWorkflowResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: RefreshWorkflowTasksResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryWorkflowResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: RefreshWorkflowTasksResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
-		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Response", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowRequestResponse
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthRequestResponse
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthRequestResponse
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if m.Response == nil {
-				m.Response = &v1.QueryWorkflowResponse{}
-			}
-			if err := m.Response.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipRequestResponse(dAtA[iNdEx:])
@@ -22480,7 +26632,7 @@ func (m *QueryWorkflowResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ReapplyEventsRequest) Unmarshal(dAtA []byte) error {
+func (m *DeleteWorkflowExecutionRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -22503,10 +26655,10 @@ func (m *ReapplyEventsRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ReapplyEventsRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: DeleteWorkflowExecutionRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ReapplyEventsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: DeleteWorkflowExecutionRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -22543,7 +26695,7 @@ func (m *ReapplyEventsRequest) Unmarshal(dAtA []byte) error {
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Request", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field WorkflowExecution", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -22563,19 +26715,51 @@ func (m *ReapplyEventsRequest) Unmarshal(dAtA []byte) error {
 			if msglen < 0 {
 				return ErrInvalidLengthRequestResponse
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthRequestResponse
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.WorkflowExecution == nil {
+				m.WorkflowExecution = &v14.WorkflowExecution{}
+			}
+			if err := m.WorkflowExecution.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Identity", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRequestResponse
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthRequestResponse
+			}
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthRequestResponse
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Request == nil {
-				m.Request = &v113.ReapplyEventsRequest{}
-			}
-			if err := m.Request.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Identity = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -22601,7 +26785,7 @@ func (m *ReapplyEventsRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ReapplyEventsResponse) Unmarshal(dAtA []byte) error {
+func (m *DeleteWorkflowExecutionResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -22624,10 +26808,10 @@ func (m *ReapplyEventsResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ReapplyEventsResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: DeleteWorkflowExecutionResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ReapplyEventsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: DeleteWorkflowExecutionResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -22654,7 +26838,7 @@ func (m *ReapplyEventsResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *GetDLQMessagesRequest) Unmarshal(dAtA []byte) error {
+func (m *UpdateWorkflowExecutionRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -22677,17 +26861,17 @@ func (m *GetDLQMessagesRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: GetDLQMessagesRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: UpdateWorkflowExecutionRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: GetDLQMessagesRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: UpdateWorkflowExecutionRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Type", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NamespaceId", wireType)
 			}
-			m.Type = 0
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowRequestResponse
@@ -22697,16 +26881,29 @@ func (m *GetDLQMessagesRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Type |= v16.DeadLetterQueueType(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthRequestResponse
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthRequestResponse
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.NamespaceId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ShardId", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field WorkflowExecution", wireType)
 			}
-			m.ShardId = 0
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowRequestResponse
@@ -22716,14 +26913,31 @@ func (m *GetDLQMessagesRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.ShardId |= int32(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			if msglen < 0 {
+				return ErrInvalidLengthRequestResponse
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthRequestResponse
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.WorkflowExecution == nil {
+				m.WorkflowExecution = &v14.WorkflowExecution{}
+			}
+			if err := m.WorkflowExecution.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SourceCluster", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field UpdateId", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -22751,13 +26965,13 @@ func (m *GetDLQMessagesRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.SourceCluster = string(dAtA[iNdEx:postIndex])
+			m.UpdateId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 4:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field InclusiveEndMessageId", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
 			}
-			m.InclusiveEndMessageId = 0
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowRequestResponse
@@ -22767,35 +26981,29 @@ func (m *GetDLQMessagesRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.InclusiveEndMessageId |= int64(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 5:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MaximumPageSize", wireType)
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthRequestResponse
 			}
-			m.MaximumPageSize = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowRequestResponse
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.MaximumPageSize |= int32(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthRequestResponse
 			}
-		case 6:
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Name = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field NextPageToken", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Input", wireType)
 			}
-			var byteLen int
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowRequestResponse
@@ -22805,103 +27013,33 @@ func (m *GetDLQMessagesRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				byteLen |= int(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if byteLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthRequestResponse
 			}
-			postIndex := iNdEx + byteLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthRequestResponse
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.NextPageToken = append(m.NextPageToken[:0], dAtA[iNdEx:postIndex]...)
-			if m.NextPageToken == nil {
-				m.NextPageToken = []byte{}
+			if m.Input == nil {
+				m.Input = &v14.Payloads{}
 			}
-			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipRequestResponse(dAtA[iNdEx:])
-			if err != nil {
+			if err := m.Input.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
-			if skippy < 0 {
-				return ErrInvalidLengthRequestResponse
-			}
-			if (iNdEx + skippy) < 0 {
-				return ErrInvalidLengthRequestResponse
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *GetDLQMessagesResponse) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowRequestResponse
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: GetDLQMessagesResponse: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: GetDLQMessagesResponse: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Type", wireType)
-			}
-			m.Type = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowRequestResponse
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.Type |= v16.DeadLetterQueueType(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 2:
+			iNdEx = postIndex
+		case 6:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ReplicationTasks", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Identity", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowRequestResponse
@@ -22911,31 +27049,29 @@ func (m *GetDLQMessagesResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthRequestResponse
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthRequestResponse
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ReplicationTasks = append(m.ReplicationTasks, &v112.ReplicationTask{})
-			if err := m.ReplicationTasks[len(m.ReplicationTasks)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Identity = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 3:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field NextPageToken", wireType)
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field WaitForAccepted", wireType)
 			}
-			var byteLen int
+			var v int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowRequestResponse
@@ -22945,26 +27081,12 @@ func (m *GetDLQMessagesResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				byteLen |= int(b&0x7F) << shift
+				v |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if byteLen < 0 {
-				return ErrInvalidLengthRequestResponse
-			}
-			postIndex := iNdEx + byteLen
-			if postIndex < 0 {
-				return ErrInvalidLengthRequestResponse
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.NextPageToken = append(m.NextPageToken[:0], dAtA[iNdEx:postIndex]...)
-			if m.NextPageToken == nil {
-				m.NextPageToken = []byte{}
-			}
-			iNdEx = postIndex
+			m.WaitForAccepted = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipRequestResponse(dAtA[iNdEx:])
@@ -22989,7 +27111,7 @@ func (m *GetDLQMessagesResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *PurgeDLQMessagesRequest) Unmarshal(dAtA []byte) error {
+func (m *UpdateWorkflowExecutionResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -23012,17 +27134,17 @@ func (m *PurgeDLQMessagesRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: PurgeDLQMessagesRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: UpdateWorkflowExecutionResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: PurgeDLQMessagesRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: UpdateWorkflowExecutionResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Type", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Completed", wireType)
 			}
-			m.Type = 0
+			var v int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowRequestResponse
@@ -23032,16 +27154,17 @@ func (m *PurgeDLQMessagesRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Type |= v16.DeadLetterQueueType(b&0x7F) << shift
+				v |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			m.Completed = bool(v != 0)
 		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ShardId", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Result", wireType)
 			}
-			m.ShardId = 0
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowRequestResponse
@@ -23051,16 +27174,33 @@ func (m *PurgeDLQMessagesRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.ShardId |= int32(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			if msglen < 0 {
+				return ErrInvalidLengthRequestResponse
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthRequestResponse
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Result == nil {
+				m.Result = &v14.Payloads{}
+			}
+			if err := m.Result.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SourceCluster", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Failure", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowRequestResponse
@@ -23070,29 +27210,86 @@ func (m *PurgeDLQMessagesRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthRequestResponse
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthRequestResponse
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.SourceCluster = string(dAtA[iNdEx:postIndex])
+			if m.Failure == nil {
+				m.Failure = &v13.Failure{}
+			}
+			if err := m.Failure.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 4:
+		default:
+			iNdEx = preIndex
+			skippy, err := skipRequestResponse(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthRequestResponse
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthRequestResponse
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *AcquireShardRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowRequestResponse
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: AcquireShardRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: AcquireShardRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field InclusiveEndMessageId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ShardId", wireType)
 			}
-			m.InclusiveEndMessageId = 0
+			m.ShardId = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowRequestResponse
@@ -23102,7 +27299,7 @@ func (m *PurgeDLQMessagesRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.InclusiveEndMessageId |= int64(b&0x7F) << shift
+				m.ShardId |= int32(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
@@ -23131,7 +27328,7 @@ func (m *PurgeDLQMessagesRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *PurgeDLQMessagesResponse) Unmarshal(dAtA []byte) error {
+func (m *AcquireShardResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -23154,10 +27351,10 @@ func (m *PurgeDLQMessagesResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: PurgeDLQMessagesResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: AcquireShardResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: PurgeDLQMessagesResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: AcquireShardResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -23184,7 +27381,7 @@ func (m *PurgeDLQMessagesResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MergeDLQMessagesRequest) Unmarshal(dAtA []byte) error {
+func (m *PauseWorkflowExecutionRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -23207,17 +27404,17 @@ func (m *MergeDLQMessagesRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MergeDLQMessagesRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: PauseWorkflowExecutionRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MergeDLQMessagesRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: PauseWorkflowExecutionRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Type", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NamespaceId", wireType)
 			}
-			m.Type = 0
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowRequestResponse
@@ -23227,16 +27424,29 @@ func (m *MergeDLQMessagesRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Type |= v16.DeadLetterQueueType(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthRequestResponse
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthRequestResponse
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.NamespaceId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ShardId", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field WorkflowExecution", wireType)
 			}
-			m.ShardId = 0
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowRequestResponse
@@ -23246,14 +27456,31 @@ func (m *MergeDLQMessagesRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.ShardId |= int32(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			if msglen < 0 {
+				return ErrInvalidLengthRequestResponse
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthRequestResponse
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.WorkflowExecution == nil {
+				m.WorkflowExecution = &v14.WorkflowExecution{}
+			}
+			if err := m.WorkflowExecution.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SourceCluster", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Identity", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -23281,51 +27508,13 @@ func (m *MergeDLQMessagesRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.SourceCluster = string(dAtA[iNdEx:postIndex])
+			m.Identity = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 4:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field InclusiveEndMessageId", wireType)
-			}
-			m.InclusiveEndMessageId = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowRequestResponse
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.InclusiveEndMessageId |= int64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 5:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MaximumPageSize", wireType)
-			}
-			m.MaximumPageSize = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowRequestResponse
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.MaximumPageSize |= int32(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 6:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field NextPageToken", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Reason", wireType)
 			}
-			var byteLen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowRequestResponse
@@ -23335,25 +27524,23 @@ func (m *MergeDLQMessagesRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				byteLen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if byteLen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthRequestResponse
 			}
-			postIndex := iNdEx + byteLen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthRequestResponse
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.NextPageToken = append(m.NextPageToken[:0], dAtA[iNdEx:postIndex]...)
-			if m.NextPageToken == nil {
-				m.NextPageToken = []byte{}
-			}
+			m.Reason = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -23379,7 +27566,7 @@ func (m *MergeDLQMessagesRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MergeDLQMessagesResponse) Unmarshal(dAtA []byte) error {
+func (m *PauseWorkflowExecutionResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -23402,46 +27589,12 @@ func (m *MergeDLQMessagesResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MergeDLQMessagesResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: PauseWorkflowExecutionResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MergeDLQMessagesResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: PauseWorkflowExecutionResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
-		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field NextPageToken", wireType)
-			}
-			var byteLen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowRequestResponse
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				byteLen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if byteLen < 0 {
-				return ErrInvalidLengthRequestResponse
-			}
-			postIndex := iNdEx + byteLen
-			if postIndex < 0 {
-				return ErrInvalidLengthRequestResponse
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.NextPageToken = append(m.NextPageToken[:0], dAtA[iNdEx:postIndex]...)
-			if m.NextPageToken == nil {
-				m.NextPageToken = []byte{}
-			}
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipRequestResponse(dAtA[iNdEx:])
@@ -23466,7 +27619,7 @@ func (m *MergeDLQMessagesResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *RefreshWorkflowTasksRequest) Unmarshal(dAtA []byte) error {
+func (m *UnpauseWorkflowExecutionRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -23489,10 +27642,10 @@ func (m *RefreshWorkflowTasksRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: RefreshWorkflowTasksRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: UnpauseWorkflowExecutionRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: RefreshWorkflowTasksRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: UnpauseWorkflowExecutionRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -23529,7 +27682,7 @@ func (m *RefreshWorkflowTasksRequest) Unmarshal(dAtA []byte) error {
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Request", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field WorkflowExecution", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -23556,13 +27709,45 @@ func (m *RefreshWorkflowTasksRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Request == nil {
-				m.Request = &v113.RefreshWorkflowTasksRequest{}
+			if m.WorkflowExecution == nil {
+				m.WorkflowExecution = &v14.WorkflowExecution{}
 			}
-			if err := m.Request.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.WorkflowExecution.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Identity", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRequestResponse
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthRequestResponse
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthRequestResponse
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Identity = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipRequestResponse(dAtA[iNdEx:])
@@ -23587,7 +27772,7 @@ func (m *RefreshWorkflowTasksRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *RefreshWorkflowTasksResponse) Unmarshal(dAtA []byte) error {
+func (m *UnpauseWorkflowExecutionResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -23610,10 +27795,10 @@ func (m *RefreshWorkflowTasksResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: RefreshWorkflowTasksResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: UnpauseWorkflowExecutionResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: RefreshWorkflowTasksResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: UnpauseWorkflowExecutionResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
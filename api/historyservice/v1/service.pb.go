@@ -54,72 +54,77 @@ func init() {
 }
 
 var fileDescriptor_655983da427ae822 = []byte{
-	// 1034 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x99, 0xcd, 0x8b, 0x23, 0x45,
-	0x18, 0x87, 0x53, 0x17, 0x0f, 0x85, 0xae, 0xda, 0x8a, 0x1f, 0xa3, 0x36, 0x22, 0x78, 0x4d, 0xd8,
-	0xdd, 0xcb, 0x7e, 0xcc, 0xba, 0xee, 0x64, 0x66, 0x32, 0xb3, 0x3b, 0xa3, 0x4e, 0xb2, 0x28, 0x78,
-	0x91, 0x9a, 0xce, 0xbb, 0x93, 0x62, 0x7a, 0x52, 0x6d, 0x55, 0x25, 0x9a, 0x9b, 0xe0, 0x49, 0x58,
-	0x50, 0x04, 0xc1, 0x93, 0xe0, 0x49, 0x11, 0x04, 0x41, 0x10, 0x04, 0xc1, 0x93, 0xe0, 0x71, 0x8e,
-	0x7b, 0x74, 0x32, 0x17, 0x8f, 0xfb, 0x27, 0x2c, 0x49, 0xa7, 0x6a, 0x52, 0xdd, 0xd5, 0xa1, 0xaa,
-	0x92, 0xdb, 0x6e, 0xa6, 0x7e, 0x4f, 0x3f, 0x5d, 0x5f, 0x6f, 0xa5, 0x82, 0xaf, 0x4a, 0x38, 0xc9,
-	0x18, 0x27, 0x69, 0x43, 0x00, 0x1f, 0x02, 0x6f, 0x90, 0x8c, 0x36, 0x7a, 0x54, 0x48, 0xc6, 0x47,
-	0x93, 0x4f, 0x68, 0x02, 0x8d, 0xe1, 0xe5, 0xc6, 0xec, 0x9f, 0xf5, 0x8c, 0x33, 0xc9, 0xa2, 0xb7,
-	0x55, 0xa8, 0x9e, 0x87, 0xea, 0x24, 0xa3, 0x75, 0x33, 0x54, 0x1f, 0x5e, 0x5e, 0x5b, 0x77, 0x63,
-	0x73, 0xf8, 0x74, 0x00, 0x42, 0x7e, 0xc2, 0x41, 0x64, 0xac, 0x2f, 0x66, 0x0f, 0xb9, 0xf2, 0xb0,
-	0x81, 0x2f, 0xed, 0xe4, 0x8d, 0x3b, 0x79, 0xe3, 0xe8, 0x27, 0x84, 0x5f, 0xea, 0x48, 0xc2, 0xe5,
-	0x47, 0x8c, 0x1f, 0x3f, 0x48, 0xd9, 0x67, 0x5b, 0x9f, 0x43, 0x32, 0x90, 0x94, 0xf5, 0xa3, 0xcd,
-	0xba, 0x93, 0x53, 0xdd, 0x1e, 0x6f, 0xe7, 0x0a, 0x6b, 0x5b, 0x4b, 0x52, 0xf2, 0x17, 0x78, 0xab,
-	0x16, 0x7d, 0x8b, 0xf0, 0xb3, 0x2d, 0x90, 0xfb, 0x03, 0x49, 0x0e, 0x53, 0xe8, 0x48, 0x22, 0x21,
-	0xba, 0xe5, 0x08, 0x2f, 0xe4, 0x94, 0xdb, 0x3b, 0xa1, 0x71, 0x2d, 0xf5, 0x1d, 0xc2, 0xcf, 0x7d,
-	0xc0, 0xd2, 0xd4, 0xb0, 0x72, 0xc5, 0x16, 0x83, 0x4a, 0xeb, 0x76, 0x70, 0x5e, 0x7b, 0xfd, 0x88,
-	0xf0, 0x8b, 0x6d, 0x10, 0x20, 0x3b, 0x92, 0x26, 0xc7, 0xa3, 0xfb, 0x44, 0x1c, 0x1f, 0x0c, 0x60,
-	0x00, 0xd1, 0x86, 0x23, 0xdb, 0x16, 0x56, 0x7e, 0xcd, 0xa5, 0x18, 0xda, 0xf1, 0x37, 0x84, 0x5f,
-	0x6d, 0x43, 0xc2, 0x78, 0x57, 0x0d, 0xfb, 0xa4, 0xd5, 0x74, 0x1e, 0x40, 0x37, 0x6a, 0x39, 0x3f,
-	0xa4, 0x82, 0xa0, 0x6c, 0x77, 0x96, 0x07, 0x59, 0x94, 0xef, 0x24, 0x92, 0x0e, 0xa9, 0x1c, 0x85,
-	0x2b, 0x5b, 0x08, 0x61, 0xca, 0x56, 0x90, 0x56, 0xfe, 0x13, 0xe1, 0xd7, 0xf3, 0xff, 0x1a, 0xef,
-	0xd6, 0x64, 0x27, 0x59, 0x0a, 0x13, 0xeb, 0xbb, 0xee, 0xa3, 0x59, 0x09, 0x51, 0xe2, 0xf7, 0x56,
-	0xc2, 0x2a, 0x74, 0x77, 0xa9, 0xe9, 0x36, 0xa1, 0xa9, 0x57, 0x77, 0x57, 0x10, 0xfc, 0xbb, 0xbb,
-	0x12, 0xa4, 0x95, 0xff, 0x40, 0xf8, 0xb5, 0xf2, 0xb0, 0xec, 0x00, 0xe1, 0xf2, 0x10, 0x88, 0x8c,
-	0x76, 0x83, 0x87, 0x56, 0x33, 0x94, 0xf6, 0xdd, 0x55, 0xa0, 0x6c, 0xf3, 0x64, 0xbe, 0x69, 0xf0,
-	0x3c, 0xb1, 0x42, 0x02, 0xe7, 0x49, 0x05, 0xcb, 0x36, 0x4f, 0xe6, 0x9b, 0x86, 0xcd, 0x93, 0x32,
-	0x21, 0x70, 0x9e, 0xd8, 0x40, 0x85, 0x79, 0x52, 0x7e, 0x3b, 0xd2, 0x4f, 0x60, 0x22, 0xbd, 0xbb,
-	0x44, 0x0f, 0xcd, 0x18, 0xfe, 0xf3, 0x64, 0x01, 0x4a, 0x8b, 0xff, 0x82, 0xf0, 0xcb, 0x1d, 0x7a,
-	0xd4, 0x27, 0x69, 0xf9, 0xc4, 0xe0, 0x5c, 0xeb, 0xed, 0x79, 0x25, 0xbc, 0xbd, 0x2c, 0x46, 0xcb,
-	0xfe, 0x83, 0xf0, 0x9b, 0xb3, 0x56, 0x54, 0xf6, 0x2a, 0xce, 0x39, 0xef, 0xf9, 0x3d, 0xae, 0x12,
-	0xa4, 0xf4, 0xdf, 0x5f, 0x19, 0x4f, 0xbf, 0xc7, 0xaf, 0x08, 0xbf, 0xd2, 0x86, 0x13, 0x36, 0x84,
-	0x3c, 0x64, 0x1c, 0x37, 0xb6, 0x9d, 0xc7, 0xd7, 0x0e, 0x50, 0xde, 0xad, 0xa5, 0x39, 0xda, 0xf7,
-	0x77, 0x84, 0xd7, 0xee, 0x03, 0x3f, 0xa1, 0x7d, 0x22, 0xa1, 0xdc, 0xe3, 0xae, 0x0b, 0xa9, 0x1a,
-	0xa1, 0x9c, 0x77, 0x57, 0x40, 0xd2, 0xd6, 0x93, 0xb3, 0xf0, 0xf4, 0xcc, 0x12, 0x7e, 0x16, 0xb6,
-	0xc7, 0x7d, 0xcf, 0xc2, 0x55, 0x14, 0x6d, 0xfa, 0x37, 0xc2, 0xf1, 0x0c, 0x9a, 0x2f, 0xd1, 0xb2,
-	0xf1, 0x9e, 0xf3, 0xb3, 0x16, 0x61, 0x94, 0xf9, 0xfe, 0x8a, 0x68, 0xc6, 0x01, 0xb5, 0x93, 0xf4,
-	0xa0, 0x3b, 0x48, 0x61, 0xbe, 0xa0, 0x3a, 0x1f, 0x50, 0x6d, 0x61, 0xdf, 0x03, 0xaa, 0x9d, 0xa1,
-	0x1d, 0xff, 0x42, 0xf8, 0x8d, 0xbc, 0x78, 0x36, 0x7b, 0x34, 0xed, 0xea, 0xd7, 0xb8, 0xa8, 0x89,
-	0xf7, 0xbc, 0x4a, 0x70, 0x05, 0x45, 0x59, 0xef, 0xad, 0x06, 0x66, 0x54, 0xc5, 0x4d, 0x10, 0x09,
-	0xa7, 0x87, 0x96, 0x35, 0xe8, 0xba, 0xda, 0x2b, 0x09, 0xbe, 0x55, 0x71, 0x01, 0x48, 0x2b, 0x7f,
-	0x8f, 0xf0, 0xf3, 0x6d, 0xc8, 0x52, 0x9a, 0x10, 0x09, 0x5b, 0x43, 0xe8, 0x4b, 0xf1, 0xe1, 0x95,
-	0xe8, 0xb6, 0x73, 0xc7, 0x14, 0x92, 0x4a, 0xf1, 0xdd, 0x70, 0x80, 0xf1, 0xf5, 0xb3, 0x33, 0xea,
-	0x27, 0x9d, 0x1e, 0xe1, 0xdd, 0xc9, 0x7e, 0x37, 0x10, 0xce, 0x5f, 0x3f, 0x0b, 0x39, 0xdf, 0xaf,
-	0x9f, 0xa5, 0xb8, 0x96, 0xfa, 0x0a, 0xe1, 0xa7, 0x27, 0x7f, 0x55, 0x35, 0x3b, 0xba, 0xe1, 0x81,
-	0x54, 0x21, 0xa5, 0x73, 0x33, 0x28, 0x6b, 0xac, 0x68, 0x35, 0xc6, 0x46, 0x7d, 0xda, 0xf0, 0x9c,
-	0x20, 0xb6, 0xda, 0xd4, 0x5c, 0x8a, 0xa1, 0x1d, 0x7f, 0x40, 0xf8, 0x05, 0xd5, 0x64, 0x76, 0x11,
-	0xb2, 0xc3, 0x84, 0x8c, 0xee, 0x78, 0xe2, 0xe7, 0xb2, 0xca, 0x70, 0x63, 0x19, 0x84, 0x16, 0xfc,
-	0x12, 0x61, 0xdc, 0x4c, 0x99, 0x80, 0xe9, 0x78, 0x47, 0xd7, 0x1c, 0xa1, 0x17, 0x11, 0xa5, 0x73,
-	0x3d, 0x20, 0x69, 0x58, 0xe4, 0x55, 0x7e, 0xba, 0x25, 0x5f, 0xf3, 0x3a, 0x18, 0xcc, 0x6f, 0xc4,
-	0xd7, 0x03, 0x92, 0x46, 0x39, 0x6e, 0x81, 0x54, 0x8b, 0x92, 0xb2, 0xfe, 0x3e, 0x08, 0x41, 0x8e,
-	0x40, 0x38, 0x97, 0x63, 0x7b, 0xdc, 0xb7, 0x1c, 0x57, 0x51, 0x8c, 0x9d, 0xb6, 0x05, 0x72, 0x73,
-	0xef, 0xc0, 0x26, 0xdb, 0x72, 0x7f, 0x8c, 0x9d, 0xe0, 0xbb, 0xd3, 0x2e, 0x00, 0x69, 0xe5, 0x87,
-	0x08, 0x3f, 0x73, 0x30, 0x00, 0x3e, 0x52, 0xdb, 0x71, 0xe4, 0xba, 0xfc, 0x8d, 0x94, 0x52, 0x5b,
-	0x0f, 0x0b, 0x1b, 0x3a, 0x6d, 0x20, 0x59, 0x96, 0x8e, 0xf2, 0xbd, 0xd7, 0x59, 0xc7, 0x48, 0xf9,
-	0xea, 0x14, 0xc2, 0x5a, 0xe7, 0x6b, 0x84, 0x2f, 0xe5, 0xbd, 0xa8, 0x47, 0x71, 0xdd, 0xab, 0xf3,
-	0x8b, 0x43, 0x77, 0x2b, 0x30, 0x6d, 0x5e, 0x34, 0x0e, 0xf8, 0x11, 0xcc, 0x3b, 0x39, 0x5f, 0x34,
-	0x16, 0x82, 0xde, 0x17, 0x8d, 0xa5, 0xbc, 0xe1, 0xb5, 0x0f, 0x81, 0x5e, 0xc5, 0xa0, 0xaf, 0x57,
-	0x39, 0x5f, 0xb8, 0x00, 0x7d, 0xc0, 0x41, 0xf4, 0xe6, 0x4f, 0x77, 0xc2, 0xe3, 0x02, 0xb4, 0x1c,
-	0xf6, 0xbf, 0x00, 0xb5, 0x31, 0x94, 0xe3, 0x46, 0x76, 0x7a, 0x16, 0xd7, 0x1e, 0x9d, 0xc5, 0xb5,
-	0xc7, 0x67, 0x31, 0xfa, 0x62, 0x1c, 0xa3, 0x9f, 0xc7, 0x31, 0xfa, 0x77, 0x1c, 0xa3, 0xd3, 0x71,
-	0x8c, 0xfe, 0x1b, 0xc7, 0xe8, 0xff, 0x71, 0x5c, 0x7b, 0x3c, 0x8e, 0xd1, 0x37, 0xe7, 0x71, 0xed,
-	0xf4, 0x3c, 0xae, 0x3d, 0x3a, 0x8f, 0x6b, 0x1f, 0xdf, 0x38, 0x62, 0x17, 0x8f, 0xa7, 0x6c, 0xe1,
-	0x0f, 0x01, 0x37, 0xcd, 0x4f, 0x0e, 0x9f, 0x9a, 0xfe, 0x0e, 0x70, 0xf5, 0x49, 0x00, 0x00, 0x00,
-	0xff, 0xff, 0x3e, 0xd6, 0x58, 0xf3, 0xa3, 0x18, 0x00, 0x00,
+	// 1115 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xad, 0x99, 0xcd, 0x8b, 0x1c, 0x45,
+	0x18, 0xc6, 0xb7, 0x2e, 0x39, 0x14, 0x1a, 0xb5, 0x15, 0x13, 0x57, 0x1d, 0x82, 0xe0, 0x75, 0x86,
+	0x24, 0x08, 0xf9, 0xd8, 0x18, 0x77, 0x67, 0x77, 0x67, 0x37, 0xd9, 0xd5, 0xec, 0x4c, 0xa2, 0x90,
+	0x4b, 0xe8, 0xed, 0x79, 0xb3, 0xd3, 0x6c, 0xef, 0x74, 0xa7, 0xab, 0x7a, 0x74, 0x6e, 0x82, 0x27,
+	0x41, 0x30, 0x08, 0x82, 0x27, 0x21, 0xa7, 0x04, 0x41, 0x10, 0x04, 0x41, 0x10, 0x3c, 0x09, 0x39,
+	0xee, 0x31, 0x47, 0x13, 0x2f, 0x1e, 0xfd, 0x13, 0xac, 0x9e, 0x9e, 0xaa, 0x9d, 0xea, 0xae, 0x1a,
+	0xaa, 0xaa, 0xe7, 0x50, 0xec, 0x4e, 0x4f, 0x3d, 0x4f, 0xfd, 0xba, 0xbe, 0xde, 0xb7, 0x6a, 0xf0,
+	0x45, 0x0a, 0x47, 0x49, 0x9c, 0xfa, 0x51, 0x8b, 0x40, 0x3a, 0x82, 0xb4, 0xe5, 0x27, 0x61, 0x6b,
+	0x10, 0x12, 0x1a, 0xa7, 0xe3, 0xfc, 0x49, 0x18, 0x40, 0x6b, 0x74, 0xbe, 0x35, 0xfd, 0xb7, 0x99,
+	0xa4, 0x31, 0x8d, 0xbd, 0xf7, 0xb9, 0xa8, 0x59, 0x88, 0x9a, 0x4c, 0xd4, 0x94, 0x45, 0xcd, 0xd1,
+	0xf9, 0xe5, 0x15, 0x33, 0xef, 0x14, 0x1e, 0x64, 0x40, 0xe8, 0xbd, 0x14, 0x48, 0x12, 0x0f, 0xc9,
+	0xb4, 0x91, 0x0b, 0x4f, 0x3f, 0xc0, 0xa7, 0xb7, 0x8a, 0xca, 0xbd, 0xa2, 0xb2, 0xf7, 0x18, 0xe1,
+	0x37, 0x7b, 0xd4, 0x4f, 0xe9, 0x67, 0x71, 0x7a, 0x78, 0x3f, 0x8a, 0x3f, 0xdf, 0xf8, 0x02, 0x82,
+	0x8c, 0x86, 0xf1, 0xd0, 0x5b, 0x6f, 0x1a, 0x31, 0x35, 0xd5, 0xf2, 0x6e, 0x81, 0xb0, 0xbc, 0x51,
+	0xd3, 0xa5, 0x78, 0x81, 0xf7, 0x96, 0xbc, 0xef, 0x10, 0x7e, 0xa5, 0x03, 0x74, 0x37, 0xa3, 0xfe,
+	0x7e, 0x04, 0xac, 0x3a, 0x05, 0xef, 0x9a, 0xa1, 0x79, 0x49, 0xc7, 0xd9, 0x3e, 0x74, 0x95, 0x0b,
+	0xa8, 0xef, 0x11, 0x7e, 0xf5, 0x56, 0x1c, 0x45, 0x12, 0x95, 0xa9, 0x6d, 0x59, 0xc8, 0xb1, 0xae,
+	0x3b, 0xeb, 0x05, 0xd7, 0x23, 0x84, 0xdf, 0x60, 0x1f, 0x81, 0xf6, 0x68, 0x18, 0x1c, 0x8e, 0x6f,
+	0xfb, 0xe4, 0x70, 0x2f, 0x83, 0x0c, 0xbc, 0x35, 0x43, 0x6f, 0x95, 0x98, 0xf3, 0xb5, 0x6b, 0x79,
+	0x08, 0xc6, 0x5f, 0x10, 0x7e, 0xab, 0x0b, 0x41, 0x9c, 0xf6, 0xf9, 0xb0, 0xe7, 0xb5, 0x26, 0xf3,
+	0x00, 0xfa, 0x5e, 0xc7, 0xb8, 0x11, 0x8d, 0x03, 0xa7, 0xdd, 0xaa, 0x6f, 0xa4, 0x40, 0x5e, 0x0d,
+	0x68, 0x38, 0x0a, 0xe9, 0xd8, 0x1d, 0x59, 0xe1, 0xe0, 0x86, 0xac, 0x34, 0x12, 0xc8, 0xbf, 0x23,
+	0xfc, 0x4e, 0xf1, 0x51, 0x7a, 0xb7, 0x76, 0x7c, 0x94, 0x44, 0x90, 0x53, 0xdf, 0x30, 0x1f, 0x4d,
+	0xad, 0x09, 0x07, 0xbf, 0xb9, 0x10, 0xaf, 0x52, 0x77, 0x57, 0xaa, 0x6e, 0xfa, 0x61, 0x64, 0xd5,
+	0xdd, 0x1a, 0x07, 0xfb, 0xee, 0xd6, 0x1a, 0x09, 0xe4, 0xdf, 0x10, 0x7e, 0xbb, 0x3a, 0x2c, 0x5b,
+	0xc0, 0x86, 0x65, 0x1f, 0x7c, 0xea, 0x6d, 0x3b, 0x0f, 0xad, 0xf0, 0xe0, 0xd8, 0x37, 0x16, 0x61,
+	0xa5, 0x9a, 0x27, 0xb3, 0x55, 0x9d, 0xe7, 0x89, 0xd2, 0xc4, 0x71, 0x9e, 0x68, 0xbc, 0x54, 0xf3,
+	0x64, 0xb6, 0xaa, 0xdb, 0x3c, 0xa9, 0x3a, 0x38, 0xce, 0x13, 0x95, 0x51, 0x69, 0x9e, 0x54, 0xdf,
+	0xce, 0x1f, 0x06, 0x90, 0x43, 0x6f, 0xd7, 0xe8, 0xa1, 0xa9, 0x87, 0xfd, 0x3c, 0x99, 0x63, 0x25,
+	0xc0, 0x7f, 0x42, 0xf8, 0x4c, 0x2f, 0x3c, 0x18, 0xfa, 0x51, 0x35, 0x63, 0x30, 0x8e, 0xf5, 0x6a,
+	0x3d, 0x07, 0xde, 0xac, 0x6b, 0x23, 0x60, 0xff, 0x42, 0xf8, 0xdc, 0xb4, 0x56, 0x48, 0x07, 0x9a,
+	0x3c, 0xe7, 0x63, 0xbb, 0xe6, 0xb4, 0x46, 0x1c, 0xff, 0x93, 0x85, 0xf9, 0x89, 0xf7, 0xf8, 0x19,
+	0xe1, 0xb3, 0x5d, 0x38, 0x8a, 0x47, 0x50, 0x88, 0xa4, 0x74, 0x63, 0xd3, 0x78, 0x7c, 0xd5, 0x06,
+	0x9c, 0xbb, 0x53, 0xdb, 0x47, 0xf0, 0xfe, 0x8a, 0xf0, 0xf2, 0x6d, 0x48, 0x8f, 0xc2, 0x21, 0x7b,
+	0x5e, 0xed, 0x71, 0xd3, 0x85, 0xa4, 0xb7, 0xe0, 0xcc, 0xdb, 0x0b, 0x70, 0x12, 0xd4, 0x79, 0x2e,
+	0x3c, 0xc9, 0x59, 0xdc, 0x73, 0x61, 0xb5, 0xdc, 0x36, 0x17, 0xd6, 0xb9, 0x08, 0xd2, 0x3f, 0x11,
+	0x6e, 0x4c, 0x4d, 0x8b, 0x25, 0x5a, 0x25, 0xde, 0x31, 0x6e, 0x6b, 0x9e, 0x0d, 0x27, 0xdf, 0x5d,
+	0x90, 0x9b, 0x94, 0xa0, 0xf6, 0x82, 0x01, 0xf4, 0xb3, 0x08, 0x66, 0x03, 0xaa, 0x71, 0x82, 0xaa,
+	0x12, 0xdb, 0x26, 0xa8, 0x6a, 0x0f, 0xc1, 0xf8, 0x07, 0xc2, 0xef, 0x16, 0xc1, 0xb3, 0x3d, 0x08,
+	0xa3, 0xbe, 0x78, 0x8d, 0x93, 0x98, 0x78, 0xd3, 0x2a, 0x04, 0x6b, 0x5c, 0x38, 0xf5, 0xce, 0x62,
+	0xcc, 0xa4, 0xa8, 0xb8, 0x0e, 0x24, 0x48, 0xc3, 0x7d, 0xc5, 0x1a, 0x34, 0x5d, 0xed, 0x5a, 0x07,
+	0xdb, 0xa8, 0x38, 0xc7, 0x48, 0x20, 0xff, 0x80, 0xf0, 0x6b, 0x5d, 0x48, 0xa2, 0x30, 0x60, 0x4b,
+	0x75, 0x63, 0x04, 0x43, 0x4a, 0x3e, 0xbd, 0xe0, 0x5d, 0x37, 0xee, 0x98, 0x92, 0x92, 0x23, 0x7e,
+	0xe4, 0x6e, 0x20, 0x1d, 0x3f, 0x7b, 0xe3, 0x61, 0xd0, 0x1b, 0xf8, 0x69, 0x3f, 0xdf, 0xef, 0x32,
+	0x62, 0x7c, 0xfc, 0x2c, 0xe9, 0x6c, 0x8f, 0x9f, 0x15, 0xb9, 0x80, 0xfa, 0x1a, 0xe1, 0x97, 0xf2,
+	0x6f, 0x79, 0xcc, 0xf6, 0xae, 0x58, 0x58, 0x72, 0x11, 0xc7, 0xb9, 0xea, 0xa4, 0x95, 0x56, 0x34,
+	0x1f, 0x63, 0x29, 0x3e, 0xad, 0x59, 0x4e, 0x10, 0x55, 0x6c, 0x6a, 0xd7, 0xf2, 0x10, 0x8c, 0x3f,
+	0x22, 0xfc, 0x3a, 0xaf, 0x32, 0xbd, 0x08, 0xd9, 0x8a, 0x09, 0xf5, 0x56, 0x2d, 0xed, 0x67, 0xb4,
+	0x9c, 0x70, 0xad, 0x8e, 0x85, 0x00, 0xfc, 0x0a, 0x61, 0xdc, 0x8e, 0x62, 0x02, 0x93, 0xf1, 0xf6,
+	0x2e, 0x19, 0x9a, 0x9e, 0x48, 0x38, 0xce, 0x65, 0x07, 0xa5, 0x44, 0x51, 0x44, 0xf9, 0xc9, 0x96,
+	0x7c, 0xc9, 0x2a, 0x31, 0x98, 0xdd, 0x88, 0x2f, 0x3b, 0x28, 0xa5, 0x70, 0xdc, 0x01, 0xca, 0x17,
+	0x25, 0xdb, 0x29, 0x76, 0x81, 0x10, 0xff, 0x00, 0x88, 0x71, 0x38, 0x56, 0xcb, 0x6d, 0xc3, 0xb1,
+	0xce, 0x45, 0xda, 0x69, 0x59, 0xa5, 0xf5, 0x9d, 0x3d, 0x15, 0x6c, 0xc7, 0xbc, 0x19, 0xb5, 0x83,
+	0xed, 0x4e, 0x3b, 0xc7, 0x48, 0x20, 0x7f, 0x83, 0xf0, 0xcb, 0x7b, 0x19, 0xa4, 0x63, 0xbe, 0x1d,
+	0x7b, 0xa6, 0xcb, 0x5f, 0x52, 0x71, 0xb4, 0x15, 0x37, 0xb1, 0x84, 0xd3, 0x05, 0x3f, 0x49, 0xa2,
+	0x71, 0xb1, 0xf7, 0x1a, 0xe3, 0x48, 0x2a, 0x5b, 0x9c, 0x92, 0x58, 0xe0, 0x7c, 0x8b, 0xf0, 0xe9,
+	0xa2, 0x17, 0xc5, 0x28, 0xae, 0x58, 0x75, 0x7e, 0x79, 0xe8, 0xae, 0x39, 0xaa, 0xe5, 0x8b, 0xc6,
+	0x2c, 0x3d, 0x80, 0x59, 0x26, 0xe3, 0x8b, 0xc6, 0x92, 0xd0, 0xfa, 0xa2, 0xb1, 0xa2, 0x97, 0xb8,
+	0x76, 0xc1, 0x91, 0xab, 0x2c, 0xb4, 0xe5, 0xaa, 0xea, 0x4b, 0x17, 0xa0, 0xf7, 0x53, 0x20, 0x83,
+	0xd9, 0xec, 0x8e, 0x58, 0x5c, 0x80, 0x56, 0xc5, 0xf6, 0x17, 0xa0, 0x2a, 0x0f, 0xc1, 0xf8, 0x84,
+	0x1d, 0xa5, 0xd7, 0x21, 0x4f, 0xdb, 0xdc, 0x8f, 0xd2, 0x1a, 0xbd, 0xed, 0x51, 0x5a, 0x6b, 0x53,
+	0xc0, 0x4e, 0x50, 0xef, 0x24, 0x7d, 0xbf, 0x0e, 0xaa, 0x46, 0x6f, 0x8b, 0xaa, 0xb5, 0x99, 0xa2,
+	0xe6, 0x39, 0xd1, 0x6a, 0xf0, 0x20, 0x0b, 0xd3, 0x69, 0x10, 0x35, 0xcd, 0x89, 0x66, 0x45, 0xb6,
+	0x39, 0x91, 0xac, 0x95, 0x42, 0xd8, 0x2d, 0x3f, 0x23, 0xe0, 0x7e, 0xa2, 0x54, 0xcb, 0x6d, 0x43,
+	0x98, 0xce, 0x45, 0xba, 0x61, 0xb8, 0x33, 0x4c, 0xd4, 0xac, 0xc6, 0x43, 0xa3, 0x31, 0xb0, 0xbd,
+	0x61, 0xd0, 0xfb, 0x70, 0xde, 0xb5, 0xe4, 0xf8, 0x79, 0x63, 0xe9, 0x19, 0x2b, 0xff, 0x3d, 0x6f,
+	0xa0, 0x2f, 0x5f, 0x34, 0xd0, 0x13, 0x56, 0x9e, 0xb2, 0x72, 0xcc, 0xca, 0xdf, 0xac, 0xfc, 0xfb,
+	0x82, 0x7d, 0xc7, 0xfe, 0x3e, 0xfc, 0xa7, 0xb1, 0x74, 0xcc, 0xca, 0x33, 0x56, 0xee, 0x5e, 0x39,
+	0x88, 0x4f, 0x10, 0xc2, 0x78, 0xee, 0x8f, 0x68, 0x57, 0xe5, 0x27, 0xfb, 0xa7, 0x26, 0xbf, 0xa1,
+	0x5d, 0xfc, 0x1f, 0x1c, 0xa0, 0x5b, 0x2a, 0xdf, 0x1b, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -260,6 +265,20 @@ type HistoryServiceClient interface {
 	MergeDLQMessages(ctx context.Context, in *MergeDLQMessagesRequest, opts ...grpc.CallOption) (*MergeDLQMessagesResponse, error)
 	// RefreshWorkflowTasks refreshes all tasks of a workflow.
 	RefreshWorkflowTasks(ctx context.Context, in *RefreshWorkflowTasksRequest, opts ...grpc.CallOption) (*RefreshWorkflowTasksResponse, error)
+	// DeleteWorkflowExecution terminates the workflow execution if it is still running and schedules
+	// the deletion of its mutable state, history and visibility records.
+	DeleteWorkflowExecution(ctx context.Context, in *DeleteWorkflowExecutionRequest, opts ...grpc.CallOption) (*DeleteWorkflowExecutionResponse, error)
+	// UpdateWorkflowExecution delivers an update to the workflow execution and waits until the workflow
+	// accepted or completed it.
+	UpdateWorkflowExecution(ctx context.Context, in *UpdateWorkflowExecutionRequest, opts ...grpc.CallOption) (*UpdateWorkflowExecutionResponse, error)
+	// AcquireShard makes the host which owns the shard acquire it right away. It is sent by the previous
+	// owner of the shard once it handed the shard off.
+	AcquireShard(ctx context.Context, in *AcquireShardRequest, opts ...grpc.CallOption) (*AcquireShardResponse, error)
+	// PauseWorkflowExecution pauses the workflow execution. Its workflow and activity tasks are not dispatched
+	// until it is unpaused, timers still fire and signals are still accepted.
+	PauseWorkflowExecution(ctx context.Context, in *PauseWorkflowExecutionRequest, opts ...grpc.CallOption) (*PauseWorkflowExecutionResponse, error)
+	// UnpauseWorkflowExecution resumes the dispatch of the workflow and activity tasks of a paused workflow execution.
+	UnpauseWorkflowExecution(ctx context.Context, in *UnpauseWorkflowExecutionRequest, opts ...grpc.CallOption) (*UnpauseWorkflowExecutionResponse, error)
 }
 
 type historyServiceClient struct {
@@ -594,6 +613,51 @@ func (c *historyServiceClient) RefreshWorkflowTasks(ctx context.Context, in *Ref
 	return out, nil
 }
 
+func (c *historyServiceClient) DeleteWorkflowExecution(ctx context.Context, in *DeleteWorkflowExecutionRequest, opts ...grpc.CallOption) (*DeleteWorkflowExecutionResponse, error) {
+	out := new(DeleteWorkflowExecutionResponse)
+	err := c.cc.Invoke(ctx, "/temporal.server.api.historyservice.v1.HistoryService/DeleteWorkflowExecution", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *historyServiceClient) UpdateWorkflowExecution(ctx context.Context, in *UpdateWorkflowExecutionRequest, opts ...grpc.CallOption) (*UpdateWorkflowExecutionResponse, error) {
+	out := new(UpdateWorkflowExecutionResponse)
+	err := c.cc.Invoke(ctx, "/temporal.server.api.historyservice.v1.HistoryService/UpdateWorkflowExecution", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *historyServiceClient) AcquireShard(ctx context.Context, in *AcquireShardRequest, opts ...grpc.CallOption) (*AcquireShardResponse, error) {
+	out := new(AcquireShardResponse)
+	err := c.cc.Invoke(ctx, "/temporal.server.api.historyservice.v1.HistoryService/AcquireShard", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *historyServiceClient) PauseWorkflowExecution(ctx context.Context, in *PauseWorkflowExecutionRequest, opts ...grpc.CallOption) (*PauseWorkflowExecutionResponse, error) {
+	out := new(PauseWorkflowExecutionResponse)
+	err := c.cc.Invoke(ctx, "/temporal.server.api.historyservice.v1.HistoryService/PauseWorkflowExecution", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *historyServiceClient) UnpauseWorkflowExecution(ctx context.Context, in *UnpauseWorkflowExecutionRequest, opts ...grpc.CallOption) (*UnpauseWorkflowExecutionResponse, error) {
+	out := new(UnpauseWorkflowExecutionResponse)
+	err := c.cc.Invoke(ctx, "/temporal.server.api.historyservice.v1.HistoryService/UnpauseWorkflowExecution", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // HistoryServiceServer is the server API for HistoryService service.
 type HistoryServiceServer interface {
 	// StartWorkflowExecution starts a new long running workflow instance.  It will create the instance with
@@ -722,6 +786,20 @@ type HistoryServiceServer interface {
 	MergeDLQMessages(context.Context, *MergeDLQMessagesRequest) (*MergeDLQMessagesResponse, error)
 	// RefreshWorkflowTasks refreshes all tasks of a workflow.
 	RefreshWorkflowTasks(context.Context, *RefreshWorkflowTasksRequest) (*RefreshWorkflowTasksResponse, error)
+	// DeleteWorkflowExecution terminates the workflow execution if it is still running and schedules
+	// the deletion of its mutable state, history and visibility records.
+	DeleteWorkflowExecution(context.Context, *DeleteWorkflowExecutionRequest) (*DeleteWorkflowExecutionResponse, error)
+	// UpdateWorkflowExecution delivers an update to the workflow execution and waits until the workflow
+	// accepted or completed it.
+	UpdateWorkflowExecution(context.Context, *UpdateWorkflowExecutionRequest) (*UpdateWorkflowExecutionResponse, error)
+	// AcquireShard makes the host which owns the shard acquire it right away. It is sent by the previous
+	// owner of the shard once it handed the shard off.
+	AcquireShard(context.Context, *AcquireShardRequest) (*AcquireShardResponse, error)
+	// PauseWorkflowExecution pauses the workflow execution. Its workflow and activity tasks are not dispatched
+	// until it is unpaused, timers still fire and signals are still accepted.
+	PauseWorkflowExecution(context.Context, *PauseWorkflowExecutionRequest) (*PauseWorkflowExecutionResponse, error)
+	// UnpauseWorkflowExecution resumes the dispatch of the workflow and activity tasks of a paused workflow execution.
+	UnpauseWorkflowExecution(context.Context, *UnpauseWorkflowExecutionRequest) (*UnpauseWorkflowExecutionResponse, error)
 }
 
 // UnimplementedHistoryServiceServer can be embedded to have forward compatible implementations.
@@ -836,6 +914,21 @@ func (*UnimplementedHistoryServiceServer) MergeDLQMessages(ctx context.Context,
 func (*UnimplementedHistoryServiceServer) RefreshWorkflowTasks(ctx context.Context, req *RefreshWorkflowTasksRequest) (*RefreshWorkflowTasksResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method RefreshWorkflowTasks not implemented")
 }
+func (*UnimplementedHistoryServiceServer) DeleteWorkflowExecution(ctx context.Context, req *DeleteWorkflowExecutionRequest) (*DeleteWorkflowExecutionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteWorkflowExecution not implemented")
+}
+func (*UnimplementedHistoryServiceServer) UpdateWorkflowExecution(ctx context.Context, req *UpdateWorkflowExecutionRequest) (*UpdateWorkflowExecutionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateWorkflowExecution not implemented")
+}
+func (*UnimplementedHistoryServiceServer) AcquireShard(ctx context.Context, req *AcquireShardRequest) (*AcquireShardResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AcquireShard not implemented")
+}
+func (*UnimplementedHistoryServiceServer) PauseWorkflowExecution(ctx context.Context, req *PauseWorkflowExecutionRequest) (*PauseWorkflowExecutionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PauseWorkflowExecution not implemented")
+}
+func (*UnimplementedHistoryServiceServer) UnpauseWorkflowExecution(ctx context.Context, req *UnpauseWorkflowExecutionRequest) (*UnpauseWorkflowExecutionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UnpauseWorkflowExecution not implemented")
+}
 
 func RegisterHistoryServiceServer(s *grpc.Server, srv HistoryServiceServer) {
 	s.RegisterService(&_HistoryService_serviceDesc, srv)
@@ -1489,6 +1582,96 @@ func _HistoryService_RefreshWorkflowTasks_Handler(srv interface{}, ctx context.C
 	return interceptor(ctx, in, info, handler)
 }
 
+func _HistoryService_DeleteWorkflowExecution_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteWorkflowExecutionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(HistoryServiceServer).DeleteWorkflowExecution(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/temporal.server.api.historyservice.v1.HistoryService/DeleteWorkflowExecution",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(HistoryServiceServer).DeleteWorkflowExecution(ctx, req.(*DeleteWorkflowExecutionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _HistoryService_UpdateWorkflowExecution_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateWorkflowExecutionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(HistoryServiceServer).UpdateWorkflowExecution(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/temporal.server.api.historyservice.v1.HistoryService/UpdateWorkflowExecution",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(HistoryServiceServer).UpdateWorkflowExecution(ctx, req.(*UpdateWorkflowExecutionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _HistoryService_AcquireShard_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AcquireShardRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(HistoryServiceServer).AcquireShard(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/temporal.server.api.historyservice.v1.HistoryService/AcquireShard",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(HistoryServiceServer).AcquireShard(ctx, req.(*AcquireShardRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _HistoryService_PauseWorkflowExecution_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PauseWorkflowExecutionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(HistoryServiceServer).PauseWorkflowExecution(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/temporal.server.api.historyservice.v1.HistoryService/PauseWorkflowExecution",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(HistoryServiceServer).PauseWorkflowExecution(ctx, req.(*PauseWorkflowExecutionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _HistoryService_UnpauseWorkflowExecution_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UnpauseWorkflowExecutionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(HistoryServiceServer).UnpauseWorkflowExecution(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/temporal.server.api.historyservice.v1.HistoryService/UnpauseWorkflowExecution",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(HistoryServiceServer).UnpauseWorkflowExecution(ctx, req.(*UnpauseWorkflowExecutionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var _HistoryService_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "temporal.server.api.historyservice.v1.HistoryService",
 	HandlerType: (*HistoryServiceServer)(nil),
@@ -1637,6 +1820,26 @@ var _HistoryService_serviceDesc = grpc.ServiceDesc{
 			MethodName: "RefreshWorkflowTasks",
 			Handler:    _HistoryService_RefreshWorkflowTasks_Handler,
 		},
+		{
+			MethodName: "DeleteWorkflowExecution",
+			Handler:    _HistoryService_DeleteWorkflowExecution_Handler,
+		},
+		{
+			MethodName: "UpdateWorkflowExecution",
+			Handler:    _HistoryService_UpdateWorkflowExecution_Handler,
+		},
+		{
+			MethodName: "AcquireShard",
+			Handler:    _HistoryService_AcquireShard_Handler,
+		},
+		{
+			MethodName: "PauseWorkflowExecution",
+			Handler:    _HistoryService_PauseWorkflowExecution_Handler,
+		},
+		{
+			MethodName: "UnpauseWorkflowExecution",
+			Handler:    _HistoryService_UnpauseWorkflowExecution_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "temporal/server/api/historyservice/v1/service.proto",
@@ -46,6 +46,7 @@ import (
 	v16 "go.temporal.io/api/enums/v1"
 	v12 "go.temporal.io/api/query/v1"
 	v14 "go.temporal.io/api/taskqueue/v1"
+	v17 "go.temporal.io/api/workflow/v1"
 	v1 "go.temporal.io/api/workflowservice/v1"
 	v15 "go.temporal.io/server/api/enums/v1"
 	v13 "go.temporal.io/server/api/history/v1"
@@ -148,6 +149,10 @@ type PollWorkflowTaskQueueResponse struct {
 	ScheduledTime              *time.Time                     `protobuf:"bytes,15,opt,name=scheduled_time,json=scheduledTime,proto3,stdtime" json:"scheduled_time,omitempty"`
 	StartedTime                *time.Time                     `protobuf:"bytes,16,opt,name=started_time,json=startedTime,proto3,stdtime" json:"started_time,omitempty"`
 	Queries                    map[string]*v12.WorkflowQuery  `protobuf:"bytes,17,rep,name=queries,proto3" json:"queries,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	HistorySizeBytes           int64                          `protobuf:"varint,18,opt,name=history_size_bytes,json=historySizeBytes,proto3" json:"history_size_bytes,omitempty"`
+	// Set once the history size or event count exceeds the warn limit of the namespace.
+	SuggestContinueAsNew bool                         `protobuf:"varint,19,opt,name=suggest_continue_as_new,json=suggestContinueAsNew,proto3" json:"suggest_continue_as_new,omitempty"`
+	Updates              []*v17.WorkflowUpdateRequest `protobuf:"bytes,20,rep,name=updates,proto3" json:"updates,omitempty"`
 }
 
 func (m *PollWorkflowTaskQueueResponse) Reset()      { *m = PollWorkflowTaskQueueResponse{} }
@@ -301,6 +306,27 @@ func (m *PollWorkflowTaskQueueResponse) GetQueries() map[string]*v12.WorkflowQue
 	return nil
 }
 
+func (m *PollWorkflowTaskQueueResponse) GetHistorySizeBytes() int64 {
+	if m != nil {
+		return m.HistorySizeBytes
+	}
+	return 0
+}
+
+func (m *PollWorkflowTaskQueueResponse) GetSuggestContinueAsNew() bool {
+	if m != nil {
+		return m.SuggestContinueAsNew
+	}
+	return false
+}
+
+func (m *PollWorkflowTaskQueueResponse) GetUpdates() []*v17.WorkflowUpdateRequest {
+	if m != nil {
+		return m.Updates
+	}
+	return nil
+}
+
 type PollActivityTaskQueueRequest struct {
 	NamespaceId     string                           `protobuf:"bytes,1,opt,name=namespace_id,json=namespaceId,proto3" json:"namespace_id,omitempty"`
 	PollerId        string                           `protobuf:"bytes,2,opt,name=poller_id,json=pollerId,proto3" json:"poller_id,omitempty"`
@@ -1352,116 +1378,119 @@ func init() {
 }
 
 var fileDescriptor_a429a3813476c583 = []byte{
-	// 1733 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xcc, 0x58, 0x4d, 0x6f, 0x13, 0xcd,
-	0x1d, 0xcf, 0x3a, 0x89, 0x13, 0xcf, 0x3a, 0x89, 0xb3, 0xb4, 0xc1, 0x09, 0x64, 0x13, 0x0c, 0x85,
-	0x50, 0xd1, 0xb5, 0x48, 0x05, 0x02, 0x5a, 0xd4, 0x86, 0x10, 0x81, 0x55, 0xa0, 0x61, 0x63, 0xd1,
-	0x0a, 0x55, 0x5a, 0x26, 0xbb, 0x13, 0x67, 0x9b, 0xf5, 0x8e, 0xb3, 0x33, 0xeb, 0xe0, 0x9e, 0x2a,
-	0x55, 0xbd, 0x23, 0xf5, 0xd2, 0xaa, 0x5f, 0xa0, 0x3d, 0xf4, 0xd4, 0x7e, 0x88, 0x1e, 0x7a, 0xe0,
-	0xc8, 0xad, 0x25, 0xb9, 0x54, 0x7a, 0x2e, 0x3c, 0xdf, 0xe0, 0xd1, 0xbc, 0xec, 0x7a, 0x77, 0x6d,
-	0x27, 0x4e, 0x88, 0x1e, 0x9e, 0x9b, 0xf7, 0xff, 0x36, 0xff, 0xd7, 0xdf, 0xfc, 0xc7, 0xe0, 0x21,
-	0x45, 0xcd, 0x16, 0x0e, 0xa0, 0x57, 0x25, 0x28, 0x68, 0xa3, 0xa0, 0x0a, 0x5b, 0x6e, 0xb5, 0x09,
-	0xa9, 0xbd, 0xeb, 0xfa, 0x0d, 0x46, 0x72, 0x6d, 0x54, 0x6d, 0xdf, 0xae, 0x06, 0x68, 0x3f, 0x44,
-	0x84, 0x5a, 0x01, 0x22, 0x2d, 0xec, 0x13, 0x64, 0xb4, 0x02, 0x4c, 0xb1, 0x76, 0x3d, 0x52, 0x37,
-	0x84, 0xba, 0x01, 0x5b, 0xae, 0x91, 0x51, 0x37, 0xda, 0xb7, 0x17, 0xf4, 0x06, 0xc6, 0x0d, 0x0f,
-	0x55, 0xb9, 0xd6, 0x76, 0xb8, 0x53, 0x75, 0xc2, 0x00, 0x52, 0x17, 0xfb, 0xc2, 0xce, 0xc2, 0x52,
-	0x96, 0x4f, 0xdd, 0x26, 0x22, 0x14, 0x36, 0x5b, 0x52, 0xe0, 0x8a, 0x83, 0x5a, 0xc8, 0x77, 0x90,
-	0x6f, 0xbb, 0x88, 0x54, 0x1b, 0xb8, 0x81, 0x39, 0x9d, 0xff, 0x92, 0x22, 0xd7, 0xe2, 0x50, 0x58,
-	0x0c, 0x36, 0x6e, 0x36, 0xb1, 0xcf, 0x5c, 0x6f, 0x22, 0x42, 0x60, 0x43, 0x7a, 0xbc, 0x70, 0x3d,
-	0x25, 0x85, 0xfc, 0xb0, 0x49, 0x98, 0x10, 0x85, 0x64, 0xcf, 0xda, 0x0f, 0x51, 0x18, 0xc9, 0xdd,
-	0x48, 0xc9, 0x31, 0x36, 0xe7, 0xf6, 0x1a, 0xbc, 0x9a, 0x12, 0xdc, 0x0f, 0x51, 0xd0, 0xe9, 0x15,
-	0xba, 0xd1, 0x2f, 0xcd, 0xa9, 0xc3, 0xa5, 0xe0, 0xad, 0x7e, 0x82, 0xbb, 0x2e, 0xa1, 0xb8, 0x9f,
-	0xd9, 0xbb, 0xa9, 0xb3, 0x0f, 0x70, 0xb0, 0xb7, 0xe3, 0xe1, 0x83, 0x13, 0xcb, 0x56, 0xf9, 0x4a,
-	0x01, 0x97, 0x37, 0xb1, 0xe7, 0xfd, 0x4a, 0x6a, 0xd4, 0x21, 0xd9, 0x7b, 0xc9, 0xc2, 0x33, 0x85,
-	0xbc, 0x76, 0x05, 0x14, 0x7d, 0xd8, 0x44, 0xa4, 0x05, 0x6d, 0x64, 0xb9, 0x4e, 0x59, 0x59, 0x56,
-	0x56, 0x0a, 0xa6, 0x1a, 0xd3, 0x6a, 0x8e, 0x76, 0x09, 0x14, 0x5a, 0xd8, 0xf3, 0x50, 0xc0, 0xf8,
-	0x39, 0xce, 0x9f, 0x14, 0x84, 0x9a, 0xa3, 0xbd, 0x01, 0x45, 0xf6, 0xdb, 0x92, 0xe7, 0x97, 0x47,
-	0x97, 0x95, 0x15, 0x75, 0xf5, 0xa1, 0x11, 0xb7, 0x0b, 0xeb, 0x93, 0x8c, 0xbf, 0x46, 0xfb, 0xb6,
-	0x71, 0x9c, 0x53, 0xa6, 0xca, 0x4c, 0x46, 0x1e, 0xde, 0x04, 0xa5, 0x1d, 0x1c, 0x1c, 0xc0, 0xc0,
-	0x41, 0x8e, 0x45, 0x70, 0x18, 0xd8, 0xa8, 0x3c, 0xc6, 0xbd, 0x98, 0x89, 0xe9, 0x5b, 0x9c, 0x5c,
-	0xf9, 0x47, 0x01, 0x2c, 0x0e, 0x30, 0x2c, 0xb2, 0xa2, 0x2d, 0x02, 0xc0, 0x1b, 0x80, 0xe2, 0x3d,
-	0xe4, 0xf3, 0x60, 0x8b, 0x66, 0x81, 0x51, 0xea, 0x8c, 0xa0, 0xfd, 0x1a, 0x68, 0x91, 0xaf, 0x16,
-	0x7a, 0x8b, 0xec, 0x90, 0x75, 0x2e, 0x8f, 0x59, 0x5d, 0xbd, 0x99, 0x8e, 0x49, 0xb4, 0x1d, 0x0b,
-	0x25, 0x3a, 0x6d, 0x23, 0x52, 0x30, 0x67, 0x0f, 0xb2, 0x24, 0xad, 0x06, 0xa6, 0x62, 0xcb, 0xb4,
-	0xd3, 0x42, 0x32, 0x51, 0xd7, 0x4e, 0x32, 0x5a, 0xef, 0xb4, 0x90, 0x59, 0x3c, 0x48, 0x7c, 0x69,
-	0xf7, 0xc1, 0x7c, 0x2b, 0x40, 0x6d, 0x17, 0x87, 0xc4, 0x22, 0x14, 0x06, 0x14, 0x39, 0x16, 0x6a,
-	0x23, 0x9f, 0xb2, 0xfa, 0xb0, 0xcc, 0x8c, 0x9a, 0x73, 0x91, 0xc0, 0x96, 0xe0, 0x6f, 0x30, 0x76,
-	0xcd, 0xd1, 0x56, 0x40, 0xa9, 0x47, 0x63, 0x9c, 0x6b, 0x4c, 0x93, 0xb4, 0x64, 0x19, 0x4c, 0x40,
-	0xca, 0x7c, 0xa3, 0xe5, 0xfc, 0xb2, 0xb2, 0x32, 0x6e, 0x46, 0x9f, 0x5a, 0x05, 0x4c, 0xf9, 0xe8,
-	0x2d, 0xed, 0x1a, 0x98, 0xe0, 0x06, 0x54, 0x46, 0x8c, 0xb4, 0x6f, 0x01, 0x6d, 0x1b, 0xda, 0x7b,
-	0x1e, 0x6e, 0x58, 0x36, 0x0e, 0x7d, 0x6a, 0xed, 0xba, 0x3e, 0x2d, 0x4f, 0x72, 0xc1, 0x92, 0xe4,
-	0xac, 0x33, 0xc6, 0x53, 0xd7, 0xa7, 0xda, 0x3d, 0x50, 0x26, 0xd4, 0xb5, 0xf7, 0x3a, 0xdd, 0x9c,
-	0x5b, 0xc8, 0x87, 0xdb, 0x1e, 0x72, 0xca, 0x85, 0x65, 0x65, 0x65, 0xd2, 0x9c, 0x13, 0xfc, 0x38,
-	0x9d, 0x1b, 0x82, 0xab, 0x3d, 0x00, 0xe3, 0x7c, 0x0e, 0xcb, 0xa0, 0x5f, 0x36, 0x39, 0x2b, 0x99,
-	0xcc, 0x97, 0x8c, 0x60, 0x0a, 0x15, 0xad, 0x91, 0xa8, 0x35, 0xef, 0x09, 0xd7, 0xdf, 0xc1, 0x65,
-	0x95, 0x1b, 0xba, 0x6f, 0xf4, 0x83, 0x3b, 0x39, 0x9d, 0xcc, 0x62, 0x3d, 0x80, 0x3e, 0x71, 0x91,
-	0x4f, 0x93, 0xad, 0x56, 0xf3, 0x77, 0xb0, 0x59, 0x3a, 0xc8, 0x50, 0xb4, 0x06, 0x58, 0xec, 0x6d,
-	0x2a, 0xab, 0x8b, 0x43, 0xe5, 0x62, 0x3f, 0xe7, 0x63, 0x20, 0xe2, 0xc7, 0xc5, 0x8d, 0xbc, 0xd0,
-	0xd3, 0x5a, 0x31, 0x4f, 0x33, 0xc0, 0x05, 0x51, 0x14, 0xe6, 0x26, 0xb2, 0xda, 0x28, 0x20, 0xac,
-	0x7d, 0xa7, 0x78, 0xfd, 0x66, 0x39, 0x6b, 0x8b, 0x71, 0x5e, 0x09, 0x06, 0x9b, 0xfd, 0xed, 0x00,
-	0xfa, 0xf6, 0xae, 0x1c, 0x87, 0x69, 0x3e, 0x0e, 0xaa, 0xa0, 0x89, 0x81, 0x78, 0x02, 0xa6, 0x89,
-	0xbd, 0x8b, 0x9c, 0xd0, 0x43, 0x8e, 0xc5, 0xa0, 0xba, 0x3c, 0xc3, 0x9d, 0x5d, 0x30, 0x04, 0x8e,
-	0x1b, 0x11, 0x8e, 0x1b, 0xf5, 0x08, 0xc7, 0x1f, 0x8d, 0xbd, 0xfb, 0xef, 0x92, 0x62, 0x4e, 0xc5,
-	0x7a, 0x8c, 0xa3, 0xad, 0x83, 0x62, 0xd4, 0x79, 0xdc, 0x4c, 0x69, 0x48, 0x33, 0xaa, 0xd4, 0xe2,
-	0x46, 0x3c, 0x30, 0xc1, 0x6a, 0xe7, 0x22, 0x52, 0x9e, 0x5d, 0x1e, 0x5d, 0x51, 0x57, 0x4d, 0x63,
-	0xb8, 0x6b, 0xc9, 0x38, 0x16, 0x15, 0x8c, 0x97, 0xc2, 0xe8, 0x86, 0x4f, 0x83, 0x8e, 0x19, 0x1d,
-	0xb1, 0xf0, 0x06, 0x14, 0x93, 0x0c, 0xad, 0x04, 0x46, 0xf7, 0x50, 0x47, 0x22, 0x24, 0xfb, 0xc9,
-	0xda, 0xaf, 0x0d, 0xbd, 0x10, 0x49, 0x84, 0x18, 0xb2, 0xfd, 0xb8, 0xca, 0x83, 0xdc, 0x3d, 0x25,
-	0x46, 0xe7, 0x35, 0x9b, 0xba, 0x6d, 0x97, 0x76, 0xbe, 0x53, 0xe8, 0x3c, 0xc8, 0xa9, 0x33, 0xa3,
-	0xf3, 0x7f, 0x26, 0x05, 0x3a, 0xf7, 0x31, 0xfc, 0xa5, 0xd1, 0x79, 0x09, 0xa8, 0x50, 0x7a, 0xc5,
-	0xd2, 0x38, 0xca, 0x03, 0x00, 0x11, 0xa9, 0xe6, 0x30, 0xf8, 0x8e, 0x05, 0x38, 0x7c, 0x8f, 0x1d,
-	0x0f, 0xdf, 0x71, 0x8c, 0x1c, 0xbe, 0x61, 0xe2, 0x4b, 0xbb, 0x0b, 0xc6, 0x5d, 0xbf, 0x15, 0x52,
-	0x0e, 0xbc, 0xea, 0xea, 0xf2, 0x20, 0x13, 0x9b, 0xb0, 0xe3, 0x61, 0xe8, 0x10, 0x53, 0x88, 0xf7,
-	0x19, 0xc5, 0xfc, 0xd9, 0x46, 0xf1, 0x35, 0x98, 0x8f, 0x08, 0x16, 0xc5, 0x96, 0xed, 0x61, 0x82,
-	0xb8, 0x41, 0x1c, 0x52, 0x0e, 0xe6, 0xea, 0xea, 0x7c, 0x8f, 0xcd, 0xc7, 0x72, 0x8d, 0x7b, 0x34,
-	0xf6, 0x67, 0x66, 0x72, 0x2e, 0xb2, 0x50, 0xc7, 0xeb, 0x4c, 0xbf, 0x2e, 0xd4, 0x7b, 0xc6, 0x7c,
-	0xf2, 0x2c, 0x63, 0x5e, 0x07, 0x73, 0xfc, 0xb3, 0xd7, 0xbb, 0xc2, 0x70, 0xde, 0x5d, 0xe0, 0xea,
-	0x19, 0xd7, 0x9e, 0x81, 0xd9, 0x5d, 0x04, 0x03, 0xba, 0x8d, 0x20, 0x8d, 0x0d, 0x82, 0xe1, 0x0c,
-	0x96, 0x62, 0xcd, 0xc8, 0x5a, 0xe2, 0x7e, 0x54, 0xd3, 0xf7, 0x23, 0x02, 0xba, 0x1d, 0x06, 0x01,
-	0xc3, 0x61, 0x49, 0xb2, 0x32, 0x75, 0x2b, 0x0e, 0x99, 0x94, 0x4b, 0xd2, 0xce, 0x9a, 0x30, 0xb3,
-	0x95, 0xaa, 0xe2, 0xf3, 0x64, 0x38, 0x0e, 0xa2, 0xd0, 0xf5, 0x08, 0x87, 0xfa, 0x61, 0x5a, 0xaa,
-	0x1b, 0xcf, 0x63, 0xa1, 0xd9, 0xbb, 0x9f, 0x4c, 0x9f, 0x79, 0x3f, 0xf9, 0x51, 0x62, 0x4c, 0x63,
-	0xa4, 0xe2, 0xf7, 0x46, 0xa1, 0x3b, 0x7b, 0x2f, 0x22, 0x86, 0x76, 0x17, 0xe4, 0x77, 0x11, 0x74,
-	0x50, 0x20, 0xef, 0x04, 0x7d, 0xd0, 0x91, 0x4f, 0xb9, 0x94, 0x29, 0xa5, 0x2b, 0xff, 0x1c, 0x05,
-	0x73, 0x6b, 0x8e, 0x93, 0x44, 0xf5, 0x53, 0xc0, 0xe6, 0x13, 0x50, 0xf8, 0x0c, 0x08, 0xe9, 0xea,
-	0x6a, 0xeb, 0x12, 0xb3, 0xc4, 0x55, 0x3e, 0x7a, 0x8a, 0xab, 0x9c, 0x23, 0x9b, 0xb8, 0xb9, 0x97,
-	0x80, 0x1a, 0x8f, 0x64, 0xbc, 0xc4, 0x81, 0x88, 0x54, 0x73, 0xb2, 0x33, 0x2b, 0xc7, 0x43, 0x36,
-	0xf1, 0xf8, 0xa9, 0x67, 0x96, 0xaf, 0x85, 0x51, 0x2b, 0xf7, 0x83, 0xf0, 0x7c, 0x5f, 0x08, 0xd7,
-	0x7e, 0x0e, 0xf2, 0x52, 0x80, 0xe1, 0xc4, 0xf4, 0xea, 0x4a, 0xdf, 0xfb, 0x97, 0x3f, 0x77, 0xa2,
-	0x58, 0x85, 0xa6, 0x29, 0xf5, 0x2a, 0xf3, 0xe0, 0x62, 0x4f, 0xd1, 0x04, 0xfa, 0x57, 0x8e, 0x44,
-	0x41, 0x93, 0xd7, 0xc3, 0x97, 0x28, 0xa8, 0x01, 0x2e, 0x08, 0x5f, 0xad, 0xd4, 0x91, 0xe2, 0x4e,
-	0x98, 0x15, 0xac, 0x17, 0x89, 0x83, 0xd3, 0x0d, 0x30, 0x76, 0x2e, 0x0d, 0x30, 0x7e, 0xba, 0x06,
-	0xc8, 0x9f, 0x7f, 0x03, 0x4c, 0x9c, 0xd4, 0x00, 0x93, 0x9f, 0xd5, 0x00, 0xe9, 0x22, 0xcb, 0x06,
-	0xf8, 0x63, 0x0e, 0x7c, 0x8f, 0xef, 0x48, 0x51, 0x7d, 0x4e, 0x51, 0xfe, 0x74, 0x15, 0x72, 0x67,
-	0xab, 0xc2, 0x6b, 0x30, 0xc5, 0x97, 0xb6, 0xcc, 0xbe, 0x74, 0xe7, 0xc4, 0x7d, 0xa9, 0x9f, 0xd7,
-	0x66, 0x91, 0xdb, 0x3a, 0xc3, 0xa2, 0xf4, 0x77, 0x05, 0x7c, 0x3f, 0x63, 0x51, 0x2e, 0x48, 0xeb,
-	0xa0, 0x18, 0x39, 0x48, 0x42, 0x8f, 0xf2, 0x44, 0x0c, 0x83, 0xf7, 0xaa, 0x74, 0x85, 0x29, 0x69,
-	0xbf, 0x00, 0xd3, 0x91, 0x91, 0xdf, 0x22, 0x9b, 0x22, 0xe7, 0x84, 0xf5, 0x55, 0xac, 0xad, 0x52,
-	0xd6, 0x9c, 0xda, 0x4f, 0x7e, 0x56, 0xfe, 0x94, 0x03, 0xcb, 0xc2, 0x3d, 0x87, 0xcb, 0xb1, 0xbc,
-	0xae, 0xe3, 0x66, 0xcb, 0x43, 0x4c, 0xf8, 0x5b, 0xae, 0xdf, 0x45, 0x30, 0x21, 0x5e, 0x72, 0xd1,
-	0xb8, 0xe6, 0xd9, 0x67, 0xcd, 0xd1, 0x7c, 0x30, 0x6b, 0x47, 0x4e, 0xc5, 0xc5, 0x15, 0xa3, 0xba,
-	0x76, 0x62, 0x71, 0x4f, 0x0a, 0xcf, 0x2c, 0xd9, 0x19, 0x4a, 0xe5, 0x2a, 0xb8, 0x72, 0x8c, 0x96,
-	0x6c, 0xf7, 0xaf, 0x15, 0x70, 0x79, 0x1d, 0xfa, 0x36, 0xf2, 0x7e, 0x19, 0x52, 0x42, 0xa1, 0xef,
-	0xb8, 0x7e, 0x63, 0x33, 0xb1, 0x5b, 0x0f, 0x91, 0xb6, 0x67, 0x60, 0xa6, 0x9b, 0x36, 0x71, 0x71,
-	0xe7, 0xf8, 0x60, 0x66, 0x72, 0x97, 0x9a, 0x48, 0x9e, 0x2c, 0x7e, 0x71, 0x4f, 0xd1, 0xe4, 0xe7,
-	0xf9, 0xdc, 0x65, 0xa9, 0x07, 0xc9, 0x58, 0xfa, 0x41, 0x52, 0x59, 0x02, 0x8b, 0x03, 0x42, 0x96,
-	0x49, 0xf9, 0xab, 0x02, 0xca, 0x8f, 0x11, 0xb1, 0x03, 0x77, 0x1b, 0x9d, 0xe5, 0x39, 0xf4, 0x1b,
-	0x50, 0x74, 0x10, 0xb1, 0xe3, 0x22, 0xe7, 0xb2, 0xef, 0xf9, 0x01, 0x45, 0x1e, 0x74, 0xa6, 0xa9,
-	0x32, 0x73, 0x51, 0x5d, 0xff, 0xa5, 0x80, 0xf9, 0x3e, 0x92, 0x72, 0x3a, 0x7f, 0x06, 0x26, 0x44,
-	0xa0, 0xa4, 0xac, 0xf0, 0xe7, 0xe9, 0x0f, 0x8e, 0xc9, 0xdd, 0xa6, 0x48, 0x89, 0xbf, 0x83, 0xcd,
-	0x48, 0x4b, 0x7b, 0x05, 0x66, 0x13, 0xd5, 0x24, 0x14, 0xd2, 0x90, 0xc8, 0x08, 0x7e, 0x38, 0x4c,
-	0x19, 0xb6, 0xb8, 0x86, 0x39, 0x43, 0xd3, 0x84, 0xca, 0x1f, 0x14, 0xa0, 0x3f, 0x73, 0x09, 0x8d,
-	0x05, 0x37, 0x61, 0x40, 0x5d, 0x76, 0x33, 0x90, 0x28, 0xb5, 0x97, 0x41, 0xa1, 0xbb, 0xab, 0x89,
-	0xbc, 0x76, 0x09, 0xe7, 0x32, 0x9d, 0x95, 0xbf, 0xe4, 0xc0, 0xd2, 0x40, 0x2f, 0x64, 0x0a, 0x7f,
-	0x07, 0xf4, 0xee, 0x3b, 0xab, 0x9b, 0x8a, 0x56, 0x2c, 0x29, 0x33, 0x7b, 0x67, 0x98, 0xc3, 0x63,
-	0xfb, 0xcf, 0x11, 0x85, 0x0e, 0xa4, 0xd0, 0xbc, 0x04, 0xb3, 0x6f, 0xcf, 0xae, 0x0f, 0xec, 0xec,
-	0xf4, 0x1f, 0x42, 0x3d, 0x67, 0xe7, 0x3e, 0xeb, 0xec, 0x83, 0xec, 0xff, 0x0f, 0xdd, 0xb3, 0x1f,
-	0x05, 0xef, 0x3f, 0xea, 0x23, 0x1f, 0x3e, 0xea, 0x23, 0x9f, 0x3e, 0xea, 0xca, 0xef, 0x0f, 0x75,
-	0xe5, 0x6f, 0x87, 0xba, 0xf2, 0xef, 0x43, 0x5d, 0x79, 0x7f, 0xa8, 0x2b, 0xff, 0x3b, 0xd4, 0x95,
-	0xff, 0x1f, 0xea, 0x23, 0x9f, 0x0e, 0x75, 0xe5, 0xdd, 0x91, 0x3e, 0xf2, 0xfe, 0x48, 0x1f, 0xf9,
-	0x70, 0xa4, 0x8f, 0xbc, 0xfe, 0x69, 0x03, 0x77, 0x7d, 0x71, 0xf1, 0xf1, 0xff, 0xec, 0xff, 0x24,
-	0x43, 0xda, 0xce, 0xf3, 0x3d, 0xe1, 0xc7, 0xdf, 0x04, 0x00, 0x00, 0xff, 0xff, 0x04, 0x68, 0xa1,
-	0xe5, 0x1a, 0x18, 0x00, 0x00,
+	// 1786 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xcd, 0x19, 0xcb, 0x6e, 0x1b, 0x55,
+	0xb4, 0x76, 0x12, 0x3b, 0xbe, 0x76, 0x12, 0x7b, 0x5a, 0x52, 0x27, 0x6d, 0x9c, 0xd4, 0x2d, 0x7d,
+	0xa0, 0x62, 0xab, 0x41, 0xad, 0xda, 0x42, 0x05, 0x49, 0x1a, 0xb5, 0x15, 0x6d, 0x49, 0x27, 0xa6,
+	0xa0, 0x0a, 0x69, 0x18, 0xcf, 0xdc, 0x38, 0x43, 0xec, 0x19, 0x77, 0x1e, 0x71, 0xd3, 0x15, 0x12,
+	0x62, 0x5f, 0x89, 0x0d, 0x88, 0x1f, 0x80, 0x35, 0x7c, 0x04, 0x0b, 0x16, 0x5d, 0x76, 0x83, 0xa0,
+	0xed, 0x06, 0x89, 0x0d, 0xfc, 0x01, 0xe7, 0xbe, 0xc6, 0x33, 0xe3, 0x71, 0xec, 0xa4, 0x11, 0x65,
+	0x31, 0x8a, 0xe7, 0xbc, 0xee, 0x79, 0x9f, 0x73, 0x27, 0xe8, 0x9a, 0x8b, 0x5b, 0x6d, 0xcb, 0x56,
+	0x9b, 0x55, 0x07, 0xdb, 0xdb, 0xd8, 0xae, 0xaa, 0x6d, 0xa3, 0xda, 0x52, 0x5d, 0x6d, 0xd3, 0x30,
+	0x1b, 0x04, 0x64, 0x68, 0xb8, 0xba, 0x7d, 0xa1, 0x6a, 0xe3, 0x87, 0x1e, 0x76, 0x5c, 0xc5, 0xc6,
+	0x4e, 0xdb, 0x32, 0x1d, 0x5c, 0x69, 0xdb, 0x96, 0x6b, 0x49, 0xa7, 0x05, 0x7b, 0x85, 0xb1, 0x57,
+	0x80, 0xbd, 0x12, 0x61, 0xaf, 0x6c, 0x5f, 0x98, 0x2d, 0x35, 0x2c, 0xab, 0xd1, 0xc4, 0x55, 0xca,
+	0x55, 0xf7, 0x36, 0xaa, 0xba, 0x67, 0xab, 0xae, 0x61, 0x99, 0x4c, 0xce, 0xec, 0x7c, 0x14, 0xef,
+	0x1a, 0x2d, 0x38, 0x4e, 0x6d, 0xb5, 0x39, 0xc1, 0x09, 0x1d, 0xb7, 0xb1, 0xa9, 0x63, 0x53, 0x33,
+	0xb0, 0x53, 0x6d, 0x58, 0x0d, 0x8b, 0xc2, 0xe9, 0x2f, 0x4e, 0x72, 0xca, 0x37, 0x85, 0xd8, 0xa0,
+	0x59, 0xad, 0x96, 0x65, 0x12, 0xd5, 0x41, 0x90, 0xa3, 0x36, 0xb8, 0xc6, 0xb3, 0xa7, 0x43, 0x54,
+	0xd8, 0xf4, 0x5a, 0x0e, 0x21, 0x72, 0x55, 0x67, 0x4b, 0x01, 0x13, 0x3d, 0x41, 0x77, 0x26, 0x44,
+	0x47, 0xd0, 0x14, 0xdb, 0x2b, 0xf0, 0x64, 0x88, 0x10, 0x88, 0xec, 0x9d, 0x41, 0xa7, 0x76, 0x2c,
+	0x7b, 0x6b, 0xa3, 0x69, 0x75, 0x7a, 0xe9, 0xce, 0xc4, 0x85, 0x23, 0xa4, 0x24, 0x27, 0x3c, 0x1f,
+	0x47, 0xb8, 0x69, 0x38, 0xae, 0x15, 0x77, 0xfc, 0xa5, 0xd8, 0xe3, 0x07, 0x86, 0xb7, 0xfc, 0x57,
+	0x02, 0x1d, 0x5f, 0xb3, 0x9a, 0xcd, 0x4f, 0x38, 0x47, 0x0d, 0x14, 0xb8, 0x47, 0xdc, 0x20, 0x33,
+	0x7a, 0xe9, 0x04, 0xca, 0x99, 0x2a, 0x9c, 0xd5, 0x56, 0x35, 0xac, 0x18, 0x7a, 0x31, 0xb1, 0x90,
+	0x38, 0x9b, 0x91, 0xb3, 0x3e, 0xec, 0x96, 0x2e, 0x1d, 0x43, 0x99, 0x36, 0x88, 0xc0, 0x36, 0xc1,
+	0x27, 0x29, 0x7e, 0x9c, 0x01, 0x00, 0xf9, 0x39, 0xca, 0x91, 0xdf, 0x0a, 0x3f, 0xbf, 0x38, 0x02,
+	0xf8, 0xec, 0xe2, 0xb5, 0x8a, 0x9f, 0x56, 0x24, 0x9f, 0x22, 0xfa, 0x42, 0x3e, 0x55, 0x76, 0x53,
+	0x4a, 0xce, 0x12, 0x91, 0x42, 0xc3, 0x73, 0x28, 0xbf, 0x61, 0xd9, 0x1d, 0xd5, 0xd6, 0xb1, 0xae,
+	0x38, 0x96, 0x67, 0x6b, 0xb8, 0x38, 0x4a, 0xb5, 0x98, 0xf2, 0xe1, 0xeb, 0x14, 0x5c, 0xfe, 0x0d,
+	0xa1, 0xb9, 0x3e, 0x82, 0x99, 0x57, 0xa4, 0x39, 0x84, 0x68, 0xa2, 0xb8, 0xd6, 0x16, 0x36, 0xa9,
+	0xb1, 0x39, 0x39, 0x43, 0x20, 0x35, 0x02, 0x90, 0x3e, 0x45, 0x92, 0xd0, 0x55, 0xc1, 0x8f, 0xb0,
+	0xe6, 0x91, 0x0c, 0xa7, 0x36, 0x67, 0x17, 0xcf, 0x85, 0x6d, 0x62, 0xe9, 0x49, 0x4c, 0x11, 0xa7,
+	0xad, 0x0a, 0x06, 0xb9, 0xd0, 0x89, 0x82, 0xa4, 0x5b, 0x68, 0xc2, 0x97, 0xec, 0xee, 0xb4, 0x31,
+	0x77, 0xd4, 0xa9, 0x41, 0x42, 0x6b, 0x40, 0x2b, 0xe7, 0x3a, 0x81, 0x37, 0xe9, 0x0a, 0x9a, 0x69,
+	0xdb, 0x78, 0xdb, 0xb0, 0x3c, 0x47, 0x81, 0x0a, 0xb3, 0x5d, 0xf0, 0x0b, 0xde, 0xc6, 0xa6, 0x4b,
+	0xe2, 0x43, 0x3c, 0x33, 0x22, 0x4f, 0x0b, 0x82, 0x75, 0x86, 0x5f, 0x25, 0x68, 0x88, 0xd6, 0x59,
+	0x94, 0xef, 0xe1, 0x18, 0xa3, 0x1c, 0x93, 0x4e, 0x98, 0xb2, 0x88, 0xd2, 0xaa, 0x4b, 0x74, 0x73,
+	0x8b, 0x29, 0x20, 0x18, 0x93, 0xc5, 0xab, 0x54, 0x46, 0x13, 0x26, 0x7e, 0xe4, 0x76, 0x05, 0xa4,
+	0xa9, 0x80, 0x2c, 0x01, 0x0a, 0xee, 0xf3, 0x48, 0xaa, 0xab, 0xda, 0x56, 0xd3, 0x6a, 0x28, 0x9a,
+	0xe5, 0x01, 0x19, 0x74, 0x13, 0xb7, 0x38, 0x4e, 0x09, 0xf3, 0x1c, 0xb3, 0x42, 0x10, 0x37, 0x01,
+	0x2e, 0x5d, 0x46, 0x45, 0xc7, 0x35, 0xb4, 0xad, 0x9d, 0xae, 0xcf, 0x15, 0x6c, 0xaa, 0xf5, 0x26,
+	0xd6, 0x8b, 0x19, 0xe0, 0x19, 0x97, 0xa7, 0x19, 0xde, 0x77, 0xe7, 0x2a, 0xc3, 0x4a, 0x57, 0xd1,
+	0x18, 0xad, 0xd7, 0x22, 0x8a, 0xf3, 0x26, 0x45, 0x05, 0x9d, 0x79, 0x8f, 0x00, 0x64, 0xc6, 0x22,
+	0x35, 0x02, 0xb1, 0xa6, 0x39, 0x61, 0x98, 0x1b, 0x56, 0x31, 0x4b, 0x05, 0x5d, 0xa9, 0xc4, 0xb5,
+	0x45, 0x5e, 0x9d, 0x44, 0x62, 0xcd, 0x56, 0x4d, 0xc7, 0x00, 0x63, 0x83, 0xa9, 0x76, 0x0b, 0x04,
+	0xc8, 0xf9, 0x4e, 0x04, 0x02, 0x07, 0xcd, 0xf5, 0x26, 0x95, 0xd2, 0xed, 0x57, 0xc5, 0x5c, 0x9c,
+	0xf2, 0x7e, 0xc3, 0xa2, 0xc7, 0xf9, 0x89, 0x3c, 0xdb, 0x93, 0x5a, 0x3e, 0x4e, 0xaa, 0xa0, 0xc3,
+	0x2c, 0x28, 0x44, 0x4d, 0xac, 0x80, 0xda, 0x0e, 0x49, 0xdf, 0x09, 0x1a, 0xbf, 0x02, 0x45, 0xad,
+	0x13, 0xcc, 0x7d, 0x86, 0x20, 0xb5, 0x5f, 0x07, 0x3b, 0xb4, 0x4d, 0x5e, 0x0e, 0x93, 0xb4, 0x1c,
+	0xb2, 0x0c, 0xc6, 0x0a, 0xe2, 0x06, 0x9a, 0x74, 0xb4, 0x4d, 0xac, 0x7b, 0xe0, 0x6d, 0x85, 0xb4,
+	0xf4, 0xe2, 0x14, 0x55, 0x76, 0xb6, 0xc2, 0xfa, 0x7d, 0x45, 0xf4, 0xfb, 0x4a, 0x4d, 0xf4, 0xfb,
+	0xe5, 0xd1, 0x27, 0xbf, 0xcf, 0x27, 0xe4, 0x09, 0x9f, 0x8f, 0x60, 0xa4, 0x15, 0x94, 0x13, 0x99,
+	0x47, 0xc5, 0xe4, 0x87, 0x14, 0x93, 0xe5, 0x5c, 0x54, 0x48, 0x13, 0xa5, 0x49, 0xec, 0x60, 0x80,
+	0x14, 0x0b, 0x0b, 0x23, 0xc0, 0x2f, 0x57, 0x86, 0x1b, 0x5f, 0x95, 0x5d, 0xbb, 0x42, 0xe5, 0x1e,
+	0x13, 0xba, 0x6a, 0xba, 0x90, 0x1e, 0xe2, 0x08, 0x92, 0xc4, 0x3c, 0xe2, 0x8a, 0x63, 0x3c, 0xc6,
+	0x4a, 0x7d, 0xc7, 0x85, 0x83, 0x25, 0x96, 0xc4, 0x1c, 0xb3, 0x0e, 0x88, 0x65, 0x02, 0x97, 0x2e,
+	0xa2, 0xa3, 0x8e, 0xd7, 0x68, 0x90, 0x1e, 0xac, 0x59, 0xa6, 0x6b, 0x98, 0x1e, 0x56, 0x54, 0x47,
+	0x31, 0x71, 0xa7, 0x78, 0x98, 0xe6, 0xf0, 0x11, 0x8e, 0x5e, 0xe1, 0xd8, 0x25, 0xe7, 0x2e, 0xee,
+	0x40, 0x5f, 0x48, 0x7b, 0x6d, 0x5d, 0x25, 0x92, 0x8f, 0x50, 0x93, 0xaa, 0xf1, 0xad, 0x33, 0x98,
+	0xc6, 0x1f, 0x53, 0x06, 0xd1, 0x2c, 0x05, 0xff, 0x2c, 0xb4, 0xe2, 0xa0, 0x21, 0x52, 0x1e, 0x8d,
+	0x6c, 0xe1, 0x1d, 0xde, 0xd1, 0xc9, 0x4f, 0x52, 0x2e, 0xdb, 0x6a, 0x13, 0x32, 0x2e, 0xb9, 0x97,
+	0x72, 0xa1, 0x2c, 0x57, 0x93, 0x97, 0x13, 0xfe, 0x34, 0x59, 0xd2, 0x5c, 0x63, 0xdb, 0x70, 0x77,
+	0xfe, 0x57, 0xd3, 0xa4, 0x9f, 0x52, 0xfb, 0x9e, 0x26, 0xbf, 0x8e, 0xb3, 0x69, 0x12, 0x23, 0xf8,
+	0x75, 0x4f, 0x93, 0x79, 0x94, 0x55, 0xb9, 0x56, 0xc4, 0x8d, 0x23, 0xd4, 0x00, 0x24, 0x40, 0xe0,
+	0x48, 0x18, 0x37, 0x3e, 0x01, 0x1d, 0x37, 0xa3, 0xbb, 0x8f, 0x1b, 0xdf, 0x46, 0x3a, 0x6e, 0xd4,
+	0xc0, 0x9b, 0x74, 0x09, 0x8d, 0x19, 0x66, 0xdb, 0x73, 0xe9, 0xa0, 0xc8, 0x2e, 0x2e, 0xf4, 0x13,
+	0xb1, 0xa6, 0xee, 0x34, 0x2d, 0x55, 0x77, 0x64, 0x46, 0x1e, 0xd3, 0x3a, 0x52, 0xfb, 0x6b, 0x1d,
+	0x0f, 0xd0, 0x8c, 0x00, 0x80, 0xa7, 0x15, 0xad, 0x69, 0x39, 0x98, 0x0a, 0xb4, 0x40, 0xa9, 0x34,
+	0x95, 0x39, 0xd3, 0x23, 0xf3, 0x3a, 0x5f, 0x4f, 0x97, 0x47, 0xbf, 0x25, 0x22, 0xa7, 0x85, 0x84,
+	0x9a, 0xb5, 0x42, 0xf8, 0x6b, 0x8c, 0xbd, 0xa7, 0x2d, 0x8d, 0xef, 0xa7, 0x2d, 0xd5, 0xd0, 0x34,
+	0x7d, 0xed, 0xd5, 0x2e, 0x33, 0x9c, 0x76, 0x87, 0x29, 0x7b, 0x44, 0xb5, 0xdb, 0xa8, 0xb0, 0x89,
+	0x01, 0x5c, 0xc7, 0xaa, 0xeb, 0x0b, 0x44, 0xc3, 0x09, 0xcc, 0xfb, 0x9c, 0x42, 0x5a, 0x60, 0x9e,
+	0x67, 0xc3, 0xf3, 0x1c, 0xa3, 0x92, 0xe6, 0xd9, 0x36, 0x99, 0x1b, 0x1c, 0xa4, 0x44, 0xe2, 0x96,
+	0x1b, 0xd2, 0x29, 0xc7, 0xb8, 0x9c, 0x25, 0x26, 0x66, 0x3d, 0x14, 0xc5, 0x3b, 0x41, 0x73, 0x74,
+	0xec, 0xaa, 0x46, 0xd3, 0xa1, 0xa3, 0x69, 0x98, 0x94, 0xea, 0xda, 0x73, 0x9d, 0x71, 0xf6, 0xee,
+	0x53, 0x93, 0xfb, 0xde, 0xa7, 0xde, 0x0e, 0x94, 0xa9, 0xdf, 0xa9, 0xe8, 0x9c, 0xcb, 0x74, 0x6b,
+	0xef, 0xae, 0x40, 0x40, 0x3d, 0xa4, 0x40, 0x1b, 0x1d, 0xdb, 0x7c, 0x86, 0x95, 0xfa, 0x1d, 0x79,
+	0x93, 0x52, 0xc9, 0x9c, 0xba, 0xfc, 0xd3, 0x08, 0x9a, 0x5e, 0xd2, 0xf5, 0xe0, 0x14, 0xda, 0x43,
+	0xdb, 0xbc, 0x81, 0x32, 0xaf, 0xd0, 0x42, 0xba, 0xbc, 0x90, 0xf1, 0x28, 0xb0, 0x7a, 0x8c, 0xec,
+	0x61, 0xf5, 0xa0, 0x9d, 0x8d, 0x6d, 0x1a, 0xd0, 0x7f, 0xfc, 0x92, 0xf4, 0x97, 0x4e, 0x24, 0x40,
+	0xa0, 0x6e, 0xa4, 0x66, 0x79, 0x79, 0xf0, 0x24, 0x1e, 0xdb, 0x73, 0xcd, 0xd2, 0x35, 0x56, 0xa4,
+	0x72, 0x5c, 0x0b, 0x4f, 0xc5, 0xb6, 0x70, 0xe9, 0x03, 0x94, 0xe2, 0x04, 0xa4, 0x4f, 0x4c, 0x2e,
+	0x9e, 0x8d, 0xdd, 0x17, 0xe8, 0xf5, 0x4c, 0xd8, 0xca, 0x38, 0x65, 0xce, 0x57, 0x9e, 0x41, 0x47,
+	0x7b, 0x82, 0xc6, 0xba, 0x7f, 0xf9, 0x25, 0x0b, 0x68, 0x70, 0x3c, 0xbc, 0x8e, 0x80, 0xc2, 0xd6,
+	0xc7, 0x74, 0x55, 0x42, 0x47, 0xb2, 0x99, 0x50, 0x60, 0xa8, 0xbb, 0x81, 0x83, 0xc3, 0x09, 0x30,
+	0x7a, 0x20, 0x09, 0x30, 0xb6, 0xb7, 0x04, 0x48, 0x1d, 0x7c, 0x02, 0xa4, 0x07, 0x25, 0xc0, 0xf8,
+	0x2b, 0x25, 0x40, 0x38, 0xc8, 0x3c, 0x01, 0xbe, 0x4e, 0xa2, 0x23, 0x74, 0x47, 0x12, 0xf1, 0xd9,
+	0x43, 0xf8, 0xc3, 0x51, 0x48, 0xee, 0x2f, 0x0a, 0x0f, 0xd0, 0x04, 0x5d, 0xda, 0x22, 0xfb, 0xd2,
+	0xc5, 0x81, 0xfb, 0x52, 0x9c, 0xd6, 0x72, 0x8e, 0xca, 0xda, 0xc7, 0xa2, 0xf4, 0x63, 0x02, 0xbd,
+	0x11, 0x91, 0xc8, 0x17, 0x24, 0x18, 0xaf, 0x42, 0x41, 0xc7, 0x6b, 0xba, 0xd4, 0x11, 0xc3, 0xf4,
+	0xfb, 0x2c, 0x57, 0x85, 0x30, 0x49, 0x1f, 0xa2, 0x49, 0x21, 0xe4, 0x0b, 0xac, 0xc1, 0xd0, 0x1d,
+	0xb0, 0xbe, 0xb2, 0xb5, 0x95, 0xd3, 0xca, 0x13, 0x0f, 0x83, 0xaf, 0xe5, 0x6f, 0x92, 0x68, 0x81,
+	0xa9, 0xa7, 0x53, 0x3a, 0xe2, 0xd7, 0x15, 0xab, 0xd5, 0x6e, 0x62, 0x42, 0xfc, 0x1f, 0xc7, 0xef,
+	0x28, 0x4a, 0xb3, 0x9b, 0xa7, 0x28, 0xd7, 0x14, 0x79, 0x05, 0xe9, 0x26, 0x2a, 0x68, 0x42, 0x29,
+	0x3f, 0xb8, 0xac, 0x54, 0x97, 0x06, 0x06, 0x77, 0x90, 0x79, 0x72, 0x5e, 0x8b, 0x40, 0xca, 0x27,
+	0xd1, 0x89, 0x5d, 0xb8, 0x78, 0xba, 0xff, 0x03, 0xdb, 0xff, 0x0a, 0x5c, 0x0d, 0x71, 0xf3, 0x23,
+	0xcf, 0x85, 0x82, 0x36, 0x75, 0xb8, 0x63, 0xad, 0x05, 0x76, 0xeb, 0x21, 0xdc, 0x76, 0x1b, 0x4d,
+	0x75, 0xdd, 0xc6, 0x06, 0x77, 0x92, 0x16, 0x66, 0xc4, 0x77, 0xa1, 0x8a, 0xa4, 0xce, 0xa2, 0x83,
+	0x7b, 0xc2, 0x0d, 0xbe, 0x1e, 0xcc, 0x2c, 0x0b, 0x5d, 0x48, 0x46, 0xc3, 0x17, 0x92, 0xf2, 0x3c,
+	0x9a, 0xeb, 0x63, 0x32, 0x77, 0xca, 0xf7, 0x09, 0x54, 0xbc, 0x8e, 0x1d, 0xcd, 0x36, 0xea, 0x78,
+	0x3f, 0xd7, 0xa1, 0xcf, 0x50, 0x4e, 0x07, 0x76, 0x3f, 0xc8, 0xc9, 0xe8, 0xf7, 0x87, 0x3e, 0x41,
+	0xee, 0x77, 0xa6, 0x9c, 0x25, 0xe2, 0x44, 0x5c, 0x7f, 0x4e, 0xa0, 0x99, 0x18, 0x4a, 0x5e, 0x9d,
+	0xef, 0xa3, 0x34, 0x33, 0xd4, 0x01, 0xcd, 0xc8, 0xdd, 0xf3, 0xcd, 0x5d, 0x7c, 0xb7, 0xc6, 0x5c,
+	0x42, 0x3e, 0x71, 0x08, 0x2e, 0xe9, 0x3e, 0x2a, 0x04, 0xa2, 0x09, 0xfe, 0x71, 0x3d, 0x87, 0x5b,
+	0xf0, 0xd6, 0x30, 0x61, 0x58, 0xa7, 0x1c, 0xf2, 0x94, 0x1b, 0x06, 0x94, 0xbf, 0x4a, 0xa0, 0xd2,
+	0x6d, 0xb8, 0x60, 0xfb, 0x84, 0x6b, 0xd0, 0xfd, 0x0d, 0x32, 0x19, 0x1c, 0xe1, 0xda, 0xe3, 0x28,
+	0xd3, 0xdd, 0xd5, 0x98, 0x5f, 0xbb, 0x80, 0x03, 0xa9, 0xce, 0xf2, 0x77, 0x49, 0x34, 0xdf, 0x57,
+	0x0b, 0xee, 0xc2, 0xc7, 0xa8, 0xd4, 0xbd, 0x67, 0x75, 0x5d, 0xd1, 0xf6, 0x29, 0xb9, 0x67, 0x2f,
+	0x0e, 0x73, 0xb8, 0x2f, 0xff, 0x0e, 0x2c, 0xb9, 0x70, 0xa7, 0x57, 0xe5, 0x63, 0x6a, 0xf4, 0xee,
+	0xd9, 0xd5, 0x81, 0x9c, 0x1d, 0xfe, 0x80, 0xd5, 0x73, 0x76, 0xf2, 0x95, 0xce, 0xee, 0x44, 0xbf,
+	0x97, 0x74, 0xcf, 0x5e, 0xb6, 0x9f, 0x3e, 0x2f, 0x1d, 0x7a, 0x06, 0xcf, 0xdf, 0xcf, 0x4b, 0x89,
+	0x2f, 0x5f, 0x94, 0x12, 0x3f, 0xc0, 0xf3, 0x0b, 0x3c, 0x4f, 0xe1, 0xf9, 0x03, 0x9e, 0x3f, 0x5f,
+	0x00, 0x0e, 0xfe, 0x3e, 0x79, 0x59, 0x3a, 0xf4, 0x14, 0x9e, 0x67, 0xf0, 0x3c, 0x78, 0xaf, 0x61,
+	0x75, 0x75, 0x31, 0xac, 0xdd, 0xff, 0x63, 0xf1, 0x6e, 0x04, 0x54, 0x4f, 0xd1, 0x3d, 0xe1, 0x9d,
+	0x7f, 0x01, 0xf5, 0x79, 0x09, 0xd5, 0xf2, 0x18, 0x00, 0x00,
 }
 
 func (this *PollWorkflowTaskQueueRequest) Equal(that interface{}) bool {
@@ -1580,6 +1609,20 @@ func (this *PollWorkflowTaskQueueResponse) Equal(that interface{}) bool {
 			return false
 		}
 	}
+	if this.HistorySizeBytes != that1.HistorySizeBytes {
+		return false
+	}
+	if this.SuggestContinueAsNew != that1.SuggestContinueAsNew {
+		return false
+	}
+	if len(this.Updates) != len(that1.Updates) {
+		return false
+	}
+	for i := range this.Updates {
+		if !this.Updates[i].Equal(that1.Updates[i]) {
+			return false
+		}
+	}
 	return true
 }
 func (this *PollActivityTaskQueueRequest) Equal(that interface{}) bool {
@@ -2165,7 +2208,7 @@ func (this *PollWorkflowTaskQueueResponse) GoString() string {
 	if this == nil {
 		return "nil"
 	}
-	s := make([]string, 0, 21)
+	s := make([]string, 0, 24)
 	s = append(s, "&matchingservice.PollWorkflowTaskQueueResponse{")
 	s = append(s, "TaskToken: "+fmt.Sprintf("%#v", this.TaskToken)+",\n")
 	if this.WorkflowExecution != nil {
@@ -2206,6 +2249,11 @@ func (this *PollWorkflowTaskQueueResponse) GoString() string {
 	if this.Queries != nil {
 		s = append(s, "Queries: "+mapStringForQueries+",\n")
 	}
+	s = append(s, "HistorySizeBytes: "+fmt.Sprintf("%#v", this.HistorySizeBytes)+",\n")
+	s = append(s, "SuggestContinueAsNew: "+fmt.Sprintf("%#v", this.SuggestContinueAsNew)+",\n")
+	if this.Updates != nil {
+		s = append(s, "Updates: "+fmt.Sprintf("%#v", this.Updates)+",\n")
+	}
 	s = append(s, "}")
 	return strings.Join(s, "")
 }
@@ -2542,6 +2590,41 @@ func (m *PollWorkflowTaskQueueResponse) MarshalToSizedBuffer(dAtA []byte) (int,
 	_ = i
 	var l int
 	_ = l
+	if len(m.Updates) > 0 {
+		for iNdEx := len(m.Updates) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Updates[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintRequestResponse(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0xa2
+		}
+	}
+	if m.SuggestContinueAsNew {
+		i--
+		if m.SuggestContinueAsNew {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x98
+	}
+	if m.HistorySizeBytes != 0 {
+		i = encodeVarintRequestResponse(dAtA, i, uint64(m.HistorySizeBytes))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x90
+	}
 	if len(m.Queries) > 0 {
 		for k := range m.Queries {
 			v := m.Queries[k]
@@ -3728,6 +3811,18 @@ func (m *PollWorkflowTaskQueueResponse) Size() (n int) {
 			n += mapEntrySize + 2 + sovRequestResponse(uint64(mapEntrySize))
 		}
 	}
+	if m.HistorySizeBytes != 0 {
+		n += 2 + sovRequestResponse(uint64(m.HistorySizeBytes))
+	}
+	if m.SuggestContinueAsNew {
+		n += 3
+	}
+	if len(m.Updates) > 0 {
+		for _, e := range m.Updates {
+			l = e.Size()
+			n += 2 + l + sovRequestResponse(uint64(l))
+		}
+	}
 	return n
 }
 
@@ -4136,6 +4231,11 @@ func (this *PollWorkflowTaskQueueResponse) String() string {
 		mapStringForQueries += fmt.Sprintf("%v: %v,", k, this.Queries[k])
 	}
 	mapStringForQueries += "}"
+	repeatedStringForUpdates := "[]*WorkflowUpdateRequest{"
+	for _, f := range this.Updates {
+		repeatedStringForUpdates += strings.Replace(fmt.Sprintf("%v", f), "WorkflowUpdateRequest", "v17.WorkflowUpdateRequest", 1) + ","
+	}
+	repeatedStringForUpdates += "}"
 	s := strings.Join([]string{`&PollWorkflowTaskQueueResponse{`,
 		`TaskToken:` + fmt.Sprintf("%v", this.TaskToken) + `,`,
 		`WorkflowExecution:` + strings.Replace(fmt.Sprintf("%v", this.WorkflowExecution), "WorkflowExecution", "v11.WorkflowExecution", 1) + `,`,
@@ -4154,6 +4254,9 @@ func (this *PollWorkflowTaskQueueResponse) String() string {
 		`ScheduledTime:` + strings.Replace(fmt.Sprintf("%v", this.ScheduledTime), "Timestamp", "types.Timestamp", 1) + `,`,
 		`StartedTime:` + strings.Replace(fmt.Sprintf("%v", this.StartedTime), "Timestamp", "types.Timestamp", 1) + `,`,
 		`Queries:` + mapStringForQueries + `,`,
+		`HistorySizeBytes:` + fmt.Sprintf("%v", this.HistorySizeBytes) + `,`,
+		`SuggestContinueAsNew:` + fmt.Sprintf("%v", this.SuggestContinueAsNew) + `,`,
+		`Updates:` + repeatedStringForUpdates + `,`,
 		`}`,
 	}, "")
 	return s
@@ -5179,6 +5282,79 @@ func (m *PollWorkflowTaskQueueResponse) Unmarshal(dAtA []byte) error {
 			}
 			m.Queries[mapkey] = mapvalue
 			iNdEx = postIndex
+		case 18:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field HistorySizeBytes", wireType)
+			}
+			m.HistorySizeBytes = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRequestResponse
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.HistorySizeBytes |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 19:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SuggestContinueAsNew", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRequestResponse
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.SuggestContinueAsNew = bool(v != 0)
+		case 20:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Updates", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRequestResponse
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthRequestResponse
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthRequestResponse
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Updates = append(m.Updates, &v17.WorkflowUpdateRequest{})
+			if err := m.Updates[len(m.Updates)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipRequestResponse(dAtA[iNdEx:])
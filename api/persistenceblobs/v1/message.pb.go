@@ -1789,6 +1789,9 @@ type WorkflowExecutionInfo struct {
 	VersionHistories             *v17.VersionHistories   `protobuf:"bytes,54,opt,name=version_histories,json=versionHistories,proto3" json:"version_histories,omitempty"`
 	FirstExecutionRunId          string                  `protobuf:"bytes,55,opt,name=first_execution_run_id,json=firstExecutionRunId,proto3" json:"first_execution_run_id,omitempty"`
 	ExecutionStats               *ExecutionStats         `protobuf:"bytes,56,opt,name=execution_stats,json=executionStats,proto3" json:"execution_stats,omitempty"`
+	Paused                       bool                    `protobuf:"varint,57,opt,name=paused,proto3" json:"paused,omitempty"`
+	PauseReason                  string                  `protobuf:"bytes,58,opt,name=pause_reason,json=pauseReason,proto3" json:"pause_reason,omitempty"`
+	UpdateInfos                  []*UpdateInfo           `protobuf:"bytes,59,rep,name=update_infos,json=updateInfos,proto3" json:"update_infos,omitempty"`
 }
 
 func (m *WorkflowExecutionInfo) Reset()      { *m = WorkflowExecutionInfo{} }
@@ -2181,6 +2184,27 @@ func (m *WorkflowExecutionInfo) GetExecutionStats() *ExecutionStats {
 	return nil
 }
 
+func (m *WorkflowExecutionInfo) GetPaused() bool {
+	if m != nil {
+		return m.Paused
+	}
+	return false
+}
+
+func (m *WorkflowExecutionInfo) GetPauseReason() string {
+	if m != nil {
+		return m.PauseReason
+	}
+	return ""
+}
+
+func (m *WorkflowExecutionInfo) GetUpdateInfos() []*UpdateInfo {
+	if m != nil {
+		return m.UpdateInfos
+	}
+	return nil
+}
+
 type Checksum struct {
 	Version int32              `protobuf:"varint,1,opt,name=version,proto3" json:"version,omitempty"`
 	Flavor  v14.ChecksumFlavor `protobuf:"varint,2,opt,name=flavor,proto3,enum=temporal.server.api.enums.v1.ChecksumFlavor" json:"flavor,omitempty"`
@@ -2738,6 +2762,84 @@ func (m *ReplicationVersions) GetLastWriteVersion() *types.Int64Value {
 	return nil
 }
 
+type UpdateInfo struct {
+	UpdateId string `protobuf:"bytes,1,opt,name=update_id,json=updateId,proto3" json:"update_id,omitempty"`
+	// Set once the workflow recorded the accepted marker of the update.
+	Accepted bool `protobuf:"varint,2,opt,name=accepted,proto3" json:"accepted,omitempty"`
+	// Set once the workflow recorded the completed marker of the update.
+	Completed bool          `protobuf:"varint,3,opt,name=completed,proto3" json:"completed,omitempty"`
+	Result    *v13.Payloads `protobuf:"bytes,4,opt,name=result,proto3" json:"result,omitempty"`
+	// Set if the update was rejected or failed.
+	Failure *v12.Failure `protobuf:"bytes,5,opt,name=failure,proto3" json:"failure,omitempty"`
+}
+
+func (m *UpdateInfo) Reset()      { *m = UpdateInfo{} }
+func (*UpdateInfo) ProtoMessage() {}
+func (*UpdateInfo) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ef806e155800e59a, []int{25}
+}
+func (m *UpdateInfo) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *UpdateInfo) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_UpdateInfo.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *UpdateInfo) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_UpdateInfo.Merge(m, src)
+}
+func (m *UpdateInfo) XXX_Size() int {
+	return m.Size()
+}
+func (m *UpdateInfo) XXX_DiscardUnknown() {
+	xxx_messageInfo_UpdateInfo.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_UpdateInfo proto.InternalMessageInfo
+
+func (m *UpdateInfo) GetUpdateId() string {
+	if m != nil {
+		return m.UpdateId
+	}
+	return ""
+}
+
+func (m *UpdateInfo) GetAccepted() bool {
+	if m != nil {
+		return m.Accepted
+	}
+	return false
+}
+
+func (m *UpdateInfo) GetCompleted() bool {
+	if m != nil {
+		return m.Completed
+	}
+	return false
+}
+
+func (m *UpdateInfo) GetResult() *v13.Payloads {
+	if m != nil {
+		return m.Result
+	}
+	return nil
+}
+
+func (m *UpdateInfo) GetFailure() *v12.Failure {
+	if m != nil {
+		return m.Failure
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterType((*ExecutionStats)(nil), "temporal.server.api.persistenceblobs.v1.ExecutionStats")
 	proto.RegisterType((*ClusterMetadata)(nil), "temporal.server.api.persistenceblobs.v1.ClusterMetadata")
@@ -2771,6 +2873,7 @@ func init() {
 	proto.RegisterType((*NamespaceReplicationConfig)(nil), "temporal.server.api.persistenceblobs.v1.NamespaceReplicationConfig")
 	proto.RegisterType((*NamespaceConfig)(nil), "temporal.server.api.persistenceblobs.v1.NamespaceConfig")
 	proto.RegisterType((*ReplicationVersions)(nil), "temporal.server.api.persistenceblobs.v1.ReplicationVersions")
+	proto.RegisterType((*UpdateInfo)(nil), "temporal.server.api.persistenceblobs.v1.UpdateInfo")
 }
 
 func init() {
@@ -2778,259 +2881,263 @@ func init() {
 }
 
 var fileDescriptor_ef806e155800e59a = []byte{
-	// 4019 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xd4, 0x3b, 0x4d, 0x73, 0x1b, 0x47,
-	0x76, 0x02, 0x09, 0x92, 0xc0, 0x03, 0x48, 0x00, 0x4d, 0x8a, 0x1c, 0x82, 0x12, 0x44, 0xc1, 0x92,
-	0x45, 0xdb, 0x5a, 0x50, 0xa4, 0x6c, 0xc9, 0xbb, 0xca, 0x26, 0x4b, 0x52, 0xf4, 0x1a, 0x8c, 0x4c,
-	0xcb, 0x43, 0xda, 0xda, 0xb8, 0xe2, 0x9a, 0x1d, 0xcc, 0x34, 0xc8, 0x29, 0x0e, 0x66, 0xe0, 0x99,
-	0x01, 0x68, 0xee, 0x69, 0x73, 0xda, 0x4a, 0x65, 0x0f, 0x5b, 0x39, 0xa5, 0x2a, 0x97, 0x7c, 0x5c,
-	0xf2, 0x07, 0x52, 0x39, 0xa5, 0x2a, 0x55, 0xb9, 0xe4, 0x90, 0x83, 0x8f, 0x7b, 0x48, 0x55, 0x62,
-	0xf9, 0x92, 0x43, 0x52, 0xbb, 0x3f, 0x21, 0xd5, 0xaf, 0xbb, 0xe7, 0x0b, 0x43, 0x12, 0x94, 0xd6,
-	0xa9, 0xda, 0x1b, 0xe6, 0x7d, 0xf5, 0xeb, 0xd7, 0xaf, 0xbb, 0xdf, 0x47, 0x03, 0xde, 0x0b, 0x68,
-	0xaf, 0xef, 0x7a, 0xba, 0xbd, 0xee, 0x53, 0x6f, 0x48, 0xbd, 0x75, 0xbd, 0x6f, 0xad, 0xf7, 0xa9,
-	0xe7, 0x5b, 0x7e, 0x40, 0x1d, 0x83, 0x76, 0x6c, 0xb7, 0xe3, 0xaf, 0x0f, 0x37, 0xd6, 0x7b, 0xd4,
-	0xf7, 0xf5, 0x23, 0xda, 0xea, 0x7b, 0x6e, 0xe0, 0x92, 0x7b, 0x92, 0xad, 0xc5, 0xd9, 0x5a, 0x7a,
-	0xdf, 0x6a, 0xa5, 0xd9, 0x5a, 0xc3, 0x8d, 0x7a, 0xe3, 0xc8, 0x75, 0x8f, 0x6c, 0xba, 0x8e, 0x6c,
-	0x9d, 0x41, 0x77, 0xdd, 0x1c, 0x78, 0x7a, 0x60, 0xb9, 0x0e, 0x17, 0x54, 0xbf, 0x95, 0xc6, 0x07,
-	0x56, 0x8f, 0xfa, 0x81, 0xde, 0xeb, 0x0b, 0x82, 0x11, 0x01, 0xa7, 0x9e, 0xde, 0x67, 0x23, 0x09,
-	0xfc, 0x6d, 0x93, 0xf6, 0xa9, 0x63, 0x52, 0xc7, 0xb0, 0xa8, 0xbf, 0x7e, 0xe4, 0x1e, 0xb9, 0x08,
-	0xc7, 0x5f, 0x82, 0xe4, 0x4e, 0x38, 0x47, 0x36, 0x39, 0xc3, 0xed, 0xf5, 0x5c, 0x67, 0x64, 0x4a,
-	0x29, 0x2a, 0xea, 0x0c, 0x7a, 0x38, 0xef, 0x53, 0xd7, 0x3b, 0xe9, 0xda, 0xee, 0xa9, 0xa0, 0xba,
-	0x9b, 0x4d, 0xe5, 0xe8, 0x3d, 0xea, 0xf7, 0x75, 0x43, 0x0a, 0xbb, 0x97, 0x20, 0x0b, 0xb1, 0xa3,
-	0xa3, 0xbe, 0x99, 0x2d, 0x2f, 0xd0, 0xfd, 0x13, 0xed, 0xcb, 0x01, 0x1d, 0xd0, 0xcc, 0x71, 0xbb,
-	0xba, 0x65, 0x0f, 0xbc, 0x0c, 0x71, 0x49, 0xb2, 0x63, 0xcb, 0x0f, 0x5c, 0xef, 0xec, 0xb2, 0x51,
-	0xe5, 0x14, 0x2f, 0x13, 0x37, 0x64, 0xeb, 0x9b, 0x65, 0xba, 0xb7, 0xb2, 0x9c, 0x28, 0x9c, 0x0b,
-	0x37, 0xb8, 0x20, 0x7d, 0xe7, 0x42, 0xd2, 0x94, 0xb1, 0xef, 0x5d, 0x48, 0xcc, 0x6c, 0x24, 0x08,
-	0xef, 0x67, 0x11, 0x9e, 0x37, 0xfb, 0xe6, 0x43, 0x98, 0xdb, 0xfd, 0x8a, 0x1a, 0x03, 0xe6, 0x86,
-	0x07, 0x81, 0x1e, 0xf8, 0xe4, 0x36, 0x94, 0x05, 0xb5, 0xe6, 0x5b, 0x3f, 0xa3, 0x4a, 0x6e, 0x35,
-	0xb7, 0x36, 0xa9, 0x96, 0x04, 0xec, 0xc0, 0xfa, 0x19, 0x6d, 0xfe, 0x7b, 0x0e, 0x2a, 0x3b, 0xf6,
-	0xc0, 0x0f, 0xa8, 0xf7, 0x11, 0x0d, 0x74, 0x53, 0x0f, 0x74, 0xc6, 0x66, 0x70, 0x90, 0xc6, 0x96,
-	0x18, 0xd9, 0x8a, 0x6a, 0x49, 0xc0, 0xf6, 0xf5, 0x1e, 0x25, 0x2d, 0x98, 0x0f, 0x25, 0x1f, 0xeb,
-	0x9e, 0xa9, 0x19, 0xee, 0xc0, 0x09, 0x94, 0x89, 0xd5, 0xdc, 0xda, 0x94, 0x5a, 0x93, 0x03, 0x30,
-	0xcc, 0x0e, 0x43, 0x90, 0x9b, 0x00, 0x52, 0xa4, 0x65, 0x2a, 0x93, 0x28, 0xb0, 0x28, 0x20, 0x6d,
-	0x93, 0xfc, 0x18, 0xca, 0x62, 0x15, 0x34, 0xcb, 0xe9, 0xba, 0x4a, 0x7e, 0x35, 0xb7, 0x56, 0xda,
-	0xbc, 0xd3, 0x0a, 0xb7, 0x23, 0xdb, 0x87, 0x82, 0xa2, 0x35, 0xdc, 0x68, 0x7d, 0xc6, 0x7f, 0xb6,
-	0x9d, 0xae, 0xab, 0x96, 0x86, 0xd1, 0x47, 0xf3, 0xaf, 0x2b, 0x50, 0xde, 0x32, 0x02, 0x6b, 0x68,
-	0x05, 0x67, 0x0c, 0x40, 0x14, 0x98, 0x11, 0x78, 0x31, 0x7b, 0xf9, 0x49, 0x1e, 0x83, 0xe2, 0x1b,
-	0xc7, 0xd4, 0x1c, 0xd8, 0xd4, 0xd4, 0xe8, 0x90, 0x3a, 0x81, 0xd6, 0xd1, 0x03, 0xe3, 0x98, 0x29,
-	0x38, 0x81, 0xa4, 0xd7, 0x43, 0xfc, 0x2e, 0x43, 0x6f, 0x33, 0x6c, 0xdb, 0x24, 0xfb, 0x50, 0x49,
-	0x31, 0xe2, 0x84, 0x4a, 0x9b, 0x77, 0x93, 0xfa, 0x0a, 0x2b, 0x30, 0x7d, 0x3f, 0xe4, 0x3f, 0x51,
-	0x8c, 0x3a, 0x97, 0x14, 0x4b, 0x7e, 0x0c, 0x11, 0x44, 0x63, 0xe7, 0x84, 0x98, 0x7e, 0xbd, 0xc5,
-	0xcf, 0x88, 0x96, 0x3c, 0x23, 0x5a, 0x87, 0xf2, 0x10, 0xd9, 0xce, 0xff, 0xea, 0x3f, 0x6f, 0xe5,
-	0xd4, 0xd9, 0x90, 0x8f, 0x61, 0x98, 0x91, 0xfd, 0x40, 0xf7, 0x02, 0x6a, 0xb2, 0x39, 0x4c, 0xe1,
-	0x1c, 0x8a, 0x02, 0xd2, 0x36, 0xc9, 0x1e, 0xcc, 0x4a, 0x34, 0xd7, 0x7a, 0xfa, 0x2a, 0x5a, 0x97,
-	0x05, 0x2f, 0xd7, 0x79, 0x07, 0xe4, 0x37, 0xd7, 0x78, 0x66, 0x4c, 0x8d, 0x4b, 0x82, 0x0b, 0xf5,
-	0xbd, 0x05, 0x25, 0x5d, 0xac, 0x15, 0x53, 0xb8, 0x80, 0x5e, 0x01, 0x12, 0xd4, 0x36, 0xd9, 0x84,
-	0x3c, 0xfa, 0xe5, 0x80, 0xfa, 0x01, 0xc3, 0x17, 0xb9, 0xd7, 0x08, 0x48, 0xdb, 0x24, 0x9f, 0xc3,
-	0xb2, 0x34, 0x80, 0x16, 0xb8, 0x1a, 0x8a, 0x46, 0x75, 0xdc, 0x41, 0xa0, 0x00, 0x6a, 0xb4, 0x3c,
-	0xa2, 0xd1, 0x53, 0x71, 0x50, 0x6f, 0xe7, 0xff, 0x8a, 0x29, 0xb4, 0x28, 0x25, 0x1c, 0xba, 0x07,
-	0x8c, 0xff, 0x90, 0xb3, 0xa7, 0x65, 0x1b, 0xb6, 0xeb, 0xd3, 0x50, 0x76, 0xe9, 0xca, 0xb2, 0x77,
-	0x18, 0xbf, 0x94, 0x7d, 0x08, 0x8b, 0x42, 0xd7, 0xb4, 0xe0, 0xf2, 0x78, 0x82, 0xe7, 0x91, 0x3d,
-	0x25, 0xf5, 0x19, 0xd4, 0x8e, 0xa9, 0xee, 0x05, 0x1d, 0xaa, 0x47, 0x56, 0x98, 0x1d, 0x4f, 0x60,
-	0x35, 0xe4, 0x94, 0xd2, 0xde, 0x82, 0xaa, 0xa1, 0x3b, 0x06, 0xb5, 0x35, 0x61, 0x6f, 0x6a, 0x2a,
-	0x73, 0xab, 0xb9, 0xb5, 0x82, 0x5a, 0xe1, 0x70, 0x55, 0x82, 0xc9, 0xdb, 0x50, 0x4b, 0x92, 0xb2,
-	0xc5, 0xaa, 0xa0, 0xf7, 0x25, 0x69, 0xdb, 0x48, 0xcb, 0x54, 0xf3, 0x34, 0xbc, 0x09, 0xfc, 0x40,
-	0x0f, 0x06, 0xbe, 0x52, 0xc5, 0x53, 0xa3, 0x82, 0x88, 0x43, 0xdd, 0x3f, 0x39, 0x40, 0x30, 0xdb,
-	0xba, 0x7a, 0xc0, 0x7c, 0x33, 0x50, 0x6a, 0x48, 0x21, 0x3f, 0x99, 0x5f, 0x44, 0x37, 0x89, 0x42,
-	0xb8, 0x5f, 0x30, 0xc8, 0x27, 0x0c, 0xc0, 0x74, 0x8f, 0xf6, 0x01, 0x75, 0x02, 0x2b, 0x38, 0x53,
-	0xe6, 0x91, 0xa8, 0x12, 0xee, 0x06, 0x0e, 0x26, 0x6b, 0x50, 0x3d, 0xd6, 0x7d, 0xcd, 0xa3, 0x81,
-	0x77, 0xa6, 0xf5, 0x5d, 0xdb, 0x32, 0xce, 0x94, 0x05, 0x9c, 0xe6, 0xdc, 0xb1, 0xee, 0xab, 0x0c,
-	0xfc, 0x1c, 0xa1, 0xe4, 0x53, 0x58, 0xe4, 0x54, 0x96, 0x63, 0x05, 0x96, 0x6e, 0x6b, 0x96, 0x13,
-	0x50, 0x6f, 0xa8, 0xdb, 0xca, 0xf5, 0xf1, 0x6c, 0xbc, 0x80, 0xec, 0x6d, 0xce, 0xdd, 0x16, 0xcc,
-	0x91, 0xd8, 0x9e, 0xfe, 0x95, 0xd5, 0x1b, 0xf4, 0x22, 0xb1, 0x8b, 0x57, 0x11, 0xfb, 0x11, 0xe7,
-	0x0e, 0xc5, 0xbe, 0x9b, 0x16, 0x2b, 0x4c, 0xe7, 0x2b, 0x4b, 0x68, 0xca, 0x04, 0xd7, 0x96, 0xc0,
-	0x91, 0x43, 0xb8, 0xce, 0xb9, 0xe8, 0x57, 0x7d, 0x8b, 0x8f, 0xc2, 0xb7, 0xb7, 0x32, 0xe6, 0xf6,
-	0x9e, 0x47, 0xf6, 0xdd, 0x90, 0x1b, 0xb7, 0xf9, 0x0f, 0x60, 0x99, 0x4b, 0xed, 0xe8, 0xc6, 0x89,
-	0xdb, 0xed, 0x6a, 0x86, 0x4b, 0xbb, 0x5d, 0xcb, 0xb0, 0xd8, 0x19, 0xb4, 0xbc, 0x9a, 0x5b, 0xcb,
-	0xa9, 0x4b, 0x48, 0xb0, 0xcd, 0xf1, 0x3b, 0x11, 0x9a, 0x3c, 0x85, 0x5b, 0x9c, 0xd7, 0x71, 0x1d,
-	0xbe, 0x4a, 0x7a, 0xc7, 0xa6, 0x1a, 0xf5, 0x3c, 0xd7, 0xd3, 0x82, 0xb3, 0x3e, 0xf5, 0x95, 0xfa,
-	0xea, 0xe4, 0x5a, 0x51, 0x5d, 0x41, 0xe4, 0xbe, 0xeb, 0xa8, 0x92, 0x68, 0x97, 0xd1, 0x1c, 0x32,
-	0x12, 0xb2, 0x0f, 0x84, 0x4b, 0xb1, 0x75, 0x3f, 0xd0, 0x44, 0x94, 0xa1, 0xac, 0xe0, 0xa4, 0x56,
-	0x93, 0xc7, 0x9f, 0x40, 0xb2, 0xe3, 0xef, 0x03, 0xfe, 0x53, 0xad, 0x22, 0xef, 0x33, 0xdd, 0x0f,
-	0x04, 0x84, 0x3c, 0x81, 0x7a, 0x4c, 0x1e, 0xbb, 0xdd, 0xf1, 0x5e, 0x13, 0xae, 0x76, 0x03, 0x5d,
-	0x6d, 0x29, 0xe4, 0x7a, 0x81, 0xf8, 0xd0, 0xe5, 0x6e, 0x43, 0x39, 0x0c, 0x9c, 0xd8, 0x4e, 0xb9,
-	0xc9, 0x6f, 0xd7, 0x10, 0xd6, 0x36, 0xd9, 0xc1, 0x18, 0x1e, 0x3e, 0x96, 0xa9, 0x34, 0x70, 0x2f,
-	0x81, 0x04, 0xb5, 0x4d, 0xf2, 0x19, 0x2c, 0xe2, 0xd0, 0xd1, 0x86, 0x37, 0x69, 0xa0, 0x5b, 0xb6,
-	0xaf, 0xdc, 0xca, 0x9a, 0x94, 0x08, 0x55, 0x86, 0x1b, 0xad, 0xe7, 0xfa, 0x99, 0xed, 0xea, 0xa6,
-	0xaf, 0x2e, 0x30, 0xfe, 0x0f, 0x25, 0xfb, 0x53, 0xce, 0x4d, 0xbe, 0x80, 0x7a, 0x4a, 0xee, 0xa0,
-	0x6f, 0xea, 0x01, 0x3f, 0xa0, 0x94, 0xd5, 0x31, 0xbd, 0x60, 0x29, 0x21, 0xfb, 0x53, 0x94, 0xc0,
-	0x68, 0x9a, 0xff, 0x02, 0x50, 0xc4, 0xa0, 0x00, 0xaf, 0xe6, 0x65, 0x28, 0xf0, 0xd8, 0xc1, 0x32,
-	0xf1, 0x6e, 0x9e, 0x52, 0x67, 0xf0, 0xbb, 0x6d, 0x32, 0x94, 0xa7, 0x3b, 0x47, 0x34, 0xba, 0x8b,
-	0x67, 0xf0, 0xbb, 0x6d, 0x92, 0x05, 0x98, 0x72, 0x4f, 0x1d, 0xea, 0x89, 0x20, 0x82, 0x7f, 0x90,
-	0x4d, 0xe6, 0xb9, 0x7d, 0xdb, 0x32, 0xb8, 0xd3, 0xea, 0xc6, 0x89, 0x66, 0xd3, 0x21, 0xb5, 0xf1,
-	0x2a, 0x9d, 0x64, 0x7e, 0x19, 0x22, 0xb7, 0x8c, 0x93, 0x67, 0x0c, 0x45, 0xee, 0x03, 0x09, 0x3c,
-	0xdd, 0xf1, 0xbb, 0xd4, 0x8b, 0x31, 0xf0, 0x6b, 0xb3, 0x2a, 0x31, 0x71, 0x6a, 0x3f, 0x70, 0x6d,
-	0xea, 0x68, 0xbe, 0xe5, 0x18, 0x54, 0xf3, 0xa8, 0x43, 0x4f, 0xf1, 0x0a, 0x9d, 0x52, 0xab, 0x1c,
-	0x73, 0xc0, 0x10, 0x2a, 0x83, 0x93, 0x2d, 0x28, 0xc5, 0x2d, 0x37, 0xee, 0xf5, 0x08, 0x83, 0xd0,
-	0x58, 0xe4, 0x13, 0x58, 0xe0, 0x47, 0x65, 0xa8, 0x1b, 0x97, 0x55, 0x18, 0x53, 0x16, 0x3f, 0x68,
-	0xa5, 0xfe, 0x28, 0xf2, 0x29, 0x34, 0x22, 0xd7, 0x73, 0xdc, 0xc0, 0xea, 0x4a, 0x83, 0xc9, 0x18,
-	0xa9, 0x88, 0xb3, 0xbf, 0x11, 0x52, 0xed, 0xc7, 0x88, 0x44, 0xd0, 0x45, 0x7e, 0x99, 0x83, 0xba,
-	0x0c, 0xe6, 0x32, 0x0c, 0x08, 0xab, 0x93, 0x6b, 0xa5, 0xcd, 0x8f, 0x5b, 0x63, 0xa6, 0x52, 0xad,
-	0xd0, 0x21, 0x5a, 0x22, 0x0e, 0x3d, 0x4c, 0x99, 0x7e, 0xd7, 0x09, 0xbc, 0x33, 0x75, 0xc9, 0xc8,
-	0xc6, 0x92, 0x3f, 0xcf, 0xc1, 0x52, 0xa8, 0x4e, 0xd2, 0x60, 0x4a, 0x09, 0x75, 0x79, 0xf6, 0x1a,
-	0xba, 0xc4, 0x6d, 0x88, 0x8a, 0x08, 0xeb, 0x2e, 0x18, 0x19, 0x04, 0xe4, 0x2f, 0x72, 0xb0, 0x2c,
-	0x75, 0x89, 0xfb, 0x23, 0xd7, 0xa6, 0xfc, 0xba, 0x96, 0x51, 0x23, 0x91, 0x19, 0x96, 0x49, 0x63,
-	0x99, 0x65, 0x96, 0xe3, 0x5a, 0x98, 0xf6, 0x97, 0x31, 0xdb, 0xcc, 0xa2, 0x36, 0xfb, 0xaf, 0xa0,
-	0x4d, 0x6c, 0xa0, 0xa7, 0xf6, 0x97, 0xc9, 0x65, 0x5a, 0xf4, 0x32, 0x91, 0xf5, 0x3d, 0xb8, 0x71,
-	0xd1, 0xf2, 0x92, 0x2a, 0x4c, 0x9e, 0xd0, 0x33, 0x91, 0x6a, 0xb0, 0x9f, 0x6c, 0xa3, 0x0f, 0x75,
-	0x7b, 0x40, 0xc5, 0x01, 0xc0, 0x3f, 0x7e, 0x30, 0xf1, 0x7e, 0xae, 0x6e, 0xc0, 0xf2, 0xb9, 0xcb,
-	0x93, 0x21, 0xe8, 0x41, 0x5c, 0xd0, 0x85, 0x3b, 0x27, 0x3e, 0x48, 0xa4, 0x70, 0xa6, 0xd5, 0xaf,
-	0xa4, 0x70, 0x1b, 0x56, 0x2e, 0xb0, 0xd9, 0x55, 0x44, 0x35, 0xff, 0x3e, 0x0f, 0xf3, 0x31, 0x59,
-	0x2c, 0x5c, 0xc2, 0xc3, 0x34, 0x7d, 0xab, 0xe4, 0x32, 0x6f, 0x15, 0x99, 0x88, 0xca, 0x73, 0xb5,
-	0xa8, 0x82, 0x04, 0xb5, 0x4d, 0x72, 0x1d, 0xa6, 0xbd, 0x81, 0x13, 0x25, 0x68, 0x53, 0xde, 0xc0,
-	0x69, 0x9b, 0x64, 0x07, 0x30, 0xb6, 0xc2, 0xeb, 0x16, 0xcf, 0xd3, 0xb9, 0xcd, 0x37, 0x33, 0xbd,
-	0x06, 0x53, 0x58, 0xe6, 0x2a, 0x4c, 0x2b, 0x76, 0xf3, 0xaa, 0x85, 0x40, 0xfc, 0x8a, 0xe7, 0x61,
-	0x53, 0xc9, 0x3c, 0xec, 0x0e, 0xcc, 0x75, 0x2d, 0xcf, 0x0f, 0x44, 0x0e, 0x66, 0x99, 0x78, 0xa8,
-	0x4e, 0xaa, 0x65, 0x84, 0x62, 0xba, 0xd1, 0x36, 0x49, 0x13, 0x66, 0x1d, 0xfa, 0x55, 0x8c, 0x68,
-	0x86, 0xe7, 0xb2, 0x0c, 0x28, 0x69, 0x6e, 0x43, 0x39, 0x4a, 0xa4, 0x44, 0x42, 0x31, 0xa9, 0x86,
-	0x57, 0x29, 0xbb, 0x58, 0x5a, 0x30, 0xcf, 0x25, 0xb0, 0xcc, 0x86, 0x26, 0x8e, 0xbd, 0x29, 0xb5,
-	0x86, 0xa8, 0x03, 0x86, 0x91, 0x67, 0xdd, 0x1f, 0xc0, 0x8a, 0x43, 0x4f, 0x35, 0x66, 0x96, 0x2c,
-	0x3e, 0x40, 0xbe, 0x25, 0x87, 0x9e, 0xaa, 0x03, 0x67, 0x77, 0x84, 0xfb, 0x36, 0x94, 0x3b, 0x9e,
-	0xee, 0x18, 0xc7, 0x5a, 0xe0, 0x9e, 0x50, 0x07, 0xf3, 0x86, 0xb2, 0x5a, 0xe2, 0xb0, 0x43, 0x06,
-	0x22, 0xeb, 0xb0, 0x20, 0x07, 0x48, 0x90, 0xce, 0x22, 0x69, 0x8d, 0x4b, 0xde, 0x8e, 0x31, 0x2c,
-	0xc1, 0x0c, 0xae, 0x46, 0x18, 0x63, 0x4f, 0xb3, 0xcf, 0xb6, 0xb9, 0x97, 0x2f, 0x94, 0xab, 0xb3,
-	0x7b, 0xf9, 0xc2, 0x5c, 0xb5, 0xd2, 0xfc, 0xdb, 0x3c, 0xcc, 0x1e, 0xca, 0x70, 0xfa, 0xf7, 0xc2,
-	0x3f, 0x76, 0xa1, 0x2c, 0x72, 0x16, 0x2e, 0x67, 0x0a, 0xe5, 0x34, 0x93, 0x71, 0x4c, 0x24, 0x80,
-	0x93, 0xa2, 0x8c, 0x52, 0x10, 0x7d, 0x10, 0x0a, 0xd7, 0xc3, 0x39, 0xc8, 0x70, 0x13, 0xe5, 0x4d,
-	0xa3, 0xbc, 0x8d, 0x8b, 0xf5, 0x7a, 0x21, 0x58, 0x45, 0x20, 0x8a, 0xe2, 0xe7, 0x4f, 0x47, 0x81,
-	0x71, 0x6f, 0x9e, 0x49, 0x7a, 0x33, 0xcb, 0x3d, 0x64, 0xe8, 0x26, 0xb3, 0x97, 0x02, 0xcf, 0x6f,
-	0x24, 0x5c, 0x84, 0xdb, 0x2c, 0xc8, 0x09, 0xbd, 0x99, 0xdf, 0xbb, 0x33, 0x54, 0x78, 0x72, 0x6c,
-	0x91, 0x21, 0xbe, 0xc8, 0xa4, 0x0d, 0x95, 0xa1, 0xe5, 0x5b, 0x1d, 0xcb, 0x66, 0x49, 0x33, 0xc6,
-	0x03, 0xa5, 0x31, 0xe3, 0x81, 0xb9, 0x88, 0x11, 0x83, 0xb1, 0xff, 0xc8, 0x43, 0x55, 0x9e, 0xc5,
-	0xbf, 0x37, 0x6e, 0xd2, 0x82, 0xf9, 0x40, 0xf7, 0x8e, 0x68, 0xa0, 0x25, 0xd4, 0x9c, 0xc2, 0x81,
-	0x6a, 0x1c, 0xb5, 0x1f, 0x53, 0x96, 0xc5, 0x78, 0x9c, 0x3e, 0xae, 0xf3, 0x34, 0x92, 0x57, 0x39,
-	0xe6, 0x45, 0xa4, 0x79, 0x13, 0x66, 0x05, 0xb5, 0x98, 0xc0, 0x0c, 0x9f, 0x3e, 0x07, 0xaa, 0x38,
-	0x8d, 0x64, 0xee, 0x59, 0x48, 0xe7, 0x9e, 0x4f, 0xa0, 0x2e, 0x44, 0x18, 0xc7, 0x96, 0x6d, 0x46,
-	0xc3, 0xba, 0x8e, 0x7d, 0x86, 0xcb, 0x5c, 0x50, 0x97, 0x38, 0xc5, 0x0e, 0x23, 0x90, 0xa3, 0x7f,
-	0xec, 0xd8, 0x67, 0xe9, 0xb8, 0x1f, 0x46, 0xe2, 0xfe, 0x98, 0xdf, 0x95, 0x92, 0x7e, 0x17, 0xf3,
-	0x98, 0xf2, 0x65, 0x1e, 0x33, 0xfb, 0x6a, 0x1e, 0x43, 0xde, 0x81, 0x9a, 0x47, 0x0d, 0xd7, 0x33,
-	0xb5, 0x08, 0x21, 0x8a, 0x02, 0x55, 0x8e, 0xf8, 0x2c, 0x84, 0x37, 0x07, 0x40, 0x44, 0xfd, 0x88,
-	0x9f, 0x5e, 0x2a, 0x8b, 0xdf, 0xc9, 0x0a, 0x14, 0xc5, 0x31, 0x17, 0x3a, 0x57, 0x81, 0x03, 0xb8,
-	0xf9, 0x3b, 0xf4, 0xc8, 0x72, 0x34, 0xc7, 0x35, 0x63, 0xa1, 0x7f, 0x09, 0x81, 0xfb, 0xae, 0xc9,
-	0x2c, 0xd0, 0x80, 0x12, 0x75, 0xcc, 0x90, 0x62, 0x92, 0x17, 0xb9, 0xa8, 0x63, 0x72, 0x7c, 0xf3,
-	0x6f, 0x72, 0x30, 0x9b, 0x18, 0x17, 0x2d, 0xe3, 0xd1, 0x98, 0x37, 0x4f, 0xb3, 0xcf, 0xb6, 0x99,
-	0xd4, 0x65, 0x22, 0xa5, 0xcb, 0x9f, 0x40, 0x51, 0x77, 0x0c, 0xca, 0x04, 0xf9, 0xca, 0x24, 0x86,
-	0x4a, 0x4f, 0xc6, 0x0e, 0x95, 0x46, 0x27, 0xae, 0x46, 0xd2, 0x9a, 0xff, 0x9c, 0x83, 0x8a, 0xa0,
-	0x38, 0x64, 0x9a, 0xb0, 0x7d, 0xf7, 0x02, 0x4a, 0x52, 0x17, 0xa7, 0xeb, 0xa2, 0xa2, 0xa5, 0xcd,
-	0x47, 0xaf, 0x38, 0x20, 0x88, 0x59, 0x30, 0xc1, 0x3f, 0x84, 0x62, 0xd7, 0xf5, 0x4e, 0xf8, 0xc2,
-	0x4f, 0x8c, 0xb9, 0xf0, 0x05, 0xc6, 0x82, 0x4b, 0x4e, 0x20, 0x8f, 0x0a, 0xf1, 0x9d, 0x8c, 0xbf,
-	0x9b, 0xff, 0x9a, 0x83, 0x22, 0x5e, 0x2e, 0x97, 0x14, 0x58, 0x93, 0xe5, 0xc8, 0x89, 0x74, 0x39,
-	0x72, 0x0b, 0x4a, 0x58, 0x66, 0x10, 0x4e, 0x39, 0x39, 0x6e, 0x8a, 0xc4, 0x99, 0x64, 0x01, 0x31,
-	0x5e, 0x47, 0xe2, 0xb9, 0x1e, 0x6e, 0x4f, 0x51, 0x42, 0x5a, 0x86, 0x02, 0x4f, 0x09, 0xc2, 0x33,
-	0x62, 0x06, 0xbf, 0xdb, 0x66, 0xf3, 0x97, 0x13, 0x50, 0xf8, 0xff, 0x38, 0xf6, 0x52, 0x7b, 0x3a,
-	0x3f, 0xb2, 0xa7, 0xb7, 0xa0, 0x64, 0x78, 0x34, 0x4c, 0x15, 0xa7, 0xc6, 0xb5, 0x03, 0x67, 0x42,
-	0x3b, 0xa4, 0x4c, 0x39, 0x7d, 0x75, 0x53, 0x36, 0x7d, 0xa8, 0x6d, 0xd9, 0xb6, 0x6b, 0xe8, 0x01,
-	0x35, 0x43, 0xb3, 0xec, 0x42, 0xde, 0xd4, 0x03, 0x5d, 0xb8, 0xe3, 0xc6, 0xd8, 0xee, 0x28, 0x05,
-	0xa8, 0xc8, 0x1e, 0x3f, 0x9b, 0x26, 0xe2, 0x67, 0x53, 0xf3, 0x37, 0x13, 0x30, 0x7b, 0x28, 0x8f,
-	0xce, 0x71, 0x17, 0x82, 0x40, 0x1e, 0xbb, 0x12, 0x7c, 0x05, 0xf0, 0x37, 0xd9, 0x8a, 0xdf, 0x2d,
-	0x93, 0x78, 0xb7, 0xdc, 0x39, 0x2f, 0x74, 0x90, 0xe3, 0xa5, 0x6e, 0x96, 0xf7, 0x21, 0x7f, 0x62,
-	0x39, 0xa6, 0xb8, 0x99, 0x2e, 0xe5, 0xfe, 0x63, 0xcb, 0x31, 0x55, 0xe4, 0x60, 0xe7, 0x48, 0xba,
-	0x7c, 0x50, 0xd0, 0x65, 0x46, 0xf8, 0xfa, 0x4b, 0x43, 0xf6, 0xa0, 0x8a, 0x45, 0x99, 0x57, 0x29,
-	0x28, 0xcc, 0x31, 0xce, 0x58, 0x05, 0xe6, 0x17, 0x13, 0x00, 0x07, 0xd6, 0x91, 0xa3, 0xdb, 0x97,
-	0x77, 0x47, 0x78, 0xa1, 0x33, 0x38, 0xb7, 0x3b, 0x12, 0xe2, 0x13, 0xdd, 0x91, 0x64, 0xcd, 0x7e,
-	0x32, 0x5d, 0xb3, 0x97, 0xab, 0x97, 0x8f, 0xad, 0xde, 0x23, 0x98, 0xb2, 0x9c, 0xfe, 0x20, 0x10,
-	0xbe, 0x7f, 0x79, 0xf1, 0x8a, 0x93, 0x33, 0xed, 0x0d, 0xd7, 0x09, 0x3c, 0xd7, 0x16, 0x37, 0xba,
-	0xfc, 0x64, 0x6e, 0x14, 0x69, 0x1f, 0x25, 0x0b, 0x21, 0xac, 0x6d, 0x36, 0xff, 0x31, 0x07, 0x35,
-	0x51, 0x97, 0xde, 0xc1, 0x22, 0xf5, 0x77, 0x65, 0x90, 0xcc, 0xf2, 0x38, 0xb7, 0xcb, 0x48, 0x79,
-	0x3c, 0xad, 0x77, 0x7e, 0x54, 0xef, 0xdf, 0xe4, 0x60, 0x51, 0x06, 0x0d, 0x89, 0x76, 0x1f, 0xc5,
-	0x91, 0xf8, 0x49, 0x12, 0x1b, 0x29, 0x27, 0x46, 0x42, 0x44, 0x34, 0x52, 0x74, 0x5a, 0x4d, 0xc4,
-	0x4f, 0xab, 0x3d, 0x98, 0x62, 0x87, 0xa9, 0xdc, 0x44, 0xef, 0x8e, 0x17, 0x2f, 0x27, 0xf5, 0x50,
-	0xb9, 0x08, 0xf2, 0x01, 0x4c, 0xc7, 0x0e, 0xe6, 0xb9, 0xcd, 0xd6, 0x39, 0x7b, 0x2a, 0x53, 0xca,
-	0xc0, 0x57, 0x05, 0x77, 0xf3, 0x2f, 0x57, 0xe0, 0xfa, 0x08, 0xcd, 0xef, 0xec, 0xd8, 0x6e, 0xc1,
-	0x7c, 0x5f, 0xf7, 0xd8, 0x72, 0x26, 0x44, 0xf1, 0x05, 0xaa, 0x71, 0x54, 0x2a, 0xa2, 0x14, 0xf4,
-	0x71, 0xb9, 0xdc, 0x9d, 0xab, 0x1c, 0x93, 0x8c, 0x28, 0x05, 0xb5, 0xb0, 0x36, 0xbf, 0x85, 0x4a,
-	0x1c, 0xc8, 0x23, 0xca, 0xf4, 0xa2, 0x4f, 0x8f, 0x2c, 0x3a, 0xf9, 0x3e, 0x2c, 0x1b, 0x6e, 0xaf,
-	0x6f, 0x53, 0xac, 0xe3, 0xa4, 0xbc, 0x8f, 0x3b, 0xf7, 0x62, 0x44, 0x90, 0x70, 0xbf, 0xe7, 0x50,
-	0x4d, 0xb3, 0x8a, 0x12, 0xe2, 0x98, 0x8d, 0xbf, 0x4a, 0x4a, 0x70, 0x2a, 0x02, 0x2e, 0xa6, 0x23,
-	0xe0, 0xfb, 0x40, 0x42, 0xcb, 0xb0, 0xf3, 0x98, 0xf7, 0x90, 0x81, 0x1b, 0x48, 0x62, 0xd8, 0x91,
-	0x8b, 0x8d, 0xe4, 0x2f, 0xa0, 0x1e, 0x52, 0x53, 0xb9, 0xb8, 0x57, 0x6d, 0xb4, 0x29, 0xa7, 0x69,
-	0xf7, 0x90, 0x6d, 0xac, 0x4f, 0x60, 0x21, 0x14, 0xcf, 0x56, 0xe0, 0x8a, 0x8d, 0xb6, 0x70, 0x26,
-	0xea, 0x20, 0x14, 0xd9, 0x81, 0x9b, 0x26, 0xed, 0xea, 0x03, 0x3b, 0xe6, 0x01, 0xfc, 0xf2, 0xb9,
-	0x5a, 0xcf, 0xad, 0x2e, 0xa4, 0x48, 0x6f, 0xc1, 0x6c, 0x47, 0x8c, 0xf1, 0x86, 0x68, 0xd5, 0x86,
-	0x85, 0x86, 0x39, 0x5e, 0x12, 0x41, 0xa0, 0xac, 0x2e, 0xbc, 0x03, 0x04, 0xef, 0x05, 0xee, 0x0e,
-	0xf2, 0x86, 0xad, 0xf1, 0xc6, 0x1b, 0xc3, 0xe0, 0x72, 0x1d, 0xf2, 0x34, 0xe0, 0x7b, 0x30, 0xcf,
-	0x9b, 0x1f, 0xc9, 0x52, 0x0b, 0xe1, 0xd5, 0x6e, 0x86, 0xfa, 0x20, 0x5e, 0x6e, 0x79, 0x00, 0xd8,
-	0x20, 0xd0, 0xfa, 0x9e, 0x6b, 0x50, 0xdf, 0x0f, 0x5b, 0xc6, 0xf3, 0x48, 0x8f, 0xe3, 0x3e, 0x97,
-	0x28, 0xee, 0x15, 0x7f, 0x24, 0xa2, 0x3d, 0x7e, 0x3f, 0x2d, 0x8c, 0x79, 0x3f, 0xf1, 0x78, 0xf0,
-	0xdc, 0x6b, 0xee, 0xfa, 0xab, 0x5d, 0x73, 0x64, 0x33, 0x56, 0x06, 0x40, 0xc3, 0x48, 0x3b, 0x2e,
-	0xf2, 0x76, 0xc0, 0x69, 0xcc, 0xe6, 0xd2, 0x9c, 0xdf, 0x87, 0xe5, 0x24, 0x4f, 0x3c, 0x6c, 0x5b,
-	0xe2, 0x7b, 0x2c, 0xce, 0x77, 0x10, 0x85, 0x70, 0x8f, 0x41, 0x49, 0xb1, 0x46, 0x71, 0xaf, 0xc2,
-	0xef, 0x86, 0x04, 0x67, 0x18, 0x03, 0x1f, 0xa4, 0xf5, 0x94, 0x3e, 0xb4, 0x3c, 0x66, 0x23, 0xf8,
-	0x34, 0xc3, 0x79, 0x46, 0x26, 0x2f, 0xeb, 0x10, 0x75, 0xac, 0x43, 0x24, 0x78, 0x64, 0x2d, 0x22,
-	0xbe, 0x0d, 0x13, 0x33, 0xc0, 0x65, 0x58, 0x19, 0xb7, 0xf1, 0x93, 0x31, 0x4b, 0x5c, 0x0f, 0x1d,
-	0x6e, 0x64, 0xdb, 0x56, 0x0c, 0x70, 0x63, 0xcc, 0x01, 0x96, 0xb3, 0x16, 0x80, 0x0f, 0x91, 0xd5,
-	0xb0, 0xbe, 0x99, 0xdd, 0xb0, 0xf6, 0xe0, 0x6e, 0x52, 0x1b, 0xd7, 0xb3, 0x8e, 0x2c, 0x47, 0xb7,
-	0xd3, 0x6a, 0x35, 0xc6, 0x54, 0xeb, 0x76, 0x5c, 0xad, 0x8f, 0x85, 0xb0, 0xa4, 0x7a, 0x23, 0x2e,
-	0x12, 0xbb, 0xa2, 0x6f, 0xe1, 0xd9, 0x98, 0x70, 0x91, 0x44, 0xc7, 0x7c, 0x34, 0x7c, 0x58, 0xcd,
-	0x0e, 0x1f, 0xde, 0x86, 0x9a, 0x1f, 0x58, 0xc6, 0xc9, 0x99, 0x16, 0x3b, 0xa0, 0x6f, 0xcb, 0xce,
-	0x37, 0x43, 0x84, 0xf1, 0x2b, 0x39, 0x82, 0x55, 0x41, 0x7b, 0xfe, 0x1b, 0x8a, 0xe6, 0x78, 0x5e,
-	0x78, 0x83, 0x0b, 0x3a, 0xc8, 0x7e, 0x49, 0x11, 0x6b, 0xe3, 0xbf, 0x91, 0x6c, 0xe3, 0x9f, 0xdf,
-	0x52, 0xbf, 0xf3, 0xdd, 0xb4, 0xd4, 0xef, 0x7e, 0x37, 0x2d, 0xf5, 0x37, 0x2f, 0x68, 0xa9, 0x5f,
-	0xd8, 0xfc, 0xbe, 0x77, 0x71, 0xf3, 0xfb, 0xdc, 0x76, 0xfc, 0xda, 0xeb, 0xb4, 0xe3, 0xc7, 0x68,
-	0xa9, 0xbf, 0x75, 0x79, 0x4b, 0x3d, 0xeb, 0xe1, 0xc4, 0xdb, 0x99, 0x0f, 0x27, 0xde, 0x80, 0x59,
-	0xc3, 0x73, 0x9d, 0xd0, 0xcd, 0x94, 0x77, 0xd0, 0x21, 0xcb, 0x0c, 0x28, 0x5d, 0xe6, 0xbc, 0xba,
-	0xfc, 0xfd, 0xf3, 0xea, 0xf2, 0xf7, 0x81, 0x88, 0x28, 0x28, 0x5e, 0x34, 0xff, 0x1e, 0x16, 0xcd,
-	0xab, 0x88, 0x89, 0xd7, 0xcc, 0x6f, 0x43, 0xd9, 0xc7, 0xa4, 0x47, 0x3c, 0x53, 0x6b, 0x89, 0xc6,
-	0x00, 0xc2, 0xf8, 0x03, 0xb5, 0xbb, 0xa9, 0xa7, 0x72, 0xeb, 0x8c, 0x64, 0x7b, 0x42, 0xc9, 0x25,
-	0x9e, 0xcb, 0x91, 0x4f, 0xa0, 0xa6, 0x0f, 0x02, 0x57, 0xf3, 0xa8, 0x4f, 0x03, 0xad, 0xef, 0x5a,
-	0x4e, 0xe0, 0x2b, 0x0f, 0xb3, 0xc2, 0xa9, 0xf0, 0xcd, 0xdf, 0x70, 0xa3, 0xa5, 0x32, 0xea, 0xe7,
-	0x48, 0xac, 0x56, 0x18, 0x7f, 0x0c, 0x40, 0xfe, 0x2c, 0x07, 0x35, 0x9f, 0xea, 0x9e, 0x71, 0xcc,
-	0x3c, 0xca, 0xb3, 0x3a, 0x83, 0x80, 0xfa, 0xca, 0xbb, 0x58, 0x72, 0x3a, 0x1c, 0x3b, 0xe5, 0xce,
-	0x0c, 0x90, 0x5b, 0x07, 0x28, 0x77, 0x2b, 0x14, 0xcb, 0x7b, 0x74, 0x55, 0x3f, 0x05, 0x26, 0x7f,
-	0x0a, 0xf9, 0x1e, 0xed, 0xb9, 0xca, 0x7b, 0x38, 0xea, 0x87, 0xaf, 0x39, 0xea, 0x47, 0xb4, 0xe7,
-	0xf2, 0x91, 0x50, 0x2a, 0xf9, 0x02, 0x6a, 0xf2, 0x75, 0x1f, 0xb7, 0xa5, 0x45, 0x7d, 0xe5, 0x11,
-	0x1a, 0xed, 0x41, 0xe6, 0x50, 0xb1, 0x50, 0x54, 0x2c, 0xf8, 0x87, 0x92, 0x4f, 0xad, 0x0e, 0x53,
-	0x10, 0xf2, 0x10, 0x16, 0x45, 0x54, 0x13, 0xc6, 0x8f, 0x22, 0xd8, 0x7e, 0x8c, 0x9e, 0x36, 0xcf,
-	0x1b, 0x49, 0x12, 0xc9, 0x83, 0xee, 0x9f, 0x42, 0x25, 0x22, 0x67, 0x89, 0x86, 0xaf, 0xbc, 0x8f,
-	0x1a, 0x3d, 0x1e, 0x7b, 0xf2, 0xc9, 0xc7, 0x96, 0xea, 0x1c, 0x4d, 0x7c, 0xd7, 0x4d, 0xb8, 0x9e,
-	0x69, 0xfe, 0x8c, 0x76, 0xdf, 0x7b, 0xc9, 0x0e, 0xe5, 0xad, 0x4b, 0x12, 0xe0, 0x78, 0x6b, 0xf1,
-	0x27, 0x50, 0x0c, 0xcd, 0xfd, 0x3b, 0x95, 0xbc, 0x97, 0x2f, 0x54, 0xaa, 0xd5, 0xbd, 0x7c, 0xa1,
-	0x5a, 0xad, 0xed, 0xe5, 0x0b, 0x0f, 0xaa, 0x1b, 0x7b, 0xf9, 0xc2, 0x46, 0x75, 0x73, 0x2f, 0x5f,
-	0xd8, 0xac, 0x3e, 0x6c, 0xfe, 0x3c, 0x07, 0x85, 0x9d, 0x63, 0x6a, 0x9c, 0xf8, 0x83, 0x5e, 0x3a,
-	0x6b, 0x9e, 0x8a, 0xb2, 0xe6, 0xa7, 0x30, 0xdd, 0xb5, 0xf5, 0xa1, 0xeb, 0xa1, 0x02, 0x73, 0x9b,
-	0xf7, 0x2f, 0x4e, 0x28, 0xa5, 0xc4, 0x0f, 0x90, 0x47, 0x15, 0xbc, 0x51, 0x3b, 0x74, 0x12, 0x37,
-	0x38, 0xff, 0x68, 0xfe, 0x6f, 0x1e, 0x08, 0xd6, 0xd0, 0x93, 0x49, 0xe1, 0x77, 0x53, 0xd3, 0x88,
-	0x45, 0x74, 0x93, 0xe9, 0x4a, 0xe6, 0x3e, 0x54, 0x52, 0x72, 0xc5, 0x0b, 0xce, 0x71, 0x1f, 0x84,
-	0x26, 0x47, 0x65, 0x87, 0xa1, 0x1c, 0x2e, 0x9e, 0x63, 0x8a, 0x26, 0x87, 0x40, 0xc5, 0x92, 0xcc,
-	0x3b, 0x30, 0x27, 0xe9, 0x85, 0xe3, 0xf3, 0x72, 0x88, 0x7c, 0xa2, 0xa9, 0x8a, 0xd4, 0x3e, 0xf5,
-	0xfc, 0x73, 0xe6, 0xd5, 0x9f, 0x7f, 0x66, 0x56, 0x1a, 0x0a, 0xd9, 0x95, 0x86, 0x1b, 0x50, 0x0c,
-	0x33, 0x6b, 0x99, 0x2d, 0x86, 0x80, 0x2b, 0x66, 0x8b, 0x3f, 0x09, 0x93, 0x75, 0xfe, 0x6e, 0x52,
-	0x5c, 0x3c, 0x25, 0xf4, 0xad, 0xb5, 0x73, 0xea, 0x0b, 0xcf, 0x91, 0x03, 0xdf, 0x4a, 0xf2, 0x2b,
-	0x49, 0xa6, 0xf5, 0x31, 0xd0, 0x48, 0x12, 0x5e, 0x1e, 0xad, 0xbc, 0xfc, 0x22, 0x0f, 0x95, 0xb0,
-	0x12, 0xc0, 0x5f, 0x4c, 0x91, 0x3d, 0x51, 0x1f, 0xbf, 0x6a, 0xc1, 0x3e, 0xaa, 0x28, 0x60, 0x99,
-	0x94, 0xc9, 0x20, 0xcf, 0x61, 0xda, 0x70, 0x9d, 0xae, 0x75, 0x24, 0x36, 0xeb, 0xfb, 0x57, 0x97,
-	0xb6, 0x83, 0xfc, 0xaa, 0x90, 0x43, 0x3c, 0x20, 0xf1, 0xf7, 0x1f, 0x42, 0x3a, 0xaf, 0xb4, 0xef,
-	0x5c, 0x5d, 0x7a, 0xec, 0xdd, 0x81, 0x18, 0xa8, 0xe6, 0xa5, 0x41, 0xe4, 0x2e, 0xcc, 0xf1, 0x71,
-	0xc2, 0x4b, 0x9c, 0x17, 0xb1, 0x66, 0x39, 0x54, 0x5e, 0xe0, 0xdb, 0x70, 0xb3, 0xab, 0x5b, 0xb6,
-	0x3b, 0xa4, 0x5e, 0xf6, 0x4b, 0x24, 0x5e, 0x48, 0x5d, 0x91, 0x44, 0x59, 0x0f, 0x91, 0xde, 0x82,
-	0x6a, 0x28, 0x43, 0xb2, 0xf1, 0xe2, 0x49, 0x45, 0xc2, 0x25, 0xe9, 0x33, 0xa8, 0x85, 0xa4, 0xd4,
-	0xb9, 0xe2, 0xa3, 0xe5, 0x50, 0xda, 0xae, 0x83, 0xc1, 0x7c, 0xf3, 0x9f, 0x26, 0x60, 0x36, 0xb1,
-	0x82, 0x64, 0x0e, 0x26, 0xc2, 0xfa, 0xd3, 0x84, 0x65, 0x92, 0x27, 0xb2, 0x8e, 0xc6, 0x8f, 0xbd,
-	0xbb, 0xe7, 0xb8, 0x66, 0x28, 0x24, 0x51, 0x38, 0x93, 0x35, 0xd2, 0xc9, 0x58, 0x8d, 0x74, 0x15,
-	0x4a, 0x26, 0xf5, 0x0d, 0xcf, 0xea, 0x07, 0xd2, 0xa6, 0x45, 0x35, 0x0e, 0x8a, 0x1e, 0xc6, 0x4d,
-	0xc5, 0x1f, 0xc6, 0x1d, 0x8a, 0x12, 0xfe, 0x34, 0xde, 0xec, 0x3f, 0x7a, 0x35, 0x07, 0x6d, 0x3d,
-	0xd5, 0x03, 0x5d, 0xdc, 0xe8, 0x4c, 0x5a, 0xfd, 0x31, 0x14, 0x43, 0xd0, 0x65, 0xcf, 0x57, 0x8a,
-	0xf1, 0xe7, 0x2b, 0xc7, 0x50, 0x3f, 0xdf, 0x9d, 0xd8, 0xc1, 0x87, 0xaf, 0xbf, 0xa9, 0x96, 0xf1,
-	0xff, 0x83, 0x1a, 0x47, 0xed, 0xc4, 0xfe, 0x85, 0x50, 0x87, 0x82, 0x20, 0xf4, 0x95, 0x09, 0x8c,
-	0x59, 0xc3, 0xef, 0xe6, 0xff, 0x4c, 0xc6, 0x76, 0xab, 0x90, 0xff, 0x43, 0x28, 0x7a, 0x34, 0xa0,
-	0x4e, 0x20, 0x2f, 0x87, 0x31, 0x92, 0x81, 0x88, 0x83, 0xdc, 0x83, 0x0a, 0xbb, 0xcf, 0xad, 0xa1,
-	0x6e, 0x6b, 0x9d, 0x81, 0x71, 0x42, 0x03, 0x31, 0xc1, 0x39, 0x09, 0xde, 0x46, 0x28, 0x69, 0x43,
-	0xb9, 0xa3, 0x9b, 0x5a, 0xc7, 0x72, 0x74, 0x8c, 0x75, 0xf8, 0x8e, 0x7b, 0x33, 0xe9, 0x04, 0xd1,
-	0x7f, 0x6b, 0x86, 0x1b, 0xad, 0x6d, 0xdd, 0xdc, 0x16, 0xd4, 0x6a, 0xa9, 0x13, 0x7d, 0x90, 0xcf,
-	0x61, 0x51, 0xc6, 0xa5, 0xe1, 0xd8, 0xdc, 0xb3, 0x2e, 0x6e, 0x54, 0x6c, 0x09, 0x62, 0xee, 0x58,
-	0x0b, 0x42, 0x46, 0x02, 0x4a, 0x1e, 0xc0, 0xc2, 0x88, 0xec, 0x81, 0x67, 0x09, 0x07, 0x22, 0x29,
-	0x9e, 0x4f, 0x3d, 0x8b, 0xfc, 0x14, 0x96, 0x63, 0xcd, 0xe4, 0x94, 0x42, 0xd3, 0x57, 0x50, 0x68,
-	0x29, 0x12, 0x93, 0xd4, 0xe9, 0x11, 0x2c, 0x65, 0x8d, 0xc0, 0xd4, 0xe2, 0xcd, 0xf8, 0xeb, 0xa3,
-	0x9c, 0x9f, 0x7a, 0x56, 0xf3, 0xef, 0x72, 0x89, 0x77, 0x51, 0x62, 0xdf, 0xfb, 0xe4, 0x47, 0xe9,
-	0x4a, 0x1a, 0x5f, 0xf6, 0x95, 0x91, 0x65, 0x6f, 0x3b, 0xc1, 0xa3, 0x77, 0x3f, 0x63, 0x8e, 0x9a,
-	0x2a, 0xb3, 0xb5, 0x45, 0x99, 0xed, 0xd4, 0xb3, 0x82, 0x28, 0x33, 0x99, 0xb8, 0x5c, 0x0c, 0x96,
-	0xb3, 0x5e, 0x30, 0x2e, 0x21, 0x6a, 0x3b, 0xf8, 0xfa, 0x9b, 0xc6, 0xb5, 0x5f, 0x7f, 0xd3, 0xb8,
-	0xf6, 0xdb, 0x6f, 0x1a, 0xb9, 0x9f, 0xbf, 0x6c, 0xe4, 0xfe, 0xe1, 0x65, 0x23, 0xf7, 0x6f, 0x2f,
-	0x1b, 0xb9, 0xaf, 0x5f, 0x36, 0x72, 0xff, 0xf5, 0xb2, 0x91, 0xfb, 0xef, 0x97, 0x8d, 0x6b, 0xbf,
-	0x7d, 0xd9, 0xc8, 0xfd, 0xea, 0xdb, 0xc6, 0xb5, 0xaf, 0xbf, 0x6d, 0x5c, 0xfb, 0xf5, 0xb7, 0x8d,
-	0x6b, 0x9f, 0xff, 0xe1, 0x91, 0x1b, 0xd9, 0xd4, 0x72, 0x2f, 0xf9, 0x47, 0xdb, 0x93, 0x34, 0xac,
-	0x33, 0x8d, 0xca, 0x3d, 0xfc, 0xbf, 0x00, 0x00, 0x00, 0xff, 0xff, 0xee, 0x3d, 0x54, 0x31, 0x14,
-	0x37, 0x00, 0x00,
+	// 4086 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xd5, 0x1b, 0x4d, 0x6f, 0x1b, 0xc7,
+	0x35, 0xa4, 0x28, 0x8a, 0x7c, 0x94, 0xf8, 0xb1, 0xfa, 0xa2, 0x3e, 0x2c, 0xdb, 0x8c, 0xbf, 0x12,
+	0x3b, 0x94, 0x2d, 0x3b, 0xb6, 0x13, 0x37, 0x6d, 0x2c, 0x59, 0xae, 0xe5, 0x3a, 0x8a, 0xb3, 0x52,
+	0xec, 0x34, 0x68, 0xc0, 0x2e, 0xc9, 0x91, 0xb4, 0x10, 0xc5, 0x65, 0x76, 0x97, 0x92, 0xdd, 0x53,
+	0x7a, 0x0a, 0x8a, 0xe6, 0x90, 0x63, 0x81, 0x5e, 0xfa, 0x81, 0x02, 0xfd, 0x03, 0x45, 0x4f, 0x05,
+	0x0a, 0xf4, 0xd2, 0x43, 0x0f, 0x39, 0xa6, 0x40, 0x81, 0x36, 0xe9, 0xa5, 0x87, 0x16, 0xe9, 0x4f,
+	0xe8, 0x9b, 0x37, 0x33, 0xfb, 0xc5, 0x95, 0x44, 0xc9, 0x71, 0x81, 0x1c, 0xe4, 0x70, 0xdf, 0xbc,
+	0xf7, 0xe6, 0xcd, 0x9b, 0x37, 0xf3, 0xbe, 0x26, 0xf0, 0xaa, 0xcb, 0x76, 0x3a, 0x96, 0x6d, 0xb4,
+	0xe6, 0x1d, 0x66, 0xef, 0x32, 0x7b, 0xde, 0xe8, 0x98, 0xf3, 0x1d, 0x66, 0x3b, 0xa6, 0xe3, 0xb2,
+	0x76, 0x83, 0xd5, 0x5b, 0x56, 0xdd, 0x99, 0xdf, 0xbd, 0x32, 0xbf, 0xc3, 0x1c, 0xc7, 0xd8, 0x64,
+	0xd5, 0x8e, 0x6d, 0xb9, 0x96, 0x76, 0x5e, 0x91, 0x55, 0x05, 0x59, 0x15, 0xc9, 0xaa, 0x51, 0xb2,
+	0xea, 0xee, 0x95, 0xe9, 0xb9, 0x4d, 0xcb, 0xda, 0x6c, 0xb1, 0x79, 0x22, 0xab, 0x77, 0x37, 0xe6,
+	0x9b, 0x5d, 0xdb, 0x70, 0x4d, 0xab, 0x2d, 0x18, 0x4d, 0x9f, 0x8c, 0x8e, 0xbb, 0x26, 0xce, 0xe4,
+	0x1a, 0x3b, 0x1d, 0x89, 0xd0, 0xc3, 0x60, 0xcf, 0x36, 0x3a, 0x7c, 0x26, 0x39, 0x7e, 0xba, 0xc9,
+	0x3a, 0xac, 0xdd, 0xc4, 0x49, 0x4d, 0xe6, 0xcc, 0x6f, 0x5a, 0x9b, 0x16, 0xc1, 0xe9, 0x97, 0x44,
+	0x39, 0xe3, 0xad, 0x91, 0x2f, 0xae, 0x61, 0xed, 0xec, 0x58, 0xed, 0x9e, 0x25, 0x45, 0xb0, 0x58,
+	0xbb, 0xbb, 0x43, 0xeb, 0xde, 0xb3, 0xec, 0xed, 0x8d, 0x96, 0xb5, 0x27, 0xb1, 0xce, 0xc6, 0x63,
+	0xb5, 0x0d, 0x64, 0xd6, 0x31, 0x1a, 0x8a, 0xd9, 0xf9, 0x10, 0x9a, 0x37, 0xda, 0x3b, 0xeb, 0xb9,
+	0x78, 0x7e, 0xae, 0xe1, 0x6c, 0xd7, 0x3e, 0xec, 0xb2, 0x2e, 0x8b, 0x9d, 0x77, 0xc3, 0x30, 0x5b,
+	0x5d, 0x3b, 0x86, 0x5d, 0x18, 0x6d, 0x0b, 0x77, 0xc3, 0xb2, 0x9f, 0x1e, 0x36, 0xab, 0x5a, 0xe2,
+	0x61, 0xec, 0x76, 0xf9, 0xfe, 0xc6, 0xa9, 0xee, 0xa5, 0x38, 0x23, 0xf2, 0xd6, 0x22, 0x14, 0x2e,
+	0x51, 0x2f, 0x1e, 0x88, 0x1a, 0x51, 0xf6, 0xf9, 0x03, 0x91, 0xb9, 0x8e, 0x24, 0xe2, 0xa5, 0x38,
+	0xc4, 0xfd, 0x56, 0x5f, 0xb9, 0x0a, 0xf9, 0xe5, 0x27, 0xac, 0xd1, 0xe5, 0x66, 0xb8, 0xe6, 0x1a,
+	0xae, 0xa3, 0x9d, 0x86, 0x61, 0x89, 0x5d, 0x73, 0xcc, 0x1f, 0xb1, 0x72, 0xe2, 0x54, 0xe2, 0xc2,
+	0x80, 0x9e, 0x93, 0xb0, 0x35, 0x04, 0x55, 0xfe, 0x92, 0x80, 0xc2, 0x52, 0xab, 0x8b, 0xe6, 0x6d,
+	0xbf, 0xc5, 0x5c, 0xa3, 0x69, 0xb8, 0x06, 0x27, 0x6b, 0x08, 0x50, 0x8d, 0x6f, 0x31, 0x91, 0x65,
+	0xf5, 0x9c, 0x84, 0xad, 0x22, 0x48, 0xab, 0xc2, 0xa8, 0xc7, 0x79, 0xcb, 0xb0, 0x9b, 0xb5, 0x86,
+	0xd5, 0x6d, 0xbb, 0xe5, 0x24, 0x62, 0x0e, 0xea, 0x25, 0x35, 0x01, 0x1f, 0x59, 0xe2, 0x03, 0xda,
+	0x09, 0x00, 0xc5, 0xd2, 0x6c, 0x96, 0x07, 0x88, 0x61, 0x56, 0x42, 0x56, 0x9a, 0xda, 0x77, 0x61,
+	0x58, 0xee, 0x42, 0xcd, 0x6c, 0x6f, 0x58, 0xe5, 0x14, 0x22, 0xe4, 0x16, 0xce, 0x54, 0xbd, 0xe3,
+	0xc8, 0xcf, 0xa1, 0xc4, 0xc0, 0xe3, 0x57, 0x7d, 0x24, 0x7e, 0xae, 0x20, 0xae, 0x9e, 0xdb, 0xf5,
+	0x3f, 0x2a, 0x3f, 0x2f, 0xc0, 0xf0, 0xed, 0x86, 0x6b, 0xee, 0x9a, 0xee, 0x53, 0x0e, 0xd0, 0xca,
+	0x30, 0x24, 0xc7, 0xe5, 0xea, 0xd5, 0xa7, 0x76, 0x03, 0xca, 0x4e, 0x63, 0x8b, 0x35, 0xbb, 0x2d,
+	0xd6, 0xac, 0xb1, 0x5d, 0xd6, 0x76, 0x6b, 0x75, 0xc3, 0x6d, 0x6c, 0x71, 0x01, 0x93, 0x84, 0x3a,
+	0xee, 0x8d, 0x2f, 0xf3, 0xe1, 0x45, 0x3e, 0x8a, 0xc2, 0xae, 0x42, 0x21, 0x42, 0x48, 0x0b, 0xca,
+	0x2d, 0x9c, 0x0d, 0xcb, 0x2b, 0xb5, 0xc0, 0xe5, 0xbd, 0x27, 0x7e, 0x12, 0x1b, 0x3d, 0x1f, 0x66,
+	0x8b, 0x8b, 0xf7, 0x21, 0x35, 0x7e, 0x4f, 0xc8, 0xe5, 0x4f, 0x57, 0xc5, 0x1d, 0x51, 0x55, 0x77,
+	0x44, 0x75, 0x5d, 0x5d, 0x22, 0x8b, 0xa9, 0x4f, 0xff, 0x7e, 0x32, 0xa1, 0x8f, 0x78, 0x74, 0x7c,
+	0x84, 0x2b, 0x19, 0x47, 0x6d, 0x17, 0xd9, 0xe0, 0x1a, 0x06, 0x69, 0x0d, 0x59, 0x09, 0x41, 0xb9,
+	0xef, 0xc3, 0x88, 0x1a, 0x16, 0x52, 0xa7, 0x8f, 0x22, 0xf5, 0xb0, 0xa4, 0x15, 0x32, 0x2f, 0x81,
+	0xfa, 0x16, 0x12, 0x0f, 0xf5, 0x29, 0x71, 0x4e, 0x52, 0x91, 0xbc, 0x27, 0x21, 0x67, 0xc8, 0xbd,
+	0xe2, 0x02, 0x67, 0xc8, 0x2a, 0x40, 0x81, 0x50, 0x62, 0x5c, 0x90, 0xcd, 0xf0, 0xba, 0x70, 0x5c,
+	0x3e, 0x9e, 0x15, 0x56, 0x23, 0x21, 0x38, 0xfc, 0x3e, 0x4c, 0x29, 0x05, 0xd4, 0x5c, 0xab, 0x46,
+	0xac, 0x49, 0x1c, 0xab, 0xeb, 0x96, 0x81, 0x24, 0x9a, 0xea, 0x91, 0xe8, 0x8e, 0xbc, 0xa8, 0x17,
+	0x53, 0x3f, 0xe3, 0x02, 0x4d, 0x28, 0x0e, 0xeb, 0xd6, 0x1a, 0xa7, 0x5f, 0x17, 0xe4, 0x51, 0xde,
+	0x8d, 0x96, 0xe5, 0x30, 0x8f, 0x77, 0xee, 0xc8, 0xbc, 0x97, 0x38, 0xbd, 0xe2, 0xbd, 0x0e, 0x13,
+	0x52, 0xd6, 0x28, 0xe3, 0xe1, 0xfe, 0x18, 0x8f, 0x12, 0x79, 0x84, 0xeb, 0x03, 0x28, 0x6d, 0x31,
+	0x04, 0xd7, 0x99, 0xe1, 0x6b, 0x61, 0xa4, 0x3f, 0x86, 0x45, 0x8f, 0x52, 0x71, 0x7b, 0x09, 0x8a,
+	0x0d, 0x03, 0x3d, 0x5e, 0xab, 0x26, 0xf5, 0xcd, 0x9a, 0xe5, 0x3c, 0x32, 0xcb, 0xe8, 0x05, 0x01,
+	0xd7, 0x15, 0x58, 0x7b, 0x19, 0x4a, 0x61, 0x54, 0xbe, 0x59, 0x05, 0xb2, 0xbe, 0x30, 0xee, 0x0a,
+	0xe1, 0x72, 0xd1, 0xec, 0x1a, 0x79, 0x02, 0x5c, 0x86, 0xdb, 0x75, 0xca, 0x45, 0xba, 0x35, 0x0a,
+	0x34, 0xb0, 0x8e, 0xf0, 0x35, 0x02, 0xf3, 0xa3, 0x6b, 0xb8, 0xdc, 0x36, 0xdd, 0x72, 0x89, 0x30,
+	0xd4, 0x27, 0xb7, 0x0b, 0xdf, 0x93, 0x94, 0x35, 0x61, 0x17, 0x1c, 0xf2, 0x0e, 0x07, 0x70, 0xd9,
+	0xfd, 0x73, 0x80, 0xd6, 0x8a, 0xc6, 0x54, 0x1e, 0x25, 0xa4, 0x82, 0x77, 0x1a, 0x04, 0x58, 0xbb,
+	0x00, 0xc5, 0x2d, 0xc3, 0x41, 0xc1, 0x5d, 0xbc, 0xc9, 0x3a, 0x56, 0xcb, 0x6c, 0x3c, 0x2d, 0x8f,
+	0xd1, 0x32, 0xf3, 0x08, 0xd7, 0x39, 0xf8, 0x21, 0x41, 0xb5, 0x77, 0x61, 0x42, 0x60, 0x99, 0x6d,
+	0xd3, 0x35, 0x8d, 0x16, 0xfe, 0x17, 0xef, 0xae, 0x5d, 0xa3, 0x55, 0x1e, 0xef, 0x4f, 0xc7, 0x63,
+	0x44, 0xbe, 0x22, 0xa8, 0x57, 0x24, 0xb1, 0xcf, 0x76, 0xc7, 0x78, 0x62, 0xee, 0x74, 0x77, 0x7c,
+	0xb6, 0x13, 0x47, 0x61, 0xfb, 0x96, 0xa0, 0xf6, 0xd8, 0x5e, 0x8b, 0xb2, 0x95, 0xaa, 0x73, 0xca,
+	0x93, 0xa4, 0xca, 0x10, 0xd5, 0x6d, 0x39, 0x86, 0x86, 0x39, 0x2e, 0xa8, 0xd8, 0x93, 0x8e, 0x29,
+	0x66, 0x11, 0xc7, 0xbb, 0xdc, 0xe7, 0xf1, 0x1e, 0x25, 0xf2, 0x65, 0x8f, 0x9a, 0x8e, 0xf9, 0xeb,
+	0x30, 0x25, 0xb8, 0xd6, 0x8d, 0xc6, 0xb6, 0xb5, 0xb1, 0x81, 0xbe, 0x82, 0x6d, 0x6c, 0x98, 0x18,
+	0xd8, 0xe0, 0x1d, 0x34, 0x85, 0x9c, 0x13, 0xfa, 0x24, 0x21, 0x2c, 0x8a, 0xf1, 0x25, 0x7f, 0x58,
+	0xbb, 0x03, 0x27, 0x05, 0x6d, 0x1b, 0x45, 0xa1, 0x5f, 0x46, 0x1d, 0x4f, 0x24, 0xb3, 0x6d, 0x0b,
+	0x6d, 0xe8, 0x69, 0x87, 0x39, 0xe5, 0xe9, 0x53, 0x03, 0xb8, 0xb3, 0x33, 0x34, 0xb8, 0x6a, 0xb5,
+	0x75, 0x85, 0xb4, 0xcc, 0x71, 0xd6, 0x39, 0x0a, 0xde, 0xd8, 0x9a, 0xe0, 0xd2, 0x32, 0xd0, 0x3a,
+	0x65, 0x94, 0x51, 0x9e, 0xa1, 0x45, 0x9d, 0x0a, 0x5f, 0x7f, 0x72, 0x90, 0x5f, 0x7f, 0x77, 0xc5,
+	0x4f, 0xbd, 0x48, 0xb4, 0x0f, 0x90, 0x54, 0x42, 0xb4, 0x5b, 0x30, 0x1d, 0xe0, 0xc7, 0xbd, 0x3b,
+	0xf9, 0x35, 0x69, 0x6a, 0xb3, 0x64, 0x6a, 0x93, 0x1e, 0xd5, 0x63, 0x1a, 0xf7, 0x4c, 0x0e, 0xbd,
+	0xab, 0x17, 0x38, 0xf1, 0x93, 0x72, 0x42, 0x78, 0x57, 0x0f, 0x86, 0xa7, 0x04, 0x2f, 0x46, 0xef,
+	0xf2, 0x41, 0x8c, 0x39, 0x3a, 0x4b, 0xa0, 0x40, 0x88, 0xf0, 0x08, 0x26, 0x68, 0x6a, 0xff, 0xc0,
+	0x37, 0xd1, 0x79, 0x9b, 0x2d, 0xa7, 0x7c, 0x32, 0x6e, 0x51, 0x32, 0x54, 0xc1, 0x35, 0x3d, 0x34,
+	0x9e, 0xb6, 0x2c, 0xa3, 0xe9, 0xe8, 0x63, 0x9c, 0xfe, 0x9e, 0x22, 0xbf, 0x23, 0xa8, 0xb5, 0x0f,
+	0x60, 0x3a, 0xc2, 0xb7, 0xdb, 0xc1, 0x90, 0x40, 0x5c, 0x50, 0xe5, 0x53, 0x7d, 0x5a, 0xc1, 0x64,
+	0x88, 0xf7, 0xbb, 0xc4, 0x81, 0xe3, 0x54, 0xfe, 0x08, 0x90, 0xa5, 0xa0, 0x80, 0x5c, 0xf3, 0x14,
+	0x64, 0x44, 0xec, 0x80, 0x4b, 0x4c, 0x88, 0x03, 0x4e, 0xdf, 0xb8, 0x3e, 0x1c, 0xb2, 0x8d, 0xf6,
+	0x26, 0xf3, 0x7d, 0xf1, 0x10, 0x7d, 0xe3, 0xd0, 0x18, 0x0c, 0x5a, 0x7b, 0x6d, 0x66, 0xcb, 0x20,
+	0x42, 0x7c, 0x68, 0x0b, 0xdc, 0x72, 0x3b, 0x78, 0x50, 0x85, 0xd1, 0xa2, 0x21, 0xd5, 0x5a, 0xe8,
+	0xe4, 0x5a, 0xe4, 0x4a, 0x07, 0xb8, 0x5d, 0x7a, 0x83, 0xb7, 0x1b, 0xdb, 0x0f, 0xf8, 0x90, 0x76,
+	0x09, 0x34, 0x17, 0xb9, 0x3a, 0x1b, 0xb8, 0x79, 0x3e, 0x81, 0x70, 0x9b, 0x45, 0x35, 0x12, 0xc4,
+	0x46, 0x6f, 0xd8, 0x62, 0x6d, 0x0c, 0xa5, 0xf0, 0x4e, 0x43, 0x63, 0x6c, 0xb3, 0x3d, 0x72, 0xa1,
+	0x83, 0x7a, 0x51, 0x8c, 0xac, 0xf1, 0x01, 0x9d, 0xc3, 0xb5, 0xdb, 0x90, 0x0b, 0x6a, 0xae, 0x5f,
+	0xf7, 0x08, 0x5d, 0x4f, 0x59, 0xda, 0x3b, 0x30, 0x26, 0xae, 0x4a, 0x4f, 0x36, 0xc1, 0x2b, 0xd3,
+	0x27, 0x2f, 0x71, 0xd1, 0x2a, 0xf9, 0x89, 0xe5, 0x1d, 0x98, 0xf3, 0x4d, 0xaf, 0x6d, 0xb9, 0xe6,
+	0x86, 0x52, 0x98, 0x8a, 0x91, 0xb2, 0xb4, 0xfa, 0x59, 0x0f, 0x6b, 0x35, 0x80, 0x24, 0x83, 0x2e,
+	0xed, 0x93, 0x04, 0x4c, 0xab, 0x60, 0x2e, 0x46, 0x81, 0x80, 0xe7, 0x31, 0xb7, 0xf0, 0x76, 0xb5,
+	0xcf, 0x54, 0xaa, 0xea, 0x19, 0x44, 0x55, 0xc6, 0xa1, 0xeb, 0x11, 0xd5, 0x2f, 0xb7, 0xf1, 0x20,
+	0xe9, 0x93, 0x8d, 0xf8, 0x51, 0xed, 0x27, 0x09, 0x98, 0xf4, 0xc4, 0x09, 0x2b, 0x0c, 0x1d, 0x35,
+	0x97, 0xe5, 0xc1, 0x33, 0xc8, 0x12, 0xd4, 0x21, 0x09, 0x22, 0xb5, 0x3b, 0xd6, 0x88, 0x41, 0xd0,
+	0x7e, 0x9a, 0x80, 0x29, 0x25, 0x4b, 0xd0, 0x1e, 0x85, 0x34, 0xc3, 0xcf, 0xaa, 0x19, 0xdd, 0x67,
+	0x19, 0xa3, 0x99, 0xe8, 0x28, 0xd7, 0xcc, 0x54, 0x50, 0x8a, 0x66, 0xeb, 0xc3, 0x80, 0x6e, 0x46,
+	0x48, 0x9a, 0xd5, 0x63, 0x48, 0x13, 0x98, 0xe8, 0x4e, 0xeb, 0xc3, 0xf0, 0x36, 0x4d, 0xd8, 0xb1,
+	0x83, 0xd3, 0xf7, 0x61, 0xf6, 0xa0, 0xed, 0xd5, 0x8a, 0x30, 0xb0, 0xcd, 0x9e, 0xca, 0x54, 0x83,
+	0xff, 0xe4, 0x07, 0x1d, 0x3d, 0x19, 0xfa, 0x77, 0x71, 0x01, 0x88, 0x8f, 0xd7, 0x93, 0x37, 0x13,
+	0xd3, 0x0d, 0x98, 0xda, 0x77, 0x7b, 0x62, 0x18, 0x5d, 0x0e, 0x32, 0x3a, 0xf0, 0xe4, 0x04, 0x27,
+	0xf1, 0x05, 0x8e, 0xd5, 0xfa, 0x91, 0x04, 0x5e, 0x81, 0x99, 0x03, 0x74, 0x76, 0x14, 0x56, 0x95,
+	0x5f, 0xa7, 0x60, 0x34, 0xc0, 0x8b, 0x87, 0x4b, 0x74, 0x99, 0x46, 0xbd, 0x4a, 0x22, 0xd6, 0xab,
+	0xa8, 0x44, 0x54, 0xdd, 0xab, 0x18, 0x6e, 0x2b, 0x10, 0x22, 0x8c, 0x43, 0xda, 0xee, 0xb6, 0xfd,
+	0x04, 0x6d, 0x10, 0xbf, 0x10, 0xbc, 0x04, 0x14, 0x5b, 0x91, 0xbb, 0xa5, 0xfb, 0x34, 0xbf, 0x70,
+	0x2e, 0xd6, 0x6a, 0x28, 0x85, 0xe5, 0xa6, 0xc2, 0xa5, 0xe2, 0x9e, 0x57, 0xcf, 0xb8, 0xf2, 0x57,
+	0x30, 0x0f, 0x1b, 0x0c, 0xe7, 0x61, 0x67, 0x20, 0xbf, 0x61, 0xda, 0xe8, 0x74, 0x44, 0x0e, 0x86,
+	0xb3, 0xa7, 0x09, 0x61, 0x98, 0xa0, 0x94, 0x6e, 0xa0, 0x10, 0x15, 0x18, 0x69, 0xb3, 0x27, 0x01,
+	0xa4, 0x21, 0x91, 0xcb, 0x72, 0xa0, 0xc2, 0x41, 0x1d, 0xf8, 0x89, 0x94, 0x4c, 0x28, 0x10, 0xc5,
+	0x83, 0x21, 0x0a, 0xe6, 0xad, 0x82, 0x03, 0xcf, 0x6c, 0x58, 0xe8, 0xda, 0xc3, 0xbc, 0x95, 0x86,
+	0xd6, 0xf8, 0x88, 0xba, 0xeb, 0xbe, 0x05, 0x33, 0x78, 0x9d, 0xd7, 0xb8, 0x5a, 0xe2, 0xe8, 0x80,
+	0xe8, 0x26, 0x11, 0x45, 0xef, 0xb6, 0x97, 0x7b, 0xa8, 0x51, 0xa0, 0x3a, 0x5a, 0x3b, 0xe6, 0x94,
+	0xae, 0xb5, 0xcd, 0xda, 0x94, 0x37, 0x0c, 0xeb, 0x39, 0x01, 0x5b, 0xe7, 0x20, 0x6d, 0x1e, 0xc6,
+	0xd4, 0x04, 0x21, 0xd4, 0x11, 0x42, 0x2d, 0x09, 0xce, 0x8b, 0x01, 0x82, 0x49, 0x18, 0xa2, 0xdd,
+	0xf0, 0x62, 0xec, 0x34, 0xff, 0x5c, 0x69, 0xde, 0x4f, 0x65, 0x86, 0x8b, 0x23, 0xf8, 0x6f, 0xbe,
+	0x58, 0xa8, 0xfc, 0x32, 0x05, 0x23, 0xeb, 0x2a, 0x9c, 0xfe, 0x46, 0xd8, 0xc7, 0x32, 0x0c, 0xcb,
+	0x9c, 0x45, 0xf0, 0x19, 0x24, 0x3e, 0x95, 0x70, 0x1c, 0xe3, 0x33, 0x10, 0xa8, 0xc4, 0x23, 0xe7,
+	0xfa, 0x1f, 0x1a, 0x83, 0x71, 0x6f, 0x0d, 0x2a, 0xdc, 0x24, 0x7e, 0x69, 0xe2, 0x77, 0xe5, 0x60,
+	0xb9, 0x1e, 0x4b, 0x52, 0x19, 0x88, 0x12, 0xfb, 0xd1, 0xbd, 0x5e, 0x60, 0xd0, 0x9a, 0x87, 0xc2,
+	0xd6, 0xcc, 0x73, 0x0f, 0x15, 0xba, 0xa9, 0xec, 0x25, 0x23, 0xf2, 0x1b, 0x05, 0x97, 0xe1, 0x36,
+	0x0f, 0x72, 0x3c, 0x6b, 0x16, 0x7e, 0x77, 0x88, 0x49, 0x4b, 0x0e, 0x6c, 0x32, 0x04, 0x37, 0x59,
+	0x5b, 0x81, 0xc2, 0xae, 0xe9, 0x98, 0x75, 0xb3, 0xc5, 0x93, 0x66, 0x8a, 0x07, 0x72, 0x7d, 0xc6,
+	0x03, 0x79, 0x9f, 0x90, 0x82, 0xb1, 0xbf, 0xa5, 0xa0, 0xa8, 0xee, 0xe2, 0x6f, 0x8c, 0x99, 0xe0,
+	0xf9, 0xc5, 0x0c, 0x6e, 0x93, 0xb9, 0xb5, 0x90, 0x98, 0x83, 0x34, 0x51, 0x49, 0x0c, 0xad, 0x06,
+	0x84, 0xe5, 0x31, 0x9e, 0xc0, 0x0f, 0xca, 0x9c, 0x26, 0xf4, 0xa2, 0x18, 0x79, 0xec, 0x4b, 0x8e,
+	0x97, 0x8c, 0xc4, 0x96, 0x0b, 0x18, 0x12, 0xcb, 0x17, 0x40, 0x9d, 0x96, 0x11, 0xce, 0x3d, 0x33,
+	0xd1, 0xdc, 0x13, 0x53, 0x03, 0xc9, 0xa2, 0xb1, 0x65, 0xb6, 0x9a, 0xfe, 0xb4, 0x56, 0xbb, 0xf5,
+	0x94, 0xb6, 0x39, 0xa3, 0x4f, 0x0a, 0x8c, 0x25, 0x8e, 0xa0, 0x66, 0x7f, 0x1b, 0x87, 0xa3, 0x71,
+	0x3f, 0xf4, 0xc4, 0xfd, 0x01, 0xbb, 0xcb, 0x85, 0xed, 0x2e, 0x60, 0x31, 0xc3, 0x87, 0x59, 0xcc,
+	0xc8, 0xf1, 0x2c, 0x46, 0xbb, 0x08, 0x25, 0x9b, 0x35, 0x2c, 0x8c, 0xd8, 0xfd, 0x01, 0x59, 0x14,
+	0x28, 0x8a, 0x81, 0x47, 0x1e, 0xbc, 0xd2, 0x05, 0x4d, 0xd6, 0x8f, 0xc4, 0xed, 0xa5, 0xf3, 0xf8,
+	0x5d, 0x9b, 0x81, 0xac, 0xbc, 0xe6, 0x3c, 0xe3, 0xca, 0x08, 0x80, 0x50, 0x7f, 0x9d, 0x6d, 0x9a,
+	0x6d, 0x0c, 0x4d, 0x9b, 0x81, 0xd0, 0x3f, 0x47, 0xc0, 0x55, 0x84, 0x21, 0xce, 0x1c, 0xe4, 0x58,
+	0xbb, 0xe9, 0x61, 0x0c, 0x88, 0x22, 0x17, 0x82, 0xc4, 0x78, 0xe5, 0x17, 0x09, 0x18, 0x09, 0xcd,
+	0x4b, 0x9a, 0xb1, 0x59, 0xc0, 0x9a, 0xd3, 0xfc, 0x13, 0x59, 0x85, 0x64, 0x49, 0x46, 0x64, 0xf9,
+	0x3e, 0x64, 0x79, 0xe9, 0x82, 0x33, 0x72, 0x70, 0x16, 0x1e, 0x2a, 0xdd, 0xea, 0x3b, 0x54, 0xea,
+	0x5d, 0xb8, 0xee, 0x73, 0xab, 0xfc, 0x21, 0x01, 0x05, 0x89, 0xb1, 0xce, 0x25, 0xe1, 0xe7, 0xee,
+	0x31, 0xe4, 0x94, 0x2c, 0xbc, 0xfe, 0x99, 0xa0, 0x1d, 0xba, 0x7e, 0xcc, 0x09, 0x41, 0xae, 0x82,
+	0x33, 0x7e, 0x03, 0xb2, 0x1b, 0x68, 0x62, 0x62, 0xe3, 0x93, 0x7d, 0x6e, 0x7c, 0x86, 0x93, 0xd0,
+	0x96, 0x6b, 0x90, 0x22, 0x81, 0xc4, 0x49, 0xa6, 0xdf, 0x95, 0x3f, 0x25, 0x20, 0x4b, 0xce, 0xe5,
+	0x90, 0x02, 0x6b, 0xb8, 0x1c, 0x99, 0x8c, 0x96, 0x23, 0x31, 0x45, 0xa2, 0x32, 0x83, 0x34, 0xca,
+	0x81, 0x7e, 0x53, 0x24, 0x41, 0xa4, 0x0a, 0x88, 0xc1, 0x3a, 0x92, 0xc8, 0xf5, 0xe8, 0x78, 0xca,
+	0x12, 0x12, 0x5e, 0xb1, 0x22, 0x25, 0xf0, 0xee, 0x88, 0x21, 0xfa, 0x46, 0x43, 0xf9, 0x24, 0x09,
+	0x99, 0xff, 0xc7, 0xb5, 0x17, 0x39, 0xd3, 0xa9, 0x9e, 0x33, 0x8d, 0x7a, 0x68, 0xd8, 0xcc, 0x4b,
+	0x15, 0x07, 0xfb, 0xd5, 0x83, 0x20, 0x22, 0x3d, 0x44, 0x54, 0x99, 0x3e, 0xba, 0x2a, 0x2b, 0x0e,
+	0x94, 0x6e, 0xb7, 0x5a, 0x16, 0x46, 0x95, 0xac, 0xe9, 0xa9, 0x65, 0x19, 0x52, 0xbc, 0x21, 0x20,
+	0xcd, 0xf1, 0x4a, 0xdf, 0xe6, 0xa8, 0x18, 0xe8, 0x44, 0x1e, 0xbc, 0x9b, 0x92, 0xc1, 0xbb, 0xa9,
+	0xf2, 0x55, 0x12, 0xc3, 0x14, 0x75, 0x75, 0xf6, 0xbb, 0x11, 0x68, 0x92, 0xd4, 0x95, 0x10, 0x3b,
+	0x40, 0xbf, 0x51, 0x01, 0x01, 0xdf, 0x32, 0x40, 0xbe, 0xe5, 0xcc, 0x7e, 0xa1, 0x83, 0x9a, 0x2f,
+	0xe2, 0x59, 0x6e, 0x42, 0x6a, 0xdb, 0x6c, 0x37, 0xa5, 0x67, 0x3a, 0x94, 0xfa, 0x7b, 0x88, 0xab,
+	0x13, 0x05, 0xbf, 0x47, 0xa2, 0xe5, 0x83, 0x8c, 0xa1, 0x32, 0xc2, 0x67, 0xdf, 0x1a, 0xed, 0x3e,
+	0x14, 0xa9, 0x28, 0x73, 0x9c, 0x82, 0x42, 0x9e, 0x53, 0x06, 0x2a, 0x30, 0x1f, 0x27, 0x01, 0xd6,
+	0xcc, 0xcd, 0x36, 0xaf, 0x40, 0x1e, 0xd6, 0x1d, 0x11, 0x85, 0x4e, 0x77, 0xdf, 0xee, 0x88, 0x37,
+	0x1e, 0xea, 0x8e, 0x84, 0x6b, 0xf6, 0x03, 0xd1, 0x9a, 0xbd, 0xda, 0xbd, 0x54, 0x60, 0xf7, 0xae,
+	0xc3, 0xa0, 0xd9, 0xee, 0x74, 0x5d, 0x69, 0xfb, 0x87, 0x17, 0xaf, 0x04, 0x3a, 0x97, 0xbe, 0x61,
+	0x61, 0x06, 0x65, 0xb5, 0xa4, 0x47, 0x57, 0x9f, 0xdc, 0x8c, 0x7c, 0xe9, 0xfd, 0x64, 0xc1, 0x83,
+	0xa1, 0xed, 0xfd, 0x2e, 0x01, 0x25, 0x59, 0x97, 0x5e, 0xa2, 0x22, 0xf5, 0xf3, 0x52, 0x48, 0x6c,
+	0x79, 0x5c, 0xe8, 0xa5, 0xa7, 0x3c, 0x1e, 0x95, 0x3b, 0xd5, 0x2b, 0xf7, 0x57, 0x09, 0x98, 0x50,
+	0x41, 0x43, 0xa8, 0xdd, 0xc7, 0x68, 0x26, 0x71, 0x93, 0x04, 0x66, 0x4a, 0xc8, 0x99, 0x68, 0xc0,
+	0x9f, 0xc9, 0xbf, 0xad, 0x92, 0xc1, 0xdb, 0xea, 0x3e, 0x0c, 0xf2, 0xcb, 0x54, 0x1d, 0xa2, 0x6b,
+	0xfd, 0xc5, 0xcb, 0x61, 0x39, 0x74, 0xc1, 0x42, 0xbb, 0x0b, 0xe9, 0xc0, 0xc5, 0x9c, 0x5f, 0xa8,
+	0xee, 0x73, 0xa6, 0x62, 0xb9, 0x74, 0x1d, 0x5d, 0x52, 0x57, 0x7e, 0x33, 0x0b, 0xe3, 0x3d, 0x38,
+	0x5f, 0xdb, 0xb5, 0x8d, 0x11, 0x65, 0xc7, 0xb0, 0xf9, 0x76, 0x86, 0x58, 0x89, 0x0d, 0x2a, 0x89,
+	0xa1, 0x48, 0x44, 0x29, 0xf1, 0x83, 0x7c, 0x85, 0x39, 0x17, 0xc5, 0x48, 0x38, 0xa2, 0x94, 0xd8,
+	0x52, 0xdb, 0xc2, 0x0b, 0xe5, 0x04, 0x50, 0x44, 0x94, 0xd1, 0x4d, 0x4f, 0xf7, 0x6c, 0xba, 0xf6,
+	0x1a, 0x4c, 0xe1, 0x31, 0xe8, 0xb4, 0x18, 0xd5, 0x71, 0x22, 0xd6, 0x27, 0x8c, 0x7b, 0xc2, 0x47,
+	0x08, 0x99, 0xdf, 0x43, 0x28, 0x46, 0x49, 0x65, 0x09, 0xb1, 0xcf, 0xc6, 0x5f, 0x21, 0xc2, 0x38,
+	0x12, 0x01, 0x67, 0xa3, 0x11, 0x30, 0x2a, 0xc8, 0xd3, 0x0c, 0xbf, 0x8f, 0x45, 0x0f, 0x19, 0x84,
+	0x82, 0xd4, 0x08, 0xbf, 0x72, 0xa9, 0x91, 0xfc, 0x01, 0x4c, 0x7b, 0xd8, 0x4c, 0x6d, 0xee, 0x51,
+	0x1b, 0x6d, 0xe5, 0xbd, 0xa8, 0x79, 0xa8, 0x36, 0xd6, 0x3b, 0x30, 0xe6, 0xb1, 0xe7, 0x3b, 0x70,
+	0xc4, 0x46, 0x9b, 0xb7, 0x12, 0xdc, 0x29, 0xc5, 0xb2, 0x0e, 0x27, 0x9a, 0x6c, 0xc3, 0xe8, 0xb6,
+	0x02, 0x16, 0x20, 0x9c, 0xcf, 0xd1, 0x7a, 0x6e, 0xd3, 0x92, 0x8b, 0xb2, 0x16, 0xca, 0x76, 0xe4,
+	0x1c, 0x2f, 0xca, 0x56, 0xad, 0x57, 0x68, 0xc8, 0x8b, 0x92, 0x08, 0x01, 0x55, 0x75, 0xe1, 0x22,
+	0x68, 0xe4, 0x17, 0x84, 0x39, 0x28, 0x0f, 0x5b, 0x12, 0x8d, 0x37, 0x3e, 0x42, 0xdb, 0xb5, 0x2e,
+	0xd2, 0x80, 0x57, 0x60, 0x54, 0x34, 0x3f, 0xc2, 0xa5, 0x16, 0x4d, 0x54, 0xbb, 0xf9, 0xd0, 0xdd,
+	0x60, 0xb9, 0xe5, 0x32, 0x50, 0x83, 0xa0, 0x86, 0xc2, 0x63, 0xd8, 0xea, 0x78, 0x2d, 0xe3, 0x51,
+	0xc2, 0xa7, 0x79, 0x1f, 0xaa, 0x21, 0x61, 0x15, 0xdf, 0x91, 0xd1, 0x9e, 0xf0, 0x4f, 0x63, 0x7d,
+	0xfa, 0x27, 0x11, 0x0f, 0xee, 0xeb, 0xe6, 0xc6, 0x8f, 0xe7, 0xe6, 0x78, 0x3b, 0x20, 0xbc, 0x37,
+	0x4a, 0x8f, 0x13, 0xa2, 0x1d, 0xb0, 0x17, 0xd0, 0xb9, 0x52, 0x27, 0x9e, 0xb1, 0x30, 0x4d, 0x30,
+	0x6c, 0x9b, 0x14, 0x67, 0x2c, 0x48, 0xb7, 0xe6, 0x87, 0x70, 0xe8, 0x1b, 0x22, 0xa4, 0x7e, 0xdc,
+	0x5b, 0x16, 0xbe, 0x21, 0x44, 0xe9, 0xc5, 0xc0, 0x6b, 0x51, 0x39, 0x95, 0x0d, 0x4d, 0xf5, 0xd9,
+	0x08, 0xde, 0x8b, 0x31, 0x9e, 0x9e, 0xc5, 0xab, 0x3a, 0xc4, 0x34, 0xd5, 0x21, 0x42, 0x34, 0xaa,
+	0x16, 0x11, 0x3c, 0x86, 0xa1, 0x15, 0xd0, 0x36, 0xcc, 0xf4, 0xdb, 0xf8, 0x89, 0x59, 0x25, 0xed,
+	0x87, 0x01, 0xb3, 0xf1, 0xba, 0x95, 0x13, 0xcc, 0xf6, 0x39, 0xc1, 0x54, 0xdc, 0x06, 0x88, 0x29,
+	0xe2, 0x1a, 0xd6, 0x27, 0xe2, 0x1b, 0xd6, 0x36, 0x9c, 0x0d, 0x4b, 0x63, 0xd9, 0x26, 0x66, 0x98,
+	0x46, 0x2b, 0x2a, 0xd6, 0x5c, 0x9f, 0x62, 0x9d, 0x0e, 0x8a, 0xf5, 0xb6, 0x64, 0x16, 0x16, 0xaf,
+	0xc7, 0x44, 0x02, 0x2e, 0xfa, 0x24, 0xdd, 0x8d, 0x21, 0x13, 0x09, 0x75, 0xcc, 0x7b, 0xc3, 0x87,
+	0x53, 0xf1, 0xe1, 0x03, 0xe2, 0x3a, 0xae, 0xd9, 0xd8, 0x7e, 0x5a, 0x0b, 0x5c, 0xd0, 0xa7, 0x55,
+	0xe7, 0x9b, 0x0f, 0x78, 0xf1, 0xab, 0xb6, 0x09, 0xa7, 0x24, 0xee, 0xfe, 0x6f, 0x28, 0x2a, 0xfd,
+	0x59, 0xe1, 0xac, 0x60, 0xb4, 0x16, 0xff, 0x92, 0x22, 0xd0, 0xc6, 0x7f, 0x31, 0xdc, 0xc6, 0xdf,
+	0xbf, 0xa5, 0x7e, 0xe6, 0xf9, 0xb4, 0xd4, 0xcf, 0x3e, 0x9f, 0x96, 0xfa, 0xb9, 0x03, 0x5a, 0xea,
+	0x07, 0x36, 0xbf, 0xcf, 0x1f, 0xdc, 0xfc, 0xde, 0xb7, 0x1d, 0x7f, 0xe1, 0x59, 0xda, 0xf1, 0x7d,
+	0xb4, 0xd4, 0x5f, 0x3a, 0xbc, 0xa5, 0x1e, 0xf7, 0x70, 0xe2, 0xe5, 0xd8, 0x87, 0x13, 0xe8, 0xcb,
+	0x1a, 0x36, 0x4e, 0xa5, 0xcc, 0xac, 0x7c, 0x91, 0x0c, 0x72, 0x98, 0x03, 0x95, 0xc9, 0xec, 0x57,
+	0x97, 0xbf, 0xb4, 0x5f, 0x5d, 0x1e, 0x83, 0x0c, 0x19, 0x05, 0x05, 0x8b, 0xe6, 0xaf, 0x50, 0xd1,
+	0xbc, 0x48, 0x23, 0xc1, 0x9a, 0x39, 0x6f, 0x0c, 0x50, 0xd2, 0x23, 0x9f, 0xa9, 0x55, 0x65, 0x63,
+	0x80, 0x60, 0xe2, 0x81, 0xda, 0xd9, 0xc8, 0x53, 0xb9, 0x79, 0x8e, 0xb2, 0x98, 0x2c, 0x27, 0x42,
+	0xcf, 0xe5, 0x30, 0x9e, 0x28, 0x19, 0x5d, 0x3c, 0x27, 0x36, 0x73, 0x18, 0x7a, 0x47, 0x0b, 0x4d,
+	0xcb, 0x29, 0x5f, 0x8d, 0x0b, 0xa7, 0xbc, 0x37, 0x7f, 0x18, 0x4f, 0xe9, 0x1c, 0xfb, 0x21, 0x21,
+	0xeb, 0x05, 0x4e, 0x1f, 0x00, 0x68, 0x3f, 0xc6, 0x44, 0xc4, 0x61, 0x86, 0x8d, 0xab, 0x40, 0x8b,
+	0xb2, 0xcd, 0x7a, 0xd7, 0xc5, 0x2d, 0xb8, 0x46, 0x25, 0xa7, 0xf5, 0xbe, 0x53, 0xee, 0xd8, 0x00,
+	0xb9, 0xba, 0x46, 0x7c, 0x6f, 0x7b, 0x6c, 0x45, 0x8f, 0xae, 0xe8, 0x44, 0xc0, 0xda, 0x0f, 0x20,
+	0xb5, 0xc3, 0x76, 0xac, 0xf2, 0xab, 0x34, 0xeb, 0xbd, 0x67, 0x9c, 0xf5, 0x2d, 0x64, 0x25, 0x66,
+	0x22, 0xae, 0xe8, 0x5c, 0x4a, 0xea, 0x75, 0x9f, 0xd0, 0xa5, 0x89, 0x0b, 0xbc, 0x4e, 0x4a, 0xbb,
+	0x1c, 0x3b, 0x55, 0x20, 0x14, 0x95, 0x1b, 0x7e, 0x4f, 0xd1, 0xe9, 0xc5, 0xdd, 0x08, 0x44, 0xbb,
+	0x0a, 0x13, 0x32, 0xaa, 0xf1, 0xe2, 0x47, 0x19, 0x6c, 0xdf, 0x20, 0x4b, 0x1b, 0x15, 0x8d, 0x24,
+	0x35, 0x28, 0x82, 0xee, 0x1f, 0x42, 0xc1, 0x47, 0xe7, 0x89, 0x86, 0x53, 0xbe, 0x49, 0x12, 0xdd,
+	0xe8, 0x7b, 0xf1, 0xe1, 0xc7, 0x96, 0x7a, 0x9e, 0x85, 0x1f, 0x5f, 0x4e, 0x40, 0xba, 0x63, 0x74,
+	0x31, 0x3e, 0x2a, 0xbf, 0x46, 0xe7, 0x42, 0x7e, 0x71, 0x63, 0xa4, 0x5f, 0x68, 0x43, 0x86, 0x83,
+	0x36, 0xfe, 0xba, 0xca, 0x08, 0x10, 0xa6, 0x13, 0x48, 0x7b, 0x04, 0xc3, 0x32, 0x0a, 0xe2, 0x05,
+	0x37, 0xa7, 0x7c, 0x8b, 0xb6, 0xe5, 0x6a, 0xdf, 0x92, 0x89, 0x48, 0x48, 0xbc, 0x8e, 0xec, 0x7a,
+	0xbf, 0x9d, 0xe9, 0x26, 0x8c, 0xc7, 0x5a, 0x44, 0x4c, 0x07, 0xf2, 0xd5, 0x70, 0xd3, 0xf4, 0xe4,
+	0x21, 0x39, 0x79, 0xb0, 0xdb, 0xf9, 0x1e, 0x64, 0x3d, 0x0b, 0xf8, 0x5a, 0x39, 0xdf, 0x4f, 0x65,
+	0x0a, 0xc5, 0x22, 0xfe, 0x5b, 0x2c, 0x96, 0xf0, 0xdf, 0xcb, 0xc5, 0x2b, 0xf8, 0xef, 0x95, 0xe2,
+	0x02, 0xfe, 0xbb, 0x50, 0xbc, 0x5a, 0xf9, 0x28, 0x01, 0x99, 0xa5, 0x2d, 0xd6, 0xd8, 0x76, 0xba,
+	0x3b, 0xd1, 0x44, 0x7e, 0xd0, 0x4f, 0xe4, 0xef, 0x40, 0x7a, 0xa3, 0x65, 0xec, 0x5a, 0x36, 0x09,
+	0x90, 0x5f, 0xb8, 0x74, 0x70, 0x8e, 0xab, 0x38, 0xde, 0x25, 0x1a, 0x5d, 0xd2, 0xfa, 0x1d, 0xda,
+	0x01, 0xba, 0x73, 0xc4, 0x47, 0xe5, 0x3f, 0x29, 0xd0, 0xa8, 0xac, 0x1f, 0xce, 0x53, 0x9f, 0x4f,
+	0x99, 0x25, 0x10, 0x64, 0x0e, 0x44, 0x8b, 0xab, 0xab, 0x50, 0x88, 0xf0, 0x95, 0x8f, 0x4a, 0xfb,
+	0x7d, 0xa3, 0x1a, 0x9e, 0x95, 0xdf, 0xcf, 0x6a, 0xba, 0x60, 0xda, 0x2b, 0xfb, 0x2e, 0x72, 0x28,
+	0x90, 0xf7, 0x9e, 0x81, 0xbc, 0xc2, 0x97, 0x67, 0x51, 0x54, 0x68, 0xd4, 0xab, 0x51, 0x5d, 0x56,
+	0x1b, 0x22, 0x2f, 0x52, 0x87, 0x8e, 0xff, 0x22, 0x35, 0xb6, 0xf8, 0x91, 0x89, 0x2f, 0x7e, 0xcc,
+	0x42, 0xd6, 0x4b, 0xf6, 0x55, 0x02, 0xeb, 0x01, 0x8e, 0x98, 0xc0, 0xbe, 0xe7, 0xd5, 0x0f, 0xc4,
+	0x53, 0x4e, 0xe9, 0x0b, 0x73, 0x64, 0x5b, 0x17, 0xf6, 0x29, 0x79, 0x3c, 0x24, 0x0a, 0x7a, 0xbe,
+	0x29, 0xbc, 0xa4, 0xaa, 0x34, 0x04, 0x40, 0x3d, 0x75, 0x81, 0xe1, 0xde, 0x62, 0xd0, 0xc7, 0x29,
+	0x28, 0x78, 0xc5, 0x09, 0xf1, 0x88, 0x0b, 0x95, 0x9a, 0x3a, 0x56, 0x0f, 0xc1, 0x2f, 0x72, 0x50,
+	0xe5, 0x96, 0xf3, 0xd0, 0x1e, 0x42, 0xba, 0x61, 0xb5, 0x37, 0xcc, 0x4d, 0x79, 0x58, 0x6f, 0x1e,
+	0x9d, 0xdb, 0x12, 0xd1, 0xeb, 0x92, 0x0f, 0xc6, 0xde, 0x5a, 0xf0, 0x49, 0x8a, 0xe4, 0x2e, 0x8a,
+	0xff, 0x4b, 0x47, 0xe7, 0x1e, 0x78, 0x0a, 0x21, 0x27, 0x2a, 0xd9, 0x51, 0x10, 0xfa, 0xf6, 0xbc,
+	0x98, 0xc7, 0x8b, 0x2b, 0x44, 0x5d, 0x6d, 0x44, 0x40, 0x55, 0x4c, 0xb1, 0x08, 0x27, 0xf8, 0xeb,
+	0x3f, 0x6b, 0x97, 0xbf, 0x7b, 0x8f, 0x7b, 0x1c, 0x25, 0x6a, 0xbb, 0x33, 0x0a, 0x29, 0xee, 0x6d,
+	0x14, 0x66, 0x21, 0x1e, 0x0f, 0x45, 0x26, 0xea, 0x39, 0x05, 0x05, 0x57, 0xa8, 0x0f, 0xa0, 0xe4,
+	0xa1, 0xf2, 0x96, 0xd6, 0x91, 0xea, 0xba, 0x1e, 0xb7, 0xe5, 0x36, 0xe5, 0x17, 0x95, 0xdf, 0x27,
+	0x61, 0x24, 0xb4, 0x83, 0x5a, 0x1e, 0x92, 0x5e, 0x49, 0x0c, 0x7f, 0x69, 0xb7, 0x54, 0x69, 0x4f,
+	0x5c, 0x7b, 0x67, 0xf7, 0x31, 0x4d, 0x8f, 0x49, 0xa8, 0x96, 0xa7, 0xca, 0xb6, 0x03, 0x81, 0xb2,
+	0xed, 0x29, 0xc8, 0x35, 0x99, 0xd3, 0xb0, 0xcd, 0x8e, 0xab, 0x74, 0x8a, 0x7e, 0x2c, 0x00, 0xf2,
+	0xdf, 0xea, 0x0d, 0x06, 0xdf, 0xea, 0xad, 0xcb, 0xae, 0x42, 0x9a, 0xbc, 0xda, 0x9b, 0xc7, 0x33,
+	0xd0, 0xea, 0x1d, 0x64, 0x21, 0x83, 0x0c, 0xce, 0x6d, 0xfa, 0x06, 0x64, 0x3d, 0xd0, 0x61, 0x2f,
+	0x6a, 0xb2, 0xc1, 0x17, 0x35, 0x5b, 0x30, 0xbd, 0xbf, 0x39, 0xf1, 0x8b, 0x8f, 0x1e, 0xa4, 0xb3,
+	0x5a, 0xcc, 0xff, 0x12, 0x51, 0x12, 0x43, 0x4b, 0x81, 0xff, 0x31, 0x62, 0x1a, 0x32, 0x12, 0xd1,
+	0xc1, 0xa9, 0x78, 0x18, 0xed, 0x7d, 0x57, 0xfe, 0x3d, 0x10, 0x38, 0xad, 0x92, 0xff, 0x1b, 0x90,
+	0xc5, 0x18, 0x9a, 0x3f, 0x0d, 0x95, 0xce, 0xa1, 0x8f, 0xfc, 0xc4, 0xa7, 0xd0, 0xce, 0x43, 0x81,
+	0xfb, 0x73, 0x13, 0x97, 0x53, 0xab, 0x77, 0x1b, 0xdb, 0xcc, 0x95, 0x0b, 0xcc, 0x2b, 0xf0, 0x22,
+	0x41, 0xb5, 0x15, 0x18, 0xae, 0x1b, 0xcd, 0x5a, 0x1d, 0x33, 0x53, 0x0a, 0xbf, 0xc4, 0x89, 0x3b,
+	0x17, 0x36, 0x02, 0xff, 0x7f, 0xf7, 0x41, 0x75, 0x2f, 0x1a, 0xcd, 0x45, 0x89, 0xad, 0xe7, 0xea,
+	0xfe, 0x87, 0xf6, 0x3e, 0x4c, 0xa8, 0x50, 0xd9, 0x9b, 0x5b, 0x58, 0xd6, 0xc1, 0xbd, 0x93, 0xdb,
+	0x12, 0x59, 0x18, 0xd6, 0x98, 0xe4, 0x11, 0x82, 0xf2, 0xba, 0x53, 0x0f, 0xef, 0xae, 0x6d, 0x4a,
+	0x03, 0xd2, 0x22, 0x34, 0xef, 0xda, 0x26, 0x06, 0x72, 0x53, 0x81, 0xfe, 0x76, 0x44, 0xa0, 0xf4,
+	0x11, 0x04, 0x9a, 0xf4, 0xd9, 0x84, 0x65, 0xba, 0x0e, 0x93, 0x71, 0x33, 0x70, 0xb1, 0xc4, 0xfb,
+	0x80, 0xf1, 0x5e, 0x4a, 0x94, 0xac, 0xf2, 0xab, 0x44, 0xe8, 0xa9, 0x96, 0x3c, 0xf7, 0x8e, 0xf6,
+	0x66, 0xb4, 0xb8, 0x27, 0xb6, 0x7d, 0xa6, 0x67, 0xdb, 0x31, 0xf5, 0xbc, 0x7e, 0xed, 0x11, 0x37,
+	0xd4, 0x48, 0xe5, 0x6f, 0x45, 0x56, 0xfe, 0xf6, 0x6c, 0xd3, 0xf5, 0x93, 0xa5, 0xe4, 0xe1, 0x6c,
+	0xa8, 0xc2, 0xf6, 0x98, 0x53, 0x49, 0x56, 0x95, 0xbf, 0x26, 0x00, 0xfc, 0x70, 0x91, 0xf7, 0xb2,
+	0x54, 0xe4, 0xe9, 0xf5, 0xe7, 0x65, 0x04, 0xd9, 0xe4, 0xb6, 0x6d, 0x34, 0x1a, 0xac, 0xc3, 0x4b,
+	0x2b, 0x49, 0x8a, 0x69, 0xbd, 0x6f, 0xee, 0x52, 0x65, 0x9d, 0x98, 0x89, 0x70, 0x24, 0xa3, 0xfb,
+	0x00, 0xed, 0x26, 0xa4, 0x31, 0x63, 0xea, 0xb6, 0x54, 0x14, 0x72, 0x78, 0x8b, 0x47, 0xe2, 0x63,
+	0xfe, 0x3c, 0xa4, 0xde, 0x6b, 0x0f, 0xf6, 0xf9, 0x5e, 0x5b, 0x11, 0x2c, 0xba, 0x9f, 0x7d, 0x31,
+	0xf7, 0xc2, 0xe7, 0xf8, 0xf7, 0xdf, 0x2f, 0xe6, 0x12, 0x1f, 0x7d, 0x39, 0x97, 0xf8, 0x2d, 0xfe,
+	0xfd, 0x19, 0xff, 0x3e, 0xc3, 0xbf, 0x7f, 0xe0, 0xdf, 0xbf, 0xbe, 0xc4, 0x31, 0xfc, 0xef, 0xa7,
+	0xff, 0x9c, 0x7b, 0xe1, 0x33, 0xfc, 0xfb, 0x1c, 0xff, 0xde, 0xff, 0xf6, 0xa6, 0xe5, 0x4f, 0x61,
+	0x5a, 0x87, 0xfc, 0x0f, 0x84, 0xb7, 0xa2, 0xb0, 0x7a, 0x9a, 0x14, 0x7f, 0xf5, 0x7f, 0x99, 0xc1,
+	0x7a, 0x35, 0x83, 0x38, 0x00, 0x00,
 }
 
 func (this *ExecutionStats) Equal(that interface{}) bool {
@@ -4161,6 +4268,20 @@ func (this *WorkflowExecutionInfo) Equal(that interface{}) bool {
 	if !this.ExecutionStats.Equal(that1.ExecutionStats) {
 		return false
 	}
+	if this.Paused != that1.Paused {
+		return false
+	}
+	if this.PauseReason != that1.PauseReason {
+		return false
+	}
+	if len(this.UpdateInfos) != len(that1.UpdateInfos) {
+		return false
+	}
+	for i := range this.UpdateInfos {
+		if !this.UpdateInfos[i].Equal(that1.UpdateInfos[i]) {
+			return false
+		}
+	}
 	return true
 }
 func (this *Checksum) Equal(that interface{}) bool {
@@ -4447,6 +4568,42 @@ func (this *ReplicationVersions) Equal(that interface{}) bool {
 	}
 	return true
 }
+func (this *UpdateInfo) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*UpdateInfo)
+	if !ok {
+		that2, ok := that.(UpdateInfo)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.UpdateId != that1.UpdateId {
+		return false
+	}
+	if this.Accepted != that1.Accepted {
+		return false
+	}
+	if this.Completed != that1.Completed {
+		return false
+	}
+	if !this.Result.Equal(that1.Result) {
+		return false
+	}
+	if !this.Failure.Equal(that1.Failure) {
+		return false
+	}
+	return true
+}
 func (this *ExecutionStats) GoString() string {
 	if this == nil {
 		return "nil"
@@ -4802,7 +4959,7 @@ func (this *WorkflowExecutionInfo) GoString() string {
 	if this == nil {
 		return "nil"
 	}
-	s := make([]string, 0, 55)
+	s := make([]string, 0, 58)
 	s = append(s, "&persistenceblobs.WorkflowExecutionInfo{")
 	s = append(s, "NamespaceId: "+fmt.Sprintf("%#v", this.NamespaceId)+",\n")
 	s = append(s, "WorkflowId: "+fmt.Sprintf("%#v", this.WorkflowId)+",\n")
@@ -4887,6 +5044,11 @@ func (this *WorkflowExecutionInfo) GoString() string {
 	if this.ExecutionStats != nil {
 		s = append(s, "ExecutionStats: "+fmt.Sprintf("%#v", this.ExecutionStats)+",\n")
 	}
+	s = append(s, "Paused: "+fmt.Sprintf("%#v", this.Paused)+",\n")
+	s = append(s, "PauseReason: "+fmt.Sprintf("%#v", this.PauseReason)+",\n")
+	if this.UpdateInfos != nil {
+		s = append(s, "UpdateInfos: "+fmt.Sprintf("%#v", this.UpdateInfos)+",\n")
+	}
 	s = append(s, "}")
 	return strings.Join(s, "")
 }
@@ -5020,6 +5182,24 @@ func (this *ReplicationVersions) GoString() string {
 	s = append(s, "}")
 	return strings.Join(s, "")
 }
+func (this *UpdateInfo) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 9)
+	s = append(s, "&persistenceblobs.UpdateInfo{")
+	s = append(s, "UpdateId: "+fmt.Sprintf("%#v", this.UpdateId)+",\n")
+	s = append(s, "Accepted: "+fmt.Sprintf("%#v", this.Accepted)+",\n")
+	s = append(s, "Completed: "+fmt.Sprintf("%#v", this.Completed)+",\n")
+	if this.Result != nil {
+		s = append(s, "Result: "+fmt.Sprintf("%#v", this.Result)+",\n")
+	}
+	if this.Failure != nil {
+		s = append(s, "Failure: "+fmt.Sprintf("%#v", this.Failure)+",\n")
+	}
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
 func valueToGoStringMessage(v interface{}, typ string) string {
 	rv := reflect.ValueOf(v)
 	if rv.IsNil() {
@@ -6458,6 +6638,43 @@ func (m *WorkflowExecutionInfo) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.UpdateInfos) > 0 {
+		for iNdEx := len(m.UpdateInfos) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.UpdateInfos[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintMessage(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x3
+			i--
+			dAtA[i] = 0xda
+		}
+	}
+	if len(m.PauseReason) > 0 {
+		i -= len(m.PauseReason)
+		copy(dAtA[i:], m.PauseReason)
+		i = encodeVarintMessage(dAtA, i, uint64(len(m.PauseReason)))
+		i--
+		dAtA[i] = 0x3
+		i--
+		dAtA[i] = 0xd2
+	}
+	if m.Paused {
+		i--
+		if m.Paused {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x3
+		i--
+		dAtA[i] = 0xc8
+	}
 	if m.ExecutionStats != nil {
 		{
 			size, err := m.ExecutionStats.MarshalToSizedBuffer(dAtA[:i])
@@ -7434,6 +7651,80 @@ func (m *ReplicationVersions) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *UpdateInfo) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *UpdateInfo) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *UpdateInfo) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Failure != nil {
+		{
+			size, err := m.Failure.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintMessage(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x2a
+	}
+	if m.Result != nil {
+		{
+			size, err := m.Result.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintMessage(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x22
+	}
+	if m.Completed {
+		i--
+		if m.Completed {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.Accepted {
+		i--
+		if m.Accepted {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.UpdateId) > 0 {
+		i -= len(m.UpdateId)
+		copy(dAtA[i:], m.UpdateId)
+		i = encodeVarintMessage(dAtA, i, uint64(len(m.UpdateId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintMessage(dAtA []byte, offset int, v uint64) int {
 	offset -= sovMessage(v)
 	base := offset
@@ -8305,6 +8596,19 @@ func (m *WorkflowExecutionInfo) Size() (n int) {
 		l = m.ExecutionStats.Size()
 		n += 2 + l + sovMessage(uint64(l))
 	}
+	if m.Paused {
+		n += 3
+	}
+	l = len(m.PauseReason)
+	if l > 0 {
+		n += 2 + l + sovMessage(uint64(l))
+	}
+	if len(m.UpdateInfos) > 0 {
+		for _, e := range m.UpdateInfos {
+			l = e.Size()
+			n += 2 + l + sovMessage(uint64(l))
+		}
+	}
 	return n
 }
 
@@ -8519,6 +8823,32 @@ func (m *ReplicationVersions) Size() (n int) {
 	}
 	return n
 }
+func (m *UpdateInfo) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.UpdateId)
+	if l > 0 {
+		n += 1 + l + sovMessage(uint64(l))
+	}
+	if m.Accepted {
+		n += 2
+	}
+	if m.Completed {
+		n += 2
+	}
+	if m.Result != nil {
+		l = m.Result.Size()
+		n += 1 + l + sovMessage(uint64(l))
+	}
+	if m.Failure != nil {
+		l = m.Failure.Size()
+		n += 1 + l + sovMessage(uint64(l))
+	}
+	return n
+}
 
 func sovMessage(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
@@ -8880,6 +9210,11 @@ func (this *WorkflowExecutionInfo) String() string {
 		mapStringForMemo += fmt.Sprintf("%v: %v,", k, this.Memo[k])
 	}
 	mapStringForMemo += "}"
+	repeatedStringForUpdateInfos := "[]*UpdateInfo{"
+	for _, f := range this.UpdateInfos {
+		repeatedStringForUpdateInfos += strings.Replace(f.String(), "UpdateInfo", "UpdateInfo", 1) + ","
+	}
+	repeatedStringForUpdateInfos += "}"
 	s := strings.Join([]string{`&WorkflowExecutionInfo{`,
 		`NamespaceId:` + fmt.Sprintf("%v", this.NamespaceId) + `,`,
 		`WorkflowId:` + fmt.Sprintf("%v", this.WorkflowId) + `,`,
@@ -8932,6 +9267,9 @@ func (this *WorkflowExecutionInfo) String() string {
 		`VersionHistories:` + strings.Replace(fmt.Sprintf("%v", this.VersionHistories), "VersionHistories", "v17.VersionHistories", 1) + `,`,
 		`FirstExecutionRunId:` + fmt.Sprintf("%v", this.FirstExecutionRunId) + `,`,
 		`ExecutionStats:` + strings.Replace(this.ExecutionStats.String(), "ExecutionStats", "ExecutionStats", 1) + `,`,
+		`Paused:` + fmt.Sprintf("%v", this.Paused) + `,`,
+		`PauseReason:` + fmt.Sprintf("%v", this.PauseReason) + `,`,
+		`UpdateInfos:` + repeatedStringForUpdateInfos + `,`,
 		`}`,
 	}, "")
 	return s
@@ -9048,6 +9386,20 @@ func (this *ReplicationVersions) String() string {
 	}, "")
 	return s
 }
+func (this *UpdateInfo) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&UpdateInfo{`,
+		`UpdateId:` + fmt.Sprintf("%v", this.UpdateId) + `,`,
+		`Accepted:` + fmt.Sprintf("%v", this.Accepted) + `,`,
+		`Completed:` + fmt.Sprintf("%v", this.Completed) + `,`,
+		`Result:` + strings.Replace(fmt.Sprintf("%v", this.Result), "Payloads", "v13.Payloads", 1) + `,`,
+		`Failure:` + strings.Replace(fmt.Sprintf("%v", this.Failure), "Failure", "v12.Failure", 1) + `,`,
+		`}`,
+	}, "")
+	return s
+}
 func valueToStringMessage(v interface{}) string {
 	rv := reflect.ValueOf(v)
 	if rv.IsNil() {
@@ -15565,6 +15917,92 @@ func (m *WorkflowExecutionInfo) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 57:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Paused", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMessage
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Paused = bool(v != 0)
+		case 58:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PauseReason", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMessage
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMessage
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMessage
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PauseReason = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 59:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UpdateInfos", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMessage
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthMessage
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthMessage
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.UpdateInfos = append(m.UpdateInfos, &UpdateInfo{})
+			if err := m.UpdateInfos[len(m.UpdateInfos)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipMessage(dAtA[iNdEx:])
@@ -17176,6 +17614,203 @@ func (m *ReplicationVersions) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *UpdateInfo) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowMessage
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: UpdateInfo: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: UpdateInfo: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UpdateId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMessage
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMessage
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMessage
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.UpdateId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Accepted", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMessage
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Accepted = bool(v != 0)
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Completed", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMessage
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Completed = bool(v != 0)
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Result", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMessage
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthMessage
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthMessage
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Result == nil {
+				m.Result = &v13.Payloads{}
+			}
+			if err := m.Result.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Failure", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMessage
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthMessage
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthMessage
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Failure == nil {
+				m.Failure = &v12.Failure{}
+			}
+			if err := m.Failure.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipMessage(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthMessage
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthMessage
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipMessage(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
@@ -288,6 +288,258 @@ func (c *clientImpl) ResendReplicationTasks(
 	return client.ResendReplicationTasks(ctx, request, opts...)
 }
 
+func (c *clientImpl) CreateSchedule(
+	ctx context.Context,
+	request *adminservice.CreateScheduleRequest,
+	opts ...grpc.CallOption,
+) (*adminservice.CreateScheduleResponse, error) {
+	client, err := c.getRandomClient()
+	if err != nil {
+		return nil, err
+	}
+	ctx, cancel := c.createContext(ctx)
+	defer cancel()
+	return client.CreateSchedule(ctx, request, opts...)
+}
+
+func (c *clientImpl) DescribeSchedule(
+	ctx context.Context,
+	request *adminservice.DescribeScheduleRequest,
+	opts ...grpc.CallOption,
+) (*adminservice.DescribeScheduleResponse, error) {
+	client, err := c.getRandomClient()
+	if err != nil {
+		return nil, err
+	}
+	ctx, cancel := c.createContext(ctx)
+	defer cancel()
+	return client.DescribeSchedule(ctx, request, opts...)
+}
+
+func (c *clientImpl) UpdateSchedule(
+	ctx context.Context,
+	request *adminservice.UpdateScheduleRequest,
+	opts ...grpc.CallOption,
+) (*adminservice.UpdateScheduleResponse, error) {
+	client, err := c.getRandomClient()
+	if err != nil {
+		return nil, err
+	}
+	ctx, cancel := c.createContext(ctx)
+	defer cancel()
+	return client.UpdateSchedule(ctx, request, opts...)
+}
+
+func (c *clientImpl) PauseSchedule(
+	ctx context.Context,
+	request *adminservice.PauseScheduleRequest,
+	opts ...grpc.CallOption,
+) (*adminservice.PauseScheduleResponse, error) {
+	client, err := c.getRandomClient()
+	if err != nil {
+		return nil, err
+	}
+	ctx, cancel := c.createContext(ctx)
+	defer cancel()
+	return client.PauseSchedule(ctx, request, opts...)
+}
+
+func (c *clientImpl) UnpauseSchedule(
+	ctx context.Context,
+	request *adminservice.UnpauseScheduleRequest,
+	opts ...grpc.CallOption,
+) (*adminservice.UnpauseScheduleResponse, error) {
+	client, err := c.getRandomClient()
+	if err != nil {
+		return nil, err
+	}
+	ctx, cancel := c.createContext(ctx)
+	defer cancel()
+	return client.UnpauseSchedule(ctx, request, opts...)
+}
+
+func (c *clientImpl) TriggerSchedule(
+	ctx context.Context,
+	request *adminservice.TriggerScheduleRequest,
+	opts ...grpc.CallOption,
+) (*adminservice.TriggerScheduleResponse, error) {
+	client, err := c.getRandomClient()
+	if err != nil {
+		return nil, err
+	}
+	ctx, cancel := c.createContext(ctx)
+	defer cancel()
+	return client.TriggerSchedule(ctx, request, opts...)
+}
+
+func (c *clientImpl) DeleteSchedule(
+	ctx context.Context,
+	request *adminservice.DeleteScheduleRequest,
+	opts ...grpc.CallOption,
+) (*adminservice.DeleteScheduleResponse, error) {
+	client, err := c.getRandomClient()
+	if err != nil {
+		return nil, err
+	}
+	ctx, cancel := c.createContext(ctx)
+	defer cancel()
+	return client.DeleteSchedule(ctx, request, opts...)
+}
+
+func (c *clientImpl) ListSchedules(
+	ctx context.Context,
+	request *adminservice.ListSchedulesRequest,
+	opts ...grpc.CallOption,
+) (*adminservice.ListSchedulesResponse, error) {
+	client, err := c.getRandomClient()
+	if err != nil {
+		return nil, err
+	}
+	ctx, cancel := c.createContext(ctx)
+	defer cancel()
+	return client.ListSchedules(ctx, request, opts...)
+}
+
+func (c *clientImpl) DeleteWorkflowExecution(
+	ctx context.Context,
+	request *adminservice.DeleteWorkflowExecutionRequest,
+	opts ...grpc.CallOption,
+) (*adminservice.DeleteWorkflowExecutionResponse, error) {
+	client, err := c.getRandomClient()
+	if err != nil {
+		return nil, err
+	}
+	ctx, cancel := c.createContext(ctx)
+	defer cancel()
+	return client.DeleteWorkflowExecution(ctx, request, opts...)
+}
+
+func (c *clientImpl) StartBatchOperation(
+	ctx context.Context,
+	request *adminservice.StartBatchOperationRequest,
+	opts ...grpc.CallOption,
+) (*adminservice.StartBatchOperationResponse, error) {
+	client, err := c.getRandomClient()
+	if err != nil {
+		return nil, err
+	}
+	ctx, cancel := c.createContext(ctx)
+	defer cancel()
+	return client.StartBatchOperation(ctx, request, opts...)
+}
+
+func (c *clientImpl) StopBatchOperation(
+	ctx context.Context,
+	request *adminservice.StopBatchOperationRequest,
+	opts ...grpc.CallOption,
+) (*adminservice.StopBatchOperationResponse, error) {
+	client, err := c.getRandomClient()
+	if err != nil {
+		return nil, err
+	}
+	ctx, cancel := c.createContext(ctx)
+	defer cancel()
+	return client.StopBatchOperation(ctx, request, opts...)
+}
+
+func (c *clientImpl) DescribeBatchOperation(
+	ctx context.Context,
+	request *adminservice.DescribeBatchOperationRequest,
+	opts ...grpc.CallOption,
+) (*adminservice.DescribeBatchOperationResponse, error) {
+	client, err := c.getRandomClient()
+	if err != nil {
+		return nil, err
+	}
+	ctx, cancel := c.createContext(ctx)
+	defer cancel()
+	return client.DescribeBatchOperation(ctx, request, opts...)
+}
+
+func (c *clientImpl) ListBatchOperations(
+	ctx context.Context,
+	request *adminservice.ListBatchOperationsRequest,
+	opts ...grpc.CallOption,
+) (*adminservice.ListBatchOperationsResponse, error) {
+	client, err := c.getRandomClient()
+	if err != nil {
+		return nil, err
+	}
+	ctx, cancel := c.createContext(ctx)
+	defer cancel()
+	return client.ListBatchOperations(ctx, request, opts...)
+}
+
+func (c *clientImpl) UpdateWorkflowExecution(
+	ctx context.Context,
+	request *adminservice.UpdateWorkflowExecutionRequest,
+	opts ...grpc.CallOption,
+) (*adminservice.UpdateWorkflowExecutionResponse, error) {
+	client, err := c.getRandomClient()
+	if err != nil {
+		return nil, err
+	}
+	ctx, cancel := c.createContextWithLargeTimeout(ctx)
+	defer cancel()
+	return client.UpdateWorkflowExecution(ctx, request, opts...)
+}
+
+func (c *clientImpl) GetWorkflowExecutionResult(
+	ctx context.Context,
+	request *adminservice.GetWorkflowExecutionResultRequest,
+	opts ...grpc.CallOption,
+) (*adminservice.GetWorkflowExecutionResultResponse, error) {
+	client, err := c.getRandomClient()
+	if err != nil {
+		return nil, err
+	}
+	ctx, cancel := c.createContextWithLargeTimeout(ctx)
+	defer cancel()
+	return client.GetWorkflowExecutionResult(ctx, request, opts...)
+}
+
+func (c *clientImpl) ResetWorkflowExecutionWithOptions(
+	ctx context.Context,
+	request *adminservice.ResetWorkflowExecutionWithOptionsRequest,
+	opts ...grpc.CallOption,
+) (*adminservice.ResetWorkflowExecutionWithOptionsResponse, error) {
+	client, err := c.getRandomClient()
+	if err != nil {
+		return nil, err
+	}
+	ctx, cancel := c.createContext(ctx)
+	defer cancel()
+	return client.ResetWorkflowExecutionWithOptions(ctx, request, opts...)
+}
+
+func (c *clientImpl) PauseWorkflowExecution(
+	ctx context.Context,
+	request *adminservice.PauseWorkflowExecutionRequest,
+	opts ...grpc.CallOption,
+) (*adminservice.PauseWorkflowExecutionResponse, error) {
+	client, err := c.getRandomClient()
+	if err != nil {
+		return nil, err
+	}
+	ctx, cancel := c.createContext(ctx)
+	defer cancel()
+	return client.PauseWorkflowExecution(ctx, request, opts...)
+}
+
+func (c *clientImpl) UnpauseWorkflowExecution(
+	ctx context.Context,
+	request *adminservice.UnpauseWorkflowExecutionRequest,
+	opts ...grpc.CallOption,
+) (*adminservice.UnpauseWorkflowExecutionResponse, error) {
+	client, err := c.getRandomClient()
+	if err != nil {
+		return nil, err
+	}
+	ctx, cancel := c.createContext(ctx)
+	defer cancel()
+	return client.UnpauseWorkflowExecution(ctx, request, opts...)
+}
+
 func (c *clientImpl) createContext(parent context.Context) (context.Context, context.CancelFunc) {
 	return context.WithTimeout(parent, c.timeout)
 }
@@ -326,3 +326,309 @@ func (c *metricClient) ResendReplicationTasks(
 	}
 	return resp, err
 }
+
+func (c *metricClient) CreateSchedule(
+	ctx context.Context,
+	request *adminservice.CreateScheduleRequest,
+	opts ...grpc.CallOption,
+) (*adminservice.CreateScheduleResponse, error) {
+
+	c.metricsClient.IncCounter(metrics.AdminClientCreateScheduleScope, metrics.ClientRequests)
+	sw := c.metricsClient.StartTimer(metrics.AdminClientCreateScheduleScope, metrics.ClientLatency)
+	resp, err := c.client.CreateSchedule(ctx, request, opts...)
+	sw.Stop()
+
+	if err != nil {
+		c.metricsClient.IncCounter(metrics.AdminClientCreateScheduleScope, metrics.ClientFailures)
+	}
+	return resp, err
+}
+
+func (c *metricClient) DescribeSchedule(
+	ctx context.Context,
+	request *adminservice.DescribeScheduleRequest,
+	opts ...grpc.CallOption,
+) (*adminservice.DescribeScheduleResponse, error) {
+
+	c.metricsClient.IncCounter(metrics.AdminClientDescribeScheduleScope, metrics.ClientRequests)
+	sw := c.metricsClient.StartTimer(metrics.AdminClientDescribeScheduleScope, metrics.ClientLatency)
+	resp, err := c.client.DescribeSchedule(ctx, request, opts...)
+	sw.Stop()
+
+	if err != nil {
+		c.metricsClient.IncCounter(metrics.AdminClientDescribeScheduleScope, metrics.ClientFailures)
+	}
+	return resp, err
+}
+
+func (c *metricClient) UpdateSchedule(
+	ctx context.Context,
+	request *adminservice.UpdateScheduleRequest,
+	opts ...grpc.CallOption,
+) (*adminservice.UpdateScheduleResponse, error) {
+
+	c.metricsClient.IncCounter(metrics.AdminClientUpdateScheduleScope, metrics.ClientRequests)
+	sw := c.metricsClient.StartTimer(metrics.AdminClientUpdateScheduleScope, metrics.ClientLatency)
+	resp, err := c.client.UpdateSchedule(ctx, request, opts...)
+	sw.Stop()
+
+	if err != nil {
+		c.metricsClient.IncCounter(metrics.AdminClientUpdateScheduleScope, metrics.ClientFailures)
+	}
+	return resp, err
+}
+
+func (c *metricClient) PauseSchedule(
+	ctx context.Context,
+	request *adminservice.PauseScheduleRequest,
+	opts ...grpc.CallOption,
+) (*adminservice.PauseScheduleResponse, error) {
+
+	c.metricsClient.IncCounter(metrics.AdminClientPauseScheduleScope, metrics.ClientRequests)
+	sw := c.metricsClient.StartTimer(metrics.AdminClientPauseScheduleScope, metrics.ClientLatency)
+	resp, err := c.client.PauseSchedule(ctx, request, opts...)
+	sw.Stop()
+
+	if err != nil {
+		c.metricsClient.IncCounter(metrics.AdminClientPauseScheduleScope, metrics.ClientFailures)
+	}
+	return resp, err
+}
+
+func (c *metricClient) UnpauseSchedule(
+	ctx context.Context,
+	request *adminservice.UnpauseScheduleRequest,
+	opts ...grpc.CallOption,
+) (*adminservice.UnpauseScheduleResponse, error) {
+
+	c.metricsClient.IncCounter(metrics.AdminClientUnpauseScheduleScope, metrics.ClientRequests)
+	sw := c.metricsClient.StartTimer(metrics.AdminClientUnpauseScheduleScope, metrics.ClientLatency)
+	resp, err := c.client.UnpauseSchedule(ctx, request, opts...)
+	sw.Stop()
+
+	if err != nil {
+		c.metricsClient.IncCounter(metrics.AdminClientUnpauseScheduleScope, metrics.ClientFailures)
+	}
+	return resp, err
+}
+
+func (c *metricClient) TriggerSchedule(
+	ctx context.Context,
+	request *adminservice.TriggerScheduleRequest,
+	opts ...grpc.CallOption,
+) (*adminservice.TriggerScheduleResponse, error) {
+
+	c.metricsClient.IncCounter(metrics.AdminClientTriggerScheduleScope, metrics.ClientRequests)
+	sw := c.metricsClient.StartTimer(metrics.AdminClientTriggerScheduleScope, metrics.ClientLatency)
+	resp, err := c.client.TriggerSchedule(ctx, request, opts...)
+	sw.Stop()
+
+	if err != nil {
+		c.metricsClient.IncCounter(metrics.AdminClientTriggerScheduleScope, metrics.ClientFailures)
+	}
+	return resp, err
+}
+
+func (c *metricClient) DeleteSchedule(
+	ctx context.Context,
+	request *adminservice.DeleteScheduleRequest,
+	opts ...grpc.CallOption,
+) (*adminservice.DeleteScheduleResponse, error) {
+
+	c.metricsClient.IncCounter(metrics.AdminClientDeleteScheduleScope, metrics.ClientRequests)
+	sw := c.metricsClient.StartTimer(metrics.AdminClientDeleteScheduleScope, metrics.ClientLatency)
+	resp, err := c.client.DeleteSchedule(ctx, request, opts...)
+	sw.Stop()
+
+	if err != nil {
+		c.metricsClient.IncCounter(metrics.AdminClientDeleteScheduleScope, metrics.ClientFailures)
+	}
+	return resp, err
+}
+
+func (c *metricClient) ListSchedules(
+	ctx context.Context,
+	request *adminservice.ListSchedulesRequest,
+	opts ...grpc.CallOption,
+) (*adminservice.ListSchedulesResponse, error) {
+
+	c.metricsClient.IncCounter(metrics.AdminClientListSchedulesScope, metrics.ClientRequests)
+	sw := c.metricsClient.StartTimer(metrics.AdminClientListSchedulesScope, metrics.ClientLatency)
+	resp, err := c.client.ListSchedules(ctx, request, opts...)
+	sw.Stop()
+
+	if err != nil {
+		c.metricsClient.IncCounter(metrics.AdminClientListSchedulesScope, metrics.ClientFailures)
+	}
+	return resp, err
+}
+
+func (c *metricClient) DeleteWorkflowExecution(
+	ctx context.Context,
+	request *adminservice.DeleteWorkflowExecutionRequest,
+	opts ...grpc.CallOption,
+) (*adminservice.DeleteWorkflowExecutionResponse, error) {
+
+	c.metricsClient.IncCounter(metrics.AdminClientDeleteWorkflowExecutionScope, metrics.ClientRequests)
+	sw := c.metricsClient.StartTimer(metrics.AdminClientDeleteWorkflowExecutionScope, metrics.ClientLatency)
+	resp, err := c.client.DeleteWorkflowExecution(ctx, request, opts...)
+	sw.Stop()
+
+	if err != nil {
+		c.metricsClient.IncCounter(metrics.AdminClientDeleteWorkflowExecutionScope, metrics.ClientFailures)
+	}
+	return resp, err
+}
+
+func (c *metricClient) StartBatchOperation(
+	ctx context.Context,
+	request *adminservice.StartBatchOperationRequest,
+	opts ...grpc.CallOption,
+) (*adminservice.StartBatchOperationResponse, error) {
+
+	c.metricsClient.IncCounter(metrics.AdminClientStartBatchOperationScope, metrics.ClientRequests)
+	sw := c.metricsClient.StartTimer(metrics.AdminClientStartBatchOperationScope, metrics.ClientLatency)
+	resp, err := c.client.StartBatchOperation(ctx, request, opts...)
+	sw.Stop()
+
+	if err != nil {
+		c.metricsClient.IncCounter(metrics.AdminClientStartBatchOperationScope, metrics.ClientFailures)
+	}
+	return resp, err
+}
+
+func (c *metricClient) StopBatchOperation(
+	ctx context.Context,
+	request *adminservice.StopBatchOperationRequest,
+	opts ...grpc.CallOption,
+) (*adminservice.StopBatchOperationResponse, error) {
+
+	c.metricsClient.IncCounter(metrics.AdminClientStopBatchOperationScope, metrics.ClientRequests)
+	sw := c.metricsClient.StartTimer(metrics.AdminClientStopBatchOperationScope, metrics.ClientLatency)
+	resp, err := c.client.StopBatchOperation(ctx, request, opts...)
+	sw.Stop()
+
+	if err != nil {
+		c.metricsClient.IncCounter(metrics.AdminClientStopBatchOperationScope, metrics.ClientFailures)
+	}
+	return resp, err
+}
+
+func (c *metricClient) DescribeBatchOperation(
+	ctx context.Context,
+	request *adminservice.DescribeBatchOperationRequest,
+	opts ...grpc.CallOption,
+) (*adminservice.DescribeBatchOperationResponse, error) {
+
+	c.metricsClient.IncCounter(metrics.AdminClientDescribeBatchOperationScope, metrics.ClientRequests)
+	sw := c.metricsClient.StartTimer(metrics.AdminClientDescribeBatchOperationScope, metrics.ClientLatency)
+	resp, err := c.client.DescribeBatchOperation(ctx, request, opts...)
+	sw.Stop()
+
+	if err != nil {
+		c.metricsClient.IncCounter(metrics.AdminClientDescribeBatchOperationScope, metrics.ClientFailures)
+	}
+	return resp, err
+}
+
+func (c *metricClient) ListBatchOperations(
+	ctx context.Context,
+	request *adminservice.ListBatchOperationsRequest,
+	opts ...grpc.CallOption,
+) (*adminservice.ListBatchOperationsResponse, error) {
+
+	c.metricsClient.IncCounter(metrics.AdminClientListBatchOperationsScope, metrics.ClientRequests)
+	sw := c.metricsClient.StartTimer(metrics.AdminClientListBatchOperationsScope, metrics.ClientLatency)
+	resp, err := c.client.ListBatchOperations(ctx, request, opts...)
+	sw.Stop()
+
+	if err != nil {
+		c.metricsClient.IncCounter(metrics.AdminClientListBatchOperationsScope, metrics.ClientFailures)
+	}
+	return resp, err
+}
+
+func (c *metricClient) UpdateWorkflowExecution(
+	ctx context.Context,
+	request *adminservice.UpdateWorkflowExecutionRequest,
+	opts ...grpc.CallOption,
+) (*adminservice.UpdateWorkflowExecutionResponse, error) {
+
+	c.metricsClient.IncCounter(metrics.AdminClientUpdateWorkflowExecutionScope, metrics.ClientRequests)
+	sw := c.metricsClient.StartTimer(metrics.AdminClientUpdateWorkflowExecutionScope, metrics.ClientLatency)
+	resp, err := c.client.UpdateWorkflowExecution(ctx, request, opts...)
+	sw.Stop()
+
+	if err != nil {
+		c.metricsClient.IncCounter(metrics.AdminClientUpdateWorkflowExecutionScope, metrics.ClientFailures)
+	}
+	return resp, err
+}
+
+func (c *metricClient) GetWorkflowExecutionResult(
+	ctx context.Context,
+	request *adminservice.GetWorkflowExecutionResultRequest,
+	opts ...grpc.CallOption,
+) (*adminservice.GetWorkflowExecutionResultResponse, error) {
+
+	c.metricsClient.IncCounter(metrics.AdminClientGetWorkflowExecutionResultScope, metrics.ClientRequests)
+	sw := c.metricsClient.StartTimer(metrics.AdminClientGetWorkflowExecutionResultScope, metrics.ClientLatency)
+	resp, err := c.client.GetWorkflowExecutionResult(ctx, request, opts...)
+	sw.Stop()
+
+	if err != nil {
+		c.metricsClient.IncCounter(metrics.AdminClientGetWorkflowExecutionResultScope, metrics.ClientFailures)
+	}
+	return resp, err
+}
+
+func (c *metricClient) ResetWorkflowExecutionWithOptions(
+	ctx context.Context,
+	request *adminservice.ResetWorkflowExecutionWithOptionsRequest,
+	opts ...grpc.CallOption,
+) (*adminservice.ResetWorkflowExecutionWithOptionsResponse, error) {
+
+	c.metricsClient.IncCounter(metrics.AdminClientResetWorkflowExecutionWithOptionsScope, metrics.ClientRequests)
+	sw := c.metricsClient.StartTimer(metrics.AdminClientResetWorkflowExecutionWithOptionsScope, metrics.ClientLatency)
+	resp, err := c.client.ResetWorkflowExecutionWithOptions(ctx, request, opts...)
+	sw.Stop()
+
+	if err != nil {
+		c.metricsClient.IncCounter(metrics.AdminClientResetWorkflowExecutionWithOptionsScope, metrics.ClientFailures)
+	}
+	return resp, err
+}
+
+func (c *metricClient) PauseWorkflowExecution(
+	ctx context.Context,
+	request *adminservice.PauseWorkflowExecutionRequest,
+	opts ...grpc.CallOption,
+) (*adminservice.PauseWorkflowExecutionResponse, error) {
+
+	c.metricsClient.IncCounter(metrics.AdminClientPauseWorkflowExecutionScope, metrics.ClientRequests)
+	sw := c.metricsClient.StartTimer(metrics.AdminClientPauseWorkflowExecutionScope, metrics.ClientLatency)
+	resp, err := c.client.PauseWorkflowExecution(ctx, request, opts...)
+	sw.Stop()
+
+	if err != nil {
+		c.metricsClient.IncCounter(metrics.AdminClientPauseWorkflowExecutionScope, metrics.ClientFailures)
+	}
+	return resp, err
+}
+
+func (c *metricClient) UnpauseWorkflowExecution(
+	ctx context.Context,
+	request *adminservice.UnpauseWorkflowExecutionRequest,
+	opts ...grpc.CallOption,
+) (*adminservice.UnpauseWorkflowExecutionResponse, error) {
+
+	c.metricsClient.IncCounter(metrics.AdminClientUnpauseWorkflowExecutionScope, metrics.ClientRequests)
+	sw := c.metricsClient.StartTimer(metrics.AdminClientUnpauseWorkflowExecutionScope, metrics.ClientLatency)
+	resp, err := c.client.UnpauseWorkflowExecution(ctx, request, opts...)
+	sw.Stop()
+
+	if err != nil {
+		c.metricsClient.IncCounter(metrics.AdminClientUnpauseWorkflowExecutionScope, metrics.ClientFailures)
+	}
+	return resp, err
+}
@@ -301,3 +301,291 @@ func (c *retryableClient) ResendReplicationTasks(
 	err := backoff.Retry(op, c.policy, c.isRetryable)
 	return resp, err
 }
+
+func (c *retryableClient) CreateSchedule(
+	ctx context.Context,
+	request *adminservice.CreateScheduleRequest,
+	opts ...grpc.CallOption,
+) (*adminservice.CreateScheduleResponse, error) {
+
+	var resp *adminservice.CreateScheduleResponse
+	op := func() error {
+		var err error
+		resp, err = c.client.CreateSchedule(ctx, request, opts...)
+		return err
+	}
+	err := backoff.Retry(op, c.policy, c.isRetryable)
+	return resp, err
+}
+
+func (c *retryableClient) DescribeSchedule(
+	ctx context.Context,
+	request *adminservice.DescribeScheduleRequest,
+	opts ...grpc.CallOption,
+) (*adminservice.DescribeScheduleResponse, error) {
+
+	var resp *adminservice.DescribeScheduleResponse
+	op := func() error {
+		var err error
+		resp, err = c.client.DescribeSchedule(ctx, request, opts...)
+		return err
+	}
+	err := backoff.Retry(op, c.policy, c.isRetryable)
+	return resp, err
+}
+
+func (c *retryableClient) UpdateSchedule(
+	ctx context.Context,
+	request *adminservice.UpdateScheduleRequest,
+	opts ...grpc.CallOption,
+) (*adminservice.UpdateScheduleResponse, error) {
+
+	var resp *adminservice.UpdateScheduleResponse
+	op := func() error {
+		var err error
+		resp, err = c.client.UpdateSchedule(ctx, request, opts...)
+		return err
+	}
+	err := backoff.Retry(op, c.policy, c.isRetryable)
+	return resp, err
+}
+
+func (c *retryableClient) PauseSchedule(
+	ctx context.Context,
+	request *adminservice.PauseScheduleRequest,
+	opts ...grpc.CallOption,
+) (*adminservice.PauseScheduleResponse, error) {
+
+	var resp *adminservice.PauseScheduleResponse
+	op := func() error {
+		var err error
+		resp, err = c.client.PauseSchedule(ctx, request, opts...)
+		return err
+	}
+	err := backoff.Retry(op, c.policy, c.isRetryable)
+	return resp, err
+}
+
+func (c *retryableClient) UnpauseSchedule(
+	ctx context.Context,
+	request *adminservice.UnpauseScheduleRequest,
+	opts ...grpc.CallOption,
+) (*adminservice.UnpauseScheduleResponse, error) {
+
+	var resp *adminservice.UnpauseScheduleResponse
+	op := func() error {
+		var err error
+		resp, err = c.client.UnpauseSchedule(ctx, request, opts...)
+		return err
+	}
+	err := backoff.Retry(op, c.policy, c.isRetryable)
+	return resp, err
+}
+
+func (c *retryableClient) TriggerSchedule(
+	ctx context.Context,
+	request *adminservice.TriggerScheduleRequest,
+	opts ...grpc.CallOption,
+) (*adminservice.TriggerScheduleResponse, error) {
+
+	var resp *adminservice.TriggerScheduleResponse
+	op := func() error {
+		var err error
+		resp, err = c.client.TriggerSchedule(ctx, request, opts...)
+		return err
+	}
+	err := backoff.Retry(op, c.policy, c.isRetryable)
+	return resp, err
+}
+
+func (c *retryableClient) DeleteSchedule(
+	ctx context.Context,
+	request *adminservice.DeleteScheduleRequest,
+	opts ...grpc.CallOption,
+) (*adminservice.DeleteScheduleResponse, error) {
+
+	var resp *adminservice.DeleteScheduleResponse
+	op := func() error {
+		var err error
+		resp, err = c.client.DeleteSchedule(ctx, request, opts...)
+		return err
+	}
+	err := backoff.Retry(op, c.policy, c.isRetryable)
+	return resp, err
+}
+
+func (c *retryableClient) ListSchedules(
+	ctx context.Context,
+	request *adminservice.ListSchedulesRequest,
+	opts ...grpc.CallOption,
+) (*adminservice.ListSchedulesResponse, error) {
+
+	var resp *adminservice.ListSchedulesResponse
+	op := func() error {
+		var err error
+		resp, err = c.client.ListSchedules(ctx, request, opts...)
+		return err
+	}
+	err := backoff.Retry(op, c.policy, c.isRetryable)
+	return resp, err
+}
+
+func (c *retryableClient) DeleteWorkflowExecution(
+	ctx context.Context,
+	request *adminservice.DeleteWorkflowExecutionRequest,
+	opts ...grpc.CallOption,
+) (*adminservice.DeleteWorkflowExecutionResponse, error) {
+
+	var resp *adminservice.DeleteWorkflowExecutionResponse
+	op := func() error {
+		var err error
+		resp, err = c.client.DeleteWorkflowExecution(ctx, request, opts...)
+		return err
+	}
+	err := backoff.Retry(op, c.policy, c.isRetryable)
+	return resp, err
+}
+
+func (c *retryableClient) StartBatchOperation(
+	ctx context.Context,
+	request *adminservice.StartBatchOperationRequest,
+	opts ...grpc.CallOption,
+) (*adminservice.StartBatchOperationResponse, error) {
+
+	var resp *adminservice.StartBatchOperationResponse
+	op := func() error {
+		var err error
+		resp, err = c.client.StartBatchOperation(ctx, request, opts...)
+		return err
+	}
+	err := backoff.Retry(op, c.policy, c.isRetryable)
+	return resp, err
+}
+
+func (c *retryableClient) StopBatchOperation(
+	ctx context.Context,
+	request *adminservice.StopBatchOperationRequest,
+	opts ...grpc.CallOption,
+) (*adminservice.StopBatchOperationResponse, error) {
+
+	var resp *adminservice.StopBatchOperationResponse
+	op := func() error {
+		var err error
+		resp, err = c.client.StopBatchOperation(ctx, request, opts...)
+		return err
+	}
+	err := backoff.Retry(op, c.policy, c.isRetryable)
+	return resp, err
+}
+
+func (c *retryableClient) DescribeBatchOperation(
+	ctx context.Context,
+	request *adminservice.DescribeBatchOperationRequest,
+	opts ...grpc.CallOption,
+) (*adminservice.DescribeBatchOperationResponse, error) {
+
+	var resp *adminservice.DescribeBatchOperationResponse
+	op := func() error {
+		var err error
+		resp, err = c.client.DescribeBatchOperation(ctx, request, opts...)
+		return err
+	}
+	err := backoff.Retry(op, c.policy, c.isRetryable)
+	return resp, err
+}
+
+func (c *retryableClient) ListBatchOperations(
+	ctx context.Context,
+	request *adminservice.ListBatchOperationsRequest,
+	opts ...grpc.CallOption,
+) (*adminservice.ListBatchOperationsResponse, error) {
+
+	var resp *adminservice.ListBatchOperationsResponse
+	op := func() error {
+		var err error
+		resp, err = c.client.ListBatchOperations(ctx, request, opts...)
+		return err
+	}
+	err := backoff.Retry(op, c.policy, c.isRetryable)
+	return resp, err
+}
+
+func (c *retryableClient) UpdateWorkflowExecution(
+	ctx context.Context,
+	request *adminservice.UpdateWorkflowExecutionRequest,
+	opts ...grpc.CallOption,
+) (*adminservice.UpdateWorkflowExecutionResponse, error) {
+
+	var resp *adminservice.UpdateWorkflowExecutionResponse
+	op := func() error {
+		var err error
+		resp, err = c.client.UpdateWorkflowExecution(ctx, request, opts...)
+		return err
+	}
+	err := backoff.Retry(op, c.policy, c.isRetryable)
+	return resp, err
+}
+
+func (c *retryableClient) GetWorkflowExecutionResult(
+	ctx context.Context,
+	request *adminservice.GetWorkflowExecutionResultRequest,
+	opts ...grpc.CallOption,
+) (*adminservice.GetWorkflowExecutionResultResponse, error) {
+
+	var resp *adminservice.GetWorkflowExecutionResultResponse
+	op := func() error {
+		var err error
+		resp, err = c.client.GetWorkflowExecutionResult(ctx, request, opts...)
+		return err
+	}
+	err := backoff.Retry(op, c.policy, c.isRetryable)
+	return resp, err
+}
+
+func (c *retryableClient) ResetWorkflowExecutionWithOptions(
+	ctx context.Context,
+	request *adminservice.ResetWorkflowExecutionWithOptionsRequest,
+	opts ...grpc.CallOption,
+) (*adminservice.ResetWorkflowExecutionWithOptionsResponse, error) {
+
+	var resp *adminservice.ResetWorkflowExecutionWithOptionsResponse
+	op := func() error {
+		var err error
+		resp, err = c.client.ResetWorkflowExecutionWithOptions(ctx, request, opts...)
+		return err
+	}
+	err := backoff.Retry(op, c.policy, c.isRetryable)
+	return resp, err
+}
+
+func (c *retryableClient) PauseWorkflowExecution(
+	ctx context.Context,
+	request *adminservice.PauseWorkflowExecutionRequest,
+	opts ...grpc.CallOption,
+) (*adminservice.PauseWorkflowExecutionResponse, error) {
+
+	var resp *adminservice.PauseWorkflowExecutionResponse
+	op := func() error {
+		var err error
+		resp, err = c.client.PauseWorkflowExecution(ctx, request, opts...)
+		return err
+	}
+	err := backoff.Retry(op, c.policy, c.isRetryable)
+	return resp, err
+}
+
+func (c *retryableClient) UnpauseWorkflowExecution(
+	ctx context.Context,
+	request *adminservice.UnpauseWorkflowExecutionRequest,
+	opts ...grpc.CallOption,
+) (*adminservice.UnpauseWorkflowExecutionResponse, error) {
+
+	var resp *adminservice.UnpauseWorkflowExecutionResponse
+	op := func() error {
+		var err error
+		resp, err = c.client.UnpauseWorkflowExecution(ctx, request, opts...)
+		return err
+	}
+	err := backoff.Retry(op, c.policy, c.isRetryable)
+	return resp, err
+}
@@ -626,3 +626,88 @@ func (c *metricClient) RefreshWorkflowTasks(
 	}
 	return resp, err
 }
+
+func (c *metricClient) DeleteWorkflowExecution(
+	ctx context.Context,
+	request *historyservice.DeleteWorkflowExecutionRequest,
+	opts ...grpc.CallOption,
+) (*historyservice.DeleteWorkflowExecutionResponse, error) {
+
+	c.metricsClient.IncCounter(metrics.HistoryClientDeleteWorkflowExecutionScope, metrics.ClientRequests)
+	sw := c.metricsClient.StartTimer(metrics.HistoryClientDeleteWorkflowExecutionScope, metrics.ClientLatency)
+	resp, err := c.client.DeleteWorkflowExecution(ctx, request, opts...)
+	sw.Stop()
+
+	if err != nil {
+		c.metricsClient.IncCounter(metrics.HistoryClientDeleteWorkflowExecutionScope, metrics.ClientFailures)
+	}
+	return resp, err
+}
+
+func (c *metricClient) UpdateWorkflowExecution(
+	ctx context.Context,
+	request *historyservice.UpdateWorkflowExecutionRequest,
+	opts ...grpc.CallOption,
+) (*historyservice.UpdateWorkflowExecutionResponse, error) {
+
+	c.metricsClient.IncCounter(metrics.HistoryClientUpdateWorkflowExecutionScope, metrics.ClientRequests)
+	sw := c.metricsClient.StartTimer(metrics.HistoryClientUpdateWorkflowExecutionScope, metrics.ClientLatency)
+	resp, err := c.client.UpdateWorkflowExecution(ctx, request, opts...)
+	sw.Stop()
+
+	if err != nil {
+		c.metricsClient.IncCounter(metrics.HistoryClientUpdateWorkflowExecutionScope, metrics.ClientFailures)
+	}
+	return resp, err
+}
+
+func (c *metricClient) PauseWorkflowExecution(
+	ctx context.Context,
+	request *historyservice.PauseWorkflowExecutionRequest,
+	opts ...grpc.CallOption,
+) (*historyservice.PauseWorkflowExecutionResponse, error) {
+
+	c.metricsClient.IncCounter(metrics.HistoryClientPauseWorkflowExecutionScope, metrics.ClientRequests)
+	sw := c.metricsClient.StartTimer(metrics.HistoryClientPauseWorkflowExecutionScope, metrics.ClientLatency)
+	resp, err := c.client.PauseWorkflowExecution(ctx, request, opts...)
+	sw.Stop()
+
+	if err != nil {
+		c.metricsClient.IncCounter(metrics.HistoryClientPauseWorkflowExecutionScope, metrics.ClientFailures)
+	}
+	return resp, err
+}
+
+func (c *metricClient) UnpauseWorkflowExecution(
+	ctx context.Context,
+	request *historyservice.UnpauseWorkflowExecutionRequest,
+	opts ...grpc.CallOption,
+) (*historyservice.UnpauseWorkflowExecutionResponse, error) {
+
+	c.metricsClient.IncCounter(metrics.HistoryClientUnpauseWorkflowExecutionScope, metrics.ClientRequests)
+	sw := c.metricsClient.StartTimer(metrics.HistoryClientUnpauseWorkflowExecutionScope, metrics.ClientLatency)
+	resp, err := c.client.UnpauseWorkflowExecution(ctx, request, opts...)
+	sw.Stop()
+
+	if err != nil {
+		c.metricsClient.IncCounter(metrics.HistoryClientUnpauseWorkflowExecutionScope, metrics.ClientFailures)
+	}
+	return resp, err
+}
+
+func (c *metricClient) AcquireShard(
+	ctx context.Context,
+	request *historyservice.AcquireShardRequest,
+	opts ...grpc.CallOption,
+) (*historyservice.AcquireShardResponse, error) {
+
+	c.metricsClient.IncCounter(metrics.HistoryClientAcquireShardScope, metrics.ClientRequests)
+	sw := c.metricsClient.StartTimer(metrics.HistoryClientAcquireShardScope, metrics.ClientLatency)
+	resp, err := c.client.AcquireShard(ctx, request, opts...)
+	sw.Stop()
+
+	if err != nil {
+		c.metricsClient.IncCounter(metrics.HistoryClientAcquireShardScope, metrics.ClientFailures)
+	}
+	return resp, err
+}
@@ -626,3 +626,88 @@ func (c *retryableClient) RefreshWorkflowTasks(
 	err := backoff.Retry(op, c.policy, c.isRetryable)
 	return resp, err
 }
+
+func (c *retryableClient) DeleteWorkflowExecution(
+	ctx context.Context,
+	request *historyservice.DeleteWorkflowExecutionRequest,
+	opts ...grpc.CallOption,
+) (*historyservice.DeleteWorkflowExecutionResponse, error) {
+
+	var resp *historyservice.DeleteWorkflowExecutionResponse
+	op := func() error {
+		var err error
+		resp, err = c.client.DeleteWorkflowExecution(ctx, request, opts...)
+		return err
+	}
+
+	err := backoff.Retry(op, c.policy, c.isRetryable)
+	return resp, err
+}
+
+func (c *retryableClient) UpdateWorkflowExecution(
+	ctx context.Context,
+	request *historyservice.UpdateWorkflowExecutionRequest,
+	opts ...grpc.CallOption,
+) (*historyservice.UpdateWorkflowExecutionResponse, error) {
+
+	var resp *historyservice.UpdateWorkflowExecutionResponse
+	op := func() error {
+		var err error
+		resp, err = c.client.UpdateWorkflowExecution(ctx, request, opts...)
+		return err
+	}
+
+	err := backoff.Retry(op, c.policy, c.isRetryable)
+	return resp, err
+}
+
+func (c *retryableClient) PauseWorkflowExecution(
+	ctx context.Context,
+	request *historyservice.PauseWorkflowExecutionRequest,
+	opts ...grpc.CallOption,
+) (*historyservice.PauseWorkflowExecutionResponse, error) {
+
+	var resp *historyservice.PauseWorkflowExecutionResponse
+	op := func() error {
+		var err error
+		resp, err = c.client.PauseWorkflowExecution(ctx, request, opts...)
+		return err
+	}
+
+	err := backoff.Retry(op, c.policy, c.isRetryable)
+	return resp, err
+}
+
+func (c *retryableClient) UnpauseWorkflowExecution(
+	ctx context.Context,
+	request *historyservice.UnpauseWorkflowExecutionRequest,
+	opts ...grpc.CallOption,
+) (*historyservice.UnpauseWorkflowExecutionResponse, error) {
+
+	var resp *historyservice.UnpauseWorkflowExecutionResponse
+	op := func() error {
+		var err error
+		resp, err = c.client.UnpauseWorkflowExecution(ctx, request, opts...)
+		return err
+	}
+
+	err := backoff.Retry(op, c.policy, c.isRetryable)
+	return resp, err
+}
+
+func (c *retryableClient) AcquireShard(
+	ctx context.Context,
+	request *historyservice.AcquireShardRequest,
+	opts ...grpc.CallOption,
+) (*historyservice.AcquireShardResponse, error) {
+
+	var resp *historyservice.AcquireShardResponse
+	op := func() error {
+		var err error
+		resp, err = c.client.AcquireShard(ctx, request, opts...)
+		return err
+	}
+
+	err := backoff.Retry(op, c.policy, c.isRetryable)
+	return resp, err
+}
@@ -32,10 +32,10 @@ import (
 
 	"github.com/urfave/cli"
 
-	"go.temporal.io/server/common/authorization"
 	"go.temporal.io/server/common/headers"
 	_ "go.temporal.io/server/common/persistence/sql/sqlplugin/mysql"      // needed to load mysql plugin
 	_ "go.temporal.io/server/common/persistence/sql/sqlplugin/postgresql" // needed to load postgresql plugin
+	_ "go.temporal.io/server/common/persistence/sql/sqlplugin/sqlite"     // needed to load sqlite plugin
 	"go.temporal.io/server/common/service/config"
 	"go.temporal.io/server/temporal"
 )
@@ -102,7 +102,6 @@ func buildCLI() *cli.App {
 					temporal.ForServices(services),
 					temporal.WithConfigLoader(configDir, env, zone),
 					temporal.InterruptOn(temporal.InterruptCh()),
-					temporal.WithAuthorizer(authorization.NewNopAuthorizer()),
 				)
 
 				err := s.Start()
@@ -0,0 +1,138 @@
+// The MIT License
+//
+// Copyright (c) 2020 Temporal Technologies Inc.  All rights reserved.
+//
+// Copyright (c) 2017 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package audit
+
+import (
+	"context"
+	"time"
+
+	commonpb "go.temporal.io/api/common/v1"
+
+	"go.temporal.io/server/common/headers"
+)
+
+const (
+	// SinkLog is the name of the sink writing audit records to the service log
+	SinkLog = "log"
+	// SinkFile is the name of the sink writing audit records to the audit file as JSON lines
+	SinkFile = "file"
+)
+
+const (
+	// DecisionAllow means the call was authorized
+	DecisionAllow = "allow"
+	// DecisionDeny means the call was not authorized
+	DecisionDeny = "deny"
+	// DecisionError means the authorization of the call failed
+	DecisionError = "error"
+)
+
+type (
+	// Record is the audit record of a mutating call
+	Record struct {
+		Time          time.Time `json:"time"`
+		Actor         string    `json:"actor,omitempty"`
+		APIName       string    `json:"apiName"`
+		Namespace     string    `json:"namespace,omitempty"`
+		WorkflowID    string    `json:"workflowId,omitempty"`
+		RunID         string    `json:"runId,omitempty"`
+		Reason        string    `json:"reason,omitempty"`
+		Identity      string    `json:"identity,omitempty"`
+		ClientName    string    `json:"clientName,omitempty"`
+		ClientVersion string    `json:"clientVersion,omitempty"`
+		// Decision is the authorization decision of the call
+		Decision string `json:"decision"`
+	}
+
+	// Sink is the AuditSink interface, a sink persists audit records and must be safe for concurrent use
+	Sink interface {
+		Write(record *Record) error
+		// Close releases the resources of the sink, no record is written after it
+		Close() error
+	}
+)
+
+// getters of the request fields recorded in audit records
+type (
+	workflowIDGetter interface {
+		GetWorkflowId() string
+	}
+	runIDGetter interface {
+		GetRunId() string
+	}
+	executionGetter interface {
+		GetExecution() *commonpb.WorkflowExecution
+	}
+	workflowExecutionGetter interface {
+		GetWorkflowExecution() *commonpb.WorkflowExecution
+	}
+	reasonGetter interface {
+		GetReason() string
+	}
+	identityGetter interface {
+		GetIdentity() string
+	}
+)
+
+// NewRecord creates the audit record of a call, the workflow, reason and identity of the record are taken from
+// the request and the client headers from the context
+func NewRecord(
+	ctx context.Context,
+	apiName string,
+	namespace string,
+	request interface{},
+) *Record {
+
+	clientHeaders := headers.GetValues(ctx, headers.ClientNameHeaderName, headers.ClientVersionHeaderName)
+	record := &Record{
+		Time:          time.Now().UTC(),
+		APIName:       apiName,
+		Namespace:     namespace,
+		ClientName:    clientHeaders[0],
+		ClientVersion: clientHeaders[1],
+	}
+
+	var execution *commonpb.WorkflowExecution
+	if r, ok := request.(executionGetter); ok {
+		execution = r.GetExecution()
+	} else if r, ok := request.(workflowExecutionGetter); ok {
+		execution = r.GetWorkflowExecution()
+	}
+	record.WorkflowID = execution.GetWorkflowId()
+	record.RunID = execution.GetRunId()
+	if r, ok := request.(workflowIDGetter); ok && record.WorkflowID == "" {
+		record.WorkflowID = r.GetWorkflowId()
+	}
+	if r, ok := request.(runIDGetter); ok && record.RunID == "" {
+		record.RunID = r.GetRunId()
+	}
+	if r, ok := request.(reasonGetter); ok {
+		record.Reason = r.GetReason()
+	}
+	if r, ok := request.(identityGetter); ok {
+		record.Identity = r.GetIdentity()
+	}
+	return record
+}
@@ -0,0 +1,137 @@
+// The MIT License
+//
+// Copyright (c) 2020 Temporal Technologies Inc.  All rights reserved.
+//
+// Copyright (c) 2017 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package audit
+
+import (
+	"bufio"
+	"context"
+	"encoding/json"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/suite"
+	commonpb "go.temporal.io/api/common/v1"
+	"go.temporal.io/api/workflowservice/v1"
+
+	"go.temporal.io/server/common/log/loggerimpl"
+)
+
+type (
+	auditSuite struct {
+		suite.Suite
+	}
+
+	recordingSink struct {
+		records []*Record
+		closed  bool
+	}
+)
+
+func TestAuditSuite(t *testing.T) {
+	suite.Run(t, new(auditSuite))
+}
+
+func (s *recordingSink) Write(record *Record) error {
+	s.records = append(s.records, record)
+	return nil
+}
+
+func (s *recordingSink) Close() error {
+	s.closed = true
+	return nil
+}
+
+func (s *auditSuite) TestNewRecord() {
+	record := NewRecord(context.Background(), "TerminateWorkflowExecution", "test-namespace", &workflowservice.TerminateWorkflowExecutionRequest{
+		Namespace: "test-namespace",
+		WorkflowExecution: &commonpb.WorkflowExecution{
+			WorkflowId: "test-workflow-id",
+			RunId:      "test-run-id",
+		},
+		Reason:   "test-reason",
+		Identity: "test-identity",
+	})
+	s.Equal("TerminateWorkflowExecution", record.APIName)
+	s.Equal("test-namespace", record.Namespace)
+	s.Equal("test-workflow-id", record.WorkflowID)
+	s.Equal("test-run-id", record.RunID)
+	s.Equal("test-reason", record.Reason)
+	s.Equal("test-identity", record.Identity)
+}
+
+func (s *auditSuite) TestLogger_SinkPerNamespace() {
+	sink := &recordingSink{}
+	sinkName := func(namespace string) string {
+		if namespace == "audited" {
+			return "test"
+		}
+		return ""
+	}
+	logger := NewLogger(map[string]Sink{"test": sink}, sinkName, loggerimpl.NewNopLogger())
+
+	logger.Log(context.Background(), &Record{APIName: "SignalWorkflowExecution", Namespace: "audited"})
+	logger.Log(context.Background(), &Record{APIName: "SignalWorkflowExecution", Namespace: "not-audited"})
+	s.Len(sink.records, 1)
+	s.Equal("audited", sink.records[0].Namespace)
+}
+
+func (s *auditSuite) TestLogger_Close() {
+	sink := &recordingSink{}
+	logger := NewLogger(map[string]Sink{"test": sink}, func(string) string { return "test" }, loggerimpl.NewNopLogger())
+
+	logger.Close()
+	s.True(sink.closed)
+}
+
+func (s *auditSuite) TestFileSink() {
+	dir, err := ioutil.TempDir("", "audit")
+	s.NoError(err)
+	defer os.RemoveAll(dir)
+
+	path := filepath.Join(dir, "audit.log")
+	sink, err := NewFileSink(path)
+	s.NoError(err)
+	s.NoError(sink.Write(&Record{APIName: "StartWorkflowExecution", Decision: DecisionAllow}))
+	s.NoError(sink.Write(&Record{APIName: "TerminateWorkflowExecution", Decision: DecisionDeny}))
+	s.NoError(sink.Close())
+	s.Error(sink.Write(&Record{APIName: "SignalWorkflowExecution", Decision: DecisionAllow}))
+
+	file, err := os.Open(path)
+	s.NoError(err)
+	defer file.Close()
+	var records []Record
+	scanner := bufio.NewScanner(file)
+	for scanner.Scan() {
+		var record Record
+		s.NoError(json.Unmarshal(scanner.Bytes(), &record))
+		records = append(records, record)
+	}
+	s.Len(records, 2)
+	s.Equal("StartWorkflowExecution", records[0].APIName)
+	s.Equal(DecisionAllow, records[0].Decision)
+	s.Equal(DecisionDeny, records[1].Decision)
+}
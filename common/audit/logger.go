@@ -0,0 +1,105 @@
+// The MIT License
+//
+// Copyright (c) 2020 Temporal Technologies Inc.  All rights reserved.
+//
+// Copyright (c) 2017 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package audit
+
+import (
+	"context"
+
+	"go.temporal.io/server/common/log"
+	"go.temporal.io/server/common/log/tag"
+	"go.temporal.io/server/common/service/dynamicconfig"
+)
+
+type (
+	// Logger writes audit records to the sink selected for their namespace
+	Logger interface {
+		Log(ctx context.Context, record *Record)
+		// Close closes all sinks of the logger, it is called once no more records are logged
+		Close()
+	}
+
+	loggerImpl struct {
+		sinks    map[string]Sink
+		sinkName dynamicconfig.StringPropertyFnWithNamespaceFilter
+		logger   log.Logger
+	}
+
+	nopLogger struct{}
+)
+
+var _ Logger = (*loggerImpl)(nil)
+
+// NewLogger creates an audit logger, sinkName returns the name of the sink of a namespace, records of namespaces
+// without a sink are dropped. The log sink is always available.
+func NewLogger(
+	sinks map[string]Sink,
+	sinkName dynamicconfig.StringPropertyFnWithNamespaceFilter,
+	logger log.Logger,
+) Logger {
+	logger = logger.WithTags(tag.ComponentAudit)
+	allSinks := map[string]Sink{
+		SinkLog: NewLogSink(logger),
+	}
+	for name, sink := range sinks {
+		allSinks[name] = sink
+	}
+	return &loggerImpl{
+		sinks:    allSinks,
+		sinkName: sinkName,
+		logger:   logger,
+	}
+}
+
+// NewNopLogger creates an audit logger dropping all records
+func NewNopLogger() Logger {
+	return &nopLogger{}
+}
+
+func (l *loggerImpl) Log(ctx context.Context, record *Record) {
+	name := l.sinkName(record.Namespace)
+	if name == "" {
+		return
+	}
+	sink, ok := l.sinks[name]
+	if !ok {
+		l.logger.Error("Unknown audit sink, audit record is dropped.", tag.Name(name), tag.AuditRecord(record))
+		return
+	}
+	if err := sink.Write(record); err != nil {
+		l.logger.Error("Unable to write audit record.", tag.Name(name), tag.AuditRecord(record), tag.Error(err))
+	}
+}
+
+func (l *loggerImpl) Close() {
+	for name, sink := range l.sinks {
+		if err := sink.Close(); err != nil {
+			l.logger.Error("Unable to close audit sink.", tag.Name(name), tag.Error(err))
+		}
+	}
+}
+
+func (l *nopLogger) Log(ctx context.Context, record *Record) {}
+
+func (l *nopLogger) Close() {}
@@ -0,0 +1,87 @@
+// The MIT License
+//
+// Copyright (c) 2020 Temporal Technologies Inc.  All rights reserved.
+//
+// Copyright (c) 2017 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package audit
+
+import (
+	"encoding/json"
+	"os"
+	"sync"
+
+	"go.temporal.io/server/common/log"
+	"go.temporal.io/server/common/log/tag"
+)
+
+type (
+	fileSink struct {
+		sync.Mutex
+		file *os.File
+	}
+
+	logSink struct {
+		logger log.Logger
+	}
+)
+
+// NewFileSink creates a sink appending audit records as JSON lines to the file at path
+func NewFileSink(path string) (Sink, error) {
+	file, err := os.OpenFile(path, os.O_WRONLY|os.O_APPEND|os.O_CREATE, 0600)
+	if err != nil {
+		return nil, err
+	}
+	return &fileSink{file: file}, nil
+}
+
+func (s *fileSink) Write(record *Record) error {
+	data, err := json.Marshal(record)
+	if err != nil {
+		return err
+	}
+	data = append(data, '\n')
+
+	s.Lock()
+	defer s.Unlock()
+	_, err = s.file.Write(data)
+	return err
+}
+
+func (s *fileSink) Close() error {
+	s.Lock()
+	defer s.Unlock()
+	return s.file.Close()
+}
+
+// NewLogSink creates a sink writing audit records to the logger
+func NewLogSink(logger log.Logger) Sink {
+	return &logSink{logger: logger}
+}
+
+func (s *logSink) Write(record *Record) error {
+	s.logger.Info("Audit record.", tag.AuditRecord(record))
+	return nil
+}
+
+func (s *logSink) Close() error {
+	return nil
+}
@@ -37,11 +37,18 @@ const (
 
 type (
 	// Attributes is input for authority to make decision.
-	// It can be extended in future if required auth on resources like WorkflowType and TaskQueue
 	Attributes struct {
 		Actor     string
 		APIName   string
 		Namespace string
+		// WorkflowID, WorkflowType and TaskQueue are set if the request targets them
+		WorkflowID   string
+		WorkflowType string
+		TaskQueue    string
+		// Request is the decoded request of the API call
+		Request interface{}
+		// Claims are the claims of the caller extracted by the ClaimMapper, nil if there are none
+		Claims *Claims
 	}
 
 	// Result is result from authority.
@@ -0,0 +1,67 @@
+// The MIT License
+//
+// Copyright (c) 2020 Temporal Technologies Inc.  All rights reserved.
+//
+// Copyright (c) 2017 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package authorization
+
+import (
+	"go.temporal.io/server/common/service/config"
+)
+
+type (
+	// AuthInfo contains the credentials of a caller
+	AuthInfo struct {
+		// AuthToken is the value of the authorization header of the call
+		AuthToken string
+	}
+
+	// ClaimMapper extracts the claims of a caller from its credentials
+	ClaimMapper interface {
+		GetClaims(authInfo *AuthInfo) (*Claims, error)
+	}
+
+	nopClaimMapper struct{}
+)
+
+// NewNopClaimMapper creates a claim mapper which never returns claims
+func NewNopClaimMapper() ClaimMapper {
+	return &nopClaimMapper{}
+}
+
+func (m *nopClaimMapper) GetClaims(authInfo *AuthInfo) (*Claims, error) {
+	return nil, nil
+}
+
+// GetClaimMapperFromConfig creates the claim mapper configured by the authorization config
+func GetClaimMapperFromConfig(cfg *config.Authorization) (ClaimMapper, error) {
+	switch cfg.ClaimMapper {
+	case config.ClaimMapperDefault:
+		keyProvider, err := NewStaticKeyProvider(&cfg.JWTKeyProvider)
+		if err != nil {
+			return nil, err
+		}
+		return NewDefaultJWTClaimMapper(keyProvider, cfg), nil
+	default:
+		return NewNopClaimMapper(), nil
+	}
+}
@@ -0,0 +1,142 @@
+// The MIT License
+//
+// Copyright (c) 2020 Temporal Technologies Inc.  All rights reserved.
+//
+// Copyright (c) 2017 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package authorization
+
+import (
+	"context"
+	"strings"
+
+	"go.temporal.io/server/common/service/config"
+)
+
+var (
+	// readOnlyAPIPrefixes are the prefixes of the APIs which don't change any state
+	readOnlyAPIPrefixes = []string{"Count", "Describe", "Get", "List", "Scan", "Query"}
+	// namespaceAdminAPIs are the APIs changing a namespace
+	namespaceAdminAPIs = map[string]struct{}{
+		"UpdateNamespace":    {},
+		"DeprecateNamespace": {},
+	}
+	// systemAdminAPIs are the APIs which require the admin role on the cluster
+	systemAdminAPIs = map[string]struct{}{
+		"RegisterNamespace": {},
+	}
+)
+
+type (
+	defaultAuthorizer struct {
+		// namespaceRoles maps namespace names and subjects to the roles granted by the config
+		namespaceRoles map[string]map[string]Role
+	}
+)
+
+var _ Authorizer = (*defaultAuthorizer)(nil)
+
+// NewDefaultAuthorizer creates a role based authorizer. Read only APIs require the reader role on the namespace,
+// namespace changes require the admin role and the other APIs require the writer role. APIs without a namespace
+// require the role on the cluster. The roles of the caller are taken from its claims and from the namespace
+// roles of the config.
+func NewDefaultAuthorizer(cfg *config.Authorization) Authorizer {
+	namespaceRoles := make(map[string]map[string]Role, len(cfg.NamespaceRoles))
+	for namespace, roles := range cfg.NamespaceRoles {
+		subjects := make(map[string]Role)
+		grant := func(names []string, role Role) {
+			for _, name := range names {
+				if role > subjects[name] {
+					subjects[name] = role
+				}
+			}
+		}
+		grant(roles.Readers, RoleReader)
+		grant(roles.Writers, RoleWriter)
+		grant(roles.Admins, RoleAdmin)
+		namespaceRoles[namespace] = subjects
+	}
+	return &defaultAuthorizer{
+		namespaceRoles: namespaceRoles,
+	}
+}
+
+// GetAuthorizerFromConfig creates the authorizer configured by the authorization config
+func GetAuthorizerFromConfig(cfg *config.Authorization) Authorizer {
+	switch cfg.Authorizer {
+	case config.AuthorizerDefault:
+		return NewDefaultAuthorizer(cfg)
+	default:
+		return NewNopAuthorizer()
+	}
+}
+
+func (a *defaultAuthorizer) Authorize(
+	ctx context.Context,
+	attributes *Attributes,
+) (Result, error) {
+
+	claims := attributes.Claims
+	if claims == nil {
+		return Result{Decision: DecisionDeny}, nil
+	}
+	if claims.System >= RoleAdmin {
+		return Result{Decision: DecisionAllow}, nil
+	}
+
+	var role Role
+	if _, ok := systemAdminAPIs[attributes.APIName]; ok || attributes.Namespace == "" {
+		role = claims.System
+	} else {
+		role = claims.GetNamespaceRole(attributes.Namespace)
+		if configRole := a.namespaceRoles[attributes.Namespace][claims.Subject]; claims.Subject != "" && configRole > role {
+			role = configRole
+		}
+	}
+
+	if role >= getRequiredRole(attributes.APIName) {
+		return Result{Decision: DecisionAllow}, nil
+	}
+	return Result{Decision: DecisionDeny}, nil
+}
+
+func getRequiredRole(apiName string) Role {
+	if _, ok := systemAdminAPIs[apiName]; ok {
+		return RoleAdmin
+	}
+	if _, ok := namespaceAdminAPIs[apiName]; ok {
+		return RoleAdmin
+	}
+	if IsReadOnlyAPI(apiName) {
+		return RoleReader
+	}
+	return RoleWriter
+}
+
+// IsReadOnlyAPI returns true if the API doesn't change any state
+func IsReadOnlyAPI(apiName string) bool {
+	for _, prefix := range readOnlyAPIPrefixes {
+		if strings.HasPrefix(apiName, prefix) {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,109 @@
+// The MIT License
+//
+// Copyright (c) 2020 Temporal Technologies Inc.  All rights reserved.
+//
+// Copyright (c) 2017 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package authorization
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"github.com/stretchr/testify/suite"
+
+	"go.temporal.io/server/common/service/config"
+)
+
+type (
+	defaultAuthorizerSuite struct {
+		suite.Suite
+		*require.Assertions
+
+		authorizer Authorizer
+	}
+)
+
+func TestDefaultAuthorizerSuite(t *testing.T) {
+	suite.Run(t, new(defaultAuthorizerSuite))
+}
+
+func (s *defaultAuthorizerSuite) SetupTest() {
+	s.Assertions = require.New(s.T())
+	s.authorizer = NewDefaultAuthorizer(&config.Authorization{
+		NamespaceRoles: map[string]config.NamespaceRoles{
+			"test-namespace": {
+				Readers: []string{"config-reader"},
+				Admins:  []string{"config-admin"},
+			},
+		},
+	})
+}
+
+func (s *defaultAuthorizerSuite) TestNoClaims() {
+	s.assertDecision(DecisionDeny, &Attributes{APIName: "DescribeNamespace", Namespace: "test-namespace"})
+}
+
+func (s *defaultAuthorizerSuite) TestSystemAdmin() {
+	claims := &Claims{Subject: "admin", System: RoleAdmin}
+	s.assertDecision(DecisionAllow, &Attributes{APIName: "RegisterNamespace", Namespace: "new-namespace", Claims: claims})
+	s.assertDecision(DecisionAllow, &Attributes{APIName: "StartWorkflowExecution", Namespace: "test-namespace", Claims: claims})
+	s.assertDecision(DecisionAllow, &Attributes{APIName: "ListNamespaces", Claims: claims})
+}
+
+func (s *defaultAuthorizerSuite) TestNamespaceRoles() {
+	reader := &Claims{Subject: "reader", Namespaces: map[string]Role{"test-namespace": RoleReader}}
+	s.assertDecision(DecisionAllow, &Attributes{APIName: "DescribeWorkflowExecution", Namespace: "test-namespace", Claims: reader})
+	s.assertDecision(DecisionAllow, &Attributes{APIName: "QueryWorkflow", Namespace: "test-namespace", Claims: reader})
+	s.assertDecision(DecisionDeny, &Attributes{APIName: "StartWorkflowExecution", Namespace: "test-namespace", Claims: reader})
+	s.assertDecision(DecisionDeny, &Attributes{APIName: "DescribeWorkflowExecution", Namespace: "other-namespace", Claims: reader})
+	s.assertDecision(DecisionDeny, &Attributes{APIName: "ListNamespaces", Claims: reader})
+
+	writer := &Claims{Subject: "writer", Namespaces: map[string]Role{"test-namespace": RoleWriter}}
+	s.assertDecision(DecisionAllow, &Attributes{APIName: "StartWorkflowExecution", Namespace: "test-namespace", Claims: writer})
+	s.assertDecision(DecisionAllow, &Attributes{APIName: "PollWorkflowTaskQueue", Namespace: "test-namespace", Claims: writer})
+	s.assertDecision(DecisionDeny, &Attributes{APIName: "UpdateNamespace", Namespace: "test-namespace", Claims: writer})
+
+	admin := &Claims{Subject: "admin", Namespaces: map[string]Role{"test-namespace": RoleAdmin}}
+	s.assertDecision(DecisionAllow, &Attributes{APIName: "UpdateNamespace", Namespace: "test-namespace", Claims: admin})
+	s.assertDecision(DecisionDeny, &Attributes{APIName: "RegisterNamespace", Namespace: "test-namespace", Claims: admin})
+}
+
+func (s *defaultAuthorizerSuite) TestSystemReader() {
+	claims := &Claims{Subject: "reader", System: RoleReader}
+	s.assertDecision(DecisionAllow, &Attributes{APIName: "ListNamespaces", Claims: claims})
+	s.assertDecision(DecisionAllow, &Attributes{APIName: "DescribeWorkflowExecution", Namespace: "test-namespace", Claims: claims})
+	s.assertDecision(DecisionDeny, &Attributes{APIName: "SignalWorkflowExecution", Namespace: "test-namespace", Claims: claims})
+}
+
+func (s *defaultAuthorizerSuite) TestConfigRoles() {
+	s.assertDecision(DecisionAllow, &Attributes{APIName: "ListWorkflowExecutions", Namespace: "test-namespace", Claims: &Claims{Subject: "config-reader"}})
+	s.assertDecision(DecisionDeny, &Attributes{APIName: "TerminateWorkflowExecution", Namespace: "test-namespace", Claims: &Claims{Subject: "config-reader"}})
+	s.assertDecision(DecisionAllow, &Attributes{APIName: "UpdateNamespace", Namespace: "test-namespace", Claims: &Claims{Subject: "config-admin"}})
+	s.assertDecision(DecisionDeny, &Attributes{APIName: "UpdateNamespace", Namespace: "other-namespace", Claims: &Claims{Subject: "config-admin"}})
+}
+
+func (s *defaultAuthorizerSuite) assertDecision(expected Decision, attributes *Attributes) {
+	result, err := s.authorizer.Authorize(context.Background(), attributes)
+	s.NoError(err)
+	s.Equal(expected, result.Decision, "API %v", attributes.APIName)
+}
@@ -0,0 +1,120 @@
+// The MIT License
+//
+// Copyright (c) 2020 Temporal Technologies Inc.  All rights reserved.
+//
+// Copyright (c) 2017 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package authorization
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"go.temporal.io/server/common/service/config"
+)
+
+const (
+	defaultPermissionsClaimName = "permissions"
+	// systemPermissionNamespace is the namespace of the permissions granting a role on the cluster
+	systemPermissionNamespace = "system"
+	authorizationBearer       = "bearer"
+)
+
+type (
+	defaultJWTClaimMapper struct {
+		keyProvider          TokenKeyProvider
+		issuer               string
+		audience             string
+		permissionsClaimName string
+		timeSource           func() time.Time
+	}
+)
+
+var _ ClaimMapper = (*defaultJWTClaimMapper)(nil)
+
+// NewDefaultJWTClaimMapper creates a claim mapper extracting claims from a bearer JWT issued by the configured
+// issuer for the configured audience. The subject is the "sub" claim and roles are taken from the permissions
+// claim, permissions have the form "<namespace>:<role>", the "system" namespace grants the role on the whole cluster.
+func NewDefaultJWTClaimMapper(keyProvider TokenKeyProvider, cfg *config.Authorization) ClaimMapper {
+	permissionsClaimName := cfg.PermissionsClaimName
+	if permissionsClaimName == "" {
+		permissionsClaimName = defaultPermissionsClaimName
+	}
+	return &defaultJWTClaimMapper{
+		keyProvider:          keyProvider,
+		issuer:               cfg.JWTIssuer,
+		audience:             cfg.JWTAudience,
+		permissionsClaimName: permissionsClaimName,
+		timeSource:           time.Now,
+	}
+}
+
+func (m *defaultJWTClaimMapper) GetClaims(authInfo *AuthInfo) (*Claims, error) {
+	if authInfo == nil || authInfo.AuthToken == "" {
+		return nil, nil
+	}
+
+	parts := strings.SplitN(authInfo.AuthToken, " ", 2)
+	if len(parts) != 2 || strings.ToLower(parts[0]) != authorizationBearer {
+		return nil, errors.New("unsupported authorization token type")
+	}
+	jwtClaims, err := parseJWT(strings.TrimSpace(parts[1]), m.keyProvider, m.issuer, m.audience, m.timeSource())
+	if err != nil {
+		return nil, err
+	}
+
+	subject, _ := jwtClaims["sub"].(string)
+	claims := &Claims{
+		Subject:    subject,
+		Namespaces: make(map[string]Role),
+	}
+	permissions, ok := jwtClaims[m.permissionsClaimName].([]interface{})
+	if !ok {
+		return claims, nil
+	}
+	for _, p := range permissions {
+		permission, ok := p.(string)
+		if !ok {
+			return nil, fmt.Errorf("invalid permission %v", p)
+		}
+		// the role follows the last colon, so namespace names may contain colons
+		index := strings.LastIndex(permission, ":")
+		if index <= 0 {
+			return nil, fmt.Errorf("invalid permission %q", permission)
+		}
+		namespace := permission[:index]
+		role, ok := ParseRole(permission[index+1:])
+		if !ok {
+			// unknown roles are ignored, so tokens can carry permissions of other services
+			continue
+		}
+		if namespace == systemPermissionNamespace {
+			if role > claims.System {
+				claims.System = role
+			}
+		} else if role > claims.Namespaces[namespace] {
+			claims.Namespaces[namespace] = role
+		}
+	}
+	return claims, nil
+}
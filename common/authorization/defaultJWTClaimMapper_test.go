@@ -0,0 +1,244 @@
+// The MIT License
+//
+// Copyright (c) 2020 Temporal Technologies Inc.  All rights reserved.
+//
+// Copyright (c) 2017 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package authorization
+
+import (
+	"crypto"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/rsa"
+	"testing"
+	"time"
+
+	"github.com/golang-jwt/jwt/v4"
+	"github.com/stretchr/testify/require"
+	"github.com/stretchr/testify/suite"
+
+	"go.temporal.io/server/common/service/config"
+)
+
+const (
+	testRSAKeyID       = "test-rsa-key"
+	testECDSAKeyID     = "test-ecdsa-key"
+	testECDSAP384KeyID = "test-ecdsa-p384-key"
+	testIssuer         = "test-issuer"
+	testAudience       = "test-audience"
+)
+
+type (
+	defaultJWTClaimMapperSuite struct {
+		suite.Suite
+		*require.Assertions
+
+		rsaKey       *rsa.PrivateKey
+		ecdsaKey     *ecdsa.PrivateKey
+		ecdsaP384Key *ecdsa.PrivateKey
+		claimMapper  ClaimMapper
+	}
+)
+
+func TestDefaultJWTClaimMapperSuite(t *testing.T) {
+	suite.Run(t, new(defaultJWTClaimMapperSuite))
+}
+
+func (s *defaultJWTClaimMapperSuite) SetupSuite() {
+	var err error
+	s.rsaKey, err = rsa.GenerateKey(rand.Reader, 2048)
+	require.NoError(s.T(), err)
+	s.ecdsaKey, err = ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	require.NoError(s.T(), err)
+	s.ecdsaP384Key, err = ecdsa.GenerateKey(elliptic.P384(), rand.Reader)
+	require.NoError(s.T(), err)
+}
+
+func (s *defaultJWTClaimMapperSuite) SetupTest() {
+	s.Assertions = require.New(s.T())
+	keyProvider := NewStaticKeyProviderFromKeys(map[string]crypto.PublicKey{
+		testRSAKeyID:       &s.rsaKey.PublicKey,
+		testECDSAKeyID:     &s.ecdsaKey.PublicKey,
+		testECDSAP384KeyID: &s.ecdsaP384Key.PublicKey,
+	})
+	s.claimMapper = NewDefaultJWTClaimMapper(keyProvider, &config.Authorization{
+		JWTIssuer:   testIssuer,
+		JWTAudience: testAudience,
+	})
+}
+
+func (s *defaultJWTClaimMapperSuite) TestNoToken() {
+	claims, err := s.claimMapper.GetClaims(&AuthInfo{})
+	s.NoError(err)
+	s.Nil(claims)
+}
+
+func (s *defaultJWTClaimMapperSuite) TestRSAToken() {
+	token := s.sign(jwt.SigningMethodRS256, s.rsaKey, testRSAKeyID, s.claims(jwt.MapClaims{
+		"exp":         time.Now().Add(time.Hour).Unix(),
+		"permissions": []string{"system:read", "test-namespace:admin", "other-namespace:write", "other:unknown"},
+	}))
+	claims, err := s.claimMapper.GetClaims(&AuthInfo{AuthToken: "Bearer " + token})
+	s.NoError(err)
+	s.Equal(&Claims{
+		Subject: "test-subject",
+		System:  RoleReader,
+		Namespaces: map[string]Role{
+			"test-namespace":  RoleAdmin,
+			"other-namespace": RoleWriter,
+		},
+	}, claims)
+}
+
+func (s *defaultJWTClaimMapperSuite) TestECDSAToken() {
+	token := s.sign(jwt.SigningMethodES256, s.ecdsaKey, testECDSAKeyID, s.claims(jwt.MapClaims{
+		"permissions": []string{"test-namespace:read"},
+	}))
+	claims, err := s.claimMapper.GetClaims(&AuthInfo{AuthToken: "bearer " + token})
+	s.NoError(err)
+	s.Equal("test-subject", claims.Subject)
+	s.Equal(RoleReader, claims.GetNamespaceRole("test-namespace"))
+
+	token = s.sign(jwt.SigningMethodES384, s.ecdsaP384Key, testECDSAP384KeyID, s.claims(jwt.MapClaims{
+		"permissions": []string{"test-namespace:write"},
+	}))
+	claims, err = s.claimMapper.GetClaims(&AuthInfo{AuthToken: "bearer " + token})
+	s.NoError(err)
+	s.Equal(RoleWriter, claims.GetNamespaceRole("test-namespace"))
+}
+
+func (s *defaultJWTClaimMapperSuite) TestAudience() {
+	// the audience may be a list
+	token := s.sign(jwt.SigningMethodRS256, s.rsaKey, testRSAKeyID, s.claims(jwt.MapClaims{
+		"aud": []string{"other-audience", testAudience},
+	}))
+	_, err := s.claimMapper.GetClaims(&AuthInfo{AuthToken: "Bearer " + token})
+	s.NoError(err)
+
+	wrongAudience := s.sign(jwt.SigningMethodRS256, s.rsaKey, testRSAKeyID, s.claims(jwt.MapClaims{
+		"aud": "other-audience",
+	}))
+	_, err = s.claimMapper.GetClaims(&AuthInfo{AuthToken: "Bearer " + wrongAudience})
+	s.Equal(errInvalidAudience, err)
+
+	noAudience := s.claims(nil)
+	delete(noAudience, "aud")
+	token = s.sign(jwt.SigningMethodRS256, s.rsaKey, testRSAKeyID, noAudience)
+	_, err = s.claimMapper.GetClaims(&AuthInfo{AuthToken: "Bearer " + token})
+	s.Equal(errInvalidAudience, err)
+}
+
+func (s *defaultJWTClaimMapperSuite) TestIssuer() {
+	wrongIssuer := s.sign(jwt.SigningMethodRS256, s.rsaKey, testRSAKeyID, s.claims(jwt.MapClaims{
+		"iss": "other-issuer",
+	}))
+	_, err := s.claimMapper.GetClaims(&AuthInfo{AuthToken: "Bearer " + wrongIssuer})
+	s.Equal(errInvalidIssuer, err)
+
+	noIssuer := s.claims(nil)
+	delete(noIssuer, "iss")
+	token := s.sign(jwt.SigningMethodRS256, s.rsaKey, testRSAKeyID, noIssuer)
+	_, err = s.claimMapper.GetClaims(&AuthInfo{AuthToken: "Bearer " + token})
+	s.Equal(errInvalidIssuer, err)
+}
+
+func (s *defaultJWTClaimMapperSuite) TestAlgorithmMismatch() {
+	// an ES256 token verified with a P-384 key
+	token := s.sign(jwt.SigningMethodES256, s.ecdsaKey, testECDSAP384KeyID, s.claims(nil))
+	_, err := s.claimMapper.GetClaims(&AuthInfo{AuthToken: "Bearer " + token})
+	s.Error(err)
+
+	// an ES384 token verified with a P-256 key
+	token = s.sign(jwt.SigningMethodES384, s.ecdsaP384Key, testECDSAKeyID, s.claims(nil))
+	_, err = s.claimMapper.GetClaims(&AuthInfo{AuthToken: "Bearer " + token})
+	s.Error(err)
+
+	// an RSA signature doesn't verify with the ECDSA key
+	token = s.sign(jwt.SigningMethodRS256, s.rsaKey, testECDSAKeyID, s.claims(nil))
+	_, err = s.claimMapper.GetClaims(&AuthInfo{AuthToken: "Bearer " + token})
+	s.Error(err)
+
+	// an ECDSA signature doesn't verify with the RSA key
+	token = s.sign(jwt.SigningMethodES256, s.ecdsaKey, testRSAKeyID, s.claims(nil))
+	_, err = s.claimMapper.GetClaims(&AuthInfo{AuthToken: "Bearer " + token})
+	s.Error(err)
+
+	// symmetric algorithms are refused, their key would be the public key of the config
+	token = s.sign(jwt.SigningMethodHS256, []byte("secret"), testRSAKeyID, s.claims(nil))
+	_, err = s.claimMapper.GetClaims(&AuthInfo{AuthToken: "Bearer " + token})
+	s.Error(err)
+
+	token = s.sign(jwt.SigningMethodNone, jwt.UnsafeAllowNoneSignatureType, testRSAKeyID, s.claims(nil))
+	_, err = s.claimMapper.GetClaims(&AuthInfo{AuthToken: "Bearer " + token})
+	s.Error(err)
+}
+
+func (s *defaultJWTClaimMapperSuite) TestInvalidTokens() {
+	_, err := s.claimMapper.GetClaims(&AuthInfo{AuthToken: "Basic dXNlcjpwYXNzd29yZA=="})
+	s.Error(err)
+
+	_, err = s.claimMapper.GetClaims(&AuthInfo{AuthToken: "Bearer not-a-token"})
+	s.Error(err)
+
+	expired := s.sign(jwt.SigningMethodRS256, s.rsaKey, testRSAKeyID, s.claims(jwt.MapClaims{
+		"exp": time.Now().Add(-time.Hour).Unix(),
+	}))
+	_, err = s.claimMapper.GetClaims(&AuthInfo{AuthToken: "Bearer " + expired})
+	s.Equal(errTokenExpired, err)
+
+	notValidYet := s.sign(jwt.SigningMethodRS256, s.rsaKey, testRSAKeyID, s.claims(jwt.MapClaims{
+		"nbf": time.Now().Add(time.Hour).Unix(),
+	}))
+	_, err = s.claimMapper.GetClaims(&AuthInfo{AuthToken: "Bearer " + notValidYet})
+	s.Equal(errTokenNotValid, err)
+
+	unknownKey := s.sign(jwt.SigningMethodRS256, s.rsaKey, "unknown-key", s.claims(nil))
+	_, err = s.claimMapper.GetClaims(&AuthInfo{AuthToken: "Bearer " + unknownKey})
+	s.Error(err)
+
+	valid := s.sign(jwt.SigningMethodRS256, s.rsaKey, testRSAKeyID, s.claims(nil))
+	tampered := valid[:len(valid)-4] + "AAAA"
+	_, err = s.claimMapper.GetClaims(&AuthInfo{AuthToken: "Bearer " + tampered})
+	s.Error(err)
+}
+
+// claims returns valid claims for the test issuer and audience, overridden by the given claims
+func (s *defaultJWTClaimMapperSuite) claims(overrides jwt.MapClaims) jwt.MapClaims {
+	claims := jwt.MapClaims{
+		"sub": "test-subject",
+		"iss": testIssuer,
+		"aud": testAudience,
+	}
+	for name, value := range overrides {
+		claims[name] = value
+	}
+	return claims
+}
+
+func (s *defaultJWTClaimMapperSuite) sign(method jwt.SigningMethod, key interface{}, keyID string, claims jwt.MapClaims) string {
+	token := jwt.NewWithClaims(method, claims)
+	token.Header["kid"] = keyID
+	signed, err := token.SignedString(key)
+	s.NoError(err)
+	return signed
+}
@@ -0,0 +1,118 @@
+// The MIT License
+//
+// Copyright (c) 2020 Temporal Technologies Inc.  All rights reserved.
+//
+// Copyright (c) 2017 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package authorization
+
+import (
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rsa"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/golang-jwt/jwt/v4"
+)
+
+var (
+	errTokenExpired    = errors.New("token is expired")
+	errTokenNotValid   = errors.New("token is not valid yet")
+	errInvalidIssuer   = errors.New("token has an invalid issuer")
+	errInvalidAudience = errors.New("token has an invalid audience")
+)
+
+var (
+	// jwtSigningMethods are the supported algorithms, only asymmetric ones so tokens can't be signed
+	// with the keys of the config
+	jwtSigningMethods = []string{"RS256", "RS384", "RS512", "ES256", "ES384", "ES512"}
+	// jwtCurves are the curves of the keys of the ES* algorithms
+	jwtCurves = map[string]elliptic.Curve{
+		"ES256": elliptic.P256(),
+		"ES384": elliptic.P384(),
+		"ES512": elliptic.P521(),
+	}
+)
+
+// parseJWT verifies the signature, the issuer, the audience and the time window of a compact serialized JWT
+// and returns its claims
+func parseJWT(
+	token string,
+	keyProvider TokenKeyProvider,
+	issuer string,
+	audience string,
+	now time.Time,
+) (jwt.MapClaims, error) {
+
+	claims := jwt.MapClaims{}
+	parser := jwt.NewParser(jwt.WithValidMethods(jwtSigningMethods), jwt.WithoutClaimsValidation())
+	if _, err := parser.ParseWithClaims(token, claims, func(token *jwt.Token) (interface{}, error) {
+		keyID, _ := token.Header["kid"].(string)
+		key, err := keyProvider.GetKey(keyID)
+		if err != nil {
+			return nil, err
+		}
+		if err := verifyJWTKey(token.Method.Alg(), key); err != nil {
+			return nil, err
+		}
+		return key, nil
+	}); err != nil {
+		return nil, err
+	}
+
+	if !claims.VerifyIssuer(issuer, true) {
+		return nil, errInvalidIssuer
+	}
+	if !claims.VerifyAudience(audience, true) {
+		return nil, errInvalidAudience
+	}
+	if !claims.VerifyExpiresAt(now.Unix(), false) {
+		return nil, errTokenExpired
+	}
+	if !claims.VerifyNotBefore(now.Unix(), false) {
+		return nil, errTokenNotValid
+	}
+	return claims, nil
+}
+
+// verifyJWTKey checks that a key can verify the signatures of an algorithm, ES* algorithms are bound to a curve
+func verifyJWTKey(algorithm string, key interface{}) error {
+	switch key := key.(type) {
+	case *rsa.PublicKey:
+		if _, ok := jwtCurves[algorithm]; ok {
+			return fmt.Errorf("signing algorithm %q does not match RSA key", algorithm)
+		}
+		return nil
+	case *ecdsa.PublicKey:
+		curve, ok := jwtCurves[algorithm]
+		if !ok {
+			return fmt.Errorf("signing algorithm %q does not match ECDSA key", algorithm)
+		}
+		if key.Curve != curve {
+			return fmt.Errorf("signing algorithm %q does not match curve %v of ECDSA key", algorithm, key.Curve.Params().Name)
+		}
+		return nil
+	default:
+		return fmt.Errorf("unsupported key type %T", key)
+	}
+}
@@ -0,0 +1,81 @@
+// The MIT License
+//
+// Copyright (c) 2020 Temporal Technologies Inc.  All rights reserved.
+//
+// Copyright (c) 2017 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package authorization
+
+import (
+	"strings"
+)
+
+const (
+	// RoleUndefined means the caller has no access
+	RoleUndefined Role = iota
+	// RoleReader allows read only APIs
+	RoleReader
+	// RoleWriter allows all the APIs of the RoleReader and the APIs changing workflows and task queues
+	RoleWriter
+	// RoleAdmin allows all the APIs of the RoleWriter and the APIs changing namespaces
+	RoleAdmin
+)
+
+type (
+	// Role is the level of access of a caller, each role includes the access of the roles below it
+	Role int
+
+	// Claims are the identity and the roles of a caller
+	Claims struct {
+		// Subject is the identity of the caller
+		Subject string
+		// System is the role of the caller on the cluster, it applies to all namespaces
+		System Role
+		// Namespaces maps namespace names to the role of the caller on the namespace
+		Namespaces map[string]Role
+	}
+)
+
+// ParseRole converts the name of a role, as used in tokens and config, to a Role
+func ParseRole(name string) (Role, bool) {
+	switch strings.ToLower(name) {
+	case "read", "reader":
+		return RoleReader, true
+	case "write", "writer":
+		return RoleWriter, true
+	case "admin":
+		return RoleAdmin, true
+	default:
+		return RoleUndefined, false
+	}
+}
+
+// GetNamespaceRole returns the role of the caller on a namespace, it is safe to call on nil
+func (c *Claims) GetNamespaceRole(namespace string) Role {
+	if c == nil {
+		return RoleUndefined
+	}
+	role := c.System
+	if namespaceRole := c.Namespaces[namespace]; namespaceRole > role {
+		role = namespaceRole
+	}
+	return role
+}
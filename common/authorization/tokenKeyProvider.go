@@ -0,0 +1,111 @@
+// The MIT License
+//
+// Copyright (c) 2020 Temporal Technologies Inc.  All rights reserved.
+//
+// Copyright (c) 2017 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package authorization
+
+import (
+	"crypto"
+	"crypto/ecdsa"
+	"crypto/rsa"
+	"crypto/x509"
+	"encoding/pem"
+	"fmt"
+	"io/ioutil"
+
+	"go.temporal.io/server/common/service/config"
+)
+
+type (
+	// TokenKeyProvider provides the public keys verifying the signature of tokens
+	TokenKeyProvider interface {
+		// GetKey returns the RSA or ECDSA public key with the given key ID
+		GetKey(keyID string) (crypto.PublicKey, error)
+	}
+
+	staticKeyProvider struct {
+		keys map[string]crypto.PublicKey
+	}
+)
+
+// NewStaticKeyProvider creates a key provider serving the keys of the key files of the config,
+// the files are only read once
+func NewStaticKeyProvider(cfg *config.JWTKeyProvider) (TokenKeyProvider, error) {
+	keys := make(map[string]crypto.PublicKey, len(cfg.KeyFiles))
+	for keyID, path := range cfg.KeyFiles {
+		data, err := ioutil.ReadFile(path)
+		if err != nil {
+			return nil, fmt.Errorf("unable to read key file %q: %w", path, err)
+		}
+		key, err := parsePublicKey(data)
+		if err != nil {
+			return nil, fmt.Errorf("unable to parse key file %q: %w", path, err)
+		}
+		keys[keyID] = key
+	}
+	return &staticKeyProvider{keys: keys}, nil
+}
+
+// NewStaticKeyProviderFromKeys creates a key provider serving the given keys
+func NewStaticKeyProviderFromKeys(keys map[string]crypto.PublicKey) TokenKeyProvider {
+	return &staticKeyProvider{keys: keys}
+}
+
+func (p *staticKeyProvider) GetKey(keyID string) (crypto.PublicKey, error) {
+	key, ok := p.keys[keyID]
+	if !ok {
+		return nil, fmt.Errorf("unknown key ID %q", keyID)
+	}
+	return key, nil
+}
+
+func parsePublicKey(data []byte) (crypto.PublicKey, error) {
+	block, _ := pem.Decode(data)
+	if block == nil {
+		return nil, fmt.Errorf("no PEM data found")
+	}
+
+	var key interface{}
+	var err error
+	switch block.Type {
+	case "CERTIFICATE":
+		var cert *x509.Certificate
+		if cert, err = x509.ParseCertificate(block.Bytes); err == nil {
+			key = cert.PublicKey
+		}
+	case "RSA PUBLIC KEY":
+		key, err = x509.ParsePKCS1PublicKey(block.Bytes)
+	default:
+		key, err = x509.ParsePKIXPublicKey(block.Bytes)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	switch key := key.(type) {
+	case *rsa.PublicKey, *ecdsa.PublicKey:
+		return key, nil
+	default:
+		return nil, fmt.Errorf("unsupported key type %T", key)
+	}
+}
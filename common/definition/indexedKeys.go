@@ -51,8 +51,11 @@ const (
 	CustomBoolField       = "CustomBoolField"
 	CustomDatetimeField   = "CustomDatetimeField"
 	TemporalChangeVersion = "TemporalChangeVersion"
-	CustomNamespace       = "CustomNamespace"
-	Operator              = "Operator"
+	TemporalPaused        = "TemporalPaused"
+	// TemporalScheduleNamespace is the namespace of the schedule a scheduler workflow belongs to
+	TemporalScheduleNamespace = "TemporalScheduleNamespace"
+	CustomNamespace           = "CustomNamespace"
+	Operator                  = "Operator"
 )
 
 // valid non-indexed fields on ES
@@ -68,16 +71,18 @@ var defaultIndexedKeys = createDefaultIndexedKeys()
 
 func createDefaultIndexedKeys() map[string]interface{} {
 	defaultIndexedKeys := map[string]interface{}{
-		CustomStringField:     enumspb.INDEXED_VALUE_TYPE_STRING,
-		CustomKeywordField:    enumspb.INDEXED_VALUE_TYPE_KEYWORD,
-		CustomIntField:        enumspb.INDEXED_VALUE_TYPE_INT,
-		CustomDoubleField:     enumspb.INDEXED_VALUE_TYPE_DOUBLE,
-		CustomBoolField:       enumspb.INDEXED_VALUE_TYPE_BOOL,
-		CustomDatetimeField:   enumspb.INDEXED_VALUE_TYPE_DATETIME,
-		TemporalChangeVersion: enumspb.INDEXED_VALUE_TYPE_KEYWORD,
-		BinaryChecksums:       enumspb.INDEXED_VALUE_TYPE_KEYWORD,
-		CustomNamespace:       enumspb.INDEXED_VALUE_TYPE_KEYWORD,
-		Operator:              enumspb.INDEXED_VALUE_TYPE_KEYWORD,
+		CustomStringField:         enumspb.INDEXED_VALUE_TYPE_STRING,
+		CustomKeywordField:        enumspb.INDEXED_VALUE_TYPE_KEYWORD,
+		CustomIntField:            enumspb.INDEXED_VALUE_TYPE_INT,
+		CustomDoubleField:         enumspb.INDEXED_VALUE_TYPE_DOUBLE,
+		CustomBoolField:           enumspb.INDEXED_VALUE_TYPE_BOOL,
+		CustomDatetimeField:       enumspb.INDEXED_VALUE_TYPE_DATETIME,
+		TemporalChangeVersion:     enumspb.INDEXED_VALUE_TYPE_KEYWORD,
+		TemporalPaused:            enumspb.INDEXED_VALUE_TYPE_BOOL,
+		TemporalScheduleNamespace: enumspb.INDEXED_VALUE_TYPE_KEYWORD,
+		BinaryChecksums:           enumspb.INDEXED_VALUE_TYPE_KEYWORD,
+		CustomNamespace:           enumspb.INDEXED_VALUE_TYPE_KEYWORD,
+		Operator:                  enumspb.INDEXED_VALUE_TYPE_KEYWORD,
 	}
 	for k, v := range systemIndexedKeys {
 		defaultIndexedKeys[k] = v
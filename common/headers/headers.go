@@ -26,7 +26,9 @@ package headers
 
 import (
 	"context"
+	"strconv"
 
+	"google.golang.org/grpc"
 	"google.golang.org/grpc/metadata"
 )
 
@@ -34,6 +36,11 @@ const (
 	ClientNameHeaderName              = "client-name"
 	ClientVersionHeaderName           = "client-version"
 	SupportedServerVersionsHeaderName = "supported-server-versions"
+	AuthorizationHeaderName           = "authorization"
+
+	HistorySizeBytesHeaderName     = "history-size-bytes"
+	HistoryLengthHeaderName        = "history-length"
+	SuggestContinueAsNewHeaderName = "suggest-continue-as-new"
 )
 
 var (
@@ -112,6 +119,16 @@ func SetVersionsForTests(ctx context.Context, clientVersion, clientName, support
 	}))
 }
 
+// SetHistoryStats sends the history size and event count of a workflow, and whether it should continue as new,
+// as response headers. The workflow service responses have no fields for them.
+func SetHistoryStats(ctx context.Context, historySizeBytes int64, historyLength int64, suggestContinueAsNew bool) error {
+	return grpc.SetHeader(ctx, metadata.Pairs(
+		HistorySizeBytesHeaderName, strconv.FormatInt(historySizeBytes, 10),
+		HistoryLengthHeaderName, strconv.FormatInt(historyLength, 10),
+		SuggestContinueAsNewHeaderName, strconv.FormatBool(suggestContinueAsNew),
+	))
+}
+
 func getSingleHeaderValue(md metadata.MD, headerName string) string {
 	values := md.Get(headerName)
 	if len(values) == 0 {
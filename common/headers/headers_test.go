@@ -30,6 +30,7 @@ import (
 
 	"github.com/stretchr/testify/require"
 	"github.com/stretchr/testify/suite"
+	"google.golang.org/grpc"
 	"google.golang.org/grpc/metadata"
 )
 
@@ -38,6 +39,10 @@ type (
 		*require.Assertions
 		suite.Suite
 	}
+
+	testServerTransportStream struct {
+		header metadata.MD
+	}
 )
 
 func TestHeadersSuite(t *testing.T) {
@@ -127,3 +132,35 @@ func (s *HeadersSuite) TestPropagateHeaders_EmptyIncomingContext() {
 	s.Equal("<21.04.16", md.Get(SupportedServerVersionsHeaderName)[0])
 	s.Equal("28.08.14", md.Get(ClientNameHeaderName)[0])
 }
+
+func (s *HeadersSuite) TestSetHistoryStats() {
+	stream := &testServerTransportStream{}
+	ctx := grpc.NewContextWithServerTransportStream(context.Background(), stream)
+
+	s.NoError(SetHistoryStats(ctx, 1024, 12, true))
+
+	s.Equal([]string{"1024"}, stream.header.Get(HistorySizeBytesHeaderName))
+	s.Equal([]string{"12"}, stream.header.Get(HistoryLengthHeaderName))
+	s.Equal([]string{"true"}, stream.header.Get(SuggestContinueAsNewHeaderName))
+}
+
+func (s *HeadersSuite) TestSetHistoryStats_NoStream() {
+	s.Error(SetHistoryStats(context.Background(), 1024, 12, false))
+}
+
+func (t *testServerTransportStream) Method() string {
+	return "test"
+}
+
+func (t *testServerTransportStream) SetHeader(md metadata.MD) error {
+	t.header = metadata.Join(t.header, md)
+	return nil
+}
+
+func (t *testServerTransportStream) SendHeader(md metadata.MD) error {
+	return t.SetHeader(md)
+}
+
+func (t *testServerTransportStream) SetTrailer(md metadata.MD) error {
+	return nil
+}
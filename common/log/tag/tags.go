@@ -385,6 +385,11 @@ func Value(v interface{}) Tag {
 	return newObjectTag("value", v)
 }
 
+// AuditRecord returns tag for AuditRecord
+func AuditRecord(record interface{}) Tag {
+	return newObjectTag("audit-record", record)
+}
+
 // ValueType returns tag for ValueType
 func ValueType(v interface{}) Tag {
 	return newStringTag("value-type", fmt.Sprintf("%T", v))
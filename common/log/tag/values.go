@@ -40,6 +40,8 @@ var (
 	WorkflowActionWorkflowSignaled               = workflowAction("add-workflow-signaled-event")
 	WorkflowActionWorkflowRecordMarker           = workflowAction("add-workflow-marker-record-event")
 	WorkflowActionUpsertWorkflowSearchAttributes = workflowAction("add-workflow-upsert-search-attributes-event")
+	WorkflowActionWorkflowUpdateAccepted         = workflowAction("add-workflow-update-accepted-event")
+	WorkflowActionWorkflowUpdateCompleted        = workflowAction("add-workflow-update-completed-event")
 
 	// workflow task
 	WorkflowActionWorkflowTaskScheduled = workflowAction("add-workflowtask-scheduled-event")
@@ -123,6 +125,8 @@ var (
 	ComponentESVisibilityManager      = component("es-visibility-manager")
 	ComponentArchiver                 = component("archiver")
 	ComponentBatcher                  = component("batcher")
+	ComponentScheduler                = component("scheduler")
+	ComponentAudit                    = component("audit")
 	ComponentWorker                   = component("worker")
 	ComponentServiceResolver          = component("service-resolver")
 	ComponentMetadataInitializer      = component("metadata-initializer")
@@ -92,6 +92,9 @@ const (
 
 	MutableStateCacheTypeTagValue = "mutablestate"
 	EventsCacheTypeTagValue       = "events"
+
+	ManagerCircuitBreakerTagValue   = "manager"
+	OperationCircuitBreakerTagValue = "operation"
 )
 
 // Common service base metrics
@@ -350,6 +353,16 @@ const (
 	HistoryClientMergeDLQMessagesScope
 	// HistoryClientRefreshWorkflowTasksScope tracks RPC calls to history service
 	HistoryClientRefreshWorkflowTasksScope
+	// HistoryClientDeleteWorkflowExecutionScope tracks RPC calls to history service
+	HistoryClientDeleteWorkflowExecutionScope
+	// HistoryClientUpdateWorkflowExecutionScope tracks RPC calls to history service
+	HistoryClientUpdateWorkflowExecutionScope
+	// HistoryClientAcquireShardScope tracks RPC calls to history service
+	HistoryClientAcquireShardScope
+	// HistoryClientPauseWorkflowExecutionScope tracks RPC calls to history service
+	HistoryClientPauseWorkflowExecutionScope
+	// HistoryClientUnpauseWorkflowExecutionScope tracks RPC calls to history service
+	HistoryClientUnpauseWorkflowExecutionScope
 	// MatchingClientPollWorkflowTaskQueueScope tracks RPC calls to matching service
 	MatchingClientPollWorkflowTaskQueueScope
 	// MatchingClientPollActivityTaskQueueScope tracks RPC calls to matching service
@@ -480,6 +493,42 @@ const (
 	AdminClientRefreshWorkflowTasksScope
 	// AdminClientResendReplicationTasksScope tracks RPC calls to admin service
 	AdminClientResendReplicationTasksScope
+	// AdminClientCreateScheduleScope tracks RPC calls to admin service
+	AdminClientCreateScheduleScope
+	// AdminClientDescribeScheduleScope tracks RPC calls to admin service
+	AdminClientDescribeScheduleScope
+	// AdminClientUpdateScheduleScope tracks RPC calls to admin service
+	AdminClientUpdateScheduleScope
+	// AdminClientPauseScheduleScope tracks RPC calls to admin service
+	AdminClientPauseScheduleScope
+	// AdminClientUnpauseScheduleScope tracks RPC calls to admin service
+	AdminClientUnpauseScheduleScope
+	// AdminClientTriggerScheduleScope tracks RPC calls to admin service
+	AdminClientTriggerScheduleScope
+	// AdminClientDeleteScheduleScope tracks RPC calls to admin service
+	AdminClientDeleteScheduleScope
+	// AdminClientListSchedulesScope tracks RPC calls to admin service
+	AdminClientListSchedulesScope
+	// AdminClientDeleteWorkflowExecutionScope tracks RPC calls to admin service
+	AdminClientDeleteWorkflowExecutionScope
+	// AdminClientStartBatchOperationScope tracks RPC calls to admin service
+	AdminClientStartBatchOperationScope
+	// AdminClientStopBatchOperationScope tracks RPC calls to admin service
+	AdminClientStopBatchOperationScope
+	// AdminClientDescribeBatchOperationScope tracks RPC calls to admin service
+	AdminClientDescribeBatchOperationScope
+	// AdminClientListBatchOperationsScope tracks RPC calls to admin service
+	AdminClientListBatchOperationsScope
+	// AdminClientUpdateWorkflowExecutionScope tracks RPC calls to admin service
+	AdminClientUpdateWorkflowExecutionScope
+	// AdminClientGetWorkflowExecutionResultScope tracks RPC calls to admin service
+	AdminClientGetWorkflowExecutionResultScope
+	// AdminClientResetWorkflowExecutionWithOptionsScope tracks RPC calls to admin service
+	AdminClientResetWorkflowExecutionWithOptionsScope
+	// AdminClientPauseWorkflowExecutionScope tracks RPC calls to admin service
+	AdminClientPauseWorkflowExecutionScope
+	// AdminClientUnpauseWorkflowExecutionScope tracks RPC calls to admin service
+	AdminClientUnpauseWorkflowExecutionScope
 	// DCRedirectionDeprecateNamespaceScope tracks RPC calls for dc redirection
 	DCRedirectionDeprecateNamespaceScope
 	// DCRedirectionDescribeNamespaceScope tracks RPC calls for dc redirection
@@ -558,6 +607,42 @@ const (
 	DCRedirectionUpdateNamespaceScope
 	// DCRedirectionListTaskQueuePartitionsScope tracks RPC calls for dc redirection
 	DCRedirectionListTaskQueuePartitionsScope
+	// DCRedirectionCreateScheduleScope tracks RPC calls for dc redirection
+	DCRedirectionCreateScheduleScope
+	// DCRedirectionDescribeScheduleScope tracks RPC calls for dc redirection
+	DCRedirectionDescribeScheduleScope
+	// DCRedirectionUpdateScheduleScope tracks RPC calls for dc redirection
+	DCRedirectionUpdateScheduleScope
+	// DCRedirectionPauseScheduleScope tracks RPC calls for dc redirection
+	DCRedirectionPauseScheduleScope
+	// DCRedirectionUnpauseScheduleScope tracks RPC calls for dc redirection
+	DCRedirectionUnpauseScheduleScope
+	// DCRedirectionTriggerScheduleScope tracks RPC calls for dc redirection
+	DCRedirectionTriggerScheduleScope
+	// DCRedirectionDeleteScheduleScope tracks RPC calls for dc redirection
+	DCRedirectionDeleteScheduleScope
+	// DCRedirectionListSchedulesScope tracks RPC calls for dc redirection
+	DCRedirectionListSchedulesScope
+	// DCRedirectionDeleteWorkflowExecutionScope tracks RPC calls for dc redirection
+	DCRedirectionDeleteWorkflowExecutionScope
+	// DCRedirectionStartBatchOperationScope tracks RPC calls for dc redirection
+	DCRedirectionStartBatchOperationScope
+	// DCRedirectionStopBatchOperationScope tracks RPC calls for dc redirection
+	DCRedirectionStopBatchOperationScope
+	// DCRedirectionDescribeBatchOperationScope tracks RPC calls for dc redirection
+	DCRedirectionDescribeBatchOperationScope
+	// DCRedirectionListBatchOperationsScope tracks RPC calls for dc redirection
+	DCRedirectionListBatchOperationsScope
+	// DCRedirectionUpdateWorkflowExecutionScope tracks RPC calls for dc redirection
+	DCRedirectionUpdateWorkflowExecutionScope
+	// DCRedirectionGetWorkflowExecutionResultScope tracks RPC calls for dc redirection
+	DCRedirectionGetWorkflowExecutionResultScope
+	// DCRedirectionResetWorkflowExecutionWithOptionsScope tracks RPC calls for dc redirection
+	DCRedirectionResetWorkflowExecutionWithOptionsScope
+	// DCRedirectionPauseWorkflowExecutionScope tracks RPC calls for dc redirection
+	DCRedirectionPauseWorkflowExecutionScope
+	// DCRedirectionUnpauseWorkflowExecutionScope tracks RPC calls for dc redirection
+	DCRedirectionUnpauseWorkflowExecutionScope
 
 	// MessagingPublishScope tracks Publish calls made by service to messaging layer
 	MessagingClientPublishScope
@@ -777,6 +862,42 @@ const (
 	FrontendResetWorkflowExecutionScope
 	// FrontendGetSearchAttributesScope is the metric scope for frontend.GetSearchAttributes
 	FrontendGetSearchAttributesScope
+	// FrontendCreateScheduleScope is the metric scope for frontend.CreateSchedule
+	FrontendCreateScheduleScope
+	// FrontendDescribeScheduleScope is the metric scope for frontend.DescribeSchedule
+	FrontendDescribeScheduleScope
+	// FrontendUpdateScheduleScope is the metric scope for frontend.UpdateSchedule
+	FrontendUpdateScheduleScope
+	// FrontendPauseScheduleScope is the metric scope for frontend.PauseSchedule
+	FrontendPauseScheduleScope
+	// FrontendUnpauseScheduleScope is the metric scope for frontend.UnpauseSchedule
+	FrontendUnpauseScheduleScope
+	// FrontendTriggerScheduleScope is the metric scope for frontend.TriggerSchedule
+	FrontendTriggerScheduleScope
+	// FrontendDeleteScheduleScope is the metric scope for frontend.DeleteSchedule
+	FrontendDeleteScheduleScope
+	// FrontendListSchedulesScope is the metric scope for frontend.ListSchedules
+	FrontendListSchedulesScope
+	// FrontendDeleteWorkflowExecutionScope is the metric scope for frontend.DeleteWorkflowExecution
+	FrontendDeleteWorkflowExecutionScope
+	// FrontendStartBatchOperationScope is the metric scope for frontend.StartBatchOperation
+	FrontendStartBatchOperationScope
+	// FrontendStopBatchOperationScope is the metric scope for frontend.StopBatchOperation
+	FrontendStopBatchOperationScope
+	// FrontendDescribeBatchOperationScope is the metric scope for frontend.DescribeBatchOperation
+	FrontendDescribeBatchOperationScope
+	// FrontendListBatchOperationsScope is the metric scope for frontend.ListBatchOperations
+	FrontendListBatchOperationsScope
+	// FrontendUpdateWorkflowExecutionScope is the metric scope for frontend.UpdateWorkflowExecution
+	FrontendUpdateWorkflowExecutionScope
+	// FrontendGetWorkflowExecutionResultScope is the metric scope for frontend.GetWorkflowExecutionResult
+	FrontendGetWorkflowExecutionResultScope
+	// FrontendResetWorkflowExecutionWithOptionsScope is the metric scope for frontend.ResetWorkflowExecutionWithOptions
+	FrontendResetWorkflowExecutionWithOptionsScope
+	// FrontendPauseWorkflowExecutionScope is the metric scope for frontend.PauseWorkflowExecution
+	FrontendPauseWorkflowExecutionScope
+	// FrontendUnpauseWorkflowExecutionScope is the metric scope for frontend.UnpauseWorkflowExecution
+	FrontendUnpauseWorkflowExecutionScope
 	// VersionCheckScope is scope used by version checker
 	VersionCheckScope
 
@@ -853,6 +974,16 @@ const (
 	HistoryReapplyEventsScope
 	// HistoryRefreshWorkflowTasksScope is the scope used by refresh workflow tasks API
 	HistoryRefreshWorkflowTasksScope
+	// HistoryDeleteWorkflowExecutionScope tracks DeleteWorkflowExecution API calls received by service
+	HistoryDeleteWorkflowExecutionScope
+	// HistoryUpdateWorkflowExecutionScope tracks UpdateWorkflowExecution API calls received by service
+	HistoryUpdateWorkflowExecutionScope
+	// HistoryAcquireShardScope tracks AcquireShard API calls received by service
+	HistoryAcquireShardScope
+	// HistoryPauseWorkflowExecutionScope tracks PauseWorkflowExecution API calls received by service
+	HistoryPauseWorkflowExecutionScope
+	// HistoryUnpauseWorkflowExecutionScope tracks UnpauseWorkflowExecution API calls received by service
+	HistoryUnpauseWorkflowExecutionScope
 	// TaskPriorityAssignerScope is the scope used by all metric emitted by task priority assigner
 	TaskPriorityAssignerScope
 	// TransferQueueProcessorScope is the scope used by all metric emitted by transfer queue processor
@@ -1055,8 +1186,12 @@ const (
 	BatcherScope
 	// HistoryScavengerScope is scope used by all metrics emitted by worker.history.Scavenger module
 	HistoryScavengerScope
+	// RetentionEnforcerScope is scope used by all metrics emitted by worker.retention.Enforcer module
+	RetentionEnforcerScope
 	// ParentClosePolicyProcessorScope is scope used by all metrics emitted by worker.ParentClosePolicyProcessor
 	ParentClosePolicyProcessorScope
+	// SchedulerScope is scope used by all metrics emitted by worker.Scheduler module
+	SchedulerScope
 
 	NumWorkerScopes
 )
@@ -1186,6 +1321,11 @@ var ScopeDefs = map[ServiceIdx]map[int]scopeDefinition{
 		HistoryClientPurgeDLQMessagesScope:                    {operation: "HistoryClientPurgeDLQMessagesScope", tags: map[string]string{ServiceRoleTagName: HistoryRoleTagValue}},
 		HistoryClientMergeDLQMessagesScope:                    {operation: "HistoryClientMergeDLQMessagesScope", tags: map[string]string{ServiceRoleTagName: HistoryRoleTagValue}},
 		HistoryClientRefreshWorkflowTasksScope:                {operation: "HistoryClientRefreshWorkflowTasksScope", tags: map[string]string{ServiceRoleTagName: HistoryRoleTagValue}},
+		HistoryClientDeleteWorkflowExecutionScope:             {operation: "HistoryClientDeleteWorkflowExecutionScope", tags: map[string]string{ServiceRoleTagName: HistoryRoleTagValue}},
+		HistoryClientUpdateWorkflowExecutionScope:             {operation: "HistoryClientUpdateWorkflowExecutionScope", tags: map[string]string{ServiceRoleTagName: HistoryRoleTagValue}},
+		HistoryClientAcquireShardScope:                        {operation: "HistoryClientAcquireShardScope", tags: map[string]string{ServiceRoleTagName: HistoryRoleTagValue}},
+		HistoryClientPauseWorkflowExecutionScope:              {operation: "HistoryClientPauseWorkflowExecutionScope", tags: map[string]string{ServiceRoleTagName: HistoryRoleTagValue}},
+		HistoryClientUnpauseWorkflowExecutionScope:            {operation: "HistoryClientUnpauseWorkflowExecutionScope", tags: map[string]string{ServiceRoleTagName: HistoryRoleTagValue}},
 		MatchingClientPollWorkflowTaskQueueScope:              {operation: "MatchingClientPollWorkflowTaskQueue", tags: map[string]string{ServiceRoleTagName: MatchingRoleTagValue}},
 		MatchingClientPollActivityTaskQueueScope:              {operation: "MatchingClientPollActivityTaskQueue", tags: map[string]string{ServiceRoleTagName: MatchingRoleTagValue}},
 		MatchingClientAddActivityTaskScope:                    {operation: "MatchingClientAddActivityTask", tags: map[string]string{ServiceRoleTagName: MatchingRoleTagValue}},
@@ -1247,6 +1387,24 @@ var ScopeDefs = map[ServiceIdx]map[int]scopeDefinition{
 		AdminClientDescribeClusterScope:                       {operation: "AdminClientDescribeCluster", tags: map[string]string{ServiceRoleTagName: AdminRoleTagValue}},
 		AdminClientRefreshWorkflowTasksScope:                  {operation: "AdminClientRefreshWorkflowTasks", tags: map[string]string{ServiceRoleTagName: AdminRoleTagValue}},
 		AdminClientResendReplicationTasksScope:                {operation: "AdminClientResendReplicationTasks", tags: map[string]string{ServiceRoleTagName: AdminRoleTagValue}},
+		AdminClientCreateScheduleScope:                        {operation: "AdminClientCreateSchedule", tags: map[string]string{ServiceRoleTagName: AdminRoleTagValue}},
+		AdminClientDescribeScheduleScope:                      {operation: "AdminClientDescribeSchedule", tags: map[string]string{ServiceRoleTagName: AdminRoleTagValue}},
+		AdminClientUpdateScheduleScope:                        {operation: "AdminClientUpdateSchedule", tags: map[string]string{ServiceRoleTagName: AdminRoleTagValue}},
+		AdminClientPauseScheduleScope:                         {operation: "AdminClientPauseSchedule", tags: map[string]string{ServiceRoleTagName: AdminRoleTagValue}},
+		AdminClientUnpauseScheduleScope:                       {operation: "AdminClientUnpauseSchedule", tags: map[string]string{ServiceRoleTagName: AdminRoleTagValue}},
+		AdminClientTriggerScheduleScope:                       {operation: "AdminClientTriggerSchedule", tags: map[string]string{ServiceRoleTagName: AdminRoleTagValue}},
+		AdminClientDeleteScheduleScope:                        {operation: "AdminClientDeleteSchedule", tags: map[string]string{ServiceRoleTagName: AdminRoleTagValue}},
+		AdminClientListSchedulesScope:                         {operation: "AdminClientListSchedules", tags: map[string]string{ServiceRoleTagName: AdminRoleTagValue}},
+		AdminClientDeleteWorkflowExecutionScope:               {operation: "AdminClientDeleteWorkflowExecution", tags: map[string]string{ServiceRoleTagName: AdminRoleTagValue}},
+		AdminClientStartBatchOperationScope:                   {operation: "AdminClientStartBatchOperation", tags: map[string]string{ServiceRoleTagName: AdminRoleTagValue}},
+		AdminClientStopBatchOperationScope:                    {operation: "AdminClientStopBatchOperation", tags: map[string]string{ServiceRoleTagName: AdminRoleTagValue}},
+		AdminClientDescribeBatchOperationScope:                {operation: "AdminClientDescribeBatchOperation", tags: map[string]string{ServiceRoleTagName: AdminRoleTagValue}},
+		AdminClientListBatchOperationsScope:                   {operation: "AdminClientListBatchOperations", tags: map[string]string{ServiceRoleTagName: AdminRoleTagValue}},
+		AdminClientUpdateWorkflowExecutionScope:               {operation: "AdminClientUpdateWorkflowExecution", tags: map[string]string{ServiceRoleTagName: AdminRoleTagValue}},
+		AdminClientGetWorkflowExecutionResultScope:            {operation: "AdminClientGetWorkflowExecutionResult", tags: map[string]string{ServiceRoleTagName: AdminRoleTagValue}},
+		AdminClientResetWorkflowExecutionWithOptionsScope:     {operation: "AdminClientResetWorkflowExecutionWithOptions", tags: map[string]string{ServiceRoleTagName: AdminRoleTagValue}},
+		AdminClientPauseWorkflowExecutionScope:                {operation: "AdminClientPauseWorkflowExecution", tags: map[string]string{ServiceRoleTagName: AdminRoleTagValue}},
+		AdminClientUnpauseWorkflowExecutionScope:              {operation: "AdminClientUnpauseWorkflowExecution", tags: map[string]string{ServiceRoleTagName: AdminRoleTagValue}},
 		AdminClientCloseShardScope:                            {operation: "AdminClientCloseShard", tags: map[string]string{ServiceRoleTagName: AdminRoleTagValue}},
 		AdminClientGetDLQMessagesScope:                        {operation: "AdminClientGetDLQMessages", tags: map[string]string{ServiceRoleTagName: AdminRoleTagValue}},
 		AdminClientPurgeDLQMessagesScope:                      {operation: "AdminClientPurgeDLQMessages", tags: map[string]string{ServiceRoleTagName: AdminRoleTagValue}},
@@ -1290,6 +1448,24 @@ var ScopeDefs = map[ServiceIdx]map[int]scopeDefinition{
 		DCRedirectionTerminateWorkflowExecutionScope:          {operation: "DCRedirectionTerminateWorkflowExecution", tags: map[string]string{ServiceRoleTagName: DCRedirectionRoleTagValue}},
 		DCRedirectionUpdateNamespaceScope:                     {operation: "DCRedirectionUpdateNamespace", tags: map[string]string{ServiceRoleTagName: DCRedirectionRoleTagValue}},
 		DCRedirectionListTaskQueuePartitionsScope:             {operation: "DCRedirectionListTaskQueuePartitions", tags: map[string]string{ServiceRoleTagName: DCRedirectionRoleTagValue}},
+		DCRedirectionCreateScheduleScope:                      {operation: "DCRedirectionCreateSchedule", tags: map[string]string{ServiceRoleTagName: DCRedirectionRoleTagValue}},
+		DCRedirectionDescribeScheduleScope:                    {operation: "DCRedirectionDescribeSchedule", tags: map[string]string{ServiceRoleTagName: DCRedirectionRoleTagValue}},
+		DCRedirectionUpdateScheduleScope:                      {operation: "DCRedirectionUpdateSchedule", tags: map[string]string{ServiceRoleTagName: DCRedirectionRoleTagValue}},
+		DCRedirectionPauseScheduleScope:                       {operation: "DCRedirectionPauseSchedule", tags: map[string]string{ServiceRoleTagName: DCRedirectionRoleTagValue}},
+		DCRedirectionUnpauseScheduleScope:                     {operation: "DCRedirectionUnpauseSchedule", tags: map[string]string{ServiceRoleTagName: DCRedirectionRoleTagValue}},
+		DCRedirectionTriggerScheduleScope:                     {operation: "DCRedirectionTriggerSchedule", tags: map[string]string{ServiceRoleTagName: DCRedirectionRoleTagValue}},
+		DCRedirectionDeleteScheduleScope:                      {operation: "DCRedirectionDeleteSchedule", tags: map[string]string{ServiceRoleTagName: DCRedirectionRoleTagValue}},
+		DCRedirectionListSchedulesScope:                       {operation: "DCRedirectionListSchedules", tags: map[string]string{ServiceRoleTagName: DCRedirectionRoleTagValue}},
+		DCRedirectionDeleteWorkflowExecutionScope:             {operation: "DCRedirectionDeleteWorkflowExecution", tags: map[string]string{ServiceRoleTagName: DCRedirectionRoleTagValue}},
+		DCRedirectionStartBatchOperationScope:                 {operation: "DCRedirectionStartBatchOperation", tags: map[string]string{ServiceRoleTagName: DCRedirectionRoleTagValue}},
+		DCRedirectionStopBatchOperationScope:                  {operation: "DCRedirectionStopBatchOperation", tags: map[string]string{ServiceRoleTagName: DCRedirectionRoleTagValue}},
+		DCRedirectionDescribeBatchOperationScope:              {operation: "DCRedirectionDescribeBatchOperation", tags: map[string]string{ServiceRoleTagName: DCRedirectionRoleTagValue}},
+		DCRedirectionListBatchOperationsScope:                 {operation: "DCRedirectionListBatchOperations", tags: map[string]string{ServiceRoleTagName: DCRedirectionRoleTagValue}},
+		DCRedirectionUpdateWorkflowExecutionScope:             {operation: "DCRedirectionUpdateWorkflowExecution", tags: map[string]string{ServiceRoleTagName: DCRedirectionRoleTagValue}},
+		DCRedirectionGetWorkflowExecutionResultScope:          {operation: "DCRedirectionGetWorkflowExecutionResult", tags: map[string]string{ServiceRoleTagName: DCRedirectionRoleTagValue}},
+		DCRedirectionResetWorkflowExecutionWithOptionsScope:   {operation: "DCRedirectionResetWorkflowExecutionWithOptions", tags: map[string]string{ServiceRoleTagName: DCRedirectionRoleTagValue}},
+		DCRedirectionPauseWorkflowExecutionScope:              {operation: "DCRedirectionPauseWorkflowExecution", tags: map[string]string{ServiceRoleTagName: DCRedirectionRoleTagValue}},
+		DCRedirectionUnpauseWorkflowExecutionScope:            {operation: "DCRedirectionUnpauseWorkflowExecution", tags: map[string]string{ServiceRoleTagName: DCRedirectionRoleTagValue}},
 
 		MessagingClientPublishScope:      {operation: "MessagingClientPublish"},
 		MessagingClientPublishBatchScope: {operation: "MessagingClientPublishBatch"},
@@ -1389,6 +1565,24 @@ var ScopeDefs = map[ServiceIdx]map[int]scopeDefinition{
 		FrontendDescribeTaskQueueScope:                  {operation: "DescribeTaskQueue"},
 		FrontendResetStickyTaskQueueScope:               {operation: "ResetStickyTaskQueue"},
 		FrontendGetSearchAttributesScope:                {operation: "GetSearchAttributes"},
+		FrontendCreateScheduleScope:                     {operation: "CreateSchedule"},
+		FrontendDescribeScheduleScope:                   {operation: "DescribeSchedule"},
+		FrontendUpdateScheduleScope:                     {operation: "UpdateSchedule"},
+		FrontendPauseScheduleScope:                      {operation: "PauseSchedule"},
+		FrontendUnpauseScheduleScope:                    {operation: "UnpauseSchedule"},
+		FrontendTriggerScheduleScope:                    {operation: "TriggerSchedule"},
+		FrontendDeleteScheduleScope:                     {operation: "DeleteSchedule"},
+		FrontendListSchedulesScope:                      {operation: "ListSchedules"},
+		FrontendDeleteWorkflowExecutionScope:            {operation: "DeleteWorkflowExecution"},
+		FrontendStartBatchOperationScope:                {operation: "StartBatchOperation"},
+		FrontendStopBatchOperationScope:                 {operation: "StopBatchOperation"},
+		FrontendDescribeBatchOperationScope:             {operation: "DescribeBatchOperation"},
+		FrontendListBatchOperationsScope:                {operation: "ListBatchOperations"},
+		FrontendUpdateWorkflowExecutionScope:            {operation: "UpdateWorkflowExecution"},
+		FrontendGetWorkflowExecutionResultScope:         {operation: "GetWorkflowExecutionResult"},
+		FrontendResetWorkflowExecutionWithOptionsScope:  {operation: "ResetWorkflowExecutionWithOptions"},
+		FrontendPauseWorkflowExecutionScope:             {operation: "PauseWorkflowExecution"},
+		FrontendUnpauseWorkflowExecutionScope:           {operation: "UnpauseWorkflowExecution"},
 		VersionCheckScope:                               {operation: "VersionCheckScope"},
 	},
 	// History Scope Names
@@ -1430,6 +1624,11 @@ var ScopeDefs = map[ServiceIdx]map[int]scopeDefinition{
 		HistoryShardControllerScope:                            {operation: "ShardController"},
 		HistoryReapplyEventsScope:                              {operation: "EventReapplication"},
 		HistoryRefreshWorkflowTasksScope:                       {operation: "RefreshWorkflowTasks"},
+		HistoryDeleteWorkflowExecutionScope:                    {operation: "DeleteWorkflowExecution"},
+		HistoryUpdateWorkflowExecutionScope:                    {operation: "UpdateWorkflowExecution"},
+		HistoryAcquireShardScope:                               {operation: "AcquireShard"},
+		HistoryPauseWorkflowExecutionScope:                     {operation: "PauseWorkflowExecution"},
+		HistoryUnpauseWorkflowExecutionScope:                   {operation: "UnpauseWorkflowExecution"},
 		TaskPriorityAssignerScope:                              {operation: "TaskPriorityAssigner"},
 		TransferQueueProcessorScope:                            {operation: "TransferQueueProcessor"},
 		TransferActiveQueueProcessorScope:                      {operation: "TransferActiveQueueProcessor"},
@@ -1527,8 +1726,10 @@ var ScopeDefs = map[ServiceIdx]map[int]scopeDefinition{
 		TaskQueueScavengerScope:                {operation: "taskqueuescavenger"},
 		ExecutionsScavengerScope:               {operation: "executionsscavenger"},
 		HistoryScavengerScope:                  {operation: "historyscavenger"},
+		RetentionEnforcerScope:                 {operation: "retentionenforcer"},
 		BatcherScope:                           {operation: "batcher"},
 		ParentClosePolicyProcessorScope:        {operation: "ParentClosePolicyProcessor"},
+		SchedulerScope:                         {operation: "scheduler"},
 	},
 }
 
@@ -1568,6 +1769,10 @@ const (
 	PersistenceErrNamespaceAlreadyExistsCounter
 	PersistenceErrBadRequestCounter
 	PersistenceSampledCounter
+	PersistenceErrUnavailableCounter
+	PersistenceCircuitBreakerOpenCounter
+	PersistenceCircuitBreakerHalfOpenCounter
+	PersistenceCircuitBreakerClosedCounter
 
 	ClientRequests
 	ClientFailures
@@ -1608,6 +1813,8 @@ const (
 
 	PriorityTaskSubmitRequest
 	PriorityTaskSubmitLatency
+	PriorityTaskQueueSize
+	PriorityTaskQueueLatency
 
 	HistoryArchiverArchiveNonRetryableErrorCount
 	HistoryArchiverArchiveTransientErrorCount
@@ -1701,6 +1908,7 @@ const (
 	CommandTypeContinueAsNewCounter
 	CommandTypeSignalExternalWorkflowCounter
 	CommandTypeUpsertWorkflowSearchAttributesCounter
+	CommandTypeUpdateWorkflowCounter
 	EmptyCompletionCommandsCounter
 	MultipleCompletionCommandsCounter
 	FailedWorkflowTasksCounter
@@ -1722,6 +1930,9 @@ const (
 	ShardItemCreatedCounter
 	ShardItemRemovedCounter
 	ShardItemAcquisitionLatency
+	ShardHandoffCounter
+	ShardHandoffFailedCounter
+	ShardHandoffLatency
 	ShardInfoReplicationPendingTasksTimer
 	ShardInfoTransferActivePendingTasksTimer
 	ShardInfoTransferStandbyPendingTasksTimer
@@ -1764,6 +1975,7 @@ const (
 	CacheMissCounter
 	AcquireLockFailedCounter
 	WorkflowContextCleared
+	HistoryLimitWarnExceededCounter
 	MutableStateSize
 	ExecutionInfoSize
 	ActivityInfoSize
@@ -1927,9 +2139,15 @@ const (
 	HistoryScavengerSuccessCount
 	HistoryScavengerErrorCount
 	HistoryScavengerSkipCount
+	RetentionEnforcerDeletedCount
+	RetentionEnforcerErrorCount
+	RetentionEnforcerSkipCount
 	ParentClosePolicyProcessorSuccess
 	ParentClosePolicyProcessorFailures
 	NamespaceReplicationEnqueueDLQCount
+	SchedulerStartWorkflowSuccess
+	SchedulerStartWorkflowFailures
+	SchedulerStartWorkflowDelay
 
 	NumWorkerMetrics
 )
@@ -1971,6 +2189,10 @@ var MetricDefs = map[ServiceIdx]map[int]metricDefinition{
 		PersistenceErrNamespaceAlreadyExistsCounter:         {metricName: "persistence_errors_namespace_already_exists", metricType: Counter},
 		PersistenceErrBadRequestCounter:                     {metricName: "persistence_errors_bad_request", metricType: Counter},
 		PersistenceSampledCounter:                           {metricName: "persistence_sampled", metricType: Counter},
+		PersistenceErrUnavailableCounter:                    {metricName: "persistence_errors_unavailable", metricType: Counter},
+		PersistenceCircuitBreakerOpenCounter:                {metricName: "persistence_circuit_breaker_open", metricType: Counter},
+		PersistenceCircuitBreakerHalfOpenCounter:            {metricName: "persistence_circuit_breaker_half_open", metricType: Counter},
+		PersistenceCircuitBreakerClosedCounter:              {metricName: "persistence_circuit_breaker_closed", metricType: Counter},
 		ClientRequests:                                      {metricName: "client_requests", metricType: Counter},
 		ClientFailures:                                      {metricName: "client_errors", metricType: Counter},
 		ClientLatency:                                       {metricName: "client_latency", metricType: Timer},
@@ -2001,6 +2223,8 @@ var MetricDefs = map[ServiceIdx]map[int]metricDefinition{
 		ParallelTaskTaskProcessingLatency:                   {metricName: "paralleltask_task_processing_latency", metricType: Timer},
 		PriorityTaskSubmitRequest:                           {metricName: "prioritytask_submit_request", metricType: Counter},
 		PriorityTaskSubmitLatency:                           {metricName: "prioritytask_submit_latency", metricType: Timer},
+		PriorityTaskQueueSize:                               {metricName: "prioritytask_queue_size", metricType: Timer},
+		PriorityTaskQueueLatency:                            {metricName: "prioritytask_queue_latency", metricType: Timer},
 
 		HistoryArchiverArchiveNonRetryableErrorCount:              {metricName: "history_archiver_archive_non_retryable_error", metricType: Counter},
 		HistoryArchiverArchiveTransientErrorCount:                 {metricName: "history_archiver_archive_transient_error", metricType: Counter},
@@ -2120,6 +2344,7 @@ var MetricDefs = map[ServiceIdx]map[int]metricDefinition{
 		CommandTypeContinueAsNewCounter:                   {metricName: "continue_as_new_command", metricType: Counter},
 		CommandTypeSignalExternalWorkflowCounter:          {metricName: "signal_external_workflow_command", metricType: Counter},
 		CommandTypeUpsertWorkflowSearchAttributesCounter:  {metricName: "upsert_workflow_search_attributes_command", metricType: Counter},
+		CommandTypeUpdateWorkflowCounter:                  {metricName: "update_workflow_command", metricType: Counter},
 		CommandTypeChildWorkflowCounter:                   {metricName: "child_workflow_command", metricType: Counter},
 		EmptyCompletionCommandsCounter:                    {metricName: "empty_completion_commands", metricType: Counter},
 		MultipleCompletionCommandsCounter:                 {metricName: "multiple_completion_commands", metricType: Counter},
@@ -2142,6 +2367,9 @@ var MetricDefs = map[ServiceIdx]map[int]metricDefinition{
 		ShardItemCreatedCounter:                           {metricName: "sharditem_created_count", metricType: Counter},
 		ShardItemRemovedCounter:                           {metricName: "sharditem_removed_count", metricType: Counter},
 		ShardItemAcquisitionLatency:                       {metricName: "sharditem_acquisition_latency", metricType: Timer},
+		ShardHandoffCounter:                               {metricName: "shard_handoff_count", metricType: Counter},
+		ShardHandoffFailedCounter:                         {metricName: "shard_handoff_failed", metricType: Counter},
+		ShardHandoffLatency:                               {metricName: "shard_handoff_latency", metricType: Timer},
 		ShardInfoReplicationPendingTasksTimer:             {metricName: "shardinfo_replication_pending_task", metricType: Timer},
 		ShardInfoTransferActivePendingTasksTimer:          {metricName: "shardinfo_transfer_active_pending_task", metricType: Timer},
 		ShardInfoTransferStandbyPendingTasksTimer:         {metricName: "shardinfo_transfer_standby_pending_task", metricType: Timer},
@@ -2184,6 +2412,7 @@ var MetricDefs = map[ServiceIdx]map[int]metricDefinition{
 		CacheMissCounter:                                  {metricName: "cache_miss", metricType: Counter},
 		AcquireLockFailedCounter:                          {metricName: "acquire_lock_failed", metricType: Counter},
 		WorkflowContextCleared:                            {metricName: "workflow_context_cleared", metricType: Counter},
+		HistoryLimitWarnExceededCounter:                   {metricName: "history_limit_warn_exceeded", metricType: Counter},
 		MutableStateSize:                                  {metricName: "mutable_state_size", metricType: Timer},
 		ExecutionInfoSize:                                 {metricName: "execution_info_size", metricType: Timer},
 		ActivityInfoSize:                                  {metricName: "activity_info_size", metricType: Timer},
@@ -2339,9 +2568,15 @@ var MetricDefs = map[ServiceIdx]map[int]metricDefinition{
 		HistoryScavengerSuccessCount:                  {metricName: "scavenger_success", metricType: Counter},
 		HistoryScavengerErrorCount:                    {metricName: "scavenger_errors", metricType: Counter},
 		HistoryScavengerSkipCount:                     {metricName: "scavenger_skips", metricType: Counter},
+		RetentionEnforcerDeletedCount:                 {metricName: "retention_enforcer_deleted", metricType: Counter},
+		RetentionEnforcerErrorCount:                   {metricName: "retention_enforcer_errors", metricType: Counter},
+		RetentionEnforcerSkipCount:                    {metricName: "retention_enforcer_skips", metricType: Counter},
 		ParentClosePolicyProcessorSuccess:             {metricName: "parent_close_policy_processor_requests", metricType: Counter},
 		ParentClosePolicyProcessorFailures:            {metricName: "parent_close_policy_processor_errors", metricType: Counter},
 		NamespaceReplicationEnqueueDLQCount:           {metricName: "namespace_replication_dlq_enqueue_requests", metricType: Counter},
+		SchedulerStartWorkflowSuccess:                 {metricName: "scheduler_start_workflow_requests", metricType: Counter},
+		SchedulerStartWorkflowFailures:                {metricName: "scheduler_start_workflow_errors", metricType: Counter},
+		SchedulerStartWorkflowDelay:                   {metricName: "scheduler_start_workflow_delay", metricType: Timer},
 	},
 }
 
@@ -40,6 +40,9 @@ const (
 	activityType  = "activityType"
 	commandType   = "commandType"
 
+	circuitBreaker = "circuitBreaker"
+	taskPriority   = "taskPriority"
+
 	namespaceAllValue = "all"
 	unknownValue      = "_unknown_"
 )
@@ -82,6 +85,14 @@ type (
 	commandTypeTag struct {
 		value string
 	}
+
+	circuitBreakerTag struct {
+		value string
+	}
+
+	taskPriorityTag struct {
+		value string
+	}
 )
 
 // NamespaceTag returns a new namespace tag. For timers, this also ensures that we
@@ -238,3 +249,35 @@ func (d commandTypeTag) Key() string {
 func (d commandTypeTag) Value() string {
 	return d.value
 }
+
+// CircuitBreakerTag returns a new circuit breaker tag, the value is the level of the
+// circuit breaker, either ManagerCircuitBreakerTagValue or OperationCircuitBreakerTagValue
+func CircuitBreakerTag(value string) Tag {
+	return circuitBreakerTag{value}
+}
+
+// Key returns the key of the circuit breaker tag
+func (d circuitBreakerTag) Key() string {
+	return circuitBreaker
+}
+
+// Value returns the value of the circuit breaker tag
+func (d circuitBreakerTag) Value() string {
+	return d.value
+}
+
+// TaskPriorityTag returns a new task priority tag, the value is the priority of the task in
+// the priority task scheduler
+func TaskPriorityTag(value string) Tag {
+	return taskPriorityTag{value}
+}
+
+// Key returns the key of the task priority tag
+func (d taskPriorityTag) Key() string {
+	return taskPriority
+}
+
+// Value returns the value of the task priority tag
+func (d taskPriorityTag) Value() string {
+	return d.value
+}
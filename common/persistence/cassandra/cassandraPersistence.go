@@ -693,6 +693,7 @@ type (
 		cassandraStore
 		shardID            int32
 		currentClusterName string
+		taskConfig         config.CassandraTaskConfig
 	}
 )
 
@@ -744,7 +745,11 @@ func newTaskPersistence(cfg config.Cassandra, logger log.Logger) (p.TaskStore, e
 	if err != nil {
 		return nil, fmt.Errorf("create cassandra session from cluster: %w", err)
 	}
-	return &cassandraPersistence{cassandraStore: cassandraStore{session: session, logger: logger}, shardID: -1}, nil
+	var taskConfig config.CassandraTaskConfig
+	if cfg.Tasks != nil {
+		taskConfig = *cfg.Tasks
+	}
+	return &cassandraPersistence{cassandraStore: cassandraStore{session: session, logger: logger}, shardID: -1, taskConfig: taskConfig}, nil
 }
 
 func (d *cassandraStore) GetName() string {
@@ -2388,43 +2393,21 @@ func MintAllocatedTaskInfo(taskID *int64, info *persistenceblobs.TaskInfo) *pers
 }
 
 // From TaskManager interface
+// The tasks and the range ID check of the task queue all go to the partition of the task queue, so with
+// batched writes they are written as an unlogged batch, which skips the batch log of a logged batch.
 func (d *cassandraPersistence) CreateTasks(request *p.CreateTasksRequest) (*p.CreateTasksResponse, error) {
-	batch := d.session.NewBatch(gocql.LoggedBatch)
+	batchType := gocql.LoggedBatch
+	if d.taskConfig.BatchedWrites {
+		batchType = gocql.UnloggedBatch
+	}
+
+	batch := d.session.NewBatch(batchType)
 	namespaceID := request.TaskQueueInfo.Data.GetNamespaceId()
 	taskQueue := request.TaskQueueInfo.Data.Name
 	taskQueueType := request.TaskQueueInfo.Data.TaskType
 
-	for _, task := range request.Tasks {
-		ttl := GetTaskTTL(task.Data)
-		datablob, err := serialization.TaskInfoToBlob(task)
-		if err != nil {
-			return nil, serviceerror.NewInternal(fmt.Sprintf("CreateTasks operation failed during serialization. Error : %v", err))
-		}
-
-		if ttl <= 0 {
-			batch.Query(templateCreateTaskQuery,
-				namespaceID,
-				taskQueue,
-				taskQueueType,
-				rowTypeTask,
-				task.GetTaskId(),
-				datablob.Data,
-				datablob.Encoding.String())
-		} else {
-			if ttl > maxCassandraTTL {
-				ttl = maxCassandraTTL
-			}
-
-			batch.Query(templateCreateTaskWithTTLQuery,
-				namespaceID,
-				taskQueue,
-				taskQueueType,
-				rowTypeTask,
-				task.GetTaskId(),
-				datablob.Data,
-				datablob.Encoding.String(),
-				ttl)
-		}
+	if err := addCreateTaskQueries(batch, request); err != nil {
+		return nil, err
 	}
 
 	tl := *request.TaskQueueInfo.Data
@@ -2467,6 +2450,47 @@ func (d *cassandraPersistence) CreateTasks(request *p.CreateTasksRequest) (*p.Cr
 	return &p.CreateTasksResponse{}, nil
 }
 
+// addCreateTaskQueries adds an insert of every task of the request to the batch
+func addCreateTaskQueries(batch *gocql.Batch, request *p.CreateTasksRequest) error {
+	namespaceID := request.TaskQueueInfo.Data.GetNamespaceId()
+	taskQueue := request.TaskQueueInfo.Data.Name
+	taskQueueType := request.TaskQueueInfo.Data.TaskType
+
+	for _, task := range request.Tasks {
+		ttl := GetTaskTTL(task.Data)
+		datablob, err := serialization.TaskInfoToBlob(task)
+		if err != nil {
+			return serviceerror.NewInternal(fmt.Sprintf("CreateTasks operation failed during serialization. Error : %v", err))
+		}
+
+		if ttl <= 0 {
+			batch.Query(templateCreateTaskQuery,
+				namespaceID,
+				taskQueue,
+				taskQueueType,
+				rowTypeTask,
+				task.GetTaskId(),
+				datablob.Data,
+				datablob.Encoding.String())
+		} else {
+			if ttl > maxCassandraTTL {
+				ttl = maxCassandraTTL
+			}
+
+			batch.Query(templateCreateTaskWithTTLQuery,
+				namespaceID,
+				taskQueue,
+				taskQueueType,
+				rowTypeTask,
+				task.GetTaskId(),
+				datablob.Data,
+				datablob.Encoding.String(),
+				ttl)
+		}
+	}
+	return nil
+}
+
 func GetTaskTTL(task *persistenceblobs.TaskInfo) int64 {
 	var ttl int64 = 0
 	if task.ExpiryTime != nil {
@@ -2500,7 +2524,10 @@ func (d *cassandraPersistence) GetTasks(request *p.GetTasksRequest) (*p.GetTasks
 		rowTypeTask,
 		request.ReadLevel,
 		*request.MaxReadLevel,
-	).PageSize(request.BatchSize)
+	).PageSize(d.taskReadPageSize(request.BatchSize))
+	if d.taskConfig.ReadPrefetch > 0 {
+		query = query.Prefetch(d.taskConfig.ReadPrefetch)
+	}
 
 	iter := query.Iter()
 	if iter == nil {
@@ -2556,6 +2583,14 @@ PopulateTasks:
 	return response, nil
 }
 
+// taskReadPageSize returns the page size of a task range read of the given batch size
+func (d *cassandraPersistence) taskReadPageSize(batchSize int) int {
+	if d.taskConfig.ReadPageSize > 0 && d.taskConfig.ReadPageSize < batchSize {
+		return d.taskConfig.ReadPageSize
+	}
+	return batchSize
+}
+
 // From TaskManager interface
 func (d *cassandraPersistence) CompleteTask(request *p.CompleteTaskRequest) error {
 	tli := request.TaskQueue
@@ -82,6 +82,11 @@ func NewTestCluster(keyspace, username, password, host string, port int, schemaD
 	return &result
 }
 
+// SetTaskConfig sets how the task store of this test cluster writes and reads tasks
+func (s *TestCluster) SetTaskConfig(taskConfig *config.CassandraTaskConfig) {
+	s.cfg.Tasks = taskConfig
+}
+
 // Config returns the persistence config for connecting to this test cluster
 func (s *TestCluster) Config() config.Persistence {
 	cfg := s.cfg
@@ -69,6 +69,7 @@ func applyWorkflowMutationBatch(
 		condition,
 		workflowMutation.Checksum,
 		startVersion,
+		workflowMutation.ExecutionInfoEncoding,
 	); err != nil {
 		return err
 	}
@@ -192,6 +193,7 @@ func applyWorkflowSnapshotBatchAsReset(
 		condition,
 		workflowSnapshot.Checksum,
 		startVersion,
+		workflowSnapshot.ExecutionInfoEncoding,
 	); err != nil {
 		return err
 	}
@@ -305,6 +307,7 @@ func applyWorkflowSnapshotBatchAsNew(
 		workflowSnapshot.Checksum,
 		cqlNowTimestampMillis,
 		startVersion,
+		workflowSnapshot.ExecutionInfoEncoding,
 	); err != nil {
 		return err
 	}
@@ -400,6 +403,7 @@ func createExecution(
 	checksum checksum.Checksum,
 	cqlNowTimestampMillis int64,
 	startVersion int64,
+	executionInfoEncoding enumspb.EncodingType,
 ) error {
 
 	// validate workflow state & close status
@@ -422,7 +426,7 @@ func createExecution(
 		return err
 	}
 
-	executionDatablob, err := serialization.WorkflowExecutionInfoToBlob(protoExecution)
+	executionDatablob, err := serialization.WorkflowExecutionInfoToBlob(protoExecution, executionInfoEncoding)
 	if err != nil {
 		return err
 	}
@@ -446,7 +450,7 @@ func createExecution(
 			runID,
 			rowTypeExecution,
 			executionDatablob.Data,
-			executionDatablob.Encoding.String(),
+			serialization.EncodingTypeName(executionDatablob.Encoding),
 			executionStateDatablob.Data,
 			executionStateDatablob.Encoding.String(),
 			executionInfo.NextEventId,
@@ -463,7 +467,7 @@ func createExecution(
 			runID,
 			rowTypeExecution,
 			executionDatablob.Data,
-			executionDatablob.Encoding.String(),
+			serialization.EncodingTypeName(executionDatablob.Encoding),
 			executionStateDatablob.Data,
 			executionStateDatablob.Encoding.String(),
 			executionInfo.NextEventId,
@@ -484,6 +488,7 @@ func updateExecution(
 	condition int64,
 	checksum checksum.Checksum,
 	startVersion int64,
+	executionInfoEncoding enumspb.EncodingType,
 ) error {
 
 	// validate workflow state & close status
@@ -505,7 +510,7 @@ func updateExecution(
 		return err
 	}
 
-	executionDatablob, err := serialization.WorkflowExecutionInfoToBlob(protoExecution)
+	executionDatablob, err := serialization.WorkflowExecutionInfoToBlob(protoExecution, executionInfoEncoding)
 	if err != nil {
 		return err
 	}
@@ -524,7 +529,7 @@ func updateExecution(
 		// Updates will be called with null ReplicationState while the feature is disabled
 		batch.Query(templateUpdateWorkflowExecutionQuery,
 			executionDatablob.Data,
-			executionDatablob.Encoding.String(),
+			serialization.EncodingTypeName(executionDatablob.Encoding),
 			executionStateDatablob.Data,
 			executionStateDatablob.Encoding.String(),
 			executionInfo.NextEventId,
@@ -542,7 +547,7 @@ func updateExecution(
 		// TODO also need to set the start / current / last write version
 		batch.Query(templateUpdateWorkflowExecutionQuery,
 			executionDatablob.Data,
-			executionDatablob.Encoding.String(),
+			serialization.EncodingTypeName(executionDatablob.Encoding),
 			executionStateDatablob.Data,
 			executionStateDatablob.Encoding.String(),
 			executionInfo.NextEventId,
@@ -35,6 +35,7 @@ import (
 	"go.temporal.io/server/common/quotas"
 	"go.temporal.io/server/common/service/config"
 	"go.temporal.io/server/common/service/dynamicconfig"
+	"go.temporal.io/server/common/tracing"
 )
 
 type (
@@ -97,6 +98,7 @@ type (
 		sync.RWMutex
 		config                   *config.Persistence
 		abstractDataStoreFactory AbstractDataStoreFactory
+		circuitBreakerConfig     *p.CircuitBreakerConfig
 		metricsClient            metrics.Client
 		logger                   log.Logger
 		datastores               map[storeType]Datastore
@@ -134,10 +136,13 @@ var storeTypes = []storeType{
 // also contains config for individual datastores themselves.
 //
 // The objects returned by this factory enforce ratelimit and maxconns according to
-// given configuration. In addition, all objects will emit metrics automatically
+// given configuration, they are also guarded by circuit breakers and adaptive concurrency
+// limits unless the circuit breaker config is nil. In addition, all objects will emit
+// metrics automatically
 func NewFactory(
 	cfg *config.Persistence,
 	persistenceMaxQPS dynamicconfig.IntPropertyFn,
+	circuitBreakerConfig *p.CircuitBreakerConfig,
 	abstractDataStoreFactory AbstractDataStoreFactory,
 	clusterName string,
 	metricsClient metrics.Client,
@@ -146,6 +151,7 @@ func NewFactory(
 	factory := &factoryImpl{
 		config:                   cfg,
 		abstractDataStoreFactory: abstractDataStoreFactory,
+		circuitBreakerConfig:     circuitBreakerConfig,
 		metricsClient:            metricsClient,
 		logger:                   logger,
 		clusterName:              clusterName,
@@ -162,6 +168,12 @@ func (f *factoryImpl) NewTaskManager() (p.TaskManager, error) {
 	if err != nil {
 		return nil, err
 	}
+	if tracing.Enabled() {
+		result = p.NewTaskPersistenceTracingClient(result, f.logger)
+	}
+	if f.circuitBreakerConfig != nil {
+		result = p.NewTaskPersistenceCircuitBreakerClient(result, f.circuitBreakerConfig, f.metricsClient, f.logger)
+	}
 	if ds.ratelimit != nil {
 		result = p.NewTaskPersistenceRateLimitedClient(result, ds.ratelimit, f.logger)
 	}
@@ -178,6 +190,12 @@ func (f *factoryImpl) NewShardManager() (p.ShardManager, error) {
 	if err != nil {
 		return nil, err
 	}
+	if tracing.Enabled() {
+		result = p.NewShardPersistenceTracingClient(result, f.logger)
+	}
+	if f.circuitBreakerConfig != nil {
+		result = p.NewShardPersistenceCircuitBreakerClient(result, f.circuitBreakerConfig, f.metricsClient, f.logger)
+	}
 	if ds.ratelimit != nil {
 		result = p.NewShardPersistenceRateLimitedClient(result, ds.ratelimit, f.logger)
 	}
@@ -195,6 +213,12 @@ func (f *factoryImpl) NewHistoryManager() (p.HistoryManager, error) {
 		return nil, err
 	}
 	result := p.NewHistoryV2ManagerImpl(store, f.logger, f.config.TransactionSizeLimit)
+	if tracing.Enabled() {
+		result = p.NewHistoryV2PersistenceTracingClient(result, f.logger)
+	}
+	if f.circuitBreakerConfig != nil {
+		result = p.NewHistoryV2PersistenceCircuitBreakerClient(result, f.circuitBreakerConfig, f.metricsClient, f.logger)
+	}
 	if ds.ratelimit != nil {
 		result = p.NewHistoryV2PersistenceRateLimitedClient(result, ds.ratelimit, f.logger)
 	}
@@ -215,6 +239,12 @@ func (f *factoryImpl) NewMetadataManager() (p.MetadataManager, error) {
 	}
 
 	result := p.NewMetadataManagerImpl(store, f.logger, f.clusterName)
+	if tracing.Enabled() {
+		result = p.NewMetadataPersistenceTracingClient(result, f.logger)
+	}
+	if f.circuitBreakerConfig != nil {
+		result = p.NewMetadataPersistenceCircuitBreakerClient(result, f.circuitBreakerConfig, f.metricsClient, f.logger)
+	}
 	if ds.ratelimit != nil {
 		result = p.NewMetadataPersistenceRateLimitedClient(result, ds.ratelimit, f.logger)
 	}
@@ -235,6 +265,12 @@ func (f *factoryImpl) NewClusterMetadataManager() (p.ClusterMetadataManager, err
 	}
 
 	result := p.NewClusterMetadataManagerImpl(store, f.logger)
+	if tracing.Enabled() {
+		result = p.NewClusterMetadataPersistenceTracingClient(result, f.logger)
+	}
+	if f.circuitBreakerConfig != nil {
+		result = p.NewClusterMetadataPersistenceCircuitBreakerClient(result, f.circuitBreakerConfig, f.metricsClient, f.logger)
+	}
 	if ds.ratelimit != nil {
 		result = p.NewClusterMetadataPersistenceRateLimitedClient(result, ds.ratelimit, f.logger)
 	}
@@ -252,6 +288,12 @@ func (f *factoryImpl) NewExecutionManager(shardID int32) (p.ExecutionManager, er
 		return nil, err
 	}
 	result := p.NewExecutionManagerImpl(store, f.logger)
+	if tracing.Enabled() {
+		result = p.NewWorkflowExecutionPersistenceTracingClient(result, f.logger)
+	}
+	if f.circuitBreakerConfig != nil {
+		result = p.NewWorkflowExecutionPersistenceCircuitBreakerClient(result, f.circuitBreakerConfig, f.metricsClient, f.logger)
+	}
 	if ds.ratelimit != nil {
 		result = p.NewWorkflowExecutionPersistenceRateLimitedClient(result, ds.ratelimit, f.logger)
 	}
@@ -270,6 +312,12 @@ func (f *factoryImpl) NewVisibilityManager() (p.VisibilityManager, error) {
 	}
 
 	result := p.NewVisibilityManagerImpl(store, f.logger)
+	if tracing.Enabled() {
+		result = p.NewVisibilityPersistenceTracingClient(result, f.logger)
+	}
+	if f.circuitBreakerConfig != nil {
+		result = p.NewVisibilityPersistenceCircuitBreakerClient(result, f.circuitBreakerConfig, f.metricsClient, f.logger)
+	}
 	if ds.ratelimit != nil {
 		result = p.NewVisibilityPersistenceRateLimitedClient(result, ds.ratelimit, f.logger)
 	}
@@ -291,6 +339,12 @@ func (f *factoryImpl) NewNamespaceReplicationQueue() (p.NamespaceReplicationQueu
 	if err != nil {
 		return nil, err
 	}
+	if tracing.Enabled() {
+		result = p.NewQueuePersistenceTracingClient(result, f.logger)
+	}
+	if f.circuitBreakerConfig != nil {
+		result = p.NewQueuePersistenceCircuitBreakerClient(result, f.circuitBreakerConfig, f.metricsClient, f.logger)
+	}
 	if ds.ratelimit != nil {
 		result = p.NewQueuePersistenceRateLimitedClient(result, ds.ratelimit, f.logger)
 	}
@@ -327,7 +381,7 @@ func (f *factoryImpl) init(clusterName string, limiters map[string]quotas.Limite
 	case defaultCfg.Cassandra != nil:
 		defaultDataStore.factory = cassandra.NewFactory(*defaultCfg.Cassandra, clusterName, f.logger)
 	case defaultCfg.SQL != nil:
-		defaultDataStore.factory = sql.NewFactory(*defaultCfg.SQL, clusterName, f.logger)
+		defaultDataStore.factory = sql.NewFactory(*defaultCfg.SQL, f.config.VisibilityConfig, clusterName, f.logger)
 	case defaultCfg.CustomDataStoreConfig != nil:
 		defaultDataStore.factory = f.abstractDataStoreFactory.NewFactory(*defaultCfg.CustomDataStoreConfig, clusterName, f.logger)
 	default:
@@ -346,7 +400,9 @@ func (f *factoryImpl) init(clusterName string, limiters map[string]quotas.Limite
 	case visibilityCfg.Cassandra != nil:
 		visibilityDataStore.factory = cassandra.NewFactory(*visibilityCfg.Cassandra, clusterName, f.logger)
 	case visibilityCfg.SQL != nil:
-		visibilityDataStore.factory = sql.NewFactory(*visibilityCfg.SQL, clusterName, f.logger)
+		visibilityDataStore.factory = sql.NewFactory(*visibilityCfg.SQL, f.config.VisibilityConfig, clusterName, f.logger)
+	case visibilityCfg.CustomDataStoreConfig != nil:
+		visibilityDataStore.factory = f.abstractDataStoreFactory.NewFactory(*visibilityCfg.CustomDataStoreConfig, clusterName, f.logger)
 	default:
 		f.logger.Fatal("invalid config: one of cassandra or sql params must be specified")
 	}
@@ -248,6 +248,11 @@ type (
 		// Cron
 		CronSchedule   string
 		ExecutionStats *persistenceblobs.ExecutionStats
+		// Pause
+		Paused      bool
+		PauseReason string
+		// Updates delivered to the workflow, kept for the lifetime of the run to deduplicate updates by ID
+		UpdateInfos []*persistenceblobs.UpdateInfo
 	}
 
 	// ReplicationTaskInfoWrapper describes a replication task.
@@ -615,6 +620,8 @@ type (
 		ExecutionInfo    *WorkflowExecutionInfo
 		ExecutionStats   *persistenceblobs.ExecutionStats
 		VersionHistories *VersionHistories
+		// Encoding of the persisted execution info, proto3 if unspecified
+		ExecutionInfoEncoding enumspb.EncodingType
 
 		UpsertActivityInfos       []*persistenceblobs.ActivityInfo
 		DeleteActivityInfos       []int64
@@ -644,6 +651,8 @@ type (
 		ExecutionInfo    *WorkflowExecutionInfo
 		ExecutionStats   *persistenceblobs.ExecutionStats
 		VersionHistories *VersionHistories
+		// Encoding of the persisted execution info, proto3 if unspecified
+		ExecutionInfoEncoding enumspb.EncodingType
 
 		ActivityInfos       []*persistenceblobs.ActivityInfo
 		TimerInfos          []*persistenceblobs.TimerInfo
@@ -1024,6 +1033,8 @@ type (
 		TransactionID int64
 		// The shard to get history node data
 		ShardID *int32
+		// Encoding of the persisted batch, proto3 if unspecified
+		Encoding enumspb.EncodingType
 	}
 
 	// AppendHistoryNodesResponse is a response to AppendHistoryNodesRequest
@@ -1047,6 +1058,9 @@ type (
 		NextPageToken []byte
 		// The shard to get history branch data
 		ShardID *int32
+		// AllowStaleRead allows the branch to be read from a replica that lags behind the primary,
+		// it must only be set for branches that no longer change, i.e. of closed workflows
+		AllowStaleRead bool
 	}
 
 	// ReadHistoryBranchResponse is the response to ReadHistoryBranchRequest
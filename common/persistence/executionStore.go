@@ -157,6 +157,9 @@ func (m *executionManagerImpl) DeserializeExecutionInfo(
 		SearchAttributes:                       info.SearchAttributes,
 		Memo:                                   info.Memo,
 		ExecutionStats:                         info.ExecutionStats,
+		Paused:                                 info.Paused,
+		PauseReason:                            info.PauseReason,
+		UpdateInfos:                            info.UpdateInfos,
 	}
 
 	if newInfo.AutoResetPoints == nil {
@@ -274,6 +277,9 @@ func (m *executionManagerImpl) SerializeExecutionInfo(
 		CronSchedule:                           info.CronSchedule,
 		Memo:                                   info.Memo,
 		SearchAttributes:                       info.SearchAttributes,
+		Paused:                                 info.Paused,
+		PauseReason:                            info.PauseReason,
+		UpdateInfos:                            info.UpdateInfos,
 
 		// attributes which are not related to mutable state
 		ExecutionStats: stats,
@@ -407,6 +413,8 @@ func (m *executionManagerImpl) SerializeWorkflowMutation(
 		StartVersion:     startVersion,
 		LastWriteVersion: lastWriteVersion,
 
+		ExecutionInfoEncoding: input.ExecutionInfoEncoding,
+
 		UpsertActivityInfos:       input.UpsertActivityInfos,
 		DeleteActivityInfos:       input.DeleteActivityInfos,
 		UpsertTimerInfos:          input.UpsertTimerInfos,
@@ -458,6 +466,8 @@ func (m *executionManagerImpl) SerializeWorkflowSnapshot(
 		StartVersion:     startVersion,
 		LastWriteVersion: lastWriteVersion,
 
+		ExecutionInfoEncoding: input.ExecutionInfoEncoding,
+
 		ActivityInfos:       input.ActivityInfos,
 		TimerInfos:          input.TimerInfos,
 		ChildExecutionInfos: input.ChildExecutionInfos,
@@ -201,6 +201,7 @@ func (m *historyV2ManagerImpl) AppendHistoryNodes(
 	if err != nil {
 		return nil, err
 	}
+	// size and limit are based on the uncompressed batch so that they do not depend on the codec
 	size := len(blob.Data)
 	sizeLimit := m.transactionSizeLimit()
 	if size > sizeLimit {
@@ -208,6 +209,11 @@ func (m *historyV2ManagerImpl) AppendHistoryNodes(
 			Msg: fmt.Sprintf("transaction size of %v bytes exceeds limit of %v bytes", size, sizeLimit),
 		}
 	}
+	compressed, err := serialization.Compress(*blob, request.Encoding)
+	if err != nil {
+		return nil, NewSerializationError(err.Error())
+	}
+	blob = &compressed
 	shardID, err := getShardID(request.ShardID)
 	if err != nil {
 		m.logger.Error("shardID is not set in append history nodes operation", tag.Error(err))
@@ -372,6 +378,7 @@ func (m *historyV2ManagerImpl) readRawHistoryBranch(
 		LastTransactionID: token.LastTransactionID,
 		ShardID:           shardID,
 		PageSize:          pageSize,
+		AllowStaleRead:    request.AllowStaleRead,
 	}
 
 	resp, err := m.persistence.ReadHistoryBranch(req)
@@ -382,10 +389,16 @@ func (m *historyV2ManagerImpl) readRawHistoryBranch(
 		return nil, nil, 0, nil, serviceerror.NewNotFound("Workflow execution history not found.")
 	}
 
-	dataBlobs := resp.History
+	// compressed batches are unwrapped here so that callers, including replication, only see proto3
+	dataBlobs := make([]*serialization.DataBlob, 0, len(resp.History))
 	dataSize := 0
 	for _, dataBlob := range resp.History {
-		dataSize += len(dataBlob.Data)
+		blob, err := serialization.Decompress(*dataBlob)
+		if err != nil {
+			return nil, nil, 0, nil, serviceerror.NewInternal(err.Error())
+		}
+		dataBlobs = append(dataBlobs, &blob)
+		dataSize += len(blob.Data)
 	}
 
 	token.StoreToken = resp.NextPageToken
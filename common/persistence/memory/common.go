@@ -0,0 +1,177 @@
+// The MIT License
+//
+// Copyright (c) 2020 Temporal Technologies Inc.  All rights reserved.
+//
+// Copyright (c) 2020 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package memory
+
+import (
+	"encoding/binary"
+	"fmt"
+	"sync"
+
+	"go.temporal.io/api/serviceerror"
+
+	"go.temporal.io/server/common/log"
+	p "go.temporal.io/server/common/persistence"
+	"go.temporal.io/server/common/persistence/serialization"
+)
+
+const (
+	// StoreName is the name reported by every store of the in-memory datastore
+	StoreName = "memory"
+)
+
+type (
+	// db holds the tables shared by all the stores vended by the factories of one
+	// AbstractDataStoreFactory. A single lock guards every table so that operations
+	// spanning several tables (e.g. the shard range check of an execution write)
+	// are applied atomically.
+	db struct {
+		sync.RWMutex
+
+		shards map[int32]*shardRow
+
+		taskQueues map[taskQueueKey]*taskQueueRow
+		tasks      map[taskQueueKey]map[int64]serialization.DataBlob
+
+		namespaces          map[string]*namespaceRow
+		namespaceIDs        map[string]string
+		notificationVersion int64
+
+		clusterMetadata *clusterMetadataRow
+		clusterMembers  map[string]*p.ClusterMember
+
+		executions map[int32]*executionsTable
+
+		historyTrees map[string]map[string]serialization.DataBlob
+		historyNodes map[historyBranchKey]map[historyNodeKey]serialization.DataBlob
+
+		visibility map[visibilityKey]*visibilityRow
+
+		queues map[p.QueueType]*queueTable
+	}
+
+	memoryStore struct {
+		db     *db
+		logger log.Logger
+	}
+
+	// transaction records how to revert the writes of an operation, so that an
+	// operation failing half way through leaves the tables as they were
+	transaction struct {
+		undo []func()
+	}
+)
+
+func newDB() *db {
+	return &db{
+		shards:         make(map[int32]*shardRow),
+		taskQueues:     make(map[taskQueueKey]*taskQueueRow),
+		tasks:          make(map[taskQueueKey]map[int64]serialization.DataBlob),
+		namespaces:     make(map[string]*namespaceRow),
+		namespaceIDs:   make(map[string]string),
+		clusterMembers: make(map[string]*p.ClusterMember),
+		executions:     make(map[int32]*executionsTable),
+		historyTrees:   make(map[string]map[string]serialization.DataBlob),
+		historyNodes:   make(map[historyBranchKey]map[historyNodeKey]serialization.DataBlob),
+		visibility:     make(map[visibilityKey]*visibilityRow),
+		queues:         make(map[p.QueueType]*queueTable),
+	}
+}
+
+func (m *memoryStore) GetName() string {
+	return StoreName
+}
+
+// Close is a noop, the tables outlive the stores and are released
+// together with the AbstractDataStoreFactory that owns them
+func (m *memoryStore) Close() {
+}
+
+// txExecute runs fn under the write lock of the db and reverts its writes if it fails
+func (m *memoryStore) txExecute(operation string, fn func(tx *transaction) error) error {
+	m.db.Lock()
+	defer m.db.Unlock()
+
+	tx := &transaction{}
+	err := fn(tx)
+	if err != nil {
+		tx.rollback()
+
+		switch err.(type) {
+		case *p.ConditionFailedError,
+			*p.CurrentWorkflowConditionFailedError,
+			*serviceerror.Internal,
+			*p.WorkflowExecutionAlreadyStartedError,
+			*serviceerror.NamespaceAlreadyExists,
+			*p.ShardOwnershipLostError:
+			return err
+		default:
+			return serviceerror.NewInternal(fmt.Sprintf("%v: %v", operation, err))
+		}
+	}
+	return nil
+}
+
+// onRollback registers a function reverting a write that has just been applied
+func (tx *transaction) onRollback(fn func()) {
+	tx.undo = append(tx.undo, fn)
+}
+
+func (tx *transaction) rollback() {
+	for i := len(tx.undo) - 1; i >= 0; i-- {
+		tx.undo[i]()
+	}
+	tx.undo = nil
+}
+
+// copyBlob returns a blob that does not share its data with the given one,
+// callers are free to reuse the buffers they pass to or get from the stores
+func copyBlob(blob serialization.DataBlob) serialization.DataBlob {
+	return serialization.DataBlob{
+		Encoding: blob.Encoding,
+		Data:     copyBytes(blob.Data),
+	}
+}
+
+func copyBytes(b []byte) []byte {
+	if b == nil {
+		return nil
+	}
+	result := make([]byte, len(b))
+	copy(result, b)
+	return result
+}
+
+func serializePageToken(offset int64) []byte {
+	b := make([]byte, 8)
+	binary.LittleEndian.PutUint64(b, uint64(offset))
+	return b
+}
+
+func deserializePageToken(payload []byte) (int64, error) {
+	if len(payload) != 8 {
+		return 0, fmt.Errorf("Invalid token of %v length", len(payload))
+	}
+	return int64(binary.LittleEndian.Uint64(payload)), nil
+}
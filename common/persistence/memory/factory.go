@@ -0,0 +1,111 @@
+// The MIT License
+//
+// Copyright (c) 2020 Temporal Technologies Inc.  All rights reserved.
+//
+// Copyright (c) 2020 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package memory
+
+import (
+	"go.temporal.io/server/common/log"
+	p "go.temporal.io/server/common/persistence"
+	"go.temporal.io/server/common/persistence/client"
+	"go.temporal.io/server/common/service/config"
+)
+
+type (
+	// Factory vends store objects backed by in-memory tables
+	Factory struct {
+		db          *db
+		clusterName string
+		logger      log.Logger
+	}
+
+	// abstractDataStoreFactory vends factories sharing the same tables
+	abstractDataStoreFactory struct {
+		db *db
+	}
+)
+
+var _ client.DataStoreFactory = (*Factory)(nil)
+
+// NewAbstractDataStoreFactory returns an AbstractDataStoreFactory whose factories all
+// read and write the same in-memory tables. Every server of a cluster must be given
+// the same AbstractDataStoreFactory, the tables are lost once it is released.
+func NewAbstractDataStoreFactory() client.AbstractDataStoreFactory {
+	return &abstractDataStoreFactory{
+		db: newDB(),
+	}
+}
+
+// NewFactory returns an instance of a factory object which can be used to create
+// datastores backed by the in-memory tables of the AbstractDataStoreFactory
+func (f *abstractDataStoreFactory) NewFactory(_ config.CustomDatastoreConfig, clusterName string, logger log.Logger) client.DataStoreFactory {
+	return &Factory{
+		db:          f.db,
+		clusterName: clusterName,
+		logger:      logger,
+	}
+}
+
+// NewTaskStore returns a new task store
+func (f *Factory) NewTaskStore() (p.TaskStore, error) {
+	return newTaskPersistence(f.db, f.logger)
+}
+
+// NewShardStore returns a new shard store
+func (f *Factory) NewShardStore() (p.ShardStore, error) {
+	return newShardPersistence(f.db, f.clusterName, f.logger)
+}
+
+// NewHistoryV2Store returns a new history store
+func (f *Factory) NewHistoryV2Store() (p.HistoryStore, error) {
+	return newHistoryV2Persistence(f.db, f.logger)
+}
+
+// NewMetadataStore returns a new metadata store
+func (f *Factory) NewMetadataStore() (p.MetadataStore, error) {
+	return newMetadataPersistenceV2(f.db, f.logger)
+}
+
+// NewClusterMetadataStore returns a new ClusterMetadata store
+func (f *Factory) NewClusterMetadataStore() (p.ClusterMetadataStore, error) {
+	return newClusterMetadataPersistence(f.db, f.logger)
+}
+
+// NewExecutionStore returns an ExecutionStore for a given shardID
+func (f *Factory) NewExecutionStore(shardID int32) (p.ExecutionStore, error) {
+	return newExecutionPersistence(f.db, f.logger, shardID)
+}
+
+// NewVisibilityStore returns a visibility store
+func (f *Factory) NewVisibilityStore() (p.VisibilityStore, error) {
+	return newVisibilityPersistence(f.db, f.logger)
+}
+
+// NewQueue returns a new queue backed by in-memory tables
+func (f *Factory) NewQueue(queueType p.QueueType) (p.Queue, error) {
+	return newQueue(f.db, f.logger, queueType)
+}
+
+// Close is a noop, the tables are shared with the other factories of the AbstractDataStoreFactory
+func (f *Factory) Close() {
+}
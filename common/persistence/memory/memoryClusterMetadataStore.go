@@ -0,0 +1,185 @@
+// The MIT License
+//
+// Copyright (c) 2020 Temporal Technologies Inc.  All rights reserved.
+//
+// Copyright (c) 2020 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package memory
+
+import (
+	"bytes"
+	"fmt"
+	"net"
+	"sort"
+	"time"
+
+	"go.temporal.io/api/serviceerror"
+
+	"go.temporal.io/server/common/log"
+	p "go.temporal.io/server/common/persistence"
+	"go.temporal.io/server/common/persistence/serialization"
+)
+
+type (
+	memoryClusterMetadataStore struct {
+		memoryStore
+	}
+
+	clusterMetadataRow struct {
+		data    serialization.DataBlob
+		version int64
+	}
+)
+
+var _ p.ClusterMetadataStore = (*memoryClusterMetadataStore)(nil)
+
+func newClusterMetadataPersistence(db *db, logger log.Logger) (p.ClusterMetadataStore, error) {
+	return &memoryClusterMetadataStore{
+		memoryStore: memoryStore{
+			db:     db,
+			logger: logger,
+		},
+	}, nil
+}
+
+func (m *memoryClusterMetadataStore) GetClusterMetadata() (*p.InternalGetClusterMetadataResponse, error) {
+	m.db.RLock()
+	defer m.db.RUnlock()
+
+	row := m.db.clusterMetadata
+	if row == nil {
+		return nil, serviceerror.NewNotFound("GetClusterMetadata failed. Cluster metadata does not exist.")
+	}
+	data := copyBlob(row.data)
+	return &p.InternalGetClusterMetadataResponse{
+		ClusterMetadata: &data,
+		Version:         row.version,
+	}, nil
+}
+
+func (m *memoryClusterMetadataStore) SaveClusterMetadata(request *p.InternalSaveClusterMetadataRequest) (bool, error) {
+	m.db.Lock()
+	defer m.db.Unlock()
+
+	var lastVersion int64
+	if m.db.clusterMetadata != nil {
+		lastVersion = m.db.clusterMetadata.version
+	}
+	if request.Version != lastVersion {
+		return false, serviceerror.NewInternal(fmt.Sprintf("SaveClusterMetadata encountered version mismatch, expected %v but got %v.",
+			request.Version, lastVersion))
+	}
+	m.db.clusterMetadata = &clusterMetadataRow{
+		data:    copyBlob(*request.ClusterMetadata),
+		version: request.Version + 1,
+	}
+	return true, nil
+}
+
+func (m *memoryClusterMetadataStore) GetClusterMembers(request *p.GetClusterMembersRequest) (*p.GetClusterMembersResponse, error) {
+	var lastSeenHostID []byte
+	if len(request.NextPageToken) == 16 {
+		lastSeenHostID = request.NextPageToken
+	} else if len(request.NextPageToken) > 0 {
+		return nil, serviceerror.NewInternal("page token is corrupted.")
+	}
+
+	now := time.Now().UTC()
+	var lastHeartbeatAfter time.Time
+	if request.LastHeartbeatWithin > 0 {
+		lastHeartbeatAfter = now.Add(-request.LastHeartbeatWithin)
+	}
+
+	m.db.RLock()
+	defer m.db.RUnlock()
+
+	members := make([]*p.ClusterMember, 0, len(m.db.clusterMembers))
+	for _, member := range m.db.clusterMembers {
+		switch {
+		case request.HostIDEquals != nil && !bytes.Equal(member.HostID, request.HostIDEquals):
+		case request.RPCAddressEquals != nil && !member.RPCAddress.Equal(request.RPCAddressEquals):
+		case request.RoleEquals != p.All && member.Role != request.RoleEquals:
+		case !lastHeartbeatAfter.IsZero() && !member.LastHeartbeat.After(lastHeartbeatAfter):
+		case !member.RecordExpiry.After(now):
+		case !request.SessionStartedAfter.IsZero() && member.SessionStart.Before(request.SessionStartedAfter):
+		case lastSeenHostID != nil && request.HostIDEquals == nil && bytes.Compare(member.HostID, lastSeenHostID) <= 0:
+		default:
+			members = append(members, copyClusterMember(member))
+		}
+	}
+	sort.Slice(members, func(i, j int) bool {
+		return bytes.Compare(members[i].HostID, members[j].HostID) < 0
+	})
+	if request.PageSize > 0 && len(members) > request.PageSize {
+		members = members[:request.PageSize]
+	}
+
+	var nextPageToken []byte
+	if request.PageSize > 0 && len(members) == request.PageSize {
+		nextPageToken = copyBytes(members[len(members)-1].HostID)
+	}
+	return &p.GetClusterMembersResponse{ActiveMembers: members, NextPageToken: nextPageToken}, nil
+}
+
+func (m *memoryClusterMetadataStore) UpsertClusterMembership(request *p.UpsertClusterMembershipRequest) error {
+	now := time.Now().UTC()
+	member := &p.ClusterMember{
+		Role:          request.Role,
+		HostID:        copyBytes(request.HostID),
+		RPCAddress:    net.ParseIP(request.RPCAddress.String()),
+		RPCPort:       request.RPCPort,
+		SessionStart:  request.SessionStart,
+		LastHeartbeat: now,
+		RecordExpiry:  now.Add(request.RecordExpiry),
+	}
+
+	m.db.Lock()
+	defer m.db.Unlock()
+
+	m.db.clusterMembers[string(member.HostID)] = member
+	return nil
+}
+
+func (m *memoryClusterMetadataStore) PruneClusterMembership(request *p.PruneClusterMembershipRequest) error {
+	now := time.Now().UTC()
+
+	m.db.Lock()
+	defer m.db.Unlock()
+
+	pruned := 0
+	for hostID, member := range m.db.clusterMembers {
+		if request.MaxRecordsPruned > 0 && pruned >= request.MaxRecordsPruned {
+			break
+		}
+		if member.RecordExpiry.Before(now) {
+			delete(m.db.clusterMembers, hostID)
+			pruned++
+		}
+	}
+	return nil
+}
+
+func copyClusterMember(member *p.ClusterMember) *p.ClusterMember {
+	result := *member
+	result.HostID = copyBytes(member.HostID)
+	result.RPCAddress = net.IP(copyBytes(member.RPCAddress))
+	return &result
+}
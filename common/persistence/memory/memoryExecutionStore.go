@@ -0,0 +1,1037 @@
+// The MIT License
+//
+// Copyright (c) 2020 Temporal Technologies Inc.  All rights reserved.
+//
+// Copyright (c) 2020 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package memory
+
+import (
+	"encoding/json"
+	"fmt"
+	"math"
+	"sort"
+	"time"
+
+	"go.temporal.io/api/serviceerror"
+
+	enumsspb "go.temporal.io/server/api/enums/v1"
+	"go.temporal.io/server/api/persistenceblobs/v1"
+	"go.temporal.io/server/common/checksum"
+	"go.temporal.io/server/common/collection"
+	"go.temporal.io/server/common/log"
+	p "go.temporal.io/server/common/persistence"
+	"go.temporal.io/server/common/persistence/serialization"
+)
+
+type (
+	memoryExecutionStore struct {
+		memoryStore
+		shardID int32
+		table   *executionsTable
+	}
+
+	timerTaskPageToken struct {
+		TaskID    int64
+		Timestamp time.Time
+	}
+
+	concreteExecutionPageToken struct {
+		NamespaceID string
+		WorkflowID  string
+		RunID       string
+	}
+)
+
+var _ p.ExecutionStore = (*memoryExecutionStore)(nil)
+
+// newExecutionPersistence creates an instance of ExecutionStore
+func newExecutionPersistence(db *db, logger log.Logger, shardID int32) (p.ExecutionStore, error) {
+	db.Lock()
+	defer db.Unlock()
+
+	table, ok := db.executions[shardID]
+	if !ok {
+		table = newExecutionsTable()
+		db.executions[shardID] = table
+	}
+	return &memoryExecutionStore{
+		memoryStore: memoryStore{
+			db:     db,
+			logger: logger,
+		},
+		shardID: shardID,
+		table:   table,
+	}, nil
+}
+
+// txExecuteShardLocked executes fn under transaction, after checking that the shard is still owned by the caller
+func (m *memoryExecutionStore) txExecuteShardLocked(
+	operation string,
+	rangeID int64,
+	fn func(tx *transaction) error,
+) error {
+
+	return m.txExecute(operation, func(tx *transaction) error {
+		if err := lockShard(m.db, m.shardID, rangeID); err != nil {
+			return err
+		}
+		return fn(tx)
+	})
+}
+
+func (m *memoryExecutionStore) GetShardID() int32 {
+	return m.shardID
+}
+
+func (m *memoryExecutionStore) CreateWorkflowExecution(
+	request *p.InternalCreateWorkflowExecutionRequest,
+) (response *p.CreateWorkflowExecutionResponse, err error) {
+
+	err = m.txExecuteShardLocked("CreateWorkflowExecution", request.RangeID, func(tx *transaction) error {
+		response, err = m.createWorkflowExecutionTx(tx, request)
+		return err
+	})
+	return
+}
+
+func (m *memoryExecutionStore) createWorkflowExecutionTx(
+	tx *transaction,
+	request *p.InternalCreateWorkflowExecutionRequest,
+) (*p.CreateWorkflowExecutionResponse, error) {
+
+	newWorkflow := request.NewWorkflowSnapshot
+	executionInfo := newWorkflow.ExecutionInfo
+	workflowID := executionInfo.WorkflowId
+	runID := executionInfo.ExecutionState.RunId
+
+	if err := p.ValidateCreateWorkflowModeState(
+		request.Mode,
+		newWorkflow,
+	); err != nil {
+		return nil, err
+	}
+
+	currentKey := currentExecutionKey{namespaceID: executionInfo.NamespaceId, workflowID: workflowID}
+	row, ok := m.table.currentExecutions[currentKey]
+
+	// current workflow record check
+	if ok {
+		// current run ID, last write version, current workflow state check
+		switch request.Mode {
+		case p.CreateWorkflowModeBrandNew:
+			return nil, &p.WorkflowExecutionAlreadyStartedError{
+				Msg:              fmt.Sprintf("Workflow execution already running. WorkflowId: %v", workflowID),
+				StartRequestID:   row.createRequestID,
+				RunID:            row.runID,
+				State:            row.state,
+				Status:           row.status,
+				LastWriteVersion: row.lastWriteVersion,
+			}
+
+		case p.CreateWorkflowModeWorkflowIDReuse:
+			if request.PreviousLastWriteVersion != row.lastWriteVersion {
+				return nil, &p.CurrentWorkflowConditionFailedError{
+					Msg: fmt.Sprintf("Workflow execution creation condition failed. WorkflowId: %v, "+
+						"LastWriteVersion: %v, PreviousLastWriteVersion: %v",
+						workflowID, row.lastWriteVersion, request.PreviousLastWriteVersion),
+				}
+			}
+			if row.state != enumsspb.WORKFLOW_EXECUTION_STATE_COMPLETED {
+				return nil, &p.CurrentWorkflowConditionFailedError{
+					Msg: fmt.Sprintf("Workflow execution creation condition failed. WorkflowId: %v, "+
+						"State: %v, Expected: %v",
+						workflowID, row.state, enumsspb.WORKFLOW_EXECUTION_STATE_COMPLETED),
+				}
+			}
+			if row.runID != request.PreviousRunID {
+				return nil, &p.CurrentWorkflowConditionFailedError{
+					Msg: fmt.Sprintf("Workflow execution creation condition failed. WorkflowId: %v, "+
+						"RunId: %v, PreviousRunId: %v",
+						workflowID, row.runID, request.PreviousRunID),
+				}
+			}
+
+		case p.CreateWorkflowModeZombie:
+			// zombie workflow creation with existence of current record, this is a noop
+			if err := assertRunIDMismatch(runID, row.runID); err != nil {
+				return nil, err
+			}
+
+		case p.CreateWorkflowModeContinueAsNew:
+			if row.runID != request.PreviousRunID {
+				return nil, &p.CurrentWorkflowConditionFailedError{
+					Msg: fmt.Sprintf("Workflow execution creation condition failed. WorkflowId: %v, "+
+						"RunId: %v, PreviousRunId: %v",
+						workflowID, row.runID, request.PreviousRunID),
+				}
+			}
+
+		default:
+			return nil, serviceerror.NewInternal(fmt.Sprintf("CreteWorkflowExecution: unknown mode: %v", request.Mode))
+		}
+	}
+
+	newRow := &currentExecutionRow{
+		runID:            runID,
+		createRequestID:  executionInfo.ExecutionState.CreateRequestId,
+		state:            executionInfo.ExecutionState.State,
+		status:           executionInfo.ExecutionState.Status,
+		startVersion:     newWorkflow.StartVersion,
+		lastWriteVersion: newWorkflow.LastWriteVersion,
+	}
+	switch request.Mode {
+	case p.CreateWorkflowModeContinueAsNew, p.CreateWorkflowModeWorkflowIDReuse:
+		if !ok {
+			return nil, serviceerror.NewInternal(fmt.Sprintf("createOrUpdateCurrentExecution failed. Current execution of workflow %v does not exist.", workflowID))
+		}
+		m.table.putCurrentExecution(tx, currentKey, newRow)
+	case p.CreateWorkflowModeBrandNew:
+		m.table.putCurrentExecution(tx, currentKey, newRow)
+	case p.CreateWorkflowModeZombie:
+		// noop
+	}
+
+	if err := m.applyWorkflowSnapshotTxAsNew(tx, &request.NewWorkflowSnapshot); err != nil {
+		return nil, err
+	}
+
+	return &p.CreateWorkflowExecutionResponse{}, nil
+}
+
+func (m *memoryExecutionStore) GetWorkflowExecution(
+	request *p.GetWorkflowExecutionRequest,
+) (*p.InternalGetWorkflowExecutionResponse, error) {
+
+	m.db.RLock()
+	defer m.db.RUnlock()
+
+	row, ok := m.table.executions[executionKey{
+		namespaceID: request.NamespaceID,
+		workflowID:  request.Execution.GetWorkflowId(),
+		runID:       request.Execution.GetRunId(),
+	}]
+	if !ok {
+		return nil, serviceerror.NewNotFound(fmt.Sprintf("Workflow executionsRow not found.  WorkflowId: %v, RunId: %v",
+			request.Execution.GetWorkflowId(),
+			request.Execution.GetRunId()))
+	}
+
+	info, err := serialization.WorkflowExecutionInfoFromBlob(row.data.Data, serialization.EncodingTypeName(row.data.Encoding))
+	if err != nil {
+		return nil, err
+	}
+
+	executionState, err := serialization.WorkflowExecutionStateFromBlob(row.state.Data, row.state.Encoding.String())
+	if err != nil {
+		return nil, err
+	}
+
+	// Build partial from proto
+	executionInfo := p.WorkflowExecutionFromProto(info, executionState, row.nextEventID)
+
+	state := &p.InternalWorkflowMutableState{
+		ExecutionInfo:       executionInfo,
+		VersionHistories:    info.GetVersionHistories(),
+		ActivityInfos:       make(map[int64]*persistenceblobs.ActivityInfo, len(row.activityInfos)),
+		TimerInfos:          make(map[string]*persistenceblobs.TimerInfo, len(row.timerInfos)),
+		ChildExecutionInfos: make(map[int64]*persistenceblobs.ChildExecutionInfo, len(row.childExecutionInfos)),
+		RequestCancelInfos:  make(map[int64]*persistenceblobs.RequestCancelInfo, len(row.requestCancelInfos)),
+		SignalInfos:         make(map[int64]*persistenceblobs.SignalInfo, len(row.signalInfos)),
+		SignalRequestedIDs:  make(map[string]struct{}, len(row.signalsRequested)),
+		Checksum: checksum.Checksum{
+			Version: row.checksum.Version,
+			Flavor:  row.checksum.Flavor,
+			Value:   copyBytes(row.checksum.Value),
+		},
+	}
+
+	for k, v := range row.activityInfos {
+		if state.ActivityInfos[k], err = serialization.ActivityInfoFromBlob(v.Data, v.Encoding.String()); err != nil {
+			return nil, err
+		}
+	}
+	for k, v := range row.timerInfos {
+		if state.TimerInfos[k], err = serialization.TimerInfoFromBlob(v.Data, v.Encoding.String()); err != nil {
+			return nil, err
+		}
+	}
+	for k, v := range row.childExecutionInfos {
+		if state.ChildExecutionInfos[k], err = serialization.ChildExecutionInfoFromBlob(v.Data, v.Encoding.String()); err != nil {
+			return nil, err
+		}
+	}
+	for k, v := range row.requestCancelInfos {
+		if state.RequestCancelInfos[k], err = serialization.RequestCancelInfoFromBlob(v.Data, v.Encoding.String()); err != nil {
+			return nil, err
+		}
+	}
+	for k, v := range row.signalInfos {
+		if state.SignalInfos[k], err = serialization.SignalInfoFromBlob(v.Data, v.Encoding.String()); err != nil {
+			return nil, err
+		}
+	}
+	for k := range row.signalsRequested {
+		state.SignalRequestedIDs[k] = struct{}{}
+	}
+	for _, v := range row.bufferedEvents {
+		blob := copyBlob(v)
+		state.BufferedEvents = append(state.BufferedEvents, &blob)
+	}
+
+	return &p.InternalGetWorkflowExecutionResponse{State: state}, nil
+}
+
+func (m *memoryExecutionStore) UpdateWorkflowExecution(
+	request *p.InternalUpdateWorkflowExecutionRequest,
+) error {
+
+	return m.txExecuteShardLocked("UpdateWorkflowExecution", request.RangeID, func(tx *transaction) error {
+		return m.updateWorkflowExecutionTx(tx, request)
+	})
+}
+
+func (m *memoryExecutionStore) updateWorkflowExecutionTx(
+	tx *transaction,
+	request *p.InternalUpdateWorkflowExecutionRequest,
+) error {
+
+	updateWorkflow := request.UpdateWorkflowMutation
+	newWorkflow := request.NewWorkflowSnapshot
+
+	executionInfo := updateWorkflow.ExecutionInfo
+	namespaceID := executionInfo.NamespaceId
+	workflowID := executionInfo.WorkflowId
+	runID := executionInfo.ExecutionState.RunId
+
+	if err := p.ValidateUpdateWorkflowModeState(
+		request.Mode,
+		updateWorkflow,
+		newWorkflow,
+	); err != nil {
+		return err
+	}
+
+	switch request.Mode {
+	case p.UpdateWorkflowModeBypassCurrent:
+		if err := m.assertNotCurrentExecution(
+			namespaceID,
+			workflowID,
+			runID); err != nil {
+			return err
+		}
+
+	case p.UpdateWorkflowModeUpdateCurrent:
+		if newWorkflow != nil {
+			newExecutionInfo := newWorkflow.ExecutionInfo
+
+			if namespaceID != newExecutionInfo.NamespaceId {
+				return serviceerror.NewInternal(fmt.Sprintf("UpdateWorkflowExecution: cannot continue as new to another namespace"))
+			}
+
+			if err := m.assertRunIDAndUpdateCurrentExecution(tx,
+				namespaceID,
+				workflowID,
+				newExecutionInfo.ExecutionState.RunId,
+				runID,
+				newExecutionInfo.ExecutionState.CreateRequestId,
+				newExecutionInfo.ExecutionState.State,
+				newExecutionInfo.ExecutionState.Status,
+				newWorkflow.StartVersion,
+				newWorkflow.LastWriteVersion); err != nil {
+				return serviceerror.NewInternal(fmt.Sprintf("UpdateWorkflowExecution: failed to continue as new current execution. Error: %v", err))
+			}
+		} else {
+			// this is only to update the current record
+			if err := m.assertRunIDAndUpdateCurrentExecution(tx,
+				namespaceID,
+				workflowID,
+				runID,
+				runID,
+				executionInfo.ExecutionState.CreateRequestId,
+				executionInfo.ExecutionState.State,
+				executionInfo.ExecutionState.Status,
+				updateWorkflow.StartVersion,
+				updateWorkflow.LastWriteVersion); err != nil {
+				return serviceerror.NewInternal(fmt.Sprintf("UpdateWorkflowExecution: failed to update current execution. Error: %v", err))
+			}
+		}
+
+	default:
+		return serviceerror.NewInternal(fmt.Sprintf("UpdateWorkflowExecution: unknown mode: %v", request.Mode))
+	}
+
+	if err := m.applyWorkflowMutationTx(tx, &updateWorkflow); err != nil {
+		return err
+	}
+	if newWorkflow != nil {
+		if err := m.applyWorkflowSnapshotTxAsNew(tx, newWorkflow); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (m *memoryExecutionStore) ResetWorkflowExecution(
+	request *p.InternalResetWorkflowExecutionRequest,
+) error {
+
+	return m.txExecuteShardLocked("ResetWorkflowExecution", request.RangeID, func(tx *transaction) error {
+		return m.resetWorkflowExecutionTx(tx, request)
+	})
+}
+
+func (m *memoryExecutionStore) resetWorkflowExecutionTx(
+	tx *transaction,
+	request *p.InternalResetWorkflowExecutionRequest,
+) error {
+
+	newExecutionInfo := request.NewWorkflowSnapshot.ExecutionInfo
+	namespaceID := newExecutionInfo.NamespaceId
+	workflowID := newExecutionInfo.WorkflowId
+
+	// 1. update current execution
+	currentKey := currentExecutionKey{namespaceID: namespaceID, workflowID: workflowID}
+	if _, ok := m.table.currentExecutions[currentKey]; !ok {
+		return serviceerror.NewInternal(fmt.Sprintf("ResetWorkflowExecution operation failed. Failed at updateCurrentExecution. Error: current execution of workflow %v does not exist", workflowID))
+	}
+	m.table.putCurrentExecution(tx, currentKey, &currentExecutionRow{
+		runID:            newExecutionInfo.ExecutionState.RunId,
+		createRequestID:  newExecutionInfo.ExecutionState.CreateRequestId,
+		state:            newExecutionInfo.ExecutionState.State,
+		status:           newExecutionInfo.ExecutionState.Status,
+		startVersion:     request.NewWorkflowSnapshot.StartVersion,
+		lastWriteVersion: request.NewWorkflowSnapshot.LastWriteVersion,
+	})
+
+	// 2. check base run: it is only needed when base run is not current run,
+	// because the current run is checked anyway
+	if request.BaseRunID != request.CurrentRunID {
+		baseKey := executionKey{namespaceID: namespaceID, workflowID: workflowID, runID: request.BaseRunID}
+		if _, err := m.lockAndCheckNextEventID(baseKey, request.BaseRunNextEventID); err != nil {
+			switch err.(type) {
+			case *p.ConditionFailedError:
+				return err
+			default:
+				return serviceerror.NewInternal(fmt.Sprintf("ResetWorkflowExecution operation failed. Failed to lock executions row. Error: %v", err))
+			}
+		}
+	}
+
+	// 3. update or check current run
+	if request.CurrentWorkflowMutation != nil {
+		if err := m.applyWorkflowMutationTx(tx, request.CurrentWorkflowMutation); err != nil {
+			return err
+		}
+	} else {
+		currentKey := executionKey{namespaceID: namespaceID, workflowID: workflowID, runID: request.CurrentRunID}
+		if _, err := m.lockAndCheckNextEventID(currentKey, request.CurrentRunNextEventID); err != nil {
+			switch err.(type) {
+			case *p.ConditionFailedError:
+				return err
+			default:
+				return serviceerror.NewInternal(fmt.Sprintf("ResetWorkflowExecution operation failed. Failed to lock executions row. Error: %v", err))
+			}
+		}
+	}
+
+	// 4. create the new reset workflow
+	return m.applyWorkflowSnapshotTxAsNew(tx, &request.NewWorkflowSnapshot)
+}
+
+func (m *memoryExecutionStore) ConflictResolveWorkflowExecution(
+	request *p.InternalConflictResolveWorkflowExecutionRequest,
+) error {
+
+	return m.txExecuteShardLocked("ConflictResolveWorkflowExecution", request.RangeID, func(tx *transaction) error {
+		return m.conflictResolveWorkflowExecutionTx(tx, request)
+	})
+}
+
+func (m *memoryExecutionStore) conflictResolveWorkflowExecutionTx(
+	tx *transaction,
+	request *p.InternalConflictResolveWorkflowExecutionRequest,
+) error {
+
+	currentWorkflow := request.CurrentWorkflowMutation
+	resetWorkflow := request.ResetWorkflowSnapshot
+	newWorkflow := request.NewWorkflowSnapshot
+
+	namespaceID := resetWorkflow.ExecutionInfo.NamespaceId
+	workflowID := resetWorkflow.ExecutionInfo.WorkflowId
+
+	if err := p.ValidateConflictResolveWorkflowModeState(
+		request.Mode,
+		resetWorkflow,
+		newWorkflow,
+		currentWorkflow,
+	); err != nil {
+		return err
+	}
+
+	switch request.Mode {
+	case p.ConflictResolveWorkflowModeBypassCurrent:
+		if err := m.assertNotCurrentExecution(
+			namespaceID,
+			workflowID,
+			resetWorkflow.ExecutionInfo.ExecutionState.RunId); err != nil {
+			return err
+		}
+
+	case p.ConflictResolveWorkflowModeUpdateCurrent:
+		executionInfo := resetWorkflow.ExecutionInfo
+		startVersion := resetWorkflow.StartVersion
+		lastWriteVersion := resetWorkflow.LastWriteVersion
+		if newWorkflow != nil {
+			executionInfo = newWorkflow.ExecutionInfo
+			startVersion = newWorkflow.StartVersion
+			lastWriteVersion = newWorkflow.LastWriteVersion
+		}
+
+		// reset workflow is current unless the current workflow is given
+		prevRunID := resetWorkflow.ExecutionInfo.ExecutionState.RunId
+		if currentWorkflow != nil {
+			prevRunID = currentWorkflow.ExecutionInfo.ExecutionState.RunId
+		}
+
+		if err := m.assertRunIDAndUpdateCurrentExecution(tx,
+			namespaceID,
+			workflowID,
+			executionInfo.ExecutionState.RunId,
+			prevRunID,
+			executionInfo.ExecutionState.CreateRequestId,
+			executionInfo.ExecutionState.State,
+			executionInfo.ExecutionState.Status,
+			startVersion,
+			lastWriteVersion); err != nil {
+			return serviceerror.NewInternal(fmt.Sprintf("ConflictResolveWorkflowExecution. Failed to comare and swap the current record. Error: %v", err))
+		}
+
+	default:
+		return serviceerror.NewInternal(fmt.Sprintf("ConflictResolveWorkflowExecution: unknown mode: %v", request.Mode))
+	}
+
+	if err := m.applyWorkflowSnapshotTxAsReset(tx, &resetWorkflow); err != nil {
+		return err
+	}
+	if currentWorkflow != nil {
+		if err := m.applyWorkflowMutationTx(tx, currentWorkflow); err != nil {
+			return err
+		}
+	}
+	if newWorkflow != nil {
+		if err := m.applyWorkflowSnapshotTxAsNew(tx, newWorkflow); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (m *memoryExecutionStore) DeleteWorkflowExecution(
+	request *p.DeleteWorkflowExecutionRequest,
+) error {
+
+	m.db.Lock()
+	defer m.db.Unlock()
+
+	delete(m.table.executions, executionKey{
+		namespaceID: request.NamespaceID,
+		workflowID:  request.WorkflowID,
+		runID:       request.RunID,
+	})
+	return nil
+}
+
+// its possible for a new run of the same workflow to have started after the run we are deleting
+// here was finished. In that case, current_executions table will have the same workflowID but different
+// runID. The following code will delete the row from current_executions if and only if the runID is
+// same as the one we are trying to delete here
+func (m *memoryExecutionStore) DeleteCurrentWorkflowExecution(
+	request *p.DeleteCurrentWorkflowExecutionRequest,
+) error {
+
+	m.db.Lock()
+	defer m.db.Unlock()
+
+	key := currentExecutionKey{namespaceID: request.NamespaceID, workflowID: request.WorkflowID}
+	if row, ok := m.table.currentExecutions[key]; ok && row.runID == request.RunID {
+		delete(m.table.currentExecutions, key)
+	}
+	return nil
+}
+
+func (m *memoryExecutionStore) GetCurrentExecution(
+	request *p.GetCurrentExecutionRequest,
+) (*p.GetCurrentExecutionResponse, error) {
+
+	m.db.RLock()
+	defer m.db.RUnlock()
+
+	row, ok := m.table.currentExecutions[currentExecutionKey{namespaceID: request.NamespaceID, workflowID: request.WorkflowID}]
+	if !ok {
+		return nil, serviceerror.NewNotFound(fmt.Sprintf("GetCurrentExecution operation failed. Current execution of workflow %v not found.", request.WorkflowID))
+	}
+	return &p.GetCurrentExecutionResponse{
+		StartRequestID:   row.createRequestID,
+		RunID:            row.runID,
+		State:            row.state,
+		Status:           row.status,
+		LastWriteVersion: row.lastWriteVersion,
+	}, nil
+}
+
+func (m *memoryExecutionStore) ListConcreteExecutions(
+	request *p.ListConcreteExecutionsRequest,
+) (*p.InternalListConcreteExecutionsResponse, error) {
+
+	var pageToken concreteExecutionPageToken
+	if len(request.PageToken) > 0 {
+		if err := json.Unmarshal(request.PageToken, &pageToken); err != nil {
+			return nil, serviceerror.NewInvalidArgument(fmt.Sprintf("ListConcreteExecutions operation failed. Invalid page token. Error: %v", err))
+		}
+	}
+	lastKey := executionKey{
+		namespaceID: pageToken.NamespaceID,
+		workflowID:  pageToken.WorkflowID,
+		runID:       pageToken.RunID,
+	}
+
+	m.db.RLock()
+	defer m.db.RUnlock()
+
+	var keys []executionKey
+	for key := range m.table.executions {
+		if len(request.PageToken) == 0 || lessExecutionKey(lastKey, key) {
+			keys = append(keys, key)
+		}
+	}
+	sort.Slice(keys, func(i, j int) bool {
+		return lessExecutionKey(keys[i], keys[j])
+	})
+
+	response := &p.InternalListConcreteExecutionsResponse{}
+	if request.PageSize > 0 && len(keys) > request.PageSize {
+		keys = keys[:request.PageSize]
+		lastKey = keys[len(keys)-1]
+		token, err := json.Marshal(concreteExecutionPageToken{
+			NamespaceID: lastKey.namespaceID,
+			WorkflowID:  lastKey.workflowID,
+			RunID:       lastKey.runID,
+		})
+		if err != nil {
+			return nil, serviceerror.NewInternal(fmt.Sprintf("ListConcreteExecutions operation failed. Failed to serialize page token. Error: %v", err))
+		}
+		response.NextPageToken = token
+	}
+
+	for _, key := range keys {
+		row := m.table.executions[key]
+		info, err := serialization.WorkflowExecutionInfoFromBlob(row.data.Data, serialization.EncodingTypeName(row.data.Encoding))
+		if err != nil {
+			return nil, err
+		}
+		executionState, err := serialization.WorkflowExecutionStateFromBlob(row.state.Data, row.state.Encoding.String())
+		if err != nil {
+			return nil, err
+		}
+		response.ExecutionInfos = append(response.ExecutionInfos, p.WorkflowExecutionFromProto(info, executionState, row.nextEventID))
+	}
+	return response, nil
+}
+
+func (m *memoryExecutionStore) GetTransferTask(request *p.GetTransferTaskRequest) (*p.GetTransferTaskResponse, error) {
+	m.db.RLock()
+	defer m.db.RUnlock()
+
+	blob, ok := m.table.transferTasks[request.TaskID]
+	if !ok {
+		return nil, serviceerror.NewNotFound(fmt.Sprintf("GetTransferTask operation failed. Task with ID %v not found.", request.TaskID))
+	}
+	transferInfo, err := serialization.TransferTaskInfoFromBlob(blob.Data, blob.Encoding.String())
+	if err != nil {
+		return nil, err
+	}
+	return &p.GetTransferTaskResponse{TransferTaskInfo: transferInfo}, nil
+}
+
+func (m *memoryExecutionStore) GetTransferTasks(
+	request *p.GetTransferTasksRequest,
+) (*p.GetTransferTasksResponse, error) {
+
+	m.db.RLock()
+	defer m.db.RUnlock()
+
+	var taskIDs []int64
+	for taskID := range m.table.transferTasks {
+		if taskID > request.ReadLevel && taskID <= request.MaxReadLevel {
+			taskIDs = append(taskIDs, taskID)
+		}
+	}
+	sortInt64s(taskIDs)
+
+	resp := &p.GetTransferTasksResponse{Tasks: make([]*persistenceblobs.TransferTaskInfo, len(taskIDs))}
+	for i, taskID := range taskIDs {
+		blob := m.table.transferTasks[taskID]
+		info, err := serialization.TransferTaskInfoFromBlob(blob.Data, blob.Encoding.String())
+		if err != nil {
+			return nil, err
+		}
+		resp.Tasks[i] = info
+	}
+	return resp, nil
+}
+
+func (m *memoryExecutionStore) CompleteTransferTask(
+	request *p.CompleteTransferTaskRequest,
+) error {
+
+	m.db.Lock()
+	defer m.db.Unlock()
+
+	delete(m.table.transferTasks, request.TaskID)
+	return nil
+}
+
+func (m *memoryExecutionStore) RangeCompleteTransferTask(
+	request *p.RangeCompleteTransferTaskRequest,
+) error {
+
+	m.db.Lock()
+	defer m.db.Unlock()
+
+	for taskID := range m.table.transferTasks {
+		if taskID > request.ExclusiveBeginTaskID && taskID <= request.InclusiveEndTaskID {
+			delete(m.table.transferTasks, taskID)
+		}
+	}
+	return nil
+}
+
+func (m *memoryExecutionStore) GetReplicationTask(request *p.GetReplicationTaskRequest) (*p.GetReplicationTaskResponse, error) {
+	m.db.RLock()
+	defer m.db.RUnlock()
+
+	blob, ok := m.table.replicationTasks[request.TaskID]
+	if !ok {
+		return nil, serviceerror.NewNotFound(fmt.Sprintf("GetReplicationTask operation failed. Task with ID %v not found.", request.TaskID))
+	}
+	replicationInfo, err := serialization.ReplicationTaskInfoFromBlob(blob.Data, blob.Encoding.String())
+	if err != nil {
+		return nil, err
+	}
+	return &p.GetReplicationTaskResponse{ReplicationTaskInfo: replicationInfo}, nil
+}
+
+func (m *memoryExecutionStore) GetReplicationTasks(
+	request *p.GetReplicationTasksRequest,
+) (*p.GetReplicationTasksResponse, error) {
+
+	readLevel, maxReadLevelInclusive, err := getReadLevels(request)
+	if err != nil {
+		return nil, err
+	}
+
+	m.db.RLock()
+	defer m.db.RUnlock()
+
+	return getReplicationTasks(m.table.replicationTasks, readLevel, maxReadLevelInclusive, request.BatchSize, request.MaxReadLevel)
+}
+
+func getReadLevels(request *p.GetReplicationTasksRequest) (readLevel int64, maxReadLevelInclusive int64, err error) {
+	readLevel = request.ReadLevel
+	if len(request.NextPageToken) > 0 {
+		readLevel, err = deserializePageToken(request.NextPageToken)
+		if err != nil {
+			return 0, 0, err
+		}
+	}
+
+	maxReadLevelInclusive = collection.MaxInt64(readLevel+int64(request.BatchSize), request.MaxReadLevel)
+	return readLevel, maxReadLevelInclusive, nil
+}
+
+// getReplicationTasks returns the tasks with ID in (readLevel, maxReadLevelInclusive], the db lock must be held
+func getReplicationTasks(
+	tasks map[int64]serialization.DataBlob,
+	readLevel int64,
+	maxReadLevelInclusive int64,
+	batchSize int,
+	requestMaxReadLevel int64,
+) (*p.GetReplicationTasksResponse, error) {
+
+	var taskIDs []int64
+	for taskID := range tasks {
+		if taskID > readLevel && taskID <= maxReadLevelInclusive {
+			taskIDs = append(taskIDs, taskID)
+		}
+	}
+	if len(taskIDs) == 0 {
+		return &p.GetReplicationTasksResponse{}, nil
+	}
+	sortInt64s(taskIDs)
+	if len(taskIDs) > batchSize {
+		taskIDs = taskIDs[:batchSize]
+	}
+
+	replicationTasks := make([]*persistenceblobs.ReplicationTaskInfo, len(taskIDs))
+	for i, taskID := range taskIDs {
+		blob := tasks[taskID]
+		info, err := serialization.ReplicationTaskInfoFromBlob(blob.Data, blob.Encoding.String())
+		if err != nil {
+			return nil, err
+		}
+		replicationTasks[i] = info
+	}
+
+	var nextPageToken []byte
+	lastTaskID := taskIDs[len(taskIDs)-1]
+	if lastTaskID < requestMaxReadLevel {
+		nextPageToken = serializePageToken(lastTaskID)
+	}
+	return &p.GetReplicationTasksResponse{
+		Tasks:         replicationTasks,
+		NextPageToken: nextPageToken,
+	}, nil
+}
+
+func (m *memoryExecutionStore) CompleteReplicationTask(
+	request *p.CompleteReplicationTaskRequest,
+) error {
+
+	m.db.Lock()
+	defer m.db.Unlock()
+
+	delete(m.table.replicationTasks, request.TaskID)
+	return nil
+}
+
+func (m *memoryExecutionStore) RangeCompleteReplicationTask(
+	request *p.RangeCompleteReplicationTaskRequest,
+) error {
+
+	m.db.Lock()
+	defer m.db.Unlock()
+
+	for taskID := range m.table.replicationTasks {
+		if taskID <= request.InclusiveEndTaskID {
+			delete(m.table.replicationTasks, taskID)
+		}
+	}
+	return nil
+}
+
+func (m *memoryExecutionStore) PutReplicationTaskToDLQ(request *p.PutReplicationTaskToDLQRequest) error {
+	blob, err := serialization.ReplicationTaskInfoToBlob(request.TaskInfo)
+	if err != nil {
+		return err
+	}
+
+	m.db.Lock()
+	defer m.db.Unlock()
+
+	dlq, ok := m.table.replicationDLQ[request.SourceClusterName]
+	if !ok {
+		dlq = make(map[int64]serialization.DataBlob)
+		m.table.replicationDLQ[request.SourceClusterName] = dlq
+	}
+
+	// Tasks are immutable. So it's fine if we already persisted it before.
+	// This can happen when tasks are retried (ack and cleanup can have lag on source side).
+	if _, ok := dlq[request.TaskInfo.GetTaskId()]; !ok {
+		dlq[request.TaskInfo.GetTaskId()] = blob
+	}
+	return nil
+}
+
+func (m *memoryExecutionStore) GetReplicationTasksFromDLQ(
+	request *p.GetReplicationTasksFromDLQRequest,
+) (*p.GetReplicationTasksFromDLQResponse, error) {
+
+	readLevel, maxReadLevelInclusive, err := getReadLevels(&request.GetReplicationTasksRequest)
+	if err != nil {
+		return nil, err
+	}
+
+	m.db.RLock()
+	defer m.db.RUnlock()
+
+	return getReplicationTasks(m.table.replicationDLQ[request.SourceClusterName], readLevel, maxReadLevelInclusive, request.BatchSize, request.MaxReadLevel)
+}
+
+func (m *memoryExecutionStore) DeleteReplicationTaskFromDLQ(
+	request *p.DeleteReplicationTaskFromDLQRequest,
+) error {
+
+	m.db.Lock()
+	defer m.db.Unlock()
+
+	delete(m.table.replicationDLQ[request.SourceClusterName], request.TaskID)
+	return nil
+}
+
+func (m *memoryExecutionStore) RangeDeleteReplicationTaskFromDLQ(
+	request *p.RangeDeleteReplicationTaskFromDLQRequest,
+) error {
+
+	m.db.Lock()
+	defer m.db.Unlock()
+
+	dlq := m.table.replicationDLQ[request.SourceClusterName]
+	for taskID := range dlq {
+		if taskID > request.ExclusiveBeginTaskID && taskID <= request.InclusiveEndTaskID {
+			delete(dlq, taskID)
+		}
+	}
+	return nil
+}
+
+func (t *timerTaskPageToken) serialize() ([]byte, error) {
+	return json.Marshal(t)
+}
+
+func (t *timerTaskPageToken) deserialize(payload []byte) error {
+	return json.Unmarshal(payload, t)
+}
+
+func (m *memoryExecutionStore) GetTimerTask(request *p.GetTimerTaskRequest) (*p.GetTimerTaskResponse, error) {
+	m.db.RLock()
+	defer m.db.RUnlock()
+
+	blob, ok := m.table.timerTasks[timerTaskKey{
+		visibilityTimestamp: request.VisibilityTimestamp.UnixNano(),
+		taskID:              request.TaskID,
+	}]
+	if !ok {
+		return nil, serviceerror.NewNotFound(fmt.Sprintf("GetTimerTask operation failed. Task with ID %v not found.", request.TaskID))
+	}
+	timerInfo, err := serialization.TimerTaskInfoFromBlob(blob.Data, blob.Encoding.String())
+	if err != nil {
+		return nil, err
+	}
+	return &p.GetTimerTaskResponse{TimerTaskInfo: timerInfo}, nil
+}
+
+func (m *memoryExecutionStore) GetTimerIndexTasks(
+	request *p.GetTimerIndexTasksRequest,
+) (*p.GetTimerIndexTasksResponse, error) {
+
+	pageToken := &timerTaskPageToken{TaskID: math.MinInt64, Timestamp: request.MinTimestamp}
+	if len(request.NextPageToken) > 0 {
+		if err := pageToken.deserialize(request.NextPageToken); err != nil {
+			return nil, serviceerror.NewInternal(fmt.Sprintf("error deserializing timerTaskPageToken: %v", err))
+		}
+	}
+
+	minTimestamp := pageToken.Timestamp.UnixNano()
+	maxTimestamp := request.MaxTimestamp.UnixNano()
+
+	m.db.RLock()
+	defer m.db.RUnlock()
+
+	var keys []timerTaskKey
+	for key := range m.table.timerTasks {
+		if ((key.visibilityTimestamp >= minTimestamp && key.taskID >= pageToken.TaskID) || key.visibilityTimestamp > minTimestamp) &&
+			key.visibilityTimestamp < maxTimestamp {
+			keys = append(keys, key)
+		}
+	}
+	sort.Slice(keys, func(i, j int) bool {
+		if keys[i].visibilityTimestamp != keys[j].visibilityTimestamp {
+			return keys[i].visibilityTimestamp < keys[j].visibilityTimestamp
+		}
+		return keys[i].taskID < keys[j].taskID
+	})
+	if len(keys) > request.BatchSize+1 {
+		keys = keys[:request.BatchSize+1]
+	}
+
+	resp := &p.GetTimerIndexTasksResponse{Timers: make([]*persistenceblobs.TimerTaskInfo, len(keys))}
+	for i, key := range keys {
+		blob := m.table.timerTasks[key]
+		info, err := serialization.TimerTaskInfoFromBlob(blob.Data, blob.Encoding.String())
+		if err != nil {
+			return nil, err
+		}
+		resp.Timers[i] = info
+	}
+
+	if len(resp.Timers) > request.BatchSize {
+		goVisibilityTimestamp := resp.Timers[request.BatchSize].VisibilityTime
+		if goVisibilityTimestamp == nil {
+			return nil, serviceerror.NewInternal(fmt.Sprintf("GetTimerTasks: time for page token is nil - TaskId '%v'", resp.Timers[request.BatchSize].TaskId))
+		}
+
+		pageToken = &timerTaskPageToken{
+			TaskID:    resp.Timers[request.BatchSize].GetTaskId(),
+			Timestamp: *goVisibilityTimestamp,
+		}
+		resp.Timers = resp.Timers[:request.BatchSize]
+		nextToken, err := pageToken.serialize()
+		if err != nil {
+			return nil, serviceerror.NewInternal(fmt.Sprintf("GetTimerTasks: error serializing page token: %v", err))
+		}
+		resp.NextPageToken = nextToken
+	}
+
+	return resp, nil
+}
+
+func (m *memoryExecutionStore) CompleteTimerTask(
+	request *p.CompleteTimerTaskRequest,
+) error {
+
+	m.db.Lock()
+	defer m.db.Unlock()
+
+	delete(m.table.timerTasks, timerTaskKey{
+		visibilityTimestamp: request.VisibilityTimestamp.UnixNano(),
+		taskID:              request.TaskID,
+	})
+	return nil
+}
+
+func (m *memoryExecutionStore) RangeCompleteTimerTask(
+	request *p.RangeCompleteTimerTaskRequest,
+) error {
+
+	start := request.InclusiveBeginTimestamp.UnixNano()
+	end := request.ExclusiveEndTimestamp.UnixNano()
+
+	m.db.Lock()
+	defer m.db.Unlock()
+
+	for key := range m.table.timerTasks {
+		if key.visibilityTimestamp >= start && key.visibilityTimestamp < end {
+			delete(m.table.timerTasks, key)
+		}
+	}
+	return nil
+}
+
+func lessExecutionKey(a executionKey, b executionKey) bool {
+	if a.namespaceID != b.namespaceID {
+		return a.namespaceID < b.namespaceID
+	}
+	if a.workflowID != b.workflowID {
+		return a.workflowID < b.workflowID
+	}
+	return a.runID < b.runID
+}
@@ -0,0 +1,832 @@
+// The MIT License
+//
+// Copyright (c) 2020 Temporal Technologies Inc.  All rights reserved.
+//
+// Copyright (c) 2020 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package memory
+
+import (
+	"fmt"
+
+	enumspb "go.temporal.io/api/enums/v1"
+	"go.temporal.io/api/serviceerror"
+
+	enumsspb "go.temporal.io/server/api/enums/v1"
+	historyspb "go.temporal.io/server/api/history/v1"
+	"go.temporal.io/server/api/persistenceblobs/v1"
+	"go.temporal.io/server/common"
+	"go.temporal.io/server/common/checksum"
+	p "go.temporal.io/server/common/persistence"
+	"go.temporal.io/server/common/persistence/serialization"
+	"go.temporal.io/server/common/primitives/timestamp"
+)
+
+type (
+	// executionsTable holds the executions and the tasks of one shard
+	executionsTable struct {
+		currentExecutions map[currentExecutionKey]*currentExecutionRow
+		executions        map[executionKey]*executionRow
+		transferTasks     map[int64]serialization.DataBlob
+		timerTasks        map[timerTaskKey]serialization.DataBlob
+		replicationTasks  map[int64]serialization.DataBlob
+		replicationDLQ    map[string]map[int64]serialization.DataBlob
+	}
+
+	currentExecutionKey struct {
+		namespaceID string
+		workflowID  string
+	}
+
+	currentExecutionRow struct {
+		runID            string
+		createRequestID  string
+		state            enumsspb.WorkflowExecutionState
+		status           enumspb.WorkflowExecutionStatus
+		startVersion     int64
+		lastWriteVersion int64
+	}
+
+	executionKey struct {
+		namespaceID string
+		workflowID  string
+		runID       string
+	}
+
+	// executionRow is never modified once stored, writers store a modified clone instead
+	executionRow struct {
+		nextEventID      int64
+		lastWriteVersion int64
+		data             serialization.DataBlob
+		state            serialization.DataBlob
+		checksum         checksum.Checksum
+
+		activityInfos       map[int64]serialization.DataBlob
+		timerInfos          map[string]serialization.DataBlob
+		childExecutionInfos map[int64]serialization.DataBlob
+		requestCancelInfos  map[int64]serialization.DataBlob
+		signalInfos         map[int64]serialization.DataBlob
+		signalsRequested    map[string]struct{}
+		bufferedEvents      []serialization.DataBlob
+	}
+
+	timerTaskKey struct {
+		visibilityTimestamp int64
+		taskID              int64
+	}
+)
+
+func newExecutionsTable() *executionsTable {
+	return &executionsTable{
+		currentExecutions: make(map[currentExecutionKey]*currentExecutionRow),
+		executions:        make(map[executionKey]*executionRow),
+		transferTasks:     make(map[int64]serialization.DataBlob),
+		timerTasks:        make(map[timerTaskKey]serialization.DataBlob),
+		replicationTasks:  make(map[int64]serialization.DataBlob),
+		replicationDLQ:    make(map[string]map[int64]serialization.DataBlob),
+	}
+}
+
+func newExecutionRow() *executionRow {
+	return &executionRow{
+		activityInfos:       make(map[int64]serialization.DataBlob),
+		timerInfos:          make(map[string]serialization.DataBlob),
+		childExecutionInfos: make(map[int64]serialization.DataBlob),
+		requestCancelInfos:  make(map[int64]serialization.DataBlob),
+		signalInfos:         make(map[int64]serialization.DataBlob),
+		signalsRequested:    make(map[string]struct{}),
+	}
+}
+
+// clone returns a copy of the row which can be modified without affecting the row,
+// blobs are immutable and are shared
+func (r *executionRow) clone() *executionRow {
+	row := newExecutionRow()
+	row.nextEventID = r.nextEventID
+	row.lastWriteVersion = r.lastWriteVersion
+	row.data = r.data
+	row.state = r.state
+	row.checksum = r.checksum
+	for k, v := range r.activityInfos {
+		row.activityInfos[k] = v
+	}
+	for k, v := range r.timerInfos {
+		row.timerInfos[k] = v
+	}
+	for k, v := range r.childExecutionInfos {
+		row.childExecutionInfos[k] = v
+	}
+	for k, v := range r.requestCancelInfos {
+		row.requestCancelInfos[k] = v
+	}
+	for k, v := range r.signalInfos {
+		row.signalInfos[k] = v
+	}
+	for k := range r.signalsRequested {
+		row.signalsRequested[k] = struct{}{}
+	}
+	row.bufferedEvents = append([]serialization.DataBlob(nil), r.bufferedEvents...)
+	return row
+}
+
+func executionKeyFromInfo(executionInfo *p.WorkflowExecutionInfo) executionKey {
+	return executionKey{
+		namespaceID: executionInfo.NamespaceId,
+		workflowID:  executionInfo.WorkflowId,
+		runID:       executionInfo.ExecutionState.RunId,
+	}
+}
+
+func (t *executionsTable) putCurrentExecution(tx *transaction, key currentExecutionKey, row *currentExecutionRow) {
+	prev, ok := t.currentExecutions[key]
+	t.currentExecutions[key] = row
+	tx.onRollback(func() {
+		if ok {
+			t.currentExecutions[key] = prev
+		} else {
+			delete(t.currentExecutions, key)
+		}
+	})
+}
+
+func (t *executionsTable) putExecution(tx *transaction, key executionKey, row *executionRow) {
+	prev, ok := t.executions[key]
+	t.executions[key] = row
+	tx.onRollback(func() {
+		if ok {
+			t.executions[key] = prev
+		} else {
+			delete(t.executions, key)
+		}
+	})
+}
+
+func (m *memoryExecutionStore) applyWorkflowMutationTx(
+	tx *transaction,
+	workflowMutation *p.InternalWorkflowMutation,
+) error {
+
+	executionInfo := workflowMutation.ExecutionInfo
+	key := executionKeyFromInfo(executionInfo)
+
+	row, err := m.lockAndCheckNextEventID(key, workflowMutation.Condition)
+	if err != nil {
+		switch err.(type) {
+		case *p.ConditionFailedError:
+			return err
+		default:
+			return serviceerror.NewInternal(fmt.Sprintf("applyWorkflowMutationTx failed. Failed to lock executions row. Error: %v", err))
+		}
+	}
+
+	row = row.clone()
+	if err := updateExecution(row,
+		executionInfo,
+		workflowMutation.VersionHistories,
+		workflowMutation.StartVersion,
+		workflowMutation.LastWriteVersion,
+		workflowMutation.ExecutionInfoEncoding,
+		workflowMutation.Checksum); err != nil {
+		return err
+	}
+
+	if err := m.applyTasks(tx,
+		key,
+		workflowMutation.TransferTasks,
+		workflowMutation.ReplicationTasks,
+		workflowMutation.TimerTasks); err != nil {
+		return err
+	}
+
+	if err := updateActivityInfos(row, workflowMutation.UpsertActivityInfos, workflowMutation.DeleteActivityInfos); err != nil {
+		return serviceerror.NewInternal(fmt.Sprintf("applyWorkflowMutationTx failed. Error: %v", err))
+	}
+	if err := updateTimerInfos(row, workflowMutation.UpsertTimerInfos, workflowMutation.DeleteTimerInfos); err != nil {
+		return serviceerror.NewInternal(fmt.Sprintf("applyWorkflowMutationTx failed. Error: %v", err))
+	}
+	if err := updateChildExecutionInfos(row, workflowMutation.UpsertChildExecutionInfos, workflowMutation.DeleteChildExecutionInfo); err != nil {
+		return serviceerror.NewInternal(fmt.Sprintf("applyWorkflowMutationTx failed. Error: %v", err))
+	}
+	if err := updateRequestCancelInfos(row, workflowMutation.UpsertRequestCancelInfos, workflowMutation.DeleteRequestCancelInfo); err != nil {
+		return serviceerror.NewInternal(fmt.Sprintf("applyWorkflowMutationTx failed. Error: %v", err))
+	}
+	if err := updateSignalInfos(row, workflowMutation.UpsertSignalInfos, workflowMutation.DeleteSignalInfo); err != nil {
+		return serviceerror.NewInternal(fmt.Sprintf("applyWorkflowMutationTx failed. Error: %v", err))
+	}
+	updateSignalsRequested(row, workflowMutation.UpsertSignalRequestedIDs, workflowMutation.DeleteSignalRequestedID)
+
+	if workflowMutation.ClearBufferedEvents {
+		row.bufferedEvents = nil
+	}
+	if workflowMutation.NewBufferedEvents != nil {
+		row.bufferedEvents = append(row.bufferedEvents, copyBlob(*workflowMutation.NewBufferedEvents))
+	}
+
+	m.table.putExecution(tx, key, row)
+	return nil
+}
+
+func (m *memoryExecutionStore) applyWorkflowSnapshotTxAsReset(
+	tx *transaction,
+	workflowSnapshot *p.InternalWorkflowSnapshot,
+) error {
+
+	executionInfo := workflowSnapshot.ExecutionInfo
+	key := executionKeyFromInfo(executionInfo)
+
+	if _, err := m.lockAndCheckNextEventID(key, workflowSnapshot.Condition); err != nil {
+		switch err.(type) {
+		case *p.ConditionFailedError:
+			return err
+		default:
+			return serviceerror.NewInternal(fmt.Sprintf("applyWorkflowSnapshotTxAsReset failed. Failed to lock executions row. Error: %v", err))
+		}
+	}
+
+	// the snapshot replaces every map as well as the buffered events of the execution
+	row := newExecutionRow()
+	if err := updateExecution(row,
+		executionInfo,
+		workflowSnapshot.VersionHistories,
+		workflowSnapshot.StartVersion,
+		workflowSnapshot.LastWriteVersion,
+		workflowSnapshot.ExecutionInfoEncoding,
+		workflowSnapshot.Checksum); err != nil {
+		return err
+	}
+
+	if err := m.applyTasks(tx,
+		key,
+		workflowSnapshot.TransferTasks,
+		workflowSnapshot.ReplicationTasks,
+		workflowSnapshot.TimerTasks); err != nil {
+		return err
+	}
+
+	if err := applySnapshotMaps(row, workflowSnapshot); err != nil {
+		return serviceerror.NewInternal(fmt.Sprintf("applyWorkflowSnapshotTxAsReset failed. Error: %v", err))
+	}
+
+	m.table.putExecution(tx, key, row)
+	return nil
+}
+
+func (m *memoryExecutionStore) applyWorkflowSnapshotTxAsNew(
+	tx *transaction,
+	workflowSnapshot *p.InternalWorkflowSnapshot,
+) error {
+
+	executionInfo := workflowSnapshot.ExecutionInfo
+	key := executionKeyFromInfo(executionInfo)
+
+	// validate workflow state & close status
+	if err := p.ValidateCreateWorkflowStateStatus(
+		executionInfo.ExecutionState.State,
+		executionInfo.ExecutionState.Status); err != nil {
+		return err
+	}
+
+	if _, ok := m.table.executions[key]; ok {
+		return &p.WorkflowExecutionAlreadyStartedError{
+			Msg:              fmt.Sprintf("Workflow execution already running. WorkflowId: %v", executionInfo.WorkflowId),
+			StartRequestID:   executionInfo.ExecutionState.CreateRequestId,
+			RunID:            executionInfo.ExecutionState.RunId,
+			State:            executionInfo.ExecutionState.State,
+			Status:           executionInfo.ExecutionState.Status,
+			LastWriteVersion: workflowSnapshot.LastWriteVersion,
+		}
+	}
+
+	// TODO we should set the start time and last update time on business logic layer
+	executionInfo.StartTime = timestamp.TimeNowPtrUtc()
+	executionInfo.LastUpdatedTime = executionInfo.StartTime
+
+	row := newExecutionRow()
+	if err := buildExecutionRow(row,
+		executionInfo,
+		workflowSnapshot.VersionHistories,
+		workflowSnapshot.StartVersion,
+		workflowSnapshot.LastWriteVersion,
+		workflowSnapshot.ExecutionInfoEncoding,
+		workflowSnapshot.Checksum); err != nil {
+		return err
+	}
+
+	if err := m.applyTasks(tx,
+		key,
+		workflowSnapshot.TransferTasks,
+		workflowSnapshot.ReplicationTasks,
+		workflowSnapshot.TimerTasks); err != nil {
+		return err
+	}
+
+	if err := applySnapshotMaps(row, workflowSnapshot); err != nil {
+		return serviceerror.NewInternal(fmt.Sprintf("applyWorkflowSnapshotTxAsNew failed. Error: %v", err))
+	}
+
+	m.table.putExecution(tx, key, row)
+	return nil
+}
+
+func applySnapshotMaps(row *executionRow, workflowSnapshot *p.InternalWorkflowSnapshot) error {
+	if err := updateActivityInfos(row, workflowSnapshot.ActivityInfos, nil); err != nil {
+		return err
+	}
+	if err := updateTimerInfos(row, workflowSnapshot.TimerInfos, nil); err != nil {
+		return err
+	}
+	if err := updateChildExecutionInfos(row, workflowSnapshot.ChildExecutionInfos, nil); err != nil {
+		return err
+	}
+	if err := updateRequestCancelInfos(row, workflowSnapshot.RequestCancelInfos, nil); err != nil {
+		return err
+	}
+	if err := updateSignalInfos(row, workflowSnapshot.SignalInfos, nil); err != nil {
+		return err
+	}
+	updateSignalsRequested(row, workflowSnapshot.SignalRequestedIDs, "")
+	return nil
+}
+
+// lockAndCheckNextEventID returns the execution row if its next event ID matches the condition
+func (m *memoryExecutionStore) lockAndCheckNextEventID(
+	key executionKey,
+	condition int64,
+) (*executionRow, error) {
+
+	row, ok := m.table.executions[key]
+	if !ok {
+		return nil, serviceerror.NewNotFound(fmt.Sprintf("lockNextEventID failed. Unable to lock executions row with (shard, namespace, workflow, run) = (%v,%v,%v,%v) which does not exist.",
+			m.shardID,
+			key.namespaceID,
+			key.workflowID,
+			key.runID))
+	}
+	if row.nextEventID != condition {
+		return nil, &p.ConditionFailedError{
+			Msg: fmt.Sprintf("lockAndCheckNextEventID failed. Next_event_id was %v when it should have been %v.", row.nextEventID, condition),
+		}
+	}
+	return row, nil
+}
+
+func (m *memoryExecutionStore) assertNotCurrentExecution(
+	namespaceID string,
+	workflowID string,
+	runID string,
+) error {
+
+	currentRow, ok := m.table.currentExecutions[currentExecutionKey{namespaceID: namespaceID, workflowID: workflowID}]
+	if !ok {
+		// allow bypassing no current record
+		return nil
+	}
+	return assertRunIDMismatch(runID, currentRow.runID)
+}
+
+func (m *memoryExecutionStore) assertRunIDAndUpdateCurrentExecution(
+	tx *transaction,
+	namespaceID string,
+	workflowID string,
+	newRunID string,
+	previousRunID string,
+	createRequestID string,
+	state enumsspb.WorkflowExecutionState,
+	status enumspb.WorkflowExecutionStatus,
+	startVersion int64,
+	lastWriteVersion int64,
+) error {
+
+	key := currentExecutionKey{namespaceID: namespaceID, workflowID: workflowID}
+	currentRow, ok := m.table.currentExecutions[key]
+	if !ok {
+		return serviceerror.NewInternal(fmt.Sprintf("assertCurrentExecution failed. Unable to load current record. WorkflowId: %v", workflowID))
+	}
+	if currentRow.runID != previousRunID {
+		return &p.ConditionFailedError{Msg: fmt.Sprintf(
+			"assertRunIDAndUpdateCurrentExecution failed. Current RunId was %v, expected %v",
+			currentRow.runID,
+			previousRunID,
+		)}
+	}
+
+	m.table.putCurrentExecution(tx, key, &currentExecutionRow{
+		runID:            newRunID,
+		createRequestID:  createRequestID,
+		state:            state,
+		status:           status,
+		startVersion:     startVersion,
+		lastWriteVersion: lastWriteVersion,
+	})
+	return nil
+}
+
+func assertRunIDMismatch(runID string, currentRunID string) error {
+	// zombie workflow creation with existence of current record, this is a noop
+	if currentRunID == runID {
+		return &p.ConditionFailedError{Msg: fmt.Sprintf(
+			"assertRunIDMismatch failed. Current RunId was %v, input %v",
+			currentRunID,
+			runID,
+		)}
+	}
+	return nil
+}
+
+func buildExecutionRow(
+	row *executionRow,
+	executionInfo *p.WorkflowExecutionInfo,
+	versionHistories *historyspb.VersionHistories,
+	startVersion int64,
+	lastWriteVersion int64,
+	executionInfoEncoding enumspb.EncodingType,
+	cs checksum.Checksum,
+) error {
+
+	info, state, err := p.WorkflowExecutionToProto(executionInfo, startVersion, versionHistories)
+	if err != nil {
+		return err
+	}
+
+	infoBlob, err := serialization.WorkflowExecutionInfoToBlob(info, executionInfoEncoding)
+	if err != nil {
+		return err
+	}
+
+	stateBlob, err := serialization.WorkflowExecutionStateToBlob(state)
+	if err != nil {
+		return err
+	}
+
+	row.nextEventID = executionInfo.NextEventId
+	row.lastWriteVersion = lastWriteVersion
+	row.data = infoBlob
+	row.state = stateBlob
+	row.checksum = checksum.Checksum{
+		Version: cs.Version,
+		Flavor:  cs.Flavor,
+		Value:   copyBytes(cs.Value),
+	}
+	return nil
+}
+
+func updateExecution(
+	row *executionRow,
+	executionInfo *p.WorkflowExecutionInfo,
+	versionHistories *historyspb.VersionHistories,
+	startVersion int64,
+	lastWriteVersion int64,
+	executionInfoEncoding enumspb.EncodingType,
+	cs checksum.Checksum,
+) error {
+
+	// validate workflow state & close status
+	if err := p.ValidateUpdateWorkflowStateStatus(
+		executionInfo.ExecutionState.State,
+		executionInfo.ExecutionState.Status); err != nil {
+		return err
+	}
+
+	// TODO we should set the last update time on business logic layer
+	executionInfo.LastUpdatedTime = timestamp.TimeNowPtrUtc()
+
+	if err := buildExecutionRow(row, executionInfo, versionHistories, startVersion, lastWriteVersion, executionInfoEncoding, cs); err != nil {
+		return serviceerror.NewInternal(fmt.Sprintf("updateExecution failed. Erorr: %v", err))
+	}
+	return nil
+}
+
+func updateActivityInfos(row *executionRow, activityInfos []*persistenceblobs.ActivityInfo, deleteInfos []int64) error {
+	for _, v := range activityInfos {
+		blob, err := serialization.ActivityInfoToBlob(v)
+		if err != nil {
+			return err
+		}
+		row.activityInfos[v.ScheduleId] = blob
+	}
+	for _, v := range deleteInfos {
+		delete(row.activityInfos, v)
+	}
+	return nil
+}
+
+func updateTimerInfos(row *executionRow, timerInfos []*persistenceblobs.TimerInfo, deleteInfos []string) error {
+	for _, v := range timerInfos {
+		blob, err := serialization.TimerInfoToBlob(v)
+		if err != nil {
+			return err
+		}
+		row.timerInfos[v.TimerId] = blob
+	}
+	for _, v := range deleteInfos {
+		delete(row.timerInfos, v)
+	}
+	return nil
+}
+
+func updateChildExecutionInfos(row *executionRow, childExecutionInfos []*persistenceblobs.ChildExecutionInfo, deleteInfo *int64) error {
+	for _, v := range childExecutionInfos {
+		blob, err := serialization.ChildExecutionInfoToBlob(v)
+		if err != nil {
+			return err
+		}
+		row.childExecutionInfos[v.InitiatedId] = blob
+	}
+	if deleteInfo != nil {
+		delete(row.childExecutionInfos, *deleteInfo)
+	}
+	return nil
+}
+
+func updateRequestCancelInfos(row *executionRow, requestCancelInfos []*persistenceblobs.RequestCancelInfo, deleteInfo *int64) error {
+	for _, v := range requestCancelInfos {
+		blob, err := serialization.RequestCancelInfoToBlob(v)
+		if err != nil {
+			return err
+		}
+		row.requestCancelInfos[v.InitiatedId] = blob
+	}
+	if deleteInfo != nil {
+		delete(row.requestCancelInfos, *deleteInfo)
+	}
+	return nil
+}
+
+func updateSignalInfos(row *executionRow, signalInfos []*persistenceblobs.SignalInfo, deleteInfo *int64) error {
+	for _, v := range signalInfos {
+		blob, err := serialization.SignalInfoToBlob(v)
+		if err != nil {
+			return err
+		}
+		row.signalInfos[v.InitiatedId] = blob
+	}
+	if deleteInfo != nil {
+		delete(row.signalInfos, *deleteInfo)
+	}
+	return nil
+}
+
+func updateSignalsRequested(row *executionRow, signalRequestedIDs []string, deleteSignalRequestID string) {
+	for _, v := range signalRequestedIDs {
+		row.signalsRequested[v] = struct{}{}
+	}
+	if deleteSignalRequestID != "" {
+		delete(row.signalsRequested, deleteSignalRequestID)
+	}
+}
+
+func (m *memoryExecutionStore) applyTasks(
+	tx *transaction,
+	key executionKey,
+	transferTasks []p.Task,
+	replicationTasks []p.Task,
+	timerTasks []p.Task,
+) error {
+
+	if err := m.createTransferTasks(tx, transferTasks, key); err != nil {
+		return serviceerror.NewInternal(fmt.Sprintf("applyTasks failed. Failed to create transfer tasks. Error: %v", err))
+	}
+
+	if err := m.createReplicationTasks(tx, replicationTasks, key); err != nil {
+		return serviceerror.NewInternal(fmt.Sprintf("applyTasks failed. Failed to create replication tasks. Error: %v", err))
+	}
+
+	if err := m.createTimerTasks(tx, timerTasks, key); err != nil {
+		return serviceerror.NewInternal(fmt.Sprintf("applyTasks failed. Failed to create timer tasks. Error: %v", err))
+	}
+
+	return nil
+}
+
+func (m *memoryExecutionStore) createTransferTasks(
+	tx *transaction,
+	transferTasks []p.Task,
+	key executionKey,
+) error {
+
+	for _, task := range transferTasks {
+		info := &persistenceblobs.TransferTaskInfo{
+			NamespaceId:       key.namespaceID,
+			WorkflowId:        key.workflowID,
+			RunId:             key.runID,
+			TargetNamespaceId: key.namespaceID,
+			TargetWorkflowId:  p.TransferTaskTransferTargetWorkflowID,
+			ScheduleId:        0,
+			TaskId:            task.GetTaskID(),
+		}
+
+		switch task.GetType() {
+		case enumsspb.TASK_TYPE_TRANSFER_ACTIVITY_TASK:
+			info.TargetNamespaceId = task.(*p.ActivityTask).NamespaceID
+			info.TaskQueue = task.(*p.ActivityTask).TaskQueue
+			info.ScheduleId = task.(*p.ActivityTask).ScheduleID
+
+		case enumsspb.TASK_TYPE_TRANSFER_WORKFLOW_TASK:
+			info.TargetNamespaceId = task.(*p.WorkflowTask).NamespaceID
+			info.TaskQueue = task.(*p.WorkflowTask).TaskQueue
+			info.ScheduleId = task.(*p.WorkflowTask).ScheduleID
+
+		case enumsspb.TASK_TYPE_TRANSFER_CANCEL_EXECUTION:
+			info.TargetNamespaceId = task.(*p.CancelExecutionTask).TargetNamespaceID
+			info.TargetWorkflowId = task.(*p.CancelExecutionTask).TargetWorkflowID
+			if task.(*p.CancelExecutionTask).TargetRunID != "" {
+				info.TargetRunId = task.(*p.CancelExecutionTask).TargetRunID
+			}
+			info.TargetChildWorkflowOnly = task.(*p.CancelExecutionTask).TargetChildWorkflowOnly
+			info.ScheduleId = task.(*p.CancelExecutionTask).InitiatedID
+
+		case enumsspb.TASK_TYPE_TRANSFER_SIGNAL_EXECUTION:
+			info.TargetNamespaceId = task.(*p.SignalExecutionTask).TargetNamespaceID
+			info.TargetWorkflowId = task.(*p.SignalExecutionTask).TargetWorkflowID
+			if task.(*p.SignalExecutionTask).TargetRunID != "" {
+				info.TargetRunId = task.(*p.SignalExecutionTask).TargetRunID
+			}
+			info.TargetChildWorkflowOnly = task.(*p.SignalExecutionTask).TargetChildWorkflowOnly
+			info.ScheduleId = task.(*p.SignalExecutionTask).InitiatedID
+
+		case enumsspb.TASK_TYPE_TRANSFER_START_CHILD_EXECUTION:
+			info.TargetNamespaceId = task.(*p.StartChildExecutionTask).TargetNamespaceID
+			info.TargetWorkflowId = task.(*p.StartChildExecutionTask).TargetWorkflowID
+			info.ScheduleId = task.(*p.StartChildExecutionTask).InitiatedID
+
+		case enumsspb.TASK_TYPE_TRANSFER_CLOSE_EXECUTION,
+			enumsspb.TASK_TYPE_TRANSFER_RECORD_WORKFLOW_STARTED,
+			enumsspb.TASK_TYPE_TRANSFER_RESET_WORKFLOW,
+			enumsspb.TASK_TYPE_TRANSFER_UPSERT_WORKFLOW_SEARCH_ATTRIBUTES:
+			// No explicit property needs to be set
+
+		default:
+			return serviceerror.NewInternal(fmt.Sprintf("createTransferTasks failed. Unknow transfer type: %v", task.GetType()))
+		}
+
+		info.TaskType = task.GetType()
+		info.Version = task.GetVersion()
+		info.VisibilityTime = timestamp.TimePtr(task.GetVisibilityTimestamp().UTC())
+
+		blob, err := serialization.TransferTaskInfoToBlob(info)
+		if err != nil {
+			return err
+		}
+
+		taskID := task.GetTaskID()
+		if _, ok := m.table.transferTasks[taskID]; ok {
+			return serviceerror.NewInternal(fmt.Sprintf("createTransferTasks failed. Task with ID %v already exists.", taskID))
+		}
+		m.table.transferTasks[taskID] = blob
+		tx.onRollback(func() {
+			delete(m.table.transferTasks, taskID)
+		})
+	}
+	return nil
+}
+
+func (m *memoryExecutionStore) createReplicationTasks(
+	tx *transaction,
+	replicationTasks []p.Task,
+	key executionKey,
+) error {
+
+	for _, task := range replicationTasks {
+		firstEventID := common.EmptyEventID
+		nextEventID := common.EmptyEventID
+		version := common.EmptyVersion
+		activityScheduleID := common.EmptyEventID
+
+		var branchToken, newRunBranchToken []byte
+
+		switch task.GetType() {
+		case enumsspb.TASK_TYPE_REPLICATION_HISTORY:
+			historyReplicationTask, ok := task.(*p.HistoryReplicationTask)
+			if !ok {
+				return serviceerror.NewInternal(fmt.Sprintf("createReplicationTasks failed. Failed to cast %v to HistoryReplicationTask", task))
+			}
+			firstEventID = historyReplicationTask.FirstEventID
+			nextEventID = historyReplicationTask.NextEventID
+			version = task.GetVersion()
+			branchToken = historyReplicationTask.BranchToken
+			newRunBranchToken = historyReplicationTask.NewRunBranchToken
+
+		case enumsspb.TASK_TYPE_REPLICATION_SYNC_ACTIVITY:
+			version = task.GetVersion()
+			activityScheduleID = task.(*p.SyncActivityTask).ScheduledID
+
+		default:
+			return serviceerror.NewInternal(fmt.Sprintf("Unknown replication task: %v", task.GetType()))
+		}
+
+		blob, err := serialization.ReplicationTaskInfoToBlob(&persistenceblobs.ReplicationTaskInfo{
+			TaskId:                  task.GetTaskID(),
+			NamespaceId:             key.namespaceID,
+			WorkflowId:              key.workflowID,
+			RunId:                   key.runID,
+			TaskType:                task.GetType(),
+			FirstEventId:            firstEventID,
+			NextEventId:             nextEventID,
+			Version:                 version,
+			ScheduledId:             activityScheduleID,
+			EventStoreVersion:       p.EventStoreVersion,
+			NewRunEventStoreVersion: p.EventStoreVersion,
+			BranchToken:             branchToken,
+			NewRunBranchToken:       newRunBranchToken,
+		})
+		if err != nil {
+			return err
+		}
+
+		taskID := task.GetTaskID()
+		if _, ok := m.table.replicationTasks[taskID]; ok {
+			return serviceerror.NewInternal(fmt.Sprintf("createReplicationTasks failed. Task with ID %v already exists.", taskID))
+		}
+		m.table.replicationTasks[taskID] = blob
+		tx.onRollback(func() {
+			delete(m.table.replicationTasks, taskID)
+		})
+	}
+	return nil
+}
+
+func (m *memoryExecutionStore) createTimerTasks(
+	tx *transaction,
+	timerTasks []p.Task,
+	key executionKey,
+) error {
+
+	for _, task := range timerTasks {
+		info := &persistenceblobs.TimerTaskInfo{}
+		switch t := task.(type) {
+		case *p.WorkflowTaskTimeoutTask:
+			info.EventId = t.EventID
+			info.TimeoutType = t.TimeoutType
+			info.ScheduleAttempt = t.ScheduleAttempt
+
+		case *p.ActivityTimeoutTask:
+			info.EventId = t.EventID
+			info.TimeoutType = t.TimeoutType
+			info.ScheduleAttempt = t.Attempt
+
+		case *p.UserTimerTask:
+			info.EventId = t.EventID
+
+		case *p.ActivityRetryTimerTask:
+			info.EventId = t.EventID
+			info.ScheduleAttempt = t.Attempt
+
+		case *p.WorkflowBackoffTimerTask:
+			info.EventId = t.EventID
+			info.WorkflowBackoffType = t.WorkflowBackoffType
+
+		case *p.WorkflowTimeoutTask:
+			// noop
+
+		case *p.DeleteHistoryEventTask:
+			// noop
+
+		default:
+			return serviceerror.NewInternal(fmt.Sprintf("createTimerTasks failed. Unknown timer task: %v", task.GetType()))
+		}
+
+		info.NamespaceId = key.namespaceID
+		info.WorkflowId = key.workflowID
+		info.RunId = key.runID
+		info.Version = task.GetVersion()
+		info.TaskType = task.GetType()
+		info.TaskId = task.GetTaskID()
+		info.VisibilityTime = timestamp.TimePtr(task.GetVisibilityTimestamp().UTC())
+
+		blob, err := serialization.TimerTaskInfoToBlob(info)
+		if err != nil {
+			return err
+		}
+
+		taskKey := timerTaskKey{
+			visibilityTimestamp: info.VisibilityTime.UnixNano(),
+			taskID:              task.GetTaskID(),
+		}
+		if _, ok := m.table.timerTasks[taskKey]; ok {
+			return serviceerror.NewInternal(fmt.Sprintf("createTimerTasks failed. Task with ID %v already exists.", taskKey.taskID))
+		}
+		m.table.timerTasks[taskKey] = blob
+		tx.onRollback(func() {
+			delete(m.table.timerTasks, taskKey)
+		})
+	}
+	return nil
+}
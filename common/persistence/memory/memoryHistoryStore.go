@@ -0,0 +1,453 @@
+// The MIT License
+//
+// Copyright (c) 2020 Temporal Technologies Inc.  All rights reserved.
+//
+// Copyright (c) 2020 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package memory
+
+import (
+	"encoding/json"
+	"fmt"
+	"sort"
+
+	"go.temporal.io/api/serviceerror"
+
+	"go.temporal.io/server/api/persistenceblobs/v1"
+	"go.temporal.io/server/common/log"
+	p "go.temporal.io/server/common/persistence"
+	"go.temporal.io/server/common/persistence/serialization"
+	"go.temporal.io/server/common/primitives/timestamp"
+)
+
+type (
+	memoryHistoryV2Store struct {
+		memoryStore
+	}
+
+	historyBranchKey struct {
+		treeID   string
+		branchID string
+	}
+
+	historyNodeKey struct {
+		nodeID int64
+		txnID  int64
+	}
+
+	historyTreePageToken struct {
+		TreeID   string
+		BranchID string
+	}
+)
+
+var _ p.HistoryStore = (*memoryHistoryV2Store)(nil)
+
+// newHistoryV2Persistence creates an instance of HistoryManager
+func newHistoryV2Persistence(db *db, logger log.Logger) (p.HistoryStore, error) {
+	return &memoryHistoryV2Store{
+		memoryStore: memoryStore{
+			db:     db,
+			logger: logger,
+		},
+	}, nil
+}
+
+// AppendHistoryNodes add(or override) a node to a history branch
+func (m *memoryHistoryV2Store) AppendHistoryNodes(
+	request *p.InternalAppendHistoryNodesRequest,
+) error {
+
+	branchInfo := request.BranchInfo
+	beginNodeID := p.GetBeginNodeID(branchInfo)
+
+	if request.NodeID < beginNodeID {
+		return &p.InvalidPersistenceRequestError{
+			Msg: fmt.Sprintf("cannot append to ancestors' nodes"),
+		}
+	}
+
+	var treeBlob serialization.DataBlob
+	if request.IsNewBranch {
+		treeInfo := &persistenceblobs.HistoryTreeInfo{
+			BranchInfo: branchInfo,
+			Info:       request.Info,
+			ForkTime:   timestamp.TimeNowPtrUtc(),
+		}
+
+		blob, err := serialization.HistoryTreeInfoToBlob(treeInfo)
+		if err != nil {
+			return err
+		}
+		treeBlob = blob
+	}
+
+	branchKey := historyBranchKey{treeID: branchInfo.GetTreeId(), branchID: branchInfo.GetBranchId()}
+	nodeKey := historyNodeKey{nodeID: request.NodeID, txnID: request.TransactionID}
+
+	return m.txExecute("AppendHistoryNodes", func(tx *transaction) error {
+		nodes, ok := m.db.historyNodes[branchKey]
+		if !ok {
+			nodes = make(map[historyNodeKey]serialization.DataBlob)
+			m.db.historyNodes[branchKey] = nodes
+		}
+		if _, ok := nodes[nodeKey]; ok {
+			return &p.ConditionFailedError{Msg: fmt.Sprintf("AppendHistoryNodes: row already exist: node ID %v, transaction ID %v", nodeKey.nodeID, nodeKey.txnID)}
+		}
+		nodes[nodeKey] = copyBlob(*request.Events)
+		tx.onRollback(func() {
+			delete(nodes, nodeKey)
+		})
+
+		// the tree row is an upsert
+		if request.IsNewBranch {
+			m.putHistoryTree(tx, branchKey, treeBlob)
+		}
+		return nil
+	})
+}
+
+// ReadHistoryBranch returns history node data for a branch
+func (m *memoryHistoryV2Store) ReadHistoryBranch(
+	request *p.InternalReadHistoryBranchRequest,
+) (*p.InternalReadHistoryBranchResponse, error) {
+
+	minNodeID := request.MinNodeID
+	maxNodeID := request.MaxNodeID
+
+	lastNodeID := request.LastNodeID
+	lastTxnID := request.LastTransactionID
+
+	if request.NextPageToken != nil && len(request.NextPageToken) > 0 {
+		var lastNodeID int64
+		var err error
+		if lastNodeID, err = deserializePageToken(request.NextPageToken); err != nil {
+			return nil, serviceerror.NewInternal(fmt.Sprintf("invalid next page token %v", request.NextPageToken))
+		}
+		minNodeID = lastNodeID + 1
+	}
+
+	m.db.RLock()
+	defer m.db.RUnlock()
+
+	nodes := m.db.historyNodes[historyBranchKey{treeID: request.TreeID, branchID: request.BranchID}]
+	var keys []historyNodeKey
+	for key := range nodes {
+		if key.nodeID >= minNodeID && key.nodeID < maxNodeID {
+			keys = append(keys, key)
+		}
+	}
+	if len(keys) == 0 {
+		return &p.InternalReadHistoryBranchResponse{}, nil
+	}
+	// the batch with the largest transaction ID of a node comes first
+	sort.Slice(keys, func(i, j int) bool {
+		if keys[i].nodeID != keys[j].nodeID {
+			return keys[i].nodeID < keys[j].nodeID
+		}
+		return keys[i].txnID > keys[j].txnID
+	})
+	if len(keys) > request.PageSize {
+		keys = keys[:request.PageSize]
+	}
+
+	history := make([]*serialization.DataBlob, 0, request.PageSize)
+
+	for _, key := range keys {
+		if key.txnID < lastTxnID {
+			// assuming that business logic layer is correct and transaction ID only increase
+			// thus, valid event batch will come with increasing transaction ID
+
+			// event batches with smaller node ID
+			//  -> should not be possible since records are already sorted
+			// event batches with same node ID
+			//  -> batch with higher transaction ID is valid
+			// event batches with larger node ID
+			//  -> batch with lower transaction ID is invalid (happens before)
+			//  -> batch with higher transaction ID is valid
+			if key.nodeID < lastNodeID {
+				return nil, serviceerror.NewInternal(fmt.Sprintf("corrupted data, nodeID cannot decrease"))
+			} else if key.nodeID > lastNodeID {
+				// update lastNodeID so that our pagination can make progress in the corner case that
+				// the page are all rows with smaller txnID
+				// because next page we always have minNodeID = lastNodeID+1
+				lastNodeID = key.nodeID
+			}
+			continue
+		}
+
+		switch {
+		case key.nodeID < lastNodeID:
+			return nil, serviceerror.NewInternal(fmt.Sprintf("corrupted data, nodeID cannot decrease"))
+		case key.nodeID == lastNodeID:
+			return nil, serviceerror.NewInternal(fmt.Sprintf("corrupted data, same nodeID must have smaller txnID"))
+		default: // key.nodeID > lastNodeID:
+			// NOTE: when key.nodeID > lastNodeID, we expect the one with largest txnID comes first
+			lastTxnID = key.txnID
+			lastNodeID = key.nodeID
+			eventBlob := copyBlob(nodes[key])
+			history = append(history, &eventBlob)
+		}
+	}
+
+	var pagingToken []byte
+	if len(keys) >= request.PageSize {
+		pagingToken = serializePageToken(lastNodeID)
+	}
+
+	return &p.InternalReadHistoryBranchResponse{
+		History:           history,
+		NextPageToken:     pagingToken,
+		LastNodeID:        lastNodeID,
+		LastTransactionID: lastTxnID,
+	}, nil
+}
+
+// ForkHistoryBranch forks a new branch from an existing branch, see the SQL
+// implementation for how the ancestors of the new branch are computed
+func (m *memoryHistoryV2Store) ForkHistoryBranch(
+	request *p.InternalForkHistoryBranchRequest,
+) (*p.InternalForkHistoryBranchResponse, error) {
+
+	forkB := request.ForkBranchInfo
+	treeID := forkB.TreeId
+
+	newAncestors := make([]*persistenceblobs.HistoryBranchRange, 0, len(forkB.Ancestors)+1)
+
+	beginNodeID := p.GetBeginNodeID(forkB)
+	if beginNodeID >= request.ForkNodeID {
+		// this is the case that new branch's ancestors doesn't include the forking branch
+		for _, br := range forkB.Ancestors {
+			if br.GetEndNodeId() >= request.ForkNodeID {
+				newAncestors = append(newAncestors, &persistenceblobs.HistoryBranchRange{
+					BranchId:    br.GetBranchId(),
+					BeginNodeId: br.GetBeginNodeId(),
+					EndNodeId:   request.ForkNodeID,
+				})
+				break
+			} else {
+				newAncestors = append(newAncestors, br)
+			}
+		}
+	} else {
+		// this is the case the new branch will inherit all ancestors from forking branch
+		newAncestors = append(newAncestors, forkB.Ancestors...)
+		newAncestors = append(newAncestors, &persistenceblobs.HistoryBranchRange{
+			BranchId:    forkB.BranchId,
+			BeginNodeId: beginNodeID,
+			EndNodeId:   request.ForkNodeID,
+		})
+	}
+
+	treeInfo := &persistenceblobs.HistoryTreeInfo{
+		BranchInfo: &persistenceblobs.HistoryBranch{
+			TreeId:    treeID,
+			BranchId:  request.NewBranchID,
+			Ancestors: newAncestors,
+		},
+		Info:     request.Info,
+		ForkTime: timestamp.TimeNowPtrUtc(),
+	}
+
+	blob, err := serialization.HistoryTreeInfoToBlob(treeInfo)
+	if err != nil {
+		return nil, err
+	}
+
+	branchKey := historyBranchKey{treeID: treeID, branchID: request.NewBranchID}
+	err = m.txExecute("ForkHistoryBranch", func(tx *transaction) error {
+		if _, ok := m.db.historyTrees[treeID][request.NewBranchID]; ok {
+			return serviceerror.NewInternal(fmt.Sprintf("ForkHistoryBranch: branch %v of tree %v already exists", request.NewBranchID, treeID))
+		}
+		m.putHistoryTree(tx, branchKey, blob)
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return &p.InternalForkHistoryBranchResponse{
+		NewBranchInfo: treeInfo.BranchInfo,
+	}, nil
+}
+
+// DeleteHistoryBranch removes a branch
+func (m *memoryHistoryV2Store) DeleteHistoryBranch(
+	request *p.InternalDeleteHistoryBranchRequest,
+) error {
+
+	branch := request.BranchInfo
+	treeID := branch.TreeId
+
+	return m.txExecute("DeleteHistoryBranch", func(tx *transaction) error {
+		branches, err := m.getHistoryTree(treeID)
+		if err != nil {
+			return err
+		}
+
+		// like the SQL store, only the nodes of the branch itself are deleted, nodes of the
+		// ancestors may still be needed by branches forked concurrently
+		minNodeID := p.GetBeginNodeID(branch)
+		for _, b := range branches {
+			for _, br := range b.Ancestors {
+				if br.GetBranchId() == branch.BranchId && br.GetEndNodeId() > minNodeID {
+					minNodeID = br.GetEndNodeId()
+				}
+			}
+		}
+
+		delete(m.db.historyTrees[treeID], branch.BranchId)
+		if len(m.db.historyTrees[treeID]) == 0 {
+			delete(m.db.historyTrees, treeID)
+		}
+
+		branchKey := historyBranchKey{treeID: treeID, branchID: branch.BranchId}
+		nodes := m.db.historyNodes[branchKey]
+		for key := range nodes {
+			if key.nodeID >= minNodeID {
+				delete(nodes, key)
+			}
+		}
+		if len(nodes) == 0 {
+			delete(m.db.historyNodes, branchKey)
+		}
+		return nil
+	})
+}
+
+func (m *memoryHistoryV2Store) GetAllHistoryTreeBranches(
+	request *p.GetAllHistoryTreeBranchesRequest,
+) (*p.GetAllHistoryTreeBranchesResponse, error) {
+
+	var pageToken historyTreePageToken
+	if len(request.NextPageToken) > 0 {
+		if err := json.Unmarshal(request.NextPageToken, &pageToken); err != nil {
+			return nil, serviceerror.NewInvalidArgument(fmt.Sprintf("GetAllHistoryTreeBranches: invalid next page token. Error: %v", err))
+		}
+	}
+	lastKey := historyBranchKey{treeID: pageToken.TreeID, branchID: pageToken.BranchID}
+
+	m.db.RLock()
+	defer m.db.RUnlock()
+
+	var keys []historyBranchKey
+	for treeID, branches := range m.db.historyTrees {
+		for branchID := range branches {
+			key := historyBranchKey{treeID: treeID, branchID: branchID}
+			if len(request.NextPageToken) == 0 || lessHistoryBranchKey(lastKey, key) {
+				keys = append(keys, key)
+			}
+		}
+	}
+	sort.Slice(keys, func(i, j int) bool {
+		return lessHistoryBranchKey(keys[i], keys[j])
+	})
+
+	response := &p.GetAllHistoryTreeBranchesResponse{}
+	if request.PageSize > 0 && len(keys) > request.PageSize {
+		keys = keys[:request.PageSize]
+		lastKey = keys[len(keys)-1]
+		token, err := json.Marshal(historyTreePageToken{TreeID: lastKey.treeID, BranchID: lastKey.branchID})
+		if err != nil {
+			return nil, serviceerror.NewInternal(fmt.Sprintf("GetAllHistoryTreeBranches: failed to serialize page token. Error: %v", err))
+		}
+		response.NextPageToken = token
+	}
+
+	for _, key := range keys {
+		blob := m.db.historyTrees[key.treeID][key.branchID]
+		treeInfo, err := serialization.HistoryTreeInfoFromBlob(blob.Data, blob.Encoding.String())
+		if err != nil {
+			return nil, err
+		}
+		response.Branches = append(response.Branches, p.HistoryBranchDetail{
+			TreeID:   key.treeID,
+			BranchID: key.branchID,
+			ForkTime: treeInfo.ForkTime,
+			Info:     treeInfo.Info,
+		})
+	}
+	return response, nil
+}
+
+// GetHistoryTree returns all branch information of a tree
+func (m *memoryHistoryV2Store) GetHistoryTree(
+	request *p.GetHistoryTreeRequest,
+) (*p.GetHistoryTreeResponse, error) {
+
+	m.db.RLock()
+	defer m.db.RUnlock()
+
+	branches, err := m.getHistoryTree(request.TreeID)
+	if err != nil {
+		return nil, err
+	}
+	if len(branches) == 0 {
+		return &p.GetHistoryTreeResponse{}, nil
+	}
+	return &p.GetHistoryTreeResponse{
+		Branches: branches,
+	}, nil
+}
+
+// getHistoryTree returns the branches of a tree ordered by branch ID, the db lock must be held
+func (m *memoryHistoryV2Store) getHistoryTree(treeID string) ([]*persistenceblobs.HistoryBranch, error) {
+	tree := m.db.historyTrees[treeID]
+	branchIDs := make([]string, 0, len(tree))
+	for branchID := range tree {
+		branchIDs = append(branchIDs, branchID)
+	}
+	sort.Strings(branchIDs)
+
+	branches := make([]*persistenceblobs.HistoryBranch, 0, len(branchIDs))
+	for _, branchID := range branchIDs {
+		blob := tree[branchID]
+		treeInfo, err := serialization.HistoryTreeInfoFromBlob(blob.Data, blob.Encoding.String())
+		if err != nil {
+			return nil, err
+		}
+		branches = append(branches, treeInfo.BranchInfo)
+	}
+	return branches, nil
+}
+
+func (m *memoryHistoryV2Store) putHistoryTree(tx *transaction, key historyBranchKey, blob serialization.DataBlob) {
+	tree, ok := m.db.historyTrees[key.treeID]
+	if !ok {
+		tree = make(map[string]serialization.DataBlob)
+		m.db.historyTrees[key.treeID] = tree
+	}
+	prev, ok := tree[key.branchID]
+	tree[key.branchID] = blob
+	tx.onRollback(func() {
+		if ok {
+			tree[key.branchID] = prev
+		} else {
+			delete(tree, key.branchID)
+		}
+	})
+}
+
+func lessHistoryBranchKey(a historyBranchKey, b historyBranchKey) bool {
+	if a.treeID != b.treeID {
+		return a.treeID < b.treeID
+	}
+	return a.branchID < b.branchID
+}
@@ -0,0 +1,208 @@
+// The MIT License
+//
+// Copyright (c) 2020 Temporal Technologies Inc.  All rights reserved.
+//
+// Copyright (c) 2020 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package memory
+
+import (
+	"fmt"
+	"sort"
+
+	"go.temporal.io/api/serviceerror"
+
+	"go.temporal.io/server/common/log"
+	p "go.temporal.io/server/common/persistence"
+	"go.temporal.io/server/common/persistence/serialization"
+)
+
+type (
+	memoryMetadataStore struct {
+		memoryStore
+	}
+
+	namespaceRow struct {
+		id                  string
+		name                string
+		data                serialization.DataBlob
+		isGlobal            bool
+		notificationVersion int64
+	}
+)
+
+var _ p.MetadataStore = (*memoryMetadataStore)(nil)
+
+// newMetadataPersistenceV2 creates an instance of memoryMetadataStore
+func newMetadataPersistenceV2(db *db, logger log.Logger) (p.MetadataStore, error) {
+	return &memoryMetadataStore{
+		memoryStore: memoryStore{
+			db:     db,
+			logger: logger,
+		},
+	}, nil
+}
+
+func (m *memoryMetadataStore) CreateNamespace(request *p.InternalCreateNamespaceRequest) (*p.CreateNamespaceResponse, error) {
+	m.db.Lock()
+	defer m.db.Unlock()
+
+	if _, ok := m.db.namespaceIDs[request.Name]; ok {
+		return nil, serviceerror.NewNamespaceAlreadyExists(fmt.Sprintf("name: %v", request.Name))
+	}
+	if _, ok := m.db.namespaces[request.ID]; ok {
+		return nil, serviceerror.NewNamespaceAlreadyExists(fmt.Sprintf("id: %v", request.ID))
+	}
+
+	m.db.namespaces[request.ID] = &namespaceRow{
+		id:                  request.ID,
+		name:                request.Name,
+		data:                copyBlob(*request.Namespace),
+		isGlobal:            request.IsGlobal,
+		notificationVersion: m.db.notificationVersion,
+	}
+	m.db.namespaceIDs[request.Name] = request.ID
+	m.db.notificationVersion++
+	return &p.CreateNamespaceResponse{ID: request.ID}, nil
+}
+
+func (m *memoryMetadataStore) GetNamespace(request *p.GetNamespaceRequest) (*p.InternalGetNamespaceResponse, error) {
+	m.db.RLock()
+	defer m.db.RUnlock()
+
+	var row *namespaceRow
+	switch {
+	case request.Name != "" && request.ID != "":
+		return nil, serviceerror.NewInvalidArgument("GetNamespace operation failed.  Both ID and Name specified in request.")
+	case request.Name != "":
+		if id, ok := m.db.namespaceIDs[request.Name]; ok {
+			row = m.db.namespaces[id]
+		}
+	case request.ID != "":
+		row = m.db.namespaces[request.ID]
+	default:
+		return nil, serviceerror.NewInvalidArgument("GetNamespace operation failed.  Both ID and Name are empty.")
+	}
+
+	if row == nil {
+		identity := request.Name
+		if len(request.ID) > 0 {
+			identity = request.ID
+		}
+		return nil, serviceerror.NewNotFound(fmt.Sprintf("Namespace %s does not exist.", identity))
+	}
+	return row.toGetNamespaceResponse(), nil
+}
+
+func (m *memoryMetadataStore) UpdateNamespace(request *p.InternalUpdateNamespaceRequest) error {
+	m.db.Lock()
+	defer m.db.Unlock()
+
+	if m.db.notificationVersion != request.NotificationVersion {
+		return serviceerror.NewInternal(fmt.Sprintf(
+			"UpdateNamespace: conditional update error: expect: %v, actual: %v",
+			request.NotificationVersion,
+			m.db.notificationVersion,
+		))
+	}
+	row, ok := m.db.namespaces[request.Id]
+	if !ok {
+		return serviceerror.NewInternal("UpdateNamespace: 0 rows updated instead of one")
+	}
+	if id, ok := m.db.namespaceIDs[request.Name]; ok && id != request.Id {
+		return serviceerror.NewNamespaceAlreadyExists(fmt.Sprintf("name: %v", request.Name))
+	}
+
+	delete(m.db.namespaceIDs, row.name)
+	m.db.namespaces[request.Id] = &namespaceRow{
+		id:                  request.Id,
+		name:                request.Name,
+		data:                copyBlob(*request.Namespace),
+		isGlobal:            row.isGlobal,
+		notificationVersion: request.NotificationVersion,
+	}
+	m.db.namespaceIDs[request.Name] = request.Id
+	m.db.notificationVersion++
+	return nil
+}
+
+func (m *memoryMetadataStore) DeleteNamespace(request *p.DeleteNamespaceRequest) error {
+	m.db.Lock()
+	defer m.db.Unlock()
+
+	if row, ok := m.db.namespaces[request.ID]; ok {
+		delete(m.db.namespaceIDs, row.name)
+		delete(m.db.namespaces, request.ID)
+	}
+	return nil
+}
+
+func (m *memoryMetadataStore) DeleteNamespaceByName(request *p.DeleteNamespaceByNameRequest) error {
+	m.db.Lock()
+	defer m.db.Unlock()
+
+	if id, ok := m.db.namespaceIDs[request.Name]; ok {
+		delete(m.db.namespaces, id)
+		delete(m.db.namespaceIDs, request.Name)
+	}
+	return nil
+}
+
+func (m *memoryMetadataStore) GetMetadata() (*p.GetMetadataResponse, error) {
+	m.db.RLock()
+	defer m.db.RUnlock()
+
+	return &p.GetMetadataResponse{NotificationVersion: m.db.notificationVersion}, nil
+}
+
+func (m *memoryMetadataStore) ListNamespaces(request *p.ListNamespacesRequest) (*p.InternalListNamespacesResponse, error) {
+	m.db.RLock()
+	defer m.db.RUnlock()
+
+	ids := make([]string, 0, len(m.db.namespaces))
+	for id := range m.db.namespaces {
+		if id > string(request.NextPageToken) {
+			ids = append(ids, id)
+		}
+	}
+	sort.Strings(ids)
+	if len(ids) > request.PageSize {
+		ids = ids[:request.PageSize]
+	}
+
+	resp := &p.InternalListNamespacesResponse{}
+	for _, id := range ids {
+		resp.Namespaces = append(resp.Namespaces, m.db.namespaces[id].toGetNamespaceResponse())
+	}
+	if len(ids) > 0 && len(ids) >= request.PageSize {
+		resp.NextPageToken = []byte(ids[len(ids)-1])
+	}
+	return resp, nil
+}
+
+func (r *namespaceRow) toGetNamespaceResponse() *p.InternalGetNamespaceResponse {
+	data := copyBlob(r.data)
+	return &p.InternalGetNamespaceResponse{
+		Namespace:           &data,
+		IsGlobal:            r.isGlobal,
+		NotificationVersion: r.notificationVersion,
+	}
+}
@@ -0,0 +1,63 @@
+// The MIT License
+//
+// Copyright (c) 2020 Temporal Technologies Inc.  All rights reserved.
+//
+// Copyright (c) 2020 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package memory
+
+import (
+	"go.temporal.io/server/common"
+	"go.temporal.io/server/common/log"
+	"go.temporal.io/server/common/service/config"
+	"go.temporal.io/server/common/service/dynamicconfig"
+)
+
+// TestCluster allows running the persistence tests against in-memory tables
+type TestCluster struct {
+	logger log.Logger
+}
+
+// NewTestCluster returns a new in-memory test cluster
+func NewTestCluster(logger log.Logger) *TestCluster {
+	return &TestCluster{logger: logger}
+}
+
+// SetupTestDatabase from PersistenceTestCluster interface, tables are created lazily
+func (s *TestCluster) SetupTestDatabase() {
+}
+
+// TearDownTestDatabase from PersistenceTestCluster interface, tables are dropped
+// together with the AbstractDataStoreFactory
+func (s *TestCluster) TearDownTestDatabase() {
+}
+
+// Config returns the persistence config for the in-memory test cluster
+func (s *TestCluster) Config() config.Persistence {
+	return config.Persistence{
+		DefaultStore:    "test",
+		VisibilityStore: "test",
+		DataStores: map[string]config.DataStore{
+			"test": {CustomDataStoreConfig: &config.CustomDatastoreConfig{Name: StoreName}},
+		},
+		TransactionSizeLimit: dynamicconfig.GetIntPropertyFn(common.DefaultTransactionSizeLimit),
+	}
+}
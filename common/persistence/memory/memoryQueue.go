@@ -0,0 +1,251 @@
+// The MIT License
+//
+// Copyright (c) 2020 Temporal Technologies Inc.  All rights reserved.
+//
+// Copyright (c) 2020 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package memory
+
+import (
+	"fmt"
+
+	"go.temporal.io/api/serviceerror"
+
+	"go.temporal.io/server/common/log"
+	p "go.temporal.io/server/common/persistence"
+)
+
+const (
+	emptyMessageID = -1
+)
+
+type (
+	memoryQueue struct {
+		memoryStore
+		queueType p.QueueType
+	}
+
+	queueTable struct {
+		lastMessageID int64
+		messages      map[int64][]byte
+		ackLevels     map[string]int64
+	}
+)
+
+var _ p.Queue = (*memoryQueue)(nil)
+
+func newQueue(db *db, logger log.Logger, queueType p.QueueType) (p.Queue, error) {
+	return &memoryQueue{
+		memoryStore: memoryStore{
+			db:     db,
+			logger: logger,
+		},
+		queueType: queueType,
+	}, nil
+}
+
+func (q *memoryQueue) EnqueueMessage(messagePayload []byte) error {
+	q.db.Lock()
+	defer q.db.Unlock()
+
+	q.enqueue(q.queueType, messagePayload)
+	return nil
+}
+
+func (q *memoryQueue) ReadMessages(lastMessageID int64, maxCount int) ([]*p.QueueMessage, error) {
+	q.db.RLock()
+	defer q.db.RUnlock()
+
+	return q.read(q.queueType, lastMessageID, q.getQueue(q.queueType).lastMessageID, maxCount), nil
+}
+
+func (q *memoryQueue) DeleteMessagesBefore(messageID int64) error {
+	q.db.Lock()
+	defer q.db.Unlock()
+
+	queue := q.getQueue(q.queueType)
+	for id := range queue.messages {
+		if id < messageID {
+			delete(queue.messages, id)
+		}
+	}
+	return nil
+}
+
+func (q *memoryQueue) UpdateAckLevel(messageID int64, clusterName string) error {
+	q.db.Lock()
+	defer q.db.Unlock()
+
+	q.updateAckLevel(q.queueType, messageID, clusterName)
+	return nil
+}
+
+func (q *memoryQueue) GetAckLevels() (map[string]int64, error) {
+	q.db.RLock()
+	defer q.db.RUnlock()
+
+	return q.getAckLevels(q.queueType), nil
+}
+
+func (q *memoryQueue) EnqueueMessageToDLQ(messagePayload []byte) (int64, error) {
+	q.db.Lock()
+	defer q.db.Unlock()
+
+	return q.enqueue(q.getDLQTypeFromQueueType(), messagePayload), nil
+}
+
+func (q *memoryQueue) ReadMessagesFromDLQ(
+	firstMessageID int64,
+	lastMessageID int64,
+	pageSize int,
+	pageToken []byte,
+) ([]*p.QueueMessage, []byte, error) {
+
+	if len(pageToken) != 0 {
+		lastReadMessageID, err := deserializePageToken(pageToken)
+		if err != nil {
+			return nil, nil, serviceerror.NewInternal(fmt.Sprintf("invalid next page token %v", pageToken))
+		}
+		firstMessageID = lastReadMessageID
+	}
+
+	q.db.RLock()
+	defer q.db.RUnlock()
+
+	messages := q.read(q.getDLQTypeFromQueueType(), firstMessageID, lastMessageID, pageSize)
+	var newPagingToken []byte
+	if len(messages) > 0 && len(messages) >= pageSize {
+		newPagingToken = serializePageToken(messages[len(messages)-1].ID)
+	}
+	return messages, newPagingToken, nil
+}
+
+func (q *memoryQueue) DeleteMessageFromDLQ(messageID int64) error {
+	q.db.Lock()
+	defer q.db.Unlock()
+
+	delete(q.getQueue(q.getDLQTypeFromQueueType()).messages, messageID)
+	return nil
+}
+
+func (q *memoryQueue) RangeDeleteMessagesFromDLQ(firstMessageID int64, lastMessageID int64) error {
+	q.db.Lock()
+	defer q.db.Unlock()
+
+	queue := q.getQueue(q.getDLQTypeFromQueueType())
+	for id := range queue.messages {
+		if id > firstMessageID && id <= lastMessageID {
+			delete(queue.messages, id)
+		}
+	}
+	return nil
+}
+
+func (q *memoryQueue) UpdateDLQAckLevel(messageID int64, clusterName string) error {
+	q.db.Lock()
+	defer q.db.Unlock()
+
+	q.updateAckLevel(q.getDLQTypeFromQueueType(), messageID, clusterName)
+	return nil
+}
+
+func (q *memoryQueue) GetDLQAckLevels() (map[string]int64, error) {
+	q.db.RLock()
+	defer q.db.RUnlock()
+
+	return q.getAckLevels(q.getDLQTypeFromQueueType()), nil
+}
+
+func (q *memoryQueue) getDLQTypeFromQueueType() p.QueueType {
+	return -q.queueType
+}
+
+// getQueue returns the table of the given queue type, the db lock must be held
+func (q *memoryQueue) getQueue(queueType p.QueueType) *queueTable {
+	queue, ok := q.db.queues[queueType]
+	if !ok {
+		queue = &queueTable{
+			lastMessageID: emptyMessageID,
+			messages:      make(map[int64][]byte),
+		}
+		q.db.queues[queueType] = queue
+	}
+	return queue
+}
+
+func (q *memoryQueue) enqueue(queueType p.QueueType, messagePayload []byte) int64 {
+	queue := q.getQueue(queueType)
+	queue.lastMessageID++
+	queue.messages[queue.lastMessageID] = copyBytes(messagePayload)
+	return queue.lastMessageID
+}
+
+// read returns the messages with ID in (exclusiveBeginID, inclusiveEndID], ordered by ID
+func (q *memoryQueue) read(queueType p.QueueType, exclusiveBeginID int64, inclusiveEndID int64, maxCount int) []*p.QueueMessage {
+	queue, ok := q.db.queues[queueType]
+	if !ok {
+		return nil
+	}
+	ids := make([]int64, 0, len(queue.messages))
+	for id := range queue.messages {
+		if id > exclusiveBeginID && id <= inclusiveEndID {
+			ids = append(ids, id)
+		}
+	}
+	sortInt64s(ids)
+	if len(ids) > maxCount {
+		ids = ids[:maxCount]
+	}
+
+	var messages []*p.QueueMessage
+	for _, id := range ids {
+		messages = append(messages, &p.QueueMessage{
+			ID:        id,
+			QueueType: queueType,
+			Payload:   copyBytes(queue.messages[id]),
+		})
+	}
+	return messages
+}
+
+func (q *memoryQueue) updateAckLevel(queueType p.QueueType, messageID int64, clusterName string) {
+	queue := q.getQueue(queueType)
+	if queue.ackLevels == nil {
+		queue.ackLevels = make(map[string]int64)
+	}
+	// Ignore possibly delayed message
+	if ackLevel, ok := queue.ackLevels[clusterName]; ok && ackLevel > messageID {
+		return
+	}
+	queue.ackLevels[clusterName] = messageID
+}
+
+func (q *memoryQueue) getAckLevels(queueType p.QueueType) map[string]int64 {
+	queue, ok := q.db.queues[queueType]
+	if !ok || queue.ackLevels == nil {
+		return nil
+	}
+	ackLevels := make(map[string]int64, len(queue.ackLevels))
+	for clusterName, ackLevel := range queue.ackLevels {
+		ackLevels[clusterName] = ackLevel
+	}
+	return ackLevels
+}
@@ -0,0 +1,131 @@
+// The MIT License
+//
+// Copyright (c) 2020 Temporal Technologies Inc.  All rights reserved.
+//
+// Copyright (c) 2020 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package memory
+
+import (
+	"fmt"
+
+	"go.temporal.io/api/serviceerror"
+
+	"go.temporal.io/server/common/log"
+	p "go.temporal.io/server/common/persistence"
+	"go.temporal.io/server/common/persistence/serialization"
+)
+
+type (
+	memoryShardStore struct {
+		memoryStore
+		currentClusterName string
+	}
+
+	shardRow struct {
+		rangeID int64
+		data    serialization.DataBlob
+	}
+)
+
+var _ p.ShardStore = (*memoryShardStore)(nil)
+
+// newShardPersistence creates an instance of ShardManager
+func newShardPersistence(db *db, currentClusterName string, logger log.Logger) (p.ShardStore, error) {
+	return &memoryShardStore{
+		memoryStore: memoryStore{
+			db:     db,
+			logger: logger,
+		},
+		currentClusterName: currentClusterName,
+	}, nil
+}
+
+func (m *memoryShardStore) CreateShard(request *p.CreateShardRequest) error {
+	blob, err := serialization.ShardInfoToBlob(request.ShardInfo)
+	if err != nil {
+		return serviceerror.NewInternal(fmt.Sprintf("CreateShard operation failed. Error: %v", err))
+	}
+
+	m.db.Lock()
+	defer m.db.Unlock()
+
+	shardID := request.ShardInfo.GetShardId()
+	if _, ok := m.db.shards[shardID]; ok {
+		return &p.ShardAlreadyExistError{
+			Msg: fmt.Sprintf("CreateShard operaiton failed. Shard with ID %v already exists.", shardID),
+		}
+	}
+	m.db.shards[shardID] = &shardRow{
+		rangeID: request.ShardInfo.GetRangeId(),
+		data:    blob,
+	}
+	return nil
+}
+
+func (m *memoryShardStore) GetShard(request *p.GetShardRequest) (*p.GetShardResponse, error) {
+	m.db.RLock()
+	defer m.db.RUnlock()
+
+	row, ok := m.db.shards[request.ShardID]
+	if !ok {
+		return nil, serviceerror.NewNotFound(fmt.Sprintf("GetShard operation failed. Shard with ID %v not found.", request.ShardID))
+	}
+	shardInfo, err := serialization.ShardInfoFromBlob(row.data.Data, row.data.Encoding.String(), m.currentClusterName)
+	if err != nil {
+		return nil, err
+	}
+	return &p.GetShardResponse{ShardInfo: shardInfo}, nil
+}
+
+func (m *memoryShardStore) UpdateShard(request *p.UpdateShardRequest) error {
+	blob, err := serialization.ShardInfoToBlob(request.ShardInfo)
+	if err != nil {
+		return serviceerror.NewInternal(fmt.Sprintf("UpdateShard operation failed. Error: %v", err))
+	}
+
+	return m.txExecute("UpdateShard", func(tx *transaction) error {
+		shardID := request.ShardInfo.GetShardId()
+		if err := lockShard(m.db, shardID, request.PreviousRangeID); err != nil {
+			return err
+		}
+		m.db.shards[shardID] = &shardRow{
+			rangeID: request.ShardInfo.GetRangeId(),
+			data:    blob,
+		}
+		return nil
+	})
+}
+
+// lockShard checks that the shard is still owned by the caller, the db lock must be held
+func lockShard(db *db, shardID int32, oldRangeID int64) error {
+	row, ok := db.shards[shardID]
+	if !ok {
+		return serviceerror.NewInternal(fmt.Sprintf("Failed to lock shard with ID %v that does not exist.", shardID))
+	}
+	if row.rangeID != oldRangeID {
+		return &p.ShardOwnershipLostError{
+			ShardID: shardID,
+			Msg:     fmt.Sprintf("Failed to update shard. Previous range ID: %v; new range ID: %v", oldRangeID, row.rangeID),
+		}
+	}
+	return nil
+}
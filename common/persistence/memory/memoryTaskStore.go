@@ -0,0 +1,324 @@
+// The MIT License
+//
+// Copyright (c) 2020 Temporal Technologies Inc.  All rights reserved.
+//
+// Copyright (c) 2020 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package memory
+
+import (
+	"encoding/json"
+	"fmt"
+	"sort"
+	"time"
+
+	enumspb "go.temporal.io/api/enums/v1"
+	"go.temporal.io/api/serviceerror"
+
+	"go.temporal.io/server/api/persistenceblobs/v1"
+	"go.temporal.io/server/common/log"
+	p "go.temporal.io/server/common/persistence"
+	"go.temporal.io/server/common/persistence/serialization"
+	"go.temporal.io/server/common/primitives/timestamp"
+)
+
+type (
+	memoryTaskStore struct {
+		memoryStore
+	}
+
+	taskQueueKey = p.TaskQueueKey
+
+	taskQueueRow struct {
+		rangeID int64
+		data    serialization.DataBlob
+	}
+)
+
+var _ p.TaskStore = (*memoryTaskStore)(nil)
+
+// newTaskPersistence creates a new instance of TaskManager
+func newTaskPersistence(db *db, logger log.Logger) (p.TaskStore, error) {
+	return &memoryTaskStore{
+		memoryStore: memoryStore{
+			db:     db,
+			logger: logger,
+		},
+	}, nil
+}
+
+func (m *memoryTaskStore) LeaseTaskQueue(request *p.LeaseTaskQueueRequest) (*p.LeaseTaskQueueResponse, error) {
+	key := taskQueueKey{NamespaceID: request.NamespaceID, Name: request.TaskQueue, TaskType: request.TaskType}
+
+	m.db.Lock()
+	defer m.db.Unlock()
+
+	var tqInfo *persistenceblobs.TaskQueueInfo
+	row, ok := m.db.taskQueues[key]
+	if ok {
+		if request.RangeID > 0 && request.RangeID != row.rangeID {
+			return nil, &p.ConditionFailedError{
+				Msg: fmt.Sprintf("leaseTaskQueue:renew failed:taskQueue:%v, taskQueueType:%v, haveRangeID:%v, gotRangeID:%v",
+					request.TaskQueue, request.TaskType, request.RangeID, row.rangeID),
+			}
+		}
+		var err error
+		if tqInfo, err = serialization.TaskQueueInfoFromBlob(row.data.Data, row.data.Encoding.String()); err != nil {
+			return nil, err
+		}
+	} else {
+		row = &taskQueueRow{}
+		tqInfo = &persistenceblobs.TaskQueueInfo{
+			NamespaceId: request.NamespaceID,
+			Name:        request.TaskQueue,
+			TaskType:    request.TaskType,
+			Kind:        request.TaskQueueKind,
+		}
+	}
+
+	tqInfo.LastUpdateTime = timestamp.TimeNowPtrUtc()
+	blob, err := serialization.TaskQueueInfoToBlob(tqInfo)
+	if err != nil {
+		return nil, serviceerror.NewInternal(fmt.Sprintf("LeaseTaskQueue operation failed. Error: %v", err))
+	}
+	m.db.taskQueues[key] = &taskQueueRow{
+		rangeID: row.rangeID + 1,
+		data:    blob,
+	}
+	return &p.LeaseTaskQueueResponse{TaskQueueInfo: &p.PersistedTaskQueueInfo{
+		Data:    tqInfo,
+		RangeID: row.rangeID + 1,
+	}}, nil
+}
+
+func (m *memoryTaskStore) UpdateTaskQueue(request *p.UpdateTaskQueueRequest) (*p.UpdateTaskQueueResponse, error) {
+	tq := request.TaskQueueInfo
+	key := taskQueueKey{NamespaceID: tq.GetNamespaceId(), Name: tq.GetName(), TaskType: tq.GetTaskType()}
+
+	tq.LastUpdateTime = timestamp.TimeNowPtrUtc()
+	if tq.GetKind() == enumspb.TASK_QUEUE_KIND_STICKY {
+		tq.ExpiryTime = stickyTaskQueueTTL()
+	}
+	blob, err := serialization.TaskQueueInfoToBlob(tq)
+	if err != nil {
+		return nil, serviceerror.NewInternal(fmt.Sprintf("UpdateTaskQueue operation failed. Error: %v", err))
+	}
+
+	m.db.Lock()
+	defer m.db.Unlock()
+
+	// sticky task queues are created on their first update
+	if tq.GetKind() != enumspb.TASK_QUEUE_KIND_STICKY {
+		if err := lockTaskQueue(m.db, key, request.RangeID); err != nil {
+			return nil, err
+		}
+	}
+	m.db.taskQueues[key] = &taskQueueRow{
+		rangeID: request.RangeID,
+		data:    blob,
+	}
+	return &p.UpdateTaskQueueResponse{}, nil
+}
+
+func (m *memoryTaskStore) ListTaskQueue(request *p.ListTaskQueueRequest) (*p.ListTaskQueueResponse, error) {
+	var lastKey *taskQueueKey
+	if len(request.PageToken) > 0 {
+		lastKey = &taskQueueKey{}
+		if err := json.Unmarshal(request.PageToken, lastKey); err != nil {
+			return nil, serviceerror.NewInternal(fmt.Sprintf("error deserializing page token: %v", err))
+		}
+	}
+
+	m.db.RLock()
+	defer m.db.RUnlock()
+
+	keys := make([]taskQueueKey, 0, len(m.db.taskQueues))
+	for key := range m.db.taskQueues {
+		if lastKey == nil || lessTaskQueueKey(*lastKey, key) {
+			keys = append(keys, key)
+		}
+	}
+	sort.Slice(keys, func(i, j int) bool {
+		return lessTaskQueueKey(keys[i], keys[j])
+	})
+
+	resp := &p.ListTaskQueueResponse{}
+	for i, key := range keys {
+		if i == request.PageSize {
+			nextPageToken, err := json.Marshal(keys[i-1])
+			if err != nil {
+				return nil, serviceerror.NewInternal(fmt.Sprintf("error serializing nextPageToken:%v", err))
+			}
+			resp.NextPageToken = nextPageToken
+			break
+		}
+		row := m.db.taskQueues[key]
+		info, err := serialization.TaskQueueInfoFromBlob(row.data.Data, row.data.Encoding.String())
+		if err != nil {
+			return nil, err
+		}
+		resp.Items = append(resp.Items, &p.PersistedTaskQueueInfo{
+			Data:    info,
+			RangeID: row.rangeID,
+		})
+	}
+	return resp, nil
+}
+
+func (m *memoryTaskStore) DeleteTaskQueue(request *p.DeleteTaskQueueRequest) error {
+	m.db.Lock()
+	defer m.db.Unlock()
+
+	key := *request.TaskQueue
+	row, ok := m.db.taskQueues[key]
+	if !ok || row.rangeID != request.RangeID {
+		return serviceerror.NewInternal("delete failed: 0 rows affected instead of 1")
+	}
+	delete(m.db.taskQueues, key)
+	return nil
+}
+
+func (m *memoryTaskStore) CreateTasks(request *p.CreateTasksRequest) (*p.CreateTasksResponse, error) {
+	blobs := make([]serialization.DataBlob, len(request.Tasks))
+	for i, v := range request.Tasks {
+		blob, err := serialization.TaskInfoToBlob(v)
+		if err != nil {
+			return nil, err
+		}
+		blobs[i] = blob
+	}
+	key := taskQueueKey{
+		NamespaceID: request.TaskQueueInfo.Data.GetNamespaceId(),
+		Name:        request.TaskQueueInfo.Data.GetName(),
+		TaskType:    request.TaskQueueInfo.Data.GetTaskType(),
+	}
+
+	m.db.Lock()
+	defer m.db.Unlock()
+
+	if err := lockTaskQueue(m.db, key, request.TaskQueueInfo.RangeID); err != nil {
+		return nil, err
+	}
+	tasks, ok := m.db.tasks[key]
+	if !ok {
+		tasks = make(map[int64]serialization.DataBlob)
+		m.db.tasks[key] = tasks
+	}
+	for i, v := range request.Tasks {
+		tasks[v.GetTaskId()] = blobs[i]
+	}
+	return &p.CreateTasksResponse{}, nil
+}
+
+func (m *memoryTaskStore) GetTasks(request *p.GetTasksRequest) (*p.GetTasksResponse, error) {
+	key := taskQueueKey{NamespaceID: request.NamespaceID, Name: request.TaskQueue, TaskType: request.TaskType}
+
+	m.db.RLock()
+	defer m.db.RUnlock()
+
+	tasks := m.db.tasks[key]
+	taskIDs := make([]int64, 0, len(tasks))
+	for taskID := range tasks {
+		if taskID > request.ReadLevel && (request.MaxReadLevel == nil || taskID <= *request.MaxReadLevel) {
+			taskIDs = append(taskIDs, taskID)
+		}
+	}
+	sortInt64s(taskIDs)
+	if len(taskIDs) > request.BatchSize {
+		taskIDs = taskIDs[:request.BatchSize]
+	}
+
+	response := &p.GetTasksResponse{Tasks: make([]*persistenceblobs.AllocatedTaskInfo, len(taskIDs))}
+	for i, taskID := range taskIDs {
+		blob := tasks[taskID]
+		info, err := serialization.TaskInfoFromBlob(blob.Data, blob.Encoding.String())
+		if err != nil {
+			return nil, err
+		}
+		response.Tasks[i] = info
+	}
+	return response, nil
+}
+
+func (m *memoryTaskStore) CompleteTask(request *p.CompleteTaskRequest) error {
+	m.db.Lock()
+	defer m.db.Unlock()
+
+	delete(m.db.tasks[*request.TaskQueue], request.TaskID)
+	return nil
+}
+
+func (m *memoryTaskStore) CompleteTasksLessThan(request *p.CompleteTasksLessThanRequest) (int, error) {
+	key := taskQueueKey{NamespaceID: request.NamespaceID, Name: request.TaskQueueName, TaskType: request.TaskType}
+
+	m.db.Lock()
+	defer m.db.Unlock()
+
+	tasks := m.db.tasks[key]
+	taskIDs := make([]int64, 0, len(tasks))
+	for taskID := range tasks {
+		if taskID <= request.TaskID {
+			taskIDs = append(taskIDs, taskID)
+		}
+	}
+	sortInt64s(taskIDs)
+	if len(taskIDs) > request.Limit {
+		taskIDs = taskIDs[:request.Limit]
+	}
+	for _, taskID := range taskIDs {
+		delete(tasks, taskID)
+	}
+	return len(taskIDs), nil
+}
+
+// lockTaskQueue checks the range ID of the task queue, the db lock must be held
+func lockTaskQueue(db *db, key taskQueueKey, oldRangeID int64) error {
+	row, ok := db.taskQueues[key]
+	if !ok {
+		return serviceerror.NewInternal(fmt.Sprintf("Failed to lock task queue %v of type %v that does not exist.", key.Name, key.TaskType))
+	}
+	if row.rangeID != oldRangeID {
+		return &p.ConditionFailedError{
+			Msg: fmt.Sprintf("Task queue range ID was %v when it was should have been %v", row.rangeID, oldRangeID),
+		}
+	}
+	return nil
+}
+
+func lessTaskQueueKey(a taskQueueKey, b taskQueueKey) bool {
+	if a.NamespaceID != b.NamespaceID {
+		return a.NamespaceID < b.NamespaceID
+	}
+	if a.Name != b.Name {
+		return a.Name < b.Name
+	}
+	return a.TaskType < b.TaskType
+}
+
+func sortInt64s(values []int64) {
+	sort.Slice(values, func(i, j int) bool {
+		return values[i] < values[j]
+	})
+}
+
+func stickyTaskQueueTTL() *time.Time {
+	return timestamp.TimePtr(time.Now().UTC().Add(24 * time.Hour))
+}
@@ -0,0 +1,344 @@
+// The MIT License
+//
+// Copyright (c) 2020 Temporal Technologies Inc.  All rights reserved.
+//
+// Copyright (c) 2020 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package memory
+
+import (
+	"encoding/json"
+	"fmt"
+	"sort"
+	"time"
+
+	enumspb "go.temporal.io/api/enums/v1"
+	"go.temporal.io/api/serviceerror"
+
+	"go.temporal.io/server/common/log"
+	p "go.temporal.io/server/common/persistence"
+	"go.temporal.io/server/common/persistence/serialization"
+)
+
+type (
+	// memoryVisibilityStore supports the basic visibility APIs only,
+	// search attributes are not stored
+	memoryVisibilityStore struct {
+		memoryStore
+	}
+
+	visibilityKey struct {
+		namespaceID string
+		runID       string
+	}
+
+	visibilityRow struct {
+		workflowID       string
+		runID            string
+		workflowTypeName string
+		startTime        int64
+		executionTime    int64
+		closeTime        int64
+		status           enumspb.WorkflowExecutionStatus
+		historyLength    int64
+		memo             serialization.DataBlob
+		taskQueue        string
+	}
+
+	visibilityPageToken struct {
+		Time  time.Time
+		RunID string
+	}
+
+	// visibilityFilter returns whether the row is selected by a list request
+	visibilityFilter func(row *visibilityRow) bool
+)
+
+var _ p.VisibilityStore = (*memoryVisibilityStore)(nil)
+
+// newVisibilityPersistence creates an instance of VisibilityStore
+func newVisibilityPersistence(db *db, logger log.Logger) (p.VisibilityStore, error) {
+	return &memoryVisibilityStore{
+		memoryStore: memoryStore{
+			db:     db,
+			logger: logger,
+		},
+	}, nil
+}
+
+func (s *memoryVisibilityStore) RecordWorkflowExecutionStarted(request *p.InternalRecordWorkflowExecutionStartedRequest) error {
+	s.db.Lock()
+	defer s.db.Unlock()
+
+	key := visibilityKey{namespaceID: request.NamespaceID, runID: request.RunID}
+	// an existing row is left as such
+	if _, ok := s.db.visibility[key]; ok {
+		return nil
+	}
+	s.db.visibility[key] = &visibilityRow{
+		workflowID:       request.WorkflowID,
+		runID:            request.RunID,
+		workflowTypeName: request.WorkflowTypeName,
+		startTime:        request.StartTimestamp,
+		executionTime:    request.ExecutionTimestamp,
+		status:           enumspb.WORKFLOW_EXECUTION_STATUS_RUNNING,
+		memo:             copyMemo(request.Memo),
+		taskQueue:        request.TaskQueue,
+	}
+	return nil
+}
+
+func (s *memoryVisibilityStore) RecordWorkflowExecutionClosed(request *p.InternalRecordWorkflowExecutionClosedRequest) error {
+	s.db.Lock()
+	defer s.db.Unlock()
+
+	s.db.visibility[visibilityKey{namespaceID: request.NamespaceID, runID: request.RunID}] = &visibilityRow{
+		workflowID:       request.WorkflowID,
+		runID:            request.RunID,
+		workflowTypeName: request.WorkflowTypeName,
+		startTime:        request.StartTimestamp,
+		executionTime:    request.ExecutionTimestamp,
+		closeTime:        request.CloseTimestamp,
+		status:           request.Status,
+		historyLength:    request.HistoryLength,
+		memo:             copyMemo(request.Memo),
+		taskQueue:        request.TaskQueue,
+	}
+	return nil
+}
+
+func (s *memoryVisibilityStore) UpsertWorkflowExecution(request *p.InternalUpsertWorkflowExecutionRequest) error {
+	s.db.Lock()
+	defer s.db.Unlock()
+
+	key := visibilityKey{namespaceID: request.NamespaceID, runID: request.RunID}
+	if row, ok := s.db.visibility[key]; ok {
+		newRow := *row
+		newRow.memo = copyMemo(request.Memo)
+		s.db.visibility[key] = &newRow
+		return nil
+	}
+	s.db.visibility[key] = &visibilityRow{
+		workflowID:       request.WorkflowID,
+		runID:            request.RunID,
+		workflowTypeName: request.WorkflowTypeName,
+		startTime:        request.StartTimestamp,
+		executionTime:    request.ExecutionTimestamp,
+		status:           request.Status,
+		memo:             copyMemo(request.Memo),
+		taskQueue:        request.TaskQueue,
+	}
+	return nil
+}
+
+func (s *memoryVisibilityStore) ListOpenWorkflowExecutions(request *p.ListWorkflowExecutionsRequest) (*p.InternalListWorkflowExecutionsResponse, error) {
+	return s.listWorkflowExecutions("ListOpenWorkflowExecutions", request, false, nil)
+}
+
+func (s *memoryVisibilityStore) ListClosedWorkflowExecutions(request *p.ListWorkflowExecutionsRequest) (*p.InternalListWorkflowExecutionsResponse, error) {
+	return s.listWorkflowExecutions("ListClosedWorkflowExecutions", request, true, nil)
+}
+
+func (s *memoryVisibilityStore) ListOpenWorkflowExecutionsByType(request *p.ListWorkflowExecutionsByTypeRequest) (*p.InternalListWorkflowExecutionsResponse, error) {
+	return s.listWorkflowExecutions("ListOpenWorkflowExecutionsByType", &request.ListWorkflowExecutionsRequest, false,
+		func(row *visibilityRow) bool {
+			return row.workflowTypeName == request.WorkflowTypeName
+		})
+}
+
+func (s *memoryVisibilityStore) ListClosedWorkflowExecutionsByType(request *p.ListWorkflowExecutionsByTypeRequest) (*p.InternalListWorkflowExecutionsResponse, error) {
+	return s.listWorkflowExecutions("ListClosedWorkflowExecutionsByType", &request.ListWorkflowExecutionsRequest, true,
+		func(row *visibilityRow) bool {
+			return row.workflowTypeName == request.WorkflowTypeName
+		})
+}
+
+func (s *memoryVisibilityStore) ListOpenWorkflowExecutionsByWorkflowID(request *p.ListWorkflowExecutionsByWorkflowIDRequest) (*p.InternalListWorkflowExecutionsResponse, error) {
+	return s.listWorkflowExecutions("ListOpenWorkflowExecutionsByWorkflowID", &request.ListWorkflowExecutionsRequest, false,
+		func(row *visibilityRow) bool {
+			return row.workflowID == request.WorkflowID
+		})
+}
+
+func (s *memoryVisibilityStore) ListClosedWorkflowExecutionsByWorkflowID(request *p.ListWorkflowExecutionsByWorkflowIDRequest) (*p.InternalListWorkflowExecutionsResponse, error) {
+	return s.listWorkflowExecutions("ListClosedWorkflowExecutionsByWorkflowID", &request.ListWorkflowExecutionsRequest, true,
+		func(row *visibilityRow) bool {
+			return row.workflowID == request.WorkflowID
+		})
+}
+
+func (s *memoryVisibilityStore) ListClosedWorkflowExecutionsByStatus(request *p.ListClosedWorkflowExecutionsByStatusRequest) (*p.InternalListWorkflowExecutionsResponse, error) {
+	return s.listWorkflowExecutions("ListClosedWorkflowExecutionsByStatus", &request.ListWorkflowExecutionsRequest, true,
+		func(row *visibilityRow) bool {
+			return row.status == request.Status
+		})
+}
+
+func (s *memoryVisibilityStore) GetClosedWorkflowExecution(request *p.GetClosedWorkflowExecutionRequest) (*p.InternalGetClosedWorkflowExecutionResponse, error) {
+	execution := request.Execution
+
+	s.db.RLock()
+	defer s.db.RUnlock()
+
+	row, ok := s.db.visibility[visibilityKey{namespaceID: request.NamespaceID, runID: execution.GetRunId()}]
+	if !ok || row.status == enumspb.WORKFLOW_EXECUTION_STATUS_RUNNING {
+		return nil, serviceerror.NewNotFound(fmt.Sprintf("Workflow execution not found.  WorkflowId: %v, RunId: %v",
+			execution.GetWorkflowId(), execution.GetRunId()))
+	}
+	info := s.rowToInfo(row)
+	info.WorkflowID = execution.GetWorkflowId()
+	return &p.InternalGetClosedWorkflowExecutionResponse{Execution: info}, nil
+}
+
+func (s *memoryVisibilityStore) DeleteWorkflowExecution(request *p.VisibilityDeleteWorkflowExecutionRequest) error {
+	s.db.Lock()
+	defer s.db.Unlock()
+
+	delete(s.db.visibility, visibilityKey{namespaceID: request.NamespaceID, runID: request.RunID})
+	return nil
+}
+
+func (s *memoryVisibilityStore) ListWorkflowExecutions(_ *p.ListWorkflowExecutionsRequestV2) (*p.InternalListWorkflowExecutionsResponse, error) {
+	return nil, p.NewOperationNotSupportErrorForVis()
+}
+
+func (s *memoryVisibilityStore) ScanWorkflowExecutions(_ *p.ListWorkflowExecutionsRequestV2) (*p.InternalListWorkflowExecutionsResponse, error) {
+	return nil, p.NewOperationNotSupportErrorForVis()
+}
+
+func (s *memoryVisibilityStore) CountWorkflowExecutions(_ *p.CountWorkflowExecutionsRequest) (*p.CountWorkflowExecutionsResponse, error) {
+	return nil, p.NewOperationNotSupportErrorForVis()
+}
+
+func (s *memoryVisibilityStore) rowToInfo(row *visibilityRow) *p.VisibilityWorkflowExecutionInfo {
+	executionTime := row.executionTime
+	if executionTime == 0 {
+		executionTime = row.startTime
+	}
+	memo := copyBlob(row.memo)
+	info := &p.VisibilityWorkflowExecutionInfo{
+		WorkflowID:    row.workflowID,
+		RunID:         row.runID,
+		TypeName:      row.workflowTypeName,
+		StartTime:     time.Unix(0, row.startTime).UTC(),
+		ExecutionTime: time.Unix(0, executionTime).UTC(),
+		Memo:          &memo,
+		Status:        row.status,
+		TaskQueue:     row.taskQueue,
+	}
+	if row.status != enumspb.WORKFLOW_EXECUTION_STATUS_RUNNING {
+		info.CloseTime = time.Unix(0, row.closeTime).UTC()
+		info.HistoryLength = row.historyLength
+	}
+	return info
+}
+
+// listWorkflowExecutions returns the open or closed executions of the namespace started, respectively
+// closed, in the requested time range, from the latest to the earliest
+func (s *memoryVisibilityStore) listWorkflowExecutions(
+	opName string,
+	request *p.ListWorkflowExecutionsRequest,
+	closeQuery bool,
+	filter visibilityFilter,
+) (*p.InternalListWorkflowExecutionsResponse, error) {
+
+	readLevel := &visibilityPageToken{Time: time.Unix(0, request.LatestStartTime).UTC(), RunID: ""}
+	if len(request.NextPageToken) > 0 {
+		if err := json.Unmarshal(request.NextPageToken, readLevel); err != nil {
+			return nil, serviceerror.NewInvalidArgument(fmt.Sprintf("%v operation failed. Invalid page token. Error: %v", opName, err))
+		}
+	}
+	maxTime := readLevel.Time.UnixNano()
+
+	rowTime := func(row *visibilityRow) int64 {
+		if closeQuery {
+			return row.closeTime
+		}
+		return row.startTime
+	}
+
+	s.db.RLock()
+	defer s.db.RUnlock()
+
+	var rows []*visibilityRow
+	for key, row := range s.db.visibility {
+		if key.namespaceID != request.NamespaceID {
+			continue
+		}
+		if closeQuery == (row.status == enumspb.WORKFLOW_EXECUTION_STATUS_RUNNING) {
+			continue
+		}
+		t := rowTime(row)
+		if t < request.EarliestStartTime || t > request.LatestStartTime {
+			continue
+		}
+		// RunID condition is needed for correct pagination
+		if !((t == maxTime && row.runID > readLevel.RunID) || t < maxTime) {
+			continue
+		}
+		if filter != nil && !filter(row) {
+			continue
+		}
+		rows = append(rows, row)
+	}
+	if len(rows) == 0 {
+		return &p.InternalListWorkflowExecutionsResponse{}, nil
+	}
+
+	sort.Slice(rows, func(i, j int) bool {
+		if rowTime(rows[i]) != rowTime(rows[j]) {
+			return rowTime(rows[i]) > rowTime(rows[j])
+		}
+		return rows[i].runID < rows[j].runID
+	})
+	if len(rows) > request.PageSize {
+		rows = rows[:request.PageSize]
+	}
+
+	infos := make([]*p.VisibilityWorkflowExecutionInfo, len(rows))
+	for i, row := range rows {
+		infos[i] = s.rowToInfo(row)
+	}
+
+	var nextPageToken []byte
+	lastRow := rows[len(rows)-1]
+	if lastTime := rowTime(lastRow); lastTime > request.EarliestStartTime {
+		token, err := json.Marshal(&visibilityPageToken{
+			Time:  time.Unix(0, lastTime).UTC(),
+			RunID: lastRow.runID,
+		})
+		if err != nil {
+			return nil, serviceerror.NewInternal(fmt.Sprintf("%v operation failed. Failed to serialize page token. Error: %v", opName, err))
+		}
+		nextPageToken = token
+	}
+	return &p.InternalListWorkflowExecutionsResponse{
+		Executions:    infos,
+		NextPageToken: nextPageToken,
+	}, nil
+}
+
+func copyMemo(memo *serialization.DataBlob) serialization.DataBlob {
+	if memo == nil {
+		return serialization.DataBlob{}
+	}
+	return copyBlob(*memo)
+}
@@ -0,0 +1,156 @@
+// The MIT License
+//
+// Copyright (c) 2020 Temporal Technologies Inc.  All rights reserved.
+//
+// Copyright (c) 2020 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package persistencetests
+
+import (
+	"math"
+	"testing"
+
+	"github.com/pborman/uuid"
+	enumspb "go.temporal.io/api/enums/v1"
+
+	"go.temporal.io/server/api/persistenceblobs/v1"
+	"go.temporal.io/server/common/convert"
+	p "go.temporal.io/server/common/persistence"
+	"go.temporal.io/server/common/primitives/timestamp"
+	"go.temporal.io/server/common/service/config"
+)
+
+const (
+	benchmarkTasksPerCreate = 16
+	benchmarkTasksToRead    = 1024
+	benchmarkReadBatchSize  = 256
+)
+
+func BenchmarkCassandraCreateTasks(b *testing.B) {
+	benchmarkCreateTasks(b, nil)
+}
+
+func BenchmarkCassandraCreateTasksBatched(b *testing.B) {
+	benchmarkCreateTasks(b, &config.CassandraTaskConfig{BatchedWrites: true})
+}
+
+func BenchmarkCassandraGetTasks(b *testing.B) {
+	benchmarkGetTasks(b, nil)
+}
+
+func BenchmarkCassandraGetTasksPrefetch(b *testing.B) {
+	benchmarkGetTasks(b, &config.CassandraTaskConfig{ReadPageSize: 64, ReadPrefetch: 0.5})
+}
+
+func benchmarkCreateTasks(b *testing.B, taskConfig *config.CassandraTaskConfig) {
+	s := newCassandraBenchmarkBase(taskConfig)
+	defer s.TearDownWorkflowStore()
+
+	namespaceID := uuid.New()
+	taskQueueInfo := s.leaseBenchmarkTaskQueue(b, namespaceID)
+
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		_, err := s.TaskMgr.CreateTasks(&p.CreateTasksRequest{
+			TaskQueueInfo: taskQueueInfo,
+			Tasks:         s.newBenchmarkTasks(namespaceID, benchmarkTasksPerCreate),
+		})
+		if err != nil {
+			b.Fatal(err)
+		}
+	}
+}
+
+func benchmarkGetTasks(b *testing.B, taskConfig *config.CassandraTaskConfig) {
+	s := newCassandraBenchmarkBase(taskConfig)
+	defer s.TearDownWorkflowStore()
+
+	namespaceID := uuid.New()
+	taskQueueInfo := s.leaseBenchmarkTaskQueue(b, namespaceID)
+	for created := 0; created < benchmarkTasksToRead; created += benchmarkTasksPerCreate {
+		_, err := s.TaskMgr.CreateTasks(&p.CreateTasksRequest{
+			TaskQueueInfo: taskQueueInfo,
+			Tasks:         s.newBenchmarkTasks(namespaceID, benchmarkTasksPerCreate),
+		})
+		if err != nil {
+			b.Fatal(err)
+		}
+	}
+
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		readLevel := int64(0)
+		for read := 0; read < benchmarkTasksToRead; {
+			response, err := s.TaskMgr.GetTasks(&p.GetTasksRequest{
+				NamespaceID:  namespaceID,
+				TaskQueue:    taskQueueInfo.Data.Name,
+				TaskType:     enumspb.TASK_QUEUE_TYPE_ACTIVITY,
+				ReadLevel:    readLevel,
+				MaxReadLevel: convert.Int64Ptr(math.MaxInt64),
+				BatchSize:    benchmarkReadBatchSize,
+			})
+			if err != nil {
+				b.Fatal(err)
+			}
+			if len(response.Tasks) == 0 {
+				b.Fatalf("expected %v tasks, read %v", benchmarkTasksToRead, read)
+			}
+			read += len(response.Tasks)
+			readLevel = response.Tasks[len(response.Tasks)-1].GetTaskId()
+		}
+	}
+}
+
+func newCassandraBenchmarkBase(taskConfig *config.CassandraTaskConfig) *TestBase {
+	s := NewTestBaseWithCassandra(&TestBaseOptions{CassandraTasks: taskConfig})
+	s.Setup()
+	return &s
+}
+
+func (s *TestBase) leaseBenchmarkTaskQueue(b *testing.B, namespaceID string) *p.PersistedTaskQueueInfo {
+	response, err := s.TaskMgr.LeaseTaskQueue(&p.LeaseTaskQueueRequest{
+		NamespaceID: namespaceID,
+		TaskQueue:   "benchmark-" + uuid.New(),
+		TaskType:    enumspb.TASK_QUEUE_TYPE_ACTIVITY,
+	})
+	if err != nil {
+		b.Fatal(err)
+	}
+	return response.TaskQueueInfo
+}
+
+func (s *TestBase) newBenchmarkTasks(namespaceID string, count int) []*persistenceblobs.AllocatedTaskInfo {
+	tasks := make([]*persistenceblobs.AllocatedTaskInfo, 0, count)
+	for i := 0; i < count; i++ {
+		tasks = append(tasks, &persistenceblobs.AllocatedTaskInfo{
+			TaskId: s.GetNextSequenceNumber(),
+			Data: &persistenceblobs.TaskInfo{
+				NamespaceId: namespaceID,
+				WorkflowId:  "benchmark-workflow",
+				RunId:       uuid.New(),
+				ScheduleId:  int64(i),
+				ExpiryTime:  timestamp.TimeNowPtrUtcAddSeconds(defaultScheduleToStartTimeout),
+				CreateTime:  timestamp.TimeNowPtrUtc(),
+			},
+		})
+	}
+	return tasks
+}
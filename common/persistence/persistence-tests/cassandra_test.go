@@ -28,6 +28,8 @@ import (
 	"testing"
 
 	"github.com/stretchr/testify/suite"
+
+	"go.temporal.io/server/common/service/config"
 )
 
 func TestCassandraHistoryV2Persistence(t *testing.T) {
@@ -44,6 +46,15 @@ func TestCassandraMatchingPersistence(t *testing.T) {
 	suite.Run(t, s)
 }
 
+func TestCassandraMatchingPersistenceBatchedWrites(t *testing.T) {
+	s := new(MatchingPersistenceSuite)
+	s.TestBase = NewTestBaseWithCassandra(&TestBaseOptions{
+		CassandraTasks: &config.CassandraTaskConfig{BatchedWrites: true, ReadPageSize: 2, ReadPrefetch: 0.5},
+	})
+	s.TestBase.Setup()
+	suite.Run(t, s)
+}
+
 func TestCassandraMetadataPersistenceV2(t *testing.T) {
 	s := new(MetadataPersistenceSuiteV2)
 	s.TestBase = NewTestBaseWithCassandra(&TestBaseOptions{})
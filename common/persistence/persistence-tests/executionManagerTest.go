@@ -55,6 +55,7 @@ import (
 	"go.temporal.io/server/common/payload"
 	"go.temporal.io/server/common/payloads"
 	p "go.temporal.io/server/common/persistence"
+	"go.temporal.io/server/common/persistence/serialization"
 	"go.temporal.io/server/common/primitives/timestamp"
 )
 
@@ -181,6 +182,68 @@ func (s *ExecutionManagerSuite) TestCreateWorkflowExecutionDeDup() {
 	s.IsType(&p.WorkflowExecutionAlreadyStartedError{}, err)
 }
 
+// TestWorkflowExecutionCompressedEncodings test
+func (s *ExecutionManagerSuite) TestWorkflowExecutionCompressedEncodings() {
+	namespaceID := uuid.New()
+	workflowID := "workflow-execution-compressed-encodings-test"
+	runID := "46ad5d63-7bc2-4c0a-a3a0-0f6a0d6a5b3e"
+	workflowExecution := commonpb.WorkflowExecution{
+		WorkflowId: workflowID,
+		RunId:      runID,
+	}
+	nextEventID := int64(3)
+
+	_, err := s.ExecutionManager.CreateWorkflowExecution(&p.CreateWorkflowExecutionRequest{
+		NewWorkflowSnapshot: p.WorkflowSnapshot{
+			ExecutionInfo: &p.WorkflowExecutionInfo{
+				NamespaceId:                namespaceID,
+				WorkflowId:                 workflowID,
+				TaskQueue:                  "some random taskqueue",
+				WorkflowTypeName:           "some random workflow type",
+				WorkflowRunTimeout:         timestamp.DurationFromSeconds(10),
+				DefaultWorkflowTaskTimeout: timestamp.DurationFromSeconds(14),
+				LastFirstEventId:           common.FirstEventID,
+				NextEventId:                nextEventID,
+				ExecutionState: &persistenceblobs.WorkflowExecutionState{
+					RunId:           runID,
+					CreateRequestId: uuid.New(),
+					State:           enumsspb.WORKFLOW_EXECUTION_STATE_CREATED,
+					Status:          enumspb.WORKFLOW_EXECUTION_STATUS_RUNNING,
+				},
+			},
+			ExecutionStats:        &persistenceblobs.ExecutionStats{},
+			ExecutionInfoEncoding: serialization.EncodingTypeProto3Zstd,
+		},
+		RangeID: s.ShardInfo.GetRangeId(),
+		Mode:    p.CreateWorkflowModeBrandNew,
+	})
+	s.NoError(err)
+
+	info, err := s.GetWorkflowExecutionInfo(namespaceID, workflowExecution)
+	s.NoError(err)
+	s.Equal("some random taskqueue", info.ExecutionInfo.TaskQueue)
+
+	updatedInfo := copyWorkflowExecutionInfo(info.ExecutionInfo)
+	updatedInfo.TaskQueue = "another random taskqueue"
+	updatedInfo.NextEventId = nextEventID + 2
+	_, err = s.ExecutionManager.UpdateWorkflowExecution(&p.UpdateWorkflowExecutionRequest{
+		UpdateWorkflowMutation: p.WorkflowMutation{
+			ExecutionInfo:         updatedInfo,
+			ExecutionStats:        copyExecutionStats(info.ExecutionStats),
+			ExecutionInfoEncoding: serialization.EncodingTypeProto3Snappy,
+			Condition:             nextEventID,
+		},
+		RangeID: s.ShardInfo.GetRangeId(),
+		Mode:    p.UpdateWorkflowModeUpdateCurrent,
+	})
+	s.NoError(err)
+
+	info, err = s.GetWorkflowExecutionInfo(namespaceID, workflowExecution)
+	s.NoError(err)
+	s.Equal("another random taskqueue", info.ExecutionInfo.TaskQueue)
+	s.Equal(nextEventID+2, info.ExecutionInfo.NextEventId)
+}
+
 // TestCreateWorkflowExecutionStateStatus test
 func (s *ExecutionManagerSuite) TestCreateWorkflowExecutionStateStatus() {
 	namespaceID := uuid.New()
@@ -37,6 +37,7 @@ import (
 	log "github.com/sirupsen/logrus"
 	"github.com/stretchr/testify/require"
 	"github.com/stretchr/testify/suite"
+	enumspb "go.temporal.io/api/enums/v1"
 	historypb "go.temporal.io/api/history/v1"
 	"go.temporal.io/api/serviceerror"
 
@@ -44,6 +45,7 @@ import (
 	"go.temporal.io/server/common/backoff"
 	"go.temporal.io/server/common/convert"
 	p "go.temporal.io/server/common/persistence"
+	"go.temporal.io/server/common/persistence/serialization"
 )
 
 type (
@@ -120,17 +122,24 @@ func (s *HistoryV2PersistenceSuite) TestGenUUIDs() {
 
 // TestScanAllTrees test
 func (s *HistoryV2PersistenceSuite) TestScanAllTrees() {
-	// TODO https://go.temporal.io/server/issues/2458
-	if s.HistoryV2Mgr.GetName() != "cassandra" {
-		return
+	// other tests in this suite do not clean up their trees, so remember them up front
+	existingTrees := map[string]bool{}
+	var pgToken []byte
+	for {
+		resp, err := s.HistoryV2Mgr.GetAllHistoryTreeBranches(&p.GetAllHistoryTreeBranchesRequest{
+			PageSize:      100,
+			NextPageToken: pgToken,
+		})
+		s.Nil(err)
+		for _, br := range resp.Branches {
+			existingTrees[br.TreeID] = true
+		}
+		if len(resp.NextPageToken) == 0 {
+			break
+		}
+		pgToken = resp.NextPageToken
 	}
 
-	resp, err := s.HistoryV2Mgr.GetAllHistoryTreeBranches(&p.GetAllHistoryTreeBranchesRequest{
-		PageSize: 1,
-	})
-	s.Nil(err)
-	s.Equal(0, len(resp.Branches), "some trees were leaked in other tests")
-
 	trees := map[string]bool{}
 	totalTrees := 1002
 	pgSize := 100
@@ -146,7 +155,7 @@ func (s *HistoryV2PersistenceSuite) TestScanAllTrees() {
 		trees[string(treeID)] = true
 	}
 
-	var pgToken []byte
+	pgToken = nil
 	for {
 		resp, err := s.HistoryV2Mgr.GetAllHistoryTreeBranches(&p.GetAllHistoryTreeBranchesRequest{
 			PageSize:      pgSize,
@@ -161,7 +170,7 @@ func (s *HistoryV2PersistenceSuite) TestScanAllTrees() {
 				s.True(br.ForkTime.UnixNano() > 0)
 				s.True(len(br.BranchID) > 0)
 				s.Equal("branchInfo", br.Info)
-			} else {
+			} else if !existingTrees[uuidTreeId] {
 				s.Fail("treeID not found", br.TreeID)
 			}
 		}
@@ -365,6 +374,54 @@ func (s *HistoryV2PersistenceSuite) TestReadBranchByPagination() {
 }
 
 // TestConcurrentlyCreateAndAppendBranches test
+func (s *HistoryV2PersistenceSuite) TestReadBranchWithMixedEncodings() {
+	treeID := uuid.NewRandom().String()
+	bi, err := s.newHistoryBranch(treeID)
+	s.Nil(err)
+
+	encodings := []enumspb.EncodingType{
+		enumspb.ENCODING_TYPE_UNSPECIFIED,
+		serialization.EncodingTypeProto3Snappy,
+		enumspb.ENCODING_TYPE_PROTO3,
+		serialization.EncodingTypeProto3Zstd,
+	}
+	var written []*historypb.HistoryEvent
+	for i, encoding := range encodings {
+		events := s.genRandomEvents([]int64{int64(2*i + 1), int64(2*i + 2)}, 1)
+		resp, err := s.HistoryV2Mgr.AppendHistoryNodes(&p.AppendHistoryNodesRequest{
+			IsNewBranch:   i == 0,
+			BranchToken:   bi,
+			Events:        events,
+			TransactionID: int64(i + 1),
+			ShardID:       convert.Int32Ptr(s.ShardInfo.GetShardId()),
+			Encoding:      encoding,
+		})
+		s.Nil(err)
+		s.True(resp.Size > 0)
+		written = append(written, events...)
+	}
+
+	events := s.read(bi, 1, int64(2*len(encodings)+1))
+	s.Equal(len(written), len(events))
+	for i, e := range events {
+		s.Equal(written[i].GetEventId(), e.GetEventId())
+	}
+
+	// raw history never exposes the compressed encodings
+	rawResp, err := s.HistoryV2Mgr.ReadRawHistoryBranch(&p.ReadHistoryBranchRequest{
+		BranchToken: bi,
+		MinEventID:  1,
+		MaxEventID:  int64(2*len(encodings) + 1),
+		PageSize:    len(encodings),
+		ShardID:     convert.Int32Ptr(s.ShardInfo.GetShardId()),
+	})
+	s.Nil(err)
+	s.Equal(len(encodings), len(rawResp.HistoryEventBlobs))
+	for _, blob := range rawResp.HistoryEventBlobs {
+		s.Equal(enumspb.ENCODING_TYPE_PROTO3, blob.Encoding)
+	}
+}
+
 func (s *HistoryV2PersistenceSuite) TestConcurrentlyCreateAndAppendBranches() {
 	treeID := uuid.NewRandom().String()
 	wg := sync.WaitGroup{}
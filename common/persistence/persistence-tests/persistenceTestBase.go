@@ -55,9 +55,11 @@ import (
 	p "go.temporal.io/server/common/persistence"
 	"go.temporal.io/server/common/persistence/cassandra"
 	"go.temporal.io/server/common/persistence/client"
+	"go.temporal.io/server/common/persistence/memory"
 	"go.temporal.io/server/common/persistence/sql"
 	"go.temporal.io/server/common/persistence/sql/sqlplugin/mysql"
 	"go.temporal.io/server/common/persistence/sql/sqlplugin/postgresql"
+	"go.temporal.io/server/common/persistence/sql/sqlplugin/sqlite"
 	"go.temporal.io/server/common/primitives/timestamp"
 	"go.temporal.io/server/common/service/config"
 	"go.temporal.io/server/environment"
@@ -80,6 +82,8 @@ type (
 		StoreType       string           `yaml:"-"`
 		SchemaDir       string           `yaml:"-"`
 		ClusterMetadata cluster.Metadata `yaml:"-"`
+		// CassandraTasks configures how the cassandra task store writes and reads tasks
+		CassandraTasks *config.CassandraTaskConfig `yaml:"-"`
 	}
 
 	// TestBase wraps the base setup needed to create workflows over persistence layer.
@@ -132,6 +136,7 @@ func NewTestBaseWithCassandra(options *TestBaseOptions) TestBase {
 		panic(err)
 	}
 	testCluster := cassandra.NewTestCluster(options.DBName, options.DBUsername, options.DBPassword, options.DBHost, options.DBPort, options.SchemaDir, logger)
+	testCluster.SetTaskConfig(options.CassandraTasks)
 	return newTestBase(options, testCluster, logger)
 }
 
@@ -151,6 +156,8 @@ func NewTestBaseWithSQL(options *TestBaseOptions) TestBase {
 			options.DBPort = environment.GetMySQLPort()
 		case postgresql.PluginName:
 			options.DBPort = environment.GetPostgreSQLPort()
+		case sqlite.PluginName:
+			// sqlite is embedded and does not listen on a port
 		default:
 			panic(fmt.Sprintf("unknown sql store drier: %v", options.SQLDBPluginName))
 		}
@@ -161,6 +168,8 @@ func NewTestBaseWithSQL(options *TestBaseOptions) TestBase {
 			options.DBHost = environment.GetMySQLAddress()
 		case postgresql.PluginName:
 			options.DBHost = environment.GetPostgreSQLAddress()
+		case sqlite.PluginName:
+			options.DBHost = "localhost"
 		default:
 			panic(fmt.Sprintf("unknown sql store drier: %v", options.SQLDBPluginName))
 		}
@@ -169,13 +178,27 @@ func NewTestBaseWithSQL(options *TestBaseOptions) TestBase {
 	return newTestBase(options, testCluster, logger)
 }
 
-// NewTestBase returns a persistence test base backed by either cassandra or sql
+// NewTestBaseWithMemory returns a new persistence test base backed by in-memory tables
+func NewTestBaseWithMemory(options *TestBaseOptions) TestBase {
+	logger, err := loggerimpl.NewDevelopment()
+	if err != nil {
+		panic(err)
+	}
+	testCluster := memory.NewTestCluster(logger)
+	base := newTestBase(options, testCluster, logger)
+	base.AbstractDataStoreFactory = memory.NewAbstractDataStoreFactory()
+	return base
+}
+
+// NewTestBase returns a persistence test base backed by either cassandra, sql or memory
 func NewTestBase(options *TestBaseOptions) TestBase {
 	switch options.StoreType {
 	case config.StoreTypeSQL:
 		return NewTestBaseWithSQL(options)
 	case config.StoreTypeNoSQL:
 		return NewTestBaseWithCassandra(options)
+	case config.StoreTypeMemory:
+		return NewTestBaseWithMemory(options)
 	default:
 		panic("invalid storeType " + options.StoreType)
 	}
@@ -222,7 +245,7 @@ func (s *TestBase) Setup() {
 	cfg := s.DefaultTestCluster.Config()
 	scope := tally.NewTestScope(common.HistoryServiceName, make(map[string]string))
 	metricsClient := metrics.NewClient(scope, metrics.GetMetricsServiceIdx(common.HistoryServiceName, s.logger))
-	factory := client.NewFactory(&cfg, nil, s.AbstractDataStoreFactory, clusterName, metricsClient, s.logger)
+	factory := client.NewFactory(&cfg, nil, nil, s.AbstractDataStoreFactory, clusterName, metricsClient, s.logger)
 
 	s.TaskMgr, err = factory.NewTaskManager()
 	s.fatalOnError("NewTaskManager", err)
@@ -246,7 +269,7 @@ func (s *TestBase) Setup() {
 	visibilityFactory := factory
 	if s.VisibilityTestCluster != s.DefaultTestCluster {
 		vCfg := s.VisibilityTestCluster.Config()
-		visibilityFactory = client.NewFactory(&vCfg, nil, nil, clusterName, nil, s.logger)
+		visibilityFactory = client.NewFactory(&vCfg, nil, nil, nil, clusterName, nil, s.logger)
 	}
 	// SQL currently doesn't have support for visibility manager
 	s.VisibilityMgr, err = visibilityFactory.NewVisibilityManager()
@@ -716,9 +716,9 @@ func (s *VisibilityPersistenceSuite) TestUpsertWorkflowExecution() {
 				SearchAttributes:   nil,
 				Status:             enumspb.WORKFLOW_EXECUTION_STATUS_RUNNING,
 			},
-			// To avoid blocking the task queue processors on non-ElasticSearch visibility stores
+			// To avoid blocking the task queue processors on Cassandra visibility stores
 			// we simply treat any attempts to perform Upserts as "no-ops"
-			// Attempts to Scan, Count or List will still fail for non-ES stores.
+			// SQL visibility stores update the search attributes of the execution.
 			expected: nil,
 		},
 	}
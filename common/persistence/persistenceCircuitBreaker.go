@@ -0,0 +1,380 @@
+// The MIT License
+//
+// Copyright (c) 2020 Temporal Technologies Inc.  All rights reserved.
+//
+// Copyright (c) 2020 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package persistence
+
+import (
+	"math"
+	"sync"
+	"time"
+
+	"go.temporal.io/api/serviceerror"
+
+	"go.temporal.io/server/common/clock"
+	"go.temporal.io/server/common/metrics"
+	"go.temporal.io/server/common/service/dynamicconfig"
+)
+
+const (
+	circuitBreakerStateClosed circuitBreakerState = iota
+	circuitBreakerStateOpen
+	circuitBreakerStateHalfOpen
+)
+
+const (
+	// weight of a single latency sample in the long term average latency
+	concurrencyLimitLatencySmoothing = 0.01
+	// weight of a single limit update, updates are smoothed to absorb latency outliers
+	concurrencyLimitSmoothing = 0.2
+	// the limit is cut by this ratio for each failed request
+	concurrencyLimitBackoffRatio = 0.9
+	// lower bound of the latency gradient, the limit is at most halved by a single update
+	concurrencyLimitMinGradient = 0.5
+)
+
+var (
+	// ErrPersistenceCircuitBreakerOpen is the error indicating the circuit breaker of the persistence manager
+	// or of the operation is open, the request is rejected without reaching the datastore.
+	ErrPersistenceCircuitBreakerOpen = serviceerror.NewUnavailable("Persistence Circuit Breaker Open.")
+	// ErrPersistenceConcurrencyLimitExceeded is the error indicating the adaptive concurrency limit reached.
+	ErrPersistenceConcurrencyLimitExceeded = serviceerror.NewUnavailable("Persistence Max Concurrency Reached.")
+)
+
+type (
+	// CircuitBreakerConfig is the config of the persistence circuit breakers and adaptive concurrency limiters
+	CircuitBreakerConfig struct {
+		CircuitBreakerEnabled             dynamicconfig.BoolPropertyFn
+		CircuitBreakerWindow              dynamicconfig.DurationPropertyFn
+		CircuitBreakerMinRequests         dynamicconfig.IntPropertyFn
+		CircuitBreakerErrorRatio          dynamicconfig.FloatPropertyFn
+		CircuitBreakerOpenTimeout         dynamicconfig.DurationPropertyFn
+		CircuitBreakerHalfOpenMaxRequests dynamicconfig.IntPropertyFn
+
+		ConcurrencyLimitEnabled          dynamicconfig.BoolPropertyFn
+		ConcurrencyLimitMin              dynamicconfig.IntPropertyFn
+		ConcurrencyLimitMax              dynamicconfig.IntPropertyFn
+		ConcurrencyLimitLatencyTolerance dynamicconfig.FloatPropertyFn
+	}
+
+	circuitBreakerState int
+
+	// circuitBreaker trips open once the ratio of failed requests within a window exceeds the threshold,
+	// after the open timeout a limited number of probe requests are let through in half open state, the
+	// breaker closes once they all succeed and opens again on the first failure
+	circuitBreaker struct {
+		sync.Mutex
+		config        *CircuitBreakerConfig
+		onStateChange func(scope int, state circuitBreakerState)
+
+		state       circuitBreakerState
+		generation  int64
+		windowStart time.Time
+		openedAt    time.Time
+		requests    int
+		failures    int
+		probes      int
+		successes   int
+	}
+
+	// concurrencyLimiter limits the number of inflight requests, the limit grows while the latency stays close
+	// to the long term average latency and shrinks as the latency increases or requests fail
+	concurrencyLimiter struct {
+		sync.Mutex
+		config *CircuitBreakerConfig
+
+		limit       float64
+		inflight    int
+		longLatency float64
+	}
+
+	// managerCircuitBreaker guards all the operations of a persistence manager with a manager circuit breaker,
+	// a circuit breaker per operation and an adaptive concurrency limiter
+	managerCircuitBreaker struct {
+		config       *CircuitBreakerConfig
+		metricClient metrics.Client
+		timeSource   clock.TimeSource
+
+		manager *circuitBreaker
+		limiter *concurrencyLimiter
+
+		sync.RWMutex
+		operations map[int]*circuitBreaker
+	}
+)
+
+// NewCircuitBreakerConfig creates the persistence circuit breaker config from dynamic config,
+// both the circuit breakers and the concurrency limiters are disabled by default
+func NewCircuitBreakerConfig(dc *dynamicconfig.Collection) *CircuitBreakerConfig {
+	return &CircuitBreakerConfig{
+		CircuitBreakerEnabled:             dc.GetBoolProperty(dynamicconfig.PersistenceCircuitBreakerEnabled, false),
+		CircuitBreakerWindow:              dc.GetDurationProperty(dynamicconfig.PersistenceCircuitBreakerWindow, 10*time.Second),
+		CircuitBreakerMinRequests:         dc.GetIntProperty(dynamicconfig.PersistenceCircuitBreakerMinRequests, 20),
+		CircuitBreakerErrorRatio:          dc.GetFloat64Property(dynamicconfig.PersistenceCircuitBreakerErrorRatio, 0.5),
+		CircuitBreakerOpenTimeout:         dc.GetDurationProperty(dynamicconfig.PersistenceCircuitBreakerOpenTimeout, 5*time.Second),
+		CircuitBreakerHalfOpenMaxRequests: dc.GetIntProperty(dynamicconfig.PersistenceCircuitBreakerHalfOpenMaxRequests, 5),
+
+		ConcurrencyLimitEnabled:          dc.GetBoolProperty(dynamicconfig.PersistenceConcurrencyLimitEnabled, false),
+		ConcurrencyLimitMin:              dc.GetIntProperty(dynamicconfig.PersistenceConcurrencyLimitMin, 10),
+		ConcurrencyLimitMax:              dc.GetIntProperty(dynamicconfig.PersistenceConcurrencyLimitMax, 1000),
+		ConcurrencyLimitLatencyTolerance: dc.GetFloat64Property(dynamicconfig.PersistenceConcurrencyLimitLatencyTolerance, 2.0),
+	}
+}
+
+func newManagerCircuitBreaker(
+	config *CircuitBreakerConfig,
+	metricClient metrics.Client,
+	timeSource clock.TimeSource,
+) *managerCircuitBreaker {
+	m := &managerCircuitBreaker{
+		config:       config,
+		metricClient: metricClient,
+		timeSource:   timeSource,
+		limiter:      newConcurrencyLimiter(config),
+		operations:   make(map[int]*circuitBreaker),
+	}
+	m.manager = newCircuitBreaker(config, func(scope int, state circuitBreakerState) {
+		emitCircuitBreakerStateMetric(m.metricClient, scope, metrics.ManagerCircuitBreakerTagValue, state)
+	})
+	return m
+}
+
+// acquire admits a request of the operation identified by its metrics scope,
+// the returned release func must be called with the result of the request
+func (m *managerCircuitBreaker) acquire(scope int) (func(error), error) {
+	breakerEnabled := m.config.CircuitBreakerEnabled()
+	limiterEnabled := m.config.ConcurrencyLimitEnabled()
+	if !breakerEnabled && !limiterEnabled {
+		return func(error) {}, nil
+	}
+
+	now := m.timeSource.Now()
+	var operation *circuitBreaker
+	var managerGeneration, operationGeneration int64
+	if breakerEnabled {
+		operation = m.getOperationCircuitBreaker(scope)
+		ok := false
+		if managerGeneration, ok = m.manager.allow(scope, now); !ok {
+			return nil, ErrPersistenceCircuitBreakerOpen
+		}
+		if operationGeneration, ok = operation.allow(scope, now); !ok {
+			m.manager.cancel(managerGeneration)
+			return nil, ErrPersistenceCircuitBreakerOpen
+		}
+	}
+	if limiterEnabled && !m.limiter.acquire() {
+		if breakerEnabled {
+			m.manager.cancel(managerGeneration)
+			operation.cancel(operationGeneration)
+		}
+		return nil, ErrPersistenceConcurrencyLimitExceeded
+	}
+
+	return func(err error) {
+		failed := isPersistenceFailure(err)
+		end := m.timeSource.Now()
+		if limiterEnabled {
+			m.limiter.release(end.Sub(now), failed)
+		}
+		if breakerEnabled {
+			m.manager.record(scope, managerGeneration, end, failed)
+			operation.record(scope, operationGeneration, end, failed)
+		}
+	}, nil
+}
+
+func (m *managerCircuitBreaker) getOperationCircuitBreaker(scope int) *circuitBreaker {
+	m.RLock()
+	breaker, ok := m.operations[scope]
+	m.RUnlock()
+	if ok {
+		return breaker
+	}
+
+	m.Lock()
+	defer m.Unlock()
+	if breaker, ok = m.operations[scope]; ok {
+		return breaker
+	}
+	breaker = newCircuitBreaker(m.config, func(scope int, state circuitBreakerState) {
+		emitCircuitBreakerStateMetric(m.metricClient, scope, metrics.OperationCircuitBreakerTagValue, state)
+	})
+	m.operations[scope] = breaker
+	return breaker
+}
+
+func newCircuitBreaker(
+	config *CircuitBreakerConfig,
+	onStateChange func(scope int, state circuitBreakerState),
+) *circuitBreaker {
+	return &circuitBreaker{
+		config:        config,
+		onStateChange: onStateChange,
+		state:         circuitBreakerStateClosed,
+	}
+}
+
+// allow returns the generation of the breaker state the request was admitted in,
+// results of requests admitted before the last state transition are ignored
+func (b *circuitBreaker) allow(scope int, now time.Time) (int64, bool) {
+	b.Lock()
+	defer b.Unlock()
+
+	switch b.state {
+	case circuitBreakerStateOpen:
+		if now.Sub(b.openedAt) < b.config.CircuitBreakerOpenTimeout() {
+			return 0, false
+		}
+		b.transition(scope, now, circuitBreakerStateHalfOpen)
+		fallthrough
+	case circuitBreakerStateHalfOpen:
+		if b.probes+b.successes >= b.config.CircuitBreakerHalfOpenMaxRequests() {
+			return 0, false
+		}
+		b.probes++
+	}
+	return b.generation, true
+}
+
+// cancel releases a request which was admitted but never sent
+func (b *circuitBreaker) cancel(generation int64) {
+	b.Lock()
+	defer b.Unlock()
+
+	if generation == b.generation && b.state == circuitBreakerStateHalfOpen {
+		b.probes--
+	}
+}
+
+func (b *circuitBreaker) record(scope int, generation int64, now time.Time, failed bool) {
+	b.Lock()
+	defer b.Unlock()
+
+	if generation != b.generation {
+		return
+	}
+
+	switch b.state {
+	case circuitBreakerStateClosed:
+		if now.Sub(b.windowStart) >= b.config.CircuitBreakerWindow() {
+			b.windowStart = now
+			b.requests = 0
+			b.failures = 0
+		}
+		b.requests++
+		if !failed {
+			return
+		}
+		b.failures++
+		if b.requests >= b.config.CircuitBreakerMinRequests() &&
+			float64(b.failures) >= float64(b.requests)*b.config.CircuitBreakerErrorRatio() {
+			b.transition(scope, now, circuitBreakerStateOpen)
+		}
+	case circuitBreakerStateHalfOpen:
+		b.probes--
+		if failed {
+			b.transition(scope, now, circuitBreakerStateOpen)
+			return
+		}
+		b.successes++
+		if b.successes >= b.config.CircuitBreakerHalfOpenMaxRequests() {
+			b.transition(scope, now, circuitBreakerStateClosed)
+		}
+	}
+}
+
+func (b *circuitBreaker) transition(scope int, now time.Time, state circuitBreakerState) {
+	b.state = state
+	b.generation++
+	b.windowStart = now
+	b.openedAt = now
+	b.requests = 0
+	b.failures = 0
+	b.probes = 0
+	b.successes = 0
+	b.onStateChange(scope, state)
+}
+
+func newConcurrencyLimiter(config *CircuitBreakerConfig) *concurrencyLimiter {
+	return &concurrencyLimiter{
+		config: config,
+		limit:  float64(config.ConcurrencyLimitMax()),
+	}
+}
+
+func (l *concurrencyLimiter) acquire() bool {
+	l.Lock()
+	defer l.Unlock()
+
+	l.limit = l.clamp(l.limit)
+	if l.inflight >= int(l.limit) {
+		return false
+	}
+	l.inflight++
+	return true
+}
+
+func (l *concurrencyLimiter) release(latency time.Duration, failed bool) {
+	l.Lock()
+	defer l.Unlock()
+
+	inflight := l.inflight
+	l.inflight--
+	if failed {
+		l.limit = l.clamp(l.limit * concurrencyLimitBackoffRatio)
+		return
+	}
+
+	sample := math.Max(float64(latency), 1)
+	if l.longLatency == 0 {
+		l.longLatency = sample
+	} else {
+		l.longLatency += (sample - l.longLatency) * concurrencyLimitLatencySmoothing
+	}
+
+	gradient := math.Max(concurrencyLimitMinGradient, math.Min(1, l.config.ConcurrencyLimitLatencyTolerance()*l.longLatency/sample))
+	if gradient == 1 && float64(inflight) < l.limit/2 {
+		// the limit is not grown while it is far from being reached
+		return
+	}
+	limit := l.limit*gradient + math.Sqrt(l.limit)
+	l.limit = l.clamp(l.limit*(1-concurrencyLimitSmoothing) + limit*concurrencyLimitSmoothing)
+}
+
+func (l *concurrencyLimiter) clamp(limit float64) float64 {
+	return math.Max(float64(l.config.ConcurrencyLimitMin()), math.Min(float64(l.config.ConcurrencyLimitMax()), limit))
+}
+
+// isPersistenceFailure returns true for the errors which indicate that the datastore is unhealthy,
+// errors caused by the request itself, e.g. condition failures, never trip the circuit breakers
+func isPersistenceFailure(err error) bool {
+	switch err.(type) {
+	case *serviceerror.Internal,
+		*serviceerror.Unavailable,
+		*serviceerror.DeadlineExceeded,
+		*TimeoutError:
+		return true
+	default:
+		return false
+	}
+}
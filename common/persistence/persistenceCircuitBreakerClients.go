@@ -0,0 +1,1153 @@
+// The MIT License
+//
+// Copyright (c) 2020 Temporal Technologies Inc.  All rights reserved.
+//
+// Copyright (c) 2020 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package persistence
+
+import (
+	"go.temporal.io/server/common/clock"
+	"go.temporal.io/server/common/log"
+	"go.temporal.io/server/common/metrics"
+)
+
+type (
+	shardCircuitBreakerPersistenceClient struct {
+		breaker     *managerCircuitBreaker
+		persistence ShardManager
+		logger      log.Logger
+	}
+
+	workflowExecutionCircuitBreakerPersistenceClient struct {
+		breaker     *managerCircuitBreaker
+		persistence ExecutionManager
+		logger      log.Logger
+	}
+
+	taskCircuitBreakerPersistenceClient struct {
+		breaker     *managerCircuitBreaker
+		persistence TaskManager
+		logger      log.Logger
+	}
+
+	historyV2CircuitBreakerPersistenceClient struct {
+		breaker     *managerCircuitBreaker
+		persistence HistoryManager
+		logger      log.Logger
+	}
+
+	metadataCircuitBreakerPersistenceClient struct {
+		breaker     *managerCircuitBreaker
+		persistence MetadataManager
+		logger      log.Logger
+	}
+
+	clusterMetadataCircuitBreakerPersistenceClient struct {
+		breaker     *managerCircuitBreaker
+		persistence ClusterMetadataManager
+		logger      log.Logger
+	}
+
+	visibilityCircuitBreakerPersistenceClient struct {
+		breaker     *managerCircuitBreaker
+		persistence VisibilityManager
+		logger      log.Logger
+	}
+
+	queueCircuitBreakerPersistenceClient struct {
+		breaker     *managerCircuitBreaker
+		persistence Queue
+		logger      log.Logger
+	}
+)
+
+var _ ShardManager = (*shardCircuitBreakerPersistenceClient)(nil)
+var _ ExecutionManager = (*workflowExecutionCircuitBreakerPersistenceClient)(nil)
+var _ TaskManager = (*taskCircuitBreakerPersistenceClient)(nil)
+var _ HistoryManager = (*historyV2CircuitBreakerPersistenceClient)(nil)
+var _ MetadataManager = (*metadataCircuitBreakerPersistenceClient)(nil)
+var _ ClusterMetadataManager = (*clusterMetadataCircuitBreakerPersistenceClient)(nil)
+var _ VisibilityManager = (*visibilityCircuitBreakerPersistenceClient)(nil)
+var _ Queue = (*queueCircuitBreakerPersistenceClient)(nil)
+
+// NewShardPersistenceCircuitBreakerClient creates a client to manage shards
+func NewShardPersistenceCircuitBreakerClient(persistence ShardManager, config *CircuitBreakerConfig, metricClient metrics.Client, logger log.Logger) ShardManager {
+	return &shardCircuitBreakerPersistenceClient{
+		persistence: persistence,
+		breaker:     newManagerCircuitBreaker(config, metricClient, clock.NewRealTimeSource()),
+		logger:      logger,
+	}
+}
+
+// NewWorkflowExecutionPersistenceCircuitBreakerClient creates a client to manage executions
+func NewWorkflowExecutionPersistenceCircuitBreakerClient(persistence ExecutionManager, config *CircuitBreakerConfig, metricClient metrics.Client, logger log.Logger) ExecutionManager {
+	return &workflowExecutionCircuitBreakerPersistenceClient{
+		persistence: persistence,
+		breaker:     newManagerCircuitBreaker(config, metricClient, clock.NewRealTimeSource()),
+		logger:      logger,
+	}
+}
+
+// NewTaskPersistenceCircuitBreakerClient creates a client to manage tasks
+func NewTaskPersistenceCircuitBreakerClient(persistence TaskManager, config *CircuitBreakerConfig, metricClient metrics.Client, logger log.Logger) TaskManager {
+	return &taskCircuitBreakerPersistenceClient{
+		persistence: persistence,
+		breaker:     newManagerCircuitBreaker(config, metricClient, clock.NewRealTimeSource()),
+		logger:      logger,
+	}
+}
+
+// NewHistoryV2PersistenceCircuitBreakerClient creates a HistoryManager client to manage workflow execution history
+func NewHistoryV2PersistenceCircuitBreakerClient(persistence HistoryManager, config *CircuitBreakerConfig, metricClient metrics.Client, logger log.Logger) HistoryManager {
+	return &historyV2CircuitBreakerPersistenceClient{
+		persistence: persistence,
+		breaker:     newManagerCircuitBreaker(config, metricClient, clock.NewRealTimeSource()),
+		logger:      logger,
+	}
+}
+
+// NewMetadataPersistenceCircuitBreakerClient creates a MetadataManager client to manage metadata
+func NewMetadataPersistenceCircuitBreakerClient(persistence MetadataManager, config *CircuitBreakerConfig, metricClient metrics.Client, logger log.Logger) MetadataManager {
+	return &metadataCircuitBreakerPersistenceClient{
+		persistence: persistence,
+		breaker:     newManagerCircuitBreaker(config, metricClient, clock.NewRealTimeSource()),
+		logger:      logger,
+	}
+}
+
+// NewClusterMetadataPersistenceCircuitBreakerClient creates a MetadataManager client to manage metadata
+func NewClusterMetadataPersistenceCircuitBreakerClient(persistence ClusterMetadataManager, config *CircuitBreakerConfig, metricClient metrics.Client, logger log.Logger) ClusterMetadataManager {
+	return &clusterMetadataCircuitBreakerPersistenceClient{
+		persistence: persistence,
+		breaker:     newManagerCircuitBreaker(config, metricClient, clock.NewRealTimeSource()),
+		logger:      logger,
+	}
+}
+
+// NewVisibilityPersistenceCircuitBreakerClient creates a client to manage visibility
+func NewVisibilityPersistenceCircuitBreakerClient(persistence VisibilityManager, config *CircuitBreakerConfig, metricClient metrics.Client, logger log.Logger) VisibilityManager {
+	return &visibilityCircuitBreakerPersistenceClient{
+		persistence: persistence,
+		breaker:     newManagerCircuitBreaker(config, metricClient, clock.NewRealTimeSource()),
+		logger:      logger,
+	}
+}
+
+// NewQueuePersistenceCircuitBreakerClient creates a client to manage queue
+func NewQueuePersistenceCircuitBreakerClient(persistence Queue, config *CircuitBreakerConfig, metricClient metrics.Client, logger log.Logger) Queue {
+	return &queueCircuitBreakerPersistenceClient{
+		persistence: persistence,
+		breaker:     newManagerCircuitBreaker(config, metricClient, clock.NewRealTimeSource()),
+		logger:      logger,
+	}
+}
+
+func (p *shardCircuitBreakerPersistenceClient) GetName() string {
+	return p.persistence.GetName()
+}
+
+func (p *shardCircuitBreakerPersistenceClient) CreateShard(request *CreateShardRequest) error {
+	release, err := p.breaker.acquire(metrics.PersistenceCreateShardScope)
+	if err != nil {
+		return err
+	}
+
+	err = p.persistence.CreateShard(request)
+	release(err)
+	return err
+}
+
+func (p *shardCircuitBreakerPersistenceClient) GetShard(request *GetShardRequest) (*GetShardResponse, error) {
+	release, err := p.breaker.acquire(metrics.PersistenceGetShardScope)
+	if err != nil {
+		return nil, err
+	}
+
+	response, err := p.persistence.GetShard(request)
+	release(err)
+	return response, err
+}
+
+func (p *shardCircuitBreakerPersistenceClient) UpdateShard(request *UpdateShardRequest) error {
+	release, err := p.breaker.acquire(metrics.PersistenceUpdateShardScope)
+	if err != nil {
+		return err
+	}
+
+	err = p.persistence.UpdateShard(request)
+	release(err)
+	return err
+}
+
+func (p *shardCircuitBreakerPersistenceClient) Close() {
+	p.persistence.Close()
+}
+
+func (p *workflowExecutionCircuitBreakerPersistenceClient) GetName() string {
+	return p.persistence.GetName()
+}
+
+func (p *workflowExecutionCircuitBreakerPersistenceClient) GetShardID() int32 {
+	return p.persistence.GetShardID()
+}
+
+func (p *workflowExecutionCircuitBreakerPersistenceClient) CreateWorkflowExecution(request *CreateWorkflowExecutionRequest) (*CreateWorkflowExecutionResponse, error) {
+	release, err := p.breaker.acquire(metrics.PersistenceCreateWorkflowExecutionScope)
+	if err != nil {
+		return nil, err
+	}
+
+	response, err := p.persistence.CreateWorkflowExecution(request)
+	release(err)
+	return response, err
+}
+
+func (p *workflowExecutionCircuitBreakerPersistenceClient) GetWorkflowExecution(request *GetWorkflowExecutionRequest) (*GetWorkflowExecutionResponse, error) {
+	release, err := p.breaker.acquire(metrics.PersistenceGetWorkflowExecutionScope)
+	if err != nil {
+		return nil, err
+	}
+
+	response, err := p.persistence.GetWorkflowExecution(request)
+	release(err)
+	return response, err
+}
+
+func (p *workflowExecutionCircuitBreakerPersistenceClient) UpdateWorkflowExecution(request *UpdateWorkflowExecutionRequest) (*UpdateWorkflowExecutionResponse, error) {
+	release, err := p.breaker.acquire(metrics.PersistenceUpdateWorkflowExecutionScope)
+	if err != nil {
+		return nil, err
+	}
+
+	response, err := p.persistence.UpdateWorkflowExecution(request)
+	release(err)
+	return response, err
+}
+
+func (p *workflowExecutionCircuitBreakerPersistenceClient) ConflictResolveWorkflowExecution(request *ConflictResolveWorkflowExecutionRequest) error {
+	release, err := p.breaker.acquire(metrics.PersistenceConflictResolveWorkflowExecutionScope)
+	if err != nil {
+		return err
+	}
+
+	err = p.persistence.ConflictResolveWorkflowExecution(request)
+	release(err)
+	return err
+}
+
+func (p *workflowExecutionCircuitBreakerPersistenceClient) ResetWorkflowExecution(request *ResetWorkflowExecutionRequest) error {
+	release, err := p.breaker.acquire(metrics.PersistenceResetWorkflowExecutionScope)
+	if err != nil {
+		return err
+	}
+
+	err = p.persistence.ResetWorkflowExecution(request)
+	release(err)
+	return err
+}
+
+func (p *workflowExecutionCircuitBreakerPersistenceClient) DeleteWorkflowExecution(request *DeleteWorkflowExecutionRequest) error {
+	release, err := p.breaker.acquire(metrics.PersistenceDeleteWorkflowExecutionScope)
+	if err != nil {
+		return err
+	}
+
+	err = p.persistence.DeleteWorkflowExecution(request)
+	release(err)
+	return err
+}
+
+func (p *workflowExecutionCircuitBreakerPersistenceClient) DeleteCurrentWorkflowExecution(request *DeleteCurrentWorkflowExecutionRequest) error {
+	release, err := p.breaker.acquire(metrics.PersistenceDeleteCurrentWorkflowExecutionScope)
+	if err != nil {
+		return err
+	}
+
+	err = p.persistence.DeleteCurrentWorkflowExecution(request)
+	release(err)
+	return err
+}
+
+func (p *workflowExecutionCircuitBreakerPersistenceClient) GetCurrentExecution(request *GetCurrentExecutionRequest) (*GetCurrentExecutionResponse, error) {
+	release, err := p.breaker.acquire(metrics.PersistenceGetCurrentExecutionScope)
+	if err != nil {
+		return nil, err
+	}
+
+	response, err := p.persistence.GetCurrentExecution(request)
+	release(err)
+	return response, err
+}
+
+func (p *workflowExecutionCircuitBreakerPersistenceClient) ListConcreteExecutions(request *ListConcreteExecutionsRequest) (*ListConcreteExecutionsResponse, error) {
+	release, err := p.breaker.acquire(metrics.PersistenceListConcreteExecutionsScope)
+	if err != nil {
+		return nil, err
+	}
+
+	response, err := p.persistence.ListConcreteExecutions(request)
+	release(err)
+	return response, err
+}
+
+func (p *workflowExecutionCircuitBreakerPersistenceClient) GetTransferTask(request *GetTransferTaskRequest) (*GetTransferTaskResponse, error) {
+	release, err := p.breaker.acquire(metrics.PersistenceGetTransferTaskScope)
+	if err != nil {
+		return nil, err
+	}
+
+	response, err := p.persistence.GetTransferTask(request)
+	release(err)
+	return response, err
+}
+
+func (p *workflowExecutionCircuitBreakerPersistenceClient) GetTransferTasks(request *GetTransferTasksRequest) (*GetTransferTasksResponse, error) {
+	release, err := p.breaker.acquire(metrics.PersistenceGetTransferTasksScope)
+	if err != nil {
+		return nil, err
+	}
+
+	response, err := p.persistence.GetTransferTasks(request)
+	release(err)
+	return response, err
+}
+
+func (p *workflowExecutionCircuitBreakerPersistenceClient) GetReplicationTask(request *GetReplicationTaskRequest) (*GetReplicationTaskResponse, error) {
+	release, err := p.breaker.acquire(metrics.PersistenceGetReplicationTaskScope)
+	if err != nil {
+		return nil, err
+	}
+
+	response, err := p.persistence.GetReplicationTask(request)
+	release(err)
+	return response, err
+}
+
+func (p *workflowExecutionCircuitBreakerPersistenceClient) GetReplicationTasks(request *GetReplicationTasksRequest) (*GetReplicationTasksResponse, error) {
+	release, err := p.breaker.acquire(metrics.PersistenceGetReplicationTasksScope)
+	if err != nil {
+		return nil, err
+	}
+
+	response, err := p.persistence.GetReplicationTasks(request)
+	release(err)
+	return response, err
+}
+
+func (p *workflowExecutionCircuitBreakerPersistenceClient) CompleteTransferTask(request *CompleteTransferTaskRequest) error {
+	release, err := p.breaker.acquire(metrics.PersistenceCompleteTransferTaskScope)
+	if err != nil {
+		return err
+	}
+
+	err = p.persistence.CompleteTransferTask(request)
+	release(err)
+	return err
+}
+
+func (p *workflowExecutionCircuitBreakerPersistenceClient) RangeCompleteTransferTask(request *RangeCompleteTransferTaskRequest) error {
+	release, err := p.breaker.acquire(metrics.PersistenceRangeCompleteTransferTaskScope)
+	if err != nil {
+		return err
+	}
+
+	err = p.persistence.RangeCompleteTransferTask(request)
+	release(err)
+	return err
+}
+
+func (p *workflowExecutionCircuitBreakerPersistenceClient) CompleteReplicationTask(request *CompleteReplicationTaskRequest) error {
+	release, err := p.breaker.acquire(metrics.PersistenceCompleteReplicationTaskScope)
+	if err != nil {
+		return err
+	}
+
+	err = p.persistence.CompleteReplicationTask(request)
+	release(err)
+	return err
+}
+
+func (p *workflowExecutionCircuitBreakerPersistenceClient) RangeCompleteReplicationTask(request *RangeCompleteReplicationTaskRequest) error {
+	release, err := p.breaker.acquire(metrics.PersistenceRangeCompleteReplicationTaskScope)
+	if err != nil {
+		return err
+	}
+
+	err = p.persistence.RangeCompleteReplicationTask(request)
+	release(err)
+	return err
+}
+
+func (p *workflowExecutionCircuitBreakerPersistenceClient) PutReplicationTaskToDLQ(
+	request *PutReplicationTaskToDLQRequest,
+) error {
+	release, err := p.breaker.acquire(metrics.PersistencePutReplicationTaskToDLQScope)
+	if err != nil {
+		return err
+	}
+
+	err = p.persistence.PutReplicationTaskToDLQ(request)
+	release(err)
+	return err
+}
+
+func (p *workflowExecutionCircuitBreakerPersistenceClient) GetReplicationTasksFromDLQ(
+	request *GetReplicationTasksFromDLQRequest,
+) (*GetReplicationTasksFromDLQResponse, error) {
+	release, err := p.breaker.acquire(metrics.PersistenceGetReplicationTasksFromDLQScope)
+	if err != nil {
+		return nil, err
+	}
+
+	response, err := p.persistence.GetReplicationTasksFromDLQ(request)
+	release(err)
+	return response, err
+}
+
+func (p *workflowExecutionCircuitBreakerPersistenceClient) DeleteReplicationTaskFromDLQ(
+	request *DeleteReplicationTaskFromDLQRequest,
+) error {
+	release, err := p.breaker.acquire(metrics.PersistenceDeleteReplicationTaskFromDLQScope)
+	if err != nil {
+		return err
+	}
+
+	err = p.persistence.DeleteReplicationTaskFromDLQ(request)
+	release(err)
+	return err
+}
+
+func (p *workflowExecutionCircuitBreakerPersistenceClient) RangeDeleteReplicationTaskFromDLQ(
+	request *RangeDeleteReplicationTaskFromDLQRequest,
+) error {
+	release, err := p.breaker.acquire(metrics.PersistenceRangeDeleteReplicationTaskFromDLQScope)
+	if err != nil {
+		return err
+	}
+
+	err = p.persistence.RangeDeleteReplicationTaskFromDLQ(request)
+	release(err)
+	return err
+}
+
+func (p *workflowExecutionCircuitBreakerPersistenceClient) GetTimerTask(request *GetTimerTaskRequest) (*GetTimerTaskResponse, error) {
+	release, err := p.breaker.acquire(metrics.PersistenceGetTimerTaskScope)
+	if err != nil {
+		return nil, err
+	}
+
+	response, err := p.persistence.GetTimerTask(request)
+	release(err)
+	return response, err
+}
+
+func (p *workflowExecutionCircuitBreakerPersistenceClient) GetTimerIndexTasks(request *GetTimerIndexTasksRequest) (*GetTimerIndexTasksResponse, error) {
+	release, err := p.breaker.acquire(metrics.PersistenceGetTimerIndexTasksScope)
+	if err != nil {
+		return nil, err
+	}
+
+	response, err := p.persistence.GetTimerIndexTasks(request)
+	release(err)
+	return response, err
+}
+
+func (p *workflowExecutionCircuitBreakerPersistenceClient) CompleteTimerTask(request *CompleteTimerTaskRequest) error {
+	release, err := p.breaker.acquire(metrics.PersistenceCompleteTimerTaskScope)
+	if err != nil {
+		return err
+	}
+
+	err = p.persistence.CompleteTimerTask(request)
+	release(err)
+	return err
+}
+
+func (p *workflowExecutionCircuitBreakerPersistenceClient) RangeCompleteTimerTask(request *RangeCompleteTimerTaskRequest) error {
+	release, err := p.breaker.acquire(metrics.PersistenceRangeCompleteTimerTaskScope)
+	if err != nil {
+		return err
+	}
+
+	err = p.persistence.RangeCompleteTimerTask(request)
+	release(err)
+	return err
+}
+
+func (p *workflowExecutionCircuitBreakerPersistenceClient) Close() {
+	p.persistence.Close()
+}
+
+func (p *taskCircuitBreakerPersistenceClient) GetName() string {
+	return p.persistence.GetName()
+}
+
+func (p *taskCircuitBreakerPersistenceClient) CreateTasks(request *CreateTasksRequest) (*CreateTasksResponse, error) {
+	release, err := p.breaker.acquire(metrics.PersistenceCreateTaskScope)
+	if err != nil {
+		return nil, err
+	}
+
+	response, err := p.persistence.CreateTasks(request)
+	release(err)
+	return response, err
+}
+
+func (p *taskCircuitBreakerPersistenceClient) GetTasks(request *GetTasksRequest) (*GetTasksResponse, error) {
+	release, err := p.breaker.acquire(metrics.PersistenceGetTasksScope)
+	if err != nil {
+		return nil, err
+	}
+
+	response, err := p.persistence.GetTasks(request)
+	release(err)
+	return response, err
+}
+
+func (p *taskCircuitBreakerPersistenceClient) CompleteTask(request *CompleteTaskRequest) error {
+	release, err := p.breaker.acquire(metrics.PersistenceCompleteTaskScope)
+	if err != nil {
+		return err
+	}
+
+	err = p.persistence.CompleteTask(request)
+	release(err)
+	return err
+}
+
+func (p *taskCircuitBreakerPersistenceClient) CompleteTasksLessThan(request *CompleteTasksLessThanRequest) (int, error) {
+	release, err := p.breaker.acquire(metrics.PersistenceCompleteTasksLessThanScope)
+	if err != nil {
+		return 0, err
+	}
+
+	response, err := p.persistence.CompleteTasksLessThan(request)
+	release(err)
+	return response, err
+}
+
+func (p *taskCircuitBreakerPersistenceClient) LeaseTaskQueue(request *LeaseTaskQueueRequest) (*LeaseTaskQueueResponse, error) {
+	release, err := p.breaker.acquire(metrics.PersistenceLeaseTaskQueueScope)
+	if err != nil {
+		return nil, err
+	}
+
+	response, err := p.persistence.LeaseTaskQueue(request)
+	release(err)
+	return response, err
+}
+
+func (p *taskCircuitBreakerPersistenceClient) UpdateTaskQueue(request *UpdateTaskQueueRequest) (*UpdateTaskQueueResponse, error) {
+	release, err := p.breaker.acquire(metrics.PersistenceUpdateTaskQueueScope)
+	if err != nil {
+		return nil, err
+	}
+
+	response, err := p.persistence.UpdateTaskQueue(request)
+	release(err)
+	return response, err
+}
+
+func (p *taskCircuitBreakerPersistenceClient) ListTaskQueue(request *ListTaskQueueRequest) (*ListTaskQueueResponse, error) {
+	release, err := p.breaker.acquire(metrics.PersistenceListTaskQueueScope)
+	if err != nil {
+		return nil, err
+	}
+
+	response, err := p.persistence.ListTaskQueue(request)
+	release(err)
+	return response, err
+}
+
+func (p *taskCircuitBreakerPersistenceClient) DeleteTaskQueue(request *DeleteTaskQueueRequest) error {
+	release, err := p.breaker.acquire(metrics.PersistenceDeleteTaskQueueScope)
+	if err != nil {
+		return err
+	}
+
+	err = p.persistence.DeleteTaskQueue(request)
+	release(err)
+	return err
+}
+
+func (p *taskCircuitBreakerPersistenceClient) Close() {
+	p.persistence.Close()
+}
+
+func (p *metadataCircuitBreakerPersistenceClient) GetName() string {
+	return p.persistence.GetName()
+}
+
+func (p *metadataCircuitBreakerPersistenceClient) CreateNamespace(request *CreateNamespaceRequest) (*CreateNamespaceResponse, error) {
+	release, err := p.breaker.acquire(metrics.PersistenceCreateNamespaceScope)
+	if err != nil {
+		return nil, err
+	}
+
+	response, err := p.persistence.CreateNamespace(request)
+	release(err)
+	return response, err
+}
+
+func (p *metadataCircuitBreakerPersistenceClient) GetNamespace(request *GetNamespaceRequest) (*GetNamespaceResponse, error) {
+	release, err := p.breaker.acquire(metrics.PersistenceGetNamespaceScope)
+	if err != nil {
+		return nil, err
+	}
+
+	response, err := p.persistence.GetNamespace(request)
+	release(err)
+	return response, err
+}
+
+func (p *metadataCircuitBreakerPersistenceClient) UpdateNamespace(request *UpdateNamespaceRequest) error {
+	release, err := p.breaker.acquire(metrics.PersistenceUpdateNamespaceScope)
+	if err != nil {
+		return err
+	}
+
+	err = p.persistence.UpdateNamespace(request)
+	release(err)
+	return err
+}
+
+func (p *metadataCircuitBreakerPersistenceClient) DeleteNamespace(request *DeleteNamespaceRequest) error {
+	release, err := p.breaker.acquire(metrics.PersistenceDeleteNamespaceScope)
+	if err != nil {
+		return err
+	}
+
+	err = p.persistence.DeleteNamespace(request)
+	release(err)
+	return err
+}
+
+func (p *metadataCircuitBreakerPersistenceClient) DeleteNamespaceByName(request *DeleteNamespaceByNameRequest) error {
+	release, err := p.breaker.acquire(metrics.PersistenceDeleteNamespaceByNameScope)
+	if err != nil {
+		return err
+	}
+
+	err = p.persistence.DeleteNamespaceByName(request)
+	release(err)
+	return err
+}
+
+func (p *metadataCircuitBreakerPersistenceClient) ListNamespaces(request *ListNamespacesRequest) (*ListNamespacesResponse, error) {
+	release, err := p.breaker.acquire(metrics.PersistenceListNamespaceScope)
+	if err != nil {
+		return nil, err
+	}
+
+	response, err := p.persistence.ListNamespaces(request)
+	release(err)
+	return response, err
+}
+
+func (p *metadataCircuitBreakerPersistenceClient) GetMetadata() (*GetMetadataResponse, error) {
+	release, err := p.breaker.acquire(metrics.PersistenceGetMetadataScope)
+	if err != nil {
+		return nil, err
+	}
+
+	response, err := p.persistence.GetMetadata()
+	release(err)
+	return response, err
+}
+
+func (p *metadataCircuitBreakerPersistenceClient) Close() {
+	p.persistence.Close()
+}
+
+func (p *visibilityCircuitBreakerPersistenceClient) GetName() string {
+	return p.persistence.GetName()
+}
+
+func (p *visibilityCircuitBreakerPersistenceClient) RecordWorkflowExecutionStarted(request *RecordWorkflowExecutionStartedRequest) error {
+	release, err := p.breaker.acquire(metrics.PersistenceRecordWorkflowExecutionStartedScope)
+	if err != nil {
+		return err
+	}
+
+	err = p.persistence.RecordWorkflowExecutionStarted(request)
+	release(err)
+	return err
+}
+
+func (p *visibilityCircuitBreakerPersistenceClient) RecordWorkflowExecutionClosed(request *RecordWorkflowExecutionClosedRequest) error {
+	release, err := p.breaker.acquire(metrics.PersistenceRecordWorkflowExecutionClosedScope)
+	if err != nil {
+		return err
+	}
+
+	err = p.persistence.RecordWorkflowExecutionClosed(request)
+	release(err)
+	return err
+}
+
+func (p *visibilityCircuitBreakerPersistenceClient) UpsertWorkflowExecution(request *UpsertWorkflowExecutionRequest) error {
+	release, err := p.breaker.acquire(metrics.PersistenceUpsertWorkflowExecutionScope)
+	if err != nil {
+		return err
+	}
+
+	err = p.persistence.UpsertWorkflowExecution(request)
+	release(err)
+	return err
+}
+
+func (p *visibilityCircuitBreakerPersistenceClient) ListOpenWorkflowExecutions(request *ListWorkflowExecutionsRequest) (*ListWorkflowExecutionsResponse, error) {
+	release, err := p.breaker.acquire(metrics.PersistenceListOpenWorkflowExecutionsScope)
+	if err != nil {
+		return nil, err
+	}
+
+	response, err := p.persistence.ListOpenWorkflowExecutions(request)
+	release(err)
+	return response, err
+}
+
+func (p *visibilityCircuitBreakerPersistenceClient) ListClosedWorkflowExecutions(request *ListWorkflowExecutionsRequest) (*ListWorkflowExecutionsResponse, error) {
+	release, err := p.breaker.acquire(metrics.PersistenceListClosedWorkflowExecutionsScope)
+	if err != nil {
+		return nil, err
+	}
+
+	response, err := p.persistence.ListClosedWorkflowExecutions(request)
+	release(err)
+	return response, err
+}
+
+func (p *visibilityCircuitBreakerPersistenceClient) ListOpenWorkflowExecutionsByType(request *ListWorkflowExecutionsByTypeRequest) (*ListWorkflowExecutionsResponse, error) {
+	release, err := p.breaker.acquire(metrics.PersistenceListOpenWorkflowExecutionsByTypeScope)
+	if err != nil {
+		return nil, err
+	}
+
+	response, err := p.persistence.ListOpenWorkflowExecutionsByType(request)
+	release(err)
+	return response, err
+}
+
+func (p *visibilityCircuitBreakerPersistenceClient) ListClosedWorkflowExecutionsByType(request *ListWorkflowExecutionsByTypeRequest) (*ListWorkflowExecutionsResponse, error) {
+	release, err := p.breaker.acquire(metrics.PersistenceListClosedWorkflowExecutionsByTypeScope)
+	if err != nil {
+		return nil, err
+	}
+
+	response, err := p.persistence.ListClosedWorkflowExecutionsByType(request)
+	release(err)
+	return response, err
+}
+
+func (p *visibilityCircuitBreakerPersistenceClient) ListOpenWorkflowExecutionsByWorkflowID(request *ListWorkflowExecutionsByWorkflowIDRequest) (*ListWorkflowExecutionsResponse, error) {
+	release, err := p.breaker.acquire(metrics.PersistenceListOpenWorkflowExecutionsByWorkflowIDScope)
+	if err != nil {
+		return nil, err
+	}
+
+	response, err := p.persistence.ListOpenWorkflowExecutionsByWorkflowID(request)
+	release(err)
+	return response, err
+}
+
+func (p *visibilityCircuitBreakerPersistenceClient) ListClosedWorkflowExecutionsByWorkflowID(request *ListWorkflowExecutionsByWorkflowIDRequest) (*ListWorkflowExecutionsResponse, error) {
+	release, err := p.breaker.acquire(metrics.PersistenceListClosedWorkflowExecutionsByWorkflowIDScope)
+	if err != nil {
+		return nil, err
+	}
+
+	response, err := p.persistence.ListClosedWorkflowExecutionsByWorkflowID(request)
+	release(err)
+	return response, err
+}
+
+func (p *visibilityCircuitBreakerPersistenceClient) ListClosedWorkflowExecutionsByStatus(request *ListClosedWorkflowExecutionsByStatusRequest) (*ListWorkflowExecutionsResponse, error) {
+	release, err := p.breaker.acquire(metrics.PersistenceListClosedWorkflowExecutionsByStatusScope)
+	if err != nil {
+		return nil, err
+	}
+
+	response, err := p.persistence.ListClosedWorkflowExecutionsByStatus(request)
+	release(err)
+	return response, err
+}
+
+func (p *visibilityCircuitBreakerPersistenceClient) GetClosedWorkflowExecution(request *GetClosedWorkflowExecutionRequest) (*GetClosedWorkflowExecutionResponse, error) {
+	release, err := p.breaker.acquire(metrics.PersistenceGetClosedWorkflowExecutionScope)
+	if err != nil {
+		return nil, err
+	}
+
+	response, err := p.persistence.GetClosedWorkflowExecution(request)
+	release(err)
+	return response, err
+}
+
+func (p *visibilityCircuitBreakerPersistenceClient) DeleteWorkflowExecution(request *VisibilityDeleteWorkflowExecutionRequest) error {
+	release, err := p.breaker.acquire(metrics.PersistenceVisibilityDeleteWorkflowExecutionScope)
+	if err != nil {
+		return err
+	}
+
+	err = p.persistence.DeleteWorkflowExecution(request)
+	release(err)
+	return err
+}
+
+func (p *visibilityCircuitBreakerPersistenceClient) ListWorkflowExecutions(request *ListWorkflowExecutionsRequestV2) (*ListWorkflowExecutionsResponse, error) {
+	release, err := p.breaker.acquire(metrics.PersistenceListWorkflowExecutionsScope)
+	if err != nil {
+		return nil, err
+	}
+
+	response, err := p.persistence.ListWorkflowExecutions(request)
+	release(err)
+	return response, err
+}
+
+func (p *visibilityCircuitBreakerPersistenceClient) ScanWorkflowExecutions(request *ListWorkflowExecutionsRequestV2) (*ListWorkflowExecutionsResponse, error) {
+	release, err := p.breaker.acquire(metrics.PersistenceScanWorkflowExecutionsScope)
+	if err != nil {
+		return nil, err
+	}
+
+	response, err := p.persistence.ScanWorkflowExecutions(request)
+	release(err)
+	return response, err
+}
+
+func (p *visibilityCircuitBreakerPersistenceClient) CountWorkflowExecutions(request *CountWorkflowExecutionsRequest) (*CountWorkflowExecutionsResponse, error) {
+	release, err := p.breaker.acquire(metrics.PersistenceCountWorkflowExecutionsScope)
+	if err != nil {
+		return nil, err
+	}
+
+	response, err := p.persistence.CountWorkflowExecutions(request)
+	release(err)
+	return response, err
+}
+
+func (p *visibilityCircuitBreakerPersistenceClient) Close() {
+	p.persistence.Close()
+}
+
+func (p *historyV2CircuitBreakerPersistenceClient) GetName() string {
+	return p.persistence.GetName()
+}
+
+func (p *historyV2CircuitBreakerPersistenceClient) Close() {
+	p.persistence.Close()
+}
+
+// AppendHistoryNodes add(or override) a node to a history branch
+func (p *historyV2CircuitBreakerPersistenceClient) AppendHistoryNodes(request *AppendHistoryNodesRequest) (*AppendHistoryNodesResponse, error) {
+	release, err := p.breaker.acquire(metrics.PersistenceAppendHistoryNodesScope)
+	if err != nil {
+		return nil, err
+	}
+
+	response, err := p.persistence.AppendHistoryNodes(request)
+	release(err)
+	return response, err
+}
+
+// ReadHistoryBranch returns history node data for a branch
+func (p *historyV2CircuitBreakerPersistenceClient) ReadHistoryBranch(request *ReadHistoryBranchRequest) (*ReadHistoryBranchResponse, error) {
+	release, err := p.breaker.acquire(metrics.PersistenceReadHistoryBranchScope)
+	if err != nil {
+		return nil, err
+	}
+
+	response, err := p.persistence.ReadHistoryBranch(request)
+	release(err)
+	return response, err
+}
+
+// ReadHistoryBranchByBatch returns history node data for a branch
+func (p *historyV2CircuitBreakerPersistenceClient) ReadHistoryBranchByBatch(request *ReadHistoryBranchRequest) (*ReadHistoryBranchByBatchResponse, error) {
+	release, err := p.breaker.acquire(metrics.PersistenceReadHistoryBranchScope)
+	if err != nil {
+		return nil, err
+	}
+
+	response, err := p.persistence.ReadHistoryBranchByBatch(request)
+	release(err)
+	return response, err
+}
+
+// ReadHistoryBranchByBatch returns history node data for a branch
+func (p *historyV2CircuitBreakerPersistenceClient) ReadRawHistoryBranch(request *ReadHistoryBranchRequest) (*ReadRawHistoryBranchResponse, error) {
+	release, err := p.breaker.acquire(metrics.PersistenceReadHistoryBranchScope)
+	if err != nil {
+		return nil, err
+	}
+
+	response, err := p.persistence.ReadRawHistoryBranch(request)
+	release(err)
+	return response, err
+}
+
+// ForkHistoryBranch forks a new branch from a old branch
+func (p *historyV2CircuitBreakerPersistenceClient) ForkHistoryBranch(request *ForkHistoryBranchRequest) (*ForkHistoryBranchResponse, error) {
+	release, err := p.breaker.acquire(metrics.PersistenceForkHistoryBranchScope)
+	if err != nil {
+		return nil, err
+	}
+
+	response, err := p.persistence.ForkHistoryBranch(request)
+	release(err)
+	return response, err
+}
+
+// DeleteHistoryBranch removes a branch
+func (p *historyV2CircuitBreakerPersistenceClient) DeleteHistoryBranch(request *DeleteHistoryBranchRequest) error {
+	release, err := p.breaker.acquire(metrics.PersistenceDeleteHistoryBranchScope)
+	if err != nil {
+		return err
+	}
+
+	err = p.persistence.DeleteHistoryBranch(request)
+	release(err)
+	return err
+}
+
+// GetHistoryTree returns all branch information of a tree
+func (p *historyV2CircuitBreakerPersistenceClient) GetHistoryTree(request *GetHistoryTreeRequest) (*GetHistoryTreeResponse, error) {
+	release, err := p.breaker.acquire(metrics.PersistenceGetHistoryTreeScope)
+	if err != nil {
+		return nil, err
+	}
+
+	response, err := p.persistence.GetHistoryTree(request)
+	release(err)
+	return response, err
+}
+
+func (p *historyV2CircuitBreakerPersistenceClient) GetAllHistoryTreeBranches(request *GetAllHistoryTreeBranchesRequest) (*GetAllHistoryTreeBranchesResponse, error) {
+	release, err := p.breaker.acquire(metrics.PersistenceGetAllHistoryTreeBranchesScope)
+	if err != nil {
+		return nil, err
+	}
+
+	response, err := p.persistence.GetAllHistoryTreeBranches(request)
+	release(err)
+	return response, err
+}
+
+func (p *queueCircuitBreakerPersistenceClient) EnqueueMessage(message []byte) error {
+	release, err := p.breaker.acquire(metrics.PersistenceEnqueueMessageScope)
+	if err != nil {
+		return err
+	}
+
+	err = p.persistence.EnqueueMessage(message)
+	release(err)
+	return err
+}
+
+func (p *queueCircuitBreakerPersistenceClient) ReadMessages(lastMessageID int64, maxCount int) ([]*QueueMessage, error) {
+	release, err := p.breaker.acquire(metrics.PersistenceReadQueueMessagesScope)
+	if err != nil {
+		return nil, err
+	}
+
+	response, err := p.persistence.ReadMessages(lastMessageID, maxCount)
+	release(err)
+	return response, err
+}
+
+func (p *queueCircuitBreakerPersistenceClient) UpdateAckLevel(messageID int64, clusterName string) error {
+	release, err := p.breaker.acquire(metrics.PersistenceUpdateAckLevelScope)
+	if err != nil {
+		return err
+	}
+
+	err = p.persistence.UpdateAckLevel(messageID, clusterName)
+	release(err)
+	return err
+}
+
+func (p *queueCircuitBreakerPersistenceClient) GetAckLevels() (map[string]int64, error) {
+	release, err := p.breaker.acquire(metrics.PersistenceGetAckLevelScope)
+	if err != nil {
+		return nil, err
+	}
+
+	response, err := p.persistence.GetAckLevels()
+	release(err)
+	return response, err
+}
+
+func (p *queueCircuitBreakerPersistenceClient) DeleteMessagesBefore(messageID int64) error {
+	release, err := p.breaker.acquire(metrics.PersistenceDeleteQueueMessagesScope)
+	if err != nil {
+		return err
+	}
+
+	err = p.persistence.DeleteMessagesBefore(messageID)
+	release(err)
+	return err
+}
+
+func (p *queueCircuitBreakerPersistenceClient) EnqueueMessageToDLQ(message []byte) (int64, error) {
+	release, err := p.breaker.acquire(metrics.PersistenceEnqueueMessageToDLQScope)
+	if err != nil {
+		return emptyMessageID, err
+	}
+
+	response, err := p.persistence.EnqueueMessageToDLQ(message)
+	release(err)
+	return response, err
+}
+
+func (p *queueCircuitBreakerPersistenceClient) ReadMessagesFromDLQ(firstMessageID int64, lastMessageID int64, pageSize int, pageToken []byte) ([]*QueueMessage, []byte, error) {
+	release, err := p.breaker.acquire(metrics.PersistenceReadQueueMessagesFromDLQScope)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	response, nextPageToken, err := p.persistence.ReadMessagesFromDLQ(firstMessageID, lastMessageID, pageSize, pageToken)
+	release(err)
+	return response, nextPageToken, err
+}
+
+func (p *queueCircuitBreakerPersistenceClient) RangeDeleteMessagesFromDLQ(firstMessageID int64, lastMessageID int64) error {
+	release, err := p.breaker.acquire(metrics.PersistenceRangeDeleteMessagesFromDLQScope)
+	if err != nil {
+		return err
+	}
+
+	err = p.persistence.RangeDeleteMessagesFromDLQ(firstMessageID, lastMessageID)
+	release(err)
+	return err
+}
+func (p *queueCircuitBreakerPersistenceClient) UpdateDLQAckLevel(messageID int64, clusterName string) error {
+	release, err := p.breaker.acquire(metrics.PersistenceUpdateDLQAckLevelScope)
+	if err != nil {
+		return err
+	}
+
+	err = p.persistence.UpdateDLQAckLevel(messageID, clusterName)
+	release(err)
+	return err
+}
+
+func (p *queueCircuitBreakerPersistenceClient) GetDLQAckLevels() (map[string]int64, error) {
+	release, err := p.breaker.acquire(metrics.PersistenceGetDLQAckLevelScope)
+	if err != nil {
+		return nil, err
+	}
+
+	response, err := p.persistence.GetDLQAckLevels()
+	release(err)
+	return response, err
+}
+
+func (p *queueCircuitBreakerPersistenceClient) DeleteMessageFromDLQ(messageID int64) error {
+	release, err := p.breaker.acquire(metrics.PersistenceDeleteQueueMessageFromDLQScope)
+	if err != nil {
+		return err
+	}
+
+	err = p.persistence.DeleteMessageFromDLQ(messageID)
+	release(err)
+	return err
+}
+
+func (p *queueCircuitBreakerPersistenceClient) Close() {
+	p.persistence.Close()
+}
+
+func (c *clusterMetadataCircuitBreakerPersistenceClient) Close() {
+	c.persistence.Close()
+}
+
+func (c *clusterMetadataCircuitBreakerPersistenceClient) GetName() string {
+	return c.persistence.GetName()
+}
+
+func (c *clusterMetadataCircuitBreakerPersistenceClient) GetClusterMembers(request *GetClusterMembersRequest) (*GetClusterMembersResponse, error) {
+	release, err := c.breaker.acquire(metrics.PersistenceGetClusterMembersScope)
+	if err != nil {
+		return nil, err
+	}
+
+	response, err := c.persistence.GetClusterMembers(request)
+	release(err)
+	return response, err
+}
+
+func (c *clusterMetadataCircuitBreakerPersistenceClient) UpsertClusterMembership(request *UpsertClusterMembershipRequest) error {
+	release, err := c.breaker.acquire(metrics.PersistenceUpsertClusterMembershipScope)
+	if err != nil {
+		return err
+	}
+
+	err = c.persistence.UpsertClusterMembership(request)
+	release(err)
+	return err
+}
+
+func (c *clusterMetadataCircuitBreakerPersistenceClient) PruneClusterMembership(request *PruneClusterMembershipRequest) error {
+	release, err := c.breaker.acquire(metrics.PersistencePruneClusterMembershipScope)
+	if err != nil {
+		return err
+	}
+
+	err = c.persistence.PruneClusterMembership(request)
+	release(err)
+	return err
+}
+
+func (c *clusterMetadataCircuitBreakerPersistenceClient) GetClusterMetadata() (*GetClusterMetadataResponse, error) {
+	release, err := c.breaker.acquire(metrics.PersistenceGetClusterMetadataScope)
+	if err != nil {
+		return nil, err
+	}
+
+	response, err := c.persistence.GetClusterMetadata()
+	release(err)
+	return response, err
+}
+
+func (c *clusterMetadataCircuitBreakerPersistenceClient) SaveClusterMetadata(request *SaveClusterMetadataRequest) (bool, error) {
+	release, err := c.breaker.acquire(metrics.PersistenceSaveClusterMetadataScope)
+	if err != nil {
+		return false, err
+	}
+
+	response, err := c.persistence.SaveClusterMetadata(request)
+	release(err)
+	return response, err
+}
+
+func (c *metadataCircuitBreakerPersistenceClient) InitializeSystemNamespaces(currentClusterName string) error {
+	release, err := c.breaker.acquire(metrics.PersistenceInitializeSystemNamespaceScope)
+	if err != nil {
+		return err
+	}
+
+	err = c.persistence.InitializeSystemNamespaces(currentClusterName)
+	release(err)
+	return err
+}
@@ -0,0 +1,201 @@
+// The MIT License
+//
+// Copyright (c) 2020 Temporal Technologies Inc.  All rights reserved.
+//
+// Copyright (c) 2020 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package persistence
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"github.com/stretchr/testify/suite"
+	"github.com/uber-go/tally"
+	"go.temporal.io/api/serviceerror"
+
+	"go.temporal.io/server/common/clock"
+	"go.temporal.io/server/common/metrics"
+	"go.temporal.io/server/common/service/dynamicconfig"
+)
+
+type (
+	circuitBreakerSuite struct {
+		suite.Suite
+		// override suite.Suite.Assertions with require.Assertions; this means that s.NotNil(nil) will stop the test,
+		// not merely log an error
+		*require.Assertions
+
+		config     *CircuitBreakerConfig
+		timeSource *clock.EventTimeSource
+		breaker    *managerCircuitBreaker
+	}
+)
+
+func TestCircuitBreakerSuite(t *testing.T) {
+	s := new(circuitBreakerSuite)
+	suite.Run(t, s)
+}
+
+func (s *circuitBreakerSuite) SetupTest() {
+	s.Assertions = require.New(s.T())
+
+	s.config = &CircuitBreakerConfig{
+		CircuitBreakerEnabled:             dynamicconfig.GetBoolPropertyFn(true),
+		CircuitBreakerWindow:              dynamicconfig.GetDurationPropertyFn(10 * time.Second),
+		CircuitBreakerMinRequests:         dynamicconfig.GetIntPropertyFn(4),
+		CircuitBreakerErrorRatio:          dynamicconfig.GetFloatPropertyFn(0.5),
+		CircuitBreakerOpenTimeout:         dynamicconfig.GetDurationPropertyFn(5 * time.Second),
+		CircuitBreakerHalfOpenMaxRequests: dynamicconfig.GetIntPropertyFn(2),
+
+		ConcurrencyLimitEnabled:          dynamicconfig.GetBoolPropertyFn(false),
+		ConcurrencyLimitMin:              dynamicconfig.GetIntPropertyFn(2),
+		ConcurrencyLimitMax:              dynamicconfig.GetIntPropertyFn(10),
+		ConcurrencyLimitLatencyTolerance: dynamicconfig.GetFloatPropertyFn(2.0),
+	}
+	s.timeSource = clock.NewEventTimeSource().Update(time.Now())
+	s.breaker = newManagerCircuitBreaker(s.config, metrics.NewClient(tally.NoopScope, metrics.Common), s.timeSource)
+}
+
+func (s *circuitBreakerSuite) TestCircuitBreaker_OpensOnFailureRatio() {
+	s.call(metrics.PersistenceGetShardScope, nil)
+	s.call(metrics.PersistenceGetShardScope, &ConditionFailedError{})
+	s.call(metrics.PersistenceGetShardScope, serviceerror.NewInternal("db error"))
+	s.Equal(circuitBreakerStateClosed, s.breaker.manager.state)
+
+	s.call(metrics.PersistenceGetShardScope, serviceerror.NewInternal("db error"))
+	s.Equal(circuitBreakerStateOpen, s.breaker.manager.state)
+
+	_, err := s.breaker.acquire(metrics.PersistenceUpdateShardScope)
+	s.Equal(ErrPersistenceCircuitBreakerOpen, err)
+}
+
+func (s *circuitBreakerSuite) TestCircuitBreaker_WindowExpires() {
+	s.call(metrics.PersistenceGetShardScope, serviceerror.NewInternal("db error"))
+	s.call(metrics.PersistenceGetShardScope, serviceerror.NewInternal("db error"))
+	s.call(metrics.PersistenceGetShardScope, serviceerror.NewInternal("db error"))
+
+	s.timeSource.Update(s.timeSource.Now().Add(10 * time.Second))
+	s.call(metrics.PersistenceGetShardScope, serviceerror.NewInternal("db error"))
+	s.Equal(circuitBreakerStateClosed, s.breaker.manager.state)
+}
+
+func (s *circuitBreakerSuite) TestCircuitBreaker_HalfOpen() {
+	s.openManagerCircuitBreaker()
+
+	s.timeSource.Update(s.timeSource.Now().Add(5 * time.Second))
+	release1, err := s.breaker.acquire(metrics.PersistenceGetShardScope)
+	s.NoError(err)
+	s.Equal(circuitBreakerStateHalfOpen, s.breaker.manager.state)
+	release2, err := s.breaker.acquire(metrics.PersistenceGetShardScope)
+	s.NoError(err)
+	_, err = s.breaker.acquire(metrics.PersistenceGetShardScope)
+	s.Equal(ErrPersistenceCircuitBreakerOpen, err)
+
+	release1(nil)
+	release2(nil)
+	s.Equal(circuitBreakerStateClosed, s.breaker.manager.state)
+	s.call(metrics.PersistenceGetShardScope, nil)
+}
+
+func (s *circuitBreakerSuite) TestCircuitBreaker_HalfOpenFailure() {
+	s.openManagerCircuitBreaker()
+
+	s.timeSource.Update(s.timeSource.Now().Add(5 * time.Second))
+	s.call(metrics.PersistenceGetShardScope, &TimeoutError{Msg: "timeout"})
+	s.Equal(circuitBreakerStateOpen, s.breaker.manager.state)
+
+	_, err := s.breaker.acquire(metrics.PersistenceGetShardScope)
+	s.Equal(ErrPersistenceCircuitBreakerOpen, err)
+}
+
+func (s *circuitBreakerSuite) TestCircuitBreaker_PerOperation() {
+	s.config.CircuitBreakerMinRequests = dynamicconfig.GetIntPropertyFn(2)
+	s.config.CircuitBreakerErrorRatio = dynamicconfig.GetFloatPropertyFn(0.6)
+	for i := 0; i < 3; i++ {
+		s.call(metrics.PersistenceUpdateShardScope, nil)
+	}
+	s.call(metrics.PersistenceGetShardScope, serviceerror.NewUnavailable("db unavailable"))
+	s.call(metrics.PersistenceGetShardScope, serviceerror.NewUnavailable("db unavailable"))
+
+	s.Equal(circuitBreakerStateClosed, s.breaker.manager.state)
+	_, err := s.breaker.acquire(metrics.PersistenceGetShardScope)
+	s.Equal(ErrPersistenceCircuitBreakerOpen, err)
+	s.call(metrics.PersistenceUpdateShardScope, nil)
+}
+
+func (s *circuitBreakerSuite) TestCircuitBreaker_Disabled() {
+	s.config.CircuitBreakerEnabled = dynamicconfig.GetBoolPropertyFn(false)
+	for i := 0; i < 10; i++ {
+		s.call(metrics.PersistenceGetShardScope, serviceerror.NewInternal("db error"))
+	}
+	s.Equal(circuitBreakerStateClosed, s.breaker.manager.state)
+}
+
+func (s *circuitBreakerSuite) TestConcurrencyLimit() {
+	s.config.CircuitBreakerEnabled = dynamicconfig.GetBoolPropertyFn(false)
+	s.config.ConcurrencyLimitEnabled = dynamicconfig.GetBoolPropertyFn(true)
+
+	var releases []func(error)
+	for i := 0; i < 10; i++ {
+		release, err := s.breaker.acquire(metrics.PersistenceGetShardScope)
+		s.NoError(err)
+		releases = append(releases, release)
+	}
+	_, err := s.breaker.acquire(metrics.PersistenceGetShardScope)
+	s.Equal(ErrPersistenceConcurrencyLimitExceeded, err)
+	s.IsType(&serviceerror.Unavailable{}, err)
+
+	for _, release := range releases {
+		release(serviceerror.NewInternal("db error"))
+	}
+	s.True(s.breaker.limiter.limit < 10)
+	s.Equal(0, s.breaker.limiter.inflight)
+}
+
+func (s *circuitBreakerSuite) TestConcurrencyLimit_Latency() {
+	limiter := newConcurrencyLimiter(s.config)
+	for i := 0; i < 100; i++ {
+		s.True(limiter.acquire())
+		limiter.release(10*time.Millisecond, false)
+	}
+	s.Equal(float64(10), limiter.limit)
+
+	for i := 0; i < 20; i++ {
+		s.True(limiter.acquire())
+		limiter.release(time.Second, false)
+	}
+	s.True(limiter.limit < 6)
+}
+
+func (s *circuitBreakerSuite) openManagerCircuitBreaker() {
+	for i := 0; i < 4; i++ {
+		s.call(metrics.PersistenceGetShardScope, serviceerror.NewInternal("db error"))
+	}
+	s.Equal(circuitBreakerStateOpen, s.breaker.manager.state)
+}
+
+func (s *circuitBreakerSuite) call(scope int, result error) {
+	release, err := s.breaker.acquire(scope)
+	s.NoError(err)
+	release(result)
+}
@@ -267,6 +267,8 @@ type (
 		VersionHistories *historyspb.VersionHistories
 		StartVersion     int64
 		LastWriteVersion int64
+		// Encoding of the persisted execution info, proto3 if unspecified
+		ExecutionInfoEncoding enumspb.EncodingType
 
 		UpsertActivityInfos       []*persistenceblobs.ActivityInfo
 		DeleteActivityInfos       []int64
@@ -298,6 +300,8 @@ type (
 		VersionHistories *historyspb.VersionHistories
 		StartVersion     int64
 		LastWriteVersion int64
+		// Encoding of the persisted execution info, proto3 if unspecified
+		ExecutionInfoEncoding enumspb.EncodingType
 
 		ActivityInfos       []*persistenceblobs.ActivityInfo
 		TimerInfos          []*persistenceblobs.TimerInfo
@@ -404,6 +408,8 @@ type (
 		LastTransactionID int64
 		// Used in sharded data stores to identify which shard to use
 		ShardID int32
+		// AllowStaleRead allows the branch to be read from a replica that lags behind the primary
+		AllowStaleRead bool
 	}
 
 	// InternalCompleteForkBranchRequest is used to update some tree/branch meta data for forking
@@ -580,14 +586,14 @@ func NewDataBlob(data []byte, encodingTypeStr string) *serialization.DataBlob {
 		return nil
 	}
 
-	encodingType, ok := enumspb.EncodingType_value[encodingTypeStr]
-	if !ok || enumspb.EncodingType(encodingType) != enumspb.ENCODING_TYPE_PROTO3 {
+	encodingType, err := serialization.EncodingTypeFromName(encodingTypeStr)
+	if err != nil || !serialization.IsProto3Encoding(encodingType) {
 		panic(fmt.Sprintf("Invalid incoding: \"%v\"", encodingTypeStr))
 	}
 
 	return &serialization.DataBlob{
 		Data:     data,
-		Encoding: enumspb.EncodingType(encodingType),
+		Encoding: encodingType,
 	}
 }
 
@@ -596,7 +602,7 @@ func FromDataBlob(blob *serialization.DataBlob) ([]byte, string) {
 	if blob == nil || len(blob.Data) == 0 {
 		return nil, ""
 	}
-	return blob.Data, blob.Encoding.String()
+	return blob.Data, serialization.EncodingTypeName(blob.Encoding)
 }
 
 // NewDataBlobFromProto convert data blob from Proto representation
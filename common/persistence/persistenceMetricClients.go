@@ -221,6 +221,9 @@ func (p *shardPersistenceClient) updateErrorMetric(scope int, err error) {
 	case *serviceerror.ResourceExhausted:
 		p.metricClient.IncCounter(scope, metrics.PersistenceErrBusyCounter)
 		p.metricClient.IncCounter(scope, metrics.PersistenceFailures)
+	case *serviceerror.Unavailable:
+		p.metricClient.IncCounter(scope, metrics.PersistenceErrUnavailableCounter)
+		p.metricClient.IncCounter(scope, metrics.PersistenceFailures)
 	default:
 		p.logger.Error("Operation failed with internal error.", tag.Error(err), tag.MetricScope(scope))
 		p.metricClient.IncCounter(scope, metrics.PersistenceFailures)
@@ -615,6 +618,9 @@ func (p *workflowExecutionPersistenceClient) updateErrorMetric(scope int, err er
 	case *serviceerror.ResourceExhausted:
 		p.metricClient.IncCounter(scope, metrics.PersistenceErrBusyCounter)
 		p.metricClient.IncCounter(scope, metrics.PersistenceFailures)
+	case *serviceerror.Unavailable:
+		p.metricClient.IncCounter(scope, metrics.PersistenceErrUnavailableCounter)
+		p.metricClient.IncCounter(scope, metrics.PersistenceFailures)
 	default:
 		p.logger.Error("Operation failed with internal error.",
 			tag.Error(err), tag.MetricScope(scope), tag.ShardID(p.GetShardID()))
@@ -743,6 +749,9 @@ func (p *taskPersistenceClient) updateErrorMetric(scope int, err error) {
 	case *serviceerror.ResourceExhausted:
 		p.metricClient.IncCounter(scope, metrics.PersistenceErrBusyCounter)
 		p.metricClient.IncCounter(scope, metrics.PersistenceFailures)
+	case *serviceerror.Unavailable:
+		p.metricClient.IncCounter(scope, metrics.PersistenceErrUnavailableCounter)
+		p.metricClient.IncCounter(scope, metrics.PersistenceFailures)
 	default:
 		p.logger.Error("Operation failed with internal error.",
 			tag.Error(err), tag.MetricScope(scope))
@@ -871,6 +880,9 @@ func (p *metadataPersistenceClient) updateErrorMetric(scope int, err error) {
 	case *serviceerror.ResourceExhausted:
 		p.metricClient.IncCounter(scope, metrics.PersistenceErrBusyCounter)
 		p.metricClient.IncCounter(scope, metrics.PersistenceFailures)
+	case *serviceerror.Unavailable:
+		p.metricClient.IncCounter(scope, metrics.PersistenceErrUnavailableCounter)
+		p.metricClient.IncCounter(scope, metrics.PersistenceFailures)
 	default:
 		p.logger.Error("Operation failed with internal error.",
 			tag.Error(err), tag.MetricScope(scope))
@@ -1104,6 +1116,9 @@ func (p *visibilityPersistenceClient) updateErrorMetric(scope int, err error) {
 	case *serviceerror.ResourceExhausted:
 		p.metricClient.IncCounter(scope, metrics.PersistenceErrBusyCounter)
 		p.metricClient.IncCounter(scope, metrics.PersistenceFailures)
+	case *serviceerror.Unavailable:
+		p.metricClient.IncCounter(scope, metrics.PersistenceErrUnavailableCounter)
+		p.metricClient.IncCounter(scope, metrics.PersistenceFailures)
 	default:
 		p.logger.Error("Operation failed with internal error.",
 			tag.Error(err), tag.MetricScope(scope))
@@ -1230,6 +1245,9 @@ func (p *historyV2PersistenceClient) updateErrorMetric(scope int, err error) {
 	case *serviceerror.ResourceExhausted:
 		p.metricClient.IncCounter(scope, metrics.PersistenceErrBusyCounter)
 		p.metricClient.IncCounter(scope, metrics.PersistenceFailures)
+	case *serviceerror.Unavailable:
+		p.metricClient.IncCounter(scope, metrics.PersistenceErrUnavailableCounter)
+		p.metricClient.IncCounter(scope, metrics.PersistenceFailures)
 	default:
 		p.logger.Error("Operation failed with internal error.",
 			tag.Error(err), tag.MetricScope(scope))
@@ -1486,3 +1504,19 @@ func (c *metadataPersistenceClient) InitializeSystemNamespaces(currentClusterNam
 
 	return err
 }
+
+func emitCircuitBreakerStateMetric(metricClient metrics.Client, scope int, level string, state circuitBreakerState) {
+	if metricClient == nil {
+		return
+	}
+
+	metricScope := metricClient.Scope(scope, metrics.CircuitBreakerTag(level))
+	switch state {
+	case circuitBreakerStateOpen:
+		metricScope.IncCounter(metrics.PersistenceCircuitBreakerOpenCounter)
+	case circuitBreakerStateHalfOpen:
+		metricScope.IncCounter(metrics.PersistenceCircuitBreakerHalfOpenCounter)
+	case circuitBreakerStateClosed:
+		metricScope.IncCounter(metrics.PersistenceCircuitBreakerClosedCounter)
+	}
+}
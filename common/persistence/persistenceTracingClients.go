@@ -0,0 +1,920 @@
+// The MIT License
+//
+// Copyright (c) 2020 Temporal Technologies Inc.  All rights reserved.
+//
+// Copyright (c) 2020 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package persistence
+
+import (
+	"context"
+	"reflect"
+
+	"go.opentelemetry.io/otel/api/trace"
+	"go.opentelemetry.io/otel/label"
+	commonpb "go.temporal.io/api/common/v1"
+
+	"go.temporal.io/server/common/log"
+	"go.temporal.io/server/common/tracing"
+)
+
+type (
+	shardTracingPersistenceClient struct {
+		persistence ShardManager
+		logger      log.Logger
+	}
+
+	workflowExecutionTracingPersistenceClient struct {
+		persistence ExecutionManager
+		logger      log.Logger
+	}
+
+	taskTracingPersistenceClient struct {
+		persistence TaskManager
+		logger      log.Logger
+	}
+
+	historyV2TracingPersistenceClient struct {
+		persistence HistoryManager
+		logger      log.Logger
+	}
+
+	metadataTracingPersistenceClient struct {
+		persistence MetadataManager
+		logger      log.Logger
+	}
+
+	clusterMetadataTracingPersistenceClient struct {
+		persistence ClusterMetadataManager
+		logger      log.Logger
+	}
+
+	visibilityTracingPersistenceClient struct {
+		persistence VisibilityManager
+		logger      log.Logger
+	}
+
+	queueTracingPersistenceClient struct {
+		persistence Queue
+		logger      log.Logger
+	}
+)
+
+var _ ShardManager = (*shardTracingPersistenceClient)(nil)
+var _ ExecutionManager = (*workflowExecutionTracingPersistenceClient)(nil)
+var _ TaskManager = (*taskTracingPersistenceClient)(nil)
+var _ HistoryManager = (*historyV2TracingPersistenceClient)(nil)
+var _ MetadataManager = (*metadataTracingPersistenceClient)(nil)
+var _ ClusterMetadataManager = (*clusterMetadataTracingPersistenceClient)(nil)
+var _ VisibilityManager = (*visibilityTracingPersistenceClient)(nil)
+var _ Queue = (*queueTracingPersistenceClient)(nil)
+
+// NewShardPersistenceTracingClient creates a client to manage shards
+func NewShardPersistenceTracingClient(persistence ShardManager, logger log.Logger) ShardManager {
+	return &shardTracingPersistenceClient{
+		persistence: persistence,
+		logger:      logger,
+	}
+}
+
+// NewWorkflowExecutionPersistenceTracingClient creates a client to manage executions
+func NewWorkflowExecutionPersistenceTracingClient(persistence ExecutionManager, logger log.Logger) ExecutionManager {
+	return &workflowExecutionTracingPersistenceClient{
+		persistence: persistence,
+		logger:      logger,
+	}
+}
+
+// NewTaskPersistenceTracingClient creates a client to manage tasks
+func NewTaskPersistenceTracingClient(persistence TaskManager, logger log.Logger) TaskManager {
+	return &taskTracingPersistenceClient{
+		persistence: persistence,
+		logger:      logger,
+	}
+}
+
+// NewHistoryV2PersistenceTracingClient creates a HistoryManager client to manage workflow execution history
+func NewHistoryV2PersistenceTracingClient(persistence HistoryManager, logger log.Logger) HistoryManager {
+	return &historyV2TracingPersistenceClient{
+		persistence: persistence,
+		logger:      logger,
+	}
+}
+
+// NewMetadataPersistenceTracingClient creates a MetadataManager client to manage metadata
+func NewMetadataPersistenceTracingClient(persistence MetadataManager, logger log.Logger) MetadataManager {
+	return &metadataTracingPersistenceClient{
+		persistence: persistence,
+		logger:      logger,
+	}
+}
+
+// NewClusterMetadataPersistenceTracingClient creates a MetadataManager client to manage metadata
+func NewClusterMetadataPersistenceTracingClient(persistence ClusterMetadataManager, logger log.Logger) ClusterMetadataManager {
+	return &clusterMetadataTracingPersistenceClient{
+		persistence: persistence,
+		logger:      logger,
+	}
+}
+
+// NewVisibilityPersistenceTracingClient creates a client to manage visibility
+func NewVisibilityPersistenceTracingClient(persistence VisibilityManager, logger log.Logger) VisibilityManager {
+	return &visibilityTracingPersistenceClient{
+		persistence: persistence,
+		logger:      logger,
+	}
+}
+
+// NewQueuePersistenceTracingClient creates a client to manage queue
+func NewQueuePersistenceTracingClient(persistence Queue, logger log.Logger) Queue {
+	return &queueTracingPersistenceClient{
+		persistence: persistence,
+		logger:      logger,
+	}
+}
+
+func (p *shardTracingPersistenceClient) GetName() string {
+	return p.persistence.GetName()
+}
+
+func (p *shardTracingPersistenceClient) CreateShard(request *CreateShardRequest) error {
+	span := p.startSpan("CreateShard", request)
+	err := p.persistence.CreateShard(request)
+	tracing.End(span, err)
+	return err
+}
+
+func (p *shardTracingPersistenceClient) GetShard(request *GetShardRequest) (*GetShardResponse, error) {
+	span := p.startSpan("GetShard", request)
+	response, err := p.persistence.GetShard(request)
+	tracing.End(span, err)
+	return response, err
+}
+
+func (p *shardTracingPersistenceClient) UpdateShard(request *UpdateShardRequest) error {
+	span := p.startSpan("UpdateShard", request)
+	err := p.persistence.UpdateShard(request)
+	tracing.End(span, err)
+	return err
+}
+
+func (p *shardTracingPersistenceClient) Close() {
+	p.persistence.Close()
+}
+
+func (p *workflowExecutionTracingPersistenceClient) GetName() string {
+	return p.persistence.GetName()
+}
+
+func (p *workflowExecutionTracingPersistenceClient) GetShardID() int32 {
+	return p.persistence.GetShardID()
+}
+
+func (p *workflowExecutionTracingPersistenceClient) CreateWorkflowExecution(request *CreateWorkflowExecutionRequest) (*CreateWorkflowExecutionResponse, error) {
+	span := p.startSpan("CreateWorkflowExecution", request)
+	response, err := p.persistence.CreateWorkflowExecution(request)
+	tracing.End(span, err)
+	return response, err
+}
+
+func (p *workflowExecutionTracingPersistenceClient) GetWorkflowExecution(request *GetWorkflowExecutionRequest) (*GetWorkflowExecutionResponse, error) {
+	span := p.startSpan("GetWorkflowExecution", request)
+	response, err := p.persistence.GetWorkflowExecution(request)
+	tracing.End(span, err)
+	return response, err
+}
+
+func (p *workflowExecutionTracingPersistenceClient) UpdateWorkflowExecution(request *UpdateWorkflowExecutionRequest) (*UpdateWorkflowExecutionResponse, error) {
+	span := p.startSpan("UpdateWorkflowExecution", request)
+	response, err := p.persistence.UpdateWorkflowExecution(request)
+	tracing.End(span, err)
+	return response, err
+}
+
+func (p *workflowExecutionTracingPersistenceClient) ConflictResolveWorkflowExecution(request *ConflictResolveWorkflowExecutionRequest) error {
+	span := p.startSpan("ConflictResolveWorkflowExecution", request)
+	err := p.persistence.ConflictResolveWorkflowExecution(request)
+	tracing.End(span, err)
+	return err
+}
+
+func (p *workflowExecutionTracingPersistenceClient) ResetWorkflowExecution(request *ResetWorkflowExecutionRequest) error {
+	span := p.startSpan("ResetWorkflowExecution", request)
+	err := p.persistence.ResetWorkflowExecution(request)
+	tracing.End(span, err)
+	return err
+}
+
+func (p *workflowExecutionTracingPersistenceClient) DeleteWorkflowExecution(request *DeleteWorkflowExecutionRequest) error {
+	span := p.startSpan("DeleteWorkflowExecution", request)
+	err := p.persistence.DeleteWorkflowExecution(request)
+	tracing.End(span, err)
+	return err
+}
+
+func (p *workflowExecutionTracingPersistenceClient) DeleteCurrentWorkflowExecution(request *DeleteCurrentWorkflowExecutionRequest) error {
+	span := p.startSpan("DeleteCurrentWorkflowExecution", request)
+	err := p.persistence.DeleteCurrentWorkflowExecution(request)
+	tracing.End(span, err)
+	return err
+}
+
+func (p *workflowExecutionTracingPersistenceClient) GetCurrentExecution(request *GetCurrentExecutionRequest) (*GetCurrentExecutionResponse, error) {
+	span := p.startSpan("GetCurrentExecution", request)
+	response, err := p.persistence.GetCurrentExecution(request)
+	tracing.End(span, err)
+	return response, err
+}
+
+func (p *workflowExecutionTracingPersistenceClient) ListConcreteExecutions(request *ListConcreteExecutionsRequest) (*ListConcreteExecutionsResponse, error) {
+	span := p.startSpan("ListConcreteExecutions", request)
+	response, err := p.persistence.ListConcreteExecutions(request)
+	tracing.End(span, err)
+	return response, err
+}
+
+func (p *workflowExecutionTracingPersistenceClient) GetTransferTask(request *GetTransferTaskRequest) (*GetTransferTaskResponse, error) {
+	span := p.startSpan("GetTransferTask", request)
+	response, err := p.persistence.GetTransferTask(request)
+	tracing.End(span, err)
+	return response, err
+}
+
+func (p *workflowExecutionTracingPersistenceClient) GetTransferTasks(request *GetTransferTasksRequest) (*GetTransferTasksResponse, error) {
+	span := p.startSpan("GetTransferTasks", request)
+	response, err := p.persistence.GetTransferTasks(request)
+	tracing.End(span, err)
+	return response, err
+}
+
+func (p *workflowExecutionTracingPersistenceClient) GetReplicationTask(request *GetReplicationTaskRequest) (*GetReplicationTaskResponse, error) {
+	span := p.startSpan("GetReplicationTask", request)
+	response, err := p.persistence.GetReplicationTask(request)
+	tracing.End(span, err)
+	return response, err
+}
+
+func (p *workflowExecutionTracingPersistenceClient) GetReplicationTasks(request *GetReplicationTasksRequest) (*GetReplicationTasksResponse, error) {
+	span := p.startSpan("GetReplicationTasks", request)
+	response, err := p.persistence.GetReplicationTasks(request)
+	tracing.End(span, err)
+	return response, err
+}
+
+func (p *workflowExecutionTracingPersistenceClient) CompleteTransferTask(request *CompleteTransferTaskRequest) error {
+	span := p.startSpan("CompleteTransferTask", request)
+	err := p.persistence.CompleteTransferTask(request)
+	tracing.End(span, err)
+	return err
+}
+
+func (p *workflowExecutionTracingPersistenceClient) RangeCompleteTransferTask(request *RangeCompleteTransferTaskRequest) error {
+	span := p.startSpan("RangeCompleteTransferTask", request)
+	err := p.persistence.RangeCompleteTransferTask(request)
+	tracing.End(span, err)
+	return err
+}
+
+func (p *workflowExecutionTracingPersistenceClient) CompleteReplicationTask(request *CompleteReplicationTaskRequest) error {
+	span := p.startSpan("CompleteReplicationTask", request)
+	err := p.persistence.CompleteReplicationTask(request)
+	tracing.End(span, err)
+	return err
+}
+
+func (p *workflowExecutionTracingPersistenceClient) RangeCompleteReplicationTask(request *RangeCompleteReplicationTaskRequest) error {
+	span := p.startSpan("RangeCompleteReplicationTask", request)
+	err := p.persistence.RangeCompleteReplicationTask(request)
+	tracing.End(span, err)
+	return err
+}
+
+func (p *workflowExecutionTracingPersistenceClient) PutReplicationTaskToDLQ(
+	request *PutReplicationTaskToDLQRequest,
+) error {
+	span := p.startSpan("PutReplicationTaskToDLQ", request)
+	err := p.persistence.PutReplicationTaskToDLQ(request)
+	tracing.End(span, err)
+	return err
+}
+
+func (p *workflowExecutionTracingPersistenceClient) GetReplicationTasksFromDLQ(
+	request *GetReplicationTasksFromDLQRequest,
+) (*GetReplicationTasksFromDLQResponse, error) {
+	span := p.startSpan("GetReplicationTasksFromDLQ", request)
+	response, err := p.persistence.GetReplicationTasksFromDLQ(request)
+	tracing.End(span, err)
+	return response, err
+}
+
+func (p *workflowExecutionTracingPersistenceClient) DeleteReplicationTaskFromDLQ(
+	request *DeleteReplicationTaskFromDLQRequest,
+) error {
+	span := p.startSpan("DeleteReplicationTaskFromDLQ", request)
+	err := p.persistence.DeleteReplicationTaskFromDLQ(request)
+	tracing.End(span, err)
+	return err
+}
+
+func (p *workflowExecutionTracingPersistenceClient) RangeDeleteReplicationTaskFromDLQ(
+	request *RangeDeleteReplicationTaskFromDLQRequest,
+) error {
+	span := p.startSpan("RangeDeleteReplicationTaskFromDLQ", request)
+	err := p.persistence.RangeDeleteReplicationTaskFromDLQ(request)
+	tracing.End(span, err)
+	return err
+}
+
+func (p *workflowExecutionTracingPersistenceClient) GetTimerTask(request *GetTimerTaskRequest) (*GetTimerTaskResponse, error) {
+	span := p.startSpan("GetTimerTask", request)
+	response, err := p.persistence.GetTimerTask(request)
+	tracing.End(span, err)
+	return response, err
+}
+
+func (p *workflowExecutionTracingPersistenceClient) GetTimerIndexTasks(request *GetTimerIndexTasksRequest) (*GetTimerIndexTasksResponse, error) {
+	span := p.startSpan("GetTimerIndexTasks", request)
+	response, err := p.persistence.GetTimerIndexTasks(request)
+	tracing.End(span, err)
+	return response, err
+}
+
+func (p *workflowExecutionTracingPersistenceClient) CompleteTimerTask(request *CompleteTimerTaskRequest) error {
+	span := p.startSpan("CompleteTimerTask", request)
+	err := p.persistence.CompleteTimerTask(request)
+	tracing.End(span, err)
+	return err
+}
+
+func (p *workflowExecutionTracingPersistenceClient) RangeCompleteTimerTask(request *RangeCompleteTimerTaskRequest) error {
+	span := p.startSpan("RangeCompleteTimerTask", request)
+	err := p.persistence.RangeCompleteTimerTask(request)
+	tracing.End(span, err)
+	return err
+}
+
+func (p *workflowExecutionTracingPersistenceClient) Close() {
+	p.persistence.Close()
+}
+
+func (p *taskTracingPersistenceClient) GetName() string {
+	return p.persistence.GetName()
+}
+
+func (p *taskTracingPersistenceClient) CreateTasks(request *CreateTasksRequest) (*CreateTasksResponse, error) {
+	span := p.startSpan("CreateTasks", request)
+	response, err := p.persistence.CreateTasks(request)
+	tracing.End(span, err)
+	return response, err
+}
+
+func (p *taskTracingPersistenceClient) GetTasks(request *GetTasksRequest) (*GetTasksResponse, error) {
+	span := p.startSpan("GetTasks", request)
+	response, err := p.persistence.GetTasks(request)
+	tracing.End(span, err)
+	return response, err
+}
+
+func (p *taskTracingPersistenceClient) CompleteTask(request *CompleteTaskRequest) error {
+	span := p.startSpan("CompleteTask", request)
+	err := p.persistence.CompleteTask(request)
+	tracing.End(span, err)
+	return err
+}
+
+func (p *taskTracingPersistenceClient) CompleteTasksLessThan(request *CompleteTasksLessThanRequest) (int, error) {
+	span := p.startSpan("CompleteTasksLessThan", request)
+	response, err := p.persistence.CompleteTasksLessThan(request)
+	tracing.End(span, err)
+	return response, err
+}
+
+func (p *taskTracingPersistenceClient) LeaseTaskQueue(request *LeaseTaskQueueRequest) (*LeaseTaskQueueResponse, error) {
+	span := p.startSpan("LeaseTaskQueue", request)
+	response, err := p.persistence.LeaseTaskQueue(request)
+	tracing.End(span, err)
+	return response, err
+}
+
+func (p *taskTracingPersistenceClient) UpdateTaskQueue(request *UpdateTaskQueueRequest) (*UpdateTaskQueueResponse, error) {
+	span := p.startSpan("UpdateTaskQueue", request)
+	response, err := p.persistence.UpdateTaskQueue(request)
+	tracing.End(span, err)
+	return response, err
+}
+
+func (p *taskTracingPersistenceClient) ListTaskQueue(request *ListTaskQueueRequest) (*ListTaskQueueResponse, error) {
+	span := p.startSpan("ListTaskQueue", request)
+	response, err := p.persistence.ListTaskQueue(request)
+	tracing.End(span, err)
+	return response, err
+}
+
+func (p *taskTracingPersistenceClient) DeleteTaskQueue(request *DeleteTaskQueueRequest) error {
+	span := p.startSpan("DeleteTaskQueue", request)
+	err := p.persistence.DeleteTaskQueue(request)
+	tracing.End(span, err)
+	return err
+}
+
+func (p *taskTracingPersistenceClient) Close() {
+	p.persistence.Close()
+}
+
+func (p *metadataTracingPersistenceClient) GetName() string {
+	return p.persistence.GetName()
+}
+
+func (p *metadataTracingPersistenceClient) CreateNamespace(request *CreateNamespaceRequest) (*CreateNamespaceResponse, error) {
+	span := p.startSpan("CreateNamespace", request)
+	response, err := p.persistence.CreateNamespace(request)
+	tracing.End(span, err)
+	return response, err
+}
+
+func (p *metadataTracingPersistenceClient) GetNamespace(request *GetNamespaceRequest) (*GetNamespaceResponse, error) {
+	span := p.startSpan("GetNamespace", request)
+	response, err := p.persistence.GetNamespace(request)
+	tracing.End(span, err)
+	return response, err
+}
+
+func (p *metadataTracingPersistenceClient) UpdateNamespace(request *UpdateNamespaceRequest) error {
+	span := p.startSpan("UpdateNamespace", request)
+	err := p.persistence.UpdateNamespace(request)
+	tracing.End(span, err)
+	return err
+}
+
+func (p *metadataTracingPersistenceClient) DeleteNamespace(request *DeleteNamespaceRequest) error {
+	span := p.startSpan("DeleteNamespace", request)
+	err := p.persistence.DeleteNamespace(request)
+	tracing.End(span, err)
+	return err
+}
+
+func (p *metadataTracingPersistenceClient) DeleteNamespaceByName(request *DeleteNamespaceByNameRequest) error {
+	span := p.startSpan("DeleteNamespaceByName", request)
+	err := p.persistence.DeleteNamespaceByName(request)
+	tracing.End(span, err)
+	return err
+}
+
+func (p *metadataTracingPersistenceClient) ListNamespaces(request *ListNamespacesRequest) (*ListNamespacesResponse, error) {
+	span := p.startSpan("ListNamespaces", request)
+	response, err := p.persistence.ListNamespaces(request)
+	tracing.End(span, err)
+	return response, err
+}
+
+func (p *metadataTracingPersistenceClient) GetMetadata() (*GetMetadataResponse, error) {
+	span := p.startSpan("GetMetadata", nil)
+	response, err := p.persistence.GetMetadata()
+	tracing.End(span, err)
+	return response, err
+}
+
+func (p *metadataTracingPersistenceClient) Close() {
+	p.persistence.Close()
+}
+
+func (p *visibilityTracingPersistenceClient) GetName() string {
+	return p.persistence.GetName()
+}
+
+func (p *visibilityTracingPersistenceClient) RecordWorkflowExecutionStarted(request *RecordWorkflowExecutionStartedRequest) error {
+	span := p.startSpan("RecordWorkflowExecutionStarted", request)
+	err := p.persistence.RecordWorkflowExecutionStarted(request)
+	tracing.End(span, err)
+	return err
+}
+
+func (p *visibilityTracingPersistenceClient) RecordWorkflowExecutionClosed(request *RecordWorkflowExecutionClosedRequest) error {
+	span := p.startSpan("RecordWorkflowExecutionClosed", request)
+	err := p.persistence.RecordWorkflowExecutionClosed(request)
+	tracing.End(span, err)
+	return err
+}
+
+func (p *visibilityTracingPersistenceClient) UpsertWorkflowExecution(request *UpsertWorkflowExecutionRequest) error {
+	span := p.startSpan("UpsertWorkflowExecution", request)
+	err := p.persistence.UpsertWorkflowExecution(request)
+	tracing.End(span, err)
+	return err
+}
+
+func (p *visibilityTracingPersistenceClient) ListOpenWorkflowExecutions(request *ListWorkflowExecutionsRequest) (*ListWorkflowExecutionsResponse, error) {
+	span := p.startSpan("ListOpenWorkflowExecutions", request)
+	response, err := p.persistence.ListOpenWorkflowExecutions(request)
+	tracing.End(span, err)
+	return response, err
+}
+
+func (p *visibilityTracingPersistenceClient) ListClosedWorkflowExecutions(request *ListWorkflowExecutionsRequest) (*ListWorkflowExecutionsResponse, error) {
+	span := p.startSpan("ListClosedWorkflowExecutions", request)
+	response, err := p.persistence.ListClosedWorkflowExecutions(request)
+	tracing.End(span, err)
+	return response, err
+}
+
+func (p *visibilityTracingPersistenceClient) ListOpenWorkflowExecutionsByType(request *ListWorkflowExecutionsByTypeRequest) (*ListWorkflowExecutionsResponse, error) {
+	span := p.startSpan("ListOpenWorkflowExecutionsByType", request)
+	response, err := p.persistence.ListOpenWorkflowExecutionsByType(request)
+	tracing.End(span, err)
+	return response, err
+}
+
+func (p *visibilityTracingPersistenceClient) ListClosedWorkflowExecutionsByType(request *ListWorkflowExecutionsByTypeRequest) (*ListWorkflowExecutionsResponse, error) {
+	span := p.startSpan("ListClosedWorkflowExecutionsByType", request)
+	response, err := p.persistence.ListClosedWorkflowExecutionsByType(request)
+	tracing.End(span, err)
+	return response, err
+}
+
+func (p *visibilityTracingPersistenceClient) ListOpenWorkflowExecutionsByWorkflowID(request *ListWorkflowExecutionsByWorkflowIDRequest) (*ListWorkflowExecutionsResponse, error) {
+	span := p.startSpan("ListOpenWorkflowExecutionsByWorkflowID", request)
+	response, err := p.persistence.ListOpenWorkflowExecutionsByWorkflowID(request)
+	tracing.End(span, err)
+	return response, err
+}
+
+func (p *visibilityTracingPersistenceClient) ListClosedWorkflowExecutionsByWorkflowID(request *ListWorkflowExecutionsByWorkflowIDRequest) (*ListWorkflowExecutionsResponse, error) {
+	span := p.startSpan("ListClosedWorkflowExecutionsByWorkflowID", request)
+	response, err := p.persistence.ListClosedWorkflowExecutionsByWorkflowID(request)
+	tracing.End(span, err)
+	return response, err
+}
+
+func (p *visibilityTracingPersistenceClient) ListClosedWorkflowExecutionsByStatus(request *ListClosedWorkflowExecutionsByStatusRequest) (*ListWorkflowExecutionsResponse, error) {
+	span := p.startSpan("ListClosedWorkflowExecutionsByStatus", request)
+	response, err := p.persistence.ListClosedWorkflowExecutionsByStatus(request)
+	tracing.End(span, err)
+	return response, err
+}
+
+func (p *visibilityTracingPersistenceClient) GetClosedWorkflowExecution(request *GetClosedWorkflowExecutionRequest) (*GetClosedWorkflowExecutionResponse, error) {
+	span := p.startSpan("GetClosedWorkflowExecution", request)
+	response, err := p.persistence.GetClosedWorkflowExecution(request)
+	tracing.End(span, err)
+	return response, err
+}
+
+func (p *visibilityTracingPersistenceClient) DeleteWorkflowExecution(request *VisibilityDeleteWorkflowExecutionRequest) error {
+	span := p.startSpan("DeleteWorkflowExecution", request)
+	err := p.persistence.DeleteWorkflowExecution(request)
+	tracing.End(span, err)
+	return err
+}
+
+func (p *visibilityTracingPersistenceClient) ListWorkflowExecutions(request *ListWorkflowExecutionsRequestV2) (*ListWorkflowExecutionsResponse, error) {
+	span := p.startSpan("ListWorkflowExecutions", request)
+	response, err := p.persistence.ListWorkflowExecutions(request)
+	tracing.End(span, err)
+	return response, err
+}
+
+func (p *visibilityTracingPersistenceClient) ScanWorkflowExecutions(request *ListWorkflowExecutionsRequestV2) (*ListWorkflowExecutionsResponse, error) {
+	span := p.startSpan("ScanWorkflowExecutions", request)
+	response, err := p.persistence.ScanWorkflowExecutions(request)
+	tracing.End(span, err)
+	return response, err
+}
+
+func (p *visibilityTracingPersistenceClient) CountWorkflowExecutions(request *CountWorkflowExecutionsRequest) (*CountWorkflowExecutionsResponse, error) {
+	span := p.startSpan("CountWorkflowExecutions", request)
+	response, err := p.persistence.CountWorkflowExecutions(request)
+	tracing.End(span, err)
+	return response, err
+}
+
+func (p *visibilityTracingPersistenceClient) Close() {
+	p.persistence.Close()
+}
+
+func (p *historyV2TracingPersistenceClient) GetName() string {
+	return p.persistence.GetName()
+}
+
+func (p *historyV2TracingPersistenceClient) Close() {
+	p.persistence.Close()
+}
+
+// AppendHistoryNodes add(or override) a node to a history branch
+func (p *historyV2TracingPersistenceClient) AppendHistoryNodes(request *AppendHistoryNodesRequest) (*AppendHistoryNodesResponse, error) {
+	span := p.startSpan("AppendHistoryNodes", request)
+	response, err := p.persistence.AppendHistoryNodes(request)
+	tracing.End(span, err)
+	return response, err
+}
+
+// ReadHistoryBranch returns history node data for a branch
+func (p *historyV2TracingPersistenceClient) ReadHistoryBranch(request *ReadHistoryBranchRequest) (*ReadHistoryBranchResponse, error) {
+	span := p.startSpan("ReadHistoryBranch", request)
+	response, err := p.persistence.ReadHistoryBranch(request)
+	tracing.End(span, err)
+	return response, err
+}
+
+// ReadHistoryBranchByBatch returns history node data for a branch
+func (p *historyV2TracingPersistenceClient) ReadHistoryBranchByBatch(request *ReadHistoryBranchRequest) (*ReadHistoryBranchByBatchResponse, error) {
+	span := p.startSpan("ReadHistoryBranchByBatch", request)
+	response, err := p.persistence.ReadHistoryBranchByBatch(request)
+	tracing.End(span, err)
+	return response, err
+}
+
+// ReadHistoryBranchByBatch returns history node data for a branch
+func (p *historyV2TracingPersistenceClient) ReadRawHistoryBranch(request *ReadHistoryBranchRequest) (*ReadRawHistoryBranchResponse, error) {
+	span := p.startSpan("ReadRawHistoryBranch", request)
+	response, err := p.persistence.ReadRawHistoryBranch(request)
+	tracing.End(span, err)
+	return response, err
+}
+
+// ForkHistoryBranch forks a new branch from a old branch
+func (p *historyV2TracingPersistenceClient) ForkHistoryBranch(request *ForkHistoryBranchRequest) (*ForkHistoryBranchResponse, error) {
+	span := p.startSpan("ForkHistoryBranch", request)
+	response, err := p.persistence.ForkHistoryBranch(request)
+	tracing.End(span, err)
+	return response, err
+}
+
+// DeleteHistoryBranch removes a branch
+func (p *historyV2TracingPersistenceClient) DeleteHistoryBranch(request *DeleteHistoryBranchRequest) error {
+	span := p.startSpan("DeleteHistoryBranch", request)
+	err := p.persistence.DeleteHistoryBranch(request)
+	tracing.End(span, err)
+	return err
+}
+
+// GetHistoryTree returns all branch information of a tree
+func (p *historyV2TracingPersistenceClient) GetHistoryTree(request *GetHistoryTreeRequest) (*GetHistoryTreeResponse, error) {
+	span := p.startSpan("GetHistoryTree", request)
+	response, err := p.persistence.GetHistoryTree(request)
+	tracing.End(span, err)
+	return response, err
+}
+
+func (p *historyV2TracingPersistenceClient) GetAllHistoryTreeBranches(request *GetAllHistoryTreeBranchesRequest) (*GetAllHistoryTreeBranchesResponse, error) {
+	span := p.startSpan("GetAllHistoryTreeBranches", request)
+	response, err := p.persistence.GetAllHistoryTreeBranches(request)
+	tracing.End(span, err)
+	return response, err
+}
+
+func (p *queueTracingPersistenceClient) EnqueueMessage(message []byte) error {
+	span := p.startSpan("EnqueueMessage", nil)
+	err := p.persistence.EnqueueMessage(message)
+	tracing.End(span, err)
+	return err
+}
+
+func (p *queueTracingPersistenceClient) ReadMessages(lastMessageID int64, maxCount int) ([]*QueueMessage, error) {
+	span := p.startSpan("ReadMessages", nil)
+	response, err := p.persistence.ReadMessages(lastMessageID, maxCount)
+	tracing.End(span, err)
+	return response, err
+}
+
+func (p *queueTracingPersistenceClient) UpdateAckLevel(messageID int64, clusterName string) error {
+	span := p.startSpan("UpdateAckLevel", nil)
+	err := p.persistence.UpdateAckLevel(messageID, clusterName)
+	tracing.End(span, err)
+	return err
+}
+
+func (p *queueTracingPersistenceClient) GetAckLevels() (map[string]int64, error) {
+	span := p.startSpan("GetAckLevels", nil)
+	response, err := p.persistence.GetAckLevels()
+	tracing.End(span, err)
+	return response, err
+}
+
+func (p *queueTracingPersistenceClient) DeleteMessagesBefore(messageID int64) error {
+	span := p.startSpan("DeleteMessagesBefore", nil)
+	err := p.persistence.DeleteMessagesBefore(messageID)
+	tracing.End(span, err)
+	return err
+}
+
+func (p *queueTracingPersistenceClient) EnqueueMessageToDLQ(message []byte) (int64, error) {
+	span := p.startSpan("EnqueueMessageToDLQ", nil)
+	response, err := p.persistence.EnqueueMessageToDLQ(message)
+	tracing.End(span, err)
+	return response, err
+}
+
+func (p *queueTracingPersistenceClient) ReadMessagesFromDLQ(firstMessageID int64, lastMessageID int64, pageSize int, pageToken []byte) ([]*QueueMessage, []byte, error) {
+	span := p.startSpan("ReadMessagesFromDLQ", nil)
+	response, nextPageToken, err := p.persistence.ReadMessagesFromDLQ(firstMessageID, lastMessageID, pageSize, pageToken)
+	tracing.End(span, err)
+	return response, nextPageToken, err
+}
+
+func (p *queueTracingPersistenceClient) RangeDeleteMessagesFromDLQ(firstMessageID int64, lastMessageID int64) error {
+	span := p.startSpan("RangeDeleteMessagesFromDLQ", nil)
+	err := p.persistence.RangeDeleteMessagesFromDLQ(firstMessageID, lastMessageID)
+	tracing.End(span, err)
+	return err
+}
+func (p *queueTracingPersistenceClient) UpdateDLQAckLevel(messageID int64, clusterName string) error {
+	span := p.startSpan("UpdateDLQAckLevel", nil)
+	err := p.persistence.UpdateDLQAckLevel(messageID, clusterName)
+	tracing.End(span, err)
+	return err
+}
+
+func (p *queueTracingPersistenceClient) GetDLQAckLevels() (map[string]int64, error) {
+	span := p.startSpan("GetDLQAckLevels", nil)
+	response, err := p.persistence.GetDLQAckLevels()
+	tracing.End(span, err)
+	return response, err
+}
+
+func (p *queueTracingPersistenceClient) DeleteMessageFromDLQ(messageID int64) error {
+	span := p.startSpan("DeleteMessageFromDLQ", nil)
+	err := p.persistence.DeleteMessageFromDLQ(messageID)
+	tracing.End(span, err)
+	return err
+}
+
+func (p *queueTracingPersistenceClient) Close() {
+	p.persistence.Close()
+}
+
+func (c *clusterMetadataTracingPersistenceClient) Close() {
+	c.persistence.Close()
+}
+
+func (c *clusterMetadataTracingPersistenceClient) GetName() string {
+	return c.persistence.GetName()
+}
+
+func (c *clusterMetadataTracingPersistenceClient) GetClusterMembers(request *GetClusterMembersRequest) (*GetClusterMembersResponse, error) {
+	span := c.startSpan("GetClusterMembers", request)
+	response, err := c.persistence.GetClusterMembers(request)
+	tracing.End(span, err)
+	return response, err
+}
+
+func (c *clusterMetadataTracingPersistenceClient) UpsertClusterMembership(request *UpsertClusterMembershipRequest) error {
+	span := c.startSpan("UpsertClusterMembership", request)
+	err := c.persistence.UpsertClusterMembership(request)
+	tracing.End(span, err)
+	return err
+}
+
+func (c *clusterMetadataTracingPersistenceClient) PruneClusterMembership(request *PruneClusterMembershipRequest) error {
+	span := c.startSpan("PruneClusterMembership", request)
+	err := c.persistence.PruneClusterMembership(request)
+	tracing.End(span, err)
+	return err
+}
+
+func (c *clusterMetadataTracingPersistenceClient) GetClusterMetadata() (*GetClusterMetadataResponse, error) {
+	span := c.startSpan("GetClusterMetadata", nil)
+	response, err := c.persistence.GetClusterMetadata()
+	tracing.End(span, err)
+	return response, err
+}
+
+func (c *clusterMetadataTracingPersistenceClient) SaveClusterMetadata(request *SaveClusterMetadataRequest) (bool, error) {
+	span := c.startSpan("SaveClusterMetadata", request)
+	response, err := c.persistence.SaveClusterMetadata(request)
+	tracing.End(span, err)
+	return response, err
+}
+
+func (c *metadataTracingPersistenceClient) InitializeSystemNamespaces(currentClusterName string) error {
+	span := c.startSpan("InitializeSystemNamespaces", nil)
+	err := c.persistence.InitializeSystemNamespaces(currentClusterName)
+	tracing.End(span, err)
+	return err
+}
+
+func (p *shardTracingPersistenceClient) startSpan(operation string, request interface{}) trace.Span {
+	return startPersistenceSpan(operation, p.persistence.GetName(), request)
+}
+
+func (p *workflowExecutionTracingPersistenceClient) startSpan(operation string, request interface{}) trace.Span {
+	return startPersistenceSpan(operation, p.persistence.GetName(), request, tracing.ShardIDKey.Int32(p.persistence.GetShardID()))
+}
+
+func (p *taskTracingPersistenceClient) startSpan(operation string, request interface{}) trace.Span {
+	return startPersistenceSpan(operation, p.persistence.GetName(), request)
+}
+
+func (p *historyV2TracingPersistenceClient) startSpan(operation string, request interface{}) trace.Span {
+	return startPersistenceSpan(operation, p.persistence.GetName(), request)
+}
+
+func (p *metadataTracingPersistenceClient) startSpan(operation string, request interface{}) trace.Span {
+	return startPersistenceSpan(operation, p.persistence.GetName(), request)
+}
+
+func (c *clusterMetadataTracingPersistenceClient) startSpan(operation string, request interface{}) trace.Span {
+	return startPersistenceSpan(operation, c.persistence.GetName(), request)
+}
+
+func (p *visibilityTracingPersistenceClient) startSpan(operation string, request interface{}) trace.Span {
+	return startPersistenceSpan(operation, p.persistence.GetName(), request)
+}
+
+func (p *queueTracingPersistenceClient) startSpan(operation string, request interface{}) trace.Span {
+	return startPersistenceSpan(operation, "", request)
+}
+
+// startPersistenceSpan starts the span of a persistence call. The persistence managers do not take a
+// context, so the span is a root span which carries the shard, namespace and workflow of the call
+func startPersistenceSpan(operation string, store string, request interface{}, attributes ...label.KeyValue) trace.Span {
+	if store != "" {
+		attributes = append(attributes, tracing.StoreKey.String(store))
+	}
+	attributes = append(attributes, persistenceRequestAttributes(request)...)
+	_, span := tracing.Tracer().Start(context.Background(), "persistence."+operation,
+		trace.WithSpanKind(trace.SpanKindClient),
+		trace.WithAttributes(attributes...),
+	)
+	return span
+}
+
+// persistenceRequestAttributes returns the shard, namespace and workflow attributes of a persistence request
+func persistenceRequestAttributes(request interface{}) []label.KeyValue {
+	switch r := request.(type) {
+	case nil:
+		return nil
+	case *CreateWorkflowExecutionRequest:
+		return executionInfoAttributes(r.NewWorkflowSnapshot.ExecutionInfo)
+	case *UpdateWorkflowExecutionRequest:
+		return executionInfoAttributes(r.UpdateWorkflowMutation.ExecutionInfo)
+	case *ConflictResolveWorkflowExecutionRequest:
+		return executionInfoAttributes(r.ResetWorkflowSnapshot.ExecutionInfo)
+	case *ResetWorkflowExecutionRequest:
+		return executionInfoAttributes(r.NewWorkflowSnapshot.ExecutionInfo)
+	case *CreateTasksRequest:
+		if r.TaskQueueInfo == nil {
+			return nil
+		}
+		return []label.KeyValue{tracing.NamespaceIDKey.String(r.TaskQueueInfo.Data.GetNamespaceId())}
+	}
+
+	// the other requests consistently name the fields of their shard, namespace and workflow
+	value := reflect.Indirect(reflect.ValueOf(request))
+	if value.Kind() != reflect.Struct {
+		return nil
+	}
+	var attributes []label.KeyValue
+	if field := value.FieldByName("ShardID"); field.IsValid() {
+		if field.Kind() == reflect.Ptr && !field.IsNil() {
+			field = field.Elem()
+		}
+		if field.Kind() == reflect.Int32 {
+			attributes = append(attributes, tracing.ShardIDKey.Int32(int32(field.Int())))
+		}
+	}
+	if field := value.FieldByName("NamespaceID"); field.IsValid() && field.Kind() == reflect.String && field.String() != "" {
+		attributes = append(attributes, tracing.NamespaceIDKey.String(field.String()))
+	}
+	if field := value.FieldByName("Execution"); field.IsValid() {
+		switch execution := field.Interface().(type) {
+		case commonpb.WorkflowExecution:
+			return append(attributes, tracing.WorkflowAttributes(execution.GetWorkflowId(), execution.GetRunId())...)
+		case *commonpb.WorkflowExecution:
+			return append(attributes, tracing.WorkflowAttributes(execution.GetWorkflowId(), execution.GetRunId())...)
+		}
+	}
+	var workflowID, runID string
+	if field := value.FieldByName("WorkflowID"); field.IsValid() && field.Kind() == reflect.String {
+		workflowID = field.String()
+	}
+	if field := value.FieldByName("RunID"); field.IsValid() && field.Kind() == reflect.String {
+		runID = field.String()
+	}
+	return append(attributes, tracing.WorkflowAttributes(workflowID, runID)...)
+}
+
+func executionInfoAttributes(info *WorkflowExecutionInfo) []label.KeyValue {
+	if info == nil {
+		return nil
+	}
+	attributes := []label.KeyValue{tracing.NamespaceIDKey.String(info.NamespaceId)}
+	return append(attributes, tracing.WorkflowAttributes(info.WorkflowId, info.ExecutionState.GetRunId())...)
+}
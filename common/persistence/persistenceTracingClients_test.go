@@ -0,0 +1,326 @@
+// The MIT License
+//
+// Copyright (c) 2020 Temporal Technologies Inc.  All rights reserved.
+//
+// Copyright (c) 2020 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package persistence
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"github.com/stretchr/testify/suite"
+	"go.opentelemetry.io/otel/api/global"
+	"go.opentelemetry.io/otel/api/trace"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/label"
+	"go.opentelemetry.io/otel/sdk/export/trace/tracetest"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	commonpb "go.temporal.io/api/common/v1"
+
+	"go.temporal.io/server/common/log"
+	"go.temporal.io/server/common/tracing"
+)
+
+type (
+	tracingPersistenceSuite struct {
+		suite.Suite
+		*require.Assertions
+
+		exporter         *tracetest.InMemoryExporter
+		previousProvider trace.TracerProvider
+	}
+
+	testTracedShardManager struct {
+		ShardManager
+		err error
+	}
+
+	testTracedExecutionManager struct {
+		ExecutionManager
+		err error
+	}
+
+	testTracedTaskManager struct {
+		TaskManager
+		err error
+	}
+
+	testTracedHistoryManager struct {
+		HistoryManager
+		err error
+	}
+
+	testTracedMetadataManager struct {
+		MetadataManager
+		err error
+	}
+
+	testTracedClusterMetadataManager struct {
+		ClusterMetadataManager
+		err error
+	}
+
+	testTracedVisibilityManager struct {
+		VisibilityManager
+		err error
+	}
+
+	testTracedQueue struct {
+		Queue
+		err error
+	}
+)
+
+const (
+	testTracedStoreName = "test-store"
+)
+
+func TestTracingPersistenceSuite(t *testing.T) {
+	s := new(tracingPersistenceSuite)
+	suite.Run(t, s)
+}
+
+func (s *tracingPersistenceSuite) SetupTest() {
+	s.Assertions = require.New(s.T())
+
+	s.exporter = tracetest.NewInMemoryExporter()
+	s.previousProvider = global.TracerProvider()
+	global.SetTracerProvider(sdktrace.NewTracerProvider(sdktrace.WithSyncer(s.exporter)))
+}
+
+func (s *tracingPersistenceSuite) TearDownTest() {
+	global.SetTracerProvider(s.previousProvider)
+}
+
+func (s *tracingPersistenceSuite) TestTracingClients() {
+	logger := log.NewNoop()
+	shardID := int32(7)
+	execution := commonpb.WorkflowExecution{WorkflowId: "test-workflow-id", RunId: "test-run-id"}
+
+	testCases := []struct {
+		name               string
+		call               func(err error) error
+		expectedSpan       string
+		expectedAttributes []label.KeyValue
+	}{
+		{
+			name: "shard",
+			call: func(err error) error {
+				_, err = NewShardPersistenceTracingClient(&testTracedShardManager{err: err}, logger).GetShard(&GetShardRequest{ShardID: 3})
+				return err
+			},
+			expectedSpan: "persistence.GetShard",
+			expectedAttributes: []label.KeyValue{
+				tracing.StoreKey.String(testTracedStoreName),
+				tracing.ShardIDKey.Int32(3),
+			},
+		},
+		{
+			name: "execution",
+			call: func(err error) error {
+				_, err = NewWorkflowExecutionPersistenceTracingClient(&testTracedExecutionManager{err: err}, logger).GetWorkflowExecution(&GetWorkflowExecutionRequest{
+					NamespaceID: "test-namespace-id",
+					Execution:   execution,
+				})
+				return err
+			},
+			expectedSpan: "persistence.GetWorkflowExecution",
+			expectedAttributes: []label.KeyValue{
+				tracing.StoreKey.String(testTracedStoreName),
+				tracing.ShardIDKey.Int32(shardID),
+				tracing.NamespaceIDKey.String("test-namespace-id"),
+				tracing.WorkflowIDKey.String("test-workflow-id"),
+				tracing.RunIDKey.String("test-run-id"),
+			},
+		},
+		{
+			name: "task",
+			call: func(err error) error {
+				_, err = NewTaskPersistenceTracingClient(&testTracedTaskManager{err: err}, logger).GetTasks(&GetTasksRequest{
+					NamespaceID: "test-namespace-id",
+					TaskQueue:   "test-task-queue",
+				})
+				return err
+			},
+			expectedSpan: "persistence.GetTasks",
+			expectedAttributes: []label.KeyValue{
+				tracing.StoreKey.String(testTracedStoreName),
+				tracing.NamespaceIDKey.String("test-namespace-id"),
+			},
+		},
+		{
+			name: "history",
+			call: func(err error) error {
+				_, err = NewHistoryV2PersistenceTracingClient(&testTracedHistoryManager{err: err}, logger).ReadHistoryBranch(&ReadHistoryBranchRequest{
+					ShardID: &shardID,
+				})
+				return err
+			},
+			expectedSpan: "persistence.ReadHistoryBranch",
+			expectedAttributes: []label.KeyValue{
+				tracing.StoreKey.String(testTracedStoreName),
+				tracing.ShardIDKey.Int32(shardID),
+			},
+		},
+		{
+			name: "metadata",
+			call: func(err error) error {
+				_, err = NewMetadataPersistenceTracingClient(&testTracedMetadataManager{err: err}, logger).GetNamespace(&GetNamespaceRequest{Name: "test-namespace"})
+				return err
+			},
+			expectedSpan: "persistence.GetNamespace",
+			expectedAttributes: []label.KeyValue{
+				tracing.StoreKey.String(testTracedStoreName),
+			},
+		},
+		{
+			name: "cluster metadata",
+			call: func(err error) error {
+				_, err = NewClusterMetadataPersistenceTracingClient(&testTracedClusterMetadataManager{err: err}, logger).GetClusterMembers(&GetClusterMembersRequest{})
+				return err
+			},
+			expectedSpan: "persistence.GetClusterMembers",
+			expectedAttributes: []label.KeyValue{
+				tracing.StoreKey.String(testTracedStoreName),
+			},
+		},
+		{
+			name: "visibility",
+			call: func(err error) error {
+				_, err = NewVisibilityPersistenceTracingClient(&testTracedVisibilityManager{err: err}, logger).ListOpenWorkflowExecutions(&ListWorkflowExecutionsRequest{
+					NamespaceID: "test-namespace-id",
+				})
+				return err
+			},
+			expectedSpan: "persistence.ListOpenWorkflowExecutions",
+			expectedAttributes: []label.KeyValue{
+				tracing.StoreKey.String(testTracedStoreName),
+				tracing.NamespaceIDKey.String("test-namespace-id"),
+			},
+		},
+		{
+			name: "queue",
+			call: func(err error) error {
+				return NewQueuePersistenceTracingClient(&testTracedQueue{err: err}, logger).EnqueueMessage([]byte("message"))
+			},
+			expectedSpan:       "persistence.EnqueueMessage",
+			expectedAttributes: nil,
+		},
+	}
+
+	for _, tc := range testCases {
+		for _, callErr := range []error{nil, errors.New("persistence error")} {
+			s.exporter.Reset()
+
+			err := tc.call(callErr)
+			s.Equal(callErr, err, tc.name)
+
+			spans := s.exporter.GetSpans()
+			s.Len(spans, 1, tc.name)
+			span := spans[0]
+			s.Equal(tc.expectedSpan, span.Name, tc.name)
+			s.Equal(trace.SpanKindClient, span.SpanKind, tc.name)
+			// the persistence managers take no context, so their spans are the roots of their own traces
+			s.False(span.ParentSpanID.IsValid(), tc.name)
+			s.Equal(attributeValues(tc.expectedAttributes), attributeValues(span.Attributes), tc.name)
+			if callErr != nil {
+				s.Equal(codes.Error, span.StatusCode, tc.name)
+			} else {
+				s.Equal(codes.Unset, span.StatusCode, tc.name)
+			}
+		}
+	}
+}
+
+func attributeValues(attributes []label.KeyValue) map[label.Key]interface{} {
+	values := make(map[label.Key]interface{}, len(attributes))
+	for _, attribute := range attributes {
+		values[attribute.Key] = attribute.Value.AsInterface()
+	}
+	return values
+}
+
+func (m *testTracedShardManager) GetName() string {
+	return testTracedStoreName
+}
+
+func (m *testTracedShardManager) GetShard(_ *GetShardRequest) (*GetShardResponse, error) {
+	return &GetShardResponse{}, m.err
+}
+
+func (m *testTracedExecutionManager) GetName() string {
+	return testTracedStoreName
+}
+
+func (m *testTracedExecutionManager) GetShardID() int32 {
+	return 7
+}
+
+func (m *testTracedExecutionManager) GetWorkflowExecution(_ *GetWorkflowExecutionRequest) (*GetWorkflowExecutionResponse, error) {
+	return &GetWorkflowExecutionResponse{}, m.err
+}
+
+func (m *testTracedTaskManager) GetName() string {
+	return testTracedStoreName
+}
+
+func (m *testTracedTaskManager) GetTasks(_ *GetTasksRequest) (*GetTasksResponse, error) {
+	return &GetTasksResponse{}, m.err
+}
+
+func (m *testTracedHistoryManager) GetName() string {
+	return testTracedStoreName
+}
+
+func (m *testTracedHistoryManager) ReadHistoryBranch(_ *ReadHistoryBranchRequest) (*ReadHistoryBranchResponse, error) {
+	return &ReadHistoryBranchResponse{}, m.err
+}
+
+func (m *testTracedMetadataManager) GetName() string {
+	return testTracedStoreName
+}
+
+func (m *testTracedMetadataManager) GetNamespace(_ *GetNamespaceRequest) (*GetNamespaceResponse, error) {
+	return &GetNamespaceResponse{}, m.err
+}
+
+func (m *testTracedClusterMetadataManager) GetName() string {
+	return testTracedStoreName
+}
+
+func (m *testTracedClusterMetadataManager) GetClusterMembers(_ *GetClusterMembersRequest) (*GetClusterMembersResponse, error) {
+	return &GetClusterMembersResponse{}, m.err
+}
+
+func (m *testTracedVisibilityManager) GetName() string {
+	return testTracedStoreName
+}
+
+func (m *testTracedVisibilityManager) ListOpenWorkflowExecutions(_ *ListWorkflowExecutionsRequest) (*ListWorkflowExecutionsResponse, error) {
+	return &ListWorkflowExecutionsResponse{}, m.err
+}
+
+func (q *testTracedQueue) EnqueueMessage(_ []byte) error {
+	return q.err
+}
@@ -82,6 +82,9 @@ func WorkflowExecutionToProto(executionInfo *WorkflowExecutionInfo, startVersion
 		// Dual write to move away from HistorySize in future
 		HistorySize:    executionInfo.ExecutionStats.GetHistorySize(),
 		ExecutionStats: executionInfo.ExecutionStats,
+		Paused:         executionInfo.Paused,
+		PauseReason:    executionInfo.PauseReason,
+		UpdateInfos:    executionInfo.UpdateInfos,
 	}
 
 	if !timestamp.TimeValue(executionInfo.WorkflowExpirationTime).IsZero() {
@@ -175,6 +178,9 @@ func WorkflowExecutionFromProto(info *persistenceblobs.WorkflowExecutionInfo, st
 		Memo:                                   info.GetMemo(),
 		CompletionEvent:                        info.GetCompletionEvent(),
 		AutoResetPoints:                        info.GetAutoResetPoints(),
+		Paused:                                 info.GetPaused(),
+		PauseReason:                            info.GetPauseReason(),
+		UpdateInfos:                            info.GetUpdateInfos(),
 	}
 
 	// Back compat for GetHistorySize
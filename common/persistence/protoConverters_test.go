@@ -109,7 +109,7 @@ func (s *protoConverterSuite) TestConvertWorkflowExecution_Success() {
 	s.NotNil(protoState)
 	s.Equal(protoWei.VersionHistories, vh)
 
-	weiBytes, err := serialization.WorkflowExecutionInfoToBlob(protoWei)
+	weiBytes, err := serialization.WorkflowExecutionInfoToBlob(protoWei, enumspb.ENCODING_TYPE_PROTO3)
 	s.NoError(err)
 	stateBytes, err := serialization.WorkflowExecutionStateToBlob(protoState)
 	s.NoError(err)
@@ -173,7 +173,7 @@ func (s *protoConverterSuite) TestConvertWorkflowExecution_HistorySizeMigration(
 	// Emulate old proto
 	protoWei.ExecutionStats = nil
 
-	weiBytes, err := serialization.WorkflowExecutionInfoToBlob(protoWei)
+	weiBytes, err := serialization.WorkflowExecutionInfoToBlob(protoWei, enumspb.ENCODING_TYPE_PROTO3)
 	s.NoError(err)
 	stateBytes, err := serialization.WorkflowExecutionStateToBlob(protoState)
 	s.NoError(err)
@@ -136,13 +136,17 @@ func HistoryBranchFromBlob(b []byte, proto string) (*persistenceblobs.HistoryBra
 	return result, proto3Decode(b, proto, result)
 }
 
-func WorkflowExecutionInfoToBlob(info *persistenceblobs.WorkflowExecutionInfo) (DataBlob, error) {
-	return proto3Encode(info)
+func WorkflowExecutionInfoToBlob(info *persistenceblobs.WorkflowExecutionInfo, encoding enumspb.EncodingType) (DataBlob, error) {
+	blob, err := proto3Encode(info)
+	if err != nil {
+		return blob, err
+	}
+	return Compress(blob, encoding)
 }
 
 func WorkflowExecutionInfoFromBlob(b []byte, proto string) (*persistenceblobs.WorkflowExecutionInfo, error) {
 	result := &persistenceblobs.WorkflowExecutionInfo{}
-	return result, proto3Decode(b, proto, result)
+	return result, proto3DecodeCompressed(b, proto, result)
 }
 
 func WorkflowExecutionStateToBlob(info *persistenceblobs.WorkflowExecutionState) (DataBlob, error) {
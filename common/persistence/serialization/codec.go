@@ -0,0 +1,140 @@
+// The MIT License
+//
+// Copyright (c) 2020 Temporal Technologies Inc.  All rights reserved.
+//
+// Copyright (c) 2020 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package serialization
+
+import (
+	"fmt"
+
+	"github.com/gogo/protobuf/proto"
+	"github.com/klauspost/compress/snappy"
+	"github.com/klauspost/compress/zstd"
+	enumspb "go.temporal.io/api/enums/v1"
+)
+
+// Compressed encodings are only used for blobs at rest, they never leave the persistence layer
+const (
+	// EncodingTypeProto3Snappy is proto3 compressed with snappy
+	EncodingTypeProto3Snappy enumspb.EncodingType = 101
+	// EncodingTypeProto3Zstd is proto3 compressed with zstd
+	EncodingTypeProto3Zstd enumspb.EncodingType = 102
+)
+
+var (
+	compressedEncodingNames = map[enumspb.EncodingType]string{
+		EncodingTypeProto3Snappy: "Proto3Snappy",
+		EncodingTypeProto3Zstd:   "Proto3Zstd",
+	}
+
+	compressedEncodingValues = map[string]enumspb.EncodingType{
+		"Proto3Snappy": EncodingTypeProto3Snappy,
+		"Proto3Zstd":   EncodingTypeProto3Zstd,
+	}
+
+	// EncodeAll and DecodeAll are safe for concurrent use
+	zstdEncoder, _ = zstd.NewWriter(nil)
+	zstdDecoder, _ = zstd.NewReader(nil)
+)
+
+// EncodingTypeName returns the name under which blobs of the encoding are persisted
+func EncodingTypeName(encoding enumspb.EncodingType) string {
+	if name, ok := compressedEncodingNames[encoding]; ok {
+		return name
+	}
+	return encoding.String()
+}
+
+// EncodingTypeFromName parses a persisted encoding name, including the compressed encodings
+func EncodingTypeFromName(name string) (enumspb.EncodingType, error) {
+	if encoding, ok := compressedEncodingValues[name]; ok {
+		return encoding, nil
+	}
+	if encoding, ok := enumspb.EncodingType_value[name]; ok {
+		return enumspb.EncodingType(encoding), nil
+	}
+	return enumspb.ENCODING_TYPE_UNSPECIFIED, fmt.Errorf("invalid encoding type: %v", name)
+}
+
+// IsProto3Encoding returns true for proto3 and the compressed encodings wrapping proto3
+func IsProto3Encoding(encoding enumspb.EncodingType) bool {
+	switch encoding {
+	case enumspb.ENCODING_TYPE_PROTO3, EncodingTypeProto3Snappy, EncodingTypeProto3Zstd:
+		return true
+	default:
+		return false
+	}
+}
+
+// Compress wraps a proto3 blob into the given encoding, unspecified or proto3 leaves the blob as is
+func Compress(blob DataBlob, encoding enumspb.EncodingType) (DataBlob, error) {
+	if blob.Encoding != enumspb.ENCODING_TYPE_PROTO3 {
+		return blob, fmt.Errorf("cannot compress blob with encoding: %v", EncodingTypeName(blob.Encoding))
+	}
+
+	switch encoding {
+	case enumspb.ENCODING_TYPE_UNSPECIFIED, enumspb.ENCODING_TYPE_PROTO3:
+		return blob, nil
+	case EncodingTypeProto3Snappy:
+		return DataBlob{Encoding: encoding, Data: snappy.Encode(nil, blob.Data)}, nil
+	case EncodingTypeProto3Zstd:
+		return DataBlob{Encoding: encoding, Data: zstdEncoder.EncodeAll(blob.Data, nil)}, nil
+	default:
+		return blob, encodeErr(encoding, fmt.Errorf("unsupported compression"))
+	}
+}
+
+// Decompress unwraps a blob of any proto3 based encoding back into a proto3 blob
+func Decompress(blob DataBlob) (DataBlob, error) {
+	switch blob.Encoding {
+	case EncodingTypeProto3Snappy:
+		data, err := snappy.Decode(nil, blob.Data)
+		if err != nil {
+			return blob, decodeErr(blob.Encoding, err)
+		}
+		return DataBlob{Encoding: enumspb.ENCODING_TYPE_PROTO3, Data: data}, nil
+	case EncodingTypeProto3Zstd:
+		data, err := zstdDecoder.DecodeAll(blob.Data, nil)
+		if err != nil {
+			return blob, decodeErr(blob.Encoding, err)
+		}
+		return DataBlob{Encoding: enumspb.ENCODING_TYPE_PROTO3, Data: data}, nil
+	default:
+		return blob, nil
+	}
+}
+
+func proto3DecodeCompressed(b []byte, encodingName string, result proto.Unmarshaler) error {
+	encoding, err := EncodingTypeFromName(encodingName)
+	if err != nil {
+		return err
+	}
+	if !IsProto3Encoding(encoding) {
+		return fmt.Errorf("invalid encoding type: %v", encodingName)
+	}
+	blob, err := Decompress(DataBlob{Encoding: encoding, Data: b})
+	if err != nil {
+		return err
+	}
+	return decodeErr(enumspb.ENCODING_TYPE_PROTO3, result.Unmarshal(blob.Data))
+}
@@ -0,0 +1,89 @@
+// The MIT License
+//
+// Copyright (c) 2020 Temporal Technologies Inc.  All rights reserved.
+//
+// Copyright (c) 2020 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package serialization
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	enumspb "go.temporal.io/api/enums/v1"
+
+	"go.temporal.io/server/api/persistenceblobs/v1"
+)
+
+func TestCompressRoundTrip(t *testing.T) {
+	info := &persistenceblobs.WorkflowExecutionInfo{
+		NamespaceId:      "namespace-id",
+		WorkflowId:       "workflow-id",
+		TaskQueue:        "task-queue",
+		WorkflowTypeName: "workflow-type",
+		LastFirstEventId: 42,
+	}
+
+	for _, encoding := range []enumspb.EncodingType{
+		enumspb.ENCODING_TYPE_UNSPECIFIED,
+		enumspb.ENCODING_TYPE_PROTO3,
+		EncodingTypeProto3Snappy,
+		EncodingTypeProto3Zstd,
+	} {
+		blob, err := WorkflowExecutionInfoToBlob(info, encoding)
+		assert.NoError(t, err)
+		if encoding == enumspb.ENCODING_TYPE_UNSPECIFIED {
+			assert.Equal(t, enumspb.ENCODING_TYPE_PROTO3, blob.Encoding)
+		} else {
+			assert.Equal(t, encoding, blob.Encoding)
+		}
+
+		decompressed, err := Decompress(blob)
+		assert.NoError(t, err)
+		assert.Equal(t, enumspb.ENCODING_TYPE_PROTO3, decompressed.Encoding)
+
+		result, err := WorkflowExecutionInfoFromBlob(blob.Data, EncodingTypeName(blob.Encoding))
+		assert.NoError(t, err)
+		assert.Equal(t, info, result)
+	}
+}
+
+func TestEncodingTypeName(t *testing.T) {
+	for _, encoding := range []enumspb.EncodingType{
+		enumspb.ENCODING_TYPE_JSON,
+		enumspb.ENCODING_TYPE_PROTO3,
+		EncodingTypeProto3Snappy,
+		EncodingTypeProto3Zstd,
+	} {
+		result, err := EncodingTypeFromName(EncodingTypeName(encoding))
+		assert.NoError(t, err)
+		assert.Equal(t, encoding, result)
+	}
+
+	_, err := EncodingTypeFromName("Proto3Gzip")
+	assert.Error(t, err)
+	assert.False(t, IsProto3Encoding(enumspb.ENCODING_TYPE_JSON))
+}
+
+func TestCompressNonProto3Blob(t *testing.T) {
+	_, err := Compress(DataBlob{Encoding: enumspb.ENCODING_TYPE_JSON, Data: []byte("{}")}, EncodingTypeProto3Zstd)
+	assert.Error(t, err)
+}
@@ -32,15 +32,21 @@ import (
 	p "go.temporal.io/server/common/persistence"
 	"go.temporal.io/server/common/persistence/sql/sqlplugin"
 	"go.temporal.io/server/common/service/config"
+	"go.temporal.io/server/common/service/dynamicconfig"
 )
 
 type (
 	// Factory vends store objects backed by MySQL
 	Factory struct {
-		cfg         config.SQL
-		dbConn      dbConn
-		clusterName string
-		logger      log.Logger
+		cfg              config.SQL
+		visibilityConfig *config.VisibilityConfig
+		dbConn           dbConn
+		shardDBConns     []*dbConn
+		clusterName      string
+		logger           log.Logger
+
+		replicasOnce sync.Once
+		replicas     *readReplicas
 	}
 
 	// dbConn represents a logical mysql connection - its a
@@ -56,13 +62,19 @@ type (
 
 // NewFactory returns an instance of a factory object which can be used to create
 // datastores backed by any kind of SQL store
-func NewFactory(cfg config.SQL, clusterName string, logger log.Logger) *Factory {
-	return &Factory{
-		cfg:         cfg,
-		clusterName: clusterName,
-		logger:      logger,
-		dbConn:      newRefCountedDBConn(&cfg),
+func NewFactory(cfg config.SQL, visibilityConfig *config.VisibilityConfig, clusterName string, logger log.Logger) *Factory {
+	factory := &Factory{
+		cfg:              cfg,
+		visibilityConfig: visibilityConfig,
+		clusterName:      clusterName,
+		logger:           logger,
+		dbConn:           newRefCountedDBConn(&cfg),
+	}
+	for _, databaseCfg := range ShardDatabaseConfigs(&factory.cfg)[1:] {
+		conn := newRefCountedDBConn(databaseCfg)
+		factory.shardDBConns = append(factory.shardDBConns, &conn)
 	}
+	return factory
 }
 
 // NewTaskStore returns a new task store
@@ -76,20 +88,66 @@ func (f *Factory) NewTaskStore() (p.TaskStore, error) {
 
 // NewShardStore returns a new shard store
 func (f *Factory) NewShardStore() (p.ShardStore, error) {
-	conn, err := f.dbConn.get()
-	if err != nil {
-		return nil, err
+	if len(f.shardDBConns) == 0 {
+		conn, err := f.dbConn.get()
+		if err != nil {
+			return nil, err
+		}
+		return newShardPersistence(conn, f.clusterName, f.logger)
+	}
+
+	// the record of a shard is kept with its executions, so that
+	// the range ID checks of a shard stay local to one database
+	stores := make([]p.ShardStore, 0, len(f.shardDBConns)+1)
+	for index := 0; index <= len(f.shardDBConns); index++ {
+		conn, err := f.shardDBConn(index).get()
+		if err != nil {
+			for _, store := range stores {
+				store.Close()
+			}
+			return nil, err
+		}
+		store, err := newShardPersistence(conn, f.clusterName, f.logger)
+		if err != nil {
+			return nil, err
+		}
+		stores = append(stores, store)
 	}
-	return newShardPersistence(conn, f.clusterName, f.logger)
+	return &shardRoutedShardStore{stores: stores}, nil
 }
 
 // NewHistoryV2Store returns a new history store
 func (f *Factory) NewHistoryV2Store() (p.HistoryStore, error) {
-	conn, err := f.dbConn.get()
-	if err != nil {
-		return nil, err
+	if len(f.shardDBConns) == 0 {
+		conn, err := f.dbConn.get()
+		if err != nil {
+			return nil, err
+		}
+		return newHistoryV2Persistence(conn, newReplicaRoutedDB(conn, f.readReplicas()), f.logger)
+	}
+
+	// the history of a shard is kept with its executions, the read
+	// replicas only replicate database 0
+	stores := make([]p.HistoryStore, 0, len(f.shardDBConns)+1)
+	for index := 0; index <= len(f.shardDBConns); index++ {
+		conn, err := f.shardDBConn(index).get()
+		if err != nil {
+			for _, store := range stores {
+				store.Close()
+			}
+			return nil, err
+		}
+		replicaDB := conn
+		if index == 0 {
+			replicaDB = newReplicaRoutedDB(conn, f.readReplicas())
+		}
+		store, err := newHistoryV2Persistence(conn, replicaDB, f.logger)
+		if err != nil {
+			return nil, err
+		}
+		stores = append(stores, store)
 	}
-	return newHistoryV2Persistence(conn, f.logger)
+	return &shardRoutedHistoryStore{stores: stores}, nil
 }
 
 // NewMetadataStore returns a new metadata store
@@ -112,7 +170,7 @@ func (f *Factory) NewClusterMetadataStore() (p.ClusterMetadataStore, error) {
 
 // NewExecutionStore returns an ExecutionStore for a given shardID
 func (f *Factory) NewExecutionStore(shardID int32) (p.ExecutionStore, error) {
-	conn, err := f.dbConn.get()
+	conn, err := f.shardDBConn(shardDatabaseIndex(shardID, len(f.shardDBConns)+1)).get()
 	if err != nil {
 		return nil, err
 	}
@@ -125,7 +183,11 @@ func (f *Factory) NewVisibilityStore() (p.VisibilityStore, error) {
 	if err != nil {
 		return nil, err
 	}
-	return NewSQLVisibilityStore(conn, f.logger)
+	var validSearchAttributes dynamicconfig.MapPropertyFn
+	if f.visibilityConfig != nil {
+		validSearchAttributes = f.visibilityConfig.ValidSearchAttributes
+	}
+	return NewSQLVisibilityStore(newReplicaRoutedDB(conn, f.readReplicas()), validSearchAttributes, f.logger)
 }
 
 // NewQueue returns a new queue backed by sql
@@ -141,6 +203,28 @@ func (f *Factory) NewQueue(queueType p.QueueType) (p.Queue, error) {
 // Close closes the factory
 func (f *Factory) Close() {
 	f.dbConn.forceClose()
+	for _, conn := range f.shardDBConns {
+		conn.forceClose()
+	}
+	f.replicas.close()
+}
+
+// shardDBConn returns the connection to the database with the given index, where 0 is the main database
+func (f *Factory) shardDBConn(index int) *dbConn {
+	if index == 0 {
+		return &f.dbConn
+	}
+	return f.shardDBConns[index-1]
+}
+
+// readReplicas returns the read replicas of the datastore, connecting to them on first use
+func (f *Factory) readReplicas() *readReplicas {
+	f.replicasOnce.Do(func() {
+		if len(f.cfg.Replicas) > 0 {
+			f.replicas = newReadReplicas(&f.cfg, f.logger)
+		}
+	})
+	return f.replicas
 }
 
 // newRefCountedDBConn returns a  logical mysql connection that
@@ -0,0 +1,244 @@
+// The MIT License
+//
+// Copyright (c) 2020 Temporal Technologies Inc.  All rights reserved.
+//
+// Copyright (c) 2020 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package sql
+
+import (
+	"database/sql"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"go.temporal.io/server/common/log"
+	"go.temporal.io/server/common/log/tag"
+	"go.temporal.io/server/common/persistence/sql/sqlplugin"
+	"go.temporal.io/server/common/service/config"
+)
+
+type (
+	// readReplicas serves reads that tolerate staleness from the read replicas of a
+	// SQL database, falling back to the primary when no replica is usable
+	readReplicas struct {
+		replicas     []*readReplica
+		maxStaleness time.Duration
+		next         uint32
+		logger       log.Logger
+	}
+
+	// readReplica is a replica connection together with its last known health
+	readReplica struct {
+		sync.Mutex
+		sqlplugin.DB
+		addr      string
+		usable    bool
+		checking  bool
+		checkedAt time.Time
+	}
+
+	// replicaRoutedDB is a sqlplugin.DB that serves visibility and history node reads from
+	// the read replicas and everything else, in particular all writes, from the primary
+	replicaRoutedDB struct {
+		sqlplugin.DB
+		replicas *readReplicas
+	}
+)
+
+const (
+	defaultReplicaMaxStaleness = 10 * time.Second
+	// replicaCheckInterval is how often the replication lag of a replica is checked,
+	// it is also how long a replica that failed a read is left alone
+	replicaCheckInterval = 5 * time.Second
+)
+
+// newReadReplicas connects to the read replicas of the given datastore, replicas that
+// cannot be reached are left out and their reads go to the primary
+func newReadReplicas(cfg *config.SQL, logger log.Logger) *readReplicas {
+	maxStaleness := cfg.ReplicaMaxStaleness
+	if maxStaleness <= 0 {
+		maxStaleness = defaultReplicaMaxStaleness
+	}
+
+	replicas := make([]*readReplica, 0, len(cfg.Replicas))
+	for _, replicaCfg := range cfg.Replicas {
+		db, err := NewSQLDB(newReplicaConfig(cfg, replicaCfg))
+		if err != nil {
+			logger.Error("unable to connect to sql read replica", tag.Address(replicaCfg.ConnectAddr), tag.Error(err))
+			continue
+		}
+		replicas = append(replicas, &readReplica{DB: db, addr: replicaCfg.ConnectAddr})
+	}
+
+	return &readReplicas{
+		replicas:     replicas,
+		maxStaleness: maxStaleness,
+		logger:       logger,
+	}
+}
+
+// newReplicaConfig returns the connection config of a replica, based on the config of its primary
+func newReplicaConfig(primary *config.SQL, replica config.SQLReplica) *config.SQL {
+	cfg := *primary
+	cfg.ConnectAddr = replica.ConnectAddr
+	cfg.Replicas = nil
+	if replica.User != "" {
+		cfg.User = replica.User
+		cfg.Password = replica.Password
+	}
+	if replica.MaxConns > 0 {
+		cfg.MaxConns = replica.MaxConns
+	}
+	if replica.MaxIdleConns > 0 {
+		cfg.MaxIdleConns = replica.MaxIdleConns
+	}
+	return &cfg
+}
+
+// read runs the given read operation against a usable replica, and against the primary
+// if there is none or the replica fails it
+func (r *readReplicas) read(primary sqlplugin.DB, op func(db sqlplugin.DB) error) error {
+	replica := r.pick()
+	if replica == nil {
+		return op(primary)
+	}
+
+	err := op(replica)
+	if err == nil {
+		return nil
+	}
+	// no rows is not a failure of the replica, but it may simply not have caught up yet
+	if err != sql.ErrNoRows {
+		r.logger.Warn("read from sql read replica failed, falling back to primary", tag.Address(replica.addr), tag.Error(err))
+		replica.disable()
+	}
+	return op(primary)
+}
+
+// pick returns the next usable replica in round robin order, nil if there is none
+func (r *readReplicas) pick() *readReplica {
+	if r == nil || len(r.replicas) == 0 {
+		return nil
+	}
+
+	start := atomic.AddUint32(&r.next, 1)
+	for i := 0; i < len(r.replicas); i++ {
+		replica := r.replicas[(int(start)+i)%len(r.replicas)]
+		if replica.isUsable(r.maxStaleness, r.logger) {
+			return replica
+		}
+	}
+	return nil
+}
+
+func (r *readReplicas) close() {
+	if r == nil {
+		return
+	}
+	for _, replica := range r.replicas {
+		if err := replica.Close(); err != nil {
+			r.logger.Warn("failed to close sql read replica connection", tag.Address(replica.addr), tag.Error(err))
+		}
+	}
+}
+
+// isUsable returns the last known health of the replica, and refreshes
+// it in the background once it is older than replicaCheckInterval
+func (r *readReplica) isUsable(maxStaleness time.Duration, logger log.Logger) bool {
+	r.Lock()
+	defer r.Unlock()
+
+	if !r.checking && time.Since(r.checkedAt) >= replicaCheckInterval {
+		r.checking = true
+		go r.checkLag(maxStaleness, logger)
+	}
+	return r.usable
+}
+
+func (r *readReplica) checkLag(maxStaleness time.Duration, logger log.Logger) {
+	lag, err := r.ReplicationLag()
+	if err != nil {
+		logger.Warn("unable to get replication lag of sql read replica", tag.Address(r.addr), tag.Error(err))
+	}
+
+	r.Lock()
+	defer r.Unlock()
+	r.checking = false
+	r.checkedAt = time.Now()
+	r.usable = err == nil && lag <= maxStaleness
+}
+
+func (r *readReplica) disable() {
+	r.Lock()
+	defer r.Unlock()
+	r.usable = false
+	r.checkedAt = time.Now()
+}
+
+// newReplicaRoutedDB returns a DB that routes the reads which tolerate staleness
+// to the replicas, or the primary itself if there are no replicas
+func newReplicaRoutedDB(primary sqlplugin.DB, replicas *readReplicas) sqlplugin.DB {
+	if replicas == nil || len(replicas.replicas) == 0 {
+		return primary
+	}
+	return &replicaRoutedDB{DB: primary, replicas: replicas}
+}
+
+func (db *replicaRoutedDB) SelectFromVisibility(filter sqlplugin.VisibilitySelectFilter) ([]sqlplugin.VisibilityRow, error) {
+	var rows []sqlplugin.VisibilityRow
+	err := db.replicas.read(db.DB, func(conn sqlplugin.DB) error {
+		var err error
+		rows, err = conn.SelectFromVisibility(filter)
+		return err
+	})
+	return rows, err
+}
+
+func (db *replicaRoutedDB) SelectFromVisibilityByQuery(filter sqlplugin.VisibilityQueryFilter) ([]sqlplugin.VisibilityRow, error) {
+	var rows []sqlplugin.VisibilityRow
+	err := db.replicas.read(db.DB, func(conn sqlplugin.DB) error {
+		var err error
+		rows, err = conn.SelectFromVisibilityByQuery(filter)
+		return err
+	})
+	return rows, err
+}
+
+func (db *replicaRoutedDB) CountFromVisibilityByQuery(filter sqlplugin.VisibilityQueryFilter) (int64, error) {
+	var count int64
+	err := db.replicas.read(db.DB, func(conn sqlplugin.DB) error {
+		var err error
+		count, err = conn.CountFromVisibilityByQuery(filter)
+		return err
+	})
+	return count, err
+}
+
+func (db *replicaRoutedDB) SelectFromHistoryNode(filter sqlplugin.HistoryNodeSelectFilter) ([]sqlplugin.HistoryNodeRow, error) {
+	var rows []sqlplugin.HistoryNodeRow
+	err := db.replicas.read(db.DB, func(conn sqlplugin.DB) error {
+		var err error
+		rows, err = conn.SelectFromHistoryNode(filter)
+		return err
+	})
+	return rows, err
+}
@@ -0,0 +1,247 @@
+// The MIT License
+//
+// Copyright (c) 2020 Temporal Technologies Inc.  All rights reserved.
+//
+// Copyright (c) 2017 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package sql
+
+import (
+	"database/sql"
+	"errors"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"github.com/stretchr/testify/suite"
+
+	"go.temporal.io/server/common/log"
+	"go.temporal.io/server/common/persistence/sql/sqlplugin"
+)
+
+type (
+	readReplicasSuite struct {
+		suite.Suite
+		*require.Assertions
+	}
+
+	// testReplicaDB is a sqlplugin.DB which records the visibility reads and writes it serves
+	testReplicaDB struct {
+		sqlplugin.DB
+		name       string
+		lag        time.Duration
+		lagErr     error
+		selectErr  error
+		selects    int32
+		inserts    int32
+		closeCalls int32
+	}
+)
+
+func TestReadReplicasSuite(t *testing.T) {
+	s := new(readReplicasSuite)
+	suite.Run(t, s)
+}
+
+func (s *readReplicasSuite) SetupTest() {
+	s.Assertions = require.New(s.T())
+}
+
+func (s *readReplicasSuite) TestNewReplicaRoutedDB_NoReplicas() {
+	primary := &testReplicaDB{name: "primary"}
+
+	s.Equal(primary, newReplicaRoutedDB(primary, nil))
+	s.Equal(primary, newReplicaRoutedDB(primary, s.newReadReplicas()))
+}
+
+func (s *readReplicasSuite) TestRead_NoUsableReplica() {
+	primary := &testReplicaDB{name: "primary"}
+	replica := &testReplicaDB{name: "replica"}
+	replicas := s.newReadReplicas(replica)
+	replicas.replicas[0].usable = false
+
+	rows, err := newReplicaRoutedDB(primary, replicas).SelectFromVisibility(sqlplugin.VisibilitySelectFilter{})
+	s.NoError(err)
+	s.Equal("primary", rows[0].WorkflowID)
+	s.Equal(int32(0), replica.selects)
+}
+
+func (s *readReplicasSuite) TestRead_RoundRobin() {
+	primary := &testReplicaDB{name: "primary"}
+	replica1 := &testReplicaDB{name: "replica1"}
+	replica2 := &testReplicaDB{name: "replica2"}
+	db := newReplicaRoutedDB(primary, s.newReadReplicas(replica1, replica2))
+
+	for i := 0; i < 4; i++ {
+		_, err := db.SelectFromVisibility(sqlplugin.VisibilitySelectFilter{})
+		s.NoError(err)
+	}
+	s.Equal(int32(0), primary.selects)
+	s.Equal(int32(2), replica1.selects)
+	s.Equal(int32(2), replica2.selects)
+}
+
+func (s *readReplicasSuite) TestRead_SkipsUnusableReplica() {
+	primary := &testReplicaDB{name: "primary"}
+	replica1 := &testReplicaDB{name: "replica1"}
+	replica2 := &testReplicaDB{name: "replica2"}
+	replicas := s.newReadReplicas(replica1, replica2)
+	replicas.replicas[0].usable = false
+	db := newReplicaRoutedDB(primary, replicas)
+
+	for i := 0; i < 4; i++ {
+		rows, err := db.SelectFromVisibility(sqlplugin.VisibilitySelectFilter{})
+		s.NoError(err)
+		s.Equal("replica2", rows[0].WorkflowID)
+	}
+	s.Equal(int32(0), replica1.selects)
+}
+
+func (s *readReplicasSuite) TestRead_ReplicaFailure_FallsBackToPrimary() {
+	primary := &testReplicaDB{name: "primary"}
+	replica := &testReplicaDB{name: "replica", selectErr: errors.New("connection refused")}
+	replicas := s.newReadReplicas(replica)
+	db := newReplicaRoutedDB(primary, replicas)
+
+	rows, err := db.SelectFromVisibility(sqlplugin.VisibilitySelectFilter{})
+	s.NoError(err)
+	s.Equal("primary", rows[0].WorkflowID)
+	s.False(replicas.replicas[0].usable)
+
+	// the failed replica is left alone until its next lag check
+	_, err = db.SelectFromVisibility(sqlplugin.VisibilitySelectFilter{})
+	s.NoError(err)
+	s.Equal(int32(1), replica.selects)
+	s.Equal(int32(2), primary.selects)
+}
+
+func (s *readReplicasSuite) TestRead_ReplicaNoRows_KeepsReplica() {
+	primary := &testReplicaDB{name: "primary"}
+	replica := &testReplicaDB{name: "replica", selectErr: sql.ErrNoRows}
+	replicas := s.newReadReplicas(replica)
+
+	rows, err := newReplicaRoutedDB(primary, replicas).SelectFromVisibility(sqlplugin.VisibilitySelectFilter{})
+	s.NoError(err)
+	s.Equal("primary", rows[0].WorkflowID)
+	s.True(replicas.replicas[0].usable)
+}
+
+func (s *readReplicasSuite) TestWrite_GoesToPrimary() {
+	primary := &testReplicaDB{name: "primary"}
+	replica := &testReplicaDB{name: "replica"}
+
+	_, err := newReplicaRoutedDB(primary, s.newReadReplicas(replica)).InsertIntoVisibility(&sqlplugin.VisibilityRow{})
+	s.NoError(err)
+	s.Equal(int32(1), primary.inserts)
+	s.Equal(int32(0), replica.inserts)
+}
+
+func (s *readReplicasSuite) TestCheckLag() {
+	testCases := []struct {
+		lag      time.Duration
+		lagErr   error
+		expected bool
+	}{
+		{lag: 0, expected: true},
+		{lag: time.Second, expected: true},
+		{lag: 2 * time.Second, expected: false},
+		{lag: 0, lagErr: errors.New("replication is not running"), expected: false},
+	}
+
+	for _, tc := range testCases {
+		replica := &readReplica{DB: &testReplicaDB{lag: tc.lag, lagErr: tc.lagErr}, usable: !tc.expected, checking: true}
+		replica.checkLag(time.Second, log.NewNoop())
+		s.Equal(tc.expected, replica.usable)
+		s.False(replica.checking)
+		s.False(replica.checkedAt.IsZero())
+	}
+}
+
+func (s *readReplicasSuite) TestIsUsable_ChecksLagInBackground() {
+	replica := &readReplica{DB: &testReplicaDB{lag: time.Second}}
+
+	// the replica is not used before its lag is known
+	s.False(replica.isUsable(time.Minute, log.NewNoop()))
+	s.Eventually(func() bool {
+		return replica.isUsable(time.Minute, log.NewNoop())
+	}, time.Second, 10*time.Millisecond)
+}
+
+func (s *readReplicasSuite) TestIsUsable_LaggingReplica() {
+	replica := &readReplica{DB: &testReplicaDB{lag: time.Minute}, usable: true}
+
+	s.True(replica.isUsable(time.Second, log.NewNoop()))
+	s.Eventually(func() bool {
+		return !replica.isUsable(time.Second, log.NewNoop())
+	}, time.Second, 10*time.Millisecond)
+}
+
+func (s *readReplicasSuite) TestClose() {
+	replica1 := &testReplicaDB{name: "replica1"}
+	replica2 := &testReplicaDB{name: "replica2"}
+
+	s.newReadReplicas(replica1, replica2).close()
+	s.Equal(int32(1), replica1.closeCalls)
+	s.Equal(int32(1), replica2.closeCalls)
+
+	var replicas *readReplicas
+	replicas.close()
+}
+
+// newReadReplicas returns read replicas which are known to be usable until their next lag check
+func (s *readReplicasSuite) newReadReplicas(dbs ...*testReplicaDB) *readReplicas {
+	replicas := &readReplicas{
+		maxStaleness: defaultReplicaMaxStaleness,
+		logger:       log.NewNoop(),
+	}
+	for _, db := range dbs {
+		replicas.replicas = append(replicas.replicas, &readReplica{
+			DB:        db,
+			addr:      db.name,
+			usable:    true,
+			checkedAt: time.Now(),
+		})
+	}
+	return replicas
+}
+
+func (d *testReplicaDB) SelectFromVisibility(_ sqlplugin.VisibilitySelectFilter) ([]sqlplugin.VisibilityRow, error) {
+	atomic.AddInt32(&d.selects, 1)
+	if d.selectErr != nil {
+		return nil, d.selectErr
+	}
+	return []sqlplugin.VisibilityRow{{WorkflowID: d.name}}, nil
+}
+
+func (d *testReplicaDB) InsertIntoVisibility(_ *sqlplugin.VisibilityRow) (sql.Result, error) {
+	atomic.AddInt32(&d.inserts, 1)
+	return nil, nil
+}
+
+func (d *testReplicaDB) ReplicationLag() (time.Duration, error) {
+	return d.lag, d.lagErr
+}
+
+func (d *testReplicaDB) Close() error {
+	atomic.AddInt32(&d.closeCalls, 1)
+	return nil
+}
@@ -0,0 +1,217 @@
+// The MIT License
+//
+// Copyright (c) 2020 Temporal Technologies Inc.  All rights reserved.
+//
+// Copyright (c) 2020 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package sql
+
+import (
+	"encoding/json"
+	"fmt"
+
+	"go.temporal.io/api/serviceerror"
+
+	p "go.temporal.io/server/common/persistence"
+	"go.temporal.io/server/common/service/config"
+)
+
+type (
+	// shardRoutedShardStore is a ShardStore that keeps the record of every
+	// history shard in the database that holds the executions of the shard
+	shardRoutedShardStore struct {
+		stores []p.ShardStore
+	}
+
+	// shardRoutedHistoryStore is a HistoryStore that keeps the history
+	// trees and nodes of a history shard with the executions of the shard
+	shardRoutedHistoryStore struct {
+		stores []p.HistoryStore
+	}
+
+	// shardRoutedPageToken is the page token of a scan over all shard
+	// databases, the token of the database being scanned is wrapped
+	shardRoutedPageToken struct {
+		Database int
+		Token    []byte
+	}
+)
+
+// shardDatabaseIndex returns the index of the database that holds the given history shard.
+// It uses jump consistent hashing, so adding a database only moves about 1/n of the
+// shards to the new database, while all other shards keep their database
+func shardDatabaseIndex(shardID int32, numDatabases int) int {
+	key := uint64(shardID)
+	b, j := int64(-1), int64(0)
+	for j < int64(numDatabases) {
+		b = j
+		key = key*2862933555777941757 + 1
+		j = int64(float64(b+1) * (float64(int64(1)<<31) / float64((key>>33)+1)))
+	}
+	return int(b)
+}
+
+// ShardDatabaseConfigs returns the connection configs of all databases that
+// history shards are spread across, starting with database 0
+func ShardDatabaseConfigs(cfg *config.SQL) []*config.SQL {
+	configs := []*config.SQL{cfg}
+	for _, database := range cfg.ShardDatabases {
+		configs = append(configs, newShardDatabaseConfig(cfg, database))
+	}
+	return configs
+}
+
+// newShardDatabaseConfig returns the connection config of a shard database, based on the config of database 0
+func newShardDatabaseConfig(primary *config.SQL, database config.SQLShardDatabase) *config.SQL {
+	cfg := *primary
+	cfg.ConnectAddr = database.ConnectAddr
+	cfg.DatabaseName = database.DatabaseName
+	cfg.Replicas = nil
+	cfg.ShardDatabases = nil
+	if database.User != "" {
+		cfg.User = database.User
+		cfg.Password = database.Password
+	}
+	if database.ConnectAttributes != nil {
+		cfg.ConnectAttributes = database.ConnectAttributes
+	}
+	if database.MaxConns > 0 {
+		cfg.MaxConns = database.MaxConns
+	}
+	if database.MaxIdleConns > 0 {
+		cfg.MaxIdleConns = database.MaxIdleConns
+	}
+	return &cfg
+}
+
+func (s *shardRoutedShardStore) GetName() string {
+	return s.stores[0].GetName()
+}
+
+func (s *shardRoutedShardStore) Close() {
+	for _, store := range s.stores {
+		store.Close()
+	}
+}
+
+func (s *shardRoutedShardStore) CreateShard(request *p.CreateShardRequest) error {
+	return s.storeOf(request.ShardInfo.GetShardId()).CreateShard(request)
+}
+
+// GetShard returns the record of a shard from its database. A shard that is missing from its database but
+// exists in another one was created with a different list of shard databases, it is refused rather than
+// created again, so that the shard databases can't be changed without migrating the shards
+func (s *shardRoutedShardStore) GetShard(request *p.GetShardRequest) (*p.GetShardResponse, error) {
+	index := shardDatabaseIndex(request.ShardID, len(s.stores))
+	response, err := s.stores[index].GetShard(request)
+	if _, ok := err.(*serviceerror.NotFound); !ok {
+		return response, err
+	}
+	for other, store := range s.stores {
+		if other == index {
+			continue
+		}
+		if _, otherErr := store.GetShard(request); otherErr == nil {
+			return nil, serviceerror.NewInternal(fmt.Sprintf(
+				"GetShard operation failed. Shard %v is in shard database %v instead of %v, the shard databases were changed without migrating the shard.",
+				request.ShardID, other, index))
+		}
+	}
+	return response, err
+}
+
+func (s *shardRoutedShardStore) UpdateShard(request *p.UpdateShardRequest) error {
+	return s.storeOf(request.ShardInfo.GetShardId()).UpdateShard(request)
+}
+
+func (s *shardRoutedShardStore) storeOf(shardID int32) p.ShardStore {
+	return s.stores[shardDatabaseIndex(shardID, len(s.stores))]
+}
+
+func (s *shardRoutedHistoryStore) GetName() string {
+	return s.stores[0].GetName()
+}
+
+func (s *shardRoutedHistoryStore) Close() {
+	for _, store := range s.stores {
+		store.Close()
+	}
+}
+
+func (s *shardRoutedHistoryStore) AppendHistoryNodes(request *p.InternalAppendHistoryNodesRequest) error {
+	return s.storeOf(request.ShardID).AppendHistoryNodes(request)
+}
+
+func (s *shardRoutedHistoryStore) ReadHistoryBranch(request *p.InternalReadHistoryBranchRequest) (*p.InternalReadHistoryBranchResponse, error) {
+	return s.storeOf(request.ShardID).ReadHistoryBranch(request)
+}
+
+func (s *shardRoutedHistoryStore) ForkHistoryBranch(request *p.InternalForkHistoryBranchRequest) (*p.InternalForkHistoryBranchResponse, error) {
+	return s.storeOf(request.ShardID).ForkHistoryBranch(request)
+}
+
+func (s *shardRoutedHistoryStore) DeleteHistoryBranch(request *p.InternalDeleteHistoryBranchRequest) error {
+	return s.storeOf(request.ShardID).DeleteHistoryBranch(request)
+}
+
+func (s *shardRoutedHistoryStore) GetHistoryTree(request *p.GetHistoryTreeRequest) (*p.GetHistoryTreeResponse, error) {
+	if request.ShardID == nil {
+		return nil, serviceerror.NewInvalidArgument("GetHistoryTree operation failed. Shard ID is not set.")
+	}
+	return s.storeOf(*request.ShardID).GetHistoryTree(request)
+}
+
+// GetAllHistoryTreeBranches scans the shard databases one after the other
+func (s *shardRoutedHistoryStore) GetAllHistoryTreeBranches(request *p.GetAllHistoryTreeBranchesRequest) (*p.GetAllHistoryTreeBranchesResponse, error) {
+	pageToken := &shardRoutedPageToken{}
+	if len(request.NextPageToken) > 0 {
+		if err := json.Unmarshal(request.NextPageToken, pageToken); err != nil {
+			return nil, serviceerror.NewInternal(fmt.Sprintf("GetAllHistoryTreeBranches operation failed. Error deserializing page token: %v", err))
+		}
+		if pageToken.Database < 0 || pageToken.Database >= len(s.stores) {
+			return nil, serviceerror.NewInternal(fmt.Sprintf("GetAllHistoryTreeBranches operation failed. Invalid shard database %v in page token.", pageToken.Database))
+		}
+	}
+
+	response, err := s.stores[pageToken.Database].GetAllHistoryTreeBranches(&p.GetAllHistoryTreeBranchesRequest{
+		NextPageToken: pageToken.Token,
+		PageSize:      request.PageSize,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	pageToken.Token = response.NextPageToken
+	if len(pageToken.Token) == 0 {
+		pageToken.Database++
+	}
+	response.NextPageToken = nil
+	if pageToken.Database < len(s.stores) {
+		if response.NextPageToken, err = json.Marshal(pageToken); err != nil {
+			return nil, serviceerror.NewInternal(fmt.Sprintf("GetAllHistoryTreeBranches operation failed. Error serializing page token: %v", err))
+		}
+	}
+	return response, nil
+}
+
+func (s *shardRoutedHistoryStore) storeOf(shardID int32) p.HistoryStore {
+	return s.stores[shardDatabaseIndex(shardID, len(s.stores))]
+}
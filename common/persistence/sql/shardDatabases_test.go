@@ -0,0 +1,240 @@
+// The MIT License
+//
+// Copyright (c) 2020 Temporal Technologies Inc.  All rights reserved.
+//
+// Copyright (c) 2020 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package sql
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"github.com/stretchr/testify/suite"
+	"go.temporal.io/api/serviceerror"
+
+	"go.temporal.io/server/api/persistenceblobs/v1"
+	p "go.temporal.io/server/common/persistence"
+	"go.temporal.io/server/common/service/config"
+)
+
+type (
+	shardDatabasesSuite struct {
+		suite.Suite
+		*require.Assertions
+	}
+
+	testShardStore struct {
+		p.ShardStore
+		shards map[int32]bool
+	}
+
+	testHistoryStore struct {
+		p.HistoryStore
+		appended []int32
+		branches []string
+	}
+)
+
+func TestShardDatabasesSuite(t *testing.T) {
+	s := new(shardDatabasesSuite)
+	suite.Run(t, s)
+}
+
+func (s *shardDatabasesSuite) SetupTest() {
+	s.Assertions = require.New(s.T())
+}
+
+func (s *shardDatabasesSuite) TestShardDatabaseIndex_SingleDatabase() {
+	for shardID := int32(1); shardID <= 1024; shardID++ {
+		s.Equal(0, shardDatabaseIndex(shardID, 1))
+	}
+}
+
+func (s *shardDatabasesSuite) TestShardDatabaseIndex_Balanced() {
+	numShards := 4096
+	numDatabases := 4
+	counts := make([]int, numDatabases)
+	for shardID := int32(1); shardID <= int32(numShards); shardID++ {
+		index := shardDatabaseIndex(shardID, numDatabases)
+		s.True(index >= 0 && index < numDatabases)
+		s.Equal(index, shardDatabaseIndex(shardID, numDatabases))
+		counts[index]++
+	}
+	for _, count := range counts {
+		s.InDelta(numShards/numDatabases, count, float64(numShards/numDatabases)/5)
+	}
+}
+
+func (s *shardDatabasesSuite) TestShardDatabaseIndex_AddDatabase() {
+	for numDatabases := 1; numDatabases < 8; numDatabases++ {
+		for shardID := int32(1); shardID <= 1024; shardID++ {
+			before := shardDatabaseIndex(shardID, numDatabases)
+			after := shardDatabaseIndex(shardID, numDatabases+1)
+			// a shard either stays or moves to the new database
+			s.True(after == before || after == numDatabases)
+		}
+	}
+}
+
+func (s *shardDatabasesSuite) TestShardDatabaseConfigs() {
+	cfg := &config.SQL{
+		User:         "user",
+		Password:     "password",
+		PluginName:   "mysql",
+		DatabaseName: "temporal",
+		ConnectAddr:  "127.0.0.1:3306",
+		MaxConns:     20,
+		ShardDatabases: []config.SQLShardDatabase{
+			{ConnectAddr: "127.0.0.2:3306", DatabaseName: "temporal_1"},
+			{ConnectAddr: "127.0.0.3:3306", DatabaseName: "temporal_2", User: "other", Password: "secret", MaxConns: 50},
+		},
+	}
+
+	configs := ShardDatabaseConfigs(cfg)
+	s.Len(configs, 3)
+	s.Equal(cfg, configs[0])
+
+	s.Equal("127.0.0.2:3306", configs[1].ConnectAddr)
+	s.Equal("temporal_1", configs[1].DatabaseName)
+	s.Equal("user", configs[1].User)
+	s.Equal("mysql", configs[1].PluginName)
+	s.Equal(20, configs[1].MaxConns)
+	s.Empty(configs[1].ShardDatabases)
+
+	s.Equal("127.0.0.3:3306", configs[2].ConnectAddr)
+	s.Equal("other", configs[2].User)
+	s.Equal("secret", configs[2].Password)
+	s.Equal(50, configs[2].MaxConns)
+}
+
+func (s *shardDatabasesSuite) TestShardRoutedShardStore_GetShard() {
+	shardID := int32(7)
+	numDatabases := 3
+	index := shardDatabaseIndex(shardID, numDatabases)
+	stores := make([]*testShardStore, numDatabases)
+	store := &shardRoutedShardStore{}
+	for i := range stores {
+		stores[i] = &testShardStore{shards: map[int32]bool{}}
+		store.stores = append(store.stores, stores[i])
+	}
+
+	_, err := store.GetShard(&p.GetShardRequest{ShardID: shardID})
+	s.IsType(&serviceerror.NotFound{}, err)
+
+	stores[index].shards[shardID] = true
+	response, err := store.GetShard(&p.GetShardRequest{ShardID: shardID})
+	s.NoError(err)
+	s.Equal(shardID, response.ShardInfo.GetShardId())
+}
+
+func (s *shardDatabasesSuite) TestShardRoutedShardStore_GetShard_NotMigrated() {
+	shardID := int32(7)
+	numDatabases := 3
+	index := shardDatabaseIndex(shardID, numDatabases)
+	store := &shardRoutedShardStore{}
+	for i := 0; i < numDatabases; i++ {
+		// the shard was created in another database than the one it is assigned to
+		store.stores = append(store.stores, &testShardStore{shards: map[int32]bool{shardID: i != index}})
+	}
+
+	_, err := store.GetShard(&p.GetShardRequest{ShardID: shardID})
+	s.IsType(&serviceerror.Internal{}, err)
+}
+
+func (s *shardDatabasesSuite) TestShardRoutedHistoryStore_AppendHistoryNodes() {
+	numDatabases := 3
+	stores := make([]*testHistoryStore, numDatabases)
+	store := &shardRoutedHistoryStore{}
+	for i := range stores {
+		stores[i] = &testHistoryStore{}
+		store.stores = append(store.stores, stores[i])
+	}
+
+	for shardID := int32(1); shardID <= 16; shardID++ {
+		s.NoError(store.AppendHistoryNodes(&p.InternalAppendHistoryNodesRequest{ShardID: shardID}))
+	}
+	for i, database := range stores {
+		for _, shardID := range database.appended {
+			s.Equal(i, shardDatabaseIndex(shardID, numDatabases))
+		}
+	}
+}
+
+func (s *shardDatabasesSuite) TestShardRoutedHistoryStore_GetAllHistoryTreeBranches() {
+	store := &shardRoutedHistoryStore{stores: []p.HistoryStore{
+		&testHistoryStore{branches: []string{"a", "b", "c"}},
+		&testHistoryStore{},
+		&testHistoryStore{branches: []string{"d"}},
+	}}
+
+	var branches []string
+	var pageToken []byte
+	for pages := 0; pages < 10; pages++ {
+		response, err := store.GetAllHistoryTreeBranches(&p.GetAllHistoryTreeBranchesRequest{
+			NextPageToken: pageToken,
+			PageSize:      2,
+		})
+		s.NoError(err)
+		for _, branch := range response.Branches {
+			branches = append(branches, branch.BranchID)
+		}
+		pageToken = response.NextPageToken
+		if len(pageToken) == 0 {
+			break
+		}
+	}
+	s.Empty(pageToken)
+	s.Equal([]string{"a", "b", "c", "d"}, branches)
+}
+
+func (s *testShardStore) GetShard(request *p.GetShardRequest) (*p.GetShardResponse, error) {
+	if !s.shards[request.ShardID] {
+		return nil, serviceerror.NewNotFound(fmt.Sprintf("shard %v not found", request.ShardID))
+	}
+	return &p.GetShardResponse{ShardInfo: &persistenceblobs.ShardInfo{ShardId: request.ShardID}}, nil
+}
+
+func (s *testHistoryStore) AppendHistoryNodes(request *p.InternalAppendHistoryNodesRequest) error {
+	s.appended = append(s.appended, request.ShardID)
+	return nil
+}
+
+// GetAllHistoryTreeBranches returns pages of the branches, the page token is the offset of the next page
+func (s *testHistoryStore) GetAllHistoryTreeBranches(request *p.GetAllHistoryTreeBranchesRequest) (*p.GetAllHistoryTreeBranchesResponse, error) {
+	offset := 0
+	if len(request.NextPageToken) > 0 {
+		offset = int(request.NextPageToken[0])
+	}
+	end := offset + request.PageSize
+	if end > len(s.branches) {
+		end = len(s.branches)
+	}
+	response := &p.GetAllHistoryTreeBranchesResponse{}
+	for _, branch := range s.branches[offset:end] {
+		response.Branches = append(response.Branches, p.HistoryBranchDetail{BranchID: branch})
+	}
+	if end < len(s.branches) {
+		response.NextPageToken = []byte{byte(end)}
+	}
+	return response, nil
+}
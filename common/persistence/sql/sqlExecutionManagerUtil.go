@@ -52,6 +52,7 @@ func applyWorkflowMutationTx(
 	versionHistories := workflowMutation.VersionHistories
 	startVersion := workflowMutation.StartVersion
 	lastWriteVersion := workflowMutation.LastWriteVersion
+	executionInfoEncoding := workflowMutation.ExecutionInfoEncoding
 	namespaceID := executionInfo.NamespaceId
 	workflowID := executionInfo.WorkflowId
 	runID := executionInfo.ExecutionState.RunId
@@ -86,6 +87,7 @@ func applyWorkflowMutationTx(
 		versionHistories,
 		startVersion,
 		lastWriteVersion,
+		executionInfoEncoding,
 		shardID); err != nil {
 		return serviceerror.NewInternal(fmt.Sprintf("applyWorkflowMutationTx failed. Failed to update executions row. Erorr: %v", err))
 	}
@@ -192,6 +194,7 @@ func applyWorkflowSnapshotTxAsReset(
 	versionHistories := workflowSnapshot.VersionHistories
 	startVersion := workflowSnapshot.StartVersion
 	lastWriteVersion := workflowSnapshot.LastWriteVersion
+	executionInfoEncoding := workflowSnapshot.ExecutionInfoEncoding
 	workflowID := executionInfo.WorkflowId
 	namespaceID := executionInfo.NamespaceId
 	runID := executionInfo.ExecutionState.RunId
@@ -224,6 +227,7 @@ func applyWorkflowSnapshotTxAsReset(
 		versionHistories,
 		startVersion,
 		lastWriteVersion,
+		executionInfoEncoding,
 		shardID); err != nil {
 		return serviceerror.NewInternal(fmt.Sprintf("applyWorkflowSnapshotTxAsReset failed. Failed to update executions row. Erorr: %v", err))
 	}
@@ -367,6 +371,7 @@ func (m *sqlExecutionManager) applyWorkflowSnapshotTxAsNew(
 	versionHistories := workflowSnapshot.VersionHistories
 	startVersion := workflowSnapshot.StartVersion
 	lastWriteVersion := workflowSnapshot.LastWriteVersion
+	executionInfoEncoding := workflowSnapshot.ExecutionInfoEncoding
 	workflowID := executionInfo.WorkflowId
 	namespaceID := executionInfo.NamespaceId
 	runID := executionInfo.ExecutionState.RunId
@@ -384,6 +389,7 @@ func (m *sqlExecutionManager) applyWorkflowSnapshotTxAsNew(
 		versionHistories,
 		startVersion,
 		lastWriteVersion,
+		executionInfoEncoding,
 		shardID); err != nil {
 		return err
 	}
@@ -1042,6 +1048,7 @@ func buildExecutionRow(
 	versionHistories *historyspb.VersionHistories,
 	startVersion int64,
 	lastWriteVersion int64,
+	executionInfoEncoding enumspb.EncodingType,
 	shardID int32,
 ) (row *sqlplugin.ExecutionsRow, err error) {
 
@@ -1050,7 +1057,7 @@ func buildExecutionRow(
 		return nil, err
 	}
 
-	infoBlob, err := serialization.WorkflowExecutionInfoToBlob(info)
+	infoBlob, err := serialization.WorkflowExecutionInfoToBlob(info, executionInfoEncoding)
 	if err != nil {
 		return nil, err
 	}
@@ -1078,7 +1085,7 @@ func buildExecutionRow(
 		NextEventID:      executionInfo.NextEventId,
 		LastWriteVersion: lastWriteVersion,
 		Data:             infoBlob.Data,
-		DataEncoding:     infoBlob.Encoding.String(),
+		DataEncoding:     serialization.EncodingTypeName(infoBlob.Encoding),
 		State:            stateBlob.Data,
 		StateEncoding:    stateBlob.Encoding.String(),
 	}, nil
@@ -1090,6 +1097,7 @@ func (m *sqlExecutionManager) createExecution(
 	versionHistories *historyspb.VersionHistories,
 	startVersion int64,
 	lastWriteVersion int64,
+	executionInfoEncoding enumspb.EncodingType,
 	shardID int32,
 ) error {
 
@@ -1109,6 +1117,7 @@ func (m *sqlExecutionManager) createExecution(
 		versionHistories,
 		startVersion,
 		lastWriteVersion,
+		executionInfoEncoding,
 		shardID,
 	)
 	if err != nil {
@@ -1145,6 +1154,7 @@ func updateExecution(
 	versionHistories *historyspb.VersionHistories,
 	startVersion int64,
 	lastWriteVersion int64,
+	executionInfoEncoding enumspb.EncodingType,
 	shardID int32,
 ) error {
 
@@ -1163,6 +1173,7 @@ func updateExecution(
 		versionHistories,
 		startVersion,
 		lastWriteVersion,
+		executionInfoEncoding,
 		shardID,
 	)
 	if err != nil {
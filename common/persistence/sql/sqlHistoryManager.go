@@ -26,7 +26,9 @@ package sql
 
 import (
 	"database/sql"
+	"encoding/json"
 	"fmt"
+	"math"
 
 	"go.temporal.io/api/serviceerror"
 
@@ -40,13 +42,24 @@ import (
 	"go.temporal.io/server/common/primitives/timestamp"
 )
 
-type sqlHistoryV2Manager struct {
-	sqlStore
-}
+type (
+	sqlHistoryV2Manager struct {
+		sqlStore
+		// replicaDB serves the reads that tolerate staleness
+		replicaDB sqlplugin.DB
+	}
+
+	historyTreeBranchPageToken struct {
+		ShardID  int32
+		TreeID   primitives.UUID
+		BranchID primitives.UUID
+	}
+)
 
 // newHistoryV2Persistence creates an instance of HistoryManager
 func newHistoryV2Persistence(
 	db sqlplugin.DB,
+	replicaDB sqlplugin.DB,
 	logger log.Logger,
 ) (p.HistoryStore, error) {
 
@@ -55,6 +68,7 @@ func newHistoryV2Persistence(
 			db:     db,
 			logger: logger,
 		},
+		replicaDB: replicaDB,
 	}, nil
 }
 
@@ -87,7 +101,7 @@ func (m *sqlHistoryV2Manager) AppendHistoryNodes(
 		NodeID:       request.NodeID,
 		TxnID:        request.TransactionID,
 		Data:         request.Events.Data,
-		DataEncoding: request.Events.Encoding.String(),
+		DataEncoding: serialization.EncodingTypeName(request.Events.Encoding),
 		ShardID:      request.ShardID,
 	}
 
@@ -179,7 +193,11 @@ func (m *sqlHistoryV2Manager) ReadHistoryBranch(
 		minNodeID = lastNodeID + 1
 	}
 
-	rows, err := m.db.SelectFromHistoryNode(sqlplugin.HistoryNodeSelectFilter{
+	db := m.db
+	if request.AllowStaleRead {
+		db = m.replicaDB
+	}
+	rows, err := db.SelectFromHistoryNode(sqlplugin.HistoryNodeSelectFilter{
 		ShardID:   request.ShardID,
 		TreeID:    treeIDBytes,
 		BranchID:  branchIDBytes,
@@ -454,13 +472,59 @@ func (m *sqlHistoryV2Manager) DeleteHistoryBranch(
 	})
 }
 
+// GetAllHistoryTreeBranches returns a page of branches of all trees, ordered by shard, tree and branch ID
 func (m *sqlHistoryV2Manager) GetAllHistoryTreeBranches(
 	request *p.GetAllHistoryTreeBranchesRequest,
 ) (*p.GetAllHistoryTreeBranchesResponse, error) {
 
-	// TODO https://github.com/uber/cadence/issues/2458
-	// Implement it when we need
-	panic("not implemented yet")
+	pageToken := &historyTreeBranchPageToken{ShardID: math.MinInt32}
+	if len(request.NextPageToken) > 0 {
+		if err := pageToken.deserialize(request.NextPageToken); err != nil {
+			return nil, serviceerror.NewInternal(fmt.Sprintf("GetAllHistoryTreeBranches operation failed. Error deserializing page token: %v", err))
+		}
+	}
+
+	rows, err := m.db.PaginateBranchesFromHistoryTree(sqlplugin.HistoryTreeBranchPageFilter{
+		ShardID:  pageToken.ShardID,
+		TreeID:   pageToken.TreeID,
+		BranchID: pageToken.BranchID,
+		PageSize: request.PageSize,
+	})
+	if err != nil && err != sql.ErrNoRows {
+		return nil, serviceerror.NewInternal(fmt.Sprintf("GetAllHistoryTreeBranches operation failed. Select failed: %v", err))
+	}
+
+	branches := make([]p.HistoryBranchDetail, 0, len(rows))
+	for _, row := range rows {
+		treeInfo, err := serialization.HistoryTreeInfoFromBlob(row.Data, row.DataEncoding)
+		if err != nil {
+			return nil, err
+		}
+		branches = append(branches, p.HistoryBranchDetail{
+			TreeID:   row.TreeID.String(),
+			BranchID: row.BranchID.String(),
+			ForkTime: treeInfo.ForkTime,
+			Info:     treeInfo.Info,
+		})
+	}
+
+	var nextPageToken []byte
+	if len(rows) > 0 && len(rows) == request.PageSize {
+		lastRow := rows[len(rows)-1]
+		nextPageToken, err = (&historyTreeBranchPageToken{
+			ShardID:  lastRow.ShardID,
+			TreeID:   lastRow.TreeID,
+			BranchID: lastRow.BranchID,
+		}).serialize()
+		if err != nil {
+			return nil, serviceerror.NewInternal(fmt.Sprintf("GetAllHistoryTreeBranches operation failed. Error serializing page token: %v", err))
+		}
+	}
+
+	return &p.GetAllHistoryTreeBranchesResponse{
+		Branches:      branches,
+		NextPageToken: nextPageToken,
+	}, nil
 }
 
 // GetHistoryTree returns all branch information of a tree
@@ -494,3 +558,11 @@ func (m *sqlHistoryV2Manager) GetHistoryTree(
 		Branches: branches,
 	}, nil
 }
+
+func (t *historyTreeBranchPageToken) serialize() ([]byte, error) {
+	return json.Marshal(t)
+}
+
+func (t *historyTreeBranchPageToken) deserialize(payload []byte) error {
+	return json.Unmarshal(payload, t)
+}
@@ -25,37 +25,60 @@
 package sql
 
 import (
+	"bytes"
 	"database/sql"
 	"encoding/json"
 	"fmt"
 	"time"
 
+	commonpb "go.temporal.io/api/common/v1"
 	enumspb "go.temporal.io/api/enums/v1"
 	"go.temporal.io/api/serviceerror"
+	"go.temporal.io/sdk/converter"
 
+	"go.temporal.io/server/common"
+	"go.temporal.io/server/common/definition"
 	"go.temporal.io/server/common/log"
+	"go.temporal.io/server/common/log/tag"
 	p "go.temporal.io/server/common/persistence"
 	"go.temporal.io/server/common/persistence/sql/sqlplugin"
+	"go.temporal.io/server/common/service/dynamicconfig"
 )
 
 type (
 	sqlVisibilityStore struct {
 		sqlStore
+		validSearchAttributes dynamicconfig.MapPropertyFn
 	}
 
 	visibilityPageToken struct {
 		Time  time.Time
 		RunID string
 	}
+
+	// visibilityQueryPageToken is the page token of the advanced visibility queries,
+	// list queries are paginated by offset and scan queries by run id
+	visibilityQueryPageToken struct {
+		Offset int
+		RunID  string
+	}
+)
+
+const (
+	defaultVisibilityQueryPageSize = 1000
 )
 
 // NewSQLVisibilityStore creates an instance of ExecutionStore
-func NewSQLVisibilityStore(db sqlplugin.DB, logger log.Logger) (p.VisibilityStore, error) {
+func NewSQLVisibilityStore(db sqlplugin.DB, validSearchAttributes dynamicconfig.MapPropertyFn, logger log.Logger) (p.VisibilityStore, error) {
+	if validSearchAttributes == nil {
+		validSearchAttributes = dynamicconfig.GetMapPropertyFn(definition.GetDefaultIndexedKeys())
+	}
 	return &sqlVisibilityStore{
 		sqlStore: sqlStore{
 			db:     db,
 			logger: logger,
 		},
+		validSearchAttributes: validSearchAttributes,
 	}, nil
 }
 
@@ -70,6 +93,8 @@ func (s *sqlVisibilityStore) RecordWorkflowExecutionStarted(request *p.InternalR
 		Status:           int32(enumspb.WORKFLOW_EXECUTION_STATUS_RUNNING), // Underlying value (1) is hardcoded in SQL queries.
 		Memo:             request.Memo.Data,
 		Encoding:         request.Memo.Encoding.String(),
+		TaskQueue:        request.TaskQueue,
+		SearchAttributes: s.encodeSearchAttributes(request.SearchAttributes),
 	})
 
 	return err
@@ -89,6 +114,8 @@ func (s *sqlVisibilityStore) RecordWorkflowExecutionClosed(request *p.InternalRe
 		HistoryLength:    &request.HistoryLength,
 		Memo:             request.Memo.Data,
 		Encoding:         request.Memo.Encoding.String(),
+		TaskQueue:        request.TaskQueue,
+		SearchAttributes: s.encodeSearchAttributes(request.SearchAttributes),
 	})
 	if err != nil {
 		return err
@@ -103,7 +130,23 @@ func (s *sqlVisibilityStore) RecordWorkflowExecutionClosed(request *p.InternalRe
 	return nil
 }
 
-func (s *sqlVisibilityStore) UpsertWorkflowExecution(_ *p.InternalUpsertWorkflowExecutionRequest) error {
+func (s *sqlVisibilityStore) UpsertWorkflowExecution(request *p.InternalUpsertWorkflowExecutionRequest) error {
+	_, err := s.db.UpsertIntoVisibility(&sqlplugin.VisibilityRow{
+		NamespaceID:      request.NamespaceID,
+		WorkflowID:       request.WorkflowID,
+		RunID:            request.RunID,
+		StartTime:        time.Unix(0, request.StartTimestamp).UTC(),
+		ExecutionTime:    time.Unix(0, request.ExecutionTimestamp).UTC(),
+		WorkflowTypeName: request.WorkflowTypeName,
+		Status:           int32(request.Status),
+		Memo:             request.Memo.Data,
+		Encoding:         request.Memo.Encoding.String(),
+		TaskQueue:        request.TaskQueue,
+		SearchAttributes: s.encodeSearchAttributes(request.SearchAttributes),
+	})
+	if err != nil {
+		return serviceerror.NewInternal(fmt.Sprintf("UpsertWorkflowExecution operation failed. Error: %v", err))
+	}
 	return nil
 }
 
@@ -244,15 +287,175 @@ func (s *sqlVisibilityStore) DeleteWorkflowExecution(request *p.VisibilityDelete
 }
 
 func (s *sqlVisibilityStore) ListWorkflowExecutions(request *p.ListWorkflowExecutionsRequestV2) (*p.InternalListWorkflowExecutionsResponse, error) {
-	return nil, p.NewOperationNotSupportErrorForVis()
+	token, err := s.deserializeQueryPageToken(request.NextPageToken)
+	if err != nil {
+		return nil, err
+	}
+	filter := s.newQueryFilter(request.NamespaceID, request.Query, request.PageSize)
+	filter.Offset = token.Offset
+	rows, err := s.db.SelectFromVisibilityByQuery(filter)
+	if err != nil {
+		return nil, s.convertQueryError("ListWorkflowExecutions", err)
+	}
+	token.Offset += len(rows)
+	return s.queryResponse(rows, filter.PageSize, token)
 }
 
 func (s *sqlVisibilityStore) ScanWorkflowExecutions(request *p.ListWorkflowExecutionsRequestV2) (*p.InternalListWorkflowExecutionsResponse, error) {
-	return nil, p.NewOperationNotSupportErrorForVis()
+	token, err := s.deserializeQueryPageToken(request.NextPageToken)
+	if err != nil {
+		return nil, err
+	}
+	filter := s.newQueryFilter(request.NamespaceID, request.Query, request.PageSize)
+	filter.AfterRunID = &token.RunID
+	rows, err := s.db.SelectFromVisibilityByQuery(filter)
+	if err != nil {
+		return nil, s.convertQueryError("ScanWorkflowExecutions", err)
+	}
+	if len(rows) > 0 {
+		token.RunID = rows[len(rows)-1].RunID
+	}
+	return s.queryResponse(rows, filter.PageSize, token)
 }
 
 func (s *sqlVisibilityStore) CountWorkflowExecutions(request *p.CountWorkflowExecutionsRequest) (*p.CountWorkflowExecutionsResponse, error) {
-	return nil, p.NewOperationNotSupportErrorForVis()
+	count, err := s.db.CountFromVisibilityByQuery(s.newQueryFilter(request.NamespaceID, request.Query, 0))
+	if err != nil {
+		return nil, s.convertQueryError("CountWorkflowExecutions", err)
+	}
+	return &p.CountWorkflowExecutionsResponse{Count: count}, nil
+}
+
+func (s *sqlVisibilityStore) newQueryFilter(namespaceID string, query string, pageSize int) sqlplugin.VisibilityQueryFilter {
+	if pageSize <= 0 {
+		pageSize = defaultVisibilityQueryPageSize
+	}
+	return sqlplugin.VisibilityQueryFilter{
+		NamespaceID:          namespaceID,
+		Query:                query,
+		SearchAttributeTypes: s.searchAttributeTypes(),
+		PageSize:             pageSize,
+	}
+}
+
+func (s *sqlVisibilityStore) queryResponse(
+	rows []sqlplugin.VisibilityRow,
+	pageSize int,
+	token *visibilityQueryPageToken,
+) (*p.InternalListWorkflowExecutionsResponse, error) {
+	response := &p.InternalListWorkflowExecutionsResponse{}
+	for i := range rows {
+		response.Executions = append(response.Executions, s.rowToInfo(&rows[i]))
+	}
+	// a full page means that there may be more rows
+	if len(rows) == pageSize {
+		nextPageToken, err := json.Marshal(token)
+		if err != nil {
+			return nil, serviceerror.NewInternal(fmt.Sprintf("unable to serialize page token. err: %v", err))
+		}
+		response.NextPageToken = nextPageToken
+	}
+	return response, nil
+}
+
+func (s *sqlVisibilityStore) convertQueryError(opName string, err error) error {
+	switch err.(type) {
+	case *serviceerror.InvalidArgument, *serviceerror.Unimplemented:
+		return err
+	}
+	return serviceerror.NewInternal(fmt.Sprintf("%v operation failed. Select failed: %v", opName, err))
+}
+
+func (s *sqlVisibilityStore) deserializeQueryPageToken(data []byte) (*visibilityQueryPageToken, error) {
+	var token visibilityQueryPageToken
+	if len(data) == 0 {
+		return &token, nil
+	}
+	if err := json.Unmarshal(data, &token); err != nil {
+		return nil, serviceerror.NewInvalidArgument(fmt.Sprintf("unable to deserialize page token. err: %v", err))
+	}
+	return &token, nil
+}
+
+func (s *sqlVisibilityStore) searchAttributeTypes() map[string]enumspb.IndexedValueType {
+	validSearchAttributes := s.validSearchAttributes()
+	types := make(map[string]enumspb.IndexedValueType, len(validSearchAttributes))
+	for name, valueType := range validSearchAttributes {
+		types[name] = common.ConvertIndexedValueTypeToProtoType(valueType, s.logger)
+	}
+	return types
+}
+
+// encodeSearchAttributes returns the JSON object stored in the search_attributes column, datetimes
+// are stored as fixed width UTC strings so that they can be compared as strings by every plugin.
+// Attributes that are not valid search attributes are not stored.
+func (s *sqlVisibilityStore) encodeSearchAttributes(searchAttributes map[string]*commonpb.Payload) *string {
+	if len(searchAttributes) == 0 {
+		return nil
+	}
+	types := s.searchAttributeTypes()
+	values := make(map[string]interface{}, len(searchAttributes))
+	for name, value := range searchAttributes {
+		valueType, ok := types[name]
+		if !ok || definition.IsSystemIndexedKey(name) {
+			s.logger.Warn("Unknown search attribute is not stored in visibility", tag.Value(name))
+			continue
+		}
+		decoded, err := s.decodeSearchAttributeValue(value, valueType)
+		if err != nil {
+			s.logger.Warn("Unable to decode search attribute", tag.Value(name), tag.Error(err))
+			continue
+		}
+		switch v := decoded.(type) {
+		case time.Time:
+			decoded = v.UTC().Format(sqlplugin.VisibilityDateTimeFormat)
+		case []time.Time:
+			times := make([]string, len(v))
+			for i, t := range v {
+				times[i] = t.UTC().Format(sqlplugin.VisibilityDateTimeFormat)
+			}
+			decoded = times
+		}
+		values[name] = decoded
+	}
+	if len(values) == 0 {
+		return nil
+	}
+	data, err := json.Marshal(values)
+	if err != nil {
+		s.logger.Warn("Unable to encode search attributes", tag.Error(err))
+		return nil
+	}
+	encoded := string(data)
+	return &encoded
+}
+
+// decodeSearchAttributeValue decodes a search attribute payload, raw binary payloads are only
+// accepted for string and keyword attributes as the data converter can't decode them into strings.
+func (s *sqlVisibilityStore) decodeSearchAttributeValue(value *commonpb.Payload, valueType enumspb.IndexedValueType) (interface{}, error) {
+	if string(value.GetMetadata()[converter.MetadataEncoding]) != converter.MetadataEncodingBinary {
+		return common.DeserializeSearchAttributeValue(value, valueType)
+	}
+	switch valueType {
+	case enumspb.INDEXED_VALUE_TYPE_STRING, enumspb.INDEXED_VALUE_TYPE_KEYWORD:
+		return string(value.GetData()), nil
+	default:
+		return nil, fmt.Errorf("binary payload can't be decoded as %v", valueType)
+	}
+}
+
+func (s *sqlVisibilityStore) decodeSearchAttributes(searchAttributes *string) map[string]interface{} {
+	if searchAttributes == nil || *searchAttributes == "" {
+		return nil
+	}
+	var values map[string]interface{}
+	dec := json.NewDecoder(bytes.NewReader([]byte(*searchAttributes)))
+	dec.UseNumber()
+	if err := dec.Decode(&values); err != nil {
+		s.logger.Warn("Unable to decode search attributes", tag.Error(err))
+		return nil
+	}
+	return values
 }
 
 func (s *sqlVisibilityStore) rowToInfo(row *sqlplugin.VisibilityRow) *p.VisibilityWorkflowExecutionInfo {
@@ -260,13 +463,15 @@ func (s *sqlVisibilityStore) rowToInfo(row *sqlplugin.VisibilityRow) *p.Visibili
 		row.ExecutionTime = row.StartTime
 	}
 	info := &p.VisibilityWorkflowExecutionInfo{
-		WorkflowID:    row.WorkflowID,
-		RunID:         row.RunID,
-		TypeName:      row.WorkflowTypeName,
-		StartTime:     row.StartTime,
-		ExecutionTime: row.ExecutionTime,
-		Memo:          p.NewDataBlob(row.Memo, row.Encoding),
-		Status:        enumspb.WorkflowExecutionStatus(row.Status),
+		WorkflowID:       row.WorkflowID,
+		RunID:            row.RunID,
+		TypeName:         row.WorkflowTypeName,
+		StartTime:        row.StartTime,
+		ExecutionTime:    row.ExecutionTime,
+		Memo:             p.NewDataBlob(row.Memo, row.Encoding),
+		Status:           enumspb.WorkflowExecutionStatus(row.Status),
+		TaskQueue:        row.TaskQueue,
+		SearchAttributes: s.decodeSearchAttributes(row.SearchAttributes),
 	}
 	if row.CloseTime != nil {
 		info.CloseTime = *row.CloseTime
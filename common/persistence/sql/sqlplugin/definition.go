@@ -247,4 +247,12 @@ type (
 		QueueType persistence.QueueType
 		Data      []byte
 	}
+	// SchemaUpdateHistoryRow represents a row in schema_update_history table
+	SchemaUpdateHistoryRow struct {
+		UpdateTime  time.Time
+		OldVersion  string
+		NewVersion  string
+		ManifestMD5 string `db:"manifest_md5"` // the snake case mapping would be manifest_md_5
+		Description string
+	}
 )
@@ -55,10 +55,21 @@ type (
 		BranchID primitives.UUID
 	}
 
+	// HistoryTreeBranchPageFilter contains the column names within history_tree table that
+	// can be used to paginate through all the branches of all the trees, rows are ordered by
+	// shard ID, tree ID and branch ID and the page starts right after the given row
+	HistoryTreeBranchPageFilter struct {
+		ShardID  int32
+		TreeID   primitives.UUID
+		BranchID primitives.UUID
+		PageSize int
+	}
+
 	// HistoryNode is the SQL persistence interface for history trees
 	HistoryTree interface {
 		InsertIntoHistoryTree(row *HistoryTreeRow) (sql.Result, error)
 		SelectFromHistoryTree(filter HistoryTreeSelectFilter) ([]HistoryTreeRow, error)
+		PaginateBranchesFromHistoryTree(filter HistoryTreeBranchPageFilter) ([]HistoryTreeRow, error)
 		DeleteFromHistoryTree(filter HistoryTreeDeleteFilter) (sql.Result, error)
 	}
 )
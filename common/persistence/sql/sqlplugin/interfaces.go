@@ -26,6 +26,7 @@ package sqlplugin
 
 import (
 	"database/sql"
+	"time"
 
 	"go.temporal.io/server/common/service/config"
 )
@@ -72,6 +73,7 @@ type (
 		ReadSchemaVersion(database string) (string, error)
 		UpdateSchemaVersion(database string, newVersion string, minCompatibleVersion string) error
 		WriteSchemaUpdateLog(oldVersion string, newVersion string, manifestMD5 string, desc string) error
+		ReadSchemaUpdateLog() ([]SchemaUpdateHistoryRow, error)
 		ListTables(database string) ([]string, error)
 		DropTable(table string) error
 		DropAllTables(database string) error
@@ -94,6 +96,8 @@ type (
 		BeginTx() (Tx, error)
 		PluginName() string
 		IsDupEntryError(err error) bool
+		// ReplicationLag returns how far this database lags behind its primary, zero if it is not a replica
+		ReplicationLag() (time.Duration, error)
 		Close() error
 	}
 
@@ -27,6 +27,8 @@ package mysql
 import (
 	"fmt"
 	"time"
+
+	"go.temporal.io/server/common/persistence/sql/sqlplugin"
 )
 
 const (
@@ -39,6 +41,8 @@ const (
 
 	writeSchemaUpdateHistoryQuery = `INSERT into schema_update_history(version_partition, year, month, update_time, old_version, new_version, manifest_md5, description) VALUES(0,?,?,?,?,?,?,?)`
 
+	readSchemaUpdateHistoryQuery = `SELECT update_time, old_version, new_version, manifest_md5, description FROM schema_update_history WHERE version_partition=0 ORDER BY update_time`
+
 	createSchemaVersionTableQuery = `CREATE TABLE schema_version(version_partition INT not null, ` +
 		`db_name VARCHAR(255) not null, ` +
 		`creation_time DATETIME(6), ` +
@@ -93,6 +97,13 @@ func (mdb *db) WriteSchemaUpdateLog(oldVersion string, newVersion string, manife
 	return mdb.Exec(writeSchemaUpdateHistoryQuery, now.Year(), int(now.Month()), now, oldVersion, newVersion, manifestMD5, desc)
 }
 
+// ReadSchemaUpdateLog returns the entries of the schema update history table, oldest first
+func (mdb *db) ReadSchemaUpdateLog() ([]sqlplugin.SchemaUpdateHistoryRow, error) {
+	var rows []sqlplugin.SchemaUpdateHistoryRow
+	err := mdb.db.Select(&rows, readSchemaUpdateHistoryQuery)
+	return rows, err
+}
+
 // Exec executes a sql statement
 func (mdb *db) Exec(stmt string, args ...interface{}) error {
 	_, err := mdb.db.Exec(stmt, args...)
@@ -25,6 +25,10 @@
 package mysql
 
 import (
+	"errors"
+	"strconv"
+	"time"
+
 	"github.com/go-sql-driver/mysql"
 	"github.com/jmoiron/sqlx"
 
@@ -92,3 +96,30 @@ func (mdb *db) Close() error {
 func (mdb *db) PluginName() string {
 	return PluginName
 }
+
+// ReplicationLag returns the replication delay reported by the replica, zero if this is not a replica
+func (mdb *db) ReplicationLag() (time.Duration, error) {
+	rows, err := mdb.db.Queryx("SHOW SLAVE STATUS")
+	if err != nil {
+		return 0, err
+	}
+	defer rows.Close()
+	if !rows.Next() {
+		return 0, rows.Err()
+	}
+
+	status := make(map[string]interface{})
+	if err := rows.MapScan(status); err != nil {
+		return 0, err
+	}
+	value, ok := status["Seconds_Behind_Master"].([]byte)
+	if !ok {
+		// NULL means the replication threads are not running
+		return 0, errors.New("replication is not running")
+	}
+	seconds, err := strconv.ParseInt(string(value), 10, 64)
+	if err != nil {
+		return 0, err
+	}
+	return time.Duration(seconds) * time.Second, nil
+}
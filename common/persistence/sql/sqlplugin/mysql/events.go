@@ -48,6 +48,10 @@ const (
 
 	getHistoryTreeQuery = `SELECT branch_id, data, data_encoding FROM history_tree WHERE shard_id = ? AND tree_id = ? `
 
+	paginateBranchesQuery = `SELECT shard_id, tree_id, branch_id, data, data_encoding FROM history_tree ` +
+		`WHERE (shard_id = ? AND tree_id = ? AND branch_id > ?) OR (shard_id = ? AND tree_id > ?) OR shard_id > ? ` +
+		`ORDER BY shard_id, tree_id, branch_id LIMIT ?`
+
 	deleteHistoryTreeQuery = `DELETE FROM history_tree WHERE shard_id = ? AND tree_id = ? AND branch_id = ? `
 )
 
@@ -91,6 +95,17 @@ func (mdb *db) SelectFromHistoryTree(filter sqlplugin.HistoryTreeSelectFilter) (
 	return rows, err
 }
 
+// PaginateBranchesFromHistoryTree reads a page of branches of all trees from history_tree table
+func (mdb *db) PaginateBranchesFromHistoryTree(filter sqlplugin.HistoryTreeBranchPageFilter) ([]sqlplugin.HistoryTreeRow, error) {
+	var rows []sqlplugin.HistoryTreeRow
+	err := mdb.conn.Select(&rows, paginateBranchesQuery,
+		filter.ShardID, filter.TreeID, filter.BranchID,
+		filter.ShardID, filter.TreeID,
+		filter.ShardID,
+		filter.PageSize)
+	return rows, err
+}
+
 // DeleteFromHistoryTree deletes one or more rows from history_tree table
 func (mdb *db) DeleteFromHistoryTree(filter sqlplugin.HistoryTreeDeleteFilter) (sql.Result, error) {
 	return mdb.conn.Exec(deleteHistoryTreeQuery, filter.ShardID, filter.TreeID, filter.BranchID)
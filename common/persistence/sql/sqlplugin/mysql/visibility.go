@@ -28,22 +28,31 @@ import (
 	"database/sql"
 	"errors"
 	"fmt"
+	"time"
+
+	enumspb "go.temporal.io/api/enums/v1"
 
 	"go.temporal.io/server/common/persistence/sql/sqlplugin"
 )
 
 const (
 	templateCreateWorkflowExecutionStarted = `INSERT INTO executions_visibility (` +
-		`namespace_id, workflow_id, run_id, start_time, execution_time, workflow_type_name, status, memo, encoding) ` +
-		`VALUES (?, ?, ?, ?, ?, ?, ?, ?, ?) ` +
+		`namespace_id, workflow_id, run_id, start_time, execution_time, workflow_type_name, status, memo, encoding, task_queue, search_attributes) ` +
+		`VALUES (?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?) ` +
 		`ON DUPLICATE KEY UPDATE ` +
 		`run_id=VALUES(run_id)`
 
-	templateCreateWorkflowExecutionClosed = `INSERT INTO executions_visibility (` +
-		`namespace_id, workflow_id, run_id, start_time, execution_time, workflow_type_name, close_time, status, history_length, memo, encoding) ` +
+	templateUpsertWorkflowExecution = `INSERT INTO executions_visibility (` +
+		`namespace_id, workflow_id, run_id, start_time, execution_time, workflow_type_name, status, memo, encoding, task_queue, search_attributes) ` +
 		`VALUES (?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?) ` +
+		`ON DUPLICATE KEY UPDATE memo = VALUES(memo), encoding = VALUES(encoding), search_attributes = VALUES(search_attributes)`
+
+	templateCreateWorkflowExecutionClosed = `INSERT INTO executions_visibility (` +
+		`namespace_id, workflow_id, run_id, start_time, execution_time, workflow_type_name, close_time, status, history_length, memo, encoding, task_queue, search_attributes) ` +
+		`VALUES (?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?) ` +
 		`ON DUPLICATE KEY UPDATE workflow_id = VALUES(workflow_id), start_time = VALUES(start_time), execution_time = VALUES(execution_time), workflow_type_name = VALUES(workflow_type_name), ` +
-		`close_time = VALUES(close_time), status = VALUES(status), history_length = VALUES(history_length), memo = VALUES(memo), encoding = VALUES(encoding)`
+		`close_time = VALUES(close_time), status = VALUES(status), history_length = VALUES(history_length), memo = VALUES(memo), encoding = VALUES(encoding), ` +
+		`task_queue = VALUES(task_queue), search_attributes = VALUES(search_attributes)`
 
 	// RunID condition is needed for correct pagination
 	templateConditions = ` AND namespace_id = ?
@@ -60,7 +69,7 @@ const (
 	ORDER BY close_time DESC, run_id
 	LIMIT ?`
 
-	templateOpenFieldNames = `workflow_id, run_id, start_time, execution_time, workflow_type_name, status, memo, encoding`
+	templateOpenFieldNames = `workflow_id, run_id, start_time, execution_time, workflow_type_name, status, memo, encoding, task_queue, search_attributes`
 	templateOpenSelect     = `SELECT ` + templateOpenFieldNames + ` FROM executions_visibility WHERE status = 1 `
 
 	templateClosedSelect = `SELECT ` + templateOpenFieldNames + `, close_time, history_length
@@ -80,7 +89,7 @@ const (
 
 	templateGetClosedWorkflowExecutionsByStatus = templateClosedSelect + `AND status = ?` + templateConditionsClosedWorkflows
 
-	templateGetClosedWorkflowExecution = `SELECT workflow_id, run_id, start_time, execution_time, memo, encoding, close_time, workflow_type_name, status, history_length 
+	templateGetClosedWorkflowExecution = `SELECT workflow_id, run_id, start_time, execution_time, memo, encoding, close_time, workflow_type_name, status, history_length, task_queue, search_attributes 
 		 FROM executions_visibility
 		 WHERE namespace_id = ? AND status != 1
 		 AND run_id = ?`
@@ -90,6 +99,10 @@ const (
 
 var errCloseParams = errors.New("missing one of {closeTime, historyLength} params")
 
+type queryDialect struct{}
+
+var _ sqlplugin.VisibilityQueryDialect = queryDialect{}
+
 // InsertIntoVisibility inserts a row into visibility table. If an row already exist,
 // its left as such and no update will be made
 func (mdb *db) InsertIntoVisibility(row *sqlplugin.VisibilityRow) (sql.Result, error) {
@@ -103,7 +116,9 @@ func (mdb *db) InsertIntoVisibility(row *sqlplugin.VisibilityRow) (sql.Result, e
 		row.WorkflowTypeName,
 		row.Status,
 		row.Memo,
-		row.Encoding)
+		row.Encoding,
+		row.TaskQueue,
+		row.SearchAttributes)
 }
 
 // ReplaceIntoVisibility replaces an existing row if it exist or creates a new row in visibility table
@@ -123,12 +138,32 @@ func (mdb *db) ReplaceIntoVisibility(row *sqlplugin.VisibilityRow) (sql.Result,
 			row.Status,
 			*row.HistoryLength,
 			row.Memo,
-			row.Encoding)
+			row.Encoding,
+			row.TaskQueue,
+			row.SearchAttributes)
 	default:
 		return nil, errCloseParams
 	}
 }
 
+// UpsertIntoVisibility inserts a row into visibility table. If a row already exist,
+// only its memo and search attributes are updated
+func (mdb *db) UpsertIntoVisibility(row *sqlplugin.VisibilityRow) (sql.Result, error) {
+	row.StartTime = mdb.converter.ToMySQLDateTime(row.StartTime)
+	return mdb.conn.Exec(templateUpsertWorkflowExecution,
+		row.NamespaceID,
+		row.WorkflowID,
+		row.RunID,
+		row.StartTime,
+		row.ExecutionTime,
+		row.WorkflowTypeName,
+		row.Status,
+		row.Memo,
+		row.Encoding,
+		row.TaskQueue,
+		row.SearchAttributes)
+}
+
 // DeleteFromVisibility deletes a row from visibility table if it exist
 func (mdb *db) DeleteFromVisibility(filter sqlplugin.VisibilityDeleteFilter) (sql.Result, error) {
 	return mdb.conn.Exec(templateDeleteWorkflowExecution, filter.NamespaceID, filter.RunID)
@@ -228,3 +263,61 @@ func (mdb *db) SelectFromVisibility(filter sqlplugin.VisibilitySelectFilter) ([]
 	}
 	return rows, err
 }
+
+// SelectFromVisibilityByQuery reads the rows of visibility table that match an advanced visibility query
+func (mdb *db) SelectFromVisibilityByQuery(filter sqlplugin.VisibilityQueryFilter) ([]sqlplugin.VisibilityRow, error) {
+	query, args, err := sqlplugin.BuildVisibilitySelectQuery(queryDialect{}, filter)
+	if err != nil {
+		return nil, err
+	}
+	for i, arg := range args {
+		if t, ok := arg.(time.Time); ok {
+			args[i] = mdb.converter.ToMySQLDateTime(t)
+		}
+	}
+	var rows []sqlplugin.VisibilityRow
+	if err := mdb.conn.Select(&rows, query, args...); err != nil {
+		return nil, err
+	}
+	for i := range rows {
+		rows[i].StartTime = mdb.converter.FromMySQLDateTime(rows[i].StartTime)
+		rows[i].ExecutionTime = mdb.converter.FromMySQLDateTime(rows[i].ExecutionTime)
+		if rows[i].CloseTime != nil {
+			closeTime := mdb.converter.FromMySQLDateTime(*rows[i].CloseTime)
+			rows[i].CloseTime = &closeTime
+		}
+	}
+	return rows, nil
+}
+
+// CountFromVisibilityByQuery counts the rows of visibility table that match an advanced visibility query
+func (mdb *db) CountFromVisibilityByQuery(filter sqlplugin.VisibilityQueryFilter) (int64, error) {
+	query, args, err := sqlplugin.BuildVisibilityCountQuery(queryDialect{}, filter)
+	if err != nil {
+		return 0, err
+	}
+	for i, arg := range args {
+		if t, ok := arg.(time.Time); ok {
+			args[i] = mdb.converter.ToMySQLDateTime(t)
+		}
+	}
+	var count int64
+	if err := mdb.conn.Get(&count, query, args...); err != nil {
+		return 0, err
+	}
+	return count, nil
+}
+
+func (queryDialect) Placeholder(_ int) string {
+	return "?"
+}
+
+func (queryDialect) SearchAttribute(name string, valueType enumspb.IndexedValueType) string {
+	path := fmt.Sprintf(`search_attributes, '$."%s"'`, name)
+	switch valueType {
+	case enumspb.INDEXED_VALUE_TYPE_INT, enumspb.INDEXED_VALUE_TYPE_DOUBLE:
+		return "JSON_EXTRACT(" + path + ")"
+	default:
+		return "JSON_UNQUOTE(JSON_EXTRACT(" + path + "))"
+	}
+}
@@ -27,6 +27,8 @@ package postgresql
 import (
 	"fmt"
 	"time"
+
+	"go.temporal.io/server/common/persistence/sql/sqlplugin"
 )
 
 const (
@@ -40,6 +42,8 @@ const (
 
 	writeSchemaUpdateHistoryQuery = `INSERT into schema_update_history(version_partition, year, month, update_time, old_version, new_version, manifest_md5, description) VALUES(0,$1,$2,$3,$4,$5,$6,$7)`
 
+	readSchemaUpdateHistoryQuery = `SELECT update_time, old_version, new_version, manifest_md5, description FROM schema_update_history WHERE version_partition=0 ORDER BY update_time`
+
 	createSchemaVersionTableQuery = `CREATE TABLE schema_version(` +
 		`version_partition INT not null, ` +
 		`db_name VARCHAR(255) not null, ` +
@@ -97,6 +101,13 @@ func (pdb *db) WriteSchemaUpdateLog(oldVersion string, newVersion string, manife
 	return pdb.Exec(writeSchemaUpdateHistoryQuery, now.Year(), int(now.Month()), now, oldVersion, newVersion, manifestMD5, desc)
 }
 
+// ReadSchemaUpdateLog returns the entries of the schema update history table, oldest first
+func (pdb *db) ReadSchemaUpdateLog() ([]sqlplugin.SchemaUpdateHistoryRow, error) {
+	var rows []sqlplugin.SchemaUpdateHistoryRow
+	err := pdb.db.Select(&rows, readSchemaUpdateHistoryQuery)
+	return rows, err
+}
+
 // Exec executes a sql statement
 func (pdb *db) Exec(stmt string, args ...interface{}) error {
 	_, err := pdb.db.Exec(stmt, args...)
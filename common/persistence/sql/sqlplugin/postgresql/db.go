@@ -25,6 +25,8 @@
 package postgresql
 
 import (
+	"time"
+
 	"github.com/jmoiron/sqlx"
 	"github.com/lib/pq"
 
@@ -91,3 +93,17 @@ func (pdb *db) Close() error {
 func (pdb *db) PluginName() string {
 	return PluginName
 }
+
+// replicationLagQuery is zero on a primary and on a replica that has replayed everything it received
+const replicationLagQuery = `SELECT COALESCE(CASE WHEN pg_last_wal_receive_lsn() = pg_last_wal_replay_lsn() THEN 0 ` +
+	`ELSE EXTRACT(EPOCH FROM now() - pg_last_xact_replay_timestamp()) END, 0)`
+
+// ReplicationLag returns how long ago the last replayed transaction was committed on the primary,
+// zero if this is not a replica
+func (pdb *db) ReplicationLag() (time.Duration, error) {
+	var seconds float64
+	if err := pdb.db.Get(&seconds, replicationLagQuery); err != nil {
+		return 0, err
+	}
+	return time.Duration(seconds * float64(time.Second)), nil
+}
@@ -48,6 +48,10 @@ const (
 
 	getHistoryTreeQuery = `SELECT branch_id, data, data_encoding FROM history_tree WHERE shard_id = $1 AND tree_id = $2 `
 
+	paginateBranchesQuery = `SELECT shard_id, tree_id, branch_id, data, data_encoding FROM history_tree ` +
+		`WHERE (shard_id = $1 AND tree_id = $2 AND branch_id > $3) OR (shard_id = $4 AND tree_id > $5) OR shard_id > $6 ` +
+		`ORDER BY shard_id, tree_id, branch_id LIMIT $7`
+
 	deleteHistoryTreeQuery = `DELETE FROM history_tree WHERE shard_id = $1 AND tree_id = $2 AND branch_id = $3 `
 )
 
@@ -91,6 +95,17 @@ func (pdb *db) SelectFromHistoryTree(filter sqlplugin.HistoryTreeSelectFilter) (
 	return rows, err
 }
 
+// PaginateBranchesFromHistoryTree reads a page of branches of all trees from history_tree table
+func (pdb *db) PaginateBranchesFromHistoryTree(filter sqlplugin.HistoryTreeBranchPageFilter) ([]sqlplugin.HistoryTreeRow, error) {
+	var rows []sqlplugin.HistoryTreeRow
+	err := pdb.conn.Select(&rows, paginateBranchesQuery,
+		filter.ShardID, filter.TreeID, filter.BranchID,
+		filter.ShardID, filter.TreeID,
+		filter.ShardID,
+		filter.PageSize)
+	return rows, err
+}
+
 // DeleteFromHistoryTree deletes one or more rows from history_tree table
 func (pdb *db) DeleteFromHistoryTree(filter sqlplugin.HistoryTreeDeleteFilter) (sql.Result, error) {
 	return pdb.conn.Exec(deleteHistoryTreeQuery, filter.ShardID, filter.TreeID, filter.BranchID)
@@ -29,19 +29,30 @@ import (
 	"errors"
 	"fmt"
 	"strings"
+	"time"
+
+	enumspb "go.temporal.io/api/enums/v1"
 
 	"go.temporal.io/server/common/persistence/sql/sqlplugin"
 )
 
 const (
 	templateCreateWorkflowExecutionStarted = `INSERT INTO executions_visibility (` +
-		`namespace_id, workflow_id, run_id, start_time, execution_time, workflow_type_name, status, memo, encoding) ` +
-		`VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9)
+		`namespace_id, workflow_id, run_id, start_time, execution_time, workflow_type_name, status, memo, encoding, task_queue, search_attributes) ` +
+		`VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11)
          ON CONFLICT (namespace_id, run_id) DO NOTHING`
 
-	templateCreateWorkflowExecutionClosed = `INSERT INTO executions_visibility (` +
-		`namespace_id, workflow_id, run_id, start_time, execution_time, workflow_type_name, close_time, status, history_length, memo, encoding) ` +
+	templateUpsertWorkflowExecution = `INSERT INTO executions_visibility (` +
+		`namespace_id, workflow_id, run_id, start_time, execution_time, workflow_type_name, status, memo, encoding, task_queue, search_attributes) ` +
 		`VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11)
+		ON CONFLICT (namespace_id, run_id) DO UPDATE
+		  SET memo = excluded.memo,
+		      encoding = excluded.encoding,
+		      search_attributes = excluded.search_attributes`
+
+	templateCreateWorkflowExecutionClosed = `INSERT INTO executions_visibility (` +
+		`namespace_id, workflow_id, run_id, start_time, execution_time, workflow_type_name, close_time, status, history_length, memo, encoding, task_queue, search_attributes) ` +
+		`VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12, $13)
 		ON CONFLICT (namespace_id, run_id) DO UPDATE 
 		  SET workflow_id = excluded.workflow_id,
 		      start_time = excluded.start_time,
@@ -51,7 +62,9 @@ const (
 			  status = excluded.status,
 			  history_length = excluded.history_length,
 			  memo = excluded.memo,
-			  encoding = excluded.encoding`
+			  encoding = excluded.encoding,
+			  task_queue = excluded.task_queue,
+			  search_attributes = excluded.search_attributes`
 
 	// RunID condition is needed for correct pagination
 	templateConditions1 = ` AND namespace_id = $1
@@ -82,7 +95,7 @@ const (
          ORDER BY close_time DESC, run_id
          LIMIT $8`
 
-	templateOpenFieldNames = `workflow_id, run_id, start_time, execution_time, workflow_type_name, status, memo, encoding`
+	templateOpenFieldNames = `workflow_id, run_id, start_time, execution_time, workflow_type_name, status, memo, encoding, task_queue, search_attributes`
 	templateOpenSelect     = `SELECT ` + templateOpenFieldNames + ` FROM executions_visibility WHERE status = 1 `
 
 	templateClosedSelect = `SELECT ` + templateOpenFieldNames + `, close_time, history_length
@@ -102,7 +115,7 @@ const (
 
 	templateGetClosedWorkflowExecutionsByStatus = templateClosedSelect + `AND status = $1` + templateConditionsClosedWorkflow2
 
-	templateGetClosedWorkflowExecution = `SELECT workflow_id, run_id, start_time, execution_time, memo, encoding, close_time, workflow_type_name, status, history_length 
+	templateGetClosedWorkflowExecution = `SELECT workflow_id, run_id, start_time, execution_time, memo, encoding, close_time, workflow_type_name, status, history_length, task_queue, search_attributes 
 		 FROM executions_visibility
 		 WHERE namespace_id = $1 AND status != 1
 		 AND run_id = $2`
@@ -112,6 +125,10 @@ const (
 
 var errCloseParams = errors.New("missing one of {closeTime, historyLength} params")
 
+type queryDialect struct{}
+
+var _ sqlplugin.VisibilityQueryDialect = queryDialect{}
+
 // InsertIntoVisibility inserts a row into visibility table. If an row already exist,
 // its left as such and no update will be made
 func (pdb *db) InsertIntoVisibility(row *sqlplugin.VisibilityRow) (sql.Result, error) {
@@ -125,7 +142,9 @@ func (pdb *db) InsertIntoVisibility(row *sqlplugin.VisibilityRow) (sql.Result, e
 		row.WorkflowTypeName,
 		row.Status,
 		row.Memo,
-		row.Encoding)
+		row.Encoding,
+		row.TaskQueue,
+		row.SearchAttributes)
 }
 
 // ReplaceIntoVisibility replaces an existing row if it exist or creates a new row in visibility table
@@ -145,12 +164,32 @@ func (pdb *db) ReplaceIntoVisibility(row *sqlplugin.VisibilityRow) (sql.Result,
 			row.Status,
 			*row.HistoryLength,
 			row.Memo,
-			row.Encoding)
+			row.Encoding,
+			row.TaskQueue,
+			row.SearchAttributes)
 	default:
 		return nil, errCloseParams
 	}
 }
 
+// UpsertIntoVisibility inserts a row into visibility table. If a row already exist,
+// only its memo and search attributes are updated
+func (pdb *db) UpsertIntoVisibility(row *sqlplugin.VisibilityRow) (sql.Result, error) {
+	row.StartTime = pdb.converter.ToPostgreSQLDateTime(row.StartTime)
+	return pdb.conn.Exec(templateUpsertWorkflowExecution,
+		row.NamespaceID,
+		row.WorkflowID,
+		row.RunID,
+		row.StartTime,
+		row.ExecutionTime,
+		row.WorkflowTypeName,
+		row.Status,
+		row.Memo,
+		row.Encoding,
+		row.TaskQueue,
+		row.SearchAttributes)
+}
+
 // DeleteFromVisibility deletes a row from visibility table if it exist
 func (pdb *db) DeleteFromVisibility(filter sqlplugin.VisibilityDeleteFilter) (sql.Result, error) {
 	return pdb.conn.Exec(templateDeleteWorkflowExecution, filter.NamespaceID, filter.RunID)
@@ -253,3 +292,64 @@ func (pdb *db) SelectFromVisibility(filter sqlplugin.VisibilitySelectFilter) ([]
 	}
 	return rows, err
 }
+
+// SelectFromVisibilityByQuery reads the rows of visibility table that match an advanced visibility query
+func (pdb *db) SelectFromVisibilityByQuery(filter sqlplugin.VisibilityQueryFilter) ([]sqlplugin.VisibilityRow, error) {
+	query, args, err := sqlplugin.BuildVisibilitySelectQuery(queryDialect{}, filter)
+	if err != nil {
+		return nil, err
+	}
+	for i, arg := range args {
+		if t, ok := arg.(time.Time); ok {
+			args[i] = pdb.converter.ToPostgreSQLDateTime(t)
+		}
+	}
+	var rows []sqlplugin.VisibilityRow
+	if err := pdb.conn.Select(&rows, query, args...); err != nil {
+		return nil, err
+	}
+	for i := range rows {
+		rows[i].StartTime = pdb.converter.FromPostgreSQLDateTime(rows[i].StartTime)
+		rows[i].ExecutionTime = pdb.converter.FromPostgreSQLDateTime(rows[i].ExecutionTime)
+		if rows[i].CloseTime != nil {
+			closeTime := pdb.converter.FromPostgreSQLDateTime(*rows[i].CloseTime)
+			rows[i].CloseTime = &closeTime
+		}
+		rows[i].RunID = strings.TrimSpace(rows[i].RunID)
+	}
+	return rows, nil
+}
+
+// CountFromVisibilityByQuery counts the rows of visibility table that match an advanced visibility query
+func (pdb *db) CountFromVisibilityByQuery(filter sqlplugin.VisibilityQueryFilter) (int64, error) {
+	query, args, err := sqlplugin.BuildVisibilityCountQuery(queryDialect{}, filter)
+	if err != nil {
+		return 0, err
+	}
+	for i, arg := range args {
+		if t, ok := arg.(time.Time); ok {
+			args[i] = pdb.converter.ToPostgreSQLDateTime(t)
+		}
+	}
+	var count int64
+	if err := pdb.conn.Get(&count, query, args...); err != nil {
+		return 0, err
+	}
+	return count, nil
+}
+
+func (queryDialect) Placeholder(n int) string {
+	return fmt.Sprintf("$%d", n)
+}
+
+func (queryDialect) SearchAttribute(name string, valueType enumspb.IndexedValueType) string {
+	value := fmt.Sprintf("(search_attributes->>'%s')", name)
+	switch valueType {
+	case enumspb.INDEXED_VALUE_TYPE_INT:
+		return value + "::bigint"
+	case enumspb.INDEXED_VALUE_TYPE_DOUBLE:
+		return value + "::double precision"
+	default:
+		return value
+	}
+}
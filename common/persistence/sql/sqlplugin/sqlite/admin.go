@@ -0,0 +1,148 @@
+// The MIT License
+//
+// Copyright (c) 2020 Temporal Technologies Inc.  All rights reserved.
+//
+// Copyright (c) 2020 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package sqlite
+
+import (
+	"fmt"
+	"os"
+	"time"
+
+	"go.temporal.io/server/common/persistence/sql/sqlplugin"
+)
+
+const (
+	readSchemaVersionQuery = `SELECT curr_version from schema_version where version_partition=0 and db_name=?`
+
+	writeSchemaVersionQuery = `INSERT into schema_version(version_partition, db_name, creation_time, curr_version, min_compatible_version) ` +
+		`VALUES (0,?,?,?,?) ` +
+		`ON CONFLICT (version_partition, db_name) DO UPDATE ` +
+		`SET creation_time=excluded.creation_time, curr_version=excluded.curr_version, min_compatible_version=excluded.min_compatible_version`
+
+	writeSchemaUpdateHistoryQuery = `INSERT into schema_update_history(version_partition, year, month, update_time, old_version, new_version, manifest_md5, description) VALUES(0,?,?,?,?,?,?,?)`
+
+	readSchemaUpdateHistoryQuery = `SELECT update_time, old_version, new_version, manifest_md5, description FROM schema_update_history WHERE version_partition=0 ORDER BY update_time`
+
+	createSchemaVersionTableQuery = `CREATE TABLE schema_version(version_partition INT not null, ` +
+		`db_name VARCHAR(255) not null, ` +
+		`creation_time TIMESTAMP, ` +
+		`curr_version VARCHAR(64), ` +
+		`min_compatible_version VARCHAR(64), ` +
+		`PRIMARY KEY (version_partition, db_name));`
+
+	createSchemaUpdateHistoryTableQuery = `CREATE TABLE schema_update_history(` +
+		`version_partition INT not null, ` +
+		`year int not null, ` +
+		`month int not null, ` +
+		`update_time TIMESTAMP not null, ` +
+		`description VARCHAR(255), ` +
+		`manifest_md5 VARCHAR(64), ` +
+		`new_version VARCHAR(64), ` +
+		`old_version VARCHAR(64), ` +
+		`PRIMARY KEY (version_partition, year, month, update_time));`
+
+	// the tables of sqlite internals, e.g. sqlite_sequence, can't be dropped
+	listTablesQuery = "SELECT name FROM sqlite_master WHERE type='table' AND name NOT LIKE 'sqlite_%'"
+
+	dropTableQuery = "DROP TABLE %v"
+)
+
+// CreateSchemaVersionTables sets up the schema version tables
+func (sdb *db) CreateSchemaVersionTables() error {
+	if err := sdb.Exec(createSchemaVersionTableQuery); err != nil {
+		return err
+	}
+	return sdb.Exec(createSchemaUpdateHistoryTableQuery)
+}
+
+// ReadSchemaVersion returns the current schema version for the keyspace
+func (sdb *db) ReadSchemaVersion(database string) (string, error) {
+	var version string
+	err := sdb.db.Get(&version, readSchemaVersionQuery, database)
+	return version, err
+}
+
+// UpdateSchemaVersion updates the schema version for the keyspace
+func (sdb *db) UpdateSchemaVersion(database string, newVersion string, minCompatibleVersion string) error {
+	return sdb.Exec(writeSchemaVersionQuery, database, time.Now().UTC(), newVersion, minCompatibleVersion)
+}
+
+// WriteSchemaUpdateLog adds an entry to the schema update history table
+func (sdb *db) WriteSchemaUpdateLog(oldVersion string, newVersion string, manifestMD5 string, desc string) error {
+	now := time.Now().UTC()
+	return sdb.Exec(writeSchemaUpdateHistoryQuery, now.Year(), int(now.Month()), now, oldVersion, newVersion, manifestMD5, desc)
+}
+
+// ReadSchemaUpdateLog returns the entries of the schema update history table, oldest first
+func (sdb *db) ReadSchemaUpdateLog() ([]sqlplugin.SchemaUpdateHistoryRow, error) {
+	var rows []sqlplugin.SchemaUpdateHistoryRow
+	err := sdb.db.Select(&rows, readSchemaUpdateHistoryQuery)
+	return rows, err
+}
+
+// Exec executes a sql statement
+func (sdb *db) Exec(stmt string, args ...interface{}) error {
+	_, err := sdb.db.Exec(stmt, args...)
+	return err
+}
+
+// ListTables returns a list of tables in this database, a sqlite database is a single file
+// so the name of the database is ignored
+func (sdb *db) ListTables(database string) ([]string, error) {
+	var tables []string
+	err := sdb.db.Select(&tables, listTablesQuery)
+	return tables, err
+}
+
+// DropTable drops a given table from the database
+func (sdb *db) DropTable(name string) error {
+	return sdb.Exec(fmt.Sprintf(dropTableQuery, name))
+}
+
+// DropAllTables drops all tables from this database
+func (sdb *db) DropAllTables(database string) error {
+	tables, err := sdb.ListTables(database)
+	if err != nil {
+		return err
+	}
+	for _, tab := range tables {
+		if err := sdb.DropTable(tab); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// CreateDatabase is a no-op, sqlite creates the database file when it is opened
+func (sdb *db) CreateDatabase(name string) error {
+	return nil
+}
+
+// DropDatabase deletes the database file
+func (sdb *db) DropDatabase(name string) error {
+	if err := os.Remove(name); err != nil && !os.IsNotExist(err) {
+		return err
+	}
+	return nil
+}
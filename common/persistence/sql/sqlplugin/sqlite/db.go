@@ -0,0 +1,101 @@
+// The MIT License
+//
+// Copyright (c) 2020 Temporal Technologies Inc.  All rights reserved.
+//
+// Copyright (c) 2020 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package sqlite
+
+import (
+	"time"
+
+	"github.com/jmoiron/sqlx"
+	"github.com/mattn/go-sqlite3"
+
+	"go.temporal.io/server/common/persistence/sql/sqlplugin"
+)
+
+// db represents a logical connection to sqlite database
+type db struct {
+	db        *sqlx.DB
+	tx        *sqlx.Tx
+	conn      sqlplugin.Conn
+	converter DataConverter
+	closeFn   func() error
+}
+
+var _ sqlplugin.AdminDB = (*db)(nil)
+var _ sqlplugin.DB = (*db)(nil)
+var _ sqlplugin.Tx = (*db)(nil)
+
+// IsDupEntryError returns true if the error is a violation of the primary key or
+// of an unique constraint, i.e. the row already exists
+func (sdb *db) IsDupEntryError(err error) bool {
+	sqlErr, ok := err.(sqlite3.Error)
+	return ok && (sqlErr.ExtendedCode == sqlite3.ErrConstraintPrimaryKey || sqlErr.ExtendedCode == sqlite3.ErrConstraintUnique)
+}
+
+// newDB returns an instance of DB, which is a logical
+// connection to the underlying sqlite database
+func newDB(xdb *sqlx.DB, tx *sqlx.Tx, closeFn func() error) *db {
+	sdb := &db{db: xdb, tx: tx, closeFn: closeFn}
+	sdb.conn = xdb
+	if tx != nil {
+		sdb.conn = tx
+	}
+	sdb.converter = &converter{}
+	return sdb
+}
+
+// BeginTx starts a new transaction and returns a reference to the Tx object
+func (sdb *db) BeginTx() (sqlplugin.Tx, error) {
+	xtx, err := sdb.db.Beginx()
+	if err != nil {
+		return nil, err
+	}
+	return newDB(sdb.db, xtx, sdb.closeFn), nil
+}
+
+// Commit commits a previously started transaction
+func (sdb *db) Commit() error {
+	return sdb.tx.Commit()
+}
+
+// Rollback triggers rollback of a previously started transaction
+func (sdb *db) Rollback() error {
+	return sdb.tx.Rollback()
+}
+
+// Close releases the connection to the sqlite db, the connection is closed
+// once all the stores of the database are closed
+func (sdb *db) Close() error {
+	return sdb.closeFn()
+}
+
+// PluginName returns the name of the sqlite plugin
+func (sdb *db) PluginName() string {
+	return PluginName
+}
+
+// ReplicationLag always returns zero, sqlite databases are never replicated
+func (sdb *db) ReplicationLag() (time.Duration, error) {
+	return 0, nil
+}
@@ -0,0 +1,112 @@
+// The MIT License
+//
+// Copyright (c) 2020 Temporal Technologies Inc.  All rights reserved.
+//
+// Copyright (c) 2020 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package sqlite
+
+import (
+	"database/sql"
+
+	"go.temporal.io/server/common/persistence/sql/sqlplugin"
+)
+
+const (
+	// below are templates for history_node table
+	addHistoryNodesQuery = `INSERT INTO history_node (` +
+		`shard_id, tree_id, branch_id, node_id, txn_id, data, data_encoding) ` +
+		`VALUES (:shard_id, :tree_id, :branch_id, :node_id, :txn_id, :data, :data_encoding) `
+
+	getHistoryNodesQuery = `SELECT node_id, txn_id, data, data_encoding FROM history_node ` +
+		`WHERE shard_id = ? AND tree_id = ? AND branch_id = ? AND node_id >= ? and node_id < ? ORDER BY shard_id, tree_id, branch_id, node_id, txn_id LIMIT ? `
+
+	deleteHistoryNodesQuery = `DELETE FROM history_node WHERE shard_id = ? AND tree_id = ? AND branch_id = ? AND node_id >= ? `
+
+	// below are templates for history_tree table
+	addHistoryTreeQuery = `INSERT INTO history_tree (` +
+		`shard_id, tree_id, branch_id, data, data_encoding) ` +
+		`VALUES (:shard_id, :tree_id, :branch_id, :data, :data_encoding) `
+
+	getHistoryTreeQuery = `SELECT branch_id, data, data_encoding FROM history_tree WHERE shard_id = ? AND tree_id = ? `
+
+	paginateBranchesQuery = `SELECT shard_id, tree_id, branch_id, data, data_encoding FROM history_tree ` +
+		`WHERE (shard_id = ? AND tree_id = ? AND branch_id > ?) OR (shard_id = ? AND tree_id > ?) OR shard_id > ? ` +
+		`ORDER BY shard_id, tree_id, branch_id LIMIT ?`
+
+	deleteHistoryTreeQuery = `DELETE FROM history_tree WHERE shard_id = ? AND tree_id = ? AND branch_id = ? `
+)
+
+// For history_node table:
+
+// InsertIntoHistoryNode inserts a row into history_node table
+func (sdb *db) InsertIntoHistoryNode(row *sqlplugin.HistoryNodeRow) (sql.Result, error) {
+	// NOTE: same as the other plugins, txn_id is multiplied by -1 so rows are ordered by descending txn_id
+	row.TxnID = -row.TxnID
+	return sdb.conn.NamedExec(addHistoryNodesQuery, row)
+}
+
+// SelectFromHistoryNode reads one or more rows from history_node table
+func (sdb *db) SelectFromHistoryNode(filter sqlplugin.HistoryNodeSelectFilter) ([]sqlplugin.HistoryNodeRow, error) {
+	var rows []sqlplugin.HistoryNodeRow
+	err := sdb.conn.Select(&rows, getHistoryNodesQuery,
+		filter.ShardID, filter.TreeID, filter.BranchID, filter.MinNodeID, filter.MaxNodeID, filter.PageSize)
+	// NOTE: since we let txn_id multiple by -1 when inserting, we have to revert it back here
+	for index := range rows {
+		rows[index].TxnID = -rows[index].TxnID
+	}
+	return rows, err
+}
+
+// DeleteFromHistoryNode deletes one or more rows from history_node table
+func (sdb *db) DeleteFromHistoryNode(filter sqlplugin.HistoryNodeDeleteFilter) (sql.Result, error) {
+	return sdb.conn.Exec(deleteHistoryNodesQuery, filter.ShardID, filter.TreeID, filter.BranchID, filter.MinNodeID)
+}
+
+// For history_tree table:
+
+// InsertIntoHistoryTree inserts a row into history_tree table
+func (sdb *db) InsertIntoHistoryTree(row *sqlplugin.HistoryTreeRow) (sql.Result, error) {
+	return sdb.conn.NamedExec(addHistoryTreeQuery, row)
+}
+
+// SelectFromHistoryTree reads one or more rows from history_tree table
+func (sdb *db) SelectFromHistoryTree(filter sqlplugin.HistoryTreeSelectFilter) ([]sqlplugin.HistoryTreeRow, error) {
+	var rows []sqlplugin.HistoryTreeRow
+	err := sdb.conn.Select(&rows, getHistoryTreeQuery, filter.ShardID, filter.TreeID)
+	return rows, err
+}
+
+// PaginateBranchesFromHistoryTree reads a page of branches of all trees from history_tree table
+func (sdb *db) PaginateBranchesFromHistoryTree(filter sqlplugin.HistoryTreeBranchPageFilter) ([]sqlplugin.HistoryTreeRow, error) {
+	var rows []sqlplugin.HistoryTreeRow
+	err := sdb.conn.Select(&rows, paginateBranchesQuery,
+		filter.ShardID, filter.TreeID, filter.BranchID,
+		filter.ShardID, filter.TreeID,
+		filter.ShardID,
+		filter.PageSize)
+	return rows, err
+}
+
+// DeleteFromHistoryTree deletes one or more rows from history_tree table
+func (sdb *db) DeleteFromHistoryTree(filter sqlplugin.HistoryTreeDeleteFilter) (sql.Result, error) {
+	return sdb.conn.Exec(deleteHistoryTreeQuery, filter.ShardID, filter.TreeID, filter.BranchID)
+}
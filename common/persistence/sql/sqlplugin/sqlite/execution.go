@@ -0,0 +1,416 @@
+// The MIT License
+//
+// Copyright (c) 2020 Temporal Technologies Inc.  All rights reserved.
+//
+// Copyright (c) 2020 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package sqlite
+
+import (
+	"database/sql"
+
+	"go.temporal.io/server/common/persistence/sql/sqlplugin"
+)
+
+const (
+	executionsColumns = `shard_id, namespace_id, workflow_id, run_id, next_event_id, last_write_version, data, data_encoding, state, state_encoding`
+
+	createExecutionQuery = `INSERT INTO executions(` + executionsColumns + `)
+ VALUES(:shard_id, :namespace_id, :workflow_id, :run_id, :next_event_id, :last_write_version, :data, :data_encoding, :state, :state_encoding)`
+
+	updateExecutionQuery = `UPDATE executions SET
+ next_event_id = :next_event_id, last_write_version = :last_write_version, data = :data, data_encoding = :data_encoding, state = :state, state_encoding = :state_encoding
+ WHERE shard_id = :shard_id AND namespace_id = :namespace_id AND workflow_id = :workflow_id AND run_id = :run_id`
+
+	getExecutionQuery = `SELECT ` + executionsColumns + ` FROM executions
+ WHERE shard_id = ? AND namespace_id = ? AND workflow_id = ? AND run_id = ?`
+
+	deleteExecutionQuery = `DELETE FROM executions 
+ WHERE shard_id = ? AND namespace_id = ? AND workflow_id = ? AND run_id = ?`
+
+	lockExecutionQueryBase = `SELECT next_event_id FROM executions 
+ WHERE shard_id = ? AND namespace_id = ? AND workflow_id = ? AND run_id = ?`
+
+	// sqlite has no row level locks, transactions are started with _txlock=immediate
+	// so the whole database is write locked for the duration of the transaction
+	writeLockExecutionQuery = lockExecutionQueryBase
+	readLockExecutionQuery  = lockExecutionQueryBase
+
+	createCurrentExecutionQuery = `INSERT INTO current_executions
+(shard_id, namespace_id, workflow_id, run_id, create_request_id, state, status, start_version, last_write_version) VALUES
+(:shard_id, :namespace_id, :workflow_id, :run_id, :create_request_id, :state, :status, :start_version, :last_write_version)`
+
+	deleteCurrentExecutionQuery = "DELETE FROM current_executions WHERE shard_id=? AND namespace_id=? AND workflow_id=? AND run_id=?"
+
+	getCurrentExecutionQuery = `SELECT
+shard_id, namespace_id, workflow_id, run_id, create_request_id, state, status, start_version, last_write_version
+FROM current_executions WHERE shard_id = ? AND namespace_id = ? AND workflow_id = ?`
+
+	lockCurrentExecutionJoinExecutionsQuery = `SELECT
+ce.shard_id, ce.namespace_id, ce.workflow_id, ce.run_id, ce.create_request_id, ce.state, ce.status, ce.start_version, e.last_write_version
+FROM current_executions ce
+INNER JOIN executions e ON e.shard_id = ce.shard_id AND e.namespace_id = ce.namespace_id AND e.workflow_id = ce.workflow_id AND e.run_id = ce.run_id
+WHERE ce.shard_id = ? AND ce.namespace_id = ? AND ce.workflow_id = ?`
+
+	lockCurrentExecutionQuery = getCurrentExecutionQuery
+
+	updateCurrentExecutionsQuery = `UPDATE current_executions SET
+run_id = :run_id,
+create_request_id = :create_request_id,
+state = :state,
+status = :status,
+start_version = :start_version,
+last_write_version = :last_write_version
+WHERE
+shard_id = :shard_id AND
+namespace_id = :namespace_id AND
+workflow_id = :workflow_id
+`
+
+	createTransferTasksQuery = `INSERT INTO transfer_tasks(shard_id, task_id, data, data_encoding) 
+ VALUES(:shard_id, :task_id, :data, :data_encoding)`
+
+	getTransferTaskQuery = `SELECT task_id, data, data_encoding 
+ FROM transfer_tasks WHERE shard_id = ? AND task_id = ?`
+	getTransferTasksQuery = `SELECT task_id, data, data_encoding 
+ FROM transfer_tasks WHERE shard_id = ? AND task_id > ? AND task_id <= ? ORDER BY shard_id, task_id`
+
+	deleteTransferTaskQuery      = `DELETE FROM transfer_tasks WHERE shard_id = ? AND task_id = ?`
+	rangeDeleteTransferTaskQuery = `DELETE FROM transfer_tasks WHERE shard_id = ? AND task_id > ? AND task_id <= ?`
+
+	createTimerTasksQuery = `INSERT INTO timer_tasks (shard_id, visibility_timestamp, task_id, data, data_encoding)
+  VALUES (:shard_id, :visibility_timestamp, :task_id, :data, :data_encoding)`
+
+	getTimerTaskQuery = `SELECT visibility_timestamp, task_id, data, data_encoding FROM timer_tasks 
+  WHERE shard_id = ? AND visibility_timestamp = ? AND task_id = ?`
+	getTimerTasksQuery = `SELECT visibility_timestamp, task_id, data, data_encoding FROM timer_tasks 
+  WHERE shard_id = ? 
+  AND ((visibility_timestamp >= ? AND task_id >= ?) OR visibility_timestamp > ?) 
+  AND visibility_timestamp < ?
+  ORDER BY visibility_timestamp,task_id LIMIT ?`
+
+	deleteTimerTaskQuery      = `DELETE FROM timer_tasks WHERE shard_id = ? AND visibility_timestamp = ? AND task_id = ?`
+	rangeDeleteTimerTaskQuery = `DELETE FROM timer_tasks WHERE shard_id = ? AND visibility_timestamp >= ? AND visibility_timestamp < ?`
+
+	createReplicationTasksQuery = `INSERT INTO replication_tasks (shard_id, task_id, data, data_encoding) 
+  VALUES(:shard_id, :task_id, :data, :data_encoding)`
+
+	getReplicationTaskQuery = `SELECT task_id, data, data_encoding FROM replication_tasks WHERE 
+shard_id = ? AND task_id = ?`
+	getReplicationTasksQuery = `SELECT task_id, data, data_encoding FROM replication_tasks WHERE 
+shard_id = ? AND task_id > ? AND task_id <= ? ORDER BY task_id LIMIT ?`
+
+	deleteReplicationTaskQuery      = `DELETE FROM replication_tasks WHERE shard_id = ? AND task_id = ?`
+	rangeDeleteReplicationTaskQuery = `DELETE FROM replication_tasks WHERE shard_id = ? AND task_id <= ?`
+
+	getReplicationTasksDLQQuery = `SELECT task_id, data, data_encoding FROM replication_tasks_dlq WHERE 
+source_cluster_name = ? AND
+shard_id = ? AND
+task_id > ? AND
+task_id <= ?
+ORDER BY task_id LIMIT ?`
+
+	bufferedEventsColumns     = `shard_id, namespace_id, workflow_id, run_id, data, data_encoding`
+	createBufferedEventsQuery = `INSERT INTO buffered_events(` + bufferedEventsColumns + `)
+VALUES (:shard_id, :namespace_id, :workflow_id, :run_id, :data, :data_encoding)`
+
+	deleteBufferedEventsQuery = `DELETE FROM buffered_events WHERE shard_id=? AND namespace_id=? AND workflow_id=? AND run_id=?`
+	getBufferedEventsQuery    = `SELECT data, data_encoding FROM buffered_events WHERE
+shard_id=? AND namespace_id=? AND workflow_id=? AND run_id=?`
+
+	insertReplicationTaskDLQQuery = `
+INSERT INTO replication_tasks_dlq 
+            (source_cluster_name, 
+             shard_id, 
+             task_id, 
+             data, 
+             data_encoding) 
+VALUES     (:source_cluster_name, 
+            :shard_id, 
+            :task_id, 
+            :data, 
+            :data_encoding)
+`
+	deleteReplicationTaskFromDLQQuery = `
+	DELETE FROM replication_tasks_dlq 
+		WHERE source_cluster_name = ? 
+		AND shard_id = ? 
+		AND task_id = ?`
+
+	rangeDeleteReplicationTaskFromDLQQuery = `
+	DELETE FROM replication_tasks_dlq 
+		WHERE source_cluster_name = ? 
+		AND shard_id = ? 
+		AND task_id > ?
+		AND task_id <= ?`
+)
+
+// InsertIntoExecutions inserts a row into executions table
+func (sdb *db) InsertIntoExecutions(row *sqlplugin.ExecutionsRow) (sql.Result, error) {
+	return sdb.conn.NamedExec(createExecutionQuery, row)
+}
+
+// UpdateExecutions updates a single row in executions table
+func (sdb *db) UpdateExecutions(row *sqlplugin.ExecutionsRow) (sql.Result, error) {
+	return sdb.conn.NamedExec(updateExecutionQuery, row)
+}
+
+// SelectFromExecutions reads a single row from executions table
+func (sdb *db) SelectFromExecutions(filter sqlplugin.ExecutionsFilter) (*sqlplugin.ExecutionsRow, error) {
+	var row sqlplugin.ExecutionsRow
+	err := sdb.conn.Get(&row, getExecutionQuery, filter.ShardID, filter.NamespaceID, filter.WorkflowID, filter.RunID)
+	if err != nil {
+		return nil, err
+	}
+	return &row, err
+}
+
+// DeleteFromExecutions deletes a single row from executions table
+func (sdb *db) DeleteFromExecutions(filter sqlplugin.ExecutionsFilter) (sql.Result, error) {
+	return sdb.conn.Exec(deleteExecutionQuery, filter.ShardID, filter.NamespaceID, filter.WorkflowID, filter.RunID)
+}
+
+// ReadLockExecutions acquires a write lock on a single row in executions table
+func (sdb *db) ReadLockExecutions(filter sqlplugin.ExecutionsFilter) (int64, error) {
+	var nextEventID int64
+	err := sdb.conn.Get(&nextEventID, readLockExecutionQuery, filter.ShardID, filter.NamespaceID, filter.WorkflowID, filter.RunID)
+	return nextEventID, err
+}
+
+// WriteLockExecutions acquires a write lock on a single row in executions table
+func (sdb *db) WriteLockExecutions(filter sqlplugin.ExecutionsFilter) (int64, error) {
+	var nextEventID int64
+	err := sdb.conn.Get(&nextEventID, writeLockExecutionQuery, filter.ShardID, filter.NamespaceID, filter.WorkflowID, filter.RunID)
+	return nextEventID, err
+}
+
+// InsertIntoCurrentExecutions inserts a single row into current_executions table
+func (sdb *db) InsertIntoCurrentExecutions(row *sqlplugin.CurrentExecutionsRow) (sql.Result, error) {
+	return sdb.conn.NamedExec(createCurrentExecutionQuery, row)
+}
+
+// UpdateCurrentExecutions updates a single row in current_executions table
+func (sdb *db) UpdateCurrentExecutions(row *sqlplugin.CurrentExecutionsRow) (sql.Result, error) {
+	return sdb.conn.NamedExec(updateCurrentExecutionsQuery, row)
+}
+
+// SelectFromCurrentExecutions reads one or more rows from current_executions table
+func (sdb *db) SelectFromCurrentExecutions(filter sqlplugin.CurrentExecutionsFilter) (*sqlplugin.CurrentExecutionsRow, error) {
+	var row sqlplugin.CurrentExecutionsRow
+	err := sdb.conn.Get(&row, getCurrentExecutionQuery, filter.ShardID, filter.NamespaceID, filter.WorkflowID)
+	return &row, err
+}
+
+// DeleteFromCurrentExecutions deletes a single row in current_executions table
+func (sdb *db) DeleteFromCurrentExecutions(filter sqlplugin.CurrentExecutionsFilter) (sql.Result, error) {
+	return sdb.conn.Exec(deleteCurrentExecutionQuery, filter.ShardID, filter.NamespaceID, filter.WorkflowID, filter.RunID)
+}
+
+// LockCurrentExecutions acquires a write lock on a single row in current_executions table
+func (sdb *db) LockCurrentExecutions(filter sqlplugin.CurrentExecutionsFilter) (*sqlplugin.CurrentExecutionsRow, error) {
+	var row sqlplugin.CurrentExecutionsRow
+	err := sdb.conn.Get(&row, lockCurrentExecutionQuery, filter.ShardID, filter.NamespaceID, filter.WorkflowID)
+	return &row, err
+}
+
+// LockCurrentExecutionsJoinExecutions joins a row in current_executions with executions table and acquires a
+// write lock on the result
+func (sdb *db) LockCurrentExecutionsJoinExecutions(filter sqlplugin.CurrentExecutionsFilter) ([]sqlplugin.CurrentExecutionsRow, error) {
+	var rows []sqlplugin.CurrentExecutionsRow
+	err := sdb.conn.Select(&rows, lockCurrentExecutionJoinExecutionsQuery, filter.ShardID, filter.NamespaceID, filter.WorkflowID)
+	return rows, err
+}
+
+// InsertIntoTransferTasks inserts one or more rows into transfer_tasks table
+func (sdb *db) InsertIntoTransferTasks(rows []sqlplugin.TransferTasksRow) (sql.Result, error) {
+	return sdb.conn.NamedExec(createTransferTasksQuery, rows)
+}
+
+// SelectFromTransferTasks reads one or more rows from transfer_tasks table
+func (sdb *db) SelectFromTransferTasks(filter sqlplugin.TransferTasksFilter) ([]sqlplugin.TransferTasksRow, error) {
+	var rows []sqlplugin.TransferTasksRow
+	err := sdb.conn.Select(&rows, getTransferTaskQuery, filter.ShardID, filter.TaskID)
+	if err != nil {
+		return nil, err
+	}
+	return rows, err
+}
+
+// RangeSelectFromTransferTasks reads one or more rows from transfer_tasks table
+func (sdb *db) RangeSelectFromTransferTasks(filter sqlplugin.TransferTasksRangeFilter) ([]sqlplugin.TransferTasksRow, error) {
+	var rows []sqlplugin.TransferTasksRow
+	err := sdb.conn.Select(&rows, getTransferTasksQuery, filter.ShardID, filter.MinTaskID, filter.MaxTaskID)
+	if err != nil {
+		return nil, err
+	}
+	return rows, err
+}
+
+// DeleteFromTransferTasks deletes one or more rows from transfer_tasks table
+func (sdb *db) DeleteFromTransferTasks(filter sqlplugin.TransferTasksFilter) (sql.Result, error) {
+	return sdb.conn.Exec(deleteTransferTaskQuery, filter.ShardID, filter.TaskID)
+}
+
+// RangeDeleteFromTransferTasks deletes one or more rows from transfer_tasks table
+func (sdb *db) RangeDeleteFromTransferTasks(filter sqlplugin.TransferTasksRangeFilter) (sql.Result, error) {
+	return sdb.conn.Exec(rangeDeleteTransferTaskQuery, filter.ShardID, filter.MinTaskID, filter.MaxTaskID)
+}
+
+// InsertIntoTimerTasks inserts one or more rows into timer_tasks table
+func (sdb *db) InsertIntoTimerTasks(rows []sqlplugin.TimerTasksRow) (sql.Result, error) {
+	for i := range rows {
+		rows[i].VisibilityTimestamp = sdb.converter.ToSQLiteDateTime(rows[i].VisibilityTimestamp)
+	}
+	return sdb.conn.NamedExec(createTimerTasksQuery, rows)
+}
+
+// SelectFromTimerTasks reads one or more rows from timer_tasks table
+func (sdb *db) SelectFromTimerTasks(filter sqlplugin.TimerTasksFilter) ([]sqlplugin.TimerTasksRow, error) {
+	var rows []sqlplugin.TimerTasksRow
+	filter.VisibilityTimestamp = sdb.converter.ToSQLiteDateTime(filter.VisibilityTimestamp)
+	err := sdb.conn.Select(&rows, getTimerTaskQuery, filter.ShardID, filter.VisibilityTimestamp, filter.TaskID)
+	if err != nil {
+		return nil, err
+	}
+	for i := range rows {
+		rows[i].VisibilityTimestamp = sdb.converter.FromSQLiteDateTime(rows[i].VisibilityTimestamp)
+	}
+	return rows, err
+}
+
+// RangeSelectFromTimerTasks reads one or more rows from timer_tasks table
+func (sdb *db) RangeSelectFromTimerTasks(filter sqlplugin.TimerTasksRangeFilter) ([]sqlplugin.TimerTasksRow, error) {
+	var rows []sqlplugin.TimerTasksRow
+	filter.MinVisibilityTimestamp = sdb.converter.ToSQLiteDateTime(filter.MinVisibilityTimestamp)
+	filter.MaxVisibilityTimestamp = sdb.converter.ToSQLiteDateTime(filter.MaxVisibilityTimestamp)
+	err := sdb.conn.Select(&rows, getTimerTasksQuery, filter.ShardID, filter.MinVisibilityTimestamp,
+		filter.TaskID, filter.MinVisibilityTimestamp, filter.MaxVisibilityTimestamp, filter.PageSize)
+	if err != nil {
+		return nil, err
+	}
+	for i := range rows {
+		rows[i].VisibilityTimestamp = sdb.converter.FromSQLiteDateTime(rows[i].VisibilityTimestamp)
+	}
+	return rows, err
+}
+
+// DeleteFromTimerTasks deletes one or more rows from timer_tasks table
+func (sdb *db) DeleteFromTimerTasks(filter sqlplugin.TimerTasksFilter) (sql.Result, error) {
+	filter.VisibilityTimestamp = sdb.converter.ToSQLiteDateTime(filter.VisibilityTimestamp)
+	return sdb.conn.Exec(deleteTimerTaskQuery, filter.ShardID, filter.VisibilityTimestamp, filter.TaskID)
+}
+
+// RangeDeleteFromTimerTasks deletes one or more rows from timer_tasks table
+func (sdb *db) RangeDeleteFromTimerTasks(filter sqlplugin.TimerTasksRangeFilter) (sql.Result, error) {
+	filter.MinVisibilityTimestamp = sdb.converter.ToSQLiteDateTime(filter.MinVisibilityTimestamp)
+	filter.MaxVisibilityTimestamp = sdb.converter.ToSQLiteDateTime(filter.MaxVisibilityTimestamp)
+	return sdb.conn.Exec(rangeDeleteTimerTaskQuery, filter.ShardID, filter.MinVisibilityTimestamp, filter.MaxVisibilityTimestamp)
+}
+
+// InsertIntoBufferedEvents inserts one or more rows into buffered_events table
+func (sdb *db) InsertIntoBufferedEvents(rows []sqlplugin.BufferedEventsRow) (sql.Result, error) {
+	return sdb.conn.NamedExec(createBufferedEventsQuery, rows)
+}
+
+// SelectFromBufferedEvents reads one or more rows from buffered_events table
+func (sdb *db) SelectFromBufferedEvents(filter *sqlplugin.BufferedEventsFilter) ([]sqlplugin.BufferedEventsRow, error) {
+	var rows []sqlplugin.BufferedEventsRow
+	err := sdb.conn.Select(&rows, getBufferedEventsQuery, filter.ShardID, filter.NamespaceID, filter.WorkflowID, filter.RunID)
+	for i := 0; i < len(rows); i++ {
+		rows[i].NamespaceID = filter.NamespaceID
+		rows[i].WorkflowID = filter.WorkflowID
+		rows[i].RunID = filter.RunID
+		rows[i].ShardID = filter.ShardID
+	}
+	return rows, err
+}
+
+// DeleteFromBufferedEvents deletes one or more rows from buffered_events table
+func (sdb *db) DeleteFromBufferedEvents(filter *sqlplugin.BufferedEventsFilter) (sql.Result, error) {
+	return sdb.conn.Exec(deleteBufferedEventsQuery, filter.ShardID, filter.NamespaceID, filter.WorkflowID, filter.RunID)
+}
+
+// InsertIntoReplicationTasks inserts one or more rows into replication_tasks table
+func (sdb *db) InsertIntoReplicationTasks(rows []sqlplugin.ReplicationTasksRow) (sql.Result, error) {
+	return sdb.conn.NamedExec(createReplicationTasksQuery, rows)
+}
+
+// SelectFromReplicationTasks reads one or more rows from replication_tasks table
+func (sdb *db) SelectFromReplicationTasks(filter *sqlplugin.ReplicationTasksFilter) ([]sqlplugin.ReplicationTasksRow, error) {
+	var rows []sqlplugin.ReplicationTasksRow
+	err := sdb.conn.Select(&rows, getReplicationTasksQuery, filter.ShardID, filter.MinTaskID, filter.MaxTaskID, filter.PageSize)
+	return rows, err
+}
+
+// DeleteFromReplicationTasks deletes one row from replication_tasks table
+func (sdb *db) DeleteFromReplicationTasks(filter *sqlplugin.ReplicationTasksFilter) (sql.Result, error) {
+	return sdb.conn.Exec(deleteReplicationTaskQuery, filter.ShardID, filter.TaskID)
+}
+
+// RangeDeleteFromReplicationTasks deletes multi rows from replication_tasks table
+func (sdb *db) RangeDeleteFromReplicationTasks(filter *sqlplugin.ReplicationTasksFilter) (sql.Result, error) {
+	return sdb.conn.Exec(rangeDeleteReplicationTaskQuery, filter.ShardID, filter.InclusiveEndTaskID)
+}
+
+// InsertIntoReplicationTasksDLQ inserts one or more rows into replication_tasks_dlq table
+func (sdb *db) InsertIntoReplicationTasksDLQ(row *sqlplugin.ReplicationTaskDLQRow) (sql.Result, error) {
+	return sdb.conn.NamedExec(insertReplicationTaskDLQQuery, row)
+}
+
+// SelectFromReplicationTasksDLQ reads one or more rows from replication_tasks_dlq table
+func (sdb *db) SelectFromReplicationTasksDLQ(filter *sqlplugin.ReplicationTasksDLQFilter) ([]sqlplugin.ReplicationTasksRow, error) {
+	var rows []sqlplugin.ReplicationTasksRow
+	err := sdb.conn.Select(
+		&rows, getReplicationTasksDLQQuery,
+		filter.SourceClusterName,
+		filter.ShardID,
+		filter.MinTaskID,
+		filter.MaxTaskID,
+		filter.PageSize)
+	return rows, err
+}
+
+// DeleteMessageFromReplicationTasksDLQ deletes one row from replication_tasks_dlq table
+func (sdb *db) DeleteMessageFromReplicationTasksDLQ(
+	filter *sqlplugin.ReplicationTasksDLQFilter,
+) (sql.Result, error) {
+
+	return sdb.conn.Exec(
+		deleteReplicationTaskFromDLQQuery,
+		filter.SourceClusterName,
+		filter.ShardID,
+		filter.TaskID,
+	)
+}
+
+// DeleteMessageFromReplicationTasksDLQ deletes one or more rows from replication_tasks_dlq table
+func (sdb *db) RangeDeleteMessageFromReplicationTasksDLQ(
+	filter *sqlplugin.ReplicationTasksDLQFilter,
+) (sql.Result, error) {
+
+	return sdb.conn.Exec(
+		rangeDeleteReplicationTaskFromDLQQuery,
+		filter.SourceClusterName,
+		filter.ShardID,
+		filter.TaskID,
+		filter.InclusiveEndTaskID,
+	)
+}
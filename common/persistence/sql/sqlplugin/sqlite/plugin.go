@@ -0,0 +1,195 @@
+// The MIT License
+//
+// Copyright (c) 2020 Temporal Technologies Inc.  All rights reserved.
+//
+// Copyright (c) 2020 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package sqlite
+
+import (
+	"fmt"
+	"net/url"
+	"strings"
+	"sync"
+
+	"github.com/iancoleman/strcase"
+	"github.com/jmoiron/sqlx"
+	_ "github.com/mattn/go-sqlite3" // needed to register the sqlite3 driver
+
+	"go.temporal.io/server/common/persistence/sql"
+	"go.temporal.io/server/common/persistence/sql/sqlplugin"
+	"go.temporal.io/server/common/service/config"
+	sqliteschema "go.temporal.io/server/schema/sqlite"
+)
+
+const (
+	// PluginName is the name of the plugin
+	PluginName = "sqlite"
+	driverName = "sqlite3"
+	dsnFmt     = "file:%v?%v"
+
+	// ConnectAttributeMode selects the mode of the database, the database is kept in memory
+	// if it is set to ModeMemory and stored in the DatabaseName file otherwise
+	ConnectAttributeMode = "mode"
+	// ConnectAttributeSetup creates the temporal and visibility schemas in an empty database if it is "true"
+	ConnectAttributeSetup = "setup"
+	// ModeMemory is the mode of in-memory databases
+	ModeMemory = "memory"
+)
+
+var dsnAttrOverrides = map[string]string{
+	// write transactions wait for each other instead of failing right away
+	"_busy_timeout": "10000",
+	"_txlock":       "immediate",
+}
+
+type (
+	plugin struct {
+		sync.Mutex
+		// connections are shared by all the stores of a database, an in-memory database
+		// only lives as long as it has a connection
+		conns map[string]*conn
+	}
+
+	conn struct {
+		db       *sqlx.DB
+		refCount int
+	}
+)
+
+var _ sqlplugin.Plugin = (*plugin)(nil)
+
+func init() {
+	sql.RegisterPlugin(PluginName, &plugin{
+		conns: make(map[string]*conn),
+	})
+}
+
+// CreateDB initialize the db object
+func (p *plugin) CreateDB(cfg *config.SQL) (sqlplugin.DB, error) {
+	return p.createDB(cfg)
+}
+
+// CreateAdminDB initialize the db object
+func (p *plugin) CreateAdminDB(cfg *config.SQL) (sqlplugin.AdminDB, error) {
+	return p.createDB(cfg)
+}
+
+func (p *plugin) createDB(cfg *config.SQL) (*db, error) {
+	dsn := buildDSN(cfg)
+	xdb, err := p.createDBConnection(cfg, dsn)
+	if err != nil {
+		return nil, err
+	}
+	return newDB(xdb, nil, func() error { return p.closeDBConnection(dsn) }), nil
+}
+
+// createDBConnection returns the connection to the database of the dsn, the connection
+// is created, and the schema set up if requested, by the first store of the database
+func (p *plugin) createDBConnection(cfg *config.SQL, dsn string) (*sqlx.DB, error) {
+	p.Lock()
+	defer p.Unlock()
+
+	if c, ok := p.conns[dsn]; ok {
+		c.refCount++
+		return c.db, nil
+	}
+
+	db, err := sqlx.Connect(driverName, dsn)
+	if err != nil {
+		return nil, err
+	}
+	// sqlite allows a single writer, more connections would only fail with busy errors
+	db.SetMaxOpenConns(1)
+	db.SetMaxIdleConns(1)
+	db.SetConnMaxLifetime(0)
+
+	// Maps struct names in CamelCase to snake without need for db struct tags.
+	db.MapperFunc(strcase.ToSnake)
+
+	if cfg.ConnectAttributes[ConnectAttributeSetup] == "true" {
+		if err := setupSchema(db, cfg.DatabaseName); err != nil {
+			_ = db.Close()
+			return nil, err
+		}
+	}
+
+	p.conns[dsn] = &conn{db: db, refCount: 1}
+	return db, nil
+}
+
+func (p *plugin) closeDBConnection(dsn string) error {
+	p.Lock()
+	defer p.Unlock()
+
+	c, ok := p.conns[dsn]
+	if !ok {
+		return nil
+	}
+	c.refCount--
+	if c.refCount > 0 {
+		return nil
+	}
+	delete(p.conns, dsn)
+	return c.db.Close()
+}
+
+// setupSchema creates the temporal and visibility tables in a database without tables,
+// both schemas live in the same database so they share its schema version
+func setupSchema(xdb *sqlx.DB, database string) error {
+	sdb := newDB(xdb, nil, nil)
+	tables, err := sdb.ListTables(database)
+	if err != nil {
+		return err
+	}
+	if len(tables) > 0 {
+		return nil
+	}
+	if err := sdb.CreateSchemaVersionTables(); err != nil {
+		return fmt.Errorf("unable to setup schema version tables: %v", err)
+	}
+	if err := sdb.Exec(sqliteschema.Schema); err != nil {
+		return fmt.Errorf("unable to setup schema: %v", err)
+	}
+	if err := sdb.Exec(sqliteschema.VisibilitySchema); err != nil {
+		return fmt.Errorf("unable to setup visibility schema: %v", err)
+	}
+	return sdb.UpdateSchemaVersion(database, sqliteschema.Version, sqliteschema.Version)
+}
+
+func buildDSN(cfg *config.SQL) string {
+	attrs := url.Values{}
+	for k, v := range cfg.ConnectAttributes {
+		if k == ConnectAttributeSetup {
+			continue
+		}
+		attrs.Set(strings.TrimSpace(k), strings.TrimSpace(v))
+	}
+	if attrs.Get(ConnectAttributeMode) == ModeMemory {
+		// the stores of an in-memory database share its connection, not its cache
+		attrs.Set("cache", "private")
+	}
+	// these attrs are always overriden
+	for k, v := range dsnAttrOverrides {
+		attrs.Set(k, v)
+	}
+	return fmt.Sprintf(dsnFmt, cfg.DatabaseName, attrs.Encode())
+}
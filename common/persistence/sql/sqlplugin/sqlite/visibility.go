@@ -0,0 +1,333 @@
+// The MIT License
+//
+// Copyright (c) 2020 Temporal Technologies Inc.  All rights reserved.
+//
+// Copyright (c) 2020 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package sqlite
+
+import (
+	"database/sql"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	enumspb "go.temporal.io/api/enums/v1"
+	"go.temporal.io/api/serviceerror"
+
+	"go.temporal.io/server/common/persistence/sql/sqlplugin"
+)
+
+const (
+	templateCreateWorkflowExecutionStarted = `INSERT INTO executions_visibility (` +
+		`namespace_id, workflow_id, run_id, start_time, execution_time, workflow_type_name, status, memo, encoding, task_queue, search_attributes) ` +
+		`VALUES (?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?) ` +
+		`ON CONFLICT (namespace_id, run_id) DO NOTHING`
+
+	templateUpsertWorkflowExecution = `INSERT INTO executions_visibility (` +
+		`namespace_id, workflow_id, run_id, start_time, execution_time, workflow_type_name, status, memo, encoding, task_queue, search_attributes) ` +
+		`VALUES (?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?) ` +
+		`ON CONFLICT (namespace_id, run_id) DO UPDATE SET memo = excluded.memo, encoding = excluded.encoding, search_attributes = excluded.search_attributes`
+
+	templateCreateWorkflowExecutionClosed = `INSERT INTO executions_visibility (` +
+		`namespace_id, workflow_id, run_id, start_time, execution_time, workflow_type_name, close_time, status, history_length, memo, encoding, task_queue, search_attributes) ` +
+		`VALUES (?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?) ` +
+		`ON CONFLICT (namespace_id, run_id) DO UPDATE SET workflow_id = excluded.workflow_id, start_time = excluded.start_time, execution_time = excluded.execution_time, workflow_type_name = excluded.workflow_type_name, ` +
+		`close_time = excluded.close_time, status = excluded.status, history_length = excluded.history_length, memo = excluded.memo, encoding = excluded.encoding, ` +
+		`task_queue = excluded.task_queue, search_attributes = excluded.search_attributes`
+
+	// RunID condition is needed for correct pagination
+	templateConditions = ` AND namespace_id = ?
+		 AND start_time >= ?
+		 AND start_time <= ?
+ 		 AND ((run_id > ? and start_time = ?) OR (start_time < ?))
+         ORDER BY start_time DESC, run_id
+		 LIMIT ?`
+
+	templateConditionsClosedWorkflows = ` AND namespace_id = ?
+	AND close_time >= ?
+	AND close_time <= ?
+	 AND ((run_id > ? and close_time = ?) OR (close_time < ?))
+	ORDER BY close_time DESC, run_id
+	LIMIT ?`
+
+	templateOpenFieldNames = `workflow_id, run_id, start_time, execution_time, workflow_type_name, status, memo, encoding, task_queue, search_attributes`
+	templateOpenSelect     = `SELECT ` + templateOpenFieldNames + ` FROM executions_visibility WHERE status = 1 `
+
+	templateClosedSelect = `SELECT ` + templateOpenFieldNames + `, close_time, history_length
+		 FROM executions_visibility WHERE status != 1 `
+
+	templateGetOpenWorkflowExecutions = templateOpenSelect + templateConditions
+
+	templateGetClosedWorkflowExecutions = templateClosedSelect + templateConditionsClosedWorkflows
+
+	templateGetOpenWorkflowExecutionsByType = templateOpenSelect + `AND workflow_type_name = ?` + templateConditions
+
+	templateGetClosedWorkflowExecutionsByType = templateClosedSelect + `AND workflow_type_name = ?` + templateConditionsClosedWorkflows
+
+	templateGetOpenWorkflowExecutionsByID = templateOpenSelect + `AND workflow_id = ?` + templateConditions
+
+	templateGetClosedWorkflowExecutionsByID = templateClosedSelect + `AND workflow_id = ?` + templateConditionsClosedWorkflows
+
+	templateGetClosedWorkflowExecutionsByStatus = templateClosedSelect + `AND status = ?` + templateConditionsClosedWorkflows
+
+	templateGetClosedWorkflowExecution = `SELECT workflow_id, run_id, start_time, execution_time, memo, encoding, close_time, workflow_type_name, status, history_length, task_queue, search_attributes 
+		 FROM executions_visibility
+		 WHERE namespace_id = ? AND status != 1
+		 AND run_id = ?`
+
+	templateDeleteWorkflowExecution = "DELETE FROM executions_visibility WHERE namespace_id = ? AND run_id = ?"
+)
+
+var errCloseParams = errors.New("missing one of {closeTime, historyLength} params")
+
+type queryDialect struct{}
+
+var _ sqlplugin.VisibilityQueryDialect = queryDialect{}
+
+// InsertIntoVisibility inserts a row into visibility table. If an row already exist,
+// its left as such and no update will be made
+func (sdb *db) InsertIntoVisibility(row *sqlplugin.VisibilityRow) (sql.Result, error) {
+	row.StartTime = sdb.converter.ToSQLiteDateTime(row.StartTime)
+	return sdb.conn.Exec(templateCreateWorkflowExecutionStarted,
+		row.NamespaceID,
+		row.WorkflowID,
+		row.RunID,
+		row.StartTime,
+		row.ExecutionTime,
+		row.WorkflowTypeName,
+		row.Status,
+		row.Memo,
+		row.Encoding,
+		row.TaskQueue,
+		row.SearchAttributes)
+}
+
+// ReplaceIntoVisibility replaces an existing row if it exist or creates a new row in visibility table
+func (sdb *db) ReplaceIntoVisibility(row *sqlplugin.VisibilityRow) (sql.Result, error) {
+	switch {
+	case row.CloseTime != nil && row.HistoryLength != nil:
+		row.StartTime = sdb.converter.ToSQLiteDateTime(row.StartTime)
+		closeTime := sdb.converter.ToSQLiteDateTime(*row.CloseTime)
+		return sdb.conn.Exec(templateCreateWorkflowExecutionClosed,
+			row.NamespaceID,
+			row.WorkflowID,
+			row.RunID,
+			row.StartTime,
+			row.ExecutionTime,
+			row.WorkflowTypeName,
+			closeTime,
+			row.Status,
+			*row.HistoryLength,
+			row.Memo,
+			row.Encoding,
+			row.TaskQueue,
+			row.SearchAttributes)
+	default:
+		return nil, errCloseParams
+	}
+}
+
+// UpsertIntoVisibility inserts a row into visibility table. If a row already exist,
+// only its memo and search attributes are updated
+func (sdb *db) UpsertIntoVisibility(row *sqlplugin.VisibilityRow) (sql.Result, error) {
+	row.StartTime = sdb.converter.ToSQLiteDateTime(row.StartTime)
+	return sdb.conn.Exec(templateUpsertWorkflowExecution,
+		row.NamespaceID,
+		row.WorkflowID,
+		row.RunID,
+		row.StartTime,
+		row.ExecutionTime,
+		row.WorkflowTypeName,
+		row.Status,
+		row.Memo,
+		row.Encoding,
+		row.TaskQueue,
+		row.SearchAttributes)
+}
+
+// DeleteFromVisibility deletes a row from visibility table if it exist
+func (sdb *db) DeleteFromVisibility(filter sqlplugin.VisibilityDeleteFilter) (sql.Result, error) {
+	return sdb.conn.Exec(templateDeleteWorkflowExecution, filter.NamespaceID, filter.RunID)
+}
+
+// SelectFromVisibility reads one or more rows from visibility table
+func (sdb *db) SelectFromVisibility(filter sqlplugin.VisibilitySelectFilter) ([]sqlplugin.VisibilityRow, error) {
+	var err error
+	var rows []sqlplugin.VisibilityRow
+	if filter.MinTime != nil {
+		*filter.MinTime = sdb.converter.ToSQLiteDateTime(*filter.MinTime)
+	}
+	if filter.MaxTime != nil {
+		*filter.MaxTime = sdb.converter.ToSQLiteDateTime(*filter.MaxTime)
+	}
+	// If filter.Status == 0 (UNSPECIFIED) then only closed workflows will be returned (all excluding 1 (RUNNING)).
+	switch {
+	case filter.MinTime == nil && filter.RunID != nil && filter.Status != 1:
+		var row sqlplugin.VisibilityRow
+		err = sdb.conn.Get(&row, templateGetClosedWorkflowExecution, filter.NamespaceID, *filter.RunID)
+		if err == nil {
+			rows = append(rows, row)
+		}
+	case filter.MinTime != nil && filter.MaxTime != nil &&
+		filter.WorkflowID != nil && filter.RunID != nil && filter.PageSize != nil:
+		qry := templateGetOpenWorkflowExecutionsByID
+		if filter.Status != 1 {
+			qry = templateGetClosedWorkflowExecutionsByID
+		}
+		err = sdb.conn.Select(&rows,
+			qry,
+			*filter.WorkflowID,
+			filter.NamespaceID,
+			*filter.MinTime,
+			*filter.MaxTime,
+			*filter.RunID,
+			*filter.MaxTime,
+			*filter.MaxTime,
+			*filter.PageSize)
+	case filter.MinTime != nil && filter.MaxTime != nil &&
+		filter.WorkflowTypeName != nil && filter.RunID != nil && filter.PageSize != nil:
+		qry := templateGetOpenWorkflowExecutionsByType
+		if filter.Status != 1 {
+			qry = templateGetClosedWorkflowExecutionsByType
+		}
+		err = sdb.conn.Select(&rows,
+			qry,
+			*filter.WorkflowTypeName,
+			filter.NamespaceID,
+			*filter.MinTime,
+			*filter.MaxTime,
+			*filter.RunID,
+			*filter.MaxTime,
+			*filter.MaxTime,
+			*filter.PageSize)
+	case filter.MinTime != nil && filter.MaxTime != nil &&
+		filter.RunID != nil && filter.PageSize != nil &&
+		filter.Status != 0 && filter.Status != 1: // 0 is UNSPECIFIED, 1 is RUNNING
+		err = sdb.conn.Select(&rows,
+			templateGetClosedWorkflowExecutionsByStatus,
+			filter.Status,
+			filter.NamespaceID,
+			*filter.MinTime,
+			*filter.MaxTime,
+			*filter.RunID,
+			*filter.MaxTime,
+			*filter.MaxTime,
+			*filter.PageSize)
+	case filter.MinTime != nil && filter.MaxTime != nil &&
+		filter.RunID != nil && filter.PageSize != nil:
+		qry := templateGetOpenWorkflowExecutions
+		if filter.Status != 1 {
+			qry = templateGetClosedWorkflowExecutions
+		}
+		err = sdb.conn.Select(&rows,
+			qry,
+			filter.NamespaceID,
+			*filter.MinTime,
+			*filter.MaxTime,
+			*filter.RunID,
+			*filter.MaxTime,
+			*filter.MaxTime,
+			*filter.PageSize)
+	default:
+		return nil, fmt.Errorf("invalid query filter")
+	}
+	if err != nil {
+		return nil, err
+	}
+	for i := range rows {
+		rows[i].StartTime = sdb.converter.FromSQLiteDateTime(rows[i].StartTime)
+		rows[i].ExecutionTime = sdb.converter.FromSQLiteDateTime(rows[i].ExecutionTime)
+		if rows[i].CloseTime != nil {
+			closeTime := sdb.converter.FromSQLiteDateTime(*rows[i].CloseTime)
+			rows[i].CloseTime = &closeTime
+		}
+	}
+	return rows, err
+}
+
+// SelectFromVisibilityByQuery reads the rows of visibility table that match an advanced visibility query
+func (sdb *db) SelectFromVisibilityByQuery(filter sqlplugin.VisibilityQueryFilter) ([]sqlplugin.VisibilityRow, error) {
+	query, args, err := sqlplugin.BuildVisibilitySelectQuery(queryDialect{}, filter)
+	if err != nil {
+		return nil, err
+	}
+	for i, arg := range args {
+		if t, ok := arg.(time.Time); ok {
+			args[i] = sdb.converter.ToSQLiteDateTime(t)
+		}
+	}
+	var rows []sqlplugin.VisibilityRow
+	if err := sdb.conn.Select(&rows, query, args...); err != nil {
+		return nil, convertJSONError(err)
+	}
+	for i := range rows {
+		rows[i].StartTime = sdb.converter.FromSQLiteDateTime(rows[i].StartTime)
+		rows[i].ExecutionTime = sdb.converter.FromSQLiteDateTime(rows[i].ExecutionTime)
+		if rows[i].CloseTime != nil {
+			closeTime := sdb.converter.FromSQLiteDateTime(*rows[i].CloseTime)
+			rows[i].CloseTime = &closeTime
+		}
+	}
+	return rows, nil
+}
+
+// CountFromVisibilityByQuery counts the rows of visibility table that match an advanced visibility query
+func (sdb *db) CountFromVisibilityByQuery(filter sqlplugin.VisibilityQueryFilter) (int64, error) {
+	query, args, err := sqlplugin.BuildVisibilityCountQuery(queryDialect{}, filter)
+	if err != nil {
+		return 0, err
+	}
+	for i, arg := range args {
+		if t, ok := arg.(time.Time); ok {
+			args[i] = sdb.converter.ToSQLiteDateTime(t)
+		}
+	}
+	var count int64
+	if err := sdb.conn.Get(&count, query, args...); err != nil {
+		return 0, convertJSONError(err)
+	}
+	return count, nil
+}
+
+// convertJSONError turns the error of a query on search attributes against an sqlite
+// library built without the JSON1 extension into a meaningful one. The extension is only
+// built with the sqlite_json tag, which the Makefile passes to go build and go test
+func convertJSONError(err error) error {
+	if strings.Contains(err.Error(), "no such function: json_") {
+		return serviceerror.NewUnimplemented("queries on search attributes require sqlite to be built with the sqlite_json tag")
+	}
+	return err
+}
+
+func (queryDialect) Placeholder(_ int) string {
+	return "?"
+}
+
+func (queryDialect) SearchAttribute(name string, valueType enumspb.IndexedValueType) string {
+	path := fmt.Sprintf("search_attributes, '$.%s'", name)
+	if valueType == enumspb.INDEXED_VALUE_TYPE_BOOL {
+		// json_extract returns booleans as 0 or 1, json_type returns 'true' or 'false'
+		return "json_type(" + path + ")"
+	}
+	return "json_extract(" + path + ")"
+}
@@ -25,6 +25,7 @@
 package tests
 
 import (
+	"bytes"
 	"math/rand"
 	"testing"
 
@@ -199,6 +200,38 @@ func (s *historyTreeSuite) TestInsertDeleteSelect() {
 	s.Equal([]sqlplugin.HistoryTreeRow(nil), rows)
 }
 
+func (s *historyTreeSuite) TestInsertPaginate() {
+	shardID := rand.Int31()
+	treeID := primitives.NewUUID()
+	branchID1 := primitives.NewUUID()
+	branchID2 := primitives.NewUUID()
+	if bytes.Compare(branchID1, branchID2) > 0 {
+		branchID1, branchID2 = branchID2, branchID1
+	}
+
+	tree1 := s.newRandomTreeRow(shardID, treeID, branchID1)
+	_, err := s.store.InsertIntoHistoryTree(&tree1)
+	s.NoError(err)
+	tree2 := s.newRandomTreeRow(shardID, treeID, branchID2)
+	_, err = s.store.InsertIntoHistoryTree(&tree2)
+	s.NoError(err)
+
+	pageFilter := sqlplugin.HistoryTreeBranchPageFilter{
+		ShardID:  shardID,
+		TreeID:   treeID,
+		BranchID: primitives.UUID(make([]byte, 16)),
+		PageSize: 1,
+	}
+	rows, err := s.store.PaginateBranchesFromHistoryTree(pageFilter)
+	s.NoError(err)
+	s.Equal([]sqlplugin.HistoryTreeRow{tree1}, rows)
+
+	pageFilter.BranchID = branchID1
+	rows, err = s.store.PaginateBranchesFromHistoryTree(pageFilter)
+	s.NoError(err)
+	s.Equal([]sqlplugin.HistoryTreeRow{tree2}, rows)
+}
+
 func (s *historyTreeSuite) newRandomTreeRow(
 	shardID int32,
 	treeID primitives.UUID,
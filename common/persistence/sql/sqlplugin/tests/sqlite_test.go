@@ -0,0 +1,311 @@
+// The MIT License
+//
+// Copyright (c) 2020 Temporal Technologies Inc.  All rights reserved.
+//
+// Copyright (c) 2020 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package tests
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/suite"
+
+	"go.temporal.io/server/common/persistence/sql"
+	"go.temporal.io/server/common/persistence/sql/sqlplugin/sqlite"
+	"go.temporal.io/server/common/service/config"
+	"go.temporal.io/server/common/shuffle"
+)
+
+const (
+	testSQLiteDatabaseNamePrefix = "test_"
+	testSQLiteDatabaseNameSuffix = "temporal_persistence"
+)
+
+func TestSQLiteNamespaceSuite(t *testing.T) {
+	cfg := NewSQLiteMemoryConfig()
+	store, err := sql.NewSQLDB(cfg)
+	if err != nil {
+		panic(fmt.Sprintf("unable to create SQLite DB: %v", err))
+	}
+	defer func() {
+		_ = store.Close()
+	}()
+
+	s := newNamespaceSuite(t, store)
+	suite.Run(t, s)
+}
+
+func TestSQLiteMatchingTaskSuite(t *testing.T) {
+	cfg := NewSQLiteMemoryConfig()
+	store, err := sql.NewSQLDB(cfg)
+	if err != nil {
+		panic(fmt.Sprintf("unable to create SQLite DB: %v", err))
+	}
+	defer func() {
+		_ = store.Close()
+	}()
+
+	s := newMatchingTaskSuite(t, store)
+	suite.Run(t, s)
+}
+
+func TestSQLiteMatchingTaskQueueSuite(t *testing.T) {
+	cfg := NewSQLiteMemoryConfig()
+	store, err := sql.NewSQLDB(cfg)
+	if err != nil {
+		panic(fmt.Sprintf("unable to create SQLite DB: %v", err))
+	}
+	defer func() {
+		_ = store.Close()
+	}()
+
+	s := newMatchingTaskQueueSuite(t, store)
+	suite.Run(t, s)
+}
+
+func TestSQLiteHistoryShardSuite(t *testing.T) {
+	cfg := NewSQLiteMemoryConfig()
+	store, err := sql.NewSQLDB(cfg)
+	if err != nil {
+		panic(fmt.Sprintf("unable to create SQLite DB: %v", err))
+	}
+	defer func() {
+		_ = store.Close()
+	}()
+
+	s := newHistoryShardSuite(t, store)
+	suite.Run(t, s)
+}
+
+func TestSQLiteHistoryNodeSuite(t *testing.T) {
+	cfg := NewSQLiteMemoryConfig()
+	store, err := sql.NewSQLDB(cfg)
+	if err != nil {
+		panic(fmt.Sprintf("unable to create SQLite DB: %v", err))
+	}
+	defer func() {
+		_ = store.Close()
+	}()
+
+	s := newHistoryNodeSuite(t, store)
+	suite.Run(t, s)
+}
+
+func TestSQLiteHistoryTreeSuite(t *testing.T) {
+	cfg := NewSQLiteMemoryConfig()
+	store, err := sql.NewSQLDB(cfg)
+	if err != nil {
+		panic(fmt.Sprintf("unable to create SQLite DB: %v", err))
+	}
+	defer func() {
+		_ = store.Close()
+	}()
+
+	s := newHistoryTreeSuite(t, store)
+	suite.Run(t, s)
+}
+
+func TestSQLiteHistoryCurrentExecutionSuite(t *testing.T) {
+	cfg := NewSQLiteMemoryConfig()
+	store, err := sql.NewSQLDB(cfg)
+	if err != nil {
+		panic(fmt.Sprintf("unable to create SQLite DB: %v", err))
+	}
+	defer func() {
+		_ = store.Close()
+	}()
+
+	s := newHistoryCurrentExecutionSuite(t, store)
+	suite.Run(t, s)
+}
+
+func TestSQLiteHistoryExecutionSuite(t *testing.T) {
+	cfg := NewSQLiteMemoryConfig()
+	store, err := sql.NewSQLDB(cfg)
+	if err != nil {
+		panic(fmt.Sprintf("unable to create SQLite DB: %v", err))
+	}
+	defer func() {
+		_ = store.Close()
+	}()
+
+	s := newHistoryExecutionSuite(t, store)
+	suite.Run(t, s)
+}
+
+func TestSQLiteHistoryTransferTaskSuite(t *testing.T) {
+	cfg := NewSQLiteMemoryConfig()
+	store, err := sql.NewSQLDB(cfg)
+	if err != nil {
+		panic(fmt.Sprintf("unable to create SQLite DB: %v", err))
+	}
+	defer func() {
+		_ = store.Close()
+	}()
+
+	s := newHistoryTransferTaskSuite(t, store)
+	suite.Run(t, s)
+}
+
+func TestSQLiteHistoryTimerTaskSuite(t *testing.T) {
+	cfg := NewSQLiteMemoryConfig()
+	store, err := sql.NewSQLDB(cfg)
+	if err != nil {
+		panic(fmt.Sprintf("unable to create SQLite DB: %v", err))
+	}
+	defer func() {
+		_ = store.Close()
+	}()
+
+	s := newHistoryTimerTaskSuite(t, store)
+	suite.Run(t, s)
+}
+
+func TestSQLiteHistoryExecutionBufferSuite(t *testing.T) {
+	cfg := NewSQLiteMemoryConfig()
+	store, err := sql.NewSQLDB(cfg)
+	if err != nil {
+		panic(fmt.Sprintf("unable to create SQLite DB: %v", err))
+	}
+	defer func() {
+		_ = store.Close()
+	}()
+
+	s := newHistoryExecutionBufferSuite(t, store)
+	suite.Run(t, s)
+}
+
+func TestSQLiteHistoryExecutionActivitySuite(t *testing.T) {
+	cfg := NewSQLiteMemoryConfig()
+	store, err := sql.NewSQLDB(cfg)
+	if err != nil {
+		panic(fmt.Sprintf("unable to create SQLite DB: %v", err))
+	}
+	defer func() {
+		_ = store.Close()
+	}()
+
+	s := newHistoryExecutionActivitySuite(t, store)
+	suite.Run(t, s)
+}
+
+func TestSQLiteHistoryExecutionChildWorkflowSuite(t *testing.T) {
+	cfg := NewSQLiteMemoryConfig()
+	store, err := sql.NewSQLDB(cfg)
+	if err != nil {
+		panic(fmt.Sprintf("unable to create SQLite DB: %v", err))
+	}
+	defer func() {
+		_ = store.Close()
+	}()
+
+	s := newHistoryExecutionChildWorkflowSuite(t, store)
+	suite.Run(t, s)
+}
+
+func TestSQLiteHistoryExecutionTimerSuite(t *testing.T) {
+	cfg := NewSQLiteMemoryConfig()
+	store, err := sql.NewSQLDB(cfg)
+	if err != nil {
+		panic(fmt.Sprintf("unable to create SQLite DB: %v", err))
+	}
+	defer func() {
+		_ = store.Close()
+	}()
+
+	s := newHistoryExecutionTimerSuite(t, store)
+	suite.Run(t, s)
+}
+
+func TestSQLiteHistoryExecutionRequestCancelSuite(t *testing.T) {
+	cfg := NewSQLiteMemoryConfig()
+	store, err := sql.NewSQLDB(cfg)
+	if err != nil {
+		panic(fmt.Sprintf("unable to create SQLite DB: %v", err))
+	}
+	defer func() {
+		_ = store.Close()
+	}()
+
+	s := newHistoryExecutionRequestCancelSuite(t, store)
+	suite.Run(t, s)
+}
+
+func TestSQLiteHistoryExecutionSignalSuite(t *testing.T) {
+	cfg := NewSQLiteMemoryConfig()
+	store, err := sql.NewSQLDB(cfg)
+	if err != nil {
+		panic(fmt.Sprintf("unable to create SQLite DB: %v", err))
+	}
+	defer func() {
+		_ = store.Close()
+	}()
+
+	s := newHistoryExecutionSignalSuite(t, store)
+	suite.Run(t, s)
+}
+
+func TestSQLiteHistoryExecutionSignalRequestSuite(t *testing.T) {
+	cfg := NewSQLiteMemoryConfig()
+	store, err := sql.NewSQLDB(cfg)
+	if err != nil {
+		panic(fmt.Sprintf("unable to create SQLite DB: %v", err))
+	}
+	defer func() {
+		_ = store.Close()
+	}()
+
+	s := newHistoryExecutionSignalRequestSuite(t, store)
+	suite.Run(t, s)
+}
+
+func TestSQLiteVisibilitySuite(t *testing.T) {
+	cfg := NewSQLiteMemoryConfig()
+	store, err := sql.NewSQLDB(cfg)
+	if err != nil {
+		panic(fmt.Sprintf("unable to create SQLite DB: %v", err))
+	}
+	defer func() {
+		_ = store.Close()
+	}()
+
+	s := newVisibilitySuite(t, store)
+	suite.Run(t, s)
+}
+
+// NewSQLiteMemoryConfig returns a new SQLite config for test, the database
+// only lives in memory and its schema is setup on connect
+func NewSQLiteMemoryConfig() *config.SQL {
+	return &config.SQL{
+		User:            "",
+		Password:        "",
+		ConnectAddr:     "localhost",
+		ConnectProtocol: "tcp",
+		PluginName:      sqlite.PluginName,
+		DatabaseName:    testSQLiteDatabaseNamePrefix + shuffle.String(testSQLiteDatabaseNameSuffix),
+		ConnectAttributes: map[string]string{
+			sqlite.ConnectAttributeMode:  sqlite.ModeMemory,
+			sqlite.ConnectAttributeSetup: "true",
+		},
+	}
+}
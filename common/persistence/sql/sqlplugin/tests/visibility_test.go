@@ -33,6 +33,7 @@ import (
 	"github.com/stretchr/testify/require"
 	"github.com/stretchr/testify/suite"
 	enumspb "go.temporal.io/api/enums/v1"
+	"go.temporal.io/api/serviceerror"
 
 	"go.temporal.io/server/common/convert"
 	"go.temporal.io/server/common/persistence/sql/sqlplugin"
@@ -60,6 +61,11 @@ var (
 	testVisibilityData = []byte("random history execution activity data")
 )
 
+var testVisibilitySearchAttributeTypes = map[string]enumspb.IndexedValueType{
+	"CustomKeywordField": enumspb.INDEXED_VALUE_TYPE_KEYWORD,
+	"CustomIntField":     enumspb.INDEXED_VALUE_TYPE_INT,
+}
+
 var testVisibilityOpenStatus = []enumspb.WorkflowExecutionStatus{
 	enumspb.WORKFLOW_EXECUTION_STATUS_RUNNING,
 }
@@ -1297,6 +1303,87 @@ func (s *visibilitySuite) testSelectMinStartTimeMaxStartTimeStatusCloseByTypeMul
 	s.Equal(visibilities, rows)
 }
 
+func (s *visibilitySuite) TestUpsertSelectByQuery() {
+	namespaceID := primitives.NewUUID()
+	runID := primitives.NewUUID()
+	workflowTypeName := shuffle.String(testVisibilityWorkflowTypeName)
+	workflowID := shuffle.String(testVisibilityWorkflowID)
+	startTime := s.now()
+	executionTime := startTime.Add(time.Second)
+	status := int32(enumspb.WORKFLOW_EXECUTION_STATUS_RUNNING)
+
+	visibility := s.newRandomVisibilityRow(
+		namespaceID,
+		runID,
+		workflowTypeName,
+		workflowID,
+		startTime,
+		executionTime,
+		status,
+		nil,
+		nil,
+	)
+	visibility.SearchAttributes = convert.StringPtr(`{"CustomKeywordField":"keyword","CustomIntField":10}`)
+	_, err := s.store.UpsertIntoVisibility(&visibility)
+	s.NoError(err)
+
+	queryFilter := sqlplugin.VisibilityQueryFilter{
+		NamespaceID:          namespaceID.String(),
+		Query:                "`Attr.CustomKeywordField` = 'keyword' and `Attr.CustomIntField` >= 5 and WorkflowType = '" + workflowTypeName + "'",
+		SearchAttributeTypes: testVisibilitySearchAttributeTypes,
+		PageSize:             10,
+	}
+	rows, err := s.store.SelectFromVisibilityByQuery(queryFilter)
+	if _, ok := err.(*serviceerror.Unimplemented); ok {
+		// sqlite is only built with the JSON1 extension with the sqlite_json tag
+		s.T().Skip(err.Error())
+	}
+	s.NoError(err)
+	s.Equal(1, len(rows))
+	s.Equal(runID.String(), rows[0].RunID)
+	s.NotNil(rows[0].SearchAttributes)
+
+	visibility.SearchAttributes = convert.StringPtr(`{"CustomKeywordField":"keyword","CustomIntField":1}`)
+	_, err = s.store.UpsertIntoVisibility(&visibility)
+	s.NoError(err)
+
+	rows, err = s.store.SelectFromVisibilityByQuery(queryFilter)
+	s.NoError(err)
+	s.Equal(0, len(rows))
+}
+
+func (s *visibilitySuite) TestInsertCountByQuery() {
+	namespaceID := primitives.NewUUID()
+	workflowTypeName := shuffle.String(testVisibilityWorkflowTypeName)
+	startTime := s.now()
+
+	numRows := 5
+	for i := 0; i < numRows; i++ {
+		visibility := s.newRandomVisibilityRow(
+			namespaceID,
+			primitives.NewUUID(),
+			workflowTypeName,
+			shuffle.String(testVisibilityWorkflowID),
+			startTime,
+			startTime,
+			int32(enumspb.WORKFLOW_EXECUTION_STATUS_RUNNING),
+			nil,
+			nil,
+		)
+		_, err := s.store.InsertIntoVisibility(&visibility)
+		s.NoError(err)
+	}
+
+	queryFilter := sqlplugin.VisibilityQueryFilter{
+		NamespaceID:          namespaceID.String(),
+		Query:                "ExecutionStatus = 'Running' and CloseTime = missing",
+		SearchAttributeTypes: testVisibilitySearchAttributeTypes,
+	}
+	count, err := s.store.CountFromVisibilityByQuery(queryFilter)
+	s.NoError(err)
+	s.Equal(int64(numRows), count)
+}
+
 func (s *visibilitySuite) sortByStartTimeDescRunIDAsc(
 	visibilities []sqlplugin.VisibilityRow,
 ) {
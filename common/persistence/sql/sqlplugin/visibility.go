@@ -27,6 +27,8 @@ package sqlplugin
 import (
 	"database/sql"
 	"time"
+
+	enumspb "go.temporal.io/api/enums/v1"
 )
 
 type (
@@ -43,6 +45,9 @@ type (
 		HistoryLength    *int64
 		Memo             []byte
 		Encoding         string
+		TaskQueue        string
+		// SearchAttributes is the JSON object of the search attributes, it is nil if there are none
+		SearchAttributes *string
 	}
 
 	// VisibilitySelectFilter contains the column names within executions_visibility table that
@@ -58,6 +63,21 @@ type (
 		PageSize         *int
 	}
 
+	// VisibilityQueryFilter contains the advanced visibility query of a list, scan or count request
+	VisibilityQueryFilter struct {
+		NamespaceID string
+		// Query is a where clause, optionally followed by an order by clause, that has been validated
+		// by the frontend, custom search attributes are prefixed with definition.Attr
+		Query string
+		// SearchAttributeTypes maps the valid search attributes to their types
+		SearchAttributeTypes map[string]enumspb.IndexedValueType
+		PageSize             int
+		// Offset is the number of rows skipped by list queries
+		Offset int
+		// AfterRunID makes a scan query, the rows are ordered by run id and start after AfterRunID
+		AfterRunID *string
+	}
+
 	VisibilityDeleteFilter struct {
 		NamespaceID string
 		RunID       string
@@ -69,6 +89,9 @@ type (
 		InsertIntoVisibility(row *VisibilityRow) (sql.Result, error)
 		// ReplaceIntoVisibility deletes old row (if it exist) and inserts new row into visibility table
 		ReplaceIntoVisibility(row *VisibilityRow) (sql.Result, error)
+		// UpsertIntoVisibility inserts a row into visibility table. If a row already exist,
+		// only its memo and search attributes are updated
+		UpsertIntoVisibility(row *VisibilityRow) (sql.Result, error)
 		// SelectFromVisibility returns one or more rows from visibility table
 		// Required filter params:
 		// - getClosedWorkflowExecution - retrieves single row - {namespaceID, runID, closed=true}
@@ -79,5 +102,9 @@ type (
 		//     - workflowID, workflowTypeName, status (along with closed=true)
 		SelectFromVisibility(filter VisibilitySelectFilter) ([]VisibilityRow, error)
 		DeleteFromVisibility(filter VisibilityDeleteFilter) (sql.Result, error)
+		// SelectFromVisibilityByQuery returns the rows of the visibility table that match an advanced visibility query
+		SelectFromVisibilityByQuery(filter VisibilityQueryFilter) ([]VisibilityRow, error)
+		// CountFromVisibilityByQuery returns the number of rows of the visibility table that match an advanced visibility query
+		CountFromVisibilityByQuery(filter VisibilityQueryFilter) (int64, error)
 	}
 )
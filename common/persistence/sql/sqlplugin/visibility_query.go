@@ -0,0 +1,422 @@
+// The MIT License
+//
+// Copyright (c) 2020 Temporal Technologies Inc.  All rights reserved.
+//
+// Copyright (c) 2020 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package sqlplugin
+
+import (
+	"fmt"
+	"regexp"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/xwb1989/sqlparser"
+	enumspb "go.temporal.io/api/enums/v1"
+	"go.temporal.io/api/serviceerror"
+
+	"go.temporal.io/server/common"
+	"go.temporal.io/server/common/definition"
+)
+
+type (
+	// VisibilityQueryDialect renders the plugin specific parts of a translated advanced visibility query
+	VisibilityQueryDialect interface {
+		// Placeholder returns the bind parameter of the n-th argument of the query, n starts at 1
+		Placeholder(n int) string
+		// SearchAttribute returns the expression of a custom search attribute, of the given type,
+		// stored in the search_attributes JSON column
+		SearchAttribute(name string, valueType enumspb.IndexedValueType) string
+	}
+
+	visibilityColumn struct {
+		expr      string
+		valueType enumspb.IndexedValueType
+		isTime    bool
+		isStatus  bool
+	}
+
+	// visibilityQueryTranslator translates the query grammar accepted by the elasticsearch
+	// visibility store, i.e. a where clause with an optional order by clause, into SQL
+	visibilityQueryTranslator struct {
+		dialect VisibilityQueryDialect
+		types   map[string]enumspb.IndexedValueType
+		args    []interface{}
+	}
+)
+
+const (
+	// VisibilityDateTimeFormat is the format of the datetime values stored in the search_attributes
+	// column, it has a fixed width and is always in UTC so that datetimes compare as strings
+	VisibilityDateTimeFormat = "2006-01-02T15:04:05.000000000Z07:00"
+
+	visibilityQueryColumns = `workflow_id, run_id, start_time, execution_time, workflow_type_name, status, memo, encoding, close_time, history_length, task_queue, search_attributes`
+
+	visibilityQueryDefaultOrderBy = "start_time DESC, run_id"
+
+	// missingValue is used by queries to check whether a field is set, e.g. CloseTime = missing
+	missingValue = "missing"
+
+	executionStatusPrefix = "WORKFLOW_EXECUTION_STATUS_"
+)
+
+var (
+	visibilityQuerySystemColumns = map[string]visibilityColumn{
+		definition.NamespaceID:     {expr: "namespace_id", valueType: enumspb.INDEXED_VALUE_TYPE_KEYWORD},
+		definition.WorkflowID:      {expr: "workflow_id", valueType: enumspb.INDEXED_VALUE_TYPE_KEYWORD},
+		definition.RunID:           {expr: "run_id", valueType: enumspb.INDEXED_VALUE_TYPE_KEYWORD},
+		definition.WorkflowType:    {expr: "workflow_type_name", valueType: enumspb.INDEXED_VALUE_TYPE_KEYWORD},
+		definition.StartTime:       {expr: "start_time", valueType: enumspb.INDEXED_VALUE_TYPE_DATETIME, isTime: true},
+		definition.ExecutionTime:   {expr: "execution_time", valueType: enumspb.INDEXED_VALUE_TYPE_DATETIME, isTime: true},
+		definition.CloseTime:       {expr: "close_time", valueType: enumspb.INDEXED_VALUE_TYPE_DATETIME, isTime: true},
+		definition.ExecutionStatus: {expr: "status", valueType: enumspb.INDEXED_VALUE_TYPE_INT, isStatus: true},
+		definition.HistoryLength:   {expr: "history_length", valueType: enumspb.INDEXED_VALUE_TYPE_INT},
+		definition.TaskQueue:       {expr: "task_queue", valueType: enumspb.INDEXED_VALUE_TYPE_KEYWORD},
+		definition.Encoding:        {expr: "encoding", valueType: enumspb.INDEXED_VALUE_TYPE_KEYWORD},
+	}
+
+	// search attribute names are inlined in the JSON paths of the queries
+	searchAttributeNameRegex = regexp.MustCompile(`^[a-zA-Z_][a-zA-Z0-9_]*$`)
+)
+
+// BuildVisibilitySelectQuery translates the advanced visibility query of the filter into a select
+// statement on the visibility table and its arguments. List queries are paginated with an offset,
+// scan queries ignore the order by clause and are paginated by run id.
+func BuildVisibilitySelectQuery(dialect VisibilityQueryDialect, filter VisibilityQueryFilter) (string, []interface{}, error) {
+	t := newVisibilityQueryTranslator(dialect, filter.SearchAttributeTypes)
+	where, orderBy, err := t.translate(filter.NamespaceID, filter.Query)
+	if err != nil {
+		return "", nil, err
+	}
+
+	var b strings.Builder
+	b.WriteString("SELECT " + visibilityQueryColumns + " FROM executions_visibility WHERE " + where)
+	if filter.AfterRunID != nil {
+		b.WriteString(" AND run_id > " + t.bind(*filter.AfterRunID))
+		orderBy = "run_id"
+	}
+	b.WriteString(" ORDER BY " + orderBy)
+	b.WriteString(" LIMIT " + t.bind(filter.PageSize))
+	if filter.AfterRunID == nil && filter.Offset > 0 {
+		b.WriteString(" OFFSET " + t.bind(filter.Offset))
+	}
+	return b.String(), t.args, nil
+}
+
+// BuildVisibilityCountQuery translates the advanced visibility query of the filter into a count
+// statement on the visibility table and its arguments
+func BuildVisibilityCountQuery(dialect VisibilityQueryDialect, filter VisibilityQueryFilter) (string, []interface{}, error) {
+	t := newVisibilityQueryTranslator(dialect, filter.SearchAttributeTypes)
+	where, _, err := t.translate(filter.NamespaceID, filter.Query)
+	if err != nil {
+		return "", nil, err
+	}
+	return "SELECT COUNT(*) FROM executions_visibility WHERE " + where, t.args, nil
+}
+
+func newVisibilityQueryTranslator(dialect VisibilityQueryDialect, types map[string]enumspb.IndexedValueType) *visibilityQueryTranslator {
+	return &visibilityQueryTranslator{
+		dialect: dialect,
+		types:   types,
+	}
+}
+
+// translate returns the where and order by clauses of the query, the where clause is always
+// restricted to the namespace
+func (t *visibilityQueryTranslator) translate(namespaceID string, query string) (string, string, error) {
+	where := "namespace_id = " + t.bind(namespaceID)
+	orderBy := visibilityQueryDefaultOrderBy
+
+	query = strings.TrimSpace(query)
+	if query == "" {
+		return where, orderBy, nil
+	}
+	// The placeholder query is never executed, it is only used to parse the query
+	var placeholderQuery string
+	if common.IsJustOrderByClause(query) {
+		placeholderQuery = fmt.Sprintf("SELECT * FROM dummy %s", query)
+	} else {
+		placeholderQuery = fmt.Sprintf("SELECT * FROM dummy WHERE %s", query)
+	}
+	stmt, err := sqlparser.Parse(placeholderQuery)
+	if err != nil {
+		return "", "", newInvalidVisibilityQueryError("Invalid query.")
+	}
+	sel, ok := stmt.(*sqlparser.Select)
+	if !ok {
+		return "", "", newInvalidVisibilityQueryError("Invalid select query.")
+	}
+
+	if sel.Where != nil {
+		condition, err := t.convertExpr(sel.Where.Expr)
+		if err != nil {
+			return "", "", err
+		}
+		where += " AND " + condition
+	}
+
+	if len(sel.OrderBy) > 1 {
+		return "", "", newInvalidVisibilityQueryError("Only one field can be used to sort.")
+	}
+	if len(sel.OrderBy) == 1 {
+		column, err := t.resolveColumn(sel.OrderBy[0].Expr)
+		if err != nil {
+			return "", "", err
+		}
+		if column.valueType == enumspb.INDEXED_VALUE_TYPE_STRING {
+			return "", "", newInvalidVisibilityQueryError("Not able to sort by IndexedValueTypeString field, use IndexedValueTypeKeyword field.")
+		}
+		direction := "ASC"
+		if sel.OrderBy[0].Direction == sqlparser.DescScr {
+			direction = "DESC"
+		}
+		// run id is the tie breaker of the pagination
+		orderBy = column.expr + " " + direction + ", run_id"
+	}
+	return where, orderBy, nil
+}
+
+func (t *visibilityQueryTranslator) convertExpr(expr sqlparser.Expr) (string, error) {
+	switch expr := expr.(type) {
+	case *sqlparser.AndExpr:
+		return t.convertLogicalExpr(expr.Left, expr.Right, "AND")
+	case *sqlparser.OrExpr:
+		return t.convertLogicalExpr(expr.Left, expr.Right, "OR")
+	case *sqlparser.NotExpr:
+		condition, err := t.convertExpr(expr.Expr)
+		if err != nil {
+			return "", err
+		}
+		return "NOT (" + condition + ")", nil
+	case *sqlparser.ParenExpr:
+		// logical expressions are always parenthesized
+		return t.convertExpr(expr.Expr)
+	case *sqlparser.ComparisonExpr:
+		return t.convertComparisonExpr(expr)
+	case *sqlparser.RangeCond:
+		return t.convertRangeCond(expr)
+	default:
+		return "", newInvalidVisibilityQueryError("Invalid where clause.")
+	}
+}
+
+func (t *visibilityQueryTranslator) convertLogicalExpr(left sqlparser.Expr, right sqlparser.Expr, operator string) (string, error) {
+	leftCondition, err := t.convertExpr(left)
+	if err != nil {
+		return "", err
+	}
+	rightCondition, err := t.convertExpr(right)
+	if err != nil {
+		return "", err
+	}
+	return "(" + leftCondition + " " + operator + " " + rightCondition + ")", nil
+}
+
+func (t *visibilityQueryTranslator) convertComparisonExpr(expr *sqlparser.ComparisonExpr) (string, error) {
+	column, err := t.resolveColumn(expr.Left)
+	if err != nil {
+		return "", err
+	}
+
+	if colName, ok := expr.Right.(*sqlparser.ColName); ok && colName.Name.EqualString(missingValue) {
+		switch expr.Operator {
+		case sqlparser.EqualStr:
+			return column.expr + " IS NULL", nil
+		case sqlparser.NotEqualStr:
+			return column.expr + " IS NOT NULL", nil
+		default:
+			return "", newInvalidVisibilityQueryError("Operator %v can not be used with %v.", expr.Operator, missingValue)
+		}
+	}
+
+	switch expr.Operator {
+	case sqlparser.LikeStr, sqlparser.NotLikeStr:
+		if column.valueType != enumspb.INDEXED_VALUE_TYPE_STRING && column.valueType != enumspb.INDEXED_VALUE_TYPE_KEYWORD {
+			return "", newInvalidVisibilityQueryError("Operator %v can only be used with string fields.", expr.Operator)
+		}
+		fallthrough
+	case sqlparser.EqualStr, sqlparser.NotEqualStr,
+		sqlparser.LessThanStr, sqlparser.LessEqualStr,
+		sqlparser.GreaterThanStr, sqlparser.GreaterEqualStr:
+		value, err := t.convertValue(column, expr.Right)
+		if err != nil {
+			return "", err
+		}
+		return column.expr + " " + strings.ToUpper(expr.Operator) + " " + t.bind(value), nil
+	case sqlparser.InStr, sqlparser.NotInStr:
+		tuple, ok := expr.Right.(sqlparser.ValTuple)
+		if !ok || len(tuple) == 0 {
+			return "", newInvalidVisibilityQueryError("Operator %v requires a list of values.", expr.Operator)
+		}
+		placeholders := make([]string, len(tuple))
+		for i, valueExpr := range tuple {
+			value, err := t.convertValue(column, valueExpr)
+			if err != nil {
+				return "", err
+			}
+			placeholders[i] = t.bind(value)
+		}
+		return column.expr + " " + strings.ToUpper(expr.Operator) + " (" + strings.Join(placeholders, ", ") + ")", nil
+	default:
+		return "", newInvalidVisibilityQueryError("Operator %v is not supported.", expr.Operator)
+	}
+}
+
+func (t *visibilityQueryTranslator) convertRangeCond(expr *sqlparser.RangeCond) (string, error) {
+	column, err := t.resolveColumn(expr.Left)
+	if err != nil {
+		return "", err
+	}
+	from, err := t.convertValue(column, expr.From)
+	if err != nil {
+		return "", err
+	}
+	to, err := t.convertValue(column, expr.To)
+	if err != nil {
+		return "", err
+	}
+	return column.expr + " " + strings.ToUpper(expr.Operator) + " " + t.bind(from) + " AND " + t.bind(to), nil
+}
+
+// resolveColumn returns the column, or the search_attributes expression, of a field of the query.
+// Custom search attributes are prefixed with definition.Attr by the frontend, the prefix is
+// either the qualifier of the column or part of its quoted name.
+func (t *visibilityQueryTranslator) resolveColumn(expr sqlparser.Expr) (visibilityColumn, error) {
+	colName, ok := expr.(*sqlparser.ColName)
+	if !ok {
+		return visibilityColumn{}, newInvalidVisibilityQueryError("Invalid search attribute.")
+	}
+	name := colName.Name.String()
+	isCustom := false
+	if !colName.Qualifier.IsEmpty() {
+		if colName.Qualifier.Name.String() != definition.Attr {
+			return visibilityColumn{}, newInvalidVisibilityQueryError("Invalid search attribute %v.", sqlparser.String(colName))
+		}
+		isCustom = true
+	} else if strings.HasPrefix(name, definition.Attr+".") {
+		name = strings.TrimPrefix(name, definition.Attr+".")
+		isCustom = true
+	}
+
+	if !isCustom {
+		if column, ok := visibilityQuerySystemColumns[name]; ok {
+			return column, nil
+		}
+	}
+	valueType, ok := t.types[name]
+	if !ok || definition.IsSystemIndexedKey(name) || !searchAttributeNameRegex.MatchString(name) {
+		return visibilityColumn{}, newInvalidVisibilityQueryError("Invalid search attribute %v.", name)
+	}
+	return visibilityColumn{
+		expr:      t.dialect.SearchAttribute(name, valueType),
+		valueType: valueType,
+	}, nil
+}
+
+// convertValue converts a value of the query to the argument compared with the column
+func (t *visibilityQueryTranslator) convertValue(column visibilityColumn, expr sqlparser.Expr) (interface{}, error) {
+	var value string
+	switch expr := expr.(type) {
+	case *sqlparser.SQLVal:
+		value = string(expr.Val)
+	case sqlparser.BoolVal:
+		value = strconv.FormatBool(bool(expr))
+	case *sqlparser.UnaryExpr:
+		val, ok := expr.Expr.(*sqlparser.SQLVal)
+		if !ok || expr.Operator != sqlparser.UMinusStr {
+			return nil, newInvalidVisibilityQueryError("Invalid value %v.", sqlparser.String(expr))
+		}
+		value = "-" + string(val.Val)
+	default:
+		return nil, newInvalidVisibilityQueryError("Invalid value %v.", sqlparser.String(expr))
+	}
+
+	var result interface{}
+	var err error
+	switch {
+	case column.isTime:
+		result, err = parseVisibilityTime(value)
+	case column.isStatus:
+		result, err = parseExecutionStatus(value)
+	default:
+		switch column.valueType {
+		case enumspb.INDEXED_VALUE_TYPE_STRING, enumspb.INDEXED_VALUE_TYPE_KEYWORD:
+			result = value
+		case enumspb.INDEXED_VALUE_TYPE_INT:
+			result, err = strconv.ParseInt(value, 10, 64)
+		case enumspb.INDEXED_VALUE_TYPE_DOUBLE:
+			result, err = strconv.ParseFloat(value, 64)
+		case enumspb.INDEXED_VALUE_TYPE_BOOL:
+			var b bool
+			b, err = strconv.ParseBool(value)
+			// the dialects compare booleans as the text of their JSON value
+			result = strconv.FormatBool(b)
+		case enumspb.INDEXED_VALUE_TYPE_DATETIME:
+			var tm time.Time
+			tm, err = parseVisibilityTime(value)
+			result = tm.Format(VisibilityDateTimeFormat)
+		default:
+			err = fmt.Errorf("unknown index value type %v", column.valueType)
+		}
+	}
+	if err != nil {
+		return nil, newInvalidVisibilityQueryError("Invalid value %v: %v.", value, err)
+	}
+	return result, nil
+}
+
+func (t *visibilityQueryTranslator) bind(arg interface{}) string {
+	t.args = append(t.args, arg)
+	return t.dialect.Placeholder(len(t.args))
+}
+
+// parseVisibilityTime parses a time in unix nanos or RFC3339 format
+func parseVisibilityTime(value string) (time.Time, error) {
+	if nanos, err := strconv.ParseInt(value, 10, 64); err == nil {
+		return time.Unix(0, nanos).UTC(), nil
+	}
+	tm, err := time.Parse(time.RFC3339Nano, value)
+	if err != nil {
+		return time.Time{}, err
+	}
+	return tm.UTC(), nil
+}
+
+// parseExecutionStatus parses a status by value or by name, e.g. 1, Running or WORKFLOW_EXECUTION_STATUS_RUNNING
+func parseExecutionStatus(value string) (int32, error) {
+	if status, err := strconv.ParseInt(value, 10, 32); err == nil {
+		return int32(status), nil
+	}
+	// the enum value names are CamelCase, e.g. ContinuedAsNew, so compare them without case and underscores
+	name := strings.ReplaceAll(strings.TrimPrefix(strings.ToUpper(value), executionStatusPrefix), "_", "")
+	for statusName, status := range enumspb.WorkflowExecutionStatus_value {
+		if strings.ToUpper(statusName) == name {
+			return status, nil
+		}
+	}
+	return 0, fmt.Errorf("unknown workflow execution status")
+}
+
+func newInvalidVisibilityQueryError(format string, args ...interface{}) error {
+	return serviceerror.NewInvalidArgument(fmt.Sprintf(format, args...))
+}
@@ -0,0 +1,149 @@
+// The MIT License
+//
+// Copyright (c) 2020 Temporal Technologies Inc.  All rights reserved.
+//
+// Copyright (c) 2020 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package sqlplugin
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"github.com/stretchr/testify/suite"
+	enumspb "go.temporal.io/api/enums/v1"
+	"go.temporal.io/api/serviceerror"
+)
+
+type (
+	visibilityQuerySuite struct {
+		suite.Suite
+		*require.Assertions
+	}
+
+	testQueryDialect struct{}
+)
+
+var testSearchAttributeTypes = map[string]enumspb.IndexedValueType{
+	"CustomStringField":   enumspb.INDEXED_VALUE_TYPE_STRING,
+	"CustomKeywordField":  enumspb.INDEXED_VALUE_TYPE_KEYWORD,
+	"CustomIntField":      enumspb.INDEXED_VALUE_TYPE_INT,
+	"CustomDoubleField":   enumspb.INDEXED_VALUE_TYPE_DOUBLE,
+	"CustomBoolField":     enumspb.INDEXED_VALUE_TYPE_BOOL,
+	"CustomDatetimeField": enumspb.INDEXED_VALUE_TYPE_DATETIME,
+}
+
+func TestVisibilityQuerySuite(t *testing.T) {
+	suite.Run(t, new(visibilityQuerySuite))
+}
+
+func (s *visibilityQuerySuite) SetupTest() {
+	s.Assertions = require.New(s.T())
+}
+
+func (testQueryDialect) Placeholder(_ int) string {
+	return "?"
+}
+
+func (testQueryDialect) SearchAttribute(name string, _ enumspb.IndexedValueType) string {
+	return "sa(" + name + ")"
+}
+
+func (s *visibilityQuerySuite) newFilter(query string) VisibilityQueryFilter {
+	return VisibilityQueryFilter{
+		NamespaceID:          "namespace",
+		Query:                query,
+		SearchAttributeTypes: testSearchAttributeTypes,
+		PageSize:             10,
+	}
+}
+
+func (s *visibilityQuerySuite) TestSelect_EmptyQuery() {
+	query, args, err := BuildVisibilitySelectQuery(testQueryDialect{}, s.newFilter(""))
+	s.NoError(err)
+	s.Equal("SELECT "+visibilityQueryColumns+" FROM executions_visibility WHERE namespace_id = ? ORDER BY start_time DESC, run_id LIMIT ?", query)
+	s.Equal([]interface{}{"namespace", 10}, args)
+}
+
+func (s *visibilityQuerySuite) TestSelect_Offset() {
+	filter := s.newFilter("")
+	filter.Offset = 20
+	query, args, err := BuildVisibilitySelectQuery(testQueryDialect{}, filter)
+	s.NoError(err)
+	s.Contains(query, " LIMIT ? OFFSET ?")
+	s.Equal([]interface{}{"namespace", 10, 20}, args)
+}
+
+func (s *visibilityQuerySuite) TestSelect_Scan() {
+	filter := s.newFilter("WorkflowType = 'type' order by StartTime")
+	filter.AfterRunID = new(string)
+	query, args, err := BuildVisibilitySelectQuery(testQueryDialect{}, filter)
+	s.NoError(err)
+	s.Contains(query, " WHERE namespace_id = ? AND workflow_type_name = ? AND run_id > ? ORDER BY run_id LIMIT ?")
+	s.Equal([]interface{}{"namespace", "type", "", 10}, args)
+}
+
+func (s *visibilityQuerySuite) TestSelect_SystemAttributes() {
+	startTime := time.Date(2020, 1, 2, 3, 4, 5, 6, time.UTC)
+	query, args, err := BuildVisibilitySelectQuery(testQueryDialect{}, s.newFilter(
+		"WorkflowId = 'wid' and (ExecutionStatus = 'Completed' or ExecutionStatus = 3) and StartTime > '2020-01-02T03:04:05.000000006Z' and CloseTime = missing order by HistoryLength desc"))
+	s.NoError(err)
+	s.Contains(query, " WHERE namespace_id = ? AND (((workflow_id = ? AND (status = ? OR status = ?)) AND start_time > ?) AND close_time IS NULL) ORDER BY history_length DESC, run_id LIMIT ?")
+	s.Equal([]interface{}{"namespace", "wid", int32(2), int32(3), startTime, 10}, args)
+}
+
+func (s *visibilityQuerySuite) TestSelect_CustomAttributes() {
+	query, args, err := BuildVisibilitySelectQuery(testQueryDialect{}, s.newFilter(
+		"`Attr.CustomIntField` between 1 and 10 and `Attr.CustomBoolField` = true and `Attr.CustomDoubleField` in (1.5, -2) and `Attr.CustomKeywordField` like 'key%' and `Attr.CustomDatetimeField` >= 0 order by `Attr.CustomKeywordField`"))
+	s.NoError(err)
+	s.Contains(query, " WHERE namespace_id = ? AND ((((sa(CustomIntField) BETWEEN ? AND ? AND sa(CustomBoolField) = ?) AND sa(CustomDoubleField) IN (?, ?)) AND sa(CustomKeywordField) LIKE ?) AND sa(CustomDatetimeField) >= ?) ORDER BY sa(CustomKeywordField) ASC, run_id LIMIT ?")
+	s.Equal([]interface{}{"namespace", int64(1), int64(10), "true", 1.5, float64(-2), "key%", "1970-01-01T00:00:00.000000000Z", 10}, args)
+}
+
+func (s *visibilityQuerySuite) TestCount() {
+	query, args, err := BuildVisibilityCountQuery(testQueryDialect{}, s.newFilter("`Attr.CustomStringField` != missing order by StartTime"))
+	s.NoError(err)
+	s.Equal("SELECT COUNT(*) FROM executions_visibility WHERE namespace_id = ? AND sa(CustomStringField) IS NOT NULL", query)
+	s.Equal([]interface{}{"namespace"}, args)
+}
+
+func (s *visibilityQuerySuite) TestInvalidQueries() {
+	queries := []string{
+		"invalid query",
+		"UnknownField = 1",
+		"`Attr.UnknownField` = 1",
+		"`Attr.WorkflowId` = 'wid'",
+		"`Attr.CustomIntField` = 'abc'",
+		"`Attr.CustomIntField` like '1%'",
+		"StartTime > 'yesterday'",
+		"ExecutionStatus = 'Unknown'",
+		"CloseTime > missing",
+		"WorkflowId = WorkflowType",
+		"order by StartTime, RunId",
+		"order by `Attr.CustomStringField`",
+	}
+	for _, q := range queries {
+		_, _, err := BuildVisibilitySelectQuery(testQueryDialect{}, s.newFilter(q))
+		s.Error(err, q)
+		s.IsType(&serviceerror.InvalidArgument{}, err, q)
+	}
+}
@@ -102,11 +102,19 @@ func (v *visibilityManagerWrapper) RecordWorkflowExecutionClosed(request *Record
 }
 
 func (v *visibilityManagerWrapper) UpsertWorkflowExecution(request *UpsertWorkflowExecutionRequest) error {
-	if v.esVisibilityManager == nil { // return operation not support
+	switch v.advancedVisWritingMode() {
+	case common.AdvancedVisibilityWritingModeOff:
+		return v.visibilityManager.UpsertWorkflowExecution(request)
+	case common.AdvancedVisibilityWritingModeOn:
+		return v.esVisibilityManager.UpsertWorkflowExecution(request)
+	case common.AdvancedVisibilityWritingModeDual:
+		if err := v.esVisibilityManager.UpsertWorkflowExecution(request); err != nil {
+			return err
+		}
 		return v.visibilityManager.UpsertWorkflowExecution(request)
+	default:
+		return serviceerror.NewInternal(fmt.Sprintf("Unknown advanced visibility writing mode: %s", v.advancedVisWritingMode()))
 	}
-
-	return v.esVisibilityManager.UpsertWorkflowExecution(request)
 }
 
 func (v *visibilityManagerWrapper) ListOpenWorkflowExecutions(request *ListWorkflowExecutionsRequest) (*ListWorkflowExecutionsResponse, error) {
@@ -35,6 +35,8 @@ type RPSKeyFunc func(key string) float64
 // Info corresponds to information required to determine rate limits
 type Info struct {
 	Namespace string
+	// APIName is the name of the API called by the request, policies may limit APIs separately
+	APIName string
 }
 
 // Limiter corresponds to basic rate limiting functionality.
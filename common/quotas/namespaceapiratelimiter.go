@@ -0,0 +1,124 @@
+// The MIT License
+//
+// Copyright (c) 2020 Temporal Technologies Inc.  All rights reserved.
+//
+// Copyright (c) 2017 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package quotas
+
+import (
+	"fmt"
+	"sync"
+
+	"golang.org/x/time/rate"
+)
+
+type (
+	// APICategoryFunc returns the category of an API
+	APICategoryFunc func(apiName string) string
+
+	// BucketQuotaFunc returns the RPS and burst of the bucket of an API category of a namespace,
+	// ok is false if the category of the namespace is not limited
+	BucketQuotaFunc func(namespace string, category string) (rps float64, burst int, ok bool)
+
+	// NamespaceAPIRateLimiter is a quota policy with a separate bucket for each API category
+	// of each namespace, so calls of one category can't starve the calls of the others
+	NamespaceAPIRateLimiter struct {
+		sync.RWMutex
+		category APICategoryFunc
+		quota    BucketQuotaFunc
+		buckets  map[string]*bucketLimiter
+	}
+
+	bucketLimiter struct {
+		rps     float64
+		burst   int
+		limiter *rate.Limiter
+	}
+)
+
+var _ Policy = (*NamespaceAPIRateLimiter)(nil)
+
+// NewNamespaceAPIRateLimiter returns a new per namespace and API category rate limiter
+func NewNamespaceAPIRateLimiter(category APICategoryFunc, quota BucketQuotaFunc) *NamespaceAPIRateLimiter {
+	return &NamespaceAPIRateLimiter{
+		category: category,
+		quota:    quota,
+		buckets:  map[string]*bucketLimiter{},
+	}
+}
+
+// Bucket returns the name of the bucket of a request
+func (r *NamespaceAPIRateLimiter) Bucket(info Info) string {
+	return fmt.Sprintf("%v/%v", info.Namespace, r.category(info.APIName))
+}
+
+// IsLimited returns whether the API category of the namespace of a request has a quota
+func (r *NamespaceAPIRateLimiter) IsLimited(info Info) bool {
+	if len(info.Namespace) == 0 {
+		return false
+	}
+	_, _, ok := r.quota(info.Namespace, r.category(info.APIName))
+	return ok
+}
+
+// Allow attempts to allow a request to go through. Requests without a namespace
+// and requests of categories without quota are always allowed.
+func (r *NamespaceAPIRateLimiter) Allow(info Info) bool {
+	if len(info.Namespace) == 0 {
+		return true
+	}
+	rps, burst, ok := r.quota(info.Namespace, r.category(info.APIName))
+	if !ok {
+		return true
+	}
+	if burst <= 0 {
+		// same as the burst of the other rate limiters
+		burst = int(rps)
+		if rps != 0 && burst < _burstSize {
+			burst = _burstSize
+		}
+	}
+	return r.getLimiter(r.Bucket(info), rps, burst).Allow()
+}
+
+func (r *NamespaceAPIRateLimiter) getLimiter(bucket string, rps float64, burst int) *rate.Limiter {
+	r.RLock()
+	b, ok := r.buckets[bucket]
+	r.RUnlock()
+	if ok && b.rps == rps && b.burst == burst {
+		return b.limiter
+	}
+
+	r.Lock()
+	defer r.Unlock()
+	b, ok = r.buckets[bucket]
+	if !ok || b.rps != rps || b.burst != burst {
+		// dynamic config changed, the new limiter starts with a full bucket
+		b = &bucketLimiter{
+			rps:     rps,
+			burst:   burst,
+			limiter: rate.NewLimiter(rate.Limit(rps), burst),
+		}
+		r.buckets[bucket] = b
+	}
+	return b.limiter
+}
@@ -0,0 +1,74 @@
+// The MIT License
+//
+// Copyright (c) 2020 Temporal Technologies Inc.  All rights reserved.
+//
+// Copyright (c) 2017 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package quotas
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func newTestNamespaceAPIRateLimiter(quota map[string]int) *NamespaceAPIRateLimiter {
+	return NewNamespaceAPIRateLimiter(
+		func(apiName string) string {
+			return apiName
+		},
+		func(namespace string, category string) (float64, int, bool) {
+			burst, ok := quota[category]
+			return 1, burst, ok
+		},
+	)
+}
+
+func TestNamespaceAPIRateLimiterSeparateBuckets(t *testing.T) {
+	policy := newTestNamespaceAPIRateLimiter(map[string]int{"visibility": 2, "start": 1})
+
+	assert.True(t, policy.Allow(Info{Namespace: defaultNamespace, APIName: "visibility"}))
+	assert.True(t, policy.Allow(Info{Namespace: defaultNamespace, APIName: "visibility"}))
+	assert.False(t, policy.Allow(Info{Namespace: defaultNamespace, APIName: "visibility"}))
+
+	// other categories and namespaces have their own buckets
+	assert.True(t, policy.Allow(Info{Namespace: defaultNamespace, APIName: "start"}))
+	assert.False(t, policy.Allow(Info{Namespace: defaultNamespace, APIName: "start"}))
+	assert.True(t, policy.Allow(Info{Namespace: "other", APIName: "visibility"}))
+}
+
+func TestNamespaceAPIRateLimiterUnlimited(t *testing.T) {
+	policy := newTestNamespaceAPIRateLimiter(map[string]int{"visibility": 1})
+
+	for n := 0; n < 5; n++ {
+		assert.True(t, policy.Allow(Info{Namespace: defaultNamespace, APIName: "poll"}))
+		assert.True(t, policy.Allow(Info{APIName: "visibility"}))
+	}
+	assert.Equal(t, defaultNamespace+"/visibility", policy.Bucket(Info{Namespace: defaultNamespace, APIName: "visibility"}))
+}
+
+func TestNamespaceAPIRateLimiterIsLimited(t *testing.T) {
+	policy := newTestNamespaceAPIRateLimiter(map[string]int{"visibility": 1})
+
+	assert.True(t, policy.IsLimited(Info{Namespace: defaultNamespace, APIName: "visibility"}))
+	assert.False(t, policy.IsLimited(Info{Namespace: defaultNamespace, APIName: "poll"}))
+	assert.False(t, policy.IsLimited(Info{APIName: "visibility"}))
+}
@@ -31,6 +31,7 @@ import (
 	"go.temporal.io/server/common"
 	"go.temporal.io/server/common/archiver"
 	"go.temporal.io/server/common/archiver/provider"
+	"go.temporal.io/server/common/audit"
 	"go.temporal.io/server/common/authorization"
 	"go.temporal.io/server/common/cluster"
 	"go.temporal.io/server/common/elasticsearch"
@@ -69,6 +70,8 @@ type (
 		ArchivalMetadata             archiver.ArchivalMetadata
 		ArchiverProvider             provider.ArchiverProvider
 		Authorizer                   authorization.Authorizer
+		ClaimMapper                  authorization.ClaimMapper
+		AuditSinks                   map[string]audit.Sink
 	}
 
 	// MembershipMonitorFactory provides a bootstrapped membership monitor
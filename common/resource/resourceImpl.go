@@ -155,6 +155,7 @@ func New(
 
 	ringpopChannel := params.RPCFactory.GetRingpopChannel()
 
+	dynamicCollection := dynamicconfig.NewCollection(params.DynamicConfig, logger)
 	persistenceBean, err := persistenceClient.NewBeanFromFactory(persistenceClient.NewFactory(
 		&params.PersistenceConfig,
 		func(...dynamicconfig.FilterOption) int {
@@ -170,6 +171,7 @@ func New(
 			}
 			return persistenceMaxQPS()
 		},
+		persistence.NewCircuitBreakerConfig(dynamicCollection),
 		params.AbstractDatastoreFactory,
 		params.ClusterMetadata.GetCurrentClusterName(),
 		params.MetricsClient,
@@ -189,7 +191,6 @@ func New(
 		return nil, err
 	}
 
-	dynamicCollection := dynamicconfig.NewCollection(params.DynamicConfig, logger)
 	clientBean, err := client.NewClientBean(
 		client.NewRPCClientFactory(
 			params.RPCFactory,
@@ -36,6 +36,7 @@ import (
 
 	"go.temporal.io/server/common/headers"
 	serviceerrors "go.temporal.io/server/common/serviceerror"
+	"go.temporal.io/server/common/tracing"
 )
 
 const (
@@ -72,6 +73,7 @@ func Dial(hostName string, tlsConfig *tls.Config) (*grpc.ClientConn, error) {
 	return grpc.Dial(hostName,
 		grpcSecureOpt,
 		grpc.WithChainUnaryInterceptor(
+			tracing.UnaryClientInterceptor,
 			versionHeadersInterceptor,
 			errorInterceptor),
 		grpc.WithDefaultServiceConfig(DefaultServiceConfig),
@@ -0,0 +1,60 @@
+// The MIT License
+//
+// Copyright (c) 2020 Temporal Technologies Inc.  All rights reserved.
+//
+// Copyright (c) 2017 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package config
+
+import (
+	"fmt"
+)
+
+const (
+	// AuthorizerDefault is the name of the role based authorizer
+	AuthorizerDefault = "default"
+	// ClaimMapperDefault is the name of the JWT claim mapper
+	ClaimMapperDefault = "default"
+)
+
+// Validate validates the authorization config
+func (a *Authorization) Validate() error {
+	if a.Authorizer != "" && a.Authorizer != AuthorizerDefault {
+		return fmt.Errorf("unknown authorizer %q", a.Authorizer)
+	}
+	if a.ClaimMapper != "" && a.ClaimMapper != ClaimMapperDefault {
+		return fmt.Errorf("unknown claim mapper %q", a.ClaimMapper)
+	}
+	// the role based authorizer denies all calls without claims
+	if a.Authorizer == AuthorizerDefault && a.ClaimMapper == "" {
+		return fmt.Errorf("authorizer %q requires a claim mapper", a.Authorizer)
+	}
+	if a.ClaimMapper == ClaimMapperDefault && len(a.JWTKeyProvider.KeyFiles) == 0 {
+		return fmt.Errorf("claim mapper %q requires JWT key files", a.ClaimMapper)
+	}
+	if a.ClaimMapper == ClaimMapperDefault && a.JWTIssuer == "" {
+		return fmt.Errorf("claim mapper %q requires a JWT issuer", a.ClaimMapper)
+	}
+	if a.ClaimMapper == ClaimMapperDefault && a.JWTAudience == "" {
+		return fmt.Errorf("claim mapper %q requires a JWT audience", a.ClaimMapper)
+	}
+	return nil
+}
@@ -108,6 +108,66 @@ type (
 		PProf PProf `yaml:"pprof"`
 		// TLS controls the communication encryption configuration
 		TLS RootTLS `yaml:"tls"`
+		// Authorization controls the authorization of the frontend APIs
+		Authorization Authorization `yaml:"authorization"`
+		// Audit controls the audit log of the mutating frontend and admin calls
+		Audit Audit `yaml:"audit"`
+		// Tracing controls the OpenTelemetry tracing of requests and persistence calls
+		Tracing *Tracing `yaml:"tracing"`
+	}
+
+	// Tracing contains the config for exporting OpenTelemetry traces
+	Tracing struct {
+		// Exporter is the exporter the spans are sent to, "otlp" or "stdout"
+		Exporter string `yaml:"exporter" validate:"nonzero"`
+		// Endpoint is the host:port of the OTLP collector, defaults to localhost:55680
+		Endpoint string `yaml:"endpoint"`
+		// Insecure disables TLS on the connection to the OTLP collector
+		Insecure bool `yaml:"insecure"`
+		// SampleRatio is the fraction of traces that are sampled, defaults to 1
+		SampleRatio float64 `yaml:"sampleRatio"`
+	}
+
+	// Audit contains the config for the audit log sinks
+	Audit struct {
+		// FilePath is the path of the file the "file" audit sink appends JSON lines to.
+		// The "file" sink is not available if it is not set.
+		FilePath string `yaml:"filePath"`
+	}
+
+	// Authorization contains the config for the claim mapper and the authorizer of the frontend
+	Authorization struct {
+		// Authorizer is the name of the authorizer, "default" for the role based authorizer.
+		// All calls are allowed if it is not set.
+		Authorizer string `yaml:"authorizer"`
+		// ClaimMapper is the name of the claim mapper, "default" for the JWT claim mapper.
+		// No claims are extracted if it is not set.
+		ClaimMapper string `yaml:"claimMapper"`
+		// JWTKeyProvider contains the keys verifying the signature of JWT tokens
+		JWTKeyProvider JWTKeyProvider `yaml:"jwtKeyProvider"`
+		// JWTIssuer is the issuer that the "iss" claim of JWT tokens must match, required by the JWT claim mapper
+		JWTIssuer string `yaml:"jwtIssuer"`
+		// JWTAudience is the audience that the "aud" claim of JWT tokens must contain, required by the JWT claim mapper
+		JWTAudience string `yaml:"jwtAudience"`
+		// PermissionsClaimName is the name of the JWT claim listing the permissions of the caller,
+		// "permissions" by default. Each permission has the form "<namespace>:<role>".
+		PermissionsClaimName string `yaml:"permissionsClaimName"`
+		// NamespaceRoles grants roles on namespaces to subjects, in addition to the roles of their tokens
+		NamespaceRoles map[string]NamespaceRoles `yaml:"namespaceRoles"`
+	}
+
+	// JWTKeyProvider contains the public keys verifying the signature of JWT tokens
+	JWTKeyProvider struct {
+		// KeyFiles maps key IDs, the "kid" header of a token, to the paths of files containing
+		// PEM-encoded RSA or ECDSA public keys or certificates.
+		KeyFiles map[string]string `yaml:"keyFiles"`
+	}
+
+	// NamespaceRoles contains the subjects granted each role on a namespace
+	NamespaceRoles struct {
+		Readers []string `yaml:"readers"`
+		Writers []string `yaml:"writers"`
+		Admins  []string `yaml:"admins"`
 	}
 
 	// RootTLS contains all TLS settings for the Temporal server
@@ -234,6 +294,20 @@ type (
 		TLS *auth.TLS `yaml:"tls"`
 		// Consistency configuration (defaults to LOCAL_QUORUM / LOCAL_SERIAL for all stores if this field not set)
 		Consistency *CassandraStoreConsistency `yaml:"consistency"`
+		// Tasks configures how matching tasks are written and read
+		Tasks *CassandraTaskConfig `yaml:"tasks"`
+	}
+
+	// CassandraTaskConfig configures how matching tasks are written to and read from Cassandra
+	CassandraTaskConfig struct {
+		// BatchedWrites writes the tasks of a CreateTasks call and the range ID check of the task queue as
+		// a single unlogged batch on the partition of the task queue instead of a logged batch
+		BatchedWrites bool `yaml:"batchedWrites"`
+		// ReadPageSize is the page size of task range reads, defaults to the batch size of the read
+		ReadPageSize int `yaml:"readPageSize"`
+		// ReadPrefetch is the fraction of a page that is left to iterate when the next page of a task
+		// range read is fetched in the background, defaults to the gocql default of 0.25
+		ReadPrefetch float64 `yaml:"readPrefetch"`
 	}
 
 	// CassandraStoreConsistency enables you to set the consistency settings for each Cassandra Persistence Store for Temporal
@@ -279,6 +353,51 @@ type (
 		TaskScanPartitions int `yaml:"taskScanPartitions"`
 		// TLS is the configuration for TLS connections
 		TLS *auth.TLS `yaml:"tls"`
+		// Replicas is the list of optional read replicas of this datastore. Visibility reads and history reads
+		// of closed workflows are served by the replicas, everything else always goes to the primary
+		Replicas []SQLReplica `yaml:"replicas"`
+		// ReplicaMaxStaleness is the maximum replication lag of a replica before reads fall back to the primary.
+		// Defaults to 10s
+		ReplicaMaxStaleness time.Duration `yaml:"replicaMaxStaleness"`
+		// ShardDatabases is the list of optional extra databases that history shards are spread across,
+		// the database configured above is database 0. The executions, the history and the shard record of a
+		// history shard always live in a single database, everything else stays in database 0. A shard is
+		// refused if it exists in another database than its own, so the list can't be changed without migration
+		ShardDatabases []SQLShardDatabase `yaml:"shardDatabases"`
+	}
+
+	// SQLReplica is the configuration of a read replica of a SQL datastore,
+	// anything that is not set here is inherited from the primary
+	SQLReplica struct {
+		// ConnectAddr is the remote addr of the replica
+		ConnectAddr string `yaml:"connectAddr" validate:"nonzero"`
+		// User is the username to be used for the conn
+		User string `yaml:"user"`
+		// Password is the password corresponding to the user name
+		Password string `yaml:"password"`
+		// MaxConns the max number of connections to this replica
+		MaxConns int `yaml:"maxConns"`
+		// MaxIdleConns is the max number of idle connections to this replica
+		MaxIdleConns int `yaml:"maxIdleConns"`
+	}
+
+	// SQLShardDatabase is the configuration of an extra database that history shards are spread
+	// across, anything that is not set here is inherited from database 0
+	SQLShardDatabase struct {
+		// ConnectAddr is the remote addr of the database
+		ConnectAddr string `yaml:"connectAddr" validate:"nonzero"`
+		// DatabaseName is the name of SQL database to connect to
+		DatabaseName string `yaml:"databaseName" validate:"nonzero"`
+		// User is the username to be used for the conn
+		User string `yaml:"user"`
+		// Password is the password corresponding to the user name
+		Password string `yaml:"password"`
+		// ConnectAttributes is a set of key-value attributes to be sent as part of connect data_source_name url
+		ConnectAttributes map[string]string `yaml:"connectAttributes"`
+		// MaxConns the max number of connections to this database
+		MaxConns int `yaml:"maxConns"`
+		// MaxIdleConns is the max number of idle connections to this database
+		MaxIdleConns int `yaml:"maxIdleConns"`
 	}
 
 	// CustomDatastoreConfig is the configuration for connecting to a custom datastore that is not supported by temporal core
@@ -487,6 +606,10 @@ func (c *Config) Validate() error {
 		return err
 	}
 
+	if err := c.Global.Authorization.Validate(); err != nil {
+		return err
+	}
+
 	return nil
 }
 
@@ -36,3 +36,22 @@ func TestToString(t *testing.T) {
 	assert.NoError(t, err)
 	assert.NotEmpty(t, cfg.String())
 }
+
+func TestAuthorizationValidate(t *testing.T) {
+	cfg := Authorization{
+		Authorizer:     AuthorizerDefault,
+		ClaimMapper:    ClaimMapperDefault,
+		JWTKeyProvider: JWTKeyProvider{KeyFiles: map[string]string{"key": "key.pem"}},
+		JWTIssuer:      "issuer",
+		JWTAudience:    "audience",
+	}
+	assert.NoError(t, cfg.Validate())
+
+	noIssuer := cfg
+	noIssuer.JWTIssuer = ""
+	assert.Error(t, noIssuer.Validate())
+
+	noAudience := cfg
+	noAudience.JWTAudience = ""
+	assert.Error(t, noAudience.Validate())
+}
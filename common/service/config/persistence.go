@@ -37,6 +37,8 @@ const (
 	StoreTypeSQL = "sql"
 	// StoreTypeNoSQL refers to nosql based storage as persistence store
 	StoreTypeNoSQL = "nosql"
+	// StoreTypeMemory refers to the in-memory storage used by tests and embedded servers
+	StoreTypeMemory = "memory"
 )
 
 // DefaultStoreType returns the storeType for the default persistence store
@@ -55,11 +57,21 @@ func (c *Persistence) Validate() error {
 		if !ok {
 			return fmt.Errorf("persistence config: missing config for datastore %v", st)
 		}
-		if ds.SQL == nil && ds.Cassandra == nil {
-			return fmt.Errorf("persistence config: datastore %v: must provide config for one of cassandra or sql stores", st)
+		storeCount := 0
+		if ds.SQL != nil {
+			storeCount++
 		}
-		if ds.SQL != nil && ds.Cassandra != nil {
-			return fmt.Errorf("persistence config: datastore %v: only one of SQL or cassandra can be specified", st)
+		if ds.Cassandra != nil {
+			storeCount++
+		}
+		if ds.CustomDataStoreConfig != nil {
+			storeCount++
+		}
+		if storeCount == 0 {
+			return fmt.Errorf("persistence config: datastore %v: must provide config for one of cassandra, sql or custom stores", st)
+		}
+		if storeCount > 1 {
+			return fmt.Errorf("persistence config: datastore %v: only one of SQL, cassandra or custom store can be specified", st)
 		}
 		if ds.SQL != nil && ds.SQL.TaskScanPartitions == 0 {
 			ds.SQL.TaskScanPartitions = 1
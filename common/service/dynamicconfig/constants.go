@@ -74,10 +74,23 @@ var keys = map[Key]string{
 	DisallowQuery:                          "system.disallowQuery",
 	EnableBatcher:                          "worker.enableBatcher",
 	EnableParentClosePolicyWorker:          "system.enableParentClosePolicyWorker",
+	EnableScheduler:                        "worker.enableScheduler",
 	EnableStickyQuery:                      "system.enableStickyQuery",
 	EnablePriorityTaskProcessor:            "system.enablePriorityTaskProcessor",
 	EnableAuthorization:                    "system.enableAuthorization",
 
+	// persistence circuit breaker
+	PersistenceCircuitBreakerEnabled:             "system.persistenceCircuitBreakerEnabled",
+	PersistenceCircuitBreakerWindow:              "system.persistenceCircuitBreakerWindow",
+	PersistenceCircuitBreakerMinRequests:         "system.persistenceCircuitBreakerMinRequests",
+	PersistenceCircuitBreakerErrorRatio:          "system.persistenceCircuitBreakerErrorRatio",
+	PersistenceCircuitBreakerOpenTimeout:         "system.persistenceCircuitBreakerOpenTimeout",
+	PersistenceCircuitBreakerHalfOpenMaxRequests: "system.persistenceCircuitBreakerHalfOpenMaxRequests",
+	PersistenceConcurrencyLimitEnabled:           "system.persistenceConcurrencyLimitEnabled",
+	PersistenceConcurrencyLimitMin:               "system.persistenceConcurrencyLimitMin",
+	PersistenceConcurrencyLimitMax:               "system.persistenceConcurrencyLimitMax",
+	PersistenceConcurrencyLimitLatencyTolerance:  "system.persistenceConcurrencyLimitLatencyTolerance",
+
 	// size limit
 	BlobSizeLimitError:     "limit.blobSize.error",
 	BlobSizeLimitWarn:      "limit.blobSize.warn",
@@ -99,6 +112,8 @@ var keys = map[Key]string{
 	FrontendRPS:                           "frontend.rps",
 	FrontendMaxNamespaceRPSPerInstance:    "frontend.namespacerps",
 	FrontendGlobalNamespaceRPS:            "frontend.globalNamespacerps",
+	FrontendNamespaceAPICategoryRPS:       "frontend.namespaceAPICategoryRPS",
+	FrontendNamespaceAPICategoryBurst:     "frontend.namespaceAPICategoryBurst",
 	FrontendHistoryMgrNumConns:            "frontend.historyMgrNumConns",
 	FrontendShutdownDrainDuration:         "frontend.shutdownDrainDuration",
 	DisableListVisibilityByFilter:         "frontend.disableListVisibilityByFilter",
@@ -115,6 +130,7 @@ var keys = map[Key]string{
 	VisibilityArchivalQueryMaxRangeInDays: "frontend.visibilityArchivalQueryMaxRangeInDays",
 	VisibilityArchivalQueryMaxQPS:         "frontend.visibilityArchivalQueryMaxQPS",
 	EnableServerVersionCheck:              "frontend.enableServerVersionCheck",
+	FrontendAuditSink:                     "frontend.auditSink",
 
 	// matching settings
 	MatchingRPS:                             "matching.rps",
@@ -160,6 +176,7 @@ var keys = map[Key]string{
 	StandbyTaskMissingEventsResendDelay:                    "history.standbyTaskMissingEventsResendDelay",
 	StandbyTaskMissingEventsDiscardDelay:                   "history.standbyTaskMissingEventsDiscardDelay",
 	TaskProcessRPS:                                         "history.taskProcessRPS",
+	TaskPriorityNamespaceTier:                              "history.taskPriorityNamespaceTier",
 	TaskSchedulerType:                                      "history.taskSchedulerType",
 	TaskSchedulerWorkerCount:                               "history.taskSchedulerWorkerCount",
 	TaskSchedulerQueueSize:                                 "history.taskSchedulerQueueSize",
@@ -222,6 +239,7 @@ var keys = map[Key]string{
 	ShardSyncMinInterval:                                   "history.shardSyncMinInterval",
 	ShardSyncTimerJitterCoefficient:                        "history.shardSyncMinInterval",
 	DefaultEventEncoding:                                   "history.defaultEventEncoding",
+	DefaultMutableStateEncoding:                            "history.defaultMutableStateEncoding",
 	EnableAdminProtection:                                  "history.enableAdminProtection",
 	AdminOperationToken:                                    "history.adminOperationToken",
 	EnableParentClosePolicy:                                "history.enableParentClosePolicy",
@@ -255,6 +273,7 @@ var keys = map[Key]string{
 	HistoryEnableKafkaReplication:                          "history.EnableKafkaReplication",
 	HistoryEnableCleanupReplicationTask:                    "history.EnableCleanupReplicationTask",
 	MaxBufferedQueryCount:                                  "history.MaxBufferedQueryCount",
+	MaxInFlightUpdateCount:                                 "history.MaxInFlightUpdateCount",
 	MutableStateChecksumGenProbability:                     "history.mutableStateChecksumGenProbability",
 	MutableStateChecksumVerifyProbability:                  "history.mutableStateChecksumVerifyProbability",
 	MutableStateChecksumInvalidateBefore:                   "history.mutableStateChecksumInvalidateBefore",
@@ -296,6 +315,7 @@ var keys = map[Key]string{
 	TaskQueueScannerEnabled:                         "worker.taskQueueScannerEnabled",
 	HistoryScannerEnabled:                           "worker.historyScannerEnabled",
 	ExecutionsScannerEnabled:                        "worker.executionsScannerEnabled",
+	RetentionScannerEnabled:                         "worker.retentionScannerEnabled",
 }
 
 const (
@@ -351,6 +371,28 @@ const (
 	EnablePriorityTaskProcessor
 	// EnableAuthorization is the key to enable authorization for a namespace
 	EnableAuthorization
+
+	// PersistenceCircuitBreakerEnabled is the key to enable the persistence circuit breakers
+	PersistenceCircuitBreakerEnabled
+	// PersistenceCircuitBreakerWindow is the window over which the persistence circuit breakers count failures
+	PersistenceCircuitBreakerWindow
+	// PersistenceCircuitBreakerMinRequests is the min number of requests in a window before a circuit breaker can open
+	PersistenceCircuitBreakerMinRequests
+	// PersistenceCircuitBreakerErrorRatio is the ratio of failed requests in a window which opens a circuit breaker
+	PersistenceCircuitBreakerErrorRatio
+	// PersistenceCircuitBreakerOpenTimeout is how long a circuit breaker stays open before letting probe requests through
+	PersistenceCircuitBreakerOpenTimeout
+	// PersistenceCircuitBreakerHalfOpenMaxRequests is the number of successful probe requests which close a circuit breaker
+	PersistenceCircuitBreakerHalfOpenMaxRequests
+	// PersistenceConcurrencyLimitEnabled is the key to enable the adaptive concurrency limit of persistence managers
+	PersistenceConcurrencyLimitEnabled
+	// PersistenceConcurrencyLimitMin is the lower bound of the adaptive concurrency limit of a persistence manager
+	PersistenceConcurrencyLimitMin
+	// PersistenceConcurrencyLimitMax is the upper bound of the adaptive concurrency limit of a persistence manager
+	PersistenceConcurrencyLimitMax
+	// PersistenceConcurrencyLimitLatencyTolerance is how many times the average latency a request can take
+	// before the adaptive concurrency limit shrinks
+	PersistenceConcurrencyLimitLatencyTolerance
 	// BlobSizeLimitError is the per event blob size limit
 	BlobSizeLimitError
 	// BlobSizeLimitWarn is the per event blob size limit for warning
@@ -390,6 +432,13 @@ const (
 	FrontendMaxNamespaceRPSPerInstance
 	// FrontendGlobalNamespaceRPS is workflow namespace rate limit per second for the whole cluster
 	FrontendGlobalNamespaceRPS
+	// FrontendNamespaceAPICategoryRPS is the rate limit per second of each API category of a namespace,
+	// a map from category to RPS. The requests of a category with RPS are only limited by it, the requests of the
+	// other categories are limited by the shared namespace and host RPS, except for polls and queries
+	FrontendNamespaceAPICategoryRPS
+	// FrontendNamespaceAPICategoryBurst is the burst of each API category of a namespace, a map from category
+	// to burst, the burst of categories without burst is their RPS
+	FrontendNamespaceAPICategoryBurst
 	// FrontendHistoryMgrNumConns is for persistence cluster.NumConns
 	FrontendHistoryMgrNumConns
 	// FrontendThrottledLogRPS is the rate limit on number of log messages emitted per second for throttled logger
@@ -423,6 +472,8 @@ const (
 	VisibilityArchivalQueryMaxQPS
 	// EnableServerVersionCheck is a flag that controls whether or not periodic version checking is enabled
 	EnableServerVersionCheck
+	// FrontendAuditSink is the name of the sink of the audit records of a namespace, auditing is disabled if empty
+	FrontendAuditSink
 
 	// key for matching
 
@@ -511,13 +562,19 @@ const (
 	StandbyTaskMissingEventsDiscardDelay
 	// TaskProcessRPS is the task processing rate per second for each namespace
 	TaskProcessRPS
+	// TaskPriorityNamespaceTier is the tier of a namespace which gives the priority class of its tasks,
+	// 0 is the high, 1 the default and 2 the low priority class
+	TaskPriorityNamespaceTier
 	// TaskSchedulerType is the task scheduler type for priority task processor
 	TaskSchedulerType
 	// TaskSchedulerWorkerCount is the number of workers per shard in task scheduler
 	TaskSchedulerWorkerCount
 	// TaskSchedulerQueueSize is the size of task channel size in task scheduler
 	TaskSchedulerQueueSize
-	// TaskSchedulerRoundRobinWeights is the priority weight for weighted round robin task scheduler
+	// TaskSchedulerRoundRobinWeights is the priority weight for weighted round robin task scheduler,
+	// it maps a task priority to its weight, e.g. {"0": 200, "8": 100}. The priority is the class
+	// (0 high, 8 default, 16 low) plus the subclass (0 workflow task, 1 activity, 2 timer, 3 transfer,
+	// 4 visibility, 5 archival, 6 retry, 7 replication), priorities not listed keep their default weight
 	TaskSchedulerRoundRobinWeights
 	// TimerTaskBatchSize is batch size for timer processor to process tasks
 	TimerTaskBatchSize
@@ -633,8 +690,10 @@ const (
 	ShardSyncMinInterval
 	// ShardSyncTimerJitterCoefficient is the sync shard jitter coefficient
 	ShardSyncTimerJitterCoefficient
-	// DefaultEventEncoding is the encoding type for history events
+	// DefaultEventEncoding is the encoding type for history events, one of Proto3, Proto3Snappy or Proto3Zstd
 	DefaultEventEncoding
+	// DefaultMutableStateEncoding is the encoding type for the persisted execution info, one of Proto3, Proto3Snappy or Proto3Zstd
+	DefaultMutableStateEncoding
 	// NumArchiveSystemWorkflows is key for number of archive system workflows running in total
 	NumArchiveSystemWorkflows
 	// ArchiveRequestRPS is the rate limit on the number of archive request per second
@@ -747,10 +806,14 @@ const (
 	HistoryScannerEnabled
 	// ExecutionsScannerEnabled indicates if executions scanner should be started as part of worker.Scanner
 	ExecutionsScannerEnabled
+	// RetentionScannerEnabled indicates if retention scanner should be started as part of worker.Scanner
+	RetentionScannerEnabled
 	// EnableBatcher decides whether start batcher in our worker
 	EnableBatcher
 	// EnableParentClosePolicyWorker decides whether or not enable system workers for processing parent close policy task
 	EnableParentClosePolicyWorker
+	// EnableScheduler decides whether start scheduler in our worker
+	EnableScheduler
 	// EnableStickyQuery indicates if sticky query should be enabled per namespace
 	EnableStickyQuery
 
@@ -788,6 +851,8 @@ const (
 	HistoryEnableCleanupReplicationTask
 	// EnableConsistentQuery indicates if consistent query is enabled for the cluster
 	MaxBufferedQueryCount
+	// MaxInFlightUpdateCount indicates the max number of updates a workflow execution can have in flight
+	MaxInFlightUpdateCount
 	// MutableStateChecksumGenProbability is the probability [0-100] that checksum will be generated for mutable state
 	MutableStateChecksumGenProbability
 	// MutableStateChecksumVerifyProbability is the probability [0-100] that checksum will be verified for mutable state
@@ -48,6 +48,10 @@ type (
 		QueueSize   int
 		WorkerCount int
 		RetryPolicy backoff.RetryPolicy
+		// FairnessKeyFn groups the tasks of a priority, the groups are dispatched round robin so
+		// a group with a burst of tasks does not delay the other groups of the same priority.
+		// Tasks of a priority are dispatched in submission order if it is not set.
+		FairnessKeyFn func(PriorityTask) string
 	}
 
 	weightedRoundRobinTaskSchedulerImpl struct {
@@ -55,7 +59,7 @@ type (
 
 		status       int32
 		weights      atomic.Value // store the currently used weights
-		taskChs      map[int]chan PriorityTask
+		taskChs      map[int]chan *wRRTask
 		shutdownCh   chan struct{}
 		notifyCh     chan struct{}
 		dispatcherWG sync.WaitGroup
@@ -64,6 +68,26 @@ type (
 		options      *WeightedRoundRobinTaskSchedulerOptions
 
 		processor Processor
+
+		// priorityScopes are the metrics scopes tagged with the task priority,
+		// only accessed by the dispatcher
+		priorityScopes map[int]metrics.Scope
+	}
+
+	// wRRTask records when the task was submitted, so the time it waited
+	// in the scheduler can be measured
+	wRRTask struct {
+		PriorityTask
+		submitTime time.Time
+	}
+
+	// fairTaskQueue holds the tasks of a priority grouped by fairness key,
+	// the groups with pending tasks are dispatched round robin
+	fairTaskQueue struct {
+		keyFn func(PriorityTask) string
+		tasks map[string][]*wRRTask
+		keys  []string
+		size  int
 	}
 )
 
@@ -94,7 +118,7 @@ func NewWeightedRoundRobinTaskScheduler(
 
 	scheduler := &weightedRoundRobinTaskSchedulerImpl{
 		status:       common.DaemonStatusInitialized,
-		taskChs:      make(map[int]chan PriorityTask),
+		taskChs:      make(map[int]chan *wRRTask),
 		shutdownCh:   make(chan struct{}),
 		notifyCh:     make(chan struct{}, 1),
 		logger:       logger,
@@ -109,6 +133,7 @@ func NewWeightedRoundRobinTaskScheduler(
 				RetryPolicy: options.RetryPolicy,
 			},
 		),
+		priorityScopes: make(map[int]metrics.Scope),
 	}
 	scheduler.weights.Store(weights)
 
@@ -156,7 +181,7 @@ func (w *weightedRoundRobinTaskSchedulerImpl) Submit(task PriorityTask) error {
 	}
 
 	select {
-	case taskCh <- task:
+	case taskCh <- &wRRTask{PriorityTask: task, submitTime: time.Now()}:
 		w.notifyDispatcher()
 		return nil
 	case <-w.shutdownCh:
@@ -173,7 +198,7 @@ func (w *weightedRoundRobinTaskSchedulerImpl) TrySubmit(
 	}
 
 	select {
-	case taskCh <- task:
+	case taskCh <- &wRRTask{PriorityTask: task, submitTime: time.Now()}:
 		w.metricsScope.IncCounter(metrics.PriorityTaskSubmitRequest)
 		w.notifyDispatcher()
 		return true, nil
@@ -188,7 +213,8 @@ func (w *weightedRoundRobinTaskSchedulerImpl) dispatcher() {
 	defer w.dispatcherWG.Done()
 
 	outstandingTasks := false
-	taskChs := make(map[int]chan PriorityTask)
+	taskChs := make(map[int]chan *wRRTask)
+	fairQueues := make(map[int]*fairTaskQueue)
 
 	for {
 		if !outstandingTasks {
@@ -206,16 +232,42 @@ func (w *weightedRoundRobinTaskSchedulerImpl) dispatcher() {
 		w.updateTaskChs(taskChs)
 		weights := w.getWeights()
 		for priority, taskCh := range taskChs {
+			if w.options.FairnessKeyFn != nil {
+				queue, ok := fairQueues[priority]
+				if !ok {
+					queue = newFairTaskQueue(w.options.FairnessKeyFn)
+					fairQueues[priority] = queue
+				}
+				// buffer at most one queue of tasks, the task channel still blocks
+				// the submitters when the dispatcher falls behind
+				queue.fill(taskCh, common.MaxInt(w.options.QueueSize, 1))
+				w.recordQueueSize(priority, len(taskCh)+queue.size)
+
+				for i := 0; i < weights[priority]; i++ {
+					task, ok := queue.next()
+					if !ok {
+						break
+					}
+					// dispatched at least one task in this round
+					outstandingTasks = true
+					w.dispatch(priority, task)
+				}
+
+				select {
+				case <-w.shutdownCh:
+					return
+				default:
+				}
+				continue
+			}
+
+			w.recordQueueSize(priority, len(taskCh))
 			for i := 0; i < weights[priority]; i++ {
 				select {
 				case task := <-taskCh:
 					// dispatched at least one task in this round
 					outstandingTasks = true
-
-					if err := w.processor.Submit(task); err != nil {
-						w.logger.Error("fail to submit task to processor", tag.Error(err))
-						task.Nack()
-					}
+					w.dispatch(priority, task)
 				case <-w.shutdownCh:
 					return
 				default:
@@ -227,9 +279,39 @@ func (w *weightedRoundRobinTaskSchedulerImpl) dispatcher() {
 	}
 }
 
+func (w *weightedRoundRobinTaskSchedulerImpl) dispatch(
+	priority int,
+	task *wRRTask,
+) {
+	w.getPriorityScope(priority).RecordTimer(metrics.PriorityTaskQueueLatency, time.Since(task.submitTime))
+
+	if err := w.processor.Submit(task.PriorityTask); err != nil {
+		w.logger.Error("fail to submit task to processor", tag.Error(err))
+		task.Nack()
+	}
+}
+
+func (w *weightedRoundRobinTaskSchedulerImpl) recordQueueSize(
+	priority int,
+	size int,
+) {
+	w.getPriorityScope(priority).RecordTimer(metrics.PriorityTaskQueueSize, time.Duration(size))
+}
+
+func (w *weightedRoundRobinTaskSchedulerImpl) getPriorityScope(
+	priority int,
+) metrics.Scope {
+	scope, ok := w.priorityScopes[priority]
+	if !ok {
+		scope = w.metricsScope.Tagged(metrics.TaskPriorityTag(strconv.Itoa(priority)))
+		w.priorityScopes[priority] = scope
+	}
+	return scope
+}
+
 func (w *weightedRoundRobinTaskSchedulerImpl) getOrCreateTaskChan(
 	priority int,
-) (chan PriorityTask, error) {
+) (chan *wRRTask, error) {
 	if _, ok := w.getWeights()[priority]; !ok {
 		return nil, fmt.Errorf("unknown task priority: %v", priority)
 	}
@@ -246,12 +328,12 @@ func (w *weightedRoundRobinTaskSchedulerImpl) getOrCreateTaskChan(
 	if taskCh, ok := w.taskChs[priority]; ok {
 		return taskCh, nil
 	}
-	taskCh := make(chan PriorityTask, w.options.QueueSize)
+	taskCh := make(chan *wRRTask, w.options.QueueSize)
 	w.taskChs[priority] = taskCh
 	return taskCh, nil
 }
 
-func (w *weightedRoundRobinTaskSchedulerImpl) updateTaskChs(taskChs map[int]chan PriorityTask) {
+func (w *weightedRoundRobinTaskSchedulerImpl) updateTaskChs(taskChs map[int]chan *wRRTask) {
 	w.RLock()
 	defer w.RUnlock()
 
@@ -293,6 +375,62 @@ func (w *weightedRoundRobinTaskSchedulerImpl) updateWeights() {
 	}
 }
 
+func newFairTaskQueue(
+	keyFn func(PriorityTask) string,
+) *fairTaskQueue {
+	return &fairTaskQueue{
+		keyFn: keyFn,
+		tasks: make(map[string][]*wRRTask),
+	}
+}
+
+// fill moves the tasks from the task channel to the queue until the queue holds maxSize tasks
+func (q *fairTaskQueue) fill(
+	taskCh chan *wRRTask,
+	maxSize int,
+) {
+	for q.size < maxSize {
+		select {
+		case task := <-taskCh:
+			q.add(task)
+		default:
+			return
+		}
+	}
+}
+
+func (q *fairTaskQueue) add(
+	task *wRRTask,
+) {
+	key := q.keyFn(task.PriorityTask)
+	if len(q.tasks[key]) == 0 {
+		q.keys = append(q.keys, key)
+	}
+	q.tasks[key] = append(q.tasks[key], task)
+	q.size++
+}
+
+// next returns the oldest task of the next group in round robin order
+func (q *fairTaskQueue) next() (*wRRTask, bool) {
+	if len(q.keys) == 0 {
+		return nil, false
+	}
+
+	key := q.keys[0]
+	q.keys = q.keys[1:]
+	tasks := q.tasks[key]
+	task := tasks[0]
+	tasks[0] = nil
+	if len(tasks) == 1 {
+		delete(q.tasks, key)
+	} else {
+		q.tasks[key] = tasks[1:]
+		q.keys = append(q.keys, key)
+	}
+	q.size--
+	return task, true
+}
+
 func convertWeightsFromDynamicConfig(
 	weightsFromDC map[string]interface{},
 ) (map[int]int, error) {
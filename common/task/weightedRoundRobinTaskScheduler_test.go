@@ -105,7 +105,7 @@ func (s *weightedRoundRobinTaskSchedulerSuite) TestSubmit_Success() {
 	s.NoError(err)
 
 	task := <-s.scheduler.taskChs[taskPriority]
-	s.Equal(mockTask, task)
+	s.Equal(mockTask, task.PriorityTask)
 	for _, taskCh := range s.scheduler.taskChs {
 		s.Empty(taskCh)
 	}
@@ -247,6 +247,56 @@ func (s *weightedRoundRobinTaskSchedulerSuite) TestDispatcher_FailToSubmit() {
 	<-doneCh
 }
 
+func (s *weightedRoundRobinTaskSchedulerSuite) TestDispatcher_Fairness() {
+	fairnessKeys := make(map[PriorityTask]string)
+	scheduler := s.newTestWeightedRoundRobinTaskScheduler(
+		&WeightedRoundRobinTaskSchedulerOptions{
+			Weights:     testSchedulerWeights,
+			QueueSize:   s.queueSize,
+			WorkerCount: 1,
+			RetryPolicy: backoff.NewExponentialRetryPolicy(time.Millisecond),
+			FairnessKeyFn: func(task PriorityTask) string {
+				return fairnessKeys[task]
+			},
+		},
+	)
+
+	// a burst of tasks with the same key followed by a single task with another key,
+	// the single task should not wait for the whole burst to be dispatched
+	taskKeys := []string{"a", "a", "a", "a", "a", "b"}
+	expectedKeys := []string{"a", "b", "a", "a", "a", "a"}
+
+	var taskWG sync.WaitGroup
+	var dispatchedKeys []string
+	mockFn := func(task Task) error {
+		dispatchedKeys = append(dispatchedKeys, fairnessKeys[task.(PriorityTask)])
+		taskWG.Done()
+		return nil
+	}
+	for _, key := range taskKeys {
+		mockTask := NewMockPriorityTask(s.controller)
+		mockTask.EXPECT().Priority().Return(0).AnyTimes()
+		fairnessKeys[mockTask] = key
+		s.NoError(scheduler.Submit(mockTask))
+		taskWG.Add(1)
+		s.mockProcessor.EXPECT().Submit(newMockPriorityTaskMatcher(mockTask)).DoAndReturn(mockFn)
+	}
+	scheduler.processor = s.mockProcessor
+
+	doneCh := make(chan struct{})
+	scheduler.dispatcherWG.Add(1)
+	go func() {
+		scheduler.dispatcher()
+		close(doneCh)
+	}()
+
+	taskWG.Wait()
+	close(scheduler.shutdownCh)
+
+	<-doneCh
+	s.Equal(expectedKeys, dispatchedKeys)
+}
+
 func (s *weightedRoundRobinTaskSchedulerSuite) newTestWeightedRoundRobinTaskScheduler(
 	options *WeightedRoundRobinTaskSchedulerOptions,
 ) *weightedRoundRobinTaskSchedulerImpl {
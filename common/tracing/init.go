@@ -0,0 +1,107 @@
+// The MIT License
+//
+// Copyright (c) 2020 Temporal Technologies Inc.  All rights reserved.
+//
+// Copyright (c) 2020 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+package tracing
+
+import (
+	"context"
+	"fmt"
+	"sync/atomic"
+
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/api/global"
+	"go.opentelemetry.io/otel/exporters/otlp"
+	"go.opentelemetry.io/otel/exporters/stdout"
+	"go.opentelemetry.io/otel/propagators"
+	exporttrace "go.opentelemetry.io/otel/sdk/export/trace"
+	"go.opentelemetry.io/otel/sdk/resource"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	"go.opentelemetry.io/otel/semconv"
+
+	"go.temporal.io/server/common/service/config"
+)
+
+const (
+	// ExporterOTLP sends the spans to an OpenTelemetry collector over gRPC
+	ExporterOTLP = "otlp"
+	// ExporterStdout prints the spans to stdout
+	ExporterStdout = "stdout"
+
+	defaultOTLPEndpoint = "localhost:55680"
+)
+
+var enabled int32
+
+// Init installs the tracer provider and the propagator described by the config.
+// The returned function flushes the pending spans and stops the exporter.
+func Init(cfg *config.Tracing, serviceName string) (func(), error) {
+	exporter, err := newExporter(cfg)
+	if err != nil {
+		return nil, err
+	}
+
+	sampleRatio := cfg.SampleRatio
+	if sampleRatio == 0 {
+		sampleRatio = 1
+	}
+	processor := sdktrace.NewBatchSpanProcessor(exporter)
+	provider := sdktrace.NewTracerProvider(
+		sdktrace.WithConfig(sdktrace.Config{DefaultSampler: sdktrace.ParentBased(sdktrace.TraceIDRatioBased(sampleRatio))}),
+		sdktrace.WithResource(resource.New(semconv.ServiceNameKey.String(serviceName))),
+		sdktrace.WithSpanProcessor(processor),
+	)
+	global.SetTracerProvider(provider)
+	global.SetTextMapPropagator(otel.NewCompositeTextMapPropagator(propagators.TraceContext{}, propagators.Baggage{}))
+	atomic.StoreInt32(&enabled, 1)
+
+	return func() {
+		atomic.StoreInt32(&enabled, 0)
+		// the processor flushes the queued spans before the exporter is stopped
+		processor.Shutdown()
+		_ = exporter.Shutdown(context.Background())
+	}, nil
+}
+
+// Enabled returns true if a tracer provider was installed by Init
+func Enabled() bool {
+	return atomic.LoadInt32(&enabled) == 1
+}
+
+func newExporter(cfg *config.Tracing) (exporttrace.SpanExporter, error) {
+	switch cfg.Exporter {
+	case ExporterStdout:
+		return stdout.NewExporter(stdout.WithoutMetricExport())
+	case ExporterOTLP:
+		endpoint := cfg.Endpoint
+		if endpoint == "" {
+			endpoint = defaultOTLPEndpoint
+		}
+		opts := []otlp.ExporterOption{otlp.WithAddress(endpoint)}
+		if cfg.Insecure {
+			opts = append(opts, otlp.WithInsecure())
+		}
+		return otlp.NewExporter(opts...)
+	default:
+		return nil, fmt.Errorf("unknown tracing exporter %q", cfg.Exporter)
+	}
+}
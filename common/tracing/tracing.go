@@ -0,0 +1,120 @@
+// The MIT License
+//
+// Copyright (c) 2020 Temporal Technologies Inc.  All rights reserved.
+//
+// Copyright (c) 2020 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package tracing
+
+import (
+	"context"
+
+	"go.opentelemetry.io/otel/api/global"
+	"go.opentelemetry.io/otel/api/trace"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/label"
+	commonpb "go.temporal.io/api/common/v1"
+)
+
+const (
+	instrumentationName = "go.temporal.io/server"
+)
+
+// Attribute keys of the spans
+const (
+	ShardIDKey     = label.Key("temporal.shard_id")
+	NamespaceKey   = label.Key("temporal.namespace")
+	NamespaceIDKey = label.Key("temporal.namespace_id")
+	WorkflowIDKey  = label.Key("temporal.workflow_id")
+	RunIDKey       = label.Key("temporal.run_id")
+	StoreKey       = label.Key("temporal.persistence.store")
+	RPCMethodKey   = label.Key("rpc.method")
+)
+
+type (
+	namespaceRequest interface {
+		GetNamespace() string
+	}
+
+	namespaceIDRequest interface {
+		GetNamespaceId() string
+	}
+
+	shardIDRequest interface {
+		GetShardId() int32
+	}
+
+	workflowExecutionRequest interface {
+		GetWorkflowExecution() *commonpb.WorkflowExecution
+	}
+
+	executionRequest interface {
+		GetExecution() *commonpb.WorkflowExecution
+	}
+)
+
+// Tracer returns the tracer of the server, which does not record
+// anything unless a tracer provider was installed by Init
+func Tracer() trace.Tracer {
+	return global.Tracer(instrumentationName)
+}
+
+// End records the error of the operation of the span, if any, and ends the span
+func End(span trace.Span, err error) {
+	if err != nil {
+		span.RecordError(context.Background(), err, trace.WithErrorStatus(codes.Error))
+	}
+	span.End()
+}
+
+// RequestAttributes returns the namespace, shard and workflow attributes of an RPC request
+func RequestAttributes(req interface{}) []label.KeyValue {
+	var attributes []label.KeyValue
+	if r, ok := req.(namespaceRequest); ok && r.GetNamespace() != "" {
+		attributes = append(attributes, NamespaceKey.String(r.GetNamespace()))
+	}
+	if r, ok := req.(namespaceIDRequest); ok && r.GetNamespaceId() != "" {
+		attributes = append(attributes, NamespaceIDKey.String(r.GetNamespaceId()))
+	}
+	if r, ok := req.(shardIDRequest); ok {
+		attributes = append(attributes, ShardIDKey.Int32(r.GetShardId()))
+	}
+
+	var execution *commonpb.WorkflowExecution
+	if r, ok := req.(workflowExecutionRequest); ok {
+		execution = r.GetWorkflowExecution()
+	} else if r, ok := req.(executionRequest); ok {
+		execution = r.GetExecution()
+	}
+	return append(attributes, WorkflowAttributes(execution.GetWorkflowId(), execution.GetRunId())...)
+}
+
+// WorkflowAttributes returns the attributes of the given workflow, leaving out the empty IDs
+func WorkflowAttributes(workflowID string, runID string) []label.KeyValue {
+	var attributes []label.KeyValue
+	if workflowID != "" {
+		attributes = append(attributes, WorkflowIDKey.String(workflowID))
+	}
+	if runID != "" {
+		attributes = append(attributes, RunIDKey.String(runID))
+	}
+	return attributes
+}
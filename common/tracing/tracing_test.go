@@ -0,0 +1,199 @@
+// The MIT License
+//
+// Copyright (c) 2020 Temporal Technologies Inc.  All rights reserved.
+//
+// Copyright (c) 2020 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+package tracing
+
+import (
+	"context"
+	"errors"
+	"sync/atomic"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/api/global"
+	"go.opentelemetry.io/otel/api/trace"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/label"
+	"go.opentelemetry.io/otel/propagators"
+	"go.opentelemetry.io/otel/sdk/export/trace/tracetest"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	commonpb "go.temporal.io/api/common/v1"
+	"go.temporal.io/api/workflowservice/v1"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/metadata"
+
+	"go.temporal.io/server/api/historyservice/v1"
+	"go.temporal.io/server/api/matchingservice/v1"
+	"go.temporal.io/server/common/service/config"
+)
+
+func TestRequestAttributes(t *testing.T) {
+	attributes := RequestAttributes(&workflowservice.GetWorkflowExecutionHistoryRequest{
+		Namespace: "test-namespace",
+		Execution: &commonpb.WorkflowExecution{WorkflowId: "test-workflow-id"},
+	})
+	assert.Equal(t, []label.KeyValue{
+		NamespaceKey.String("test-namespace"),
+		WorkflowIDKey.String("test-workflow-id"),
+	}, attributes)
+
+	attributes = RequestAttributes(&historyservice.CloseShardRequest{ShardId: 3})
+	assert.Equal(t, []label.KeyValue{ShardIDKey.Int32(3)}, attributes)
+
+	assert.Empty(t, RequestAttributes(&workflowservice.GetClusterInfoRequest{}))
+}
+
+func TestMetadataCarrier(t *testing.T) {
+	md := metadata.MD{}
+	carrier := metadataCarrier(md)
+	carrier.Set("Traceparent", "value")
+	assert.Equal(t, []string{"value"}, md.Get("traceparent"))
+	assert.Equal(t, "value", carrier.Get("traceparent"))
+	assert.Equal(t, "", carrier.Get("tracestate"))
+}
+
+func TestInitUnknownExporter(t *testing.T) {
+	_, err := Init(&config.Tracing{Exporter: "unknown"}, "test")
+	assert.Error(t, err)
+	assert.False(t, Enabled())
+}
+
+func TestUnaryInterceptors(t *testing.T) {
+	exporter := tracetest.NewInMemoryExporter()
+	previousProvider := global.TracerProvider()
+	previousPropagator := global.TextMapPropagator()
+	global.SetTracerProvider(sdktrace.NewTracerProvider(sdktrace.WithSyncer(exporter)))
+	global.SetTextMapPropagator(otel.NewCompositeTextMapPropagator(propagators.TraceContext{}, propagators.Baggage{}))
+	atomic.StoreInt32(&enabled, 1)
+	defer func() {
+		atomic.StoreInt32(&enabled, 0)
+		global.SetTracerProvider(previousProvider)
+		global.SetTextMapPropagator(previousPropagator)
+	}()
+
+	testCases := []struct {
+		name               string
+		method             string
+		request            interface{}
+		err                error
+		expectedAttributes []label.KeyValue
+	}{
+		{
+			name:   "frontend",
+			method: "/temporal.api.workflowservice.v1.WorkflowService/GetWorkflowExecutionHistory",
+			request: &workflowservice.GetWorkflowExecutionHistoryRequest{
+				Namespace: "test-namespace",
+				Execution: &commonpb.WorkflowExecution{WorkflowId: "test-workflow-id", RunId: "test-run-id"},
+			},
+			expectedAttributes: []label.KeyValue{
+				NamespaceKey.String("test-namespace"),
+				WorkflowIDKey.String("test-workflow-id"),
+				RunIDKey.String("test-run-id"),
+			},
+		},
+		{
+			name:    "history",
+			method:  "/temporal.server.api.historyservice.v1.HistoryService/CloseShard",
+			request: &historyservice.CloseShardRequest{ShardId: 3},
+			err:     errors.New("shard error"),
+			expectedAttributes: []label.KeyValue{
+				ShardIDKey.Int32(3),
+			},
+		},
+		{
+			name:   "matching",
+			method: "/temporal.server.api.matchingservice.v1.MatchingService/PollWorkflowTaskQueue",
+			request: &matchingservice.PollWorkflowTaskQueueRequest{
+				NamespaceId: "test-namespace-id",
+			},
+			expectedAttributes: []label.KeyValue{
+				NamespaceIDKey.String("test-namespace-id"),
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		exporter.Reset()
+
+		var serverSpanContext trace.SpanContext
+		invoker := func(ctx context.Context, method string, req, reply interface{}, cc *grpc.ClientConn, opts ...grpc.CallOption) error {
+			// hand the outgoing metadata of the client to the server, as the gRPC transport does
+			md, ok := metadata.FromOutgoingContext(ctx)
+			require.True(t, ok, tc.name)
+			serverCtx := metadata.NewIncomingContext(context.Background(), md)
+			_, err := UnaryServerInterceptor(serverCtx, req, &grpc.UnaryServerInfo{FullMethod: method},
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					serverSpanContext = trace.SpanFromContext(ctx).SpanContext()
+					return nil, tc.err
+				},
+			)
+			return err
+		}
+
+		err := UnaryClientInterceptor(context.Background(), tc.method, tc.request, nil, nil, invoker)
+		assert.Equal(t, tc.err, err, tc.name)
+
+		// the server span ends before the client span
+		spans := exporter.GetSpans()
+		require.Len(t, spans, 2, tc.name)
+		serverSpan, clientSpan := spans[0], spans[1]
+
+		assert.Equal(t, trace.SpanKindClient, clientSpan.SpanKind, tc.name)
+		assert.Equal(t, trace.SpanKindServer, serverSpan.SpanKind, tc.name)
+		assert.Equal(t, tc.method, clientSpan.Name, tc.name)
+		assert.Equal(t, tc.method, serverSpan.Name, tc.name)
+
+		// the server span is a child of the client span propagated in the metadata
+		assert.False(t, clientSpan.ParentSpanID.IsValid(), tc.name)
+		assert.Equal(t, clientSpan.SpanContext.TraceID, serverSpan.SpanContext.TraceID, tc.name)
+		assert.Equal(t, clientSpan.SpanContext.SpanID, serverSpan.ParentSpanID, tc.name)
+		assert.True(t, serverSpan.HasRemoteParent, tc.name)
+		assert.Equal(t, serverSpan.SpanContext.SpanID, serverSpanContext.SpanID, tc.name)
+
+		expectedAttributes := append([]label.KeyValue{RPCMethodKey.String(tc.method)}, tc.expectedAttributes...)
+		assert.ElementsMatch(t, expectedAttributes, clientSpan.Attributes, tc.name)
+		assert.ElementsMatch(t, expectedAttributes, serverSpan.Attributes, tc.name)
+
+		expectedStatus := codes.Unset
+		if tc.err != nil {
+			expectedStatus = codes.Error
+		}
+		assert.Equal(t, expectedStatus, clientSpan.StatusCode, tc.name)
+		assert.Equal(t, expectedStatus, serverSpan.StatusCode, tc.name)
+	}
+}
+
+func TestUnaryInterceptorsDisabled(t *testing.T) {
+	invoked := false
+	invoker := func(ctx context.Context, method string, req, reply interface{}, cc *grpc.ClientConn, opts ...grpc.CallOption) error {
+		invoked = true
+		_, ok := metadata.FromOutgoingContext(ctx)
+		assert.False(t, ok)
+		return nil
+	}
+
+	assert.NoError(t, UnaryClientInterceptor(context.Background(), "method", &historyservice.CloseShardRequest{}, nil, nil, invoker))
+	assert.True(t, invoked)
+}
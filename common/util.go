@@ -228,7 +228,8 @@ func CreateReplicationServiceBusyRetryPolicy() backoff.RetryPolicy {
 func IsPersistenceTransientError(err error) bool {
 	switch err.(type) {
 	case *serviceerror.Internal,
-		*serviceerror.ResourceExhausted:
+		*serviceerror.ResourceExhausted,
+		*serviceerror.Unavailable:
 		return true
 	}
 
@@ -351,6 +352,9 @@ func CreateMatchingPollWorkflowTaskQueueResponse(historyResponse *historyservice
 		ScheduledTime:              historyResponse.ScheduledTime,
 		StartedTime:                historyResponse.StartedTime,
 		Queries:                    historyResponse.Queries,
+		HistorySizeBytes:           historyResponse.HistorySizeBytes,
+		SuggestContinueAsNew:       historyResponse.SuggestContinueAsNew,
+		Updates:                    historyResponse.Updates,
 	}
 
 	return matchingResp
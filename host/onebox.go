@@ -99,6 +99,7 @@ type (
 		visibilityMgr                    persistence.VisibilityManager
 		executionMgrFactory              persistence.ExecutionManagerFactory
 		namespaceReplicationQueue        persistence.NamespaceReplicationQueue
+		abstractDataStoreFactory         persistenceClient.AbstractDataStoreFactory
 		shutdownCh                       chan struct{}
 		shutdownWG                       sync.WaitGroup
 		clusterNo                        int // cluster number
@@ -135,6 +136,7 @@ type (
 		TaskMgr                          persistence.TaskManager
 		VisibilityMgr                    persistence.VisibilityManager
 		NamespaceReplicationQueue        persistence.NamespaceReplicationQueue
+		AbstractDataStoreFactory         persistenceClient.AbstractDataStoreFactory
 		Logger                           log.Logger
 		ClusterNo                        int
 		ArchiverMetadata                 carchiver.ArchivalMetadata
@@ -168,6 +170,7 @@ func NewTemporal(params *TemporalParams) Temporal {
 		taskMgr:                          params.TaskMgr,
 		executionMgrFactory:              params.ExecutionMgrFactory,
 		namespaceReplicationQueue:        params.NamespaceReplicationQueue,
+		abstractDataStoreFactory:         params.AbstractDataStoreFactory,
 		shutdownCh:                       make(chan struct{}),
 		clusterNo:                        params.ClusterNo,
 		esConfig:                         params.ESConfig,
@@ -389,12 +392,14 @@ func (c *temporalImpl) startFrontend(hosts map[string][]string, startWG *sync.Wa
 	params.ESConfig = c.esConfig
 	params.ESClient = c.esClient
 	params.Authorizer = authorization.NewNopAuthorizer()
+	params.ClaimMapper = authorization.NewNopClaimMapper()
 
 	var err error
 	params.PersistenceConfig, err = copyPersistenceConfig(c.persistenceConfig)
 	if err != nil {
 		c.logger.Fatal("Failed to copy persistence config for frontend", tag.Error(err))
 	}
+	params.AbstractDatastoreFactory = c.abstractDataStoreFactory
 
 	if c.esConfig != nil {
 		esDataStoreName := "es-visibility"
@@ -473,6 +478,7 @@ func (c *temporalImpl) startHistory(
 		if err != nil {
 			c.logger.Fatal("Failed to copy persistence config for history", tag.Error(err))
 		}
+		params.AbstractDatastoreFactory = c.abstractDataStoreFactory
 
 		if c.esConfig != nil {
 			esDataStoreName := "es-visibility"
@@ -538,6 +544,7 @@ func (c *temporalImpl) startMatching(hosts map[string][]string, startWG *sync.Wa
 	if err != nil {
 		c.logger.Fatal("Failed to copy persistence config for matching", tag.Error(err))
 	}
+	params.AbstractDatastoreFactory = c.abstractDataStoreFactory
 
 	matchingService, err := matching.NewService(params)
 	if err != nil {
@@ -580,6 +587,7 @@ func (c *temporalImpl) startWorker(hosts map[string][]string, startWG *sync.Wait
 	if err != nil {
 		c.logger.Fatal("Failed to copy persistence config for worker", tag.Error(err))
 	}
+	params.AbstractDatastoreFactory = c.abstractDataStoreFactory
 
 	params.PublicClient, err = sdkclient.NewClient(sdkclient.Options{
 		HostPort:     c.FrontendGRPCAddress(),
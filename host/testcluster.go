@@ -145,6 +145,8 @@ func NewCluster(options *TestClusterConfig, logger log.Logger) (*TestCluster, er
 		options.Persistence.SchemaDir = ops.SchemaDir
 	case config.StoreTypeNoSQL:
 		// noop for now
+	case config.StoreTypeMemory:
+		// in-memory tables need no connection settings
 	default:
 		panic(fmt.Sprintf("unknown store type: %v", options.Persistence.StoreType))
 	}
@@ -194,6 +196,7 @@ func NewCluster(options *TestClusterConfig, logger log.Logger) (*TestCluster, er
 		HistoryV2Mgr:                     testBase.HistoryV2Mgr,
 		ExecutionMgrFactory:              testBase.ExecutionMgrFactory,
 		NamespaceReplicationQueue:        testBase.NamespaceReplicationQueue,
+		AbstractDataStoreFactory:         testBase.AbstractDataStoreFactory,
 		TaskMgr:                          testBase.TaskMgr,
 		VisibilityMgr:                    visibilityMgr,
 		Logger:                           logger,
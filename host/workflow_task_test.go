@@ -35,8 +35,11 @@ import (
 	"go.temporal.io/api/serviceerror"
 	taskqueuepb "go.temporal.io/api/taskqueue/v1"
 	"go.temporal.io/api/workflowservice/v1"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/metadata"
 
 	"go.temporal.io/server/common/codec"
+	"go.temporal.io/server/common/headers"
 	"go.temporal.io/server/common/payloads"
 	"go.temporal.io/server/common/primitives/timestamp"
 )
@@ -292,6 +295,77 @@ func (s *integrationSuite) TestWorkflowTaskHeartbeatingWithLocalActivitiesResult
 	s.assertHistory(we, expectedHistory)
 }
 
+func (s *integrationSuite) TestWorkflowTaskHistoryStatsHeaders() {
+	id := uuid.New()
+	wt := "integration-workflow-workflow-task-history-stats-headers"
+	tl := id
+	identity := "worker1"
+
+	taskQueue := &taskqueuepb.TaskQueue{
+		Name: tl,
+		Kind: enumspb.TASK_QUEUE_KIND_NORMAL,
+	}
+	stikyTaskQueue := &taskqueuepb.TaskQueue{
+		Name: "test-sticky-taskqueue",
+		Kind: enumspb.TASK_QUEUE_KIND_STICKY,
+	}
+
+	request := &workflowservice.StartWorkflowExecutionRequest{
+		RequestId:           uuid.New(),
+		Namespace:           s.namespace,
+		WorkflowId:          id,
+		WorkflowType:        &commonpb.WorkflowType{Name: wt},
+		TaskQueue:           taskQueue,
+		Input:               nil,
+		WorkflowRunTimeout:  timestamp.DurationPtr(20 * time.Second),
+		WorkflowTaskTimeout: timestamp.DurationPtr(3 * time.Second),
+		Identity:            identity,
+	}
+
+	_, err0 := s.engine.StartWorkflowExecution(NewContext(), request)
+	s.NoError(err0)
+
+	assertHistoryStats := func(header metadata.MD, startedEventID int64) {
+		historySizeBytes, err := strconv.ParseInt(s.firstHeaderValue(header, headers.HistorySizeBytesHeaderName), 10, 64)
+		s.NoError(err)
+		s.Greater(historySizeBytes, int64(0))
+		s.Equal(strconv.FormatInt(startedEventID, 10), s.firstHeaderValue(header, headers.HistoryLengthHeaderName))
+		s.Equal("false", s.firstHeaderValue(header, headers.SuggestContinueAsNewHeaderName))
+	}
+
+	// workflow task handed out by the poll
+	var pollHeader metadata.MD
+	resp1, err1 := s.engine.PollWorkflowTaskQueue(NewContext(), &workflowservice.PollWorkflowTaskQueueRequest{
+		Namespace: s.namespace,
+		TaskQueue: taskQueue,
+		Identity:  identity,
+	}, grpc.Header(&pollHeader))
+	s.NoError(err1)
+	assertHistoryStats(pollHeader, resp1.GetStartedEventId())
+
+	// workflow task handed out by the completion of the previous one
+	var completeHeader metadata.MD
+	resp2, err2 := s.engine.RespondWorkflowTaskCompleted(NewContext(), &workflowservice.RespondWorkflowTaskCompletedRequest{
+		TaskToken: resp1.GetTaskToken(),
+		Commands:  []*commandpb.Command{},
+		StickyAttributes: &taskqueuepb.StickyExecutionAttributes{
+			WorkerTaskQueue:        stikyTaskQueue,
+			ScheduleToStartTimeout: timestamp.DurationPtr(5 * time.Second),
+		},
+		ReturnNewWorkflowTask:      true,
+		ForceCreateNewWorkflowTask: true,
+	}, grpc.Header(&completeHeader))
+	s.NoError(err2)
+	s.NotNil(resp2.WorkflowTask)
+	assertHistoryStats(completeHeader, resp2.WorkflowTask.GetStartedEventId())
+}
+
+func (s *integrationSuite) firstHeaderValue(header metadata.MD, name string) string {
+	values := header.Get(name)
+	s.Len(values, 1)
+	return values[0]
+}
+
 func (s *integrationSuite) TestWorkflowTerminationSignalBeforeRegularWorkflowTaskStarted() {
 	id := uuid.New()
 	wt := "integration-workflow-transient-workflow-task-test-type"
@@ -26,34 +26,75 @@ package frontend
 
 import (
 	"context"
+	"strings"
 
+	commonpb "go.temporal.io/api/common/v1"
+	taskqueuepb "go.temporal.io/api/taskqueue/v1"
 	"go.temporal.io/api/workflowservice/v1"
 	healthpb "google.golang.org/grpc/health/grpc_health_v1"
 
+	"go.temporal.io/server/common/audit"
 	"go.temporal.io/server/common/authorization"
+	"go.temporal.io/server/common/headers"
 	"go.temporal.io/server/common/metrics"
 	"go.temporal.io/server/common/resource"
+	"go.temporal.io/server/service/worker/batcher"
+	"go.temporal.io/server/service/worker/scheduler"
 )
 
 // TODO(vancexu): add metrics
 
+// getters of the requests targeting workflows and task queues
+type (
+	workflowIDGetter interface {
+		GetWorkflowId() string
+	}
+	executionGetter interface {
+		GetExecution() *commonpb.WorkflowExecution
+	}
+	workflowExecutionGetter interface {
+		GetWorkflowExecution() *commonpb.WorkflowExecution
+	}
+	workflowTypeGetter interface {
+		GetWorkflowType() *commonpb.WorkflowType
+	}
+	taskQueueGetter interface {
+		GetTaskQueue() *taskqueuepb.TaskQueue
+	}
+)
+
 // AccessControlledWorkflowHandler frontend handler wrapper for authentication and authorization
 type AccessControlledWorkflowHandler struct {
 	frontendHandler Handler
 	authorizer      authorization.Authorizer
+	claimMapper     authorization.ClaimMapper
+	auditLogger     audit.Logger
 }
 
 var _ Handler = (*AccessControlledWorkflowHandler)(nil)
 
 // NewAccessControlledHandlerImpl creates frontend handler with authentication support
-func NewAccessControlledHandlerImpl(wfHandler Handler, authorizer authorization.Authorizer) *AccessControlledWorkflowHandler {
+func NewAccessControlledHandlerImpl(
+	wfHandler Handler,
+	authorizer authorization.Authorizer,
+	claimMapper authorization.ClaimMapper,
+	auditLogger audit.Logger,
+) *AccessControlledWorkflowHandler {
 	if authorizer == nil {
 		authorizer = authorization.NewNopAuthorizer()
 	}
+	if claimMapper == nil {
+		claimMapper = authorization.NewNopClaimMapper()
+	}
+	if auditLogger == nil {
+		auditLogger = audit.NewNopLogger()
+	}
 
 	return &AccessControlledWorkflowHandler{
 		frontendHandler: wfHandler,
 		authorizer:      authorizer,
+		claimMapper:     claimMapper,
+		auditLogger:     auditLogger,
 	}
 }
 
@@ -103,6 +144,7 @@ func (a *AccessControlledWorkflowHandler) CountWorkflowExecutions(
 	attr := &authorization.Attributes{
 		APIName:   "CountWorkflowExecutions",
 		Namespace: request.GetNamespace(),
+		Request:   request,
 	}
 	isAuthorized, err := a.isAuthorized(ctx, attr, scope)
 	if err != nil {
@@ -126,6 +168,7 @@ func (a *AccessControlledWorkflowHandler) DeprecateNamespace(
 	attr := &authorization.Attributes{
 		APIName:   "DeprecateNamespace",
 		Namespace: request.GetName(),
+		Request:   request,
 	}
 	isAuthorized, err := a.isAuthorized(ctx, attr, scope)
 	if err != nil {
@@ -149,6 +192,7 @@ func (a *AccessControlledWorkflowHandler) DescribeNamespace(
 	attr := &authorization.Attributes{
 		APIName:   "DescribeNamespace",
 		Namespace: request.GetName(),
+		Request:   request,
 	}
 	isAuthorized, err := a.isAuthorized(ctx, attr, scope)
 	if err != nil {
@@ -172,6 +216,7 @@ func (a *AccessControlledWorkflowHandler) DescribeTaskQueue(
 	attr := &authorization.Attributes{
 		APIName:   "DescribeTaskQueue",
 		Namespace: request.GetNamespace(),
+		Request:   request,
 	}
 	isAuthorized, err := a.isAuthorized(ctx, attr, scope)
 	if err != nil {
@@ -195,6 +240,7 @@ func (a *AccessControlledWorkflowHandler) DescribeWorkflowExecution(
 	attr := &authorization.Attributes{
 		APIName:   "DescribeWorkflowExecution",
 		Namespace: request.GetNamespace(),
+		Request:   request,
 	}
 	isAuthorized, err := a.isAuthorized(ctx, attr, scope)
 	if err != nil {
@@ -226,6 +272,7 @@ func (a *AccessControlledWorkflowHandler) GetWorkflowExecutionHistory(
 	attr := &authorization.Attributes{
 		APIName:   "GetWorkflowExecutionHistory",
 		Namespace: request.GetNamespace(),
+		Request:   request,
 	}
 	isAuthorized, err := a.isAuthorized(ctx, attr, scope)
 	if err != nil {
@@ -249,6 +296,7 @@ func (a *AccessControlledWorkflowHandler) ListArchivedWorkflowExecutions(
 	attr := &authorization.Attributes{
 		APIName:   "ListArchivedWorkflowExecutions",
 		Namespace: request.GetNamespace(),
+		Request:   request,
 	}
 	isAuthorized, err := a.isAuthorized(ctx, attr, scope)
 	if err != nil {
@@ -272,6 +320,7 @@ func (a *AccessControlledWorkflowHandler) ListClosedWorkflowExecutions(
 	attr := &authorization.Attributes{
 		APIName:   "ListClosedWorkflowExecutions",
 		Namespace: request.GetNamespace(),
+		Request:   request,
 	}
 	isAuthorized, err := a.isAuthorized(ctx, attr, scope)
 	if err != nil {
@@ -294,6 +343,7 @@ func (a *AccessControlledWorkflowHandler) ListNamespaces(
 
 	attr := &authorization.Attributes{
 		APIName: "ListNamespaces",
+		Request: request,
 	}
 	isAuthorized, err := a.isAuthorized(ctx, attr, scope)
 	if err != nil {
@@ -317,6 +367,7 @@ func (a *AccessControlledWorkflowHandler) ListOpenWorkflowExecutions(
 	attr := &authorization.Attributes{
 		APIName:   "ListOpenWorkflowExecutions",
 		Namespace: request.GetNamespace(),
+		Request:   request,
 	}
 	isAuthorized, err := a.isAuthorized(ctx, attr, scope)
 	if err != nil {
@@ -340,6 +391,7 @@ func (a *AccessControlledWorkflowHandler) ListWorkflowExecutions(
 	attr := &authorization.Attributes{
 		APIName:   "ListWorkflowExecutions",
 		Namespace: request.GetNamespace(),
+		Request:   request,
 	}
 	isAuthorized, err := a.isAuthorized(ctx, attr, scope)
 	if err != nil {
@@ -363,6 +415,7 @@ func (a *AccessControlledWorkflowHandler) PollActivityTaskQueue(
 	attr := &authorization.Attributes{
 		APIName:   "PollActivityTaskQueue",
 		Namespace: request.GetNamespace(),
+		Request:   request,
 	}
 	isAuthorized, err := a.isAuthorized(ctx, attr, scope)
 	if err != nil {
@@ -386,6 +439,7 @@ func (a *AccessControlledWorkflowHandler) PollWorkflowTaskQueue(
 	attr := &authorization.Attributes{
 		APIName:   "PollWorkflowTaskQueue",
 		Namespace: request.GetNamespace(),
+		Request:   request,
 	}
 	isAuthorized, err := a.isAuthorized(ctx, attr, scope)
 	if err != nil {
@@ -409,6 +463,7 @@ func (a *AccessControlledWorkflowHandler) QueryWorkflow(
 	attr := &authorization.Attributes{
 		APIName:   "QueryWorkflow",
 		Namespace: request.GetNamespace(),
+		Request:   request,
 	}
 	isAuthorized, err := a.isAuthorized(ctx, attr, scope)
 	if err != nil {
@@ -457,6 +512,7 @@ func (a *AccessControlledWorkflowHandler) RegisterNamespace(
 	attr := &authorization.Attributes{
 		APIName:   "RegisterNamespace",
 		Namespace: request.GetName(),
+		Request:   request,
 	}
 	isAuthorized, err := a.isAuthorized(ctx, attr, scope)
 	if err != nil {
@@ -480,6 +536,7 @@ func (a *AccessControlledWorkflowHandler) RequestCancelWorkflowExecution(
 	attr := &authorization.Attributes{
 		APIName:   "RequestCancelWorkflowExecution",
 		Namespace: request.GetNamespace(),
+		Request:   request,
 	}
 	isAuthorized, err := a.isAuthorized(ctx, attr, scope)
 	if err != nil {
@@ -503,6 +560,7 @@ func (a *AccessControlledWorkflowHandler) ResetStickyTaskQueue(
 	attr := &authorization.Attributes{
 		APIName:   "ResetStickyTaskQueue",
 		Namespace: request.GetNamespace(),
+		Request:   request,
 	}
 	isAuthorized, err := a.isAuthorized(ctx, attr, scope)
 	if err != nil {
@@ -526,6 +584,7 @@ func (a *AccessControlledWorkflowHandler) ResetWorkflowExecution(
 	attr := &authorization.Attributes{
 		APIName:   "ResetWorkflowExecution",
 		Namespace: request.GetNamespace(),
+		Request:   request,
 	}
 	isAuthorized, err := a.isAuthorized(ctx, attr, scope)
 	if err != nil {
@@ -621,6 +680,7 @@ func (a *AccessControlledWorkflowHandler) ScanWorkflowExecutions(
 	attr := &authorization.Attributes{
 		APIName:   "ScanWorkflowExecutions",
 		Namespace: request.GetNamespace(),
+		Request:   request,
 	}
 	isAuthorized, err := a.isAuthorized(ctx, attr, scope)
 	if err != nil {
@@ -644,6 +704,7 @@ func (a *AccessControlledWorkflowHandler) SignalWithStartWorkflowExecution(
 	attr := &authorization.Attributes{
 		APIName:   "SignalWithStartWorkflowExecution",
 		Namespace: request.GetNamespace(),
+		Request:   request,
 	}
 	isAuthorized, err := a.isAuthorized(ctx, attr, scope)
 	if err != nil {
@@ -667,6 +728,7 @@ func (a *AccessControlledWorkflowHandler) SignalWorkflowExecution(
 	attr := &authorization.Attributes{
 		APIName:   "SignalWorkflowExecution",
 		Namespace: request.GetNamespace(),
+		Request:   request,
 	}
 	isAuthorized, err := a.isAuthorized(ctx, attr, scope)
 	if err != nil {
@@ -690,6 +752,7 @@ func (a *AccessControlledWorkflowHandler) StartWorkflowExecution(
 	attr := &authorization.Attributes{
 		APIName:   "StartWorkflowExecution",
 		Namespace: request.GetNamespace(),
+		Request:   request,
 	}
 	isAuthorized, err := a.isAuthorized(ctx, attr, scope)
 	if err != nil {
@@ -713,6 +776,7 @@ func (a *AccessControlledWorkflowHandler) TerminateWorkflowExecution(
 	attr := &authorization.Attributes{
 		APIName:   "TerminateWorkflowExecution",
 		Namespace: request.GetNamespace(),
+		Request:   request,
 	}
 	isAuthorized, err := a.isAuthorized(ctx, attr, scope)
 	if err != nil {
@@ -736,6 +800,7 @@ func (a *AccessControlledWorkflowHandler) ListTaskQueuePartitions(
 	attr := &authorization.Attributes{
 		APIName:   "ListTaskQueuePartitions",
 		Namespace: request.GetNamespace(),
+		Request:   request,
 	}
 	isAuthorized, err := a.isAuthorized(ctx, attr, scope)
 	if err != nil {
@@ -759,6 +824,7 @@ func (a *AccessControlledWorkflowHandler) UpdateNamespace(
 	attr := &authorization.Attributes{
 		APIName:   "UpdateNamespace",
 		Namespace: request.GetName(),
+		Request:   request,
 	}
 	isAuthorized, err := a.isAuthorized(ctx, attr, scope)
 	if err != nil {
@@ -771,6 +837,438 @@ func (a *AccessControlledWorkflowHandler) UpdateNamespace(
 	return a.frontendHandler.UpdateNamespace(ctx, request)
 }
 
+// CreateSchedule API call
+func (a *AccessControlledWorkflowHandler) CreateSchedule(
+	ctx context.Context,
+	request *scheduler.CreateScheduleRequest,
+) (*scheduler.CreateScheduleResponse, error) {
+
+	scope := a.getMetricsScopeWithNamespace(metrics.FrontendCreateScheduleScope, request.GetNamespace())
+
+	attr := &authorization.Attributes{
+		APIName:   "CreateSchedule",
+		Namespace: request.GetNamespace(),
+		Request:   request,
+	}
+	isAuthorized, err := a.isAuthorized(ctx, attr, scope)
+	if err != nil {
+		return nil, err
+	}
+	if !isAuthorized {
+		return nil, errUnauthorized
+	}
+
+	return a.frontendHandler.CreateSchedule(ctx, request)
+}
+
+// DescribeSchedule API call
+func (a *AccessControlledWorkflowHandler) DescribeSchedule(
+	ctx context.Context,
+	request *scheduler.DescribeScheduleRequest,
+) (*scheduler.DescribeScheduleResponse, error) {
+
+	scope := a.getMetricsScopeWithNamespace(metrics.FrontendDescribeScheduleScope, request.GetNamespace())
+
+	attr := &authorization.Attributes{
+		APIName:   "DescribeSchedule",
+		Namespace: request.GetNamespace(),
+		Request:   request,
+	}
+	isAuthorized, err := a.isAuthorized(ctx, attr, scope)
+	if err != nil {
+		return nil, err
+	}
+	if !isAuthorized {
+		return nil, errUnauthorized
+	}
+
+	return a.frontendHandler.DescribeSchedule(ctx, request)
+}
+
+// UpdateSchedule API call
+func (a *AccessControlledWorkflowHandler) UpdateSchedule(
+	ctx context.Context,
+	request *scheduler.UpdateScheduleRequest,
+) (*scheduler.UpdateScheduleResponse, error) {
+
+	scope := a.getMetricsScopeWithNamespace(metrics.FrontendUpdateScheduleScope, request.GetNamespace())
+
+	attr := &authorization.Attributes{
+		APIName:   "UpdateSchedule",
+		Namespace: request.GetNamespace(),
+		Request:   request,
+	}
+	isAuthorized, err := a.isAuthorized(ctx, attr, scope)
+	if err != nil {
+		return nil, err
+	}
+	if !isAuthorized {
+		return nil, errUnauthorized
+	}
+
+	return a.frontendHandler.UpdateSchedule(ctx, request)
+}
+
+// PauseSchedule API call
+func (a *AccessControlledWorkflowHandler) PauseSchedule(
+	ctx context.Context,
+	request *scheduler.PauseScheduleRequest,
+) (*scheduler.PauseScheduleResponse, error) {
+
+	scope := a.getMetricsScopeWithNamespace(metrics.FrontendPauseScheduleScope, request.GetNamespace())
+
+	attr := &authorization.Attributes{
+		APIName:   "PauseSchedule",
+		Namespace: request.GetNamespace(),
+		Request:   request,
+	}
+	isAuthorized, err := a.isAuthorized(ctx, attr, scope)
+	if err != nil {
+		return nil, err
+	}
+	if !isAuthorized {
+		return nil, errUnauthorized
+	}
+
+	return a.frontendHandler.PauseSchedule(ctx, request)
+}
+
+// UnpauseSchedule API call
+func (a *AccessControlledWorkflowHandler) UnpauseSchedule(
+	ctx context.Context,
+	request *scheduler.UnpauseScheduleRequest,
+) (*scheduler.UnpauseScheduleResponse, error) {
+
+	scope := a.getMetricsScopeWithNamespace(metrics.FrontendUnpauseScheduleScope, request.GetNamespace())
+
+	attr := &authorization.Attributes{
+		APIName:   "UnpauseSchedule",
+		Namespace: request.GetNamespace(),
+		Request:   request,
+	}
+	isAuthorized, err := a.isAuthorized(ctx, attr, scope)
+	if err != nil {
+		return nil, err
+	}
+	if !isAuthorized {
+		return nil, errUnauthorized
+	}
+
+	return a.frontendHandler.UnpauseSchedule(ctx, request)
+}
+
+// TriggerSchedule API call
+func (a *AccessControlledWorkflowHandler) TriggerSchedule(
+	ctx context.Context,
+	request *scheduler.TriggerScheduleRequest,
+) (*scheduler.TriggerScheduleResponse, error) {
+
+	scope := a.getMetricsScopeWithNamespace(metrics.FrontendTriggerScheduleScope, request.GetNamespace())
+
+	attr := &authorization.Attributes{
+		APIName:   "TriggerSchedule",
+		Namespace: request.GetNamespace(),
+		Request:   request,
+	}
+	isAuthorized, err := a.isAuthorized(ctx, attr, scope)
+	if err != nil {
+		return nil, err
+	}
+	if !isAuthorized {
+		return nil, errUnauthorized
+	}
+
+	return a.frontendHandler.TriggerSchedule(ctx, request)
+}
+
+// DeleteSchedule API call
+func (a *AccessControlledWorkflowHandler) DeleteSchedule(
+	ctx context.Context,
+	request *scheduler.DeleteScheduleRequest,
+) (*scheduler.DeleteScheduleResponse, error) {
+
+	scope := a.getMetricsScopeWithNamespace(metrics.FrontendDeleteScheduleScope, request.GetNamespace())
+
+	attr := &authorization.Attributes{
+		APIName:   "DeleteSchedule",
+		Namespace: request.GetNamespace(),
+		Request:   request,
+	}
+	isAuthorized, err := a.isAuthorized(ctx, attr, scope)
+	if err != nil {
+		return nil, err
+	}
+	if !isAuthorized {
+		return nil, errUnauthorized
+	}
+
+	return a.frontendHandler.DeleteSchedule(ctx, request)
+}
+
+// ListSchedules API call
+func (a *AccessControlledWorkflowHandler) ListSchedules(
+	ctx context.Context,
+	request *scheduler.ListSchedulesRequest,
+) (*scheduler.ListSchedulesResponse, error) {
+
+	scope := a.getMetricsScopeWithNamespace(metrics.FrontendListSchedulesScope, request.GetNamespace())
+
+	attr := &authorization.Attributes{
+		APIName:   "ListSchedules",
+		Namespace: request.GetNamespace(),
+		Request:   request,
+	}
+	isAuthorized, err := a.isAuthorized(ctx, attr, scope)
+	if err != nil {
+		return nil, err
+	}
+	if !isAuthorized {
+		return nil, errUnauthorized
+	}
+
+	return a.frontendHandler.ListSchedules(ctx, request)
+}
+
+// DeleteWorkflowExecution API call
+func (a *AccessControlledWorkflowHandler) DeleteWorkflowExecution(
+	ctx context.Context,
+	request *DeleteWorkflowExecutionRequest,
+) (*DeleteWorkflowExecutionResponse, error) {
+
+	scope := a.getMetricsScopeWithNamespace(metrics.FrontendDeleteWorkflowExecutionScope, request.GetNamespace())
+
+	attr := &authorization.Attributes{
+		APIName:   "DeleteWorkflowExecution",
+		Namespace: request.GetNamespace(),
+		Request:   request,
+	}
+	isAuthorized, err := a.isAuthorized(ctx, attr, scope)
+	if err != nil {
+		return nil, err
+	}
+	if !isAuthorized {
+		return nil, errUnauthorized
+	}
+
+	return a.frontendHandler.DeleteWorkflowExecution(ctx, request)
+}
+
+// UpdateWorkflowExecution API call
+func (a *AccessControlledWorkflowHandler) UpdateWorkflowExecution(
+	ctx context.Context,
+	request *UpdateWorkflowExecutionRequest,
+) (*UpdateWorkflowExecutionResponse, error) {
+
+	scope := a.getMetricsScopeWithNamespace(metrics.FrontendUpdateWorkflowExecutionScope, request.GetNamespace())
+
+	attr := &authorization.Attributes{
+		APIName:   "UpdateWorkflowExecution",
+		Namespace: request.GetNamespace(),
+		Request:   request,
+	}
+	isAuthorized, err := a.isAuthorized(ctx, attr, scope)
+	if err != nil {
+		return nil, err
+	}
+	if !isAuthorized {
+		return nil, errUnauthorized
+	}
+
+	return a.frontendHandler.UpdateWorkflowExecution(ctx, request)
+}
+
+// GetWorkflowExecutionResult API call
+func (a *AccessControlledWorkflowHandler) GetWorkflowExecutionResult(
+	ctx context.Context,
+	request *GetWorkflowExecutionResultRequest,
+) (*GetWorkflowExecutionResultResponse, error) {
+
+	scope := a.getMetricsScopeWithNamespace(metrics.FrontendGetWorkflowExecutionResultScope, request.GetNamespace())
+
+	attr := &authorization.Attributes{
+		APIName:   "GetWorkflowExecutionResult",
+		Namespace: request.GetNamespace(),
+		Request:   request,
+	}
+	isAuthorized, err := a.isAuthorized(ctx, attr, scope)
+	if err != nil {
+		return nil, err
+	}
+	if !isAuthorized {
+		return nil, errUnauthorized
+	}
+
+	return a.frontendHandler.GetWorkflowExecutionResult(ctx, request)
+}
+
+// ResetWorkflowExecutionWithOptions API call
+func (a *AccessControlledWorkflowHandler) ResetWorkflowExecutionWithOptions(
+	ctx context.Context,
+	request *ResetWorkflowExecutionWithOptionsRequest,
+) (*ResetWorkflowExecutionWithOptionsResponse, error) {
+
+	scope := a.getMetricsScopeWithNamespace(metrics.FrontendResetWorkflowExecutionWithOptionsScope, request.GetNamespace())
+
+	attr := &authorization.Attributes{
+		APIName:   "ResetWorkflowExecutionWithOptions",
+		Namespace: request.GetNamespace(),
+		Request:   request,
+	}
+	isAuthorized, err := a.isAuthorized(ctx, attr, scope)
+	if err != nil {
+		return nil, err
+	}
+	if !isAuthorized {
+		return nil, errUnauthorized
+	}
+
+	return a.frontendHandler.ResetWorkflowExecutionWithOptions(ctx, request)
+}
+
+// PauseWorkflowExecution API call
+func (a *AccessControlledWorkflowHandler) PauseWorkflowExecution(
+	ctx context.Context,
+	request *PauseWorkflowExecutionRequest,
+) (*PauseWorkflowExecutionResponse, error) {
+
+	scope := a.getMetricsScopeWithNamespace(metrics.FrontendPauseWorkflowExecutionScope, request.GetNamespace())
+
+	attr := &authorization.Attributes{
+		APIName:   "PauseWorkflowExecution",
+		Namespace: request.GetNamespace(),
+		Request:   request,
+	}
+	isAuthorized, err := a.isAuthorized(ctx, attr, scope)
+	if err != nil {
+		return nil, err
+	}
+	if !isAuthorized {
+		return nil, errUnauthorized
+	}
+
+	return a.frontendHandler.PauseWorkflowExecution(ctx, request)
+}
+
+// UnpauseWorkflowExecution API call
+func (a *AccessControlledWorkflowHandler) UnpauseWorkflowExecution(
+	ctx context.Context,
+	request *UnpauseWorkflowExecutionRequest,
+) (*UnpauseWorkflowExecutionResponse, error) {
+
+	scope := a.getMetricsScopeWithNamespace(metrics.FrontendUnpauseWorkflowExecutionScope, request.GetNamespace())
+
+	attr := &authorization.Attributes{
+		APIName:   "UnpauseWorkflowExecution",
+		Namespace: request.GetNamespace(),
+		Request:   request,
+	}
+	isAuthorized, err := a.isAuthorized(ctx, attr, scope)
+	if err != nil {
+		return nil, err
+	}
+	if !isAuthorized {
+		return nil, errUnauthorized
+	}
+
+	return a.frontendHandler.UnpauseWorkflowExecution(ctx, request)
+}
+
+// StartBatchOperation API call
+func (a *AccessControlledWorkflowHandler) StartBatchOperation(
+	ctx context.Context,
+	request *batcher.StartBatchOperationRequest,
+) (*batcher.StartBatchOperationResponse, error) {
+
+	scope := a.getMetricsScopeWithNamespace(metrics.FrontendStartBatchOperationScope, request.GetNamespace())
+
+	attr := &authorization.Attributes{
+		APIName:   "StartBatchOperation",
+		Namespace: request.GetNamespace(),
+		Request:   request,
+	}
+	isAuthorized, err := a.isAuthorized(ctx, attr, scope)
+	if err != nil {
+		return nil, err
+	}
+	if !isAuthorized {
+		return nil, errUnauthorized
+	}
+
+	return a.frontendHandler.StartBatchOperation(ctx, request)
+}
+
+// StopBatchOperation API call
+func (a *AccessControlledWorkflowHandler) StopBatchOperation(
+	ctx context.Context,
+	request *batcher.StopBatchOperationRequest,
+) (*batcher.StopBatchOperationResponse, error) {
+
+	scope := a.getMetricsScopeWithNamespace(metrics.FrontendStopBatchOperationScope, request.GetNamespace())
+
+	attr := &authorization.Attributes{
+		APIName:   "StopBatchOperation",
+		Namespace: request.GetNamespace(),
+		Request:   request,
+	}
+	isAuthorized, err := a.isAuthorized(ctx, attr, scope)
+	if err != nil {
+		return nil, err
+	}
+	if !isAuthorized {
+		return nil, errUnauthorized
+	}
+
+	return a.frontendHandler.StopBatchOperation(ctx, request)
+}
+
+// DescribeBatchOperation API call
+func (a *AccessControlledWorkflowHandler) DescribeBatchOperation(
+	ctx context.Context,
+	request *batcher.DescribeBatchOperationRequest,
+) (*batcher.DescribeBatchOperationResponse, error) {
+
+	scope := a.getMetricsScopeWithNamespace(metrics.FrontendDescribeBatchOperationScope, request.GetNamespace())
+
+	attr := &authorization.Attributes{
+		APIName:   "DescribeBatchOperation",
+		Namespace: request.GetNamespace(),
+		Request:   request,
+	}
+	isAuthorized, err := a.isAuthorized(ctx, attr, scope)
+	if err != nil {
+		return nil, err
+	}
+	if !isAuthorized {
+		return nil, errUnauthorized
+	}
+
+	return a.frontendHandler.DescribeBatchOperation(ctx, request)
+}
+
+// ListBatchOperations API call
+func (a *AccessControlledWorkflowHandler) ListBatchOperations(
+	ctx context.Context,
+	request *batcher.ListBatchOperationsRequest,
+) (*batcher.ListBatchOperationsResponse, error) {
+
+	scope := a.getMetricsScopeWithNamespace(metrics.FrontendListBatchOperationsScope, request.GetNamespace())
+
+	attr := &authorization.Attributes{
+		APIName:   "ListBatchOperations",
+		Namespace: request.GetNamespace(),
+		Request:   request,
+	}
+	isAuthorized, err := a.isAuthorized(ctx, attr, scope)
+	if err != nil {
+		return nil, err
+	}
+	if !isAuthorized {
+		return nil, errUnauthorized
+	}
+
+	return a.frontendHandler.ListBatchOperations(ctx, request)
+}
+
 func (a *AccessControlledWorkflowHandler) isAuthorized(
 	ctx context.Context,
 	attr *authorization.Attributes,
@@ -779,6 +1277,43 @@ func (a *AccessControlledWorkflowHandler) isAuthorized(
 	sw := scope.StartTimer(metrics.ServiceAuthorizationLatency)
 	defer sw.Stop()
 
+	isAuth, err := a.authorize(ctx, attr, scope)
+	if isAuditedAPI(attr.APIName) {
+		record := audit.NewRecord(ctx, attr.APIName, attr.Namespace, attr.Request)
+		record.Actor = attr.Actor
+		switch {
+		case err != nil:
+			record.Decision = audit.DecisionError
+		case isAuth:
+			record.Decision = audit.DecisionAllow
+		default:
+			record.Decision = audit.DecisionDeny
+		}
+		a.auditLogger.Log(ctx, record)
+	}
+	return isAuth, err
+}
+
+func (a *AccessControlledWorkflowHandler) authorize(
+	ctx context.Context,
+	attr *authorization.Attributes,
+	scope metrics.Scope,
+) (bool, error) {
+
+	if attr.Claims == nil {
+		authToken := headers.GetValues(ctx, headers.AuthorizationHeaderName)[0]
+		claims, err := a.claimMapper.GetClaims(&authorization.AuthInfo{AuthToken: authToken})
+		if err != nil {
+			scope.IncCounter(metrics.ServiceErrUnauthorizedCounter)
+			return false, errInvalidAuthToken
+		}
+		attr.Claims = claims
+		if attr.Actor == "" && claims != nil {
+			attr.Actor = claims.Subject
+		}
+	}
+	setResourceAttributes(attr)
+
 	result, err := a.authorizer.Authorize(ctx, attr)
 	if err != nil {
 		scope.IncCounter(metrics.ServiceErrAuthorizeFailedCounter)
@@ -791,6 +1326,33 @@ func (a *AccessControlledWorkflowHandler) isAuthorized(
 	return isAuth, nil
 }
 
+// isAuditedAPI returns true for the APIs changing state, polls are not audited as they are issued
+// continuously by workers
+func isAuditedAPI(apiName string) bool {
+	return !authorization.IsReadOnlyAPI(apiName) && !strings.HasPrefix(apiName, "Poll")
+}
+
+// setResourceAttributes sets the workflow attributes from the request, so authorizers don't need to know the
+// request type of each API
+func setResourceAttributes(attr *authorization.Attributes) {
+	request := attr.Request
+	if r, ok := request.(workflowIDGetter); ok && attr.WorkflowID == "" {
+		attr.WorkflowID = r.GetWorkflowId()
+	}
+	if r, ok := request.(executionGetter); ok && attr.WorkflowID == "" {
+		attr.WorkflowID = r.GetExecution().GetWorkflowId()
+	}
+	if r, ok := request.(workflowExecutionGetter); ok && attr.WorkflowID == "" {
+		attr.WorkflowID = r.GetWorkflowExecution().GetWorkflowId()
+	}
+	if r, ok := request.(workflowTypeGetter); ok && attr.WorkflowType == "" {
+		attr.WorkflowType = r.GetWorkflowType().GetName()
+	}
+	if r, ok := request.(taskQueueGetter); ok && attr.TaskQueue == "" {
+		attr.TaskQueue = r.GetTaskQueue().GetName()
+	}
+}
+
 // getMetricsScopeWithNamespace return metrics scope with namespace tag
 func (a *AccessControlledWorkflowHandler) getMetricsScopeWithNamespace(
 	scope int,
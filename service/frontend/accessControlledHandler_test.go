@@ -32,6 +32,9 @@ import (
 	"github.com/golang/mock/gomock"
 	"github.com/stretchr/testify/require"
 	"github.com/stretchr/testify/suite"
+	commonpb "go.temporal.io/api/common/v1"
+	taskqueuepb "go.temporal.io/api/taskqueue/v1"
+	"go.temporal.io/api/workflowservice/v1"
 	"go.temporal.io/api/workflowservicemock/v1"
 
 	"go.temporal.io/server/common/authorization"
@@ -71,7 +74,7 @@ func (s *accessControlledHandlerSuite) SetupTest() {
 	s.mockFrontendHandler = workflowservicemock.NewMockWorkflowServiceServer(s.controller)
 	s.mockAuthorizer = authorization.NewMockAuthorizer(s.controller)
 	s.mockMetricsScope = &mocks.Scope{}
-	s.handler = NewAccessControlledHandlerImpl(frontendHandlerGRPC, s.mockAuthorizer)
+	s.handler = NewAccessControlledHandlerImpl(frontendHandlerGRPC, s.mockAuthorizer, nil, nil)
 }
 
 func (s *accessControlledHandlerSuite) TearDownTest() {
@@ -124,3 +127,27 @@ func (s *accessControlledHandlerSuite) TestIsAuthorized_Unauthorized() {
 	s.False(res)
 	s.NoError(err)
 }
+
+func (s *accessControlledHandlerSuite) TestSetResourceAttributes() {
+	attr := &authorization.Attributes{
+		Request: &workflowservice.StartWorkflowExecutionRequest{
+			WorkflowId:   "test-workflow-id",
+			WorkflowType: &commonpb.WorkflowType{Name: "test-workflow-type"},
+			TaskQueue:    &taskqueuepb.TaskQueue{Name: "test-task-queue"},
+		},
+	}
+	setResourceAttributes(attr)
+	s.Equal("test-workflow-id", attr.WorkflowID)
+	s.Equal("test-workflow-type", attr.WorkflowType)
+	s.Equal("test-task-queue", attr.TaskQueue)
+
+	attr = &authorization.Attributes{
+		Request: &workflowservice.SignalWorkflowExecutionRequest{
+			WorkflowExecution: &commonpb.WorkflowExecution{WorkflowId: "test-workflow-id"},
+		},
+	}
+	setResourceAttributes(attr)
+	s.Equal("test-workflow-id", attr.WorkflowID)
+	s.Empty(attr.WorkflowType)
+	s.Empty(attr.TaskQueue)
+}
@@ -42,8 +42,10 @@ import (
 	enumsspb "go.temporal.io/server/api/enums/v1"
 	"go.temporal.io/server/api/historyservice/v1"
 	replicationspb "go.temporal.io/server/api/replication/v1"
+	schedulespb "go.temporal.io/server/api/schedule/v1"
 	tokenspb "go.temporal.io/server/api/token/v1"
 	"go.temporal.io/server/common"
+	"go.temporal.io/server/common/audit"
 	"go.temporal.io/server/common/backoff"
 	"go.temporal.io/server/common/definition"
 	"go.temporal.io/server/common/headers"
@@ -52,9 +54,12 @@ import (
 	"go.temporal.io/server/common/metrics"
 	"go.temporal.io/server/common/namespace"
 	"go.temporal.io/server/common/persistence"
+	"go.temporal.io/server/common/primitives/timestamp"
 	"go.temporal.io/server/common/resource"
 	"go.temporal.io/server/common/service/dynamicconfig"
 	"go.temporal.io/server/common/xdc"
+	"go.temporal.io/server/service/worker/batcher"
+	"go.temporal.io/server/service/worker/scheduler"
 )
 
 const (
@@ -72,6 +77,10 @@ type (
 		config                *Config
 		namespaceDLQHandler   namespace.DLQMessageHandler
 		eventSerializder      persistence.PayloadSerializer
+		auditLogger           audit.Logger
+		// frontendHandler serves the APIs which are not part of workflowservice yet,
+		// requests go through the same access control and redirection as workflowservice
+		frontendHandler Handler
 	}
 )
 
@@ -80,6 +89,14 @@ var (
 
 	adminServiceRetryPolicy = common.CreateAdminServiceRetryPolicy()
 	resendStartEventID      = int64(0)
+
+	// resetReapplyTypes maps the reapply types of ResetWorkflowExecutionWithOptionsRequest, all is the default
+	resetReapplyTypes = map[string]ResetReapplyType{
+		"":                ResetReapplyTypeAll,
+		"all":             ResetReapplyTypeAll,
+		"exclude_signals": ResetReapplyTypeExcludeSignals,
+		"none":            ResetReapplyTypeNone,
+	}
 )
 
 // NewAdminHandler creates a gRPC handler for the workflowservice
@@ -87,6 +104,8 @@ func NewAdminHandler(
 	resource resource.Resource,
 	params *resource.BootstrapParams,
 	config *Config,
+	auditLogger audit.Logger,
+	frontendHandler Handler,
 ) *AdminHandler {
 
 	namespaceReplicationTaskExecutor := namespace.NewReplicationTaskExecutor(
@@ -104,6 +123,8 @@ func NewAdminHandler(
 			resource.GetLogger(),
 		),
 		eventSerializder: persistence.NewPayloadSerializer(),
+		auditLogger:      auditLogger,
+		frontendHandler:  frontendHandler,
 	}
 }
 
@@ -134,8 +155,10 @@ func (adh *AdminHandler) AddSearchAttribute(ctx context.Context, request *admins
 		return nil, adh.error(errRequestNotSet, scope)
 	}
 	if err := adh.checkPermission(adh.config, request.SecurityToken); err != nil {
+		adh.audit(ctx, "AddSearchAttribute", "", request, audit.DecisionDeny)
 		return nil, adh.error(errNoPermission, scope)
 	}
+	adh.audit(ctx, "AddSearchAttribute", "", request, audit.DecisionAllow)
 	if len(request.GetSearchAttribute()) == 0 {
 		return nil, adh.error(errSearchAttributesNotSet, scope)
 	}
@@ -240,6 +263,7 @@ func (adh *AdminHandler) RemoveTask(ctx context.Context, request *adminservice.R
 	if request == nil {
 		return nil, adh.error(errRequestNotSet, scope)
 	}
+	adh.audit(ctx, "RemoveTask", "", request, audit.DecisionAllow)
 	_, err := adh.GetHistoryClient().RemoveTask(ctx, &historyservice.RemoveTaskRequest{
 		ShardId:        request.GetShardId(),
 		Category:       request.GetCategory(),
@@ -259,6 +283,7 @@ func (adh *AdminHandler) CloseShard(ctx context.Context, request *adminservice.C
 	if request == nil {
 		return nil, adh.error(errRequestNotSet, scope)
 	}
+	adh.audit(ctx, "CloseShard", "", request, audit.DecisionAllow)
 	_, err := adh.GetHistoryClient().CloseShard(ctx, &historyservice.CloseShardRequest{ShardId: request.GetShardId()})
 	return &adminservice.CloseShardResponse{}, err
 }
@@ -597,6 +622,7 @@ func (adh *AdminHandler) ReapplyEvents(ctx context.Context, request *adminservic
 	if request == nil {
 		return nil, adh.error(errRequestNotSet, scope)
 	}
+	adh.audit(ctx, "ReapplyEvents", request.GetNamespace(), request, audit.DecisionAllow)
 	if request.GetNamespace() == "" {
 		return nil, adh.error(errNamespaceNotSet, scope)
 	}
@@ -710,6 +736,7 @@ func (adh *AdminHandler) PurgeDLQMessages(
 	if request == nil {
 		return nil, adh.error(errRequestNotSet, scope)
 	}
+	adh.audit(ctx, "PurgeDLQMessages", "", request, audit.DecisionAllow)
 
 	if request.GetInclusiveEndMessageId() <= 0 {
 		request.InclusiveEndMessageId = common.EndMessageID
@@ -763,6 +790,7 @@ func (adh *AdminHandler) MergeDLQMessages(
 	if request == nil {
 		return nil, adh.error(errRequestNotSet, scope)
 	}
+	adh.audit(ctx, "MergeDLQMessages", "", request, audit.DecisionAllow)
 
 	if request.GetInclusiveEndMessageId() <= 0 {
 		request.InclusiveEndMessageId = common.EndMessageID
@@ -828,6 +856,7 @@ func (adh *AdminHandler) RefreshWorkflowTasks(
 	if request == nil {
 		return nil, adh.error(errRequestNotSet, scope)
 	}
+	adh.audit(ctx, "RefreshWorkflowTasks", request.GetNamespace(), request, audit.DecisionAllow)
 	if err := validateExecution(request.Execution); err != nil {
 		return nil, adh.error(err, scope)
 	}
@@ -858,6 +887,7 @@ func (adh *AdminHandler) ResendReplicationTasks(
 	if request == nil {
 		return nil, adh.error(errRequestNotSet, scope)
 	}
+	adh.audit(ctx, "ResendReplicationTasks", "", request, audit.DecisionAllow)
 
 	resender := xdc.NewNDCHistoryResender(
 		adh.GetNamespaceCache(),
@@ -881,6 +911,427 @@ func (adh *AdminHandler) ResendReplicationTasks(
 	)
 }
 
+// CreateSchedule creates a schedule
+func (adh *AdminHandler) CreateSchedule(ctx context.Context, request *adminservice.CreateScheduleRequest) (_ *adminservice.CreateScheduleResponse, retError error) {
+	defer log.CapturePanic(adh.GetLogger(), &retError)
+
+	if request == nil {
+		return nil, errRequestNotSet
+	}
+	resp, err := adh.frontendHandler.CreateSchedule(ctx, &scheduler.CreateScheduleRequest{
+		Namespace:    request.GetNamespace(),
+		ScheduleID:   request.GetScheduleId(),
+		Schedule:     scheduler.ScheduleFromProto(request.GetSchedule()),
+		InitialPatch: scheduler.PatchFromProto(request.GetInitialPatch()),
+		Identity:     request.GetIdentity(),
+		RequestID:    request.GetRequestId(),
+	})
+	if err != nil {
+		return nil, err
+	}
+	return &adminservice.CreateScheduleResponse{ConflictToken: resp.ConflictToken}, nil
+}
+
+// DescribeSchedule returns the definition and the runtime information of a schedule
+func (adh *AdminHandler) DescribeSchedule(ctx context.Context, request *adminservice.DescribeScheduleRequest) (_ *adminservice.DescribeScheduleResponse, retError error) {
+	defer log.CapturePanic(adh.GetLogger(), &retError)
+
+	if request == nil {
+		return nil, errRequestNotSet
+	}
+	resp, err := adh.frontendHandler.DescribeSchedule(ctx, &scheduler.DescribeScheduleRequest{
+		Namespace:  request.GetNamespace(),
+		ScheduleID: request.GetScheduleId(),
+	})
+	if err != nil {
+		return nil, err
+	}
+	return &adminservice.DescribeScheduleResponse{
+		Schedule:      scheduler.ScheduleToProto(resp.Schedule),
+		Info:          scheduler.ScheduleInfoToProto(resp.Info),
+		ConflictToken: resp.ConflictToken,
+	}, nil
+}
+
+// UpdateSchedule replaces the definition of a schedule
+func (adh *AdminHandler) UpdateSchedule(ctx context.Context, request *adminservice.UpdateScheduleRequest) (_ *adminservice.UpdateScheduleResponse, retError error) {
+	defer log.CapturePanic(adh.GetLogger(), &retError)
+
+	if request == nil {
+		return nil, errRequestNotSet
+	}
+	_, err := adh.frontendHandler.UpdateSchedule(ctx, &scheduler.UpdateScheduleRequest{
+		Namespace:     request.GetNamespace(),
+		ScheduleID:    request.GetScheduleId(),
+		Schedule:      scheduler.ScheduleFromProto(request.GetSchedule()),
+		ConflictToken: request.GetConflictToken(),
+		Identity:      request.GetIdentity(),
+		RequestID:     request.GetRequestId(),
+	})
+	if err != nil {
+		return nil, err
+	}
+	return &adminservice.UpdateScheduleResponse{}, nil
+}
+
+// PauseSchedule pauses a schedule
+func (adh *AdminHandler) PauseSchedule(ctx context.Context, request *adminservice.PauseScheduleRequest) (_ *adminservice.PauseScheduleResponse, retError error) {
+	defer log.CapturePanic(adh.GetLogger(), &retError)
+
+	if request == nil {
+		return nil, errRequestNotSet
+	}
+	_, err := adh.frontendHandler.PauseSchedule(ctx, &scheduler.PauseScheduleRequest{
+		Namespace:  request.GetNamespace(),
+		ScheduleID: request.GetScheduleId(),
+		Notes:      request.GetNotes(),
+		Identity:   request.GetIdentity(),
+	})
+	if err != nil {
+		return nil, err
+	}
+	return &adminservice.PauseScheduleResponse{}, nil
+}
+
+// UnpauseSchedule unpauses a schedule
+func (adh *AdminHandler) UnpauseSchedule(ctx context.Context, request *adminservice.UnpauseScheduleRequest) (_ *adminservice.UnpauseScheduleResponse, retError error) {
+	defer log.CapturePanic(adh.GetLogger(), &retError)
+
+	if request == nil {
+		return nil, errRequestNotSet
+	}
+	_, err := adh.frontendHandler.UnpauseSchedule(ctx, &scheduler.UnpauseScheduleRequest{
+		Namespace:  request.GetNamespace(),
+		ScheduleID: request.GetScheduleId(),
+		Notes:      request.GetNotes(),
+		Identity:   request.GetIdentity(),
+	})
+	if err != nil {
+		return nil, err
+	}
+	return &adminservice.UnpauseScheduleResponse{}, nil
+}
+
+// TriggerSchedule takes an action of a schedule right now, or backfills past time ranges
+func (adh *AdminHandler) TriggerSchedule(ctx context.Context, request *adminservice.TriggerScheduleRequest) (_ *adminservice.TriggerScheduleResponse, retError error) {
+	defer log.CapturePanic(adh.GetLogger(), &retError)
+
+	if request == nil {
+		return nil, errRequestNotSet
+	}
+	_, err := adh.frontendHandler.TriggerSchedule(ctx, &scheduler.TriggerScheduleRequest{
+		Namespace:     request.GetNamespace(),
+		ScheduleID:    request.GetScheduleId(),
+		OverlapPolicy: scheduler.OverlapPolicyFromProto(request.GetOverlapPolicy()),
+		Backfill:      scheduler.BackfillRequestsFromProto(request.GetBackfill()),
+		Identity:      request.GetIdentity(),
+	})
+	if err != nil {
+		return nil, err
+	}
+	return &adminservice.TriggerScheduleResponse{}, nil
+}
+
+// DeleteSchedule deletes a schedule
+func (adh *AdminHandler) DeleteSchedule(ctx context.Context, request *adminservice.DeleteScheduleRequest) (_ *adminservice.DeleteScheduleResponse, retError error) {
+	defer log.CapturePanic(adh.GetLogger(), &retError)
+
+	if request == nil {
+		return nil, errRequestNotSet
+	}
+	_, err := adh.frontendHandler.DeleteSchedule(ctx, &scheduler.DeleteScheduleRequest{
+		Namespace:  request.GetNamespace(),
+		ScheduleID: request.GetScheduleId(),
+		Identity:   request.GetIdentity(),
+	})
+	if err != nil {
+		return nil, err
+	}
+	return &adminservice.DeleteScheduleResponse{}, nil
+}
+
+// ListSchedules lists the schedules of a namespace
+func (adh *AdminHandler) ListSchedules(ctx context.Context, request *adminservice.ListSchedulesRequest) (_ *adminservice.ListSchedulesResponse, retError error) {
+	defer log.CapturePanic(adh.GetLogger(), &retError)
+
+	if request == nil {
+		return nil, errRequestNotSet
+	}
+	resp, err := adh.frontendHandler.ListSchedules(ctx, &scheduler.ListSchedulesRequest{
+		Namespace:       request.GetNamespace(),
+		MaximumPageSize: request.GetMaximumPageSize(),
+		NextPageToken:   request.GetNextPageToken(),
+	})
+	if err != nil {
+		return nil, err
+	}
+	schedules := make([]*schedulespb.ScheduleListEntry, 0, len(resp.Schedules))
+	for _, entry := range resp.Schedules {
+		schedules = append(schedules, scheduler.ScheduleListEntryToProto(entry))
+	}
+	return &adminservice.ListSchedulesResponse{
+		Schedules:     schedules,
+		NextPageToken: resp.NextPageToken,
+	}, nil
+}
+
+// DeleteWorkflowExecution terminates a running workflow execution and deletes all its data
+func (adh *AdminHandler) DeleteWorkflowExecution(ctx context.Context, request *adminservice.DeleteWorkflowExecutionRequest) (_ *adminservice.DeleteWorkflowExecutionResponse, retError error) {
+	defer log.CapturePanic(adh.GetLogger(), &retError)
+
+	if request == nil {
+		return nil, errRequestNotSet
+	}
+	_, err := adh.frontendHandler.DeleteWorkflowExecution(ctx, &DeleteWorkflowExecutionRequest{
+		Namespace:         request.GetNamespace(),
+		WorkflowExecution: request.GetExecution(),
+		Identity:          request.GetIdentity(),
+	})
+	if err != nil {
+		return nil, err
+	}
+	return &adminservice.DeleteWorkflowExecutionResponse{}, nil
+}
+
+// StartBatchOperation starts a batch operation on the workflows of a namespace matching a visibility query
+func (adh *AdminHandler) StartBatchOperation(ctx context.Context, request *adminservice.StartBatchOperationRequest) (_ *adminservice.StartBatchOperationResponse, retError error) {
+	defer log.CapturePanic(adh.GetLogger(), &retError)
+
+	if request == nil {
+		return nil, errRequestNotSet
+	}
+	resetParams := request.GetResetParams()
+	_, err := adh.frontendHandler.StartBatchOperation(ctx, &batcher.StartBatchOperationRequest{
+		Namespace: request.GetNamespace(),
+		JobID:     request.GetJobId(),
+		Query:     request.GetQuery(),
+		Reason:    request.GetReason(),
+		BatchType: request.GetBatchType(),
+		Identity:  request.GetIdentity(),
+		SignalParams: batcher.SignalParams{
+			SignalName: request.GetSignalName(),
+			Input:      request.GetSignalInput(),
+		},
+		ResetParams: batcher.ResetParams{
+			ResetType:            resetParams.GetResetType(),
+			BadBinaryChecksum:    resetParams.GetBadBinaryChecksum(),
+			SkipCurrentOpen:      resetParams.GetSkipCurrentOpen(),
+			SkipBaseNotCurrent:   resetParams.GetSkipBaseNotCurrent(),
+			NonDeterministicOnly: resetParams.GetNonDeterministicOnly(),
+		},
+		RPS: int(request.GetRps()),
+	})
+	if err != nil {
+		return nil, err
+	}
+	return &adminservice.StartBatchOperationResponse{}, nil
+}
+
+// StopBatchOperation stops a running batch operation
+func (adh *AdminHandler) StopBatchOperation(ctx context.Context, request *adminservice.StopBatchOperationRequest) (_ *adminservice.StopBatchOperationResponse, retError error) {
+	defer log.CapturePanic(adh.GetLogger(), &retError)
+
+	if request == nil {
+		return nil, errRequestNotSet
+	}
+	_, err := adh.frontendHandler.StopBatchOperation(ctx, &batcher.StopBatchOperationRequest{
+		Namespace: request.GetNamespace(),
+		JobID:     request.GetJobId(),
+		Reason:    request.GetReason(),
+		Identity:  request.GetIdentity(),
+	})
+	if err != nil {
+		return nil, err
+	}
+	return &adminservice.StopBatchOperationResponse{}, nil
+}
+
+// DescribeBatchOperation returns the state and the progress of a batch operation
+func (adh *AdminHandler) DescribeBatchOperation(ctx context.Context, request *adminservice.DescribeBatchOperationRequest) (_ *adminservice.DescribeBatchOperationResponse, retError error) {
+	defer log.CapturePanic(adh.GetLogger(), &retError)
+
+	if request == nil {
+		return nil, errRequestNotSet
+	}
+	resp, err := adh.frontendHandler.DescribeBatchOperation(ctx, &batcher.DescribeBatchOperationRequest{
+		Namespace: request.GetNamespace(),
+		JobID:     request.GetJobId(),
+	})
+	if err != nil {
+		return nil, err
+	}
+	return &adminservice.DescribeBatchOperationResponse{
+		JobId:                  resp.JobID,
+		State:                  string(resp.State),
+		StartTime:              timePtrOrNil(resp.StartTime),
+		CloseTime:              timePtrOrNil(resp.CloseTime),
+		Reason:                 resp.Reason,
+		Identity:               resp.Identity,
+		TotalOperationCount:    resp.TotalOperationCount,
+		CompleteOperationCount: resp.CompleteOperationCount,
+		FailureOperationCount:  resp.FailureOperationCount,
+	}, nil
+}
+
+// ListBatchOperations lists the batch operations of a namespace
+func (adh *AdminHandler) ListBatchOperations(ctx context.Context, request *adminservice.ListBatchOperationsRequest) (_ *adminservice.ListBatchOperationsResponse, retError error) {
+	defer log.CapturePanic(adh.GetLogger(), &retError)
+
+	if request == nil {
+		return nil, errRequestNotSet
+	}
+	resp, err := adh.frontendHandler.ListBatchOperations(ctx, &batcher.ListBatchOperationsRequest{
+		Namespace:     request.GetNamespace(),
+		PageSize:      request.GetPageSize(),
+		NextPageToken: request.GetNextPageToken(),
+	})
+	if err != nil {
+		return nil, err
+	}
+	response := &adminservice.ListBatchOperationsResponse{NextPageToken: resp.NextPageToken}
+	for _, info := range resp.OperationInfo {
+		response.OperationInfo = append(response.OperationInfo, &adminservice.BatchOperationInfo{
+			JobId:     info.JobID,
+			State:     string(info.State),
+			StartTime: timePtrOrNil(info.StartTime),
+			CloseTime: timePtrOrNil(info.CloseTime),
+		})
+	}
+	return response, nil
+}
+
+// UpdateWorkflowExecution sends an update to a workflow execution and waits for its outcome
+func (adh *AdminHandler) UpdateWorkflowExecution(ctx context.Context, request *adminservice.UpdateWorkflowExecutionRequest) (_ *adminservice.UpdateWorkflowExecutionResponse, retError error) {
+	defer log.CapturePanic(adh.GetLogger(), &retError)
+
+	if request == nil {
+		return nil, errRequestNotSet
+	}
+	waitStage := UpdateStageCompleted
+	if request.GetWaitForAccepted() {
+		waitStage = UpdateStageAccepted
+	}
+	resp, err := adh.frontendHandler.UpdateWorkflowExecution(ctx, &UpdateWorkflowExecutionRequest{
+		Namespace:         request.GetNamespace(),
+		WorkflowExecution: request.GetExecution(),
+		UpdateID:          request.GetUpdateId(),
+		Name:              request.GetName(),
+		Input:             request.GetInput(),
+		Identity:          request.GetIdentity(),
+		WaitStage:         waitStage,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return &adminservice.UpdateWorkflowExecutionResponse{
+		UpdateId:  resp.UpdateID,
+		Completed: resp.Stage == UpdateStageCompleted,
+		Result:    resp.Result,
+		Failure:   resp.Failure,
+	}, nil
+}
+
+// GetWorkflowExecutionResult long polls for the outcome of a workflow execution
+func (adh *AdminHandler) GetWorkflowExecutionResult(ctx context.Context, request *adminservice.GetWorkflowExecutionResultRequest) (_ *adminservice.GetWorkflowExecutionResultResponse, retError error) {
+	defer log.CapturePanic(adh.GetLogger(), &retError)
+
+	if request == nil {
+		return nil, errRequestNotSet
+	}
+	resp, err := adh.frontendHandler.GetWorkflowExecutionResult(ctx, &GetWorkflowExecutionResultRequest{
+		Namespace:         request.GetNamespace(),
+		WorkflowExecution: request.GetExecution(),
+		FollowRuns:        request.GetFollowRuns(),
+	})
+	if err != nil {
+		return nil, err
+	}
+	return &adminservice.GetWorkflowExecutionResultResponse{
+		Execution:         resp.WorkflowExecution,
+		Status:            resp.Status,
+		Result:            resp.Result,
+		Failure:           resp.Failure,
+		NewExecutionRunId: resp.NewExecutionRunID,
+	}, nil
+}
+
+// ResetWorkflowExecutionWithOptions resets a workflow execution with control over the reset point and the
+// reapplied events, or previews the reset
+func (adh *AdminHandler) ResetWorkflowExecutionWithOptions(ctx context.Context, request *adminservice.ResetWorkflowExecutionWithOptionsRequest) (_ *adminservice.ResetWorkflowExecutionWithOptionsResponse, retError error) {
+	defer log.CapturePanic(adh.GetLogger(), &retError)
+
+	if request == nil {
+		return nil, errRequestNotSet
+	}
+	reapplyType, ok := resetReapplyTypes[request.GetReapplyType()]
+	if !ok {
+		return nil, errInvalidResetReapplyType
+	}
+	resp, err := adh.frontendHandler.ResetWorkflowExecutionWithOptions(ctx, &ResetWorkflowExecutionWithOptionsRequest{
+		Namespace:                    request.GetNamespace(),
+		WorkflowExecution:            request.GetExecution(),
+		Reason:                       request.GetReason(),
+		RequestID:                    request.GetRequestId(),
+		WorkflowTaskFinishEventID:    request.GetWorkflowTaskFinishEventId(),
+		WorkflowTaskScheduledEventID: request.GetWorkflowTaskScheduledEventId(),
+		ReapplyType:                  reapplyType,
+		DryRun:                       request.GetDryRun(),
+	})
+	if err != nil {
+		return nil, err
+	}
+	result := &adminservice.ResetWorkflowExecutionWithOptionsResponse{RunId: resp.RunID}
+	if preview := resp.Preview; preview != nil {
+		result.Preview = &adminservice.ResetWorkflowExecutionPreview{
+			BaseRunId:                 preview.BaseRunID,
+			WorkflowTaskFinishEventId: preview.WorkflowTaskFinishEventID,
+			TerminatedRunId:           preview.TerminatedRunID,
+			DroppedEvents:             preview.DroppedEvents,
+			FailedActivities:          preview.FailedActivities,
+			PendingChildren:           preview.PendingChildren,
+			ReappliedEvents:           preview.ReappliedEvents,
+		}
+	}
+	return result, nil
+}
+
+// PauseWorkflowExecution stops dispatching the tasks of a workflow execution until it is unpaused
+func (adh *AdminHandler) PauseWorkflowExecution(ctx context.Context, request *adminservice.PauseWorkflowExecutionRequest) (_ *adminservice.PauseWorkflowExecutionResponse, retError error) {
+	defer log.CapturePanic(adh.GetLogger(), &retError)
+
+	if request == nil {
+		return nil, errRequestNotSet
+	}
+	_, err := adh.frontendHandler.PauseWorkflowExecution(ctx, &PauseWorkflowExecutionRequest{
+		Namespace:         request.GetNamespace(),
+		WorkflowExecution: request.GetExecution(),
+		Identity:          request.GetIdentity(),
+		Reason:            request.GetReason(),
+	})
+	if err != nil {
+		return nil, err
+	}
+	return &adminservice.PauseWorkflowExecutionResponse{}, nil
+}
+
+// UnpauseWorkflowExecution resumes dispatching the tasks of a paused workflow execution
+func (adh *AdminHandler) UnpauseWorkflowExecution(ctx context.Context, request *adminservice.UnpauseWorkflowExecutionRequest) (_ *adminservice.UnpauseWorkflowExecutionResponse, retError error) {
+	defer log.CapturePanic(adh.GetLogger(), &retError)
+
+	if request == nil {
+		return nil, errRequestNotSet
+	}
+	_, err := adh.frontendHandler.UnpauseWorkflowExecution(ctx, &UnpauseWorkflowExecutionRequest{
+		Namespace:         request.GetNamespace(),
+		WorkflowExecution: request.GetExecution(),
+		Identity:          request.GetIdentity(),
+	})
+	if err != nil {
+		return nil, err
+	}
+	return &adminservice.UnpauseWorkflowExecutionResponse{}, nil
+}
+
 func (adh *AdminHandler) validateGetWorkflowExecutionRawHistoryV2Request(
 	request *adminservice.GetWorkflowExecutionRawHistoryV2Request,
 ) error {
@@ -1056,6 +1507,18 @@ func (adh *AdminHandler) convertIndexedValueTypeToESDataType(valueType enumspb.I
 	}
 }
 
+func (adh *AdminHandler) audit(
+	ctx context.Context,
+	apiName string,
+	namespace string,
+	request interface{},
+	decision string,
+) {
+	record := audit.NewRecord(ctx, apiName, namespace, request)
+	record.Decision = decision
+	adh.auditLogger.Log(ctx, record)
+}
+
 func (adh *AdminHandler) checkPermission(
 	config *Config,
 	securityToken string,
@@ -1071,3 +1534,10 @@ func (adh *AdminHandler) checkPermission(
 	}
 	return nil
 }
+
+func timePtrOrNil(t time.Time) *time.Time {
+	if t.IsZero() {
+		return nil
+	}
+	return timestamp.TimePtr(t)
+}
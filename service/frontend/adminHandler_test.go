@@ -29,6 +29,7 @@ import (
 	"errors"
 	"fmt"
 	"testing"
+	"time"
 
 	enumspb "go.temporal.io/api/enums/v1"
 
@@ -40,22 +41,29 @@ import (
 	"github.com/stretchr/testify/require"
 	"github.com/stretchr/testify/suite"
 	commonpb "go.temporal.io/api/common/v1"
+	historypb "go.temporal.io/api/history/v1"
 	"go.temporal.io/api/serviceerror"
 
 	"go.temporal.io/server/api/adminservice/v1"
+	enumsspb "go.temporal.io/server/api/enums/v1"
 	"go.temporal.io/server/api/historyservice/v1"
 	"go.temporal.io/server/api/historyservicemock/v1"
+	schedulespb "go.temporal.io/server/api/schedule/v1"
 	"go.temporal.io/server/common"
+	"go.temporal.io/server/common/audit"
 	"go.temporal.io/server/common/cache"
 	"go.temporal.io/server/common/definition"
 	"go.temporal.io/server/common/elasticsearch"
 	esmock "go.temporal.io/server/common/elasticsearch/mocks"
 	"go.temporal.io/server/common/metrics"
 	"go.temporal.io/server/common/mocks"
+	"go.temporal.io/server/common/payloads"
 	"go.temporal.io/server/common/persistence"
 	"go.temporal.io/server/common/resource"
 	"go.temporal.io/server/common/service/config"
 	"go.temporal.io/server/common/service/dynamicconfig"
+	"go.temporal.io/server/service/worker/batcher"
+	"go.temporal.io/server/service/worker/scheduler"
 )
 
 type (
@@ -63,10 +71,11 @@ type (
 		suite.Suite
 		*require.Assertions
 
-		controller         *gomock.Controller
-		mockResource       *resource.Test
-		mockHistoryClient  *historyservicemock.MockHistoryServiceClient
-		mockNamespaceCache *cache.MockNamespaceCache
+		controller          *gomock.Controller
+		mockResource        *resource.Test
+		mockHistoryClient   *historyservicemock.MockHistoryServiceClient
+		mockNamespaceCache  *cache.MockNamespaceCache
+		mockFrontendHandler *MockHandler
 
 		mockHistoryV2Mgr *mocks.HistoryV2Manager
 
@@ -92,6 +101,7 @@ func (s *adminHandlerSuite) SetupTest() {
 	s.mockResource = resource.NewTest(s.controller, metrics.Frontend)
 	s.mockNamespaceCache = s.mockResource.NamespaceCache
 	s.mockHistoryClient = s.mockResource.HistoryClient
+	s.mockFrontendHandler = NewMockHandler(s.controller)
 	s.mockHistoryV2Mgr = s.mockResource.HistoryMgr
 
 	params := &resource.BootstrapParams{
@@ -103,7 +113,7 @@ func (s *adminHandlerSuite) SetupTest() {
 		EnableAdminProtection:        dynamicconfig.GetBoolPropertyFn(false),
 		EnableCleanupReplicationTask: dynamicconfig.GetBoolPropertyFn(false),
 	}
-	s.handler = NewAdminHandler(s.mockResource, params, config)
+	s.handler = NewAdminHandler(s.mockResource, params, config, audit.NewNopLogger(), s.mockFrontendHandler)
 	s.handler.Start()
 }
 
@@ -604,3 +614,340 @@ func (s *adminHandlerSuite) Test_AddSearchAttribute_Permission() {
 		s.Nil(resp)
 	}
 }
+
+func (s *adminHandlerSuite) Test_CreateSchedule() {
+	ctx := context.Background()
+	_, err := s.handler.CreateSchedule(ctx, nil)
+	s.Equal(errRequestNotSet, err)
+
+	s.mockFrontendHandler.EXPECT().CreateSchedule(ctx, &scheduler.CreateScheduleRequest{
+		Namespace:  s.namespace,
+		ScheduleID: "schedule-id",
+		Schedule: &scheduler.Schedule{
+			Spec: scheduler.ScheduleSpec{CronStrings: []string{"@hourly"}},
+			Action: scheduler.StartWorkflowAction{
+				WorkflowID:   "workflow-id",
+				WorkflowType: "workflow-type",
+				TaskQueue:    "task-queue",
+			},
+			Policies: scheduler.SchedulePolicies{OverlapPolicy: scheduler.ScheduleOverlapPolicyAllowAll},
+		},
+		InitialPatch: &scheduler.SchedulePatch{Pause: "created paused"},
+		Identity:     "identity",
+		RequestID:    "request-id",
+	}).Return(&scheduler.CreateScheduleResponse{ConflictToken: 1}, nil)
+
+	resp, err := s.handler.CreateSchedule(ctx, &adminservice.CreateScheduleRequest{
+		Namespace:  s.namespace,
+		ScheduleId: "schedule-id",
+		Schedule: &schedulespb.Schedule{
+			Spec: &schedulespb.ScheduleSpec{CronString: []string{"@hourly"}},
+			Action: &schedulespb.StartWorkflowAction{
+				WorkflowId:   "workflow-id",
+				WorkflowType: &commonpb.WorkflowType{Name: "workflow-type"},
+				TaskQueue:    "task-queue",
+			},
+			Policies: &schedulespb.SchedulePolicies{OverlapPolicy: enumsspb.SCHEDULE_OVERLAP_POLICY_ALLOW_ALL},
+		},
+		InitialPatch: &schedulespb.SchedulePatch{Pause: "created paused"},
+		Identity:     "identity",
+		RequestId:    "request-id",
+	})
+	s.NoError(err)
+	s.Equal(int64(1), resp.GetConflictToken())
+}
+
+func (s *adminHandlerSuite) Test_ListSchedules() {
+	ctx := context.Background()
+	startTime := time.Date(2020, 1, 1, 0, 0, 0, 0, time.UTC)
+	s.mockFrontendHandler.EXPECT().ListSchedules(ctx, &scheduler.ListSchedulesRequest{
+		Namespace:       s.namespace,
+		MaximumPageSize: 10,
+	}).Return(&scheduler.ListSchedulesResponse{
+		Schedules:     []scheduler.ScheduleListEntry{{ScheduleID: "schedule-id", SchedulerRunID: "run-id", StartTime: startTime}},
+		NextPageToken: []byte{1},
+	}, nil)
+
+	resp, err := s.handler.ListSchedules(ctx, &adminservice.ListSchedulesRequest{
+		Namespace:       s.namespace,
+		MaximumPageSize: 10,
+	})
+	s.NoError(err)
+	s.Equal([]*schedulespb.ScheduleListEntry{{
+		ScheduleId:     "schedule-id",
+		SchedulerRunId: "run-id",
+		StartTime:      &startTime,
+	}}, resp.GetSchedules())
+	s.Equal([]byte{1}, resp.GetNextPageToken())
+}
+
+func (s *adminHandlerSuite) Test_DeleteWorkflowExecution() {
+	ctx := context.Background()
+	_, err := s.handler.DeleteWorkflowExecution(ctx, nil)
+	s.Equal(errRequestNotSet, err)
+
+	execution := &commonpb.WorkflowExecution{WorkflowId: "workflow-id", RunId: uuid.New()}
+	s.mockFrontendHandler.EXPECT().DeleteWorkflowExecution(ctx, &DeleteWorkflowExecutionRequest{
+		Namespace:         s.namespace,
+		WorkflowExecution: execution,
+		Identity:          "identity",
+	}).Return(&DeleteWorkflowExecutionResponse{}, nil)
+
+	resp, err := s.handler.DeleteWorkflowExecution(ctx, &adminservice.DeleteWorkflowExecutionRequest{
+		Namespace: s.namespace,
+		Execution: execution,
+		Identity:  "identity",
+	})
+	s.NoError(err)
+	s.NotNil(resp)
+}
+
+func (s *adminHandlerSuite) Test_StartBatchOperation() {
+	ctx := context.Background()
+	_, err := s.handler.StartBatchOperation(ctx, nil)
+	s.Equal(errRequestNotSet, err)
+
+	s.mockFrontendHandler.EXPECT().StartBatchOperation(ctx, &batcher.StartBatchOperationRequest{
+		Namespace: s.namespace,
+		JobID:     "job-id",
+		Query:     "WorkflowType = 'test-type'",
+		Reason:    "reason",
+		BatchType: batcher.BatchTypeReset,
+		Identity:  "identity",
+		ResetParams: batcher.ResetParams{
+			ResetType:       "LastWorkflowTask",
+			SkipCurrentOpen: true,
+		},
+		RPS: 10,
+	}).Return(&batcher.StartBatchOperationResponse{}, nil)
+
+	resp, err := s.handler.StartBatchOperation(ctx, &adminservice.StartBatchOperationRequest{
+		Namespace: s.namespace,
+		JobId:     "job-id",
+		Query:     "WorkflowType = 'test-type'",
+		Reason:    "reason",
+		BatchType: batcher.BatchTypeReset,
+		Identity:  "identity",
+		ResetParams: &adminservice.BatchResetParams{
+			ResetType:       "LastWorkflowTask",
+			SkipCurrentOpen: true,
+		},
+		Rps: 10,
+	})
+	s.NoError(err)
+	s.NotNil(resp)
+}
+
+func (s *adminHandlerSuite) Test_DescribeBatchOperation() {
+	ctx := context.Background()
+	_, err := s.handler.DescribeBatchOperation(ctx, nil)
+	s.Equal(errRequestNotSet, err)
+
+	startTime := time.Now().UTC()
+	s.mockFrontendHandler.EXPECT().DescribeBatchOperation(ctx, &batcher.DescribeBatchOperationRequest{
+		Namespace: s.namespace,
+		JobID:     "job-id",
+	}).Return(&batcher.DescribeBatchOperationResponse{
+		JobID:                  "job-id",
+		State:                  batcher.BatchOperationStateRunning,
+		StartTime:              startTime,
+		Reason:                 "reason",
+		TotalOperationCount:    10,
+		CompleteOperationCount: 4,
+	}, nil)
+
+	resp, err := s.handler.DescribeBatchOperation(ctx, &adminservice.DescribeBatchOperationRequest{
+		Namespace: s.namespace,
+		JobId:     "job-id",
+	})
+	s.NoError(err)
+	s.Equal("job-id", resp.GetJobId())
+	s.Equal(string(batcher.BatchOperationStateRunning), resp.GetState())
+	s.Equal(startTime, *resp.GetStartTime())
+	s.Nil(resp.GetCloseTime())
+	s.Equal(int64(10), resp.GetTotalOperationCount())
+	s.Equal(int64(4), resp.GetCompleteOperationCount())
+}
+
+func (s *adminHandlerSuite) Test_ListBatchOperations() {
+	ctx := context.Background()
+	_, err := s.handler.ListBatchOperations(ctx, nil)
+	s.Equal(errRequestNotSet, err)
+
+	s.mockFrontendHandler.EXPECT().ListBatchOperations(ctx, &batcher.ListBatchOperationsRequest{
+		Namespace: s.namespace,
+		PageSize:  5,
+	}).Return(&batcher.ListBatchOperationsResponse{
+		OperationInfo: []batcher.BatchOperationInfo{
+			{JobID: "job-1", State: batcher.BatchOperationStateCompleted},
+			{JobID: "job-2", State: batcher.BatchOperationStateRunning},
+		},
+		NextPageToken: []byte("token"),
+	}, nil)
+
+	resp, err := s.handler.ListBatchOperations(ctx, &adminservice.ListBatchOperationsRequest{
+		Namespace: s.namespace,
+		PageSize:  5,
+	})
+	s.NoError(err)
+	s.Len(resp.GetOperationInfo(), 2)
+	s.Equal("job-1", resp.GetOperationInfo()[0].GetJobId())
+	s.Equal(string(batcher.BatchOperationStateRunning), resp.GetOperationInfo()[1].GetState())
+	s.Equal([]byte("token"), resp.GetNextPageToken())
+}
+
+func (s *adminHandlerSuite) Test_UpdateWorkflowExecution() {
+	ctx := context.Background()
+	_, err := s.handler.UpdateWorkflowExecution(ctx, nil)
+	s.Equal(errRequestNotSet, err)
+
+	execution := &commonpb.WorkflowExecution{WorkflowId: "workflow-id", RunId: uuid.New()}
+	input := payloads.EncodeString("input")
+	result := payloads.EncodeString("result")
+	s.mockFrontendHandler.EXPECT().UpdateWorkflowExecution(ctx, &UpdateWorkflowExecutionRequest{
+		Namespace:         s.namespace,
+		WorkflowExecution: execution,
+		UpdateID:          "update-id",
+		Name:              "update-name",
+		Input:             input,
+		Identity:          "identity",
+		WaitStage:         UpdateStageAccepted,
+	}).Return(&UpdateWorkflowExecutionResponse{
+		UpdateID: "update-id",
+		Stage:    UpdateStageCompleted,
+		Result:   result,
+	}, nil)
+
+	resp, err := s.handler.UpdateWorkflowExecution(ctx, &adminservice.UpdateWorkflowExecutionRequest{
+		Namespace:       s.namespace,
+		Execution:       execution,
+		UpdateId:        "update-id",
+		Name:            "update-name",
+		Input:           input,
+		Identity:        "identity",
+		WaitForAccepted: true,
+	})
+	s.NoError(err)
+	s.Equal("update-id", resp.GetUpdateId())
+	s.True(resp.GetCompleted())
+	s.Equal(result, resp.GetResult())
+	s.Nil(resp.GetFailure())
+}
+
+func (s *adminHandlerSuite) Test_GetWorkflowExecutionResult() {
+	ctx := context.Background()
+	_, err := s.handler.GetWorkflowExecutionResult(ctx, nil)
+	s.Equal(errRequestNotSet, err)
+
+	execution := &commonpb.WorkflowExecution{WorkflowId: "workflow-id"}
+	closedExecution := &commonpb.WorkflowExecution{WorkflowId: "workflow-id", RunId: uuid.New()}
+	result := payloads.EncodeString("result")
+	s.mockFrontendHandler.EXPECT().GetWorkflowExecutionResult(ctx, &GetWorkflowExecutionResultRequest{
+		Namespace:         s.namespace,
+		WorkflowExecution: execution,
+		FollowRuns:        true,
+	}).Return(&GetWorkflowExecutionResultResponse{
+		WorkflowExecution: closedExecution,
+		Status:            enumspb.WORKFLOW_EXECUTION_STATUS_COMPLETED,
+		Result:            result,
+	}, nil)
+
+	resp, err := s.handler.GetWorkflowExecutionResult(ctx, &adminservice.GetWorkflowExecutionResultRequest{
+		Namespace:  s.namespace,
+		Execution:  execution,
+		FollowRuns: true,
+	})
+	s.NoError(err)
+	s.Equal(closedExecution, resp.GetExecution())
+	s.Equal(enumspb.WORKFLOW_EXECUTION_STATUS_COMPLETED, resp.GetStatus())
+	s.Equal(result, resp.GetResult())
+}
+
+func (s *adminHandlerSuite) Test_ResetWorkflowExecutionWithOptions() {
+	ctx := context.Background()
+	_, err := s.handler.ResetWorkflowExecutionWithOptions(ctx, nil)
+	s.Equal(errRequestNotSet, err)
+
+	execution := &commonpb.WorkflowExecution{WorkflowId: "workflow-id", RunId: uuid.New()}
+	_, err = s.handler.ResetWorkflowExecutionWithOptions(ctx, &adminservice.ResetWorkflowExecutionWithOptionsRequest{
+		Namespace:   s.namespace,
+		Execution:   execution,
+		ReapplyType: "signals",
+	})
+	s.Equal(errInvalidResetReapplyType, err)
+
+	droppedEvents := []*historypb.HistoryEvent{{EventId: 5, EventType: enumspb.EVENT_TYPE_WORKFLOW_TASK_COMPLETED}}
+	s.mockFrontendHandler.EXPECT().ResetWorkflowExecutionWithOptions(ctx, &ResetWorkflowExecutionWithOptionsRequest{
+		Namespace:                    s.namespace,
+		WorkflowExecution:            execution,
+		Reason:                       "reason",
+		RequestID:                    "request-id",
+		WorkflowTaskScheduledEventID: 2,
+		ReapplyType:                  ResetReapplyTypeExcludeSignals,
+		DryRun:                       true,
+	}).Return(&ResetWorkflowExecutionWithOptionsResponse{
+		Preview: &ResetWorkflowExecutionPreview{
+			BaseRunID:                 execution.GetRunId(),
+			WorkflowTaskFinishEventID: 4,
+			DroppedEvents:             droppedEvents,
+		},
+	}, nil)
+
+	resp, err := s.handler.ResetWorkflowExecutionWithOptions(ctx, &adminservice.ResetWorkflowExecutionWithOptionsRequest{
+		Namespace:                    s.namespace,
+		Execution:                    execution,
+		Reason:                       "reason",
+		RequestId:                    "request-id",
+		WorkflowTaskScheduledEventId: 2,
+		ReapplyType:                  "exclude_signals",
+		DryRun:                       true,
+	})
+	s.NoError(err)
+	s.Empty(resp.GetRunId())
+	s.Equal(execution.GetRunId(), resp.GetPreview().GetBaseRunId())
+	s.Equal(int64(4), resp.GetPreview().GetWorkflowTaskFinishEventId())
+	s.Equal(droppedEvents, resp.GetPreview().GetDroppedEvents())
+}
+
+func (s *adminHandlerSuite) Test_PauseWorkflowExecution() {
+	ctx := context.Background()
+	_, err := s.handler.PauseWorkflowExecution(ctx, nil)
+	s.Equal(errRequestNotSet, err)
+
+	execution := &commonpb.WorkflowExecution{WorkflowId: "workflow-id", RunId: uuid.New()}
+	s.mockFrontendHandler.EXPECT().PauseWorkflowExecution(ctx, &PauseWorkflowExecutionRequest{
+		Namespace:         s.namespace,
+		WorkflowExecution: execution,
+		Identity:          "identity",
+		Reason:            "reason",
+	}).Return(&PauseWorkflowExecutionResponse{}, nil)
+
+	resp, err := s.handler.PauseWorkflowExecution(ctx, &adminservice.PauseWorkflowExecutionRequest{
+		Namespace: s.namespace,
+		Execution: execution,
+		Identity:  "identity",
+		Reason:    "reason",
+	})
+	s.NoError(err)
+	s.NotNil(resp)
+}
+
+func (s *adminHandlerSuite) Test_UnpauseWorkflowExecution() {
+	ctx := context.Background()
+	_, err := s.handler.UnpauseWorkflowExecution(ctx, nil)
+	s.Equal(errRequestNotSet, err)
+
+	execution := &commonpb.WorkflowExecution{WorkflowId: "workflow-id", RunId: uuid.New()}
+	s.mockFrontendHandler.EXPECT().UnpauseWorkflowExecution(ctx, &UnpauseWorkflowExecutionRequest{
+		Namespace:         s.namespace,
+		WorkflowExecution: execution,
+		Identity:          "identity",
+	}).Return(nil, serviceerror.NewNotFound("workflow not found"))
+
+	_, err = s.handler.UnpauseWorkflowExecution(ctx, &adminservice.UnpauseWorkflowExecutionRequest{
+		Namespace: s.namespace,
+		Execution: execution,
+		Identity:  "identity",
+	})
+	s.IsType(&serviceerror.NotFound{}, err)
+}
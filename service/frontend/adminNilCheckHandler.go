@@ -197,3 +197,165 @@ func (adh *AdminNilCheckHandler) ResendReplicationTasks(ctx context.Context, req
 	}
 	return resp, err
 }
+
+// CreateSchedule creates a schedule
+func (adh *AdminNilCheckHandler) CreateSchedule(ctx context.Context, request *adminservice.CreateScheduleRequest) (*adminservice.CreateScheduleResponse, error) {
+	resp, err := adh.parentHandler.CreateSchedule(ctx, request)
+	if resp == nil && err == nil {
+		resp = &adminservice.CreateScheduleResponse{}
+	}
+	return resp, err
+}
+
+// DescribeSchedule returns a schedule
+func (adh *AdminNilCheckHandler) DescribeSchedule(ctx context.Context, request *adminservice.DescribeScheduleRequest) (*adminservice.DescribeScheduleResponse, error) {
+	resp, err := adh.parentHandler.DescribeSchedule(ctx, request)
+	if resp == nil && err == nil {
+		resp = &adminservice.DescribeScheduleResponse{}
+	}
+	return resp, err
+}
+
+// UpdateSchedule replaces the definition of a schedule
+func (adh *AdminNilCheckHandler) UpdateSchedule(ctx context.Context, request *adminservice.UpdateScheduleRequest) (*adminservice.UpdateScheduleResponse, error) {
+	resp, err := adh.parentHandler.UpdateSchedule(ctx, request)
+	if resp == nil && err == nil {
+		resp = &adminservice.UpdateScheduleResponse{}
+	}
+	return resp, err
+}
+
+// PauseSchedule pauses a schedule
+func (adh *AdminNilCheckHandler) PauseSchedule(ctx context.Context, request *adminservice.PauseScheduleRequest) (*adminservice.PauseScheduleResponse, error) {
+	resp, err := adh.parentHandler.PauseSchedule(ctx, request)
+	if resp == nil && err == nil {
+		resp = &adminservice.PauseScheduleResponse{}
+	}
+	return resp, err
+}
+
+// UnpauseSchedule unpauses a schedule
+func (adh *AdminNilCheckHandler) UnpauseSchedule(ctx context.Context, request *adminservice.UnpauseScheduleRequest) (*adminservice.UnpauseScheduleResponse, error) {
+	resp, err := adh.parentHandler.UnpauseSchedule(ctx, request)
+	if resp == nil && err == nil {
+		resp = &adminservice.UnpauseScheduleResponse{}
+	}
+	return resp, err
+}
+
+// TriggerSchedule triggers or backfills a schedule
+func (adh *AdminNilCheckHandler) TriggerSchedule(ctx context.Context, request *adminservice.TriggerScheduleRequest) (*adminservice.TriggerScheduleResponse, error) {
+	resp, err := adh.parentHandler.TriggerSchedule(ctx, request)
+	if resp == nil && err == nil {
+		resp = &adminservice.TriggerScheduleResponse{}
+	}
+	return resp, err
+}
+
+// DeleteSchedule deletes a schedule
+func (adh *AdminNilCheckHandler) DeleteSchedule(ctx context.Context, request *adminservice.DeleteScheduleRequest) (*adminservice.DeleteScheduleResponse, error) {
+	resp, err := adh.parentHandler.DeleteSchedule(ctx, request)
+	if resp == nil && err == nil {
+		resp = &adminservice.DeleteScheduleResponse{}
+	}
+	return resp, err
+}
+
+// ListSchedules lists schedules
+func (adh *AdminNilCheckHandler) ListSchedules(ctx context.Context, request *adminservice.ListSchedulesRequest) (*adminservice.ListSchedulesResponse, error) {
+	resp, err := adh.parentHandler.ListSchedules(ctx, request)
+	if resp == nil && err == nil {
+		resp = &adminservice.ListSchedulesResponse{}
+	}
+	return resp, err
+}
+
+// DeleteWorkflowExecution deletes a workflow execution
+func (adh *AdminNilCheckHandler) DeleteWorkflowExecution(ctx context.Context, request *adminservice.DeleteWorkflowExecutionRequest) (*adminservice.DeleteWorkflowExecutionResponse, error) {
+	resp, err := adh.parentHandler.DeleteWorkflowExecution(ctx, request)
+	if resp == nil && err == nil {
+		resp = &adminservice.DeleteWorkflowExecutionResponse{}
+	}
+	return resp, err
+}
+
+// StartBatchOperation starts a batch operation
+func (adh *AdminNilCheckHandler) StartBatchOperation(ctx context.Context, request *adminservice.StartBatchOperationRequest) (*adminservice.StartBatchOperationResponse, error) {
+	resp, err := adh.parentHandler.StartBatchOperation(ctx, request)
+	if resp == nil && err == nil {
+		resp = &adminservice.StartBatchOperationResponse{}
+	}
+	return resp, err
+}
+
+// StopBatchOperation stops a batch operation
+func (adh *AdminNilCheckHandler) StopBatchOperation(ctx context.Context, request *adminservice.StopBatchOperationRequest) (*adminservice.StopBatchOperationResponse, error) {
+	resp, err := adh.parentHandler.StopBatchOperation(ctx, request)
+	if resp == nil && err == nil {
+		resp = &adminservice.StopBatchOperationResponse{}
+	}
+	return resp, err
+}
+
+// DescribeBatchOperation describes a batch operation
+func (adh *AdminNilCheckHandler) DescribeBatchOperation(ctx context.Context, request *adminservice.DescribeBatchOperationRequest) (*adminservice.DescribeBatchOperationResponse, error) {
+	resp, err := adh.parentHandler.DescribeBatchOperation(ctx, request)
+	if resp == nil && err == nil {
+		resp = &adminservice.DescribeBatchOperationResponse{}
+	}
+	return resp, err
+}
+
+// ListBatchOperations lists the batch operations of a namespace
+func (adh *AdminNilCheckHandler) ListBatchOperations(ctx context.Context, request *adminservice.ListBatchOperationsRequest) (*adminservice.ListBatchOperationsResponse, error) {
+	resp, err := adh.parentHandler.ListBatchOperations(ctx, request)
+	if resp == nil && err == nil {
+		resp = &adminservice.ListBatchOperationsResponse{}
+	}
+	return resp, err
+}
+
+// UpdateWorkflowExecution sends an update to a workflow execution
+func (adh *AdminNilCheckHandler) UpdateWorkflowExecution(ctx context.Context, request *adminservice.UpdateWorkflowExecutionRequest) (*adminservice.UpdateWorkflowExecutionResponse, error) {
+	resp, err := adh.parentHandler.UpdateWorkflowExecution(ctx, request)
+	if resp == nil && err == nil {
+		resp = &adminservice.UpdateWorkflowExecutionResponse{}
+	}
+	return resp, err
+}
+
+// GetWorkflowExecutionResult long polls for the outcome of a workflow execution
+func (adh *AdminNilCheckHandler) GetWorkflowExecutionResult(ctx context.Context, request *adminservice.GetWorkflowExecutionResultRequest) (*adminservice.GetWorkflowExecutionResultResponse, error) {
+	resp, err := adh.parentHandler.GetWorkflowExecutionResult(ctx, request)
+	if resp == nil && err == nil {
+		resp = &adminservice.GetWorkflowExecutionResultResponse{}
+	}
+	return resp, err
+}
+
+// ResetWorkflowExecutionWithOptions resets a workflow execution with options
+func (adh *AdminNilCheckHandler) ResetWorkflowExecutionWithOptions(ctx context.Context, request *adminservice.ResetWorkflowExecutionWithOptionsRequest) (*adminservice.ResetWorkflowExecutionWithOptionsResponse, error) {
+	resp, err := adh.parentHandler.ResetWorkflowExecutionWithOptions(ctx, request)
+	if resp == nil && err == nil {
+		resp = &adminservice.ResetWorkflowExecutionWithOptionsResponse{}
+	}
+	return resp, err
+}
+
+// PauseWorkflowExecution pauses a workflow execution
+func (adh *AdminNilCheckHandler) PauseWorkflowExecution(ctx context.Context, request *adminservice.PauseWorkflowExecutionRequest) (*adminservice.PauseWorkflowExecutionResponse, error) {
+	resp, err := adh.parentHandler.PauseWorkflowExecution(ctx, request)
+	if resp == nil && err == nil {
+		resp = &adminservice.PauseWorkflowExecutionResponse{}
+	}
+	return resp, err
+}
+
+// UnpauseWorkflowExecution unpauses a workflow execution
+func (adh *AdminNilCheckHandler) UnpauseWorkflowExecution(ctx context.Context, request *adminservice.UnpauseWorkflowExecutionRequest) (*adminservice.UnpauseWorkflowExecutionResponse, error) {
+	resp, err := adh.parentHandler.UnpauseWorkflowExecution(ctx, request)
+	if resp == nil && err == nil {
+		resp = &adminservice.UnpauseWorkflowExecutionResponse{}
+	}
+	return resp, err
+}
@@ -0,0 +1,99 @@
+// The MIT License
+//
+// Copyright (c) 2020 Temporal Technologies Inc.  All rights reserved.
+//
+// Copyright (c) 2017 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package frontend
+
+// API categories of the per namespace quotas, the keys of the frontend.namespaceAPICategoryRPS
+// and frontend.namespaceAPICategoryBurst dynamic configs
+const (
+	apiCategoryStartAndSignal = "startAndSignal"
+	apiCategoryPoll           = "poll"
+	apiCategoryQuery          = "query"
+	apiCategoryVisibility     = "visibility"
+	apiCategoryAdmin          = "admin"
+	apiCategoryDefault        = "default"
+)
+
+// apiCategories maps the APIs to the categories of their quotas, APIs missing from the map are in the default category
+var apiCategories = map[string]string{
+	"StartWorkflowExecution":           apiCategoryStartAndSignal,
+	"SignalWorkflowExecution":          apiCategoryStartAndSignal,
+	"SignalWithStartWorkflowExecution": apiCategoryStartAndSignal,
+
+	"PollWorkflowTaskQueue": apiCategoryPoll,
+	"PollActivityTaskQueue": apiCategoryPoll,
+
+	"QueryWorkflow":               apiCategoryQuery,
+	"DescribeWorkflowExecution":   apiCategoryQuery,
+	"DescribeTaskQueue":           apiCategoryQuery,
+	"ListTaskQueuePartitions":     apiCategoryQuery,
+	"GetWorkflowExecutionHistory": apiCategoryQuery,
+	"GetWorkflowExecutionResult":  apiCategoryQuery,
+
+	"ListOpenWorkflowExecutions":     apiCategoryVisibility,
+	"ListClosedWorkflowExecutions":   apiCategoryVisibility,
+	"ListWorkflowExecutions":         apiCategoryVisibility,
+	"ListArchivedWorkflowExecutions": apiCategoryVisibility,
+	"ScanWorkflowExecutions":         apiCategoryVisibility,
+	"CountWorkflowExecutions":        apiCategoryVisibility,
+
+	"RegisterNamespace":      apiCategoryAdmin,
+	"UpdateNamespace":        apiCategoryAdmin,
+	"DeprecateNamespace":     apiCategoryAdmin,
+	"StartBatchOperation":    apiCategoryAdmin,
+	"StopBatchOperation":     apiCategoryAdmin,
+	"DescribeBatchOperation": apiCategoryAdmin,
+	"ListBatchOperations":    apiCategoryAdmin,
+	"CreateSchedule":         apiCategoryAdmin,
+	"DescribeSchedule":       apiCategoryAdmin,
+	"UpdateSchedule":         apiCategoryAdmin,
+	"PauseSchedule":          apiCategoryAdmin,
+	"UnpauseSchedule":        apiCategoryAdmin,
+	"TriggerSchedule":        apiCategoryAdmin,
+	"DeleteSchedule":         apiCategoryAdmin,
+	"ListSchedules":          apiCategoryAdmin,
+}
+
+// apiCategory returns the quota category of an API
+func apiCategory(apiName string) string {
+	if category, ok := apiCategories[apiName]; ok {
+		return category
+	}
+	return apiCategoryDefault
+}
+
+// mapNumber returns the number of a key of a map dynamic config, numbers are either ints or floats
+// depending on how they are written in the config file
+func mapNumber(m map[string]interface{}, key string) (float64, bool) {
+	switch value := m[key].(type) {
+	case int:
+		return float64(value), true
+	case int64:
+		return float64(value), true
+	case float64:
+		return value, true
+	default:
+		return 0, false
+	}
+}
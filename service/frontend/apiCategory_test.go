@@ -0,0 +1,71 @@
+// The MIT License
+//
+// Copyright (c) 2020 Temporal Technologies Inc.  All rights reserved.
+//
+// Copyright (c) 2017 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package frontend
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/suite"
+)
+
+type (
+	apiCategorySuite struct {
+		suite.Suite
+	}
+)
+
+func TestAPICategorySuite(t *testing.T) {
+	suite.Run(t, new(apiCategorySuite))
+}
+
+func (s *apiCategorySuite) TestAPICategory() {
+	s.Equal(apiCategoryStartAndSignal, apiCategory("StartWorkflowExecution"))
+	s.Equal(apiCategoryStartAndSignal, apiCategory("SignalWithStartWorkflowExecution"))
+	s.Equal(apiCategoryPoll, apiCategory("PollActivityTaskQueue"))
+	s.Equal(apiCategoryQuery, apiCategory("QueryWorkflow"))
+	s.Equal(apiCategoryQuery, apiCategory("GetWorkflowExecutionHistory"))
+	s.Equal(apiCategoryVisibility, apiCategory("ListWorkflowExecutions"))
+	s.Equal(apiCategoryVisibility, apiCategory("CountWorkflowExecutions"))
+	s.Equal(apiCategoryQuery, apiCategory("ListTaskQueuePartitions"))
+	s.Equal(apiCategoryAdmin, apiCategory("StartBatchOperation"))
+	s.Equal(apiCategoryAdmin, apiCategory("ListSchedules"))
+	s.Equal(apiCategoryDefault, apiCategory("TerminateWorkflowExecution"))
+	s.Equal(apiCategoryDefault, apiCategory("StartUnknownOperation"))
+}
+
+func (s *apiCategorySuite) TestMapNumber() {
+	m := map[string]interface{}{"int": 10, "float": 2.5, "string": "10"}
+
+	value, ok := mapNumber(m, "int")
+	s.True(ok)
+	s.Equal(float64(10), value)
+	value, ok = mapNumber(m, "float")
+	s.True(ok)
+	s.Equal(2.5, value)
+	_, ok = mapNumber(m, "string")
+	s.False(ok)
+	_, ok = mapNumber(nil, "int")
+	s.False(ok)
+}
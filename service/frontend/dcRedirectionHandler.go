@@ -36,6 +36,8 @@ import (
 	"go.temporal.io/server/common/metrics"
 	"go.temporal.io/server/common/resource"
 	"go.temporal.io/server/common/service/config"
+	"go.temporal.io/server/service/worker/batcher"
+	"go.temporal.io/server/service/worker/scheduler"
 )
 
 var _ Handler = (*DCRedirectionHandlerImpl)(nil)
@@ -1182,6 +1184,300 @@ func (handler *DCRedirectionHandlerImpl) GetClusterInfo(
 	return handler.frontendHandler.GetClusterInfo(ctx, request)
 }
 
+// CreateSchedule API call
+func (handler *DCRedirectionHandlerImpl) CreateSchedule(
+	ctx context.Context,
+	request *scheduler.CreateScheduleRequest,
+) (_ *scheduler.CreateScheduleResponse, retError error) {
+
+	// schedules are backed by workflows of the local system namespace, they are never redirected
+	cluster := handler.currentClusterName
+	scope, startTime := handler.beforeCall(metrics.DCRedirectionCreateScheduleScope)
+	defer func() {
+		handler.afterCall(scope, startTime, cluster, &retError)
+	}()
+
+	return handler.frontendHandler.CreateSchedule(ctx, request)
+}
+
+// DescribeSchedule API call
+func (handler *DCRedirectionHandlerImpl) DescribeSchedule(
+	ctx context.Context,
+	request *scheduler.DescribeScheduleRequest,
+) (_ *scheduler.DescribeScheduleResponse, retError error) {
+
+	// schedules are backed by workflows of the local system namespace, they are never redirected
+	cluster := handler.currentClusterName
+	scope, startTime := handler.beforeCall(metrics.DCRedirectionDescribeScheduleScope)
+	defer func() {
+		handler.afterCall(scope, startTime, cluster, &retError)
+	}()
+
+	return handler.frontendHandler.DescribeSchedule(ctx, request)
+}
+
+// UpdateSchedule API call
+func (handler *DCRedirectionHandlerImpl) UpdateSchedule(
+	ctx context.Context,
+	request *scheduler.UpdateScheduleRequest,
+) (_ *scheduler.UpdateScheduleResponse, retError error) {
+
+	// schedules are backed by workflows of the local system namespace, they are never redirected
+	cluster := handler.currentClusterName
+	scope, startTime := handler.beforeCall(metrics.DCRedirectionUpdateScheduleScope)
+	defer func() {
+		handler.afterCall(scope, startTime, cluster, &retError)
+	}()
+
+	return handler.frontendHandler.UpdateSchedule(ctx, request)
+}
+
+// PauseSchedule API call
+func (handler *DCRedirectionHandlerImpl) PauseSchedule(
+	ctx context.Context,
+	request *scheduler.PauseScheduleRequest,
+) (_ *scheduler.PauseScheduleResponse, retError error) {
+
+	// schedules are backed by workflows of the local system namespace, they are never redirected
+	cluster := handler.currentClusterName
+	scope, startTime := handler.beforeCall(metrics.DCRedirectionPauseScheduleScope)
+	defer func() {
+		handler.afterCall(scope, startTime, cluster, &retError)
+	}()
+
+	return handler.frontendHandler.PauseSchedule(ctx, request)
+}
+
+// UnpauseSchedule API call
+func (handler *DCRedirectionHandlerImpl) UnpauseSchedule(
+	ctx context.Context,
+	request *scheduler.UnpauseScheduleRequest,
+) (_ *scheduler.UnpauseScheduleResponse, retError error) {
+
+	// schedules are backed by workflows of the local system namespace, they are never redirected
+	cluster := handler.currentClusterName
+	scope, startTime := handler.beforeCall(metrics.DCRedirectionUnpauseScheduleScope)
+	defer func() {
+		handler.afterCall(scope, startTime, cluster, &retError)
+	}()
+
+	return handler.frontendHandler.UnpauseSchedule(ctx, request)
+}
+
+// TriggerSchedule API call
+func (handler *DCRedirectionHandlerImpl) TriggerSchedule(
+	ctx context.Context,
+	request *scheduler.TriggerScheduleRequest,
+) (_ *scheduler.TriggerScheduleResponse, retError error) {
+
+	// schedules are backed by workflows of the local system namespace, they are never redirected
+	cluster := handler.currentClusterName
+	scope, startTime := handler.beforeCall(metrics.DCRedirectionTriggerScheduleScope)
+	defer func() {
+		handler.afterCall(scope, startTime, cluster, &retError)
+	}()
+
+	return handler.frontendHandler.TriggerSchedule(ctx, request)
+}
+
+// DeleteSchedule API call
+func (handler *DCRedirectionHandlerImpl) DeleteSchedule(
+	ctx context.Context,
+	request *scheduler.DeleteScheduleRequest,
+) (_ *scheduler.DeleteScheduleResponse, retError error) {
+
+	// schedules are backed by workflows of the local system namespace, they are never redirected
+	cluster := handler.currentClusterName
+	scope, startTime := handler.beforeCall(metrics.DCRedirectionDeleteScheduleScope)
+	defer func() {
+		handler.afterCall(scope, startTime, cluster, &retError)
+	}()
+
+	return handler.frontendHandler.DeleteSchedule(ctx, request)
+}
+
+// ListSchedules API call
+func (handler *DCRedirectionHandlerImpl) ListSchedules(
+	ctx context.Context,
+	request *scheduler.ListSchedulesRequest,
+) (_ *scheduler.ListSchedulesResponse, retError error) {
+
+	// schedules are backed by workflows of the local system namespace, they are never redirected
+	cluster := handler.currentClusterName
+	scope, startTime := handler.beforeCall(metrics.DCRedirectionListSchedulesScope)
+	defer func() {
+		handler.afterCall(scope, startTime, cluster, &retError)
+	}()
+
+	return handler.frontendHandler.ListSchedules(ctx, request)
+}
+
+// DeleteWorkflowExecution API call
+func (handler *DCRedirectionHandlerImpl) DeleteWorkflowExecution(
+	ctx context.Context,
+	request *DeleteWorkflowExecutionRequest,
+) (_ *DeleteWorkflowExecutionResponse, retError error) {
+
+	// the remote frontend client does not serve this API, the history service of the current
+	// cluster rejects the request if the namespace is not active here
+	cluster := handler.currentClusterName
+	scope, startTime := handler.beforeCall(metrics.DCRedirectionDeleteWorkflowExecutionScope)
+	defer func() {
+		handler.afterCall(scope, startTime, cluster, &retError)
+	}()
+
+	return handler.frontendHandler.DeleteWorkflowExecution(ctx, request)
+}
+
+// UpdateWorkflowExecution API call
+func (handler *DCRedirectionHandlerImpl) UpdateWorkflowExecution(
+	ctx context.Context,
+	request *UpdateWorkflowExecutionRequest,
+) (_ *UpdateWorkflowExecutionResponse, retError error) {
+
+	// the remote frontend client does not serve this API, the history service of the current
+	// cluster rejects the request if the namespace is not active here
+	cluster := handler.currentClusterName
+	scope, startTime := handler.beforeCall(metrics.DCRedirectionUpdateWorkflowExecutionScope)
+	defer func() {
+		handler.afterCall(scope, startTime, cluster, &retError)
+	}()
+
+	return handler.frontendHandler.UpdateWorkflowExecution(ctx, request)
+}
+
+// GetWorkflowExecutionResult API call
+func (handler *DCRedirectionHandlerImpl) GetWorkflowExecutionResult(
+	ctx context.Context,
+	request *GetWorkflowExecutionResultRequest,
+) (_ *GetWorkflowExecutionResultResponse, retError error) {
+
+	// the remote frontend client does not serve this API, the outcome is read from the
+	// replicated mutable state and history of the current cluster
+	cluster := handler.currentClusterName
+	scope, startTime := handler.beforeCall(metrics.DCRedirectionGetWorkflowExecutionResultScope)
+	defer func() {
+		handler.afterCall(scope, startTime, cluster, &retError)
+	}()
+
+	return handler.frontendHandler.GetWorkflowExecutionResult(ctx, request)
+}
+
+// ResetWorkflowExecutionWithOptions API call
+func (handler *DCRedirectionHandlerImpl) ResetWorkflowExecutionWithOptions(
+	ctx context.Context,
+	request *ResetWorkflowExecutionWithOptionsRequest,
+) (_ *ResetWorkflowExecutionWithOptionsResponse, retError error) {
+
+	// the remote frontend client does not serve this API, the reset is done by the history
+	// service of the current cluster
+	cluster := handler.currentClusterName
+	scope, startTime := handler.beforeCall(metrics.DCRedirectionResetWorkflowExecutionWithOptionsScope)
+	defer func() {
+		handler.afterCall(scope, startTime, cluster, &retError)
+	}()
+
+	return handler.frontendHandler.ResetWorkflowExecutionWithOptions(ctx, request)
+}
+
+// PauseWorkflowExecution API call
+func (handler *DCRedirectionHandlerImpl) PauseWorkflowExecution(
+	ctx context.Context,
+	request *PauseWorkflowExecutionRequest,
+) (_ *PauseWorkflowExecutionResponse, retError error) {
+
+	// the remote frontend client does not serve this API, the history service of the current
+	// cluster rejects the request if the namespace is not active here
+	cluster := handler.currentClusterName
+	scope, startTime := handler.beforeCall(metrics.DCRedirectionPauseWorkflowExecutionScope)
+	defer func() {
+		handler.afterCall(scope, startTime, cluster, &retError)
+	}()
+
+	return handler.frontendHandler.PauseWorkflowExecution(ctx, request)
+}
+
+// UnpauseWorkflowExecution API call
+func (handler *DCRedirectionHandlerImpl) UnpauseWorkflowExecution(
+	ctx context.Context,
+	request *UnpauseWorkflowExecutionRequest,
+) (_ *UnpauseWorkflowExecutionResponse, retError error) {
+
+	// the remote frontend client does not serve this API, the history service of the current
+	// cluster rejects the request if the namespace is not active here
+	cluster := handler.currentClusterName
+	scope, startTime := handler.beforeCall(metrics.DCRedirectionUnpauseWorkflowExecutionScope)
+	defer func() {
+		handler.afterCall(scope, startTime, cluster, &retError)
+	}()
+
+	return handler.frontendHandler.UnpauseWorkflowExecution(ctx, request)
+}
+
+// StartBatchOperation API call
+func (handler *DCRedirectionHandlerImpl) StartBatchOperation(
+	ctx context.Context,
+	request *batcher.StartBatchOperationRequest,
+) (_ *batcher.StartBatchOperationResponse, retError error) {
+
+	// batch operations are backed by workflows of the local system namespace, they are never redirected
+	cluster := handler.currentClusterName
+	scope, startTime := handler.beforeCall(metrics.DCRedirectionStartBatchOperationScope)
+	defer func() {
+		handler.afterCall(scope, startTime, cluster, &retError)
+	}()
+
+	return handler.frontendHandler.StartBatchOperation(ctx, request)
+}
+
+// StopBatchOperation API call
+func (handler *DCRedirectionHandlerImpl) StopBatchOperation(
+	ctx context.Context,
+	request *batcher.StopBatchOperationRequest,
+) (_ *batcher.StopBatchOperationResponse, retError error) {
+
+	// batch operations are backed by workflows of the local system namespace, they are never redirected
+	cluster := handler.currentClusterName
+	scope, startTime := handler.beforeCall(metrics.DCRedirectionStopBatchOperationScope)
+	defer func() {
+		handler.afterCall(scope, startTime, cluster, &retError)
+	}()
+
+	return handler.frontendHandler.StopBatchOperation(ctx, request)
+}
+
+// DescribeBatchOperation API call
+func (handler *DCRedirectionHandlerImpl) DescribeBatchOperation(
+	ctx context.Context,
+	request *batcher.DescribeBatchOperationRequest,
+) (_ *batcher.DescribeBatchOperationResponse, retError error) {
+
+	// batch operations are backed by workflows of the local system namespace, they are never redirected
+	cluster := handler.currentClusterName
+	scope, startTime := handler.beforeCall(metrics.DCRedirectionDescribeBatchOperationScope)
+	defer func() {
+		handler.afterCall(scope, startTime, cluster, &retError)
+	}()
+
+	return handler.frontendHandler.DescribeBatchOperation(ctx, request)
+}
+
+// ListBatchOperations API call
+func (handler *DCRedirectionHandlerImpl) ListBatchOperations(
+	ctx context.Context,
+	request *batcher.ListBatchOperationsRequest,
+) (_ *batcher.ListBatchOperationsResponse, retError error) {
+
+	// batch operations are backed by workflows of the local system namespace, they are never redirected
+	cluster := handler.currentClusterName
+	scope, startTime := handler.beforeCall(metrics.DCRedirectionListBatchOperationsScope)
+	defer func() {
+		handler.afterCall(scope, startTime, cluster, &retError)
+	}()
+
+	return handler.frontendHandler.ListBatchOperations(ctx, request)
+}
+
 func (handler *DCRedirectionHandlerImpl) beforeCall(
 	scope int,
 ) (metrics.Scope, time.Time) {
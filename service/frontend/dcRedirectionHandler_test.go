@@ -69,11 +69,14 @@ type (
 
 	testServerHandler struct {
 		*workflowservicemock.MockWorkflowServiceServer
+		ScheduleHandler
+		WorkflowExecutionHandler
+		BatchOperationHandler
 	}
 )
 
 func newTestServerHandler(mockHandler *workflowservicemock.MockWorkflowServiceServer) Handler {
-	return &testServerHandler{mockHandler}
+	return &testServerHandler{MockWorkflowServiceServer: mockHandler}
 }
 
 func TestDCRedirectionHandlerSuite(t *testing.T) {
@@ -78,14 +78,26 @@ var (
 	errDLQTypeIsNotSupported                              = serviceerror.NewInvalidArgument("The DLQ type is not supported.")
 	errFailureMustHaveApplicationFailureInfo              = serviceerror.NewInvalidArgument("Failure must have ApplicationFailureInfo.")
 	errStatusFilterMustBeNotRunning                       = serviceerror.NewInvalidArgument("StatusFilter must be specified and must be not Running.")
+	errScheduleIDNotSet                                   = serviceerror.NewInvalidArgument("ScheduleId is not set on request.")
+	errScheduleIDTooLong                                  = serviceerror.NewInvalidArgument("ScheduleId length exceeds limit.")
+	errJobIDNotSet                                        = serviceerror.NewInvalidArgument("JobId is not set on request.")
+	errJobIDTooLong                                       = serviceerror.NewInvalidArgument("JobId length exceeds limit.")
+	errVisibilityQueryNotSet                              = serviceerror.NewInvalidArgument("VisibilityQuery is not set on request.")
+	errReasonNotSet                                       = serviceerror.NewInvalidArgument("Reason is not set on request.")
+	errUpdateIDTooLong                                    = serviceerror.NewInvalidArgument("UpdateId length exceeds limit.")
+	errUpdateNameNotSet                                   = serviceerror.NewInvalidArgument("UpdateName is not set on request.")
+	errUpdateNameTooLong                                  = serviceerror.NewInvalidArgument("UpdateName length exceeds limit.")
+	errResetPointAmbiguous                                = serviceerror.NewInvalidArgument("Only one of WorkflowTaskFinishEventId and WorkflowTaskScheduledEventId can be set.")
+	errInvalidResetReapplyType                            = serviceerror.NewInvalidArgument("Invalid ResetReapplyType.")
 	errShuttingDown                                       = serviceerror.NewInternal("Shutting down")
 
 	errFailedUpdateDynamicConfig = serviceerror.NewInternal("Failed to update dynamic config, err: %v.")
 	errFailedToCreateESIndex     = serviceerror.NewInternal("Failed to create ES index, err: %v.")
 	errFailedToUpdateESMapping   = serviceerror.NewInternal("Failed to update ES mapping, err: %v.")
 
-	errNoPermission = serviceerror.NewPermissionDenied("No permission to do this operation.")
-	errUnauthorized = serviceerror.NewPermissionDenied("Request unauthorized.")
+	errNoPermission     = serviceerror.NewPermissionDenied("No permission to do this operation.")
+	errUnauthorized     = serviceerror.NewPermissionDenied("Request unauthorized.")
+	errInvalidAuthToken = serviceerror.NewPermissionDenied("Authorization token is invalid.")
 
 	errServiceBusy = serviceerror.NewResourceExhausted("Too many outstanding requests to the service.")
 )
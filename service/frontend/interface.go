@@ -27,10 +27,14 @@
 package frontend
 
 import (
+	"context"
+
 	"go.temporal.io/api/workflowservice/v1"
 
 	"go.temporal.io/server/common"
 	"go.temporal.io/server/common/resource"
+	"go.temporal.io/server/service/worker/batcher"
+	"go.temporal.io/server/service/worker/scheduler"
 
 	healthpb "google.golang.org/grpc/health/grpc_health_v1"
 )
@@ -39,6 +43,9 @@ type (
 	// Handler is interface wrapping frontend handler
 	Handler interface {
 		workflowservice.WorkflowServiceServer
+		ScheduleHandler
+		WorkflowExecutionHandler
+		BatchOperationHandler
 		common.Daemon
 
 		// Health is the health check method for this rpc handler
@@ -50,4 +57,37 @@ type (
 		GetResource() resource.Resource
 		GetConfig() *Config
 	}
+
+	// ScheduleHandler is the interface of the schedule APIs. Schedules are not part of
+	// workflowservice yet, they are served through adminservice by AdminHandler.
+	ScheduleHandler interface {
+		CreateSchedule(ctx context.Context, request *scheduler.CreateScheduleRequest) (*scheduler.CreateScheduleResponse, error)
+		DescribeSchedule(ctx context.Context, request *scheduler.DescribeScheduleRequest) (*scheduler.DescribeScheduleResponse, error)
+		UpdateSchedule(ctx context.Context, request *scheduler.UpdateScheduleRequest) (*scheduler.UpdateScheduleResponse, error)
+		PauseSchedule(ctx context.Context, request *scheduler.PauseScheduleRequest) (*scheduler.PauseScheduleResponse, error)
+		UnpauseSchedule(ctx context.Context, request *scheduler.UnpauseScheduleRequest) (*scheduler.UnpauseScheduleResponse, error)
+		TriggerSchedule(ctx context.Context, request *scheduler.TriggerScheduleRequest) (*scheduler.TriggerScheduleResponse, error)
+		DeleteSchedule(ctx context.Context, request *scheduler.DeleteScheduleRequest) (*scheduler.DeleteScheduleResponse, error)
+		ListSchedules(ctx context.Context, request *scheduler.ListSchedulesRequest) (*scheduler.ListSchedulesResponse, error)
+	}
+
+	// WorkflowExecutionHandler is the interface of the workflow execution APIs which are not
+	// part of workflowservice yet, they are served through adminservice by AdminHandler.
+	WorkflowExecutionHandler interface {
+		DeleteWorkflowExecution(ctx context.Context, request *DeleteWorkflowExecutionRequest) (*DeleteWorkflowExecutionResponse, error)
+		UpdateWorkflowExecution(ctx context.Context, request *UpdateWorkflowExecutionRequest) (*UpdateWorkflowExecutionResponse, error)
+		GetWorkflowExecutionResult(ctx context.Context, request *GetWorkflowExecutionResultRequest) (*GetWorkflowExecutionResultResponse, error)
+		ResetWorkflowExecutionWithOptions(ctx context.Context, request *ResetWorkflowExecutionWithOptionsRequest) (*ResetWorkflowExecutionWithOptionsResponse, error)
+		PauseWorkflowExecution(ctx context.Context, request *PauseWorkflowExecutionRequest) (*PauseWorkflowExecutionResponse, error)
+		UnpauseWorkflowExecution(ctx context.Context, request *UnpauseWorkflowExecutionRequest) (*UnpauseWorkflowExecutionResponse, error)
+	}
+
+	// BatchOperationHandler is the interface of the batch operation APIs. Batch operations are not
+	// part of workflowservice yet, they are served through adminservice by AdminHandler.
+	BatchOperationHandler interface {
+		StartBatchOperation(ctx context.Context, request *batcher.StartBatchOperationRequest) (*batcher.StartBatchOperationResponse, error)
+		StopBatchOperation(ctx context.Context, request *batcher.StopBatchOperationRequest) (*batcher.StopBatchOperationResponse, error)
+		DescribeBatchOperation(ctx context.Context, request *batcher.DescribeBatchOperationRequest) (*batcher.DescribeBatchOperationResponse, error)
+		ListBatchOperations(ctx context.Context, request *batcher.ListBatchOperationsRequest) (*batcher.ListBatchOperationsResponse, error)
+	}
 )
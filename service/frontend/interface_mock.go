@@ -35,6 +35,8 @@ import (
 	gomock "github.com/golang/mock/gomock"
 	v1 "go.temporal.io/api/workflowservice/v1"
 	resource "go.temporal.io/server/common/resource"
+	batcher "go.temporal.io/server/service/worker/batcher"
+	scheduler "go.temporal.io/server/service/worker/scheduler"
 	grpc_health_v1 "google.golang.org/grpc/health/grpc_health_v1"
 )
 
@@ -631,6 +633,276 @@ func (mr *MockHandlerMockRecorder) ListTaskQueuePartitions(arg0, arg1 interface{
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListTaskQueuePartitions", reflect.TypeOf((*MockHandler)(nil).ListTaskQueuePartitions), arg0, arg1)
 }
 
+// CreateSchedule mocks base method.
+func (m *MockHandler) CreateSchedule(ctx context.Context, request *scheduler.CreateScheduleRequest) (*scheduler.CreateScheduleResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateSchedule", ctx, request)
+	ret0, _ := ret[0].(*scheduler.CreateScheduleResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateSchedule indicates an expected call of CreateSchedule.
+func (mr *MockHandlerMockRecorder) CreateSchedule(ctx, request interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateSchedule", reflect.TypeOf((*MockHandler)(nil).CreateSchedule), ctx, request)
+}
+
+// DescribeSchedule mocks base method.
+func (m *MockHandler) DescribeSchedule(ctx context.Context, request *scheduler.DescribeScheduleRequest) (*scheduler.DescribeScheduleResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DescribeSchedule", ctx, request)
+	ret0, _ := ret[0].(*scheduler.DescribeScheduleResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DescribeSchedule indicates an expected call of DescribeSchedule.
+func (mr *MockHandlerMockRecorder) DescribeSchedule(ctx, request interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DescribeSchedule", reflect.TypeOf((*MockHandler)(nil).DescribeSchedule), ctx, request)
+}
+
+// UpdateSchedule mocks base method.
+func (m *MockHandler) UpdateSchedule(ctx context.Context, request *scheduler.UpdateScheduleRequest) (*scheduler.UpdateScheduleResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateSchedule", ctx, request)
+	ret0, _ := ret[0].(*scheduler.UpdateScheduleResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpdateSchedule indicates an expected call of UpdateSchedule.
+func (mr *MockHandlerMockRecorder) UpdateSchedule(ctx, request interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateSchedule", reflect.TypeOf((*MockHandler)(nil).UpdateSchedule), ctx, request)
+}
+
+// PauseSchedule mocks base method.
+func (m *MockHandler) PauseSchedule(ctx context.Context, request *scheduler.PauseScheduleRequest) (*scheduler.PauseScheduleResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "PauseSchedule", ctx, request)
+	ret0, _ := ret[0].(*scheduler.PauseScheduleResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// PauseSchedule indicates an expected call of PauseSchedule.
+func (mr *MockHandlerMockRecorder) PauseSchedule(ctx, request interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PauseSchedule", reflect.TypeOf((*MockHandler)(nil).PauseSchedule), ctx, request)
+}
+
+// UnpauseSchedule mocks base method.
+func (m *MockHandler) UnpauseSchedule(ctx context.Context, request *scheduler.UnpauseScheduleRequest) (*scheduler.UnpauseScheduleResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UnpauseSchedule", ctx, request)
+	ret0, _ := ret[0].(*scheduler.UnpauseScheduleResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UnpauseSchedule indicates an expected call of UnpauseSchedule.
+func (mr *MockHandlerMockRecorder) UnpauseSchedule(ctx, request interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UnpauseSchedule", reflect.TypeOf((*MockHandler)(nil).UnpauseSchedule), ctx, request)
+}
+
+// TriggerSchedule mocks base method.
+func (m *MockHandler) TriggerSchedule(ctx context.Context, request *scheduler.TriggerScheduleRequest) (*scheduler.TriggerScheduleResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "TriggerSchedule", ctx, request)
+	ret0, _ := ret[0].(*scheduler.TriggerScheduleResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// TriggerSchedule indicates an expected call of TriggerSchedule.
+func (mr *MockHandlerMockRecorder) TriggerSchedule(ctx, request interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "TriggerSchedule", reflect.TypeOf((*MockHandler)(nil).TriggerSchedule), ctx, request)
+}
+
+// DeleteSchedule mocks base method.
+func (m *MockHandler) DeleteSchedule(ctx context.Context, request *scheduler.DeleteScheduleRequest) (*scheduler.DeleteScheduleResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteSchedule", ctx, request)
+	ret0, _ := ret[0].(*scheduler.DeleteScheduleResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DeleteSchedule indicates an expected call of DeleteSchedule.
+func (mr *MockHandlerMockRecorder) DeleteSchedule(ctx, request interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteSchedule", reflect.TypeOf((*MockHandler)(nil).DeleteSchedule), ctx, request)
+}
+
+// ListSchedules mocks base method.
+func (m *MockHandler) ListSchedules(ctx context.Context, request *scheduler.ListSchedulesRequest) (*scheduler.ListSchedulesResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListSchedules", ctx, request)
+	ret0, _ := ret[0].(*scheduler.ListSchedulesResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListSchedules indicates an expected call of ListSchedules.
+func (mr *MockHandlerMockRecorder) ListSchedules(ctx, request interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListSchedules", reflect.TypeOf((*MockHandler)(nil).ListSchedules), ctx, request)
+}
+
+// DeleteWorkflowExecution mocks base method.
+func (m *MockHandler) DeleteWorkflowExecution(ctx context.Context, request *DeleteWorkflowExecutionRequest) (*DeleteWorkflowExecutionResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteWorkflowExecution", ctx, request)
+	ret0, _ := ret[0].(*DeleteWorkflowExecutionResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DeleteWorkflowExecution indicates an expected call of DeleteWorkflowExecution.
+func (mr *MockHandlerMockRecorder) DeleteWorkflowExecution(ctx, request interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteWorkflowExecution", reflect.TypeOf((*MockHandler)(nil).DeleteWorkflowExecution), ctx, request)
+}
+
+// UpdateWorkflowExecution mocks base method.
+func (m *MockHandler) UpdateWorkflowExecution(ctx context.Context, request *UpdateWorkflowExecutionRequest) (*UpdateWorkflowExecutionResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateWorkflowExecution", ctx, request)
+	ret0, _ := ret[0].(*UpdateWorkflowExecutionResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpdateWorkflowExecution indicates an expected call of UpdateWorkflowExecution.
+func (mr *MockHandlerMockRecorder) UpdateWorkflowExecution(ctx, request interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateWorkflowExecution", reflect.TypeOf((*MockHandler)(nil).UpdateWorkflowExecution), ctx, request)
+}
+
+// GetWorkflowExecutionResult mocks base method.
+func (m *MockHandler) GetWorkflowExecutionResult(ctx context.Context, request *GetWorkflowExecutionResultRequest) (*GetWorkflowExecutionResultResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetWorkflowExecutionResult", ctx, request)
+	ret0, _ := ret[0].(*GetWorkflowExecutionResultResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetWorkflowExecutionResult indicates an expected call of GetWorkflowExecutionResult.
+func (mr *MockHandlerMockRecorder) GetWorkflowExecutionResult(ctx, request interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetWorkflowExecutionResult", reflect.TypeOf((*MockHandler)(nil).GetWorkflowExecutionResult), ctx, request)
+}
+
+// ResetWorkflowExecutionWithOptions mocks base method.
+func (m *MockHandler) ResetWorkflowExecutionWithOptions(ctx context.Context, request *ResetWorkflowExecutionWithOptionsRequest) (*ResetWorkflowExecutionWithOptionsResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ResetWorkflowExecutionWithOptions", ctx, request)
+	ret0, _ := ret[0].(*ResetWorkflowExecutionWithOptionsResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ResetWorkflowExecutionWithOptions indicates an expected call of ResetWorkflowExecutionWithOptions.
+func (mr *MockHandlerMockRecorder) ResetWorkflowExecutionWithOptions(ctx, request interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ResetWorkflowExecutionWithOptions", reflect.TypeOf((*MockHandler)(nil).ResetWorkflowExecutionWithOptions), ctx, request)
+}
+
+// PauseWorkflowExecution mocks base method.
+func (m *MockHandler) PauseWorkflowExecution(ctx context.Context, request *PauseWorkflowExecutionRequest) (*PauseWorkflowExecutionResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "PauseWorkflowExecution", ctx, request)
+	ret0, _ := ret[0].(*PauseWorkflowExecutionResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// PauseWorkflowExecution indicates an expected call of PauseWorkflowExecution.
+func (mr *MockHandlerMockRecorder) PauseWorkflowExecution(ctx, request interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PauseWorkflowExecution", reflect.TypeOf((*MockHandler)(nil).PauseWorkflowExecution), ctx, request)
+}
+
+// UnpauseWorkflowExecution mocks base method.
+func (m *MockHandler) UnpauseWorkflowExecution(ctx context.Context, request *UnpauseWorkflowExecutionRequest) (*UnpauseWorkflowExecutionResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UnpauseWorkflowExecution", ctx, request)
+	ret0, _ := ret[0].(*UnpauseWorkflowExecutionResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UnpauseWorkflowExecution indicates an expected call of UnpauseWorkflowExecution.
+func (mr *MockHandlerMockRecorder) UnpauseWorkflowExecution(ctx, request interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UnpauseWorkflowExecution", reflect.TypeOf((*MockHandler)(nil).UnpauseWorkflowExecution), ctx, request)
+}
+
+// StartBatchOperation mocks base method.
+func (m *MockHandler) StartBatchOperation(ctx context.Context, request *batcher.StartBatchOperationRequest) (*batcher.StartBatchOperationResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "StartBatchOperation", ctx, request)
+	ret0, _ := ret[0].(*batcher.StartBatchOperationResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// StartBatchOperation indicates an expected call of StartBatchOperation.
+func (mr *MockHandlerMockRecorder) StartBatchOperation(ctx, request interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "StartBatchOperation", reflect.TypeOf((*MockHandler)(nil).StartBatchOperation), ctx, request)
+}
+
+// StopBatchOperation mocks base method.
+func (m *MockHandler) StopBatchOperation(ctx context.Context, request *batcher.StopBatchOperationRequest) (*batcher.StopBatchOperationResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "StopBatchOperation", ctx, request)
+	ret0, _ := ret[0].(*batcher.StopBatchOperationResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// StopBatchOperation indicates an expected call of StopBatchOperation.
+func (mr *MockHandlerMockRecorder) StopBatchOperation(ctx, request interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "StopBatchOperation", reflect.TypeOf((*MockHandler)(nil).StopBatchOperation), ctx, request)
+}
+
+// DescribeBatchOperation mocks base method.
+func (m *MockHandler) DescribeBatchOperation(ctx context.Context, request *batcher.DescribeBatchOperationRequest) (*batcher.DescribeBatchOperationResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DescribeBatchOperation", ctx, request)
+	ret0, _ := ret[0].(*batcher.DescribeBatchOperationResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DescribeBatchOperation indicates an expected call of DescribeBatchOperation.
+func (mr *MockHandlerMockRecorder) DescribeBatchOperation(ctx, request interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DescribeBatchOperation", reflect.TypeOf((*MockHandler)(nil).DescribeBatchOperation), ctx, request)
+}
+
+// ListBatchOperations mocks base method.
+func (m *MockHandler) ListBatchOperations(ctx context.Context, request *batcher.ListBatchOperationsRequest) (*batcher.ListBatchOperationsResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListBatchOperations", ctx, request)
+	ret0, _ := ret[0].(*batcher.ListBatchOperationsResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListBatchOperations indicates an expected call of ListBatchOperations.
+func (mr *MockHandlerMockRecorder) ListBatchOperations(ctx, request interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListBatchOperations", reflect.TypeOf((*MockHandler)(nil).ListBatchOperations), ctx, request)
+}
+
 // Start mocks base method.
 func (m *MockHandler) Start() {
 	m.ctrl.T.Helper()
@@ -723,3 +995,342 @@ func (mr *MockHandlerMockRecorder) GetConfig() *gomock.Call {
 	mr.mock.ctrl.T.Helper()
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetConfig", reflect.TypeOf((*MockHandler)(nil).GetConfig))
 }
+
+// MockScheduleHandler is a mock of ScheduleHandler interface.
+type MockScheduleHandler struct {
+	ctrl     *gomock.Controller
+	recorder *MockScheduleHandlerMockRecorder
+}
+
+// MockScheduleHandlerMockRecorder is the mock recorder for MockScheduleHandler.
+type MockScheduleHandlerMockRecorder struct {
+	mock *MockScheduleHandler
+}
+
+// NewMockScheduleHandler creates a new mock instance.
+func NewMockScheduleHandler(ctrl *gomock.Controller) *MockScheduleHandler {
+	mock := &MockScheduleHandler{ctrl: ctrl}
+	mock.recorder = &MockScheduleHandlerMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockScheduleHandler) EXPECT() *MockScheduleHandlerMockRecorder {
+	return m.recorder
+}
+
+// CreateSchedule mocks base method.
+func (m *MockScheduleHandler) CreateSchedule(ctx context.Context, request *scheduler.CreateScheduleRequest) (*scheduler.CreateScheduleResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateSchedule", ctx, request)
+	ret0, _ := ret[0].(*scheduler.CreateScheduleResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateSchedule indicates an expected call of CreateSchedule.
+func (mr *MockScheduleHandlerMockRecorder) CreateSchedule(ctx, request interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateSchedule", reflect.TypeOf((*MockScheduleHandler)(nil).CreateSchedule), ctx, request)
+}
+
+// DescribeSchedule mocks base method.
+func (m *MockScheduleHandler) DescribeSchedule(ctx context.Context, request *scheduler.DescribeScheduleRequest) (*scheduler.DescribeScheduleResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DescribeSchedule", ctx, request)
+	ret0, _ := ret[0].(*scheduler.DescribeScheduleResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DescribeSchedule indicates an expected call of DescribeSchedule.
+func (mr *MockScheduleHandlerMockRecorder) DescribeSchedule(ctx, request interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DescribeSchedule", reflect.TypeOf((*MockScheduleHandler)(nil).DescribeSchedule), ctx, request)
+}
+
+// UpdateSchedule mocks base method.
+func (m *MockScheduleHandler) UpdateSchedule(ctx context.Context, request *scheduler.UpdateScheduleRequest) (*scheduler.UpdateScheduleResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateSchedule", ctx, request)
+	ret0, _ := ret[0].(*scheduler.UpdateScheduleResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpdateSchedule indicates an expected call of UpdateSchedule.
+func (mr *MockScheduleHandlerMockRecorder) UpdateSchedule(ctx, request interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateSchedule", reflect.TypeOf((*MockScheduleHandler)(nil).UpdateSchedule), ctx, request)
+}
+
+// PauseSchedule mocks base method.
+func (m *MockScheduleHandler) PauseSchedule(ctx context.Context, request *scheduler.PauseScheduleRequest) (*scheduler.PauseScheduleResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "PauseSchedule", ctx, request)
+	ret0, _ := ret[0].(*scheduler.PauseScheduleResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// PauseSchedule indicates an expected call of PauseSchedule.
+func (mr *MockScheduleHandlerMockRecorder) PauseSchedule(ctx, request interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PauseSchedule", reflect.TypeOf((*MockScheduleHandler)(nil).PauseSchedule), ctx, request)
+}
+
+// UnpauseSchedule mocks base method.
+func (m *MockScheduleHandler) UnpauseSchedule(ctx context.Context, request *scheduler.UnpauseScheduleRequest) (*scheduler.UnpauseScheduleResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UnpauseSchedule", ctx, request)
+	ret0, _ := ret[0].(*scheduler.UnpauseScheduleResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UnpauseSchedule indicates an expected call of UnpauseSchedule.
+func (mr *MockScheduleHandlerMockRecorder) UnpauseSchedule(ctx, request interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UnpauseSchedule", reflect.TypeOf((*MockScheduleHandler)(nil).UnpauseSchedule), ctx, request)
+}
+
+// TriggerSchedule mocks base method.
+func (m *MockScheduleHandler) TriggerSchedule(ctx context.Context, request *scheduler.TriggerScheduleRequest) (*scheduler.TriggerScheduleResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "TriggerSchedule", ctx, request)
+	ret0, _ := ret[0].(*scheduler.TriggerScheduleResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// TriggerSchedule indicates an expected call of TriggerSchedule.
+func (mr *MockScheduleHandlerMockRecorder) TriggerSchedule(ctx, request interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "TriggerSchedule", reflect.TypeOf((*MockScheduleHandler)(nil).TriggerSchedule), ctx, request)
+}
+
+// DeleteSchedule mocks base method.
+func (m *MockScheduleHandler) DeleteSchedule(ctx context.Context, request *scheduler.DeleteScheduleRequest) (*scheduler.DeleteScheduleResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteSchedule", ctx, request)
+	ret0, _ := ret[0].(*scheduler.DeleteScheduleResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DeleteSchedule indicates an expected call of DeleteSchedule.
+func (mr *MockScheduleHandlerMockRecorder) DeleteSchedule(ctx, request interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteSchedule", reflect.TypeOf((*MockScheduleHandler)(nil).DeleteSchedule), ctx, request)
+}
+
+// ListSchedules mocks base method.
+func (m *MockScheduleHandler) ListSchedules(ctx context.Context, request *scheduler.ListSchedulesRequest) (*scheduler.ListSchedulesResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListSchedules", ctx, request)
+	ret0, _ := ret[0].(*scheduler.ListSchedulesResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListSchedules indicates an expected call of ListSchedules.
+func (mr *MockScheduleHandlerMockRecorder) ListSchedules(ctx, request interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListSchedules", reflect.TypeOf((*MockScheduleHandler)(nil).ListSchedules), ctx, request)
+}
+
+// MockWorkflowExecutionHandler is a mock of WorkflowExecutionHandler interface.
+type MockWorkflowExecutionHandler struct {
+	ctrl     *gomock.Controller
+	recorder *MockWorkflowExecutionHandlerMockRecorder
+}
+
+// MockWorkflowExecutionHandlerMockRecorder is the mock recorder for MockWorkflowExecutionHandler.
+type MockWorkflowExecutionHandlerMockRecorder struct {
+	mock *MockWorkflowExecutionHandler
+}
+
+// NewMockWorkflowExecutionHandler creates a new mock instance.
+func NewMockWorkflowExecutionHandler(ctrl *gomock.Controller) *MockWorkflowExecutionHandler {
+	mock := &MockWorkflowExecutionHandler{ctrl: ctrl}
+	mock.recorder = &MockWorkflowExecutionHandlerMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockWorkflowExecutionHandler) EXPECT() *MockWorkflowExecutionHandlerMockRecorder {
+	return m.recorder
+}
+
+// DeleteWorkflowExecution mocks base method.
+func (m *MockWorkflowExecutionHandler) DeleteWorkflowExecution(ctx context.Context, request *DeleteWorkflowExecutionRequest) (*DeleteWorkflowExecutionResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteWorkflowExecution", ctx, request)
+	ret0, _ := ret[0].(*DeleteWorkflowExecutionResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DeleteWorkflowExecution indicates an expected call of DeleteWorkflowExecution.
+func (mr *MockWorkflowExecutionHandlerMockRecorder) DeleteWorkflowExecution(ctx, request interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteWorkflowExecution", reflect.TypeOf((*MockWorkflowExecutionHandler)(nil).DeleteWorkflowExecution), ctx, request)
+}
+
+// UpdateWorkflowExecution mocks base method.
+func (m *MockWorkflowExecutionHandler) UpdateWorkflowExecution(ctx context.Context, request *UpdateWorkflowExecutionRequest) (*UpdateWorkflowExecutionResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateWorkflowExecution", ctx, request)
+	ret0, _ := ret[0].(*UpdateWorkflowExecutionResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpdateWorkflowExecution indicates an expected call of UpdateWorkflowExecution.
+func (mr *MockWorkflowExecutionHandlerMockRecorder) UpdateWorkflowExecution(ctx, request interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateWorkflowExecution", reflect.TypeOf((*MockWorkflowExecutionHandler)(nil).UpdateWorkflowExecution), ctx, request)
+}
+
+// GetWorkflowExecutionResult mocks base method.
+func (m *MockWorkflowExecutionHandler) GetWorkflowExecutionResult(ctx context.Context, request *GetWorkflowExecutionResultRequest) (*GetWorkflowExecutionResultResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetWorkflowExecutionResult", ctx, request)
+	ret0, _ := ret[0].(*GetWorkflowExecutionResultResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetWorkflowExecutionResult indicates an expected call of GetWorkflowExecutionResult.
+func (mr *MockWorkflowExecutionHandlerMockRecorder) GetWorkflowExecutionResult(ctx, request interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetWorkflowExecutionResult", reflect.TypeOf((*MockWorkflowExecutionHandler)(nil).GetWorkflowExecutionResult), ctx, request)
+}
+
+// ResetWorkflowExecutionWithOptions mocks base method.
+func (m *MockWorkflowExecutionHandler) ResetWorkflowExecutionWithOptions(ctx context.Context, request *ResetWorkflowExecutionWithOptionsRequest) (*ResetWorkflowExecutionWithOptionsResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ResetWorkflowExecutionWithOptions", ctx, request)
+	ret0, _ := ret[0].(*ResetWorkflowExecutionWithOptionsResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ResetWorkflowExecutionWithOptions indicates an expected call of ResetWorkflowExecutionWithOptions.
+func (mr *MockWorkflowExecutionHandlerMockRecorder) ResetWorkflowExecutionWithOptions(ctx, request interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ResetWorkflowExecutionWithOptions", reflect.TypeOf((*MockWorkflowExecutionHandler)(nil).ResetWorkflowExecutionWithOptions), ctx, request)
+}
+
+// PauseWorkflowExecution mocks base method.
+func (m *MockWorkflowExecutionHandler) PauseWorkflowExecution(ctx context.Context, request *PauseWorkflowExecutionRequest) (*PauseWorkflowExecutionResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "PauseWorkflowExecution", ctx, request)
+	ret0, _ := ret[0].(*PauseWorkflowExecutionResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// PauseWorkflowExecution indicates an expected call of PauseWorkflowExecution.
+func (mr *MockWorkflowExecutionHandlerMockRecorder) PauseWorkflowExecution(ctx, request interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PauseWorkflowExecution", reflect.TypeOf((*MockWorkflowExecutionHandler)(nil).PauseWorkflowExecution), ctx, request)
+}
+
+// UnpauseWorkflowExecution mocks base method.
+func (m *MockWorkflowExecutionHandler) UnpauseWorkflowExecution(ctx context.Context, request *UnpauseWorkflowExecutionRequest) (*UnpauseWorkflowExecutionResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UnpauseWorkflowExecution", ctx, request)
+	ret0, _ := ret[0].(*UnpauseWorkflowExecutionResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UnpauseWorkflowExecution indicates an expected call of UnpauseWorkflowExecution.
+func (mr *MockWorkflowExecutionHandlerMockRecorder) UnpauseWorkflowExecution(ctx, request interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UnpauseWorkflowExecution", reflect.TypeOf((*MockWorkflowExecutionHandler)(nil).UnpauseWorkflowExecution), ctx, request)
+}
+
+// MockBatchOperationHandler is a mock of BatchOperationHandler interface.
+type MockBatchOperationHandler struct {
+	ctrl     *gomock.Controller
+	recorder *MockBatchOperationHandlerMockRecorder
+}
+
+// MockBatchOperationHandlerMockRecorder is the mock recorder for MockBatchOperationHandler.
+type MockBatchOperationHandlerMockRecorder struct {
+	mock *MockBatchOperationHandler
+}
+
+// NewMockBatchOperationHandler creates a new mock instance.
+func NewMockBatchOperationHandler(ctrl *gomock.Controller) *MockBatchOperationHandler {
+	mock := &MockBatchOperationHandler{ctrl: ctrl}
+	mock.recorder = &MockBatchOperationHandlerMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockBatchOperationHandler) EXPECT() *MockBatchOperationHandlerMockRecorder {
+	return m.recorder
+}
+
+// StartBatchOperation mocks base method.
+func (m *MockBatchOperationHandler) StartBatchOperation(ctx context.Context, request *batcher.StartBatchOperationRequest) (*batcher.StartBatchOperationResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "StartBatchOperation", ctx, request)
+	ret0, _ := ret[0].(*batcher.StartBatchOperationResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// StartBatchOperation indicates an expected call of StartBatchOperation.
+func (mr *MockBatchOperationHandlerMockRecorder) StartBatchOperation(ctx, request interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "StartBatchOperation", reflect.TypeOf((*MockBatchOperationHandler)(nil).StartBatchOperation), ctx, request)
+}
+
+// StopBatchOperation mocks base method.
+func (m *MockBatchOperationHandler) StopBatchOperation(ctx context.Context, request *batcher.StopBatchOperationRequest) (*batcher.StopBatchOperationResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "StopBatchOperation", ctx, request)
+	ret0, _ := ret[0].(*batcher.StopBatchOperationResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// StopBatchOperation indicates an expected call of StopBatchOperation.
+func (mr *MockBatchOperationHandlerMockRecorder) StopBatchOperation(ctx, request interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "StopBatchOperation", reflect.TypeOf((*MockBatchOperationHandler)(nil).StopBatchOperation), ctx, request)
+}
+
+// DescribeBatchOperation mocks base method.
+func (m *MockBatchOperationHandler) DescribeBatchOperation(ctx context.Context, request *batcher.DescribeBatchOperationRequest) (*batcher.DescribeBatchOperationResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DescribeBatchOperation", ctx, request)
+	ret0, _ := ret[0].(*batcher.DescribeBatchOperationResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DescribeBatchOperation indicates an expected call of DescribeBatchOperation.
+func (mr *MockBatchOperationHandlerMockRecorder) DescribeBatchOperation(ctx, request interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DescribeBatchOperation", reflect.TypeOf((*MockBatchOperationHandler)(nil).DescribeBatchOperation), ctx, request)
+}
+
+// ListBatchOperations mocks base method.
+func (m *MockBatchOperationHandler) ListBatchOperations(ctx context.Context, request *batcher.ListBatchOperationsRequest) (*batcher.ListBatchOperationsResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListBatchOperations", ctx, request)
+	ret0, _ := ret[0].(*batcher.ListBatchOperationsResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListBatchOperations indicates an expected call of ListBatchOperations.
+func (mr *MockBatchOperationHandlerMockRecorder) ListBatchOperations(ctx, request interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListBatchOperations", reflect.TypeOf((*MockBatchOperationHandler)(nil).ListBatchOperations), ctx, request)
+}
@@ -0,0 +1,353 @@
+// The MIT License
+//
+// Copyright (c) 2020 Temporal Technologies Inc.  All rights reserved.
+//
+// Copyright (c) 2017 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package frontend
+
+import (
+	commonpb "go.temporal.io/api/common/v1"
+	enumspb "go.temporal.io/api/enums/v1"
+	failurepb "go.temporal.io/api/failure/v1"
+	historypb "go.temporal.io/api/history/v1"
+	workflowpb "go.temporal.io/api/workflow/v1"
+)
+
+const (
+	// UpdateStageCompleted waits until the workflow completed the update, it is the default
+	UpdateStageCompleted UpdateStage = iota
+	// UpdateStageAccepted waits only until the workflow validated and accepted the update
+	UpdateStageAccepted
+)
+
+const (
+	// ResetReapplyTypeAll reapplies the events received after the reset point, it is the default
+	ResetReapplyTypeAll ResetReapplyType = iota
+	// ResetReapplyTypeExcludeSignals reapplies the events received after the reset point other than signals
+	ResetReapplyTypeExcludeSignals
+	// ResetReapplyTypeNone reapplies no events received after the reset point
+	ResetReapplyTypeNone
+)
+
+type (
+	// UpdateStage is the stage of an update a caller waits for
+	UpdateStage int
+
+	// ResetReapplyType is the kind of events received after the reset point which are reapplied to
+	// the reset run
+	ResetReapplyType int
+
+	// DeleteWorkflowExecutionRequest is the request to delete a workflow execution. A running
+	// execution is terminated first.
+	DeleteWorkflowExecutionRequest struct {
+		Namespace         string
+		WorkflowExecution *commonpb.WorkflowExecution
+		Identity          string
+	}
+
+	// DeleteWorkflowExecutionResponse is the response to DeleteWorkflowExecutionRequest
+	DeleteWorkflowExecutionResponse struct{}
+
+	// UpdateWorkflowExecutionRequest is the request to send an update to a workflow execution and
+	// wait for its outcome.
+	UpdateWorkflowExecutionRequest struct {
+		Namespace         string
+		WorkflowExecution *commonpb.WorkflowExecution
+		// UpdateID identifies the update, requests with the ID of an update in flight share its
+		// outcome. A random ID is used if it is not set.
+		UpdateID  string
+		Name      string
+		Input     *commonpb.Payloads
+		Identity  string
+		WaitStage UpdateStage
+	}
+
+	// UpdateWorkflowExecutionResponse is the response to UpdateWorkflowExecutionRequest
+	UpdateWorkflowExecutionResponse struct {
+		UpdateID string
+		// Stage is the stage the update reached, Result is only set once it is completed
+		Stage  UpdateStage
+		Result *commonpb.Payloads
+		// Failure is set if the workflow rejected the update or failed to complete it
+		Failure *failurepb.Failure
+	}
+
+	// GetWorkflowExecutionResultRequest is the request to long poll for the outcome of a workflow
+	// execution. The current run is used if the run ID is not set.
+	GetWorkflowExecutionResultRequest struct {
+		Namespace         string
+		WorkflowExecution *commonpb.WorkflowExecution
+		// FollowRuns waits for the outcome of the last run of the chain started by continue-as-new,
+		// which includes retries and cron runs.
+		FollowRuns bool
+	}
+
+	// GetWorkflowExecutionResultResponse is the response to GetWorkflowExecutionResultRequest
+	GetWorkflowExecutionResultResponse struct {
+		// WorkflowExecution is the run the outcome belongs to
+		WorkflowExecution *commonpb.WorkflowExecution
+		// Status is running if the poll expired before the workflow closed, the caller is
+		// expected to poll again
+		Status enumspb.WorkflowExecutionStatus
+		// Result is set if the workflow completed
+		Result *commonpb.Payloads
+		// Failure is set if the workflow failed, timed out, was canceled or terminated
+		Failure *failurepb.Failure
+		// NewExecutionRunID is the run which continued the workflow if runs are not followed
+		NewExecutionRunID string
+	}
+
+	// ResetWorkflowExecutionWithOptionsRequest is the request to reset a workflow execution with
+	// control over the reset point and the reapplied events.
+	ResetWorkflowExecutionWithOptionsRequest struct {
+		Namespace         string
+		WorkflowExecution *commonpb.WorkflowExecution
+		Reason            string
+		RequestID         string
+		// WorkflowTaskFinishEventID is the event the run is reset to, as in ResetWorkflowExecution
+		WorkflowTaskFinishEventID int64
+		// WorkflowTaskScheduledEventID resets to the workflow task scheduled with this event ID
+		// instead. The run may be any run of the continue-as-new chain of the current run.
+		WorkflowTaskScheduledEventID int64
+		ReapplyType                  ResetReapplyType
+		// DryRun only returns a preview of the reset, nothing is persisted
+		DryRun bool
+	}
+
+	// ResetWorkflowExecutionWithOptionsResponse is the response to ResetWorkflowExecutionWithOptionsRequest
+	ResetWorkflowExecutionWithOptionsResponse struct {
+		// RunID is the reset run, it is not set for a dry run
+		RunID string
+		// Preview is only set for a dry run
+		Preview *ResetWorkflowExecutionPreview
+	}
+
+	// ResetWorkflowExecutionPreview describes what a reset would do
+	ResetWorkflowExecutionPreview struct {
+		BaseRunID                 string
+		WorkflowTaskFinishEventID int64
+		// TerminatedRunID is the current run which is terminated, it is empty if the run is closed
+		TerminatedRunID string
+		// DroppedEvents are the events of the base run after the reset point
+		DroppedEvents []*historypb.HistoryEvent
+		// FailedActivities are the activities started before the reset point, they are failed in
+		// the reset run
+		FailedActivities []*workflowpb.PendingActivityInfo
+		// PendingChildren are the child workflows pending at the reset point, a reset with pending
+		// children is rejected
+		PendingChildren []*workflowpb.PendingChildExecutionInfo
+		// ReappliedEvents are the events received after the reset point which are reapplied
+		ReappliedEvents []*historypb.HistoryEvent
+	}
+
+	// PauseWorkflowExecutionRequest is the request to pause a workflow execution. The workflow and
+	// activity tasks of a paused workflow execution are not dispatched, timers still fire and
+	// signals are still accepted.
+	PauseWorkflowExecutionRequest struct {
+		Namespace         string
+		WorkflowExecution *commonpb.WorkflowExecution
+		Identity          string
+		Reason            string
+	}
+
+	// PauseWorkflowExecutionResponse is the response to PauseWorkflowExecutionRequest
+	PauseWorkflowExecutionResponse struct{}
+
+	// UnpauseWorkflowExecutionRequest is the request to resume the dispatch of the tasks of a
+	// paused workflow execution.
+	UnpauseWorkflowExecutionRequest struct {
+		Namespace         string
+		WorkflowExecution *commonpb.WorkflowExecution
+		Identity          string
+	}
+
+	// UnpauseWorkflowExecutionResponse is the response to UnpauseWorkflowExecutionRequest
+	UnpauseWorkflowExecutionResponse struct{}
+)
+
+// GetNamespace returns the namespace of the request, it is safe to call on nil
+func (r *DeleteWorkflowExecutionRequest) GetNamespace() string {
+	if r == nil {
+		return ""
+	}
+	return r.Namespace
+}
+
+// GetWorkflowExecution returns the workflow execution of the request, it is safe to call on nil
+func (r *DeleteWorkflowExecutionRequest) GetWorkflowExecution() *commonpb.WorkflowExecution {
+	if r == nil {
+		return nil
+	}
+	return r.WorkflowExecution
+}
+
+// GetIdentity returns the identity of the caller, it is safe to call on nil
+func (r *DeleteWorkflowExecutionRequest) GetIdentity() string {
+	if r == nil {
+		return ""
+	}
+	return r.Identity
+}
+
+// GetNamespace returns the namespace of the request, it is safe to call on nil
+func (r *UpdateWorkflowExecutionRequest) GetNamespace() string {
+	if r == nil {
+		return ""
+	}
+	return r.Namespace
+}
+
+// GetWorkflowExecution returns the workflow execution of the request, it is safe to call on nil
+func (r *UpdateWorkflowExecutionRequest) GetWorkflowExecution() *commonpb.WorkflowExecution {
+	if r == nil {
+		return nil
+	}
+	return r.WorkflowExecution
+}
+
+// GetUpdateID returns the update ID of the request, it is safe to call on nil
+func (r *UpdateWorkflowExecutionRequest) GetUpdateID() string {
+	if r == nil {
+		return ""
+	}
+	return r.UpdateID
+}
+
+// GetName returns the update name of the request, it is safe to call on nil
+func (r *UpdateWorkflowExecutionRequest) GetName() string {
+	if r == nil {
+		return ""
+	}
+	return r.Name
+}
+
+// GetInput returns the update input of the request, it is safe to call on nil
+func (r *UpdateWorkflowExecutionRequest) GetInput() *commonpb.Payloads {
+	if r == nil {
+		return nil
+	}
+	return r.Input
+}
+
+// GetIdentity returns the identity of the caller, it is safe to call on nil
+func (r *UpdateWorkflowExecutionRequest) GetIdentity() string {
+	if r == nil {
+		return ""
+	}
+	return r.Identity
+}
+
+// GetNamespace returns the namespace of the request, it is safe to call on nil
+func (r *GetWorkflowExecutionResultRequest) GetNamespace() string {
+	if r == nil {
+		return ""
+	}
+	return r.Namespace
+}
+
+// GetWorkflowExecution returns the workflow execution of the request, it is safe to call on nil
+func (r *GetWorkflowExecutionResultRequest) GetWorkflowExecution() *commonpb.WorkflowExecution {
+	if r == nil {
+		return nil
+	}
+	return r.WorkflowExecution
+}
+
+// GetFollowRuns returns whether continue-as-new runs are followed, it is safe to call on nil
+func (r *GetWorkflowExecutionResultRequest) GetFollowRuns() bool {
+	if r == nil {
+		return false
+	}
+	return r.FollowRuns
+}
+
+// GetNamespace returns the namespace of the request, it is safe to call on nil
+func (r *ResetWorkflowExecutionWithOptionsRequest) GetNamespace() string {
+	if r == nil {
+		return ""
+	}
+	return r.Namespace
+}
+
+// GetWorkflowExecution returns the workflow execution of the request, it is safe to call on nil
+func (r *ResetWorkflowExecutionWithOptionsRequest) GetWorkflowExecution() *commonpb.WorkflowExecution {
+	if r == nil {
+		return nil
+	}
+	return r.WorkflowExecution
+}
+
+// GetNamespace returns the namespace of the request, it is safe to call on nil
+func (r *PauseWorkflowExecutionRequest) GetNamespace() string {
+	if r == nil {
+		return ""
+	}
+	return r.Namespace
+}
+
+// GetWorkflowExecution returns the workflow execution of the request, it is safe to call on nil
+func (r *PauseWorkflowExecutionRequest) GetWorkflowExecution() *commonpb.WorkflowExecution {
+	if r == nil {
+		return nil
+	}
+	return r.WorkflowExecution
+}
+
+// GetIdentity returns the identity of the caller, it is safe to call on nil
+func (r *PauseWorkflowExecutionRequest) GetIdentity() string {
+	if r == nil {
+		return ""
+	}
+	return r.Identity
+}
+
+// GetReason returns the reason of the pause, it is safe to call on nil
+func (r *PauseWorkflowExecutionRequest) GetReason() string {
+	if r == nil {
+		return ""
+	}
+	return r.Reason
+}
+
+// GetNamespace returns the namespace of the request, it is safe to call on nil
+func (r *UnpauseWorkflowExecutionRequest) GetNamespace() string {
+	if r == nil {
+		return ""
+	}
+	return r.Namespace
+}
+
+// GetWorkflowExecution returns the workflow execution of the request, it is safe to call on nil
+func (r *UnpauseWorkflowExecutionRequest) GetWorkflowExecution() *commonpb.WorkflowExecution {
+	if r == nil {
+		return nil
+	}
+	return r.WorkflowExecution
+}
+
+// GetIdentity returns the identity of the caller, it is safe to call on nil
+func (r *UnpauseWorkflowExecutionRequest) GetIdentity() string {
+	if r == nil {
+		return ""
+	}
+	return r.Identity
+}
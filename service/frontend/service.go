@@ -38,6 +38,7 @@ import (
 
 	"go.temporal.io/server/api/adminservice/v1"
 	"go.temporal.io/server/common"
+	"go.temporal.io/server/common/audit"
 	"go.temporal.io/server/common/definition"
 	"go.temporal.io/server/common/log"
 	"go.temporal.io/server/common/log/tag"
@@ -50,6 +51,7 @@ import (
 	"go.temporal.io/server/common/resource"
 	"go.temporal.io/server/common/service/config"
 	"go.temporal.io/server/common/service/dynamicconfig"
+	"go.temporal.io/server/common/tracing"
 )
 
 // Config represents configuration for frontend service
@@ -67,6 +69,8 @@ type Config struct {
 	RPS                        dynamicconfig.IntPropertyFn
 	MaxNamespaceRPSPerInstance dynamicconfig.IntPropertyFnWithNamespaceFilter
 	GlobalNamespaceRPS         dynamicconfig.IntPropertyFnWithNamespaceFilter
+	NamespaceAPICategoryRPS    dynamicconfig.MapPropertyFnWithNamespaceFilter
+	NamespaceAPICategoryBurst  dynamicconfig.MapPropertyFnWithNamespaceFilter
 	MaxIDLengthLimit           dynamicconfig.IntPropertyFn
 	EnableClientVersionCheck   dynamicconfig.BoolPropertyFn
 	MinRetentionDays           dynamicconfig.IntPropertyFn
@@ -125,6 +129,9 @@ type Config struct {
 
 	// EnableServerVersionCheck disables periodic version checking performed by the frontend
 	EnableServerVersionCheck dynamicconfig.BoolPropertyFn
+
+	// AuditSink is the name of the sink of the audit records of a namespace, auditing is disabled if empty
+	AuditSink dynamicconfig.StringPropertyFnWithNamespaceFilter
 }
 
 // NewConfig returns new service config with default values
@@ -143,6 +150,8 @@ func NewConfig(dc *dynamicconfig.Collection, numHistoryShards int32, enableReadF
 		RPS:                                    dc.GetIntProperty(dynamicconfig.FrontendRPS, 1200),
 		MaxNamespaceRPSPerInstance:             dc.GetIntPropertyFilteredByNamespace(dynamicconfig.FrontendMaxNamespaceRPSPerInstance, 1200),
 		GlobalNamespaceRPS:                     dc.GetIntPropertyFilteredByNamespace(dynamicconfig.FrontendGlobalNamespaceRPS, 0),
+		NamespaceAPICategoryRPS:                dc.GetMapPropertyFnWithNamespaceFilter(dynamicconfig.FrontendNamespaceAPICategoryRPS, nil),
+		NamespaceAPICategoryBurst:              dc.GetMapPropertyFnWithNamespaceFilter(dynamicconfig.FrontendNamespaceAPICategoryBurst, nil),
 		MaxIDLengthLimit:                       dc.GetIntProperty(dynamicconfig.MaxIDLengthLimit, 1000),
 		HistoryMgrNumConns:                     dc.GetIntProperty(dynamicconfig.FrontendHistoryMgrNumConns, 10),
 		MaxBadBinaries:                         dc.GetIntPropertyFilteredByNamespace(dynamicconfig.FrontendMaxBadBinaries, namespace.MaxBadBinaries),
@@ -172,6 +181,7 @@ func NewConfig(dc *dynamicconfig.Collection, numHistoryShards int32, enableReadF
 		MaxWorkflowRunTimeout:                  dc.GetDurationPropertyFilteredByNamespace(dynamicconfig.MaxWorkflowRunTimeout, common.DefaultWorkflowRunTimeout),
 		DefaultWorkflowTaskTimeout:             dc.GetDurationPropertyFilteredByNamespace(dynamicconfig.DefaultWorkflowTaskTimeout, common.DefaultWorkflowTaskTimeout),
 		EnableServerVersionCheck:               dc.GetBoolProperty(dynamicconfig.EnableServerVersionCheck, os.Getenv("TEMPORAL_VERSION_CHECK_DISABLED") == ""),
+		AuditSink:                              dc.GetStringPropertyFnWithNamespaceFilter(dynamicconfig.FrontendAuditSink, ""),
 	}
 }
 
@@ -186,6 +196,7 @@ type Service struct {
 	handler        Handler
 	adminHandler   *AdminHandler
 	versionChecker *VersionChecker
+	auditLogger    audit.Logger
 	server         *grpc.Server
 }
 
@@ -199,8 +210,9 @@ func NewService(
 
 	params.PersistenceConfig.HistoryMaxConns = serviceConfig.HistoryMgrNumConns()
 	params.PersistenceConfig.VisibilityConfig = &config.VisibilityConfig{
-		VisibilityListMaxQPS: serviceConfig.VisibilityListMaxQPS,
-		EnableSampling:       serviceConfig.EnableVisibilitySampling,
+		VisibilityListMaxQPS:  serviceConfig.VisibilityListMaxQPS,
+		EnableSampling:        serviceConfig.EnableVisibilitySampling,
+		ValidSearchAttributes: serviceConfig.ValidSearchAttributes,
 	}
 
 	visibilityManagerInitializer := func(
@@ -283,20 +295,21 @@ func (s *Service) Start() {
 	if err != nil {
 		logger.Fatal("creating grpc server options failed", tag.Error(err))
 	}
-	opts = append(opts, grpc.UnaryInterceptor(interceptor))
+	opts = append(opts, grpc.ChainUnaryInterceptor(tracing.UnaryServerInterceptor, interceptor))
 	s.server = grpc.NewServer(opts...)
 
 	wfHandler := NewWorkflowHandler(s, s.config, replicationMessageSink)
 	s.handler = NewDCRedirectionHandler(wfHandler, s.params.DCRedirectionPolicy)
+	s.auditLogger = audit.NewLogger(s.params.AuditSinks, s.config.AuditSink, logger)
 	if s.params.Authorizer != nil {
-		s.handler = NewAccessControlledHandlerImpl(s.handler, s.params.Authorizer)
+		s.handler = NewAccessControlledHandlerImpl(s.handler, s.params.Authorizer, s.params.ClaimMapper, s.auditLogger)
 	}
 	workflowNilCheckHandler := NewWorkflowNilCheckHandler(s.handler)
 
 	workflowservice.RegisterWorkflowServiceServer(s.server, workflowNilCheckHandler)
 	healthpb.RegisterHealthServer(s.server, s.handler)
 
-	s.adminHandler = NewAdminHandler(s, s.params, s.config)
+	s.adminHandler = NewAdminHandler(s, s.params, s.config, s.auditLogger, s.handler)
 	adminNilCheckHandler := NewAdminNilCheckHandler(s.adminHandler)
 
 	adminservice.RegisterAdminServiceServer(s.server, adminNilCheckHandler)
@@ -344,6 +357,8 @@ func (s *Service) Stop() {
 
 	// TODO: Change this to GracefulStop when integration tests are refactored.
 	s.server.Stop()
+	// no call is audited once the server is stopped
+	s.auditLogger.Close()
 	s.Resource.Stop()
 	s.params.Logger.Info("frontend stopped")
 }
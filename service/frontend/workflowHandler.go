@@ -33,6 +33,7 @@ import (
 	"github.com/pborman/uuid"
 	commonpb "go.temporal.io/api/common/v1"
 	enumspb "go.temporal.io/api/enums/v1"
+	failurepb "go.temporal.io/api/failure/v1"
 	filterpb "go.temporal.io/api/filter/v1"
 	historypb "go.temporal.io/api/history/v1"
 	"go.temporal.io/api/serviceerror"
@@ -63,6 +64,8 @@ import (
 	"go.temporal.io/server/common/quotas"
 	"go.temporal.io/server/common/resource"
 	"go.temporal.io/server/common/service/dynamicconfig"
+	"go.temporal.io/server/service/worker/batcher"
+	"go.temporal.io/server/service/worker/scheduler"
 )
 
 const (
@@ -78,6 +81,11 @@ const (
 
 var _ Handler = (*WorkflowHandler)(nil)
 
+const (
+	// getWorkflowExecutionResultTailRoom is the time left to respond once a GetWorkflowExecutionResult poll expired
+	getWorkflowExecutionResultTailRoom = time.Second
+)
+
 var (
 	maxTime = time.Date(2100, 1, 1, 1, 0, 0, 0, time.UTC)
 )
@@ -91,12 +99,15 @@ type (
 		healthStatus                    int32
 		tokenSerializer                 common.TaskTokenSerializer
 		rateLimiter                     quotas.Policy
+		namespaceAPIRateLimiter         *quotas.NamespaceAPIRateLimiter
 		config                          *Config
 		versionChecker                  headers.VersionChecker
 		namespaceHandler                namespace.Handler
 		visibilityQueryValidator        *validator.VisibilityQueryValidator
 		searchAttributesValidator       *validator.SearchAttributesValidator
 		getDefaultWorkflowRetrySettings dynamicconfig.MapPropertyFnWithNamespaceFilter
+		schedulerClient                 scheduler.Client
+		batcherClient                   batcher.Client
 	}
 
 	// HealthStatus is an enum that refers to the rpc handler health status
@@ -133,6 +144,17 @@ func NewWorkflowHandler(
 				return float64(config.MaxNamespaceRPSPerInstance(namespace))
 			},
 		),
+		namespaceAPIRateLimiter: quotas.NewNamespaceAPIRateLimiter(
+			apiCategory,
+			func(namespace string, category string) (float64, int, bool) {
+				rps, ok := mapNumber(config.NamespaceAPICategoryRPS(namespace), category)
+				if !ok {
+					return 0, 0, false
+				}
+				burst, _ := mapNumber(config.NamespaceAPICategoryBurst(namespace), category)
+				return rps, int(burst), true
+			},
+		),
 		versionChecker: headers.NewDefaultVersionChecker(),
 		namespaceHandler: namespace.NewHandler(
 			config.MinRetentionDays(),
@@ -153,6 +175,8 @@ func NewWorkflowHandler(
 			config.SearchAttributesTotalSizeLimit,
 		),
 		getDefaultWorkflowRetrySettings: config.DefaultWorkflowRetryPolicy,
+		schedulerClient:                 scheduler.NewClient(resource.GetLogger(), resource.GetSDKClient()),
+		batcherClient:                   batcher.NewClient(resource.GetLogger(), resource.GetSDKClient()),
 	}
 
 	return handler
@@ -406,8 +430,8 @@ func (wh *WorkflowHandler) StartWorkflowExecution(ctx context.Context, request *
 		return nil, wh.error(errRequestNotSet, scope)
 	}
 
-	if ok := wh.allow(request.GetNamespace()); !ok {
-		return nil, wh.error(errServiceBusy, scope)
+	if err := wh.allow("StartWorkflowExecution", request.GetNamespace()); err != nil {
+		return nil, wh.error(err, scope)
 	}
 
 	namespace := request.GetNamespace()
@@ -527,8 +551,8 @@ func (wh *WorkflowHandler) GetWorkflowExecutionHistory(ctx context.Context, requ
 		return nil, wh.error(errRequestNotSet, scope)
 	}
 
-	if ok := wh.allow(request.GetNamespace()); !ok {
-		return nil, wh.error(errServiceBusy, scope)
+	if err := wh.allow("GetWorkflowExecutionHistory", request.GetNamespace()); err != nil {
+		return nil, wh.error(err, scope)
 	}
 
 	if request.GetNamespace() == "" {
@@ -691,6 +715,7 @@ func (wh *WorkflowHandler) GetWorkflowExecutionHistory(ctx context.Context, requ
 					nil,
 					continuationToken.TransientWorkflowTask,
 					continuationToken.BranchToken,
+					!isWorkflowRunning,
 				)
 				if err != nil {
 					return nil, wh.error(err, scope)
@@ -737,6 +762,7 @@ func (wh *WorkflowHandler) GetWorkflowExecutionHistory(ctx context.Context, requ
 					continuationToken.PersistenceToken,
 					continuationToken.TransientWorkflowTask,
 					continuationToken.BranchToken,
+					!isWorkflowRunning,
 				)
 			}
 
@@ -787,6 +813,10 @@ func (wh *WorkflowHandler) PollWorkflowTaskQueue(ctx context.Context, request *w
 		return nil, wh.error(errRequestNotSet, scope, tagsForErrorLog...)
 	}
 
+	if err := wh.allowAPICategory("PollWorkflowTaskQueue", request.GetNamespace()); err != nil {
+		return nil, wh.error(err, scope, tagsForErrorLog...)
+	}
+
 	wh.GetLogger().Debug("Received PollWorkflowTaskQueue")
 	if err := common.ValidateLongPollContextTimeout(
 		ctx,
@@ -885,7 +915,7 @@ func (wh *WorkflowHandler) RespondWorkflowTaskCompleted(ctx context.Context, req
 	}
 
 	// Count the request in the RPS, but we still accept it even if RPS is exceeded
-	wh.allow("")
+	wh.allow("RespondWorkflowTaskCompleted", "")
 
 	if request.TaskToken == nil {
 		return nil, wh.error(errTaskTokenNotSet, scope)
@@ -969,7 +999,7 @@ func (wh *WorkflowHandler) RespondWorkflowTaskFailed(ctx context.Context, reques
 	}
 
 	// Count the request in the RPS, but we still accept it even if RPS is exceeded
-	wh.allow("")
+	wh.allow("RespondWorkflowTaskFailed", "")
 
 	if request.TaskToken == nil {
 		return nil, wh.error(errTaskTokenNotSet, scope)
@@ -1054,6 +1084,10 @@ func (wh *WorkflowHandler) PollActivityTaskQueue(ctx context.Context, request *w
 		return nil, wh.error(errRequestNotSet, scope)
 	}
 
+	if err := wh.allowAPICategory("PollActivityTaskQueue", request.GetNamespace()); err != nil {
+		return nil, wh.error(err, scope)
+	}
+
 	wh.GetLogger().Debug("Received PollActivityTaskQueue")
 	if err := common.ValidateLongPollContextTimeout(
 		ctx,
@@ -1156,7 +1190,7 @@ func (wh *WorkflowHandler) RecordActivityTaskHeartbeat(ctx context.Context, requ
 	}
 
 	// Count the request in the RPS, but we still accept it even if RPS is exceeded
-	wh.allow("")
+	wh.allow("RecordActivityTaskHeartbeat", "")
 
 	wh.GetLogger().Debug("Received RecordActivityTaskHeartbeat")
 	if request.TaskToken == nil {
@@ -1250,7 +1284,7 @@ func (wh *WorkflowHandler) RecordActivityTaskHeartbeatById(ctx context.Context,
 	}
 
 	// Count the request in the RPS, but we still accept it even if RPS is exceeded
-	wh.allow("")
+	wh.allow("RecordActivityTaskHeartbeatById", "")
 
 	wh.GetLogger().Debug("Received RecordActivityTaskHeartbeatById")
 	namespaceID, err := wh.GetNamespaceCache().GetNamespaceID(request.GetNamespace())
@@ -1356,7 +1390,7 @@ func (wh *WorkflowHandler) RespondActivityTaskCompleted(ctx context.Context, req
 	}
 
 	// Count the request in the RPS, but we still accept it even if RPS is exceeded
-	wh.allow("")
+	wh.allow("RespondActivityTaskCompleted", "")
 
 	if request.TaskToken == nil {
 		return nil, wh.error(errTaskTokenNotSet, scope)
@@ -1452,7 +1486,7 @@ func (wh *WorkflowHandler) RespondActivityTaskCompletedById(ctx context.Context,
 	}
 
 	// Count the request in the RPS, but we still accept it even if RPS is exceeded
-	wh.allow("")
+	wh.allow("RespondActivityTaskCompletedById", "")
 
 	namespaceID, err := wh.GetNamespaceCache().GetNamespaceID(request.GetNamespace())
 	if err != nil {
@@ -1561,7 +1595,7 @@ func (wh *WorkflowHandler) RespondActivityTaskFailed(ctx context.Context, reques
 	}
 
 	// Count the request in the RPS, but we still accept it even if RPS is exceeded
-	wh.allow("")
+	wh.allow("RespondActivityTaskFailed", "")
 
 	if request.TaskToken == nil {
 		return nil, wh.error(errTaskTokenNotSet, scope)
@@ -1651,7 +1685,7 @@ func (wh *WorkflowHandler) RespondActivityTaskFailedById(ctx context.Context, re
 	}
 
 	// Count the request in the RPS, but we still accept it even if RPS is exceeded
-	wh.allow("")
+	wh.allow("RespondActivityTaskFailedById", "")
 
 	namespaceID, err := wh.GetNamespaceCache().GetNamespaceID(request.GetNamespace())
 	if err != nil {
@@ -1748,7 +1782,7 @@ func (wh *WorkflowHandler) RespondActivityTaskCanceled(ctx context.Context, requ
 	}
 
 	// Count the request in the RPS, but we still accept it even if RPS is exceeded
-	wh.allow("")
+	wh.allow("RespondActivityTaskCanceled", "")
 
 	if request.TaskToken == nil {
 		return nil, wh.error(errTaskTokenNotSet, scope)
@@ -1847,7 +1881,7 @@ func (wh *WorkflowHandler) RespondActivityTaskCanceledById(ctx context.Context,
 	}
 
 	// Count the request in the RPS, but we still accept it even if RPS is exceeded
-	wh.allow("")
+	wh.allow("RespondActivityTaskCanceledById", "")
 
 	namespaceID, err := wh.GetNamespaceCache().GetNamespaceID(request.GetNamespace())
 	if err != nil {
@@ -1959,8 +1993,8 @@ func (wh *WorkflowHandler) RequestCancelWorkflowExecution(ctx context.Context, r
 		return nil, wh.error(errRequestNotSet, scope)
 	}
 
-	if ok := wh.allow(request.GetNamespace()); !ok {
-		return nil, wh.error(errServiceBusy, scope)
+	if err := wh.allow("RequestCancelWorkflowExecution", request.GetNamespace()); err != nil {
+		return nil, wh.error(err, scope)
 	}
 
 	if request.GetNamespace() == "" {
@@ -2007,8 +2041,8 @@ func (wh *WorkflowHandler) SignalWorkflowExecution(ctx context.Context, request
 		return nil, wh.error(errRequestNotSet, scope)
 	}
 
-	if ok := wh.allow(request.GetNamespace()); !ok {
-		return nil, wh.error(errServiceBusy, scope)
+	if err := wh.allow("SignalWorkflowExecution", request.GetNamespace()); err != nil {
+		return nil, wh.error(err, scope)
 	}
 
 	if request.GetNamespace() == "" {
@@ -2090,8 +2124,8 @@ func (wh *WorkflowHandler) SignalWithStartWorkflowExecution(ctx context.Context,
 		return nil, wh.error(errRequestNotSet, scope)
 	}
 
-	if ok := wh.allow(request.GetNamespace()); !ok {
-		return nil, wh.error(errServiceBusy, scope)
+	if err := wh.allow("SignalWithStartWorkflowExecution", request.GetNamespace()); err != nil {
+		return nil, wh.error(err, scope)
 	}
 
 	namespace := request.GetNamespace()
@@ -2227,8 +2261,8 @@ func (wh *WorkflowHandler) ResetWorkflowExecution(ctx context.Context, request *
 		return nil, wh.error(errRequestNotSet, scope)
 	}
 
-	if ok := wh.allow(request.GetNamespace()); !ok {
-		return nil, wh.error(errServiceBusy, scope)
+	if err := wh.allow("ResetWorkflowExecution", request.GetNamespace()); err != nil {
+		return nil, wh.error(err, scope)
 	}
 
 	if request.GetNamespace() == "" {
@@ -2275,8 +2309,8 @@ func (wh *WorkflowHandler) TerminateWorkflowExecution(ctx context.Context, reque
 		return nil, wh.error(errRequestNotSet, scope)
 	}
 
-	if ok := wh.allow(request.GetNamespace()); !ok {
-		return nil, wh.error(errServiceBusy, scope)
+	if err := wh.allow("TerminateWorkflowExecution", request.GetNamespace()); err != nil {
+		return nil, wh.error(err, scope)
 	}
 
 	if request.GetNamespace() == "" {
@@ -2322,8 +2356,8 @@ func (wh *WorkflowHandler) ListOpenWorkflowExecutions(ctx context.Context, reque
 		return nil, wh.error(errRequestNotSet, scope)
 	}
 
-	if ok := wh.allow(request.GetNamespace()); !ok {
-		return nil, wh.error(errServiceBusy, scope)
+	if err := wh.allow("ListOpenWorkflowExecutions", request.GetNamespace()); err != nil {
+		return nil, wh.error(err, scope)
 	}
 
 	if request.GetNamespace() == "" {
@@ -2422,8 +2456,8 @@ func (wh *WorkflowHandler) ListClosedWorkflowExecutions(ctx context.Context, req
 		return nil, wh.error(errRequestNotSet, scope)
 	}
 
-	if ok := wh.allow(request.GetNamespace()); !ok {
-		return nil, wh.error(errServiceBusy, scope)
+	if err := wh.allow("ListClosedWorkflowExecutions", request.GetNamespace()); err != nil {
+		return nil, wh.error(err, scope)
 	}
 
 	if request.GetNamespace() == "" {
@@ -2537,8 +2571,8 @@ func (wh *WorkflowHandler) ListWorkflowExecutions(ctx context.Context, request *
 		return nil, wh.error(errRequestNotSet, scope)
 	}
 
-	if ok := wh.allow(request.GetNamespace()); !ok {
-		return nil, wh.error(errServiceBusy, scope)
+	if err := wh.allow("ListWorkflowExecutions", request.GetNamespace()); err != nil {
+		return nil, wh.error(err, scope)
 	}
 
 	if request.GetNamespace() == "" {
@@ -2600,8 +2634,8 @@ func (wh *WorkflowHandler) ListArchivedWorkflowExecutions(ctx context.Context, r
 		return nil, wh.error(errRequestNotSet, scope)
 	}
 
-	if ok := wh.allow(request.GetNamespace()); !ok {
-		return nil, wh.error(errServiceBusy, scope)
+	if err := wh.allow("ListArchivedWorkflowExecutions", request.GetNamespace()); err != nil {
+		return nil, wh.error(err, scope)
 	}
 
 	if request.GetNamespace() == "" {
@@ -2688,8 +2722,8 @@ func (wh *WorkflowHandler) ScanWorkflowExecutions(ctx context.Context, request *
 		return nil, wh.error(errRequestNotSet, scope)
 	}
 
-	if ok := wh.allow(request.GetNamespace()); !ok {
-		return nil, wh.error(errServiceBusy, scope)
+	if err := wh.allow("ScanWorkflowExecutions", request.GetNamespace()); err != nil {
+		return nil, wh.error(err, scope)
 	}
 
 	if request.GetNamespace() == "" {
@@ -2752,8 +2786,8 @@ func (wh *WorkflowHandler) CountWorkflowExecutions(ctx context.Context, request
 		return nil, wh.error(errRequestNotSet, scope)
 	}
 
-	if ok := wh.allow(request.GetNamespace()); !ok {
-		return nil, wh.error(errServiceBusy, scope)
+	if err := wh.allow("CountWorkflowExecutions", request.GetNamespace()); err != nil {
+		return nil, wh.error(err, scope)
 	}
 
 	if request.GetNamespace() == "" {
@@ -2824,7 +2858,7 @@ func (wh *WorkflowHandler) RespondQueryTaskCompleted(ctx context.Context, reques
 	}
 
 	// Count the request in the RPS, but we still accept it even if RPS is exceeded
-	wh.allow("")
+	wh.allow("RespondQueryTaskCompleted", "")
 
 	if request.TaskToken == nil {
 		return nil, wh.error(errTaskTokenNotSet, scope)
@@ -2959,6 +2993,10 @@ func (wh *WorkflowHandler) QueryWorkflow(ctx context.Context, request *workflows
 		return nil, wh.error(errRequestNotSet, scope)
 	}
 
+	if err := wh.allowAPICategory("QueryWorkflow", request.GetNamespace()); err != nil {
+		return nil, wh.error(err, scope)
+	}
+
 	if request.GetNamespace() == "" {
 		return nil, wh.error(errNamespaceNotSet, scope)
 	}
@@ -3027,8 +3065,8 @@ func (wh *WorkflowHandler) DescribeWorkflowExecution(ctx context.Context, reques
 		return nil, wh.error(errRequestNotSet, scope)
 	}
 
-	if ok := wh.allow(request.GetNamespace()); !ok {
-		return nil, wh.error(errServiceBusy, scope)
+	if err := wh.allow("DescribeWorkflowExecution", request.GetNamespace()); err != nil {
+		return nil, wh.error(err, scope)
 	}
 
 	if request.GetNamespace() == "" {
@@ -3052,6 +3090,14 @@ func (wh *WorkflowHandler) DescribeWorkflowExecution(ctx context.Context, reques
 		return nil, wh.error(err, scope)
 	}
 
+	// the headers cannot be set if the handler is not called through gRPC, the stats are informative only
+	_ = headers.SetHistoryStats(
+		ctx,
+		response.GetHistorySizeBytes(),
+		response.GetWorkflowExecutionInfo().GetHistoryLength(),
+		response.GetSuggestContinueAsNew(),
+	)
+
 	return &workflowservice.DescribeWorkflowExecutionResponse{
 		ExecutionConfig:       response.GetExecutionConfig(),
 		WorkflowExecutionInfo: response.GetWorkflowExecutionInfo(),
@@ -3080,8 +3126,8 @@ func (wh *WorkflowHandler) DescribeTaskQueue(ctx context.Context, request *workf
 		return nil, wh.error(errRequestNotSet, scope)
 	}
 
-	if ok := wh.allow(request.GetNamespace()); !ok {
-		return nil, wh.error(errServiceBusy, scope)
+	if err := wh.allow("DescribeTaskQueue", request.GetNamespace()); err != nil {
+		return nil, wh.error(err, scope)
 	}
 
 	if request.GetNamespace() == "" {
@@ -3122,8 +3168,8 @@ func (wh *WorkflowHandler) GetClusterInfo(ctx context.Context, _ *workflowservic
 	defer log.CapturePanic(wh.GetLogger(), &retError)
 
 	scope := wh.getDefaultScope(metrics.FrontendClientGetClusterInfoScope)
-	if ok := wh.allow(""); !ok {
-		return nil, wh.error(errServiceBusy, scope)
+	if err := wh.allow("GetClusterInfo", ""); err != nil {
+		return nil, wh.error(err, scope)
 	}
 
 	metadata, err := wh.GetClusterMetadataManager().GetClusterMetadata()
@@ -3156,8 +3202,8 @@ func (wh *WorkflowHandler) ListTaskQueuePartitions(ctx context.Context, request
 		return nil, wh.error(errRequestNotSet, scope)
 	}
 
-	if ok := wh.allow(request.GetNamespace()); !ok {
-		return nil, wh.error(errServiceBusy, scope)
+	if err := wh.allow("ListTaskQueuePartitions", request.GetNamespace()); err != nil {
+		return nil, wh.error(err, scope)
 	}
 
 	if request.GetNamespace() == "" {
@@ -3183,187 +3229,1185 @@ func (wh *WorkflowHandler) ListTaskQueuePartitions(ctx context.Context, request
 	}, err
 }
 
-func (wh *WorkflowHandler) getRawHistory(
-	scope metrics.Scope,
-	namespaceID string,
-	execution commonpb.WorkflowExecution,
-	firstEventID int64,
-	nextEventID int64,
-	pageSize int32,
-	nextPageToken []byte,
-	transientWorkflowTaskInfo *historyspb.TransientWorkflowTaskInfo,
-	branchToken []byte,
-) ([]*commonpb.DataBlob, []byte, error) {
-	var rawHistory []*commonpb.DataBlob
-	shardID := common.WorkflowIDToHistoryShard(namespaceID, execution.GetWorkflowId(), wh.config.NumHistoryShards)
+// CreateSchedule creates a new schedule, backed by a scheduler workflow in the system namespace.
+func (wh *WorkflowHandler) CreateSchedule(ctx context.Context, request *scheduler.CreateScheduleRequest) (_ *scheduler.CreateScheduleResponse, retError error) {
+	defer log.CapturePanic(wh.GetLogger(), &retError)
 
-	resp, err := wh.GetHistoryManager().ReadRawHistoryBranch(&persistence.ReadHistoryBranchRequest{
-		BranchToken:   branchToken,
-		MinEventID:    firstEventID,
-		MaxEventID:    nextEventID,
-		PageSize:      int(pageSize),
-		NextPageToken: nextPageToken,
-		ShardID:       convert.Int32Ptr(shardID),
-	})
-	if err != nil {
-		return nil, nil, err
+	scope, sw := wh.startRequestProfileWithNamespace(metrics.FrontendCreateScheduleScope, request.GetNamespace())
+	defer sw.Stop()
+
+	if request == nil {
+		return nil, wh.error(errRequestNotSet, scope)
 	}
 
-	for _, data := range resp.HistoryEventBlobs {
-		rawHistory = append(rawHistory, &commonpb.DataBlob{
-			EncodingType: data.Encoding,
-			Data:         data.Data,
-		})
+	if err := wh.validateScheduleRequest(ctx, "CreateSchedule", request.GetNamespace(), request.GetScheduleID(), scope); err != nil {
+		return nil, err
 	}
 
-	if len(nextPageToken) == 0 && transientWorkflowTaskInfo != nil {
-		if err := wh.validateTransientWorkflowTaskEvents(nextEventID, transientWorkflowTaskInfo); err != nil {
-			scope.IncCounter(metrics.ServiceErrIncompleteHistoryCounter)
-			wh.GetLogger().Error("getHistory error",
-				tag.WorkflowNamespaceID(namespaceID),
-				tag.WorkflowID(execution.GetWorkflowId()),
-				tag.WorkflowRunID(execution.GetRunId()),
-				tag.Error(err))
-		}
+	if err := scheduler.ValidateSchedule(request.Schedule); err != nil {
+		return nil, wh.error(serviceerror.NewInvalidArgument(err.Error()), scope)
+	}
 
-		blob, err := wh.GetPayloadSerializer().SerializeEvent(transientWorkflowTaskInfo.ScheduledEvent, enumspb.ENCODING_TYPE_PROTO3)
-		if err != nil {
-			return nil, nil, err
-		}
-		rawHistory = append(rawHistory, &commonpb.DataBlob{
-			EncodingType: enumspb.ENCODING_TYPE_PROTO3,
-			Data:         blob.Data,
-		})
+	if err := scheduler.ValidatePatch(request.InitialPatch); err != nil {
+		return nil, wh.error(serviceerror.NewInvalidArgument(err.Error()), scope)
+	}
 
-		blob, err = wh.GetPayloadSerializer().SerializeEvent(transientWorkflowTaskInfo.StartedEvent, enumspb.ENCODING_TYPE_PROTO3)
-		if err != nil {
-			return nil, nil, err
-		}
-		rawHistory = append(rawHistory, &commonpb.DataBlob{
-			EncodingType: enumspb.ENCODING_TYPE_PROTO3,
-			Data:         blob.Data,
-		})
+	if err := wh.validateScheduleAction(request.GetNamespace(), request.GetScheduleID(), request.Schedule, "CreateSchedule", scope); err != nil {
+		return nil, err
 	}
 
-	return rawHistory, resp.NextPageToken, nil
+	resp, err := wh.schedulerClient.CreateSchedule(ctx, request)
+	if err != nil {
+		return nil, wh.error(err, scope)
+	}
+	return resp, nil
 }
 
-func (wh *WorkflowHandler) getHistory(
-	scope metrics.Scope,
-	namespaceID string,
-	execution commonpb.WorkflowExecution,
-	firstEventID, nextEventID int64,
-	pageSize int32,
-	nextPageToken []byte,
-	transientWorkflowTaskInfo *historyspb.TransientWorkflowTaskInfo,
-	branchToken []byte,
-) (*historypb.History, []byte, error) {
+// DescribeSchedule returns the definition and runtime information of a schedule.
+func (wh *WorkflowHandler) DescribeSchedule(ctx context.Context, request *scheduler.DescribeScheduleRequest) (_ *scheduler.DescribeScheduleResponse, retError error) {
+	defer log.CapturePanic(wh.GetLogger(), &retError)
 
-	var size int
+	scope, sw := wh.startRequestProfileWithNamespace(metrics.FrontendDescribeScheduleScope, request.GetNamespace())
+	defer sw.Stop()
 
-	isFirstPage := len(nextPageToken) == 0
-	shardID := common.WorkflowIDToHistoryShard(namespaceID, execution.GetWorkflowId(), wh.config.NumHistoryShards)
-	var err error
-	var historyEvents []*historypb.HistoryEvent
-	historyEvents, size, nextPageToken, err = persistence.ReadFullPageV2Events(wh.GetHistoryManager(), &persistence.ReadHistoryBranchRequest{
-		BranchToken:   branchToken,
-		MinEventID:    firstEventID,
-		MaxEventID:    nextEventID,
-		PageSize:      int(pageSize),
-		NextPageToken: nextPageToken,
-		ShardID:       convert.Int32Ptr(shardID),
-	})
+	if request == nil {
+		return nil, wh.error(errRequestNotSet, scope)
+	}
+
+	if err := wh.validateScheduleRequest(ctx, "DescribeSchedule", request.GetNamespace(), request.GetScheduleID(), scope); err != nil {
+		return nil, err
+	}
+
+	resp, err := wh.schedulerClient.DescribeSchedule(ctx, request)
 	if err != nil {
-		return nil, nil, err
+		return nil, wh.error(err, scope)
 	}
+	return resp, nil
+}
 
-	scope.RecordTimer(metrics.HistorySize, time.Duration(size))
+// UpdateSchedule replaces the definition of a schedule. The runtime information of the schedule is kept.
+func (wh *WorkflowHandler) UpdateSchedule(ctx context.Context, request *scheduler.UpdateScheduleRequest) (_ *scheduler.UpdateScheduleResponse, retError error) {
+	defer log.CapturePanic(wh.GetLogger(), &retError)
 
-	isLastPage := len(nextPageToken) == 0
-	if err := wh.verifyHistoryIsComplete(
-		historyEvents,
-		firstEventID,
-		nextEventID-1,
-		isFirstPage,
-		isLastPage,
-		int(pageSize)); err != nil {
-		scope.IncCounter(metrics.ServiceErrIncompleteHistoryCounter)
-		wh.GetLogger().Error("getHistory: incomplete history",
-			tag.WorkflowNamespaceID(namespaceID),
-			tag.WorkflowID(execution.GetWorkflowId()),
-			tag.WorkflowRunID(execution.GetRunId()),
-			tag.Error(err))
-		return nil, nil, err
+	scope, sw := wh.startRequestProfileWithNamespace(metrics.FrontendUpdateScheduleScope, request.GetNamespace())
+	defer sw.Stop()
+
+	if request == nil {
+		return nil, wh.error(errRequestNotSet, scope)
 	}
 
-	if len(nextPageToken) == 0 && transientWorkflowTaskInfo != nil {
-		if err := wh.validateTransientWorkflowTaskEvents(nextEventID, transientWorkflowTaskInfo); err != nil {
-			scope.IncCounter(metrics.ServiceErrIncompleteHistoryCounter)
-			wh.GetLogger().Error("getHistory error",
-				tag.WorkflowNamespaceID(namespaceID),
-				tag.WorkflowID(execution.GetWorkflowId()),
-				tag.WorkflowRunID(execution.GetRunId()),
-				tag.Error(err))
-		}
-		// Append the transient workflow task events once we are done enumerating everything from the events table
-		historyEvents = append(historyEvents, transientWorkflowTaskInfo.ScheduledEvent, transientWorkflowTaskInfo.StartedEvent)
+	if err := wh.validateScheduleRequest(ctx, "UpdateSchedule", request.GetNamespace(), request.GetScheduleID(), scope); err != nil {
+		return nil, err
 	}
 
-	executionHistory := &historypb.History{}
-	executionHistory.Events = historyEvents
-	return executionHistory, nextPageToken, nil
+	if err := scheduler.ValidateSchedule(request.Schedule); err != nil {
+		return nil, wh.error(serviceerror.NewInvalidArgument(err.Error()), scope)
+	}
+
+	if err := wh.validateScheduleAction(request.GetNamespace(), request.GetScheduleID(), request.Schedule, "UpdateSchedule", scope); err != nil {
+		return nil, err
+	}
+
+	if err := wh.schedulerClient.UpdateSchedule(ctx, request); err != nil {
+		return nil, wh.error(err, scope)
+	}
+	return &scheduler.UpdateScheduleResponse{}, nil
 }
 
-func (wh *WorkflowHandler) validateTransientWorkflowTaskEvents(
-	expectedNextEventID int64,
-	transientWorkflowTaskInfo *historyspb.TransientWorkflowTaskInfo,
-) error {
+// PauseSchedule pauses a schedule. Actions which would be taken while the schedule is paused are skipped.
+func (wh *WorkflowHandler) PauseSchedule(ctx context.Context, request *scheduler.PauseScheduleRequest) (_ *scheduler.PauseScheduleResponse, retError error) {
+	defer log.CapturePanic(wh.GetLogger(), &retError)
 
-	if transientWorkflowTaskInfo.ScheduledEvent.GetEventId() == expectedNextEventID &&
-		transientWorkflowTaskInfo.StartedEvent.GetEventId() == expectedNextEventID+1 {
-		return nil
+	scope, sw := wh.startRequestProfileWithNamespace(metrics.FrontendPauseScheduleScope, request.GetNamespace())
+	defer sw.Stop()
+
+	if request == nil {
+		return nil, wh.error(errRequestNotSet, scope)
 	}
 
-	return fmt.Errorf("invalid transient workflow task: expectedScheduledEventID=%v expectedStartedEventID=%v but have scheduledEventID=%v startedEventID=%v",
-		expectedNextEventID,
-		expectedNextEventID+1,
-		transientWorkflowTaskInfo.ScheduledEvent.GetEventId(),
-		transientWorkflowTaskInfo.StartedEvent.GetEventId())
-}
+	if err := wh.validateScheduleRequest(ctx, "PauseSchedule", request.GetNamespace(), request.GetScheduleID(), scope); err != nil {
+		return nil, err
+	}
 
-// startRequestProfile initiates recording of request metrics
-func (wh *WorkflowHandler) startRequestProfile(scope int) (metrics.Scope, metrics.Stopwatch) {
-	metricsScope := wh.GetMetricsClient().Scope(scope).Tagged(metrics.NamespaceUnknownTag())
-	// timer should be emitted with the all tag
-	sw := metricsScope.StartTimer(metrics.ServiceLatency)
-	metricsScope.IncCounter(metrics.ServiceRequests)
-	return metricsScope, sw
+	notes := request.Notes
+	if notes == "" {
+		notes = fmt.Sprintf("Paused by %v", request.Identity)
+	}
+	if err := wh.schedulerClient.PatchSchedule(ctx, request.Namespace, request.ScheduleID, &scheduler.SchedulePatch{Pause: notes}); err != nil {
+		return nil, wh.error(err, scope)
+	}
+	return &scheduler.PauseScheduleResponse{}, nil
 }
 
-// startRequestProfileWithNamespace initiates recording of request metrics and returns a namespace tagged scope
-func (wh *WorkflowHandler) startRequestProfileWithNamespace(scope int, namespace string) (metrics.Scope, metrics.Stopwatch) {
-	var metricsScope metrics.Scope
-	if namespace != "" {
-		metricsScope = wh.GetMetricsClient().Scope(scope).Tagged(metrics.NamespaceTag(namespace))
-	} else {
-		metricsScope = wh.GetMetricsClient().Scope(scope).Tagged(metrics.NamespaceUnknownTag())
+// UnpauseSchedule unpauses a paused schedule.
+func (wh *WorkflowHandler) UnpauseSchedule(ctx context.Context, request *scheduler.UnpauseScheduleRequest) (_ *scheduler.UnpauseScheduleResponse, retError error) {
+	defer log.CapturePanic(wh.GetLogger(), &retError)
+
+	scope, sw := wh.startRequestProfileWithNamespace(metrics.FrontendUnpauseScheduleScope, request.GetNamespace())
+	defer sw.Stop()
+
+	if request == nil {
+		return nil, wh.error(errRequestNotSet, scope)
 	}
-	sw := metricsScope.StartTimer(metrics.ServiceLatency)
-	metricsScope.IncCounter(metrics.ServiceRequests)
-	return metricsScope, sw
+
+	if err := wh.validateScheduleRequest(ctx, "UnpauseSchedule", request.GetNamespace(), request.GetScheduleID(), scope); err != nil {
+		return nil, err
+	}
+
+	notes := request.Notes
+	if notes == "" {
+		notes = fmt.Sprintf("Unpaused by %v", request.Identity)
+	}
+	if err := wh.schedulerClient.PatchSchedule(ctx, request.Namespace, request.ScheduleID, &scheduler.SchedulePatch{Unpause: notes}); err != nil {
+		return nil, wh.error(err, scope)
+	}
+	return &scheduler.UnpauseScheduleResponse{}, nil
 }
 
-// getDefaultScope returns a default scope to use for request metrics
-func (wh *WorkflowHandler) getDefaultScope(scope int) metrics.Scope {
-	return wh.GetMetricsClient().Scope(scope).Tagged(metrics.NamespaceUnknownTag())
+// TriggerSchedule takes an action of a schedule right now, or backfills the actions of past time ranges.
+func (wh *WorkflowHandler) TriggerSchedule(ctx context.Context, request *scheduler.TriggerScheduleRequest) (_ *scheduler.TriggerScheduleResponse, retError error) {
+	defer log.CapturePanic(wh.GetLogger(), &retError)
+
+	scope, sw := wh.startRequestProfileWithNamespace(metrics.FrontendTriggerScheduleScope, request.GetNamespace())
+	defer sw.Stop()
+
+	if request == nil {
+		return nil, wh.error(errRequestNotSet, scope)
+	}
+
+	if err := wh.validateScheduleRequest(ctx, "TriggerSchedule", request.GetNamespace(), request.GetScheduleID(), scope); err != nil {
+		return nil, err
+	}
+
+	patch := &scheduler.SchedulePatch{}
+	if len(request.Backfill) == 0 {
+		patch.TriggerImmediately = &scheduler.TriggerImmediatelyRequest{OverlapPolicy: request.OverlapPolicy}
+	}
+	for _, backfill := range request.Backfill {
+		if backfill.OverlapPolicy == scheduler.ScheduleOverlapPolicyUnspecified {
+			backfill.OverlapPolicy = request.OverlapPolicy
+		}
+		patch.BackfillRequests = append(patch.BackfillRequests, backfill)
+	}
+	if err := scheduler.ValidatePatch(patch); err != nil {
+		return nil, wh.error(serviceerror.NewInvalidArgument(err.Error()), scope)
+	}
+
+	if err := wh.schedulerClient.PatchSchedule(ctx, request.Namespace, request.ScheduleID, patch); err != nil {
+		return nil, wh.error(err, scope)
+	}
+	return &scheduler.TriggerScheduleResponse{}, nil
 }
 
-func (wh *WorkflowHandler) error(err error, scope metrics.Scope, tagsForErrorLog ...tag.Tag) error {
-	switch err := err.(type) {
-	case *serviceerror.Internal, *serviceerror.DataLoss:
-		wh.GetLogger().WithTags(tagsForErrorLog...).Error("Internal service error", tag.Error(err))
-		scope.IncCounter(metrics.ServiceFailures)
-		return err
+// DeleteSchedule deletes a schedule. Workflows started by the schedule keep running.
+func (wh *WorkflowHandler) DeleteSchedule(ctx context.Context, request *scheduler.DeleteScheduleRequest) (_ *scheduler.DeleteScheduleResponse, retError error) {
+	defer log.CapturePanic(wh.GetLogger(), &retError)
+
+	scope, sw := wh.startRequestProfileWithNamespace(metrics.FrontendDeleteScheduleScope, request.GetNamespace())
+	defer sw.Stop()
+
+	if request == nil {
+		return nil, wh.error(errRequestNotSet, scope)
+	}
+
+	if err := wh.validateScheduleRequest(ctx, "DeleteSchedule", request.GetNamespace(), request.GetScheduleID(), scope); err != nil {
+		return nil, err
+	}
+
+	if err := wh.schedulerClient.DeleteSchedule(ctx, request); err != nil {
+		return nil, wh.error(err, scope)
+	}
+	return &scheduler.DeleteScheduleResponse{}, nil
+}
+
+// ListSchedules lists the schedules of a namespace.
+func (wh *WorkflowHandler) ListSchedules(ctx context.Context, request *scheduler.ListSchedulesRequest) (_ *scheduler.ListSchedulesResponse, retError error) {
+	defer log.CapturePanic(wh.GetLogger(), &retError)
+
+	scope, sw := wh.startRequestProfileWithNamespace(metrics.FrontendListSchedulesScope, request.GetNamespace())
+	defer sw.Stop()
+
+	if wh.isShuttingDown() {
+		return nil, errShuttingDown
+	}
+
+	if err := wh.versionChecker.ClientSupported(ctx, wh.config.EnableClientVersionCheck()); err != nil {
+		return nil, wh.error(err, scope)
+	}
+
+	if request == nil {
+		return nil, wh.error(errRequestNotSet, scope)
+	}
+
+	if err := wh.allow("ListSchedules", request.GetNamespace()); err != nil {
+		return nil, wh.error(err, scope)
+	}
+
+	if request.GetNamespace() == "" {
+		return nil, wh.error(errNamespaceNotSet, scope)
+	}
+
+	if _, err := wh.GetNamespaceCache().GetNamespaceID(request.GetNamespace()); err != nil {
+		return nil, wh.error(err, scope)
+	}
+
+	if request.GetMaximumPageSize() <= 0 {
+		request.MaximumPageSize = int32(wh.config.VisibilityMaxPageSize(request.GetNamespace()))
+	}
+
+	if wh.isListRequestPageSizeTooLarge(request.GetMaximumPageSize(), request.GetNamespace()) {
+		return nil, wh.error(errPageSizeTooBig.MessageArgs(wh.config.ESIndexMaxResultWindow()), scope)
+	}
+
+	resp, err := wh.schedulerClient.ListSchedules(ctx, request)
+	if err != nil {
+		return nil, wh.error(err, scope)
+	}
+	return resp, nil
+}
+
+// validateScheduleRequest runs the checks shared by all schedule APIs which target a single schedule
+func (wh *WorkflowHandler) validateScheduleRequest(ctx context.Context, apiName string, namespace string, scheduleID string, scope metrics.Scope) error {
+	if wh.isShuttingDown() {
+		return errShuttingDown
+	}
+
+	if err := wh.versionChecker.ClientSupported(ctx, wh.config.EnableClientVersionCheck()); err != nil {
+		return wh.error(err, scope)
+	}
+
+	if err := wh.allow(apiName, namespace); err != nil {
+		return wh.error(err, scope)
+	}
+
+	if namespace == "" {
+		return wh.error(errNamespaceNotSet, scope)
+	}
+
+	if scheduleID == "" {
+		return wh.error(errScheduleIDNotSet, scope)
+	}
+
+	if len(scheduler.WorkflowID(namespace, scheduleID)) > wh.config.MaxIDLengthLimit() {
+		return wh.error(errScheduleIDTooLong, scope)
+	}
+
+	// schedules of unknown namespaces would start workflows which can never run
+	if _, err := wh.GetNamespaceCache().GetNamespaceID(namespace); err != nil {
+		return wh.error(err, scope)
+	}
+	return nil
+}
+
+func (wh *WorkflowHandler) validateScheduleAction(namespace string, scheduleID string, schedule *scheduler.Schedule, operation string, scope metrics.Scope) error {
+	action := schedule.Action
+	if len(action.WorkflowID) > wh.config.MaxIDLengthLimit() {
+		return wh.error(errWorkflowIDTooLong, scope)
+	}
+
+	if len(action.WorkflowType) > wh.config.MaxIDLengthLimit() {
+		return wh.error(errWorkflowTypeTooLong, scope)
+	}
+
+	if err := wh.validateTaskQueue(&taskqueuepb.TaskQueue{Name: action.TaskQueue}, scope); err != nil {
+		return err
+	}
+
+	if err := wh.validateRetryPolicy(namespace, action.RetryPolicy); err != nil {
+		return wh.error(err, scope)
+	}
+
+	if err := wh.searchAttributesValidator.ValidateSearchAttributes(action.SearchAttributes, namespace); err != nil {
+		return wh.error(err, scope)
+	}
+
+	namespaceID, err := wh.GetNamespaceCache().GetNamespaceID(namespace)
+	if err != nil {
+		return wh.error(err, scope)
+	}
+
+	// the scheduler workflow carries the action input in its history, so the size is checked
+	// once here instead of failing every start
+	if err := common.CheckEventBlobSizeLimit(
+		action.Input.Size()+action.Memo.Size(),
+		wh.config.BlobSizeLimitWarn(namespace),
+		wh.config.BlobSizeLimitError(namespace),
+		namespaceID,
+		scheduler.WorkflowID(namespace, scheduleID),
+		"",
+		scope,
+		wh.GetThrottledLogger(),
+		tag.BlobSizeViolationOperation(operation),
+	); err != nil {
+		return wh.error(err, scope)
+	}
+	return nil
+}
+
+// DeleteWorkflowExecution deletes a workflow execution. A running execution is terminated first, its
+// mutable state, history and visibility records are then removed asynchronously by the history service.
+func (wh *WorkflowHandler) DeleteWorkflowExecution(ctx context.Context, request *DeleteWorkflowExecutionRequest) (_ *DeleteWorkflowExecutionResponse, retError error) {
+	defer log.CapturePanic(wh.GetLogger(), &retError)
+
+	scope, sw := wh.startRequestProfileWithNamespace(metrics.FrontendDeleteWorkflowExecutionScope, request.GetNamespace())
+	defer sw.Stop()
+
+	if wh.isShuttingDown() {
+		return nil, errShuttingDown
+	}
+
+	if err := wh.versionChecker.ClientSupported(ctx, wh.config.EnableClientVersionCheck()); err != nil {
+		return nil, wh.error(err, scope)
+	}
+
+	if request == nil {
+		return nil, wh.error(errRequestNotSet, scope)
+	}
+
+	if err := wh.allow("DeleteWorkflowExecution", request.GetNamespace()); err != nil {
+		return nil, wh.error(err, scope)
+	}
+
+	if request.GetNamespace() == "" {
+		return nil, wh.error(errNamespaceNotSet, scope)
+	}
+
+	if err := wh.validateExecution(request.GetWorkflowExecution(), scope); err != nil {
+		return nil, err
+	}
+
+	namespaceID, err := wh.GetNamespaceCache().GetNamespaceID(request.GetNamespace())
+	if err != nil {
+		return nil, wh.error(err, scope)
+	}
+
+	_, err = wh.GetHistoryClient().DeleteWorkflowExecution(ctx, &historyservice.DeleteWorkflowExecutionRequest{
+		NamespaceId:       namespaceID,
+		WorkflowExecution: request.GetWorkflowExecution(),
+		Identity:          request.GetIdentity(),
+	})
+	if err != nil {
+		return nil, wh.error(err, scope)
+	}
+
+	return &DeleteWorkflowExecutionResponse{}, nil
+}
+
+// UpdateWorkflowExecution sends an update to a workflow execution and waits until the workflow accepted or
+// completed it. The update is delivered on a workflow task and its outcome is recorded in the workflow history.
+func (wh *WorkflowHandler) UpdateWorkflowExecution(ctx context.Context, request *UpdateWorkflowExecutionRequest) (_ *UpdateWorkflowExecutionResponse, retError error) {
+	defer log.CapturePanic(wh.GetLogger(), &retError)
+
+	scope, sw := wh.startRequestProfileWithNamespace(metrics.FrontendUpdateWorkflowExecutionScope, request.GetNamespace())
+	defer sw.Stop()
+
+	if wh.isShuttingDown() {
+		return nil, errShuttingDown
+	}
+
+	if err := wh.versionChecker.ClientSupported(ctx, wh.config.EnableClientVersionCheck()); err != nil {
+		return nil, wh.error(err, scope)
+	}
+
+	if request == nil {
+		return nil, wh.error(errRequestNotSet, scope)
+	}
+
+	if err := wh.allow("UpdateWorkflowExecution", request.GetNamespace()); err != nil {
+		return nil, wh.error(err, scope)
+	}
+
+	if request.GetNamespace() == "" {
+		return nil, wh.error(errNamespaceNotSet, scope)
+	}
+
+	if err := wh.validateExecution(request.GetWorkflowExecution(), scope); err != nil {
+		return nil, err
+	}
+
+	if request.GetName() == "" {
+		return nil, wh.error(errUpdateNameNotSet, scope)
+	}
+
+	if len(request.GetName()) > wh.config.MaxIDLengthLimit() {
+		return nil, wh.error(errUpdateNameTooLong, scope)
+	}
+
+	updateID := request.GetUpdateID()
+	if updateID == "" {
+		updateID = uuid.New()
+	}
+	if len(updateID) > wh.config.MaxIDLengthLimit() {
+		return nil, wh.error(errUpdateIDTooLong, scope)
+	}
+
+	namespaceID, err := wh.GetNamespaceCache().GetNamespaceID(request.GetNamespace())
+	if err != nil {
+		return nil, wh.error(err, scope)
+	}
+
+	sizeLimitError := wh.config.BlobSizeLimitError(request.GetNamespace())
+	sizeLimitWarn := wh.config.BlobSizeLimitWarn(request.GetNamespace())
+	if err := common.CheckEventBlobSizeLimit(
+		request.GetInput().Size(),
+		sizeLimitWarn,
+		sizeLimitError,
+		namespaceID,
+		request.GetWorkflowExecution().GetWorkflowId(),
+		request.GetWorkflowExecution().GetRunId(),
+		scope,
+		wh.GetThrottledLogger(),
+		tag.BlobSizeViolationOperation("UpdateWorkflowExecution"),
+	); err != nil {
+		return nil, wh.error(err, scope)
+	}
+
+	resp, err := wh.GetHistoryClient().UpdateWorkflowExecution(ctx, &historyservice.UpdateWorkflowExecutionRequest{
+		NamespaceId:       namespaceID,
+		WorkflowExecution: request.GetWorkflowExecution(),
+		UpdateId:          updateID,
+		Name:              request.GetName(),
+		Input:             request.GetInput(),
+		Identity:          request.GetIdentity(),
+		WaitForAccepted:   request.WaitStage == UpdateStageAccepted,
+	})
+	if err != nil {
+		return nil, wh.error(err, scope)
+	}
+
+	stage := UpdateStageAccepted
+	if resp.GetCompleted() {
+		stage = UpdateStageCompleted
+	}
+	return &UpdateWorkflowExecutionResponse{
+		UpdateID: updateID,
+		Stage:    stage,
+		Result:   resp.GetResult(),
+		Failure:  resp.GetFailure(),
+	}, nil
+}
+
+// GetWorkflowExecutionResult long polls until the workflow execution closed and returns its outcome. The poll is
+// served by PollMutableState, so only the last batch of events is read from history to get the close event.
+func (wh *WorkflowHandler) GetWorkflowExecutionResult(ctx context.Context, request *GetWorkflowExecutionResultRequest) (_ *GetWorkflowExecutionResultResponse, retError error) {
+	defer log.CapturePanic(wh.GetLogger(), &retError)
+
+	scope, sw := wh.startRequestProfileWithNamespace(metrics.FrontendGetWorkflowExecutionResultScope, request.GetNamespace())
+	defer sw.Stop()
+
+	if wh.isShuttingDown() {
+		return nil, errShuttingDown
+	}
+
+	if err := wh.versionChecker.ClientSupported(ctx, wh.config.EnableClientVersionCheck()); err != nil {
+		return nil, wh.error(err, scope)
+	}
+
+	if request == nil {
+		return nil, wh.error(errRequestNotSet, scope)
+	}
+
+	if err := common.ValidateLongPollContextTimeout(
+		ctx,
+		"GetWorkflowExecutionResult",
+		wh.GetThrottledLogger(),
+	); err != nil {
+		return nil, wh.error(err, scope)
+	}
+
+	if err := wh.allow("GetWorkflowExecutionResult", request.GetNamespace()); err != nil {
+		return nil, wh.error(err, scope)
+	}
+
+	if request.GetNamespace() == "" {
+		return nil, wh.error(errNamespaceNotSet, scope)
+	}
+
+	if err := wh.validateExecution(request.GetWorkflowExecution(), scope); err != nil {
+		return nil, err
+	}
+
+	namespaceID, err := wh.GetNamespaceCache().GetNamespaceID(request.GetNamespace())
+	if err != nil {
+		return nil, wh.error(err, scope)
+	}
+
+	// stop polling a bit before the caller's deadline, so an empty response can still be returned
+	deadline, _ := ctx.Deadline()
+	pollCtx, cancel := context.WithDeadline(ctx, deadline.Add(-getWorkflowExecutionResultTailRoom))
+	defer cancel()
+
+	execution := &commonpb.WorkflowExecution{
+		WorkflowId: request.GetWorkflowExecution().GetWorkflowId(),
+		RunId:      request.GetWorkflowExecution().GetRunId(),
+	}
+	for {
+		// EndEventID is never reached, so the poll only returns once the workflow closed or the poll expired
+		response, err := wh.GetHistoryClient().PollMutableState(pollCtx, &historyservice.PollMutableStateRequest{
+			NamespaceId:         namespaceID,
+			Execution:           execution,
+			ExpectedNextEventId: common.EndEventID,
+		})
+		if err != nil {
+			if pollCtx.Err() != nil && ctx.Err() == nil {
+				return &GetWorkflowExecutionResultResponse{
+					WorkflowExecution: execution,
+					Status:            enumspb.WORKFLOW_EXECUTION_STATUS_RUNNING,
+				}, nil
+			}
+			return nil, wh.error(err, scope)
+		}
+		execution.RunId = response.GetExecution().GetRunId()
+		if response.GetWorkflowStatus() == enumspb.WORKFLOW_EXECUTION_STATUS_RUNNING {
+			continue
+		}
+
+		closeEvent, err := wh.getWorkflowCloseEvent(
+			scope,
+			request.GetNamespace(),
+			namespaceID,
+			*execution,
+			response.GetLastFirstEventId(),
+			response.GetNextEventId(),
+			response.GetCurrentBranchToken(),
+		)
+		if err != nil {
+			return nil, wh.error(err, scope)
+		}
+
+		result := newWorkflowExecutionResult(execution, response.GetWorkflowStatus(), closeEvent)
+		if request.GetFollowRuns() && result.NewExecutionRunID != "" {
+			execution = &commonpb.WorkflowExecution{
+				WorkflowId: execution.GetWorkflowId(),
+				RunId:      result.NewExecutionRunID,
+			}
+			continue
+		}
+		return result, nil
+	}
+}
+
+// ResetWorkflowExecutionWithOptions resets a workflow execution like ResetWorkflowExecution, the reset point can be
+// given by the workflow task scheduled event and the events reapplied to the reset run can be restricted. A dry run
+// returns a preview of the reset without persisting anything.
+func (wh *WorkflowHandler) ResetWorkflowExecutionWithOptions(ctx context.Context, request *ResetWorkflowExecutionWithOptionsRequest) (_ *ResetWorkflowExecutionWithOptionsResponse, retError error) {
+	defer log.CapturePanic(wh.GetLogger(), &retError)
+
+	scope, sw := wh.startRequestProfileWithNamespace(metrics.FrontendResetWorkflowExecutionWithOptionsScope, request.GetNamespace())
+	defer sw.Stop()
+
+	if wh.isShuttingDown() {
+		return nil, errShuttingDown
+	}
+
+	if err := wh.versionChecker.ClientSupported(ctx, wh.config.EnableClientVersionCheck()); err != nil {
+		return nil, wh.error(err, scope)
+	}
+
+	if request == nil {
+		return nil, wh.error(errRequestNotSet, scope)
+	}
+
+	if err := wh.allow("ResetWorkflowExecutionWithOptions", request.GetNamespace()); err != nil {
+		return nil, wh.error(err, scope)
+	}
+
+	if request.GetNamespace() == "" {
+		return nil, wh.error(errNamespaceNotSet, scope)
+	}
+
+	if err := wh.validateExecution(request.GetWorkflowExecution(), scope); err != nil {
+		return nil, err
+	}
+
+	if request.WorkflowTaskFinishEventID != 0 && request.WorkflowTaskScheduledEventID != 0 {
+		return nil, wh.error(errResetPointAmbiguous, scope)
+	}
+
+	requestID := request.RequestID
+	if requestID == "" {
+		requestID = uuid.New()
+	}
+	if len(requestID) > wh.config.MaxIDLengthLimit() {
+		return nil, wh.error(errRequestIDTooLong, scope)
+	}
+
+	namespaceID, err := wh.GetNamespaceCache().GetNamespaceID(request.GetNamespace())
+	if err != nil {
+		return nil, wh.error(err, scope)
+	}
+
+	resp, err := wh.GetHistoryClient().ResetWorkflowExecution(ctx, &historyservice.ResetWorkflowExecutionRequest{
+		NamespaceId: namespaceID,
+		ResetRequest: &workflowservice.ResetWorkflowExecutionRequest{
+			Namespace:                 request.GetNamespace(),
+			WorkflowExecution:         request.GetWorkflowExecution(),
+			Reason:                    request.Reason,
+			WorkflowTaskFinishEventId: request.WorkflowTaskFinishEventID,
+			RequestId:                 requestID,
+		},
+		WorkflowTaskScheduledEventId: request.WorkflowTaskScheduledEventID,
+		ReapplyExcludeSignals:        request.ReapplyType == ResetReapplyTypeExcludeSignals,
+		ReapplyExcludeAll:            request.ReapplyType == ResetReapplyTypeNone,
+		DryRun:                       request.DryRun,
+	})
+	if err != nil {
+		return nil, wh.error(err, scope)
+	}
+
+	if preview := resp.GetPreview(); preview != nil {
+		return &ResetWorkflowExecutionWithOptionsResponse{
+			Preview: &ResetWorkflowExecutionPreview{
+				BaseRunID:                 preview.GetBaseRunId(),
+				WorkflowTaskFinishEventID: preview.GetWorkflowTaskFinishEventId(),
+				TerminatedRunID:           preview.GetTerminatedRunId(),
+				DroppedEvents:             preview.GetDroppedEvents(),
+				FailedActivities:          preview.GetFailedActivities(),
+				PendingChildren:           preview.GetPendingChildren(),
+				ReappliedEvents:           preview.GetReappliedEvents(),
+			},
+		}, nil
+	}
+	return &ResetWorkflowExecutionWithOptionsResponse{RunID: resp.GetRunId()}, nil
+}
+
+// PauseWorkflowExecution pauses a workflow execution. The workflow and activity tasks of a paused workflow execution
+// are not dispatched to workers until it is unpaused, timers still fire and signals are still accepted.
+func (wh *WorkflowHandler) PauseWorkflowExecution(ctx context.Context, request *PauseWorkflowExecutionRequest) (_ *PauseWorkflowExecutionResponse, retError error) {
+	defer log.CapturePanic(wh.GetLogger(), &retError)
+
+	scope, sw := wh.startRequestProfileWithNamespace(metrics.FrontendPauseWorkflowExecutionScope, request.GetNamespace())
+	defer sw.Stop()
+
+	if wh.isShuttingDown() {
+		return nil, errShuttingDown
+	}
+
+	if err := wh.versionChecker.ClientSupported(ctx, wh.config.EnableClientVersionCheck()); err != nil {
+		return nil, wh.error(err, scope)
+	}
+
+	if request == nil {
+		return nil, wh.error(errRequestNotSet, scope)
+	}
+
+	if err := wh.allow("PauseWorkflowExecution", request.GetNamespace()); err != nil {
+		return nil, wh.error(err, scope)
+	}
+
+	if request.GetNamespace() == "" {
+		return nil, wh.error(errNamespaceNotSet, scope)
+	}
+
+	if err := wh.validateExecution(request.GetWorkflowExecution(), scope); err != nil {
+		return nil, err
+	}
+
+	namespaceID, err := wh.GetNamespaceCache().GetNamespaceID(request.GetNamespace())
+	if err != nil {
+		return nil, wh.error(err, scope)
+	}
+
+	_, err = wh.GetHistoryClient().PauseWorkflowExecution(ctx, &historyservice.PauseWorkflowExecutionRequest{
+		NamespaceId:       namespaceID,
+		WorkflowExecution: request.GetWorkflowExecution(),
+		Identity:          request.GetIdentity(),
+		Reason:            request.GetReason(),
+	})
+	if err != nil {
+		return nil, wh.error(err, scope)
+	}
+
+	return &PauseWorkflowExecutionResponse{}, nil
+}
+
+// UnpauseWorkflowExecution resumes the dispatch of the workflow and activity tasks of a paused workflow execution.
+func (wh *WorkflowHandler) UnpauseWorkflowExecution(ctx context.Context, request *UnpauseWorkflowExecutionRequest) (_ *UnpauseWorkflowExecutionResponse, retError error) {
+	defer log.CapturePanic(wh.GetLogger(), &retError)
+
+	scope, sw := wh.startRequestProfileWithNamespace(metrics.FrontendUnpauseWorkflowExecutionScope, request.GetNamespace())
+	defer sw.Stop()
+
+	if wh.isShuttingDown() {
+		return nil, errShuttingDown
+	}
+
+	if err := wh.versionChecker.ClientSupported(ctx, wh.config.EnableClientVersionCheck()); err != nil {
+		return nil, wh.error(err, scope)
+	}
+
+	if request == nil {
+		return nil, wh.error(errRequestNotSet, scope)
+	}
+
+	if err := wh.allow("UnpauseWorkflowExecution", request.GetNamespace()); err != nil {
+		return nil, wh.error(err, scope)
+	}
+
+	if request.GetNamespace() == "" {
+		return nil, wh.error(errNamespaceNotSet, scope)
+	}
+
+	if err := wh.validateExecution(request.GetWorkflowExecution(), scope); err != nil {
+		return nil, err
+	}
+
+	namespaceID, err := wh.GetNamespaceCache().GetNamespaceID(request.GetNamespace())
+	if err != nil {
+		return nil, wh.error(err, scope)
+	}
+
+	_, err = wh.GetHistoryClient().UnpauseWorkflowExecution(ctx, &historyservice.UnpauseWorkflowExecutionRequest{
+		NamespaceId:       namespaceID,
+		WorkflowExecution: request.GetWorkflowExecution(),
+		Identity:          request.GetIdentity(),
+	})
+	if err != nil {
+		return nil, wh.error(err, scope)
+	}
+
+	return &UnpauseWorkflowExecutionResponse{}, nil
+}
+
+// StartBatchOperation starts a batch operation on the workflows of a namespace matching a visibility query.
+func (wh *WorkflowHandler) StartBatchOperation(ctx context.Context, request *batcher.StartBatchOperationRequest) (_ *batcher.StartBatchOperationResponse, retError error) {
+	defer log.CapturePanic(wh.GetLogger(), &retError)
+
+	scope, sw := wh.startRequestProfileWithNamespace(metrics.FrontendStartBatchOperationScope, request.GetNamespace())
+	defer sw.Stop()
+
+	if request == nil {
+		return nil, wh.error(errRequestNotSet, scope)
+	}
+
+	if err := wh.validateBatchOperationRequest(ctx, "StartBatchOperation", request.GetNamespace(), request.GetJobID(), scope); err != nil {
+		return nil, err
+	}
+
+	if request.Query == "" {
+		return nil, wh.error(errVisibilityQueryNotSet, scope)
+	}
+
+	if request.Reason == "" {
+		return nil, wh.error(errReasonNotSet, scope)
+	}
+
+	// the batch workflow passes the query to the visibility APIs, only the validation result matters here
+	countRequest := &workflowservice.CountWorkflowExecutionsRequest{
+		Namespace: request.GetNamespace(),
+		Query:     request.Query,
+	}
+	if err := wh.visibilityQueryValidator.ValidateCountRequestForQuery(countRequest); err != nil {
+		return nil, wh.error(err, scope)
+	}
+
+	if err := wh.batcherClient.StartBatchOperation(ctx, request); err != nil {
+		return nil, wh.error(err, scope)
+	}
+	return &batcher.StartBatchOperationResponse{}, nil
+}
+
+// StopBatchOperation stops a running batch operation. Workflows which were already processed are not affected.
+func (wh *WorkflowHandler) StopBatchOperation(ctx context.Context, request *batcher.StopBatchOperationRequest) (_ *batcher.StopBatchOperationResponse, retError error) {
+	defer log.CapturePanic(wh.GetLogger(), &retError)
+
+	scope, sw := wh.startRequestProfileWithNamespace(metrics.FrontendStopBatchOperationScope, request.GetNamespace())
+	defer sw.Stop()
+
+	if request == nil {
+		return nil, wh.error(errRequestNotSet, scope)
+	}
+
+	if err := wh.validateBatchOperationRequest(ctx, "StopBatchOperation", request.GetNamespace(), request.GetJobID(), scope); err != nil {
+		return nil, err
+	}
+
+	if err := wh.batcherClient.StopBatchOperation(ctx, request); err != nil {
+		return nil, wh.error(err, scope)
+	}
+	return &batcher.StopBatchOperationResponse{}, nil
+}
+
+// DescribeBatchOperation returns the state and progress of a batch operation.
+func (wh *WorkflowHandler) DescribeBatchOperation(ctx context.Context, request *batcher.DescribeBatchOperationRequest) (_ *batcher.DescribeBatchOperationResponse, retError error) {
+	defer log.CapturePanic(wh.GetLogger(), &retError)
+
+	scope, sw := wh.startRequestProfileWithNamespace(metrics.FrontendDescribeBatchOperationScope, request.GetNamespace())
+	defer sw.Stop()
+
+	if request == nil {
+		return nil, wh.error(errRequestNotSet, scope)
+	}
+
+	if err := wh.validateBatchOperationRequest(ctx, "DescribeBatchOperation", request.GetNamespace(), request.GetJobID(), scope); err != nil {
+		return nil, err
+	}
+
+	resp, err := wh.batcherClient.DescribeBatchOperation(ctx, request)
+	if err != nil {
+		return nil, wh.error(err, scope)
+	}
+	return resp, nil
+}
+
+// ListBatchOperations lists the batch operations of a namespace.
+func (wh *WorkflowHandler) ListBatchOperations(ctx context.Context, request *batcher.ListBatchOperationsRequest) (_ *batcher.ListBatchOperationsResponse, retError error) {
+	defer log.CapturePanic(wh.GetLogger(), &retError)
+
+	scope, sw := wh.startRequestProfileWithNamespace(metrics.FrontendListBatchOperationsScope, request.GetNamespace())
+	defer sw.Stop()
+
+	if wh.isShuttingDown() {
+		return nil, errShuttingDown
+	}
+
+	if err := wh.versionChecker.ClientSupported(ctx, wh.config.EnableClientVersionCheck()); err != nil {
+		return nil, wh.error(err, scope)
+	}
+
+	if request == nil {
+		return nil, wh.error(errRequestNotSet, scope)
+	}
+
+	if err := wh.allow("ListBatchOperations", request.GetNamespace()); err != nil {
+		return nil, wh.error(err, scope)
+	}
+
+	if request.GetNamespace() == "" {
+		return nil, wh.error(errNamespaceNotSet, scope)
+	}
+
+	if _, err := wh.GetNamespaceCache().GetNamespaceID(request.GetNamespace()); err != nil {
+		return nil, wh.error(err, scope)
+	}
+
+	if request.GetPageSize() <= 0 {
+		request.PageSize = int32(wh.config.VisibilityMaxPageSize(request.GetNamespace()))
+	}
+
+	if wh.isListRequestPageSizeTooLarge(request.GetPageSize(), request.GetNamespace()) {
+		return nil, wh.error(errPageSizeTooBig.MessageArgs(wh.config.ESIndexMaxResultWindow()), scope)
+	}
+
+	resp, err := wh.batcherClient.ListBatchOperations(ctx, request)
+	if err != nil {
+		return nil, wh.error(err, scope)
+	}
+	return resp, nil
+}
+
+// validateBatchOperationRequest runs the checks shared by all batch operation APIs which target a single job
+func (wh *WorkflowHandler) validateBatchOperationRequest(ctx context.Context, apiName string, namespace string, jobID string, scope metrics.Scope) error {
+	if wh.isShuttingDown() {
+		return errShuttingDown
+	}
+
+	if err := wh.versionChecker.ClientSupported(ctx, wh.config.EnableClientVersionCheck()); err != nil {
+		return wh.error(err, scope)
+	}
+
+	if err := wh.allow(apiName, namespace); err != nil {
+		return wh.error(err, scope)
+	}
+
+	if namespace == "" {
+		return wh.error(errNamespaceNotSet, scope)
+	}
+
+	if jobID == "" {
+		return wh.error(errJobIDNotSet, scope)
+	}
+
+	if len(jobID) > wh.config.MaxIDLengthLimit() {
+		return wh.error(errJobIDTooLong, scope)
+	}
+
+	if _, err := wh.GetNamespaceCache().GetNamespaceID(namespace); err != nil {
+		return wh.error(err, scope)
+	}
+	return nil
+}
+
+func (wh *WorkflowHandler) getRawHistory(
+	scope metrics.Scope,
+	namespaceID string,
+	execution commonpb.WorkflowExecution,
+	firstEventID int64,
+	nextEventID int64,
+	pageSize int32,
+	nextPageToken []byte,
+	transientWorkflowTaskInfo *historyspb.TransientWorkflowTaskInfo,
+	branchToken []byte,
+) ([]*commonpb.DataBlob, []byte, error) {
+	var rawHistory []*commonpb.DataBlob
+	shardID := common.WorkflowIDToHistoryShard(namespaceID, execution.GetWorkflowId(), wh.config.NumHistoryShards)
+
+	resp, err := wh.GetHistoryManager().ReadRawHistoryBranch(&persistence.ReadHistoryBranchRequest{
+		BranchToken:   branchToken,
+		MinEventID:    firstEventID,
+		MaxEventID:    nextEventID,
+		PageSize:      int(pageSize),
+		NextPageToken: nextPageToken,
+		ShardID:       convert.Int32Ptr(shardID),
+	})
+	if err != nil {
+		return nil, nil, err
+	}
+
+	for _, data := range resp.HistoryEventBlobs {
+		rawHistory = append(rawHistory, &commonpb.DataBlob{
+			EncodingType: data.Encoding,
+			Data:         data.Data,
+		})
+	}
+
+	if len(nextPageToken) == 0 && transientWorkflowTaskInfo != nil {
+		if err := wh.validateTransientWorkflowTaskEvents(nextEventID, transientWorkflowTaskInfo); err != nil {
+			scope.IncCounter(metrics.ServiceErrIncompleteHistoryCounter)
+			wh.GetLogger().Error("getHistory error",
+				tag.WorkflowNamespaceID(namespaceID),
+				tag.WorkflowID(execution.GetWorkflowId()),
+				tag.WorkflowRunID(execution.GetRunId()),
+				tag.Error(err))
+		}
+
+		blob, err := wh.GetPayloadSerializer().SerializeEvent(transientWorkflowTaskInfo.ScheduledEvent, enumspb.ENCODING_TYPE_PROTO3)
+		if err != nil {
+			return nil, nil, err
+		}
+		rawHistory = append(rawHistory, &commonpb.DataBlob{
+			EncodingType: enumspb.ENCODING_TYPE_PROTO3,
+			Data:         blob.Data,
+		})
+
+		blob, err = wh.GetPayloadSerializer().SerializeEvent(transientWorkflowTaskInfo.StartedEvent, enumspb.ENCODING_TYPE_PROTO3)
+		if err != nil {
+			return nil, nil, err
+		}
+		rawHistory = append(rawHistory, &commonpb.DataBlob{
+			EncodingType: enumspb.ENCODING_TYPE_PROTO3,
+			Data:         blob.Data,
+		})
+	}
+
+	return rawHistory, resp.NextPageToken, nil
+}
+
+func (wh *WorkflowHandler) getHistory(
+	scope metrics.Scope,
+	namespaceID string,
+	execution commonpb.WorkflowExecution,
+	firstEventID, nextEventID int64,
+	pageSize int32,
+	nextPageToken []byte,
+	transientWorkflowTaskInfo *historyspb.TransientWorkflowTaskInfo,
+	branchToken []byte,
+	isWorkflowClosed bool,
+) (*historypb.History, []byte, error) {
+
+	isFirstPage := len(nextPageToken) == 0
+	persistenceToken := nextPageToken
+	shardID := common.WorkflowIDToHistoryShard(namespaceID, execution.GetWorkflowId(), wh.config.NumHistoryShards)
+	request := &persistence.ReadHistoryBranchRequest{
+		BranchToken:   branchToken,
+		MinEventID:    firstEventID,
+		MaxEventID:    nextEventID,
+		PageSize:      int(pageSize),
+		NextPageToken: nextPageToken,
+		ShardID:       convert.Int32Ptr(shardID),
+		// the history of a closed workflow does not change anymore, so it can be read from a replica
+		AllowStaleRead: isWorkflowClosed,
+	}
+	historyEvents, size, nextPageToken, err := persistence.ReadFullPageV2Events(wh.GetHistoryManager(), request)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	isLastPage := len(nextPageToken) == 0
+	err = wh.verifyHistoryIsComplete(
+		historyEvents,
+		firstEventID,
+		nextEventID-1,
+		isFirstPage,
+		isLastPage,
+		int(pageSize))
+	if err != nil && request.AllowStaleRead {
+		// the replica has not caught up with the close of the workflow yet, read the page from the primary
+		request.AllowStaleRead = false
+		request.NextPageToken = persistenceToken
+		historyEvents, size, nextPageToken, err = persistence.ReadFullPageV2Events(wh.GetHistoryManager(), request)
+		if err != nil {
+			return nil, nil, err
+		}
+
+		isLastPage = len(nextPageToken) == 0
+		err = wh.verifyHistoryIsComplete(
+			historyEvents,
+			firstEventID,
+			nextEventID-1,
+			isFirstPage,
+			isLastPage,
+			int(pageSize))
+	}
+
+	scope.RecordTimer(metrics.HistorySize, time.Duration(size))
+
+	if err != nil {
+		scope.IncCounter(metrics.ServiceErrIncompleteHistoryCounter)
+		wh.GetLogger().Error("getHistory: incomplete history",
+			tag.WorkflowNamespaceID(namespaceID),
+			tag.WorkflowID(execution.GetWorkflowId()),
+			tag.WorkflowRunID(execution.GetRunId()),
+			tag.Error(err))
+		return nil, nil, err
+	}
+
+	if len(nextPageToken) == 0 && transientWorkflowTaskInfo != nil {
+		if err := wh.validateTransientWorkflowTaskEvents(nextEventID, transientWorkflowTaskInfo); err != nil {
+			scope.IncCounter(metrics.ServiceErrIncompleteHistoryCounter)
+			wh.GetLogger().Error("getHistory error",
+				tag.WorkflowNamespaceID(namespaceID),
+				tag.WorkflowID(execution.GetWorkflowId()),
+				tag.WorkflowRunID(execution.GetRunId()),
+				tag.Error(err))
+		}
+		// Append the transient workflow task events once we are done enumerating everything from the events table
+		historyEvents = append(historyEvents, transientWorkflowTaskInfo.ScheduledEvent, transientWorkflowTaskInfo.StartedEvent)
+	}
+
+	executionHistory := &historypb.History{}
+	executionHistory.Events = historyEvents
+	return executionHistory, nextPageToken, nil
+}
+
+// getWorkflowCloseEvent reads the last batch of events of a closed workflow execution and returns its close event
+func (wh *WorkflowHandler) getWorkflowCloseEvent(
+	scope metrics.Scope,
+	namespace string,
+	namespaceID string,
+	execution commonpb.WorkflowExecution,
+	lastFirstEventID, nextEventID int64,
+	branchToken []byte,
+) (*historypb.HistoryEvent, error) {
+
+	pageSize := int32(wh.config.HistoryMaxPageSize(namespace))
+	var closeEvent *historypb.HistoryEvent
+	var nextPageToken []byte
+	for {
+		history, token, err := wh.getHistory(
+			scope,
+			namespaceID,
+			execution,
+			lastFirstEventID,
+			nextEventID,
+			pageSize,
+			nextPageToken,
+			nil,
+			branchToken,
+			true,
+		)
+		if err != nil {
+			return nil, err
+		}
+		if events := history.GetEvents(); len(events) > 0 {
+			closeEvent = events[len(events)-1]
+		}
+		if len(token) == 0 {
+			break
+		}
+		nextPageToken = token
+	}
+	if closeEvent == nil || closeEvent.GetEventId() != nextEventID-1 {
+		return nil, serviceerror.NewInternal("Unable to read close event of workflow execution.")
+	}
+	return closeEvent, nil
+}
+
+func newWorkflowExecutionResult(
+	execution *commonpb.WorkflowExecution,
+	status enumspb.WorkflowExecutionStatus,
+	closeEvent *historypb.HistoryEvent,
+) *GetWorkflowExecutionResultResponse {
+
+	result := &GetWorkflowExecutionResultResponse{
+		WorkflowExecution: execution,
+		Status:            status,
+	}
+	switch closeEvent.GetEventType() {
+	case enumspb.EVENT_TYPE_WORKFLOW_EXECUTION_COMPLETED:
+		result.Result = closeEvent.GetWorkflowExecutionCompletedEventAttributes().GetResult()
+	case enumspb.EVENT_TYPE_WORKFLOW_EXECUTION_FAILED:
+		result.Failure = closeEvent.GetWorkflowExecutionFailedEventAttributes().GetFailure()
+	case enumspb.EVENT_TYPE_WORKFLOW_EXECUTION_TIMED_OUT:
+		result.Failure = failure.NewTimeoutFailure("workflow execution timed out", enumspb.TIMEOUT_TYPE_START_TO_CLOSE)
+	case enumspb.EVENT_TYPE_WORKFLOW_EXECUTION_CANCELED:
+		result.Failure = &failurepb.Failure{
+			Message: "workflow execution canceled",
+			FailureInfo: &failurepb.Failure_CanceledFailureInfo{CanceledFailureInfo: &failurepb.CanceledFailureInfo{
+				Details: closeEvent.GetWorkflowExecutionCanceledEventAttributes().GetDetails(),
+			}},
+		}
+	case enumspb.EVENT_TYPE_WORKFLOW_EXECUTION_TERMINATED:
+		result.Failure = &failurepb.Failure{
+			Message:     closeEvent.GetWorkflowExecutionTerminatedEventAttributes().GetReason(),
+			FailureInfo: &failurepb.Failure_TerminatedFailureInfo{TerminatedFailureInfo: &failurepb.TerminatedFailureInfo{}},
+		}
+	case enumspb.EVENT_TYPE_WORKFLOW_EXECUTION_CONTINUED_AS_NEW:
+		result.NewExecutionRunID = closeEvent.GetWorkflowExecutionContinuedAsNewEventAttributes().GetNewExecutionRunId()
+	}
+	return result
+}
+
+func (wh *WorkflowHandler) validateTransientWorkflowTaskEvents(
+	expectedNextEventID int64,
+	transientWorkflowTaskInfo *historyspb.TransientWorkflowTaskInfo,
+) error {
+
+	if transientWorkflowTaskInfo.ScheduledEvent.GetEventId() == expectedNextEventID &&
+		transientWorkflowTaskInfo.StartedEvent.GetEventId() == expectedNextEventID+1 {
+		return nil
+	}
+
+	return fmt.Errorf("invalid transient workflow task: expectedScheduledEventID=%v expectedStartedEventID=%v but have scheduledEventID=%v startedEventID=%v",
+		expectedNextEventID,
+		expectedNextEventID+1,
+		transientWorkflowTaskInfo.ScheduledEvent.GetEventId(),
+		transientWorkflowTaskInfo.StartedEvent.GetEventId())
+}
+
+// startRequestProfile initiates recording of request metrics
+func (wh *WorkflowHandler) startRequestProfile(scope int) (metrics.Scope, metrics.Stopwatch) {
+	metricsScope := wh.GetMetricsClient().Scope(scope).Tagged(metrics.NamespaceUnknownTag())
+	// timer should be emitted with the all tag
+	sw := metricsScope.StartTimer(metrics.ServiceLatency)
+	metricsScope.IncCounter(metrics.ServiceRequests)
+	return metricsScope, sw
+}
+
+// startRequestProfileWithNamespace initiates recording of request metrics and returns a namespace tagged scope
+func (wh *WorkflowHandler) startRequestProfileWithNamespace(scope int, namespace string) (metrics.Scope, metrics.Stopwatch) {
+	var metricsScope metrics.Scope
+	if namespace != "" {
+		metricsScope = wh.GetMetricsClient().Scope(scope).Tagged(metrics.NamespaceTag(namespace))
+	} else {
+		metricsScope = wh.GetMetricsClient().Scope(scope).Tagged(metrics.NamespaceUnknownTag())
+	}
+	sw := metricsScope.StartTimer(metrics.ServiceLatency)
+	metricsScope.IncCounter(metrics.ServiceRequests)
+	return metricsScope, sw
+}
+
+// getDefaultScope returns a default scope to use for request metrics
+func (wh *WorkflowHandler) getDefaultScope(scope int) metrics.Scope {
+	return wh.GetMetricsClient().Scope(scope).Tagged(metrics.NamespaceUnknownTag())
+}
+
+func (wh *WorkflowHandler) error(err error, scope metrics.Scope, tagsForErrorLog ...tag.Tag) error {
+	switch err := err.(type) {
+	case *serviceerror.Internal, *serviceerror.DataLoss:
+		wh.GetLogger().WithTags(tagsForErrorLog...).Error("Internal service error", tag.Error(err))
+		scope.IncCounter(metrics.ServiceFailures)
+		return err
 	case *serviceerror.InvalidArgument:
 		scope.IncCounter(metrics.ServiceErrInvalidArgumentCounter)
 		return err
@@ -3435,6 +4479,14 @@ func (wh *WorkflowHandler) createPollWorkflowTaskQueueResponse(
 		return &workflowservice.PollWorkflowTaskQueueResponse{}, nil
 	}
 
+	// the headers cannot be set if the handler is not called through gRPC, the stats are informative only
+	_ = headers.SetHistoryStats(
+		ctx,
+		matchingResp.GetHistorySizeBytes(),
+		matchingResp.GetNextEventId()-common.FirstEventID,
+		matchingResp.GetSuggestContinueAsNew(),
+	)
+
 	var history *historypb.History
 	var continuation []byte
 	var err error
@@ -3475,6 +4527,7 @@ func (wh *WorkflowHandler) createPollWorkflowTaskQueueResponse(
 			nil,
 			matchingResp.GetWorkflowTaskInfo(),
 			branchToken,
+			false,
 		)
 		if err != nil {
 			return nil, err
@@ -3510,6 +4563,7 @@ func (wh *WorkflowHandler) createPollWorkflowTaskQueueResponse(
 		ScheduledTime:              matchingResp.ScheduledTime,
 		StartedTime:                matchingResp.StartedTime,
 		Queries:                    matchingResp.Queries,
+		Updates:                    matchingResp.Updates,
 	}
 
 	return resp, nil
@@ -3660,9 +4714,29 @@ func (wh *WorkflowHandler) isListRequestPageSizeTooLarge(pageSize int32, namespa
 		pageSize > int32(wh.config.ESIndexMaxResultWindow())
 }
 
-func (wh *WorkflowHandler) allow(namespace string) bool {
-	return wh.rateLimiter.Allow(quotas.Info{Namespace: namespace})
+// allow checks the quotas of a request. A request of an API category with a quota in its namespace is only
+// limited by the quota of the category, the other requests are limited by the shared quotas.
+func (wh *WorkflowHandler) allow(apiName string, namespace string) error {
+	info := quotas.Info{Namespace: namespace, APIName: apiName}
+	if wh.namespaceAPIRateLimiter.IsLimited(info) {
+		return wh.allowAPICategory(apiName, namespace)
+	}
+	if !wh.rateLimiter.Allow(info) {
+		return errServiceBusy
+	}
+	return nil
+}
+
+// allowAPICategory checks only the quota of the API category of a request, it is used by the APIs which are not
+// limited by the shared quotas
+func (wh *WorkflowHandler) allowAPICategory(apiName string, namespace string) error {
+	info := quotas.Info{Namespace: namespace, APIName: apiName}
+	if !wh.namespaceAPIRateLimiter.Allow(info) {
+		return serviceerror.NewResourceExhausted(fmt.Sprintf("Rate limit exceeded for bucket %v.", wh.namespaceAPIRateLimiter.Bucket(info)))
+	}
+	return nil
 }
+
 func (wh *WorkflowHandler) checkPermission(
 	config *Config,
 	securityToken string,
@@ -45,6 +45,7 @@ import (
 	taskqueuepb "go.temporal.io/api/taskqueue/v1"
 	"go.temporal.io/api/workflowservice/v1"
 
+	"go.temporal.io/server/api/historyservice/v1"
 	"go.temporal.io/server/api/historyservicemock/v1"
 	"go.temporal.io/server/api/persistenceblobs/v1"
 	"go.temporal.io/server/common"
@@ -57,11 +58,13 @@ import (
 	"go.temporal.io/server/common/metrics"
 	"go.temporal.io/server/common/mocks"
 	"go.temporal.io/server/common/namespace"
+	"go.temporal.io/server/common/payloads"
 	"go.temporal.io/server/common/persistence"
 	"go.temporal.io/server/common/primitives"
 	"go.temporal.io/server/common/primitives/timestamp"
 	"go.temporal.io/server/common/resource"
 	dc "go.temporal.io/server/common/service/dynamicconfig"
+	"go.temporal.io/server/service/worker/batcher"
 )
 
 const (
@@ -1080,7 +1083,7 @@ func (s *workflowHandlerSuite) TestGetHistory() {
 	wh := s.getWorkflowHandler(s.newConfig())
 
 	scope := metrics.NoopScope(metrics.Frontend)
-	history, token, err := wh.getHistory(scope, namespaceID, we, firstEventID, nextEventID, 0, []byte{}, nil, branchToken)
+	history, token, err := wh.getHistory(scope, namespaceID, we, firstEventID, nextEventID, 0, []byte{}, nil, branchToken, false)
 	s.NoError(err)
 	s.NotNil(history)
 	s.Equal([]byte{}, token)
@@ -1267,6 +1270,263 @@ func (s *workflowHandlerSuite) TestCountWorkflowExecutions() {
 	s.NotNil(err)
 }
 
+func (s *workflowHandlerSuite) TestDeleteWorkflowExecution_Failed_WorkflowIdNotSet() {
+	wh := s.getWorkflowHandler(s.newConfig())
+
+	_, err := wh.DeleteWorkflowExecution(context.Background(), &DeleteWorkflowExecutionRequest{
+		Namespace:         s.testNamespace,
+		WorkflowExecution: &commonpb.WorkflowExecution{},
+	})
+	s.Error(err)
+	s.Equal(errWorkflowIDNotSet, err)
+}
+
+func (s *workflowHandlerSuite) TestDeleteWorkflowExecution() {
+	wh := s.getWorkflowHandler(s.newConfig())
+
+	execution := &commonpb.WorkflowExecution{
+		WorkflowId: testWorkflowID,
+		RunId:      uuid.New(),
+	}
+	s.mockNamespaceCache.EXPECT().GetNamespaceID(s.testNamespace).Return(s.testNamespaceID, nil).AnyTimes()
+	s.mockHistoryClient.EXPECT().DeleteWorkflowExecution(gomock.Any(), &historyservice.DeleteWorkflowExecutionRequest{
+		NamespaceId:       s.testNamespaceID,
+		WorkflowExecution: execution,
+		Identity:          "test-identity",
+	}).Return(&historyservice.DeleteWorkflowExecutionResponse{}, nil)
+
+	resp, err := wh.DeleteWorkflowExecution(context.Background(), &DeleteWorkflowExecutionRequest{
+		Namespace:         s.testNamespace,
+		WorkflowExecution: execution,
+		Identity:          "test-identity",
+	})
+	s.NoError(err)
+	s.NotNil(resp)
+}
+
+func (s *workflowHandlerSuite) TestUpdateWorkflowExecution_Failed_NameNotSet() {
+	wh := s.getWorkflowHandler(s.newConfig())
+
+	_, err := wh.UpdateWorkflowExecution(context.Background(), &UpdateWorkflowExecutionRequest{
+		Namespace: s.testNamespace,
+		WorkflowExecution: &commonpb.WorkflowExecution{
+			WorkflowId: testWorkflowID,
+		},
+	})
+	s.Error(err)
+	s.Equal(errUpdateNameNotSet, err)
+}
+
+func (s *workflowHandlerSuite) TestUpdateWorkflowExecution() {
+	wh := s.getWorkflowHandler(s.newConfig())
+
+	execution := &commonpb.WorkflowExecution{
+		WorkflowId: testWorkflowID,
+	}
+	result := payloads.EncodeString("test-result")
+	s.mockNamespaceCache.EXPECT().GetNamespaceID(s.testNamespace).Return(s.testNamespaceID, nil).AnyTimes()
+	s.mockHistoryClient.EXPECT().UpdateWorkflowExecution(gomock.Any(), &historyservice.UpdateWorkflowExecutionRequest{
+		NamespaceId:       s.testNamespaceID,
+		WorkflowExecution: execution,
+		UpdateId:          "test-update-id",
+		Name:              "test-update",
+		Identity:          "test-identity",
+		WaitForAccepted:   true,
+	}).Return(&historyservice.UpdateWorkflowExecutionResponse{Completed: true, Result: result}, nil)
+
+	resp, err := wh.UpdateWorkflowExecution(context.Background(), &UpdateWorkflowExecutionRequest{
+		Namespace:         s.testNamespace,
+		WorkflowExecution: execution,
+		UpdateID:          "test-update-id",
+		Name:              "test-update",
+		Identity:          "test-identity",
+		WaitStage:         UpdateStageAccepted,
+	})
+	s.NoError(err)
+	s.Equal("test-update-id", resp.UpdateID)
+	s.Equal(UpdateStageCompleted, resp.Stage)
+	s.Equal(result, resp.Result)
+	s.Nil(resp.Failure)
+}
+
+func (s *workflowHandlerSuite) TestGetWorkflowExecutionResult_Failed_ContextTimeoutNotSet() {
+	wh := s.getWorkflowHandler(s.newConfig())
+
+	_, err := wh.GetWorkflowExecutionResult(context.Background(), &GetWorkflowExecutionResultRequest{
+		Namespace: s.testNamespace,
+		WorkflowExecution: &commonpb.WorkflowExecution{
+			WorkflowId: testWorkflowID,
+		},
+	})
+	s.Error(err)
+	s.Equal(common.ErrContextTimeoutNotSet, err)
+}
+
+func (s *workflowHandlerSuite) TestGetWorkflowExecutionResult_FollowRuns() {
+	wh := s.getWorkflowHandler(s.newConfig())
+
+	branchToken := []byte{1}
+	result := payloads.EncodeString("test-result")
+	s.mockNamespaceCache.EXPECT().GetNamespaceID(s.testNamespace).Return(s.testNamespaceID, nil).AnyTimes()
+	s.mockHistoryClient.EXPECT().PollMutableState(gomock.Any(), &historyservice.PollMutableStateRequest{
+		NamespaceId:         s.testNamespaceID,
+		Execution:           &commonpb.WorkflowExecution{WorkflowId: testWorkflowID},
+		ExpectedNextEventId: common.EndEventID,
+	}).Return(&historyservice.PollMutableStateResponse{
+		Execution:          &commonpb.WorkflowExecution{WorkflowId: testWorkflowID, RunId: testRunID},
+		LastFirstEventId:   5,
+		NextEventId:        6,
+		CurrentBranchToken: branchToken,
+		WorkflowStatus:     enumspb.WORKFLOW_EXECUTION_STATUS_CONTINUED_AS_NEW,
+	}, nil)
+	s.mockHistoryClient.EXPECT().PollMutableState(gomock.Any(), &historyservice.PollMutableStateRequest{
+		NamespaceId:         s.testNamespaceID,
+		Execution:           &commonpb.WorkflowExecution{WorkflowId: testWorkflowID, RunId: "test-new-run-id"},
+		ExpectedNextEventId: common.EndEventID,
+	}).Return(&historyservice.PollMutableStateResponse{
+		Execution:          &commonpb.WorkflowExecution{WorkflowId: testWorkflowID, RunId: "test-new-run-id"},
+		LastFirstEventId:   7,
+		NextEventId:        8,
+		CurrentBranchToken: branchToken,
+		WorkflowStatus:     enumspb.WORKFLOW_EXECUTION_STATUS_COMPLETED,
+	}, nil)
+	s.mockHistoryV2Mgr.On("ReadHistoryBranch", mock.MatchedBy(func(request *persistence.ReadHistoryBranchRequest) bool {
+		return request.MinEventID == 5
+	})).Return(&persistence.ReadHistoryBranchResponse{
+		HistoryEvents: []*historypb.HistoryEvent{
+			{
+				EventId:   5,
+				EventType: enumspb.EVENT_TYPE_WORKFLOW_EXECUTION_CONTINUED_AS_NEW,
+				Attributes: &historypb.HistoryEvent_WorkflowExecutionContinuedAsNewEventAttributes{
+					WorkflowExecutionContinuedAsNewEventAttributes: &historypb.WorkflowExecutionContinuedAsNewEventAttributes{
+						NewExecutionRunId: "test-new-run-id",
+					},
+				},
+			},
+		},
+		Size: 1,
+	}, nil).Once()
+	s.mockHistoryV2Mgr.On("ReadHistoryBranch", mock.MatchedBy(func(request *persistence.ReadHistoryBranchRequest) bool {
+		return request.MinEventID == 7
+	})).Return(&persistence.ReadHistoryBranchResponse{
+		HistoryEvents: []*historypb.HistoryEvent{
+			{
+				EventId:   7,
+				EventType: enumspb.EVENT_TYPE_WORKFLOW_EXECUTION_COMPLETED,
+				Attributes: &historypb.HistoryEvent_WorkflowExecutionCompletedEventAttributes{
+					WorkflowExecutionCompletedEventAttributes: &historypb.WorkflowExecutionCompletedEventAttributes{
+						Result: result,
+					},
+				},
+			},
+		},
+		Size: 1,
+	}, nil).Once()
+
+	ctx, cancel := context.WithTimeout(context.Background(), common.CriticalLongPollTimeout)
+	defer cancel()
+	resp, err := wh.GetWorkflowExecutionResult(ctx, &GetWorkflowExecutionResultRequest{
+		Namespace: s.testNamespace,
+		WorkflowExecution: &commonpb.WorkflowExecution{
+			WorkflowId: testWorkflowID,
+		},
+		FollowRuns: true,
+	})
+	s.NoError(err)
+	s.Equal("test-new-run-id", resp.WorkflowExecution.GetRunId())
+	s.Equal(enumspb.WORKFLOW_EXECUTION_STATUS_COMPLETED, resp.Status)
+	s.Equal(result, resp.Result)
+	s.Nil(resp.Failure)
+	s.Empty(resp.NewExecutionRunID)
+}
+
+func (s *workflowHandlerSuite) TestResetWorkflowExecutionWithOptions_Failed_ResetPointAmbiguous() {
+	wh := s.getWorkflowHandler(s.newConfig())
+
+	_, err := wh.ResetWorkflowExecutionWithOptions(context.Background(), &ResetWorkflowExecutionWithOptionsRequest{
+		Namespace: s.testNamespace,
+		WorkflowExecution: &commonpb.WorkflowExecution{
+			WorkflowId: testWorkflowID,
+		},
+		WorkflowTaskFinishEventID:    4,
+		WorkflowTaskScheduledEventID: 2,
+	})
+	s.Error(err)
+	s.Equal(errResetPointAmbiguous, err)
+}
+
+func (s *workflowHandlerSuite) TestResetWorkflowExecutionWithOptions_DryRun() {
+	wh := s.getWorkflowHandler(s.newConfig())
+
+	runID := uuid.New()
+	execution := &commonpb.WorkflowExecution{
+		WorkflowId: testWorkflowID,
+		RunId:      runID,
+	}
+	droppedEvents := []*historypb.HistoryEvent{{EventId: 5}, {EventId: 6}}
+	s.mockNamespaceCache.EXPECT().GetNamespaceID(s.testNamespace).Return(s.testNamespaceID, nil).AnyTimes()
+	s.mockHistoryClient.EXPECT().ResetWorkflowExecution(gomock.Any(), &historyservice.ResetWorkflowExecutionRequest{
+		NamespaceId: s.testNamespaceID,
+		ResetRequest: &workflowservice.ResetWorkflowExecutionRequest{
+			Namespace:         s.testNamespace,
+			WorkflowExecution: execution,
+			Reason:            "test-reason",
+			RequestId:         "test-request-id",
+		},
+		WorkflowTaskScheduledEventId: 2,
+		ReapplyExcludeSignals:        true,
+		DryRun:                       true,
+	}).Return(&historyservice.ResetWorkflowExecutionResponse{
+		Preview: &historyservice.ResetWorkflowExecutionPreview{
+			BaseRunId:                 runID,
+			WorkflowTaskFinishEventId: 4,
+			TerminatedRunId:           runID,
+			DroppedEvents:             droppedEvents,
+		},
+	}, nil)
+
+	resp, err := wh.ResetWorkflowExecutionWithOptions(context.Background(), &ResetWorkflowExecutionWithOptionsRequest{
+		Namespace:                    s.testNamespace,
+		WorkflowExecution:            execution,
+		Reason:                       "test-reason",
+		RequestID:                    "test-request-id",
+		WorkflowTaskScheduledEventID: 2,
+		ReapplyType:                  ResetReapplyTypeExcludeSignals,
+		DryRun:                       true,
+	})
+	s.NoError(err)
+	s.Empty(resp.RunID)
+	s.Equal(&ResetWorkflowExecutionPreview{
+		BaseRunID:                 runID,
+		WorkflowTaskFinishEventID: 4,
+		TerminatedRunID:           runID,
+		DroppedEvents:             droppedEvents,
+	}, resp.Preview)
+}
+
+func (s *workflowHandlerSuite) TestStartBatchOperation_Failed_InvalidRequest() {
+	wh := s.getWorkflowHandler(s.newConfig())
+	s.mockNamespaceCache.EXPECT().GetNamespaceID(s.testNamespace).Return(s.testNamespaceID, nil).AnyTimes()
+
+	request := &batcher.StartBatchOperationRequest{
+		Namespace: s.testNamespace,
+		Query:     "WorkflowId = 'wid'",
+		Reason:    "test-reason",
+		BatchType: batcher.BatchTypeTerminate,
+	}
+	_, err := wh.StartBatchOperation(context.Background(), request)
+	s.Equal(errJobIDNotSet, err)
+
+	request.JobID = "test-job-id"
+	request.Query = ""
+	_, err = wh.StartBatchOperation(context.Background(), request)
+	s.Equal(errVisibilityQueryNotSet, err)
+
+	request.Query = "InvalidKey = 'a'"
+	_, err = wh.StartBatchOperation(context.Background(), request)
+	s.Error(err)
+}
+
 func (s *workflowHandlerSuite) TestConvertIndexedKeyToProto() {
 	wh := s.getWorkflowHandler(s.newConfig())
 	m := map[string]interface{}{
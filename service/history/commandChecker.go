@@ -329,6 +329,28 @@ func (v *commandAttrValidator) validateRecordMarkerAttributes(
 	return nil
 }
 
+func (v *commandAttrValidator) validateRespondWorkflowUpdateAttributes(
+	attributes *commandpb.RespondWorkflowUpdateCommandAttributes,
+) error {
+
+	if attributes == nil {
+		return serviceerror.NewInvalidArgument("RespondWorkflowUpdateCommandAttributes is not set on command.")
+	}
+	if attributes.GetUpdateId() == "" {
+		return serviceerror.NewInvalidArgument("UpdateId is not set on command.")
+	}
+	if len(attributes.GetUpdateId()) > v.maxIDLengthLimit {
+		return serviceerror.NewInvalidArgument("UpdateId exceeds length limit.")
+	}
+	if !attributes.GetCompleted() && attributes.GetResult() != nil {
+		return serviceerror.NewInvalidArgument("Result is set on command of an update which is not completed.")
+	}
+	if attributes.GetResult() != nil && attributes.GetFailure() != nil {
+		return serviceerror.NewInvalidArgument("Result and Failure are both set on command.")
+	}
+	return nil
+}
+
 func (v *commandAttrValidator) validateCompleteWorkflowExecutionAttributes(
 	attributes *commandpb.CompleteWorkflowExecutionCommandAttributes,
 ) error {
@@ -35,6 +35,7 @@ import (
 	commandpb "go.temporal.io/api/command/v1"
 	commonpb "go.temporal.io/api/common/v1"
 	enumspb "go.temporal.io/api/enums/v1"
+	failurepb "go.temporal.io/api/failure/v1"
 	"go.temporal.io/api/serviceerror"
 	taskqueuepb "go.temporal.io/api/taskqueue/v1"
 
@@ -168,6 +169,29 @@ func (s *commandAttrValidatorSuite) TestValidateUpsertWorkflowSearchAttributes()
 	s.Nil(err)
 }
 
+func (s *commandAttrValidatorSuite) TestValidateRespondWorkflowUpdateAttributes() {
+	attributes := &commandpb.RespondWorkflowUpdateCommandAttributes{}
+	err := s.validator.validateRespondWorkflowUpdateAttributes(attributes)
+	s.EqualError(err, "UpdateId is not set on command.")
+
+	attributes.UpdateId = "update-id"
+	attributes.Result = payloads.EncodeString("result")
+	err = s.validator.validateRespondWorkflowUpdateAttributes(attributes)
+	s.EqualError(err, "Result is set on command of an update which is not completed.")
+
+	attributes.Completed = true
+	err = s.validator.validateRespondWorkflowUpdateAttributes(attributes)
+	s.NoError(err)
+
+	attributes.Failure = &failurepb.Failure{Message: "update failed"}
+	err = s.validator.validateRespondWorkflowUpdateAttributes(attributes)
+	s.EqualError(err, "Result and Failure are both set on command.")
+
+	attributes.Result = nil
+	err = s.validator.validateRespondWorkflowUpdateAttributes(attributes)
+	s.NoError(err)
+}
+
 func (s *commandAttrValidatorSuite) TestValidateCrossNamespaceCall_LocalToLocal() {
 	namespaceEntry := cache.NewLocalNamespaceCacheEntryForTest(
 		&persistenceblobs.NamespaceInfo{Name: s.testNamespaceID},
@@ -163,10 +163,11 @@ func (h *Handler) Start() {
 			}
 		case task.SchedulerTypeWRR:
 			queueTaskProcessorOptions.wRRSchedulerOptions = &task.WeightedRoundRobinTaskSchedulerOptions{
-				Weights:     h.config.TaskSchedulerRoundRobinWeights,
-				QueueSize:   h.config.TaskSchedulerQueueSize(),
-				WorkerCount: h.config.TaskSchedulerWorkerCount(),
-				RetryPolicy: common.CreatePersistanceRetryPolicy(),
+				Weights:       h.config.TaskSchedulerRoundRobinWeights,
+				QueueSize:     h.config.TaskSchedulerQueueSize(),
+				WorkerCount:   h.config.TaskSchedulerWorkerCount(),
+				RetryPolicy:   common.CreatePersistanceRetryPolicy(),
+				FairnessKeyFn: getTaskFairnessKey,
 			}
 		default:
 			h.GetLogger().Fatal("Unknown task scheduler type", tag.Value(schedulerType))
@@ -1725,6 +1726,177 @@ func (h *Handler) RefreshWorkflowTasks(ctx context.Context, request *historyserv
 	return &historyservice.RefreshWorkflowTasksResponse{}, nil
 }
 
+// DeleteWorkflowExecution terminates the workflow execution if it is still running and deletes it
+// together with its history and visibility records asynchronously.
+func (h *Handler) DeleteWorkflowExecution(ctx context.Context, request *historyservice.DeleteWorkflowExecutionRequest) (_ *historyservice.DeleteWorkflowExecutionResponse, retError error) {
+	defer log.CapturePanic(h.GetLogger(), &retError)
+	h.startWG.Wait()
+
+	scope := metrics.HistoryDeleteWorkflowExecutionScope
+	h.GetMetricsClient().IncCounter(scope, metrics.ServiceRequests)
+	sw := h.GetMetricsClient().StartTimer(scope, metrics.ServiceLatency)
+	defer sw.Stop()
+
+	if h.isShuttingDown() {
+		return nil, errShuttingDown
+	}
+
+	namespaceID := request.GetNamespaceId()
+	if namespaceID == "" {
+		return nil, h.error(errNamespaceNotSet, scope, namespaceID, "")
+	}
+
+	if ok := h.rateLimiter.Allow(); !ok {
+		return nil, h.error(errHistoryHostThrottle, scope, namespaceID, "")
+	}
+
+	workflowID := request.GetWorkflowExecution().GetWorkflowId()
+	engine, err1 := h.controller.GetEngine(namespaceID, workflowID)
+	if err1 != nil {
+		return nil, h.error(err1, scope, namespaceID, workflowID)
+	}
+
+	err2 := engine.DeleteWorkflowExecution(ctx, request)
+	if err2 != nil {
+		return nil, h.error(err2, scope, namespaceID, workflowID)
+	}
+
+	return &historyservice.DeleteWorkflowExecutionResponse{}, nil
+}
+
+// UpdateWorkflowExecution - delivers an update to the workflow execution and waits for its outcome
+func (h *Handler) UpdateWorkflowExecution(ctx context.Context, request *historyservice.UpdateWorkflowExecutionRequest) (_ *historyservice.UpdateWorkflowExecutionResponse, retError error) {
+	defer log.CapturePanic(h.GetLogger(), &retError)
+	h.startWG.Wait()
+
+	scope := metrics.HistoryUpdateWorkflowExecutionScope
+	h.GetMetricsClient().IncCounter(scope, metrics.ServiceRequests)
+	sw := h.GetMetricsClient().StartTimer(scope, metrics.ServiceLatency)
+	defer sw.Stop()
+
+	if h.isShuttingDown() {
+		return nil, errShuttingDown
+	}
+
+	namespaceID := request.GetNamespaceId()
+	if namespaceID == "" {
+		return nil, h.error(errNamespaceNotSet, scope, namespaceID, "")
+	}
+
+	if ok := h.rateLimiter.Allow(); !ok {
+		return nil, h.error(errHistoryHostThrottle, scope, namespaceID, "")
+	}
+
+	workflowID := request.GetWorkflowExecution().GetWorkflowId()
+	engine, err1 := h.controller.GetEngine(namespaceID, workflowID)
+	if err1 != nil {
+		return nil, h.error(err1, scope, namespaceID, workflowID)
+	}
+
+	resp, err2 := engine.UpdateWorkflowExecution(ctx, request)
+	if err2 != nil {
+		return nil, h.error(err2, scope, namespaceID, workflowID)
+	}
+
+	return resp, nil
+}
+
+// PauseWorkflowExecution - pauses the workflow execution, its workflow and activity tasks are not dispatched until it is unpaused
+func (h *Handler) PauseWorkflowExecution(ctx context.Context, request *historyservice.PauseWorkflowExecutionRequest) (_ *historyservice.PauseWorkflowExecutionResponse, retError error) {
+	defer log.CapturePanic(h.GetLogger(), &retError)
+	h.startWG.Wait()
+
+	scope := metrics.HistoryPauseWorkflowExecutionScope
+	h.GetMetricsClient().IncCounter(scope, metrics.ServiceRequests)
+	sw := h.GetMetricsClient().StartTimer(scope, metrics.ServiceLatency)
+	defer sw.Stop()
+
+	if h.isShuttingDown() {
+		return nil, errShuttingDown
+	}
+
+	namespaceID := request.GetNamespaceId()
+	if namespaceID == "" {
+		return nil, h.error(errNamespaceNotSet, scope, namespaceID, "")
+	}
+
+	if ok := h.rateLimiter.Allow(); !ok {
+		return nil, h.error(errHistoryHostThrottle, scope, namespaceID, "")
+	}
+
+	workflowID := request.GetWorkflowExecution().GetWorkflowId()
+	engine, err1 := h.controller.GetEngine(namespaceID, workflowID)
+	if err1 != nil {
+		return nil, h.error(err1, scope, namespaceID, workflowID)
+	}
+
+	err2 := engine.PauseWorkflowExecution(ctx, request)
+	if err2 != nil {
+		return nil, h.error(err2, scope, namespaceID, workflowID)
+	}
+
+	return &historyservice.PauseWorkflowExecutionResponse{}, nil
+}
+
+// UnpauseWorkflowExecution - resumes the dispatch of the workflow and activity tasks of a paused workflow execution
+func (h *Handler) UnpauseWorkflowExecution(ctx context.Context, request *historyservice.UnpauseWorkflowExecutionRequest) (_ *historyservice.UnpauseWorkflowExecutionResponse, retError error) {
+	defer log.CapturePanic(h.GetLogger(), &retError)
+	h.startWG.Wait()
+
+	scope := metrics.HistoryUnpauseWorkflowExecutionScope
+	h.GetMetricsClient().IncCounter(scope, metrics.ServiceRequests)
+	sw := h.GetMetricsClient().StartTimer(scope, metrics.ServiceLatency)
+	defer sw.Stop()
+
+	if h.isShuttingDown() {
+		return nil, errShuttingDown
+	}
+
+	namespaceID := request.GetNamespaceId()
+	if namespaceID == "" {
+		return nil, h.error(errNamespaceNotSet, scope, namespaceID, "")
+	}
+
+	if ok := h.rateLimiter.Allow(); !ok {
+		return nil, h.error(errHistoryHostThrottle, scope, namespaceID, "")
+	}
+
+	workflowID := request.GetWorkflowExecution().GetWorkflowId()
+	engine, err1 := h.controller.GetEngine(namespaceID, workflowID)
+	if err1 != nil {
+		return nil, h.error(err1, scope, namespaceID, workflowID)
+	}
+
+	err2 := engine.UnpauseWorkflowExecution(ctx, request)
+	if err2 != nil {
+		return nil, h.error(err2, scope, namespaceID, workflowID)
+	}
+
+	return &historyservice.UnpauseWorkflowExecutionResponse{}, nil
+}
+
+// AcquireShard - acquires the shard right away if it is owned by this host, called by the previous owner
+// once it handed the shard off
+func (h *Handler) AcquireShard(_ context.Context, request *historyservice.AcquireShardRequest) (_ *historyservice.AcquireShardResponse, retError error) {
+	defer log.CapturePanic(h.GetLogger(), &retError)
+	h.startWG.Wait()
+
+	scope := metrics.HistoryAcquireShardScope
+	h.GetMetricsClient().IncCounter(scope, metrics.ServiceRequests)
+	sw := h.GetMetricsClient().StartTimer(scope, metrics.ServiceLatency)
+	defer sw.Stop()
+
+	if h.isShuttingDown() {
+		return nil, errShuttingDown
+	}
+
+	if _, err := h.controller.getEngineForShard(request.GetShardId()); err != nil {
+		return nil, h.error(err, scope, "", "")
+	}
+
+	return &historyservice.AcquireShardResponse{}, nil
+}
+
 // convertError is a helper method to convert ShardOwnershipLostError from persistence layer returned by various
 // HistoryEngine API calls to ShardOwnershipLost error return by HistoryService for client to be redirected to the
 // correct shard.
@@ -1734,9 +1906,9 @@ func (h *Handler) convertError(err error) error {
 		shardID := err.(*persistence.ShardOwnershipLostError).ShardID
 		info, err := h.GetHistoryServiceResolver().Lookup(convert.Int32ToString(shardID))
 		if err == nil {
-			return serviceerrors.NewShardOwnershipLost(h.GetHostInfo().GetAddress(), info.GetAddress())
+			return serviceerrors.NewShardOwnershipLost(info.GetAddress(), h.GetHostInfo().GetAddress())
 		}
-		return serviceerrors.NewShardOwnershipLost(h.GetHostInfo().GetAddress(), "<unknown>")
+		return serviceerrors.NewShardOwnershipLost("<unknown>", h.GetHostInfo().GetAddress())
 	case *persistence.WorkflowExecutionAlreadyStartedError:
 		err := err.(*persistence.WorkflowExecutionAlreadyStartedError)
 		return serviceerror.NewInternal(err.Msg)
@@ -340,6 +340,22 @@ func (b *historyBuilder) AddUpsertWorkflowSearchAttributesEvent(
 	return b.addEventToHistory(event)
 }
 
+func (b *historyBuilder) AddWorkflowExecutionUpdateAcceptedEvent(
+	workflowTaskCompletedEventID int64,
+	request *workflowpb.WorkflowUpdateRequest) *historypb.HistoryEvent {
+	event := b.newWorkflowExecutionUpdateAcceptedEvent(workflowTaskCompletedEventID, request)
+
+	return b.addEventToHistory(event)
+}
+
+func (b *historyBuilder) AddWorkflowExecutionUpdateCompletedEvent(
+	workflowTaskCompletedEventID int64,
+	attributes *commandpb.RespondWorkflowUpdateCommandAttributes) *historypb.HistoryEvent {
+	event := b.newWorkflowExecutionUpdateCompletedEvent(workflowTaskCompletedEventID, attributes)
+
+	return b.addEventToHistory(event)
+}
+
 func (b *historyBuilder) AddSignalExternalWorkflowExecutionFailedEvent(workflowTaskCompletedEventID, initiatedEventID int64,
 	namespace, workflowID, runID, control string, cause enumspb.SignalExternalWorkflowExecutionFailedCause) *historypb.HistoryEvent {
 	event := b.newSignalExternalWorkflowExecutionFailedEvent(workflowTaskCompletedEventID, initiatedEventID,
@@ -829,6 +845,35 @@ func (b *historyBuilder) newUpsertWorkflowSearchAttributesEvent(workflowTaskComp
 	return event
 }
 
+func (b *historyBuilder) newWorkflowExecutionUpdateAcceptedEvent(workflowTaskCompletedEventID int64,
+	request *workflowpb.WorkflowUpdateRequest) *historypb.HistoryEvent {
+	event := b.msBuilder.CreateNewHistoryEvent(enumspb.EVENT_TYPE_WORKFLOW_EXECUTION_UPDATE_ACCEPTED)
+	attributes := &historypb.WorkflowExecutionUpdateAcceptedEventAttributes{
+		UpdateId:                     request.GetUpdateId(),
+		Name:                         request.GetName(),
+		Input:                        request.GetInput(),
+		Identity:                     request.GetIdentity(),
+		WorkflowTaskCompletedEventId: workflowTaskCompletedEventID,
+	}
+	event.Attributes = &historypb.HistoryEvent_WorkflowExecutionUpdateAcceptedEventAttributes{WorkflowExecutionUpdateAcceptedEventAttributes: attributes}
+
+	return event
+}
+
+func (b *historyBuilder) newWorkflowExecutionUpdateCompletedEvent(workflowTaskCompletedEventID int64,
+	request *commandpb.RespondWorkflowUpdateCommandAttributes) *historypb.HistoryEvent {
+	event := b.msBuilder.CreateNewHistoryEvent(enumspb.EVENT_TYPE_WORKFLOW_EXECUTION_UPDATE_COMPLETED)
+	attributes := &historypb.WorkflowExecutionUpdateCompletedEventAttributes{
+		UpdateId:                     request.GetUpdateId(),
+		Result:                       request.GetResult(),
+		Failure:                      request.GetFailure(),
+		WorkflowTaskCompletedEventId: workflowTaskCompletedEventID,
+	}
+	event.Attributes = &historypb.HistoryEvent_WorkflowExecutionUpdateCompletedEventAttributes{WorkflowExecutionUpdateCompletedEventAttributes: attributes}
+
+	return event
+}
+
 func (b *historyBuilder) newSignalExternalWorkflowExecutionFailedEvent(workflowTaskCompletedEventID, initiatedEventID int64,
 	namespace, workflowID, runID, control string, cause enumspb.SignalExternalWorkflowExecutionFailedCause) *historypb.HistoryEvent {
 	event := b.msBuilder.CreateNewHistoryEvent(enumspb.EVENT_TYPE_SIGNAL_EXTERNAL_WORKFLOW_EXECUTION_FAILED)
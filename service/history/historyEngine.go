@@ -57,6 +57,7 @@ import (
 	"go.temporal.io/server/common/cache"
 	"go.temporal.io/server/common/clock"
 	"go.temporal.io/server/common/cluster"
+	"go.temporal.io/server/common/convert"
 	"go.temporal.io/server/common/definition"
 	"go.temporal.io/server/common/failure"
 	"go.temporal.io/server/common/log"
@@ -78,6 +79,7 @@ const (
 	timerCancellationMsgTimerIDUnknown        = "TIMER_ID_UNKNOWN"
 	defaultQueryFirstWorkflowTaskWaitTime     = time.Second
 	queryFirstWorkflowTaskCheckInterval       = 200 * time.Millisecond
+	deleteWorkflowExecutionTerminateReason    = "workflow execution deleted"
 )
 
 type (
@@ -118,6 +120,10 @@ type (
 		PurgeDLQMessages(ctx context.Context, messagesRequest *historyservice.PurgeDLQMessagesRequest) error
 		MergeDLQMessages(ctx context.Context, messagesRequest *historyservice.MergeDLQMessagesRequest) (*historyservice.MergeDLQMessagesResponse, error)
 		RefreshWorkflowTasks(ctx context.Context, namespaceUUID string, execution commonpb.WorkflowExecution) error
+		DeleteWorkflowExecution(ctx context.Context, deleteRequest *historyservice.DeleteWorkflowExecutionRequest) error
+		UpdateWorkflowExecution(ctx context.Context, updateRequest *historyservice.UpdateWorkflowExecutionRequest) (*historyservice.UpdateWorkflowExecutionResponse, error)
+		PauseWorkflowExecution(ctx context.Context, pauseRequest *historyservice.PauseWorkflowExecutionRequest) error
+		UnpauseWorkflowExecution(ctx context.Context, unpauseRequest *historyservice.UnpauseWorkflowExecutionRequest) error
 
 		NotifyNewHistoryEvent(event *historyEventNotification)
 		NotifyNewTransferTasks(tasks []persistence.Task)
@@ -177,6 +183,8 @@ var (
 	ErrActivityTaskNotFound = serviceerror.NewNotFound("invalid activityID or activity already timed out or invoking workflow is completed")
 	// ErrWorkflowCompleted is the error to indicate workflow execution already completed
 	ErrWorkflowCompleted = serviceerror.NewNotFound("workflow execution already completed")
+	// ErrWorkflowPaused is the error to indicate workflow execution is paused and its tasks are not dispatched
+	ErrWorkflowPaused = serviceerror.NewNotFound("workflow execution is paused")
 	// ErrWorkflowExecutionNotFound is the error to indicate workflow execution does not exist
 	ErrWorkflowExecutionNotFound = serviceerror.NewNotFound("workflow execution not found")
 	// ErrWorkflowParent is the error to parent execution is given and mismatch
@@ -193,6 +201,11 @@ var (
 	ErrQueryEnteredInvalidState = serviceerror.NewInvalidArgument("query entered invalid state, this should be impossible")
 	// ErrConsistentQueryBufferExceeded is error indicating that too many consistent queries have been buffered and until buffered queries are finished new consistent queries cannot be buffered
 	ErrConsistentQueryBufferExceeded = serviceerror.NewInternal("consistent query buffer is full, cannot accept new consistent queries")
+	// ErrUpdateLimitExceeded is the error indicating limit reached for maximum number of updates in flight
+	ErrUpdateLimitExceeded = serviceerror.NewResourceExhausted("exceeded workflow execution limit for updates in flight")
+	// ErrUpdateTimedOut is the error indicating the update did not reach the requested stage before the caller's deadline,
+	// the update stays in flight and can be waited for again with the same update ID
+	ErrUpdateTimedOut = serviceerror.NewDeadlineExceeded("timed out waiting for the update to reach the requested stage")
 	// ErrEmptyHistoryRawEventBatch indicate that one single batch of history raw events is of size 0
 	ErrEmptyHistoryRawEventBatch = serviceerror.NewInvalidArgument("encounter empty history batch")
 	// ErrSizeExceedsLimit is error indicating workflow execution has exceeded system defined limit
@@ -1063,6 +1076,13 @@ func (e *historyEngineImpl) getMutableState(
 		WorkflowState:                         workflowState,
 		WorkflowStatus:                        workflowStatus,
 		IsStickyTaskQueueEnabled:              mutableState.IsStickyTaskQueueEnabled(),
+		HistorySizeBytes:                      context.getHistorySize(),
+		SuggestContinueAsNew: historyLimitWarnExceeded(
+			e.config,
+			mutableState.GetNamespaceEntry().GetInfo().Name,
+			context.getHistorySize(),
+			mutableState.GetNextEventID()-common.FirstEventID,
+		),
 	}
 	versionHistories := mutableState.GetVersionHistories()
 	if versionHistories != nil {
@@ -1210,6 +1230,15 @@ func (e *historyEngineImpl) DescribeWorkflowExecution(
 			SearchAttributes: &commonpb.SearchAttributes{IndexedFields: executionInfo.SearchAttributes},
 			Status:           executionInfo.ExecutionState.Status,
 		},
+		HistorySizeBytes: context.getHistorySize(),
+		SuggestContinueAsNew: historyLimitWarnExceeded(
+			e.config,
+			mutableState.GetNamespaceEntry().GetInfo().Name,
+			context.getHistorySize(),
+			mutableState.GetNextEventID()-common.FirstEventID,
+		),
+		Paused:      executionInfo.Paused,
+		PauseReason: executionInfo.PauseReason,
 	}
 
 	// TODO: we need to consider adding execution time to mutable state
@@ -1369,6 +1398,11 @@ func (e *historyEngineImpl) RecordActivityTaskStarted(
 				return serviceerrors.NewTaskAlreadyStarted("Activity")
 			}
 
+			if paused, _ := mutableState.IsWorkflowExecutionPaused(); paused {
+				// The task is dropped by matching and regenerated when the workflow execution is unpaused.
+				return ErrWorkflowPaused
+			}
+
 			if _, err := mutableState.AddActivityTaskStartedEvent(
 				ai, scheduleID, requestID, request.PollRequest.GetIdentity(),
 			); err != nil {
@@ -2286,8 +2320,15 @@ func (e *historyEngineImpl) ResetWorkflowExecution(
 	if err != nil {
 		return nil, err
 	}
-	if request.GetWorkflowTaskFinishEventId() <= common.FirstEventID ||
-		request.GetWorkflowTaskFinishEventId() >= baseMutableState.GetNextEventID() {
+	workflowTaskFinishEventID := request.GetWorkflowTaskFinishEventId()
+	if scheduledEventID := resetRequest.GetWorkflowTaskScheduledEventId(); scheduledEventID != 0 {
+		workflowTaskFinishEventID, err = e.getWorkflowTaskFinishEventID(baseMutableState, scheduledEventID)
+		if err != nil {
+			return nil, err
+		}
+	}
+	if workflowTaskFinishEventID <= common.FirstEventID ||
+		workflowTaskFinishEventID >= baseMutableState.GetNextEventID() {
 		return nil, serviceerror.NewInvalidArgument("Workflow task finish ID must be > 1 && <= workflow next event ID.")
 	}
 	// also load the current run of the workflow, it can be different from the base runID
@@ -2327,21 +2368,19 @@ func (e *historyEngineImpl) ResetWorkflowExecution(
 		if err != nil {
 			return nil, err
 		}
-	}
 
-	// dedup by requestID
-	if currentMutableState.GetExecutionInfo().GetExecutionState().CreateRequestId == request.GetRequestId() {
-		e.logger.Info("Duplicated reset request",
-			tag.WorkflowID(workflowID),
-			tag.WorkflowRunID(currentRunID),
-			tag.WorkflowNamespaceID(namespaceID))
-		return &historyservice.ResetWorkflowExecutionResponse{
-			RunId: currentRunID,
-		}, nil
+		// the events received after the reset point are reapplied by following the continue-as-new chain
+		// from the base run, which has to end at the current run
+		baseFirstRunID := baseMutableState.GetExecutionInfo().FirstExecutionRunId
+		currentFirstRunID := currentMutableState.GetExecutionInfo().FirstExecutionRunId
+		if baseFirstRunID != "" && currentFirstRunID != "" && baseFirstRunID != currentFirstRunID {
+			return nil, serviceerror.NewInvalidArgument(fmt.Sprintf(
+				"Run %v is not part of the continue-as-new chain of the current run %v.", baseRunID, currentRunID,
+			))
+		}
 	}
 
-	resetRunID := uuid.New()
-	baseRebuildLastEventID := request.GetWorkflowTaskFinishEventId() - 1
+	baseRebuildLastEventID := workflowTaskFinishEventID - 1
 	baseVersionHistories := baseMutableState.GetVersionHistories()
 	baseCurrentVersionHistory, err := baseVersionHistories.GetCurrentVersionHistory()
 	if err != nil {
@@ -2354,6 +2393,54 @@ func (e *historyEngineImpl) ResetWorkflowExecution(
 	baseCurrentBranchToken := baseCurrentVersionHistory.GetBranchToken()
 	baseNextEventID := baseMutableState.GetNextEventID()
 
+	reapplyType := resetReapplyTypeAll
+	if resetRequest.GetReapplyExcludeAll() {
+		reapplyType = resetReapplyTypeNone
+	} else if resetRequest.GetReapplyExcludeSignals() {
+		reapplyType = resetReapplyTypeExcludeSignals
+	}
+	currentWorkflow := newNDCWorkflow(
+		ctx,
+		e.shard.GetNamespaceCache(),
+		e.shard.GetClusterMetadata(),
+		currentContext,
+		currentMutableState,
+		currentReleaseFn,
+	)
+
+	if resetRequest.GetDryRun() {
+		preview, err := e.workflowResetter.previewResetWorkflow(
+			ctx,
+			namespaceID,
+			workflowID,
+			baseRunID,
+			baseCurrentBranchToken,
+			baseRebuildLastEventID,
+			baseRebuildLastEventVersion,
+			baseNextEventID,
+			currentWorkflow,
+			reapplyType,
+		)
+		if err != nil {
+			return nil, err
+		}
+		return &historyservice.ResetWorkflowExecutionResponse{
+			Preview: preview,
+		}, nil
+	}
+
+	// dedup by requestID
+	if currentMutableState.GetExecutionInfo().GetExecutionState().CreateRequestId == request.GetRequestId() {
+		e.logger.Info("Duplicated reset request",
+			tag.WorkflowID(workflowID),
+			tag.WorkflowRunID(currentRunID),
+			tag.WorkflowNamespaceID(namespaceID))
+		return &historyservice.ResetWorkflowExecutionResponse{
+			RunId: currentRunID,
+		}, nil
+	}
+
+	resetRunID := uuid.New()
 	if err := e.workflowResetter.resetWorkflow(
 		ctx,
 		namespaceID,
@@ -2365,16 +2452,10 @@ func (e *historyEngineImpl) ResetWorkflowExecution(
 		baseNextEventID,
 		resetRunID,
 		request.GetRequestId(),
-		newNDCWorkflow(
-			ctx,
-			e.shard.GetNamespaceCache(),
-			e.shard.GetClusterMetadata(),
-			currentContext,
-			currentMutableState,
-			currentReleaseFn,
-		),
+		currentWorkflow,
 		request.GetReason(),
 		nil,
+		reapplyType,
 	); err != nil {
 		return nil, err
 	}
@@ -2383,6 +2464,50 @@ func (e *historyEngineImpl) ResetWorkflowExecution(
 	}, nil
 }
 
+// getWorkflowTaskFinishEventID returns the ID of the event after the started event of the workflow task
+// scheduled with the given event ID, which is the reset point of the workflow task
+func (e *historyEngineImpl) getWorkflowTaskFinishEventID(
+	mutableState mutableState,
+	scheduledEventID int64,
+) (int64, error) {
+
+	nextEventID := mutableState.GetNextEventID()
+	if scheduledEventID <= common.FirstEventID || scheduledEventID >= nextEventID {
+		return 0, serviceerror.NewInvalidArgument("Workflow task scheduled ID must be > 1 && < workflow next event ID.")
+	}
+	branchToken, err := mutableState.GetCurrentBranchToken()
+	if err != nil {
+		return 0, err
+	}
+
+	var token []byte
+	for {
+		var events []*historypb.HistoryEvent
+		events, _, token, _, err = PaginateHistory(
+			e.historyV2Mgr,
+			false,
+			branchToken,
+			scheduledEventID,
+			nextEventID,
+			token,
+			nDCDefaultPageSize,
+			convert.Int32Ptr(e.shard.GetShardID()),
+		)
+		if err != nil {
+			return 0, err
+		}
+		for _, event := range events {
+			if event.GetEventType() == enumspb.EVENT_TYPE_WORKFLOW_TASK_STARTED &&
+				event.GetWorkflowTaskStartedEventAttributes().GetScheduledEventId() == scheduledEventID {
+				return event.GetEventId() + 1, nil
+			}
+		}
+		if len(token) == 0 {
+			return 0, serviceerror.NewInvalidArgument(fmt.Sprintf("Workflow task %v was not started.", scheduledEventID))
+		}
+	}
+}
+
 func (e *historyEngineImpl) updateWorkflow(
 	ctx context.Context,
 	namespaceID string,
@@ -2983,6 +3108,7 @@ func (e *historyEngineImpl) ReapplyEvents(
 					),
 					eventsReapplicationResetWorkflowReason,
 					toReapplyEvents,
+					resetReapplyTypeAll,
 				); err != nil {
 					return nil, err
 				}
@@ -3118,6 +3244,259 @@ func (e *historyEngineImpl) RefreshWorkflowTasks(
 	return nil
 }
 
+// DeleteWorkflowExecution terminates the workflow execution if it is still running and schedules a delete
+// history event timer task which removes the mutable state, current execution record, history and visibility
+// records of the execution in the background
+func (e *historyEngineImpl) DeleteWorkflowExecution(
+	ctx context.Context,
+	deleteRequest *historyservice.DeleteWorkflowExecutionRequest,
+) error {
+
+	namespaceEntry, err := e.getActiveNamespaceEntry(deleteRequest.GetNamespaceId())
+	if err != nil {
+		return err
+	}
+	namespaceID := namespaceEntry.GetInfo().Id
+
+	execution := commonpb.WorkflowExecution{
+		WorkflowId: deleteRequest.GetWorkflowExecution().GetWorkflowId(),
+		RunId:      deleteRequest.GetWorkflowExecution().GetRunId(),
+	}
+
+	return e.updateWorkflow(
+		ctx,
+		namespaceID,
+		execution,
+		func(context workflowExecutionContext, mutableState mutableState) (*updateWorkflowAction, error) {
+			if mutableState.IsWorkflowExecutionRunning() {
+				if err := terminateWorkflow(
+					mutableState,
+					mutableState.GetNextEventID(),
+					deleteWorkflowExecutionTerminateReason,
+					nil,
+					deleteRequest.GetIdentity(),
+				); err != nil {
+					return nil, err
+				}
+			}
+
+			// use the last write version so the task passes the version check of the timer task executor,
+			// the version of a closed workflow is not updated by the transaction
+			lastWriteVersion, err := mutableState.GetLastWriteVersion()
+			if err != nil {
+				return nil, err
+			}
+			// the task waits for the close execution task to be acked, firing once the transfer ack level
+			// is persisted again mostly avoids it being delayed by the timer executor
+			mutableState.AddTimerTasks(&persistence.DeleteHistoryEventTask{
+				// TaskID is set by shard
+				VisibilityTimestamp: e.shard.GetTimeSource().Now().Add(e.config.TransferProcessorCompleteTransferInterval()),
+				Version:             lastWriteVersion,
+			})
+			return updateWorkflowWithoutWorkflowTask, nil
+		})
+}
+
+// UpdateWorkflowExecution admits the update to the update registry of the workflow, which hands it to the
+// workflow with the next workflow task, and blocks until the workflow accepted or completed the update, depending
+// on the stage requested by the caller
+func (e *historyEngineImpl) UpdateWorkflowExecution(
+	ctx context.Context,
+	updateRequest *historyservice.UpdateWorkflowExecutionRequest,
+) (*historyservice.UpdateWorkflowExecutionResponse, error) {
+
+	namespaceEntry, err := e.getActiveNamespaceEntry(updateRequest.GetNamespaceId())
+	if err != nil {
+		return nil, err
+	}
+	namespaceID := namespaceEntry.GetInfo().Id
+	namespace := namespaceEntry.GetInfo().Name
+
+	execution := commonpb.WorkflowExecution{
+		WorkflowId: updateRequest.GetWorkflowExecution().GetWorkflowId(),
+		RunId:      updateRequest.GetWorkflowExecution().GetRunId(),
+	}
+	updateID := updateRequest.GetUpdateId()
+	request := &workflowpb.WorkflowUpdateRequest{
+		UpdateId: updateID,
+		Name:     updateRequest.GetName(),
+		Input:    updateRequest.GetInput(),
+		Identity: updateRequest.GetIdentity(),
+	}
+
+	stage := updateStageCompleted
+	if updateRequest.GetWaitForAccepted() {
+		stage = updateStageAccepted
+	}
+
+	var upd update
+	var admitted bool
+	var registry updateRegistry
+	var recorded *persistenceblobs.UpdateInfo
+	err = e.updateWorkflow(
+		ctx,
+		namespaceID,
+		execution,
+		func(context workflowExecutionContext, mutableState mutableState) (*updateWorkflowAction, error) {
+			// the workflow accepted or rejected the update before, it is deduplicated by ID for the lifetime
+			// of the run
+			if info, ok := mutableState.GetUpdateInfo(updateID); ok {
+				if info.GetCompleted() || stage == updateStageAccepted {
+					recorded = info
+					return &updateWorkflowAction{noop: true}, nil
+				}
+				if !mutableState.IsWorkflowExecutionRunning() {
+					return nil, ErrWorkflowCompleted
+				}
+				// wait for the accepted update to complete
+				registry = mutableState.GetUpdateRegistry()
+				upd = registry.watchUpdate(updateID)
+				return &updateWorkflowAction{noop: true}, nil
+			}
+			if !mutableState.IsWorkflowExecutionRunning() {
+				return nil, ErrWorkflowCompleted
+			}
+
+			registry = mutableState.GetUpdateRegistry()
+			if _, err := registry.getUpdate(updateID); err == nil {
+				// join the update in flight
+				upd = registry.admitUpdate(request)
+				return &updateWorkflowAction{noop: true}, nil
+			}
+			if len(registry.getPendingIDs()) >= e.config.MaxInFlightUpdateCount(namespace) {
+				return nil, ErrUpdateLimitExceeded
+			}
+			upd = registry.admitUpdate(request)
+			admitted = true
+
+			// a workflow task which is scheduled hands out the update once it is started, a workflow task
+			// which is started is followed by a new one once it completes
+			if mutableState.HasPendingWorkflowTask() {
+				return &updateWorkflowAction{noop: true}, nil
+			}
+			// Do not create workflow task when the workflow is cron and the cron has not been started yet
+			if mutableState.GetExecutionInfo().CronSchedule != "" && !mutableState.HasProcessedOrPendingWorkflowTask() {
+				return &updateWorkflowAction{noop: true}, nil
+			}
+			return &updateWorkflowAction{
+				createWorkflowTask: true,
+			}, nil
+		})
+	if err != nil {
+		if admitted {
+			registry.removeUpdate(updateID)
+		}
+		return nil, err
+	}
+
+	if recorded != nil {
+		return &historyservice.UpdateWorkflowExecutionResponse{
+			Completed: recorded.GetCompleted(),
+			Result:    recorded.GetResult(),
+			Failure:   recorded.GetFailure(),
+		}, nil
+	}
+	select {
+	case <-upd.getStageCh(stage):
+		outcome := upd.getOutcome()
+		if outcome.err != nil {
+			return nil, outcome.err
+		}
+		return &historyservice.UpdateWorkflowExecutionResponse{
+			Completed: outcome.stage == updateStageCompleted,
+			Result:    outcome.result,
+			Failure:   outcome.failure,
+		}, nil
+	case <-ctx.Done():
+		return nil, ErrUpdateTimedOut
+	}
+}
+
+// PauseWorkflowExecution marks the workflow execution as paused, its workflow tasks, activity tasks and activity
+// timeouts are dropped by the task queues and matching until the workflow execution is unpaused
+func (e *historyEngineImpl) PauseWorkflowExecution(
+	ctx context.Context,
+	pauseRequest *historyservice.PauseWorkflowExecutionRequest,
+) error {
+
+	namespaceEntry, err := e.getActiveNamespaceEntry(pauseRequest.GetNamespaceId())
+	if err != nil {
+		return err
+	}
+	namespaceID := namespaceEntry.GetInfo().Id
+
+	execution := commonpb.WorkflowExecution{
+		WorkflowId: pauseRequest.GetWorkflowExecution().GetWorkflowId(),
+		RunId:      pauseRequest.GetWorkflowExecution().GetRunId(),
+	}
+
+	return e.updateWorkflow(
+		ctx,
+		namespaceID,
+		execution,
+		func(context workflowExecutionContext, mutableState mutableState) (*updateWorkflowAction, error) {
+			if !mutableState.IsWorkflowExecutionRunning() {
+				return nil, ErrWorkflowCompleted
+			}
+			if paused, _ := mutableState.IsWorkflowExecutionPaused(); paused {
+				return &updateWorkflowAction{noop: true}, nil
+			}
+
+			if err := mutableState.UpdateWorkflowPauseState(true, pauseRequest.GetReason()); err != nil {
+				return nil, err
+			}
+			return updateWorkflowWithoutWorkflowTask, nil
+		})
+}
+
+// UnpauseWorkflowExecution clears the paused state of the workflow execution and regenerates the workflow and
+// activity tasks which were dropped while the workflow execution was paused
+func (e *historyEngineImpl) UnpauseWorkflowExecution(
+	ctx context.Context,
+	unpauseRequest *historyservice.UnpauseWorkflowExecutionRequest,
+) error {
+
+	namespaceEntry, err := e.getActiveNamespaceEntry(unpauseRequest.GetNamespaceId())
+	if err != nil {
+		return err
+	}
+	namespaceID := namespaceEntry.GetInfo().Id
+
+	execution := commonpb.WorkflowExecution{
+		WorkflowId: unpauseRequest.GetWorkflowExecution().GetWorkflowId(),
+		RunId:      unpauseRequest.GetWorkflowExecution().GetRunId(),
+	}
+
+	return e.updateWorkflow(
+		ctx,
+		namespaceID,
+		execution,
+		func(context workflowExecutionContext, mutableState mutableState) (*updateWorkflowAction, error) {
+			if !mutableState.IsWorkflowExecutionRunning() {
+				return nil, ErrWorkflowCompleted
+			}
+			if paused, _ := mutableState.IsWorkflowExecutionPaused(); !paused {
+				return &updateWorkflowAction{noop: true}, nil
+			}
+
+			if err := mutableState.UpdateWorkflowPauseState(false, ""); err != nil {
+				return nil, err
+			}
+
+			// tasks dispatched while the workflow execution was paused were dropped, regenerate them
+			mutableStateTaskRefresher := newMutableStateTaskRefresher(
+				e.shard.GetConfig(),
+				e.shard.GetNamespaceCache(),
+				e.shard.GetEventsCache(),
+				e.shard.GetLogger(),
+			)
+			if err := mutableStateTaskRefresher.refreshTasks(e.shard.GetTimeSource().Now(), mutableState); err != nil {
+				return nil, err
+			}
+			return updateWorkflowWithoutWorkflowTask, nil
+		})
+}
+
 func (e *historyEngineImpl) loadWorkflowOnce(
 	ctx context.Context,
 	namespaceID string,
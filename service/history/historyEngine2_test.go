@@ -62,6 +62,7 @@ import (
 	"go.temporal.io/server/common/mocks"
 	"go.temporal.io/server/common/payloads"
 	p "go.temporal.io/server/common/persistence"
+	"go.temporal.io/server/common/service/dynamicconfig"
 )
 
 type (
@@ -309,6 +310,53 @@ func (s *engine2Suite) TestRecordWorkflowTaskStartedSuccessStickyEnabled() {
 	s.Equal(&expectedResponse, response)
 }
 
+func (s *engine2Suite) TestRecordWorkflowTaskStartedSuggestContinueAsNew() {
+	historySizeLimitWarn := s.config.HistorySizeLimitWarn
+	defer func() { s.config.HistorySizeLimitWarn = historySizeLimitWarn }()
+	s.config.HistorySizeLimitWarn = dynamicconfig.GetIntPropertyFilteredByNamespace(512)
+
+	namespaceID := testNamespaceID
+	we := commonpb.WorkflowExecution{
+		WorkflowId: "wId",
+		RunId:      testRunID,
+	}
+	tl := "testTaskQueue"
+	identity := "testIdentity"
+
+	msBuilder := newMutableStateBuilderWithEventV2(s.historyEngine.shard, s.mockEventsCache,
+		loggerimpl.NewDevelopmentForTest(s.Suite), we.GetRunId())
+	addWorkflowExecutionStartedEvent(msBuilder, we, "wType", tl, payloads.EncodeString("input"), 100*time.Second, 50*time.Second, 200*time.Second, identity)
+	addWorkflowTaskScheduledEvent(msBuilder)
+
+	ms := createMutableState(msBuilder)
+	ms.ExecutionStats.HistorySize = 1024
+
+	gwmsResponse := &p.GetWorkflowExecutionResponse{State: ms}
+
+	s.mockExecutionMgr.On("GetWorkflowExecution", mock.Anything).Return(gwmsResponse, nil).Once()
+	s.mockHistoryV2Mgr.On("AppendHistoryNodes", mock.Anything).Return(&p.AppendHistoryNodesResponse{Size: 0}, nil).Once()
+	s.mockExecutionMgr.On("UpdateWorkflowExecution", mock.Anything).Return(&p.UpdateWorkflowExecutionResponse{
+		MutableStateUpdateSessionStats: &p.MutableStateUpdateSessionStats{},
+	}, nil).Once()
+
+	response, err := s.historyEngine.RecordWorkflowTaskStarted(context.Background(), &historyservice.RecordWorkflowTaskStartedRequest{
+		NamespaceId:       namespaceID,
+		WorkflowExecution: &we,
+		ScheduleId:        2,
+		TaskId:            100,
+		RequestId:         "reqId",
+		PollRequest: &workflowservice.PollWorkflowTaskQueueRequest{
+			TaskQueue: &taskqueuepb.TaskQueue{
+				Name: tl,
+			},
+			Identity: identity,
+		},
+	})
+	s.NoError(err)
+	s.Equal(int64(1024), response.HistorySizeBytes)
+	s.True(response.SuggestContinueAsNew)
+}
+
 func (s *engine2Suite) TestRecordWorkflowTaskStartedIfNoExecution() {
 	namespaceID := testNamespaceID
 	workflowExecution := &commonpb.WorkflowExecution{
@@ -86,6 +86,7 @@ type (
 		queueTaskInfo
 		GetQueueType() queueType
 		GetShard() ShardContext
+		GetAttempt() int
 	}
 
 	queueTaskExecutor interface {
@@ -415,6 +415,20 @@ func (mr *MockqueueTaskMockRecorder) GetShard() *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetShard", reflect.TypeOf((*MockqueueTask)(nil).GetShard))
 }
 
+// GetAttempt mocks base method
+func (m *MockqueueTask) GetAttempt() int {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetAttempt")
+	ret0, _ := ret[0].(int)
+	return ret0
+}
+
+// GetAttempt indicates an expected call of GetAttempt
+func (mr *MockqueueTaskMockRecorder) GetAttempt() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAttempt", reflect.TypeOf((*MockqueueTask)(nil).GetAttempt))
+}
+
 // MockqueueTaskExecutor is a mock of queueTaskExecutor interface
 type MockqueueTaskExecutor struct {
 	ctrl     *gomock.Controller
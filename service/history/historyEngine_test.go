@@ -4754,6 +4754,239 @@ func (s *engineSuite) TestSignalWorkflowExecution_Failed() {
 	s.EqualError(err, "workflow execution already completed")
 }
 
+func (s *engineSuite) TestDeleteWorkflowExecution_Running() {
+	we := commonpb.WorkflowExecution{
+		WorkflowId: "wId",
+		RunId:      testRunID,
+	}
+	msBuilder := newMutableStateBuilderWithEventV2(s.mockHistoryEngine.shard, s.eventsCache,
+		loggerimpl.NewDevelopmentForTest(s.Suite), we.GetRunId())
+	addWorkflowExecutionStartedEvent(msBuilder, we, "wType", "testTaskQueue", payloads.EncodeString("input"), 100*time.Second, 50*time.Second, 200*time.Second, "identity")
+	addWorkflowTaskScheduledEvent(msBuilder)
+	ms := createMutableState(msBuilder)
+	ms.ExecutionInfo.NamespaceId = testNamespaceID
+	gwmsResponse := &persistence.GetWorkflowExecutionResponse{State: ms}
+
+	now := s.mockHistoryEngine.shard.GetTimeSource().Now()
+	s.mockExecutionMgr.On("GetWorkflowExecution", mock.Anything).Return(gwmsResponse, nil).Once()
+	s.mockHistoryV2Mgr.On("AppendHistoryNodes", mock.Anything).Return(&persistence.AppendHistoryNodesResponse{Size: 0}, nil).Once()
+	s.mockExecutionMgr.On("UpdateWorkflowExecution", mock.MatchedBy(func(input *persistence.UpdateWorkflowExecutionRequest) bool {
+		mutation := input.UpdateWorkflowMutation
+		return mutation.ExecutionInfo.ExecutionState.Status == enumspb.WORKFLOW_EXECUTION_STATUS_TERMINATED &&
+			s.hasTransferTask(mutation.TransferTasks, enumsspb.TASK_TYPE_TRANSFER_CLOSE_EXECUTION) &&
+			s.hasDeleteHistoryEventTask(mutation.TimerTasks, now)
+	})).Return(&persistence.UpdateWorkflowExecutionResponse{MutableStateUpdateSessionStats: &persistence.MutableStateUpdateSessionStats{}}, nil).Once()
+
+	err := s.mockHistoryEngine.DeleteWorkflowExecution(context.Background(), &historyservice.DeleteWorkflowExecutionRequest{
+		NamespaceId:       testNamespaceID,
+		WorkflowExecution: &we,
+		Identity:          "identity",
+	})
+	s.NoError(err)
+}
+
+func (s *engineSuite) TestDeleteWorkflowExecution_Closed() {
+	we := commonpb.WorkflowExecution{
+		WorkflowId: "wId",
+		RunId:      testRunID,
+	}
+	msBuilder := newMutableStateBuilderWithEventV2(s.mockHistoryEngine.shard, s.eventsCache,
+		loggerimpl.NewDevelopmentForTest(s.Suite), we.GetRunId())
+	addWorkflowExecutionStartedEvent(msBuilder, we, "wType", "testTaskQueue", payloads.EncodeString("input"), 100*time.Second, 50*time.Second, 200*time.Second, "identity")
+	di := addWorkflowTaskScheduledEvent(msBuilder)
+	startedEvent := addWorkflowTaskStartedEvent(msBuilder, di.ScheduleID, "testTaskQueue", "identity")
+	completedEvent := addWorkflowTaskCompletedEvent(msBuilder, di.ScheduleID, startedEvent.GetEventId(), "identity")
+	addCompleteWorkflowEvent(msBuilder, completedEvent.GetEventId(), nil)
+	ms := createMutableState(msBuilder)
+	ms.ExecutionInfo.NamespaceId = testNamespaceID
+	gwmsResponse := &persistence.GetWorkflowExecutionResponse{State: ms}
+
+	now := s.mockHistoryEngine.shard.GetTimeSource().Now()
+	s.mockExecutionMgr.On("GetWorkflowExecution", mock.Anything).Return(gwmsResponse, nil).Once()
+	s.mockExecutionMgr.On("UpdateWorkflowExecution", mock.MatchedBy(func(input *persistence.UpdateWorkflowExecutionRequest) bool {
+		mutation := input.UpdateWorkflowMutation
+		return mutation.ExecutionInfo.ExecutionState.Status == enumspb.WORKFLOW_EXECUTION_STATUS_COMPLETED &&
+			len(mutation.TransferTasks) == 0 &&
+			s.hasDeleteHistoryEventTask(mutation.TimerTasks, now)
+	})).Return(&persistence.UpdateWorkflowExecutionResponse{MutableStateUpdateSessionStats: &persistence.MutableStateUpdateSessionStats{}}, nil).Once()
+
+	err := s.mockHistoryEngine.DeleteWorkflowExecution(context.Background(), &historyservice.DeleteWorkflowExecutionRequest{
+		NamespaceId:       testNamespaceID,
+		WorkflowExecution: &we,
+		Identity:          "identity",
+	})
+	s.NoError(err)
+}
+
+func (s *engineSuite) TestPauseWorkflowExecution() {
+	we := commonpb.WorkflowExecution{
+		WorkflowId: "wId",
+		RunId:      testRunID,
+	}
+	msBuilder := newMutableStateBuilderWithEventV2(s.mockHistoryEngine.shard, s.eventsCache,
+		loggerimpl.NewDevelopmentForTest(s.Suite), we.GetRunId())
+	addWorkflowExecutionStartedEvent(msBuilder, we, "wType", "testTaskQueue", payloads.EncodeString("input"), 100*time.Second, 50*time.Second, 200*time.Second, "identity")
+	addWorkflowTaskScheduledEvent(msBuilder)
+	ms := createMutableState(msBuilder)
+	ms.ExecutionInfo.NamespaceId = testNamespaceID
+	gwmsResponse := &persistence.GetWorkflowExecutionResponse{State: ms}
+
+	s.mockExecutionMgr.On("GetWorkflowExecution", mock.Anything).Return(gwmsResponse, nil).Once()
+	s.mockExecutionMgr.On("UpdateWorkflowExecution", mock.MatchedBy(func(input *persistence.UpdateWorkflowExecutionRequest) bool {
+		executionInfo := input.UpdateWorkflowMutation.ExecutionInfo
+		return executionInfo.Paused && executionInfo.PauseReason == "reason" && len(input.UpdateWorkflowMutation.ReplicationTasks) == 0
+	})).Return(&persistence.UpdateWorkflowExecutionResponse{MutableStateUpdateSessionStats: &persistence.MutableStateUpdateSessionStats{}}, nil).Once()
+
+	err := s.mockHistoryEngine.PauseWorkflowExecution(context.Background(), &historyservice.PauseWorkflowExecutionRequest{
+		NamespaceId:       testNamespaceID,
+		WorkflowExecution: &we,
+		Identity:          "identity",
+		Reason:            "reason",
+	})
+	s.NoError(err)
+}
+
+func (s *engineSuite) TestPauseWorkflowExecution_AlreadyPaused() {
+	we := commonpb.WorkflowExecution{
+		WorkflowId: "wId",
+		RunId:      testRunID,
+	}
+	msBuilder := newMutableStateBuilderWithEventV2(s.mockHistoryEngine.shard, s.eventsCache,
+		loggerimpl.NewDevelopmentForTest(s.Suite), we.GetRunId())
+	addWorkflowExecutionStartedEvent(msBuilder, we, "wType", "testTaskQueue", payloads.EncodeString("input"), 100*time.Second, 50*time.Second, 200*time.Second, "identity")
+	addWorkflowTaskScheduledEvent(msBuilder)
+	s.NoError(msBuilder.UpdateWorkflowPauseState(true, "reason"))
+	ms := createMutableState(msBuilder)
+	ms.ExecutionInfo.NamespaceId = testNamespaceID
+	gwmsResponse := &persistence.GetWorkflowExecutionResponse{State: ms}
+
+	s.mockExecutionMgr.On("GetWorkflowExecution", mock.Anything).Return(gwmsResponse, nil).Once()
+
+	err := s.mockHistoryEngine.PauseWorkflowExecution(context.Background(), &historyservice.PauseWorkflowExecutionRequest{
+		NamespaceId:       testNamespaceID,
+		WorkflowExecution: &we,
+		Identity:          "identity",
+		Reason:            "another reason",
+	})
+	s.NoError(err)
+}
+
+func (s *engineSuite) TestUnpauseWorkflowExecution() {
+	we := commonpb.WorkflowExecution{
+		WorkflowId: "wId",
+		RunId:      testRunID,
+	}
+	msBuilder := newMutableStateBuilderWithEventV2(s.mockHistoryEngine.shard, s.eventsCache,
+		loggerimpl.NewDevelopmentForTest(s.Suite), we.GetRunId())
+	addWorkflowExecutionStartedEvent(msBuilder, we, "wType", "testTaskQueue", payloads.EncodeString("input"), 100*time.Second, 50*time.Second, 200*time.Second, "identity")
+	addWorkflowTaskScheduledEvent(msBuilder)
+	s.NoError(msBuilder.UpdateWorkflowPauseState(true, "reason"))
+	ms := createMutableState(msBuilder)
+	ms.ExecutionInfo.NamespaceId = testNamespaceID
+	gwmsResponse := &persistence.GetWorkflowExecutionResponse{State: ms}
+
+	s.mockExecutionMgr.On("GetWorkflowExecution", mock.Anything).Return(gwmsResponse, nil).Once()
+	s.mockExecutionMgr.On("UpdateWorkflowExecution", mock.MatchedBy(func(input *persistence.UpdateWorkflowExecutionRequest) bool {
+		executionInfo := input.UpdateWorkflowMutation.ExecutionInfo
+		if executionInfo.Paused || executionInfo.PauseReason != "" {
+			return false
+		}
+		// the workflow task dropped while the workflow execution was paused is regenerated
+		for _, task := range input.UpdateWorkflowMutation.TransferTasks {
+			if task.GetType() == enumsspb.TASK_TYPE_TRANSFER_WORKFLOW_TASK {
+				return true
+			}
+		}
+		return false
+	})).Return(&persistence.UpdateWorkflowExecutionResponse{MutableStateUpdateSessionStats: &persistence.MutableStateUpdateSessionStats{}}, nil).Once()
+
+	err := s.mockHistoryEngine.UnpauseWorkflowExecution(context.Background(), &historyservice.UnpauseWorkflowExecutionRequest{
+		NamespaceId:       testNamespaceID,
+		WorkflowExecution: &we,
+		Identity:          "identity",
+	})
+	s.NoError(err)
+}
+
+func (s *engineSuite) TestUpdateWorkflowExecution_Deduplicated() {
+	we := commonpb.WorkflowExecution{
+		WorkflowId: "wId",
+		RunId:      testRunID,
+	}
+	msBuilder := newMutableStateBuilderWithEventV2(s.mockHistoryEngine.shard, s.eventsCache,
+		loggerimpl.NewDevelopmentForTest(s.Suite), we.GetRunId())
+	addWorkflowExecutionStartedEvent(msBuilder, we, "wType", "testTaskQueue", payloads.EncodeString("input"), 100*time.Second, 50*time.Second, 200*time.Second, "identity")
+	addWorkflowTaskScheduledEvent(msBuilder)
+	ms := createMutableState(msBuilder)
+	ms.ExecutionInfo.NamespaceId = testNamespaceID
+	result := payloads.EncodeString("result")
+	ms.ExecutionInfo.UpdateInfos = []*persistenceblobs.UpdateInfo{{UpdateId: "update-id", Accepted: true, Completed: true, Result: result}}
+	gwmsResponse := &persistence.GetWorkflowExecutionResponse{State: ms}
+
+	// the update is answered from mutable state, nothing is written
+	s.mockExecutionMgr.On("GetWorkflowExecution", mock.Anything).Return(gwmsResponse, nil).Once()
+
+	resp, err := s.mockHistoryEngine.UpdateWorkflowExecution(context.Background(), &historyservice.UpdateWorkflowExecutionRequest{
+		NamespaceId:       testNamespaceID,
+		WorkflowExecution: &we,
+		UpdateId:          "update-id",
+		Name:              "update-name",
+		Identity:          "identity",
+	})
+	s.NoError(err)
+	s.True(resp.GetCompleted())
+	s.Equal(result, resp.GetResult())
+}
+
+func (s *engineSuite) TestUpdateWorkflowExecution_TimedOut() {
+	we := commonpb.WorkflowExecution{
+		WorkflowId: "wId",
+		RunId:      testRunID,
+	}
+	msBuilder := newMutableStateBuilderWithEventV2(s.mockHistoryEngine.shard, s.eventsCache,
+		loggerimpl.NewDevelopmentForTest(s.Suite), we.GetRunId())
+	addWorkflowExecutionStartedEvent(msBuilder, we, "wType", "testTaskQueue", payloads.EncodeString("input"), 100*time.Second, 50*time.Second, 200*time.Second, "identity")
+	addWorkflowTaskScheduledEvent(msBuilder)
+	ms := createMutableState(msBuilder)
+	ms.ExecutionInfo.NamespaceId = testNamespaceID
+	gwmsResponse := &persistence.GetWorkflowExecutionResponse{State: ms}
+
+	// the scheduled workflow task hands out the update once it is started, nothing is written
+	s.mockExecutionMgr.On("GetWorkflowExecution", mock.Anything).Return(gwmsResponse, nil).Once()
+
+	ctx, cancel := context.WithTimeout(context.Background(), 100*time.Millisecond)
+	defer cancel()
+	resp, err := s.mockHistoryEngine.UpdateWorkflowExecution(ctx, &historyservice.UpdateWorkflowExecutionRequest{
+		NamespaceId:       testNamespaceID,
+		WorkflowExecution: &we,
+		UpdateId:          "update-id",
+		Name:              "update-name",
+		Identity:          "identity",
+	})
+	s.Nil(resp)
+	s.Equal(ErrUpdateTimedOut, err)
+}
+
+func (s *engineSuite) hasTransferTask(tasks []persistence.Task, taskType enumsspb.TaskType) bool {
+	for _, task := range tasks {
+		if task.GetType() == taskType {
+			return true
+		}
+	}
+	return false
+}
+
+// hasDeleteHistoryEventTask checks the delete task fires after the transfer ack level is persisted again,
+// so it doesn't race the close execution task
+func (s *engineSuite) hasDeleteHistoryEventTask(tasks []persistence.Task, now time.Time) bool {
+	for _, task := range tasks {
+		if task.GetType() == enumsspb.TASK_TYPE_DELETE_HISTORY_EVENT {
+			return !task.GetVisibilityTimestamp().Before(now.Add(s.config.TransferProcessorCompleteTransferInterval()))
+		}
+	}
+	return false
+}
+
 func (s *engineSuite) TestRemoveSignalMutableState() {
 	removeRequest := &historyservice.RemoveSignalMutableStateRequest{}
 	err := s.mockHistoryEngine.RemoveSignalMutableState(context.Background(), removeRequest)
@@ -4907,6 +5140,7 @@ func (s *engineSuite) TestReapplyEvents_ResetWorkflow() {
 	s.mockEventsReapplier.EXPECT().reapplyEvents(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Times(0)
 	s.mockWorkflowResetter.EXPECT().resetWorkflow(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(),
 		gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(),
+		resetReapplyTypeAll,
 	).Return(nil).Times(1)
 	err = s.mockHistoryEngine.ReapplyEvents(
 		context.Background(),
@@ -4918,6 +5152,115 @@ func (s *engineSuite) TestReapplyEvents_ResetWorkflow() {
 	s.NoError(err)
 }
 
+func (s *engineSuite) TestResetWorkflowExecution_DryRun() {
+	workflowExecution := commonpb.WorkflowExecution{
+		WorkflowId: "test-reset-workflow-dry-run",
+		RunId:      testRunID,
+	}
+	taskqueue := "testTaskQueue"
+	identity := "testIdentity"
+
+	msBuilder := newMutableStateBuilderWithEventV2(
+		s.mockHistoryEngine.shard,
+		s.eventsCache,
+		loggerimpl.NewDevelopmentForTest(s.Suite),
+		workflowExecution.GetRunId(),
+	)
+	addWorkflowExecutionStartedEvent(msBuilder, workflowExecution, "wType", taskqueue, payloads.EncodeString("input"), 100*time.Second, 50*time.Second, 200*time.Second, identity)
+	di := addWorkflowTaskScheduledEvent(msBuilder)
+	startedEvent := addWorkflowTaskStartedEvent(msBuilder, di.ScheduleID, taskqueue, identity)
+	addWorkflowTaskCompletedEvent(msBuilder, di.ScheduleID, startedEvent.GetEventId(), identity)
+
+	ms := createMutableState(msBuilder)
+	token, err := msBuilder.GetCurrentBranchToken()
+	s.NoError(err)
+	item := persistence.NewVersionHistoryItem(msBuilder.GetNextEventID()-1, 1)
+	versionHistory := persistence.NewVersionHistory(token, []*persistence.VersionHistoryItem{item})
+	ms.VersionHistories = persistence.NewVersionHistories(versionHistory)
+	gwmsResponse := &persistence.GetWorkflowExecutionResponse{State: ms}
+	gceResponse := &persistence.GetCurrentExecutionResponse{RunID: testRunID}
+	s.mockExecutionMgr.On("GetCurrentExecution", mock.Anything).Return(gceResponse, nil).Once()
+	s.mockExecutionMgr.On("GetWorkflowExecution", mock.Anything).Return(gwmsResponse, nil).Once()
+	s.mockHistoryV2Mgr.On("ReadHistoryBranch", mock.Anything).Return(&persistence.ReadHistoryBranchResponse{
+		HistoryEvents: []*historypb.HistoryEvent{
+			{EventId: di.ScheduleID, EventType: enumspb.EVENT_TYPE_WORKFLOW_TASK_SCHEDULED},
+			startedEvent,
+		},
+	}, nil).Once()
+
+	preview := &historyservice.ResetWorkflowExecutionPreview{
+		BaseRunId:                 testRunID,
+		WorkflowTaskFinishEventId: startedEvent.GetEventId() + 1,
+	}
+	s.mockWorkflowResetter.EXPECT().previewResetWorkflow(
+		gomock.Any(),
+		testNamespaceID,
+		workflowExecution.GetWorkflowId(),
+		testRunID,
+		token,
+		startedEvent.GetEventId(),
+		int64(1),
+		msBuilder.GetNextEventID(),
+		gomock.Any(),
+		resetReapplyTypeExcludeSignals,
+	).Return(preview, nil).Times(1)
+
+	resp, err := s.mockHistoryEngine.ResetWorkflowExecution(context.Background(), &historyservice.ResetWorkflowExecutionRequest{
+		NamespaceId: testNamespaceID,
+		ResetRequest: &workflowservice.ResetWorkflowExecutionRequest{
+			Namespace:         testNamespace,
+			WorkflowExecution: &workflowExecution,
+			Reason:            "some random reset reason",
+			RequestId:         uuid.New(),
+		},
+		WorkflowTaskScheduledEventId: di.ScheduleID,
+		ReapplyExcludeSignals:        true,
+		DryRun:                       true,
+	})
+	s.NoError(err)
+	s.Empty(resp.GetRunId())
+	s.Equal(preview, resp.GetPreview())
+}
+
+func (s *engineSuite) TestResetWorkflowExecution_WorkflowTaskNotStarted() {
+	workflowExecution := commonpb.WorkflowExecution{
+		WorkflowId: "test-reset-workflow-not-started",
+		RunId:      testRunID,
+	}
+	taskqueue := "testTaskQueue"
+	identity := "testIdentity"
+
+	msBuilder := newMutableStateBuilderWithEventV2(
+		s.mockHistoryEngine.shard,
+		s.eventsCache,
+		loggerimpl.NewDevelopmentForTest(s.Suite),
+		workflowExecution.GetRunId(),
+	)
+	addWorkflowExecutionStartedEvent(msBuilder, workflowExecution, "wType", taskqueue, payloads.EncodeString("input"), 100*time.Second, 50*time.Second, 200*time.Second, identity)
+	di := addWorkflowTaskScheduledEvent(msBuilder)
+
+	ms := createMutableState(msBuilder)
+	gwmsResponse := &persistence.GetWorkflowExecutionResponse{State: ms}
+	s.mockExecutionMgr.On("GetWorkflowExecution", mock.Anything).Return(gwmsResponse, nil).Once()
+	s.mockHistoryV2Mgr.On("ReadHistoryBranch", mock.Anything).Return(&persistence.ReadHistoryBranchResponse{
+		HistoryEvents: []*historypb.HistoryEvent{
+			{EventId: di.ScheduleID, EventType: enumspb.EVENT_TYPE_WORKFLOW_TASK_SCHEDULED},
+		},
+	}, nil).Once()
+
+	_, err := s.mockHistoryEngine.ResetWorkflowExecution(context.Background(), &historyservice.ResetWorkflowExecutionRequest{
+		NamespaceId: testNamespaceID,
+		ResetRequest: &workflowservice.ResetWorkflowExecutionRequest{
+			Namespace:         testNamespace,
+			WorkflowExecution: &workflowExecution,
+			RequestId:         uuid.New(),
+		},
+		WorkflowTaskScheduledEventId: di.ScheduleID,
+		DryRun:                       true,
+	})
+	s.IsType(&serviceerror.InvalidArgument{}, err)
+}
+
 func (s *engineSuite) getBuilder(testNamespaceID string, we commonpb.WorkflowExecution) mutableState {
 	context, release, err := s.mockHistoryEngine.historyCache.getOrCreateWorkflowExecutionForBackground(testNamespaceID, we)
 	if err != nil {
@@ -5323,6 +5666,9 @@ func copyWorkflowExecutionInfo(sourceInfo *persistence.WorkflowExecutionInfo) *p
 		RetryMaximumAttempts:                   sourceInfo.RetryMaximumAttempts,
 		RetryNonRetryableErrorTypes:            sourceInfo.RetryNonRetryableErrorTypes,
 		EventBranchToken:                       sourceInfo.EventBranchToken,
+		Paused:                                 sourceInfo.Paused,
+		PauseReason:                            sourceInfo.PauseReason,
+		UpdateInfos:                            sourceInfo.UpdateInfos,
 	}
 }
 
@@ -158,6 +158,7 @@ type (
 		taskGenerator       mutableStateTaskGenerator
 		workflowTaskManager mutableStateWorkflowTaskManager
 		queryRegistry       queryRegistry
+		updateRegistry      updateRegistry
 
 		shard           ShardContext
 		clusterMetadata cluster.Metadata
@@ -213,7 +214,8 @@ func newMutableStateBuilder(
 		namespaceEntry:        namespaceEntry,
 		appliedEvents:         make(map[string]struct{}),
 
-		queryRegistry: newQueryRegistry(),
+		queryRegistry:  newQueryRegistry(),
+		updateRegistry: newUpdateRegistry(),
 
 		shard:           shard,
 		clusterMetadata: shard.GetClusterMetadata(),
@@ -890,7 +892,9 @@ func (e *mutableStateBuilder) shouldBufferEvent(
 		enumspb.EVENT_TYPE_MARKER_RECORDED,
 		enumspb.EVENT_TYPE_START_CHILD_WORKFLOW_EXECUTION_INITIATED,
 		enumspb.EVENT_TYPE_SIGNAL_EXTERNAL_WORKFLOW_EXECUTION_INITIATED,
-		enumspb.EVENT_TYPE_UPSERT_WORKFLOW_SEARCH_ATTRIBUTES:
+		enumspb.EVENT_TYPE_UPSERT_WORKFLOW_SEARCH_ATTRIBUTES,
+		enumspb.EVENT_TYPE_WORKFLOW_EXECUTION_UPDATE_ACCEPTED,
+		enumspb.EVENT_TYPE_WORKFLOW_EXECUTION_UPDATE_COMPLETED:
 		// do not buffer event if event is directly generated from a corresponding command
 
 		// sanity check there is no workflow task on the fly
@@ -916,6 +920,20 @@ func (e *mutableStateBuilder) GetQueryRegistry() queryRegistry {
 	return e.queryRegistry
 }
 
+func (e *mutableStateBuilder) GetUpdateRegistry() updateRegistry {
+	return e.updateRegistry
+}
+
+// GetUpdateInfo returns the persisted state of an update accepted or rejected by the workflow
+func (e *mutableStateBuilder) GetUpdateInfo(updateID string) (*persistenceblobs.UpdateInfo, bool) {
+	for _, info := range e.executionInfo.UpdateInfos {
+		if info.GetUpdateId() == updateID {
+			return info, true
+		}
+	}
+	return nil, false
+}
+
 func (e *mutableStateBuilder) GetActivityScheduledEvent(
 	scheduleEventID int64,
 ) (*historypb.HistoryEvent, error) {
@@ -1549,6 +1567,11 @@ func (e *mutableStateBuilder) IsWorkflowExecutionRunning() bool {
 	}
 }
 
+// IsWorkflowExecutionPaused returns whether the workflow is paused and the reason it was paused for
+func (e *mutableStateBuilder) IsWorkflowExecutionPaused() (bool, string) {
+	return e.executionInfo.Paused, e.executionInfo.PauseReason
+}
+
 func (e *mutableStateBuilder) IsCancelRequested() (bool, string) {
 	if e.executionInfo.CancelRequested {
 		return e.executionInfo.CancelRequested, e.executionInfo.GetExecutionState().CreateRequestId
@@ -1972,10 +1995,7 @@ func (e *mutableStateBuilder) addBinaryCheckSumIfNotExists(
 		exeInfo.SearchAttributes = make(map[string]*commonpb.Payload)
 	}
 	exeInfo.SearchAttributes[definition.BinaryChecksums] = bytes
-	if e.shard.GetConfig().AdvancedVisibilityWritingMode() != common.AdvancedVisibilityWritingModeOff {
-		return e.taskGenerator.generateWorkflowSearchAttrTasks(timestamp.TimeValue(event.GetEventTime()))
-	}
-	return nil
+	return e.taskGenerator.generateWorkflowSearchAttrTasks(timestamp.TimeValue(event.GetEventTime()))
 }
 
 // TODO: we will release the restriction when reset API allow those pending
@@ -3158,6 +3178,72 @@ func (e *mutableStateBuilder) AddRecordMarkerEvent(
 	return e.hBuilder.AddMarkerRecordedEvent(workflowTaskCompletedEventID, attributes), nil
 }
 
+func (e *mutableStateBuilder) AddWorkflowExecutionUpdateAcceptedEvent(
+	workflowTaskCompletedEventID int64,
+	request *workflowpb.WorkflowUpdateRequest,
+) (*historypb.HistoryEvent, error) {
+
+	opTag := tag.WorkflowActionWorkflowUpdateAccepted
+	if err := e.checkMutability(opTag); err != nil {
+		return nil, err
+	}
+
+	event := e.hBuilder.AddWorkflowExecutionUpdateAcceptedEvent(workflowTaskCompletedEventID, request)
+	if err := e.ReplicateWorkflowExecutionUpdateAcceptedEvent(event); err != nil {
+		return nil, err
+	}
+	return event, nil
+}
+
+func (e *mutableStateBuilder) ReplicateWorkflowExecutionUpdateAcceptedEvent(
+	event *historypb.HistoryEvent,
+) error {
+
+	updateID := event.GetWorkflowExecutionUpdateAcceptedEventAttributes().GetUpdateId()
+	info, ok := e.GetUpdateInfo(updateID)
+	if !ok {
+		info = &persistenceblobs.UpdateInfo{UpdateId: updateID}
+		e.executionInfo.UpdateInfos = append(e.executionInfo.UpdateInfos, info)
+	}
+	info.Accepted = true
+	return nil
+}
+
+func (e *mutableStateBuilder) AddWorkflowExecutionUpdateCompletedEvent(
+	workflowTaskCompletedEventID int64,
+	attributes *commandpb.RespondWorkflowUpdateCommandAttributes,
+) (*historypb.HistoryEvent, error) {
+
+	opTag := tag.WorkflowActionWorkflowUpdateCompleted
+	if err := e.checkMutability(opTag); err != nil {
+		return nil, err
+	}
+
+	event := e.hBuilder.AddWorkflowExecutionUpdateCompletedEvent(workflowTaskCompletedEventID, attributes)
+	if err := e.ReplicateWorkflowExecutionUpdateCompletedEvent(event); err != nil {
+		return nil, err
+	}
+	return event, nil
+}
+
+// ReplicateWorkflowExecutionUpdateCompletedEvent records the outcome of the update, an update completed without
+// being accepted before was rejected by the workflow
+func (e *mutableStateBuilder) ReplicateWorkflowExecutionUpdateCompletedEvent(
+	event *historypb.HistoryEvent,
+) error {
+
+	attributes := event.GetWorkflowExecutionUpdateCompletedEventAttributes()
+	info, ok := e.GetUpdateInfo(attributes.GetUpdateId())
+	if !ok {
+		info = &persistenceblobs.UpdateInfo{UpdateId: attributes.GetUpdateId()}
+		e.executionInfo.UpdateInfos = append(e.executionInfo.UpdateInfos, info)
+	}
+	info.Completed = true
+	info.Result = attributes.GetResult()
+	info.Failure = attributes.GetFailure()
+	return nil
+}
+
 func (e *mutableStateBuilder) AddWorkflowExecutionTerminatedEvent(
 	firstEventID int64,
 	reason string,
@@ -3806,6 +3892,32 @@ func (e *mutableStateBuilder) UpdateWorkflowStateStatus(
 	return e.executionInfo.UpdateWorkflowStateStatus(state, status)
 }
 
+// UpdateWorkflowPauseState pauses or unpauses the workflow, the pause state is kept in mutable state only and
+// no history event is written for it. It is also upserted to visibility as a search attribute so paused
+// workflows can be listed
+func (e *mutableStateBuilder) UpdateWorkflowPauseState(
+	paused bool,
+	reason string,
+) error {
+
+	exeInfo := e.executionInfo
+	exeInfo.Paused = paused
+	exeInfo.PauseReason = ""
+	if paused {
+		exeInfo.PauseReason = reason
+	}
+
+	bytes, err := payload.Encode(paused)
+	if err != nil {
+		return err
+	}
+	if exeInfo.SearchAttributes == nil {
+		exeInfo.SearchAttributes = make(map[string]*commonpb.Payload)
+	}
+	exeInfo.SearchAttributes[definition.TemporalPaused] = bytes
+	return e.taskGenerator.generateWorkflowSearchAttrTasks(e.timeSource.Now())
+}
+
 func (e *mutableStateBuilder) StartTransaction(
 	namespaceEntry *cache.NamespaceCacheEntry,
 ) (bool, error) {
@@ -3882,6 +3994,8 @@ func (e *mutableStateBuilder) CloseTransactionAsMutation(
 		ExecutionInfo:    e.executionInfo,
 		VersionHistories: e.versionHistories,
 
+		ExecutionInfoEncoding: e.getExecutionInfoEncoding(),
+
 		UpsertActivityInfos:       convertUpdateActivityInfos(e.updateActivityInfos),
 		DeleteActivityInfos:       convertDeleteActivityInfos(e.deleteActivityInfos),
 		UpsertTimerInfos:          convertUpdateTimerInfos(e.updateTimerInfos),
@@ -3966,6 +4080,8 @@ func (e *mutableStateBuilder) CloseTransactionAsSnapshot(
 		ExecutionInfo:    e.executionInfo,
 		VersionHistories: e.versionHistories,
 
+		ExecutionInfoEncoding: e.getExecutionInfoEncoding(),
+
 		ActivityInfos:       convertPendingActivityInfos(e.pendingActivityInfoIDs),
 		TimerInfos:          convertPendingTimerInfos(e.pendingTimerInfoIDs),
 		ChildExecutionInfos: convertPendingChildExecutionInfos(e.pendingChildExecutionInfoIDs),
@@ -4527,6 +4643,13 @@ func (e *mutableStateBuilder) shouldGenerateChecksum() bool {
 	return rand.Intn(100) < e.config.MutableStateChecksumGenProbability(e.namespaceEntry.GetInfo().Name)
 }
 
+func (e *mutableStateBuilder) getExecutionInfoEncoding() enumspb.EncodingType {
+	if e.namespaceEntry == nil {
+		return enumspb.ENCODING_TYPE_PROTO3
+	}
+	return getPersistenceEncoding(e.config.MutableStateEncodingType(e.namespaceEntry.GetInfo().Name), e.shard.GetThrottledLogger())
+}
+
 func (e *mutableStateBuilder) shouldVerifyChecksum() bool {
 	if e.namespaceEntry == nil {
 		return false
@@ -35,6 +35,7 @@ import (
 	"github.com/uber-go/tally"
 	commonpb "go.temporal.io/api/common/v1"
 	enumspb "go.temporal.io/api/enums/v1"
+	failurepb "go.temporal.io/api/failure/v1"
 	historypb "go.temporal.io/api/history/v1"
 	taskqueuepb "go.temporal.io/api/taskqueue/v1"
 
@@ -222,6 +223,8 @@ func (s *mutableStateSuite) TestShouldBufferEvent() {
 		enumspb.EVENT_TYPE_START_CHILD_WORKFLOW_EXECUTION_INITIATED:             true,
 		enumspb.EVENT_TYPE_SIGNAL_EXTERNAL_WORKFLOW_EXECUTION_INITIATED:         true,
 		enumspb.EVENT_TYPE_UPSERT_WORKFLOW_SEARCH_ATTRIBUTES:                    true,
+		enumspb.EVENT_TYPE_WORKFLOW_EXECUTION_UPDATE_ACCEPTED:                   true,
+		enumspb.EVENT_TYPE_WORKFLOW_EXECUTION_UPDATE_COMPLETED:                  true,
 	}
 
 	// other events will not be assign event ID immediately
@@ -257,7 +260,8 @@ OtherEventsLoop:
 
 	commandTypes := enumspb.CommandType_name
 	delete(commandTypes, 0) // Remove Unspecified.
-	s.Equal(len(commandTypes), len(commandEvents),
+	// RespondWorkflowUpdate command is recorded as either update accepted or update completed event
+	s.Equal(len(commandTypes)+1, len(commandEvents),
 		"This assertion will be broken a new command is added and no corresponding logic added to shouldBufferEvent()")
 }
 
@@ -545,6 +549,78 @@ func (s *mutableStateSuite) TestEventReapplied() {
 	s.True(isReapplied)
 }
 
+func (s *mutableStateSuite) TestUpdateInfo() {
+	s.msBuilder.executionInfo = &persistence.WorkflowExecutionInfo{}
+	accepted := func(updateID string) *historypb.HistoryEvent {
+		return &historypb.HistoryEvent{
+			EventType: enumspb.EVENT_TYPE_WORKFLOW_EXECUTION_UPDATE_ACCEPTED,
+			Attributes: &historypb.HistoryEvent_WorkflowExecutionUpdateAcceptedEventAttributes{WorkflowExecutionUpdateAcceptedEventAttributes: &historypb.WorkflowExecutionUpdateAcceptedEventAttributes{
+				UpdateId: updateID,
+				Name:     "update-name",
+			}},
+		}
+	}
+	completed := func(updateID string, result *commonpb.Payloads, failure *failurepb.Failure) *historypb.HistoryEvent {
+		return &historypb.HistoryEvent{
+			EventType: enumspb.EVENT_TYPE_WORKFLOW_EXECUTION_UPDATE_COMPLETED,
+			Attributes: &historypb.HistoryEvent_WorkflowExecutionUpdateCompletedEventAttributes{WorkflowExecutionUpdateCompletedEventAttributes: &historypb.WorkflowExecutionUpdateCompletedEventAttributes{
+				UpdateId: updateID,
+				Result:   result,
+				Failure:  failure,
+			}},
+		}
+	}
+
+	s.NoError(s.msBuilder.ReplicateWorkflowExecutionUpdateAcceptedEvent(accepted("update-1")))
+	info, ok := s.msBuilder.GetUpdateInfo("update-1")
+	s.True(ok)
+	s.True(info.GetAccepted())
+	s.False(info.GetCompleted())
+
+	result := payloads.EncodeString("result")
+	s.NoError(s.msBuilder.ReplicateWorkflowExecutionUpdateCompletedEvent(completed("update-1", result, nil)))
+	info, _ = s.msBuilder.GetUpdateInfo("update-1")
+	s.True(info.GetAccepted())
+	s.True(info.GetCompleted())
+	s.Equal(result, info.GetResult())
+
+	// a rejected update is completed without being accepted
+	rejection := failure.NewServerFailure("rejected", true)
+	s.NoError(s.msBuilder.ReplicateWorkflowExecutionUpdateCompletedEvent(completed("update-2", nil, rejection)))
+	info, _ = s.msBuilder.GetUpdateInfo("update-2")
+	s.False(info.GetAccepted())
+	s.True(info.GetCompleted())
+	s.Equal(rejection, info.GetFailure())
+	s.Nil(info.GetResult())
+	s.Len(s.msBuilder.executionInfo.UpdateInfos, 2)
+
+	_, ok = s.msBuilder.GetUpdateInfo("update-3")
+	s.False(ok)
+}
+
+func (s *mutableStateSuite) TestUpdateWorkflowPauseState() {
+	s.msBuilder.executionInfo = &persistence.WorkflowExecutionInfo{}
+	searchAttrPaused := func() bool {
+		var paused bool
+		s.NoError(payload.Decode(s.msBuilder.executionInfo.SearchAttributes[definition.TemporalPaused], &paused))
+		return paused
+	}
+
+	s.NoError(s.msBuilder.UpdateWorkflowPauseState(true, "reason"))
+	paused, reason := s.msBuilder.IsWorkflowExecutionPaused()
+	s.True(paused)
+	s.Equal("reason", reason)
+	s.True(searchAttrPaused())
+	s.Empty(s.msBuilder.hBuilder.history)
+
+	s.NoError(s.msBuilder.UpdateWorkflowPauseState(false, ""))
+	paused, reason = s.msBuilder.IsWorkflowExecutionPaused()
+	s.False(paused)
+	s.Equal("", reason)
+	s.False(searchAttrPaused())
+	s.Empty(s.msBuilder.hBuilder.history)
+}
+
 func (s *mutableStateSuite) prepareTransientWorkflowTaskCompletionFirstBatchReplicated(version int64, runID string) (*historypb.HistoryEvent, *historypb.HistoryEvent) {
 	namespaceID := testNamespaceID
 	execution := commonpb.WorkflowExecution{
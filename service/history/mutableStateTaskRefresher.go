@@ -153,14 +153,12 @@ func (r *mutableStateTaskRefresherImpl) refreshTasks(
 		return err
 	}
 
-	if r.config.AdvancedVisibilityWritingMode() != common.AdvancedVisibilityWritingModeOff {
-		if err := r.refreshTasksForWorkflowSearchAttr(
-			now,
-			mutableState,
-			taskGenerator,
-		); err != nil {
-			return err
-		}
+	if err := r.refreshTasksForWorkflowSearchAttr(
+		now,
+		mutableState,
+		taskGenerator,
+	); err != nil {
+		return err
 	}
 
 	return nil
@@ -25,8 +25,13 @@
 package history
 
 import (
+	enumspb "go.temporal.io/api/enums/v1"
+
 	"go.temporal.io/server/api/persistenceblobs/v1"
+	"go.temporal.io/server/common/log"
+	"go.temporal.io/server/common/log/tag"
 	"go.temporal.io/server/common/persistence"
+	"go.temporal.io/server/common/persistence/serialization"
 )
 
 type transactionPolicy int
@@ -203,3 +208,18 @@ func convertSignalRequestedIDs(
 	}
 	return outputs
 }
+
+// getPersistenceEncoding parses an encoding from dynamic config,
+// anything which is not proto3 based falls back to plain proto3
+func getPersistenceEncoding(
+	name string,
+	logger log.Logger,
+) enumspb.EncodingType {
+
+	encoding, err := serialization.EncodingTypeFromName(name)
+	if err != nil || !serialization.IsProto3Encoding(encoding) {
+		logger.Warn("invalid persistence encoding in dynamic config, using proto3", tag.Value(name))
+		return enumspb.ENCODING_TYPE_PROTO3
+	}
+	return encoding
+}
@@ -39,6 +39,7 @@ import (
 	failure "go.temporal.io/api/failure/v1"
 	history "go.temporal.io/api/history/v1"
 	taskqueue "go.temporal.io/api/taskqueue/v1"
+	workflow "go.temporal.io/api/workflow/v1"
 	workflowservice "go.temporal.io/api/workflowservice/v1"
 	enums0 "go.temporal.io/server/api/enums/v1"
 	historyservice "go.temporal.io/server/api/historyservice/v1"
@@ -735,6 +736,36 @@ func (mr *MockmutableStateMockRecorder) AddWorkflowExecutionTerminatedEvent(firs
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddWorkflowExecutionTerminatedEvent", reflect.TypeOf((*MockmutableState)(nil).AddWorkflowExecutionTerminatedEvent), firstEventID, reason, details, identity)
 }
 
+// AddWorkflowExecutionUpdateAcceptedEvent mocks base method.
+func (m *MockmutableState) AddWorkflowExecutionUpdateAcceptedEvent(arg0 int64, arg1 *workflow.WorkflowUpdateRequest) (*history.HistoryEvent, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AddWorkflowExecutionUpdateAcceptedEvent", arg0, arg1)
+	ret0, _ := ret[0].(*history.HistoryEvent)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// AddWorkflowExecutionUpdateAcceptedEvent indicates an expected call of AddWorkflowExecutionUpdateAcceptedEvent.
+func (mr *MockmutableStateMockRecorder) AddWorkflowExecutionUpdateAcceptedEvent(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddWorkflowExecutionUpdateAcceptedEvent", reflect.TypeOf((*MockmutableState)(nil).AddWorkflowExecutionUpdateAcceptedEvent), arg0, arg1)
+}
+
+// AddWorkflowExecutionUpdateCompletedEvent mocks base method.
+func (m *MockmutableState) AddWorkflowExecutionUpdateCompletedEvent(arg0 int64, arg1 *command.RespondWorkflowUpdateCommandAttributes) (*history.HistoryEvent, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AddWorkflowExecutionUpdateCompletedEvent", arg0, arg1)
+	ret0, _ := ret[0].(*history.HistoryEvent)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// AddWorkflowExecutionUpdateCompletedEvent indicates an expected call of AddWorkflowExecutionUpdateCompletedEvent.
+func (mr *MockmutableStateMockRecorder) AddWorkflowExecutionUpdateCompletedEvent(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddWorkflowExecutionUpdateCompletedEvent", reflect.TypeOf((*MockmutableState)(nil).AddWorkflowExecutionUpdateCompletedEvent), arg0, arg1)
+}
+
 // ClearStickyness mocks base method.
 func (m *MockmutableState) ClearStickyness() {
 	m.ctrl.T.Helper()
@@ -1409,6 +1440,35 @@ func (mr *MockmutableStateMockRecorder) GetQueryRegistry() *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetQueryRegistry", reflect.TypeOf((*MockmutableState)(nil).GetQueryRegistry))
 }
 
+// GetUpdateInfo mocks base method.
+func (m *MockmutableState) GetUpdateInfo(updateID string) (*persistenceblobs.UpdateInfo, bool) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetUpdateInfo", updateID)
+	ret0, _ := ret[0].(*persistenceblobs.UpdateInfo)
+	ret1, _ := ret[1].(bool)
+	return ret0, ret1
+}
+
+// GetUpdateInfo indicates an expected call of GetUpdateInfo.
+func (mr *MockmutableStateMockRecorder) GetUpdateInfo(updateID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetUpdateInfo", reflect.TypeOf((*MockmutableState)(nil).GetUpdateInfo), updateID)
+}
+
+// GetUpdateRegistry mocks base method.
+func (m *MockmutableState) GetUpdateRegistry() updateRegistry {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetUpdateRegistry")
+	ret0, _ := ret[0].(updateRegistry)
+	return ret0
+}
+
+// GetUpdateRegistry indicates an expected call of GetUpdateRegistry.
+func (mr *MockmutableStateMockRecorder) GetUpdateRegistry() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetUpdateRegistry", reflect.TypeOf((*MockmutableState)(nil).GetUpdateRegistry))
+}
+
 // HasBufferedEvents mocks base method.
 func (m *MockmutableState) HasBufferedEvents() bool {
 	m.ctrl.T.Helper()
@@ -1536,6 +1596,21 @@ func (mr *MockmutableStateMockRecorder) IsStickyTaskQueueEnabled() *gomock.Call
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "IsStickyTaskQueueEnabled", reflect.TypeOf((*MockmutableState)(nil).IsStickyTaskQueueEnabled))
 }
 
+// IsWorkflowExecutionPaused mocks base method.
+func (m *MockmutableState) IsWorkflowExecutionPaused() (bool, string) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "IsWorkflowExecutionPaused")
+	ret0, _ := ret[0].(bool)
+	ret1, _ := ret[1].(string)
+	return ret0, ret1
+}
+
+// IsWorkflowExecutionPaused indicates an expected call of IsWorkflowExecutionPaused.
+func (mr *MockmutableStateMockRecorder) IsWorkflowExecutionPaused() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "IsWorkflowExecutionPaused", reflect.TypeOf((*MockmutableState)(nil).IsWorkflowExecutionPaused))
+}
+
 // IsWorkflowExecutionRunning mocks base method.
 func (m *MockmutableState) IsWorkflowExecutionRunning() bool {
 	m.ctrl.T.Helper()
@@ -2168,6 +2243,34 @@ func (mr *MockmutableStateMockRecorder) ReplicateWorkflowExecutionTimedoutEvent(
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ReplicateWorkflowExecutionTimedoutEvent", reflect.TypeOf((*MockmutableState)(nil).ReplicateWorkflowExecutionTimedoutEvent), arg0, arg1)
 }
 
+// ReplicateWorkflowExecutionUpdateAcceptedEvent mocks base method.
+func (m *MockmutableState) ReplicateWorkflowExecutionUpdateAcceptedEvent(arg0 *history.HistoryEvent) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ReplicateWorkflowExecutionUpdateAcceptedEvent", arg0)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// ReplicateWorkflowExecutionUpdateAcceptedEvent indicates an expected call of ReplicateWorkflowExecutionUpdateAcceptedEvent.
+func (mr *MockmutableStateMockRecorder) ReplicateWorkflowExecutionUpdateAcceptedEvent(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ReplicateWorkflowExecutionUpdateAcceptedEvent", reflect.TypeOf((*MockmutableState)(nil).ReplicateWorkflowExecutionUpdateAcceptedEvent), arg0)
+}
+
+// ReplicateWorkflowExecutionUpdateCompletedEvent mocks base method.
+func (m *MockmutableState) ReplicateWorkflowExecutionUpdateCompletedEvent(arg0 *history.HistoryEvent) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ReplicateWorkflowExecutionUpdateCompletedEvent", arg0)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// ReplicateWorkflowExecutionUpdateCompletedEvent indicates an expected call of ReplicateWorkflowExecutionUpdateCompletedEvent.
+func (mr *MockmutableStateMockRecorder) ReplicateWorkflowExecutionUpdateCompletedEvent(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ReplicateWorkflowExecutionUpdateCompletedEvent", reflect.TypeOf((*MockmutableState)(nil).ReplicateWorkflowExecutionUpdateCompletedEvent), arg0)
+}
+
 // SetCurrentBranchToken mocks base method.
 func (m *MockmutableState) SetCurrentBranchToken(branchToken []byte) error {
 	m.ctrl.T.Helper()
@@ -2302,6 +2405,20 @@ func (mr *MockmutableStateMockRecorder) UpdateCurrentVersion(version, forceUpdat
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateCurrentVersion", reflect.TypeOf((*MockmutableState)(nil).UpdateCurrentVersion), version, forceUpdate)
 }
 
+// UpdateWorkflowPauseState mocks base method.
+func (m *MockmutableState) UpdateWorkflowPauseState(paused bool, reason string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateWorkflowPauseState", paused, reason)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UpdateWorkflowPauseState indicates an expected call of UpdateWorkflowPauseState.
+func (mr *MockmutableStateMockRecorder) UpdateWorkflowPauseState(paused, reason interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateWorkflowPauseState", reflect.TypeOf((*MockmutableState)(nil).UpdateWorkflowPauseState), paused, reason)
+}
+
 // UpdateWorkflowStateStatus mocks base method.
 func (m *MockmutableState) UpdateWorkflowStateStatus(state enums0.WorkflowExecutionState, status enums.WorkflowExecutionStatus) error {
 	m.ctrl.T.Helper()
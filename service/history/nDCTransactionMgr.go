@@ -354,6 +354,7 @@ func (r *nDCTransactionMgrImpl) backfillWorkflowEventsReapply(
 			targetWorkflow,
 			eventsReapplicationResetWorkflowReason,
 			targetWorkflowEvents.Events,
+			resetReapplyTypeAll,
 		); err != nil {
 			return 0, transactionPolicyActive, err
 		}
@@ -232,6 +232,7 @@ func (s *nDCTransactionMgrSuite) TestBackfillWorkflow_CurrentWorkflow_Active_Clo
 		workflow,
 		eventsReapplicationResetWorkflowReason,
 		workflowEvents.Events,
+		resetReapplyTypeAll,
 	).Return(nil).Times(1)
 
 	s.mockExecutionMgr.On("GetCurrentExecution", &persistence.GetCurrentExecutionRequest{
@@ -340,3 +340,43 @@ func (h *NilCheckHandler) RefreshWorkflowTasks(ctx context.Context, request *his
 	}
 	return resp, err
 }
+
+func (h *NilCheckHandler) DeleteWorkflowExecution(ctx context.Context, request *historyservice.DeleteWorkflowExecutionRequest) (*historyservice.DeleteWorkflowExecutionResponse, error) {
+	resp, err := h.parentHandler.DeleteWorkflowExecution(ctx, request)
+	if resp == nil && err == nil {
+		resp = &historyservice.DeleteWorkflowExecutionResponse{}
+	}
+	return resp, err
+}
+
+func (h *NilCheckHandler) UpdateWorkflowExecution(ctx context.Context, request *historyservice.UpdateWorkflowExecutionRequest) (*historyservice.UpdateWorkflowExecutionResponse, error) {
+	resp, err := h.parentHandler.UpdateWorkflowExecution(ctx, request)
+	if resp == nil && err == nil {
+		resp = &historyservice.UpdateWorkflowExecutionResponse{}
+	}
+	return resp, err
+}
+
+func (h *NilCheckHandler) PauseWorkflowExecution(ctx context.Context, request *historyservice.PauseWorkflowExecutionRequest) (*historyservice.PauseWorkflowExecutionResponse, error) {
+	resp, err := h.parentHandler.PauseWorkflowExecution(ctx, request)
+	if resp == nil && err == nil {
+		resp = &historyservice.PauseWorkflowExecutionResponse{}
+	}
+	return resp, err
+}
+
+func (h *NilCheckHandler) UnpauseWorkflowExecution(ctx context.Context, request *historyservice.UnpauseWorkflowExecutionRequest) (*historyservice.UnpauseWorkflowExecutionResponse, error) {
+	resp, err := h.parentHandler.UnpauseWorkflowExecution(ctx, request)
+	if resp == nil && err == nil {
+		resp = &historyservice.UnpauseWorkflowExecutionResponse{}
+	}
+	return resp, err
+}
+
+func (h *NilCheckHandler) AcquireShard(ctx context.Context, request *historyservice.AcquireShardRequest) (*historyservice.AcquireShardResponse, error) {
+	resp, err := h.parentHandler.AcquireShard(ctx, request)
+	if resp == nil && err == nil {
+		resp = &historyservice.AcquireShardResponse{}
+	}
+	return resp, err
+}
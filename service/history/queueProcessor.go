@@ -176,6 +176,10 @@ func (p *queueProcessorBase) Stop() {
 	if p.taskProcessor != nil {
 		p.taskProcessor.stop()
 	}
+
+	// persist the ack level of the tasks completed so far, so that whoever processes the queue next,
+	// e.g. the new owner of the shard after a handoff, does not load and dispatch them again
+	p.ackMgr.updateQueueAckLevel() // nolint:errcheck
 }
 
 func (p *queueProcessorBase) notifyNewTask() {
@@ -338,3 +338,10 @@ func (t *queueTaskBase) SetPriority(
 func (t *queueTaskBase) GetShard() ShardContext {
 	return t.shard
 }
+
+func (t *queueTaskBase) GetAttempt() int {
+	t.Lock()
+	defer t.Unlock()
+
+	return t.attempt
+}
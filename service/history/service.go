@@ -46,6 +46,7 @@ import (
 	"go.temporal.io/server/common/service/config"
 	"go.temporal.io/server/common/service/dynamicconfig"
 	"go.temporal.io/server/common/task"
+	"go.temporal.io/server/common/tracing"
 )
 
 // Config represents configuration for history service
@@ -90,6 +91,7 @@ type Config struct {
 
 	// Task process settings
 	TaskProcessRPS                 dynamicconfig.IntPropertyFnWithNamespaceFilter
+	TaskPriorityNamespaceTier      dynamicconfig.IntPropertyFnWithNamespaceFilter
 	EnablePriorityTaskProcessor    dynamicconfig.BoolPropertyFn
 	TaskSchedulerType              dynamicconfig.IntPropertyFn
 	TaskSchedulerWorkerCount       dynamicconfig.IntPropertyFn
@@ -170,6 +172,8 @@ type Config struct {
 
 	// encoding the history events
 	EventEncodingType dynamicconfig.StringPropertyFnWithNamespaceFilter
+	// encoding the execution info of mutable state
+	MutableStateEncodingType dynamicconfig.StringPropertyFnWithNamespaceFilter
 	// whether or not using ParentClosePolicy
 	EnableParentClosePolicy dynamicconfig.BoolPropertyFnWithNamespaceFilter
 	// whether or not enable system workers for processing parent close policy task
@@ -248,6 +252,9 @@ type Config struct {
 	// The following are used by consistent query
 	MaxBufferedQueryCount dynamicconfig.IntPropertyFn
 
+	// The following are used by workflow update
+	MaxInFlightUpdateCount dynamicconfig.IntPropertyFnWithNamespaceFilter
+
 	// Data integrity check related config knobs
 	MutableStateChecksumGenProbability    dynamicconfig.IntPropertyFnWithNamespaceFilter
 	MutableStateChecksumVerifyProbability dynamicconfig.IntPropertyFnWithNamespaceFilter
@@ -295,13 +302,14 @@ func NewConfig(dc *dynamicconfig.Collection, numberOfShards int32, isAdvancedVis
 		StandbyTaskMissingEventsResendDelay:  dc.GetDurationProperty(dynamicconfig.StandbyTaskMissingEventsResendDelay, 10*time.Minute),
 		StandbyTaskMissingEventsDiscardDelay: dc.GetDurationProperty(dynamicconfig.StandbyTaskMissingEventsDiscardDelay, 15*time.Minute),
 
-		TaskProcessRPS: dc.GetIntPropertyFilteredByNamespace(dynamicconfig.TaskProcessRPS, 1000),
+		TaskProcessRPS:            dc.GetIntPropertyFilteredByNamespace(dynamicconfig.TaskProcessRPS, 1000),
+		TaskPriorityNamespaceTier: dc.GetIntPropertyFilteredByNamespace(dynamicconfig.TaskPriorityNamespaceTier, namespaceTierHigh),
 
 		EnablePriorityTaskProcessor:    dc.GetBoolProperty(dynamicconfig.EnablePriorityTaskProcessor, false),
 		TaskSchedulerType:              dc.GetIntProperty(dynamicconfig.TaskSchedulerType, int(task.SchedulerTypeWRR)),
 		TaskSchedulerWorkerCount:       dc.GetIntProperty(dynamicconfig.TaskSchedulerWorkerCount, 20),
 		TaskSchedulerQueueSize:         dc.GetIntProperty(dynamicconfig.TaskSchedulerQueueSize, 2000),
-		TaskSchedulerRoundRobinWeights: dc.GetMapProperty(dynamicconfig.TaskSchedulerRoundRobinWeights, convertWeightsToDynamicConfigValue(defaultTaskPriorityWeight)),
+		TaskSchedulerRoundRobinWeights: withDefaultTaskPriorityWeight(dc.GetMapProperty(dynamicconfig.TaskSchedulerRoundRobinWeights, convertWeightsToDynamicConfigValue(defaultTaskPriorityWeight))),
 
 		TimerTaskBatchSize:                                dc.GetIntProperty(dynamicconfig.TimerTaskBatchSize, 100),
 		TimerTaskWorkerCount:                              dc.GetIntProperty(dynamicconfig.TimerTaskWorkerCount, 10),
@@ -370,6 +378,7 @@ func NewConfig(dc *dynamicconfig.Collection, numberOfShards int32, isAdvancedVis
 		// TODO: Return this value to the client: go.temporal.io/server/issues/294
 		LongPollExpirationInterval:          dc.GetDurationPropertyFilteredByNamespace(dynamicconfig.HistoryLongPollExpirationInterval, time.Second*20),
 		EventEncodingType:                   dc.GetStringPropertyFnWithNamespaceFilter(dynamicconfig.DefaultEventEncoding, enumspb.ENCODING_TYPE_PROTO3.String()),
+		MutableStateEncodingType:            dc.GetStringPropertyFnWithNamespaceFilter(dynamicconfig.DefaultMutableStateEncoding, enumspb.ENCODING_TYPE_PROTO3.String()),
 		EnableParentClosePolicy:             dc.GetBoolPropertyFnWithNamespaceFilter(dynamicconfig.EnableParentClosePolicy, true),
 		NumParentClosePolicySystemWorkflows: dc.GetIntProperty(dynamicconfig.NumParentClosePolicySystemWorkflows, 10),
 		EnableParentClosePolicyWorker:       dc.GetBoolProperty(dynamicconfig.EnableParentClosePolicyWorker, true),
@@ -414,6 +423,7 @@ func NewConfig(dc *dynamicconfig.Collection, numberOfShards int32, isAdvancedVis
 		EnableCleanupReplicationTask:                     dc.GetBoolProperty(dynamicconfig.HistoryEnableCleanupReplicationTask, true),
 
 		MaxBufferedQueryCount:                 dc.GetIntProperty(dynamicconfig.MaxBufferedQueryCount, 1),
+		MaxInFlightUpdateCount:                dc.GetIntPropertyFilteredByNamespace(dynamicconfig.MaxInFlightUpdateCount, 10),
 		MutableStateChecksumGenProbability:    dc.GetIntPropertyFilteredByNamespace(dynamicconfig.MutableStateChecksumGenProbability, 0),
 		MutableStateChecksumVerifyProbability: dc.GetIntPropertyFilteredByNamespace(dynamicconfig.MutableStateChecksumVerifyProbability, 0),
 		MutableStateChecksumInvalidateBefore:  dc.GetFloat64Property(dynamicconfig.MutableStateChecksumInvalidateBefore, 0),
@@ -458,6 +468,7 @@ func NewService(
 		VisibilityOpenMaxQPS:   serviceConfig.VisibilityOpenMaxQPS,
 		VisibilityClosedMaxQPS: serviceConfig.VisibilityClosedMaxQPS,
 		EnableSampling:         serviceConfig.EnableVisibilitySampling,
+		ValidSearchAttributes:  serviceConfig.ValidSearchAttributes,
 	}
 
 	visibilityManagerInitializer := func(
@@ -523,7 +534,7 @@ func (s *Service) Start() {
 	if err != nil {
 		logger.Fatal("creating grpc server options failed", tag.Error(err))
 	}
-	opts = append(opts, grpc.UnaryInterceptor(interceptor))
+	opts = append(opts, grpc.ChainUnaryInterceptor(tracing.UnaryServerInterceptor, interceptor))
 	s.server = grpc.NewServer(opts...)
 	nilCheckHandler := NewNilCheckHandler(s.handler)
 	historyservice.RegisterHistoryServiceServer(s.server, nilCheckHandler)
@@ -810,6 +810,9 @@ func (s *shardContextImpl) AppendHistoryV2Events(
 
 	request.ShardID = convert.Int32Ptr(s.shardID)
 	request.TransactionID = transactionID
+	if entry, err := s.GetNamespaceCache().GetNamespaceByID(namespaceID); err == nil && entry != nil && entry.GetInfo() != nil {
+		request.Encoding = getPersistenceEncoding(s.config.EventEncodingType(entry.GetInfo().Name), s.throttledLogger)
+	}
 
 	size := 0
 	defer func() {
@@ -951,15 +954,30 @@ func (s *shardContextImpl) updateShardInfoLocked() error {
 		return ErrShardClosed
 	}
 
-	var err error
 	now := clock.NewRealTimeSource().Now()
 	if s.lastUpdated.Add(s.config.ShardUpdateMinInterval()).After(now) {
 		return nil
 	}
+	return s.persistShardInfoLocked(now)
+}
+
+// flush persists the shard info, including the queue ack levels, regardless of ShardUpdateMinInterval.
+// It is called when the shard is handed off so that the next owner resumes from the latest ack levels.
+func (s *shardContextImpl) flush() error {
+	s.Lock()
+	defer s.Unlock()
+
+	if s.isClosed() {
+		return ErrShardClosed
+	}
+	return s.persistShardInfoLocked(clock.NewRealTimeSource().Now())
+}
+
+func (s *shardContextImpl) persistShardInfoLocked(now time.Time) error {
 	updatedShardInfo := copyShardInfo(s.shardInfo)
 	s.emitShardInfoMetricsLogsLocked()
 
-	err = s.GetShardManager().UpdateShard(&persistence.UpdateShardRequest{
+	err := s.GetShardManager().UpdateShard(&persistence.UpdateShardRequest{
 		ShardInfo:       updatedShardInfo.ShardInfo,
 		PreviousRangeID: s.shardInfo.GetRangeId(),
 	})
@@ -1154,7 +1172,7 @@ func (s *shardContextImpl) GetLastUpdatedTime() time.Time {
 func acquireShard(
 	shardItem *historyShardsItem,
 	closeCallback func(int32, *historyShardsItem),
-) (ShardContext, error) {
+) (*shardContextImpl, error) {
 
 	var shardInfo *persistence.ShardInfoWithFailover
 
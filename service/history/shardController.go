@@ -25,6 +25,7 @@
 package history
 
 import (
+	"context"
 	"fmt"
 	"sync"
 	"sync/atomic"
@@ -32,6 +33,7 @@ import (
 
 	"go.temporal.io/server/common/convert"
 
+	"go.temporal.io/server/api/historyservice/v1"
 	"go.temporal.io/server/common"
 	"go.temporal.io/server/common/log"
 	"go.temporal.io/server/common/log/tag"
@@ -44,6 +46,9 @@ import (
 
 const (
 	shardControllerMembershipUpdateListenerName = "ShardController"
+
+	// shardHandoffNotifyTimeout bounds the AcquireShard call made to the new owner of a handed off shard
+	shardHandoffNotifyTimeout = 5 * time.Second
 )
 
 type (
@@ -77,14 +82,17 @@ type (
 		engineFactory   EngineFactory
 
 		sync.RWMutex
-		status historyShardsItemStatus
-		engine Engine
+		status   historyShardsItemStatus
+		engine   Engine
+		shard    *shardContextImpl
+		newOwner string
 	}
 )
 
 const (
 	historyShardsItemStatusInitialized = iota
 	historyShardsItemStatusStarted
+	historyShardsItemStatusHandingOff
 	historyShardsItemStatusStopped
 )
 
@@ -167,9 +175,14 @@ func (c *shardController) Stop() {
 	c.logger.Info("", tag.LifeCycleStopped)
 }
 
-// PrepareToStop starts the graceful shutdown process for controller
+// PrepareToStop starts the graceful shutdown process for controller. It hands off the shards
+// which the membership ring already assigns to other hosts, which is the case for all shards
+// once this host evicted itself from the ring.
 func (c *shardController) PrepareToStop() {
-	atomic.StoreInt32(&c.shuttingDown, 1)
+	if !atomic.CompareAndSwapInt32(&c.shuttingDown, 0, 1) {
+		return
+	}
+	c.handoffShards()
 }
 
 func (c *shardController) isShuttingDown() bool {
@@ -235,32 +248,32 @@ func (c *shardController) getOrCreateHistoryShardItem(shardID int32) (*historySh
 		// if item not valid then process to create a new one
 	}
 
-	if c.isShuttingDown() || atomic.LoadInt32(&c.status) == common.DaemonStatusStopped {
-		return nil, fmt.Errorf("shardController for host '%v' shutting down", c.GetHostInfo().Identity())
-	}
 	info, err := c.GetHistoryServiceResolver().Lookup(convert.Int32ToString(shardID))
 	if err != nil {
 		return nil, err
 	}
+	if info.Identity() != c.GetHostInfo().Identity() {
+		return nil, serviceerrors.NewShardOwnershipLost(info.GetAddress(), c.GetHostInfo().GetAddress())
+	}
 
-	if info.Identity() == c.GetHostInfo().Identity() {
-		shardItem, err := newHistoryShardsItem(
-			c.Resource,
-			shardID,
-			c.engineFactory,
-			c.config,
-		)
-		if err != nil {
-			return nil, err
-		}
-		c.historyShards[shardID] = shardItem
-		c.metricsScope.IncCounter(metrics.ShardItemCreatedCounter)
+	if c.isShuttingDown() || atomic.LoadInt32(&c.status) == common.DaemonStatusStopped {
+		return nil, fmt.Errorf("shardController for host '%v' shutting down", c.GetHostInfo().Identity())
+	}
 
-		shardItem.logger.Info("", tag.LifeCycleStarted, tag.ComponentShardItem)
-		return shardItem, nil
+	shardItem, err := newHistoryShardsItem(
+		c.Resource,
+		shardID,
+		c.engineFactory,
+		c.config,
+	)
+	if err != nil {
+		return nil, err
 	}
+	c.historyShards[shardID] = shardItem
+	c.metricsScope.IncCounter(metrics.ShardItemCreatedCounter)
 
-	return nil, serviceerrors.NewShardOwnershipLost(c.GetHostInfo().Identity(), info.GetAddress())
+	shardItem.logger.Info("", tag.LifeCycleStarted, tag.ComponentShardItem)
+	return shardItem, nil
 }
 
 func (c *shardController) removeHistoryShardItem(shardID int32, shardItem *historyShardsItem) (*historyShardsItem, error) {
@@ -316,6 +329,7 @@ func (c *shardController) shardManagementPump() {
 				tag.NumberProcessed(len(changedEvent.HostsAdded)),
 				tag.NumberDeleted(len(changedEvent.HostsRemoved)),
 				tag.Number(int64(len(changedEvent.HostsUpdated))))
+			c.handoffShards()
 			c.acquireShards()
 		}
 	}
@@ -367,6 +381,79 @@ func (c *shardController) acquireShards() {
 	c.metricsScope.UpdateGauge(metrics.NumShardsGauge, float64(c.numShards()))
 }
 
+// handoffShards hands off every shard held by this host which the membership ring assigns
+// to another host.
+func (c *shardController) handoffShards() {
+	shardIDs := c.shardIDs()
+	if len(shardIDs) == 0 {
+		return
+	}
+
+	concurrency := common.MaxInt(c.config.AcquireShardConcurrency(), 1)
+	shardActionCh := make(chan int32, concurrency)
+	var wg sync.WaitGroup
+	wg.Add(concurrency)
+	for i := 0; i < concurrency; i++ {
+		go func() {
+			defer wg.Done()
+			for shardID := range shardActionCh {
+				info, err := c.GetHistoryServiceResolver().Lookup(convert.Int32ToString(shardID))
+				if err != nil {
+					c.logger.Error("Error looking up host for shardID", tag.Error(err), tag.OperationFailed, tag.ShardID(shardID))
+					continue
+				}
+				if info.Identity() != c.GetHostInfo().Identity() {
+					c.handoffShard(shardID, info)
+				}
+			}
+		}()
+	}
+	for _, shardID := range shardIDs {
+		shardActionCh <- shardID
+	}
+	close(shardActionCh)
+	wg.Wait()
+
+	c.metricsScope.UpdateGauge(metrics.NumShardsGauge, float64(c.numShards()))
+}
+
+// handoffShard stops the engine of the shard, persists the shard state and asks the new owner to
+// acquire the shard right away, instead of waiting for it to find out about the ownership change.
+// Calls received while the handoff is in progress are redirected to the new owner.
+func (c *shardController) handoffShard(shardID int32, newOwner *membership.HostInfo) {
+	c.RLock()
+	item, ok := c.historyShards[shardID]
+	c.RUnlock()
+	if !ok {
+		return
+	}
+
+	sw := c.metricsScope.StartTimer(metrics.ShardHandoffLatency)
+	defer sw.Stop()
+
+	handedOff, err := item.handoff(newOwner.GetAddress())
+	c.removeHistoryShardItem(shardID, item) // nolint:errcheck
+	if !handedOff {
+		return
+	}
+	c.metricsScope.IncCounter(metrics.ShardHandoffCounter)
+	if err != nil {
+		c.metricsScope.IncCounter(metrics.ShardHandoffFailedCounter)
+		item.logger.Warn("Unable to persist shard state on handoff", tag.Error(err), tag.Address(newOwner.GetAddress()))
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), shardHandoffNotifyTimeout)
+	defer cancel()
+	if _, err := c.GetHistoryClient().AcquireShard(ctx, &historyservice.AcquireShardRequest{ShardId: shardID}); err != nil {
+		// the new owner still acquires the shard once it learns about the ownership change
+		c.metricsScope.IncCounter(metrics.ShardHandoffFailedCounter)
+		item.logger.Warn("Unable to notify new shard owner", tag.Error(err), tag.Address(newOwner.GetAddress()))
+		return
+	}
+	item.logger.Info("Shard handed off", tag.Address(newOwner.GetAddress()))
+}
+
 func (c *shardController) doShutdown() {
 	c.logger.Info("", tag.LifeCycleStopping)
 	c.Lock()
@@ -425,11 +512,14 @@ func (i *historyShardsItem) getOrCreateEngine(
 		}
 		i.engine = i.engineFactory.CreateEngine(context)
 		i.engine.Start()
+		i.shard = context
 		i.logger.Info("", tag.LifeCycleStarted, tag.ComponentShardEngine)
 		i.status = historyShardsItemStatusStarted
 		return i.engine, nil
 	case historyShardsItemStatusStarted:
 		return i.engine, nil
+	case historyShardsItemStatusHandingOff:
+		return nil, serviceerrors.NewShardOwnershipLost(i.newOwner, i.GetHostInfo().GetAddress())
 	case historyShardsItemStatusStopped:
 		return nil, fmt.Errorf("shard %v for host '%v' is shut down", i.shardID, i.GetHostInfo().Identity())
 	default:
@@ -450,11 +540,47 @@ func (i *historyShardsItem) stopEngine() {
 		i.engine = nil
 		i.logger.Info("", tag.LifeCycleStopped, tag.ComponentShardEngine)
 		i.status = historyShardsItemStatusStopped
-	case historyShardsItemStatusStopped:
-		// no op
+	case historyShardsItemStatusHandingOff, historyShardsItemStatusStopped:
+		// no op, the handoff stops the engine
+	default:
+		panic(i.logInvalidStatus())
+	}
+}
+
+// handoff stops the engine and persists the shard info, including the queue ack levels, so that
+// the new owner resumes from where this host left off. Calls made while the engine is stopping are
+// redirected to newOwner. It returns false if the engine was not started, in which case there is
+// nothing to hand off.
+func (i *historyShardsItem) handoff(newOwner string) (bool, error) {
+	i.Lock()
+	switch i.status {
+	case historyShardsItemStatusStarted:
+		i.status = historyShardsItemStatusHandingOff
+		i.newOwner = newOwner
+	case historyShardsItemStatusInitialized:
+		i.status = historyShardsItemStatusStopped
+		i.Unlock()
+		return false, nil
+	case historyShardsItemStatusHandingOff, historyShardsItemStatusStopped:
+		i.Unlock()
+		return false, nil
 	default:
+		i.Unlock()
 		panic(i.logInvalidStatus())
 	}
+	engine, shard := i.engine, i.shard
+	i.Unlock()
+
+	i.logger.Info("Handing off shard", tag.Address(newOwner))
+	engine.Stop()
+	err := shard.flush()
+
+	i.Lock()
+	i.engine = nil
+	i.status = historyShardsItemStatusStopped
+	i.Unlock()
+	i.logger.Info("", tag.LifeCycleStopped, tag.ComponentShardEngine)
+	return true, err
 }
 
 func (i *historyShardsItem) isValid() bool {
@@ -462,7 +588,7 @@ func (i *historyShardsItem) isValid() bool {
 	defer i.RUnlock()
 
 	switch i.status {
-	case historyShardsItemStatusInitialized, historyShardsItemStatusStarted:
+	case historyShardsItemStatusInitialized, historyShardsItemStatusStarted, historyShardsItemStatusHandingOff:
 		return true
 	case historyShardsItemStatusStopped:
 		return false
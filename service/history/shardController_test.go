@@ -33,6 +33,7 @@ import (
 
 	"go.temporal.io/server/common/convert"
 
+	"go.temporal.io/server/api/historyservice/v1"
 	"go.temporal.io/server/api/persistenceblobs/v1"
 
 	"github.com/golang/mock/gomock"
@@ -50,6 +51,7 @@ import (
 	"go.temporal.io/server/common/primitives/timestamp"
 	"go.temporal.io/server/common/resource"
 	"go.temporal.io/server/common/service/dynamicconfig"
+	serviceerrors "go.temporal.io/server/common/serviceerror"
 )
 
 type (
@@ -554,6 +556,55 @@ func (s *shardControllerSuite) TestShardControllerClosed() {
 	workerWG.Wait()
 }
 
+func (s *shardControllerSuite) TestShardHandoff() {
+	numShards := int32(2)
+	s.config.NumberOfShards = numShards
+	s.shardController = newShardController(s.mockResource, s.mockEngineFactory, s.config)
+	historyEngines := make(map[int32]*MockEngine)
+	for shardID := int32(1); shardID <= numShards; shardID++ {
+		mockEngine := NewMockEngine(s.controller)
+		historyEngines[shardID] = mockEngine
+		s.setupMocksForAcquireShard(shardID, mockEngine, 5, 6)
+	}
+
+	s.mockServiceResolver.EXPECT().AddListener(shardControllerMembershipUpdateListenerName, gomock.Any()).Return(nil).AnyTimes()
+	s.mockClusterMetadata.EXPECT().GetCurrentClusterName().Return(cluster.TestCurrentClusterName).AnyTimes()
+	s.mockClusterMetadata.EXPECT().GetAllClusterInfo().Return(cluster.TestSingleDCClusterInfo).AnyTimes()
+	s.shardController.Start()
+	s.Equal(2, s.shardController.numShards())
+
+	// shard 1 moves to another host, shard 2 stays
+	differentHostInfo := membership.NewHostInfo("another-host", nil)
+	s.mockServiceResolver.EXPECT().Lookup(convert.Int32ToString(1)).Return(differentHostInfo, nil).AnyTimes()
+	s.mockServiceResolver.EXPECT().Lookup(convert.Int32ToString(2)).Return(s.hostInfo, nil).AnyTimes()
+
+	historyEngines[1].EXPECT().Stop().Do(func() {
+		// calls made while the shard is handed off are redirected to the new owner
+		_, err := s.shardController.getEngineForShard(1)
+		s.IsType(&serviceerrors.ShardOwnershipLost{}, err)
+		s.Equal(differentHostInfo.GetAddress(), err.(*serviceerrors.ShardOwnershipLost).OwnerHost)
+	}).Times(1)
+	s.mockShardManager.On("UpdateShard", mock.MatchedBy(func(request *persistence.UpdateShardRequest) bool {
+		return request.ShardInfo.GetShardId() == 1 && request.PreviousRangeID == 6 && request.ShardInfo.GetRangeId() == 6
+	})).Return(nil).Once()
+	s.mockResource.HistoryClient.EXPECT().AcquireShard(gomock.Any(), &historyservice.AcquireShardRequest{ShardId: 1}).
+		Return(&historyservice.AcquireShardResponse{}, nil).Times(1)
+
+	s.shardController.handoffShards()
+	s.Equal([]int32{2}, s.shardController.shardIDs())
+
+	_, err := s.shardController.getEngineForShard(1)
+	s.IsType(&serviceerrors.ShardOwnershipLost{}, err)
+	s.Equal(differentHostInfo.GetAddress(), err.(*serviceerrors.ShardOwnershipLost).OwnerHost)
+	engine, err := s.shardController.getEngineForShard(2)
+	s.NoError(err)
+	s.Equal(historyEngines[2], engine)
+
+	s.mockServiceResolver.EXPECT().RemoveListener(shardControllerMembershipUpdateListenerName).Return(nil).AnyTimes()
+	historyEngines[2].EXPECT().Stop().Times(1)
+	s.shardController.Stop()
+}
+
 func (s *shardControllerSuite) setupMocksForAcquireShard(shardID int32, mockEngine *MockEngine, currentRangeID,
 	newRangeID int64) {
 
@@ -518,6 +518,20 @@ func (b *stateBuilderImpl) applyEvents(
 				return nil, err
 			}
 
+		case enumspb.EVENT_TYPE_WORKFLOW_EXECUTION_UPDATE_ACCEPTED:
+			if err := b.mutableState.ReplicateWorkflowExecutionUpdateAcceptedEvent(
+				event,
+			); err != nil {
+				return nil, err
+			}
+
+		case enumspb.EVENT_TYPE_WORKFLOW_EXECUTION_UPDATE_COMPLETED:
+			if err := b.mutableState.ReplicateWorkflowExecutionUpdateCompletedEvent(
+				event,
+			); err != nil {
+				return nil, err
+			}
+
 		case enumspb.EVENT_TYPE_WORKFLOW_EXECUTION_COMPLETED:
 			if err := b.mutableState.ReplicateWorkflowExecutionCompletedEvent(
 				firstEvent.GetEventId(),
@@ -28,15 +28,20 @@ package history
 
 import (
 	"strconv"
+	"strings"
 	"sync"
 
 	"go.temporal.io/api/serviceerror"
 
+	enumsspb "go.temporal.io/server/api/enums/v1"
+	"go.temporal.io/server/common"
 	"go.temporal.io/server/common/cache"
 	"go.temporal.io/server/common/log"
 	"go.temporal.io/server/common/log/tag"
 	"go.temporal.io/server/common/metrics"
 	"go.temporal.io/server/common/quotas"
+	"go.temporal.io/server/common/service/dynamicconfig"
+	"go.temporal.io/server/common/task"
 )
 
 type (
@@ -62,23 +67,73 @@ const (
 	numBitsPerLevel = 3
 )
 
+// The priority class of a task is given by the tier of its namespace, the class is lowered
+// if the namespace is throttled or not active in the current cluster.
 const (
 	taskHighPriorityClass = iota << numBitsPerLevel
 	taskDefaultPriorityClass
 	taskLowPriorityClass
 )
 
+// The priority subclass of a task is given by its category, retried tasks have their own
+// subclass so they do not delay the first attempt of the tasks of their category.
 const (
-	taskHighPrioritySubclass = iota
-	taskDefaultPrioritySubclass
-	taskLowPrioritySubclass
+	taskWorkflowTaskPrioritySubclass = iota
+	taskActivityPrioritySubclass
+	taskTimerPrioritySubclass
+	taskTransferPrioritySubclass
+	taskVisibilityPrioritySubclass
+	taskArchivalPrioritySubclass
+	taskRetryPrioritySubclass
+	taskReplicationPrioritySubclass
 )
 
-var defaultTaskPriorityWeight = map[int]int{
-	getTaskPriority(taskHighPriorityClass, taskDefaultPrioritySubclass):    200,
-	getTaskPriority(taskDefaultPriorityClass, taskDefaultPrioritySubclass): 100,
-	getTaskPriority(taskLowPriorityClass, taskDefaultPrioritySubclass):     50,
-}
+// Namespaces are in the high tier unless configured otherwise, so the tasks of an unthrottled
+// namespace keep the high priority class.
+const (
+	namespaceTierHigh = iota
+	namespaceTierDefault
+	namespaceTierLow
+)
+
+var (
+	taskPriorityClasses = []int{
+		taskHighPriorityClass,
+		taskDefaultPriorityClass,
+		taskLowPriorityClass,
+	}
+
+	taskPrioritySubclasses = []int{
+		taskWorkflowTaskPrioritySubclass,
+		taskActivityPrioritySubclass,
+		taskTimerPrioritySubclass,
+		taskTransferPrioritySubclass,
+		taskVisibilityPrioritySubclass,
+		taskArchivalPrioritySubclass,
+		taskRetryPrioritySubclass,
+		taskReplicationPrioritySubclass,
+	}
+
+	defaultTaskPriorityClassWeight = map[int]int{
+		taskHighPriorityClass:    200,
+		taskDefaultPriorityClass: 100,
+		taskLowPriorityClass:     50,
+	}
+
+	// defaultTaskPrioritySubclassShare is the share of the weight of the class in quarters
+	defaultTaskPrioritySubclassShare = map[int]int{
+		taskWorkflowTaskPrioritySubclass: 4,
+		taskActivityPrioritySubclass:     3,
+		taskTimerPrioritySubclass:        2,
+		taskTransferPrioritySubclass:     2,
+		taskVisibilityPrioritySubclass:   1,
+		taskArchivalPrioritySubclass:     1,
+		taskRetryPrioritySubclass:        1,
+		taskReplicationPrioritySubclass:  1,
+	}
+
+	defaultTaskPriorityWeight = getDefaultTaskPriorityWeight()
+)
 
 func newTaskPriorityAssigner(
 	currentClusterName string,
@@ -101,10 +156,12 @@ func (a *taskPriorityAssignerImpl) Assign(
 	task queueTask,
 ) error {
 	if task.GetQueueType() == replicationQueueType {
-		task.SetPriority(getTaskPriority(taskLowPriorityClass, taskDefaultPrioritySubclass))
+		task.SetPriority(getTaskPriority(taskLowPriorityClass, taskReplicationPrioritySubclass))
 		return nil
 	}
 
+	subclass := getTaskPrioritySubclass(task)
+
 	// timer of transfer task, first check if namespace is active or not
 	namespace, active, err := a.getNamespaceInfo(task.GetNamespaceId())
 	if err != nil {
@@ -112,12 +169,13 @@ func (a *taskPriorityAssignerImpl) Assign(
 	}
 
 	if !active {
-		task.SetPriority(getTaskPriority(taskLowPriorityClass, taskDefaultPrioritySubclass))
+		task.SetPriority(getTaskPriority(taskLowPriorityClass, subclass))
 		return nil
 	}
 
+	class := getTaskPriorityClass(a.config.TaskPriorityNamespaceTier(namespace))
 	if !a.getRateLimiter(namespace).Allow() {
-		task.SetPriority(getTaskPriority(taskDefaultPriorityClass, taskDefaultPrioritySubclass))
+		task.SetPriority(getTaskPriority(lowerTaskPriorityClass(class), subclass))
 		taggedScope := a.scope.Tagged(metrics.NamespaceTag(namespace))
 		if task.GetQueueType() == transferQueueType {
 			taggedScope.IncCounter(metrics.TransferTaskThrottledCounter)
@@ -127,7 +185,7 @@ func (a *taskPriorityAssignerImpl) Assign(
 		return nil
 	}
 
-	task.SetPriority(getTaskPriority(taskHighPriorityClass, taskDefaultPrioritySubclass))
+	task.SetPriority(getTaskPriority(class, subclass))
 	return nil
 }
 
@@ -188,6 +246,91 @@ func getTaskPriority(
 	return class | subClass
 }
 
+func getTaskPriorityClass(
+	namespaceTier int,
+) int {
+	switch {
+	case namespaceTier <= namespaceTierHigh:
+		return taskHighPriorityClass
+	case namespaceTier == namespaceTierDefault:
+		return taskDefaultPriorityClass
+	default:
+		return taskLowPriorityClass
+	}
+}
+
+func lowerTaskPriorityClass(
+	class int,
+) int {
+	if class >= taskLowPriorityClass {
+		return taskLowPriorityClass
+	}
+	return class + 1<<numBitsPerLevel
+}
+
+func getTaskPrioritySubclass(
+	task queueTask,
+) int {
+	if task.GetAttempt() > 1 {
+		return taskRetryPrioritySubclass
+	}
+
+	switch task.GetTaskType() {
+	case enumsspb.TASK_TYPE_TRANSFER_WORKFLOW_TASK:
+		return taskWorkflowTaskPrioritySubclass
+	case enumsspb.TASK_TYPE_TRANSFER_ACTIVITY_TASK:
+		return taskActivityPrioritySubclass
+	case enumsspb.TASK_TYPE_TRANSFER_RECORD_WORKFLOW_STARTED,
+		enumsspb.TASK_TYPE_TRANSFER_UPSERT_WORKFLOW_SEARCH_ATTRIBUTES:
+		return taskVisibilityPrioritySubclass
+	case enumsspb.TASK_TYPE_DELETE_HISTORY_EVENT:
+		return taskArchivalPrioritySubclass
+	}
+
+	if task.GetQueueType() == timerQueueType {
+		return taskTimerPrioritySubclass
+	}
+	return taskTransferPrioritySubclass
+}
+
+// getTaskFairnessKey groups the tasks of a priority by namespace, so a namespace with a burst of
+// tasks does not delay the tasks of the other namespaces of the same priority class
+func getTaskFairnessKey(
+	priorityTask task.PriorityTask,
+) string {
+	if t, ok := priorityTask.(queueTask); ok {
+		return t.GetNamespaceId()
+	}
+	return ""
+}
+
+func getDefaultTaskPriorityWeight() map[int]int {
+	weights := make(map[int]int)
+	for _, class := range taskPriorityClasses {
+		for _, subclass := range taskPrioritySubclasses {
+			weights[getTaskPriority(class, subclass)] = common.MaxInt(
+				defaultTaskPriorityClassWeight[class]*defaultTaskPrioritySubclassShare[subclass]/4,
+				1,
+			)
+		}
+	}
+	return weights
+}
+
+// withDefaultTaskPriorityWeight fills the priorities missing from the weights given by dynamic config
+// with their default weight, so every priority assigned to a task can be scheduled
+func withDefaultTaskPriorityWeight(
+	weightsFn dynamicconfig.MapPropertyFn,
+) dynamicconfig.MapPropertyFn {
+	return func(opts ...dynamicconfig.FilterOption) map[string]interface{} {
+		weights := convertWeightsToDynamicConfigValue(defaultTaskPriorityWeight)
+		for priority, weight := range weightsFn(opts...) {
+			weights[strings.TrimSpace(priority)] = weight
+		}
+		return weights
+	}
+}
+
 func convertWeightsToDynamicConfigValue(
 	weights map[int]int,
 ) map[string]interface{} {
@@ -34,6 +34,7 @@ import (
 	"github.com/uber-go/tally"
 	"go.temporal.io/api/serviceerror"
 
+	enumsspb "go.temporal.io/server/api/enums/v1"
 	"go.temporal.io/server/common/cache"
 	"go.temporal.io/server/common/cluster"
 	"go.temporal.io/server/common/log"
@@ -141,7 +142,7 @@ func (s *taskPriorityAssignerSuite) TestGetNamespaceInfo_Fail_UnknownError() {
 func (s *taskPriorityAssignerSuite) TestAssign_ReplicationTask() {
 	mockTask := NewMockqueueTask(s.controller)
 	mockTask.EXPECT().GetQueueType().Return(replicationQueueType).Times(1)
-	mockTask.EXPECT().SetPriority(getTaskPriority(taskLowPriorityClass, taskDefaultPrioritySubclass)).Times(1)
+	mockTask.EXPECT().SetPriority(getTaskPriority(taskLowPriorityClass, taskReplicationPrioritySubclass)).Times(1)
 
 	err := s.priorityAssigner.Assign(mockTask)
 	s.NoError(err)
@@ -155,9 +156,11 @@ func (s *taskPriorityAssignerSuite) TestAssign_StandbyTask() {
 	s.mockNamespaceCache.EXPECT().GetNamespaceByID(testNamespaceID).Return(testGlobalNamespaceEntry, nil)
 
 	mockTask := NewMockqueueTask(s.controller)
-	mockTask.EXPECT().GetQueueType().Return(transferQueueType).Times(1)
+	mockTask.EXPECT().GetQueueType().Return(transferQueueType).AnyTimes()
+	mockTask.EXPECT().GetTaskType().Return(enumsspb.TASK_TYPE_TRANSFER_WORKFLOW_TASK).AnyTimes()
+	mockTask.EXPECT().GetAttempt().Return(1).AnyTimes()
 	mockTask.EXPECT().GetNamespaceID().Return(testNamespaceID).Times(1)
-	mockTask.EXPECT().SetPriority(getTaskPriority(taskLowPriorityClass, taskDefaultPrioritySubclass)).Times(1)
+	mockTask.EXPECT().SetPriority(getTaskPriority(taskLowPriorityClass, taskWorkflowTaskPrioritySubclass)).Times(1)
 
 	err := s.priorityAssigner.Assign(mockTask)
 	s.NoError(err)
@@ -168,8 +171,10 @@ func (s *taskPriorityAssignerSuite) TestAssign_TransferTask() {
 
 	mockTask := NewMockqueueTask(s.controller)
 	mockTask.EXPECT().GetQueueType().Return(transferQueueType).AnyTimes()
+	mockTask.EXPECT().GetTaskType().Return(enumsspb.TASK_TYPE_TRANSFER_ACTIVITY_TASK).AnyTimes()
+	mockTask.EXPECT().GetAttempt().Return(1).AnyTimes()
 	mockTask.EXPECT().GetNamespaceID().Return(testNamespaceID).Times(1)
-	mockTask.EXPECT().SetPriority(getTaskPriority(taskHighPriorityClass, taskDefaultPrioritySubclass)).Times(1)
+	mockTask.EXPECT().SetPriority(getTaskPriority(taskHighPriorityClass, taskActivityPrioritySubclass)).Times(1)
 
 	err := s.priorityAssigner.Assign(mockTask)
 	s.NoError(err)
@@ -180,8 +185,58 @@ func (s *taskPriorityAssignerSuite) TestAssign_TimerTask() {
 
 	mockTask := NewMockqueueTask(s.controller)
 	mockTask.EXPECT().GetQueueType().Return(timerQueueType).AnyTimes()
+	mockTask.EXPECT().GetTaskType().Return(enumsspb.TASK_TYPE_USER_TIMER).AnyTimes()
+	mockTask.EXPECT().GetAttempt().Return(1).AnyTimes()
+	mockTask.EXPECT().GetNamespaceID().Return(testNamespaceID).Times(1)
+	mockTask.EXPECT().SetPriority(getTaskPriority(taskHighPriorityClass, taskTimerPrioritySubclass)).Times(1)
+
+	err := s.priorityAssigner.Assign(mockTask)
+	s.NoError(err)
+}
+
+func (s *taskPriorityAssignerSuite) TestAssign_RetriedTask() {
+	s.mockNamespaceCache.EXPECT().GetNamespaceByID(testNamespaceID).Return(testGlobalNamespaceEntry, nil)
+
+	mockTask := NewMockqueueTask(s.controller)
+	mockTask.EXPECT().GetQueueType().Return(timerQueueType).AnyTimes()
+	mockTask.EXPECT().GetTaskType().Return(enumsspb.TASK_TYPE_USER_TIMER).AnyTimes()
+	mockTask.EXPECT().GetAttempt().Return(2).AnyTimes()
 	mockTask.EXPECT().GetNamespaceID().Return(testNamespaceID).Times(1)
-	mockTask.EXPECT().SetPriority(getTaskPriority(taskHighPriorityClass, taskDefaultPrioritySubclass)).Times(1)
+	mockTask.EXPECT().SetPriority(getTaskPriority(taskHighPriorityClass, taskRetryPrioritySubclass)).Times(1)
+
+	err := s.priorityAssigner.Assign(mockTask)
+	s.NoError(err)
+}
+
+func (s *taskPriorityAssignerSuite) TestAssign_NamespaceTier() {
+	s.priorityAssigner.config.TaskPriorityNamespaceTier = dynamicconfig.GetIntPropertyFilteredByNamespace(namespaceTierLow)
+	s.mockNamespaceCache.EXPECT().GetNamespaceByID(testNamespaceID).Return(testGlobalNamespaceEntry, nil)
+
+	mockTask := NewMockqueueTask(s.controller)
+	mockTask.EXPECT().GetQueueType().Return(transferQueueType).AnyTimes()
+	mockTask.EXPECT().GetTaskType().Return(enumsspb.TASK_TYPE_TRANSFER_WORKFLOW_TASK).AnyTimes()
+	mockTask.EXPECT().GetAttempt().Return(1).AnyTimes()
+	mockTask.EXPECT().GetNamespaceID().Return(testNamespaceID).Times(1)
+	mockTask.EXPECT().SetPriority(getTaskPriority(taskLowPriorityClass, taskWorkflowTaskPrioritySubclass)).Times(1)
+
+	err := s.priorityAssigner.Assign(mockTask)
+	s.NoError(err)
+}
+
+func (s *taskPriorityAssignerSuite) TestAssign_DefaultNamespaceTier() {
+	s.Equal(namespaceTierHigh, NewDynamicConfigForTest().TaskPriorityNamespaceTier(testNamespace))
+	s.Equal(taskHighPriorityClass, getTaskPriorityClass(namespaceTierHigh))
+}
+
+func (s *taskPriorityAssignerSuite) TestAssign_CloseExecutionTask() {
+	s.mockNamespaceCache.EXPECT().GetNamespaceByID(testNamespaceID).Return(testGlobalNamespaceEntry, nil)
+
+	mockTask := NewMockqueueTask(s.controller)
+	mockTask.EXPECT().GetQueueType().Return(transferQueueType).AnyTimes()
+	mockTask.EXPECT().GetTaskType().Return(enumsspb.TASK_TYPE_TRANSFER_CLOSE_EXECUTION).AnyTimes()
+	mockTask.EXPECT().GetAttempt().Return(1).AnyTimes()
+	mockTask.EXPECT().GetNamespaceID().Return(testNamespaceID).Times(1)
+	mockTask.EXPECT().SetPriority(getTaskPriority(taskHighPriorityClass, taskTransferPrioritySubclass)).Times(1)
 
 	err := s.priorityAssigner.Assign(mockTask)
 	s.NoError(err)
@@ -193,11 +248,13 @@ func (s *taskPriorityAssignerSuite) TestAssign_ThrottledTask() {
 	for i := 0; i != s.testTaskProcessRPS*2; i++ {
 		mockTask := NewMockqueueTask(s.controller)
 		mockTask.EXPECT().GetQueueType().Return(timerQueueType).AnyTimes()
+		mockTask.EXPECT().GetTaskType().Return(enumsspb.TASK_TYPE_USER_TIMER).AnyTimes()
+		mockTask.EXPECT().GetAttempt().Return(1).AnyTimes()
 		mockTask.EXPECT().GetNamespaceID().Return(testNamespaceID).Times(1)
 		if i < s.testTaskProcessRPS {
-			mockTask.EXPECT().SetPriority(getTaskPriority(taskHighPriorityClass, taskDefaultPrioritySubclass)).Times(1)
+			mockTask.EXPECT().SetPriority(getTaskPriority(taskHighPriorityClass, taskTimerPrioritySubclass)).Times(1)
 		} else {
-			mockTask.EXPECT().SetPriority(getTaskPriority(taskDefaultPriorityClass, taskDefaultPrioritySubclass)).Times(1)
+			mockTask.EXPECT().SetPriority(getTaskPriority(taskDefaultPriorityClass, taskTimerPrioritySubclass)).Times(1)
 		}
 
 		err := s.priorityAssigner.Assign(mockTask)
@@ -213,17 +270,17 @@ func (s *taskPriorityAssignerSuite) TestGetTaskPriority() {
 	}{
 		{
 			class:            taskHighPriorityClass,
-			subClass:         taskDefaultPrioritySubclass,
+			subClass:         taskActivityPrioritySubclass,
 			expectedPriority: 1,
 		},
 		{
 			class:            taskDefaultPriorityClass,
-			subClass:         taskLowPrioritySubclass,
+			subClass:         taskTimerPrioritySubclass,
 			expectedPriority: 10,
 		},
 		{
 			class:            taskLowPriorityClass,
-			subClass:         taskHighPrioritySubclass,
+			subClass:         taskWorkflowTaskPrioritySubclass,
 			expectedPriority: 16,
 		},
 	}
@@ -232,3 +289,38 @@ func (s *taskPriorityAssignerSuite) TestGetTaskPriority() {
 		s.Equal(tc.expectedPriority, getTaskPriority(tc.class, tc.subClass))
 	}
 }
+
+func (s *taskPriorityAssignerSuite) TestLowerTaskPriorityClass() {
+	s.Equal(taskDefaultPriorityClass, lowerTaskPriorityClass(taskHighPriorityClass))
+	s.Equal(taskLowPriorityClass, lowerTaskPriorityClass(taskDefaultPriorityClass))
+	s.Equal(taskLowPriorityClass, lowerTaskPriorityClass(taskLowPriorityClass))
+}
+
+func (s *taskPriorityAssignerSuite) TestDefaultTaskPriorityWeight() {
+	// every priority assigned to a task must have a weight in the scheduler
+	for _, class := range taskPriorityClasses {
+		for _, subclass := range taskPrioritySubclasses {
+			s.Greater(defaultTaskPriorityWeight[getTaskPriority(class, subclass)], 0)
+		}
+	}
+	s.Greater(
+		defaultTaskPriorityWeight[getTaskPriority(taskHighPriorityClass, taskWorkflowTaskPrioritySubclass)],
+		defaultTaskPriorityWeight[getTaskPriority(taskHighPriorityClass, taskTimerPrioritySubclass)],
+	)
+}
+
+func (s *taskPriorityAssignerSuite) TestWithDefaultTaskPriorityWeight() {
+	weightsFn := withDefaultTaskPriorityWeight(func(...dynamicconfig.FilterOption) map[string]interface{} {
+		return map[string]interface{}{
+			" 0": 500,
+			"8":  7,
+		}
+	})
+
+	weights := weightsFn()
+	s.Len(weights, len(defaultTaskPriorityWeight))
+	s.Equal(500, weights["0"])
+	s.Equal(7, weights["8"])
+	s.Equal(defaultTaskPriorityWeight[1], weights["1"])
+	s.Equal(defaultTaskPriorityWeight[16], weights["16"])
+}
@@ -375,7 +375,11 @@ MoveAckLevelLoop:
 		// this means in failover mode, all possible failover timer tasks
 		// are processed and we are free to shutdown
 		t.logger.Debug("Timer ack manager shutdown")
-		t.finishedChan <- struct{}{}
+		select {
+		case t.finishedChan <- struct{}{}:
+		default:
+			// the processor was already notified, e.g. this is the final update when it stops
+		}
 		err := t.timerQueueShutdown()
 		if err != nil {
 			t.logger.Error("Error shutting down timer queue", tag.Error(err))
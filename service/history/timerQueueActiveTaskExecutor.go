@@ -43,6 +43,7 @@ import (
 	"go.temporal.io/server/common/log"
 	"go.temporal.io/server/common/log/tag"
 	"go.temporal.io/server/common/metrics"
+	"go.temporal.io/server/common/persistence"
 	"go.temporal.io/server/common/primitives/timestamp"
 )
 
@@ -101,7 +102,11 @@ func (t *timerQueueActiveTaskExecutor) execute(
 	case enumsspb.TASK_TYPE_WORKFLOW_BACKOFF_TIMER:
 		return t.executeWorkflowBackoffTimerTask(timerTask)
 	case enumsspb.TASK_TYPE_DELETE_HISTORY_EVENT:
-		return t.executeDeleteHistoryEventTask(timerTask)
+		err := t.executeDeleteHistoryEventTask(timerTask)
+		if err == errCloseExecutionTaskPending {
+			return t.delayDeleteHistoryEventTask(timerTask)
+		}
+		return err
 	default:
 		return errUnknownTimerTask
 	}
@@ -179,6 +184,11 @@ func (t *timerQueueActiveTaskExecutor) executeActivityTimeoutTask(
 		return nil
 	}
 
+	if paused, _ := mutableState.IsWorkflowExecutionPaused(); paused {
+		// the activity timer task is regenerated when the workflow execution is unpaused
+		return nil
+	}
+
 	timerSequence := t.getTimerSequence(mutableState)
 	referenceTime := t.shard.GetTimeSource().Now()
 	updateMutableState := false
@@ -574,6 +584,34 @@ func (t *timerQueueActiveTaskExecutor) getTimerSequence(
 	return newTimerSequence(timeSource, mutableState)
 }
 
+// delayDeleteHistoryEventTask replaces a delete history event task which can't be processed yet with
+// one firing after the transfer ack level is persisted again
+func (t *timerQueueActiveTaskExecutor) delayDeleteHistoryEventTask(
+	task *persistenceblobs.TimerTaskInfo,
+) (retError error) {
+
+	weContext, release, err := t.cache.getOrCreateWorkflowExecutionForBackground(t.getNamespaceIDAndWorkflowExecution(task))
+	if err != nil {
+		return err
+	}
+	defer func() { release(retError) }()
+
+	mutableState, err := loadMutableStateForTimerTask(weContext, task, t.metricsClient, t.logger)
+	if err != nil {
+		return err
+	}
+	if mutableState == nil || mutableState.IsWorkflowExecutionRunning() {
+		return nil
+	}
+
+	mutableState.AddTimerTasks(&persistence.DeleteHistoryEventTask{
+		// TaskID is set by shard
+		VisibilityTimestamp: t.shard.GetTimeSource().Now().Add(t.config.TransferProcessorCompleteTransferInterval()),
+		Version:             task.GetVersion(),
+	})
+	return t.updateWorkflowExecution(weContext, mutableState, false)
+}
+
 func (t *timerQueueActiveTaskExecutor) updateWorkflowExecution(
 	context workflowExecutionContext,
 	mutableState mutableState,
@@ -1478,6 +1478,63 @@ func (s *timerQueueActiveTaskExecutorSuite) TestWorkflowTimeout_WorkflowExpired(
 	s.EqualValues(enumspb.WORKFLOW_EXECUTION_STATUS_TIMED_OUT, status)
 }
 
+func (s *timerQueueActiveTaskExecutorSuite) TestDeleteHistoryEvent_CloseExecutionPending() {
+
+	execution := commonpb.WorkflowExecution{
+		WorkflowId: "some random workflow ID",
+		RunId:      uuid.New(),
+	}
+	workflowType := "some random workflow type"
+	taskQueueName := "some random task queue"
+
+	mutableState := newMutableStateBuilderWithVersionHistoriesForTest(s.mockShard, s.mockShard.GetEventsCache(), s.logger, s.version, execution.GetRunId())
+	_, err := mutableState.AddWorkflowExecutionStartedEvent(
+		execution,
+		&historyservice.StartWorkflowExecutionRequest{
+			Attempt:     1,
+			NamespaceId: s.namespaceID,
+			StartRequest: &workflowservice.StartWorkflowExecutionRequest{
+				WorkflowType:             &commonpb.WorkflowType{Name: workflowType},
+				TaskQueue:                &taskqueuepb.TaskQueue{Name: taskQueueName},
+				WorkflowExecutionTimeout: timestamp.DurationPtr(2 * time.Second),
+				WorkflowTaskTimeout:      timestamp.DurationPtr(1 * time.Second),
+			},
+		},
+	)
+	s.Nil(err)
+	event, err := mutableState.AddWorkflowExecutionTerminatedEvent(mutableState.GetNextEventID(), "some random reason", nil, "some random identity")
+	s.Nil(err)
+
+	protoTaskTime := s.now
+	timerTask := &persistenceblobs.TimerTaskInfo{
+		ScheduleAttempt: 1,
+		Version:         s.version,
+		NamespaceId:     s.namespaceID,
+		WorkflowId:      execution.GetWorkflowId(),
+		RunId:           execution.GetRunId(),
+		// above the transfer ack level of the shard, i.e. the close execution task may not be processed yet
+		TaskId:         int64(100),
+		TaskType:       enumsspb.TASK_TYPE_DELETE_HISTORY_EVENT,
+		VisibilityTime: &protoTaskTime,
+		EventId:        event.GetEventId(),
+	}
+
+	persistenceMutableState := s.createPersistenceMutableState(mutableState, event.GetEventId(), event.GetVersion())
+	s.mockExecutionMgr.On("GetWorkflowExecution", mock.Anything).Return(&persistence.GetWorkflowExecutionResponse{State: persistenceMutableState}, nil).Once()
+	// the current version of a closed workflow loaded from persistence is not updated by the transaction
+	s.mockClusterMetadata.EXPECT().ClusterNameForFailoverVersion(common.EmptyVersion).Return(cluster.TestCurrentClusterName).AnyTimes()
+	s.mockExecutionMgr.On("UpdateWorkflowExecution", mock.MatchedBy(func(input *persistence.UpdateWorkflowExecutionRequest) bool {
+		timerTasks := input.UpdateWorkflowMutation.TimerTasks
+		return len(timerTasks) == 1 &&
+			timerTasks[0].GetType() == enumsspb.TASK_TYPE_DELETE_HISTORY_EVENT &&
+			timerTasks[0].GetVisibilityTimestamp().Equal(s.now.Add(s.mockShard.GetConfig().TransferProcessorCompleteTransferInterval()))
+	})).Return(&persistence.UpdateWorkflowExecutionResponse{MutableStateUpdateSessionStats: &persistence.MutableStateUpdateSessionStats{}}, nil).Once()
+
+	// no history or visibility record is deleted
+	err = s.timerQueueActiveTaskExecutor.execute(timerTask, true)
+	s.NoError(err)
+}
+
 func (s *timerQueueActiveTaskExecutorSuite) createPersistenceMutableState(
 	ms mutableState,
 	lastEventID int64,
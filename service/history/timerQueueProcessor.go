@@ -46,6 +46,9 @@ import (
 
 var (
 	errUnknownTimerTask = errors.New("unknown timer task")
+	// errCloseExecutionTaskPending is returned by a delete history event task which must wait for the
+	// transfer tasks of the workflow, including the close execution task, to be acked
+	errCloseExecutionTaskPending = errors.New("close execution transfer task is not acked yet")
 )
 
 type (
@@ -179,6 +179,10 @@ func (t *timerQueueProcessorBase) Stop() {
 	if t.taskProcessor != nil {
 		t.taskProcessor.stop()
 	}
+
+	// persist the ack level of the timers fired so far, so that whoever processes the queue next,
+	// e.g. the new owner of the shard after a handoff, does not load and fire them again
+	t.timerQueueAckMgr.updateAckLevel() // nolint:errcheck
 	t.logger.Info("Timer queue processor stopped.")
 }
 
@@ -106,7 +106,11 @@ func (t *timerQueueStandbyTaskExecutor) execute(
 	case enumsspb.TASK_TYPE_WORKFLOW_BACKOFF_TIMER:
 		return t.executeWorkflowBackoffTimerTask(timerTask)
 	case enumsspb.TASK_TYPE_DELETE_HISTORY_EVENT:
-		return t.executeDeleteHistoryEventTask(timerTask)
+		err := t.executeDeleteHistoryEventTask(timerTask)
+		if err == errCloseExecutionTaskPending {
+			return ErrTaskRetry
+		}
+		return err
 	default:
 		return errUnknownTimerTask
 	}
@@ -710,6 +710,7 @@ func (s *timerQueueStandbyTaskExecutorSuite) TestProcessActivityTimeout_Multiple
 				ExecutionInfo:             mutableState.executionInfo,
 				ExecutionStats:            &persistenceblobs.ExecutionStats{},
 				VersionHistories:          mutableState.versionHistories,
+				ExecutionInfoEncoding:     enumspb.ENCODING_TYPE_PROTO3,
 				TransferTasks:             nil,
 				ReplicationTasks:          nil,
 				TimerTasks:                input.UpdateWorkflowMutation.TimerTasks,
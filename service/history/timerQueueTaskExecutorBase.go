@@ -96,6 +96,15 @@ func (t *timerQueueTaskExecutorBase) executeDeleteHistoryEventTask(
 		return err
 	}
 
+	// timer task IDs of a transaction are allocated after its transfer task IDs, so once the transfer
+	// ack level passed this task the close execution task recorded the closed visibility record, which
+	// would be recreated if it was processed after the deletion
+	if task.GetTaskId() > t.shard.GetTransferAckLevel() {
+		// the cached mutable state is still valid
+		release(nil)
+		return errCloseExecutionTaskPending
+	}
+
 	namespaceCacheEntry, err := t.shard.GetNamespaceCache().GetNamespaceByID(task.GetNamespaceId())
 	if err != nil {
 		return err
@@ -156,6 +156,11 @@ func (t *transferQueueActiveTaskExecutor) processActivityTask(
 		return err
 	}
 
+	if paused, _ := mutableState.IsWorkflowExecutionPaused(); paused {
+		// the activity task is regenerated when the workflow execution is unpaused
+		return nil
+	}
+
 	timeout := timestamp.MinDuration(timestamp.DurationValue(ai.ScheduleToStartTimeout), common.MaxTaskTimeout)
 	// release the context lock since we no longer need mutable state builder and
 	// the rest of logic is making RPC call, which takes time.
@@ -193,6 +198,11 @@ func (t *transferQueueActiveTaskExecutor) processWorkflowTask(
 		return err
 	}
 
+	if paused, _ := mutableState.IsWorkflowExecutionPaused(); paused {
+		// the workflow task is regenerated when the workflow execution is unpaused
+		return nil
+	}
+
 	executionInfo := mutableState.GetExecutionInfo()
 	runTimeoutSeconds := int64(timestamp.DurationValue(executionInfo.WorkflowRunTimeout).Round(time.Second).Seconds())
 	taskTimeoutSeconds := common.MinInt64(runTimeoutSeconds, common.MaxTaskTimeoutSeconds)
@@ -1300,6 +1310,7 @@ func (t *transferQueueActiveTaskExecutor) resetWorkflow(
 		),
 		reason,
 		nil,
+		resetReapplyTypeAll,
 	)
 
 	switch err.(type) {
@@ -0,0 +1,124 @@
+// The MIT License
+//
+// Copyright (c) 2020 Temporal Technologies Inc.  All rights reserved.
+//
+// Copyright (c) 2017 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package history
+
+import (
+	"sync"
+
+	commonpb "go.temporal.io/api/common/v1"
+	failurepb "go.temporal.io/api/failure/v1"
+	"go.temporal.io/api/serviceerror"
+)
+
+// An update is handed to the workflow with the next workflow task, the workflow accepts it with a
+// RespondWorkflowUpdate command, recorded as a WorkflowExecutionUpdateAccepted event, and completes it with
+// another RespondWorkflowUpdate command, recorded as a WorkflowExecutionUpdateCompleted event. A rejected update
+// is completed right away with the failure set.
+const (
+	updateStageAdmitted updateStage = iota
+	updateStageAccepted
+	updateStageCompleted
+)
+
+var (
+	errUpdateAlreadyInStage = serviceerror.NewInternal("update already reached stage")
+)
+
+type (
+	updateStage int
+
+	update interface {
+		getUpdateID() string
+		getStageCh(updateStage) <-chan struct{}
+		getOutcome() *updateOutcome
+		setOutcome(updateStage, *updateOutcome) error
+	}
+
+	updateImpl struct {
+		sync.Mutex
+
+		id          string
+		stage       updateStage
+		acceptedCh  chan struct{}
+		completedCh chan struct{}
+		outcome     *updateOutcome
+	}
+
+	updateOutcome struct {
+		stage   updateStage
+		result  *commonpb.Payloads
+		failure *failurepb.Failure
+		// err is set when the update can't reach the stage anymore, e.g. the workflow completed
+		err error
+	}
+)
+
+func newUpdate(id string) update {
+	return &updateImpl{
+		id:          id,
+		stage:       updateStageAdmitted,
+		acceptedCh:  make(chan struct{}),
+		completedCh: make(chan struct{}),
+	}
+}
+
+func (u *updateImpl) getUpdateID() string {
+	return u.id
+}
+
+func (u *updateImpl) getStageCh(stage updateStage) <-chan struct{} {
+	if stage == updateStageAccepted {
+		return u.acceptedCh
+	}
+	return u.completedCh
+}
+
+func (u *updateImpl) getOutcome() *updateOutcome {
+	u.Lock()
+	defer u.Unlock()
+	return u.outcome
+}
+
+// setOutcome moves the update to the given stage, an update which can't make progress anymore is completed
+// with the error set
+func (u *updateImpl) setOutcome(stage updateStage, outcome *updateOutcome) error {
+	u.Lock()
+	defer u.Unlock()
+
+	if u.stage >= stage {
+		return errUpdateAlreadyInStage
+	}
+	outcome.stage = stage
+	u.outcome = outcome
+	if u.stage < updateStageAccepted {
+		close(u.acceptedCh)
+	}
+	u.stage = stage
+	if stage == updateStageCompleted || outcome.err != nil {
+		u.stage = updateStageCompleted
+		close(u.completedCh)
+	}
+	return nil
+}
@@ -0,0 +1,209 @@
+// The MIT License
+//
+// Copyright (c) 2020 Temporal Technologies Inc.  All rights reserved.
+//
+// Copyright (c) 2017 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package history
+
+import (
+	"sync"
+
+	commonpb "go.temporal.io/api/common/v1"
+	failurepb "go.temporal.io/api/failure/v1"
+	"go.temporal.io/api/serviceerror"
+	workflowpb "go.temporal.io/api/workflow/v1"
+)
+
+var (
+	errUpdateNotExists = serviceerror.NewInternal("update does not exist")
+)
+
+type (
+	// updateRegistry tracks the updates of a workflow which are in flight, an update is removed once it is
+	// completed or failed, waiters keep their reference to the update and observe its outcome. The requests of
+	// updates which are not accepted yet are handed to the workflow with every workflow task started.
+	updateRegistry interface {
+		hasPendingUpdate() bool
+		hasUndeliveredUpdate() bool
+		getPendingIDs() []string
+
+		admitUpdate(request *workflowpb.WorkflowUpdateRequest) update
+		watchUpdate(id string) update
+		getUpdate(id string) (update, error)
+		getUpdateRequest(id string) (*workflowpb.WorkflowUpdateRequest, error)
+		deliverUpdates() []*workflowpb.WorkflowUpdateRequest
+
+		acceptUpdate(id string) error
+		completeUpdate(id string, result *commonpb.Payloads, failure *failurepb.Failure) error
+		failPendingUpdates(err error)
+		removeUpdate(id string)
+	}
+
+	updateRegistryImpl struct {
+		sync.RWMutex
+
+		updates map[string]update
+		// requests of the admitted updates, removed once the update is accepted or rejected
+		requests map[string]*workflowpb.WorkflowUpdateRequest
+		// admitted updates which were not handed to a workflow task yet
+		undelivered map[string]struct{}
+	}
+)
+
+func newUpdateRegistry() updateRegistry {
+	return &updateRegistryImpl{
+		updates:     make(map[string]update),
+		requests:    make(map[string]*workflowpb.WorkflowUpdateRequest),
+		undelivered: make(map[string]struct{}),
+	}
+}
+
+func (r *updateRegistryImpl) hasPendingUpdate() bool {
+	r.RLock()
+	defer r.RUnlock()
+	return len(r.updates) > 0
+}
+
+func (r *updateRegistryImpl) hasUndeliveredUpdate() bool {
+	r.RLock()
+	defer r.RUnlock()
+	return len(r.undelivered) > 0
+}
+
+func (r *updateRegistryImpl) getPendingIDs() []string {
+	r.RLock()
+	defer r.RUnlock()
+	result := make([]string, 0, len(r.updates))
+	for id := range r.updates {
+		result = append(result, id)
+	}
+	return result
+}
+
+// admitUpdate registers a new update, callers admitting an update ID which is already in flight share it
+func (r *updateRegistryImpl) admitUpdate(request *workflowpb.WorkflowUpdateRequest) update {
+	r.Lock()
+	defer r.Unlock()
+	id := request.GetUpdateId()
+	if u, ok := r.updates[id]; ok {
+		return u
+	}
+	u := newUpdate(id)
+	r.updates[id] = u
+	r.requests[id] = request
+	r.undelivered[id] = struct{}{}
+	return u
+}
+
+// watchUpdate returns the update the workflow already accepted, the registry does not survive a reload of the
+// mutable state so the update is registered again in the accepted stage
+func (r *updateRegistryImpl) watchUpdate(id string) update {
+	r.Lock()
+	defer r.Unlock()
+	if u, ok := r.updates[id]; ok {
+		return u
+	}
+	u := newUpdate(id)
+	_ = u.setOutcome(updateStageAccepted, &updateOutcome{})
+	r.updates[id] = u
+	return u
+}
+
+func (r *updateRegistryImpl) getUpdate(id string) (update, error) {
+	r.RLock()
+	defer r.RUnlock()
+	u, ok := r.updates[id]
+	if !ok {
+		return nil, errUpdateNotExists
+	}
+	return u, nil
+}
+
+// getUpdateRequest returns the request of an update which is admitted but not accepted yet
+func (r *updateRegistryImpl) getUpdateRequest(id string) (*workflowpb.WorkflowUpdateRequest, error) {
+	r.RLock()
+	defer r.RUnlock()
+	request, ok := r.requests[id]
+	if !ok {
+		return nil, errUpdateNotExists
+	}
+	return request, nil
+}
+
+// deliverUpdates returns the requests of all updates which are not accepted yet, an update handed to a workflow
+// task which failed or timed out is handed to the next one again
+func (r *updateRegistryImpl) deliverUpdates() []*workflowpb.WorkflowUpdateRequest {
+	r.Lock()
+	defer r.Unlock()
+	var result []*workflowpb.WorkflowUpdateRequest
+	for id, request := range r.requests {
+		result = append(result, request)
+		delete(r.undelivered, id)
+	}
+	return result
+}
+
+func (r *updateRegistryImpl) acceptUpdate(id string) error {
+	u, err := r.getUpdate(id)
+	if err != nil {
+		return err
+	}
+	if err := u.setOutcome(updateStageAccepted, &updateOutcome{}); err != nil {
+		return err
+	}
+	r.Lock()
+	defer r.Unlock()
+	delete(r.requests, id)
+	delete(r.undelivered, id)
+	return nil
+}
+
+func (r *updateRegistryImpl) completeUpdate(id string, result *commonpb.Payloads, failure *failurepb.Failure) error {
+	u, err := r.getUpdate(id)
+	if err != nil {
+		return err
+	}
+	if err := u.setOutcome(updateStageCompleted, &updateOutcome{result: result, failure: failure}); err != nil {
+		return err
+	}
+	r.removeUpdate(id)
+	return nil
+}
+
+func (r *updateRegistryImpl) failPendingUpdates(err error) {
+	r.Lock()
+	defer r.Unlock()
+	for id, u := range r.updates {
+		_ = u.setOutcome(updateStageCompleted, &updateOutcome{err: err})
+		delete(r.updates, id)
+	}
+	r.requests = make(map[string]*workflowpb.WorkflowUpdateRequest)
+	r.undelivered = make(map[string]struct{})
+}
+
+func (r *updateRegistryImpl) removeUpdate(id string) {
+	r.Lock()
+	defer r.Unlock()
+	delete(r.updates, id)
+	delete(r.requests, id)
+	delete(r.undelivered, id)
+}
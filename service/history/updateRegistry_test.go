@@ -0,0 +1,153 @@
+// The MIT License
+//
+// Copyright (c) 2020 Temporal Technologies Inc.  All rights reserved.
+//
+// Copyright (c) 2017 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package history
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"github.com/stretchr/testify/suite"
+	failurepb "go.temporal.io/api/failure/v1"
+	workflowpb "go.temporal.io/api/workflow/v1"
+
+	"go.temporal.io/server/common/payloads"
+)
+
+type UpdateRegistrySuite struct {
+	suite.Suite
+	*require.Assertions
+}
+
+func TestUpdateRegistrySuite(t *testing.T) {
+	suite.Run(t, new(UpdateRegistrySuite))
+}
+
+func (s *UpdateRegistrySuite) SetupTest() {
+	s.Assertions = require.New(s.T())
+}
+
+func (s *UpdateRegistrySuite) TestAdmitUpdate_Join() {
+	ur := newUpdateRegistry()
+	u1 := ur.admitUpdate(&workflowpb.WorkflowUpdateRequest{UpdateId: "update-id", Name: "update-name"})
+	u2 := ur.admitUpdate(&workflowpb.WorkflowUpdateRequest{UpdateId: "update-id", Name: "other-update-name"})
+	s.Equal(u1, u2)
+	s.True(ur.hasPendingUpdate())
+	s.Equal([]string{"update-id"}, ur.getPendingIDs())
+	request, err := ur.getUpdateRequest("update-id")
+	s.NoError(err)
+	s.Equal("update-name", request.GetName())
+
+	_, err = ur.getUpdate("other-update-id")
+	s.Equal(errUpdateNotExists, err)
+}
+
+func (s *UpdateRegistrySuite) TestDeliverUpdates() {
+	ur := newUpdateRegistry()
+	request := &workflowpb.WorkflowUpdateRequest{UpdateId: "update-id", Name: "update-name"}
+	ur.admitUpdate(request)
+	s.True(ur.hasUndeliveredUpdate())
+
+	s.Equal([]*workflowpb.WorkflowUpdateRequest{request}, ur.deliverUpdates())
+	s.False(ur.hasUndeliveredUpdate())
+	// the update is handed out again until it is accepted
+	s.Equal([]*workflowpb.WorkflowUpdateRequest{request}, ur.deliverUpdates())
+
+	s.NoError(ur.acceptUpdate("update-id"))
+	s.Empty(ur.deliverUpdates())
+	_, err := ur.getUpdateRequest("update-id")
+	s.Equal(errUpdateNotExists, err)
+}
+
+func (s *UpdateRegistrySuite) TestAcceptAndComplete() {
+	ur := newUpdateRegistry()
+	u := ur.admitUpdate(&workflowpb.WorkflowUpdateRequest{UpdateId: "update-id"})
+	s.assertChanState(false, u.getStageCh(updateStageAccepted), u.getStageCh(updateStageCompleted))
+
+	s.NoError(ur.acceptUpdate("update-id"))
+	s.assertChanState(true, u.getStageCh(updateStageAccepted))
+	s.assertChanState(false, u.getStageCh(updateStageCompleted))
+	s.Equal(updateStageAccepted, u.getOutcome().stage)
+	s.True(ur.hasPendingUpdate())
+	s.Equal(errUpdateAlreadyInStage, ur.acceptUpdate("update-id"))
+
+	result := payloads.EncodeString("result")
+	s.NoError(ur.completeUpdate("update-id", result, nil))
+	s.assertChanState(true, u.getStageCh(updateStageCompleted))
+	s.Equal(updateStageCompleted, u.getOutcome().stage)
+	s.Equal(result, u.getOutcome().result)
+	s.False(ur.hasPendingUpdate())
+	s.Equal(errUpdateNotExists, ur.completeUpdate("update-id", result, nil))
+}
+
+func (s *UpdateRegistrySuite) TestRejectUpdate() {
+	ur := newUpdateRegistry()
+	u := ur.admitUpdate(&workflowpb.WorkflowUpdateRequest{UpdateId: "update-id"})
+	failure := &failurepb.Failure{Message: "rejected"}
+	s.NoError(ur.completeUpdate("update-id", nil, failure))
+	s.assertChanState(true, u.getStageCh(updateStageAccepted), u.getStageCh(updateStageCompleted))
+	s.Equal(failure, u.getOutcome().failure)
+	s.False(ur.hasPendingUpdate())
+	s.False(ur.hasUndeliveredUpdate())
+}
+
+func (s *UpdateRegistrySuite) TestWatchUpdate() {
+	ur := newUpdateRegistry()
+	u := ur.watchUpdate("update-id")
+	s.assertChanState(true, u.getStageCh(updateStageAccepted))
+	s.assertChanState(false, u.getStageCh(updateStageCompleted))
+	s.False(ur.hasUndeliveredUpdate())
+	s.Empty(ur.deliverUpdates())
+	s.Equal(u, ur.watchUpdate("update-id"))
+
+	s.NoError(ur.completeUpdate("update-id", payloads.EncodeString("result"), nil))
+	s.assertChanState(true, u.getStageCh(updateStageCompleted))
+}
+
+func (s *UpdateRegistrySuite) TestFailPendingUpdates() {
+	ur := newUpdateRegistry()
+	u1 := ur.admitUpdate(&workflowpb.WorkflowUpdateRequest{UpdateId: "update-id-1"})
+	u2 := ur.admitUpdate(&workflowpb.WorkflowUpdateRequest{UpdateId: "update-id-2"})
+	s.NoError(ur.acceptUpdate("update-id-2"))
+
+	testErr := errors.New("workflow completed")
+	ur.failPendingUpdates(testErr)
+	s.assertChanState(true, u1.getStageCh(updateStageCompleted), u2.getStageCh(updateStageCompleted))
+	s.Equal(testErr, u1.getOutcome().err)
+	s.Equal(testErr, u2.getOutcome().err)
+	s.False(ur.hasPendingUpdate())
+	s.False(ur.hasUndeliveredUpdate())
+}
+
+func (s *UpdateRegistrySuite) assertChanState(expectedClosed bool, chans ...<-chan struct{}) {
+	for _, ch := range chans {
+		select {
+		case <-ch:
+			s.True(expectedClosed)
+		default:
+			s.False(expectedClosed)
+		}
+	}
+}
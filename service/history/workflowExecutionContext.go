@@ -667,6 +667,8 @@ func (c *workflowExecutionContextImpl) updateWorkflowExecutionWithNew(
 		return err
 	}
 
+	previousHistorySize := c.getHistorySize()
+	previousHistoryCount := c.updateCondition - common.FirstEventID
 	currentWorkflowSize := c.getHistorySize()
 	for _, workflowEvents := range currentWorkflowEventsSeq {
 		eventsSize, err := c.persistNonFirstWorkflowEvents(workflowEvents)
@@ -791,6 +793,12 @@ func (c *workflowExecutionContextImpl) updateWorkflowExecutionWithNew(
 		int(c.stats.HistorySize),
 		int(c.mutableState.GetNextEventID()-1),
 	)
+	// count the workflows crossing the warn limit, from now on they are suggested to continue as new
+	if currentWorkflowTransactionPolicy == transactionPolicyActive &&
+		!historyLimitWarnExceeded(c.config, namespace, previousHistorySize, previousHistoryCount) &&
+		historyLimitWarnExceeded(c.config, namespace, c.stats.HistorySize, c.mutableState.GetNextEventID()-common.FirstEventID) {
+		c.metricsClient.Scope(metrics.WorkflowContextScope, metrics.NamespaceTag(namespace)).IncCounter(metrics.HistoryLimitWarnExceededCounter)
+	}
 	emitSessionUpdateStats(
 		c.metricsClient,
 		namespace,
@@ -1172,10 +1180,9 @@ func (c *workflowExecutionContextImpl) reapplyEvents(
 
 // Returns true if execution is forced terminated
 func (c *workflowExecutionContextImpl) enforceSizeCheck() (bool, error) {
-	historySizeLimitWarn := c.config.HistorySizeLimitWarn(c.getNamespace())
-	historySizeLimitError := c.config.HistorySizeLimitError(c.getNamespace())
-	historyCountLimitWarn := c.config.HistoryCountLimitWarn(c.getNamespace())
-	historyCountLimitError := c.config.HistoryCountLimitError(c.getNamespace())
+	namespace := c.getNamespace()
+	historySizeLimitError := c.config.HistorySizeLimitError(namespace)
+	historyCountLimitError := c.config.HistoryCountLimitError(namespace)
 
 	historySize := int(c.stats.HistorySize)
 	historyCount := int(c.mutableState.GetNextEventID() - 1)
@@ -1216,7 +1223,7 @@ func (c *workflowExecutionContextImpl) enforceSizeCheck() (bool, error) {
 		return true, nil
 	}
 
-	if historySize > historySizeLimitWarn || historyCount > historyCountLimitWarn {
+	if historyLimitWarnExceeded(c.config, namespace, int64(historySize), int64(historyCount)) {
 		executionInfo := c.mutableState.GetExecutionInfo()
 		c.logger.Warn("history size exceeds warn limit.",
 			tag.WorkflowNamespaceID(executionInfo.NamespaceId),
@@ -1228,3 +1235,16 @@ func (c *workflowExecutionContextImpl) enforceSizeCheck() (bool, error) {
 
 	return false, nil
 }
+
+// historyLimitWarnExceeded returns true if the history size or event count of a workflow exceeds the warn limit
+// of its namespace, the workflow is then suggested to continue as new before it is terminated at the error limit
+func historyLimitWarnExceeded(
+	config *Config,
+	namespace string,
+	historySize int64,
+	historyCount int64,
+) bool {
+
+	return historySize > int64(config.HistorySizeLimitWarn(namespace)) ||
+		historyCount > int64(config.HistoryCountLimitWarn(namespace))
+}
@@ -30,11 +30,15 @@ import (
 	"context"
 	"fmt"
 
+	"github.com/pborman/uuid"
 	commonpb "go.temporal.io/api/common/v1"
 	enumspb "go.temporal.io/api/enums/v1"
 	historypb "go.temporal.io/api/history/v1"
 	"go.temporal.io/api/serviceerror"
+	workflowpb "go.temporal.io/api/workflow/v1"
 
+	"go.temporal.io/server/api/historyservice/v1"
+	"go.temporal.io/server/api/persistenceblobs/v1"
 	"go.temporal.io/server/common"
 	"go.temporal.io/server/common/cache"
 	"go.temporal.io/server/common/cluster"
@@ -47,6 +51,16 @@ import (
 	"go.temporal.io/server/common/primitives/timestamp"
 )
 
+const (
+	// resetReapplyTypeAll reapplies all events received after the reset point, it is the default
+	resetReapplyTypeAll resetReapplyType = iota
+	// resetReapplyTypeExcludeSignals reapplies the events other than signals, signals are the only events
+	// reapplied so far
+	resetReapplyTypeExcludeSignals
+	// resetReapplyTypeNone reapplies no events
+	resetReapplyTypeNone
+)
+
 type (
 	workflowResetter interface {
 		// resetWorkflow is the new NDC compatible workflow reset logic
@@ -64,7 +78,33 @@ type (
 			currentWorkflow nDCWorkflow,
 			resetReason string,
 			additionalReapplyEvents []*historypb.HistoryEvent,
+			reapplyType resetReapplyType,
 		) error
+		// previewResetWorkflow runs the same logic as resetWorkflow without persisting anything and returns
+		// what the reset would change
+		previewResetWorkflow(
+			ctx context.Context,
+			namespaceID string,
+			workflowID string,
+			baseRunID string,
+			baseBranchToken []byte,
+			baseRebuildLastEventID int64,
+			baseRebuildLastEventVersion int64,
+			baseNextEventID int64,
+			currentWorkflow nDCWorkflow,
+			reapplyType resetReapplyType,
+		) (*historyservice.ResetWorkflowExecutionPreview, error)
+	}
+
+	// resetReapplyType selects the events received after the reset point which are reapplied to the reset run
+	resetReapplyType int
+
+	// resetCurrentRun is the persisted history of the current run, the run stays locked during the reset
+	// so its events are reapplied without loading it through the history cache again
+	resetCurrentRun struct {
+		runID       string
+		nextEventID int64
+		branchToken []byte
 	}
 
 	nDCStateRebuilderProvider func() nDCStateRebuilder
@@ -114,6 +154,7 @@ func (r *workflowResetterImpl) resetWorkflow(
 	currentWorkflow nDCWorkflow,
 	resetReason string,
 	additionalReapplyEvents []*historypb.HistoryEvent,
+	reapplyType resetReapplyType,
 ) (retError error) {
 
 	namespaceEntry, err := r.namespaceCache.GetNamespaceByID(namespaceID)
@@ -123,6 +164,10 @@ func (r *workflowResetterImpl) resetWorkflow(
 	resetWorkflowVersion := namespaceEntry.GetFailoverVersion()
 
 	currentMutableState := currentWorkflow.getMutableState()
+	currentRun, err := newResetCurrentRun(currentMutableState)
+	if err != nil {
+		return err
+	}
 	currentWorkflowTerminated := false
 	if currentMutableState.IsWorkflowExecutionRunning() {
 		if err := r.terminateWorkflow(
@@ -149,6 +194,9 @@ func (r *workflowResetterImpl) resetWorkflow(
 		resetWorkflowVersion,
 		resetReason,
 		additionalReapplyEvents,
+		currentRun,
+		reapplyType,
+		nil,
 	)
 	if err != nil {
 		return err
@@ -162,6 +210,78 @@ func (r *workflowResetterImpl) resetWorkflow(
 	)
 }
 
+func (r *workflowResetterImpl) previewResetWorkflow(
+	ctx context.Context,
+	namespaceID string,
+	workflowID string,
+	baseRunID string,
+	baseBranchToken []byte,
+	baseRebuildLastEventID int64,
+	baseRebuildLastEventVersion int64,
+	baseNextEventID int64,
+	currentWorkflow nDCWorkflow,
+	reapplyType resetReapplyType,
+) (*historyservice.ResetWorkflowExecutionPreview, error) {
+
+	namespaceEntry, err := r.namespaceCache.GetNamespaceByID(namespaceID)
+	if err != nil {
+		return nil, err
+	}
+	resetWorkflowVersion := namespaceEntry.GetFailoverVersion()
+
+	preview := &historyservice.ResetWorkflowExecutionPreview{
+		BaseRunId:                 baseRunID,
+		WorkflowTaskFinishEventId: baseRebuildLastEventID + 1,
+	}
+	currentMutableState := currentWorkflow.getMutableState()
+	currentRun, err := newResetCurrentRun(currentMutableState)
+	if err != nil {
+		return nil, err
+	}
+	if currentMutableState.IsWorkflowExecutionRunning() {
+		// the current run is not terminated by a preview, terminating it would not change its version
+		preview.TerminatedRunId = currentRun.runID
+		resetWorkflowVersion = currentMutableState.GetCurrentVersion()
+	}
+
+	iter := collection.NewPagingIterator(r.getPaginationFn(
+		baseRebuildLastEventID+1,
+		baseNextEventID,
+		baseBranchToken,
+	))
+	for iter.HasNext() {
+		batch, err := iter.Next()
+		if err != nil {
+			return nil, err
+		}
+		preview.DroppedEvents = append(preview.DroppedEvents, batch.(*historypb.History).Events...)
+	}
+
+	if _, err := r.prepareResetWorkflow(
+		ctx,
+		namespaceID,
+		workflowID,
+		baseRunID,
+		baseBranchToken,
+		baseRebuildLastEventID,
+		baseRebuildLastEventVersion,
+		baseNextEventID,
+		uuid.New(),
+		uuid.New(),
+		resetWorkflowVersion,
+		"",
+		nil,
+		currentRun,
+		reapplyType,
+		preview,
+	); err != nil {
+		return nil, err
+	}
+	return preview, nil
+}
+
+// prepareResetWorkflow builds the mutable state of the reset run, a non nil preview makes it a dry run which
+// leaves the history of the base run untouched and records what the reset changes in the preview
 func (r *workflowResetterImpl) prepareResetWorkflow(
 	ctx context.Context,
 	namespaceID string,
@@ -176,6 +296,9 @@ func (r *workflowResetterImpl) prepareResetWorkflow(
 	resetWorkflowVersion int64,
 	resetReason string,
 	additionalReapplyEvents []*historypb.HistoryEvent,
+	currentRun resetCurrentRun,
+	reapplyType resetReapplyType,
+	preview *historyservice.ResetWorkflowExecutionPreview,
 ) (nDCWorkflow, error) {
 
 	resetWorkflow, err := r.replayResetWorkflow(
@@ -188,6 +311,7 @@ func (r *workflowResetterImpl) prepareResetWorkflow(
 		baseRebuildLastEventVersion,
 		resetRunID,
 		resetRequestID,
+		preview != nil,
 	)
 	if err != nil {
 		return nil, err
@@ -218,8 +342,11 @@ func (r *workflowResetterImpl) prepareResetWorkflow(
 	if !ok || workflowTask.StartedID+1 != resetMutableState.GetNextEventID() {
 		return nil, serviceerror.NewInvalidArgument(fmt.Sprintf("Can only reset workflow to WorkflowTaskStarted + 1: %v", baseRebuildLastEventID+1))
 	}
-	if len(resetMutableState.GetPendingChildExecutionInfos()) > 0 {
-		return nil, serviceerror.NewInvalidArgument(fmt.Sprintf("Can only reset workflow with pending child workflows"))
+	if pendingChildren := resetMutableState.GetPendingChildExecutionInfos(); len(pendingChildren) > 0 {
+		if preview == nil {
+			return nil, serviceerror.NewInvalidArgument("Cannot reset workflow with pending child workflows.")
+		}
+		preview.PendingChildren = describePendingChildren(pendingChildren)
 	}
 
 	resetFailure := failure.NewResetWorkflowFailure(resetReason, nil)
@@ -237,11 +364,16 @@ func (r *workflowResetterImpl) prepareResetWorkflow(
 		return nil, err
 	}
 
+	if preview != nil {
+		if preview.FailedActivities, err = describeInflightActivities(resetMutableState); err != nil {
+			return nil, err
+		}
+	}
 	if err := r.failInflightActivity(resetMutableState, resetReason); err != nil {
 		return nil, err
 	}
 
-	if err := r.reapplyContinueAsNewWorkflowEvents(
+	reappliedEvents, err := r.reapplyContinueAsNewWorkflowEvents(
 		ctx,
 		resetMutableState,
 		namespaceID,
@@ -250,13 +382,20 @@ func (r *workflowResetterImpl) prepareResetWorkflow(
 		baseBranchToken,
 		baseRebuildLastEventID+1,
 		baseNextEventID,
-	); err != nil {
+		currentRun,
+		reapplyType,
+	)
+	if err != nil {
 		return nil, err
 	}
 
-	if err := r.reapplyEvents(resetMutableState, additionalReapplyEvents); err != nil {
+	additionalReappliedEvents, err := r.reapplyEvents(resetMutableState, additionalReapplyEvents, reapplyType)
+	if err != nil {
 		return nil, err
 	}
+	if preview != nil {
+		preview.ReappliedEvents = append(reappliedEvents, additionalReappliedEvents...)
+	}
 
 	if err := scheduleWorkflowTask(resetMutableState); err != nil {
 		return nil, err
@@ -319,17 +458,22 @@ func (r *workflowResetterImpl) replayResetWorkflow(
 	baseRebuildLastEventVersion int64,
 	resetRunID string,
 	resetRequestID string,
+	dryRun bool,
 ) (nDCWorkflow, error) {
 
-	resetBranchToken, err := r.forkAndGenerateBranchToken(
-		namespaceID,
-		workflowID,
-		baseBranchToken,
-		baseRebuildLastEventID+1,
-		resetRunID,
-	)
-	if err != nil {
-		return nil, err
+	// a dry run replays the base branch without forking it, the rebuilt mutable state is never persisted
+	resetBranchToken := baseBranchToken
+	if !dryRun {
+		var err error
+		if resetBranchToken, err = r.forkAndGenerateBranchToken(
+			namespaceID,
+			workflowID,
+			baseBranchToken,
+			baseRebuildLastEventID+1,
+			resetRunID,
+		); err != nil {
+			return nil, err
+		}
 	}
 
 	resetContext := newWorkflowExecutionContext(
@@ -404,6 +548,51 @@ func (r *workflowResetterImpl) failInflightActivity(
 	return nil
 }
 
+func describeInflightActivities(
+	mutableState mutableState,
+) ([]*workflowpb.PendingActivityInfo, error) {
+
+	var activities []*workflowpb.PendingActivityInfo
+	for _, ai := range mutableState.GetPendingActivityInfos() {
+		if ai.StartedId == common.EmptyEventID {
+			// activity not started, it is not failed by the reset
+			continue
+		}
+		scheduledEvent, err := mutableState.GetActivityScheduledEvent(ai.ScheduleId)
+		if err != nil {
+			return nil, err
+		}
+		activities = append(activities, &workflowpb.PendingActivityInfo{
+			ActivityId:         ai.ActivityId,
+			ActivityType:       scheduledEvent.GetActivityTaskScheduledEventAttributes().GetActivityType(),
+			State:              enumspb.PENDING_ACTIVITY_STATE_STARTED,
+			HeartbeatDetails:   ai.LastHeartbeatDetails,
+			LastHeartbeatTime:  ai.LastHeartbeatUpdateTime,
+			LastStartedTime:    ai.StartedTime,
+			Attempt:            ai.Attempt,
+			LastWorkerIdentity: ai.StartedIdentity,
+		})
+	}
+	return activities, nil
+}
+
+func describePendingChildren(
+	childInfos map[int64]*persistenceblobs.ChildExecutionInfo,
+) []*workflowpb.PendingChildExecutionInfo {
+
+	var children []*workflowpb.PendingChildExecutionInfo
+	for _, ci := range childInfos {
+		children = append(children, &workflowpb.PendingChildExecutionInfo{
+			WorkflowId:        ci.StartedWorkflowId,
+			RunId:             ci.StartedRunId,
+			WorkflowTypeName:  ci.WorkflowTypeName,
+			InitiatedId:       ci.InitiatedId,
+			ParentClosePolicy: ci.ParentClosePolicy,
+		})
+	}
+	return children
+}
+
 func (r *workflowResetterImpl) forkAndGenerateBranchToken(
 	namespaceID string,
 	workflowID string,
@@ -450,25 +639,33 @@ func (r *workflowResetterImpl) reapplyContinueAsNewWorkflowEvents(
 	baseBranchToken []byte,
 	baseRebuildNextEventID int64,
 	baseNextEventID int64,
-) error {
+	currentRun resetCurrentRun,
+	reapplyType resetReapplyType,
+) ([]*historypb.HistoryEvent, error) {
 
 	// TODO change this logic to fetching all workflow [baseWorkflow, currentWorkflow]
 	//  from visibility for better coverage of events eligible for re-application.
 
 	var nextRunID string
+	var reappliedEvents []*historypb.HistoryEvent
 	var err error
 
 	// first special handling the remaining events for base workflow
-	if nextRunID, err = r.reapplyWorkflowEvents(
+	if nextRunID, reappliedEvents, err = r.reapplyWorkflowEvents(
 		resetMutableState,
 		baseRebuildNextEventID,
 		baseNextEventID,
 		baseBranchToken,
+		reapplyType,
 	); err != nil {
-		return err
+		return nil, err
 	}
 
 	getNextEventIDBranchToken := func(runID string) (nextEventID int64, branchToken []byte, retError error) {
+		if runID == currentRun.runID {
+			return currentRun.nextEventID, currentRun.branchToken, nil
+		}
+
 		context, release, err := r.historyCache.getOrCreateWorkflowExecution(
 			ctx,
 			namespaceID,
@@ -500,19 +697,22 @@ func (r *workflowResetterImpl) reapplyContinueAsNewWorkflowEvents(
 	for len(nextRunID) != 0 {
 		nextWorkflowNextEventID, nextWorkflowBranchToken, err := getNextEventIDBranchToken(nextRunID)
 		if err != nil {
-			return err
+			return nil, err
 		}
 
-		if nextRunID, err = r.reapplyWorkflowEvents(
+		var events []*historypb.HistoryEvent
+		if nextRunID, events, err = r.reapplyWorkflowEvents(
 			resetMutableState,
 			common.FirstEventID,
 			nextWorkflowNextEventID,
 			nextWorkflowBranchToken,
+			reapplyType,
 		); err != nil {
-			return err
+			return nil, err
 		}
+		reappliedEvents = append(reappliedEvents, events...)
 	}
-	return nil
+	return reappliedEvents, nil
 }
 
 func (r *workflowResetterImpl) reapplyWorkflowEvents(
@@ -520,7 +720,8 @@ func (r *workflowResetterImpl) reapplyWorkflowEvents(
 	firstEventID int64,
 	nextEventID int64,
 	branchToken []byte,
-) (string, error) {
+	reapplyType resetReapplyType,
+) (string, []*historypb.HistoryEvent, error) {
 
 	// TODO change this logic to fetching all workflow [baseWorkflow, currentWorkflow]
 	//  from visibility for better coverage of events eligible for re-application.
@@ -534,16 +735,19 @@ func (r *workflowResetterImpl) reapplyWorkflowEvents(
 
 	var nextRunID string
 	var lastEvents []*historypb.HistoryEvent
+	var reappliedEvents []*historypb.HistoryEvent
 
 	for iter.HasNext() {
 		batch, err := iter.Next()
 		if err != nil {
-			return "", err
+			return "", nil, err
 		}
 		lastEvents = batch.(*historypb.History).Events
-		if err := r.reapplyEvents(mutableState, lastEvents); err != nil {
-			return "", err
+		events, err := r.reapplyEvents(mutableState, lastEvents, reapplyType)
+		if err != nil {
+			return "", nil, err
 		}
+		reappliedEvents = append(reappliedEvents, events...)
 	}
 
 	if len(lastEvents) > 0 {
@@ -552,30 +756,56 @@ func (r *workflowResetterImpl) reapplyWorkflowEvents(
 			nextRunID = lastEvent.GetWorkflowExecutionContinuedAsNewEventAttributes().GetNewExecutionRunId()
 		}
 	}
-	return nextRunID, nil
+	return nextRunID, reappliedEvents, nil
 }
 
+// reapplyEvents reapplies the events selected by reapplyType and returns them
 func (r *workflowResetterImpl) reapplyEvents(
 	mutableState mutableState,
 	events []*historypb.HistoryEvent,
-) error {
+	reapplyType resetReapplyType,
+) ([]*historypb.HistoryEvent, error) {
 
+	if reapplyType == resetReapplyTypeNone {
+		return nil, nil
+	}
+
+	var reappliedEvents []*historypb.HistoryEvent
 	for _, event := range events {
 		switch event.GetEventType() {
 		case enumspb.EVENT_TYPE_WORKFLOW_EXECUTION_SIGNALED:
 			attr := event.GetWorkflowExecutionSignaledEventAttributes()
+			if reapplyType == resetReapplyTypeExcludeSignals {
+				continue
+			}
 			if _, err := mutableState.AddWorkflowExecutionSignaled(
 				attr.GetSignalName(),
 				attr.GetInput(),
 				attr.GetIdentity(),
 			); err != nil {
-				return err
+				return nil, err
 			}
+			reappliedEvents = append(reappliedEvents, event)
 		default:
 			// events other than signal will be ignored
 		}
 	}
-	return nil
+	return reappliedEvents, nil
+}
+
+func newResetCurrentRun(
+	mutableState mutableState,
+) (resetCurrentRun, error) {
+
+	branchToken, err := mutableState.GetCurrentBranchToken()
+	if err != nil {
+		return resetCurrentRun{}, err
+	}
+	return resetCurrentRun{
+		runID:       mutableState.GetExecutionInfo().GetRunId(),
+		nextEventID: mutableState.GetNextEventID(),
+		branchToken: branchToken,
+	}, nil
 }
 
 func (r *workflowResetterImpl) getPaginationFn(
@@ -260,6 +260,7 @@ func (s *workflowResetterSuite) TestReplayResetWorkflow() {
 		baseRebuildLastEventVersion,
 		s.resetRunID,
 		resetRequestID,
+		false,
 	)
 	s.NoError(err)
 	s.Equal(resetHistorySize, resetWorkflow.getContext().getHistorySize())
@@ -456,7 +457,7 @@ func (s *workflowResetterSuite) TestReapplyContinueAsNewWorkflowEvents() {
 
 	mutableState := NewMockmutableState(s.controller)
 
-	err := s.workflowResetter.reapplyContinueAsNewWorkflowEvents(
+	_, err := s.workflowResetter.reapplyContinueAsNewWorkflowEvents(
 		ctx,
 		mutableState,
 		s.namespaceID,
@@ -465,10 +466,105 @@ func (s *workflowResetterSuite) TestReapplyContinueAsNewWorkflowEvents() {
 		baseBranchToken,
 		baseFirstEventID,
 		baseNextEventID,
+		resetCurrentRun{},
+		resetReapplyTypeAll,
 	)
 	s.NoError(err)
 }
 
+func (s *workflowResetterSuite) TestReapplyContinueAsNewWorkflowEvents_CurrentRun() {
+	ctx := context.Background()
+	baseFirstEventID := int64(124)
+	baseNextEventID := int64(126)
+	baseBranchToken := []byte("some random base branch token")
+
+	currentRun := resetCurrentRun{
+		runID:       uuid.New(),
+		nextEventID: int64(3),
+		branchToken: []byte("some random current branch token"),
+	}
+
+	baseEvents := []*historypb.HistoryEvent{
+		{
+			EventId:    124,
+			EventType:  enumspb.EVENT_TYPE_WORKFLOW_TASK_COMPLETED,
+			Attributes: &historypb.HistoryEvent_WorkflowTaskCompletedEventAttributes{WorkflowTaskCompletedEventAttributes: &historypb.WorkflowTaskCompletedEventAttributes{}},
+		},
+		{
+			EventId:   125,
+			EventType: enumspb.EVENT_TYPE_WORKFLOW_EXECUTION_CONTINUED_AS_NEW,
+			Attributes: &historypb.HistoryEvent_WorkflowExecutionContinuedAsNewEventAttributes{WorkflowExecutionContinuedAsNewEventAttributes: &historypb.WorkflowExecutionContinuedAsNewEventAttributes{
+				NewExecutionRunId: currentRun.runID,
+			}},
+		},
+	}
+	signalEvent := &historypb.HistoryEvent{
+		EventId:   2,
+		EventType: enumspb.EVENT_TYPE_WORKFLOW_EXECUTION_SIGNALED,
+		Attributes: &historypb.HistoryEvent_WorkflowExecutionSignaledEventAttributes{WorkflowExecutionSignaledEventAttributes: &historypb.WorkflowExecutionSignaledEventAttributes{
+			SignalName: "some random signal name",
+			Input:      payloads.EncodeString("some random signal input"),
+			Identity:   "some random signal identity",
+		}},
+	}
+	currentEvents := []*historypb.HistoryEvent{
+		{
+			EventId:    1,
+			EventType:  enumspb.EVENT_TYPE_WORKFLOW_EXECUTION_STARTED,
+			Attributes: &historypb.HistoryEvent_WorkflowExecutionStartedEventAttributes{WorkflowExecutionStartedEventAttributes: &historypb.WorkflowExecutionStartedEventAttributes{}},
+		},
+		signalEvent,
+	}
+
+	shardId := s.mockShard.GetShardID()
+	s.mockHistoryV2Mgr.On("ReadHistoryBranchByBatch", &persistence.ReadHistoryBranchRequest{
+		BranchToken:   baseBranchToken,
+		MinEventID:    baseFirstEventID,
+		MaxEventID:    baseNextEventID,
+		PageSize:      nDCDefaultPageSize,
+		NextPageToken: nil,
+		ShardID:       &shardId,
+	}).Return(&persistence.ReadHistoryBranchByBatchResponse{
+		History:       []*historypb.History{{Events: baseEvents}},
+		NextPageToken: nil,
+	}, nil).Once()
+	// the current run is locked by the caller, it is read with the branch token it had before the reset
+	s.mockHistoryV2Mgr.On("ReadHistoryBranchByBatch", &persistence.ReadHistoryBranchRequest{
+		BranchToken:   currentRun.branchToken,
+		MinEventID:    common.FirstEventID,
+		MaxEventID:    currentRun.nextEventID,
+		PageSize:      nDCDefaultPageSize,
+		NextPageToken: nil,
+		ShardID:       &shardId,
+	}).Return(&persistence.ReadHistoryBranchByBatchResponse{
+		History:       []*historypb.History{{Events: currentEvents}},
+		NextPageToken: nil,
+	}, nil).Once()
+
+	mutableState := NewMockmutableState(s.controller)
+	attr := signalEvent.GetWorkflowExecutionSignaledEventAttributes()
+	mutableState.EXPECT().AddWorkflowExecutionSignaled(
+		attr.GetSignalName(),
+		attr.GetInput(),
+		attr.GetIdentity(),
+	).Return(&historypb.HistoryEvent{}, nil).Times(1)
+
+	reappliedEvents, err := s.workflowResetter.reapplyContinueAsNewWorkflowEvents(
+		ctx,
+		mutableState,
+		s.namespaceID,
+		s.workflowID,
+		s.baseRunID,
+		baseBranchToken,
+		baseFirstEventID,
+		baseNextEventID,
+		currentRun,
+		resetReapplyTypeAll,
+	)
+	s.NoError(err)
+	s.Equal([]*historypb.HistoryEvent{signalEvent}, reappliedEvents)
+}
+
 func (s *workflowResetterSuite) TestReapplyWorkflowEvents() {
 	firstEventID := common.FirstEventID
 	nextEventID := int64(6)
@@ -518,14 +614,16 @@ func (s *workflowResetterSuite) TestReapplyWorkflowEvents() {
 
 	mutableState := NewMockmutableState(s.controller)
 
-	nextRunID, err := s.workflowResetter.reapplyWorkflowEvents(
+	nextRunID, reappliedEvents, err := s.workflowResetter.reapplyWorkflowEvents(
 		mutableState,
 		firstEventID,
 		nextEventID,
 		branchToken,
+		resetReapplyTypeAll,
 	)
 	s.NoError(err)
 	s.Equal(newRunID, nextRunID)
+	s.Empty(reappliedEvents)
 }
 
 func (s *workflowResetterSuite) TestReapplyEvents() {
@@ -568,8 +666,38 @@ func (s *workflowResetterSuite) TestReapplyEvents() {
 		}
 	}
 
-	err := s.workflowResetter.reapplyEvents(mutableState, events)
+	reappliedEvents, err := s.workflowResetter.reapplyEvents(mutableState, events, resetReapplyTypeAll)
+	s.NoError(err)
+	s.Equal([]*historypb.HistoryEvent{event1, event3}, reappliedEvents)
+}
+
+func (s *workflowResetterSuite) TestReapplyEvents_ExcludeSignals() {
+
+	signalEvent := &historypb.HistoryEvent{
+		EventId:   101,
+		EventType: enumspb.EVENT_TYPE_WORKFLOW_EXECUTION_SIGNALED,
+		Attributes: &historypb.HistoryEvent_WorkflowExecutionSignaledEventAttributes{WorkflowExecutionSignaledEventAttributes: &historypb.WorkflowExecutionSignaledEventAttributes{
+			SignalName: "some random signal name",
+			Input:      payloads.EncodeString("some random signal input"),
+			Identity:   "some random signal identity",
+		}},
+	}
+	timerEvent := &historypb.HistoryEvent{
+		EventId:    102,
+		EventType:  enumspb.EVENT_TYPE_TIMER_FIRED,
+		Attributes: &historypb.HistoryEvent_TimerFiredEventAttributes{TimerFiredEventAttributes: &historypb.TimerFiredEventAttributes{}},
+	}
+	events := []*historypb.HistoryEvent{signalEvent, timerEvent}
+
+	mutableState := NewMockmutableState(s.controller)
+
+	reappliedEvents, err := s.workflowResetter.reapplyEvents(mutableState, events, resetReapplyTypeExcludeSignals)
+	s.NoError(err)
+	s.Empty(reappliedEvents)
+
+	reappliedEvents, err = s.workflowResetter.reapplyEvents(mutableState, events, resetReapplyTypeNone)
 	s.NoError(err)
+	s.Empty(reappliedEvents)
 }
 
 func (s *workflowResetterSuite) TestPagination() {
@@ -63,6 +63,8 @@ type (
 		stopProcessing                  bool // should stop processing any more commands
 		mutableState                    mutableState
 		initiatedChildExecutionsInBatch map[string]struct{} // Set of initiated child executions in the workflow task
+		// Updates accepted, rejected or completed by the workflow task
+		respondedUpdates []*commandpb.RespondWorkflowUpdateCommandAttributes
 
 		// validation
 		attrValidator    *commandAttrValidator
@@ -176,6 +178,9 @@ func (handler *workflowTaskHandlerImpl) handleCommand(command *commandpb.Command
 	case enumspb.COMMAND_TYPE_UPSERT_WORKFLOW_SEARCH_ATTRIBUTES:
 		return handler.handleCommandUpsertWorkflowSearchAttributes(command.GetUpsertWorkflowSearchAttributesCommandAttributes())
 
+	case enumspb.COMMAND_TYPE_RESPOND_WORKFLOW_UPDATE:
+		return handler.handleCommandRespondWorkflowUpdate(command.GetRespondWorkflowUpdateCommandAttributes())
+
 	default:
 		return serviceerror.NewInvalidArgument(fmt.Sprintf("Unknown command type: %v", command.GetCommandType()))
 	}
@@ -628,6 +633,68 @@ func (handler *workflowTaskHandlerImpl) handleCommandRecordMarker(
 	return err
 }
 
+func (handler *workflowTaskHandlerImpl) handleCommandRespondWorkflowUpdate(
+	attr *commandpb.RespondWorkflowUpdateCommandAttributes,
+) error {
+
+	handler.metricsClient.IncCounter(
+		metrics.HistoryRespondWorkflowTaskCompletedScope,
+		metrics.CommandTypeUpdateWorkflowCounter,
+	)
+
+	if err := handler.validateCommandAttr(
+		func() error {
+			return handler.attrValidator.validateRespondWorkflowUpdateAttributes(attr)
+		},
+		enumspb.WORKFLOW_TASK_FAILED_CAUSE_BAD_RESPOND_WORKFLOW_UPDATE_ATTRIBUTES,
+	); err != nil || handler.stopProcessing {
+		return err
+	}
+
+	failWorkflow, err := handler.sizeLimitChecker.failWorkflowIfPayloadSizeExceedsLimit(
+		metrics.CommandTypeTag(enumspb.COMMAND_TYPE_RESPOND_WORKFLOW_UPDATE.String()),
+		attr.GetResult().Size(),
+		"RespondWorkflowUpdateCommandAttributes.Result exceeds size limit.",
+	)
+	if err != nil || failWorkflow {
+		handler.stopProcessing = true
+		return err
+	}
+
+	updateID := attr.GetUpdateId()
+	updateInfo, recorded := handler.mutableState.GetUpdateInfo(updateID)
+	if attr.GetCompleted() {
+		// only an accepted update can be completed
+		if !recorded || !updateInfo.GetAccepted() || updateInfo.GetCompleted() {
+			return handler.failCommand(
+				enumspb.WORKFLOW_TASK_FAILED_CAUSE_BAD_RESPOND_WORKFLOW_UPDATE_ATTRIBUTES,
+				serviceerror.NewInvalidArgument(fmt.Sprintf("Update %v is not accepted.", updateID)),
+			)
+		}
+		_, err = handler.mutableState.AddWorkflowExecutionUpdateCompletedEvent(handler.workflowTaskCompletedID, attr)
+	} else {
+		// the update is accepted or rejected once, before it is recorded in history
+		request, requestErr := handler.mutableState.GetUpdateRegistry().getUpdateRequest(updateID)
+		if recorded || requestErr != nil {
+			return handler.failCommand(
+				enumspb.WORKFLOW_TASK_FAILED_CAUSE_BAD_RESPOND_WORKFLOW_UPDATE_ATTRIBUTES,
+				serviceerror.NewInvalidArgument(fmt.Sprintf("Update %v does not exist.", updateID)),
+			)
+		}
+		if attr.GetFailure() == nil {
+			_, err = handler.mutableState.AddWorkflowExecutionUpdateAcceptedEvent(handler.workflowTaskCompletedID, request)
+		} else {
+			_, err = handler.mutableState.AddWorkflowExecutionUpdateCompletedEvent(handler.workflowTaskCompletedID, attr)
+		}
+	}
+	if err != nil {
+		return err
+	}
+	// waiters are only notified once the workflow task completion is persisted
+	handler.respondedUpdates = append(handler.respondedUpdates, attr)
+	return nil
+}
+
 func (handler *workflowTaskHandlerImpl) handleCommandContinueAsNewWorkflow(
 	attr *commandpb.ContinueAsNewWorkflowExecutionCommandAttributes,
 ) error {
@@ -28,6 +28,7 @@ import (
 	"context"
 	"fmt"
 
+	commandpb "go.temporal.io/api/command/v1"
 	commonpb "go.temporal.io/api/common/v1"
 	enumspb "go.temporal.io/api/enums/v1"
 	historypb "go.temporal.io/api/history/v1"
@@ -196,7 +197,7 @@ func (handler *workflowTaskHandlerCallbacksImpl) handleWorkflowTaskStarted(
 			if workflowTask.StartedID != common.EmptyEventID {
 				// If workflow task is started as part of the current request scope then return a positive response
 				if workflowTask.RequestID == requestID {
-					resp, err = handler.createRecordWorkflowTaskStartedResponse(namespaceID, mutableState, workflowTask, req.PollRequest.GetIdentity())
+					resp, err = handler.createRecordWorkflowTaskStartedResponse(namespaceID, mutableState, context.getHistorySize(), workflowTask, req.PollRequest.GetIdentity())
 					if err != nil {
 						return nil, err
 					}
@@ -209,13 +210,18 @@ func (handler *workflowTaskHandlerCallbacksImpl) handleWorkflowTaskStarted(
 				return nil, serviceerrors.NewTaskAlreadyStarted("Workflow")
 			}
 
+			if paused, _ := mutableState.IsWorkflowExecutionPaused(); paused {
+				// The task is dropped by matching and regenerated when the workflow execution is unpaused.
+				return nil, ErrWorkflowPaused
+			}
+
 			_, workflowTask, err = mutableState.AddWorkflowTaskStartedEvent(scheduleID, requestID, req.PollRequest)
 			if err != nil {
 				// Unable to add WorkflowTaskStarted event to history
 				return nil, serviceerror.NewInternal("Unable to add WorkflowTaskStarted event to history.")
 			}
 
-			resp, err = handler.createRecordWorkflowTaskStartedResponse(namespaceID, mutableState, workflowTask, req.PollRequest.GetIdentity())
+			resp, err = handler.createRecordWorkflowTaskStartedResponse(namespaceID, mutableState, context.getHistorySize(), workflowTask, req.PollRequest.GetIdentity())
 			if err != nil {
 				return nil, err
 			}
@@ -368,6 +374,7 @@ Update_History_Loop:
 			workflowTaskFailedErr       *workflowTaskFailedError
 			activityNotStartedCancelled bool
 			continueAsNewBuilder        mutableState
+			respondedUpdates            []*commandpb.RespondWorkflowUpdateCommandAttributes
 
 			hasUnhandledEvents bool
 		)
@@ -431,6 +438,8 @@ Update_History_Loop:
 
 			continueAsNewBuilder = workflowTaskHandler.continueAsNewBuilder
 
+			respondedUpdates = workflowTaskHandler.respondedUpdates
+
 			hasUnhandledEvents = workflowTaskHandler.hasBufferedEvents
 		}
 
@@ -447,21 +456,28 @@ Update_History_Loop:
 			}
 			hasUnhandledEvents = true
 			continueAsNewBuilder = nil
+			respondedUpdates = nil
 		}
 
-		createNewWorkflowTask := msBuilder.IsWorkflowExecutionRunning() && (hasUnhandledEvents || request.GetForceCreateNewWorkflowTask() || activityNotStartedCancelled)
+		// a paused workflow execution does not hand out new workflow tasks, the new workflow task is
+		// scheduled through the transfer queue and dispatched once the workflow execution is unpaused
+		paused, _ := msBuilder.IsWorkflowExecutionPaused()
+		returnNewWorkflowTask := request.GetReturnNewWorkflowTask() && !paused
+		// updates admitted while the workflow task was running are handed to the next workflow task
+		hasUndeliveredUpdates := msBuilder.GetUpdateRegistry().hasUndeliveredUpdate()
+		createNewWorkflowTask := msBuilder.IsWorkflowExecutionRunning() && (hasUnhandledEvents || request.GetForceCreateNewWorkflowTask() || activityNotStartedCancelled || hasUndeliveredUpdates)
 		var newWorkflowTaskScheduledID int64
 		if createNewWorkflowTask {
 			var newWorkflowTask *workflowTaskInfo
 			var err error
 			if workflowTaskHeartbeating && !workflowTaskHeartbeatTimeout {
 				newWorkflowTask, err = msBuilder.AddWorkflowTaskScheduledEventAsHeartbeat(
-					request.GetReturnNewWorkflowTask(),
+					returnNewWorkflowTask,
 					currentWorkflowTask.OriginalScheduledTimestamp,
 				)
 			} else {
 				newWorkflowTask, err = msBuilder.AddWorkflowTaskScheduledEvent(
-					request.GetReturnNewWorkflowTask(),
+					returnNewWorkflowTask,
 				)
 			}
 			if err != nil {
@@ -470,7 +486,7 @@ Update_History_Loop:
 
 			newWorkflowTaskScheduledID = newWorkflowTask.ScheduleID
 			// skip transfer task for workflow task if request asking to return new workflow task
-			if request.GetReturnNewWorkflowTask() {
+			if returnNewWorkflowTask {
 				// start the new workflow task if request asked to do so
 				// TODO: replace the poll request
 				_, _, err := msBuilder.AddWorkflowTaskStartedEvent(newWorkflowTask.ScheduleID, "request-from-RespondWorkflowTaskCompleted", &workflowservice.PollWorkflowTaskQueueRequest{
@@ -543,6 +559,7 @@ Update_History_Loop:
 		}
 
 		handler.handleBufferedQueries(msBuilder, req.GetCompleteRequest().GetQueryResults(), createNewWorkflowTask, namespaceEntry, workflowTaskHeartbeating)
+		handler.handleUpdates(msBuilder, respondedUpdates, namespaceEntry)
 
 		if workflowTaskHeartbeatTimeout {
 			// at this point, update is successful, but we still return an error to client so that the worker will give up this workflow
@@ -554,9 +571,9 @@ Update_History_Loop:
 		}
 
 		resp = &historyservice.RespondWorkflowTaskCompletedResponse{}
-		if request.GetReturnNewWorkflowTask() && createNewWorkflowTask {
+		if returnNewWorkflowTask && createNewWorkflowTask {
 			workflowTask, _ := msBuilder.GetWorkflowTaskInfo(newWorkflowTaskScheduledID)
-			resp.StartedResponse, err = handler.createRecordWorkflowTaskStartedResponse(namespaceID, msBuilder, workflowTask, request.GetIdentity())
+			resp.StartedResponse, err = handler.createRecordWorkflowTaskStartedResponse(namespaceID, msBuilder, weContext.getHistorySize(), workflowTask, request.GetIdentity())
 			if err != nil {
 				return nil, err
 			}
@@ -573,6 +590,7 @@ Update_History_Loop:
 func (handler *workflowTaskHandlerCallbacksImpl) createRecordWorkflowTaskStartedResponse(
 	namespaceID string,
 	msBuilder mutableState,
+	historySize int64,
 	workflowTask *workflowTaskInfo,
 	identity string,
 ) (*historyservice.RecordWorkflowTaskStartedResponse, error) {
@@ -597,6 +615,13 @@ func (handler *workflowTaskHandlerCallbacksImpl) createRecordWorkflowTaskStarted
 	}
 	response.ScheduledTime = workflowTask.ScheduledTimestamp
 	response.StartedTime = workflowTask.StartedTimestamp
+	response.HistorySizeBytes = historySize
+	response.SuggestContinueAsNew = historyLimitWarnExceeded(
+		handler.config,
+		msBuilder.GetNamespaceEntry().GetInfo().Name,
+		historySize,
+		response.NextEventId-common.FirstEventID,
+	)
 
 	if workflowTask.Attempt > 1 {
 		// This workflowTask is retried from mutable state
@@ -623,6 +648,7 @@ func (handler *workflowTaskHandlerCallbacksImpl) createRecordWorkflowTaskStarted
 		queries[id] = input
 	}
 	response.Queries = queries
+	response.Updates = msBuilder.GetUpdateRegistry().deliverUpdates()
 	return response, nil
 }
 
@@ -723,3 +749,33 @@ func (handler *workflowTaskHandlerCallbacksImpl) handleBufferedQueries(msBuilder
 		}
 	}
 }
+
+func (handler *workflowTaskHandlerCallbacksImpl) handleUpdates(msBuilder mutableState, respondedUpdates []*commandpb.RespondWorkflowUpdateCommandAttributes, namespaceEntry *cache.NamespaceCacheEntry) {
+	updateRegistry := msBuilder.GetUpdateRegistry()
+	if !updateRegistry.hasPendingUpdate() {
+		return
+	}
+
+	for _, attr := range respondedUpdates {
+		var err error
+		if !attr.GetCompleted() && attr.GetFailure() == nil {
+			err = updateRegistry.acceptUpdate(attr.GetUpdateId())
+		} else {
+			err = updateRegistry.completeUpdate(attr.GetUpdateId(), attr.GetResult(), attr.GetFailure())
+		}
+		// the caller waiting for the update may be gone already, its outcome is still recorded in history
+		if err != nil && err != errUpdateNotExists {
+			handler.logger.Warn(
+				"failed to set update stage",
+				tag.WorkflowNamespace(namespaceEntry.GetInfo().Name),
+				tag.WorkflowID(msBuilder.GetExecutionInfo().WorkflowId),
+				tag.WorkflowRunID(msBuilder.GetExecutionInfo().GetRunId()),
+				tag.Error(err))
+		}
+	}
+
+	// updates which are not completed when the workflow closes will never be
+	if !msBuilder.IsWorkflowExecutionRunning() {
+		updateRegistry.failPendingUpdates(ErrWorkflowCompleted)
+	}
+}
@@ -378,6 +378,8 @@ pollLoop:
 				BranchToken:                mutableStateResp.CurrentBranchToken,
 				StartedEventId:             common.EmptyEventID,
 				Attempt:                    1,
+				HistorySizeBytes:           mutableStateResp.GetHistorySizeBytes(),
+				SuggestContinueAsNew:       mutableStateResp.GetSuggestContinueAsNew(),
 			}
 			return e.createPollWorkflowTaskQueueResponse(task, resp, hCtx.scope), nil
 		}
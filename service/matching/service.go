@@ -41,6 +41,7 @@ import (
 	persistenceClient "go.temporal.io/server/common/persistence/client"
 	"go.temporal.io/server/common/resource"
 	"go.temporal.io/server/common/service/dynamicconfig"
+	"go.temporal.io/server/common/tracing"
 )
 
 // Service represents the matching service
@@ -105,7 +106,7 @@ func (s *Service) Start() {
 	if err != nil {
 		logger.Fatal("creating grpc server options failed", tag.Error(err))
 	}
-	opts = append(opts, grpc.UnaryInterceptor(interceptor))
+	opts = append(opts, grpc.ChainUnaryInterceptor(tracing.UnaryServerInterceptor, interceptor))
 	s.server = grpc.NewServer(opts...)
 	nilCheckHandler := NewNilCheckHandler(s.handler)
 	matchingservice.RegisterMatchingServiceServer(s.server, nilCheckHandler)
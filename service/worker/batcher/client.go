@@ -0,0 +1,247 @@
+// The MIT License
+//
+// Copyright (c) 2020 Temporal Technologies Inc.  All rights reserved.
+//
+// Copyright (c) 2017 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package batcher
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	commonpb "go.temporal.io/api/common/v1"
+	enumspb "go.temporal.io/api/enums/v1"
+	"go.temporal.io/api/serviceerror"
+	"go.temporal.io/api/workflowservice/v1"
+	sdkclient "go.temporal.io/sdk/client"
+
+	"go.temporal.io/server/common/definition"
+	"go.temporal.io/server/common/log"
+	"go.temporal.io/server/common/log/tag"
+	"go.temporal.io/server/common/payload"
+	"go.temporal.io/server/common/payloads"
+	"go.temporal.io/server/common/primitives/timestamp"
+)
+
+const (
+	memoKeyReason = "Reason"
+)
+
+type (
+	// Client is used by the frontend to manage batch operations, each batch operation is
+	// backed by a batch workflow in the system namespace
+	Client interface {
+		StartBatchOperation(ctx context.Context, request *StartBatchOperationRequest) error
+		StopBatchOperation(ctx context.Context, request *StopBatchOperationRequest) error
+		DescribeBatchOperation(ctx context.Context, request *DescribeBatchOperationRequest) (*DescribeBatchOperationResponse, error)
+		ListBatchOperations(ctx context.Context, request *ListBatchOperationsRequest) (*ListBatchOperationsResponse, error)
+	}
+
+	clientImpl struct {
+		logger         log.Logger
+		temporalClient sdkclient.Client
+	}
+)
+
+var _ Client = (*clientImpl)(nil)
+
+var (
+	errBatchOperationNotFound = serviceerror.NewNotFound("Batch operation not found.")
+	errBatchOperationExists   = serviceerror.NewInvalidArgument("Batch operation with this JobId already exists.")
+)
+
+// NewClient creates a new Client
+func NewClient(
+	logger log.Logger,
+	publicClient sdkclient.Client,
+) Client {
+	return &clientImpl{
+		logger:         logger,
+		temporalClient: publicClient,
+	}
+}
+
+func (c *clientImpl) StartBatchOperation(ctx context.Context, request *StartBatchOperationRequest) error {
+	params := request.ToBatchParams()
+	if err := validateParams(setDefaultParams(params)); err != nil {
+		return serviceerror.NewInvalidArgument(err.Error())
+	}
+
+	workflowOptions := sdkclient.StartWorkflowOptions{
+		ID:        request.JobID,
+		TaskQueue: BatcherTaskQueueName,
+		// job IDs share the system namespace, a closed job of another namespace must not be replaced
+		WorkflowIDReusePolicy: enumspb.WORKFLOW_ID_REUSE_POLICY_REJECT_DUPLICATE,
+		Memo: map[string]interface{}{
+			memoKeyReason: request.Reason,
+		},
+		SearchAttributes: map[string]interface{}{
+			definition.CustomNamespace: request.Namespace,
+			definition.Operator:        request.Identity,
+		},
+	}
+	_, err := c.temporalClient.ExecuteWorkflow(ctx, workflowOptions, BatchWFTypeName, params)
+	if err != nil {
+		if _, ok := err.(*serviceerror.WorkflowExecutionAlreadyStarted); ok {
+			return errBatchOperationExists
+		}
+		c.logger.Error("Failed to start batch workflow", tag.WorkflowID(request.JobID), tag.Error(err))
+		return err
+	}
+	return nil
+}
+
+func (c *clientImpl) StopBatchOperation(ctx context.Context, request *StopBatchOperationRequest) error {
+	if _, err := c.describe(ctx, request.Namespace, request.JobID); err != nil {
+		return err
+	}
+	err := c.temporalClient.TerminateWorkflow(
+		ctx,
+		request.JobID,
+		"",
+		fmt.Sprintf("batch operation stopped by %v: %v", request.Identity, request.Reason),
+	)
+	return convertError(err)
+}
+
+func (c *clientImpl) DescribeBatchOperation(ctx context.Context, request *DescribeBatchOperationRequest) (*DescribeBatchOperationResponse, error) {
+	resp, err := c.describe(ctx, request.Namespace, request.JobID)
+	if err != nil {
+		return nil, err
+	}
+
+	info := resp.GetWorkflowExecutionInfo()
+	response := &DescribeBatchOperationResponse{
+		JobID:     request.JobID,
+		State:     toBatchOperationState(info.GetStatus()),
+		StartTime: timestamp.TimeValue(info.GetStartTime()),
+		CloseTime: timestamp.TimeValue(info.GetCloseTime()),
+		Reason:    memoString(info.GetMemo(), memoKeyReason),
+		Identity:  searchAttributeString(info.GetSearchAttributes(), definition.Operator),
+	}
+
+	var hbd HeartBeatDetails
+	switch info.GetStatus() {
+	case enumspb.WORKFLOW_EXECUTION_STATUS_RUNNING:
+		// progress is only known once the batch activity heartbeats
+		if len(resp.GetPendingActivities()) > 0 {
+			if err := payloads.Decode(resp.GetPendingActivities()[0].GetHeartbeatDetails(), &hbd); err != nil {
+				return nil, err
+			}
+		}
+	case enumspb.WORKFLOW_EXECUTION_STATUS_COMPLETED:
+		// the batch workflow returns the final heartbeat details as its result
+		if err := c.temporalClient.GetWorkflow(ctx, request.JobID, info.GetExecution().GetRunId()).Get(ctx, &hbd); err != nil {
+			return nil, err
+		}
+	}
+	response.TotalOperationCount = hbd.TotalEstimate
+	response.CompleteOperationCount = int64(hbd.SuccessCount)
+	response.FailureOperationCount = int64(hbd.ErrorCount)
+	return response, nil
+}
+
+func (c *clientImpl) ListBatchOperations(ctx context.Context, request *ListBatchOperationsRequest) (*ListBatchOperationsResponse, error) {
+	resp, err := c.temporalClient.ListWorkflow(ctx, &workflowservice.ListWorkflowExecutionsRequest{
+		PageSize:      request.PageSize,
+		NextPageToken: request.NextPageToken,
+		Query:         listBatchOperationsQuery(request.Namespace),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	response := &ListBatchOperationsResponse{
+		NextPageToken: resp.GetNextPageToken(),
+	}
+	for _, execution := range resp.GetExecutions() {
+		response.OperationInfo = append(response.OperationInfo, BatchOperationInfo{
+			JobID:     execution.GetExecution().GetWorkflowId(),
+			State:     toBatchOperationState(execution.GetStatus()),
+			StartTime: timestamp.TimeValue(execution.GetStartTime()),
+			CloseTime: timestamp.TimeValue(execution.GetCloseTime()),
+		})
+	}
+	return response, nil
+}
+
+// describe returns the batch workflow backing the job, jobs started for other namespaces are reported as not found
+func (c *clientImpl) describe(ctx context.Context, namespace string, jobID string) (*workflowservice.DescribeWorkflowExecutionResponse, error) {
+	resp, err := c.temporalClient.DescribeWorkflowExecution(ctx, jobID, "")
+	if err != nil {
+		return nil, convertError(err)
+	}
+	info := resp.GetWorkflowExecutionInfo()
+	if info.GetType().GetName() != BatchWFTypeName ||
+		searchAttributeString(info.GetSearchAttributes(), definition.CustomNamespace) != namespace {
+		return nil, errBatchOperationNotFound
+	}
+	return resp, nil
+}
+
+func listBatchOperationsQuery(namespace string) string {
+	return fmt.Sprintf("%s = '%s' and %s = '%s'",
+		definition.WorkflowType, BatchWFTypeName,
+		definition.CustomNamespace, escapeQueryValue(namespace))
+}
+
+// escapeQueryValue escapes a value quoted in a visibility query
+func escapeQueryValue(value string) string {
+	return strings.NewReplacer("\\", "\\\\", "'", "\\'").Replace(value)
+}
+
+func toBatchOperationState(status enumspb.WorkflowExecutionStatus) BatchOperationState {
+	switch status {
+	case enumspb.WORKFLOW_EXECUTION_STATUS_RUNNING:
+		return BatchOperationStateRunning
+	case enumspb.WORKFLOW_EXECUTION_STATUS_COMPLETED:
+		return BatchOperationStateCompleted
+	case enumspb.WORKFLOW_EXECUTION_STATUS_TERMINATED, enumspb.WORKFLOW_EXECUTION_STATUS_CANCELED:
+		return BatchOperationStateStopped
+	default:
+		return BatchOperationStateFailed
+	}
+}
+
+func memoString(memo *commonpb.Memo, key string) string {
+	var value string
+	if p, ok := memo.GetFields()[key]; ok {
+		_ = payload.Decode(p, &value)
+	}
+	return value
+}
+
+func searchAttributeString(searchAttributes *commonpb.SearchAttributes, key string) string {
+	var value string
+	if p, ok := searchAttributes.GetIndexedFields()[key]; ok {
+		_ = payload.Decode(p, &value)
+	}
+	return value
+}
+
+func convertError(err error) error {
+	if _, ok := err.(*serviceerror.NotFound); ok {
+		return errBatchOperationNotFound
+	}
+	return err
+}
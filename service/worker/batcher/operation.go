@@ -0,0 +1,203 @@
+// The MIT License
+//
+// Copyright (c) 2020 Temporal Technologies Inc.  All rights reserved.
+//
+// Copyright (c) 2017 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package batcher
+
+import (
+	"time"
+)
+
+// BatchOperationState is the state of a batch operation
+type BatchOperationState string
+
+const (
+	// BatchOperationStateRunning means the batch operation is still processing workflows
+	BatchOperationStateRunning BatchOperationState = "Running"
+	// BatchOperationStateCompleted means all workflows matching the query have been processed
+	BatchOperationStateCompleted BatchOperationState = "Completed"
+	// BatchOperationStateStopped means the batch operation was stopped by StopBatchOperation
+	BatchOperationStateStopped BatchOperationState = "Stopped"
+	// BatchOperationStateFailed means the batch operation workflow failed or timed out
+	BatchOperationStateFailed BatchOperationState = "Failed"
+)
+
+type (
+	// StartBatchOperationRequest is the request to start a batch operation on the
+	// workflows of a namespace matching a visibility query
+	StartBatchOperationRequest struct {
+		Namespace string
+		// JobID identifies the batch operation, it must be unique across namespaces
+		JobID string
+		// Query is the visibility query selecting the target workflows
+		Query  string
+		Reason string
+		// BatchType is one of AllBatchTypes
+		BatchType string
+		Identity  string
+
+		// Below are all optional
+		TerminateParams TerminateParams
+		CancelParams    CancelParams
+		SignalParams    SignalParams
+		ResetParams     ResetParams
+		// RPS of processing. Default to DefaultRPS
+		RPS int
+	}
+
+	// StartBatchOperationResponse is the response to StartBatchOperationRequest
+	StartBatchOperationResponse struct{}
+
+	// StopBatchOperationRequest is the request to stop a running batch operation. Workflows
+	// which were already processed are not affected.
+	StopBatchOperationRequest struct {
+		Namespace string
+		JobID     string
+		Reason    string
+		Identity  string
+	}
+
+	// StopBatchOperationResponse is the response to StopBatchOperationRequest
+	StopBatchOperationResponse struct{}
+
+	// DescribeBatchOperationRequest is the request to describe a batch operation
+	DescribeBatchOperationRequest struct {
+		Namespace string
+		JobID     string
+	}
+
+	// DescribeBatchOperationResponse is the response to DescribeBatchOperationRequest
+	DescribeBatchOperationResponse struct {
+		JobID     string
+		State     BatchOperationState
+		StartTime time.Time
+		// CloseTime is zero while the batch operation is running
+		CloseTime time.Time
+		Reason    string
+		Identity  string
+		// TotalOperationCount is an estimation of the number of workflows matching the query
+		TotalOperationCount    int64
+		CompleteOperationCount int64
+		FailureOperationCount  int64
+	}
+
+	// ListBatchOperationsRequest is the request to list the batch operations of a namespace
+	ListBatchOperationsRequest struct {
+		Namespace     string
+		PageSize      int32
+		NextPageToken []byte
+	}
+
+	// BatchOperationInfo is a single batch operation returned by ListBatchOperations
+	BatchOperationInfo struct {
+		JobID     string
+		State     BatchOperationState
+		StartTime time.Time
+		CloseTime time.Time
+	}
+
+	// ListBatchOperationsResponse is the response to ListBatchOperationsRequest
+	ListBatchOperationsResponse struct {
+		OperationInfo []BatchOperationInfo
+		NextPageToken []byte
+	}
+)
+
+// GetNamespace returns the namespace of the request, it is safe to call on nil
+func (r *StartBatchOperationRequest) GetNamespace() string {
+	if r == nil {
+		return ""
+	}
+	return r.Namespace
+}
+
+// GetJobID returns the job ID of the request, it is safe to call on nil
+func (r *StartBatchOperationRequest) GetJobID() string {
+	if r == nil {
+		return ""
+	}
+	return r.JobID
+}
+
+// GetNamespace returns the namespace of the request, it is safe to call on nil
+func (r *StopBatchOperationRequest) GetNamespace() string {
+	if r == nil {
+		return ""
+	}
+	return r.Namespace
+}
+
+// GetJobID returns the job ID of the request, it is safe to call on nil
+func (r *StopBatchOperationRequest) GetJobID() string {
+	if r == nil {
+		return ""
+	}
+	return r.JobID
+}
+
+// GetNamespace returns the namespace of the request, it is safe to call on nil
+func (r *DescribeBatchOperationRequest) GetNamespace() string {
+	if r == nil {
+		return ""
+	}
+	return r.Namespace
+}
+
+// GetJobID returns the job ID of the request, it is safe to call on nil
+func (r *DescribeBatchOperationRequest) GetJobID() string {
+	if r == nil {
+		return ""
+	}
+	return r.JobID
+}
+
+// GetNamespace returns the namespace of the request, it is safe to call on nil
+func (r *ListBatchOperationsRequest) GetNamespace() string {
+	if r == nil {
+		return ""
+	}
+	return r.Namespace
+}
+
+// GetPageSize returns the page size of the request, it is safe to call on nil
+func (r *ListBatchOperationsRequest) GetPageSize() int32 {
+	if r == nil {
+		return 0
+	}
+	return r.PageSize
+}
+
+// ToBatchParams converts the request to the parameters of the batch workflow
+func (r *StartBatchOperationRequest) ToBatchParams() BatchParams {
+	return BatchParams{
+		Namespace:       r.Namespace,
+		Query:           r.Query,
+		Reason:          r.Reason,
+		BatchType:       r.BatchType,
+		TerminateParams: r.TerminateParams,
+		CancelParams:    r.CancelParams,
+		SignalParams:    r.SignalParams,
+		ResetParams:     r.ResetParams,
+		RPS:             r.RPS,
+	}
+}
@@ -0,0 +1,369 @@
+// The MIT License
+//
+// Copyright (c) 2020 Temporal Technologies Inc.  All rights reserved.
+//
+// Copyright (c) 2017 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package batcher
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/google/uuid"
+	commonpb "go.temporal.io/api/common/v1"
+	enumspb "go.temporal.io/api/enums/v1"
+	historypb "go.temporal.io/api/history/v1"
+	namespacepb "go.temporal.io/api/namespace/v1"
+	"go.temporal.io/api/serviceerror"
+	"go.temporal.io/api/workflowservice/v1"
+	"go.temporal.io/sdk/activity"
+
+	"go.temporal.io/server/common/clock"
+	"go.temporal.io/server/service/history"
+)
+
+const (
+	// ResetTypeFirstWorkflowTask resets to the first completed workflow task
+	ResetTypeFirstWorkflowTask = "FirstWorkflowTask"
+	// ResetTypeLastWorkflowTask resets to the last completed or scheduled workflow task
+	ResetTypeLastWorkflowTask = "LastWorkflowTask"
+	// ResetTypeLastContinuedAsNew resets to the last completed workflow task of the run which continued as new
+	ResetTypeLastContinuedAsNew = "LastContinuedAsNew"
+	// ResetTypeBadBinary resets to the first workflow task completed by a bad binary
+	ResetTypeBadBinary = "BadBinary"
+
+	historyPageSize = 1000
+)
+
+// AllResetTypes is the reset types we supported
+var AllResetTypes = []string{ResetTypeFirstWorkflowTask, ResetTypeLastWorkflowTask, ResetTypeLastContinuedAsNew, ResetTypeBadBinary}
+
+// ValidateResetType validates the reset type and the extra options it requires
+func ValidateResetType(resetType string, badBinaryChecksum string) error {
+	switch resetType {
+	case ResetTypeFirstWorkflowTask, ResetTypeLastWorkflowTask, ResetTypeLastContinuedAsNew:
+		return nil
+	case ResetTypeBadBinary:
+		if badBinaryChecksum == "" {
+			return fmt.Errorf("must provide bad binary checksum for reset type %v", ResetTypeBadBinary)
+		}
+		return nil
+	default:
+		return fmt.Errorf("not supported reset type: %v, supported: %v", resetType, strings.Join(AllResetTypes, ","))
+	}
+}
+
+// GetResetPoint returns the base run and the workflow task finish event ID a workflow should be reset to
+func GetResetPoint(
+	ctx context.Context,
+	client workflowservice.WorkflowServiceClient,
+	namespace string,
+	workflowID string,
+	runID string,
+	resetType string,
+	badBinaryChecksum string,
+) (resetBaseRunID string, workflowTaskFinishID int64, err error) {
+
+	switch resetType {
+	case ResetTypeLastWorkflowTask:
+		return getLastWorkflowTaskEventID(ctx, client, namespace, workflowID, runID)
+	case ResetTypeLastContinuedAsNew:
+		return getLastContinueAsNewID(ctx, client, namespace, workflowID, runID)
+	case ResetTypeFirstWorkflowTask:
+		return getFirstWorkflowTaskEventID(ctx, client, namespace, workflowID, runID)
+	case ResetTypeBadBinary:
+		return getBadWorkflowTaskCompletedID(ctx, client, namespace, workflowID, runID, badBinaryChecksum)
+	default:
+		return "", 0, serviceerror.NewInvalidArgument(fmt.Sprintf("not supported reset type: %v", resetType))
+	}
+}
+
+// IsLastEventWorkflowTaskFailedWithNonDeterminism returns true if the last workflow task of the run
+// failed because of a nondeterministic workflow worker
+func IsLastEventWorkflowTaskFailedWithNonDeterminism(
+	ctx context.Context,
+	client workflowservice.WorkflowServiceClient,
+	namespace string,
+	workflowID string,
+	runID string,
+) (bool, error) {
+
+	req := newGetHistoryRequest(namespace, workflowID, runID, historyPageSize)
+	var workflowTaskFailedEvent *historypb.WorkflowTaskFailedEventAttributes
+	for {
+		resp, err := client.GetWorkflowExecutionHistory(ctx, req)
+		if err != nil {
+			return false, err
+		}
+		for _, e := range resp.GetHistory().GetEvents() {
+			if e.GetEventType() == enumspb.EVENT_TYPE_WORKFLOW_TASK_FAILED {
+				workflowTaskFailedEvent = e.GetWorkflowTaskFailedEventAttributes()
+			} else if e.GetEventType() == enumspb.EVENT_TYPE_WORKFLOW_TASK_COMPLETED {
+				workflowTaskFailedEvent = nil
+			}
+		}
+		if len(resp.NextPageToken) == 0 {
+			break
+		}
+		req.NextPageToken = resp.NextPageToken
+	}
+
+	if workflowTaskFailedEvent == nil {
+		return false, nil
+	}
+	return workflowTaskFailedEvent.GetCause() == enumspb.WORKFLOW_TASK_FAILED_CAUSE_WORKFLOW_WORKER_UNHANDLED_FAILURE ||
+		strings.Contains(workflowTaskFailedEvent.GetFailure().GetMessage(), "nondeterministic"), nil
+}
+
+// resetWorkflow resets a single workflow of the batch, honoring the skip options of ResetParams
+func resetWorkflow(
+	ctx context.Context,
+	client workflowservice.WorkflowServiceClient,
+	batchParams BatchParams,
+	workflowID string,
+	runID string,
+) error {
+
+	params := batchParams.ResetParams
+	resp, err := client.DescribeWorkflowExecution(ctx, &workflowservice.DescribeWorkflowExecutionRequest{
+		Namespace: batchParams.Namespace,
+		Execution: &commonpb.WorkflowExecution{
+			WorkflowId: workflowID,
+		},
+	})
+	if err != nil {
+		return err
+	}
+
+	currentRunID := resp.GetWorkflowExecutionInfo().GetExecution().GetRunId()
+	if currentRunID != runID && params.SkipBaseNotCurrent {
+		return nil
+	}
+	if runID == "" {
+		runID = currentRunID
+	}
+	if resp.GetWorkflowExecutionInfo().GetStatus() == enumspb.WORKFLOW_EXECUTION_STATUS_RUNNING && params.SkipCurrentOpen {
+		return nil
+	}
+
+	if params.NonDeterministicOnly {
+		isLDN, err := IsLastEventWorkflowTaskFailedWithNonDeterminism(ctx, client, batchParams.Namespace, workflowID, runID)
+		if err != nil {
+			return err
+		}
+		if !isLDN {
+			return nil
+		}
+	}
+
+	resetBaseRunID, workflowTaskFinishID, err := GetResetPoint(
+		ctx,
+		client,
+		batchParams.Namespace,
+		workflowID,
+		runID,
+		params.ResetType,
+		params.BadBinaryChecksum,
+	)
+	if err != nil {
+		return err
+	}
+
+	_, err = client.ResetWorkflowExecution(ctx, &workflowservice.ResetWorkflowExecutionRequest{
+		Namespace: batchParams.Namespace,
+		WorkflowExecution: &commonpb.WorkflowExecution{
+			WorkflowId: workflowID,
+			RunId:      resetBaseRunID,
+		},
+		Reason:                    batchParams.Reason,
+		WorkflowTaskFinishEventId: workflowTaskFinishID,
+		RequestId:                 resetRequestID(activity.GetInfo(ctx).WorkflowExecution.ID, workflowID, runID),
+	})
+	return err
+}
+
+// resetRequestID derives the reset request ID from the batch job and the target run, so that a
+// reset retried after a timeout or after the activity resumes from its heartbeat is deduplicated
+// by history instead of resetting the workflow again
+func resetRequestID(jobID string, workflowID string, runID string) string {
+	return uuid.NewSHA1(uuid.NameSpaceOID, []byte(jobID+"/"+workflowID+"/"+runID)).String()
+}
+
+// Returns event id of the last completed task or id of the next event after scheduled task.
+func getLastWorkflowTaskEventID(
+	ctx context.Context,
+	client workflowservice.WorkflowServiceClient,
+	namespace string,
+	workflowID string,
+	runID string,
+) (resetBaseRunID string, workflowTaskEventID int64, err error) {
+
+	req := newGetHistoryRequest(namespace, workflowID, runID, historyPageSize)
+	for {
+		resp, err := client.GetWorkflowExecutionHistory(ctx, req)
+		if err != nil {
+			return "", 0, err
+		}
+		for _, e := range resp.GetHistory().GetEvents() {
+			if e.GetEventType() == enumspb.EVENT_TYPE_WORKFLOW_TASK_COMPLETED {
+				workflowTaskEventID = e.GetEventId()
+			} else if e.GetEventType() == enumspb.EVENT_TYPE_WORKFLOW_TASK_SCHEDULED {
+				workflowTaskEventID = e.GetEventId() + 1
+			}
+		}
+		if len(resp.NextPageToken) == 0 {
+			break
+		}
+		req.NextPageToken = resp.NextPageToken
+	}
+	if workflowTaskEventID == 0 {
+		return "", 0, serviceerror.NewInvalidArgument("unable to find any scheduled or completed task")
+	}
+	return runID, workflowTaskEventID, nil
+}
+
+// Returns id of the first workflow task completed event or if it doesn't exist then id of the event after task scheduled event.
+func getFirstWorkflowTaskEventID(
+	ctx context.Context,
+	client workflowservice.WorkflowServiceClient,
+	namespace string,
+	workflowID string,
+	runID string,
+) (resetBaseRunID string, workflowTaskEventID int64, err error) {
+
+	req := newGetHistoryRequest(namespace, workflowID, runID, historyPageSize)
+	for {
+		resp, err := client.GetWorkflowExecutionHistory(ctx, req)
+		if err != nil {
+			return "", 0, err
+		}
+		for _, e := range resp.GetHistory().GetEvents() {
+			if e.GetEventType() == enumspb.EVENT_TYPE_WORKFLOW_TASK_COMPLETED {
+				return runID, e.GetEventId(), nil
+			}
+			if e.GetEventType() == enumspb.EVENT_TYPE_WORKFLOW_TASK_SCHEDULED && workflowTaskEventID == 0 {
+				workflowTaskEventID = e.GetEventId() + 1
+			}
+		}
+		if len(resp.NextPageToken) == 0 {
+			break
+		}
+		req.NextPageToken = resp.NextPageToken
+	}
+	if workflowTaskEventID == 0 {
+		return "", 0, serviceerror.NewInvalidArgument("unable to find any scheduled or completed task")
+	}
+	return runID, workflowTaskEventID, nil
+}
+
+// Returns id of the last workflow task completed event of the run which continued as new to the given run.
+func getLastContinueAsNewID(
+	ctx context.Context,
+	client workflowservice.WorkflowServiceClient,
+	namespace string,
+	workflowID string,
+	runID string,
+) (resetBaseRunID string, workflowTaskCompletedID int64, err error) {
+
+	// get first event
+	resp, err := client.GetWorkflowExecutionHistory(ctx, newGetHistoryRequest(namespace, workflowID, runID, 1))
+	if err != nil {
+		return "", 0, err
+	}
+	if len(resp.GetHistory().GetEvents()) == 0 {
+		return "", 0, serviceerror.NewInvalidArgument("cannot get resetBaseRunId")
+	}
+	firstEvent := resp.GetHistory().GetEvents()[0]
+	resetBaseRunID = firstEvent.GetWorkflowExecutionStartedEventAttributes().GetContinuedExecutionRunId()
+	if resetBaseRunID == "" {
+		return "", 0, serviceerror.NewInvalidArgument("cannot get resetBaseRunId")
+	}
+
+	req := newGetHistoryRequest(namespace, workflowID, resetBaseRunID, historyPageSize)
+	for {
+		resp, err := client.GetWorkflowExecutionHistory(ctx, req)
+		if err != nil {
+			return "", 0, err
+		}
+		for _, e := range resp.GetHistory().GetEvents() {
+			if e.GetEventType() == enumspb.EVENT_TYPE_WORKFLOW_TASK_COMPLETED {
+				workflowTaskCompletedID = e.GetEventId()
+			}
+		}
+		if len(resp.NextPageToken) == 0 {
+			break
+		}
+		req.NextPageToken = resp.NextPageToken
+	}
+	if workflowTaskCompletedID == 0 {
+		return "", 0, serviceerror.NewInvalidArgument("no WorkflowTaskCompletedID")
+	}
+	return resetBaseRunID, workflowTaskCompletedID, nil
+}
+
+// Returns id of the first workflow task completed by the bad binary, taken from the auto reset points of the run.
+func getBadWorkflowTaskCompletedID(
+	ctx context.Context,
+	client workflowservice.WorkflowServiceClient,
+	namespace string,
+	workflowID string,
+	runID string,
+	binChecksum string,
+) (resetBaseRunID string, workflowTaskCompletedID int64, err error) {
+
+	resp, err := client.DescribeWorkflowExecution(ctx, &workflowservice.DescribeWorkflowExecutionRequest{
+		Namespace: namespace,
+		Execution: &commonpb.WorkflowExecution{
+			WorkflowId: workflowID,
+			RunId:      runID,
+		},
+	})
+	if err != nil {
+		return "", 0, err
+	}
+
+	_, p := history.FindAutoResetPoint(clock.NewRealTimeSource(), &namespacepb.BadBinaries{
+		Binaries: map[string]*namespacepb.BadBinaryInfo{
+			binChecksum: {},
+		},
+	}, resp.GetWorkflowExecutionInfo().GetAutoResetPoints())
+	if p != nil {
+		workflowTaskCompletedID = p.GetFirstWorkflowTaskCompletedId()
+	}
+
+	if workflowTaskCompletedID == 0 {
+		return "", 0, serviceerror.NewInvalidArgument("no WorkflowTaskCompletedID")
+	}
+	return runID, workflowTaskCompletedID, nil
+}
+
+func newGetHistoryRequest(namespace string, workflowID string, runID string, pageSize int32) *workflowservice.GetWorkflowExecutionHistoryRequest {
+	return &workflowservice.GetWorkflowExecutionHistoryRequest{
+		Namespace: namespace,
+		Execution: &commonpb.WorkflowExecution{
+			WorkflowId: workflowID,
+			RunId:      runID,
+		},
+		MaximumPageSize: pageSize,
+	}
+}
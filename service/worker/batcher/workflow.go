@@ -38,6 +38,7 @@ import (
 	"go.temporal.io/sdk/workflow"
 	"golang.org/x/time/rate"
 
+	"go.temporal.io/server/api/adminservice/v1"
 	"go.temporal.io/server/client/frontend"
 	"go.temporal.io/server/common/convert"
 	"go.temporal.io/server/common/log"
@@ -73,10 +74,16 @@ const (
 	BatchTypeCancel = "cancel"
 	// BatchTypeSignal is batch type for signaling workflows
 	BatchTypeSignal = "signal"
+	// BatchTypeReset is batch type for resetting workflows
+	BatchTypeReset = "reset"
+	// BatchTypeDelete is batch type for deleting workflows
+	BatchTypeDelete = "delete"
 )
 
-// AllBatchTypes is the batch types we supported
-var AllBatchTypes = []string{BatchTypeTerminate, BatchTypeCancel, BatchTypeSignal}
+// AllBatchTypes is the batch types we supported. Re-running failed activities is not supported as a batch
+// type, history has no API to retry a single activity of a workflow; use BatchTypeReset with
+// ResetTypeLastWorkflowTask to re-run the activities scheduled by the last workflow task instead.
+var AllBatchTypes = []string{BatchTypeTerminate, BatchTypeCancel, BatchTypeSignal, BatchTypeReset, BatchTypeDelete}
 
 type (
 	// TerminateParams is the parameters for terminating workflow
@@ -101,6 +108,20 @@ type (
 		Input      *commonpb.Payloads
 	}
 
+	// ResetParams is the parameters for resetting workflow
+	ResetParams struct {
+		// where to reset, one of AllResetTypes
+		ResetType string
+		// binary checksum, required for ResetTypeBadBinary
+		BadBinaryChecksum string
+		// skip the workflow if its current run is open
+		SkipCurrentOpen bool
+		// skip the workflow if the base run is not the current run
+		SkipBaseNotCurrent bool
+		// only reset the workflow if its last workflow task failed with nondeterministic error
+		NonDeterministicOnly bool
+	}
+
 	// BatchParams is the parameters for batch operation workflow
 	BatchParams struct {
 		// Target namespace to execute batch operation
@@ -109,7 +130,7 @@ type (
 		Query string
 		// Reason for the operation
 		Reason string
-		// Supporting: signal,cancel,terminate
+		// Supporting: signal,cancel,terminate,reset,delete
 		BatchType string
 
 		// Below are all optional
@@ -119,6 +140,8 @@ type (
 		CancelParams CancelParams
 		// SignalParams is params only for BatchTypeSignal
 		SignalParams SignalParams
+		// ResetParams is params only for BatchTypeReset
+		ResetParams ResetParams
 		// RPS of processing. Default to DefaultRPS
 		// TODO we will implement smarter way than this static rate limiter: https://go.temporal.io/server/issues/2138
 		RPS int
@@ -195,7 +218,9 @@ func validateParams(params BatchParams) error {
 			return fmt.Errorf("must provide signal name")
 		}
 		return nil
-	case BatchTypeCancel, BatchTypeTerminate:
+	case BatchTypeReset:
+		return ValidateResetType(params.ResetParams.ResetType, params.ResetParams.BadBinaryChecksum)
+	case BatchTypeCancel, BatchTypeTerminate, BatchTypeDelete:
 		return nil
 	default:
 		return fmt.Errorf("not supported batch type: %v", params.BatchType)
@@ -254,6 +279,7 @@ func BatchActivity(ctx context.Context, batchParams BatchParams) (HeartBeatDetai
 		}
 		hbd.TotalEstimate = resp.GetCount()
 	}
+
 	rateLimiter := rate.NewLimiter(rate.Limit(batchParams.RPS), batchParams.RPS)
 	taskCh := make(chan taskDetail, pageSize)
 	respCh := make(chan error, pageSize)
@@ -389,6 +415,27 @@ func startTaskProcessor(
 						})
 						return err
 					})
+			case BatchTypeReset:
+				err = processTask(ctx, limiter, task, batchParams, client, convert.BoolPtr(false),
+					func(workflowID, runID string) error {
+						return resetWorkflow(ctx, client, batchParams, workflowID, runID)
+					})
+			case BatchTypeDelete:
+				err = processTask(ctx, limiter, task, batchParams, client, convert.BoolPtr(false),
+					func(workflowID, runID string) error {
+						// delete is not part of workflowservice, it goes through the adminservice of the
+						// current cluster to be subject to the same authorization and audit as other callers
+						adminClient := batcher.clientBean.GetRemoteAdminClient(batcher.cfg.ClusterMetadata.GetCurrentClusterName())
+						_, err := adminClient.DeleteWorkflowExecution(ctx, &adminservice.DeleteWorkflowExecutionRequest{
+							Namespace: batchParams.Namespace,
+							Execution: &commonpb.WorkflowExecution{
+								WorkflowId: workflowID,
+								RunId:      runID,
+							},
+							Identity: BatchWFTypeName,
+						})
+						return err
+					})
 			}
 			if err != nil {
 				batcher.metricsClient.IncCounter(metrics.BatcherScope, metrics.BatcherProcessorFailures)
@@ -0,0 +1,299 @@
+// The MIT License
+//
+// Copyright (c) 2020 Temporal Technologies Inc.  All rights reserved.
+//
+// Copyright (c) 2020 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package retention
+
+import (
+	"context"
+	"time"
+
+	commonpb "go.temporal.io/api/common/v1"
+	"go.temporal.io/api/serviceerror"
+	"go.temporal.io/sdk/activity"
+	"golang.org/x/time/rate"
+
+	enumsspb "go.temporal.io/server/api/enums/v1"
+	"go.temporal.io/server/common"
+	"go.temporal.io/server/common/cache"
+	"go.temporal.io/server/common/convert"
+	"go.temporal.io/server/common/log"
+	"go.temporal.io/server/common/log/tag"
+	"go.temporal.io/server/common/metrics"
+	"go.temporal.io/server/common/persistence"
+	"go.temporal.io/server/common/primitives/timestamp"
+)
+
+type (
+	// EnforcerHeartbeatDetails is the heartbeat detail for RetentionEnforcerActivity
+	EnforcerHeartbeatDetails struct {
+		NextPageToken []byte
+		CurrentPage   int
+		SkipCount     int
+		ErrorCount    int
+		DeletedCount  int
+	}
+
+	// ExecutionManagerProvider returns the execution manager of the given shard
+	ExecutionManagerProvider func(shardID int32) (persistence.ExecutionManager, error)
+
+	// Enforcer is the type that holds the state for the retention enforcer daemon
+	Enforcer struct {
+		historyMgr           persistence.HistoryManager
+		executionMgrProvider ExecutionManagerProvider
+		namespaceCache       cache.NamespaceCache
+		numShards            int32
+		hbd                  EnforcerHeartbeatDetails
+		limiter              *rate.Limiter
+		metrics              metrics.Client
+		logger               log.Logger
+		isInTest             bool
+	}
+
+	branchDetail struct {
+		namespaceID string
+		workflowID  string
+		runID       string
+		shardID     int32
+		treeID      string
+		branchID    string
+	}
+)
+
+const (
+	pageSize = 1000
+	// retentionGracePeriod is added on top of the namespace retention before anything gets deleted.
+	// The retention timer of the history service archives the history before deleting it, so the
+	// enforcer must only pick up what that timer missed and never race with it.
+	retentionGracePeriod = 24 * time.Hour
+)
+
+// NewEnforcer returns an instance of the retention enforcer daemon
+// The Enforcer can be started by calling the Run() method on the
+// returned object. Calling the Run() method will result in one
+// complete iteration over all of the history branches in the system. For
+// each branch, the enforcer will
+//   - load the owning workflow execution from the executions table
+//   - delete the execution and the branch, if the workflow closed longer ago than the namespace retention
+//   - delete the branch, if there is no owning execution and the branch is older than the namespace retention
+func NewEnforcer(
+	historyMgr persistence.HistoryManager,
+	executionMgrProvider ExecutionManagerProvider,
+	namespaceCache cache.NamespaceCache,
+	numShards int32,
+	rps int,
+	hbd EnforcerHeartbeatDetails,
+	metricsClient metrics.Client,
+	logger log.Logger,
+) *Enforcer {
+
+	return &Enforcer{
+		historyMgr:           historyMgr,
+		executionMgrProvider: executionMgrProvider,
+		namespaceCache:       namespaceCache,
+		numShards:            numShards,
+		hbd:                  hbd,
+		limiter:              rate.NewLimiter(rate.Limit(rps), rps),
+		metrics:              metricsClient,
+		logger:               logger,
+	}
+}
+
+// Run runs the enforcer
+func (e *Enforcer) Run(ctx context.Context) (EnforcerHeartbeatDetails, error) {
+	for {
+		if err := e.limiter.Wait(ctx); err != nil {
+			return e.hbd, err
+		}
+		resp, err := e.historyMgr.GetAllHistoryTreeBranches(&persistence.GetAllHistoryTreeBranchesRequest{
+			PageSize:      pageSize,
+			NextPageToken: e.hbd.NextPageToken,
+		})
+		if err != nil {
+			return e.hbd, err
+		}
+
+		for _, br := range resp.Branches {
+			if err := ctx.Err(); err != nil {
+				return e.hbd, err
+			}
+
+			deleted, err := e.enforce(ctx, br)
+			switch {
+			case err != nil:
+				e.hbd.ErrorCount++
+				e.metrics.IncCounter(metrics.RetentionEnforcerScope, metrics.RetentionEnforcerErrorCount)
+				e.logger.Error("unable to enforce retention on history branch",
+					tag.Error(err), tag.WorkflowTreeID(br.TreeID), tag.WorkflowBranchID(br.BranchID), tag.DetailInfo(br.Info))
+			case deleted:
+				e.hbd.DeletedCount++
+				e.metrics.IncCounter(metrics.RetentionEnforcerScope, metrics.RetentionEnforcerDeletedCount)
+			default:
+				e.hbd.SkipCount++
+				e.metrics.IncCounter(metrics.RetentionEnforcerScope, metrics.RetentionEnforcerSkipCount)
+			}
+			e.recordHeartbeat(ctx)
+		}
+
+		e.hbd.CurrentPage++
+		e.hbd.NextPageToken = resp.NextPageToken
+		e.recordHeartbeat(ctx)
+
+		if len(e.hbd.NextPageToken) == 0 {
+			break
+		}
+	}
+	return e.hbd, nil
+}
+
+// enforce deletes the given branch if it is past retention and reports whether it did
+func (e *Enforcer) enforce(
+	ctx context.Context,
+	branch persistence.HistoryBranchDetail,
+) (bool, error) {
+
+	namespaceID, workflowID, runID, err := persistence.SplitHistoryGarbageCleanupInfo(branch.Info)
+	if err != nil {
+		return false, err
+	}
+	entry, err := e.namespaceCache.GetNamespaceByID(namespaceID)
+	if err != nil {
+		return false, err
+	}
+	expiration := *timestamp.DurationFromDays(entry.GetRetentionDays(workflowID)) + retentionGracePeriod
+	now := time.Now().UTC()
+
+	task := branchDetail{
+		namespaceID: namespaceID,
+		workflowID:  workflowID,
+		runID:       runID,
+		shardID:     common.WorkflowIDToHistoryShard(namespaceID, workflowID, e.numShards),
+		treeID:      branch.TreeID,
+		branchID:    branch.BranchID,
+	}
+	executionMgr, err := e.executionMgrProvider(task.shardID)
+	if err != nil {
+		return false, err
+	}
+
+	if err := e.limiter.Wait(ctx); err != nil {
+		return false, err
+	}
+	resp, err := executionMgr.GetWorkflowExecution(&persistence.GetWorkflowExecutionRequest{
+		NamespaceID: namespaceID,
+		Execution: commonpb.WorkflowExecution{
+			WorkflowId: workflowID,
+			RunId:      runID,
+		},
+	})
+	switch err.(type) {
+	case nil:
+		executionInfo := resp.State.ExecutionInfo
+		if executionInfo.ExecutionState.GetState() != enumsspb.WORKFLOW_EXECUTION_STATE_COMPLETED {
+			return false, nil
+		}
+		if now.Before(timestamp.TimeValue(executionInfo.LastUpdatedTime).Add(expiration)) {
+			return false, nil
+		}
+		if err := e.deleteExecution(ctx, executionMgr, task); err != nil {
+			return false, err
+		}
+	case *serviceerror.NotFound:
+		// the branch has no owning execution, only its own age tells how long it has been around
+		if now.Before(timestamp.TimeValue(branch.ForkTime).Add(expiration)) {
+			return false, nil
+		}
+	default:
+		return false, err
+	}
+
+	if err := e.deleteBranch(ctx, task); err != nil {
+		return false, err
+	}
+	e.logger.Info("deleted history past retention", getTaskLoggingTags(task)...)
+	return true, nil
+}
+
+func (e *Enforcer) deleteExecution(
+	ctx context.Context,
+	executionMgr persistence.ExecutionManager,
+	task branchDetail,
+) error {
+
+	if err := e.limiter.Wait(ctx); err != nil {
+		return err
+	}
+	// only removes the current record if it still points to this run
+	if err := executionMgr.DeleteCurrentWorkflowExecution(&persistence.DeleteCurrentWorkflowExecutionRequest{
+		NamespaceID: task.namespaceID,
+		WorkflowID:  task.workflowID,
+		RunID:       task.runID,
+	}); err != nil {
+		return err
+	}
+
+	if err := e.limiter.Wait(ctx); err != nil {
+		return err
+	}
+	return executionMgr.DeleteWorkflowExecution(&persistence.DeleteWorkflowExecutionRequest{
+		NamespaceID: task.namespaceID,
+		WorkflowID:  task.workflowID,
+		RunID:       task.runID,
+	})
+}
+
+func (e *Enforcer) deleteBranch(
+	ctx context.Context,
+	task branchDetail,
+) error {
+
+	branchToken, err := persistence.NewHistoryBranchTokenByBranchID(task.treeID, task.branchID)
+	if err != nil {
+		return err
+	}
+
+	if err := e.limiter.Wait(ctx); err != nil {
+		return err
+	}
+	return e.historyMgr.DeleteHistoryBranch(&persistence.DeleteHistoryBranchRequest{
+		BranchToken: branchToken,
+		ShardID:     convert.Int32Ptr(task.shardID),
+	})
+}
+
+func (e *Enforcer) recordHeartbeat(ctx context.Context) {
+	if !e.isInTest {
+		activity.RecordHeartbeat(ctx, e.hbd)
+	}
+}
+
+func getTaskLoggingTags(task branchDetail) []tag.Tag {
+	return []tag.Tag{
+		tag.WorkflowNamespaceID(task.namespaceID),
+		tag.WorkflowID(task.workflowID),
+		tag.WorkflowRunID(task.runID),
+		tag.ShardID(task.shardID),
+		tag.WorkflowTreeID(task.treeID),
+		tag.WorkflowBranchID(task.branchID),
+	}
+}
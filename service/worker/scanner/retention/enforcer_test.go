@@ -0,0 +1,244 @@
+// The MIT License
+//
+// Copyright (c) 2020 Temporal Technologies Inc.  All rights reserved.
+//
+// Copyright (c) 2020 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package retention
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	"github.com/stretchr/testify/suite"
+	"github.com/uber-go/tally"
+	commonpb "go.temporal.io/api/common/v1"
+	"go.temporal.io/api/serviceerror"
+
+	enumsspb "go.temporal.io/server/api/enums/v1"
+	"go.temporal.io/server/api/persistenceblobs/v1"
+	"go.temporal.io/server/common"
+	"go.temporal.io/server/common/cache"
+	"go.temporal.io/server/common/convert"
+	"go.temporal.io/server/common/log/loggerimpl"
+	"go.temporal.io/server/common/metrics"
+	"go.temporal.io/server/common/mocks"
+	p "go.temporal.io/server/common/persistence"
+	"go.temporal.io/server/common/primitives/timestamp"
+)
+
+type (
+	enforcerSuite struct {
+		suite.Suite
+		*require.Assertions
+
+		controller         *gomock.Controller
+		mockNamespaceCache *cache.MockNamespaceCache
+		mockHistoryMgr     *mocks.HistoryV2Manager
+		mockExecutionMgr   *mocks.ExecutionManager
+
+		enforcer *Enforcer
+	}
+)
+
+const (
+	testNamespaceID = "deadbeef-0123-4567-890a-bcdef0123456"
+	testWorkflowID  = "retention-enforcer-test-workflow-id"
+	testRunID       = "0d00698f-08e1-4d36-a3e2-3bf109f5d2d6"
+	testTreeID      = "deadbeef-17ee-0000-0000-000000000001"
+	testBranchID    = "deadbeef-face-0000-0000-000000000001"
+	testNumShards   = 4
+)
+
+func TestEnforcerSuite(t *testing.T) {
+	s := new(enforcerSuite)
+	suite.Run(t, s)
+}
+
+func (s *enforcerSuite) SetupTest() {
+	s.Assertions = require.New(s.T())
+
+	s.controller = gomock.NewController(s.T())
+	s.mockNamespaceCache = cache.NewMockNamespaceCache(s.controller)
+	s.mockHistoryMgr = &mocks.HistoryV2Manager{}
+	s.mockExecutionMgr = &mocks.ExecutionManager{}
+
+	s.mockNamespaceCache.EXPECT().GetNamespaceByID(testNamespaceID).Return(cache.NewLocalNamespaceCacheEntryForTest(
+		&persistenceblobs.NamespaceInfo{Id: testNamespaceID},
+		&persistenceblobs.NamespaceConfig{Retention: timestamp.DurationFromDays(7)},
+		"active",
+		nil,
+	), nil).AnyTimes()
+
+	s.enforcer = NewEnforcer(
+		s.mockHistoryMgr,
+		func(shardID int32) (p.ExecutionManager, error) {
+			s.Equal(common.WorkflowIDToHistoryShard(testNamespaceID, testWorkflowID, testNumShards), shardID)
+			return s.mockExecutionMgr, nil
+		},
+		s.mockNamespaceCache,
+		testNumShards,
+		100,
+		EnforcerHeartbeatDetails{},
+		metrics.NewClient(tally.NoopScope, metrics.Worker),
+		loggerimpl.NewNopLogger(),
+	)
+	s.enforcer.isInTest = true
+}
+
+func (s *enforcerSuite) TearDownTest() {
+	s.controller.Finish()
+	s.mockHistoryMgr.AssertExpectations(s.T())
+	s.mockExecutionMgr.AssertExpectations(s.T())
+}
+
+func (s *enforcerSuite) TestRun_Pagination() {
+	s.mockHistoryMgr.On("GetAllHistoryTreeBranches", &p.GetAllHistoryTreeBranchesRequest{
+		PageSize: pageSize,
+	}).Return(&p.GetAllHistoryTreeBranchesResponse{
+		NextPageToken: []byte("page1"),
+		Branches:      []p.HistoryBranchDetail{s.newBranch(time.Hour, "error-info")},
+	}, nil).Once()
+	s.mockHistoryMgr.On("GetAllHistoryTreeBranches", &p.GetAllHistoryTreeBranchesRequest{
+		PageSize:      pageSize,
+		NextPageToken: []byte("page1"),
+	}).Return(&p.GetAllHistoryTreeBranchesResponse{
+		Branches: []p.HistoryBranchDetail{s.newBranch(time.Hour, "error-info")},
+	}, nil).Once()
+
+	hbd, err := s.enforcer.Run(context.Background())
+	s.NoError(err)
+	s.Equal(EnforcerHeartbeatDetails{CurrentPage: 2, ErrorCount: 2}, hbd)
+}
+
+func (s *enforcerSuite) TestRun_RunningWorkflow_Skipped() {
+	s.expectBranches(s.newBranch(30*24*time.Hour, ""))
+	s.expectExecution(enumsspb.WORKFLOW_EXECUTION_STATE_RUNNING, 30*24*time.Hour)
+
+	hbd, err := s.enforcer.Run(context.Background())
+	s.NoError(err)
+	s.Equal(EnforcerHeartbeatDetails{CurrentPage: 1, SkipCount: 1}, hbd)
+}
+
+func (s *enforcerSuite) TestRun_ClosedWithinRetention_Skipped() {
+	s.expectBranches(s.newBranch(30*24*time.Hour, ""))
+	s.expectExecution(enumsspb.WORKFLOW_EXECUTION_STATE_COMPLETED, 7*24*time.Hour)
+
+	hbd, err := s.enforcer.Run(context.Background())
+	s.NoError(err)
+	s.Equal(EnforcerHeartbeatDetails{CurrentPage: 1, SkipCount: 1}, hbd)
+}
+
+func (s *enforcerSuite) TestRun_ClosedPastRetention_Deleted() {
+	s.expectBranches(s.newBranch(30*24*time.Hour, ""))
+	s.expectExecution(enumsspb.WORKFLOW_EXECUTION_STATE_COMPLETED, 9*24*time.Hour)
+	s.mockExecutionMgr.On("DeleteCurrentWorkflowExecution", &p.DeleteCurrentWorkflowExecutionRequest{
+		NamespaceID: testNamespaceID,
+		WorkflowID:  testWorkflowID,
+		RunID:       testRunID,
+	}).Return(nil).Once()
+	s.mockExecutionMgr.On("DeleteWorkflowExecution", &p.DeleteWorkflowExecutionRequest{
+		NamespaceID: testNamespaceID,
+		WorkflowID:  testWorkflowID,
+		RunID:       testRunID,
+	}).Return(nil).Once()
+	s.expectDeleteBranch(nil)
+
+	hbd, err := s.enforcer.Run(context.Background())
+	s.NoError(err)
+	s.Equal(EnforcerHeartbeatDetails{CurrentPage: 1, DeletedCount: 1}, hbd)
+}
+
+func (s *enforcerSuite) TestRun_OrphanBranch() {
+	s.expectBranches(
+		s.newBranch(9*24*time.Hour, ""),
+		s.newBranch(7*24*time.Hour, ""),
+	)
+	s.mockExecutionMgr.On("GetWorkflowExecution", mock.Anything).
+		Return(nil, serviceerror.NewNotFound("workflow execution not found")).Twice()
+	s.expectDeleteBranch(nil)
+
+	hbd, err := s.enforcer.Run(context.Background())
+	s.NoError(err)
+	s.Equal(EnforcerHeartbeatDetails{CurrentPage: 1, DeletedCount: 1, SkipCount: 1}, hbd)
+}
+
+func (s *enforcerSuite) TestRun_DeleteError() {
+	s.expectBranches(s.newBranch(9*24*time.Hour, ""))
+	s.mockExecutionMgr.On("GetWorkflowExecution", mock.Anything).
+		Return(nil, serviceerror.NewNotFound("workflow execution not found")).Once()
+	s.expectDeleteBranch(errors.New("some random error"))
+
+	hbd, err := s.enforcer.Run(context.Background())
+	s.NoError(err)
+	s.Equal(EnforcerHeartbeatDetails{CurrentPage: 1, ErrorCount: 1}, hbd)
+}
+
+func (s *enforcerSuite) newBranch(age time.Duration, info string) p.HistoryBranchDetail {
+	if info == "" {
+		info = p.BuildHistoryGarbageCleanupInfo(testNamespaceID, testWorkflowID, testRunID)
+	}
+	return p.HistoryBranchDetail{
+		TreeID:   testTreeID,
+		BranchID: testBranchID,
+		ForkTime: timestamp.TimeNowPtrUtcAddDuration(-age),
+		Info:     info,
+	}
+}
+
+func (s *enforcerSuite) expectBranches(branches ...p.HistoryBranchDetail) {
+	s.mockHistoryMgr.On("GetAllHistoryTreeBranches", &p.GetAllHistoryTreeBranchesRequest{
+		PageSize: pageSize,
+	}).Return(&p.GetAllHistoryTreeBranchesResponse{
+		Branches: branches,
+	}, nil).Once()
+}
+
+func (s *enforcerSuite) expectExecution(state enumsspb.WorkflowExecutionState, lastUpdated time.Duration) {
+	s.mockExecutionMgr.On("GetWorkflowExecution", &p.GetWorkflowExecutionRequest{
+		NamespaceID: testNamespaceID,
+		Execution: commonpb.WorkflowExecution{
+			WorkflowId: testWorkflowID,
+			RunId:      testRunID,
+		},
+	}).Return(&p.GetWorkflowExecutionResponse{
+		State: &p.WorkflowMutableState{
+			ExecutionInfo: &p.WorkflowExecutionInfo{
+				ExecutionState:  &persistenceblobs.WorkflowExecutionState{State: state},
+				LastUpdatedTime: timestamp.TimeNowPtrUtcAddDuration(-lastUpdated),
+			},
+		},
+	}, nil).Once()
+}
+
+func (s *enforcerSuite) expectDeleteBranch(err error) {
+	branchToken, tokenErr := p.NewHistoryBranchTokenByBranchID(testTreeID, testBranchID)
+	s.NoError(tokenErr)
+	s.mockHistoryMgr.On("DeleteHistoryBranch", &p.DeleteHistoryBranchRequest{
+		BranchToken: branchToken,
+		ShardID:     convert.Int32Ptr(common.WorkflowIDToHistoryShard(testNamespaceID, testWorkflowID, testNumShards)),
+	}).Return(err).Once()
+}
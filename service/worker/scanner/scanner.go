@@ -70,6 +70,8 @@ type (
 		HistoryScannerEnabled dynamicconfig.BoolPropertyFn
 		// ExecutionsScannerEnabled indicates if executions scanner should be started as part of scanner
 		ExecutionsScannerEnabled dynamicconfig.BoolPropertyFn
+		// RetentionScannerEnabled indicates if retention scanner should be started as part of scanner
+		RetentionScannerEnabled dynamicconfig.BoolPropertyFn
 	}
 
 	// BootstrapParams contains the set of params needed to bootstrap
@@ -136,15 +138,22 @@ func (s *Scanner) Start() error {
 		workerTaskQueueNames = append(workerTaskQueueNames, historyScannerTaskQueueName)
 	}
 
+	if s.context.cfg.Persistence.DefaultStoreType() == config.StoreTypeSQL && s.context.cfg.RetentionScannerEnabled() {
+		go s.startWorkflowWithRetry(retentionScannerWFStartOptions, retentionScannerWFTypeName)
+		workerTaskQueueNames = append(workerTaskQueueNames, retentionScannerTaskQueueName)
+	}
+
 	for _, tl := range workerTaskQueueNames {
 		work := worker.New(s.context.GetSDKClient(), tl, workerOpts)
 
 		work.RegisterWorkflowWithOptions(TaskQueueScannerWorkflow, workflow.RegisterOptions{Name: tqScannerWFTypeName})
 		work.RegisterWorkflowWithOptions(HistoryScannerWorkflow, workflow.RegisterOptions{Name: historyScannerWFTypeName})
 		work.RegisterWorkflowWithOptions(ExecutionsScannerWorkflow, workflow.RegisterOptions{Name: executionsScannerWFTypeName})
+		work.RegisterWorkflowWithOptions(RetentionScannerWorkflow, workflow.RegisterOptions{Name: retentionScannerWFTypeName})
 		work.RegisterActivityWithOptions(TaskQueueScavengerActivity, activity.RegisterOptions{Name: taskQueueScavengerActivityName})
 		work.RegisterActivityWithOptions(HistoryScavengerActivity, activity.RegisterOptions{Name: historyScavengerActivityName})
 		work.RegisterActivityWithOptions(ExecutionsScavengerActivity, activity.RegisterOptions{Name: executionsScavengerActivityName})
+		work.RegisterActivityWithOptions(RetentionEnforcerActivity, activity.RegisterOptions{Name: retentionEnforcerActivityName})
 
 		if err := work.Start(); err != nil {
 			return err
@@ -37,6 +37,7 @@ import (
 	"go.temporal.io/server/common/log/tag"
 	"go.temporal.io/server/service/worker/scanner/executions"
 	"go.temporal.io/server/service/worker/scanner/history"
+	"go.temporal.io/server/service/worker/scanner/retention"
 	"go.temporal.io/server/service/worker/scanner/taskqueue"
 )
 
@@ -65,6 +66,11 @@ const (
 	executionsScannerWFTypeName     = "temporal-sys-executions-scanner-workflow"
 	executionsScannerTaskQueueName  = "temporal-sys-executions-scanner-taskqueue-0"
 	executionsScavengerActivityName = "temporal-sys-executions-scanner-scvg-activity"
+
+	retentionScannerWFID          = "temporal-sys-retention-scanner"
+	retentionScannerWFTypeName    = "temporal-sys-retention-scanner-workflow"
+	retentionScannerTaskQueueName = "temporal-sys-retention-scanner-taskqueue-0"
+	retentionEnforcerActivityName = "temporal-sys-retention-scanner-enforcer-activity"
 )
 
 var (
@@ -101,6 +107,12 @@ var (
 		WorkflowIDReusePolicy: enumspb.WORKFLOW_ID_REUSE_POLICY_ALLOW_DUPLICATE,
 		CronSchedule:          "0 */12 * * *",
 	}
+	retentionScannerWFStartOptions = client.StartWorkflowOptions{
+		ID:                    retentionScannerWFID,
+		TaskQueue:             retentionScannerTaskQueueName,
+		WorkflowIDReusePolicy: enumspb.WORKFLOW_ID_REUSE_POLICY_ALLOW_DUPLICATE,
+		CronSchedule:          "0 */12 * * *",
+	}
 )
 
 // TaskQueueScannerWorkflow is the workflow that runs the task queue scanner background daemon
@@ -134,6 +146,18 @@ func ExecutionsScannerWorkflow(
 	return future.Get(ctx, nil)
 }
 
+// RetentionScannerWorkflow is the workflow that runs the retention scanner background daemon
+func RetentionScannerWorkflow(
+	ctx workflow.Context,
+) error {
+
+	future := workflow.ExecuteActivity(
+		workflow.WithActivityOptions(ctx, activityOptions),
+		retentionEnforcerActivityName,
+	)
+	return future.Get(ctx, nil)
+}
+
 // HistoryScavengerActivity is the activity that runs history scavenger
 func HistoryScavengerActivity(
 	activityCtx context.Context,
@@ -160,6 +184,34 @@ func HistoryScavengerActivity(
 	return scavenger.Run(activityCtx)
 }
 
+// RetentionEnforcerActivity is the activity that runs retention enforcer
+func RetentionEnforcerActivity(
+	activityCtx context.Context,
+) (retention.EnforcerHeartbeatDetails, error) {
+
+	ctx := activityCtx.Value(scannerContextKey).(scannerContext)
+	rps := ctx.cfg.PersistenceMaxQPS()
+
+	hbd := retention.EnforcerHeartbeatDetails{}
+	if activity.HasHeartbeatDetails(activityCtx) {
+		if err := activity.GetHeartbeatDetails(activityCtx, &hbd); err != nil {
+			ctx.GetLogger().Error("Failed to recover from last heartbeat, start over from beginning", tag.Error(err))
+		}
+	}
+
+	enforcer := retention.NewEnforcer(
+		ctx.GetHistoryManager(),
+		ctx.GetExecutionManager,
+		ctx.GetNamespaceCache(),
+		ctx.cfg.Persistence.NumHistoryShards,
+		rps,
+		hbd,
+		ctx.GetMetricsClient(),
+		ctx.GetLogger(),
+	)
+	return enforcer.Run(activityCtx)
+}
+
 // TaskQueueScavengerActivity is the activity that runs task queue scavenger
 func TaskQueueScavengerActivity(
 	activityCtx context.Context,
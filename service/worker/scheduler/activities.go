@@ -0,0 +1,216 @@
+// The MIT License
+//
+// Copyright (c) 2020 Temporal Technologies Inc.  All rights reserved.
+//
+// Copyright (c) 2020 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package scheduler
+
+import (
+	"context"
+	"time"
+
+	commonpb "go.temporal.io/api/common/v1"
+	enumspb "go.temporal.io/api/enums/v1"
+	historypb "go.temporal.io/api/history/v1"
+	"go.temporal.io/api/serviceerror"
+	taskqueuepb "go.temporal.io/api/taskqueue/v1"
+	"go.temporal.io/api/workflowservice/v1"
+	"go.temporal.io/sdk/activity"
+
+	"go.temporal.io/server/common/log"
+	"go.temporal.io/server/common/log/tag"
+	"go.temporal.io/server/common/metrics"
+	"go.temporal.io/server/common/primitives/timestamp"
+)
+
+const (
+	// watchPollTimeout is the timeout of a single long poll for the close event of a workflow
+	watchPollTimeout = time.Minute
+)
+
+type (
+	// StartWorkflowRequest is the input of the start workflow activity
+	StartWorkflowRequest struct {
+		Namespace   string
+		ScheduleID  string
+		Action      StartWorkflowAction
+		NominalTime time.Time
+		ActualTime  time.Time
+		RequestID   string
+	}
+
+	// StartWorkflowResponse is the result of the start workflow activity
+	StartWorkflowResponse struct {
+		WorkflowID string
+		RunID      string
+	}
+
+	// WatchWorkflowRequest is the input of the watch workflow activity
+	WatchWorkflowRequest struct {
+		Namespace string
+		Execution commonpb.WorkflowExecution
+	}
+
+	// WatchWorkflowResponse is the result of the watch workflow activity
+	WatchWorkflowResponse struct {
+		Status enumspb.WorkflowExecutionStatus
+	}
+
+	// CancelWorkflowRequest is the input of the cancel workflow activity
+	CancelWorkflowRequest struct {
+		Namespace string
+		Execution commonpb.WorkflowExecution
+		Reason    string
+	}
+)
+
+// StartWorkflowActivity starts the workflow of a schedule action
+func StartWorkflowActivity(ctx context.Context, request StartWorkflowRequest) (*StartWorkflowResponse, error) {
+	s := ctx.Value(schedulerContextKey).(*Scheduler)
+	action := request.Action
+	// nominal times can be less than a second apart, e.g. with jitter or backfills, keep the full precision
+	workflowID := action.WorkflowID + "-" + request.NominalTime.UTC().Format(time.RFC3339Nano)
+
+	resp, err := s.clientBean.GetFrontendClient().StartWorkflowExecution(ctx, &workflowservice.StartWorkflowExecutionRequest{
+		Namespace:                request.Namespace,
+		WorkflowId:               workflowID,
+		WorkflowType:             &commonpb.WorkflowType{Name: action.WorkflowType},
+		TaskQueue:                &taskqueuepb.TaskQueue{Name: action.TaskQueue},
+		Input:                    action.Input,
+		WorkflowExecutionTimeout: timestamp.DurationPtr(action.WorkflowExecutionTimeout),
+		WorkflowRunTimeout:       timestamp.DurationPtr(action.WorkflowRunTimeout),
+		WorkflowTaskTimeout:      timestamp.DurationPtr(action.WorkflowTaskTimeout),
+		Identity:                 WorkflowTypeName + ":" + request.ScheduleID,
+		RequestId:                request.RequestID,
+		WorkflowIdReusePolicy:    enumspb.WORKFLOW_ID_REUSE_POLICY_ALLOW_DUPLICATE,
+		RetryPolicy:              action.RetryPolicy,
+		Memo:                     action.Memo,
+		SearchAttributes:         action.SearchAttributes,
+		Header:                   action.Header,
+	})
+	if err != nil {
+		s.metricsClient.IncCounter(metrics.SchedulerScope, metrics.SchedulerStartWorkflowFailures)
+		getActivityLogger(ctx).Error("Failed to start workflow for schedule",
+			tag.WorkflowNamespace(request.Namespace),
+			tag.WorkflowID(workflowID),
+			tag.Error(err))
+		return nil, err
+	}
+
+	s.metricsClient.IncCounter(metrics.SchedulerScope, metrics.SchedulerStartWorkflowSuccess)
+	s.metricsClient.RecordTimer(metrics.SchedulerScope, metrics.SchedulerStartWorkflowDelay, time.Now().UTC().Sub(request.ActualTime))
+	return &StartWorkflowResponse{
+		WorkflowID: workflowID,
+		RunID:      resp.GetRunId(),
+	}, nil
+}
+
+// WatchWorkflowActivity long polls until the given workflow closed, following continue-as-new runs
+func WatchWorkflowActivity(ctx context.Context, request WatchWorkflowRequest) (*WatchWorkflowResponse, error) {
+	s := ctx.Value(schedulerContextKey).(*Scheduler)
+	client := s.clientBean.GetFrontendClient()
+	execution := request.Execution
+
+	for {
+		activity.RecordHeartbeat(ctx)
+
+		pollCtx, cancel := context.WithTimeout(ctx, watchPollTimeout)
+		resp, err := client.GetWorkflowExecutionHistory(pollCtx, &workflowservice.GetWorkflowExecutionHistoryRequest{
+			Namespace:              request.Namespace,
+			Execution:              &execution,
+			WaitNewEvent:           true,
+			HistoryEventFilterType: enumspb.HISTORY_EVENT_FILTER_TYPE_CLOSE_EVENT,
+		})
+		cancel()
+
+		switch err.(type) {
+		case nil:
+		case *serviceerror.NotFound:
+			// workflow is deleted, nothing to wait for
+			return &WatchWorkflowResponse{Status: enumspb.WORKFLOW_EXECUTION_STATUS_UNSPECIFIED}, nil
+		default:
+			if ctx.Err() == nil && pollCtx.Err() == context.DeadlineExceeded {
+				continue
+			}
+			return nil, err
+		}
+
+		events := resp.GetHistory().GetEvents()
+		if len(events) == 0 {
+			continue
+		}
+		closeEvent := events[len(events)-1]
+		if attributes := closeEvent.GetWorkflowExecutionContinuedAsNewEventAttributes(); attributes != nil {
+			execution.RunId = attributes.GetNewExecutionRunId()
+			continue
+		}
+		return &WatchWorkflowResponse{Status: closeStatus(closeEvent)}, nil
+	}
+}
+
+// CancelWorkflowActivity requests cancellation of a workflow started by a schedule
+func CancelWorkflowActivity(ctx context.Context, request CancelWorkflowRequest) error {
+	s := ctx.Value(schedulerContextKey).(*Scheduler)
+	_, err := s.clientBean.GetFrontendClient().RequestCancelWorkflowExecution(ctx, &workflowservice.RequestCancelWorkflowExecutionRequest{
+		Namespace: request.Namespace,
+		WorkflowExecution: &commonpb.WorkflowExecution{
+			WorkflowId: request.Execution.GetWorkflowId(),
+		},
+		Identity:  WorkflowTypeName,
+		RequestId: activity.GetInfo(ctx).ActivityID + "-" + request.Execution.GetRunId(),
+	})
+	switch err.(type) {
+	case nil, *serviceerror.NotFound, *serviceerror.CancellationAlreadyRequested:
+		return nil
+	default:
+		return err
+	}
+}
+
+func closeStatus(event *historypb.HistoryEvent) enumspb.WorkflowExecutionStatus {
+	switch event.GetEventType() {
+	case enumspb.EVENT_TYPE_WORKFLOW_EXECUTION_COMPLETED:
+		return enumspb.WORKFLOW_EXECUTION_STATUS_COMPLETED
+	case enumspb.EVENT_TYPE_WORKFLOW_EXECUTION_FAILED:
+		return enumspb.WORKFLOW_EXECUTION_STATUS_FAILED
+	case enumspb.EVENT_TYPE_WORKFLOW_EXECUTION_TIMED_OUT:
+		return enumspb.WORKFLOW_EXECUTION_STATUS_TIMED_OUT
+	case enumspb.EVENT_TYPE_WORKFLOW_EXECUTION_CANCELED:
+		return enumspb.WORKFLOW_EXECUTION_STATUS_CANCELED
+	case enumspb.EVENT_TYPE_WORKFLOW_EXECUTION_TERMINATED:
+		return enumspb.WORKFLOW_EXECUTION_STATUS_TERMINATED
+	case enumspb.EVENT_TYPE_WORKFLOW_EXECUTION_CONTINUED_AS_NEW:
+		return enumspb.WORKFLOW_EXECUTION_STATUS_CONTINUED_AS_NEW
+	default:
+		return enumspb.WORKFLOW_EXECUTION_STATUS_UNSPECIFIED
+	}
+}
+
+func getActivityLogger(ctx context.Context) log.Logger {
+	s := ctx.Value(schedulerContextKey).(*Scheduler)
+	wfInfo := activity.GetInfo(ctx)
+	return s.logger.WithTags(
+		tag.WorkflowID(wfInfo.WorkflowExecution.ID),
+		tag.WorkflowRunID(wfInfo.WorkflowExecution.RunID),
+		tag.WorkflowNamespace(wfInfo.WorkflowNamespace),
+	)
+}
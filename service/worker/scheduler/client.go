@@ -0,0 +1,218 @@
+// The MIT License
+//
+// Copyright (c) 2020 Temporal Technologies Inc.  All rights reserved.
+//
+// Copyright (c) 2020 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package scheduler
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	commonpb "go.temporal.io/api/common/v1"
+	enumspb "go.temporal.io/api/enums/v1"
+	"go.temporal.io/api/serviceerror"
+	"go.temporal.io/api/workflowservice/v1"
+	sdkclient "go.temporal.io/sdk/client"
+
+	"go.temporal.io/server/common/definition"
+	"go.temporal.io/server/common/log"
+	"go.temporal.io/server/common/log/tag"
+	"go.temporal.io/server/common/payload"
+)
+
+const (
+	workflowIDPrefix = "temporal-sys-scheduler:"
+
+	memoKeyNamespace  = "Namespace"
+	memoKeyScheduleID = "ScheduleId"
+)
+
+type (
+	// Client is used by the frontend to manage schedules, each schedule is backed
+	// by a scheduler workflow in the system namespace
+	Client interface {
+		CreateSchedule(ctx context.Context, request *CreateScheduleRequest) (*CreateScheduleResponse, error)
+		DescribeSchedule(ctx context.Context, request *DescribeScheduleRequest) (*DescribeScheduleResponse, error)
+		UpdateSchedule(ctx context.Context, request *UpdateScheduleRequest) error
+		PatchSchedule(ctx context.Context, namespace string, scheduleID string, patch *SchedulePatch) error
+		DeleteSchedule(ctx context.Context, request *DeleteScheduleRequest) error
+		ListSchedules(ctx context.Context, request *ListSchedulesRequest) (*ListSchedulesResponse, error)
+	}
+
+	clientImpl struct {
+		logger         log.Logger
+		temporalClient sdkclient.Client
+	}
+)
+
+var _ Client = (*clientImpl)(nil)
+
+var (
+	errScheduleNotFound = serviceerror.NewNotFound("Schedule not found.")
+	errScheduleExists   = serviceerror.NewInvalidArgument("Schedule with this ID already exists.")
+)
+
+// NewClient creates a new Client
+func NewClient(
+	logger log.Logger,
+	publicClient sdkclient.Client,
+) Client {
+	return &clientImpl{
+		logger:         logger,
+		temporalClient: publicClient,
+	}
+}
+
+// WorkflowID returns the ID of the scheduler workflow backing a schedule
+func WorkflowID(namespace string, scheduleID string) string {
+	return fmt.Sprintf("%v%v:%v", workflowIDPrefix, namespace, scheduleID)
+}
+
+func (c *clientImpl) CreateSchedule(ctx context.Context, request *CreateScheduleRequest) (*CreateScheduleResponse, error) {
+	workflowID := WorkflowID(request.Namespace, request.ScheduleID)
+	workflowOptions := sdkclient.StartWorkflowOptions{
+		ID:                    workflowID,
+		TaskQueue:             TaskQueueName,
+		WorkflowTaskTimeout:   time.Minute,
+		WorkflowIDReusePolicy: enumspb.WORKFLOW_ID_REUSE_POLICY_ALLOW_DUPLICATE,
+		Memo: map[string]interface{}{
+			memoKeyNamespace:  request.Namespace,
+			memoKeyScheduleID: request.ScheduleID,
+		},
+		SearchAttributes: map[string]interface{}{
+			definition.TemporalScheduleNamespace: request.Namespace,
+		},
+	}
+	args := StartSchedulerArgs{
+		Namespace:    request.Namespace,
+		ScheduleID:   request.ScheduleID,
+		Schedule:     *request.Schedule,
+		InitialPatch: request.InitialPatch,
+	}
+	_, err := c.temporalClient.ExecuteWorkflow(ctx, workflowOptions, WorkflowTypeName, args)
+	if err != nil {
+		if _, ok := err.(*serviceerror.WorkflowExecutionAlreadyStarted); ok {
+			return nil, errScheduleExists
+		}
+		c.logger.Error("Failed to start scheduler workflow", tag.WorkflowID(workflowID), tag.Error(err))
+		return nil, err
+	}
+	return &CreateScheduleResponse{ConflictToken: initialConflictToken}, nil
+}
+
+func (c *clientImpl) DescribeSchedule(ctx context.Context, request *DescribeScheduleRequest) (*DescribeScheduleResponse, error) {
+	resp, err := c.temporalClient.QueryWorkflowWithOptions(ctx, &sdkclient.QueryWorkflowWithOptionsRequest{
+		WorkflowID: WorkflowID(request.Namespace, request.ScheduleID),
+		QueryType:  QueryNameDescribe,
+		// deleted schedules are closed workflows, they can't be described anymore
+		QueryRejectCondition: enumspb.QUERY_REJECT_CONDITION_NOT_OPEN,
+	})
+	if err != nil {
+		return nil, convertError(err)
+	}
+	if resp.QueryRejected != nil {
+		return nil, errScheduleNotFound
+	}
+	var response DescribeScheduleResponse
+	if err := resp.QueryResult.Get(&response); err != nil {
+		return nil, err
+	}
+	return &response, nil
+}
+
+func (c *clientImpl) UpdateSchedule(ctx context.Context, request *UpdateScheduleRequest) error {
+	signal := UpdateSignal{
+		Schedule:      *request.Schedule,
+		ConflictToken: request.ConflictToken,
+	}
+	err := c.temporalClient.SignalWorkflow(ctx, WorkflowID(request.Namespace, request.ScheduleID), "", SignalNameUpdate, signal)
+	return convertError(err)
+}
+
+func (c *clientImpl) PatchSchedule(ctx context.Context, namespace string, scheduleID string, patch *SchedulePatch) error {
+	err := c.temporalClient.SignalWorkflow(ctx, WorkflowID(namespace, scheduleID), "", SignalNamePatch, patch)
+	return convertError(err)
+}
+
+func (c *clientImpl) DeleteSchedule(ctx context.Context, request *DeleteScheduleRequest) error {
+	err := c.temporalClient.TerminateWorkflow(
+		ctx,
+		WorkflowID(request.Namespace, request.ScheduleID),
+		"",
+		fmt.Sprintf("schedule deleted by %v", request.Identity),
+	)
+	return convertError(err)
+}
+
+// ListSchedules lists the running scheduler workflows of the given namespace. Schedules are
+// filtered by the TemporalScheduleNamespace search attribute, so it requires a visibility store
+// supporting queries, i.e. elasticsearch or SQL visibility.
+func (c *clientImpl) ListSchedules(ctx context.Context, request *ListSchedulesRequest) (*ListSchedulesResponse, error) {
+	resp, err := c.temporalClient.ListWorkflow(ctx, &workflowservice.ListWorkflowExecutionsRequest{
+		PageSize:      request.MaximumPageSize,
+		NextPageToken: request.NextPageToken,
+		Query:         listSchedulesQuery(request.Namespace),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	response := &ListSchedulesResponse{
+		NextPageToken: resp.GetNextPageToken(),
+	}
+	for _, execution := range resp.GetExecutions() {
+		entry := ScheduleListEntry{
+			ScheduleID:     memoString(execution.GetMemo(), memoKeyScheduleID),
+			SchedulerRunID: execution.GetExecution().GetRunId(),
+		}
+		if execution.GetStartTime() != nil {
+			entry.StartTime = *execution.GetStartTime()
+		}
+		response.Schedules = append(response.Schedules, entry)
+	}
+	return response, nil
+}
+
+func listSchedulesQuery(namespace string) string {
+	return fmt.Sprintf("%s = '%s' and %s = %d and %s = '%s'",
+		definition.WorkflowType, WorkflowTypeName,
+		definition.ExecutionStatus, int32(enumspb.WORKFLOW_EXECUTION_STATUS_RUNNING),
+		definition.TemporalScheduleNamespace, strings.ReplaceAll(namespace, "'", "\\'"))
+}
+
+func memoString(memo *commonpb.Memo, key string) string {
+	var value string
+	if p, ok := memo.GetFields()[key]; ok {
+		_ = payload.Decode(p, &value)
+	}
+	return value
+}
+
+func convertError(err error) error {
+	if _, ok := err.(*serviceerror.NotFound); ok {
+		return errScheduleNotFound
+	}
+	return err
+}
@@ -0,0 +1,237 @@
+// The MIT License
+//
+// Copyright (c) 2020 Temporal Technologies Inc.  All rights reserved.
+//
+// Copyright (c) 2020 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package scheduler
+
+import (
+	"time"
+
+	commonpb "go.temporal.io/api/common/v1"
+
+	enumsspb "go.temporal.io/server/api/enums/v1"
+	schedulespb "go.temporal.io/server/api/schedule/v1"
+	"go.temporal.io/server/common/primitives/timestamp"
+)
+
+// ScheduleFromProto converts the wire representation of a schedule, it returns nil for nil
+func ScheduleFromProto(schedule *schedulespb.Schedule) *Schedule {
+	if schedule == nil {
+		return nil
+	}
+	result := &Schedule{
+		State: ScheduleState{
+			Paused: schedule.GetState().GetPaused(),
+			Notes:  schedule.GetState().GetNotes(),
+		},
+		Policies: SchedulePolicies{
+			OverlapPolicy: OverlapPolicyFromProto(schedule.GetPolicies().GetOverlapPolicy()),
+			CatchupWindow: timestamp.DurationValue(schedule.GetPolicies().GetCatchupWindow()),
+		},
+	}
+	if spec := schedule.GetSpec(); spec != nil {
+		result.Spec = ScheduleSpec{
+			CronStrings:  spec.GetCronString(),
+			StartTime:    timestamp.TimeValue(spec.GetStartTime()),
+			EndTime:      timestamp.TimeValue(spec.GetEndTime()),
+			Jitter:       timestamp.DurationValue(spec.GetJitter()),
+			TimezoneName: spec.GetTimezoneName(),
+		}
+		for _, calendar := range spec.GetCalendar() {
+			result.Spec.Calendars = append(result.Spec.Calendars, CalendarSpec{
+				Second:     calendar.GetSecond(),
+				Minute:     calendar.GetMinute(),
+				Hour:       calendar.GetHour(),
+				DayOfMonth: calendar.GetDayOfMonth(),
+				Month:      calendar.GetMonth(),
+				DayOfWeek:  calendar.GetDayOfWeek(),
+			})
+		}
+		for _, interval := range spec.GetInterval() {
+			result.Spec.Intervals = append(result.Spec.Intervals, IntervalSpec{
+				Interval: timestamp.DurationValue(interval.GetInterval()),
+				Phase:    timestamp.DurationValue(interval.GetPhase()),
+			})
+		}
+	}
+	if action := schedule.GetAction(); action != nil {
+		result.Action = StartWorkflowAction{
+			WorkflowID:               action.GetWorkflowId(),
+			WorkflowType:             action.GetWorkflowType().GetName(),
+			TaskQueue:                action.GetTaskQueue(),
+			Input:                    action.GetInput(),
+			WorkflowExecutionTimeout: timestamp.DurationValue(action.GetWorkflowExecutionTimeout()),
+			WorkflowRunTimeout:       timestamp.DurationValue(action.GetWorkflowRunTimeout()),
+			WorkflowTaskTimeout:      timestamp.DurationValue(action.GetWorkflowTaskTimeout()),
+			RetryPolicy:              action.GetRetryPolicy(),
+			Memo:                     action.GetMemo(),
+			SearchAttributes:         action.GetSearchAttributes(),
+			Header:                   action.GetHeader(),
+		}
+	}
+	return result
+}
+
+// ScheduleToProto converts a schedule to its wire representation
+func ScheduleToProto(schedule Schedule) *schedulespb.Schedule {
+	spec := &schedulespb.ScheduleSpec{
+		CronString:   schedule.Spec.CronStrings,
+		StartTime:    timePtrOrNil(schedule.Spec.StartTime),
+		EndTime:      timePtrOrNil(schedule.Spec.EndTime),
+		Jitter:       durationPtrOrNil(schedule.Spec.Jitter),
+		TimezoneName: schedule.Spec.TimezoneName,
+	}
+	for _, calendar := range schedule.Spec.Calendars {
+		spec.Calendar = append(spec.Calendar, &schedulespb.CalendarSpec{
+			Second:     calendar.Second,
+			Minute:     calendar.Minute,
+			Hour:       calendar.Hour,
+			DayOfMonth: calendar.DayOfMonth,
+			Month:      calendar.Month,
+			DayOfWeek:  calendar.DayOfWeek,
+		})
+	}
+	for _, interval := range schedule.Spec.Intervals {
+		spec.Interval = append(spec.Interval, &schedulespb.IntervalSpec{
+			Interval: durationPtrOrNil(interval.Interval),
+			Phase:    durationPtrOrNil(interval.Phase),
+		})
+	}
+	action := schedule.Action
+	return &schedulespb.Schedule{
+		Spec: spec,
+		Action: &schedulespb.StartWorkflowAction{
+			WorkflowId:               action.WorkflowID,
+			WorkflowType:             &commonpb.WorkflowType{Name: action.WorkflowType},
+			TaskQueue:                action.TaskQueue,
+			Input:                    action.Input,
+			WorkflowExecutionTimeout: durationPtrOrNil(action.WorkflowExecutionTimeout),
+			WorkflowRunTimeout:       durationPtrOrNil(action.WorkflowRunTimeout),
+			WorkflowTaskTimeout:      durationPtrOrNil(action.WorkflowTaskTimeout),
+			RetryPolicy:              action.RetryPolicy,
+			Memo:                     action.Memo,
+			SearchAttributes:         action.SearchAttributes,
+			Header:                   action.Header,
+		},
+		Policies: &schedulespb.SchedulePolicies{
+			OverlapPolicy: OverlapPolicyToProto(schedule.Policies.OverlapPolicy),
+			CatchupWindow: durationPtrOrNil(schedule.Policies.CatchupWindow),
+		},
+		State: &schedulespb.ScheduleState{
+			Paused: schedule.State.Paused,
+			Notes:  schedule.State.Notes,
+		},
+	}
+}
+
+// ScheduleInfoToProto converts the runtime information of a schedule to its wire representation
+func ScheduleInfoToProto(info ScheduleInfo) *schedulespb.ScheduleInfo {
+	result := &schedulespb.ScheduleInfo{
+		ActionCount:         info.ActionCount,
+		MissedCatchupWindow: info.MissedCatchupWindow,
+		OverlapSkipped:      info.OverlapSkipped,
+		BufferDropped:       info.BufferDropped,
+		CreateTime:          timePtrOrNil(info.CreateTime),
+		UpdateTime:          timePtrOrNil(info.UpdateTime),
+	}
+	for i := range info.RunningWorkflows {
+		result.RunningWorkflows = append(result.RunningWorkflows, &info.RunningWorkflows[i])
+	}
+	for i := range info.RecentActions {
+		action := info.RecentActions[i]
+		result.RecentActions = append(result.RecentActions, &schedulespb.ScheduleActionResult{
+			ScheduleTime:        timePtrOrNil(action.ScheduleTime),
+			ActualTime:          timePtrOrNil(action.ActualTime),
+			StartWorkflowResult: &action.Execution,
+		})
+	}
+	if len(info.FutureActionTimes) > 0 {
+		result.NextActionTime = timestamp.TimePtr(info.FutureActionTimes[0])
+	}
+	return result
+}
+
+// PatchFromProto converts the wire representation of a schedule patch, it returns nil for nil
+func PatchFromProto(patch *schedulespb.SchedulePatch) *SchedulePatch {
+	if patch == nil {
+		return nil
+	}
+	result := &SchedulePatch{
+		BackfillRequests: BackfillRequestsFromProto(patch.GetBackfillRequest()),
+		Pause:            patch.GetPause(),
+		Unpause:          patch.GetUnpause(),
+	}
+	if trigger := patch.GetTriggerImmediately(); trigger != nil {
+		result.TriggerImmediately = &TriggerImmediatelyRequest{
+			OverlapPolicy: OverlapPolicyFromProto(trigger.GetOverlapPolicy()),
+		}
+	}
+	return result
+}
+
+// BackfillRequestsFromProto converts the wire representation of backfill requests
+func BackfillRequestsFromProto(backfills []*schedulespb.BackfillRequest) []BackfillRequest {
+	var result []BackfillRequest
+	for _, backfill := range backfills {
+		result = append(result, BackfillRequest{
+			StartTime:     timestamp.TimeValue(backfill.GetStartTime()),
+			EndTime:       timestamp.TimeValue(backfill.GetEndTime()),
+			OverlapPolicy: OverlapPolicyFromProto(backfill.GetOverlapPolicy()),
+		})
+	}
+	return result
+}
+
+// ScheduleListEntryToProto converts a list entry to its wire representation
+func ScheduleListEntryToProto(entry ScheduleListEntry) *schedulespb.ScheduleListEntry {
+	return &schedulespb.ScheduleListEntry{
+		ScheduleId:     entry.ScheduleID,
+		SchedulerRunId: entry.SchedulerRunID,
+		StartTime:      timePtrOrNil(entry.StartTime),
+	}
+}
+
+// OverlapPolicyFromProto converts the wire representation of an overlap policy,
+// the values of both enums are kept in sync
+func OverlapPolicyFromProto(policy enumsspb.ScheduleOverlapPolicy) ScheduleOverlapPolicy {
+	return ScheduleOverlapPolicy(policy)
+}
+
+// OverlapPolicyToProto converts an overlap policy to its wire representation
+func OverlapPolicyToProto(policy ScheduleOverlapPolicy) enumsspb.ScheduleOverlapPolicy {
+	return enumsspb.ScheduleOverlapPolicy(policy)
+}
+
+func timePtrOrNil(t time.Time) *time.Time {
+	if t.IsZero() {
+		return nil
+	}
+	return timestamp.TimePtr(t)
+}
+
+func durationPtrOrNil(d time.Duration) *time.Duration {
+	if d == 0 {
+		return nil
+	}
+	return timestamp.DurationPtr(d)
+}
@@ -0,0 +1,117 @@
+// The MIT License
+//
+// Copyright (c) 2020 Temporal Technologies Inc.  All rights reserved.
+//
+// Copyright (c) 2020 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package scheduler
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/suite"
+	commonpb "go.temporal.io/api/common/v1"
+
+	enumsspb "go.temporal.io/server/api/enums/v1"
+	schedulespb "go.temporal.io/server/api/schedule/v1"
+	"go.temporal.io/server/common/payloads"
+	"go.temporal.io/server/common/primitives/timestamp"
+)
+
+type (
+	convertSuite struct {
+		suite.Suite
+	}
+)
+
+func TestConvertSuite(t *testing.T) {
+	suite.Run(t, new(convertSuite))
+}
+
+func (s *convertSuite) TestScheduleRoundTrip() {
+	schedule := Schedule{
+		Spec: ScheduleSpec{
+			Calendars:    []CalendarSpec{{Hour: "10", DayOfWeek: "MON-FRI"}},
+			Intervals:    []IntervalSpec{{Interval: 90 * time.Minute, Phase: 5 * time.Minute}},
+			CronStrings:  []string{"*/10 * * * *"},
+			StartTime:    time.Date(2020, 1, 1, 0, 0, 0, 0, time.UTC),
+			Jitter:       time.Minute,
+			TimezoneName: "America/New_York",
+		},
+		Action: StartWorkflowAction{
+			WorkflowID:          "wid",
+			WorkflowType:        "wt",
+			TaskQueue:           "tq",
+			Input:               payloads.EncodeString("input"),
+			WorkflowRunTimeout:  time.Hour,
+			WorkflowTaskTimeout: 10 * time.Second,
+			RetryPolicy:         &commonpb.RetryPolicy{MaximumAttempts: 3},
+		},
+		Policies: SchedulePolicies{
+			OverlapPolicy: ScheduleOverlapPolicyBufferOne,
+			CatchupWindow: 10 * time.Minute,
+		},
+		State: ScheduleState{Paused: true, Notes: "paused for maintenance"},
+	}
+
+	s.Equal(&schedule, ScheduleFromProto(ScheduleToProto(schedule)))
+}
+
+func (s *convertSuite) TestScheduleToProto_ZeroValuesAreNotSet() {
+	pb := ScheduleToProto(Schedule{Spec: ScheduleSpec{CronStrings: []string{"@hourly"}}})
+	s.Nil(pb.GetSpec().GetStartTime())
+	s.Nil(pb.GetSpec().GetEndTime())
+	s.Nil(pb.GetSpec().GetJitter())
+	s.Nil(pb.GetPolicies().GetCatchupWindow())
+	s.Nil(pb.GetAction().GetWorkflowExecutionTimeout())
+}
+
+func (s *convertSuite) TestPatchFromProto() {
+	s.Nil(PatchFromProto(nil))
+
+	start := time.Date(2020, 1, 1, 0, 0, 0, 0, time.UTC)
+	end := start.Add(time.Hour)
+	patch := PatchFromProto(&schedulespb.SchedulePatch{
+		TriggerImmediately: &schedulespb.TriggerImmediatelyRequest{OverlapPolicy: enumsspb.SCHEDULE_OVERLAP_POLICY_ALLOW_ALL},
+		BackfillRequest: []*schedulespb.BackfillRequest{{
+			StartTime:     timestamp.TimePtr(start),
+			EndTime:       timestamp.TimePtr(end),
+			OverlapPolicy: enumsspb.SCHEDULE_OVERLAP_POLICY_SKIP,
+		}},
+		Pause: "paused",
+	})
+	s.Equal(&SchedulePatch{
+		TriggerImmediately: &TriggerImmediatelyRequest{OverlapPolicy: ScheduleOverlapPolicyAllowAll},
+		BackfillRequests:   []BackfillRequest{{StartTime: start, EndTime: end, OverlapPolicy: ScheduleOverlapPolicySkip}},
+		Pause:              "paused",
+	}, patch)
+}
+
+func (s *convertSuite) TestOverlapPolicyValuesInSync() {
+	for policy := ScheduleOverlapPolicyUnspecified; policy <= ScheduleOverlapPolicyAllowAll; policy++ {
+		pb := OverlapPolicyToProto(policy)
+		_, ok := enumsspb.ScheduleOverlapPolicy_name[int32(pb)]
+		s.True(ok)
+		s.Equal(policy, OverlapPolicyFromProto(pb))
+	}
+	s.Equal(enumsspb.SCHEDULE_OVERLAP_POLICY_CANCEL_OTHER, OverlapPolicyToProto(ScheduleOverlapPolicyCancelOther))
+}
@@ -0,0 +1,432 @@
+// The MIT License
+//
+// Copyright (c) 2020 Temporal Technologies Inc.  All rights reserved.
+//
+// Copyright (c) 2020 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package scheduler
+
+import (
+	"fmt"
+	"time"
+
+	commonpb "go.temporal.io/api/common/v1"
+)
+
+// ScheduleOverlapPolicy controls what happens when an action is due while workflows
+// started by earlier actions of the same schedule are still running.
+type ScheduleOverlapPolicy int
+
+const (
+	// ScheduleOverlapPolicyUnspecified falls back to the schedule policy, or ScheduleOverlapPolicySkip
+	ScheduleOverlapPolicyUnspecified ScheduleOverlapPolicy = iota
+	// ScheduleOverlapPolicySkip drops the action if a previous workflow is still running
+	ScheduleOverlapPolicySkip
+	// ScheduleOverlapPolicyBufferOne starts the workflow as soon as the running one closes,
+	// at most one action is buffered, further ones are dropped
+	ScheduleOverlapPolicyBufferOne
+	// ScheduleOverlapPolicyCancelOther requests cancellation of the running workflows and
+	// starts the new one once they closed
+	ScheduleOverlapPolicyCancelOther
+	// ScheduleOverlapPolicyAllowAll starts the workflow regardless of the running ones
+	ScheduleOverlapPolicyAllowAll
+)
+
+type (
+	// StartWorkflowAction describes the workflow started on every action of a schedule
+	StartWorkflowAction struct {
+		// WorkflowID is used as a prefix, the nominal time of the action is appended to it
+		WorkflowID               string
+		WorkflowType             string
+		TaskQueue                string
+		Input                    *commonpb.Payloads
+		WorkflowExecutionTimeout time.Duration
+		WorkflowRunTimeout       time.Duration
+		WorkflowTaskTimeout      time.Duration
+		RetryPolicy              *commonpb.RetryPolicy
+		Memo                     *commonpb.Memo
+		SearchAttributes         *commonpb.SearchAttributes
+		Header                   *commonpb.Header
+	}
+
+	// SchedulePolicies controls the behavior of a schedule
+	SchedulePolicies struct {
+		OverlapPolicy ScheduleOverlapPolicy
+		// CatchupWindow is how far in the past a missed action can still be taken,
+		// e.g. after an outage of the worker service. Default to defaultCatchupWindow.
+		CatchupWindow time.Duration
+	}
+
+	// ScheduleState is the user controlled state of a schedule
+	ScheduleState struct {
+		Paused bool
+		// Notes is a human readable note on the state, e.g. the reason for pausing
+		Notes string
+	}
+
+	// Schedule is the complete definition of a schedule
+	Schedule struct {
+		Spec     ScheduleSpec
+		Action   StartWorkflowAction
+		Policies SchedulePolicies
+		State    ScheduleState
+	}
+
+	// ScheduleActionResult records a single action taken by a schedule
+	ScheduleActionResult struct {
+		ScheduleTime time.Time
+		ActualTime   time.Time
+		Execution    commonpb.WorkflowExecution
+	}
+
+	// ScheduleInfo is the runtime information of a schedule
+	ScheduleInfo struct {
+		ActionCount         int64
+		MissedCatchupWindow int64
+		OverlapSkipped      int64
+		BufferDropped       int64
+		RunningWorkflows    []commonpb.WorkflowExecution
+		RecentActions       []ScheduleActionResult
+		FutureActionTimes   []time.Time
+		CreateTime          time.Time
+		UpdateTime          time.Time
+	}
+
+	// BackfillRequest takes all the actions the spec matches between StartTime and EndTime,
+	// ignoring the catchup window and the paused state
+	BackfillRequest struct {
+		StartTime     time.Time
+		EndTime       time.Time
+		OverlapPolicy ScheduleOverlapPolicy
+	}
+
+	// TriggerImmediatelyRequest takes an action right now
+	TriggerImmediatelyRequest struct {
+		OverlapPolicy ScheduleOverlapPolicy
+	}
+
+	// SchedulePatch is a one-off change to a running schedule
+	SchedulePatch struct {
+		TriggerImmediately *TriggerImmediatelyRequest
+		BackfillRequests   []BackfillRequest
+		// Pause pauses the schedule if set, the value is used as the notes
+		Pause string
+		// Unpause unpauses the schedule if set, the value is used as the notes
+		Unpause string
+	}
+)
+
+type (
+	// CreateScheduleRequest is the request to create a schedule
+	CreateScheduleRequest struct {
+		Namespace    string
+		ScheduleID   string
+		Schedule     *Schedule
+		InitialPatch *SchedulePatch
+		Identity     string
+		RequestID    string
+	}
+
+	// CreateScheduleResponse is the response to CreateScheduleRequest
+	CreateScheduleResponse struct {
+		ConflictToken int64
+	}
+
+	// DescribeScheduleRequest is the request to describe a schedule
+	DescribeScheduleRequest struct {
+		Namespace  string
+		ScheduleID string
+	}
+
+	// DescribeScheduleResponse is the response to DescribeScheduleRequest
+	DescribeScheduleResponse struct {
+		Schedule Schedule
+		Info     ScheduleInfo
+		// ConflictToken can be passed to UpdateScheduleRequest to detect concurrent updates
+		ConflictToken int64
+	}
+
+	// UpdateScheduleRequest is the request to replace the definition of a schedule
+	UpdateScheduleRequest struct {
+		Namespace  string
+		ScheduleID string
+		Schedule   *Schedule
+		// ConflictToken if not zero, the update is dropped unless it matches the current token
+		ConflictToken int64
+		Identity      string
+		RequestID     string
+	}
+
+	// UpdateScheduleResponse is the response to UpdateScheduleRequest
+	UpdateScheduleResponse struct{}
+
+	// PauseScheduleRequest is the request to pause a schedule
+	PauseScheduleRequest struct {
+		Namespace  string
+		ScheduleID string
+		Notes      string
+		Identity   string
+	}
+
+	// PauseScheduleResponse is the response to PauseScheduleRequest
+	PauseScheduleResponse struct{}
+
+	// UnpauseScheduleRequest is the request to unpause a schedule
+	UnpauseScheduleRequest struct {
+		Namespace  string
+		ScheduleID string
+		Notes      string
+		Identity   string
+	}
+
+	// UnpauseScheduleResponse is the response to UnpauseScheduleRequest
+	UnpauseScheduleResponse struct{}
+
+	// TriggerScheduleRequest is the request to take an action right now, or to
+	// backfill the actions of a past time range
+	TriggerScheduleRequest struct {
+		Namespace  string
+		ScheduleID string
+		// OverlapPolicy overrides the schedule policy for these actions
+		OverlapPolicy ScheduleOverlapPolicy
+		// Backfill if set, actions are taken for the given ranges instead of right now
+		Backfill []BackfillRequest
+		Identity string
+	}
+
+	// TriggerScheduleResponse is the response to TriggerScheduleRequest
+	TriggerScheduleResponse struct{}
+
+	// DeleteScheduleRequest is the request to delete a schedule. Workflows started by
+	// the schedule are not affected.
+	DeleteScheduleRequest struct {
+		Namespace  string
+		ScheduleID string
+		Identity   string
+	}
+
+	// DeleteScheduleResponse is the response to DeleteScheduleRequest
+	DeleteScheduleResponse struct{}
+
+	// ListSchedulesRequest is the request to list schedules of a namespace
+	ListSchedulesRequest struct {
+		Namespace       string
+		MaximumPageSize int32
+		NextPageToken   []byte
+	}
+
+	// ScheduleListEntry is a single schedule returned by ListSchedules
+	ScheduleListEntry struct {
+		ScheduleID string
+		// SchedulerRunID is the run ID of the scheduler workflow backing the schedule
+		SchedulerRunID string
+		StartTime      time.Time
+	}
+
+	// ListSchedulesResponse is the response to ListSchedulesRequest
+	ListSchedulesResponse struct {
+		Schedules     []ScheduleListEntry
+		NextPageToken []byte
+	}
+)
+
+// GetNamespace returns the namespace of the request, it is safe to call on nil
+func (r *CreateScheduleRequest) GetNamespace() string {
+	if r == nil {
+		return ""
+	}
+	return r.Namespace
+}
+
+// GetScheduleID returns the schedule ID of the request, it is safe to call on nil
+func (r *CreateScheduleRequest) GetScheduleID() string {
+	if r == nil {
+		return ""
+	}
+	return r.ScheduleID
+}
+
+// GetNamespace returns the namespace of the request, it is safe to call on nil
+func (r *DescribeScheduleRequest) GetNamespace() string {
+	if r == nil {
+		return ""
+	}
+	return r.Namespace
+}
+
+// GetScheduleID returns the schedule ID of the request, it is safe to call on nil
+func (r *DescribeScheduleRequest) GetScheduleID() string {
+	if r == nil {
+		return ""
+	}
+	return r.ScheduleID
+}
+
+// GetNamespace returns the namespace of the request, it is safe to call on nil
+func (r *UpdateScheduleRequest) GetNamespace() string {
+	if r == nil {
+		return ""
+	}
+	return r.Namespace
+}
+
+// GetScheduleID returns the schedule ID of the request, it is safe to call on nil
+func (r *UpdateScheduleRequest) GetScheduleID() string {
+	if r == nil {
+		return ""
+	}
+	return r.ScheduleID
+}
+
+// GetNamespace returns the namespace of the request, it is safe to call on nil
+func (r *PauseScheduleRequest) GetNamespace() string {
+	if r == nil {
+		return ""
+	}
+	return r.Namespace
+}
+
+// GetScheduleID returns the schedule ID of the request, it is safe to call on nil
+func (r *PauseScheduleRequest) GetScheduleID() string {
+	if r == nil {
+		return ""
+	}
+	return r.ScheduleID
+}
+
+// GetNamespace returns the namespace of the request, it is safe to call on nil
+func (r *UnpauseScheduleRequest) GetNamespace() string {
+	if r == nil {
+		return ""
+	}
+	return r.Namespace
+}
+
+// GetScheduleID returns the schedule ID of the request, it is safe to call on nil
+func (r *UnpauseScheduleRequest) GetScheduleID() string {
+	if r == nil {
+		return ""
+	}
+	return r.ScheduleID
+}
+
+// GetNamespace returns the namespace of the request, it is safe to call on nil
+func (r *TriggerScheduleRequest) GetNamespace() string {
+	if r == nil {
+		return ""
+	}
+	return r.Namespace
+}
+
+// GetScheduleID returns the schedule ID of the request, it is safe to call on nil
+func (r *TriggerScheduleRequest) GetScheduleID() string {
+	if r == nil {
+		return ""
+	}
+	return r.ScheduleID
+}
+
+// GetNamespace returns the namespace of the request, it is safe to call on nil
+func (r *DeleteScheduleRequest) GetNamespace() string {
+	if r == nil {
+		return ""
+	}
+	return r.Namespace
+}
+
+// GetScheduleID returns the schedule ID of the request, it is safe to call on nil
+func (r *DeleteScheduleRequest) GetScheduleID() string {
+	if r == nil {
+		return ""
+	}
+	return r.ScheduleID
+}
+
+// GetNamespace returns the namespace of the request, it is safe to call on nil
+func (r *ListSchedulesRequest) GetNamespace() string {
+	if r == nil {
+		return ""
+	}
+	return r.Namespace
+}
+
+// GetMaximumPageSize returns the page size of the request, it is safe to call on nil
+func (r *ListSchedulesRequest) GetMaximumPageSize() int32 {
+	if r == nil {
+		return 0
+	}
+	return r.MaximumPageSize
+}
+
+// ValidateSchedule validates a schedule definition
+func ValidateSchedule(schedule *Schedule) error {
+	if schedule == nil {
+		return fmt.Errorf("schedule is not set")
+	}
+	if err := ValidateSpec(schedule.Spec); err != nil {
+		return err
+	}
+	action := schedule.Action
+	if action.WorkflowID == "" || action.WorkflowType == "" || action.TaskQueue == "" {
+		return fmt.Errorf("must provide WorkflowID, WorkflowType and TaskQueue of the action")
+	}
+	if action.WorkflowExecutionTimeout < 0 || action.WorkflowRunTimeout < 0 || action.WorkflowTaskTimeout < 0 {
+		return fmt.Errorf("workflow timeouts must not be negative")
+	}
+	if err := validateOverlapPolicy(schedule.Policies.OverlapPolicy); err != nil {
+		return err
+	}
+	if schedule.Policies.CatchupWindow != 0 && schedule.Policies.CatchupWindow < minCatchupWindow {
+		return fmt.Errorf("catchup window must be at least %v", minCatchupWindow)
+	}
+	return nil
+}
+
+// ValidatePatch validates a schedule patch
+func ValidatePatch(patch *SchedulePatch) error {
+	if patch == nil {
+		return nil
+	}
+	if patch.Pause != "" && patch.Unpause != "" {
+		return fmt.Errorf("cannot pause and unpause at the same time")
+	}
+	if patch.TriggerImmediately != nil {
+		if err := validateOverlapPolicy(patch.TriggerImmediately.OverlapPolicy); err != nil {
+			return err
+		}
+	}
+	for _, backfill := range patch.BackfillRequests {
+		if backfill.StartTime.IsZero() || !backfill.EndTime.After(backfill.StartTime) {
+			return fmt.Errorf("backfill end time must be after start time")
+		}
+		if err := validateOverlapPolicy(backfill.OverlapPolicy); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func validateOverlapPolicy(policy ScheduleOverlapPolicy) error {
+	if policy < ScheduleOverlapPolicyUnspecified || policy > ScheduleOverlapPolicyAllowAll {
+		return fmt.Errorf("not supported overlap policy: %v", policy)
+	}
+	return nil
+}
@@ -0,0 +1,87 @@
+// The MIT License
+//
+// Copyright (c) 2020 Temporal Technologies Inc.  All rights reserved.
+//
+// Copyright (c) 2020 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package scheduler
+
+import (
+	"context"
+
+	"go.temporal.io/sdk/activity"
+	sdkclient "go.temporal.io/sdk/client"
+	"go.temporal.io/sdk/worker"
+	"go.temporal.io/sdk/workflow"
+
+	"go.temporal.io/server/client"
+	"go.temporal.io/server/common/log"
+	"go.temporal.io/server/common/log/tag"
+	"go.temporal.io/server/common/metrics"
+)
+
+type (
+	// BootstrapParams contains the set of params needed to bootstrap
+	// the scheduler sub-system
+	BootstrapParams struct {
+		// ServiceClient is an instance of temporal service client
+		ServiceClient sdkclient.Client
+		// MetricsClient is an instance of metrics object for emitting stats
+		MetricsClient metrics.Client
+		Logger        log.Logger
+		// ClientBean is an instance of client.Bean for a collection of clients
+		ClientBean client.Bean
+	}
+
+	// Scheduler is the background sub-system that runs the scheduler workflows
+	// It is also the context object that get's passed around within the scheduler activities
+	Scheduler struct {
+		svcClient     sdkclient.Client
+		clientBean    client.Bean
+		metricsClient metrics.Client
+		logger        log.Logger
+	}
+)
+
+// New returns a new instance of scheduler daemon Scheduler
+func New(params *BootstrapParams) *Scheduler {
+	return &Scheduler{
+		svcClient:     params.ServiceClient,
+		metricsClient: params.MetricsClient,
+		logger:        params.Logger.WithTags(tag.ComponentScheduler),
+		clientBean:    params.ClientBean,
+	}
+}
+
+// Start starts the scheduler worker
+func (s *Scheduler) Start() error {
+	ctx := context.WithValue(context.Background(), schedulerContextKey, s)
+	workerOpts := worker.Options{
+		BackgroundActivityContext: ctx,
+	}
+	schedulerWorker := worker.New(s.svcClient, TaskQueueName, workerOpts)
+	schedulerWorker.RegisterWorkflowWithOptions(SchedulerWorkflow, workflow.RegisterOptions{Name: WorkflowTypeName})
+	schedulerWorker.RegisterActivityWithOptions(StartWorkflowActivity, activity.RegisterOptions{Name: startWorkflowActivityName})
+	schedulerWorker.RegisterActivityWithOptions(WatchWorkflowActivity, activity.RegisterOptions{Name: watchWorkflowActivityName})
+	schedulerWorker.RegisterActivityWithOptions(CancelWorkflowActivity, activity.RegisterOptions{Name: cancelWorkflowActivityName})
+
+	return schedulerWorker.Start()
+}
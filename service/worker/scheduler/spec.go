@@ -0,0 +1,222 @@
+// The MIT License
+//
+// Copyright (c) 2020 Temporal Technologies Inc.  All rights reserved.
+//
+// Copyright (c) 2020 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package scheduler
+
+import (
+	"fmt"
+	"hash/fnv"
+	"time"
+
+	"github.com/robfig/cron"
+)
+
+type (
+	// CalendarSpec describes times relative to the calendar. Each field uses cron field syntax,
+	// e.g. "*/15", "1-5" or "MON,WED,FRI". Empty Second, Minute and Hour default to "0",
+	// empty DayOfMonth, Month and DayOfWeek default to "*".
+	CalendarSpec struct {
+		Second     string
+		Minute     string
+		Hour       string
+		DayOfMonth string
+		Month      string
+		DayOfWeek  string
+	}
+
+	// IntervalSpec matches times that can be expressed as epoch + n*Interval + Phase.
+	// Phase must be smaller than Interval.
+	IntervalSpec struct {
+		Interval time.Duration
+		Phase    time.Duration
+	}
+
+	// ScheduleSpec is the union of all times a schedule takes actions at.
+	ScheduleSpec struct {
+		// Calendars are matched in the schedule timezone.
+		Calendars []CalendarSpec
+		// Intervals are matched in UTC.
+		Intervals []IntervalSpec
+		// CronStrings are standard 5 field cron expressions, same syntax as CronSchedule
+		// on StartWorkflowExecutionRequest. They are matched in the schedule timezone.
+		CronStrings []string
+		// StartTime and EndTime bound the matching times. Zero value means unbounded.
+		StartTime time.Time
+		EndTime   time.Time
+		// Jitter delays every action by a random amount up to this duration,
+		// but never past the next matching time.
+		Jitter time.Duration
+		// TimezoneName is an IANA timezone name, default to UTC.
+		TimezoneName string
+	}
+
+	// compiledSpec is the parsed form of ScheduleSpec
+	compiledSpec struct {
+		spec      ScheduleSpec
+		tz        *time.Location
+		calendars []cron.Schedule
+	}
+
+	getNextTimeResult struct {
+		// Nominal is the time matched by the spec
+		Nominal time.Time
+		// Next is the time the action should be taken at, Nominal plus jitter
+		Next time.Time
+	}
+)
+
+// ValidateSpec validates a schedule spec
+func ValidateSpec(spec ScheduleSpec) error {
+	_, err := newCompiledSpec(spec)
+	return err
+}
+
+func newCompiledSpec(spec ScheduleSpec) (*compiledSpec, error) {
+	if len(spec.Calendars) == 0 && len(spec.Intervals) == 0 && len(spec.CronStrings) == 0 {
+		return nil, fmt.Errorf("schedule spec must contain at least one calendar, interval or cron string")
+	}
+	if spec.Jitter < 0 {
+		return nil, fmt.Errorf("jitter must not be negative")
+	}
+	if !spec.StartTime.IsZero() && !spec.EndTime.IsZero() && !spec.EndTime.After(spec.StartTime) {
+		return nil, fmt.Errorf("end time must be after start time")
+	}
+
+	tz := time.UTC
+	if spec.TimezoneName != "" {
+		var err error
+		if tz, err = time.LoadLocation(spec.TimezoneName); err != nil {
+			return nil, fmt.Errorf("invalid timezone name %q: %v", spec.TimezoneName, err)
+		}
+	}
+
+	cs := &compiledSpec{
+		spec: spec,
+		tz:   tz,
+	}
+	for _, calendar := range spec.Calendars {
+		schedule, err := cron.Parse(calendar.cronString())
+		if err != nil {
+			return nil, fmt.Errorf("invalid calendar spec %+v: %v", calendar, err)
+		}
+		cs.calendars = append(cs.calendars, schedule)
+	}
+	for _, cronString := range spec.CronStrings {
+		schedule, err := cron.ParseStandard(cronString)
+		if err != nil {
+			return nil, fmt.Errorf("invalid cron string %q: %v", cronString, err)
+		}
+		cs.calendars = append(cs.calendars, schedule)
+	}
+	for _, interval := range spec.Intervals {
+		if interval.Interval <= 0 {
+			return nil, fmt.Errorf("interval must be positive")
+		}
+		if interval.Phase < 0 || interval.Phase >= interval.Interval {
+			return nil, fmt.Errorf("interval phase must be between zero and the interval")
+		}
+	}
+	return cs, nil
+}
+
+func (c CalendarSpec) cronString() string {
+	return fmt.Sprintf("%s %s %s %s %s %s",
+		defaultString(c.Second, "0"),
+		defaultString(c.Minute, "0"),
+		defaultString(c.Hour, "0"),
+		defaultString(c.DayOfMonth, "*"),
+		defaultString(c.Month, "*"),
+		defaultString(c.DayOfWeek, "*"),
+	)
+}
+
+// getNextTime returns the first matching time strictly after the given time, or zero
+// values if the spec doesn't match any time after it.
+func (cs *compiledSpec) getNextTime(jitterSeed string, after time.Time) getNextTimeResult {
+	if !cs.spec.StartTime.IsZero() && after.Before(cs.spec.StartTime) {
+		after = cs.spec.StartTime.Add(-time.Nanosecond)
+	}
+
+	nominal := cs.rawNextTime(after)
+	if nominal.IsZero() || (!cs.spec.EndTime.IsZero() && nominal.After(cs.spec.EndTime)) {
+		return getNextTimeResult{}
+	}
+
+	maxJitter := cs.spec.Jitter
+	if following := cs.rawNextTime(nominal); !following.IsZero() && following.Sub(nominal) < maxJitter {
+		maxJitter = following.Sub(nominal)
+	}
+	return getNextTimeResult{
+		Nominal: nominal,
+		Next:    nominal.Add(jitterFor(jitterSeed, nominal, maxJitter)),
+	}
+}
+
+func (cs *compiledSpec) rawNextTime(after time.Time) time.Time {
+	var next time.Time
+	earlier := func(t time.Time) {
+		if !t.IsZero() && (next.IsZero() || t.Before(next)) {
+			next = t
+		}
+	}
+
+	for _, calendar := range cs.calendars {
+		// cron schedules return zero time if there is no match in the next five years
+		earlier(calendar.Next(after.In(cs.tz)))
+	}
+
+	ts := after.UnixNano()
+	for _, interval := range cs.spec.Intervals {
+		iv := int64(interval.Interval)
+		phase := int64(interval.Phase)
+		n := (ts - phase) / iv
+		if (ts-phase)%iv < 0 {
+			n--
+		}
+		earlier(time.Unix(0, (n+1)*iv+phase))
+	}
+
+	if next.IsZero() {
+		return next
+	}
+	return next.UTC()
+}
+
+// jitterFor returns a deterministic pseudo-random duration in [0, maxJitter)
+func jitterFor(seed string, nominal time.Time, maxJitter time.Duration) time.Duration {
+	if maxJitter <= 0 {
+		return 0
+	}
+	h := fnv.New64a()
+	_, _ = h.Write([]byte(seed))
+	_, _ = h.Write([]byte(nominal.UTC().Format(time.RFC3339Nano)))
+	return time.Duration(h.Sum64() % uint64(maxJitter))
+}
+
+func defaultString(value string, defaultValue string) string {
+	if value == "" {
+		return defaultValue
+	}
+	return value
+}
@@ -0,0 +1,151 @@
+// The MIT License
+//
+// Copyright (c) 2020 Temporal Technologies Inc.  All rights reserved.
+//
+// Copyright (c) 2020 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package scheduler
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/suite"
+)
+
+type (
+	specSuite struct {
+		suite.Suite
+	}
+)
+
+func TestSpecSuite(t *testing.T) {
+	suite.Run(t, new(specSuite))
+}
+
+func (s *specSuite) parse(value string) time.Time {
+	t, err := time.Parse(time.RFC3339, value)
+	s.NoError(err)
+	return t.UTC()
+}
+
+func (s *specSuite) TestValidateSpec() {
+	s.Error(ValidateSpec(ScheduleSpec{}))
+	s.Error(ValidateSpec(ScheduleSpec{CronStrings: []string{"invalid-cron-spec"}}))
+	s.Error(ValidateSpec(ScheduleSpec{Intervals: []IntervalSpec{{Interval: 0}}}))
+	s.Error(ValidateSpec(ScheduleSpec{Intervals: []IntervalSpec{{Interval: time.Hour, Phase: time.Hour}}}))
+	s.Error(ValidateSpec(ScheduleSpec{Calendars: []CalendarSpec{{Hour: "25"}}}))
+	s.Error(ValidateSpec(ScheduleSpec{CronStrings: []string{"@hourly"}, TimezoneName: "Not/AZone"}))
+	s.Error(ValidateSpec(ScheduleSpec{CronStrings: []string{"@hourly"}, Jitter: -time.Second}))
+	s.Error(ValidateSpec(ScheduleSpec{
+		CronStrings: []string{"@hourly"},
+		StartTime:   s.parse("2020-01-02T00:00:00Z"),
+		EndTime:     s.parse("2020-01-01T00:00:00Z"),
+	}))
+
+	s.NoError(ValidateSpec(ScheduleSpec{
+		Calendars:   []CalendarSpec{{Hour: "10", DayOfWeek: "MON-FRI"}},
+		Intervals:   []IntervalSpec{{Interval: 90 * time.Minute, Phase: 5 * time.Minute}},
+		CronStrings: []string{"*/10 * * * *"},
+	}))
+}
+
+func (s *specSuite) TestInterval() {
+	cs, err := newCompiledSpec(ScheduleSpec{
+		Intervals: []IntervalSpec{{Interval: 90 * time.Minute, Phase: 5 * time.Minute}},
+	})
+	s.NoError(err)
+
+	next := cs.getNextTime("", s.parse("2020-01-01T00:00:00Z"))
+	s.Equal(s.parse("2020-01-01T00:05:00Z"), next.Nominal)
+	s.Equal(next.Nominal, next.Next)
+
+	next = cs.getNextTime("", next.Nominal)
+	s.Equal(s.parse("2020-01-01T01:35:00Z"), next.Nominal)
+}
+
+func (s *specSuite) TestCalendarAndCronUnion() {
+	cs, err := newCompiledSpec(ScheduleSpec{
+		Calendars:   []CalendarSpec{{Minute: "30", Hour: "10"}},
+		CronStrings: []string{"0 12 * * *"},
+	})
+	s.NoError(err)
+
+	t := s.parse("2020-01-01T00:00:00Z")
+	var times []time.Time
+	for i := 0; i < 4; i++ {
+		next := cs.getNextTime("", t)
+		times = append(times, next.Nominal)
+		t = next.Nominal
+	}
+	s.Equal([]time.Time{
+		s.parse("2020-01-01T10:30:00Z"),
+		s.parse("2020-01-01T12:00:00Z"),
+		s.parse("2020-01-02T10:30:00Z"),
+		s.parse("2020-01-02T12:00:00Z"),
+	}, times)
+}
+
+func (s *specSuite) TestTimezone() {
+	cs, err := newCompiledSpec(ScheduleSpec{
+		CronStrings:  []string{"0 9 * * *"},
+		TimezoneName: "America/New_York",
+	})
+	s.NoError(err)
+
+	next := cs.getNextTime("", s.parse("2020-01-01T00:00:00Z"))
+	s.Equal(s.parse("2020-01-01T14:00:00Z"), next.Nominal)
+}
+
+func (s *specSuite) TestStartAndEndTime() {
+	cs, err := newCompiledSpec(ScheduleSpec{
+		Intervals: []IntervalSpec{{Interval: time.Hour}},
+		StartTime: s.parse("2020-01-01T05:00:00Z"),
+		EndTime:   s.parse("2020-01-01T06:00:00Z"),
+	})
+	s.NoError(err)
+
+	next := cs.getNextTime("", s.parse("2020-01-01T00:00:00Z"))
+	s.Equal(s.parse("2020-01-01T05:00:00Z"), next.Nominal)
+	next = cs.getNextTime("", next.Nominal)
+	s.Equal(s.parse("2020-01-01T06:00:00Z"), next.Nominal)
+	next = cs.getNextTime("", next.Nominal)
+	s.True(next.Nominal.IsZero())
+}
+
+func (s *specSuite) TestJitter() {
+	cs, err := newCompiledSpec(ScheduleSpec{
+		Intervals: []IntervalSpec{{Interval: time.Minute}},
+		Jitter:    time.Hour,
+	})
+	s.NoError(err)
+
+	t := s.parse("2020-01-01T00:00:00Z")
+	for i := 0; i < 100; i++ {
+		next := cs.getNextTime("namespace/schedule", t)
+		// jitter never exceeds the time until the following nominal time
+		s.False(next.Next.Before(next.Nominal))
+		s.True(next.Next.Before(next.Nominal.Add(time.Minute)))
+		// jitter is deterministic
+		s.Equal(next, cs.getNextTime("namespace/schedule", t))
+		t = next.Nominal
+	}
+}
@@ -0,0 +1,517 @@
+// The MIT License
+//
+// Copyright (c) 2020 Temporal Technologies Inc.  All rights reserved.
+//
+// Copyright (c) 2020 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package scheduler
+
+import (
+	"time"
+
+	"github.com/pborman/uuid"
+	commonpb "go.temporal.io/api/common/v1"
+	sdklog "go.temporal.io/sdk/log"
+	"go.temporal.io/sdk/temporal"
+	"go.temporal.io/sdk/workflow"
+)
+
+const (
+	schedulerContextKey = "schedulerContext"
+	// TaskQueueName is the taskqueue name of scheduler workflows
+	TaskQueueName = "temporal-sys-scheduler-taskqueue"
+	// WorkflowTypeName is the workflow type of scheduler workflows, one per schedule
+	WorkflowTypeName = "temporal-sys-scheduler-workflow"
+
+	startWorkflowActivityName  = "temporal-sys-scheduler-start-workflow-activity"
+	watchWorkflowActivityName  = "temporal-sys-scheduler-watch-workflow-activity"
+	cancelWorkflowActivityName = "temporal-sys-scheduler-cancel-workflow-activity"
+
+	// SignalNameUpdate is the signal replacing the schedule definition
+	SignalNameUpdate = "update"
+	// SignalNamePatch is the signal applying a SchedulePatch
+	SignalNamePatch = "patch"
+	// QueryNameDescribe is the query returning DescribeScheduleResponse
+	QueryNameDescribe = "describe"
+
+	// InfiniteDuration is a long duration(20 yrs) we used for infinite workflow running
+	InfiniteDuration = 20 * 365 * 24 * time.Hour
+
+	defaultCatchupWindow = time.Minute
+	minCatchupWindow     = 10 * time.Second
+	maxRecentActions     = 10
+	futureActionCount    = 10
+	maxBufferedStarts    = 1000
+	// iterationsBeforeContinueAsNew bounds the history size of a scheduler workflow run
+	iterationsBeforeContinueAsNew = 500
+	// initialConflictToken is the conflict token of a newly created schedule, zero means no check
+	initialConflictToken = 1
+)
+
+type (
+	// StartSchedulerArgs is the input of the scheduler workflow, it is also carried over continue-as-new
+	StartSchedulerArgs struct {
+		Namespace    string
+		ScheduleID   string
+		Schedule     Schedule
+		Info         ScheduleInfo
+		InitialPatch *SchedulePatch
+		State        InternalState
+	}
+
+	// InternalState is the state of a scheduler workflow that is not visible to users
+	InternalState struct {
+		// LastProcessedTime is the last nominal time that was processed
+		LastProcessedTime time.Time
+		BufferedStarts    []BufferedStart
+		ConflictToken     int64
+	}
+
+	// BufferedStart is an action that is due but not taken yet
+	BufferedStart struct {
+		NominalTime   time.Time
+		ActualTime    time.Time
+		OverlapPolicy ScheduleOverlapPolicy
+		// Manual is set for triggered and backfilled actions, they are taken even if the schedule is paused
+		Manual bool
+	}
+
+	// UpdateSignal is the payload of SignalNameUpdate
+	UpdateSignal struct {
+		Schedule      Schedule
+		ConflictToken int64
+	}
+
+	scheduler struct {
+		StartSchedulerArgs
+
+		ctx      workflow.Context
+		logger   sdklog.Logger
+		cspec    *compiledSpec
+		watchers map[string]workflow.Future
+		// cancelRequested tracks running workflows we already requested cancellation for
+		cancelRequested map[string]bool
+	}
+)
+
+var (
+	startActivityRetryPolicy = temporal.RetryPolicy{
+		InitialInterval:        time.Second,
+		BackoffCoefficient:     2,
+		MaximumInterval:        time.Minute,
+		MaximumAttempts:        10,
+		NonRetryableErrorTypes: []string{"WorkflowExecutionAlreadyStarted", "InvalidArgument", "NamespaceNotActive"},
+	}
+
+	startActivityOptions = workflow.ActivityOptions{
+		ScheduleToStartTimeout: time.Minute,
+		StartToCloseTimeout:    time.Minute,
+		RetryPolicy:            &startActivityRetryPolicy,
+	}
+
+	watchActivityRetryPolicy = temporal.RetryPolicy{
+		InitialInterval:    10 * time.Second,
+		BackoffCoefficient: 1.7,
+		MaximumInterval:    5 * time.Minute,
+	}
+
+	watchActivityOptions = workflow.ActivityOptions{
+		ScheduleToStartTimeout: InfiniteDuration,
+		StartToCloseTimeout:    InfiniteDuration,
+		HeartbeatTimeout:       2 * watchPollTimeout,
+		RetryPolicy:            &watchActivityRetryPolicy,
+	}
+)
+
+// SchedulerWorkflow is the workflow driving a single schedule. It sleeps until the next
+// matching time of the spec, starts the target workflow according to the overlap policy and
+// handles signals for updates, pausing and triggering.
+func SchedulerWorkflow(ctx workflow.Context, args StartSchedulerArgs) error {
+	s := &scheduler{
+		StartSchedulerArgs: args,
+		ctx:                ctx,
+		logger:             workflow.GetLogger(ctx),
+		watchers:           make(map[string]workflow.Future),
+		cancelRequested:    make(map[string]bool),
+	}
+	return s.run()
+}
+
+func (s *scheduler) run() error {
+	if err := workflow.SetQueryHandler(s.ctx, QueryNameDescribe, s.describe); err != nil {
+		return err
+	}
+
+	s.compileSpec()
+	if s.State.LastProcessedTime.IsZero() {
+		s.State.LastProcessedTime = s.now()
+		s.Info.CreateTime = s.State.LastProcessedTime
+		s.State.ConflictToken = initialConflictToken
+	}
+	if s.InitialPatch != nil {
+		s.processPatch(s.InitialPatch)
+		s.InitialPatch = nil
+	}
+	// watchers don't survive continue-as-new, restart them
+	for _, execution := range s.Info.RunningWorkflows {
+		s.startWatcher(execution)
+	}
+
+	updateCh := workflow.GetSignalChannel(s.ctx, SignalNameUpdate)
+	patchCh := workflow.GetSignalChannel(s.ctx, SignalNamePatch)
+
+	for i := 0; i < iterationsBeforeContinueAsNew; i++ {
+		nextSleep := s.processTimeRange(s.now())
+		s.processBuffer()
+		s.sleep(nextSleep, updateCh, patchCh)
+	}
+
+	// signals received after the last iteration would be lost by continue-as-new
+	s.drainSignals(updateCh, patchCh)
+	return workflow.NewContinueAsNewError(s.ctx, WorkflowTypeName, s.StartSchedulerArgs)
+}
+
+func (s *scheduler) now() time.Time {
+	return workflow.Now(s.ctx).UTC()
+}
+
+func (s *scheduler) jitterSeed() string {
+	return s.Namespace + "/" + s.ScheduleID
+}
+
+func (s *scheduler) compileSpec() {
+	cspec, err := newCompiledSpec(s.Schedule.Spec)
+	if err != nil {
+		// specs are validated by the frontend, keep the previous one if any
+		s.logger.Error("Invalid schedule spec", "ScheduleID", s.ScheduleID, "Error", err)
+		return
+	}
+	s.cspec = cspec
+}
+
+// processTimeRange takes all the actions whose time passed since the last processed time and
+// returns the duration until the next one, negative if the spec has no more matching times.
+func (s *scheduler) processTimeRange(end time.Time) time.Duration {
+	if s.cspec == nil {
+		return -1
+	}
+	catchupWindow := s.getCatchupWindow()
+	for {
+		next := s.cspec.getNextTime(s.jitterSeed(), s.State.LastProcessedTime)
+		if next.Nominal.IsZero() {
+			return -1
+		}
+		if next.Next.After(end) {
+			return next.Next.Sub(end)
+		}
+		s.State.LastProcessedTime = next.Nominal
+		if s.Schedule.State.Paused {
+			continue
+		}
+		if end.Sub(next.Next) > catchupWindow {
+			s.logger.Warn("Schedule missed catchup window", "ScheduleID", s.ScheduleID, "NominalTime", next.Nominal)
+			s.Info.MissedCatchupWindow++
+			continue
+		}
+		s.addStart(next.Nominal, next.Next, s.Schedule.Policies.OverlapPolicy, false)
+	}
+}
+
+func (s *scheduler) processBackfill(backfill BackfillRequest) {
+	if s.cspec == nil {
+		return
+	}
+	t := backfill.StartTime.Add(-time.Nanosecond)
+	for {
+		next := s.cspec.getNextTime(s.jitterSeed(), t)
+		if next.Nominal.IsZero() || next.Nominal.After(backfill.EndTime) {
+			return
+		}
+		t = next.Nominal
+		s.addStart(next.Nominal, s.now(), backfill.OverlapPolicy, true)
+	}
+}
+
+func (s *scheduler) getCatchupWindow() time.Duration {
+	if s.Schedule.Policies.CatchupWindow == 0 {
+		return defaultCatchupWindow
+	}
+	return s.Schedule.Policies.CatchupWindow
+}
+
+func (s *scheduler) resolveOverlapPolicy(policy ScheduleOverlapPolicy) ScheduleOverlapPolicy {
+	if policy == ScheduleOverlapPolicyUnspecified {
+		policy = s.Schedule.Policies.OverlapPolicy
+	}
+	if policy == ScheduleOverlapPolicyUnspecified {
+		policy = ScheduleOverlapPolicySkip
+	}
+	return policy
+}
+
+func (s *scheduler) addStart(nominalTime, actualTime time.Time, overlapPolicy ScheduleOverlapPolicy, manual bool) {
+	if len(s.State.BufferedStarts) >= maxBufferedStarts {
+		s.Info.BufferDropped++
+		return
+	}
+	s.State.BufferedStarts = append(s.State.BufferedStarts, BufferedStart{
+		NominalTime:   nominalTime,
+		ActualTime:    actualTime,
+		OverlapPolicy: overlapPolicy,
+		Manual:        manual,
+	})
+}
+
+// processBuffer starts the buffered actions that are allowed to start given the running workflows.
+// Scheduled actions buffered before the schedule was paused are held until it is unpaused.
+func (s *scheduler) processBuffer() {
+	pending := s.State.BufferedStarts
+	s.State.BufferedStarts = nil
+
+	var held []BufferedStart
+	for _, start := range pending {
+		if s.Schedule.State.Paused && !start.Manual {
+			held = append(held, start)
+			continue
+		}
+		running := len(s.Info.RunningWorkflows) > 0
+		switch s.resolveOverlapPolicy(start.OverlapPolicy) {
+		case ScheduleOverlapPolicySkip:
+			if running {
+				s.Info.OverlapSkipped++
+				continue
+			}
+		case ScheduleOverlapPolicyBufferOne:
+			if running {
+				if len(s.State.BufferedStarts) > 0 {
+					s.Info.OverlapSkipped++
+				} else {
+					s.State.BufferedStarts = append(s.State.BufferedStarts, start)
+				}
+				continue
+			}
+		case ScheduleOverlapPolicyCancelOther:
+			if running {
+				s.cancelRunning()
+				// only the latest action is kept, it starts once the running workflows closed
+				if len(s.State.BufferedStarts) > 0 {
+					s.Info.OverlapSkipped++
+				}
+				s.State.BufferedStarts = []BufferedStart{start}
+				continue
+			}
+		case ScheduleOverlapPolicyAllowAll:
+		}
+		if len(s.State.BufferedStarts) > 0 {
+			// keep the order of actions, this one waits behind the buffered one
+			s.State.BufferedStarts = append(s.State.BufferedStarts, start)
+			continue
+		}
+		s.startWorkflow(start)
+	}
+	s.State.BufferedStarts = append(held, s.State.BufferedStarts...)
+}
+
+func (s *scheduler) startWorkflow(start BufferedStart) {
+	var requestID string
+	if err := workflow.SideEffect(s.ctx, func(ctx workflow.Context) interface{} {
+		return uuid.New()
+	}).Get(&requestID); err != nil {
+		s.logger.Error("Failed to generate request ID", "Error", err)
+		return
+	}
+
+	request := StartWorkflowRequest{
+		Namespace:   s.Namespace,
+		ScheduleID:  s.ScheduleID,
+		Action:      s.Schedule.Action,
+		NominalTime: start.NominalTime,
+		ActualTime:  start.ActualTime,
+		RequestID:   requestID,
+	}
+	var response StartWorkflowResponse
+	ctx := workflow.WithActivityOptions(s.ctx, startActivityOptions)
+	if err := workflow.ExecuteActivity(ctx, startWorkflowActivityName, request).Get(s.ctx, &response); err != nil {
+		s.logger.Error("Failed to start workflow for schedule", "ScheduleID", s.ScheduleID, "Error", err)
+		return
+	}
+
+	execution := commonpb.WorkflowExecution{
+		WorkflowId: response.WorkflowID,
+		RunId:      response.RunID,
+	}
+	s.Info.ActionCount++
+	s.Info.RecentActions = append(s.Info.RecentActions, ScheduleActionResult{
+		ScheduleTime: start.NominalTime,
+		ActualTime:   s.now(),
+		Execution:    execution,
+	})
+	if len(s.Info.RecentActions) > maxRecentActions {
+		s.Info.RecentActions = s.Info.RecentActions[len(s.Info.RecentActions)-maxRecentActions:]
+	}
+	s.Info.RunningWorkflows = append(s.Info.RunningWorkflows, execution)
+	s.startWatcher(execution)
+}
+
+func (s *scheduler) startWatcher(execution commonpb.WorkflowExecution) {
+	ctx := workflow.WithActivityOptions(s.ctx, watchActivityOptions)
+	s.watchers[execution.GetWorkflowId()] = workflow.ExecuteActivity(ctx, watchWorkflowActivityName, WatchWorkflowRequest{
+		Namespace: s.Namespace,
+		Execution: execution,
+	})
+}
+
+func (s *scheduler) watcherDone(workflowID string, f workflow.Future) {
+	delete(s.watchers, workflowID)
+	delete(s.cancelRequested, workflowID)
+
+	var response WatchWorkflowResponse
+	if err := f.Get(s.ctx, &response); err != nil {
+		s.logger.Error("Failed to watch workflow started by schedule", "WorkflowID", workflowID, "Error", err)
+	}
+	for i, execution := range s.Info.RunningWorkflows {
+		if execution.GetWorkflowId() == workflowID {
+			s.Info.RunningWorkflows = append(s.Info.RunningWorkflows[:i], s.Info.RunningWorkflows[i+1:]...)
+			break
+		}
+	}
+}
+
+func (s *scheduler) cancelRunning() {
+	ctx := workflow.WithActivityOptions(s.ctx, startActivityOptions)
+	for _, execution := range s.Info.RunningWorkflows {
+		if s.cancelRequested[execution.GetWorkflowId()] {
+			continue
+		}
+		s.cancelRequested[execution.GetWorkflowId()] = true
+		err := workflow.ExecuteActivity(ctx, cancelWorkflowActivityName, CancelWorkflowRequest{
+			Namespace: s.Namespace,
+			Execution: execution,
+			Reason:    "cancelled by schedule overlap policy",
+		}).Get(s.ctx, nil)
+		if err != nil {
+			s.logger.Error("Failed to cancel workflow started by schedule", "WorkflowID", execution.GetWorkflowId(), "Error", err)
+		}
+	}
+}
+
+// sleep blocks until the next action is due, a signal is received, or a running workflow closed
+func (s *scheduler) sleep(nextSleep time.Duration, updateCh, patchCh workflow.ReceiveChannel) {
+	selector := workflow.NewSelector(s.ctx)
+
+	timerCtx, cancelTimer := workflow.WithCancel(s.ctx)
+	defer cancelTimer()
+	if nextSleep >= 0 {
+		selector.AddFuture(workflow.NewTimer(timerCtx, nextSleep), func(_ workflow.Future) {})
+	}
+
+	selector.AddReceive(updateCh, func(c workflow.ReceiveChannel, _ bool) {
+		var signal UpdateSignal
+		c.Receive(s.ctx, &signal)
+		s.processUpdate(signal)
+	})
+	selector.AddReceive(patchCh, func(c workflow.ReceiveChannel, _ bool) {
+		var patch SchedulePatch
+		c.Receive(s.ctx, &patch)
+		s.processPatch(&patch)
+	})
+	// iterate over the running workflows instead of the map to keep the order deterministic
+	for _, execution := range s.Info.RunningWorkflows {
+		workflowID := execution.GetWorkflowId()
+		if f, ok := s.watchers[workflowID]; ok {
+			selector.AddFuture(f, func(f workflow.Future) {
+				s.watcherDone(workflowID, f)
+			})
+		}
+	}
+
+	selector.Select(s.ctx)
+}
+
+func (s *scheduler) drainSignals(updateCh, patchCh workflow.ReceiveChannel) {
+	for {
+		var signal UpdateSignal
+		if !updateCh.ReceiveAsync(&signal) {
+			break
+		}
+		s.processUpdate(signal)
+	}
+	for {
+		var patch SchedulePatch
+		if !patchCh.ReceiveAsync(&patch) {
+			break
+		}
+		s.processPatch(&patch)
+	}
+}
+
+func (s *scheduler) processUpdate(signal UpdateSignal) {
+	if signal.ConflictToken != 0 && signal.ConflictToken != s.State.ConflictToken {
+		s.logger.Warn("Schedule update dropped due to conflict token mismatch", "ScheduleID", s.ScheduleID)
+		return
+	}
+	s.logger.Info("Schedule updated", "ScheduleID", s.ScheduleID)
+	s.Schedule = signal.Schedule
+	s.compileSpec()
+	s.Info.UpdateTime = s.now()
+	s.State.ConflictToken++
+}
+
+func (s *scheduler) processPatch(patch *SchedulePatch) {
+	s.logger.Info("Schedule patch", "ScheduleID", s.ScheduleID)
+	if patch.TriggerImmediately != nil {
+		now := s.now()
+		s.addStart(now, now, patch.TriggerImmediately.OverlapPolicy, true)
+	}
+	for _, backfill := range patch.BackfillRequests {
+		s.processBackfill(backfill)
+	}
+	if patch.Pause != "" {
+		s.Schedule.State.Paused = true
+		s.Schedule.State.Notes = patch.Pause
+	}
+	if patch.Unpause != "" {
+		s.Schedule.State.Paused = false
+		s.Schedule.State.Notes = patch.Unpause
+	}
+	s.Info.UpdateTime = s.now()
+}
+
+func (s *scheduler) describe() (*DescribeScheduleResponse, error) {
+	info := s.Info
+	info.FutureActionTimes = nil
+	if s.cspec != nil && !s.Schedule.State.Paused {
+		t := s.State.LastProcessedTime
+		for len(info.FutureActionTimes) < futureActionCount {
+			next := s.cspec.getNextTime(s.jitterSeed(), t)
+			if next.Nominal.IsZero() {
+				break
+			}
+			info.FutureActionTimes = append(info.FutureActionTimes, next.Next)
+			t = next.Nominal
+		}
+	}
+	return &DescribeScheduleResponse{
+		Schedule:      s.Schedule,
+		Info:          info,
+		ConflictToken: s.State.ConflictToken,
+	}, nil
+}
@@ -0,0 +1,225 @@
+// The MIT License
+//
+// Copyright (c) 2020 Temporal Technologies Inc.  All rights reserved.
+//
+// Copyright (c) 2020 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package scheduler
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/suite"
+	enumspb "go.temporal.io/api/enums/v1"
+	"go.temporal.io/sdk/activity"
+	"go.temporal.io/sdk/testsuite"
+	"go.temporal.io/sdk/workflow"
+)
+
+type (
+	workflowSuite struct {
+		suite.Suite
+		testsuite.WorkflowTestSuite
+
+		env     *testsuite.TestWorkflowEnvironment
+		started []StartWorkflowRequest
+	}
+)
+
+var workflowSuiteStartTime = time.Date(2021, 1, 1, 0, 30, 0, 0, time.UTC)
+
+func TestWorkflowSuite(t *testing.T) {
+	suite.Run(t, new(workflowSuite))
+}
+
+func (s *workflowSuite) SetupTest() {
+	s.started = nil
+	s.env = s.NewTestWorkflowEnvironment()
+	s.env.SetStartTime(workflowSuiteStartTime)
+	s.env.RegisterWorkflowWithOptions(SchedulerWorkflow, workflow.RegisterOptions{Name: WorkflowTypeName})
+	s.env.RegisterActivityWithOptions(StartWorkflowActivity, activity.RegisterOptions{Name: startWorkflowActivityName})
+	s.env.RegisterActivityWithOptions(WatchWorkflowActivity, activity.RegisterOptions{Name: watchWorkflowActivityName})
+	s.env.RegisterActivityWithOptions(CancelWorkflowActivity, activity.RegisterOptions{Name: cancelWorkflowActivityName})
+
+	s.env.OnActivity(startWorkflowActivityName, mock.Anything, mock.Anything).Return(
+		func(ctx context.Context, request StartWorkflowRequest) (*StartWorkflowResponse, error) {
+			s.started = append(s.started, request)
+			return &StartWorkflowResponse{
+				WorkflowID: request.Action.WorkflowID + "-" + request.NominalTime.Format(time.RFC3339Nano),
+				RunID:      request.RequestID,
+			}, nil
+		})
+}
+
+func (s *workflowSuite) mockWatch(runningFor time.Duration) {
+	s.env.OnActivity(watchWorkflowActivityName, mock.Anything, mock.Anything).
+		After(runningFor).
+		Return(&WatchWorkflowResponse{Status: enumspb.WORKFLOW_EXECUTION_STATUS_COMPLETED}, nil)
+}
+
+func (s *workflowSuite) hourlySchedule(overlapPolicy ScheduleOverlapPolicy) Schedule {
+	return Schedule{
+		Spec: ScheduleSpec{Intervals: []IntervalSpec{{Interval: time.Hour}}},
+		Action: StartWorkflowAction{
+			WorkflowID:   "wf",
+			WorkflowType: "wf-type",
+			TaskQueue:    "tq",
+		},
+		Policies: SchedulePolicies{OverlapPolicy: overlapPolicy},
+	}
+}
+
+func (s *workflowSuite) describe() *DescribeScheduleResponse {
+	value, err := s.env.QueryWorkflow(QueryNameDescribe)
+	s.NoError(err)
+	var response DescribeScheduleResponse
+	s.NoError(value.Get(&response))
+	return &response
+}
+
+func (s *workflowSuite) run(args StartSchedulerArgs) {
+	s.env.ExecuteWorkflow(WorkflowTypeName, args)
+	s.True(s.env.IsWorkflowCompleted())
+	var continueAsNewErr *workflow.ContinueAsNewError
+	s.True(errors.As(s.env.GetWorkflowError(), &continueAsNewErr))
+}
+
+func (s *workflowSuite) TestStartsWorkflowsOnSpec() {
+	s.mockWatch(time.Minute)
+	s.env.RegisterDelayedCallback(func() {
+		response := s.describe()
+		s.Equal(int64(3), response.Info.ActionCount)
+		s.Len(response.Info.RecentActions, 3)
+		s.Empty(response.Info.RunningWorkflows)
+		s.Equal(workflowSuiteStartTime.Add(3*time.Hour+30*time.Minute), response.Info.FutureActionTimes[0])
+
+		s.Len(s.started, 3)
+		for i, request := range s.started {
+			s.Equal(workflowSuiteStartTime.Add(time.Duration(i)*time.Hour+30*time.Minute), request.NominalTime)
+			s.Equal("wf", request.Action.WorkflowID)
+		}
+	}, 3*time.Hour)
+
+	s.run(StartSchedulerArgs{
+		Namespace:  "ns",
+		ScheduleID: "sched",
+		Schedule:   s.hourlySchedule(ScheduleOverlapPolicySkip),
+	})
+}
+
+func (s *workflowSuite) TestOverlapSkip() {
+	s.mockWatch(90 * time.Minute)
+	s.env.RegisterDelayedCallback(func() {
+		response := s.describe()
+		// 01:00 started, 02:00 skipped, 03:00 started
+		s.Equal(int64(2), response.Info.ActionCount)
+		s.Equal(int64(1), response.Info.OverlapSkipped)
+	}, 2*time.Hour+45*time.Minute)
+
+	s.run(StartSchedulerArgs{
+		Namespace:  "ns",
+		ScheduleID: "sched",
+		Schedule:   s.hourlySchedule(ScheduleOverlapPolicySkip),
+	})
+}
+
+func (s *workflowSuite) TestPauseHoldsBufferedStarts() {
+	s.mockWatch(90 * time.Minute)
+	// 01:00 starts a workflow running until 02:30, 02:00 is buffered behind it
+	s.env.RegisterDelayedCallback(func() {
+		s.env.SignalWorkflow(SignalNamePatch, &SchedulePatch{Pause: "maintenance"})
+	}, time.Hour+40*time.Minute)
+	s.env.RegisterDelayedCallback(func() {
+		response := s.describe()
+		s.True(response.Schedule.State.Paused)
+		s.Equal("maintenance", response.Schedule.State.Notes)
+		s.Empty(response.Info.RunningWorkflows)
+		s.Equal(int64(1), response.Info.ActionCount)
+		s.Len(s.started, 1)
+	}, 2*time.Hour+45*time.Minute)
+	s.env.RegisterDelayedCallback(func() {
+		s.env.SignalWorkflow(SignalNamePatch, &SchedulePatch{Unpause: "done"})
+	}, 2*time.Hour+50*time.Minute)
+	s.env.RegisterDelayedCallback(func() {
+		response := s.describe()
+		s.False(response.Schedule.State.Paused)
+		s.Equal(int64(2), response.Info.ActionCount)
+		s.Len(s.started, 2)
+		s.Equal(workflowSuiteStartTime.Add(90*time.Minute), s.started[1].NominalTime)
+	}, 2*time.Hour+55*time.Minute)
+
+	s.run(StartSchedulerArgs{
+		Namespace:  "ns",
+		ScheduleID: "sched",
+		Schedule:   s.hourlySchedule(ScheduleOverlapPolicyBufferOne),
+	})
+}
+
+func (s *workflowSuite) TestTriggerWhilePaused() {
+	s.mockWatch(time.Minute)
+	schedule := s.hourlySchedule(ScheduleOverlapPolicySkip)
+	schedule.State.Paused = true
+	s.env.RegisterDelayedCallback(func() {
+		s.env.SignalWorkflow(SignalNamePatch, &SchedulePatch{TriggerImmediately: &TriggerImmediatelyRequest{}})
+	}, 10*time.Minute)
+	s.env.RegisterDelayedCallback(func() {
+		response := s.describe()
+		s.Equal(int64(1), response.Info.ActionCount)
+		s.Empty(response.Info.FutureActionTimes)
+		s.Len(s.started, 1)
+		s.Equal(workflowSuiteStartTime.Add(10*time.Minute), s.started[0].NominalTime)
+	}, 3*time.Hour)
+
+	s.run(StartSchedulerArgs{
+		Namespace:  "ns",
+		ScheduleID: "sched",
+		Schedule:   schedule,
+	})
+}
+
+func (s *workflowSuite) TestUpdateConflictToken() {
+	s.mockWatch(time.Minute)
+	updated := s.hourlySchedule(ScheduleOverlapPolicyAllowAll)
+	s.env.RegisterDelayedCallback(func() {
+		s.env.SignalWorkflow(SignalNameUpdate, UpdateSignal{Schedule: updated, ConflictToken: initialConflictToken + 1})
+	}, 10*time.Minute)
+	s.env.RegisterDelayedCallback(func() {
+		response := s.describe()
+		s.Equal(ScheduleOverlapPolicySkip, response.Schedule.Policies.OverlapPolicy)
+		s.Equal(int64(initialConflictToken), response.ConflictToken)
+		s.env.SignalWorkflow(SignalNameUpdate, UpdateSignal{Schedule: updated, ConflictToken: initialConflictToken})
+	}, 15*time.Minute)
+	s.env.RegisterDelayedCallback(func() {
+		response := s.describe()
+		s.Equal(ScheduleOverlapPolicyAllowAll, response.Schedule.Policies.OverlapPolicy)
+		s.Equal(int64(initialConflictToken+1), response.ConflictToken)
+	}, 20*time.Minute)
+
+	s.run(StartSchedulerArgs{
+		Namespace:  "ns",
+		ScheduleID: "sched",
+		Schedule:   s.hourlySchedule(ScheduleOverlapPolicySkip),
+	})
+}
@@ -45,6 +45,7 @@ import (
 	"go.temporal.io/server/service/worker/parentclosepolicy"
 	"go.temporal.io/server/service/worker/replicator"
 	"go.temporal.io/server/service/worker/scanner"
+	"go.temporal.io/server/service/worker/scheduler"
 )
 
 type (
@@ -72,6 +73,7 @@ type (
 		PersistenceGlobalMaxQPS       dynamicconfig.IntPropertyFn
 		EnableBatcher                 dynamicconfig.BoolPropertyFn
 		EnableParentClosePolicyWorker dynamicconfig.BoolPropertyFn
+		EnableScheduler               dynamicconfig.BoolPropertyFn
 	}
 )
 
@@ -138,6 +140,7 @@ func NewConfig(params *resource.BootstrapParams) *Config {
 			TaskQueueScannerEnabled:  dc.GetBoolProperty(dynamicconfig.TaskQueueScannerEnabled, true),
 			HistoryScannerEnabled:    dc.GetBoolProperty(dynamicconfig.HistoryScannerEnabled, true),
 			ExecutionsScannerEnabled: dc.GetBoolProperty(dynamicconfig.ExecutionsScannerEnabled, false),
+			RetentionScannerEnabled:  dc.GetBoolProperty(dynamicconfig.RetentionScannerEnabled, true),
 		},
 		BatcherCfg: &batcher.Config{
 			AdminOperationToken: dc.GetStringProperty(dynamicconfig.AdminOperationToken, common.DefaultAdminOperationToken),
@@ -145,6 +148,7 @@ func NewConfig(params *resource.BootstrapParams) *Config {
 		},
 		EnableBatcher:                 dc.GetBoolProperty(dynamicconfig.EnableBatcher, true),
 		EnableParentClosePolicyWorker: dc.GetBoolProperty(dynamicconfig.EnableParentClosePolicyWorker, true),
+		EnableScheduler:               dc.GetBoolProperty(dynamicconfig.EnableScheduler, true),
 		ThrottledLogRPS:               dc.GetIntProperty(dynamicconfig.WorkerThrottledLogRPS, 20),
 		PersistenceGlobalMaxQPS:       dc.GetIntProperty(dynamicconfig.WorkerPersistenceGlobalMaxQPS, 0),
 	}
@@ -194,6 +198,9 @@ func (s *Service) Start() {
 	if s.config.EnableParentClosePolicyWorker() {
 		s.startParentClosePolicyProcessor()
 	}
+	if s.config.EnableScheduler() {
+		s.startScheduler()
+	}
 
 	logger.Info("worker started", tag.ComponentWorker)
 	<-s.stopC
@@ -238,6 +245,18 @@ func (s *Service) startBatcher() {
 	}
 }
 
+func (s *Service) startScheduler() {
+	params := &scheduler.BootstrapParams{
+		ServiceClient: s.params.PublicClient,
+		MetricsClient: s.GetMetricsClient(),
+		Logger:        s.GetLogger(),
+		ClientBean:    s.GetClientBean(),
+	}
+	if err := scheduler.New(params).Start(); err != nil {
+		s.GetLogger().Fatal("error starting scheduler", tag.Error(err))
+	}
+}
+
 func (s *Service) startScanner() {
 	params := &scanner.BootstrapParams{
 		Config: *s.config.ScannerCfg,
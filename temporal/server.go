@@ -38,6 +38,7 @@ import (
 	"go.temporal.io/server/common"
 	"go.temporal.io/server/common/archiver"
 	"go.temporal.io/server/common/archiver/provider"
+	"go.temporal.io/server/common/audit"
 	"go.temporal.io/server/common/authorization"
 	"go.temporal.io/server/common/cluster"
 	"go.temporal.io/server/common/elasticsearch"
@@ -54,6 +55,7 @@ import (
 	"go.temporal.io/server/common/rpc/encryption"
 	"go.temporal.io/server/common/service/config/ringpop"
 	"go.temporal.io/server/common/service/dynamicconfig"
+	"go.temporal.io/server/common/tracing"
 	"go.temporal.io/server/service/frontend"
 	"go.temporal.io/server/service/history"
 	"go.temporal.io/server/service/matching"
@@ -70,6 +72,7 @@ type (
 		serviceStoppedChs map[string]chan struct{}
 		stoppedCh         chan struct{}
 		logger            l.Logger
+		stopTracing       func()
 	}
 )
 
@@ -122,6 +125,13 @@ func (s *Server) Start() error {
 		}
 	}
 
+	if s.so.config.Global.Tracing != nil {
+		s.stopTracing, err = tracing.Init(s.so.config.Global.Tracing, "temporal")
+		if err != nil {
+			return fmt.Errorf("tracing initialization error: %w", err)
+		}
+	}
+
 	dynamicConfig, err := dynamicconfig.NewFileBasedClient(&s.so.config.DynamicConfigClient, s.logger, s.stoppedCh)
 	if err != nil {
 		s.logger.Info("Error creating file based dynamic config client, use no-op config client instead.", tag.Error(err))
@@ -209,6 +219,10 @@ func (s *Server) Stop() {
 		}(svc, svcName, s.serviceStoppedChs[svcName])
 	}
 	wg.Wait()
+
+	if s.stopTracing != nil {
+		s.stopTracing()
+	}
 }
 
 // Populates parameters for a service
@@ -224,6 +238,7 @@ func (s *Server) getServiceParams(
 	params.Name = svcName
 	params.Logger = s.logger
 	params.PersistenceConfig = s.so.config.Persistence
+	params.AbstractDatastoreFactory = s.so.customDataStoreFactory
 	params.DynamicConfig = dynamicConfig
 
 	svcCfg := s.so.config.Services[svcName]
@@ -326,12 +341,42 @@ func (s *Server) getServiceParams(
 	if s.so.authorizer != nil {
 		params.Authorizer = s.so.authorizer
 	} else {
-		params.Authorizer = authorization.NewNopAuthorizer()
+		params.Authorizer = authorization.GetAuthorizerFromConfig(&s.so.config.Global.Authorization)
+	}
+	if s.so.claimMapper != nil {
+		params.ClaimMapper = s.so.claimMapper
+	} else {
+		claimMapper, err := authorization.GetClaimMapperFromConfig(&s.so.config.Global.Authorization)
+		if err != nil {
+			return nil, fmt.Errorf("unable to create claim mapper: %w", err)
+		}
+		params.ClaimMapper = claimMapper
+	}
+	if svcName == primitives.FrontendService {
+		params.AuditSinks, err = s.getAuditSinks()
+		if err != nil {
+			return nil, fmt.Errorf("unable to create audit sinks: %w", err)
+		}
 	}
 
 	return &params, nil
 }
 
+func (s *Server) getAuditSinks() (map[string]audit.Sink, error) {
+	sinks := make(map[string]audit.Sink)
+	if filePath := s.so.config.Global.Audit.FilePath; filePath != "" {
+		fileSink, err := audit.NewFileSink(filePath)
+		if err != nil {
+			return nil, err
+		}
+		sinks[audit.SinkFile] = fileSink
+	}
+	for name, sink := range s.so.auditSinks {
+		sinks[name] = sink
+	}
+	return sinks, nil
+}
+
 // Validates configuration of dependencies
 func (s *Server) validate() error {
 	// cassandra schema version validation
@@ -360,6 +405,7 @@ func (s *Server) immutableClusterMetadataInitialization(dc *dynamicconfig.Collec
 		&s.so.config.Persistence,
 		dc.GetIntProperty(dynamicconfig.HistoryPersistenceMaxQPS, 3000),
 		nil,
+		s.so.customDataStoreFactory,
 		s.so.config.ClusterMetadata.CurrentClusterName,
 		nil,
 		logger,
@@ -25,7 +25,9 @@
 package temporal
 
 import (
+	"go.temporal.io/server/common/audit"
 	"go.temporal.io/server/common/authorization"
+	persistenceClient "go.temporal.io/server/common/persistence/client"
 	"go.temporal.io/server/common/rpc/encryption"
 	"go.temporal.io/server/common/service/config"
 )
@@ -69,9 +71,34 @@ func WithAuthorizer(authorizer authorization.Authorizer) ServerOption {
 	})
 }
 
+// Sets the claim mapper extracting the claims of callers passed to the authorizer
+func WithClaimMapper(claimMapper authorization.ClaimMapper) ServerOption {
+	return newApplyFuncContainer(func(s *serverOptions) {
+		s.claimMapper = claimMapper
+	})
+}
+
+// Adds a named audit sink, selectable per namespace with the frontend.auditSink dynamic config.
+// The sink is closed when the frontend service stops.
+func WithAuditSink(name string, sink audit.Sink) ServerOption {
+	return newApplyFuncContainer(func(s *serverOptions) {
+		if s.auditSinks == nil {
+			s.auditSinks = make(map[string]audit.Sink)
+		}
+		s.auditSinks[name] = sink
+	})
+}
+
 // Overrides default provider of TLS configuration
 func WithTLSConfigFactory(tlsConfigProvider encryption.TLSConfigProvider) ServerOption {
 	return newApplyFuncContainer(func(s *serverOptions) {
 		s.tlsConfigProvider = tlsConfigProvider
 	})
 }
+
+// Sets the factory serving the datastores configured with customDatastore
+func WithCustomDataStoreFactory(customFactory persistenceClient.AbstractDataStoreFactory) ServerOption {
+	return newApplyFuncContainer(func(s *serverOptions) {
+		s.customDataStoreFactory = customFactory
+	})
+}
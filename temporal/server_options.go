@@ -27,7 +27,9 @@ package temporal
 import (
 	"fmt"
 
+	"go.temporal.io/server/common/audit"
 	"go.temporal.io/server/common/authorization"
+	persistenceClient "go.temporal.io/server/common/persistence/client"
 	"go.temporal.io/server/common/rpc/encryption"
 	"go.temporal.io/server/common/service/config"
 )
@@ -36,11 +38,15 @@ type (
 	serverOptions struct {
 		config            *config.Config
 		authorizer        authorization.Authorizer
+		claimMapper       authorization.ClaimMapper
+		auditSinks        map[string]audit.Sink
 		tlsConfigProvider encryption.TLSConfigProvider
 		configDir         string
 		env               string
 		zone              string
 
+		customDataStoreFactory persistenceClient.AbstractDataStoreFactory
+
 		serviceNames []string
 
 		interruptCh   <-chan interface{}
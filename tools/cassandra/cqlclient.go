@@ -28,6 +28,7 @@ import (
 	"errors"
 	"fmt"
 	"log"
+	"sort"
 	"time"
 
 	"github.com/gocql/gocql"
@@ -73,6 +74,7 @@ const (
 	listTypesCQL                = `SELECT type_name from system_schema.types where keyspace_name=?`
 	writeSchemaVersionCQL       = `INSERT into schema_version(keyspace_name, creation_time, curr_version, min_compatible_version) VALUES (?,?,?,?)`
 	writeSchemaUpdateHistoryCQL = `INSERT into schema_update_history(year, month, update_time, old_version, new_version, manifest_md5, description) VALUES(?,?,?,?,?,?,?)`
+	readSchemaUpdateHistoryCQL  = `SELECT update_time, old_version, new_version, manifest_md5, description from schema_update_history`
 
 	createSchemaVersionTableCQL = `CREATE TABLE IF NOT EXISTS schema_version(keyspace_name text PRIMARY KEY, ` +
 		`creation_time timestamp, ` +
@@ -204,6 +206,24 @@ func (client *cqlClient) WriteSchemaUpdateLog(oldVersion string, newVersion stri
 	return query.Exec()
 }
 
+// ReadSchemaUpdateLog returns the entries of the schema update history table, oldest first
+func (client *cqlClient) ReadSchemaUpdateLog() ([]schema.UpdateLogEntry, error) {
+	iter := client.session.Query(readSchemaUpdateHistoryCQL).Iter()
+	var entries []schema.UpdateLogEntry
+	var entry schema.UpdateLogEntry
+	for iter.Scan(&entry.UpdateTime, &entry.OldVersion, &entry.NewVersion, &entry.ManifestMD5, &entry.Description) {
+		entries = append(entries, entry)
+	}
+	if err := iter.Close(); err != nil {
+		return nil, err
+	}
+	// the history is partitioned by month, so the rows only come back ordered within a month
+	sort.Slice(entries, func(i, j int) bool {
+		return entries[i].UpdateTime.Before(entries[j].UpdateTime)
+	})
+	return entries, nil
+}
+
 // Exec executes a cql statement
 func (client *cqlClient) Exec(stmt string, args ...interface{}) error {
 	return client.session.Query(stmt, args...).Exec()
@@ -180,6 +180,14 @@ func buildCLIOptions() *cli.App {
 					Name:  schema.CLIFlagDryrun,
 					Usage: "do a dryrun",
 				},
+				cli.BoolFlag{
+					Name:  schema.CLIFlagPlan,
+					Usage: "print the statements the update would execute, without executing them",
+				},
+				cli.BoolFlag{
+					Name:  schema.CLIFlagMarkApplied,
+					Usage: "record the versions up to the target version as applied without executing them",
+				},
 			},
 			Action: func(c *cli.Context) {
 				cliHandler(c, updateSchema)
@@ -114,6 +114,12 @@ func NewCliApp() *cli.App {
 			Usage:       "batch operation on a list of workflows from query.",
 			Subcommands: newBatchCommands(),
 		},
+		{
+			Name:        "schedule",
+			Aliases:     []string{"sch"},
+			Usage:       "Operate schedules which start workflows at given times",
+			Subcommands: newScheduleCommands(),
+		},
 		{
 			Name:    "admin",
 			Aliases: []string{"adm"},
@@ -50,6 +50,8 @@ import (
 
 	"go.temporal.io/server/api/adminservice/v1"
 	"go.temporal.io/server/api/adminservicemock/v1"
+	enumsspb "go.temporal.io/server/api/enums/v1"
+	schedulespb "go.temporal.io/server/api/schedule/v1"
 	"go.temporal.io/server/common/payload"
 	"go.temporal.io/server/common/payloads"
 	"go.temporal.io/server/common/primitives/timestamp"
@@ -86,6 +88,7 @@ var commands = []string{
 	"namespace", "n",
 	"workflow", "wf",
 	"taskqueue", "tq",
+	"schedule", "sch",
 }
 
 var cliTestNamespace = "cli-test-namespace"
@@ -344,6 +347,127 @@ func (s *cliAppSuite) TestTerminateWorkflow_Failed() {
 	s.sdkClient.AssertExpectations(s.T())
 }
 
+func (s *cliAppSuite) TestDeleteWorkflow() {
+	s.serverAdminClient.EXPECT().DeleteWorkflowExecution(gomock.Any(), gomock.Any()).DoAndReturn(
+		func(_ interface{}, request *adminservice.DeleteWorkflowExecutionRequest, _ ...interface{}) (*adminservice.DeleteWorkflowExecutionResponse, error) {
+			s.Equal(cliTestNamespace, request.GetNamespace())
+			s.Equal("wid", request.GetExecution().GetWorkflowId())
+			s.Equal("rid", request.GetExecution().GetRunId())
+			return &adminservice.DeleteWorkflowExecutionResponse{}, nil
+		})
+	err := s.app.Run([]string{"", "--ns", cliTestNamespace, "workflow", "delete", "-w", "wid", "-r", "rid"})
+	s.Nil(err)
+}
+
+func (s *cliAppSuite) TestDeleteWorkflow_Failed() {
+	s.serverAdminClient.EXPECT().DeleteWorkflowExecution(gomock.Any(), gomock.Any()).Return(nil, serviceerror.NewNotFound("faked error"))
+	errorCode := s.RunErrorExitCode([]string{"", "--ns", cliTestNamespace, "workflow", "delete", "-w", "wid"})
+	s.Equal(1, errorCode)
+}
+
+func (s *cliAppSuite) TestUpdateWorkflow() {
+	s.serverAdminClient.EXPECT().UpdateWorkflowExecution(gomock.Any(), gomock.Any()).DoAndReturn(
+		func(_ interface{}, request *adminservice.UpdateWorkflowExecutionRequest, _ ...interface{}) (*adminservice.UpdateWorkflowExecutionResponse, error) {
+			s.Equal(cliTestNamespace, request.GetNamespace())
+			s.Equal("wid", request.GetExecution().GetWorkflowId())
+			s.Equal("update-name", request.GetName())
+			s.Equal("update-id", request.GetUpdateId())
+			s.True(request.GetWaitForAccepted())
+			return &adminservice.UpdateWorkflowExecutionResponse{UpdateId: "update-id"}, nil
+		})
+	err := s.app.Run([]string{"", "--ns", cliTestNamespace, "workflow", "update", "-w", "wid", "-n", "update-name", "-i", "1", "--update_id", "update-id", "--wait_for_accepted"})
+	s.Nil(err)
+}
+
+func (s *cliAppSuite) TestUpdateWorkflow_Failed() {
+	s.serverAdminClient.EXPECT().UpdateWorkflowExecution(gomock.Any(), gomock.Any()).Return(nil, serviceerror.NewDeadlineExceeded("faked error"))
+	errorCode := s.RunErrorExitCode([]string{"", "--ns", cliTestNamespace, "workflow", "update", "-w", "wid", "-n", "update-name"})
+	s.Equal(1, errorCode)
+}
+
+func (s *cliAppSuite) TestGetWorkflowResult() {
+	gomock.InOrder(
+		s.serverAdminClient.EXPECT().GetWorkflowExecutionResult(gomock.Any(), gomock.Any()).Return(&adminservice.GetWorkflowExecutionResultResponse{
+			Execution: &commonpb.WorkflowExecution{WorkflowId: "wid", RunId: "rid"},
+			Status:    enumspb.WORKFLOW_EXECUTION_STATUS_RUNNING,
+		}, nil),
+		s.serverAdminClient.EXPECT().GetWorkflowExecutionResult(gomock.Any(), gomock.Any()).DoAndReturn(
+			func(_ interface{}, request *adminservice.GetWorkflowExecutionResultRequest, _ ...interface{}) (*adminservice.GetWorkflowExecutionResultResponse, error) {
+				s.Equal(cliTestNamespace, request.GetNamespace())
+				s.Equal("wid", request.GetExecution().GetWorkflowId())
+				s.True(request.GetFollowRuns())
+				return &adminservice.GetWorkflowExecutionResultResponse{
+					Execution: &commonpb.WorkflowExecution{WorkflowId: "wid", RunId: "rid"},
+					Status:    enumspb.WORKFLOW_EXECUTION_STATUS_COMPLETED,
+					Result:    payloads.EncodeString("result"),
+				}, nil
+			}),
+	)
+	err := s.app.Run([]string{"", "--ns", cliTestNamespace, "workflow", "result", "-w", "wid", "--follow_runs"})
+	s.Nil(err)
+}
+
+func (s *cliAppSuite) TestGetWorkflowResult_Failed() {
+	s.serverAdminClient.EXPECT().GetWorkflowExecutionResult(gomock.Any(), gomock.Any()).Return(nil, serviceerror.NewNotFound("faked error"))
+	errorCode := s.RunErrorExitCode([]string{"", "--ns", cliTestNamespace, "workflow", "result", "-w", "wid"})
+	s.Equal(1, errorCode)
+}
+
+func (s *cliAppSuite) TestResetWorkflowWithOptions() {
+	s.serverAdminClient.EXPECT().ResetWorkflowExecutionWithOptions(gomock.Any(), gomock.Any()).DoAndReturn(
+		func(_ interface{}, request *adminservice.ResetWorkflowExecutionWithOptionsRequest, _ ...interface{}) (*adminservice.ResetWorkflowExecutionWithOptionsResponse, error) {
+			s.Equal(cliTestNamespace, request.GetNamespace())
+			s.Equal("wid", request.GetExecution().GetWorkflowId())
+			s.Equal(int64(0), request.GetWorkflowTaskFinishEventId())
+			s.Equal(int64(2), request.GetWorkflowTaskScheduledEventId())
+			s.Equal("exclude_signals", request.GetReapplyType())
+			s.True(request.GetDryRun())
+			s.NotEmpty(request.GetRequestId())
+			return &adminservice.ResetWorkflowExecutionWithOptionsResponse{
+				Preview: &adminservice.ResetWorkflowExecutionPreview{BaseRunId: "rid", WorkflowTaskFinishEventId: 4},
+			}, nil
+		})
+	err := s.app.Run([]string{"", "--ns", cliTestNamespace, "workflow", "reset", "-w", "wid", "--reason", "test",
+		"--scheduled_event_id", "2", "--reapply_type", "exclude_signals", "--dry_run"})
+	s.Nil(err)
+}
+
+func (s *cliAppSuite) TestResetWorkflowWithOptions_Failed() {
+	s.serverAdminClient.EXPECT().ResetWorkflowExecutionWithOptions(gomock.Any(), gomock.Any()).Return(nil, serviceerror.NewInvalidArgument("faked error"))
+	errorCode := s.RunErrorExitCode([]string{"", "--ns", cliTestNamespace, "workflow", "reset", "-w", "wid", "--reason", "test", "--scheduled_event_id", "2"})
+	s.Equal(1, errorCode)
+}
+
+func (s *cliAppSuite) TestPauseWorkflow() {
+	s.serverAdminClient.EXPECT().PauseWorkflowExecution(gomock.Any(), gomock.Any()).DoAndReturn(
+		func(_ interface{}, request *adminservice.PauseWorkflowExecutionRequest, _ ...interface{}) (*adminservice.PauseWorkflowExecutionResponse, error) {
+			s.Equal(cliTestNamespace, request.GetNamespace())
+			s.Equal("wid", request.GetExecution().GetWorkflowId())
+			s.Equal("rid", request.GetExecution().GetRunId())
+			s.Equal("maintenance", request.GetReason())
+			return &adminservice.PauseWorkflowExecutionResponse{}, nil
+		})
+	err := s.app.Run([]string{"", "--ns", cliTestNamespace, "workflow", "pause", "-w", "wid", "-r", "rid", "--reason", "maintenance"})
+	s.Nil(err)
+}
+
+func (s *cliAppSuite) TestUnpauseWorkflow() {
+	s.serverAdminClient.EXPECT().UnpauseWorkflowExecution(gomock.Any(), gomock.Any()).DoAndReturn(
+		func(_ interface{}, request *adminservice.UnpauseWorkflowExecutionRequest, _ ...interface{}) (*adminservice.UnpauseWorkflowExecutionResponse, error) {
+			s.Equal(cliTestNamespace, request.GetNamespace())
+			s.Equal("wid", request.GetExecution().GetWorkflowId())
+			return &adminservice.UnpauseWorkflowExecutionResponse{}, nil
+		})
+	err := s.app.Run([]string{"", "--ns", cliTestNamespace, "workflow", "unpause", "-w", "wid"})
+	s.Nil(err)
+}
+
+func (s *cliAppSuite) TestUnpauseWorkflow_Failed() {
+	s.serverAdminClient.EXPECT().UnpauseWorkflowExecution(gomock.Any(), gomock.Any()).Return(nil, serviceerror.NewNotFound("faked error"))
+	errorCode := s.RunErrorExitCode([]string{"", "--ns", cliTestNamespace, "workflow", "unpause", "-w", "wid"})
+	s.Equal(1, errorCode)
+}
+
 func (s *cliAppSuite) TestCancelWorkflow() {
 	s.sdkClient.On("CancelWorkflow", mock.Anything, mock.Anything, mock.Anything).Return(nil).Once()
 	err := s.app.Run([]string{"", "--ns", cliTestNamespace, "workflow", "cancel", "-w", "wid"})
@@ -551,6 +675,132 @@ func (s *cliAppSuite) TestAdminAddSearchAttribute() {
 	s.Nil(err)
 }
 
+func (s *cliAppSuite) TestStartBatchJob() {
+	s.sdkClient.On("CountWorkflow", mock.Anything, mock.Anything).Return(&workflowservice.CountWorkflowExecutionsResponse{Count: 3}, nil).Once()
+	s.serverAdminClient.EXPECT().StartBatchOperation(gomock.Any(), gomock.Any()).DoAndReturn(
+		func(_ interface{}, request *adminservice.StartBatchOperationRequest, _ ...interface{}) (*adminservice.StartBatchOperationResponse, error) {
+			s.Equal(cliTestNamespace, request.GetNamespace())
+			s.Equal("job-id", request.GetJobId())
+			s.Equal("WorkflowType = 'test-type'", request.GetQuery())
+			s.Equal("reset", request.GetBatchType())
+			s.Equal("LastWorkflowTask", request.GetResetParams().GetResetType())
+			s.True(request.GetResetParams().GetSkipCurrentOpen())
+			return &adminservice.StartBatchOperationResponse{}, nil
+		})
+	err := s.app.Run([]string{"", "--ns", cliTestNamespace, "batch", "start", "--job_id", "job-id",
+		"--query", "WorkflowType = 'test-type'", "--reason", "test", "--batch_type", "reset",
+		"--reset_type", "LastWorkflowTask", "--skip_current_open", "--yes"})
+	s.Nil(err)
+	s.sdkClient.AssertExpectations(s.T())
+}
+
+func (s *cliAppSuite) TestTerminateBatchJob() {
+	s.serverAdminClient.EXPECT().StopBatchOperation(gomock.Any(), gomock.Any()).DoAndReturn(
+		func(_ interface{}, request *adminservice.StopBatchOperationRequest, _ ...interface{}) (*adminservice.StopBatchOperationResponse, error) {
+			s.Equal(cliTestNamespace, request.GetNamespace())
+			s.Equal("job-id", request.GetJobId())
+			s.Equal("test", request.GetReason())
+			return &adminservice.StopBatchOperationResponse{}, nil
+		})
+	err := s.app.Run([]string{"", "--ns", cliTestNamespace, "batch", "terminate", "--job_id", "job-id", "--reason", "test"})
+	s.Nil(err)
+}
+
+func (s *cliAppSuite) TestDescribeBatchJob() {
+	s.serverAdminClient.EXPECT().DescribeBatchOperation(gomock.Any(), gomock.Any()).Return(&adminservice.DescribeBatchOperationResponse{
+		JobId:               "job-id",
+		State:               "Running",
+		StartTime:           timestamp.TimePtr(time.Now().UTC()),
+		TotalOperationCount: 10,
+	}, nil)
+	err := s.app.Run([]string{"", "--ns", cliTestNamespace, "batch", "describe", "--job_id", "job-id"})
+	s.Nil(err)
+}
+
+func (s *cliAppSuite) TestDescribeBatchJob_Failed() {
+	s.serverAdminClient.EXPECT().DescribeBatchOperation(gomock.Any(), gomock.Any()).Return(nil, serviceerror.NewNotFound("faked error"))
+	errorCode := s.RunErrorExitCode([]string{"", "--ns", cliTestNamespace, "batch", "describe", "--job_id", "job-id"})
+	s.Equal(1, errorCode)
+}
+
+func (s *cliAppSuite) TestListBatchJobs() {
+	s.serverAdminClient.EXPECT().ListBatchOperations(gomock.Any(), gomock.Any()).DoAndReturn(
+		func(_ interface{}, request *adminservice.ListBatchOperationsRequest, _ ...interface{}) (*adminservice.ListBatchOperationsResponse, error) {
+			s.Equal(cliTestNamespace, request.GetNamespace())
+			s.Equal(int32(10), request.GetPageSize())
+			return &adminservice.ListBatchOperationsResponse{
+				OperationInfo: []*adminservice.BatchOperationInfo{{JobId: "job-id", State: "Completed"}},
+			}, nil
+		})
+	err := s.app.Run([]string{"", "--ns", cliTestNamespace, "batch", "list", "--pagesize", "10"})
+	s.Nil(err)
+}
+
+func (s *cliAppSuite) TestCreateSchedule() {
+	s.serverAdminClient.EXPECT().CreateSchedule(gomock.Any(), gomock.Any()).DoAndReturn(
+		func(_ interface{}, request *adminservice.CreateScheduleRequest, _ ...interface{}) (*adminservice.CreateScheduleResponse, error) {
+			s.Equal(cliTestNamespace, request.GetNamespace())
+			s.Equal("test-schedule", request.GetScheduleId())
+			spec := request.GetSchedule().GetSpec()
+			s.Equal([]string{"0 12 * * *"}, spec.GetCronString())
+			s.Len(spec.GetInterval(), 1)
+			s.Equal(time.Hour, timestamp.DurationValue(spec.GetInterval()[0].GetInterval()))
+			s.Equal(15*time.Minute, timestamp.DurationValue(spec.GetInterval()[0].GetPhase()))
+			s.Len(spec.GetCalendar(), 1)
+			s.Equal("MON-FRI", spec.GetCalendar()[0].GetDayOfWeek())
+			s.Equal("test-wf-id", request.GetSchedule().GetAction().GetWorkflowId())
+			s.Equal("test-wf-type", request.GetSchedule().GetAction().GetWorkflowType().GetName())
+			s.Equal(enumsspb.SCHEDULE_OVERLAP_POLICY_BUFFER_ONE, request.GetSchedule().GetPolicies().GetOverlapPolicy())
+			s.True(request.GetSchedule().GetState().GetPaused())
+			s.NotEmpty(request.GetRequestId())
+			return &adminservice.CreateScheduleResponse{ConflictToken: 1}, nil
+		})
+	err := s.app.Run([]string{"", "--ns", cliTestNamespace, "schedule", "create", "--sid", "test-schedule",
+		"--cron", "0 12 * * *", "--interval", "1h/15m", "--calendar", `{"hour":"9","dayOfWeek":"MON-FRI"}`,
+		"-w", "test-wf-id", "--wt", "test-wf-type", "--tq", "testTaskQueue", "--et", "60",
+		"--overlap_policy", "BufferOne", "--paused"})
+	s.Nil(err)
+}
+
+func (s *cliAppSuite) TestCreateSchedule_InvalidInterval() {
+	// osExit is stubbed, so the command goes on after reporting the invalid interval
+	s.serverAdminClient.EXPECT().CreateSchedule(gomock.Any(), gomock.Any()).Return(&adminservice.CreateScheduleResponse{}, nil).AnyTimes()
+	errorCode := s.RunErrorExitCode([]string{"", "--ns", cliTestNamespace, "schedule", "create", "--sid", "test-schedule",
+		"--interval", "every hour", "-w", "test-wf-id", "--wt", "test-wf-type", "--tq", "testTaskQueue"})
+	s.Equal(1, errorCode)
+}
+
+func (s *cliAppSuite) TestBackfillSchedule() {
+	s.serverAdminClient.EXPECT().TriggerSchedule(gomock.Any(), gomock.Any()).DoAndReturn(
+		func(_ interface{}, request *adminservice.TriggerScheduleRequest, _ ...interface{}) (*adminservice.TriggerScheduleResponse, error) {
+			s.Len(request.GetBackfill(), 1)
+			backfill := request.GetBackfill()[0]
+			s.Equal(time.Date(2020, 1, 1, 0, 0, 0, 0, time.UTC), timestamp.TimeValue(backfill.GetStartTime()))
+			s.Equal(time.Date(2020, 1, 2, 0, 0, 0, 0, time.UTC), timestamp.TimeValue(backfill.GetEndTime()))
+			s.Equal(enumsspb.SCHEDULE_OVERLAP_POLICY_ALLOW_ALL, backfill.GetOverlapPolicy())
+			return &adminservice.TriggerScheduleResponse{}, nil
+		})
+	err := s.app.Run([]string{"", "--ns", cliTestNamespace, "schedule", "backfill", "--sid", "test-schedule",
+		"--start_time", "2020-01-01T00:00:00Z", "--end_time", "2020-01-02T00:00:00Z", "--overlap_policy", "allowall"})
+	s.Nil(err)
+}
+
+func (s *cliAppSuite) TestPauseSchedule_Failed() {
+	s.serverAdminClient.EXPECT().PauseSchedule(gomock.Any(), gomock.Any()).Return(nil, serviceerror.NewNotFound("faked error"))
+	errorCode := s.RunErrorExitCode([]string{"", "--ns", cliTestNamespace, "schedule", "pause", "--sid", "test-schedule", "--reason", "maintenance"})
+	s.Equal(1, errorCode)
+}
+
+func (s *cliAppSuite) TestListSchedules() {
+	s.serverAdminClient.EXPECT().ListSchedules(gomock.Any(), gomock.Any()).Return(&adminservice.ListSchedulesResponse{
+		Schedules:     []*schedulespb.ScheduleListEntry{{ScheduleId: "test-schedule", StartTime: timestamp.TimePtr(time.Now().UTC())}},
+		NextPageToken: []byte{1},
+	}, nil)
+	s.serverAdminClient.EXPECT().ListSchedules(gomock.Any(), gomock.Any()).Return(&adminservice.ListSchedulesResponse{}, nil)
+	err := s.app.Run([]string{"", "--ns", cliTestNamespace, "schedule", "list", "--all"})
+	s.Nil(err)
+}
+
 func (s *cliAppSuite) TestDescribeTaskQueue() {
 	s.sdkClient.On("DescribeTaskQueue", mock.Anything, mock.Anything, mock.Anything).Return(describeTaskQueueResponse, nil).Once()
 	err := s.app.Run([]string{"", "--ns", cliTestNamespace, "taskqueue", "describe", "-tq", "test-taskQueue"})
@@ -68,7 +68,7 @@ func newBatchCommands() []cli.Command {
 		{
 			Name:    "list",
 			Aliases: []string{"l"},
-			Usage:   "Describe a batch operation job",
+			Usage:   "List batch operation jobs",
 			Flags: []cli.Flag{
 				cli.IntFlag{
 					Name:  FlagPageSizeWithAlias,
@@ -97,6 +97,10 @@ func newBatchCommands() []cli.Command {
 					Usage: "Types supported: " + strings.Join(batcher.AllBatchTypes, ","),
 				},
 				//below are optional
+				cli.StringFlag{
+					Name:  FlagJobIDWithAlias,
+					Usage: "Optional Batch Job Id, a random one is generated if not provided",
+				},
 				cli.StringFlag{
 					Name:  FlagSignalNameWithAlias,
 					Usage: "Required for batch signal",
@@ -105,6 +109,26 @@ func newBatchCommands() []cli.Command {
 					Name:  FlagInputWithAlias,
 					Usage: "Optional input of signal",
 				},
+				cli.StringFlag{
+					Name:  FlagResetType,
+					Usage: "Required for batch reset, where to reset. Support one of these: " + strings.Join(batcher.AllResetTypes, ","),
+				},
+				cli.StringFlag{
+					Name:  FlagResetBadBinaryChecksum,
+					Usage: "Binary checksum for batch reset with resetType of BadBinary",
+				},
+				cli.BoolFlag{
+					Name:  FlagSkipCurrentOpen,
+					Usage: "Skip the workflow for batch reset if the current run is open",
+				},
+				cli.BoolFlag{
+					Name:  FlagSkipBaseIsNotCurrent,
+					Usage: "Skip the workflow for batch reset if the base run is not the current run",
+				},
+				cli.BoolFlag{
+					Name:  FlagNonDeterministicOnly,
+					Usage: "Only reset workflows whose last event is workflowTaskFailed with non deterministic error",
+				},
 				cli.IntFlag{
 					Name:  FlagRPS,
 					Value: batcher.DefaultRPS,
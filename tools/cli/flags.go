@@ -229,6 +229,24 @@ const (
 	FlagUpperShardBound                  = "upper_shard_bound"
 	FlagInputDirectory                   = "input_directory"
 	FlagAutoConfirm                      = "auto_confirm"
+	FlagScheduleID                       = "schedule_id"
+	FlagScheduleIDWithAlias              = FlagScheduleID + ", sid"
+	FlagInterval                         = "interval"
+	FlagCalendar                         = "calendar"
+	FlagStartTime                        = "start_time"
+	FlagEndTime                          = "end_time"
+	FlagJitter                           = "jitter"
+	FlagTimeZone                         = "time_zone"
+	FlagOverlapPolicy                    = "overlap_policy"
+	FlagCatchupWindow                    = "catchup_window"
+	FlagPaused                           = "paused"
+	FlagNotes                            = "notes"
+	FlagConflictToken                    = "conflict_token"
+	FlagUpdateID                         = "update_id"
+	FlagWaitForAccepted                  = "wait_for_accepted"
+	FlagFollowRuns                       = "follow_runs"
+	FlagScheduledEventID                 = "scheduled_event_id"
+	FlagReapplyType                      = "reapply_type"
 )
 
 var flagsForExecution = []cli.Flag{
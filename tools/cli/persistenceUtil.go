@@ -61,6 +61,7 @@ func CreatePersistenceFactory(c *cli.Context) persistenceClient.Factory {
 	factory := persistenceClient.NewFactory(
 		&persistence,
 		GetQPS,
+		nil, // CircuitBreakerConfig
 		params.AbstractDatastoreFactory,
 		c.String(FlagTargetCluster),
 		nil, // MetricsClient
@@ -0,0 +1,239 @@
+// The MIT License
+//
+// Copyright (c) 2020 Temporal Technologies Inc.  All rights reserved.
+//
+// Copyright (c) 2020 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package cli
+
+import (
+	"github.com/urfave/cli"
+)
+
+var flagsForScheduleID = []cli.Flag{
+	cli.StringFlag{
+		Name:  FlagScheduleIDWithAlias,
+		Usage: "Schedule Id",
+	},
+}
+
+var flagsForScheduleDefinition = []cli.Flag{
+	cli.StringSliceFlag{
+		Name: FlagCronSchedule,
+		Usage: "Cron string of the times to take actions at, same syntax as the cron schedule of a workflow. " +
+			"Pass each cron string as a separate flag",
+	},
+	cli.StringSliceFlag{
+		Name: FlagInterval,
+		Usage: "Interval of the times to take actions at, e.g. '90m', optionally followed by a phase e.g. '1h/15m'. " +
+			"Pass each interval as a separate flag",
+	},
+	cli.StringSliceFlag{
+		Name: FlagCalendar,
+		Usage: "Calendar spec of the times to take actions at in JSON format, e.g. '{\"hour\":\"9\",\"dayOfWeek\":\"MON-FRI\"}'. " +
+			"Pass each calendar spec as a separate flag",
+	},
+	cli.StringFlag{
+		Name:  FlagStartTime,
+		Usage: "Optional time before which no action is taken, in UTC format '2006-01-02T15:04:05Z' or raw UnixNano",
+	},
+	cli.StringFlag{
+		Name:  FlagEndTime,
+		Usage: "Optional time after which no action is taken, in UTC format '2006-01-02T15:04:05Z' or raw UnixNano",
+	},
+	cli.StringFlag{
+		Name:  FlagJitter,
+		Usage: "Optional random delay of every action, e.g. '30s'",
+	},
+	cli.StringFlag{
+		Name:  FlagTimeZone,
+		Usage: "Optional IANA time zone name the cron strings and calendar specs are matched in, default to UTC",
+	},
+	cli.StringFlag{
+		Name:  FlagWorkflowIDWithAlias,
+		Usage: "Workflow Id prefix of the started workflows, the nominal time of the action is appended to it",
+	},
+	cli.StringFlag{
+		Name:  FlagWorkflowTypeWithAlias,
+		Usage: "Workflow type name of the started workflows",
+	},
+	cli.StringFlag{
+		Name:  FlagTaskQueueWithAlias,
+		Usage: "Task queue of the started workflows",
+	},
+	cli.IntFlag{
+		Name:  FlagExecutionTimeoutWithAlias,
+		Usage: "Execution start to close timeout of the started workflows in seconds",
+	},
+	cli.IntFlag{
+		Name:  FlagWorkflowTaskTimeoutWithAlias,
+		Value: defaultWorkflowTaskTimeoutInSeconds,
+		Usage: "Workflow task start to close timeout of the started workflows in seconds",
+	},
+	cli.StringSliceFlag{
+		Name: FlagInputWithAlias,
+		Usage: "Optional input for the started workflows in JSON format. If there are multiple parameters, pass each as a separate input flag. " +
+			"Pass \"null\" for null values",
+	},
+	cli.StringFlag{
+		Name: FlagInputFileWithAlias,
+		Usage: "Optional input for the started workflows from JSON file. If there are multiple JSON, concatenate them and separate by space or newline. " +
+			"Input from file will be overwrite by input from command line",
+	},
+	cli.StringFlag{
+		Name:  FlagOverlapPolicy,
+		Usage: "What to do when an action is due while a started workflow is still running. Options: Skip, BufferOne, CancelOther, AllowAll",
+	},
+	cli.StringFlag{
+		Name:  FlagCatchupWindow,
+		Usage: "Optional window in which actions missed e.g. during an outage are still taken, e.g. '10m'",
+	},
+	cli.BoolFlag{
+		Name:  FlagPaused,
+		Usage: "Create or update the schedule in paused state",
+	},
+	cli.StringFlag{
+		Name:  FlagNotes,
+		Usage: "Optional notes on the state of the schedule",
+	},
+}
+
+func newScheduleCommands() []cli.Command {
+	return []cli.Command{
+		{
+			Name:  "create",
+			Usage: "Create a schedule",
+			Flags: append(flagsForScheduleID, flagsForScheduleDefinition...),
+			Action: func(c *cli.Context) {
+				CreateSchedule(c)
+			},
+		},
+		{
+			Name:    "describe",
+			Aliases: []string{"desc"},
+			Usage:   "Show the definition and the runtime information of a schedule",
+			Flags:   flagsForScheduleID,
+			Action: func(c *cli.Context) {
+				DescribeSchedule(c)
+			},
+		},
+		{
+			Name:  "update",
+			Usage: "Replace the definition of a schedule",
+			Flags: append(append(flagsForScheduleID, flagsForScheduleDefinition...),
+				cli.Int64Flag{
+					Name:  FlagConflictToken,
+					Usage: "Optional conflict token returned by describe, the update fails if the schedule was changed since",
+				},
+			),
+			Action: func(c *cli.Context) {
+				UpdateSchedule(c)
+			},
+		},
+		{
+			Name:  "pause",
+			Usage: "Pause a schedule",
+			Flags: append(flagsForScheduleID,
+				cli.StringFlag{
+					Name:  FlagReasonWithAlias,
+					Usage: "Reason for pausing the schedule",
+				},
+			),
+			Action: func(c *cli.Context) {
+				PauseSchedule(c)
+			},
+		},
+		{
+			Name:  "unpause",
+			Usage: "Unpause a schedule",
+			Flags: append(flagsForScheduleID,
+				cli.StringFlag{
+					Name:  FlagReasonWithAlias,
+					Usage: "Reason for unpausing the schedule",
+				},
+			),
+			Action: func(c *cli.Context) {
+				UnpauseSchedule(c)
+			},
+		},
+		{
+			Name:  "trigger",
+			Usage: "Take an action of a schedule right now",
+			Flags: append(flagsForScheduleID,
+				cli.StringFlag{
+					Name:  FlagOverlapPolicy,
+					Usage: "Optional overlap policy overriding the one of the schedule. Options: Skip, BufferOne, CancelOther, AllowAll",
+				},
+			),
+			Action: func(c *cli.Context) {
+				TriggerSchedule(c)
+			},
+		},
+		{
+			Name:  "backfill",
+			Usage: "Take all the actions of a schedule in a past time range",
+			Flags: append(flagsForScheduleID,
+				cli.StringFlag{
+					Name:  FlagStartTime,
+					Usage: "Start of the time range, in UTC format '2006-01-02T15:04:05Z', raw UnixNano or time range like '3d'",
+				},
+				cli.StringFlag{
+					Name:  FlagEndTime,
+					Usage: "End of the time range, default to now",
+				},
+				cli.StringFlag{
+					Name:  FlagOverlapPolicy,
+					Usage: "Optional overlap policy overriding the one of the schedule. Options: Skip, BufferOne, CancelOther, AllowAll",
+				},
+			),
+			Action: func(c *cli.Context) {
+				BackfillSchedule(c)
+			},
+		},
+		{
+			Name:  "delete",
+			Usage: "Delete a schedule, workflows started by the schedule are not affected",
+			Flags: flagsForScheduleID,
+			Action: func(c *cli.Context) {
+				DeleteSchedule(c)
+			},
+		},
+		{
+			Name:    "list",
+			Aliases: []string{"l"},
+			Usage:   "List the schedules of a namespace",
+			Flags: []cli.Flag{
+				cli.IntFlag{
+					Name:  FlagPageSizeWithAlias,
+					Value: 30,
+					Usage: "Result page size",
+				},
+				cli.BoolFlag{
+					Name:  FlagAllWithAlias,
+					Usage: "List all pages",
+				},
+			},
+			Action: func(c *cli.Context) {
+				ListSchedules(c)
+			},
+		},
+	}
+}
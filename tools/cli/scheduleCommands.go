@@ -0,0 +1,331 @@
+// The MIT License
+//
+// Copyright (c) 2020 Temporal Technologies Inc.  All rights reserved.
+//
+// Copyright (c) 2020 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package cli
+
+import (
+	"fmt"
+	"os"
+	"strings"
+	"time"
+
+	"github.com/olekukonko/tablewriter"
+	"github.com/pborman/uuid"
+	"github.com/urfave/cli"
+	commonpb "go.temporal.io/api/common/v1"
+
+	"go.temporal.io/server/api/adminservice/v1"
+	enumsspb "go.temporal.io/server/api/enums/v1"
+	schedulespb "go.temporal.io/server/api/schedule/v1"
+	"go.temporal.io/server/common/codec"
+	"go.temporal.io/server/common/primitives/timestamp"
+)
+
+// CreateSchedule creates a schedule
+func CreateSchedule(c *cli.Context) {
+	namespace := getRequiredGlobalOption(c, FlagNamespace)
+	scheduleID := getRequiredOption(c, FlagScheduleID)
+	adminClient := cFactory.AdminClient(c)
+
+	ctx, cancel := newContext(c)
+	defer cancel()
+	resp, err := adminClient.CreateSchedule(ctx, &adminservice.CreateScheduleRequest{
+		Namespace:  namespace,
+		ScheduleId: scheduleID,
+		Schedule:   scheduleFromFlags(c),
+		Identity:   getCliIdentity(),
+		RequestId:  uuid.New(),
+	})
+	if err != nil {
+		ErrorAndExit("Failed to create schedule", err)
+	}
+	fmt.Printf("Schedule %s is created, conflict token: %d\n", scheduleID, resp.GetConflictToken())
+}
+
+// DescribeSchedule shows the definition and the runtime information of a schedule
+func DescribeSchedule(c *cli.Context) {
+	namespace := getRequiredGlobalOption(c, FlagNamespace)
+	scheduleID := getRequiredOption(c, FlagScheduleID)
+	adminClient := cFactory.AdminClient(c)
+
+	ctx, cancel := newContext(c)
+	defer cancel()
+	resp, err := adminClient.DescribeSchedule(ctx, &adminservice.DescribeScheduleRequest{
+		Namespace:  namespace,
+		ScheduleId: scheduleID,
+	})
+	if err != nil {
+		ErrorAndExit("Failed to describe schedule", err)
+	}
+	prettyPrintJSONObject(resp)
+}
+
+// UpdateSchedule replaces the definition of a schedule
+func UpdateSchedule(c *cli.Context) {
+	namespace := getRequiredGlobalOption(c, FlagNamespace)
+	scheduleID := getRequiredOption(c, FlagScheduleID)
+	adminClient := cFactory.AdminClient(c)
+
+	ctx, cancel := newContext(c)
+	defer cancel()
+	_, err := adminClient.UpdateSchedule(ctx, &adminservice.UpdateScheduleRequest{
+		Namespace:     namespace,
+		ScheduleId:    scheduleID,
+		Schedule:      scheduleFromFlags(c),
+		ConflictToken: c.Int64(FlagConflictToken),
+		Identity:      getCliIdentity(),
+		RequestId:     uuid.New(),
+	})
+	if err != nil {
+		ErrorAndExit("Failed to update schedule", err)
+	}
+	fmt.Printf("Schedule %s is updated\n", scheduleID)
+}
+
+// PauseSchedule pauses a schedule
+func PauseSchedule(c *cli.Context) {
+	namespace := getRequiredGlobalOption(c, FlagNamespace)
+	scheduleID := getRequiredOption(c, FlagScheduleID)
+	adminClient := cFactory.AdminClient(c)
+
+	ctx, cancel := newContext(c)
+	defer cancel()
+	_, err := adminClient.PauseSchedule(ctx, &adminservice.PauseScheduleRequest{
+		Namespace:  namespace,
+		ScheduleId: scheduleID,
+		Notes:      c.String(FlagReason),
+		Identity:   getCliIdentity(),
+	})
+	if err != nil {
+		ErrorAndExit("Failed to pause schedule", err)
+	}
+	fmt.Printf("Schedule %s is paused\n", scheduleID)
+}
+
+// UnpauseSchedule unpauses a schedule
+func UnpauseSchedule(c *cli.Context) {
+	namespace := getRequiredGlobalOption(c, FlagNamespace)
+	scheduleID := getRequiredOption(c, FlagScheduleID)
+	adminClient := cFactory.AdminClient(c)
+
+	ctx, cancel := newContext(c)
+	defer cancel()
+	_, err := adminClient.UnpauseSchedule(ctx, &adminservice.UnpauseScheduleRequest{
+		Namespace:  namespace,
+		ScheduleId: scheduleID,
+		Notes:      c.String(FlagReason),
+		Identity:   getCliIdentity(),
+	})
+	if err != nil {
+		ErrorAndExit("Failed to unpause schedule", err)
+	}
+	fmt.Printf("Schedule %s is unpaused\n", scheduleID)
+}
+
+// TriggerSchedule takes an action of a schedule right now
+func TriggerSchedule(c *cli.Context) {
+	namespace := getRequiredGlobalOption(c, FlagNamespace)
+	scheduleID := getRequiredOption(c, FlagScheduleID)
+	adminClient := cFactory.AdminClient(c)
+
+	ctx, cancel := newContext(c)
+	defer cancel()
+	_, err := adminClient.TriggerSchedule(ctx, &adminservice.TriggerScheduleRequest{
+		Namespace:     namespace,
+		ScheduleId:    scheduleID,
+		OverlapPolicy: overlapPolicyFromFlag(c),
+		Identity:      getCliIdentity(),
+	})
+	if err != nil {
+		ErrorAndExit("Failed to trigger schedule", err)
+	}
+	fmt.Printf("Schedule %s is triggered\n", scheduleID)
+}
+
+// BackfillSchedule takes all the actions of a schedule in a past time range
+func BackfillSchedule(c *cli.Context) {
+	namespace := getRequiredGlobalOption(c, FlagNamespace)
+	scheduleID := getRequiredOption(c, FlagScheduleID)
+	now := time.Now().UTC()
+	startTime := parseTime(getRequiredOption(c, FlagStartTime), time.Time{}, now)
+	endTime := parseTime(c.String(FlagEndTime), now, now)
+	adminClient := cFactory.AdminClient(c)
+
+	ctx, cancel := newContext(c)
+	defer cancel()
+	_, err := adminClient.TriggerSchedule(ctx, &adminservice.TriggerScheduleRequest{
+		Namespace:  namespace,
+		ScheduleId: scheduleID,
+		Backfill: []*schedulespb.BackfillRequest{{
+			StartTime:     timestamp.TimePtr(startTime),
+			EndTime:       timestamp.TimePtr(endTime),
+			OverlapPolicy: overlapPolicyFromFlag(c),
+		}},
+		Identity: getCliIdentity(),
+	})
+	if err != nil {
+		ErrorAndExit("Failed to backfill schedule", err)
+	}
+	fmt.Printf("Schedule %s is backfilled from %s to %s\n", scheduleID, formatTime(startTime, false), formatTime(endTime, false))
+}
+
+// DeleteSchedule deletes a schedule
+func DeleteSchedule(c *cli.Context) {
+	namespace := getRequiredGlobalOption(c, FlagNamespace)
+	scheduleID := getRequiredOption(c, FlagScheduleID)
+	adminClient := cFactory.AdminClient(c)
+
+	ctx, cancel := newContext(c)
+	defer cancel()
+	_, err := adminClient.DeleteSchedule(ctx, &adminservice.DeleteScheduleRequest{
+		Namespace:  namespace,
+		ScheduleId: scheduleID,
+		Identity:   getCliIdentity(),
+	})
+	if err != nil {
+		ErrorAndExit("Failed to delete schedule", err)
+	}
+	fmt.Printf("Schedule %s is deleted\n", scheduleID)
+}
+
+// ListSchedules lists the schedules of a namespace
+func ListSchedules(c *cli.Context) {
+	namespace := getRequiredGlobalOption(c, FlagNamespace)
+	adminClient := cFactory.AdminClient(c)
+
+	table := tablewriter.NewWriter(os.Stdout)
+	table.SetBorder(false)
+	table.SetColumnSeparator("|")
+	table.SetHeader([]string{"Schedule Id", "Start Time"})
+	table.SetHeaderLine(false)
+	table.SetHeaderColor(tableHeaderBlue, tableHeaderBlue)
+
+	var nextPageToken []byte
+	for {
+		ctx, cancel := newContext(c)
+		resp, err := adminClient.ListSchedules(ctx, &adminservice.ListSchedulesRequest{
+			Namespace:       namespace,
+			MaximumPageSize: int32(c.Int(FlagPageSize)),
+			NextPageToken:   nextPageToken,
+		})
+		cancel()
+		if err != nil {
+			ErrorAndExit("Failed to list schedules", err)
+		}
+		for _, entry := range resp.GetSchedules() {
+			table.Append([]string{entry.GetScheduleId(), formatTime(timestamp.TimeValue(entry.GetStartTime()), false)})
+		}
+		nextPageToken = resp.GetNextPageToken()
+		if !c.Bool(FlagAll) || len(nextPageToken) == 0 {
+			break
+		}
+	}
+	table.Render()
+}
+
+func scheduleFromFlags(c *cli.Context) *schedulespb.Schedule {
+	spec := &schedulespb.ScheduleSpec{
+		CronString:   c.StringSlice(FlagCronSchedule),
+		TimezoneName: c.String(FlagTimeZone),
+		Jitter:       durationFromFlag(c, FlagJitter),
+	}
+	now := time.Now().UTC()
+	if c.IsSet(FlagStartTime) {
+		spec.StartTime = timestamp.TimePtr(parseTime(c.String(FlagStartTime), time.Time{}, now))
+	}
+	if c.IsSet(FlagEndTime) {
+		spec.EndTime = timestamp.TimePtr(parseTime(c.String(FlagEndTime), time.Time{}, now))
+	}
+	for _, interval := range c.StringSlice(FlagInterval) {
+		spec.Interval = append(spec.Interval, parseIntervalSpec(interval))
+	}
+	encoder := codec.NewJSONPBEncoder()
+	for _, calendar := range c.StringSlice(FlagCalendar) {
+		calendarSpec := &schedulespb.CalendarSpec{}
+		if err := encoder.Decode([]byte(calendar), calendarSpec); err != nil {
+			ErrorAndExit(fmt.Sprintf("Option %s format is invalid.", FlagCalendar), err)
+		}
+		spec.Calendar = append(spec.Calendar, calendarSpec)
+	}
+
+	var input *commonpb.Payloads
+	if c.IsSet(FlagInput) || c.IsSet(FlagInputFile) {
+		input = processJSONInput(c)
+	}
+	return &schedulespb.Schedule{
+		Spec: spec,
+		Action: &schedulespb.StartWorkflowAction{
+			WorkflowId:               getRequiredOption(c, FlagWorkflowID),
+			WorkflowType:             &commonpb.WorkflowType{Name: getRequiredOption(c, FlagWorkflowType)},
+			TaskQueue:                getRequiredOption(c, FlagTaskQueue),
+			Input:                    input,
+			WorkflowExecutionTimeout: timestamp.DurationFromSeconds(int64(c.Int(FlagExecutionTimeout))),
+			WorkflowTaskTimeout:      timestamp.DurationFromSeconds(int64(c.Int(FlagWorkflowTaskTimeout))),
+		},
+		Policies: &schedulespb.SchedulePolicies{
+			OverlapPolicy: overlapPolicyFromFlag(c),
+			CatchupWindow: durationFromFlag(c, FlagCatchupWindow),
+		},
+		State: &schedulespb.ScheduleState{
+			Paused: c.Bool(FlagPaused),
+			Notes:  c.String(FlagNotes),
+		},
+	}
+}
+
+func parseIntervalSpec(interval string) *schedulespb.IntervalSpec {
+	parts := strings.SplitN(interval, "/", 2)
+	every, err := time.ParseDuration(parts[0])
+	if err != nil {
+		ErrorAndExit(fmt.Sprintf("Option %s format is invalid.", FlagInterval), err)
+	}
+	result := &schedulespb.IntervalSpec{Interval: timestamp.DurationPtr(every)}
+	if len(parts) == 2 {
+		phase, err := time.ParseDuration(parts[1])
+		if err != nil {
+			ErrorAndExit(fmt.Sprintf("Option %s format is invalid.", FlagInterval), err)
+		}
+		result.Phase = timestamp.DurationPtr(phase)
+	}
+	return result
+}
+
+func durationFromFlag(c *cli.Context, flag string) *time.Duration {
+	if !c.IsSet(flag) {
+		return nil
+	}
+	d, err := time.ParseDuration(c.String(flag))
+	if err != nil {
+		ErrorAndExit(fmt.Sprintf("Option %s format is invalid.", flag), err)
+	}
+	return timestamp.DurationPtr(d)
+}
+
+func overlapPolicyFromFlag(c *cli.Context) enumsspb.ScheduleOverlapPolicy {
+	policy, err := stringToEnum(c.String(FlagOverlapPolicy), enumsspb.ScheduleOverlapPolicy_value)
+	if err != nil {
+		ErrorAndExit(fmt.Sprintf("Option %s format is invalid.", FlagOverlapPolicy), err)
+	}
+	return enumsspb.ScheduleOverlapPolicy(policy)
+}
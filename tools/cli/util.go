@@ -353,6 +353,12 @@ func ColorEvent(e *historypb.HistoryEvent) string {
 	case enumspb.EVENT_TYPE_UPSERT_WORKFLOW_SEARCH_ATTRIBUTES:
 		data = e.EventType.String()
 
+	case enumspb.EVENT_TYPE_WORKFLOW_EXECUTION_UPDATE_ACCEPTED:
+		data = e.EventType.String()
+
+	case enumspb.EVENT_TYPE_WORKFLOW_EXECUTION_UPDATE_COMPLETED:
+		data = e.EventType.String()
+
 	default:
 		data = e.EventType.String()
 	}
@@ -482,6 +488,12 @@ func getEventAttributes(e *historypb.HistoryEvent) interface{} {
 	case enumspb.EVENT_TYPE_UPSERT_WORKFLOW_SEARCH_ATTRIBUTES:
 		data = e.GetUpsertWorkflowSearchAttributesEventAttributes()
 
+	case enumspb.EVENT_TYPE_WORKFLOW_EXECUTION_UPDATE_ACCEPTED:
+		data = e.GetWorkflowExecutionUpdateAcceptedEventAttributes()
+
+	case enumspb.EVENT_TYPE_WORKFLOW_EXECUTION_UPDATE_COMPLETED:
+		data = e.GetWorkflowExecutionUpdateCompletedEventAttributes()
+
 	default:
 		data = e
 	}
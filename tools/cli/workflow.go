@@ -104,6 +104,43 @@ func newWorkflowCommands() []cli.Command {
 				SignalWorkflow(c)
 			},
 		},
+		{
+			Name:  "update",
+			Usage: "send an update to a workflow execution and wait for its outcome",
+			Flags: []cli.Flag{
+				cli.StringFlag{
+					Name:  FlagWorkflowIDWithAlias,
+					Usage: "WorkflowId",
+				},
+				cli.StringFlag{
+					Name:  FlagRunIDWithAlias,
+					Usage: "RunId",
+				},
+				cli.StringFlag{
+					Name:  FlagNameWithAlias,
+					Usage: "UpdateName",
+				},
+				cli.StringFlag{
+					Name:  FlagInputWithAlias,
+					Usage: "Input for the update, in JSON format.",
+				},
+				cli.StringFlag{
+					Name:  FlagInputFileWithAlias,
+					Usage: "Input for the update from JSON file.",
+				},
+				cli.StringFlag{
+					Name:  FlagUpdateID,
+					Usage: "Optional update id, an update sent again with the same id returns the outcome of the first one",
+				},
+				cli.BoolFlag{
+					Name:  FlagWaitForAccepted,
+					Usage: "Return once the workflow accepted the update instead of waiting for it to complete",
+				},
+			},
+			Action: func(c *cli.Context) {
+				UpdateWorkflow(c)
+			},
+		},
 		{
 			Name:    "terminate",
 			Aliases: []string{"term"},
@@ -126,6 +163,62 @@ func newWorkflowCommands() []cli.Command {
 				TerminateWorkflow(c)
 			},
 		},
+		{
+			Name:  "pause",
+			Usage: "stop dispatching the workflow and activity tasks of a workflow execution until it is unpaused",
+			Flags: []cli.Flag{
+				cli.StringFlag{
+					Name:  FlagWorkflowIDWithAlias,
+					Usage: "WorkflowId",
+				},
+				cli.StringFlag{
+					Name:  FlagRunIDWithAlias,
+					Usage: "RunId",
+				},
+				cli.StringFlag{
+					Name:  FlagReasonWithAlias,
+					Usage: "The reason you want to pause the workflow",
+				},
+			},
+			Action: func(c *cli.Context) {
+				PauseWorkflow(c)
+			},
+		},
+		{
+			Name:  "unpause",
+			Usage: "resume dispatching the tasks of a paused workflow execution",
+			Flags: []cli.Flag{
+				cli.StringFlag{
+					Name:  FlagWorkflowIDWithAlias,
+					Usage: "WorkflowId",
+				},
+				cli.StringFlag{
+					Name:  FlagRunIDWithAlias,
+					Usage: "RunId",
+				},
+			},
+			Action: func(c *cli.Context) {
+				UnpauseWorkflow(c)
+			},
+		},
+		{
+			Name:    "delete",
+			Aliases: []string{"del"},
+			Usage:   "delete a workflow execution, a running execution is terminated first",
+			Flags: []cli.Flag{
+				cli.StringFlag{
+					Name:  FlagWorkflowIDWithAlias,
+					Usage: "WorkflowId",
+				},
+				cli.StringFlag{
+					Name:  FlagRunIDWithAlias,
+					Usage: "RunId",
+				},
+			},
+			Action: func(c *cli.Context) {
+				DeleteWorkflow(c)
+			},
+		},
 		{
 			Name:        "list",
 			Aliases:     []string{"l"},
@@ -225,6 +318,27 @@ func newWorkflowCommands() []cli.Command {
 				ObserveHistoryWithID(c)
 			},
 		},
+		{
+			Name:  "result",
+			Usage: "wait for a workflow execution to close and show its outcome",
+			Flags: []cli.Flag{
+				cli.StringFlag{
+					Name:  FlagWorkflowIDWithAlias,
+					Usage: "WorkflowId",
+				},
+				cli.StringFlag{
+					Name:  FlagRunIDWithAlias,
+					Usage: "RunId",
+				},
+				cli.BoolFlag{
+					Name:  FlagFollowRuns,
+					Usage: "Wait for the outcome of the last run started by continue-as-new, retry or cron",
+				},
+			},
+			Action: func(c *cli.Context) {
+				GetWorkflowResult(c)
+			},
+		},
 		{
 			Name:    "reset",
 			Aliases: []string{"rs"},
@@ -254,6 +368,18 @@ func newWorkflowCommands() []cli.Command {
 					Name:  FlagResetBadBinaryChecksum,
 					Usage: "Binary checksum for resetType of BadBinary",
 				},
+				cli.Int64Flag{
+					Name:  FlagScheduledEventID,
+					Usage: "The eventId of a WorkflowTaskScheduled event to reset to, the run may be any run continued as new by the current run. Exclusive with event_id and reset_type",
+				},
+				cli.StringFlag{
+					Name:  FlagReapplyType,
+					Usage: "Events received after the reset point which are reapplied. Support one of these: all, exclude_signals, none",
+				},
+				cli.BoolFlag{
+					Name:  FlagDryRun,
+					Usage: "Show what the reset would do without resetting the workflow",
+				},
 			},
 			Action: func(c *cli.Context) {
 				ResetWorkflow(c)
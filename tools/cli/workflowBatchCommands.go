@@ -30,14 +30,12 @@ import (
 	"os"
 	"strings"
 
+	"github.com/pborman/uuid"
 	"github.com/urfave/cli"
-	enumspb "go.temporal.io/api/enums/v1"
 	"go.temporal.io/api/workflowservice/v1"
-	sdkclient "go.temporal.io/sdk/client"
 
+	"go.temporal.io/server/api/adminservice/v1"
 	"go.temporal.io/server/common"
-	"go.temporal.io/server/common/definition"
-	"go.temporal.io/server/common/payload"
 	"go.temporal.io/server/common/payloads"
 	"go.temporal.io/server/common/primitives/timestamp"
 	"go.temporal.io/server/service/worker/batcher"
@@ -45,12 +43,18 @@ import (
 
 // TerminateBatchJob stops abatch job
 func TerminateBatchJob(c *cli.Context) {
+	namespace := getRequiredGlobalOption(c, FlagNamespace)
 	jobID := getRequiredOption(c, FlagJobID)
 	reason := getRequiredOption(c, FlagReason)
-	client := cFactory.SDKClient(c, common.SystemLocalNamespace)
+	adminClient := cFactory.AdminClient(c)
 	tcCtx, cancel := newContext(c)
 	defer cancel()
-	err := client.TerminateWorkflow(tcCtx, jobID, "", reason, nil)
+	_, err := adminClient.StopBatchOperation(tcCtx, &adminservice.StopBatchOperationRequest{
+		Namespace: namespace,
+		JobId:     jobID,
+		Reason:    reason,
+		Identity:  getCliIdentity(),
+	})
 	if err != nil {
 		ErrorAndExit("Failed to terminate batch job", err)
 	}
@@ -62,34 +66,34 @@ func TerminateBatchJob(c *cli.Context) {
 
 // DescribeBatchJob describe the status of the batch job
 func DescribeBatchJob(c *cli.Context) {
+	namespace := getRequiredGlobalOption(c, FlagNamespace)
 	jobID := getRequiredOption(c, FlagJobID)
 
-	client := cFactory.SDKClient(c, common.SystemLocalNamespace)
+	adminClient := cFactory.AdminClient(c)
 	tcCtx, cancel := newContext(c)
 	defer cancel()
-	wf, err := client.DescribeWorkflowExecution(tcCtx, jobID, "")
+	resp, err := adminClient.DescribeBatchOperation(tcCtx, &adminservice.DescribeBatchOperationRequest{
+		Namespace: namespace,
+		JobId:     jobID,
+	})
 	if err != nil {
 		ErrorAndExit("Failed to describe batch job", err)
 	}
 
-	output := map[string]interface{}{}
-	if wf.WorkflowExecutionInfo.GetStatus() != enumspb.WORKFLOW_EXECUTION_STATUS_RUNNING {
-		if wf.WorkflowExecutionInfo.GetStatus() != enumspb.WORKFLOW_EXECUTION_STATUS_COMPLETED {
-			output["msg"] = "batch job stopped status: " + wf.WorkflowExecutionInfo.GetStatus().String()
-		} else {
-			output["msg"] = "batch job is finished successfully"
-		}
-	} else {
-		output["msg"] = "batch job is running"
-		if len(wf.PendingActivities) > 0 {
-			hbdPayload := wf.PendingActivities[0].HeartbeatDetails
-			var hbd batcher.HeartBeatDetails
-			err := payloads.Decode(hbdPayload, &hbd)
-			if err != nil {
-				ErrorAndExit("Failed to describe batch job", err)
-			}
-			output["progress"] = hbd
-		}
+	output := map[string]interface{}{
+		"jobId":     resp.GetJobId(),
+		"state":     resp.GetState(),
+		"startTime": formatTime(timestamp.TimeValue(resp.GetStartTime()), false),
+		"reason":    resp.GetReason(),
+		"operator":  resp.GetIdentity(),
+		"progress": map[string]int64{
+			"total":    resp.GetTotalOperationCount(),
+			"complete": resp.GetCompleteOperationCount(),
+			"failure":  resp.GetFailureOperationCount(),
+		},
+	}
+	if resp.GetCloseTime() != nil {
+		output["closeTime"] = formatTime(timestamp.TimeValue(resp.GetCloseTime()), false)
 	}
 	prettyPrintJSONObject(output)
 }
@@ -98,45 +102,27 @@ func DescribeBatchJob(c *cli.Context) {
 func ListBatchJobs(c *cli.Context) {
 	namespace := getRequiredGlobalOption(c, FlagNamespace)
 	pageSize := c.Int(FlagPageSize)
-	client := cFactory.SDKClient(c, common.SystemLocalNamespace)
+	adminClient := cFactory.AdminClient(c)
 	tcCtx, cancel := newContext(c)
 	defer cancel()
-	resp, err := client.ListWorkflow(tcCtx, &workflowservice.ListWorkflowExecutionsRequest{
-		Namespace: common.SystemLocalNamespace,
+	resp, err := adminClient.ListBatchOperations(tcCtx, &adminservice.ListBatchOperationsRequest{
+		Namespace: namespace,
 		PageSize:  int32(pageSize),
-		Query:     fmt.Sprintf("CustomNamespace = '%v'", namespace),
 	})
 	if err != nil {
 		ErrorAndExit("Failed to list batch jobs", err)
 	}
 
-	output := make([]interface{}, 0, len(resp.Executions))
-	for _, wf := range resp.Executions {
-		var reason, operator string
-		err = payload.Decode(wf.Memo.Fields["Reason"], &reason)
-		if err != nil {
-			ErrorAndExit("Failed to deserialize reason memo field", err)
-		}
-
-		err = payload.Decode(wf.SearchAttributes.IndexedFields["Operator"], &operator)
-		if err != nil {
-			ErrorAndExit("Failed to deserialize operator search attribute", err)
-		}
-
+	output := make([]interface{}, 0, len(resp.GetOperationInfo()))
+	for _, info := range resp.GetOperationInfo() {
 		job := map[string]string{
-			"jobId":     wf.Execution.GetWorkflowId(),
-			"startTime": formatTime(timestamp.TimeValue(wf.GetStartTime()), false),
-			"reason":    reason,
-			"operator":  operator,
+			"jobId":     info.GetJobId(),
+			"status":    info.GetState(),
+			"startTime": formatTime(timestamp.TimeValue(info.GetStartTime()), false),
 		}
-
-		if wf.GetStatus() != enumspb.WORKFLOW_EXECUTION_STATUS_RUNNING {
-			job["status"] = wf.GetStatus().String()
-			job["closeTime"] = formatTime(timestamp.TimeValue(wf.GetCloseTime()), false)
-		} else {
-			job["status"] = "RUNNING"
+		if info.GetCloseTime() != nil {
+			job["closeTime"] = formatTime(timestamp.TimeValue(info.GetCloseTime()), false)
 		}
-
 		output = append(output, job)
 	}
 	prettyPrintJSONObject(output)
@@ -157,6 +143,19 @@ func StartBatchJob(c *cli.Context) {
 		sigName = getRequiredOption(c, FlagSignalName)
 		sigVal = getRequiredOption(c, FlagInput)
 	}
+	var resetParams *adminservice.BatchResetParams
+	if batchType == batcher.BatchTypeReset {
+		resetParams = &adminservice.BatchResetParams{
+			ResetType:            getRequiredOption(c, FlagResetType),
+			BadBinaryChecksum:    c.String(FlagResetBadBinaryChecksum),
+			SkipCurrentOpen:      c.Bool(FlagSkipCurrentOpen),
+			SkipBaseNotCurrent:   c.Bool(FlagSkipBaseIsNotCurrent),
+			NonDeterministicOnly: c.Bool(FlagNonDeterministicOnly),
+		}
+		if err := batcher.ValidateResetType(resetParams.GetResetType(), resetParams.GetBadBinaryChecksum()); err != nil {
+			ErrorAndExit("Invalid reset options", err)
+		}
+	}
 	rps := c.Int(FlagRPS)
 
 	client := cFactory.SDKClient(c, common.SystemLocalNamespace)
@@ -187,42 +186,36 @@ func StartBatchJob(c *cli.Context) {
 		}
 
 	}
-	tcCtx, cancel = newContext(c)
-	defer cancel()
-	options := sdkclient.StartWorkflowOptions{
-		TaskQueue: batcher.BatcherTaskQueueName,
-		Memo: map[string]interface{}{
-			"Reason": reason,
-		},
-		SearchAttributes: map[string]interface{}{
-			definition.CustomNamespace: namespace,
-			definition.Operator:        operator,
-		},
-	}
-
 	sigInput, err := payloads.Encode(sigVal)
 	if err != nil {
 		ErrorAndExit("Failed to serialize signal value", err)
 	}
 
-	params := batcher.BatchParams{
-		Namespace: namespace,
-		Query:     query,
-		Reason:    reason,
-		BatchType: batchType,
-		SignalParams: batcher.SignalParams{
-			SignalName: sigName,
-			Input:      sigInput,
-		},
-		RPS: rps,
+	jobID := c.String(FlagJobID)
+	if jobID == "" {
+		jobID = uuid.New()
 	}
-	wf, err := client.ExecuteWorkflow(tcCtx, options, batcher.BatchWFTypeName, params)
+	adminClient := cFactory.AdminClient(c)
+	tcCtx, cancel = newContext(c)
+	defer cancel()
+	_, err = adminClient.StartBatchOperation(tcCtx, &adminservice.StartBatchOperationRequest{
+		Namespace:   namespace,
+		JobId:       jobID,
+		Query:       query,
+		Reason:      reason,
+		BatchType:   batchType,
+		Identity:    operator,
+		SignalName:  sigName,
+		SignalInput: sigInput,
+		ResetParams: resetParams,
+		Rps:         int32(rps),
+	})
 	if err != nil {
 		ErrorAndExit("Failed to start batch job", err)
 	}
 	output := map[string]interface{}{
 		"msg":   "batch job is started",
-		"jobId": wf.GetID(),
+		"jobId": jobID,
 	}
 	prettyPrintJSONObject(output)
 }
@@ -50,7 +50,6 @@ import (
 	failurepb "go.temporal.io/api/failure/v1"
 	filterpb "go.temporal.io/api/filter/v1"
 	historypb "go.temporal.io/api/history/v1"
-	namespacepb "go.temporal.io/api/namespace/v1"
 	querypb "go.temporal.io/api/query/v1"
 	"go.temporal.io/api/serviceerror"
 	taskqueuepb "go.temporal.io/api/taskqueue/v1"
@@ -58,14 +57,14 @@ import (
 	"go.temporal.io/api/workflowservice/v1"
 	"go.temporal.io/sdk/client"
 
+	"go.temporal.io/server/api/adminservice/v1"
 	clispb "go.temporal.io/server/api/cli/v1"
 	"go.temporal.io/server/common"
-	"go.temporal.io/server/common/clock"
 	"go.temporal.io/server/common/codec"
 	"go.temporal.io/server/common/payload"
 	"go.temporal.io/server/common/payloads"
 	"go.temporal.io/server/common/primitives/timestamp"
-	"go.temporal.io/server/service/history"
+	"go.temporal.io/server/service/worker/batcher"
 )
 
 // ShowHistory shows the history of given workflow execution based on workflowID and runID.
@@ -457,6 +456,80 @@ func TerminateWorkflow(c *cli.Context) {
 	}
 }
 
+// DeleteWorkflow deletes a workflow execution
+func DeleteWorkflow(c *cli.Context) {
+	namespace := getRequiredGlobalOption(c, FlagNamespace)
+	wid := getRequiredOption(c, FlagWorkflowID)
+	rid := c.String(FlagRunID)
+	adminClient := cFactory.AdminClient(c)
+
+	ctx, cancel := newContext(c)
+	defer cancel()
+	_, err := adminClient.DeleteWorkflowExecution(ctx, &adminservice.DeleteWorkflowExecutionRequest{
+		Namespace: namespace,
+		Execution: &commonpb.WorkflowExecution{
+			WorkflowId: wid,
+			RunId:      rid,
+		},
+		Identity: getCliIdentity(),
+	})
+	if err != nil {
+		ErrorAndExit("Delete workflow failed.", err)
+	} else {
+		fmt.Println("Delete workflow succeeded.")
+	}
+}
+
+// PauseWorkflow pauses a workflow execution
+func PauseWorkflow(c *cli.Context) {
+	namespace := getRequiredGlobalOption(c, FlagNamespace)
+	wid := getRequiredOption(c, FlagWorkflowID)
+	rid := c.String(FlagRunID)
+	reason := c.String(FlagReason)
+	adminClient := cFactory.AdminClient(c)
+
+	ctx, cancel := newContext(c)
+	defer cancel()
+	_, err := adminClient.PauseWorkflowExecution(ctx, &adminservice.PauseWorkflowExecutionRequest{
+		Namespace: namespace,
+		Execution: &commonpb.WorkflowExecution{
+			WorkflowId: wid,
+			RunId:      rid,
+		},
+		Identity: getCliIdentity(),
+		Reason:   reason,
+	})
+	if err != nil {
+		ErrorAndExit("Pause workflow failed.", err)
+	} else {
+		fmt.Println("Pause workflow succeeded.")
+	}
+}
+
+// UnpauseWorkflow unpauses a paused workflow execution
+func UnpauseWorkflow(c *cli.Context) {
+	namespace := getRequiredGlobalOption(c, FlagNamespace)
+	wid := getRequiredOption(c, FlagWorkflowID)
+	rid := c.String(FlagRunID)
+	adminClient := cFactory.AdminClient(c)
+
+	ctx, cancel := newContext(c)
+	defer cancel()
+	_, err := adminClient.UnpauseWorkflowExecution(ctx, &adminservice.UnpauseWorkflowExecutionRequest{
+		Namespace: namespace,
+		Execution: &commonpb.WorkflowExecution{
+			WorkflowId: wid,
+			RunId:      rid,
+		},
+		Identity: getCliIdentity(),
+	})
+	if err != nil {
+		ErrorAndExit("Unpause workflow failed.", err)
+	} else {
+		fmt.Println("Unpause workflow succeeded.")
+	}
+}
+
 // CancelWorkflow cancels a workflow execution
 func CancelWorkflow(c *cli.Context) {
 	wfClient := getWorkflowClient(c)
@@ -505,6 +578,90 @@ func SignalWorkflow(c *cli.Context) {
 	}
 }
 
+// UpdateWorkflow sends an update to a workflow execution and waits for its outcome
+func UpdateWorkflow(c *cli.Context) {
+	namespace := getRequiredGlobalOption(c, FlagNamespace)
+	wid := getRequiredOption(c, FlagWorkflowID)
+	rid := c.String(FlagRunID)
+	name := getRequiredOption(c, FlagName)
+	input := processJSONInput(c)
+	adminClient := cFactory.AdminClient(c)
+
+	ctx, cancel := newContext(c)
+	defer cancel()
+	resp, err := adminClient.UpdateWorkflowExecution(ctx, &adminservice.UpdateWorkflowExecutionRequest{
+		Namespace: namespace,
+		Execution: &commonpb.WorkflowExecution{
+			WorkflowId: wid,
+			RunId:      rid,
+		},
+		UpdateId:        c.String(FlagUpdateID),
+		Name:            name,
+		Input:           input,
+		Identity:        getCliIdentity(),
+		WaitForAccepted: c.Bool(FlagWaitForAccepted),
+	})
+	if err != nil {
+		ErrorAndExit("Update workflow failed.", err)
+		return
+	}
+
+	fmt.Printf("Update id: %s\n", resp.GetUpdateId())
+	switch {
+	case resp.GetFailure() != nil:
+		fmt.Printf("  Status: %s\n", colorRed("FAILED"))
+		fmt.Printf("  Failure: %s\n", convertFailure(resp.GetFailure()).String())
+	case resp.GetCompleted():
+		fmt.Printf("  Status: %s\n", colorGreen("COMPLETED"))
+		fmt.Printf("  Output: %s\n", payloads.ToString(resp.GetResult()))
+	default:
+		fmt.Printf("  Status: %s\n", colorGreen("ACCEPTED"))
+	}
+}
+
+// GetWorkflowResult waits for a workflow execution to close and shows its outcome
+func GetWorkflowResult(c *cli.Context) {
+	namespace := getRequiredGlobalOption(c, FlagNamespace)
+	wid := getRequiredOption(c, FlagWorkflowID)
+	rid := c.String(FlagRunID)
+	adminClient := cFactory.AdminClient(c)
+
+	request := &adminservice.GetWorkflowExecutionResultRequest{
+		Namespace: namespace,
+		Execution: &commonpb.WorkflowExecution{
+			WorkflowId: wid,
+			RunId:      rid,
+		},
+		FollowRuns: c.Bool(FlagFollowRuns),
+	}
+	for {
+		ctx, cancel := newContextForLongPoll(c)
+		resp, err := adminClient.GetWorkflowExecutionResult(ctx, request)
+		cancel()
+		if err != nil {
+			ErrorAndExit("Get workflow result failed.", err)
+			return
+		}
+		// the poll expired before the workflow closed
+		if resp.GetStatus() == enumspb.WORKFLOW_EXECUTION_STATUS_RUNNING {
+			continue
+		}
+
+		fmt.Printf("Run id: %s\n", resp.GetExecution().GetRunId())
+		fmt.Printf("  Status: %s\n", resp.GetStatus())
+		if resp.GetResult() != nil {
+			fmt.Printf("  Output: %s\n", payloads.ToString(resp.GetResult()))
+		}
+		if resp.GetFailure() != nil {
+			fmt.Printf("  Failure: %s\n", convertFailure(resp.GetFailure()).String())
+		}
+		if resp.GetNewExecutionRunId() != "" {
+			fmt.Printf("  Continued as: %s\n", resp.GetNewExecutionRunId())
+		}
+		return
+	}
+}
+
 // QueryWorkflow query workflow execution
 func QueryWorkflow(c *cli.Context) {
 	getRequiredGlobalOption(c, FlagNamespace) // for pre-check and alert if not provided
@@ -1375,10 +1532,11 @@ func ResetWorkflow(c *cli.Context) {
 	}
 	rid := c.String(FlagRunID)
 	eventID := c.Int64(FlagEventID)
+	scheduledEventID := c.Int64(FlagScheduledEventID)
 	resetType := c.String(FlagResetType)
 	extraForResetType, ok := resetTypesMap[resetType]
-	if !ok && eventID <= 0 {
-		ErrorAndExit(fmt.Sprintf("must specify either valid event_id or reset_type (one of %s)", strings.Join(mapKeysToArray(resetTypesMap), ", ")), nil)
+	if !ok && eventID <= 0 && scheduledEventID <= 0 {
+		ErrorAndExit(fmt.Sprintf("must specify either valid event_id, scheduled_event_id or reset_type (one of %s)", strings.Join(mapKeysToArray(resetTypesMap), ", ")), nil)
 	}
 	if ok && len(extraForResetType) > 0 {
 		getRequiredOption(c, extraForResetType)
@@ -1398,6 +1556,26 @@ func ResetWorkflow(c *cli.Context) {
 			ErrorAndExit("getResetEventIDByType failed", err)
 		}
 	}
+	if scheduledEventID > 0 || c.IsSet(FlagReapplyType) || c.Bool(FlagDryRun) {
+		resp, err := cFactory.AdminClient(c).ResetWorkflowExecutionWithOptions(ctx, &adminservice.ResetWorkflowExecutionWithOptionsRequest{
+			Namespace: namespace,
+			Execution: &commonpb.WorkflowExecution{
+				WorkflowId: wid,
+				RunId:      resetBaseRunID,
+			},
+			Reason:                       fmt.Sprintf("%v:%v", getCurrentUserFromEnv(), reason),
+			RequestId:                    uuid.New(),
+			WorkflowTaskFinishEventId:    workflowTaskFinishID,
+			WorkflowTaskScheduledEventId: scheduledEventID,
+			ReapplyType:                  c.String(FlagReapplyType),
+			DryRun:                       c.Bool(FlagDryRun),
+		})
+		if err != nil {
+			ErrorAndExit("reset failed", err)
+		}
+		prettyPrintJSONObject(resp)
+		return
+	}
 	resp, err := frontendClient.ResetWorkflowExecution(ctx, &workflowservice.ResetWorkflowExecutionRequest{
 		Namespace: namespace,
 		WorkflowExecution: &commonpb.WorkflowExecution{
@@ -1644,14 +1822,15 @@ func doReset(c *cli.Context, namespace, wid, rid string, params batchResetParams
 	}
 
 	if params.nonDeterministicOnly {
-		isLDN, err := isLastEventWorkflowTaskFailedWithNonDeterminism(ctx, namespace, wid, rid, frontendClient)
+		isLDN, err := batcher.IsLastEventWorkflowTaskFailedWithNonDeterminism(ctx, frontendClient, namespace, wid, rid)
 		if err != nil {
-			return printErrorAndReturn("check isLastEventWorkflowTaskFailedWithNonDeterminism failed", err)
+			return printErrorAndReturn("check IsLastEventWorkflowTaskFailedWithNonDeterminism failed", err)
 		}
 		if !isLDN {
 			fmt.Println("skip because last event is not WorkflowTaskFailedWithNonDeterminism")
 			return nil
 		}
+		fmt.Printf("found non deterministic workflow wid:%v, rid:%v\n", wid, rid)
 	}
 
 	resetBaseRunID, workflowTaskFinishID, err := getResetEventIDByType(ctx, c, params.resetType, namespace, wid, rid, frontendClient)
@@ -1683,236 +1862,11 @@ func doReset(c *cli.Context, namespace, wid, rid string, params batchResetParams
 	return nil
 }
 
-func isLastEventWorkflowTaskFailedWithNonDeterminism(ctx context.Context, namespace, wid, rid string, frontendClient workflowservice.WorkflowServiceClient) (bool, error) {
-	req := &workflowservice.GetWorkflowExecutionHistoryRequest{
-		Namespace: namespace,
-		Execution: &commonpb.WorkflowExecution{
-			WorkflowId: wid,
-			RunId:      rid,
-		},
-		MaximumPageSize: 1000,
-		NextPageToken:   nil,
-	}
-
-	var firstEvent, workflowTaskFailedEvent *historypb.HistoryEvent
-	for {
-		resp, err := frontendClient.GetWorkflowExecutionHistory(ctx, req)
-		if err != nil {
-			return false, printErrorAndReturn("GetWorkflowExecutionHistory failed", err)
-		}
-		for _, e := range resp.GetHistory().GetEvents() {
-			if firstEvent == nil {
-				firstEvent = e
-			}
-			if e.GetEventType() == enumspb.EVENT_TYPE_WORKFLOW_TASK_FAILED {
-				workflowTaskFailedEvent = e
-			} else if e.GetEventType() == enumspb.EVENT_TYPE_WORKFLOW_TASK_COMPLETED {
-				workflowTaskFailedEvent = nil
-			}
-		}
-		if len(resp.NextPageToken) != 0 {
-			req.NextPageToken = resp.NextPageToken
-		} else {
-			break
-		}
-	}
-
-	if workflowTaskFailedEvent != nil {
-		attr := workflowTaskFailedEvent.GetWorkflowTaskFailedEventAttributes()
-
-		if attr.GetCause() == enumspb.WORKFLOW_TASK_FAILED_CAUSE_WORKFLOW_WORKER_UNHANDLED_FAILURE ||
-			strings.Contains(attr.GetFailure().GetMessage(), "nondeterministic") {
-			fmt.Printf("found non determnistic workflow wid:%v, rid:%v, orignalStartTime:%v \n", wid, rid, timestamp.TimeValue(firstEvent.GetEventTime()))
-			return true, nil
-		}
-	}
-
-	return false, nil
-}
-
 func getResetEventIDByType(ctx context.Context, c *cli.Context, resetType, namespace, wid, rid string, frontendClient workflowservice.WorkflowServiceClient) (resetBaseRunID string, workflowTaskFinishID int64, err error) {
 	fmt.Println("resetType:", resetType)
-	switch resetType {
-	case "LastWorkflowTask":
-		resetBaseRunID, workflowTaskFinishID, err = getLastWorkflowTaskEventID(ctx, namespace, wid, rid, frontendClient)
-		if err != nil {
-			return
-		}
-	case "LastContinuedAsNew":
-		resetBaseRunID, workflowTaskFinishID, err = getLastContinueAsNewID(ctx, namespace, wid, rid, frontendClient)
-		if err != nil {
-			return
-		}
-	case "FirstWorkflowTask":
-		resetBaseRunID, workflowTaskFinishID, err = getFirstWorkflowTaskEventID(ctx, namespace, wid, rid, frontendClient)
-		if err != nil {
-			return
-		}
-	case "BadBinary":
-		binCheckSum := c.String(FlagResetBadBinaryChecksum)
-		resetBaseRunID, workflowTaskFinishID, err = getBadWorkflowTaskCompletedID(ctx, namespace, wid, rid, binCheckSum, frontendClient)
-		if err != nil {
-			return
-		}
-	default:
-		panic("not supported resetType")
-	}
-	return
-}
-
-// Returns event id of the last completed task or id of the next event after scheduled task.
-func getLastWorkflowTaskEventID(ctx context.Context, namespace, wid, rid string, frontendClient workflowservice.WorkflowServiceClient) (resetBaseRunID string, workflowTaskEventID int64, err error) {
-	resetBaseRunID = rid
-	req := &workflowservice.GetWorkflowExecutionHistoryRequest{
-		Namespace: namespace,
-		Execution: &commonpb.WorkflowExecution{
-			WorkflowId: wid,
-			RunId:      rid,
-		},
-		MaximumPageSize: 1000,
-		NextPageToken:   nil,
-	}
-
-	for {
-		resp, err := frontendClient.GetWorkflowExecutionHistory(ctx, req)
-		if err != nil {
-			return "", 0, printErrorAndReturn("GetWorkflowExecutionHistory failed", err)
-		}
-		for _, e := range resp.GetHistory().GetEvents() {
-			if e.GetEventType() == enumspb.EVENT_TYPE_WORKFLOW_TASK_COMPLETED {
-				workflowTaskEventID = e.GetEventId()
-			} else if e.GetEventType() == enumspb.EVENT_TYPE_WORKFLOW_TASK_SCHEDULED {
-				workflowTaskEventID = e.GetEventId() + 1
-			}
-		}
-		if len(resp.NextPageToken) != 0 {
-			req.NextPageToken = resp.NextPageToken
-		} else {
-			break
-		}
-	}
-	if workflowTaskEventID == 0 {
-		return "", 0, printErrorAndReturn("Get LastWorkflowTaskID failed", fmt.Errorf("unable to find any scheduled or completed task"))
-	}
-	return
-}
-
-func getBadWorkflowTaskCompletedID(ctx context.Context, namespace, wid, rid, binChecksum string, frontendClient workflowservice.WorkflowServiceClient) (resetBaseRunID string, workflowTaskCompletedID int64, err error) {
-	resetBaseRunID = rid
-	resp, err := frontendClient.DescribeWorkflowExecution(ctx, &workflowservice.DescribeWorkflowExecutionRequest{
-		Namespace: namespace,
-		Execution: &commonpb.WorkflowExecution{
-			WorkflowId: wid,
-			RunId:      rid,
-		},
-	})
-	if err != nil {
-		return "", 0, printErrorAndReturn("DescribeWorkflowExecution failed", err)
-	}
-
-	_, p := history.FindAutoResetPoint(clock.NewRealTimeSource(), &namespacepb.BadBinaries{
-		Binaries: map[string]*namespacepb.BadBinaryInfo{
-			binChecksum: {},
-		},
-	}, resp.WorkflowExecutionInfo.AutoResetPoints)
-	if p != nil {
-		workflowTaskCompletedID = p.GetFirstWorkflowTaskCompletedId()
-	}
-
-	if workflowTaskCompletedID == 0 {
-		return "", 0, printErrorAndReturn("Get BadWorkflowTaskCompletedID failed", serviceerror.NewInvalidArgument("no WorkflowTaskCompletedID"))
-	}
-	return
-}
-
-// Returns id of the first workflow task completed event or if it doesn't exist then id of the event after task scheduled event.
-func getFirstWorkflowTaskEventID(ctx context.Context, namespace, wid, rid string, frontendClient workflowservice.WorkflowServiceClient) (resetBaseRunID string, workflowTaskEventID int64, err error) {
-	resetBaseRunID = rid
-	req := &workflowservice.GetWorkflowExecutionHistoryRequest{
-		Namespace: namespace,
-		Execution: &commonpb.WorkflowExecution{
-			WorkflowId: wid,
-			RunId:      rid,
-		},
-		MaximumPageSize: 1000,
-		NextPageToken:   nil,
-	}
-	for {
-		resp, err := frontendClient.GetWorkflowExecutionHistory(ctx, req)
-		if err != nil {
-			return "", 0, printErrorAndReturn("GetWorkflowExecutionHistory failed", err)
-		}
-		for _, e := range resp.GetHistory().GetEvents() {
-			if e.GetEventType() == enumspb.EVENT_TYPE_WORKFLOW_TASK_COMPLETED {
-				workflowTaskEventID = e.GetEventId()
-				return resetBaseRunID, workflowTaskEventID, nil
-			}
-			if e.GetEventType() == enumspb.EVENT_TYPE_WORKFLOW_TASK_SCHEDULED {
-				if workflowTaskEventID == 0 {
-					workflowTaskEventID = e.GetEventId() + 1
-				}
-			}
-		}
-		if len(resp.NextPageToken) != 0 {
-			req.NextPageToken = resp.NextPageToken
-		} else {
-			break
-		}
-	}
-	if workflowTaskEventID == 0 {
-		return "", 0, printErrorAndReturn("Get FirstWorkflowTaskID failed", fmt.Errorf("unable to find any scheduled or completed task"))
-	}
-	return
-}
-
-func getLastContinueAsNewID(ctx context.Context, namespace, wid, rid string, frontendClient workflowservice.WorkflowServiceClient) (resetBaseRunID string, workflowTaskCompletedID int64, err error) {
-	// get first event
-	req := &workflowservice.GetWorkflowExecutionHistoryRequest{
-		Namespace: namespace,
-		Execution: &commonpb.WorkflowExecution{
-			WorkflowId: wid,
-			RunId:      rid,
-		},
-		MaximumPageSize: 1,
-		NextPageToken:   nil,
-	}
-	resp, err := frontendClient.GetWorkflowExecutionHistory(ctx, req)
+	resetBaseRunID, workflowTaskFinishID, err = batcher.GetResetPoint(ctx, frontendClient, namespace, wid, rid, resetType, c.String(FlagResetBadBinaryChecksum))
 	if err != nil {
-		return "", 0, printErrorAndReturn("GetWorkflowExecutionHistory failed", err)
-	}
-	firstEvent := resp.History.Events[0]
-	resetBaseRunID = firstEvent.GetWorkflowExecutionStartedEventAttributes().GetContinuedExecutionRunId()
-	if resetBaseRunID == "" {
-		return "", 0, printErrorAndReturn("GetWorkflowExecutionHistory failed", fmt.Errorf("cannot get resetBaseRunId"))
-	}
-
-	req = &workflowservice.GetWorkflowExecutionHistoryRequest{
-		Namespace: namespace,
-		Execution: &commonpb.WorkflowExecution{
-			WorkflowId: wid,
-			RunId:      resetBaseRunID,
-		},
-		MaximumPageSize: 1000,
-		NextPageToken:   nil,
-	}
-	for {
-		resp, err := frontendClient.GetWorkflowExecutionHistory(ctx, req)
-		if err != nil {
-			return "", 0, printErrorAndReturn("GetWorkflowExecutionHistory failed", err)
-		}
-		for _, e := range resp.GetHistory().GetEvents() {
-			if e.GetEventType() == enumspb.EVENT_TYPE_WORKFLOW_TASK_COMPLETED {
-				workflowTaskCompletedID = e.GetEventId()
-			}
-		}
-		if len(resp.NextPageToken) != 0 {
-			req.NextPageToken = resp.NextPageToken
-		} else {
-			break
-		}
-	}
-	if workflowTaskCompletedID == 0 {
-		return "", 0, printErrorAndReturn("Get LastContinueAsNewID failed", fmt.Errorf("no WorkflowTaskCompletedID"))
+		return "", 0, printErrorAndReturn("Get reset point failed", err)
 	}
 	return
 }
@@ -46,10 +46,18 @@ func VerifyCompatibleVersion(
 	// cassandra. This check is to allow such rollbacks since we only make backwards compatible schema
 	// changes
 	if cmpVersion(version, expectedVersion) < 0 {
-		return fmt.Errorf(
+		err := fmt.Errorf(
 			"version mismatch for keyspace/database: %q. Expected version: %s cannot be greater than "+
 				"Actual version: %s", dbName, expectedVersion, version,
 		)
+		// tell a failed update apart from a missing one, the update history is only a hint here
+		if updateLog, logErr := db.ReadSchemaUpdateLog(); logErr == nil {
+			if partial := findPartialUpdate(updateLog, version); partial != nil {
+				err = fmt.Errorf("%v. The update to version %s stopped after %d statements, "+
+					"rerun update-schema to resume it", err, partial.version, partial.appliedStmts)
+			}
+		}
+		return err
 	}
 	return nil
 }
@@ -84,6 +92,8 @@ func newUpdateConfig(cli *cli.Context) (*UpdateConfig, error) {
 	config := new(UpdateConfig)
 	config.SchemaDir = cli.String(CLIOptSchemaDir)
 	config.IsDryRun = cli.Bool(CLIOptDryrun)
+	config.IsPlan = cli.Bool(CLIOptPlan)
+	config.MarkApplied = cli.Bool(CLIOptMarkApplied)
 	config.TargetVersion = cli.String(CLIOptTargetVersion)
 
 	if err := validateUpdateConfig(config); err != nil {
@@ -135,6 +145,17 @@ func validateUpdateConfig(config *UpdateConfig) error {
 		}
 		config.TargetVersion = ver
 	}
+	if config.IsPlan && config.IsDryRun {
+		return NewConfigError("only one of " + flag(CLIOptPlan) + " and " + flag(CLIOptDryrun) + " can be specified")
+	}
+	if config.MarkApplied {
+		if config.IsPlan || config.IsDryRun {
+			return NewConfigError(flag(CLIOptMarkApplied) + " cannot be combined with " + flag(CLIOptPlan) + " or " + flag(CLIOptDryrun))
+		}
+		if len(config.TargetVersion) == 0 {
+			return NewConfigError(flag(CLIOptMarkApplied) + " requires the " + flag(CLIOptTargetVersion) + " argument")
+		}
+	}
 	return nil
 }
 
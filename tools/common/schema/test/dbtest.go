@@ -129,6 +129,14 @@ func (tb *DBTestBase) RunUpdateTest(db DB) {
 	err = db.WriteSchemaUpdateLog("9.0", "10.0", "abc", "test")
 	tb.Nil(err)
 
+	updateLog, err := db.ReadSchemaUpdateLog()
+	tb.Nil(err)
+	tb.Equal(1, len(updateLog))
+	tb.Equal("9.0", updateLog[0].OldVersion)
+	tb.Equal("10.0", updateLog[0].NewVersion)
+	tb.Equal("abc", updateLog[0].ManifestMD5)
+	tb.Equal("test", updateLog[0].Description)
+
 	ver, err := db.ReadSchemaVersion()
 	tb.Nil(err)
 	tb.Equal("10.0", ver)
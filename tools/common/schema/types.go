@@ -27,6 +27,7 @@ package schema
 import (
 	"fmt"
 	"regexp"
+	"time"
 )
 
 type (
@@ -42,6 +43,8 @@ type (
 		TargetVersion string
 		SchemaDir     string
 		IsDryRun      bool
+		IsPlan        bool // only print the updates that would be executed
+		MarkApplied   bool // record the versions up to the target version as applied without executing them
 	}
 	// SetupConfig holds the config
 	// params need by the SetupTask
@@ -51,6 +54,15 @@ type (
 		Overwrite         bool // overwrite previous data
 		DisableVersioning bool // do not use schema versioning
 	}
+	// UpdateLogEntry is an entry of the
+	// schema update history table
+	UpdateLogEntry struct {
+		UpdateTime  time.Time
+		OldVersion  string
+		NewVersion  string
+		ManifestMD5 string
+		Description string
+	}
 	// DB is the database interface that's required to be implemented
 	// for the schema-tool to work
 	DB interface {
@@ -66,6 +78,8 @@ type (
 		UpdateSchemaVersion(newVersion string, minCompatibleVersion string) error
 		// WriteSchemaUpdateLog adds an entry to the schema update history table
 		WriteSchemaUpdateLog(oldVersion string, newVersion string, manifestMD5 string, desc string) error
+		// ReadSchemaUpdateLog returns the entries of the schema update history table, oldest first
+		ReadSchemaUpdateLog() ([]UpdateLogEntry, error)
 		// Close gracefully closes the client object
 		Close()
 	}
@@ -102,12 +116,18 @@ const (
 	CLIOptTargetVersion = "version"
 	// CLIOptDryrun is the cli option for enabling dryrun
 	CLIOptDryrun = "dryrun"
+	// CLIOptPlan is the cli option for printing the update plan
+	CLIOptPlan = "plan"
+	// CLIOptMarkApplied is the cli option for marking versions as applied
+	CLIOptMarkApplied = "mark-applied"
 	// CLIOptSchemaDir is the cli option for schema directory
 	CLIOptSchemaDir = "schema-dir"
 	// CLIOptReplicationFactor is the cli option for replication factor
 	CLIOptReplicationFactor = "replication-factor"
 	// CLIOptQuiet is the cli option for quiet mode
 	CLIOptQuiet = "quiet"
+	// CLIOptShardDatabases is the cli option for the extra databases history shards are spread across
+	CLIOptShardDatabases = "shard-databases"
 
 	// CLIFlagEndpoint is the cli flag for endpoint
 	CLIFlagEndpoint = CLIOptEndpoint + ", ep"
@@ -139,12 +159,18 @@ const (
 	CLIFlagTargetVersion = CLIOptTargetVersion + ", v"
 	// CLIFlagDryrun is the cli flag for dryrun
 	CLIFlagDryrun = CLIOptDryrun + ", y"
+	// CLIFlagPlan is the cli flag for printing the update plan
+	CLIFlagPlan = CLIOptPlan + ", pn"
+	// CLIFlagMarkApplied is the cli flag for marking versions as applied
+	CLIFlagMarkApplied = CLIOptMarkApplied + ", ma"
 	// CLIFlagSchemaDir is the cli flag for schema directory
 	CLIFlagSchemaDir = CLIOptSchemaDir + ", d"
 	// CLIFlagReplicationFactor is the cli flag for replication factor
 	CLIFlagReplicationFactor = CLIOptReplicationFactor + ", rf"
 	// CLIFlagQuiet is the cli flag for quiet mode
 	CLIFlagQuiet = CLIOptQuiet + ", q"
+	// CLIFlagShardDatabases is the cli flag for shard databases
+	CLIFlagShardDatabases = CLIOptShardDatabases + ", sd"
 
 	// CLIFlagEnableTLS enables cassandra client TLS
 	CLIFlagEnableTLS = "tls"
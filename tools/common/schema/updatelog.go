@@ -0,0 +1,96 @@
+// The MIT License
+//
+// Copyright (c) 2020 Temporal Technologies Inc.  All rights reserved.
+//
+// Copyright (c) 2020 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+package schema
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+type (
+	// partialUpdate is a version whose update
+	// stopped before all its statements were applied
+	partialUpdate struct {
+		version      string
+		checksum     string
+		appliedStmts int
+	}
+)
+
+const (
+	// partialUpdateDescPrefix prefixes the description of the entries that record
+	// the progress of a version update, it is followed by the number of applied statements
+	partialUpdateDescPrefix = "partial update, statements applied: "
+	// markedAppliedDescPrefix prefixes the description of
+	// the versions that were marked as applied by hand
+	markedAppliedDescPrefix = "marked as applied: "
+)
+
+func partialUpdateDescription(appliedStmts int) string {
+	return fmt.Sprintf("%v%v", partialUpdateDescPrefix, appliedStmts)
+}
+
+func parsePartialUpdateDescription(desc string) (int, bool) {
+	if !strings.HasPrefix(desc, partialUpdateDescPrefix) {
+		return 0, false
+	}
+	appliedStmts, err := strconv.Atoi(strings.TrimPrefix(desc, partialUpdateDescPrefix))
+	if err != nil {
+		return 0, false
+	}
+	return appliedStmts, true
+}
+
+// findPartialUpdate returns the update from the current version that was
+// left partially applied, it is the last update attempted from the current version
+func findPartialUpdate(updateLog []UpdateLogEntry, currVer string) *partialUpdate {
+	var partial *partialUpdate
+	for _, entry := range updateLog {
+		if cmpVersion(entry.OldVersion, currVer) != 0 || cmpVersion(entry.NewVersion, currVer) <= 0 {
+			continue
+		}
+		partial = nil
+		if appliedStmts, ok := parsePartialUpdateDescription(entry.Description); ok {
+			partial = &partialUpdate{
+				version:      entry.NewVersion,
+				checksum:     entry.ManifestMD5,
+				appliedStmts: appliedStmts,
+			}
+		}
+	}
+	return partial
+}
+
+// appliedChecksums returns the last checksum recorded for every applied version
+func appliedChecksums(updateLog []UpdateLogEntry) map[string]string {
+	checksums := make(map[string]string)
+	for _, entry := range updateLog {
+		if _, ok := parsePartialUpdateDescription(entry.Description); ok || len(entry.ManifestMD5) == 0 {
+			continue
+		}
+		checksums[entry.NewVersion] = entry.ManifestMD5
+	}
+	return checksums
+}
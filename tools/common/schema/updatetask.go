@@ -63,6 +63,7 @@ type (
 		version  string
 		manifest *manifest
 		cqlStmts []string
+		checksum string
 	}
 
 	// byVersion is a comparator type
@@ -104,12 +105,43 @@ func (task *UpdateTask) Run() error {
 		return fmt.Errorf("error reading current schema version:%v", err.Error())
 	}
 
+	updateLog, err := task.db.ReadSchemaUpdateLog()
+	if err != nil {
+		return fmt.Errorf("error reading schema update history:%v", err.Error())
+	}
+
+	// a version which is marked as applied again gets its checksum recorded anew
+	var unverifiedVer string
+	if config.MarkApplied {
+		unverifiedVer = config.TargetVersion
+	}
+	if err := task.verifyChecksums(currVer, updateLog, unverifiedVer); err != nil {
+		return err
+	}
+
+	if config.MarkApplied && cmpVersion(config.TargetVersion, currVer) <= 0 {
+		return task.recordChecksum(currVer, config.TargetVersion)
+	}
+
 	updates, err := task.buildChangeSet(currVer)
 	if err != nil {
 		return err
 	}
 
-	err = task.executeUpdates(currVer, updates)
+	appliedStmts, err := task.resumePoint(currVer, updateLog, updates)
+	if err != nil {
+		return err
+	}
+
+	switch {
+	case config.IsPlan:
+		task.printPlan(currVer, updates, appliedStmts)
+		return nil
+	case config.MarkApplied:
+		err = task.markApplied(currVer, updates)
+	default:
+		err = task.executeUpdates(currVer, updates, appliedStmts)
+	}
 	if err != nil {
 		return err
 	}
@@ -119,7 +151,33 @@ func (task *UpdateTask) Run() error {
 	return nil
 }
 
-func (task *UpdateTask) executeUpdates(currVer string, updates []changeSet) error {
+// resumePoint returns the number of statements of the first update
+// which were applied by a previous run that did not complete
+func (task *UpdateTask) resumePoint(currVer string, updateLog []UpdateLogEntry, updates []changeSet) (int, error) {
+	partial := findPartialUpdate(updateLog, currVer)
+	if partial == nil {
+		return 0, nil
+	}
+	if len(updates) == 0 || updates[0].version != partial.version {
+		return 0, fmt.Errorf("version %v was partially applied (%v statements), but it is not the next version to apply",
+			partial.version, partial.appliedStmts)
+	}
+	cs := updates[0]
+	if cs.checksum != partial.checksum {
+		return 0, fmt.Errorf("version %v was partially applied (%v statements) from files with a different checksum, "+
+			"restore the files or finish the version by hand and record it with %v",
+			partial.version, partial.appliedStmts, flag(CLIOptMarkApplied))
+	}
+	if partial.appliedStmts > len(cs.cqlStmts) {
+		return 0, fmt.Errorf("version %v was partially applied (%v statements), but it only has %v statements",
+			partial.version, partial.appliedStmts, len(cs.cqlStmts))
+	}
+	log.Printf("Resuming the partial update to version %v after %v of %v statements\n",
+		partial.version, partial.appliedStmts, len(cs.cqlStmts))
+	return partial.appliedStmts, nil
+}
+
+func (task *UpdateTask) executeUpdates(currVer string, updates []changeSet, appliedStmts int) error {
 
 	if len(updates) == 0 {
 		log.Printf("found zero updates from current version %v", currVer)
@@ -128,43 +186,155 @@ func (task *UpdateTask) executeUpdates(currVer string, updates []changeSet) erro
 
 	for _, cs := range updates {
 
-		err := task.execCQLStmts(cs.version, cs.cqlStmts)
+		err := task.execCQLStmts(currVer, &cs, appliedStmts)
 		if err != nil {
 			return err
 		}
-		err = task.updateSchemaVersion(currVer, &cs)
+		err = task.updateSchemaVersion(currVer, &cs, cs.manifest.Description)
 		if err != nil {
 			return err
 		}
 
 		log.Printf("Schema updated from %v to %v\n", currVer, cs.version)
 		currVer = cs.version
+		appliedStmts = 0
 	}
 
 	return nil
 }
 
-func (task *UpdateTask) execCQLStmts(ver string, stmts []string) error {
-	log.Printf("---- Executing updates for version %v ----\n", ver)
-	for _, stmt := range stmts {
+// execCQLStmts executes the statements of the change set, skipping the ones
+// that were already applied, and records the progress after every statement
+// so that a failed update can be resumed
+func (task *UpdateTask) execCQLStmts(currVer string, cs *changeSet, appliedStmts int) error {
+	log.Printf("---- Executing updates for version %v ----\n", cs.version)
+	for i := appliedStmts; i < len(cs.cqlStmts); i++ {
+		stmt := cs.cqlStmts[i]
 		log.Println(rmspaceRegex.ReplaceAllString(stmt, " "))
 		e := task.db.Exec(stmt)
 		if e != nil {
-			return fmt.Errorf("error executing CQL statement:%v", e)
+			return fmt.Errorf("error executing CQL statement %v of %v of version %v:%v, "+
+				"the statements before it were applied and the next update resumes from it", i+1, len(cs.cqlStmts), cs.version, e)
+		}
+		e = task.db.WriteSchemaUpdateLog(currVer, cs.version, cs.checksum, partialUpdateDescription(i+1))
+		if e != nil {
+			return fmt.Errorf("failed to record the progress of version %v after statement %v in schema_update_history, err=%v",
+				cs.version, i+1, e)
 		}
 	}
 	log.Printf("---- Done ----\n")
 	return nil
 }
 
-func (task *UpdateTask) updateSchemaVersion(oldVer string, cs *changeSet) error {
+// markApplied records the versions of the change sets
+// as applied, without executing their statements
+func (task *UpdateTask) markApplied(currVer string, updates []changeSet) error {
+	for _, cs := range updates {
+		err := task.updateSchemaVersion(currVer, &cs, markedAppliedDescPrefix+cs.manifest.Description)
+		if err != nil {
+			return err
+		}
+		log.Printf("Schema version %v marked as applied\n", cs.version)
+		currVer = cs.version
+	}
+	return nil
+}
+
+// recordChecksum records the checksum of the current
+// files of an applied version in the update history
+func (task *UpdateTask) recordChecksum(currVer string, ver string) error {
+	dirPath := task.config.SchemaDir + "/v" + ver
+	m, err := readManifest(dirPath)
+	if err != nil {
+		return fmt.Errorf("error processing manifest for version %v:%v", ver, err.Error())
+	}
+	checksum, err := versionChecksum(dirPath, m)
+	if err != nil {
+		return fmt.Errorf("error computing checksum of version %v:%v", ver, err.Error())
+	}
+	err = task.db.WriteSchemaUpdateLog(currVer, ver, checksum, markedAppliedDescPrefix+m.Description)
+	if err != nil {
+		return fmt.Errorf("failed to add entry to schema_update_history, err=%v", err.Error())
+	}
+	log.Printf("Checksum of applied schema version %v recorded\n", ver)
+	return nil
+}
+
+// verifyChecksums verifies that the files of the versions up to the current
+// version match the checksums recorded when the versions were applied
+func (task *UpdateTask) verifyChecksums(currVer string, updateLog []UpdateLogEntry, unverifiedVer string) error {
+	checksums := appliedChecksums(updateLog)
+
+	subdirs, err := ioutil.ReadDir(task.config.SchemaDir)
+	if err != nil {
+		return fmt.Errorf("error listing schema dir:%v", err.Error())
+	}
+
+	var mismatched []string
+	for _, dir := range subdirs {
+		if !dir.IsDir() || !versionStrRegex.MatchString(dir.Name()) {
+			continue
+		}
+		ver := dirToVersion(dir.Name())
+		checksum, ok := checksums[ver]
+		if !ok || ver == unverifiedVer || cmpVersion(ver, currVer) > 0 {
+			continue
+		}
+
+		dirPath := task.config.SchemaDir + "/" + dir.Name()
+		m, err := readManifest(dirPath)
+		if err != nil {
+			return fmt.Errorf("error processing manifest for version %v:%v", ver, err.Error())
+		}
+		actual, err := versionChecksum(dirPath, m)
+		if err != nil {
+			return fmt.Errorf("error computing checksum of version %v:%v", ver, err.Error())
+		}
+		// versions applied before the checksums covered the update files only recorded the manifest md5
+		if checksum != actual && checksum != m.md5 {
+			mismatched = append(mismatched, ver)
+		}
+	}
+
+	if len(mismatched) > 0 {
+		sort.Slice(mismatched, func(i, j int) bool { return cmpVersion(mismatched[i], mismatched[j]) < 0 })
+		return fmt.Errorf("the files of the applied versions %v were edited after they were applied, "+
+			"restore them or record their new checksums with %v", mismatched, flag(CLIOptMarkApplied))
+	}
+	return nil
+}
+
+// printPlan prints the statements the update would execute
+func (task *UpdateTask) printPlan(currVer string, updates []changeSet, appliedStmts int) {
+	if len(updates) == 0 {
+		log.Printf("found zero updates from current version %v", currVer)
+		return
+	}
+
+	log.Printf("---- Update plan from version %v ----\n", currVer)
+	for _, cs := range updates {
+		log.Printf("Version %v (min compatible version %v, checksum %v): %v\n",
+			cs.version, cs.manifest.MinCompatibleVersion, cs.checksum, cs.manifest.Description)
+		for i, stmt := range cs.cqlStmts {
+			status := ""
+			if i < appliedStmts {
+				status = " (already applied)"
+			}
+			log.Printf("  %v/%v%v: %v\n", i+1, len(cs.cqlStmts), status, rmspaceRegex.ReplaceAllString(stmt, " "))
+		}
+		appliedStmts = 0
+	}
+	log.Printf("---- End of plan ----\n")
+}
+
+func (task *UpdateTask) updateSchemaVersion(oldVer string, cs *changeSet, desc string) error {
 
 	err := task.db.UpdateSchemaVersion(cs.version, cs.manifest.MinCompatibleVersion)
 	if err != nil {
 		return fmt.Errorf("failed to update schema_version table, err=%v", err.Error())
 	}
 
-	err = task.db.WriteSchemaUpdateLog(oldVer, cs.manifest.CurrVersion, cs.manifest.md5, cs.manifest.Description)
+	err = task.db.WriteSchemaUpdateLog(oldVer, cs.manifest.CurrVersion, cs.checksum, desc)
 	if err != nil {
 		return fmt.Errorf("failed to add entry to schema_update_history, err=%v", err.Error())
 	}
@@ -207,10 +377,16 @@ func (task *UpdateTask) buildChangeSet(currVer string) ([]changeSet, error) {
 			return nil, fmt.Errorf("error processing version %v:%v", vd, e.Error())
 		}
 
+		checksum, e := versionChecksum(dirPath, m)
+		if e != nil {
+			return nil, fmt.Errorf("error computing checksum of version %v:%v", vd, e.Error())
+		}
+
 		cs := changeSet{}
 		cs.manifest = m
 		cs.cqlStmts = stmts
 		cs.version = m.CurrVersion
+		cs.checksum = checksum
 		result = append(result, cs)
 	}
 
@@ -296,6 +472,22 @@ func readManifest(dirPath string) (*manifest, error) {
 	return &manifest, nil
 }
 
+// versionChecksum returns the checksum of a version directory,
+// the md5 of its manifest followed by all its update files
+func versionChecksum(dirPath string, m *manifest) (string, error) {
+	// See comment above. This is an appropriate usage of md5.
+	// #nosec
+	hash := md5.New()
+	for _, file := range append([]string{manifestFileName}, m.SchemaUpdateCqlFiles...) {
+		content, err := ioutil.ReadFile(dirPath + "/" + file)
+		if err != nil {
+			return "", err
+		}
+		_, _ = hash.Write(content)
+	}
+	return hex.EncodeToString(hash.Sum(nil)), nil
+}
+
 // readSchemaDir returns a sorted list of subdir names that hold
 // the schema changes for versions in the range startVer < ver <= endVer
 // when endVer is empty this method returns all subdir names that are greater than startVer
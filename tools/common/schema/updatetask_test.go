@@ -25,18 +25,31 @@
 package schema
 
 import (
+	"errors"
 	"io/ioutil"
 	"os"
+	"strings"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/require"
 	"github.com/stretchr/testify/suite"
 )
 
-type UpdateTaskTestSuite struct {
-	*require.Assertions // override suite.Suite.Assertions with require.Assertions; this means that s.NotNil(nil) will stop the test, not merely log an error
-	suite.Suite
-}
+type (
+	UpdateTaskTestSuite struct {
+		*require.Assertions // override suite.Suite.Assertions with require.Assertions; this means that s.NotNil(nil) will stop the test, not merely log an error
+		suite.Suite
+	}
+
+	// fakeDB keeps the schema version and update history in memory
+	fakeDB struct {
+		version   string
+		updateLog []UpdateLogEntry
+		executed  []string
+		failStmt  string
+	}
+)
 
 func TestUpdateTaskTestSuite(t *testing.T) {
 	suite.Run(t, new(UpdateTaskTestSuite))
@@ -164,3 +177,144 @@ func (s *UpdateTaskTestSuite) runReadManifestTest(dir, input, currVer, minVer, d
 	s.True(len(m.md5) > 0)
 	s.Equal(files, m.SchemaUpdateCqlFiles)
 }
+
+func (s *UpdateTaskTestSuite) TestUpdateResumesPartialUpdate() {
+	dir := s.makeSchemaVersionDirs()
+	defer os.RemoveAll(dir)
+
+	db := &fakeDB{version: "0.0", failStmt: "CREATE TABLE c (id int);"}
+	err := newUpdateSchemaTask(db, &UpdateConfig{SchemaDir: dir}).Run()
+	s.Error(err)
+	s.Contains(err.Error(), "statement 2 of 2 of version 1.1")
+	s.Equal("1.0", db.version)
+	s.Equal([]string{"CREATE TABLE a (id int);", "CREATE TABLE b (id int);"}, db.executed)
+
+	err = VerifyCompatibleVersion(db, "test", "1.1")
+	s.Error(err)
+	s.Contains(err.Error(), "The update to version 1.1 stopped after 1 statements")
+
+	db.failStmt = ""
+	s.NoError(newUpdateSchemaTask(db, &UpdateConfig{SchemaDir: dir}).Run())
+	s.Equal("1.1", db.version)
+	s.Equal([]string{"CREATE TABLE a (id int);", "CREATE TABLE b (id int);", "CREATE TABLE c (id int);"}, db.executed)
+	s.NoError(VerifyCompatibleVersion(db, "test", "1.1"))
+}
+
+func (s *UpdateTaskTestSuite) TestUpdateVerifiesChecksums() {
+	dir := s.makeSchemaVersionDirs()
+	defer os.RemoveAll(dir)
+
+	db := &fakeDB{version: "0.0"}
+	s.NoError(newUpdateSchemaTask(db, &UpdateConfig{SchemaDir: dir}).Run())
+	s.Equal("1.1", db.version)
+	s.NoError(newUpdateSchemaTask(db, &UpdateConfig{SchemaDir: dir}).Run())
+
+	s.NoError(ioutil.WriteFile(dir+"/v1.0/base.cql", []byte("CREATE TABLE a (id bigint);"), os.FileMode(0600)))
+	err := newUpdateSchemaTask(db, &UpdateConfig{SchemaDir: dir}).Run()
+	s.Error(err)
+	s.Contains(err.Error(), "[1.0]")
+
+	s.NoError(newUpdateSchemaTask(db, &UpdateConfig{SchemaDir: dir, TargetVersion: "1.0", MarkApplied: true}).Run())
+	s.NoError(newUpdateSchemaTask(db, &UpdateConfig{SchemaDir: dir}).Run())
+	s.Equal("1.1", db.version)
+	s.Equal(3, len(db.executed))
+}
+
+func (s *UpdateTaskTestSuite) TestUpdatePlan() {
+	dir := s.makeSchemaVersionDirs()
+	defer os.RemoveAll(dir)
+
+	db := &fakeDB{version: "0.0"}
+	s.NoError(newUpdateSchemaTask(db, &UpdateConfig{SchemaDir: dir, IsPlan: true}).Run())
+	s.Equal("0.0", db.version)
+	s.Empty(db.executed)
+	s.Empty(db.updateLog)
+}
+
+func (s *UpdateTaskTestSuite) TestUpdateMarkApplied() {
+	dir := s.makeSchemaVersionDirs()
+	defer os.RemoveAll(dir)
+
+	db := &fakeDB{version: "0.0"}
+	s.NoError(newUpdateSchemaTask(db, &UpdateConfig{SchemaDir: dir, TargetVersion: "1.1", MarkApplied: true}).Run())
+	s.Equal("1.1", db.version)
+	s.Empty(db.executed)
+	s.Equal(2, len(db.updateLog))
+	for _, entry := range db.updateLog {
+		s.Contains(entry.Description, markedAppliedDescPrefix)
+	}
+}
+
+func (s *UpdateTaskTestSuite) makeSchemaVersionDirs() string {
+	dir, err := ioutil.TempDir("", "update_schema_test")
+	s.NoError(err)
+
+	versions := []struct {
+		version string
+		files   map[string]string
+	}{
+		{"1.0", map[string]string{"base.cql": "CREATE TABLE a (id int);"}},
+		{"1.1", map[string]string{"b.cql": "CREATE TABLE b (id int);", "c.cql": "CREATE TABLE c (id int);"}},
+	}
+	for _, v := range versions {
+		versionDir := dir + "/v" + v.version
+		s.NoError(os.Mkdir(versionDir, os.FileMode(0700)))
+		var files []string
+		for _, name := range []string{"base.cql", "b.cql", "c.cql"} {
+			if content, ok := v.files[name]; ok {
+				s.NoError(ioutil.WriteFile(versionDir+"/"+name, []byte(content), os.FileMode(0600)))
+				files = append(files, `"`+name+`"`)
+			}
+		}
+		manifest := `{
+			"CurrVersion": "` + v.version + `",
+			"MinCompatibleVersion": "1.0",
+			"Description": "version ` + v.version + `",
+			"SchemaUpdateCqlFiles": [` + strings.Join(files, ",") + `]
+		}`
+		s.NoError(ioutil.WriteFile(versionDir+"/manifest.json", []byte(manifest), os.FileMode(0600)))
+	}
+	return dir
+}
+
+func (db *fakeDB) Exec(stmt string, _ ...interface{}) error {
+	if stmt == db.failStmt {
+		return errors.New("statement failed")
+	}
+	db.executed = append(db.executed, stmt)
+	return nil
+}
+
+func (db *fakeDB) DropAllTables() error {
+	return nil
+}
+
+func (db *fakeDB) CreateSchemaVersionTables() error {
+	return nil
+}
+
+func (db *fakeDB) ReadSchemaVersion() (string, error) {
+	return db.version, nil
+}
+
+func (db *fakeDB) UpdateSchemaVersion(newVersion string, _ string) error {
+	db.version = newVersion
+	return nil
+}
+
+func (db *fakeDB) WriteSchemaUpdateLog(oldVersion string, newVersion string, manifestMD5 string, desc string) error {
+	db.updateLog = append(db.updateLog, UpdateLogEntry{
+		UpdateTime:  time.Now().UTC(),
+		OldVersion:  oldVersion,
+		NewVersion:  newVersion,
+		ManifestMD5: manifestMD5,
+		Description: desc,
+	})
+	return nil
+}
+
+func (db *fakeDB) ReadSchemaUpdateLog() ([]UpdateLogEntry, error) {
+	return db.updateLog, nil
+}
+
+func (db *fakeDB) Close() {}
@@ -74,6 +74,25 @@ func (c *Connection) WriteSchemaUpdateLog(oldVersion string, newVersion string,
 	return c.adminDb.WriteSchemaUpdateLog(oldVersion, newVersion, manifestMD5, desc)
 }
 
+// ReadSchemaUpdateLog returns the entries of the schema update history table, oldest first
+func (c *Connection) ReadSchemaUpdateLog() ([]schema.UpdateLogEntry, error) {
+	rows, err := c.adminDb.ReadSchemaUpdateLog()
+	if err != nil {
+		return nil, err
+	}
+	entries := make([]schema.UpdateLogEntry, len(rows))
+	for i, row := range rows {
+		entries[i] = schema.UpdateLogEntry{
+			UpdateTime:  row.UpdateTime,
+			OldVersion:  row.OldVersion,
+			NewVersion:  row.NewVersion,
+			ManifestMD5: row.ManifestMD5,
+			Description: row.Description,
+		}
+	}
+	return entries, nil
+}
+
 // Exec executes a sql statement
 func (c *Connection) Exec(stmt string, args ...interface{}) error {
 	err := c.adminDb.Exec(stmt, args...)
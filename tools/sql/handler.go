@@ -29,15 +29,19 @@ import (
 	"log"
 	"net"
 	"net/url"
+	"strings"
 
 	"github.com/urfave/cli"
 
 	"go.temporal.io/server/common/auth"
+	"go.temporal.io/server/common/persistence/sql"
 	"go.temporal.io/server/common/persistence/sql/sqlplugin/mysql"
 	"go.temporal.io/server/common/persistence/sql/sqlplugin/postgresql"
+	"go.temporal.io/server/common/persistence/sql/sqlplugin/sqlite"
 	"go.temporal.io/server/common/service/config"
 	mysqlversion "go.temporal.io/server/schema/mysql"
 	posrgresqlversion "go.temporal.io/server/schema/postgresql"
+	sqliteversion "go.temporal.io/server/schema/sqlite"
 	"go.temporal.io/server/tools/common/schema"
 )
 
@@ -60,6 +64,11 @@ func VerifyCompatibleVersion(
 			if err != nil {
 				return err
 			}
+		case sqlite.PluginName:
+			err := CheckCompatibleVersion(*ds.SQL, sqliteversion.Version)
+			if err != nil {
+				return err
+			}
 		default:
 			panic(fmt.Sprintf("unknown sql store drier: %v", ds.SQL.PluginName))
 		}
@@ -77,6 +86,11 @@ func VerifyCompatibleVersion(
 			if err != nil {
 				return err
 			}
+		case sqlite.PluginName:
+			err := CheckCompatibleVersion(*ds.SQL, sqliteversion.VisibilityVersion)
+			if err != nil {
+				return err
+			}
 		default:
 			panic(fmt.Sprintf("unknown sql store drier: %v", ds.SQL.PluginName))
 		}
@@ -85,11 +99,24 @@ func VerifyCompatibleVersion(
 }
 
 // CheckCompatibleVersion check the version compatibility
+// of the database and of all its shard databases
 func CheckCompatibleVersion(
 	cfg config.SQL,
 	expectedVersion string,
 ) error {
-	connection, err := NewConnection(&cfg)
+	for _, databaseCfg := range sql.ShardDatabaseConfigs(&cfg) {
+		if err := checkCompatibleVersion(databaseCfg, expectedVersion); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func checkCompatibleVersion(
+	cfg *config.SQL,
+	expectedVersion string,
+) error {
+	connection, err := NewConnection(cfg)
 
 	if err != nil {
 		return fmt.Errorf("unable to create SQL connection: %v", err.Error())
@@ -101,48 +128,65 @@ func CheckCompatibleVersion(
 
 // setupSchema executes the setupSchemaTask
 // using the given command line arguments
-// as input
+// as input, on the database and all its
+// shard databases
 func setupSchema(cli *cli.Context) error {
 	cfg, err := parseConnectConfig(cli)
 	if err != nil {
 		return handleErr(schema.NewConfigError(err.Error()))
 	}
+	for _, databaseCfg := range sql.ShardDatabaseConfigs(cfg) {
+		if err := setupDatabaseSchema(cli, databaseCfg); err != nil {
+			return handleErr(err)
+		}
+	}
+	return nil
+}
+
+func setupDatabaseSchema(cli *cli.Context, cfg *config.SQL) error {
 	conn, err := NewConnection(cfg)
 	if err != nil {
-		return handleErr(err)
+		return err
 	}
 	defer conn.Close()
-	if err := schema.Setup(cli, conn); err != nil {
-		return handleErr(err)
-	}
-	return nil
+	return schema.Setup(cli, conn)
 }
 
 // updateSchema executes the updateSchemaTask
-// using the given command lien args as input
+// using the given command lien args as input,
+// on the database and all its shard databases
 func updateSchema(cli *cli.Context) error {
 	cfg, err := parseConnectConfig(cli)
 	if err != nil {
 		return handleErr(schema.NewConfigError(err.Error()))
 	}
 	if cfg.DatabaseName == schema.DryrunDBName {
+		// all shard databases share the schema, a single dryrun covers them
+		cfg.ShardDatabases = nil
 		if err := DoCreateDatabase(cfg, cfg.DatabaseName); err != nil {
 			return handleErr(fmt.Errorf("error creating dryrun database: %v", err))
 		}
 		defer DoDropDatabase(cfg, cfg.DatabaseName)
 	}
+	for _, databaseCfg := range sql.ShardDatabaseConfigs(cfg) {
+		if err := updateDatabaseSchema(cli, databaseCfg); err != nil {
+			return handleErr(err)
+		}
+	}
+	return nil
+}
+
+func updateDatabaseSchema(cli *cli.Context, cfg *config.SQL) error {
 	conn, err := NewConnection(cfg)
 	if err != nil {
-		return handleErr(err)
+		return err
 	}
 	defer conn.Close()
-	if err := schema.Update(cli, conn); err != nil {
-		return handleErr(err)
-	}
-	return nil
+	return schema.Update(cli, conn)
 }
 
-// createDatabase creates a sql database
+// createDatabase creates a sql database,
+// together with all shard databases
 func createDatabase(cli *cli.Context) error {
 	cfg, err := parseConnectConfig(cli)
 	if err != nil {
@@ -152,10 +196,16 @@ func createDatabase(cli *cli.Context) error {
 	if database == "" {
 		return handleErr(schema.NewConfigError("missing " + flag(schema.CLIOptDatabase) + " argument "))
 	}
-	err = DoCreateDatabase(cfg, database)
+	databaseCfgs := sql.ShardDatabaseConfigs(cfg)
+	err = DoCreateDatabase(databaseCfgs[0], database)
 	if err != nil {
 		return handleErr(fmt.Errorf("error creating database:%v", err))
 	}
+	for _, databaseCfg := range databaseCfgs[1:] {
+		if err := DoCreateDatabase(databaseCfg, databaseCfg.DatabaseName); err != nil {
+			return handleErr(fmt.Errorf("error creating shard database:%v", err))
+		}
+	}
 	return nil
 }
 
@@ -213,6 +263,12 @@ func parseConnectConfig(cli *cli.Context) (*config.SQL, error) {
 		}
 	}
 
+	shardDatabases, err := parseShardDatabases(cli.GlobalString(schema.CLIOptShardDatabases))
+	if err != nil {
+		return nil, err
+	}
+	cfg.ShardDatabases = shardDatabases
+
 	if cli.GlobalBool(schema.CLIFlagEnableTLS) {
 		cfg.TLS = &auth.TLS{
 			Enabled:                true,
@@ -230,6 +286,29 @@ func parseConnectConfig(cli *cli.Context) (*config.SQL, error) {
 	return cfg, nil
 }
 
+// parseShardDatabases parses a comma separated list of host:port/database shard databases
+func parseShardDatabases(value string) ([]config.SQLShardDatabase, error) {
+	if value == "" {
+		return nil, nil
+	}
+	var databases []config.SQLShardDatabase
+	for _, entry := range strings.Split(value, ",") {
+		entry = strings.TrimSpace(entry)
+		slash := strings.LastIndex(entry, "/")
+		if slash <= 0 || slash == len(entry)-1 {
+			return nil, fmt.Errorf("invalid shard database %q, expected host:port/database", entry)
+		}
+		if _, _, err := net.SplitHostPort(entry[:slash]); err != nil {
+			return nil, fmt.Errorf("invalid host and port of shard database %q: %v", entry, err)
+		}
+		databases = append(databases, config.SQLShardDatabase{
+			ConnectAddr:  entry[:slash],
+			DatabaseName: entry[slash+1:],
+		})
+	}
+	return databases, nil
+}
+
 // ValidateConnectConfig validates params
 func ValidateConnectConfig(cfg *config.SQL, isDryRun bool) error {
 	host, _, err := net.SplitHostPort(cfg.ConnectAddr)
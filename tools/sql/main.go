@@ -98,6 +98,11 @@ func BuildCLIOptions() *cli.App {
 			Name:  schema.CLIFlagQuiet,
 			Usage: "Don't set exit status to 1 on error",
 		},
+		cli.StringFlag{
+			Name:   schema.CLIFlagShardDatabases,
+			Usage:  "comma separated host:port/database list of the extra databases history shards are spread across",
+			EnvVar: "SQL_SHARD_DATABASES",
+		},
 		cli.StringFlag{
 			Name:   schema.CLIFlagConnectAttributes,
 			Usage:  "sql connect attributes",
@@ -174,6 +179,14 @@ func BuildCLIOptions() *cli.App {
 					Name:  schema.CLIFlagDryrun,
 					Usage: "do a dryrun",
 				},
+				cli.BoolFlag{
+					Name:  schema.CLIFlagPlan,
+					Usage: "print the statements the update would execute, without executing them",
+				},
+				cli.BoolFlag{
+					Name:  schema.CLIFlagMarkApplied,
+					Usage: "record the versions up to the target version as applied without executing them",
+				},
 			},
 			Action: func(c *cli.Context) {
 				cliHandler(c, updateSchema)